@@ -131,7 +131,9 @@ func (clc *CallLogCreate) Mutation() *CallLogMutation {
 
 // Save creates the CallLog in the database.
 func (clc *CallLogCreate) Save(ctx context.Context) (*CallLog, error) {
-	clc.defaults()
+	if err := clc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, clc.sqlSave, clc.mutation, clc.hooks)
 }
 
@@ -158,19 +160,26 @@ func (clc *CallLogCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (clc *CallLogCreate) defaults() {
+func (clc *CallLogCreate) defaults() error {
 	if _, ok := clc.mutation.Status(); !ok {
 		v := calllog.DefaultStatus
 		clc.mutation.SetStatus(v)
 	}
 	if _, ok := clc.mutation.StartedAt(); !ok {
+		if calllog.DefaultStartedAt == nil {
+			return fmt.Errorf("ent: uninitialized calllog.DefaultStartedAt (forgotten import ent/runtime?)")
+		}
 		v := calllog.DefaultStartedAt()
 		clc.mutation.SetStartedAt(v)
 	}
 	if _, ok := clc.mutation.CreatedAt(); !ok {
+		if calllog.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized calllog.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := calllog.DefaultCreatedAt()
 		clc.mutation.SetCreatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
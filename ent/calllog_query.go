@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -436,6 +437,12 @@ func (clq *CallLogQuery) prepareQuery(ctx context.Context) error {
 		}
 		clq.sql = prev
 	}
+	if calllog.Policy == nil {
+		return errors.New("ent: uninitialized calllog.Policy (forgotten import ent/runtime?)")
+	}
+	if err := calllog.Policy.EvalQuery(ctx, clq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -93,7 +93,9 @@ func (cpc *CallParticipantCreate) Mutation() *CallParticipantMutation {
 
 // Save creates the CallParticipant in the database.
 func (cpc *CallParticipantCreate) Save(ctx context.Context) (*CallParticipant, error) {
-	cpc.defaults()
+	if err := cpc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, cpc.sqlSave, cpc.mutation, cpc.hooks)
 }
 
@@ -120,15 +122,19 @@ func (cpc *CallParticipantCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (cpc *CallParticipantCreate) defaults() {
+func (cpc *CallParticipantCreate) defaults() error {
 	if _, ok := cpc.mutation.Role(); !ok {
 		v := callparticipant.DefaultRole
 		cpc.mutation.SetRole(v)
 	}
 	if _, ok := cpc.mutation.JoinedAt(); !ok {
+		if callparticipant.DefaultJoinedAt == nil {
+			return fmt.Errorf("ent: uninitialized callparticipant.DefaultJoinedAt (forgotten import ent/runtime?)")
+		}
 		v := callparticipant.DefaultJoinedAt()
 		cpc.mutation.SetJoinedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -399,6 +400,12 @@ func (cpq *CallParticipantQuery) prepareQuery(ctx context.Context) error {
 		}
 		cpq.sql = prev
 	}
+	if callparticipant.Policy == nil {
+		return errors.New("ent: uninitialized callparticipant.Policy (forgotten import ent/runtime?)")
+	}
+	if err := callparticipant.Policy.EvalQuery(ctx, cpq); err != nil {
+		return err
+	}
 	return nil
 }
 
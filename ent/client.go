@@ -15,15 +15,38 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eleven-am/enclave/ent/apikey"
 	"github.com/eleven-am/enclave/ent/calllog"
 	"github.com/eleven-am/enclave/ent/callparticipant"
 	"github.com/eleven-am/enclave/ent/contact"
+	"github.com/eleven-am/enclave/ent/credential"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/emailtoken"
 	"github.com/eleven-am/enclave/ent/favourite"
+	"github.com/eleven-am/enclave/ent/identity"
+	"github.com/eleven-am/enclave/ent/identitykey"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/keylogentry"
+	"github.com/eleven-am/enclave/ent/keyloghead"
+	"github.com/eleven-am/enclave/ent/keylognode"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/loginthrottle"
 	"github.com/eleven-am/enclave/ent/media"
 	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
 	"github.com/eleven-am/enclave/ent/notification"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+	"github.com/eleven-am/enclave/ent/recoverycode"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+	"github.com/eleven-am/enclave/ent/totpsecret"
 	"github.com/eleven-am/enclave/ent/user"
 )
 
@@ -32,24 +55,70 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
 	// CallLog is the client for interacting with the CallLog builders.
 	CallLog *CallLogClient
 	// CallParticipant is the client for interacting with the CallParticipant builders.
 	CallParticipant *CallParticipantClient
 	// Contact is the client for interacting with the Contact builders.
 	Contact *ContactClient
+	// Credential is the client for interacting with the Credential builders.
+	Credential *CredentialClient
+	// Device is the client for interacting with the Device builders.
+	Device *DeviceClient
+	// EmailToken is the client for interacting with the EmailToken builders.
+	EmailToken *EmailTokenClient
 	// Favourite is the client for interacting with the Favourite builders.
 	Favourite *FavouriteClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
+	// IdentityKey is the client for interacting with the IdentityKey builders.
+	IdentityKey *IdentityKeyClient
+	// IdentityKeyRecord is the client for interacting with the IdentityKeyRecord builders.
+	IdentityKeyRecord *IdentityKeyRecordClient
+	// KeyBackupKey is the client for interacting with the KeyBackupKey builders.
+	KeyBackupKey *KeyBackupKeyClient
+	// KeyBackupVersion is the client for interacting with the KeyBackupVersion builders.
+	KeyBackupVersion *KeyBackupVersionClient
+	// KeyLogEntry is the client for interacting with the KeyLogEntry builders.
+	KeyLogEntry *KeyLogEntryClient
+	// KeyLogHead is the client for interacting with the KeyLogHead builders.
+	KeyLogHead *KeyLogHeadClient
+	// KeyLogNode is the client for interacting with the KeyLogNode builders.
+	KeyLogNode *KeyLogNodeClient
+	// KeyPackage is the client for interacting with the KeyPackage builders.
+	KeyPackage *KeyPackageClient
+	// LoginThrottle is the client for interacting with the LoginThrottle builders.
+	LoginThrottle *LoginThrottleClient
 	// Media is the client for interacting with the Media builders.
 	Media *MediaClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageEnvelope is the client for interacting with the MessageEnvelope builders.
+	MessageEnvelope *MessageEnvelopeClient
+	// MlsCommit is the client for interacting with the MlsCommit builders.
+	MlsCommit *MlsCommitClient
+	// MlsWelcome is the client for interacting with the MlsWelcome builders.
+	MlsWelcome *MlsWelcomeClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
+	// OneTimePreKey is the client for interacting with the OneTimePreKey builders.
+	OneTimePreKey *OneTimePreKeyClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
 	// Room is the client for interacting with the Room builders.
 	Room *RoomClient
 	// RoomMembership is the client for interacting with the RoomMembership builders.
 	RoomMembership *RoomMembershipClient
+	// SenderKeyDistribution is the client for interacting with the SenderKeyDistribution builders.
+	SenderKeyDistribution *SenderKeyDistributionClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// SignedPreKey is the client for interacting with the SignedPreKey builders.
+	SignedPreKey *SignedPreKeyClient
+	// TotpSecret is the client for interacting with the TotpSecret builders.
+	TotpSecret *TotpSecretClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -63,15 +132,38 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ApiKey = NewApiKeyClient(c.config)
 	c.CallLog = NewCallLogClient(c.config)
 	c.CallParticipant = NewCallParticipantClient(c.config)
 	c.Contact = NewContactClient(c.config)
+	c.Credential = NewCredentialClient(c.config)
+	c.Device = NewDeviceClient(c.config)
+	c.EmailToken = NewEmailTokenClient(c.config)
 	c.Favourite = NewFavouriteClient(c.config)
+	c.Identity = NewIdentityClient(c.config)
+	c.IdentityKey = NewIdentityKeyClient(c.config)
+	c.IdentityKeyRecord = NewIdentityKeyRecordClient(c.config)
+	c.KeyBackupKey = NewKeyBackupKeyClient(c.config)
+	c.KeyBackupVersion = NewKeyBackupVersionClient(c.config)
+	c.KeyLogEntry = NewKeyLogEntryClient(c.config)
+	c.KeyLogHead = NewKeyLogHeadClient(c.config)
+	c.KeyLogNode = NewKeyLogNodeClient(c.config)
+	c.KeyPackage = NewKeyPackageClient(c.config)
+	c.LoginThrottle = NewLoginThrottleClient(c.config)
 	c.Media = NewMediaClient(c.config)
 	c.Message = NewMessageClient(c.config)
+	c.MessageEnvelope = NewMessageEnvelopeClient(c.config)
+	c.MlsCommit = NewMlsCommitClient(c.config)
+	c.MlsWelcome = NewMlsWelcomeClient(c.config)
 	c.Notification = NewNotificationClient(c.config)
+	c.OneTimePreKey = NewOneTimePreKeyClient(c.config)
+	c.RecoveryCode = NewRecoveryCodeClient(c.config)
 	c.Room = NewRoomClient(c.config)
 	c.RoomMembership = NewRoomMembershipClient(c.config)
+	c.SenderKeyDistribution = NewSenderKeyDistributionClient(c.config)
+	c.Session = NewSessionClient(c.config)
+	c.SignedPreKey = NewSignedPreKeyClient(c.config)
+	c.TotpSecret = NewTotpSecretClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -163,18 +255,41 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		CallLog:         NewCallLogClient(cfg),
-		CallParticipant: NewCallParticipantClient(cfg),
-		Contact:         NewContactClient(cfg),
-		Favourite:       NewFavouriteClient(cfg),
-		Media:           NewMediaClient(cfg),
-		Message:         NewMessageClient(cfg),
-		Notification:    NewNotificationClient(cfg),
-		Room:            NewRoomClient(cfg),
-		RoomMembership:  NewRoomMembershipClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		ApiKey:                NewApiKeyClient(cfg),
+		CallLog:               NewCallLogClient(cfg),
+		CallParticipant:       NewCallParticipantClient(cfg),
+		Contact:               NewContactClient(cfg),
+		Credential:            NewCredentialClient(cfg),
+		Device:                NewDeviceClient(cfg),
+		EmailToken:            NewEmailTokenClient(cfg),
+		Favourite:             NewFavouriteClient(cfg),
+		Identity:              NewIdentityClient(cfg),
+		IdentityKey:           NewIdentityKeyClient(cfg),
+		IdentityKeyRecord:     NewIdentityKeyRecordClient(cfg),
+		KeyBackupKey:          NewKeyBackupKeyClient(cfg),
+		KeyBackupVersion:      NewKeyBackupVersionClient(cfg),
+		KeyLogEntry:           NewKeyLogEntryClient(cfg),
+		KeyLogHead:            NewKeyLogHeadClient(cfg),
+		KeyLogNode:            NewKeyLogNodeClient(cfg),
+		KeyPackage:            NewKeyPackageClient(cfg),
+		LoginThrottle:         NewLoginThrottleClient(cfg),
+		Media:                 NewMediaClient(cfg),
+		Message:               NewMessageClient(cfg),
+		MessageEnvelope:       NewMessageEnvelopeClient(cfg),
+		MlsCommit:             NewMlsCommitClient(cfg),
+		MlsWelcome:            NewMlsWelcomeClient(cfg),
+		Notification:          NewNotificationClient(cfg),
+		OneTimePreKey:         NewOneTimePreKeyClient(cfg),
+		RecoveryCode:          NewRecoveryCodeClient(cfg),
+		Room:                  NewRoomClient(cfg),
+		RoomMembership:        NewRoomMembershipClient(cfg),
+		SenderKeyDistribution: NewSenderKeyDistributionClient(cfg),
+		Session:               NewSessionClient(cfg),
+		SignedPreKey:          NewSignedPreKeyClient(cfg),
+		TotpSecret:            NewTotpSecretClient(cfg),
+		User:                  NewUserClient(cfg),
 	}, nil
 }
 
@@ -192,25 +307,48 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		CallLog:         NewCallLogClient(cfg),
-		CallParticipant: NewCallParticipantClient(cfg),
-		Contact:         NewContactClient(cfg),
-		Favourite:       NewFavouriteClient(cfg),
-		Media:           NewMediaClient(cfg),
-		Message:         NewMessageClient(cfg),
-		Notification:    NewNotificationClient(cfg),
-		Room:            NewRoomClient(cfg),
-		RoomMembership:  NewRoomMembershipClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		ApiKey:                NewApiKeyClient(cfg),
+		CallLog:               NewCallLogClient(cfg),
+		CallParticipant:       NewCallParticipantClient(cfg),
+		Contact:               NewContactClient(cfg),
+		Credential:            NewCredentialClient(cfg),
+		Device:                NewDeviceClient(cfg),
+		EmailToken:            NewEmailTokenClient(cfg),
+		Favourite:             NewFavouriteClient(cfg),
+		Identity:              NewIdentityClient(cfg),
+		IdentityKey:           NewIdentityKeyClient(cfg),
+		IdentityKeyRecord:     NewIdentityKeyRecordClient(cfg),
+		KeyBackupKey:          NewKeyBackupKeyClient(cfg),
+		KeyBackupVersion:      NewKeyBackupVersionClient(cfg),
+		KeyLogEntry:           NewKeyLogEntryClient(cfg),
+		KeyLogHead:            NewKeyLogHeadClient(cfg),
+		KeyLogNode:            NewKeyLogNodeClient(cfg),
+		KeyPackage:            NewKeyPackageClient(cfg),
+		LoginThrottle:         NewLoginThrottleClient(cfg),
+		Media:                 NewMediaClient(cfg),
+		Message:               NewMessageClient(cfg),
+		MessageEnvelope:       NewMessageEnvelopeClient(cfg),
+		MlsCommit:             NewMlsCommitClient(cfg),
+		MlsWelcome:            NewMlsWelcomeClient(cfg),
+		Notification:          NewNotificationClient(cfg),
+		OneTimePreKey:         NewOneTimePreKeyClient(cfg),
+		RecoveryCode:          NewRecoveryCodeClient(cfg),
+		Room:                  NewRoomClient(cfg),
+		RoomMembership:        NewRoomMembershipClient(cfg),
+		SenderKeyDistribution: NewSenderKeyDistributionClient(cfg),
+		Session:               NewSessionClient(cfg),
+		SignedPreKey:          NewSignedPreKeyClient(cfg),
+		TotpSecret:            NewTotpSecretClient(cfg),
+		User:                  NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		CallLog.
+//		ApiKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -233,8 +371,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.CallLog, c.CallParticipant, c.Contact, c.Favourite, c.Media, c.Message,
-		c.Notification, c.Room, c.RoomMembership, c.User,
+		c.ApiKey, c.CallLog, c.CallParticipant, c.Contact, c.Credential, c.Device,
+		c.EmailToken, c.Favourite, c.Identity, c.IdentityKey, c.IdentityKeyRecord,
+		c.KeyBackupKey, c.KeyBackupVersion, c.KeyLogEntry, c.KeyLogHead, c.KeyLogNode,
+		c.KeyPackage, c.LoginThrottle, c.Media, c.Message, c.MessageEnvelope,
+		c.MlsCommit, c.MlsWelcome, c.Notification, c.OneTimePreKey, c.RecoveryCode,
+		c.Room, c.RoomMembership, c.SenderKeyDistribution, c.Session, c.SignedPreKey,
+		c.TotpSecret, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -244,8 +387,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.CallLog, c.CallParticipant, c.Contact, c.Favourite, c.Media, c.Message,
-		c.Notification, c.Room, c.RoomMembership, c.User,
+		c.ApiKey, c.CallLog, c.CallParticipant, c.Contact, c.Credential, c.Device,
+		c.EmailToken, c.Favourite, c.Identity, c.IdentityKey, c.IdentityKeyRecord,
+		c.KeyBackupKey, c.KeyBackupVersion, c.KeyLogEntry, c.KeyLogHead, c.KeyLogNode,
+		c.KeyPackage, c.LoginThrottle, c.Media, c.Message, c.MessageEnvelope,
+		c.MlsCommit, c.MlsWelcome, c.Notification, c.OneTimePreKey, c.RecoveryCode,
+		c.Room, c.RoomMembership, c.SenderKeyDistribution, c.Session, c.SignedPreKey,
+		c.TotpSecret, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -254,24 +402,70 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ApiKeyMutation:
+		return c.ApiKey.mutate(ctx, m)
 	case *CallLogMutation:
 		return c.CallLog.mutate(ctx, m)
 	case *CallParticipantMutation:
 		return c.CallParticipant.mutate(ctx, m)
 	case *ContactMutation:
 		return c.Contact.mutate(ctx, m)
+	case *CredentialMutation:
+		return c.Credential.mutate(ctx, m)
+	case *DeviceMutation:
+		return c.Device.mutate(ctx, m)
+	case *EmailTokenMutation:
+		return c.EmailToken.mutate(ctx, m)
 	case *FavouriteMutation:
 		return c.Favourite.mutate(ctx, m)
+	case *IdentityMutation:
+		return c.Identity.mutate(ctx, m)
+	case *IdentityKeyMutation:
+		return c.IdentityKey.mutate(ctx, m)
+	case *IdentityKeyRecordMutation:
+		return c.IdentityKeyRecord.mutate(ctx, m)
+	case *KeyBackupKeyMutation:
+		return c.KeyBackupKey.mutate(ctx, m)
+	case *KeyBackupVersionMutation:
+		return c.KeyBackupVersion.mutate(ctx, m)
+	case *KeyLogEntryMutation:
+		return c.KeyLogEntry.mutate(ctx, m)
+	case *KeyLogHeadMutation:
+		return c.KeyLogHead.mutate(ctx, m)
+	case *KeyLogNodeMutation:
+		return c.KeyLogNode.mutate(ctx, m)
+	case *KeyPackageMutation:
+		return c.KeyPackage.mutate(ctx, m)
+	case *LoginThrottleMutation:
+		return c.LoginThrottle.mutate(ctx, m)
 	case *MediaMutation:
 		return c.Media.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
+	case *MessageEnvelopeMutation:
+		return c.MessageEnvelope.mutate(ctx, m)
+	case *MlsCommitMutation:
+		return c.MlsCommit.mutate(ctx, m)
+	case *MlsWelcomeMutation:
+		return c.MlsWelcome.mutate(ctx, m)
 	case *NotificationMutation:
 		return c.Notification.mutate(ctx, m)
+	case *OneTimePreKeyMutation:
+		return c.OneTimePreKey.mutate(ctx, m)
+	case *RecoveryCodeMutation:
+		return c.RecoveryCode.mutate(ctx, m)
 	case *RoomMutation:
 		return c.Room.mutate(ctx, m)
 	case *RoomMembershipMutation:
 		return c.RoomMembership.mutate(ctx, m)
+	case *SenderKeyDistributionMutation:
+		return c.SenderKeyDistribution.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
+	case *SignedPreKeyMutation:
+		return c.SignedPreKey.mutate(ctx, m)
+	case *TotpSecretMutation:
+		return c.TotpSecret.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -279,6 +473,171 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// ApiKeyClient is a client for the ApiKey schema.
+type ApiKeyClient struct {
+	config
+}
+
+// NewApiKeyClient returns a client for the ApiKey from the given config.
+func NewApiKeyClient(c config) *ApiKeyClient {
+	return &ApiKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *ApiKeyClient) Use(hooks ...Hook) {
+	c.hooks.ApiKey = append(c.hooks.ApiKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *ApiKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApiKey = append(c.inters.ApiKey, interceptors...)
+}
+
+// Create returns a builder for creating a ApiKey entity.
+func (c *ApiKeyClient) Create() *ApiKeyCreate {
+	mutation := newApiKeyMutation(c.config, OpCreate)
+	return &ApiKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApiKey entities.
+func (c *ApiKeyClient) CreateBulk(builders ...*ApiKeyCreate) *ApiKeyCreateBulk {
+	return &ApiKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ApiKeyClient) MapCreateBulk(slice any, setFunc func(*ApiKeyCreate, int)) *ApiKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ApiKeyCreateBulk{err: fmt.Errorf("calling to ApiKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ApiKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ApiKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApiKey.
+func (c *ApiKeyClient) Update() *ApiKeyUpdate {
+	mutation := newApiKeyMutation(c.config, OpUpdate)
+	return &ApiKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApiKeyClient) UpdateOne(ak *ApiKey) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKey(ak))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApiKeyClient) UpdateOneID(id int) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKeyID(id))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApiKey.
+func (c *ApiKeyClient) Delete() *ApiKeyDelete {
+	mutation := newApiKeyMutation(c.config, OpDelete)
+	return &ApiKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApiKeyClient) DeleteOne(ak *ApiKey) *ApiKeyDeleteOne {
+	return c.DeleteOneID(ak.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ApiKeyClient) DeleteOneID(id int) *ApiKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApiKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for ApiKey.
+func (c *ApiKeyClient) Query() *ApiKeyQuery {
+	return &ApiKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeApiKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ApiKey entity by its id.
+func (c *ApiKeyClient) Get(ctx context.Context, id int) (*ApiKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApiKeyClient) GetX(ctx context.Context, id int) *ApiKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ApiKey.
+func (c *ApiKeyClient) QueryUser(ak *ApiKey) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ak.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, apikey.UserTable, apikey.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ak.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedBy queries the created_by edge of a ApiKey.
+func (c *ApiKeyClient) QueryCreatedBy(ak *ApiKey) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ak.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, apikey.CreatedByTable, apikey.CreatedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(ak.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ApiKeyClient) Hooks() []Hook {
+	return c.hooks.ApiKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *ApiKeyClient) Interceptors() []Interceptor {
+	return c.inters.ApiKey
+}
+
+func (c *ApiKeyClient) mutate(ctx context.Context, m *ApiKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ApiKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ApiKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ApiKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ApiKey mutation op: %q", m.Op())
+	}
+}
+
 // CallLogClient is a client for the CallLog schema.
 type CallLogClient struct {
 	config
@@ -437,7 +796,8 @@ func (c *CallLogClient) QueryParticipants(cl *CallLog) *CallParticipantQuery {
 
 // Hooks returns the client hooks.
 func (c *CallLogClient) Hooks() []Hook {
-	return c.hooks.CallLog
+	hooks := c.hooks.CallLog
+	return append(hooks[:len(hooks):len(hooks)], calllog.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -602,7 +962,8 @@ func (c *CallParticipantClient) QueryParticipant(cp *CallParticipant) *UserQuery
 
 // Hooks returns the client hooks.
 func (c *CallParticipantClient) Hooks() []Hook {
-	return c.hooks.CallParticipant
+	hooks := c.hooks.CallParticipant
+	return append(hooks[:len(hooks):len(hooks)], callparticipant.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -767,7 +1128,8 @@ func (c *ContactClient) QueryContact(co *Contact) *UserQuery {
 
 // Hooks returns the client hooks.
 func (c *ContactClient) Hooks() []Hook {
-	return c.hooks.Contact
+	hooks := c.hooks.Contact
+	return append(hooks[:len(hooks):len(hooks)], contact.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -790,107 +1152,107 @@ func (c *ContactClient) mutate(ctx context.Context, m *ContactMutation) (Value,
 	}
 }
 
-// FavouriteClient is a client for the Favourite schema.
-type FavouriteClient struct {
+// CredentialClient is a client for the Credential schema.
+type CredentialClient struct {
 	config
 }
 
-// NewFavouriteClient returns a client for the Favourite from the given config.
-func NewFavouriteClient(c config) *FavouriteClient {
-	return &FavouriteClient{config: c}
+// NewCredentialClient returns a client for the Credential from the given config.
+func NewCredentialClient(c config) *CredentialClient {
+	return &CredentialClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `favourite.Hooks(f(g(h())))`.
-func (c *FavouriteClient) Use(hooks ...Hook) {
-	c.hooks.Favourite = append(c.hooks.Favourite, hooks...)
+// A call to `Use(f, g, h)` equals to `credential.Hooks(f(g(h())))`.
+func (c *CredentialClient) Use(hooks ...Hook) {
+	c.hooks.Credential = append(c.hooks.Credential, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `favourite.Intercept(f(g(h())))`.
-func (c *FavouriteClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Favourite = append(c.inters.Favourite, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `credential.Intercept(f(g(h())))`.
+func (c *CredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Credential = append(c.inters.Credential, interceptors...)
 }
 
-// Create returns a builder for creating a Favourite entity.
-func (c *FavouriteClient) Create() *FavouriteCreate {
-	mutation := newFavouriteMutation(c.config, OpCreate)
-	return &FavouriteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Credential entity.
+func (c *CredentialClient) Create() *CredentialCreate {
+	mutation := newCredentialMutation(c.config, OpCreate)
+	return &CredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Favourite entities.
-func (c *FavouriteClient) CreateBulk(builders ...*FavouriteCreate) *FavouriteCreateBulk {
-	return &FavouriteCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Credential entities.
+func (c *CredentialClient) CreateBulk(builders ...*CredentialCreate) *CredentialCreateBulk {
+	return &CredentialCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FavouriteClient) MapCreateBulk(slice any, setFunc func(*FavouriteCreate, int)) *FavouriteCreateBulk {
+func (c *CredentialClient) MapCreateBulk(slice any, setFunc func(*CredentialCreate, int)) *CredentialCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FavouriteCreateBulk{err: fmt.Errorf("calling to FavouriteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CredentialCreateBulk{err: fmt.Errorf("calling to CredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FavouriteCreate, rv.Len())
+	builders := make([]*CredentialCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FavouriteCreateBulk{config: c.config, builders: builders}
+	return &CredentialCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Favourite.
-func (c *FavouriteClient) Update() *FavouriteUpdate {
-	mutation := newFavouriteMutation(c.config, OpUpdate)
-	return &FavouriteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Credential.
+func (c *CredentialClient) Update() *CredentialUpdate {
+	mutation := newCredentialMutation(c.config, OpUpdate)
+	return &CredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FavouriteClient) UpdateOne(f *Favourite) *FavouriteUpdateOne {
-	mutation := newFavouriteMutation(c.config, OpUpdateOne, withFavourite(f))
-	return &FavouriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CredentialClient) UpdateOne(cr *Credential) *CredentialUpdateOne {
+	mutation := newCredentialMutation(c.config, OpUpdateOne, withCredential(cr))
+	return &CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FavouriteClient) UpdateOneID(id int) *FavouriteUpdateOne {
-	mutation := newFavouriteMutation(c.config, OpUpdateOne, withFavouriteID(id))
-	return &FavouriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CredentialClient) UpdateOneID(id int) *CredentialUpdateOne {
+	mutation := newCredentialMutation(c.config, OpUpdateOne, withCredentialID(id))
+	return &CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Favourite.
-func (c *FavouriteClient) Delete() *FavouriteDelete {
-	mutation := newFavouriteMutation(c.config, OpDelete)
-	return &FavouriteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Credential.
+func (c *CredentialClient) Delete() *CredentialDelete {
+	mutation := newCredentialMutation(c.config, OpDelete)
+	return &CredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FavouriteClient) DeleteOne(f *Favourite) *FavouriteDeleteOne {
-	return c.DeleteOneID(f.ID)
+func (c *CredentialClient) DeleteOne(cr *Credential) *CredentialDeleteOne {
+	return c.DeleteOneID(cr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FavouriteClient) DeleteOneID(id int) *FavouriteDeleteOne {
-	builder := c.Delete().Where(favourite.ID(id))
+func (c *CredentialClient) DeleteOneID(id int) *CredentialDeleteOne {
+	builder := c.Delete().Where(credential.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FavouriteDeleteOne{builder}
+	return &CredentialDeleteOne{builder}
 }
 
-// Query returns a query builder for Favourite.
-func (c *FavouriteClient) Query() *FavouriteQuery {
-	return &FavouriteQuery{
+// Query returns a query builder for Credential.
+func (c *CredentialClient) Query() *CredentialQuery {
+	return &CredentialQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFavourite},
+		ctx:    &QueryContext{Type: TypeCredential},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Favourite entity by its id.
-func (c *FavouriteClient) Get(ctx context.Context, id int) (*Favourite, error) {
-	return c.Query().Where(favourite.ID(id)).Only(ctx)
+// Get returns a Credential entity by its id.
+func (c *CredentialClient) Get(ctx context.Context, id int) (*Credential, error) {
+	return c.Query().Where(credential.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FavouriteClient) GetX(ctx context.Context, id int) *Favourite {
+func (c *CredentialClient) GetX(ctx context.Context, id int) *Credential {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -898,164 +1260,148 @@ func (c *FavouriteClient) GetX(ctx context.Context, id int) *Favourite {
 	return obj
 }
 
-// QueryUser queries the user edge of a Favourite.
-func (c *FavouriteClient) QueryUser(f *Favourite) *UserQuery {
+// QueryUser queries the user edge of a Credential.
+func (c *CredentialClient) QueryUser(cr *Credential) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := f.ID
+		id := cr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(favourite.Table, favourite.FieldID, id),
+			sqlgraph.From(credential.Table, credential.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, favourite.UserTable, favourite.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRoom queries the room edge of a Favourite.
-func (c *FavouriteClient) QueryRoom(f *Favourite) *RoomQuery {
-	query := (&RoomClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := f.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(favourite.Table, favourite.FieldID, id),
-			sqlgraph.To(room.Table, room.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, favourite.RoomTable, favourite.RoomColumn),
+			sqlgraph.Edge(sqlgraph.O2O, false, credential.UserTable, credential.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *FavouriteClient) Hooks() []Hook {
-	return c.hooks.Favourite
+func (c *CredentialClient) Hooks() []Hook {
+	return c.hooks.Credential
 }
 
 // Interceptors returns the client interceptors.
-func (c *FavouriteClient) Interceptors() []Interceptor {
-	return c.inters.Favourite
+func (c *CredentialClient) Interceptors() []Interceptor {
+	return c.inters.Credential
 }
 
-func (c *FavouriteClient) mutate(ctx context.Context, m *FavouriteMutation) (Value, error) {
+func (c *CredentialClient) mutate(ctx context.Context, m *CredentialMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FavouriteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FavouriteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FavouriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FavouriteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Favourite mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Credential mutation op: %q", m.Op())
 	}
 }
 
-// MediaClient is a client for the Media schema.
-type MediaClient struct {
+// DeviceClient is a client for the Device schema.
+type DeviceClient struct {
 	config
 }
 
-// NewMediaClient returns a client for the Media from the given config.
-func NewMediaClient(c config) *MediaClient {
-	return &MediaClient{config: c}
+// NewDeviceClient returns a client for the Device from the given config.
+func NewDeviceClient(c config) *DeviceClient {
+	return &DeviceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `media.Hooks(f(g(h())))`.
-func (c *MediaClient) Use(hooks ...Hook) {
-	c.hooks.Media = append(c.hooks.Media, hooks...)
+// A call to `Use(f, g, h)` equals to `device.Hooks(f(g(h())))`.
+func (c *DeviceClient) Use(hooks ...Hook) {
+	c.hooks.Device = append(c.hooks.Device, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `media.Intercept(f(g(h())))`.
-func (c *MediaClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Media = append(c.inters.Media, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `device.Intercept(f(g(h())))`.
+func (c *DeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Device = append(c.inters.Device, interceptors...)
 }
 
-// Create returns a builder for creating a Media entity.
-func (c *MediaClient) Create() *MediaCreate {
-	mutation := newMediaMutation(c.config, OpCreate)
-	return &MediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Device entity.
+func (c *DeviceClient) Create() *DeviceCreate {
+	mutation := newDeviceMutation(c.config, OpCreate)
+	return &DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Media entities.
-func (c *MediaClient) CreateBulk(builders ...*MediaCreate) *MediaCreateBulk {
-	return &MediaCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Device entities.
+func (c *DeviceClient) CreateBulk(builders ...*DeviceCreate) *DeviceCreateBulk {
+	return &DeviceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MediaClient) MapCreateBulk(slice any, setFunc func(*MediaCreate, int)) *MediaCreateBulk {
+func (c *DeviceClient) MapCreateBulk(slice any, setFunc func(*DeviceCreate, int)) *DeviceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MediaCreateBulk{err: fmt.Errorf("calling to MediaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeviceCreateBulk{err: fmt.Errorf("calling to DeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MediaCreate, rv.Len())
+	builders := make([]*DeviceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MediaCreateBulk{config: c.config, builders: builders}
+	return &DeviceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Media.
-func (c *MediaClient) Update() *MediaUpdate {
-	mutation := newMediaMutation(c.config, OpUpdate)
-	return &MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Device.
+func (c *DeviceClient) Update() *DeviceUpdate {
+	mutation := newDeviceMutation(c.config, OpUpdate)
+	return &DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MediaClient) UpdateOne(m *Media) *MediaUpdateOne {
-	mutation := newMediaMutation(c.config, OpUpdateOne, withMedia(m))
-	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceClient) UpdateOne(d *Device) *DeviceUpdateOne {
+	mutation := newDeviceMutation(c.config, OpUpdateOne, withDevice(d))
+	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MediaClient) UpdateOneID(id int) *MediaUpdateOne {
-	mutation := newMediaMutation(c.config, OpUpdateOne, withMediaID(id))
-	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeviceClient) UpdateOneID(id int) *DeviceUpdateOne {
+	mutation := newDeviceMutation(c.config, OpUpdateOne, withDeviceID(id))
+	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Media.
-func (c *MediaClient) Delete() *MediaDelete {
-	mutation := newMediaMutation(c.config, OpDelete)
-	return &MediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Device.
+func (c *DeviceClient) Delete() *DeviceDelete {
+	mutation := newDeviceMutation(c.config, OpDelete)
+	return &DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MediaClient) DeleteOne(m *Media) *MediaDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *DeviceClient) DeleteOne(d *Device) *DeviceDeleteOne {
+	return c.DeleteOneID(d.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MediaClient) DeleteOneID(id int) *MediaDeleteOne {
-	builder := c.Delete().Where(media.ID(id))
+func (c *DeviceClient) DeleteOneID(id int) *DeviceDeleteOne {
+	builder := c.Delete().Where(device.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MediaDeleteOne{builder}
+	return &DeviceDeleteOne{builder}
 }
 
-// Query returns a query builder for Media.
-func (c *MediaClient) Query() *MediaQuery {
-	return &MediaQuery{
+// Query returns a query builder for Device.
+func (c *DeviceClient) Query() *DeviceQuery {
+	return &DeviceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMedia},
+		ctx:    &QueryContext{Type: TypeDevice},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Media entity by its id.
-func (c *MediaClient) Get(ctx context.Context, id int) (*Media, error) {
-	return c.Query().Where(media.ID(id)).Only(ctx)
+// Get returns a Device entity by its id.
+func (c *DeviceClient) Get(ctx context.Context, id int) (*Device, error) {
+	return c.Query().Where(device.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MediaClient) GetX(ctx context.Context, id int) *Media {
+func (c *DeviceClient) GetX(ctx context.Context, id int) *Device {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1063,164 +1409,3867 @@ func (c *MediaClient) GetX(ctx context.Context, id int) *Media {
 	return obj
 }
 
-// QueryUploader queries the uploader edge of a Media.
-func (c *MediaClient) QueryUploader(m *Media) *UserQuery {
+// QueryUser queries the user edge of a Device.
+func (c *DeviceClient) QueryUser(d *Device) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := d.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.From(device.Table, device.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, media.UploaderTable, media.UploaderColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, device.UserTable, device.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryMessage queries the message edge of a Media.
-func (c *MediaClient) QueryMessage(m *Media) *MessageQuery {
-	query := (&MessageClient{config: c.config}).Query()
+// QueryIdentityKey queries the identity_key edge of a Device.
+func (c *DeviceClient) QueryIdentityKey(d *Device) *IdentityKeyQuery {
+	query := (&IdentityKeyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := d.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(message.Table, message.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, media.MessageTable, media.MessageColumn),
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(identitykey.Table, identitykey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, device.IdentityKeyTable, device.IdentityKeyColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *MediaClient) Hooks() []Hook {
-	return c.hooks.Media
+// QueryIdentityKeyHistory queries the identity_key_history edge of a Device.
+func (c *DeviceClient) QueryIdentityKeyHistory(d *Device) *IdentityKeyRecordQuery {
+	query := (&IdentityKeyRecordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(identitykeyrecord.Table, identitykeyrecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.IdentityKeyHistoryTable, device.IdentityKeyHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Interceptors returns the client interceptors.
-func (c *MediaClient) Interceptors() []Interceptor {
-	return c.inters.Media
+// QuerySignedPreKeys queries the signed_pre_keys edge of a Device.
+func (c *DeviceClient) QuerySignedPreKeys(d *Device) *SignedPreKeyQuery {
+	query := (&SignedPreKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(signedprekey.Table, signedprekey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.SignedPreKeysTable, device.SignedPreKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *MediaClient) mutate(ctx context.Context, m *MediaMutation) (Value, error) {
+// QueryOneTimePreKeys queries the one_time_pre_keys edge of a Device.
+func (c *DeviceClient) QueryOneTimePreKeys(d *Device) *OneTimePreKeyQuery {
+	query := (&OneTimePreKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(onetimeprekey.Table, onetimeprekey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.OneTimePreKeysTable, device.OneTimePreKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnvelopes queries the envelopes edge of a Device.
+func (c *DeviceClient) QueryEnvelopes(d *Device) *MessageEnvelopeQuery {
+	query := (&MessageEnvelopeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(messageenvelope.Table, messageenvelope.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.EnvelopesTable, device.EnvelopesColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryKeyPackages queries the key_packages edge of a Device.
+func (c *DeviceClient) QueryKeyPackages(d *Device) *KeyPackageQuery {
+	query := (&KeyPackageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(keypackage.Table, keypackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.KeyPackagesTable, device.KeyPackagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMlsWelcomes queries the mls_welcomes edge of a Device.
+func (c *DeviceClient) QueryMlsWelcomes(d *Device) *MlsWelcomeQuery {
+	query := (&MlsWelcomeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(mlswelcome.Table, mlswelcome.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.MlsWelcomesTable, device.MlsWelcomesColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySenderKeyDistributions queries the sender_key_distributions edge of a Device.
+func (c *DeviceClient) QuerySenderKeyDistributions(d *Device) *SenderKeyDistributionQuery {
+	query := (&SenderKeyDistributionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(senderkeydistribution.Table, senderkeydistribution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.SenderKeyDistributionsTable, device.SenderKeyDistributionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceClient) Hooks() []Hook {
+	return c.hooks.Device
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceClient) Interceptors() []Interceptor {
+	return c.inters.Device
+}
+
+func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Device mutation op: %q", m.Op())
+	}
+}
+
+// EmailTokenClient is a client for the EmailToken schema.
+type EmailTokenClient struct {
+	config
+}
+
+// NewEmailTokenClient returns a client for the EmailToken from the given config.
+func NewEmailTokenClient(c config) *EmailTokenClient {
+	return &EmailTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailtoken.Hooks(f(g(h())))`.
+func (c *EmailTokenClient) Use(hooks ...Hook) {
+	c.hooks.EmailToken = append(c.hooks.EmailToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailtoken.Intercept(f(g(h())))`.
+func (c *EmailTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailToken = append(c.inters.EmailToken, interceptors...)
+}
+
+// Create returns a builder for creating a EmailToken entity.
+func (c *EmailTokenClient) Create() *EmailTokenCreate {
+	mutation := newEmailTokenMutation(c.config, OpCreate)
+	return &EmailTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailToken entities.
+func (c *EmailTokenClient) CreateBulk(builders ...*EmailTokenCreate) *EmailTokenCreateBulk {
+	return &EmailTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailTokenClient) MapCreateBulk(slice any, setFunc func(*EmailTokenCreate, int)) *EmailTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailTokenCreateBulk{err: fmt.Errorf("calling to EmailTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailToken.
+func (c *EmailTokenClient) Update() *EmailTokenUpdate {
+	mutation := newEmailTokenMutation(c.config, OpUpdate)
+	return &EmailTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailTokenClient) UpdateOne(et *EmailToken) *EmailTokenUpdateOne {
+	mutation := newEmailTokenMutation(c.config, OpUpdateOne, withEmailToken(et))
+	return &EmailTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailTokenClient) UpdateOneID(id int) *EmailTokenUpdateOne {
+	mutation := newEmailTokenMutation(c.config, OpUpdateOne, withEmailTokenID(id))
+	return &EmailTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailToken.
+func (c *EmailTokenClient) Delete() *EmailTokenDelete {
+	mutation := newEmailTokenMutation(c.config, OpDelete)
+	return &EmailTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailTokenClient) DeleteOne(et *EmailToken) *EmailTokenDeleteOne {
+	return c.DeleteOneID(et.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailTokenClient) DeleteOneID(id int) *EmailTokenDeleteOne {
+	builder := c.Delete().Where(emailtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailToken.
+func (c *EmailTokenClient) Query() *EmailTokenQuery {
+	return &EmailTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailToken entity by its id.
+func (c *EmailTokenClient) Get(ctx context.Context, id int) (*EmailToken, error) {
+	return c.Query().Where(emailtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailTokenClient) GetX(ctx context.Context, id int) *EmailToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a EmailToken.
+func (c *EmailTokenClient) QueryUser(et *EmailToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := et.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailtoken.Table, emailtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, emailtoken.UserTable, emailtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(et.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmailTokenClient) Hooks() []Hook {
+	return c.hooks.EmailToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailTokenClient) Interceptors() []Interceptor {
+	return c.inters.EmailToken
+}
+
+func (c *EmailTokenClient) mutate(ctx context.Context, m *EmailTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailToken mutation op: %q", m.Op())
+	}
+}
+
+// FavouriteClient is a client for the Favourite schema.
+type FavouriteClient struct {
+	config
+}
+
+// NewFavouriteClient returns a client for the Favourite from the given config.
+func NewFavouriteClient(c config) *FavouriteClient {
+	return &FavouriteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `favourite.Hooks(f(g(h())))`.
+func (c *FavouriteClient) Use(hooks ...Hook) {
+	c.hooks.Favourite = append(c.hooks.Favourite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `favourite.Intercept(f(g(h())))`.
+func (c *FavouriteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Favourite = append(c.inters.Favourite, interceptors...)
+}
+
+// Create returns a builder for creating a Favourite entity.
+func (c *FavouriteClient) Create() *FavouriteCreate {
+	mutation := newFavouriteMutation(c.config, OpCreate)
+	return &FavouriteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Favourite entities.
+func (c *FavouriteClient) CreateBulk(builders ...*FavouriteCreate) *FavouriteCreateBulk {
+	return &FavouriteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FavouriteClient) MapCreateBulk(slice any, setFunc func(*FavouriteCreate, int)) *FavouriteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FavouriteCreateBulk{err: fmt.Errorf("calling to FavouriteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FavouriteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FavouriteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Favourite.
+func (c *FavouriteClient) Update() *FavouriteUpdate {
+	mutation := newFavouriteMutation(c.config, OpUpdate)
+	return &FavouriteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FavouriteClient) UpdateOne(f *Favourite) *FavouriteUpdateOne {
+	mutation := newFavouriteMutation(c.config, OpUpdateOne, withFavourite(f))
+	return &FavouriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FavouriteClient) UpdateOneID(id int) *FavouriteUpdateOne {
+	mutation := newFavouriteMutation(c.config, OpUpdateOne, withFavouriteID(id))
+	return &FavouriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Favourite.
+func (c *FavouriteClient) Delete() *FavouriteDelete {
+	mutation := newFavouriteMutation(c.config, OpDelete)
+	return &FavouriteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FavouriteClient) DeleteOne(f *Favourite) *FavouriteDeleteOne {
+	return c.DeleteOneID(f.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FavouriteClient) DeleteOneID(id int) *FavouriteDeleteOne {
+	builder := c.Delete().Where(favourite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FavouriteDeleteOne{builder}
+}
+
+// Query returns a query builder for Favourite.
+func (c *FavouriteClient) Query() *FavouriteQuery {
+	return &FavouriteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFavourite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Favourite entity by its id.
+func (c *FavouriteClient) Get(ctx context.Context, id int) (*Favourite, error) {
+	return c.Query().Where(favourite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FavouriteClient) GetX(ctx context.Context, id int) *Favourite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Favourite.
+func (c *FavouriteClient) QueryUser(f *Favourite) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(favourite.Table, favourite.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, favourite.UserTable, favourite.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoom queries the room edge of a Favourite.
+func (c *FavouriteClient) QueryRoom(f *Favourite) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(favourite.Table, favourite.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, favourite.RoomTable, favourite.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FavouriteClient) Hooks() []Hook {
+	return c.hooks.Favourite
+}
+
+// Interceptors returns the client interceptors.
+func (c *FavouriteClient) Interceptors() []Interceptor {
+	return c.inters.Favourite
+}
+
+func (c *FavouriteClient) mutate(ctx context.Context, m *FavouriteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FavouriteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FavouriteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FavouriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FavouriteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Favourite mutation op: %q", m.Op())
+	}
+}
+
+// IdentityClient is a client for the Identity schema.
+type IdentityClient struct {
+	config
+}
+
+// NewIdentityClient returns a client for the Identity from the given config.
+func NewIdentityClient(c config) *IdentityClient {
+	return &IdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `identity.Hooks(f(g(h())))`.
+func (c *IdentityClient) Use(hooks ...Hook) {
+	c.hooks.Identity = append(c.hooks.Identity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `identity.Intercept(f(g(h())))`.
+func (c *IdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Identity = append(c.inters.Identity, interceptors...)
+}
+
+// Create returns a builder for creating a Identity entity.
+func (c *IdentityClient) Create() *IdentityCreate {
+	mutation := newIdentityMutation(c.config, OpCreate)
+	return &IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Identity entities.
+func (c *IdentityClient) CreateBulk(builders ...*IdentityCreate) *IdentityCreateBulk {
+	return &IdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdentityClient) MapCreateBulk(slice any, setFunc func(*IdentityCreate, int)) *IdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdentityCreateBulk{err: fmt.Errorf("calling to IdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Identity.
+func (c *IdentityClient) Update() *IdentityUpdate {
+	mutation := newIdentityMutation(c.config, OpUpdate)
+	return &IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdentityClient) UpdateOne(i *Identity) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentity(i))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdentityClient) UpdateOneID(id int) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentityID(id))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Identity.
+func (c *IdentityClient) Delete() *IdentityDelete {
+	mutation := newIdentityMutation(c.config, OpDelete)
+	return &IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdentityClient) DeleteOne(i *Identity) *IdentityDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdentityClient) DeleteOneID(id int) *IdentityDeleteOne {
+	builder := c.Delete().Where(identity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for Identity.
+func (c *IdentityClient) Query() *IdentityQuery {
+	return &IdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Identity entity by its id.
+func (c *IdentityClient) Get(ctx context.Context, id int) (*Identity, error) {
+	return c.Query().Where(identity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdentityClient) GetX(ctx context.Context, id int) *Identity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Identity.
+func (c *IdentityClient) QueryUser(i *Identity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identity.Table, identity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, identity.UserTable, identity.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IdentityClient) Hooks() []Hook {
+	return c.hooks.Identity
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdentityClient) Interceptors() []Interceptor {
+	return c.inters.Identity
+}
+
+func (c *IdentityClient) mutate(ctx context.Context, m *IdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Identity mutation op: %q", m.Op())
+	}
+}
+
+// IdentityKeyClient is a client for the IdentityKey schema.
+type IdentityKeyClient struct {
+	config
+}
+
+// NewIdentityKeyClient returns a client for the IdentityKey from the given config.
+func NewIdentityKeyClient(c config) *IdentityKeyClient {
+	return &IdentityKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `identitykey.Hooks(f(g(h())))`.
+func (c *IdentityKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdentityKey = append(c.hooks.IdentityKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `identitykey.Intercept(f(g(h())))`.
+func (c *IdentityKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdentityKey = append(c.inters.IdentityKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdentityKey entity.
+func (c *IdentityKeyClient) Create() *IdentityKeyCreate {
+	mutation := newIdentityKeyMutation(c.config, OpCreate)
+	return &IdentityKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdentityKey entities.
+func (c *IdentityKeyClient) CreateBulk(builders ...*IdentityKeyCreate) *IdentityKeyCreateBulk {
+	return &IdentityKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdentityKeyClient) MapCreateBulk(slice any, setFunc func(*IdentityKeyCreate, int)) *IdentityKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdentityKeyCreateBulk{err: fmt.Errorf("calling to IdentityKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdentityKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdentityKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdentityKey.
+func (c *IdentityKeyClient) Update() *IdentityKeyUpdate {
+	mutation := newIdentityKeyMutation(c.config, OpUpdate)
+	return &IdentityKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdentityKeyClient) UpdateOne(ik *IdentityKey) *IdentityKeyUpdateOne {
+	mutation := newIdentityKeyMutation(c.config, OpUpdateOne, withIdentityKey(ik))
+	return &IdentityKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdentityKeyClient) UpdateOneID(id int) *IdentityKeyUpdateOne {
+	mutation := newIdentityKeyMutation(c.config, OpUpdateOne, withIdentityKeyID(id))
+	return &IdentityKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdentityKey.
+func (c *IdentityKeyClient) Delete() *IdentityKeyDelete {
+	mutation := newIdentityKeyMutation(c.config, OpDelete)
+	return &IdentityKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdentityKeyClient) DeleteOne(ik *IdentityKey) *IdentityKeyDeleteOne {
+	return c.DeleteOneID(ik.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdentityKeyClient) DeleteOneID(id int) *IdentityKeyDeleteOne {
+	builder := c.Delete().Where(identitykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdentityKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdentityKey.
+func (c *IdentityKeyClient) Query() *IdentityKeyQuery {
+	return &IdentityKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdentityKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdentityKey entity by its id.
+func (c *IdentityKeyClient) Get(ctx context.Context, id int) (*IdentityKey, error) {
+	return c.Query().Where(identitykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdentityKeyClient) GetX(ctx context.Context, id int) *IdentityKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDevice queries the device edge of a IdentityKey.
+func (c *IdentityKeyClient) QueryDevice(ik *IdentityKey) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ik.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identitykey.Table, identitykey.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, identitykey.DeviceTable, identitykey.DeviceColumn),
+		)
+		fromV = sqlgraph.Neighbors(ik.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IdentityKeyClient) Hooks() []Hook {
+	return c.hooks.IdentityKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdentityKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdentityKey
+}
+
+func (c *IdentityKeyClient) mutate(ctx context.Context, m *IdentityKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdentityKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdentityKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdentityKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdentityKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdentityKey mutation op: %q", m.Op())
+	}
+}
+
+// IdentityKeyRecordClient is a client for the IdentityKeyRecord schema.
+type IdentityKeyRecordClient struct {
+	config
+}
+
+// NewIdentityKeyRecordClient returns a client for the IdentityKeyRecord from the given config.
+func NewIdentityKeyRecordClient(c config) *IdentityKeyRecordClient {
+	return &IdentityKeyRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `identitykeyrecord.Hooks(f(g(h())))`.
+func (c *IdentityKeyRecordClient) Use(hooks ...Hook) {
+	c.hooks.IdentityKeyRecord = append(c.hooks.IdentityKeyRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `identitykeyrecord.Intercept(f(g(h())))`.
+func (c *IdentityKeyRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdentityKeyRecord = append(c.inters.IdentityKeyRecord, interceptors...)
+}
+
+// Create returns a builder for creating a IdentityKeyRecord entity.
+func (c *IdentityKeyRecordClient) Create() *IdentityKeyRecordCreate {
+	mutation := newIdentityKeyRecordMutation(c.config, OpCreate)
+	return &IdentityKeyRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdentityKeyRecord entities.
+func (c *IdentityKeyRecordClient) CreateBulk(builders ...*IdentityKeyRecordCreate) *IdentityKeyRecordCreateBulk {
+	return &IdentityKeyRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdentityKeyRecordClient) MapCreateBulk(slice any, setFunc func(*IdentityKeyRecordCreate, int)) *IdentityKeyRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdentityKeyRecordCreateBulk{err: fmt.Errorf("calling to IdentityKeyRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdentityKeyRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdentityKeyRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdentityKeyRecord.
+func (c *IdentityKeyRecordClient) Update() *IdentityKeyRecordUpdate {
+	mutation := newIdentityKeyRecordMutation(c.config, OpUpdate)
+	return &IdentityKeyRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdentityKeyRecordClient) UpdateOne(ikr *IdentityKeyRecord) *IdentityKeyRecordUpdateOne {
+	mutation := newIdentityKeyRecordMutation(c.config, OpUpdateOne, withIdentityKeyRecord(ikr))
+	return &IdentityKeyRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdentityKeyRecordClient) UpdateOneID(id int) *IdentityKeyRecordUpdateOne {
+	mutation := newIdentityKeyRecordMutation(c.config, OpUpdateOne, withIdentityKeyRecordID(id))
+	return &IdentityKeyRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdentityKeyRecord.
+func (c *IdentityKeyRecordClient) Delete() *IdentityKeyRecordDelete {
+	mutation := newIdentityKeyRecordMutation(c.config, OpDelete)
+	return &IdentityKeyRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdentityKeyRecordClient) DeleteOne(ikr *IdentityKeyRecord) *IdentityKeyRecordDeleteOne {
+	return c.DeleteOneID(ikr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdentityKeyRecordClient) DeleteOneID(id int) *IdentityKeyRecordDeleteOne {
+	builder := c.Delete().Where(identitykeyrecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdentityKeyRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for IdentityKeyRecord.
+func (c *IdentityKeyRecordClient) Query() *IdentityKeyRecordQuery {
+	return &IdentityKeyRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdentityKeyRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdentityKeyRecord entity by its id.
+func (c *IdentityKeyRecordClient) Get(ctx context.Context, id int) (*IdentityKeyRecord, error) {
+	return c.Query().Where(identitykeyrecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdentityKeyRecordClient) GetX(ctx context.Context, id int) *IdentityKeyRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a IdentityKeyRecord.
+func (c *IdentityKeyRecordClient) QueryUser(ikr *IdentityKeyRecord) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ikr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identitykeyrecord.Table, identitykeyrecord.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, identitykeyrecord.UserTable, identitykeyrecord.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(ikr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevice queries the device edge of a IdentityKeyRecord.
+func (c *IdentityKeyRecordClient) QueryDevice(ikr *IdentityKeyRecord) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ikr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identitykeyrecord.Table, identitykeyrecord.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, identitykeyrecord.DeviceTable, identitykeyrecord.DeviceColumn),
+		)
+		fromV = sqlgraph.Neighbors(ikr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IdentityKeyRecordClient) Hooks() []Hook {
+	return c.hooks.IdentityKeyRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdentityKeyRecordClient) Interceptors() []Interceptor {
+	return c.inters.IdentityKeyRecord
+}
+
+func (c *IdentityKeyRecordClient) mutate(ctx context.Context, m *IdentityKeyRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdentityKeyRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdentityKeyRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdentityKeyRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdentityKeyRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdentityKeyRecord mutation op: %q", m.Op())
+	}
+}
+
+// KeyBackupKeyClient is a client for the KeyBackupKey schema.
+type KeyBackupKeyClient struct {
+	config
+}
+
+// NewKeyBackupKeyClient returns a client for the KeyBackupKey from the given config.
+func NewKeyBackupKeyClient(c config) *KeyBackupKeyClient {
+	return &KeyBackupKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keybackupkey.Hooks(f(g(h())))`.
+func (c *KeyBackupKeyClient) Use(hooks ...Hook) {
+	c.hooks.KeyBackupKey = append(c.hooks.KeyBackupKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keybackupkey.Intercept(f(g(h())))`.
+func (c *KeyBackupKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KeyBackupKey = append(c.inters.KeyBackupKey, interceptors...)
+}
+
+// Create returns a builder for creating a KeyBackupKey entity.
+func (c *KeyBackupKeyClient) Create() *KeyBackupKeyCreate {
+	mutation := newKeyBackupKeyMutation(c.config, OpCreate)
+	return &KeyBackupKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KeyBackupKey entities.
+func (c *KeyBackupKeyClient) CreateBulk(builders ...*KeyBackupKeyCreate) *KeyBackupKeyCreateBulk {
+	return &KeyBackupKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeyBackupKeyClient) MapCreateBulk(slice any, setFunc func(*KeyBackupKeyCreate, int)) *KeyBackupKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeyBackupKeyCreateBulk{err: fmt.Errorf("calling to KeyBackupKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeyBackupKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeyBackupKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KeyBackupKey.
+func (c *KeyBackupKeyClient) Update() *KeyBackupKeyUpdate {
+	mutation := newKeyBackupKeyMutation(c.config, OpUpdate)
+	return &KeyBackupKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeyBackupKeyClient) UpdateOne(kbk *KeyBackupKey) *KeyBackupKeyUpdateOne {
+	mutation := newKeyBackupKeyMutation(c.config, OpUpdateOne, withKeyBackupKey(kbk))
+	return &KeyBackupKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeyBackupKeyClient) UpdateOneID(id int) *KeyBackupKeyUpdateOne {
+	mutation := newKeyBackupKeyMutation(c.config, OpUpdateOne, withKeyBackupKeyID(id))
+	return &KeyBackupKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KeyBackupKey.
+func (c *KeyBackupKeyClient) Delete() *KeyBackupKeyDelete {
+	mutation := newKeyBackupKeyMutation(c.config, OpDelete)
+	return &KeyBackupKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeyBackupKeyClient) DeleteOne(kbk *KeyBackupKey) *KeyBackupKeyDeleteOne {
+	return c.DeleteOneID(kbk.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeyBackupKeyClient) DeleteOneID(id int) *KeyBackupKeyDeleteOne {
+	builder := c.Delete().Where(keybackupkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeyBackupKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for KeyBackupKey.
+func (c *KeyBackupKeyClient) Query() *KeyBackupKeyQuery {
+	return &KeyBackupKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeyBackupKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KeyBackupKey entity by its id.
+func (c *KeyBackupKeyClient) Get(ctx context.Context, id int) (*KeyBackupKey, error) {
+	return c.Query().Where(keybackupkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeyBackupKeyClient) GetX(ctx context.Context, id int) *KeyBackupKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVersion queries the version edge of a KeyBackupKey.
+func (c *KeyBackupKeyClient) QueryVersion(kbk *KeyBackupKey) *KeyBackupVersionQuery {
+	query := (&KeyBackupVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := kbk.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keybackupkey.Table, keybackupkey.FieldID, id),
+			sqlgraph.To(keybackupversion.Table, keybackupversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, keybackupkey.VersionTable, keybackupkey.VersionColumn),
+		)
+		fromV = sqlgraph.Neighbors(kbk.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoom queries the room edge of a KeyBackupKey.
+func (c *KeyBackupKeyClient) QueryRoom(kbk *KeyBackupKey) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := kbk.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keybackupkey.Table, keybackupkey.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, keybackupkey.RoomTable, keybackupkey.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(kbk.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KeyBackupKeyClient) Hooks() []Hook {
+	hooks := c.hooks.KeyBackupKey
+	return append(hooks[:len(hooks):len(hooks)], keybackupkey.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeyBackupKeyClient) Interceptors() []Interceptor {
+	return c.inters.KeyBackupKey
+}
+
+func (c *KeyBackupKeyClient) mutate(ctx context.Context, m *KeyBackupKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeyBackupKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeyBackupKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeyBackupKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeyBackupKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KeyBackupKey mutation op: %q", m.Op())
+	}
+}
+
+// KeyBackupVersionClient is a client for the KeyBackupVersion schema.
+type KeyBackupVersionClient struct {
+	config
+}
+
+// NewKeyBackupVersionClient returns a client for the KeyBackupVersion from the given config.
+func NewKeyBackupVersionClient(c config) *KeyBackupVersionClient {
+	return &KeyBackupVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keybackupversion.Hooks(f(g(h())))`.
+func (c *KeyBackupVersionClient) Use(hooks ...Hook) {
+	c.hooks.KeyBackupVersion = append(c.hooks.KeyBackupVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keybackupversion.Intercept(f(g(h())))`.
+func (c *KeyBackupVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KeyBackupVersion = append(c.inters.KeyBackupVersion, interceptors...)
+}
+
+// Create returns a builder for creating a KeyBackupVersion entity.
+func (c *KeyBackupVersionClient) Create() *KeyBackupVersionCreate {
+	mutation := newKeyBackupVersionMutation(c.config, OpCreate)
+	return &KeyBackupVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KeyBackupVersion entities.
+func (c *KeyBackupVersionClient) CreateBulk(builders ...*KeyBackupVersionCreate) *KeyBackupVersionCreateBulk {
+	return &KeyBackupVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeyBackupVersionClient) MapCreateBulk(slice any, setFunc func(*KeyBackupVersionCreate, int)) *KeyBackupVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeyBackupVersionCreateBulk{err: fmt.Errorf("calling to KeyBackupVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeyBackupVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeyBackupVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KeyBackupVersion.
+func (c *KeyBackupVersionClient) Update() *KeyBackupVersionUpdate {
+	mutation := newKeyBackupVersionMutation(c.config, OpUpdate)
+	return &KeyBackupVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeyBackupVersionClient) UpdateOne(kbv *KeyBackupVersion) *KeyBackupVersionUpdateOne {
+	mutation := newKeyBackupVersionMutation(c.config, OpUpdateOne, withKeyBackupVersion(kbv))
+	return &KeyBackupVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeyBackupVersionClient) UpdateOneID(id int) *KeyBackupVersionUpdateOne {
+	mutation := newKeyBackupVersionMutation(c.config, OpUpdateOne, withKeyBackupVersionID(id))
+	return &KeyBackupVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KeyBackupVersion.
+func (c *KeyBackupVersionClient) Delete() *KeyBackupVersionDelete {
+	mutation := newKeyBackupVersionMutation(c.config, OpDelete)
+	return &KeyBackupVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeyBackupVersionClient) DeleteOne(kbv *KeyBackupVersion) *KeyBackupVersionDeleteOne {
+	return c.DeleteOneID(kbv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeyBackupVersionClient) DeleteOneID(id int) *KeyBackupVersionDeleteOne {
+	builder := c.Delete().Where(keybackupversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeyBackupVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for KeyBackupVersion.
+func (c *KeyBackupVersionClient) Query() *KeyBackupVersionQuery {
+	return &KeyBackupVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeyBackupVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KeyBackupVersion entity by its id.
+func (c *KeyBackupVersionClient) Get(ctx context.Context, id int) (*KeyBackupVersion, error) {
+	return c.Query().Where(keybackupversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeyBackupVersionClient) GetX(ctx context.Context, id int) *KeyBackupVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a KeyBackupVersion.
+func (c *KeyBackupVersionClient) QueryOwner(kbv *KeyBackupVersion) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := kbv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keybackupversion.Table, keybackupversion.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, keybackupversion.OwnerTable, keybackupversion.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(kbv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryKeys queries the keys edge of a KeyBackupVersion.
+func (c *KeyBackupVersionClient) QueryKeys(kbv *KeyBackupVersion) *KeyBackupKeyQuery {
+	query := (&KeyBackupKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := kbv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keybackupversion.Table, keybackupversion.FieldID, id),
+			sqlgraph.To(keybackupkey.Table, keybackupkey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, keybackupversion.KeysTable, keybackupversion.KeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(kbv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KeyBackupVersionClient) Hooks() []Hook {
+	hooks := c.hooks.KeyBackupVersion
+	return append(hooks[:len(hooks):len(hooks)], keybackupversion.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeyBackupVersionClient) Interceptors() []Interceptor {
+	return c.inters.KeyBackupVersion
+}
+
+func (c *KeyBackupVersionClient) mutate(ctx context.Context, m *KeyBackupVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeyBackupVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeyBackupVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeyBackupVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeyBackupVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KeyBackupVersion mutation op: %q", m.Op())
+	}
+}
+
+// KeyLogEntryClient is a client for the KeyLogEntry schema.
+type KeyLogEntryClient struct {
+	config
+}
+
+// NewKeyLogEntryClient returns a client for the KeyLogEntry from the given config.
+func NewKeyLogEntryClient(c config) *KeyLogEntryClient {
+	return &KeyLogEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keylogentry.Hooks(f(g(h())))`.
+func (c *KeyLogEntryClient) Use(hooks ...Hook) {
+	c.hooks.KeyLogEntry = append(c.hooks.KeyLogEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keylogentry.Intercept(f(g(h())))`.
+func (c *KeyLogEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KeyLogEntry = append(c.inters.KeyLogEntry, interceptors...)
+}
+
+// Create returns a builder for creating a KeyLogEntry entity.
+func (c *KeyLogEntryClient) Create() *KeyLogEntryCreate {
+	mutation := newKeyLogEntryMutation(c.config, OpCreate)
+	return &KeyLogEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KeyLogEntry entities.
+func (c *KeyLogEntryClient) CreateBulk(builders ...*KeyLogEntryCreate) *KeyLogEntryCreateBulk {
+	return &KeyLogEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeyLogEntryClient) MapCreateBulk(slice any, setFunc func(*KeyLogEntryCreate, int)) *KeyLogEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeyLogEntryCreateBulk{err: fmt.Errorf("calling to KeyLogEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeyLogEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeyLogEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KeyLogEntry.
+func (c *KeyLogEntryClient) Update() *KeyLogEntryUpdate {
+	mutation := newKeyLogEntryMutation(c.config, OpUpdate)
+	return &KeyLogEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeyLogEntryClient) UpdateOne(kle *KeyLogEntry) *KeyLogEntryUpdateOne {
+	mutation := newKeyLogEntryMutation(c.config, OpUpdateOne, withKeyLogEntry(kle))
+	return &KeyLogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeyLogEntryClient) UpdateOneID(id int) *KeyLogEntryUpdateOne {
+	mutation := newKeyLogEntryMutation(c.config, OpUpdateOne, withKeyLogEntryID(id))
+	return &KeyLogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KeyLogEntry.
+func (c *KeyLogEntryClient) Delete() *KeyLogEntryDelete {
+	mutation := newKeyLogEntryMutation(c.config, OpDelete)
+	return &KeyLogEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeyLogEntryClient) DeleteOne(kle *KeyLogEntry) *KeyLogEntryDeleteOne {
+	return c.DeleteOneID(kle.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeyLogEntryClient) DeleteOneID(id int) *KeyLogEntryDeleteOne {
+	builder := c.Delete().Where(keylogentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeyLogEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for KeyLogEntry.
+func (c *KeyLogEntryClient) Query() *KeyLogEntryQuery {
+	return &KeyLogEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeyLogEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KeyLogEntry entity by its id.
+func (c *KeyLogEntryClient) Get(ctx context.Context, id int) (*KeyLogEntry, error) {
+	return c.Query().Where(keylogentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeyLogEntryClient) GetX(ctx context.Context, id int) *KeyLogEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KeyLogEntryClient) Hooks() []Hook {
+	return c.hooks.KeyLogEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeyLogEntryClient) Interceptors() []Interceptor {
+	return c.inters.KeyLogEntry
+}
+
+func (c *KeyLogEntryClient) mutate(ctx context.Context, m *KeyLogEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeyLogEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeyLogEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeyLogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeyLogEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KeyLogEntry mutation op: %q", m.Op())
+	}
+}
+
+// KeyLogHeadClient is a client for the KeyLogHead schema.
+type KeyLogHeadClient struct {
+	config
+}
+
+// NewKeyLogHeadClient returns a client for the KeyLogHead from the given config.
+func NewKeyLogHeadClient(c config) *KeyLogHeadClient {
+	return &KeyLogHeadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keyloghead.Hooks(f(g(h())))`.
+func (c *KeyLogHeadClient) Use(hooks ...Hook) {
+	c.hooks.KeyLogHead = append(c.hooks.KeyLogHead, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keyloghead.Intercept(f(g(h())))`.
+func (c *KeyLogHeadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KeyLogHead = append(c.inters.KeyLogHead, interceptors...)
+}
+
+// Create returns a builder for creating a KeyLogHead entity.
+func (c *KeyLogHeadClient) Create() *KeyLogHeadCreate {
+	mutation := newKeyLogHeadMutation(c.config, OpCreate)
+	return &KeyLogHeadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KeyLogHead entities.
+func (c *KeyLogHeadClient) CreateBulk(builders ...*KeyLogHeadCreate) *KeyLogHeadCreateBulk {
+	return &KeyLogHeadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeyLogHeadClient) MapCreateBulk(slice any, setFunc func(*KeyLogHeadCreate, int)) *KeyLogHeadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeyLogHeadCreateBulk{err: fmt.Errorf("calling to KeyLogHeadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeyLogHeadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeyLogHeadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KeyLogHead.
+func (c *KeyLogHeadClient) Update() *KeyLogHeadUpdate {
+	mutation := newKeyLogHeadMutation(c.config, OpUpdate)
+	return &KeyLogHeadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeyLogHeadClient) UpdateOne(klh *KeyLogHead) *KeyLogHeadUpdateOne {
+	mutation := newKeyLogHeadMutation(c.config, OpUpdateOne, withKeyLogHead(klh))
+	return &KeyLogHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeyLogHeadClient) UpdateOneID(id int) *KeyLogHeadUpdateOne {
+	mutation := newKeyLogHeadMutation(c.config, OpUpdateOne, withKeyLogHeadID(id))
+	return &KeyLogHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KeyLogHead.
+func (c *KeyLogHeadClient) Delete() *KeyLogHeadDelete {
+	mutation := newKeyLogHeadMutation(c.config, OpDelete)
+	return &KeyLogHeadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeyLogHeadClient) DeleteOne(klh *KeyLogHead) *KeyLogHeadDeleteOne {
+	return c.DeleteOneID(klh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeyLogHeadClient) DeleteOneID(id int) *KeyLogHeadDeleteOne {
+	builder := c.Delete().Where(keyloghead.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeyLogHeadDeleteOne{builder}
+}
+
+// Query returns a query builder for KeyLogHead.
+func (c *KeyLogHeadClient) Query() *KeyLogHeadQuery {
+	return &KeyLogHeadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeyLogHead},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KeyLogHead entity by its id.
+func (c *KeyLogHeadClient) Get(ctx context.Context, id int) (*KeyLogHead, error) {
+	return c.Query().Where(keyloghead.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeyLogHeadClient) GetX(ctx context.Context, id int) *KeyLogHead {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KeyLogHeadClient) Hooks() []Hook {
+	return c.hooks.KeyLogHead
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeyLogHeadClient) Interceptors() []Interceptor {
+	return c.inters.KeyLogHead
+}
+
+func (c *KeyLogHeadClient) mutate(ctx context.Context, m *KeyLogHeadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeyLogHeadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeyLogHeadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeyLogHeadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeyLogHeadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KeyLogHead mutation op: %q", m.Op())
+	}
+}
+
+// KeyLogNodeClient is a client for the KeyLogNode schema.
+type KeyLogNodeClient struct {
+	config
+}
+
+// NewKeyLogNodeClient returns a client for the KeyLogNode from the given config.
+func NewKeyLogNodeClient(c config) *KeyLogNodeClient {
+	return &KeyLogNodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keylognode.Hooks(f(g(h())))`.
+func (c *KeyLogNodeClient) Use(hooks ...Hook) {
+	c.hooks.KeyLogNode = append(c.hooks.KeyLogNode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keylognode.Intercept(f(g(h())))`.
+func (c *KeyLogNodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KeyLogNode = append(c.inters.KeyLogNode, interceptors...)
+}
+
+// Create returns a builder for creating a KeyLogNode entity.
+func (c *KeyLogNodeClient) Create() *KeyLogNodeCreate {
+	mutation := newKeyLogNodeMutation(c.config, OpCreate)
+	return &KeyLogNodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KeyLogNode entities.
+func (c *KeyLogNodeClient) CreateBulk(builders ...*KeyLogNodeCreate) *KeyLogNodeCreateBulk {
+	return &KeyLogNodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeyLogNodeClient) MapCreateBulk(slice any, setFunc func(*KeyLogNodeCreate, int)) *KeyLogNodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeyLogNodeCreateBulk{err: fmt.Errorf("calling to KeyLogNodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeyLogNodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeyLogNodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KeyLogNode.
+func (c *KeyLogNodeClient) Update() *KeyLogNodeUpdate {
+	mutation := newKeyLogNodeMutation(c.config, OpUpdate)
+	return &KeyLogNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeyLogNodeClient) UpdateOne(kln *KeyLogNode) *KeyLogNodeUpdateOne {
+	mutation := newKeyLogNodeMutation(c.config, OpUpdateOne, withKeyLogNode(kln))
+	return &KeyLogNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeyLogNodeClient) UpdateOneID(id int) *KeyLogNodeUpdateOne {
+	mutation := newKeyLogNodeMutation(c.config, OpUpdateOne, withKeyLogNodeID(id))
+	return &KeyLogNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KeyLogNode.
+func (c *KeyLogNodeClient) Delete() *KeyLogNodeDelete {
+	mutation := newKeyLogNodeMutation(c.config, OpDelete)
+	return &KeyLogNodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeyLogNodeClient) DeleteOne(kln *KeyLogNode) *KeyLogNodeDeleteOne {
+	return c.DeleteOneID(kln.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeyLogNodeClient) DeleteOneID(id int) *KeyLogNodeDeleteOne {
+	builder := c.Delete().Where(keylognode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeyLogNodeDeleteOne{builder}
+}
+
+// Query returns a query builder for KeyLogNode.
+func (c *KeyLogNodeClient) Query() *KeyLogNodeQuery {
+	return &KeyLogNodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeyLogNode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KeyLogNode entity by its id.
+func (c *KeyLogNodeClient) Get(ctx context.Context, id int) (*KeyLogNode, error) {
+	return c.Query().Where(keylognode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeyLogNodeClient) GetX(ctx context.Context, id int) *KeyLogNode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KeyLogNodeClient) Hooks() []Hook {
+	return c.hooks.KeyLogNode
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeyLogNodeClient) Interceptors() []Interceptor {
+	return c.inters.KeyLogNode
+}
+
+func (c *KeyLogNodeClient) mutate(ctx context.Context, m *KeyLogNodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeyLogNodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeyLogNodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeyLogNodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeyLogNodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KeyLogNode mutation op: %q", m.Op())
+	}
+}
+
+// KeyPackageClient is a client for the KeyPackage schema.
+type KeyPackageClient struct {
+	config
+}
+
+// NewKeyPackageClient returns a client for the KeyPackage from the given config.
+func NewKeyPackageClient(c config) *KeyPackageClient {
+	return &KeyPackageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `keypackage.Hooks(f(g(h())))`.
+func (c *KeyPackageClient) Use(hooks ...Hook) {
+	c.hooks.KeyPackage = append(c.hooks.KeyPackage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `keypackage.Intercept(f(g(h())))`.
+func (c *KeyPackageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KeyPackage = append(c.inters.KeyPackage, interceptors...)
+}
+
+// Create returns a builder for creating a KeyPackage entity.
+func (c *KeyPackageClient) Create() *KeyPackageCreate {
+	mutation := newKeyPackageMutation(c.config, OpCreate)
+	return &KeyPackageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KeyPackage entities.
+func (c *KeyPackageClient) CreateBulk(builders ...*KeyPackageCreate) *KeyPackageCreateBulk {
+	return &KeyPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KeyPackageClient) MapCreateBulk(slice any, setFunc func(*KeyPackageCreate, int)) *KeyPackageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KeyPackageCreateBulk{err: fmt.Errorf("calling to KeyPackageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KeyPackageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KeyPackageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KeyPackage.
+func (c *KeyPackageClient) Update() *KeyPackageUpdate {
+	mutation := newKeyPackageMutation(c.config, OpUpdate)
+	return &KeyPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KeyPackageClient) UpdateOne(kp *KeyPackage) *KeyPackageUpdateOne {
+	mutation := newKeyPackageMutation(c.config, OpUpdateOne, withKeyPackage(kp))
+	return &KeyPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KeyPackageClient) UpdateOneID(id int) *KeyPackageUpdateOne {
+	mutation := newKeyPackageMutation(c.config, OpUpdateOne, withKeyPackageID(id))
+	return &KeyPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KeyPackage.
+func (c *KeyPackageClient) Delete() *KeyPackageDelete {
+	mutation := newKeyPackageMutation(c.config, OpDelete)
+	return &KeyPackageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KeyPackageClient) DeleteOne(kp *KeyPackage) *KeyPackageDeleteOne {
+	return c.DeleteOneID(kp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KeyPackageClient) DeleteOneID(id int) *KeyPackageDeleteOne {
+	builder := c.Delete().Where(keypackage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KeyPackageDeleteOne{builder}
+}
+
+// Query returns a query builder for KeyPackage.
+func (c *KeyPackageClient) Query() *KeyPackageQuery {
+	return &KeyPackageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKeyPackage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KeyPackage entity by its id.
+func (c *KeyPackageClient) Get(ctx context.Context, id int) (*KeyPackage, error) {
+	return c.Query().Where(keypackage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KeyPackageClient) GetX(ctx context.Context, id int) *KeyPackage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDevice queries the device edge of a KeyPackage.
+func (c *KeyPackageClient) QueryDevice(kp *KeyPackage) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := kp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keypackage.Table, keypackage.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, keypackage.DeviceTable, keypackage.DeviceColumn),
+		)
+		fromV = sqlgraph.Neighbors(kp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KeyPackageClient) Hooks() []Hook {
+	return c.hooks.KeyPackage
+}
+
+// Interceptors returns the client interceptors.
+func (c *KeyPackageClient) Interceptors() []Interceptor {
+	return c.inters.KeyPackage
+}
+
+func (c *KeyPackageClient) mutate(ctx context.Context, m *KeyPackageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KeyPackageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KeyPackageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KeyPackageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KeyPackageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KeyPackage mutation op: %q", m.Op())
+	}
+}
+
+// LoginThrottleClient is a client for the LoginThrottle schema.
+type LoginThrottleClient struct {
+	config
+}
+
+// NewLoginThrottleClient returns a client for the LoginThrottle from the given config.
+func NewLoginThrottleClient(c config) *LoginThrottleClient {
+	return &LoginThrottleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loginthrottle.Hooks(f(g(h())))`.
+func (c *LoginThrottleClient) Use(hooks ...Hook) {
+	c.hooks.LoginThrottle = append(c.hooks.LoginThrottle, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loginthrottle.Intercept(f(g(h())))`.
+func (c *LoginThrottleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LoginThrottle = append(c.inters.LoginThrottle, interceptors...)
+}
+
+// Create returns a builder for creating a LoginThrottle entity.
+func (c *LoginThrottleClient) Create() *LoginThrottleCreate {
+	mutation := newLoginThrottleMutation(c.config, OpCreate)
+	return &LoginThrottleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LoginThrottle entities.
+func (c *LoginThrottleClient) CreateBulk(builders ...*LoginThrottleCreate) *LoginThrottleCreateBulk {
+	return &LoginThrottleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoginThrottleClient) MapCreateBulk(slice any, setFunc func(*LoginThrottleCreate, int)) *LoginThrottleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoginThrottleCreateBulk{err: fmt.Errorf("calling to LoginThrottleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoginThrottleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoginThrottleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LoginThrottle.
+func (c *LoginThrottleClient) Update() *LoginThrottleUpdate {
+	mutation := newLoginThrottleMutation(c.config, OpUpdate)
+	return &LoginThrottleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoginThrottleClient) UpdateOne(lt *LoginThrottle) *LoginThrottleUpdateOne {
+	mutation := newLoginThrottleMutation(c.config, OpUpdateOne, withLoginThrottle(lt))
+	return &LoginThrottleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoginThrottleClient) UpdateOneID(id int) *LoginThrottleUpdateOne {
+	mutation := newLoginThrottleMutation(c.config, OpUpdateOne, withLoginThrottleID(id))
+	return &LoginThrottleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LoginThrottle.
+func (c *LoginThrottleClient) Delete() *LoginThrottleDelete {
+	mutation := newLoginThrottleMutation(c.config, OpDelete)
+	return &LoginThrottleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoginThrottleClient) DeleteOne(lt *LoginThrottle) *LoginThrottleDeleteOne {
+	return c.DeleteOneID(lt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoginThrottleClient) DeleteOneID(id int) *LoginThrottleDeleteOne {
+	builder := c.Delete().Where(loginthrottle.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoginThrottleDeleteOne{builder}
+}
+
+// Query returns a query builder for LoginThrottle.
+func (c *LoginThrottleClient) Query() *LoginThrottleQuery {
+	return &LoginThrottleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoginThrottle},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LoginThrottle entity by its id.
+func (c *LoginThrottleClient) Get(ctx context.Context, id int) (*LoginThrottle, error) {
+	return c.Query().Where(loginthrottle.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoginThrottleClient) GetX(ctx context.Context, id int) *LoginThrottle {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a LoginThrottle.
+func (c *LoginThrottleClient) QueryUser(lt *LoginThrottle) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loginthrottle.Table, loginthrottle.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, loginthrottle.UserTable, loginthrottle.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(lt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LoginThrottleClient) Hooks() []Hook {
+	return c.hooks.LoginThrottle
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoginThrottleClient) Interceptors() []Interceptor {
+	return c.inters.LoginThrottle
+}
+
+func (c *LoginThrottleClient) mutate(ctx context.Context, m *LoginThrottleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoginThrottleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoginThrottleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoginThrottleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoginThrottleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LoginThrottle mutation op: %q", m.Op())
+	}
+}
+
+// MediaClient is a client for the Media schema.
+type MediaClient struct {
+	config
+}
+
+// NewMediaClient returns a client for the Media from the given config.
+func NewMediaClient(c config) *MediaClient {
+	return &MediaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `media.Hooks(f(g(h())))`.
+func (c *MediaClient) Use(hooks ...Hook) {
+	c.hooks.Media = append(c.hooks.Media, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `media.Intercept(f(g(h())))`.
+func (c *MediaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Media = append(c.inters.Media, interceptors...)
+}
+
+// Create returns a builder for creating a Media entity.
+func (c *MediaClient) Create() *MediaCreate {
+	mutation := newMediaMutation(c.config, OpCreate)
+	return &MediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Media entities.
+func (c *MediaClient) CreateBulk(builders ...*MediaCreate) *MediaCreateBulk {
+	return &MediaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MediaClient) MapCreateBulk(slice any, setFunc func(*MediaCreate, int)) *MediaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MediaCreateBulk{err: fmt.Errorf("calling to MediaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MediaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MediaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Media.
+func (c *MediaClient) Update() *MediaUpdate {
+	mutation := newMediaMutation(c.config, OpUpdate)
+	return &MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MediaClient) UpdateOne(m *Media) *MediaUpdateOne {
+	mutation := newMediaMutation(c.config, OpUpdateOne, withMedia(m))
+	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MediaClient) UpdateOneID(id int) *MediaUpdateOne {
+	mutation := newMediaMutation(c.config, OpUpdateOne, withMediaID(id))
+	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Media.
+func (c *MediaClient) Delete() *MediaDelete {
+	mutation := newMediaMutation(c.config, OpDelete)
+	return &MediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MediaClient) DeleteOne(m *Media) *MediaDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MediaClient) DeleteOneID(id int) *MediaDeleteOne {
+	builder := c.Delete().Where(media.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MediaDeleteOne{builder}
+}
+
+// Query returns a query builder for Media.
+func (c *MediaClient) Query() *MediaQuery {
+	return &MediaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMedia},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Media entity by its id.
+func (c *MediaClient) Get(ctx context.Context, id int) (*Media, error) {
+	return c.Query().Where(media.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MediaClient) GetX(ctx context.Context, id int) *Media {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUploader queries the uploader edge of a Media.
+func (c *MediaClient) QueryUploader(m *Media) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, media.UploaderTable, media.UploaderColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessage queries the message edge of a Media.
+func (c *MediaClient) QueryMessage(m *Media) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, media.MessageTable, media.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MediaClient) Hooks() []Hook {
+	hooks := c.hooks.Media
+	return append(hooks[:len(hooks):len(hooks)], media.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MediaClient) Interceptors() []Interceptor {
+	return c.inters.Media
+}
+
+func (c *MediaClient) mutate(ctx context.Context, m *MediaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MediaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MediaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Media mutation op: %q", m.Op())
+	}
+}
+
+// MessageClient is a client for the Message schema.
+type MessageClient struct {
+	config
+}
+
+// NewMessageClient returns a client for the Message from the given config.
+func NewMessageClient(c config) *MessageClient {
+	return &MessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
+func (c *MessageClient) Use(hooks ...Hook) {
+	c.hooks.Message = append(c.hooks.Message, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
+func (c *MessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Message = append(c.inters.Message, interceptors...)
+}
+
+// Create returns a builder for creating a Message entity.
+func (c *MessageClient) Create() *MessageCreate {
+	mutation := newMessageMutation(c.config, OpCreate)
+	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Message entities.
+func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Message.
+func (c *MessageClient) Update() *MessageUpdate {
+	mutation := newMessageMutation(c.config, OpUpdate)
+	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageClient) UpdateOne(m *Message) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(m))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageClient) UpdateOneID(id int) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Message.
+func (c *MessageClient) Delete() *MessageDelete {
+	mutation := newMessageMutation(c.config, OpDelete)
+	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageClient) DeleteOne(m *Message) *MessageDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageClient) DeleteOneID(id int) *MessageDeleteOne {
+	builder := c.Delete().Where(message.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageDeleteOne{builder}
+}
+
+// Query returns a query builder for Message.
+func (c *MessageClient) Query() *MessageQuery {
+	return &MessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Message entity by its id.
+func (c *MessageClient) Get(ctx context.Context, id int) (*Message, error) {
+	return c.Query().Where(message.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySender queries the sender edge of a Message.
+func (c *MessageClient) QuerySender(m *Message) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.SenderTable, message.SenderColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoom queries the room edge of a Message.
+func (c *MessageClient) QueryRoom(m *Message) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.RoomTable, message.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMedia queries the media edge of a Message.
+func (c *MessageClient) QueryMedia(m *Message) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.MediaTable, message.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnvelopes queries the envelopes edge of a Message.
+func (c *MessageClient) QueryEnvelopes(m *Message) *MessageEnvelopeQuery {
+	query := (&MessageEnvelopeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messageenvelope.Table, messageenvelope.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.EnvelopesTable, message.EnvelopesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageClient) Hooks() []Hook {
+	hooks := c.hooks.Message
+	return append(hooks[:len(hooks):len(hooks)], message.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageClient) Interceptors() []Interceptor {
+	return c.inters.Message
+}
+
+func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+	}
+}
+
+// MessageEnvelopeClient is a client for the MessageEnvelope schema.
+type MessageEnvelopeClient struct {
+	config
+}
+
+// NewMessageEnvelopeClient returns a client for the MessageEnvelope from the given config.
+func NewMessageEnvelopeClient(c config) *MessageEnvelopeClient {
+	return &MessageEnvelopeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messageenvelope.Hooks(f(g(h())))`.
+func (c *MessageEnvelopeClient) Use(hooks ...Hook) {
+	c.hooks.MessageEnvelope = append(c.hooks.MessageEnvelope, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messageenvelope.Intercept(f(g(h())))`.
+func (c *MessageEnvelopeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageEnvelope = append(c.inters.MessageEnvelope, interceptors...)
+}
+
+// Create returns a builder for creating a MessageEnvelope entity.
+func (c *MessageEnvelopeClient) Create() *MessageEnvelopeCreate {
+	mutation := newMessageEnvelopeMutation(c.config, OpCreate)
+	return &MessageEnvelopeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageEnvelope entities.
+func (c *MessageEnvelopeClient) CreateBulk(builders ...*MessageEnvelopeCreate) *MessageEnvelopeCreateBulk {
+	return &MessageEnvelopeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageEnvelopeClient) MapCreateBulk(slice any, setFunc func(*MessageEnvelopeCreate, int)) *MessageEnvelopeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageEnvelopeCreateBulk{err: fmt.Errorf("calling to MessageEnvelopeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageEnvelopeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageEnvelopeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageEnvelope.
+func (c *MessageEnvelopeClient) Update() *MessageEnvelopeUpdate {
+	mutation := newMessageEnvelopeMutation(c.config, OpUpdate)
+	return &MessageEnvelopeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageEnvelopeClient) UpdateOne(me *MessageEnvelope) *MessageEnvelopeUpdateOne {
+	mutation := newMessageEnvelopeMutation(c.config, OpUpdateOne, withMessageEnvelope(me))
+	return &MessageEnvelopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageEnvelopeClient) UpdateOneID(id int) *MessageEnvelopeUpdateOne {
+	mutation := newMessageEnvelopeMutation(c.config, OpUpdateOne, withMessageEnvelopeID(id))
+	return &MessageEnvelopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageEnvelope.
+func (c *MessageEnvelopeClient) Delete() *MessageEnvelopeDelete {
+	mutation := newMessageEnvelopeMutation(c.config, OpDelete)
+	return &MessageEnvelopeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageEnvelopeClient) DeleteOne(me *MessageEnvelope) *MessageEnvelopeDeleteOne {
+	return c.DeleteOneID(me.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageEnvelopeClient) DeleteOneID(id int) *MessageEnvelopeDeleteOne {
+	builder := c.Delete().Where(messageenvelope.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageEnvelopeDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageEnvelope.
+func (c *MessageEnvelopeClient) Query() *MessageEnvelopeQuery {
+	return &MessageEnvelopeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageEnvelope},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageEnvelope entity by its id.
+func (c *MessageEnvelopeClient) Get(ctx context.Context, id int) (*MessageEnvelope, error) {
+	return c.Query().Where(messageenvelope.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageEnvelopeClient) GetX(ctx context.Context, id int) *MessageEnvelope {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a MessageEnvelope.
+func (c *MessageEnvelopeClient) QueryMessage(me *MessageEnvelope) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := me.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messageenvelope.Table, messageenvelope.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messageenvelope.MessageTable, messageenvelope.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(me.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevice queries the device edge of a MessageEnvelope.
+func (c *MessageEnvelopeClient) QueryDevice(me *MessageEnvelope) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := me.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messageenvelope.Table, messageenvelope.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messageenvelope.DeviceTable, messageenvelope.DeviceColumn),
+		)
+		fromV = sqlgraph.Neighbors(me.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageEnvelopeClient) Hooks() []Hook {
+	hooks := c.hooks.MessageEnvelope
+	return append(hooks[:len(hooks):len(hooks)], messageenvelope.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageEnvelopeClient) Interceptors() []Interceptor {
+	return c.inters.MessageEnvelope
+}
+
+func (c *MessageEnvelopeClient) mutate(ctx context.Context, m *MessageEnvelopeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageEnvelopeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageEnvelopeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageEnvelopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageEnvelopeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageEnvelope mutation op: %q", m.Op())
+	}
+}
+
+// MlsCommitClient is a client for the MlsCommit schema.
+type MlsCommitClient struct {
+	config
+}
+
+// NewMlsCommitClient returns a client for the MlsCommit from the given config.
+func NewMlsCommitClient(c config) *MlsCommitClient {
+	return &MlsCommitClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mlscommit.Hooks(f(g(h())))`.
+func (c *MlsCommitClient) Use(hooks ...Hook) {
+	c.hooks.MlsCommit = append(c.hooks.MlsCommit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mlscommit.Intercept(f(g(h())))`.
+func (c *MlsCommitClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MlsCommit = append(c.inters.MlsCommit, interceptors...)
+}
+
+// Create returns a builder for creating a MlsCommit entity.
+func (c *MlsCommitClient) Create() *MlsCommitCreate {
+	mutation := newMlsCommitMutation(c.config, OpCreate)
+	return &MlsCommitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MlsCommit entities.
+func (c *MlsCommitClient) CreateBulk(builders ...*MlsCommitCreate) *MlsCommitCreateBulk {
+	return &MlsCommitCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MlsCommitClient) MapCreateBulk(slice any, setFunc func(*MlsCommitCreate, int)) *MlsCommitCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MlsCommitCreateBulk{err: fmt.Errorf("calling to MlsCommitClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MlsCommitCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MlsCommitCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MlsCommit.
+func (c *MlsCommitClient) Update() *MlsCommitUpdate {
+	mutation := newMlsCommitMutation(c.config, OpUpdate)
+	return &MlsCommitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MlsCommitClient) UpdateOne(mc *MlsCommit) *MlsCommitUpdateOne {
+	mutation := newMlsCommitMutation(c.config, OpUpdateOne, withMlsCommit(mc))
+	return &MlsCommitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MlsCommitClient) UpdateOneID(id int) *MlsCommitUpdateOne {
+	mutation := newMlsCommitMutation(c.config, OpUpdateOne, withMlsCommitID(id))
+	return &MlsCommitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MlsCommit.
+func (c *MlsCommitClient) Delete() *MlsCommitDelete {
+	mutation := newMlsCommitMutation(c.config, OpDelete)
+	return &MlsCommitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MlsCommitClient) DeleteOne(mc *MlsCommit) *MlsCommitDeleteOne {
+	return c.DeleteOneID(mc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MlsCommitClient) DeleteOneID(id int) *MlsCommitDeleteOne {
+	builder := c.Delete().Where(mlscommit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MlsCommitDeleteOne{builder}
+}
+
+// Query returns a query builder for MlsCommit.
+func (c *MlsCommitClient) Query() *MlsCommitQuery {
+	return &MlsCommitQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMlsCommit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MlsCommit entity by its id.
+func (c *MlsCommitClient) Get(ctx context.Context, id int) (*MlsCommit, error) {
+	return c.Query().Where(mlscommit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MlsCommitClient) GetX(ctx context.Context, id int) *MlsCommit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoom queries the room edge of a MlsCommit.
+func (c *MlsCommitClient) QueryRoom(mc *MlsCommit) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mlscommit.Table, mlscommit.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mlscommit.RoomTable, mlscommit.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(mc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySender queries the sender edge of a MlsCommit.
+func (c *MlsCommitClient) QuerySender(mc *MlsCommit) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mlscommit.Table, mlscommit.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mlscommit.SenderTable, mlscommit.SenderColumn),
+		)
+		fromV = sqlgraph.Neighbors(mc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MlsCommitClient) Hooks() []Hook {
+	hooks := c.hooks.MlsCommit
+	return append(hooks[:len(hooks):len(hooks)], mlscommit.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MlsCommitClient) Interceptors() []Interceptor {
+	return c.inters.MlsCommit
+}
+
+func (c *MlsCommitClient) mutate(ctx context.Context, m *MlsCommitMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MlsCommitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MlsCommitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MlsCommitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MlsCommitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MlsCommit mutation op: %q", m.Op())
+	}
+}
+
+// MlsWelcomeClient is a client for the MlsWelcome schema.
+type MlsWelcomeClient struct {
+	config
+}
+
+// NewMlsWelcomeClient returns a client for the MlsWelcome from the given config.
+func NewMlsWelcomeClient(c config) *MlsWelcomeClient {
+	return &MlsWelcomeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mlswelcome.Hooks(f(g(h())))`.
+func (c *MlsWelcomeClient) Use(hooks ...Hook) {
+	c.hooks.MlsWelcome = append(c.hooks.MlsWelcome, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mlswelcome.Intercept(f(g(h())))`.
+func (c *MlsWelcomeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MlsWelcome = append(c.inters.MlsWelcome, interceptors...)
+}
+
+// Create returns a builder for creating a MlsWelcome entity.
+func (c *MlsWelcomeClient) Create() *MlsWelcomeCreate {
+	mutation := newMlsWelcomeMutation(c.config, OpCreate)
+	return &MlsWelcomeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MlsWelcome entities.
+func (c *MlsWelcomeClient) CreateBulk(builders ...*MlsWelcomeCreate) *MlsWelcomeCreateBulk {
+	return &MlsWelcomeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MlsWelcomeClient) MapCreateBulk(slice any, setFunc func(*MlsWelcomeCreate, int)) *MlsWelcomeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MlsWelcomeCreateBulk{err: fmt.Errorf("calling to MlsWelcomeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MlsWelcomeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MlsWelcomeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MlsWelcome.
+func (c *MlsWelcomeClient) Update() *MlsWelcomeUpdate {
+	mutation := newMlsWelcomeMutation(c.config, OpUpdate)
+	return &MlsWelcomeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MlsWelcomeClient) UpdateOne(mw *MlsWelcome) *MlsWelcomeUpdateOne {
+	mutation := newMlsWelcomeMutation(c.config, OpUpdateOne, withMlsWelcome(mw))
+	return &MlsWelcomeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MlsWelcomeClient) UpdateOneID(id int) *MlsWelcomeUpdateOne {
+	mutation := newMlsWelcomeMutation(c.config, OpUpdateOne, withMlsWelcomeID(id))
+	return &MlsWelcomeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MlsWelcome.
+func (c *MlsWelcomeClient) Delete() *MlsWelcomeDelete {
+	mutation := newMlsWelcomeMutation(c.config, OpDelete)
+	return &MlsWelcomeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MlsWelcomeClient) DeleteOne(mw *MlsWelcome) *MlsWelcomeDeleteOne {
+	return c.DeleteOneID(mw.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MlsWelcomeClient) DeleteOneID(id int) *MlsWelcomeDeleteOne {
+	builder := c.Delete().Where(mlswelcome.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MlsWelcomeDeleteOne{builder}
+}
+
+// Query returns a query builder for MlsWelcome.
+func (c *MlsWelcomeClient) Query() *MlsWelcomeQuery {
+	return &MlsWelcomeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMlsWelcome},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MlsWelcome entity by its id.
+func (c *MlsWelcomeClient) Get(ctx context.Context, id int) (*MlsWelcome, error) {
+	return c.Query().Where(mlswelcome.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MlsWelcomeClient) GetX(ctx context.Context, id int) *MlsWelcome {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRoom queries the room edge of a MlsWelcome.
+func (c *MlsWelcomeClient) QueryRoom(mw *MlsWelcome) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mlswelcome.Table, mlswelcome.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mlswelcome.RoomTable, mlswelcome.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(mw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySender queries the sender edge of a MlsWelcome.
+func (c *MlsWelcomeClient) QuerySender(mw *MlsWelcome) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mlswelcome.Table, mlswelcome.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mlswelcome.SenderTable, mlswelcome.SenderColumn),
+		)
+		fromV = sqlgraph.Neighbors(mw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevice queries the device edge of a MlsWelcome.
+func (c *MlsWelcomeClient) QueryDevice(mw *MlsWelcome) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mlswelcome.Table, mlswelcome.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mlswelcome.DeviceTable, mlswelcome.DeviceColumn),
+		)
+		fromV = sqlgraph.Neighbors(mw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MlsWelcomeClient) Hooks() []Hook {
+	hooks := c.hooks.MlsWelcome
+	return append(hooks[:len(hooks):len(hooks)], mlswelcome.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MlsWelcomeClient) Interceptors() []Interceptor {
+	return c.inters.MlsWelcome
+}
+
+func (c *MlsWelcomeClient) mutate(ctx context.Context, m *MlsWelcomeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MlsWelcomeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MlsWelcomeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MlsWelcomeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MlsWelcomeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MlsWelcome mutation op: %q", m.Op())
+	}
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id int) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id int) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id int) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRecipient queries the recipient edge of a Notification.
+func (c *NotificationClient) QueryRecipient(n *Notification) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, notification.RecipientTable, notification.RecipientColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoom queries the room edge of a Notification.
+func (c *NotificationClient) QueryRoom(n *Notification) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, notification.RoomTable, notification.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessage queries the message edge of a Notification.
+func (c *NotificationClient) QueryMessage(n *Notification) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, notification.MessageTable, notification.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	hooks := c.hooks.Notification
+	return append(hooks[:len(hooks):len(hooks)], notification.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
+// OneTimePreKeyClient is a client for the OneTimePreKey schema.
+type OneTimePreKeyClient struct {
+	config
+}
+
+// NewOneTimePreKeyClient returns a client for the OneTimePreKey from the given config.
+func NewOneTimePreKeyClient(c config) *OneTimePreKeyClient {
+	return &OneTimePreKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `onetimeprekey.Hooks(f(g(h())))`.
+func (c *OneTimePreKeyClient) Use(hooks ...Hook) {
+	c.hooks.OneTimePreKey = append(c.hooks.OneTimePreKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `onetimeprekey.Intercept(f(g(h())))`.
+func (c *OneTimePreKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OneTimePreKey = append(c.inters.OneTimePreKey, interceptors...)
+}
+
+// Create returns a builder for creating a OneTimePreKey entity.
+func (c *OneTimePreKeyClient) Create() *OneTimePreKeyCreate {
+	mutation := newOneTimePreKeyMutation(c.config, OpCreate)
+	return &OneTimePreKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OneTimePreKey entities.
+func (c *OneTimePreKeyClient) CreateBulk(builders ...*OneTimePreKeyCreate) *OneTimePreKeyCreateBulk {
+	return &OneTimePreKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OneTimePreKeyClient) MapCreateBulk(slice any, setFunc func(*OneTimePreKeyCreate, int)) *OneTimePreKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OneTimePreKeyCreateBulk{err: fmt.Errorf("calling to OneTimePreKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OneTimePreKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OneTimePreKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OneTimePreKey.
+func (c *OneTimePreKeyClient) Update() *OneTimePreKeyUpdate {
+	mutation := newOneTimePreKeyMutation(c.config, OpUpdate)
+	return &OneTimePreKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OneTimePreKeyClient) UpdateOne(otpk *OneTimePreKey) *OneTimePreKeyUpdateOne {
+	mutation := newOneTimePreKeyMutation(c.config, OpUpdateOne, withOneTimePreKey(otpk))
+	return &OneTimePreKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OneTimePreKeyClient) UpdateOneID(id int) *OneTimePreKeyUpdateOne {
+	mutation := newOneTimePreKeyMutation(c.config, OpUpdateOne, withOneTimePreKeyID(id))
+	return &OneTimePreKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OneTimePreKey.
+func (c *OneTimePreKeyClient) Delete() *OneTimePreKeyDelete {
+	mutation := newOneTimePreKeyMutation(c.config, OpDelete)
+	return &OneTimePreKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OneTimePreKeyClient) DeleteOne(otpk *OneTimePreKey) *OneTimePreKeyDeleteOne {
+	return c.DeleteOneID(otpk.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OneTimePreKeyClient) DeleteOneID(id int) *OneTimePreKeyDeleteOne {
+	builder := c.Delete().Where(onetimeprekey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OneTimePreKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for OneTimePreKey.
+func (c *OneTimePreKeyClient) Query() *OneTimePreKeyQuery {
+	return &OneTimePreKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOneTimePreKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OneTimePreKey entity by its id.
+func (c *OneTimePreKeyClient) Get(ctx context.Context, id int) (*OneTimePreKey, error) {
+	return c.Query().Where(onetimeprekey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OneTimePreKeyClient) GetX(ctx context.Context, id int) *OneTimePreKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDevice queries the device edge of a OneTimePreKey.
+func (c *OneTimePreKeyClient) QueryDevice(otpk *OneTimePreKey) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := otpk.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(onetimeprekey.Table, onetimeprekey.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, onetimeprekey.DeviceTable, onetimeprekey.DeviceColumn),
+		)
+		fromV = sqlgraph.Neighbors(otpk.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OneTimePreKeyClient) Hooks() []Hook {
+	return c.hooks.OneTimePreKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *OneTimePreKeyClient) Interceptors() []Interceptor {
+	return c.inters.OneTimePreKey
+}
+
+func (c *OneTimePreKeyClient) mutate(ctx context.Context, m *OneTimePreKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OneTimePreKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OneTimePreKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OneTimePreKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OneTimePreKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OneTimePreKey mutation op: %q", m.Op())
+	}
+}
+
+// RecoveryCodeClient is a client for the RecoveryCode schema.
+type RecoveryCodeClient struct {
+	config
+}
+
+// NewRecoveryCodeClient returns a client for the RecoveryCode from the given config.
+func NewRecoveryCodeClient(c config) *RecoveryCodeClient {
+	return &RecoveryCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recoverycode.Hooks(f(g(h())))`.
+func (c *RecoveryCodeClient) Use(hooks ...Hook) {
+	c.hooks.RecoveryCode = append(c.hooks.RecoveryCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recoverycode.Intercept(f(g(h())))`.
+func (c *RecoveryCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecoveryCode = append(c.inters.RecoveryCode, interceptors...)
+}
+
+// Create returns a builder for creating a RecoveryCode entity.
+func (c *RecoveryCodeClient) Create() *RecoveryCodeCreate {
+	mutation := newRecoveryCodeMutation(c.config, OpCreate)
+	return &RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecoveryCode entities.
+func (c *RecoveryCodeClient) CreateBulk(builders ...*RecoveryCodeCreate) *RecoveryCodeCreateBulk {
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecoveryCodeClient) MapCreateBulk(slice any, setFunc func(*RecoveryCodeCreate, int)) *RecoveryCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecoveryCodeCreateBulk{err: fmt.Errorf("calling to RecoveryCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecoveryCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecoveryCode.
+func (c *RecoveryCodeClient) Update() *RecoveryCodeUpdate {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdate)
+	return &RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecoveryCodeClient) UpdateOne(rc *RecoveryCode) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCode(rc))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecoveryCodeClient) UpdateOneID(id int) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCodeID(id))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecoveryCode.
+func (c *RecoveryCodeClient) Delete() *RecoveryCodeDelete {
+	mutation := newRecoveryCodeMutation(c.config, OpDelete)
+	return &RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecoveryCodeClient) DeleteOne(rc *RecoveryCode) *RecoveryCodeDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecoveryCodeClient) DeleteOneID(id int) *RecoveryCodeDeleteOne {
+	builder := c.Delete().Where(recoverycode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecoveryCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for RecoveryCode.
+func (c *RecoveryCodeClient) Query() *RecoveryCodeQuery {
+	return &RecoveryCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecoveryCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecoveryCode entity by its id.
+func (c *RecoveryCodeClient) Get(ctx context.Context, id int) (*RecoveryCode, error) {
+	return c.Query().Where(recoverycode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecoveryCodeClient) GetX(ctx context.Context, id int) *RecoveryCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RecoveryCode.
+func (c *RecoveryCodeClient) QueryUser(rc *RecoveryCode) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recoverycode.Table, recoverycode.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, recoverycode.UserTable, recoverycode.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecoveryCodeClient) Hooks() []Hook {
+	return c.hooks.RecoveryCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecoveryCodeClient) Interceptors() []Interceptor {
+	return c.inters.RecoveryCode
+}
+
+func (c *RecoveryCodeClient) mutate(ctx context.Context, m *RecoveryCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecoveryCode mutation op: %q", m.Op())
+	}
+}
+
+// RoomClient is a client for the Room schema.
+type RoomClient struct {
+	config
+}
+
+// NewRoomClient returns a client for the Room from the given config.
+func NewRoomClient(c config) *RoomClient {
+	return &RoomClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `room.Hooks(f(g(h())))`.
+func (c *RoomClient) Use(hooks ...Hook) {
+	c.hooks.Room = append(c.hooks.Room, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `room.Intercept(f(g(h())))`.
+func (c *RoomClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Room = append(c.inters.Room, interceptors...)
+}
+
+// Create returns a builder for creating a Room entity.
+func (c *RoomClient) Create() *RoomCreate {
+	mutation := newRoomMutation(c.config, OpCreate)
+	return &RoomCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Room entities.
+func (c *RoomClient) CreateBulk(builders ...*RoomCreate) *RoomCreateBulk {
+	return &RoomCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoomClient) MapCreateBulk(slice any, setFunc func(*RoomCreate, int)) *RoomCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoomCreateBulk{err: fmt.Errorf("calling to RoomClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoomCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoomCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Room.
+func (c *RoomClient) Update() *RoomUpdate {
+	mutation := newRoomMutation(c.config, OpUpdate)
+	return &RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoomClient) UpdateOne(r *Room) *RoomUpdateOne {
+	mutation := newRoomMutation(c.config, OpUpdateOne, withRoom(r))
+	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoomClient) UpdateOneID(id int) *RoomUpdateOne {
+	mutation := newRoomMutation(c.config, OpUpdateOne, withRoomID(id))
+	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Room.
+func (c *RoomClient) Delete() *RoomDelete {
+	mutation := newRoomMutation(c.config, OpDelete)
+	return &RoomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoomClient) DeleteOne(r *Room) *RoomDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoomClient) DeleteOneID(id int) *RoomDeleteOne {
+	builder := c.Delete().Where(room.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoomDeleteOne{builder}
+}
+
+// Query returns a query builder for Room.
+func (c *RoomClient) Query() *RoomQuery {
+	return &RoomQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoom},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Room entity by its id.
+func (c *RoomClient) Get(ctx context.Context, id int) (*Room, error) {
+	return c.Query().Where(room.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoomClient) GetX(ctx context.Context, id int) *Room {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Room.
+func (c *RoomClient) QueryOwner(r *Room) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, room.OwnerTable, room.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMemberships queries the memberships edge of a Room.
+func (c *RoomClient) QueryMemberships(r *Room) *RoomMembershipQuery {
+	query := (&RoomMembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(roommembership.Table, roommembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, room.MembershipsTable, room.MembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessages queries the messages edge of a Room.
+func (c *RoomClient) QueryMessages(r *Room) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, room.MessagesTable, room.MessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFavourites queries the favourites edge of a Room.
+func (c *RoomClient) QueryFavourites(r *Room) *FavouriteQuery {
+	query := (&FavouriteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(favourite.Table, favourite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, room.FavouritesTable, room.FavouritesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCallLogs queries the call_logs edge of a Room.
+func (c *RoomClient) QueryCallLogs(r *Room) *CallLogQuery {
+	query := (&CallLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(calllog.Table, calllog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, room.CallLogsTable, room.CallLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMlsCommits queries the mls_commits edge of a Room.
+func (c *RoomClient) QueryMlsCommits(r *Room) *MlsCommitQuery {
+	query := (&MlsCommitClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(mlscommit.Table, mlscommit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, room.MlsCommitsTable, room.MlsCommitsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMlsWelcomes queries the mls_welcomes edge of a Room.
+func (c *RoomClient) QueryMlsWelcomes(r *Room) *MlsWelcomeQuery {
+	query := (&MlsWelcomeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(mlswelcome.Table, mlswelcome.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, room.MlsWelcomesTable, room.MlsWelcomesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySenderKeyDistributions queries the sender_key_distributions edge of a Room.
+func (c *RoomClient) QuerySenderKeyDistributions(r *Room) *SenderKeyDistributionQuery {
+	query := (&SenderKeyDistributionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, id),
+			sqlgraph.To(senderkeydistribution.Table, senderkeydistribution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, room.SenderKeyDistributionsTable, room.SenderKeyDistributionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoomClient) Hooks() []Hook {
+	return c.hooks.Room
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoomClient) Interceptors() []Interceptor {
+	return c.inters.Room
+}
+
+func (c *RoomClient) mutate(ctx context.Context, m *RoomMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MediaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MediaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RoomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Media mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Room mutation op: %q", m.Op())
 	}
 }
 
-// MessageClient is a client for the Message schema.
-type MessageClient struct {
+// RoomMembershipClient is a client for the RoomMembership schema.
+type RoomMembershipClient struct {
 	config
 }
 
-// NewMessageClient returns a client for the Message from the given config.
-func NewMessageClient(c config) *MessageClient {
-	return &MessageClient{config: c}
+// NewRoomMembershipClient returns a client for the RoomMembership from the given config.
+func NewRoomMembershipClient(c config) *RoomMembershipClient {
+	return &RoomMembershipClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
-func (c *MessageClient) Use(hooks ...Hook) {
-	c.hooks.Message = append(c.hooks.Message, hooks...)
+// A call to `Use(f, g, h)` equals to `roommembership.Hooks(f(g(h())))`.
+func (c *RoomMembershipClient) Use(hooks ...Hook) {
+	c.hooks.RoomMembership = append(c.hooks.RoomMembership, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
-func (c *MessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Message = append(c.inters.Message, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `roommembership.Intercept(f(g(h())))`.
+func (c *RoomMembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoomMembership = append(c.inters.RoomMembership, interceptors...)
 }
 
-// Create returns a builder for creating a Message entity.
-func (c *MessageClient) Create() *MessageCreate {
-	mutation := newMessageMutation(c.config, OpCreate)
-	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RoomMembership entity.
+func (c *RoomMembershipClient) Create() *RoomMembershipCreate {
+	mutation := newRoomMembershipMutation(c.config, OpCreate)
+	return &RoomMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Message entities.
-func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
-	return &MessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RoomMembership entities.
+func (c *RoomMembershipClient) CreateBulk(builders ...*RoomMembershipCreate) *RoomMembershipCreateBulk {
+	return &RoomMembershipCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+func (c *RoomMembershipClient) MapCreateBulk(slice any, setFunc func(*RoomMembershipCreate, int)) *RoomMembershipCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RoomMembershipCreateBulk{err: fmt.Errorf("calling to RoomMembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MessageCreate, rv.Len())
+	builders := make([]*RoomMembershipCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MessageCreateBulk{config: c.config, builders: builders}
+	return &RoomMembershipCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Message.
-func (c *MessageClient) Update() *MessageUpdate {
-	mutation := newMessageMutation(c.config, OpUpdate)
-	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RoomMembership.
+func (c *RoomMembershipClient) Update() *RoomMembershipUpdate {
+	mutation := newRoomMembershipMutation(c.config, OpUpdate)
+	return &RoomMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MessageClient) UpdateOne(m *Message) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(m))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoomMembershipClient) UpdateOne(rm *RoomMembership) *RoomMembershipUpdateOne {
+	mutation := newRoomMembershipMutation(c.config, OpUpdateOne, withRoomMembership(rm))
+	return &RoomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MessageClient) UpdateOneID(id int) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoomMembershipClient) UpdateOneID(id int) *RoomMembershipUpdateOne {
+	mutation := newRoomMembershipMutation(c.config, OpUpdateOne, withRoomMembershipID(id))
+	return &RoomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Message.
-func (c *MessageClient) Delete() *MessageDelete {
-	mutation := newMessageMutation(c.config, OpDelete)
-	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RoomMembership.
+func (c *RoomMembershipClient) Delete() *RoomMembershipDelete {
+	mutation := newRoomMembershipMutation(c.config, OpDelete)
+	return &RoomMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MessageClient) DeleteOne(m *Message) *MessageDeleteOne {
-	return c.DeleteOneID(m.ID)
+func (c *RoomMembershipClient) DeleteOne(rm *RoomMembership) *RoomMembershipDeleteOne {
+	return c.DeleteOneID(rm.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MessageClient) DeleteOneID(id int) *MessageDeleteOne {
-	builder := c.Delete().Where(message.ID(id))
+func (c *RoomMembershipClient) DeleteOneID(id int) *RoomMembershipDeleteOne {
+	builder := c.Delete().Where(roommembership.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoomMembershipDeleteOne{builder}
+}
+
+// Query returns a query builder for RoomMembership.
+func (c *RoomMembershipClient) Query() *RoomMembershipQuery {
+	return &RoomMembershipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoomMembership},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoomMembership entity by its id.
+func (c *RoomMembershipClient) Get(ctx context.Context, id int) (*RoomMembership, error) {
+	return c.Query().Where(roommembership.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoomMembershipClient) GetX(ctx context.Context, id int) *RoomMembership {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RoomMembership.
+func (c *RoomMembershipClient) QueryUser(rm *RoomMembership) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roommembership.Table, roommembership.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roommembership.UserTable, roommembership.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(rm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoom queries the room edge of a RoomMembership.
+func (c *RoomMembershipClient) QueryRoom(rm *RoomMembership) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roommembership.Table, roommembership.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roommembership.RoomTable, roommembership.RoomColumn),
+		)
+		fromV = sqlgraph.Neighbors(rm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoomMembershipClient) Hooks() []Hook {
+	hooks := c.hooks.RoomMembership
+	return append(hooks[:len(hooks):len(hooks)], roommembership.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoomMembershipClient) Interceptors() []Interceptor {
+	return c.inters.RoomMembership
+}
+
+func (c *RoomMembershipClient) mutate(ctx context.Context, m *RoomMembershipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoomMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoomMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoomMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoomMembership mutation op: %q", m.Op())
+	}
+}
+
+// SenderKeyDistributionClient is a client for the SenderKeyDistribution schema.
+type SenderKeyDistributionClient struct {
+	config
+}
+
+// NewSenderKeyDistributionClient returns a client for the SenderKeyDistribution from the given config.
+func NewSenderKeyDistributionClient(c config) *SenderKeyDistributionClient {
+	return &SenderKeyDistributionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `senderkeydistribution.Hooks(f(g(h())))`.
+func (c *SenderKeyDistributionClient) Use(hooks ...Hook) {
+	c.hooks.SenderKeyDistribution = append(c.hooks.SenderKeyDistribution, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `senderkeydistribution.Intercept(f(g(h())))`.
+func (c *SenderKeyDistributionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SenderKeyDistribution = append(c.inters.SenderKeyDistribution, interceptors...)
+}
+
+// Create returns a builder for creating a SenderKeyDistribution entity.
+func (c *SenderKeyDistributionClient) Create() *SenderKeyDistributionCreate {
+	mutation := newSenderKeyDistributionMutation(c.config, OpCreate)
+	return &SenderKeyDistributionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SenderKeyDistribution entities.
+func (c *SenderKeyDistributionClient) CreateBulk(builders ...*SenderKeyDistributionCreate) *SenderKeyDistributionCreateBulk {
+	return &SenderKeyDistributionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SenderKeyDistributionClient) MapCreateBulk(slice any, setFunc func(*SenderKeyDistributionCreate, int)) *SenderKeyDistributionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SenderKeyDistributionCreateBulk{err: fmt.Errorf("calling to SenderKeyDistributionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SenderKeyDistributionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SenderKeyDistributionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SenderKeyDistribution.
+func (c *SenderKeyDistributionClient) Update() *SenderKeyDistributionUpdate {
+	mutation := newSenderKeyDistributionMutation(c.config, OpUpdate)
+	return &SenderKeyDistributionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SenderKeyDistributionClient) UpdateOne(skd *SenderKeyDistribution) *SenderKeyDistributionUpdateOne {
+	mutation := newSenderKeyDistributionMutation(c.config, OpUpdateOne, withSenderKeyDistribution(skd))
+	return &SenderKeyDistributionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SenderKeyDistributionClient) UpdateOneID(id int) *SenderKeyDistributionUpdateOne {
+	mutation := newSenderKeyDistributionMutation(c.config, OpUpdateOne, withSenderKeyDistributionID(id))
+	return &SenderKeyDistributionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SenderKeyDistribution.
+func (c *SenderKeyDistributionClient) Delete() *SenderKeyDistributionDelete {
+	mutation := newSenderKeyDistributionMutation(c.config, OpDelete)
+	return &SenderKeyDistributionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SenderKeyDistributionClient) DeleteOne(skd *SenderKeyDistribution) *SenderKeyDistributionDeleteOne {
+	return c.DeleteOneID(skd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SenderKeyDistributionClient) DeleteOneID(id int) *SenderKeyDistributionDeleteOne {
+	builder := c.Delete().Where(senderkeydistribution.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MessageDeleteOne{builder}
+	return &SenderKeyDistributionDeleteOne{builder}
 }
 
-// Query returns a query builder for Message.
-func (c *MessageClient) Query() *MessageQuery {
-	return &MessageQuery{
+// Query returns a query builder for SenderKeyDistribution.
+func (c *SenderKeyDistributionClient) Query() *SenderKeyDistributionQuery {
+	return &SenderKeyDistributionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMessage},
+		ctx:    &QueryContext{Type: TypeSenderKeyDistribution},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Message entity by its id.
-func (c *MessageClient) Get(ctx context.Context, id int) (*Message, error) {
-	return c.Query().Where(message.ID(id)).Only(ctx)
+// Get returns a SenderKeyDistribution entity by its id.
+func (c *SenderKeyDistributionClient) Get(ctx context.Context, id int) (*SenderKeyDistribution, error) {
+	return c.Query().Where(senderkeydistribution.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
+func (c *SenderKeyDistributionClient) GetX(ctx context.Context, id int) *SenderKeyDistribution {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1228,180 +5277,181 @@ func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
 	return obj
 }
 
-// QuerySender queries the sender edge of a Message.
-func (c *MessageClient) QuerySender(m *Message) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryRoom queries the room edge of a SenderKeyDistribution.
+func (c *SenderKeyDistributionClient) QueryRoom(skd *SenderKeyDistribution) *RoomQuery {
+	query := (&RoomClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := skd.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, message.SenderTable, message.SenderColumn),
+			sqlgraph.From(senderkeydistribution.Table, senderkeydistribution.FieldID, id),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, senderkeydistribution.RoomTable, senderkeydistribution.RoomColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(skd.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryRoom queries the room edge of a Message.
-func (c *MessageClient) QueryRoom(m *Message) *RoomQuery {
-	query := (&RoomClient{config: c.config}).Query()
+// QuerySender queries the sender edge of a SenderKeyDistribution.
+func (c *SenderKeyDistributionClient) QuerySender(skd *SenderKeyDistribution) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := skd.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(room.Table, room.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, message.RoomTable, message.RoomColumn),
+			sqlgraph.From(senderkeydistribution.Table, senderkeydistribution.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, senderkeydistribution.SenderTable, senderkeydistribution.SenderColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(skd.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryMedia queries the media edge of a Message.
-func (c *MessageClient) QueryMedia(m *Message) *MediaQuery {
-	query := (&MediaClient{config: c.config}).Query()
+// QueryDevice queries the device edge of a SenderKeyDistribution.
+func (c *SenderKeyDistributionClient) QueryDevice(skd *SenderKeyDistribution) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := m.ID
+		id := skd.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(media.Table, media.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, message.MediaTable, message.MediaColumn),
+			sqlgraph.From(senderkeydistribution.Table, senderkeydistribution.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, senderkeydistribution.DeviceTable, senderkeydistribution.DeviceColumn),
 		)
-		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(skd.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *MessageClient) Hooks() []Hook {
-	return c.hooks.Message
+func (c *SenderKeyDistributionClient) Hooks() []Hook {
+	hooks := c.hooks.SenderKeyDistribution
+	return append(hooks[:len(hooks):len(hooks)], senderkeydistribution.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *MessageClient) Interceptors() []Interceptor {
-	return c.inters.Message
+func (c *SenderKeyDistributionClient) Interceptors() []Interceptor {
+	return c.inters.SenderKeyDistribution
 }
 
-func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+func (c *SenderKeyDistributionClient) mutate(ctx context.Context, m *SenderKeyDistributionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderKeyDistributionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderKeyDistributionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderKeyDistributionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SenderKeyDistributionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SenderKeyDistribution mutation op: %q", m.Op())
 	}
 }
 
-// NotificationClient is a client for the Notification schema.
-type NotificationClient struct {
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
 	config
 }
 
-// NewNotificationClient returns a client for the Notification from the given config.
-func NewNotificationClient(c config) *NotificationClient {
-	return &NotificationClient{config: c}
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
-func (c *NotificationClient) Use(hooks ...Hook) {
-	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
-func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Notification = append(c.inters.Notification, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
 }
 
-// Create returns a builder for creating a Notification entity.
-func (c *NotificationClient) Create() *NotificationCreate {
-	mutation := newNotificationMutation(c.config, OpCreate)
-	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Notification entities.
-func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationCreate, rv.Len())
+	builders := make([]*SessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+	return &SessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Notification.
-func (c *NotificationClient) Update() *NotificationUpdate {
-	mutation := newNotificationMutation(c.config, OpUpdate)
-	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionClient) UpdateOne(s *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(s))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationClient) UpdateOneID(id int) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionClient) UpdateOneID(id int) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Notification.
-func (c *NotificationClient) Delete() *NotificationDelete {
-	mutation := newNotificationMutation(c.config, OpDelete)
-	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
-	return c.DeleteOneID(n.ID)
+func (c *SessionClient) DeleteOne(s *Session) *SessionDeleteOne {
+	return c.DeleteOneID(s.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationClient) DeleteOneID(id int) *NotificationDeleteOne {
-	builder := c.Delete().Where(notification.ID(id))
+func (c *SessionClient) DeleteOneID(id int) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationDeleteOne{builder}
+	return &SessionDeleteOne{builder}
 }
 
-// Query returns a query builder for Notification.
-func (c *NotificationClient) Query() *NotificationQuery {
-	return &NotificationQuery{
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotification},
+		ctx:    &QueryContext{Type: TypeSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Notification entity by its id.
-func (c *NotificationClient) Get(ctx context.Context, id int) (*Notification, error) {
-	return c.Query().Where(notification.ID(id)).Only(ctx)
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id int) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
+func (c *SessionClient) GetX(ctx context.Context, id int) *Session {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1409,180 +5459,148 @@ func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
 	return obj
 }
 
-// QueryRecipient queries the recipient edge of a Notification.
-func (c *NotificationClient) QueryRecipient(n *Notification) *UserQuery {
+// QueryUser queries the user edge of a Session.
+func (c *SessionClient) QueryUser(s *Session) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
+		id := s.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.From(session.Table, session.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, notification.RecipientTable, notification.RecipientColumn),
-		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRoom queries the room edge of a Notification.
-func (c *NotificationClient) QueryRoom(n *Notification) *RoomQuery {
-	query := (&RoomClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(notification.Table, notification.FieldID, id),
-			sqlgraph.To(room.Table, room.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, notification.RoomTable, notification.RoomColumn),
-		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMessage queries the message edge of a Notification.
-func (c *NotificationClient) QueryMessage(n *Notification) *MessageQuery {
-	query := (&MessageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(notification.Table, notification.FieldID, id),
-			sqlgraph.To(message.Table, message.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, notification.MessageTable, notification.MessageColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, session.UserTable, session.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NotificationClient) Hooks() []Hook {
-	return c.hooks.Notification
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationClient) Interceptors() []Interceptor {
-	return c.inters.Notification
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
 }
 
-func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
 	}
 }
 
-// RoomClient is a client for the Room schema.
-type RoomClient struct {
+// SignedPreKeyClient is a client for the SignedPreKey schema.
+type SignedPreKeyClient struct {
 	config
 }
 
-// NewRoomClient returns a client for the Room from the given config.
-func NewRoomClient(c config) *RoomClient {
-	return &RoomClient{config: c}
+// NewSignedPreKeyClient returns a client for the SignedPreKey from the given config.
+func NewSignedPreKeyClient(c config) *SignedPreKeyClient {
+	return &SignedPreKeyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `room.Hooks(f(g(h())))`.
-func (c *RoomClient) Use(hooks ...Hook) {
-	c.hooks.Room = append(c.hooks.Room, hooks...)
+// A call to `Use(f, g, h)` equals to `signedprekey.Hooks(f(g(h())))`.
+func (c *SignedPreKeyClient) Use(hooks ...Hook) {
+	c.hooks.SignedPreKey = append(c.hooks.SignedPreKey, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `room.Intercept(f(g(h())))`.
-func (c *RoomClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Room = append(c.inters.Room, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `signedprekey.Intercept(f(g(h())))`.
+func (c *SignedPreKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SignedPreKey = append(c.inters.SignedPreKey, interceptors...)
 }
 
-// Create returns a builder for creating a Room entity.
-func (c *RoomClient) Create() *RoomCreate {
-	mutation := newRoomMutation(c.config, OpCreate)
-	return &RoomCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SignedPreKey entity.
+func (c *SignedPreKeyClient) Create() *SignedPreKeyCreate {
+	mutation := newSignedPreKeyMutation(c.config, OpCreate)
+	return &SignedPreKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Room entities.
-func (c *RoomClient) CreateBulk(builders ...*RoomCreate) *RoomCreateBulk {
-	return &RoomCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SignedPreKey entities.
+func (c *SignedPreKeyClient) CreateBulk(builders ...*SignedPreKeyCreate) *SignedPreKeyCreateBulk {
+	return &SignedPreKeyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoomClient) MapCreateBulk(slice any, setFunc func(*RoomCreate, int)) *RoomCreateBulk {
+func (c *SignedPreKeyClient) MapCreateBulk(slice any, setFunc func(*SignedPreKeyCreate, int)) *SignedPreKeyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoomCreateBulk{err: fmt.Errorf("calling to RoomClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SignedPreKeyCreateBulk{err: fmt.Errorf("calling to SignedPreKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RoomCreate, rv.Len())
+	builders := make([]*SignedPreKeyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RoomCreateBulk{config: c.config, builders: builders}
+	return &SignedPreKeyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Room.
-func (c *RoomClient) Update() *RoomUpdate {
-	mutation := newRoomMutation(c.config, OpUpdate)
-	return &RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SignedPreKey.
+func (c *SignedPreKeyClient) Update() *SignedPreKeyUpdate {
+	mutation := newSignedPreKeyMutation(c.config, OpUpdate)
+	return &SignedPreKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoomClient) UpdateOne(r *Room) *RoomUpdateOne {
-	mutation := newRoomMutation(c.config, OpUpdateOne, withRoom(r))
-	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SignedPreKeyClient) UpdateOne(spk *SignedPreKey) *SignedPreKeyUpdateOne {
+	mutation := newSignedPreKeyMutation(c.config, OpUpdateOne, withSignedPreKey(spk))
+	return &SignedPreKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoomClient) UpdateOneID(id int) *RoomUpdateOne {
-	mutation := newRoomMutation(c.config, OpUpdateOne, withRoomID(id))
-	return &RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SignedPreKeyClient) UpdateOneID(id int) *SignedPreKeyUpdateOne {
+	mutation := newSignedPreKeyMutation(c.config, OpUpdateOne, withSignedPreKeyID(id))
+	return &SignedPreKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Room.
-func (c *RoomClient) Delete() *RoomDelete {
-	mutation := newRoomMutation(c.config, OpDelete)
-	return &RoomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SignedPreKey.
+func (c *SignedPreKeyClient) Delete() *SignedPreKeyDelete {
+	mutation := newSignedPreKeyMutation(c.config, OpDelete)
+	return &SignedPreKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoomClient) DeleteOne(r *Room) *RoomDeleteOne {
-	return c.DeleteOneID(r.ID)
+func (c *SignedPreKeyClient) DeleteOne(spk *SignedPreKey) *SignedPreKeyDeleteOne {
+	return c.DeleteOneID(spk.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoomClient) DeleteOneID(id int) *RoomDeleteOne {
-	builder := c.Delete().Where(room.ID(id))
+func (c *SignedPreKeyClient) DeleteOneID(id int) *SignedPreKeyDeleteOne {
+	builder := c.Delete().Where(signedprekey.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoomDeleteOne{builder}
+	return &SignedPreKeyDeleteOne{builder}
 }
 
-// Query returns a query builder for Room.
-func (c *RoomClient) Query() *RoomQuery {
-	return &RoomQuery{
+// Query returns a query builder for SignedPreKey.
+func (c *SignedPreKeyClient) Query() *SignedPreKeyQuery {
+	return &SignedPreKeyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRoom},
+		ctx:    &QueryContext{Type: TypeSignedPreKey},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Room entity by its id.
-func (c *RoomClient) Get(ctx context.Context, id int) (*Room, error) {
-	return c.Query().Where(room.ID(id)).Only(ctx)
+// Get returns a SignedPreKey entity by its id.
+func (c *SignedPreKeyClient) Get(ctx context.Context, id int) (*SignedPreKey, error) {
+	return c.Query().Where(signedprekey.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoomClient) GetX(ctx context.Context, id int) *Room {
+func (c *SignedPreKeyClient) GetX(ctx context.Context, id int) *SignedPreKey {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1590,212 +5608,148 @@ func (c *RoomClient) GetX(ctx context.Context, id int) *Room {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Room.
-func (c *RoomClient) QueryOwner(r *Room) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(room.Table, room.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, room.OwnerTable, room.OwnerColumn),
-		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMemberships queries the memberships edge of a Room.
-func (c *RoomClient) QueryMemberships(r *Room) *RoomMembershipQuery {
-	query := (&RoomMembershipClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(room.Table, room.FieldID, id),
-			sqlgraph.To(roommembership.Table, roommembership.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, room.MembershipsTable, room.MembershipsColumn),
-		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMessages queries the messages edge of a Room.
-func (c *RoomClient) QueryMessages(r *Room) *MessageQuery {
-	query := (&MessageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(room.Table, room.FieldID, id),
-			sqlgraph.To(message.Table, message.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, room.MessagesTable, room.MessagesColumn),
-		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryFavourites queries the favourites edge of a Room.
-func (c *RoomClient) QueryFavourites(r *Room) *FavouriteQuery {
-	query := (&FavouriteClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(room.Table, room.FieldID, id),
-			sqlgraph.To(favourite.Table, favourite.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, room.FavouritesTable, room.FavouritesColumn),
-		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCallLogs queries the call_logs edge of a Room.
-func (c *RoomClient) QueryCallLogs(r *Room) *CallLogQuery {
-	query := (&CallLogClient{config: c.config}).Query()
+// QueryDevice queries the device edge of a SignedPreKey.
+func (c *SignedPreKeyClient) QueryDevice(spk *SignedPreKey) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
+		id := spk.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(room.Table, room.FieldID, id),
-			sqlgraph.To(calllog.Table, calllog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, room.CallLogsTable, room.CallLogsColumn),
+			sqlgraph.From(signedprekey.Table, signedprekey.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, signedprekey.DeviceTable, signedprekey.DeviceColumn),
 		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(spk.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *RoomClient) Hooks() []Hook {
-	return c.hooks.Room
+func (c *SignedPreKeyClient) Hooks() []Hook {
+	return c.hooks.SignedPreKey
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoomClient) Interceptors() []Interceptor {
-	return c.inters.Room
+func (c *SignedPreKeyClient) Interceptors() []Interceptor {
+	return c.inters.SignedPreKey
 }
 
-func (c *RoomClient) mutate(ctx context.Context, m *RoomMutation) (Value, error) {
+func (c *SignedPreKeyClient) mutate(ctx context.Context, m *SignedPreKeyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SignedPreKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SignedPreKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SignedPreKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SignedPreKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Room mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SignedPreKey mutation op: %q", m.Op())
 	}
 }
 
-// RoomMembershipClient is a client for the RoomMembership schema.
-type RoomMembershipClient struct {
+// TotpSecretClient is a client for the TotpSecret schema.
+type TotpSecretClient struct {
 	config
 }
 
-// NewRoomMembershipClient returns a client for the RoomMembership from the given config.
-func NewRoomMembershipClient(c config) *RoomMembershipClient {
-	return &RoomMembershipClient{config: c}
+// NewTotpSecretClient returns a client for the TotpSecret from the given config.
+func NewTotpSecretClient(c config) *TotpSecretClient {
+	return &TotpSecretClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `roommembership.Hooks(f(g(h())))`.
-func (c *RoomMembershipClient) Use(hooks ...Hook) {
-	c.hooks.RoomMembership = append(c.hooks.RoomMembership, hooks...)
+// A call to `Use(f, g, h)` equals to `totpsecret.Hooks(f(g(h())))`.
+func (c *TotpSecretClient) Use(hooks ...Hook) {
+	c.hooks.TotpSecret = append(c.hooks.TotpSecret, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `roommembership.Intercept(f(g(h())))`.
-func (c *RoomMembershipClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RoomMembership = append(c.inters.RoomMembership, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `totpsecret.Intercept(f(g(h())))`.
+func (c *TotpSecretClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TotpSecret = append(c.inters.TotpSecret, interceptors...)
 }
 
-// Create returns a builder for creating a RoomMembership entity.
-func (c *RoomMembershipClient) Create() *RoomMembershipCreate {
-	mutation := newRoomMembershipMutation(c.config, OpCreate)
-	return &RoomMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TotpSecret entity.
+func (c *TotpSecretClient) Create() *TotpSecretCreate {
+	mutation := newTotpSecretMutation(c.config, OpCreate)
+	return &TotpSecretCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RoomMembership entities.
-func (c *RoomMembershipClient) CreateBulk(builders ...*RoomMembershipCreate) *RoomMembershipCreateBulk {
-	return &RoomMembershipCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TotpSecret entities.
+func (c *TotpSecretClient) CreateBulk(builders ...*TotpSecretCreate) *TotpSecretCreateBulk {
+	return &TotpSecretCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoomMembershipClient) MapCreateBulk(slice any, setFunc func(*RoomMembershipCreate, int)) *RoomMembershipCreateBulk {
+func (c *TotpSecretClient) MapCreateBulk(slice any, setFunc func(*TotpSecretCreate, int)) *TotpSecretCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoomMembershipCreateBulk{err: fmt.Errorf("calling to RoomMembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TotpSecretCreateBulk{err: fmt.Errorf("calling to TotpSecretClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RoomMembershipCreate, rv.Len())
+	builders := make([]*TotpSecretCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RoomMembershipCreateBulk{config: c.config, builders: builders}
+	return &TotpSecretCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RoomMembership.
-func (c *RoomMembershipClient) Update() *RoomMembershipUpdate {
-	mutation := newRoomMembershipMutation(c.config, OpUpdate)
-	return &RoomMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TotpSecret.
+func (c *TotpSecretClient) Update() *TotpSecretUpdate {
+	mutation := newTotpSecretMutation(c.config, OpUpdate)
+	return &TotpSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoomMembershipClient) UpdateOne(rm *RoomMembership) *RoomMembershipUpdateOne {
-	mutation := newRoomMembershipMutation(c.config, OpUpdateOne, withRoomMembership(rm))
-	return &RoomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TotpSecretClient) UpdateOne(ts *TotpSecret) *TotpSecretUpdateOne {
+	mutation := newTotpSecretMutation(c.config, OpUpdateOne, withTotpSecret(ts))
+	return &TotpSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoomMembershipClient) UpdateOneID(id int) *RoomMembershipUpdateOne {
-	mutation := newRoomMembershipMutation(c.config, OpUpdateOne, withRoomMembershipID(id))
-	return &RoomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TotpSecretClient) UpdateOneID(id int) *TotpSecretUpdateOne {
+	mutation := newTotpSecretMutation(c.config, OpUpdateOne, withTotpSecretID(id))
+	return &TotpSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RoomMembership.
-func (c *RoomMembershipClient) Delete() *RoomMembershipDelete {
-	mutation := newRoomMembershipMutation(c.config, OpDelete)
-	return &RoomMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TotpSecret.
+func (c *TotpSecretClient) Delete() *TotpSecretDelete {
+	mutation := newTotpSecretMutation(c.config, OpDelete)
+	return &TotpSecretDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoomMembershipClient) DeleteOne(rm *RoomMembership) *RoomMembershipDeleteOne {
-	return c.DeleteOneID(rm.ID)
+func (c *TotpSecretClient) DeleteOne(ts *TotpSecret) *TotpSecretDeleteOne {
+	return c.DeleteOneID(ts.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoomMembershipClient) DeleteOneID(id int) *RoomMembershipDeleteOne {
-	builder := c.Delete().Where(roommembership.ID(id))
+func (c *TotpSecretClient) DeleteOneID(id int) *TotpSecretDeleteOne {
+	builder := c.Delete().Where(totpsecret.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoomMembershipDeleteOne{builder}
+	return &TotpSecretDeleteOne{builder}
 }
 
-// Query returns a query builder for RoomMembership.
-func (c *RoomMembershipClient) Query() *RoomMembershipQuery {
-	return &RoomMembershipQuery{
+// Query returns a query builder for TotpSecret.
+func (c *TotpSecretClient) Query() *TotpSecretQuery {
+	return &TotpSecretQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRoomMembership},
+		ctx:    &QueryContext{Type: TypeTotpSecret},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RoomMembership entity by its id.
-func (c *RoomMembershipClient) Get(ctx context.Context, id int) (*RoomMembership, error) {
-	return c.Query().Where(roommembership.ID(id)).Only(ctx)
+// Get returns a TotpSecret entity by its id.
+func (c *TotpSecretClient) Get(ctx context.Context, id int) (*TotpSecret, error) {
+	return c.Query().Where(totpsecret.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoomMembershipClient) GetX(ctx context.Context, id int) *RoomMembership {
+func (c *TotpSecretClient) GetX(ctx context.Context, id int) *TotpSecret {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1803,60 +5757,44 @@ func (c *RoomMembershipClient) GetX(ctx context.Context, id int) *RoomMembership
 	return obj
 }
 
-// QueryUser queries the user edge of a RoomMembership.
-func (c *RoomMembershipClient) QueryUser(rm *RoomMembership) *UserQuery {
+// QueryUser queries the user edge of a TotpSecret.
+func (c *TotpSecretClient) QueryUser(ts *TotpSecret) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rm.ID
+		id := ts.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(roommembership.Table, roommembership.FieldID, id),
+			sqlgraph.From(totpsecret.Table, totpsecret.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, roommembership.UserTable, roommembership.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(rm.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRoom queries the room edge of a RoomMembership.
-func (c *RoomMembershipClient) QueryRoom(rm *RoomMembership) *RoomQuery {
-	query := (&RoomClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rm.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(roommembership.Table, roommembership.FieldID, id),
-			sqlgraph.To(room.Table, room.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, roommembership.RoomTable, roommembership.RoomColumn),
+			sqlgraph.Edge(sqlgraph.O2O, false, totpsecret.UserTable, totpsecret.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(rm.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ts.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *RoomMembershipClient) Hooks() []Hook {
-	return c.hooks.RoomMembership
+func (c *TotpSecretClient) Hooks() []Hook {
+	return c.hooks.TotpSecret
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoomMembershipClient) Interceptors() []Interceptor {
-	return c.inters.RoomMembership
+func (c *TotpSecretClient) Interceptors() []Interceptor {
+	return c.inters.TotpSecret
 }
 
-func (c *RoomMembershipClient) mutate(ctx context.Context, m *RoomMembershipMutation) (Value, error) {
+func (c *TotpSecretClient) mutate(ctx context.Context, m *TotpSecretMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoomMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TotpSecretCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoomMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TotpSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TotpSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoomMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TotpSecretDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RoomMembership mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TotpSecret mutation op: %q", m.Op())
 	}
 }
 
@@ -2112,6 +6050,230 @@ func (c *UserClient) QueryCallParticipations(u *User) *CallParticipantQuery {
 	return query
 }
 
+// QueryCredential queries the credential edge of a User.
+func (c *UserClient) QueryCredential(u *User) *CredentialQuery {
+	query := (&CredentialClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(credential.Table, credential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, user.CredentialTable, user.CredentialColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a User.
+func (c *UserClient) QuerySessions(u *User) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.SessionsTable, user.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentities queries the identities edge of a User.
+func (c *UserClient) QueryIdentities(u *User) *IdentityQuery {
+	query := (&IdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(identity.Table, identity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTotpSecret queries the totp_secret edge of a User.
+func (c *UserClient) QueryTotpSecret(u *User) *TotpSecretQuery {
+	query := (&TotpSecretClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(totpsecret.Table, totpsecret.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, user.TotpSecretTable, user.TotpSecretColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecoveryCodes queries the recovery_codes edge of a User.
+func (c *UserClient) QueryRecoveryCodes(u *User) *RecoveryCodeQuery {
+	query := (&RecoveryCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(recoverycode.Table, recoverycode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.RecoveryCodesTable, user.RecoveryCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKeys queries the api_keys edge of a User.
+func (c *UserClient) QueryAPIKeys(u *User) *ApiKeyQuery {
+	query := (&ApiKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.APIKeysTable, user.APIKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmailTokens queries the email_tokens edge of a User.
+func (c *UserClient) QueryEmailTokens(u *User) *EmailTokenQuery {
+	query := (&EmailTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(emailtoken.Table, emailtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.EmailTokensTable, user.EmailTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLoginThrottles queries the login_throttles edge of a User.
+func (c *UserClient) QueryLoginThrottles(u *User) *LoginThrottleQuery {
+	query := (&LoginThrottleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(loginthrottle.Table, loginthrottle.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.LoginThrottlesTable, user.LoginThrottlesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevices queries the devices edge of a User.
+func (c *UserClient) QueryDevices(u *User) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.DevicesTable, user.DevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMlsCommits queries the mls_commits edge of a User.
+func (c *UserClient) QueryMlsCommits(u *User) *MlsCommitQuery {
+	query := (&MlsCommitClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(mlscommit.Table, mlscommit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.MlsCommitsTable, user.MlsCommitsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySentMlsWelcomes queries the sent_mls_welcomes edge of a User.
+func (c *UserClient) QuerySentMlsWelcomes(u *User) *MlsWelcomeQuery {
+	query := (&MlsWelcomeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(mlswelcome.Table, mlswelcome.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.SentMlsWelcomesTable, user.SentMlsWelcomesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySentSenderKeyDistributions queries the sent_sender_key_distributions edge of a User.
+func (c *UserClient) QuerySentSenderKeyDistributions(u *User) *SenderKeyDistributionQuery {
+	query := (&SenderKeyDistributionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(senderkeydistribution.Table, senderkeydistribution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.SentSenderKeyDistributionsTable, user.SentSenderKeyDistributionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryServiceOwner queries the service_owner edge of a User.
+func (c *UserClient) QueryServiceOwner(u *User) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.ServiceOwnerTable, user.ServiceOwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryServiceAccounts queries the service_accounts edge of a User.
+func (c *UserClient) QueryServiceAccounts(u *User) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ServiceAccountsTable, user.ServiceAccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -2140,11 +6302,20 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		CallLog, CallParticipant, Contact, Favourite, Media, Message, Notification,
-		Room, RoomMembership, User []ent.Hook
+		ApiKey, CallLog, CallParticipant, Contact, Credential, Device, EmailToken,
+		Favourite, Identity, IdentityKey, IdentityKeyRecord, KeyBackupKey,
+		KeyBackupVersion, KeyLogEntry, KeyLogHead, KeyLogNode, KeyPackage,
+		LoginThrottle, Media, Message, MessageEnvelope, MlsCommit, MlsWelcome,
+		Notification, OneTimePreKey, RecoveryCode, Room, RoomMembership,
+		SenderKeyDistribution, Session, SignedPreKey, TotpSecret, User []ent.Hook
 	}
 	inters struct {
-		CallLog, CallParticipant, Contact, Favourite, Media, Message, Notification,
-		Room, RoomMembership, User []ent.Interceptor
+		ApiKey, CallLog, CallParticipant, Contact, Credential, Device, EmailToken,
+		Favourite, Identity, IdentityKey, IdentityKeyRecord, KeyBackupKey,
+		KeyBackupVersion, KeyLogEntry, KeyLogHead, KeyLogNode, KeyPackage,
+		LoginThrottle, Media, Message, MessageEnvelope, MlsCommit, MlsWelcome,
+		Notification, OneTimePreKey, RecoveryCode, Room, RoomMembership,
+		SenderKeyDistribution, Session, SignedPreKey, TotpSecret,
+		User []ent.Interceptor
 	}
 )
@@ -5,6 +5,7 @@ package contact
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -78,7 +79,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eleven-am/enclave/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// DefaultIsFavourite holds the default value on creation for the "is_favourite" field.
 	DefaultIsFavourite bool
 	// DefaultIsBlocked holds the default value on creation for the "is_blocked" field.
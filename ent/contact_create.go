@@ -120,7 +120,9 @@ func (cc *ContactCreate) Mutation() *ContactMutation {
 
 // Save creates the Contact in the database.
 func (cc *ContactCreate) Save(ctx context.Context) (*Contact, error) {
-	cc.defaults()
+	if err := cc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
 }
 
@@ -147,7 +149,7 @@ func (cc *ContactCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (cc *ContactCreate) defaults() {
+func (cc *ContactCreate) defaults() error {
 	if _, ok := cc.mutation.IsFavourite(); !ok {
 		v := contact.DefaultIsFavourite
 		cc.mutation.SetIsFavourite(v)
@@ -161,13 +163,20 @@ func (cc *ContactCreate) defaults() {
 		cc.mutation.SetAlias(v)
 	}
 	if _, ok := cc.mutation.CreatedAt(); !ok {
+		if contact.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized contact.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := contact.DefaultCreatedAt()
 		cc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		if contact.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized contact.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := contact.DefaultUpdatedAt()
 		cc.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -398,6 +399,12 @@ func (cq *ContactQuery) prepareQuery(ctx context.Context) error {
 		}
 		cq.sql = prev
 	}
+	if contact.Policy == nil {
+		return errors.New("ent: uninitialized contact.Policy (forgotten import ent/runtime?)")
+	}
+	if err := contact.Policy.EvalQuery(ctx, cq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykey"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// Device is the model entity for the Device schema.
+type Device struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID int `json:"device_id,omitempty"`
+	// RegistrationID holds the value of the "registration_id" field.
+	RegistrationID int `json:"registration_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// LowPrekeysNotifiedAt holds the value of the "low_prekeys_notified_at" field.
+	LowPrekeysNotifiedAt *time.Time `json:"low_prekeys_notified_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeviceQuery when eager-loading is set.
+	Edges               DeviceEdges `json:"edges"`
+	device_user         *int
+	identity_key_device *int
+	selectValues        sql.SelectValues
+}
+
+// DeviceEdges holds the relations/edges for other nodes in the graph.
+type DeviceEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// IdentityKey holds the value of the identity_key edge.
+	IdentityKey *IdentityKey `json:"identity_key,omitempty"`
+	// IdentityKeyHistory holds the value of the identity_key_history edge.
+	IdentityKeyHistory []*IdentityKeyRecord `json:"identity_key_history,omitempty"`
+	// SignedPreKeys holds the value of the signed_pre_keys edge.
+	SignedPreKeys []*SignedPreKey `json:"signed_pre_keys,omitempty"`
+	// OneTimePreKeys holds the value of the one_time_pre_keys edge.
+	OneTimePreKeys []*OneTimePreKey `json:"one_time_pre_keys,omitempty"`
+	// Envelopes holds the value of the envelopes edge.
+	Envelopes []*MessageEnvelope `json:"envelopes,omitempty"`
+	// KeyPackages holds the value of the key_packages edge.
+	KeyPackages []*KeyPackage `json:"key_packages,omitempty"`
+	// MlsWelcomes holds the value of the mls_welcomes edge.
+	MlsWelcomes []*MlsWelcome `json:"mls_welcomes,omitempty"`
+	// SenderKeyDistributions holds the value of the sender_key_distributions edge.
+	SenderKeyDistributions []*SenderKeyDistribution `json:"sender_key_distributions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [9]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// IdentityKeyOrErr returns the IdentityKey value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceEdges) IdentityKeyOrErr() (*IdentityKey, error) {
+	if e.IdentityKey != nil {
+		return e.IdentityKey, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: identitykey.Label}
+	}
+	return nil, &NotLoadedError{edge: "identity_key"}
+}
+
+// IdentityKeyHistoryOrErr returns the IdentityKeyHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) IdentityKeyHistoryOrErr() ([]*IdentityKeyRecord, error) {
+	if e.loadedTypes[2] {
+		return e.IdentityKeyHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "identity_key_history"}
+}
+
+// SignedPreKeysOrErr returns the SignedPreKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) SignedPreKeysOrErr() ([]*SignedPreKey, error) {
+	if e.loadedTypes[3] {
+		return e.SignedPreKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "signed_pre_keys"}
+}
+
+// OneTimePreKeysOrErr returns the OneTimePreKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) OneTimePreKeysOrErr() ([]*OneTimePreKey, error) {
+	if e.loadedTypes[4] {
+		return e.OneTimePreKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "one_time_pre_keys"}
+}
+
+// EnvelopesOrErr returns the Envelopes value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) EnvelopesOrErr() ([]*MessageEnvelope, error) {
+	if e.loadedTypes[5] {
+		return e.Envelopes, nil
+	}
+	return nil, &NotLoadedError{edge: "envelopes"}
+}
+
+// KeyPackagesOrErr returns the KeyPackages value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) KeyPackagesOrErr() ([]*KeyPackage, error) {
+	if e.loadedTypes[6] {
+		return e.KeyPackages, nil
+	}
+	return nil, &NotLoadedError{edge: "key_packages"}
+}
+
+// MlsWelcomesOrErr returns the MlsWelcomes value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) MlsWelcomesOrErr() ([]*MlsWelcome, error) {
+	if e.loadedTypes[7] {
+		return e.MlsWelcomes, nil
+	}
+	return nil, &NotLoadedError{edge: "mls_welcomes"}
+}
+
+// SenderKeyDistributionsOrErr returns the SenderKeyDistributions value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) SenderKeyDistributionsOrErr() ([]*SenderKeyDistribution, error) {
+	if e.loadedTypes[8] {
+		return e.SenderKeyDistributions, nil
+	}
+	return nil, &NotLoadedError{edge: "sender_key_distributions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Device) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case device.FieldID, device.FieldDeviceID, device.FieldRegistrationID:
+			values[i] = new(sql.NullInt64)
+		case device.FieldName:
+			values[i] = new(sql.NullString)
+		case device.FieldLowPrekeysNotifiedAt, device.FieldCreatedAt, device.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case device.ForeignKeys[0]: // device_user
+			values[i] = new(sql.NullInt64)
+		case device.ForeignKeys[1]: // identity_key_device
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Device fields.
+func (d *Device) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case device.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			d.ID = int(value.Int64)
+		case device.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				d.DeviceID = int(value.Int64)
+			}
+		case device.FieldRegistrationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field registration_id", values[i])
+			} else if value.Valid {
+				d.RegistrationID = int(value.Int64)
+			}
+		case device.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				d.Name = value.String
+			}
+		case device.FieldLowPrekeysNotifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field low_prekeys_notified_at", values[i])
+			} else if value.Valid {
+				d.LowPrekeysNotifiedAt = new(time.Time)
+				*d.LowPrekeysNotifiedAt = value.Time
+			}
+		case device.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case device.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				d.UpdatedAt = value.Time
+			}
+		case device.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field device_user", value)
+			} else if value.Valid {
+				d.device_user = new(int)
+				*d.device_user = int(value.Int64)
+			}
+		case device.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field identity_key_device", value)
+			} else if value.Valid {
+				d.identity_key_device = new(int)
+				*d.identity_key_device = int(value.Int64)
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Device.
+// This includes values selected through modifiers, order, etc.
+func (d *Device) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Device entity.
+func (d *Device) QueryUser() *UserQuery {
+	return NewDeviceClient(d.config).QueryUser(d)
+}
+
+// QueryIdentityKey queries the "identity_key" edge of the Device entity.
+func (d *Device) QueryIdentityKey() *IdentityKeyQuery {
+	return NewDeviceClient(d.config).QueryIdentityKey(d)
+}
+
+// QueryIdentityKeyHistory queries the "identity_key_history" edge of the Device entity.
+func (d *Device) QueryIdentityKeyHistory() *IdentityKeyRecordQuery {
+	return NewDeviceClient(d.config).QueryIdentityKeyHistory(d)
+}
+
+// QuerySignedPreKeys queries the "signed_pre_keys" edge of the Device entity.
+func (d *Device) QuerySignedPreKeys() *SignedPreKeyQuery {
+	return NewDeviceClient(d.config).QuerySignedPreKeys(d)
+}
+
+// QueryOneTimePreKeys queries the "one_time_pre_keys" edge of the Device entity.
+func (d *Device) QueryOneTimePreKeys() *OneTimePreKeyQuery {
+	return NewDeviceClient(d.config).QueryOneTimePreKeys(d)
+}
+
+// QueryEnvelopes queries the "envelopes" edge of the Device entity.
+func (d *Device) QueryEnvelopes() *MessageEnvelopeQuery {
+	return NewDeviceClient(d.config).QueryEnvelopes(d)
+}
+
+// QueryKeyPackages queries the "key_packages" edge of the Device entity.
+func (d *Device) QueryKeyPackages() *KeyPackageQuery {
+	return NewDeviceClient(d.config).QueryKeyPackages(d)
+}
+
+// QueryMlsWelcomes queries the "mls_welcomes" edge of the Device entity.
+func (d *Device) QueryMlsWelcomes() *MlsWelcomeQuery {
+	return NewDeviceClient(d.config).QueryMlsWelcomes(d)
+}
+
+// QuerySenderKeyDistributions queries the "sender_key_distributions" edge of the Device entity.
+func (d *Device) QuerySenderKeyDistributions() *SenderKeyDistributionQuery {
+	return NewDeviceClient(d.config).QuerySenderKeyDistributions(d)
+}
+
+// Update returns a builder for updating this Device.
+// Note that you need to call Device.Unwrap() before calling this method if this Device
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Device) Update() *DeviceUpdateOne {
+	return NewDeviceClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Device entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Device) Unwrap() *Device {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Device is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Device) String() string {
+	var builder strings.Builder
+	builder.WriteString("Device(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("device_id=")
+	builder.WriteString(fmt.Sprintf("%v", d.DeviceID))
+	builder.WriteString(", ")
+	builder.WriteString("registration_id=")
+	builder.WriteString(fmt.Sprintf("%v", d.RegistrationID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(d.Name)
+	builder.WriteString(", ")
+	if v := d.LowPrekeysNotifiedAt; v != nil {
+		builder.WriteString("low_prekeys_notified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(d.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Devices is a parsable slice of Device.
+type Devices []*Device
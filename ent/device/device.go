@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package device
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the device type in the database.
+	Label = "device"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDeviceID holds the string denoting the device_id field in the database.
+	FieldDeviceID = "device_id"
+	// FieldRegistrationID holds the string denoting the registration_id field in the database.
+	FieldRegistrationID = "registration_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldLowPrekeysNotifiedAt holds the string denoting the low_prekeys_notified_at field in the database.
+	FieldLowPrekeysNotifiedAt = "low_prekeys_notified_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeIdentityKey holds the string denoting the identity_key edge name in mutations.
+	EdgeIdentityKey = "identity_key"
+	// EdgeIdentityKeyHistory holds the string denoting the identity_key_history edge name in mutations.
+	EdgeIdentityKeyHistory = "identity_key_history"
+	// EdgeSignedPreKeys holds the string denoting the signed_pre_keys edge name in mutations.
+	EdgeSignedPreKeys = "signed_pre_keys"
+	// EdgeOneTimePreKeys holds the string denoting the one_time_pre_keys edge name in mutations.
+	EdgeOneTimePreKeys = "one_time_pre_keys"
+	// EdgeEnvelopes holds the string denoting the envelopes edge name in mutations.
+	EdgeEnvelopes = "envelopes"
+	// EdgeKeyPackages holds the string denoting the key_packages edge name in mutations.
+	EdgeKeyPackages = "key_packages"
+	// EdgeMlsWelcomes holds the string denoting the mls_welcomes edge name in mutations.
+	EdgeMlsWelcomes = "mls_welcomes"
+	// EdgeSenderKeyDistributions holds the string denoting the sender_key_distributions edge name in mutations.
+	EdgeSenderKeyDistributions = "sender_key_distributions"
+	// Table holds the table name of the device in the database.
+	Table = "devices"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "devices"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "device_user"
+	// IdentityKeyTable is the table that holds the identity_key relation/edge.
+	IdentityKeyTable = "devices"
+	// IdentityKeyInverseTable is the table name for the IdentityKey entity.
+	// It exists in this package in order to avoid circular dependency with the "identitykey" package.
+	IdentityKeyInverseTable = "identity_keys"
+	// IdentityKeyColumn is the table column denoting the identity_key relation/edge.
+	IdentityKeyColumn = "identity_key_device"
+	// IdentityKeyHistoryTable is the table that holds the identity_key_history relation/edge.
+	IdentityKeyHistoryTable = "identity_key_records"
+	// IdentityKeyHistoryInverseTable is the table name for the IdentityKeyRecord entity.
+	// It exists in this package in order to avoid circular dependency with the "identitykeyrecord" package.
+	IdentityKeyHistoryInverseTable = "identity_key_records"
+	// IdentityKeyHistoryColumn is the table column denoting the identity_key_history relation/edge.
+	IdentityKeyHistoryColumn = "identity_key_record_device"
+	// SignedPreKeysTable is the table that holds the signed_pre_keys relation/edge.
+	SignedPreKeysTable = "signed_pre_keys"
+	// SignedPreKeysInverseTable is the table name for the SignedPreKey entity.
+	// It exists in this package in order to avoid circular dependency with the "signedprekey" package.
+	SignedPreKeysInverseTable = "signed_pre_keys"
+	// SignedPreKeysColumn is the table column denoting the signed_pre_keys relation/edge.
+	SignedPreKeysColumn = "signed_pre_key_device"
+	// OneTimePreKeysTable is the table that holds the one_time_pre_keys relation/edge.
+	OneTimePreKeysTable = "one_time_pre_keys"
+	// OneTimePreKeysInverseTable is the table name for the OneTimePreKey entity.
+	// It exists in this package in order to avoid circular dependency with the "onetimeprekey" package.
+	OneTimePreKeysInverseTable = "one_time_pre_keys"
+	// OneTimePreKeysColumn is the table column denoting the one_time_pre_keys relation/edge.
+	OneTimePreKeysColumn = "one_time_pre_key_device"
+	// EnvelopesTable is the table that holds the envelopes relation/edge.
+	EnvelopesTable = "message_envelopes"
+	// EnvelopesInverseTable is the table name for the MessageEnvelope entity.
+	// It exists in this package in order to avoid circular dependency with the "messageenvelope" package.
+	EnvelopesInverseTable = "message_envelopes"
+	// EnvelopesColumn is the table column denoting the envelopes relation/edge.
+	EnvelopesColumn = "message_envelope_device"
+	// KeyPackagesTable is the table that holds the key_packages relation/edge.
+	KeyPackagesTable = "key_packages"
+	// KeyPackagesInverseTable is the table name for the KeyPackage entity.
+	// It exists in this package in order to avoid circular dependency with the "keypackage" package.
+	KeyPackagesInverseTable = "key_packages"
+	// KeyPackagesColumn is the table column denoting the key_packages relation/edge.
+	KeyPackagesColumn = "key_package_device"
+	// MlsWelcomesTable is the table that holds the mls_welcomes relation/edge.
+	MlsWelcomesTable = "mls_welcomes"
+	// MlsWelcomesInverseTable is the table name for the MlsWelcome entity.
+	// It exists in this package in order to avoid circular dependency with the "mlswelcome" package.
+	MlsWelcomesInverseTable = "mls_welcomes"
+	// MlsWelcomesColumn is the table column denoting the mls_welcomes relation/edge.
+	MlsWelcomesColumn = "mls_welcome_device"
+	// SenderKeyDistributionsTable is the table that holds the sender_key_distributions relation/edge.
+	SenderKeyDistributionsTable = "sender_key_distributions"
+	// SenderKeyDistributionsInverseTable is the table name for the SenderKeyDistribution entity.
+	// It exists in this package in order to avoid circular dependency with the "senderkeydistribution" package.
+	SenderKeyDistributionsInverseTable = "sender_key_distributions"
+	// SenderKeyDistributionsColumn is the table column denoting the sender_key_distributions relation/edge.
+	SenderKeyDistributionsColumn = "sender_key_distribution_device"
+)
+
+// Columns holds all SQL columns for device fields.
+var Columns = []string{
+	FieldID,
+	FieldDeviceID,
+	FieldRegistrationID,
+	FieldName,
+	FieldLowPrekeysNotifiedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "devices"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"device_user",
+	"identity_key_device",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	DeviceIDValidator func(int) error
+	// RegistrationIDValidator is a validator for the "registration_id" field. It is called by the builders before save.
+	RegistrationIDValidator func(int) error
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Device queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDeviceID orders the results by the device_id field.
+func ByDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceID, opts...).ToFunc()
+}
+
+// ByRegistrationID orders the results by the registration_id field.
+func ByRegistrationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegistrationID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByLowPrekeysNotifiedAt orders the results by the low_prekeys_notified_at field.
+func ByLowPrekeysNotifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLowPrekeysNotifiedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByIdentityKeyField orders the results by identity_key field.
+func ByIdentityKeyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentityKeyStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByIdentityKeyHistoryCount orders the results by identity_key_history count.
+func ByIdentityKeyHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdentityKeyHistoryStep(), opts...)
+	}
+}
+
+// ByIdentityKeyHistory orders the results by identity_key_history terms.
+func ByIdentityKeyHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentityKeyHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySignedPreKeysCount orders the results by signed_pre_keys count.
+func BySignedPreKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSignedPreKeysStep(), opts...)
+	}
+}
+
+// BySignedPreKeys orders the results by signed_pre_keys terms.
+func BySignedPreKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSignedPreKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOneTimePreKeysCount orders the results by one_time_pre_keys count.
+func ByOneTimePreKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOneTimePreKeysStep(), opts...)
+	}
+}
+
+// ByOneTimePreKeys orders the results by one_time_pre_keys terms.
+func ByOneTimePreKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOneTimePreKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEnvelopesCount orders the results by envelopes count.
+func ByEnvelopesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEnvelopesStep(), opts...)
+	}
+}
+
+// ByEnvelopes orders the results by envelopes terms.
+func ByEnvelopes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnvelopesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByKeyPackagesCount orders the results by key_packages count.
+func ByKeyPackagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newKeyPackagesStep(), opts...)
+	}
+}
+
+// ByKeyPackages orders the results by key_packages terms.
+func ByKeyPackages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKeyPackagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMlsWelcomesCount orders the results by mls_welcomes count.
+func ByMlsWelcomesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMlsWelcomesStep(), opts...)
+	}
+}
+
+// ByMlsWelcomes orders the results by mls_welcomes terms.
+func ByMlsWelcomes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMlsWelcomesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySenderKeyDistributionsCount orders the results by sender_key_distributions count.
+func BySenderKeyDistributionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSenderKeyDistributionsStep(), opts...)
+	}
+}
+
+// BySenderKeyDistributions orders the results by sender_key_distributions terms.
+func BySenderKeyDistributions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSenderKeyDistributionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+	)
+}
+func newIdentityKeyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentityKeyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, IdentityKeyTable, IdentityKeyColumn),
+	)
+}
+func newIdentityKeyHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentityKeyHistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, IdentityKeyHistoryTable, IdentityKeyHistoryColumn),
+	)
+}
+func newSignedPreKeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SignedPreKeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SignedPreKeysTable, SignedPreKeysColumn),
+	)
+}
+func newOneTimePreKeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OneTimePreKeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, OneTimePreKeysTable, OneTimePreKeysColumn),
+	)
+}
+func newEnvelopesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnvelopesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, EnvelopesTable, EnvelopesColumn),
+	)
+}
+func newKeyPackagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KeyPackagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, KeyPackagesTable, KeyPackagesColumn),
+	)
+}
+func newMlsWelcomesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MlsWelcomesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MlsWelcomesTable, MlsWelcomesColumn),
+	)
+}
+func newSenderKeyDistributionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SenderKeyDistributionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SenderKeyDistributionsTable, SenderKeyDistributionsColumn),
+	)
+}
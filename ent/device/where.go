@@ -0,0 +1,583 @@
+// Code generated by ent, DO NOT EDIT.
+
+package device
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldID, id))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// RegistrationID applies equality check predicate on the "registration_id" field. It's identical to RegistrationIDEQ.
+func RegistrationID(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldRegistrationID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldName, v))
+}
+
+// LowPrekeysNotifiedAt applies equality check predicate on the "low_prekeys_notified_at" field. It's identical to LowPrekeysNotifiedAtEQ.
+func LowPrekeysNotifiedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLowPrekeysNotifiedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v int) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v int) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v int) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v int) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// RegistrationIDEQ applies the EQ predicate on the "registration_id" field.
+func RegistrationIDEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldRegistrationID, v))
+}
+
+// RegistrationIDNEQ applies the NEQ predicate on the "registration_id" field.
+func RegistrationIDNEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldRegistrationID, v))
+}
+
+// RegistrationIDIn applies the In predicate on the "registration_id" field.
+func RegistrationIDIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldRegistrationID, vs...))
+}
+
+// RegistrationIDNotIn applies the NotIn predicate on the "registration_id" field.
+func RegistrationIDNotIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldRegistrationID, vs...))
+}
+
+// RegistrationIDGT applies the GT predicate on the "registration_id" field.
+func RegistrationIDGT(v int) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldRegistrationID, v))
+}
+
+// RegistrationIDGTE applies the GTE predicate on the "registration_id" field.
+func RegistrationIDGTE(v int) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldRegistrationID, v))
+}
+
+// RegistrationIDLT applies the LT predicate on the "registration_id" field.
+func RegistrationIDLT(v int) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldRegistrationID, v))
+}
+
+// RegistrationIDLTE applies the LTE predicate on the "registration_id" field.
+func RegistrationIDLTE(v int) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldRegistrationID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldName, v))
+}
+
+// LowPrekeysNotifiedAtEQ applies the EQ predicate on the "low_prekeys_notified_at" field.
+func LowPrekeysNotifiedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLowPrekeysNotifiedAt, v))
+}
+
+// LowPrekeysNotifiedAtNEQ applies the NEQ predicate on the "low_prekeys_notified_at" field.
+func LowPrekeysNotifiedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLowPrekeysNotifiedAt, v))
+}
+
+// LowPrekeysNotifiedAtIn applies the In predicate on the "low_prekeys_notified_at" field.
+func LowPrekeysNotifiedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLowPrekeysNotifiedAt, vs...))
+}
+
+// LowPrekeysNotifiedAtNotIn applies the NotIn predicate on the "low_prekeys_notified_at" field.
+func LowPrekeysNotifiedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLowPrekeysNotifiedAt, vs...))
+}
+
+// LowPrekeysNotifiedAtGT applies the GT predicate on the "low_prekeys_notified_at" field.
+func LowPrekeysNotifiedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLowPrekeysNotifiedAt, v))
+}
+
+// LowPrekeysNotifiedAtGTE applies the GTE predicate on the "low_prekeys_notified_at" field.
+func LowPrekeysNotifiedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLowPrekeysNotifiedAt, v))
+}
+
+// LowPrekeysNotifiedAtLT applies the LT predicate on the "low_prekeys_notified_at" field.
+func LowPrekeysNotifiedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLowPrekeysNotifiedAt, v))
+}
+
+// LowPrekeysNotifiedAtLTE applies the LTE predicate on the "low_prekeys_notified_at" field.
+func LowPrekeysNotifiedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLowPrekeysNotifiedAt, v))
+}
+
+// LowPrekeysNotifiedAtIsNil applies the IsNil predicate on the "low_prekeys_notified_at" field.
+func LowPrekeysNotifiedAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLowPrekeysNotifiedAt))
+}
+
+// LowPrekeysNotifiedAtNotNil applies the NotNil predicate on the "low_prekeys_notified_at" field.
+func LowPrekeysNotifiedAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLowPrekeysNotifiedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIdentityKey applies the HasEdge predicate on the "identity_key" edge.
+func HasIdentityKey() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, IdentityKeyTable, IdentityKeyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdentityKeyWith applies the HasEdge predicate on the "identity_key" edge with a given conditions (other predicates).
+func HasIdentityKeyWith(preds ...predicate.IdentityKey) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newIdentityKeyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIdentityKeyHistory applies the HasEdge predicate on the "identity_key_history" edge.
+func HasIdentityKeyHistory() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, IdentityKeyHistoryTable, IdentityKeyHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdentityKeyHistoryWith applies the HasEdge predicate on the "identity_key_history" edge with a given conditions (other predicates).
+func HasIdentityKeyHistoryWith(preds ...predicate.IdentityKeyRecord) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newIdentityKeyHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSignedPreKeys applies the HasEdge predicate on the "signed_pre_keys" edge.
+func HasSignedPreKeys() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SignedPreKeysTable, SignedPreKeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSignedPreKeysWith applies the HasEdge predicate on the "signed_pre_keys" edge with a given conditions (other predicates).
+func HasSignedPreKeysWith(preds ...predicate.SignedPreKey) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newSignedPreKeysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOneTimePreKeys applies the HasEdge predicate on the "one_time_pre_keys" edge.
+func HasOneTimePreKeys() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, OneTimePreKeysTable, OneTimePreKeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOneTimePreKeysWith applies the HasEdge predicate on the "one_time_pre_keys" edge with a given conditions (other predicates).
+func HasOneTimePreKeysWith(preds ...predicate.OneTimePreKey) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newOneTimePreKeysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnvelopes applies the HasEdge predicate on the "envelopes" edge.
+func HasEnvelopes() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, EnvelopesTable, EnvelopesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvelopesWith applies the HasEdge predicate on the "envelopes" edge with a given conditions (other predicates).
+func HasEnvelopesWith(preds ...predicate.MessageEnvelope) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newEnvelopesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasKeyPackages applies the HasEdge predicate on the "key_packages" edge.
+func HasKeyPackages() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, KeyPackagesTable, KeyPackagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKeyPackagesWith applies the HasEdge predicate on the "key_packages" edge with a given conditions (other predicates).
+func HasKeyPackagesWith(preds ...predicate.KeyPackage) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newKeyPackagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMlsWelcomes applies the HasEdge predicate on the "mls_welcomes" edge.
+func HasMlsWelcomes() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MlsWelcomesTable, MlsWelcomesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMlsWelcomesWith applies the HasEdge predicate on the "mls_welcomes" edge with a given conditions (other predicates).
+func HasMlsWelcomesWith(preds ...predicate.MlsWelcome) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newMlsWelcomesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSenderKeyDistributions applies the HasEdge predicate on the "sender_key_distributions" edge.
+func HasSenderKeyDistributions() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SenderKeyDistributionsTable, SenderKeyDistributionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderKeyDistributionsWith applies the HasEdge predicate on the "sender_key_distributions" edge with a given conditions (other predicates).
+func HasSenderKeyDistributionsWith(preds ...predicate.SenderKeyDistribution) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newSenderKeyDistributionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Device) predicate.Device {
+	return predicate.Device(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Device) predicate.Device {
+	return predicate.Device(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Device) predicate.Device {
+	return predicate.Device(sql.NotPredicates(p))
+}
@@ -0,0 +1,599 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykey"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// DeviceCreate is the builder for creating a Device entity.
+type DeviceCreate struct {
+	config
+	mutation *DeviceMutation
+	hooks    []Hook
+}
+
+// SetDeviceID sets the "device_id" field.
+func (dc *DeviceCreate) SetDeviceID(i int) *DeviceCreate {
+	dc.mutation.SetDeviceID(i)
+	return dc
+}
+
+// SetRegistrationID sets the "registration_id" field.
+func (dc *DeviceCreate) SetRegistrationID(i int) *DeviceCreate {
+	dc.mutation.SetRegistrationID(i)
+	return dc
+}
+
+// SetName sets the "name" field.
+func (dc *DeviceCreate) SetName(s string) *DeviceCreate {
+	dc.mutation.SetName(s)
+	return dc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableName(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetName(*s)
+	}
+	return dc
+}
+
+// SetLowPrekeysNotifiedAt sets the "low_prekeys_notified_at" field.
+func (dc *DeviceCreate) SetLowPrekeysNotifiedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetLowPrekeysNotifiedAt(t)
+	return dc
+}
+
+// SetNillableLowPrekeysNotifiedAt sets the "low_prekeys_notified_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableLowPrekeysNotifiedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetLowPrekeysNotifiedAt(*t)
+	}
+	return dc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DeviceCreate) SetCreatedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableCreatedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dc *DeviceCreate) SetUpdatedAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetUpdatedAt(t)
+	return dc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableUpdatedAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetUpdatedAt(*t)
+	}
+	return dc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (dc *DeviceCreate) SetUserID(id int) *DeviceCreate {
+	dc.mutation.SetUserID(id)
+	return dc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dc *DeviceCreate) SetUser(u *User) *DeviceCreate {
+	return dc.SetUserID(u.ID)
+}
+
+// SetIdentityKeyID sets the "identity_key" edge to the IdentityKey entity by ID.
+func (dc *DeviceCreate) SetIdentityKeyID(id int) *DeviceCreate {
+	dc.mutation.SetIdentityKeyID(id)
+	return dc
+}
+
+// SetNillableIdentityKeyID sets the "identity_key" edge to the IdentityKey entity by ID if the given value is not nil.
+func (dc *DeviceCreate) SetNillableIdentityKeyID(id *int) *DeviceCreate {
+	if id != nil {
+		dc = dc.SetIdentityKeyID(*id)
+	}
+	return dc
+}
+
+// SetIdentityKey sets the "identity_key" edge to the IdentityKey entity.
+func (dc *DeviceCreate) SetIdentityKey(i *IdentityKey) *DeviceCreate {
+	return dc.SetIdentityKeyID(i.ID)
+}
+
+// AddIdentityKeyHistoryIDs adds the "identity_key_history" edge to the IdentityKeyRecord entity by IDs.
+func (dc *DeviceCreate) AddIdentityKeyHistoryIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddIdentityKeyHistoryIDs(ids...)
+	return dc
+}
+
+// AddIdentityKeyHistory adds the "identity_key_history" edges to the IdentityKeyRecord entity.
+func (dc *DeviceCreate) AddIdentityKeyHistory(i ...*IdentityKeyRecord) *DeviceCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return dc.AddIdentityKeyHistoryIDs(ids...)
+}
+
+// AddSignedPreKeyIDs adds the "signed_pre_keys" edge to the SignedPreKey entity by IDs.
+func (dc *DeviceCreate) AddSignedPreKeyIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddSignedPreKeyIDs(ids...)
+	return dc
+}
+
+// AddSignedPreKeys adds the "signed_pre_keys" edges to the SignedPreKey entity.
+func (dc *DeviceCreate) AddSignedPreKeys(s ...*SignedPreKey) *DeviceCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return dc.AddSignedPreKeyIDs(ids...)
+}
+
+// AddOneTimePreKeyIDs adds the "one_time_pre_keys" edge to the OneTimePreKey entity by IDs.
+func (dc *DeviceCreate) AddOneTimePreKeyIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddOneTimePreKeyIDs(ids...)
+	return dc
+}
+
+// AddOneTimePreKeys adds the "one_time_pre_keys" edges to the OneTimePreKey entity.
+func (dc *DeviceCreate) AddOneTimePreKeys(o ...*OneTimePreKey) *DeviceCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return dc.AddOneTimePreKeyIDs(ids...)
+}
+
+// AddEnvelopeIDs adds the "envelopes" edge to the MessageEnvelope entity by IDs.
+func (dc *DeviceCreate) AddEnvelopeIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddEnvelopeIDs(ids...)
+	return dc
+}
+
+// AddEnvelopes adds the "envelopes" edges to the MessageEnvelope entity.
+func (dc *DeviceCreate) AddEnvelopes(m ...*MessageEnvelope) *DeviceCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return dc.AddEnvelopeIDs(ids...)
+}
+
+// AddKeyPackageIDs adds the "key_packages" edge to the KeyPackage entity by IDs.
+func (dc *DeviceCreate) AddKeyPackageIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddKeyPackageIDs(ids...)
+	return dc
+}
+
+// AddKeyPackages adds the "key_packages" edges to the KeyPackage entity.
+func (dc *DeviceCreate) AddKeyPackages(k ...*KeyPackage) *DeviceCreate {
+	ids := make([]int, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return dc.AddKeyPackageIDs(ids...)
+}
+
+// AddMlsWelcomeIDs adds the "mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (dc *DeviceCreate) AddMlsWelcomeIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddMlsWelcomeIDs(ids...)
+	return dc
+}
+
+// AddMlsWelcomes adds the "mls_welcomes" edges to the MlsWelcome entity.
+func (dc *DeviceCreate) AddMlsWelcomes(m ...*MlsWelcome) *DeviceCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return dc.AddMlsWelcomeIDs(ids...)
+}
+
+// AddSenderKeyDistributionIDs adds the "sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (dc *DeviceCreate) AddSenderKeyDistributionIDs(ids ...int) *DeviceCreate {
+	dc.mutation.AddSenderKeyDistributionIDs(ids...)
+	return dc
+}
+
+// AddSenderKeyDistributions adds the "sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (dc *DeviceCreate) AddSenderKeyDistributions(s ...*SenderKeyDistribution) *DeviceCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return dc.AddSenderKeyDistributionIDs(ids...)
+}
+
+// Mutation returns the DeviceMutation object of the builder.
+func (dc *DeviceCreate) Mutation() *DeviceMutation {
+	return dc.mutation
+}
+
+// Save creates the Device in the database.
+func (dc *DeviceCreate) Save(ctx context.Context) (*Device, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DeviceCreate) SaveX(ctx context.Context) *Device {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DeviceCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DeviceCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DeviceCreate) defaults() {
+	if _, ok := dc.mutation.Name(); !ok {
+		v := device.DefaultName
+		dc.mutation.SetName(v)
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := device.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		v := device.DefaultUpdatedAt()
+		dc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DeviceCreate) check() error {
+	if _, ok := dc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "Device.device_id"`)}
+	}
+	if v, ok := dc.mutation.DeviceID(); ok {
+		if err := device.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "Device.device_id": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.RegistrationID(); !ok {
+		return &ValidationError{Name: "registration_id", err: errors.New(`ent: missing required field "Device.registration_id"`)}
+	}
+	if v, ok := dc.mutation.RegistrationID(); ok {
+		if err := device.RegistrationIDValidator(v); err != nil {
+			return &ValidationError{Name: "registration_id", err: fmt.Errorf(`ent: validator failed for field "Device.registration_id": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Device.name"`)}
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Device.created_at"`)}
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Device.updated_at"`)}
+	}
+	if _, ok := dc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Device.user"`)}
+	}
+	return nil
+}
+
+func (dc *DeviceCreate) sqlSave(ctx context.Context) (*Device, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Device{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(device.Table, sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt))
+	)
+	if value, ok := dc.mutation.DeviceID(); ok {
+		_spec.SetField(device.FieldDeviceID, field.TypeInt, value)
+		_node.DeviceID = value
+	}
+	if value, ok := dc.mutation.RegistrationID(); ok {
+		_spec.SetField(device.FieldRegistrationID, field.TypeInt, value)
+		_node.RegistrationID = value
+	}
+	if value, ok := dc.mutation.Name(); ok {
+		_spec.SetField(device.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := dc.mutation.LowPrekeysNotifiedAt(); ok {
+		_spec.SetField(device.FieldLowPrekeysNotifiedAt, field.TypeTime, value)
+		_node.LowPrekeysNotifiedAt = &value
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.SetField(device.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dc.mutation.UpdatedAt(); ok {
+		_spec.SetField(device.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := dc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   device.UserTable,
+			Columns: []string{device.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.device_user = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.IdentityKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   device.IdentityKeyTable,
+			Columns: []string{device.IdentityKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.identity_key_device = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.IdentityKeyHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.IdentityKeyHistoryTable,
+			Columns: []string{device.IdentityKeyHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.SignedPreKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SignedPreKeysTable,
+			Columns: []string{device.SignedPreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.OneTimePreKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.OneTimePreKeysTable,
+			Columns: []string{device.OneTimePreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.EnvelopesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.EnvelopesTable,
+			Columns: []string{device.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.KeyPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.KeyPackagesTable,
+			Columns: []string{device.KeyPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.MlsWelcomesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.MlsWelcomesTable,
+			Columns: []string{device.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.SenderKeyDistributionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SenderKeyDistributionsTable,
+			Columns: []string{device.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DeviceCreateBulk is the builder for creating many Device entities in bulk.
+type DeviceCreateBulk struct {
+	config
+	err      error
+	builders []*DeviceCreate
+}
+
+// Save creates the Device entities in the database.
+func (dcb *DeviceCreateBulk) Save(ctx context.Context) ([]*Device, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Device, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DeviceCreateBulk) SaveX(ctx context.Context) []*Device {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DeviceCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DeviceCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykey"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// DeviceQuery is the builder for querying Device entities.
+type DeviceQuery struct {
+	config
+	ctx                        *QueryContext
+	order                      []device.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.Device
+	withUser                   *UserQuery
+	withIdentityKey            *IdentityKeyQuery
+	withIdentityKeyHistory     *IdentityKeyRecordQuery
+	withSignedPreKeys          *SignedPreKeyQuery
+	withOneTimePreKeys         *OneTimePreKeyQuery
+	withEnvelopes              *MessageEnvelopeQuery
+	withKeyPackages            *KeyPackageQuery
+	withMlsWelcomes            *MlsWelcomeQuery
+	withSenderKeyDistributions *SenderKeyDistributionQuery
+	withFKs                    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DeviceQuery builder.
+func (dq *DeviceQuery) Where(ps ...predicate.Device) *DeviceQuery {
+	dq.predicates = append(dq.predicates, ps...)
+	return dq
+}
+
+// Limit the number of records to be returned by this query.
+func (dq *DeviceQuery) Limit(limit int) *DeviceQuery {
+	dq.ctx.Limit = &limit
+	return dq
+}
+
+// Offset to start from.
+func (dq *DeviceQuery) Offset(offset int) *DeviceQuery {
+	dq.ctx.Offset = &offset
+	return dq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dq *DeviceQuery) Unique(unique bool) *DeviceQuery {
+	dq.ctx.Unique = &unique
+	return dq
+}
+
+// Order specifies how the records should be ordered.
+func (dq *DeviceQuery) Order(o ...device.OrderOption) *DeviceQuery {
+	dq.order = append(dq.order, o...)
+	return dq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (dq *DeviceQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, device.UserTable, device.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIdentityKey chains the current query on the "identity_key" edge.
+func (dq *DeviceQuery) QueryIdentityKey() *IdentityKeyQuery {
+	query := (&IdentityKeyClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(identitykey.Table, identitykey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, device.IdentityKeyTable, device.IdentityKeyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIdentityKeyHistory chains the current query on the "identity_key_history" edge.
+func (dq *DeviceQuery) QueryIdentityKeyHistory() *IdentityKeyRecordQuery {
+	query := (&IdentityKeyRecordClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(identitykeyrecord.Table, identitykeyrecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.IdentityKeyHistoryTable, device.IdentityKeyHistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySignedPreKeys chains the current query on the "signed_pre_keys" edge.
+func (dq *DeviceQuery) QuerySignedPreKeys() *SignedPreKeyQuery {
+	query := (&SignedPreKeyClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(signedprekey.Table, signedprekey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.SignedPreKeysTable, device.SignedPreKeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOneTimePreKeys chains the current query on the "one_time_pre_keys" edge.
+func (dq *DeviceQuery) QueryOneTimePreKeys() *OneTimePreKeyQuery {
+	query := (&OneTimePreKeyClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(onetimeprekey.Table, onetimeprekey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.OneTimePreKeysTable, device.OneTimePreKeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnvelopes chains the current query on the "envelopes" edge.
+func (dq *DeviceQuery) QueryEnvelopes() *MessageEnvelopeQuery {
+	query := (&MessageEnvelopeClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(messageenvelope.Table, messageenvelope.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.EnvelopesTable, device.EnvelopesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryKeyPackages chains the current query on the "key_packages" edge.
+func (dq *DeviceQuery) QueryKeyPackages() *KeyPackageQuery {
+	query := (&KeyPackageClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(keypackage.Table, keypackage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.KeyPackagesTable, device.KeyPackagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMlsWelcomes chains the current query on the "mls_welcomes" edge.
+func (dq *DeviceQuery) QueryMlsWelcomes() *MlsWelcomeQuery {
+	query := (&MlsWelcomeClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(mlswelcome.Table, mlswelcome.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.MlsWelcomesTable, device.MlsWelcomesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySenderKeyDistributions chains the current query on the "sender_key_distributions" edge.
+func (dq *DeviceQuery) QuerySenderKeyDistributions() *SenderKeyDistributionQuery {
+	query := (&SenderKeyDistributionClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, selector),
+			sqlgraph.To(senderkeydistribution.Table, senderkeydistribution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, device.SenderKeyDistributionsTable, device.SenderKeyDistributionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Device entity from the query.
+// Returns a *NotFoundError when no Device was found.
+func (dq *DeviceQuery) First(ctx context.Context) (*Device, error) {
+	nodes, err := dq.Limit(1).All(setContextOp(ctx, dq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{device.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dq *DeviceQuery) FirstX(ctx context.Context) *Device {
+	node, err := dq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Device ID from the query.
+// Returns a *NotFoundError when no Device ID was found.
+func (dq *DeviceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dq.Limit(1).IDs(setContextOp(ctx, dq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{device.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dq *DeviceQuery) FirstIDX(ctx context.Context) int {
+	id, err := dq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Device entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Device entity is found.
+// Returns a *NotFoundError when no Device entities are found.
+func (dq *DeviceQuery) Only(ctx context.Context) (*Device, error) {
+	nodes, err := dq.Limit(2).All(setContextOp(ctx, dq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{device.Label}
+	default:
+		return nil, &NotSingularError{device.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dq *DeviceQuery) OnlyX(ctx context.Context) *Device {
+	node, err := dq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Device ID in the query.
+// Returns a *NotSingularError when more than one Device ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dq *DeviceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dq.Limit(2).IDs(setContextOp(ctx, dq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{device.Label}
+	default:
+		err = &NotSingularError{device.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dq *DeviceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := dq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Devices.
+func (dq *DeviceQuery) All(ctx context.Context) ([]*Device, error) {
+	ctx = setContextOp(ctx, dq.ctx, "All")
+	if err := dq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Device, *DeviceQuery]()
+	return withInterceptors[[]*Device](ctx, dq, qr, dq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dq *DeviceQuery) AllX(ctx context.Context) []*Device {
+	nodes, err := dq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Device IDs.
+func (dq *DeviceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if dq.ctx.Unique == nil && dq.path != nil {
+		dq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dq.ctx, "IDs")
+	if err = dq.Select(device.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dq *DeviceQuery) IDsX(ctx context.Context) []int {
+	ids, err := dq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dq *DeviceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dq.ctx, "Count")
+	if err := dq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dq, querierCount[*DeviceQuery](), dq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dq *DeviceQuery) CountX(ctx context.Context) int {
+	count, err := dq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dq *DeviceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dq.ctx, "Exist")
+	switch _, err := dq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dq *DeviceQuery) ExistX(ctx context.Context) bool {
+	exist, err := dq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DeviceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dq *DeviceQuery) Clone() *DeviceQuery {
+	if dq == nil {
+		return nil
+	}
+	return &DeviceQuery{
+		config:                     dq.config,
+		ctx:                        dq.ctx.Clone(),
+		order:                      append([]device.OrderOption{}, dq.order...),
+		inters:                     append([]Interceptor{}, dq.inters...),
+		predicates:                 append([]predicate.Device{}, dq.predicates...),
+		withUser:                   dq.withUser.Clone(),
+		withIdentityKey:            dq.withIdentityKey.Clone(),
+		withIdentityKeyHistory:     dq.withIdentityKeyHistory.Clone(),
+		withSignedPreKeys:          dq.withSignedPreKeys.Clone(),
+		withOneTimePreKeys:         dq.withOneTimePreKeys.Clone(),
+		withEnvelopes:              dq.withEnvelopes.Clone(),
+		withKeyPackages:            dq.withKeyPackages.Clone(),
+		withMlsWelcomes:            dq.withMlsWelcomes.Clone(),
+		withSenderKeyDistributions: dq.withSenderKeyDistributions.Clone(),
+		// clone intermediate query.
+		sql:  dq.sql.Clone(),
+		path: dq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithUser(opts ...func(*UserQuery)) *DeviceQuery {
+	query := (&UserClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withUser = query
+	return dq
+}
+
+// WithIdentityKey tells the query-builder to eager-load the nodes that are connected to
+// the "identity_key" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithIdentityKey(opts ...func(*IdentityKeyQuery)) *DeviceQuery {
+	query := (&IdentityKeyClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withIdentityKey = query
+	return dq
+}
+
+// WithIdentityKeyHistory tells the query-builder to eager-load the nodes that are connected to
+// the "identity_key_history" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithIdentityKeyHistory(opts ...func(*IdentityKeyRecordQuery)) *DeviceQuery {
+	query := (&IdentityKeyRecordClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withIdentityKeyHistory = query
+	return dq
+}
+
+// WithSignedPreKeys tells the query-builder to eager-load the nodes that are connected to
+// the "signed_pre_keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithSignedPreKeys(opts ...func(*SignedPreKeyQuery)) *DeviceQuery {
+	query := (&SignedPreKeyClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withSignedPreKeys = query
+	return dq
+}
+
+// WithOneTimePreKeys tells the query-builder to eager-load the nodes that are connected to
+// the "one_time_pre_keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithOneTimePreKeys(opts ...func(*OneTimePreKeyQuery)) *DeviceQuery {
+	query := (&OneTimePreKeyClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withOneTimePreKeys = query
+	return dq
+}
+
+// WithEnvelopes tells the query-builder to eager-load the nodes that are connected to
+// the "envelopes" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithEnvelopes(opts ...func(*MessageEnvelopeQuery)) *DeviceQuery {
+	query := (&MessageEnvelopeClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withEnvelopes = query
+	return dq
+}
+
+// WithKeyPackages tells the query-builder to eager-load the nodes that are connected to
+// the "key_packages" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithKeyPackages(opts ...func(*KeyPackageQuery)) *DeviceQuery {
+	query := (&KeyPackageClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withKeyPackages = query
+	return dq
+}
+
+// WithMlsWelcomes tells the query-builder to eager-load the nodes that are connected to
+// the "mls_welcomes" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithMlsWelcomes(opts ...func(*MlsWelcomeQuery)) *DeviceQuery {
+	query := (&MlsWelcomeClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withMlsWelcomes = query
+	return dq
+}
+
+// WithSenderKeyDistributions tells the query-builder to eager-load the nodes that are connected to
+// the "sender_key_distributions" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DeviceQuery) WithSenderKeyDistributions(opts ...func(*SenderKeyDistributionQuery)) *DeviceQuery {
+	query := (&SenderKeyDistributionClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withSenderKeyDistributions = query
+	return dq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		DeviceID int `json:"device_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Device.Query().
+//		GroupBy(device.FieldDeviceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dq *DeviceQuery) GroupBy(field string, fields ...string) *DeviceGroupBy {
+	dq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DeviceGroupBy{build: dq}
+	grbuild.flds = &dq.ctx.Fields
+	grbuild.label = device.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		DeviceID int `json:"device_id,omitempty"`
+//	}
+//
+//	client.Device.Query().
+//		Select(device.FieldDeviceID).
+//		Scan(ctx, &v)
+func (dq *DeviceQuery) Select(fields ...string) *DeviceSelect {
+	dq.ctx.Fields = append(dq.ctx.Fields, fields...)
+	sbuild := &DeviceSelect{DeviceQuery: dq}
+	sbuild.label = device.Label
+	sbuild.flds, sbuild.scan = &dq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DeviceSelect configured with the given aggregations.
+func (dq *DeviceQuery) Aggregate(fns ...AggregateFunc) *DeviceSelect {
+	return dq.Select().Aggregate(fns...)
+}
+
+func (dq *DeviceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dq.ctx.Fields {
+		if !device.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dq.path != nil {
+		prev, err := dq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dq.sql = prev
+	}
+	return nil
+}
+
+func (dq *DeviceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Device, error) {
+	var (
+		nodes       = []*Device{}
+		withFKs     = dq.withFKs
+		_spec       = dq.querySpec()
+		loadedTypes = [9]bool{
+			dq.withUser != nil,
+			dq.withIdentityKey != nil,
+			dq.withIdentityKeyHistory != nil,
+			dq.withSignedPreKeys != nil,
+			dq.withOneTimePreKeys != nil,
+			dq.withEnvelopes != nil,
+			dq.withKeyPackages != nil,
+			dq.withMlsWelcomes != nil,
+			dq.withSenderKeyDistributions != nil,
+		}
+	)
+	if dq.withUser != nil || dq.withIdentityKey != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, device.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Device).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Device{config: dq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := dq.withUser; query != nil {
+		if err := dq.loadUser(ctx, query, nodes, nil,
+			func(n *Device, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withIdentityKey; query != nil {
+		if err := dq.loadIdentityKey(ctx, query, nodes, nil,
+			func(n *Device, e *IdentityKey) { n.Edges.IdentityKey = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withIdentityKeyHistory; query != nil {
+		if err := dq.loadIdentityKeyHistory(ctx, query, nodes,
+			func(n *Device) { n.Edges.IdentityKeyHistory = []*IdentityKeyRecord{} },
+			func(n *Device, e *IdentityKeyRecord) {
+				n.Edges.IdentityKeyHistory = append(n.Edges.IdentityKeyHistory, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withSignedPreKeys; query != nil {
+		if err := dq.loadSignedPreKeys(ctx, query, nodes,
+			func(n *Device) { n.Edges.SignedPreKeys = []*SignedPreKey{} },
+			func(n *Device, e *SignedPreKey) { n.Edges.SignedPreKeys = append(n.Edges.SignedPreKeys, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withOneTimePreKeys; query != nil {
+		if err := dq.loadOneTimePreKeys(ctx, query, nodes,
+			func(n *Device) { n.Edges.OneTimePreKeys = []*OneTimePreKey{} },
+			func(n *Device, e *OneTimePreKey) { n.Edges.OneTimePreKeys = append(n.Edges.OneTimePreKeys, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withEnvelopes; query != nil {
+		if err := dq.loadEnvelopes(ctx, query, nodes,
+			func(n *Device) { n.Edges.Envelopes = []*MessageEnvelope{} },
+			func(n *Device, e *MessageEnvelope) { n.Edges.Envelopes = append(n.Edges.Envelopes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withKeyPackages; query != nil {
+		if err := dq.loadKeyPackages(ctx, query, nodes,
+			func(n *Device) { n.Edges.KeyPackages = []*KeyPackage{} },
+			func(n *Device, e *KeyPackage) { n.Edges.KeyPackages = append(n.Edges.KeyPackages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withMlsWelcomes; query != nil {
+		if err := dq.loadMlsWelcomes(ctx, query, nodes,
+			func(n *Device) { n.Edges.MlsWelcomes = []*MlsWelcome{} },
+			func(n *Device, e *MlsWelcome) { n.Edges.MlsWelcomes = append(n.Edges.MlsWelcomes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dq.withSenderKeyDistributions; query != nil {
+		if err := dq.loadSenderKeyDistributions(ctx, query, nodes,
+			func(n *Device) { n.Edges.SenderKeyDistributions = []*SenderKeyDistribution{} },
+			func(n *Device, e *SenderKeyDistribution) {
+				n.Edges.SenderKeyDistributions = append(n.Edges.SenderKeyDistributions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dq *DeviceQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Device, init func(*Device), assign func(*Device, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Device)
+	for i := range nodes {
+		if nodes[i].device_user == nil {
+			continue
+		}
+		fk := *nodes[i].device_user
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "device_user" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadIdentityKey(ctx context.Context, query *IdentityKeyQuery, nodes []*Device, init func(*Device), assign func(*Device, *IdentityKey)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Device)
+	for i := range nodes {
+		if nodes[i].identity_key_device == nil {
+			continue
+		}
+		fk := *nodes[i].identity_key_device
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(identitykey.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "identity_key_device" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadIdentityKeyHistory(ctx context.Context, query *IdentityKeyRecordQuery, nodes []*Device, init func(*Device), assign func(*Device, *IdentityKeyRecord)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Device)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.IdentityKeyRecord(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(device.IdentityKeyHistoryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.identity_key_record_device
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "identity_key_record_device" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "identity_key_record_device" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadSignedPreKeys(ctx context.Context, query *SignedPreKeyQuery, nodes []*Device, init func(*Device), assign func(*Device, *SignedPreKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Device)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SignedPreKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(device.SignedPreKeysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.signed_pre_key_device
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "signed_pre_key_device" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "signed_pre_key_device" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadOneTimePreKeys(ctx context.Context, query *OneTimePreKeyQuery, nodes []*Device, init func(*Device), assign func(*Device, *OneTimePreKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Device)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.OneTimePreKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(device.OneTimePreKeysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.one_time_pre_key_device
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "one_time_pre_key_device" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "one_time_pre_key_device" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadEnvelopes(ctx context.Context, query *MessageEnvelopeQuery, nodes []*Device, init func(*Device), assign func(*Device, *MessageEnvelope)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Device)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MessageEnvelope(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(device.EnvelopesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.message_envelope_device
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "message_envelope_device" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_envelope_device" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadKeyPackages(ctx context.Context, query *KeyPackageQuery, nodes []*Device, init func(*Device), assign func(*Device, *KeyPackage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Device)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.KeyPackage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(device.KeyPackagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.key_package_device
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "key_package_device" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "key_package_device" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadMlsWelcomes(ctx context.Context, query *MlsWelcomeQuery, nodes []*Device, init func(*Device), assign func(*Device, *MlsWelcome)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Device)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MlsWelcome(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(device.MlsWelcomesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.mls_welcome_device
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "mls_welcome_device" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "mls_welcome_device" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (dq *DeviceQuery) loadSenderKeyDistributions(ctx context.Context, query *SenderKeyDistributionQuery, nodes []*Device, init func(*Device), assign func(*Device, *SenderKeyDistribution)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Device)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SenderKeyDistribution(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(device.SenderKeyDistributionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_key_distribution_device
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_key_distribution_device" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_key_distribution_device" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (dq *DeviceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dq.querySpec()
+	_spec.Node.Columns = dq.ctx.Fields
+	if len(dq.ctx.Fields) > 0 {
+		_spec.Unique = dq.ctx.Unique != nil && *dq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dq.driver, _spec)
+}
+
+func (dq *DeviceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(device.Table, device.Columns, sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt))
+	_spec.From = dq.sql
+	if unique := dq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, device.FieldID)
+		for i := range fields {
+			if fields[i] != device.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dq *DeviceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dq.driver.Dialect())
+	t1 := builder.Table(device.Table)
+	columns := dq.ctx.Fields
+	if len(columns) == 0 {
+		columns = device.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dq.sql != nil {
+		selector = dq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dq.ctx.Unique != nil && *dq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dq.predicates {
+		p(selector)
+	}
+	for _, p := range dq.order {
+		p(selector)
+	}
+	if offset := dq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DeviceGroupBy is the group-by builder for Device entities.
+type DeviceGroupBy struct {
+	selector
+	build *DeviceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dgb *DeviceGroupBy) Aggregate(fns ...AggregateFunc) *DeviceGroupBy {
+	dgb.fns = append(dgb.fns, fns...)
+	return dgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dgb *DeviceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dgb.build.ctx, "GroupBy")
+	if err := dgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeviceQuery, *DeviceGroupBy](ctx, dgb.build, dgb, dgb.build.inters, v)
+}
+
+func (dgb *DeviceGroupBy) sqlScan(ctx context.Context, root *DeviceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dgb.fns))
+	for _, fn := range dgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dgb.flds)+len(dgb.fns))
+		for _, f := range *dgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DeviceSelect is the builder for selecting fields of Device entities.
+type DeviceSelect struct {
+	*DeviceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ds *DeviceSelect) Aggregate(fns ...AggregateFunc) *DeviceSelect {
+	ds.fns = append(ds.fns, fns...)
+	return ds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ds *DeviceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ds.ctx, "Select")
+	if err := ds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeviceQuery, *DeviceSelect](ctx, ds.DeviceQuery, ds, ds.inters, v)
+}
+
+func (ds *DeviceSelect) sqlScan(ctx context.Context, root *DeviceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ds.fns))
+	for _, fn := range ds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
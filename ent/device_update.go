@@ -0,0 +1,1825 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykey"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// DeviceUpdate is the builder for updating Device entities.
+type DeviceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeviceMutation
+}
+
+// Where appends a list predicates to the DeviceUpdate builder.
+func (du *DeviceUpdate) Where(ps ...predicate.Device) *DeviceUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetDeviceID sets the "device_id" field.
+func (du *DeviceUpdate) SetDeviceID(i int) *DeviceUpdate {
+	du.mutation.ResetDeviceID()
+	du.mutation.SetDeviceID(i)
+	return du
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableDeviceID(i *int) *DeviceUpdate {
+	if i != nil {
+		du.SetDeviceID(*i)
+	}
+	return du
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (du *DeviceUpdate) AddDeviceID(i int) *DeviceUpdate {
+	du.mutation.AddDeviceID(i)
+	return du
+}
+
+// SetRegistrationID sets the "registration_id" field.
+func (du *DeviceUpdate) SetRegistrationID(i int) *DeviceUpdate {
+	du.mutation.ResetRegistrationID()
+	du.mutation.SetRegistrationID(i)
+	return du
+}
+
+// SetNillableRegistrationID sets the "registration_id" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableRegistrationID(i *int) *DeviceUpdate {
+	if i != nil {
+		du.SetRegistrationID(*i)
+	}
+	return du
+}
+
+// AddRegistrationID adds i to the "registration_id" field.
+func (du *DeviceUpdate) AddRegistrationID(i int) *DeviceUpdate {
+	du.mutation.AddRegistrationID(i)
+	return du
+}
+
+// SetName sets the "name" field.
+func (du *DeviceUpdate) SetName(s string) *DeviceUpdate {
+	du.mutation.SetName(s)
+	return du
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableName(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetName(*s)
+	}
+	return du
+}
+
+// SetLowPrekeysNotifiedAt sets the "low_prekeys_notified_at" field.
+func (du *DeviceUpdate) SetLowPrekeysNotifiedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetLowPrekeysNotifiedAt(t)
+	return du
+}
+
+// SetNillableLowPrekeysNotifiedAt sets the "low_prekeys_notified_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableLowPrekeysNotifiedAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetLowPrekeysNotifiedAt(*t)
+	}
+	return du
+}
+
+// ClearLowPrekeysNotifiedAt clears the value of the "low_prekeys_notified_at" field.
+func (du *DeviceUpdate) ClearLowPrekeysNotifiedAt() *DeviceUpdate {
+	du.mutation.ClearLowPrekeysNotifiedAt()
+	return du
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (du *DeviceUpdate) SetCreatedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetCreatedAt(t)
+	return du
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableCreatedAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetCreatedAt(*t)
+	}
+	return du
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (du *DeviceUpdate) SetUpdatedAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetUpdatedAt(t)
+	return du
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (du *DeviceUpdate) SetUserID(id int) *DeviceUpdate {
+	du.mutation.SetUserID(id)
+	return du
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (du *DeviceUpdate) SetUser(u *User) *DeviceUpdate {
+	return du.SetUserID(u.ID)
+}
+
+// SetIdentityKeyID sets the "identity_key" edge to the IdentityKey entity by ID.
+func (du *DeviceUpdate) SetIdentityKeyID(id int) *DeviceUpdate {
+	du.mutation.SetIdentityKeyID(id)
+	return du
+}
+
+// SetNillableIdentityKeyID sets the "identity_key" edge to the IdentityKey entity by ID if the given value is not nil.
+func (du *DeviceUpdate) SetNillableIdentityKeyID(id *int) *DeviceUpdate {
+	if id != nil {
+		du = du.SetIdentityKeyID(*id)
+	}
+	return du
+}
+
+// SetIdentityKey sets the "identity_key" edge to the IdentityKey entity.
+func (du *DeviceUpdate) SetIdentityKey(i *IdentityKey) *DeviceUpdate {
+	return du.SetIdentityKeyID(i.ID)
+}
+
+// AddIdentityKeyHistoryIDs adds the "identity_key_history" edge to the IdentityKeyRecord entity by IDs.
+func (du *DeviceUpdate) AddIdentityKeyHistoryIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddIdentityKeyHistoryIDs(ids...)
+	return du
+}
+
+// AddIdentityKeyHistory adds the "identity_key_history" edges to the IdentityKeyRecord entity.
+func (du *DeviceUpdate) AddIdentityKeyHistory(i ...*IdentityKeyRecord) *DeviceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return du.AddIdentityKeyHistoryIDs(ids...)
+}
+
+// AddSignedPreKeyIDs adds the "signed_pre_keys" edge to the SignedPreKey entity by IDs.
+func (du *DeviceUpdate) AddSignedPreKeyIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddSignedPreKeyIDs(ids...)
+	return du
+}
+
+// AddSignedPreKeys adds the "signed_pre_keys" edges to the SignedPreKey entity.
+func (du *DeviceUpdate) AddSignedPreKeys(s ...*SignedPreKey) *DeviceUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return du.AddSignedPreKeyIDs(ids...)
+}
+
+// AddOneTimePreKeyIDs adds the "one_time_pre_keys" edge to the OneTimePreKey entity by IDs.
+func (du *DeviceUpdate) AddOneTimePreKeyIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddOneTimePreKeyIDs(ids...)
+	return du
+}
+
+// AddOneTimePreKeys adds the "one_time_pre_keys" edges to the OneTimePreKey entity.
+func (du *DeviceUpdate) AddOneTimePreKeys(o ...*OneTimePreKey) *DeviceUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return du.AddOneTimePreKeyIDs(ids...)
+}
+
+// AddEnvelopeIDs adds the "envelopes" edge to the MessageEnvelope entity by IDs.
+func (du *DeviceUpdate) AddEnvelopeIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddEnvelopeIDs(ids...)
+	return du
+}
+
+// AddEnvelopes adds the "envelopes" edges to the MessageEnvelope entity.
+func (du *DeviceUpdate) AddEnvelopes(m ...*MessageEnvelope) *DeviceUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return du.AddEnvelopeIDs(ids...)
+}
+
+// AddKeyPackageIDs adds the "key_packages" edge to the KeyPackage entity by IDs.
+func (du *DeviceUpdate) AddKeyPackageIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddKeyPackageIDs(ids...)
+	return du
+}
+
+// AddKeyPackages adds the "key_packages" edges to the KeyPackage entity.
+func (du *DeviceUpdate) AddKeyPackages(k ...*KeyPackage) *DeviceUpdate {
+	ids := make([]int, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return du.AddKeyPackageIDs(ids...)
+}
+
+// AddMlsWelcomeIDs adds the "mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (du *DeviceUpdate) AddMlsWelcomeIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddMlsWelcomeIDs(ids...)
+	return du
+}
+
+// AddMlsWelcomes adds the "mls_welcomes" edges to the MlsWelcome entity.
+func (du *DeviceUpdate) AddMlsWelcomes(m ...*MlsWelcome) *DeviceUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return du.AddMlsWelcomeIDs(ids...)
+}
+
+// AddSenderKeyDistributionIDs adds the "sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (du *DeviceUpdate) AddSenderKeyDistributionIDs(ids ...int) *DeviceUpdate {
+	du.mutation.AddSenderKeyDistributionIDs(ids...)
+	return du
+}
+
+// AddSenderKeyDistributions adds the "sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (du *DeviceUpdate) AddSenderKeyDistributions(s ...*SenderKeyDistribution) *DeviceUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return du.AddSenderKeyDistributionIDs(ids...)
+}
+
+// Mutation returns the DeviceMutation object of the builder.
+func (du *DeviceUpdate) Mutation() *DeviceMutation {
+	return du.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (du *DeviceUpdate) ClearUser() *DeviceUpdate {
+	du.mutation.ClearUser()
+	return du
+}
+
+// ClearIdentityKey clears the "identity_key" edge to the IdentityKey entity.
+func (du *DeviceUpdate) ClearIdentityKey() *DeviceUpdate {
+	du.mutation.ClearIdentityKey()
+	return du
+}
+
+// ClearIdentityKeyHistory clears all "identity_key_history" edges to the IdentityKeyRecord entity.
+func (du *DeviceUpdate) ClearIdentityKeyHistory() *DeviceUpdate {
+	du.mutation.ClearIdentityKeyHistory()
+	return du
+}
+
+// RemoveIdentityKeyHistoryIDs removes the "identity_key_history" edge to IdentityKeyRecord entities by IDs.
+func (du *DeviceUpdate) RemoveIdentityKeyHistoryIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveIdentityKeyHistoryIDs(ids...)
+	return du
+}
+
+// RemoveIdentityKeyHistory removes "identity_key_history" edges to IdentityKeyRecord entities.
+func (du *DeviceUpdate) RemoveIdentityKeyHistory(i ...*IdentityKeyRecord) *DeviceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return du.RemoveIdentityKeyHistoryIDs(ids...)
+}
+
+// ClearSignedPreKeys clears all "signed_pre_keys" edges to the SignedPreKey entity.
+func (du *DeviceUpdate) ClearSignedPreKeys() *DeviceUpdate {
+	du.mutation.ClearSignedPreKeys()
+	return du
+}
+
+// RemoveSignedPreKeyIDs removes the "signed_pre_keys" edge to SignedPreKey entities by IDs.
+func (du *DeviceUpdate) RemoveSignedPreKeyIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveSignedPreKeyIDs(ids...)
+	return du
+}
+
+// RemoveSignedPreKeys removes "signed_pre_keys" edges to SignedPreKey entities.
+func (du *DeviceUpdate) RemoveSignedPreKeys(s ...*SignedPreKey) *DeviceUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return du.RemoveSignedPreKeyIDs(ids...)
+}
+
+// ClearOneTimePreKeys clears all "one_time_pre_keys" edges to the OneTimePreKey entity.
+func (du *DeviceUpdate) ClearOneTimePreKeys() *DeviceUpdate {
+	du.mutation.ClearOneTimePreKeys()
+	return du
+}
+
+// RemoveOneTimePreKeyIDs removes the "one_time_pre_keys" edge to OneTimePreKey entities by IDs.
+func (du *DeviceUpdate) RemoveOneTimePreKeyIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveOneTimePreKeyIDs(ids...)
+	return du
+}
+
+// RemoveOneTimePreKeys removes "one_time_pre_keys" edges to OneTimePreKey entities.
+func (du *DeviceUpdate) RemoveOneTimePreKeys(o ...*OneTimePreKey) *DeviceUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return du.RemoveOneTimePreKeyIDs(ids...)
+}
+
+// ClearEnvelopes clears all "envelopes" edges to the MessageEnvelope entity.
+func (du *DeviceUpdate) ClearEnvelopes() *DeviceUpdate {
+	du.mutation.ClearEnvelopes()
+	return du
+}
+
+// RemoveEnvelopeIDs removes the "envelopes" edge to MessageEnvelope entities by IDs.
+func (du *DeviceUpdate) RemoveEnvelopeIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveEnvelopeIDs(ids...)
+	return du
+}
+
+// RemoveEnvelopes removes "envelopes" edges to MessageEnvelope entities.
+func (du *DeviceUpdate) RemoveEnvelopes(m ...*MessageEnvelope) *DeviceUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return du.RemoveEnvelopeIDs(ids...)
+}
+
+// ClearKeyPackages clears all "key_packages" edges to the KeyPackage entity.
+func (du *DeviceUpdate) ClearKeyPackages() *DeviceUpdate {
+	du.mutation.ClearKeyPackages()
+	return du
+}
+
+// RemoveKeyPackageIDs removes the "key_packages" edge to KeyPackage entities by IDs.
+func (du *DeviceUpdate) RemoveKeyPackageIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveKeyPackageIDs(ids...)
+	return du
+}
+
+// RemoveKeyPackages removes "key_packages" edges to KeyPackage entities.
+func (du *DeviceUpdate) RemoveKeyPackages(k ...*KeyPackage) *DeviceUpdate {
+	ids := make([]int, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return du.RemoveKeyPackageIDs(ids...)
+}
+
+// ClearMlsWelcomes clears all "mls_welcomes" edges to the MlsWelcome entity.
+func (du *DeviceUpdate) ClearMlsWelcomes() *DeviceUpdate {
+	du.mutation.ClearMlsWelcomes()
+	return du
+}
+
+// RemoveMlsWelcomeIDs removes the "mls_welcomes" edge to MlsWelcome entities by IDs.
+func (du *DeviceUpdate) RemoveMlsWelcomeIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveMlsWelcomeIDs(ids...)
+	return du
+}
+
+// RemoveMlsWelcomes removes "mls_welcomes" edges to MlsWelcome entities.
+func (du *DeviceUpdate) RemoveMlsWelcomes(m ...*MlsWelcome) *DeviceUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return du.RemoveMlsWelcomeIDs(ids...)
+}
+
+// ClearSenderKeyDistributions clears all "sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (du *DeviceUpdate) ClearSenderKeyDistributions() *DeviceUpdate {
+	du.mutation.ClearSenderKeyDistributions()
+	return du
+}
+
+// RemoveSenderKeyDistributionIDs removes the "sender_key_distributions" edge to SenderKeyDistribution entities by IDs.
+func (du *DeviceUpdate) RemoveSenderKeyDistributionIDs(ids ...int) *DeviceUpdate {
+	du.mutation.RemoveSenderKeyDistributionIDs(ids...)
+	return du
+}
+
+// RemoveSenderKeyDistributions removes "sender_key_distributions" edges to SenderKeyDistribution entities.
+func (du *DeviceUpdate) RemoveSenderKeyDistributions(s ...*SenderKeyDistribution) *DeviceUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return du.RemoveSenderKeyDistributionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DeviceUpdate) Save(ctx context.Context) (int, error) {
+	du.defaults()
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DeviceUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DeviceUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DeviceUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (du *DeviceUpdate) defaults() {
+	if _, ok := du.mutation.UpdatedAt(); !ok {
+		v := device.UpdateDefaultUpdatedAt()
+		du.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DeviceUpdate) check() error {
+	if v, ok := du.mutation.DeviceID(); ok {
+		if err := device.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "Device.device_id": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.RegistrationID(); ok {
+		if err := device.RegistrationIDValidator(v); err != nil {
+			return &ValidationError{Name: "registration_id", err: fmt.Errorf(`ent: validator failed for field "Device.registration_id": %w`, err)}
+		}
+	}
+	if _, ok := du.mutation.UserID(); du.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Device.user"`)
+	}
+	return nil
+}
+
+func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(device.Table, device.Columns, sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.DeviceID(); ok {
+		_spec.SetField(device.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedDeviceID(); ok {
+		_spec.AddField(device.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.RegistrationID(); ok {
+		_spec.SetField(device.FieldRegistrationID, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedRegistrationID(); ok {
+		_spec.AddField(device.FieldRegistrationID, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.Name(); ok {
+		_spec.SetField(device.FieldName, field.TypeString, value)
+	}
+	if value, ok := du.mutation.LowPrekeysNotifiedAt(); ok {
+		_spec.SetField(device.FieldLowPrekeysNotifiedAt, field.TypeTime, value)
+	}
+	if du.mutation.LowPrekeysNotifiedAtCleared() {
+		_spec.ClearField(device.FieldLowPrekeysNotifiedAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.CreatedAt(); ok {
+		_spec.SetField(device.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := du.mutation.UpdatedAt(); ok {
+		_spec.SetField(device.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if du.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   device.UserTable,
+			Columns: []string{device.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   device.UserTable,
+			Columns: []string{device.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.IdentityKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   device.IdentityKeyTable,
+			Columns: []string{device.IdentityKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.IdentityKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   device.IdentityKeyTable,
+			Columns: []string{device.IdentityKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.IdentityKeyHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.IdentityKeyHistoryTable,
+			Columns: []string{device.IdentityKeyHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedIdentityKeyHistoryIDs(); len(nodes) > 0 && !du.mutation.IdentityKeyHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.IdentityKeyHistoryTable,
+			Columns: []string{device.IdentityKeyHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.IdentityKeyHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.IdentityKeyHistoryTable,
+			Columns: []string{device.IdentityKeyHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.SignedPreKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SignedPreKeysTable,
+			Columns: []string{device.SignedPreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedSignedPreKeysIDs(); len(nodes) > 0 && !du.mutation.SignedPreKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SignedPreKeysTable,
+			Columns: []string{device.SignedPreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.SignedPreKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SignedPreKeysTable,
+			Columns: []string{device.SignedPreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.OneTimePreKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.OneTimePreKeysTable,
+			Columns: []string{device.OneTimePreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedOneTimePreKeysIDs(); len(nodes) > 0 && !du.mutation.OneTimePreKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.OneTimePreKeysTable,
+			Columns: []string{device.OneTimePreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.OneTimePreKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.OneTimePreKeysTable,
+			Columns: []string{device.OneTimePreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.EnvelopesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.EnvelopesTable,
+			Columns: []string{device.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedEnvelopesIDs(); len(nodes) > 0 && !du.mutation.EnvelopesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.EnvelopesTable,
+			Columns: []string{device.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.EnvelopesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.EnvelopesTable,
+			Columns: []string{device.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.KeyPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.KeyPackagesTable,
+			Columns: []string{device.KeyPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedKeyPackagesIDs(); len(nodes) > 0 && !du.mutation.KeyPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.KeyPackagesTable,
+			Columns: []string{device.KeyPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.KeyPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.KeyPackagesTable,
+			Columns: []string{device.KeyPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.MlsWelcomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.MlsWelcomesTable,
+			Columns: []string{device.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedMlsWelcomesIDs(); len(nodes) > 0 && !du.mutation.MlsWelcomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.MlsWelcomesTable,
+			Columns: []string{device.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.MlsWelcomesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.MlsWelcomesTable,
+			Columns: []string{device.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.SenderKeyDistributionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SenderKeyDistributionsTable,
+			Columns: []string{device.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedSenderKeyDistributionsIDs(); len(nodes) > 0 && !du.mutation.SenderKeyDistributionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SenderKeyDistributionsTable,
+			Columns: []string{device.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.SenderKeyDistributionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SenderKeyDistributionsTable,
+			Columns: []string{device.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{device.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DeviceUpdateOne is the builder for updating a single Device entity.
+type DeviceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeviceMutation
+}
+
+// SetDeviceID sets the "device_id" field.
+func (duo *DeviceUpdateOne) SetDeviceID(i int) *DeviceUpdateOne {
+	duo.mutation.ResetDeviceID()
+	duo.mutation.SetDeviceID(i)
+	return duo
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableDeviceID(i *int) *DeviceUpdateOne {
+	if i != nil {
+		duo.SetDeviceID(*i)
+	}
+	return duo
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (duo *DeviceUpdateOne) AddDeviceID(i int) *DeviceUpdateOne {
+	duo.mutation.AddDeviceID(i)
+	return duo
+}
+
+// SetRegistrationID sets the "registration_id" field.
+func (duo *DeviceUpdateOne) SetRegistrationID(i int) *DeviceUpdateOne {
+	duo.mutation.ResetRegistrationID()
+	duo.mutation.SetRegistrationID(i)
+	return duo
+}
+
+// SetNillableRegistrationID sets the "registration_id" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableRegistrationID(i *int) *DeviceUpdateOne {
+	if i != nil {
+		duo.SetRegistrationID(*i)
+	}
+	return duo
+}
+
+// AddRegistrationID adds i to the "registration_id" field.
+func (duo *DeviceUpdateOne) AddRegistrationID(i int) *DeviceUpdateOne {
+	duo.mutation.AddRegistrationID(i)
+	return duo
+}
+
+// SetName sets the "name" field.
+func (duo *DeviceUpdateOne) SetName(s string) *DeviceUpdateOne {
+	duo.mutation.SetName(s)
+	return duo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableName(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetName(*s)
+	}
+	return duo
+}
+
+// SetLowPrekeysNotifiedAt sets the "low_prekeys_notified_at" field.
+func (duo *DeviceUpdateOne) SetLowPrekeysNotifiedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetLowPrekeysNotifiedAt(t)
+	return duo
+}
+
+// SetNillableLowPrekeysNotifiedAt sets the "low_prekeys_notified_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableLowPrekeysNotifiedAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetLowPrekeysNotifiedAt(*t)
+	}
+	return duo
+}
+
+// ClearLowPrekeysNotifiedAt clears the value of the "low_prekeys_notified_at" field.
+func (duo *DeviceUpdateOne) ClearLowPrekeysNotifiedAt() *DeviceUpdateOne {
+	duo.mutation.ClearLowPrekeysNotifiedAt()
+	return duo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (duo *DeviceUpdateOne) SetCreatedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetCreatedAt(t)
+	return duo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableCreatedAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetCreatedAt(*t)
+	}
+	return duo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (duo *DeviceUpdateOne) SetUpdatedAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetUpdatedAt(t)
+	return duo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (duo *DeviceUpdateOne) SetUserID(id int) *DeviceUpdateOne {
+	duo.mutation.SetUserID(id)
+	return duo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (duo *DeviceUpdateOne) SetUser(u *User) *DeviceUpdateOne {
+	return duo.SetUserID(u.ID)
+}
+
+// SetIdentityKeyID sets the "identity_key" edge to the IdentityKey entity by ID.
+func (duo *DeviceUpdateOne) SetIdentityKeyID(id int) *DeviceUpdateOne {
+	duo.mutation.SetIdentityKeyID(id)
+	return duo
+}
+
+// SetNillableIdentityKeyID sets the "identity_key" edge to the IdentityKey entity by ID if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableIdentityKeyID(id *int) *DeviceUpdateOne {
+	if id != nil {
+		duo = duo.SetIdentityKeyID(*id)
+	}
+	return duo
+}
+
+// SetIdentityKey sets the "identity_key" edge to the IdentityKey entity.
+func (duo *DeviceUpdateOne) SetIdentityKey(i *IdentityKey) *DeviceUpdateOne {
+	return duo.SetIdentityKeyID(i.ID)
+}
+
+// AddIdentityKeyHistoryIDs adds the "identity_key_history" edge to the IdentityKeyRecord entity by IDs.
+func (duo *DeviceUpdateOne) AddIdentityKeyHistoryIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddIdentityKeyHistoryIDs(ids...)
+	return duo
+}
+
+// AddIdentityKeyHistory adds the "identity_key_history" edges to the IdentityKeyRecord entity.
+func (duo *DeviceUpdateOne) AddIdentityKeyHistory(i ...*IdentityKeyRecord) *DeviceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return duo.AddIdentityKeyHistoryIDs(ids...)
+}
+
+// AddSignedPreKeyIDs adds the "signed_pre_keys" edge to the SignedPreKey entity by IDs.
+func (duo *DeviceUpdateOne) AddSignedPreKeyIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddSignedPreKeyIDs(ids...)
+	return duo
+}
+
+// AddSignedPreKeys adds the "signed_pre_keys" edges to the SignedPreKey entity.
+func (duo *DeviceUpdateOne) AddSignedPreKeys(s ...*SignedPreKey) *DeviceUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return duo.AddSignedPreKeyIDs(ids...)
+}
+
+// AddOneTimePreKeyIDs adds the "one_time_pre_keys" edge to the OneTimePreKey entity by IDs.
+func (duo *DeviceUpdateOne) AddOneTimePreKeyIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddOneTimePreKeyIDs(ids...)
+	return duo
+}
+
+// AddOneTimePreKeys adds the "one_time_pre_keys" edges to the OneTimePreKey entity.
+func (duo *DeviceUpdateOne) AddOneTimePreKeys(o ...*OneTimePreKey) *DeviceUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return duo.AddOneTimePreKeyIDs(ids...)
+}
+
+// AddEnvelopeIDs adds the "envelopes" edge to the MessageEnvelope entity by IDs.
+func (duo *DeviceUpdateOne) AddEnvelopeIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddEnvelopeIDs(ids...)
+	return duo
+}
+
+// AddEnvelopes adds the "envelopes" edges to the MessageEnvelope entity.
+func (duo *DeviceUpdateOne) AddEnvelopes(m ...*MessageEnvelope) *DeviceUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return duo.AddEnvelopeIDs(ids...)
+}
+
+// AddKeyPackageIDs adds the "key_packages" edge to the KeyPackage entity by IDs.
+func (duo *DeviceUpdateOne) AddKeyPackageIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddKeyPackageIDs(ids...)
+	return duo
+}
+
+// AddKeyPackages adds the "key_packages" edges to the KeyPackage entity.
+func (duo *DeviceUpdateOne) AddKeyPackages(k ...*KeyPackage) *DeviceUpdateOne {
+	ids := make([]int, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return duo.AddKeyPackageIDs(ids...)
+}
+
+// AddMlsWelcomeIDs adds the "mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (duo *DeviceUpdateOne) AddMlsWelcomeIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddMlsWelcomeIDs(ids...)
+	return duo
+}
+
+// AddMlsWelcomes adds the "mls_welcomes" edges to the MlsWelcome entity.
+func (duo *DeviceUpdateOne) AddMlsWelcomes(m ...*MlsWelcome) *DeviceUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return duo.AddMlsWelcomeIDs(ids...)
+}
+
+// AddSenderKeyDistributionIDs adds the "sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (duo *DeviceUpdateOne) AddSenderKeyDistributionIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.AddSenderKeyDistributionIDs(ids...)
+	return duo
+}
+
+// AddSenderKeyDistributions adds the "sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (duo *DeviceUpdateOne) AddSenderKeyDistributions(s ...*SenderKeyDistribution) *DeviceUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return duo.AddSenderKeyDistributionIDs(ids...)
+}
+
+// Mutation returns the DeviceMutation object of the builder.
+func (duo *DeviceUpdateOne) Mutation() *DeviceMutation {
+	return duo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (duo *DeviceUpdateOne) ClearUser() *DeviceUpdateOne {
+	duo.mutation.ClearUser()
+	return duo
+}
+
+// ClearIdentityKey clears the "identity_key" edge to the IdentityKey entity.
+func (duo *DeviceUpdateOne) ClearIdentityKey() *DeviceUpdateOne {
+	duo.mutation.ClearIdentityKey()
+	return duo
+}
+
+// ClearIdentityKeyHistory clears all "identity_key_history" edges to the IdentityKeyRecord entity.
+func (duo *DeviceUpdateOne) ClearIdentityKeyHistory() *DeviceUpdateOne {
+	duo.mutation.ClearIdentityKeyHistory()
+	return duo
+}
+
+// RemoveIdentityKeyHistoryIDs removes the "identity_key_history" edge to IdentityKeyRecord entities by IDs.
+func (duo *DeviceUpdateOne) RemoveIdentityKeyHistoryIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveIdentityKeyHistoryIDs(ids...)
+	return duo
+}
+
+// RemoveIdentityKeyHistory removes "identity_key_history" edges to IdentityKeyRecord entities.
+func (duo *DeviceUpdateOne) RemoveIdentityKeyHistory(i ...*IdentityKeyRecord) *DeviceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return duo.RemoveIdentityKeyHistoryIDs(ids...)
+}
+
+// ClearSignedPreKeys clears all "signed_pre_keys" edges to the SignedPreKey entity.
+func (duo *DeviceUpdateOne) ClearSignedPreKeys() *DeviceUpdateOne {
+	duo.mutation.ClearSignedPreKeys()
+	return duo
+}
+
+// RemoveSignedPreKeyIDs removes the "signed_pre_keys" edge to SignedPreKey entities by IDs.
+func (duo *DeviceUpdateOne) RemoveSignedPreKeyIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveSignedPreKeyIDs(ids...)
+	return duo
+}
+
+// RemoveSignedPreKeys removes "signed_pre_keys" edges to SignedPreKey entities.
+func (duo *DeviceUpdateOne) RemoveSignedPreKeys(s ...*SignedPreKey) *DeviceUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return duo.RemoveSignedPreKeyIDs(ids...)
+}
+
+// ClearOneTimePreKeys clears all "one_time_pre_keys" edges to the OneTimePreKey entity.
+func (duo *DeviceUpdateOne) ClearOneTimePreKeys() *DeviceUpdateOne {
+	duo.mutation.ClearOneTimePreKeys()
+	return duo
+}
+
+// RemoveOneTimePreKeyIDs removes the "one_time_pre_keys" edge to OneTimePreKey entities by IDs.
+func (duo *DeviceUpdateOne) RemoveOneTimePreKeyIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveOneTimePreKeyIDs(ids...)
+	return duo
+}
+
+// RemoveOneTimePreKeys removes "one_time_pre_keys" edges to OneTimePreKey entities.
+func (duo *DeviceUpdateOne) RemoveOneTimePreKeys(o ...*OneTimePreKey) *DeviceUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return duo.RemoveOneTimePreKeyIDs(ids...)
+}
+
+// ClearEnvelopes clears all "envelopes" edges to the MessageEnvelope entity.
+func (duo *DeviceUpdateOne) ClearEnvelopes() *DeviceUpdateOne {
+	duo.mutation.ClearEnvelopes()
+	return duo
+}
+
+// RemoveEnvelopeIDs removes the "envelopes" edge to MessageEnvelope entities by IDs.
+func (duo *DeviceUpdateOne) RemoveEnvelopeIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveEnvelopeIDs(ids...)
+	return duo
+}
+
+// RemoveEnvelopes removes "envelopes" edges to MessageEnvelope entities.
+func (duo *DeviceUpdateOne) RemoveEnvelopes(m ...*MessageEnvelope) *DeviceUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return duo.RemoveEnvelopeIDs(ids...)
+}
+
+// ClearKeyPackages clears all "key_packages" edges to the KeyPackage entity.
+func (duo *DeviceUpdateOne) ClearKeyPackages() *DeviceUpdateOne {
+	duo.mutation.ClearKeyPackages()
+	return duo
+}
+
+// RemoveKeyPackageIDs removes the "key_packages" edge to KeyPackage entities by IDs.
+func (duo *DeviceUpdateOne) RemoveKeyPackageIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveKeyPackageIDs(ids...)
+	return duo
+}
+
+// RemoveKeyPackages removes "key_packages" edges to KeyPackage entities.
+func (duo *DeviceUpdateOne) RemoveKeyPackages(k ...*KeyPackage) *DeviceUpdateOne {
+	ids := make([]int, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return duo.RemoveKeyPackageIDs(ids...)
+}
+
+// ClearMlsWelcomes clears all "mls_welcomes" edges to the MlsWelcome entity.
+func (duo *DeviceUpdateOne) ClearMlsWelcomes() *DeviceUpdateOne {
+	duo.mutation.ClearMlsWelcomes()
+	return duo
+}
+
+// RemoveMlsWelcomeIDs removes the "mls_welcomes" edge to MlsWelcome entities by IDs.
+func (duo *DeviceUpdateOne) RemoveMlsWelcomeIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveMlsWelcomeIDs(ids...)
+	return duo
+}
+
+// RemoveMlsWelcomes removes "mls_welcomes" edges to MlsWelcome entities.
+func (duo *DeviceUpdateOne) RemoveMlsWelcomes(m ...*MlsWelcome) *DeviceUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return duo.RemoveMlsWelcomeIDs(ids...)
+}
+
+// ClearSenderKeyDistributions clears all "sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (duo *DeviceUpdateOne) ClearSenderKeyDistributions() *DeviceUpdateOne {
+	duo.mutation.ClearSenderKeyDistributions()
+	return duo
+}
+
+// RemoveSenderKeyDistributionIDs removes the "sender_key_distributions" edge to SenderKeyDistribution entities by IDs.
+func (duo *DeviceUpdateOne) RemoveSenderKeyDistributionIDs(ids ...int) *DeviceUpdateOne {
+	duo.mutation.RemoveSenderKeyDistributionIDs(ids...)
+	return duo
+}
+
+// RemoveSenderKeyDistributions removes "sender_key_distributions" edges to SenderKeyDistribution entities.
+func (duo *DeviceUpdateOne) RemoveSenderKeyDistributions(s ...*SenderKeyDistribution) *DeviceUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return duo.RemoveSenderKeyDistributionIDs(ids...)
+}
+
+// Where appends a list predicates to the DeviceUpdate builder.
+func (duo *DeviceUpdateOne) Where(ps ...predicate.Device) *DeviceUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DeviceUpdateOne) Select(field string, fields ...string) *DeviceUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Device entity.
+func (duo *DeviceUpdateOne) Save(ctx context.Context) (*Device, error) {
+	duo.defaults()
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DeviceUpdateOne) SaveX(ctx context.Context) *Device {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DeviceUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DeviceUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (duo *DeviceUpdateOne) defaults() {
+	if _, ok := duo.mutation.UpdatedAt(); !ok {
+		v := device.UpdateDefaultUpdatedAt()
+		duo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DeviceUpdateOne) check() error {
+	if v, ok := duo.mutation.DeviceID(); ok {
+		if err := device.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "Device.device_id": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.RegistrationID(); ok {
+		if err := device.RegistrationIDValidator(v); err != nil {
+			return &ValidationError{Name: "registration_id", err: fmt.Errorf(`ent: validator failed for field "Device.registration_id": %w`, err)}
+		}
+	}
+	if _, ok := duo.mutation.UserID(); duo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Device.user"`)
+	}
+	return nil
+}
+
+func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(device.Table, device.Columns, sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Device.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, device.FieldID)
+		for _, f := range fields {
+			if !device.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != device.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.DeviceID(); ok {
+		_spec.SetField(device.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedDeviceID(); ok {
+		_spec.AddField(device.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.RegistrationID(); ok {
+		_spec.SetField(device.FieldRegistrationID, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedRegistrationID(); ok {
+		_spec.AddField(device.FieldRegistrationID, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.Name(); ok {
+		_spec.SetField(device.FieldName, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.LowPrekeysNotifiedAt(); ok {
+		_spec.SetField(device.FieldLowPrekeysNotifiedAt, field.TypeTime, value)
+	}
+	if duo.mutation.LowPrekeysNotifiedAtCleared() {
+		_spec.ClearField(device.FieldLowPrekeysNotifiedAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.CreatedAt(); ok {
+		_spec.SetField(device.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := duo.mutation.UpdatedAt(); ok {
+		_spec.SetField(device.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if duo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   device.UserTable,
+			Columns: []string{device.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   device.UserTable,
+			Columns: []string{device.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.IdentityKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   device.IdentityKeyTable,
+			Columns: []string{device.IdentityKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.IdentityKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   device.IdentityKeyTable,
+			Columns: []string{device.IdentityKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.IdentityKeyHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.IdentityKeyHistoryTable,
+			Columns: []string{device.IdentityKeyHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedIdentityKeyHistoryIDs(); len(nodes) > 0 && !duo.mutation.IdentityKeyHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.IdentityKeyHistoryTable,
+			Columns: []string{device.IdentityKeyHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.IdentityKeyHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.IdentityKeyHistoryTable,
+			Columns: []string{device.IdentityKeyHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.SignedPreKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SignedPreKeysTable,
+			Columns: []string{device.SignedPreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedSignedPreKeysIDs(); len(nodes) > 0 && !duo.mutation.SignedPreKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SignedPreKeysTable,
+			Columns: []string{device.SignedPreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.SignedPreKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SignedPreKeysTable,
+			Columns: []string{device.SignedPreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.OneTimePreKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.OneTimePreKeysTable,
+			Columns: []string{device.OneTimePreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedOneTimePreKeysIDs(); len(nodes) > 0 && !duo.mutation.OneTimePreKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.OneTimePreKeysTable,
+			Columns: []string{device.OneTimePreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.OneTimePreKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.OneTimePreKeysTable,
+			Columns: []string{device.OneTimePreKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.EnvelopesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.EnvelopesTable,
+			Columns: []string{device.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedEnvelopesIDs(); len(nodes) > 0 && !duo.mutation.EnvelopesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.EnvelopesTable,
+			Columns: []string{device.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.EnvelopesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.EnvelopesTable,
+			Columns: []string{device.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.KeyPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.KeyPackagesTable,
+			Columns: []string{device.KeyPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedKeyPackagesIDs(); len(nodes) > 0 && !duo.mutation.KeyPackagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.KeyPackagesTable,
+			Columns: []string{device.KeyPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.KeyPackagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.KeyPackagesTable,
+			Columns: []string{device.KeyPackagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.MlsWelcomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.MlsWelcomesTable,
+			Columns: []string{device.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedMlsWelcomesIDs(); len(nodes) > 0 && !duo.mutation.MlsWelcomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.MlsWelcomesTable,
+			Columns: []string{device.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.MlsWelcomesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.MlsWelcomesTable,
+			Columns: []string{device.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.SenderKeyDistributionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SenderKeyDistributionsTable,
+			Columns: []string{device.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedSenderKeyDistributionsIDs(); len(nodes) > 0 && !duo.mutation.SenderKeyDistributionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SenderKeyDistributionsTable,
+			Columns: []string{device.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.SenderKeyDistributionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   device.SenderKeyDistributionsTable,
+			Columns: []string{device.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Device{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{device.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
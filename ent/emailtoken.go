@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/emailtoken"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// EmailToken is the model entity for the EmailToken schema.
+type EmailToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose emailtoken.Purpose `json:"purpose,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmailTokenQuery when eager-loading is set.
+	Edges            EmailTokenEdges `json:"edges"`
+	email_token_user *int
+	selectValues     sql.SelectValues
+}
+
+// EmailTokenEdges holds the relations/edges for other nodes in the graph.
+type EmailTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmailTokenEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailtoken.FieldID:
+			values[i] = new(sql.NullInt64)
+		case emailtoken.FieldPurpose, emailtoken.FieldTokenHash, emailtoken.FieldEmail:
+			values[i] = new(sql.NullString)
+		case emailtoken.FieldExpiresAt, emailtoken.FieldUsedAt, emailtoken.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case emailtoken.ForeignKeys[0]: // email_token_user
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailToken fields.
+func (et *EmailToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailtoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			et.ID = int(value.Int64)
+		case emailtoken.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				et.Purpose = emailtoken.Purpose(value.String)
+			}
+		case emailtoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				et.TokenHash = value.String
+			}
+		case emailtoken.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				et.Email = value.String
+			}
+		case emailtoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				et.ExpiresAt = value.Time
+			}
+		case emailtoken.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				et.UsedAt = new(time.Time)
+				*et.UsedAt = value.Time
+			}
+		case emailtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				et.CreatedAt = value.Time
+			}
+		case emailtoken.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field email_token_user", value)
+			} else if value.Valid {
+				et.email_token_user = new(int)
+				*et.email_token_user = int(value.Int64)
+			}
+		default:
+			et.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailToken.
+// This includes values selected through modifiers, order, etc.
+func (et *EmailToken) Value(name string) (ent.Value, error) {
+	return et.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the EmailToken entity.
+func (et *EmailToken) QueryUser() *UserQuery {
+	return NewEmailTokenClient(et.config).QueryUser(et)
+}
+
+// Update returns a builder for updating this EmailToken.
+// Note that you need to call EmailToken.Unwrap() before calling this method if this EmailToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (et *EmailToken) Update() *EmailTokenUpdateOne {
+	return NewEmailTokenClient(et.config).UpdateOne(et)
+}
+
+// Unwrap unwraps the EmailToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (et *EmailToken) Unwrap() *EmailToken {
+	_tx, ok := et.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailToken is not a transactional entity")
+	}
+	et.config.driver = _tx.drv
+	return et
+}
+
+// String implements the fmt.Stringer.
+func (et *EmailToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", et.ID))
+	builder.WriteString("purpose=")
+	builder.WriteString(fmt.Sprintf("%v", et.Purpose))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(et.Email)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(et.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := et.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(et.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailTokens is a parsable slice of EmailToken.
+type EmailTokens []*EmailToken
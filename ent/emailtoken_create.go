@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/emailtoken"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// EmailTokenCreate is the builder for creating a EmailToken entity.
+type EmailTokenCreate struct {
+	config
+	mutation *EmailTokenMutation
+	hooks    []Hook
+}
+
+// SetPurpose sets the "purpose" field.
+func (etc *EmailTokenCreate) SetPurpose(e emailtoken.Purpose) *EmailTokenCreate {
+	etc.mutation.SetPurpose(e)
+	return etc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (etc *EmailTokenCreate) SetTokenHash(s string) *EmailTokenCreate {
+	etc.mutation.SetTokenHash(s)
+	return etc
+}
+
+// SetEmail sets the "email" field.
+func (etc *EmailTokenCreate) SetEmail(s string) *EmailTokenCreate {
+	etc.mutation.SetEmail(s)
+	return etc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (etc *EmailTokenCreate) SetExpiresAt(t time.Time) *EmailTokenCreate {
+	etc.mutation.SetExpiresAt(t)
+	return etc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (etc *EmailTokenCreate) SetUsedAt(t time.Time) *EmailTokenCreate {
+	etc.mutation.SetUsedAt(t)
+	return etc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (etc *EmailTokenCreate) SetNillableUsedAt(t *time.Time) *EmailTokenCreate {
+	if t != nil {
+		etc.SetUsedAt(*t)
+	}
+	return etc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (etc *EmailTokenCreate) SetCreatedAt(t time.Time) *EmailTokenCreate {
+	etc.mutation.SetCreatedAt(t)
+	return etc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (etc *EmailTokenCreate) SetNillableCreatedAt(t *time.Time) *EmailTokenCreate {
+	if t != nil {
+		etc.SetCreatedAt(*t)
+	}
+	return etc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (etc *EmailTokenCreate) SetUserID(id int) *EmailTokenCreate {
+	etc.mutation.SetUserID(id)
+	return etc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (etc *EmailTokenCreate) SetUser(u *User) *EmailTokenCreate {
+	return etc.SetUserID(u.ID)
+}
+
+// Mutation returns the EmailTokenMutation object of the builder.
+func (etc *EmailTokenCreate) Mutation() *EmailTokenMutation {
+	return etc.mutation
+}
+
+// Save creates the EmailToken in the database.
+func (etc *EmailTokenCreate) Save(ctx context.Context) (*EmailToken, error) {
+	etc.defaults()
+	return withHooks(ctx, etc.sqlSave, etc.mutation, etc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (etc *EmailTokenCreate) SaveX(ctx context.Context) *EmailToken {
+	v, err := etc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (etc *EmailTokenCreate) Exec(ctx context.Context) error {
+	_, err := etc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (etc *EmailTokenCreate) ExecX(ctx context.Context) {
+	if err := etc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (etc *EmailTokenCreate) defaults() {
+	if _, ok := etc.mutation.CreatedAt(); !ok {
+		v := emailtoken.DefaultCreatedAt()
+		etc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (etc *EmailTokenCreate) check() error {
+	if _, ok := etc.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "EmailToken.purpose"`)}
+	}
+	if v, ok := etc.mutation.Purpose(); ok {
+		if err := emailtoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "EmailToken.purpose": %w`, err)}
+		}
+	}
+	if _, ok := etc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "EmailToken.token_hash"`)}
+	}
+	if v, ok := etc.mutation.TokenHash(); ok {
+		if err := emailtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := etc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "EmailToken.email"`)}
+	}
+	if v, ok := etc.mutation.Email(); ok {
+		if err := emailtoken.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "EmailToken.email": %w`, err)}
+		}
+	}
+	if _, ok := etc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "EmailToken.expires_at"`)}
+	}
+	if _, ok := etc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailToken.created_at"`)}
+	}
+	if _, ok := etc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "EmailToken.user"`)}
+	}
+	return nil
+}
+
+func (etc *EmailTokenCreate) sqlSave(ctx context.Context) (*EmailToken, error) {
+	if err := etc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := etc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, etc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	etc.mutation.id = &_node.ID
+	etc.mutation.done = true
+	return _node, nil
+}
+
+func (etc *EmailTokenCreate) createSpec() (*EmailToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailToken{config: etc.config}
+		_spec = sqlgraph.NewCreateSpec(emailtoken.Table, sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt))
+	)
+	if value, ok := etc.mutation.Purpose(); ok {
+		_spec.SetField(emailtoken.FieldPurpose, field.TypeEnum, value)
+		_node.Purpose = value
+	}
+	if value, ok := etc.mutation.TokenHash(); ok {
+		_spec.SetField(emailtoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := etc.mutation.Email(); ok {
+		_spec.SetField(emailtoken.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := etc.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailtoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := etc.mutation.UsedAt(); ok {
+		_spec.SetField(emailtoken.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := etc.mutation.CreatedAt(); ok {
+		_spec.SetField(emailtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := etc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   emailtoken.UserTable,
+			Columns: []string{emailtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.email_token_user = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EmailTokenCreateBulk is the builder for creating many EmailToken entities in bulk.
+type EmailTokenCreateBulk struct {
+	config
+	err      error
+	builders []*EmailTokenCreate
+}
+
+// Save creates the EmailToken entities in the database.
+func (etcb *EmailTokenCreateBulk) Save(ctx context.Context) ([]*EmailToken, error) {
+	if etcb.err != nil {
+		return nil, etcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(etcb.builders))
+	nodes := make([]*EmailToken, len(etcb.builders))
+	mutators := make([]Mutator, len(etcb.builders))
+	for i := range etcb.builders {
+		func(i int, root context.Context) {
+			builder := etcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, etcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, etcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, etcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (etcb *EmailTokenCreateBulk) SaveX(ctx context.Context) []*EmailToken {
+	v, err := etcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (etcb *EmailTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := etcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (etcb *EmailTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := etcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
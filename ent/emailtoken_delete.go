@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/emailtoken"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// EmailTokenDelete is the builder for deleting a EmailToken entity.
+type EmailTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmailTokenMutation
+}
+
+// Where appends a list predicates to the EmailTokenDelete builder.
+func (etd *EmailTokenDelete) Where(ps ...predicate.EmailToken) *EmailTokenDelete {
+	etd.mutation.Where(ps...)
+	return etd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (etd *EmailTokenDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, etd.sqlExec, etd.mutation, etd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (etd *EmailTokenDelete) ExecX(ctx context.Context) int {
+	n, err := etd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (etd *EmailTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(emailtoken.Table, sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt))
+	if ps := etd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, etd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	etd.mutation.done = true
+	return affected, err
+}
+
+// EmailTokenDeleteOne is the builder for deleting a single EmailToken entity.
+type EmailTokenDeleteOne struct {
+	etd *EmailTokenDelete
+}
+
+// Where appends a list predicates to the EmailTokenDelete builder.
+func (etdo *EmailTokenDeleteOne) Where(ps ...predicate.EmailToken) *EmailTokenDeleteOne {
+	etdo.etd.mutation.Where(ps...)
+	return etdo
+}
+
+// Exec executes the deletion query.
+func (etdo *EmailTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := etdo.etd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{emailtoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (etdo *EmailTokenDeleteOne) ExecX(ctx context.Context) {
+	if err := etdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
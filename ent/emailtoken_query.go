@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/emailtoken"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// EmailTokenQuery is the builder for querying EmailToken entities.
+type EmailTokenQuery struct {
+	config
+	ctx        *QueryContext
+	order      []emailtoken.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EmailToken
+	withUser   *UserQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EmailTokenQuery builder.
+func (etq *EmailTokenQuery) Where(ps ...predicate.EmailToken) *EmailTokenQuery {
+	etq.predicates = append(etq.predicates, ps...)
+	return etq
+}
+
+// Limit the number of records to be returned by this query.
+func (etq *EmailTokenQuery) Limit(limit int) *EmailTokenQuery {
+	etq.ctx.Limit = &limit
+	return etq
+}
+
+// Offset to start from.
+func (etq *EmailTokenQuery) Offset(offset int) *EmailTokenQuery {
+	etq.ctx.Offset = &offset
+	return etq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (etq *EmailTokenQuery) Unique(unique bool) *EmailTokenQuery {
+	etq.ctx.Unique = &unique
+	return etq
+}
+
+// Order specifies how the records should be ordered.
+func (etq *EmailTokenQuery) Order(o ...emailtoken.OrderOption) *EmailTokenQuery {
+	etq.order = append(etq.order, o...)
+	return etq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (etq *EmailTokenQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: etq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := etq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := etq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailtoken.Table, emailtoken.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, emailtoken.UserTable, emailtoken.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(etq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EmailToken entity from the query.
+// Returns a *NotFoundError when no EmailToken was found.
+func (etq *EmailTokenQuery) First(ctx context.Context) (*EmailToken, error) {
+	nodes, err := etq.Limit(1).All(setContextOp(ctx, etq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{emailtoken.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (etq *EmailTokenQuery) FirstX(ctx context.Context) *EmailToken {
+	node, err := etq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EmailToken ID from the query.
+// Returns a *NotFoundError when no EmailToken ID was found.
+func (etq *EmailTokenQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = etq.Limit(1).IDs(setContextOp(ctx, etq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{emailtoken.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (etq *EmailTokenQuery) FirstIDX(ctx context.Context) int {
+	id, err := etq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EmailToken entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EmailToken entity is found.
+// Returns a *NotFoundError when no EmailToken entities are found.
+func (etq *EmailTokenQuery) Only(ctx context.Context) (*EmailToken, error) {
+	nodes, err := etq.Limit(2).All(setContextOp(ctx, etq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{emailtoken.Label}
+	default:
+		return nil, &NotSingularError{emailtoken.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (etq *EmailTokenQuery) OnlyX(ctx context.Context) *EmailToken {
+	node, err := etq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EmailToken ID in the query.
+// Returns a *NotSingularError when more than one EmailToken ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (etq *EmailTokenQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = etq.Limit(2).IDs(setContextOp(ctx, etq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{emailtoken.Label}
+	default:
+		err = &NotSingularError{emailtoken.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (etq *EmailTokenQuery) OnlyIDX(ctx context.Context) int {
+	id, err := etq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EmailTokens.
+func (etq *EmailTokenQuery) All(ctx context.Context) ([]*EmailToken, error) {
+	ctx = setContextOp(ctx, etq.ctx, "All")
+	if err := etq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EmailToken, *EmailTokenQuery]()
+	return withInterceptors[[]*EmailToken](ctx, etq, qr, etq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (etq *EmailTokenQuery) AllX(ctx context.Context) []*EmailToken {
+	nodes, err := etq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EmailToken IDs.
+func (etq *EmailTokenQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if etq.ctx.Unique == nil && etq.path != nil {
+		etq.Unique(true)
+	}
+	ctx = setContextOp(ctx, etq.ctx, "IDs")
+	if err = etq.Select(emailtoken.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (etq *EmailTokenQuery) IDsX(ctx context.Context) []int {
+	ids, err := etq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (etq *EmailTokenQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, etq.ctx, "Count")
+	if err := etq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, etq, querierCount[*EmailTokenQuery](), etq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (etq *EmailTokenQuery) CountX(ctx context.Context) int {
+	count, err := etq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (etq *EmailTokenQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, etq.ctx, "Exist")
+	switch _, err := etq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (etq *EmailTokenQuery) ExistX(ctx context.Context) bool {
+	exist, err := etq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EmailTokenQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (etq *EmailTokenQuery) Clone() *EmailTokenQuery {
+	if etq == nil {
+		return nil
+	}
+	return &EmailTokenQuery{
+		config:     etq.config,
+		ctx:        etq.ctx.Clone(),
+		order:      append([]emailtoken.OrderOption{}, etq.order...),
+		inters:     append([]Interceptor{}, etq.inters...),
+		predicates: append([]predicate.EmailToken{}, etq.predicates...),
+		withUser:   etq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  etq.sql.Clone(),
+		path: etq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (etq *EmailTokenQuery) WithUser(opts ...func(*UserQuery)) *EmailTokenQuery {
+	query := (&UserClient{config: etq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	etq.withUser = query
+	return etq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Purpose emailtoken.Purpose `json:"purpose,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EmailToken.Query().
+//		GroupBy(emailtoken.FieldPurpose).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (etq *EmailTokenQuery) GroupBy(field string, fields ...string) *EmailTokenGroupBy {
+	etq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EmailTokenGroupBy{build: etq}
+	grbuild.flds = &etq.ctx.Fields
+	grbuild.label = emailtoken.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Purpose emailtoken.Purpose `json:"purpose,omitempty"`
+//	}
+//
+//	client.EmailToken.Query().
+//		Select(emailtoken.FieldPurpose).
+//		Scan(ctx, &v)
+func (etq *EmailTokenQuery) Select(fields ...string) *EmailTokenSelect {
+	etq.ctx.Fields = append(etq.ctx.Fields, fields...)
+	sbuild := &EmailTokenSelect{EmailTokenQuery: etq}
+	sbuild.label = emailtoken.Label
+	sbuild.flds, sbuild.scan = &etq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EmailTokenSelect configured with the given aggregations.
+func (etq *EmailTokenQuery) Aggregate(fns ...AggregateFunc) *EmailTokenSelect {
+	return etq.Select().Aggregate(fns...)
+}
+
+func (etq *EmailTokenQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range etq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, etq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range etq.ctx.Fields {
+		if !emailtoken.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if etq.path != nil {
+		prev, err := etq.path(ctx)
+		if err != nil {
+			return err
+		}
+		etq.sql = prev
+	}
+	return nil
+}
+
+func (etq *EmailTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EmailToken, error) {
+	var (
+		nodes       = []*EmailToken{}
+		withFKs     = etq.withFKs
+		_spec       = etq.querySpec()
+		loadedTypes = [1]bool{
+			etq.withUser != nil,
+		}
+	)
+	if etq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, emailtoken.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EmailToken).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EmailToken{config: etq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, etq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := etq.withUser; query != nil {
+		if err := etq.loadUser(ctx, query, nodes, nil,
+			func(n *EmailToken, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (etq *EmailTokenQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*EmailToken, init func(*EmailToken), assign func(*EmailToken, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*EmailToken)
+	for i := range nodes {
+		if nodes[i].email_token_user == nil {
+			continue
+		}
+		fk := *nodes[i].email_token_user
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "email_token_user" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (etq *EmailTokenQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := etq.querySpec()
+	_spec.Node.Columns = etq.ctx.Fields
+	if len(etq.ctx.Fields) > 0 {
+		_spec.Unique = etq.ctx.Unique != nil && *etq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, etq.driver, _spec)
+}
+
+func (etq *EmailTokenQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(emailtoken.Table, emailtoken.Columns, sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt))
+	_spec.From = etq.sql
+	if unique := etq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if etq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := etq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailtoken.FieldID)
+		for i := range fields {
+			if fields[i] != emailtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := etq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := etq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := etq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := etq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (etq *EmailTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(etq.driver.Dialect())
+	t1 := builder.Table(emailtoken.Table)
+	columns := etq.ctx.Fields
+	if len(columns) == 0 {
+		columns = emailtoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if etq.sql != nil {
+		selector = etq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if etq.ctx.Unique != nil && *etq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range etq.predicates {
+		p(selector)
+	}
+	for _, p := range etq.order {
+		p(selector)
+	}
+	if offset := etq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := etq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EmailTokenGroupBy is the group-by builder for EmailToken entities.
+type EmailTokenGroupBy struct {
+	selector
+	build *EmailTokenQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (etgb *EmailTokenGroupBy) Aggregate(fns ...AggregateFunc) *EmailTokenGroupBy {
+	etgb.fns = append(etgb.fns, fns...)
+	return etgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (etgb *EmailTokenGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, etgb.build.ctx, "GroupBy")
+	if err := etgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmailTokenQuery, *EmailTokenGroupBy](ctx, etgb.build, etgb, etgb.build.inters, v)
+}
+
+func (etgb *EmailTokenGroupBy) sqlScan(ctx context.Context, root *EmailTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(etgb.fns))
+	for _, fn := range etgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*etgb.flds)+len(etgb.fns))
+		for _, f := range *etgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*etgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := etgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EmailTokenSelect is the builder for selecting fields of EmailToken entities.
+type EmailTokenSelect struct {
+	*EmailTokenQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ets *EmailTokenSelect) Aggregate(fns ...AggregateFunc) *EmailTokenSelect {
+	ets.fns = append(ets.fns, fns...)
+	return ets
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ets *EmailTokenSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ets.ctx, "Select")
+	if err := ets.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmailTokenQuery, *EmailTokenSelect](ctx, ets.EmailTokenQuery, ets, ets.inters, v)
+}
+
+func (ets *EmailTokenSelect) sqlScan(ctx context.Context, root *EmailTokenQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ets.fns))
+	for _, fn := range ets.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ets.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ets.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,543 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/emailtoken"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// EmailTokenUpdate is the builder for updating EmailToken entities.
+type EmailTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailTokenMutation
+}
+
+// Where appends a list predicates to the EmailTokenUpdate builder.
+func (etu *EmailTokenUpdate) Where(ps ...predicate.EmailToken) *EmailTokenUpdate {
+	etu.mutation.Where(ps...)
+	return etu
+}
+
+// SetPurpose sets the "purpose" field.
+func (etu *EmailTokenUpdate) SetPurpose(e emailtoken.Purpose) *EmailTokenUpdate {
+	etu.mutation.SetPurpose(e)
+	return etu
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (etu *EmailTokenUpdate) SetNillablePurpose(e *emailtoken.Purpose) *EmailTokenUpdate {
+	if e != nil {
+		etu.SetPurpose(*e)
+	}
+	return etu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (etu *EmailTokenUpdate) SetTokenHash(s string) *EmailTokenUpdate {
+	etu.mutation.SetTokenHash(s)
+	return etu
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (etu *EmailTokenUpdate) SetNillableTokenHash(s *string) *EmailTokenUpdate {
+	if s != nil {
+		etu.SetTokenHash(*s)
+	}
+	return etu
+}
+
+// SetEmail sets the "email" field.
+func (etu *EmailTokenUpdate) SetEmail(s string) *EmailTokenUpdate {
+	etu.mutation.SetEmail(s)
+	return etu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (etu *EmailTokenUpdate) SetNillableEmail(s *string) *EmailTokenUpdate {
+	if s != nil {
+		etu.SetEmail(*s)
+	}
+	return etu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (etu *EmailTokenUpdate) SetExpiresAt(t time.Time) *EmailTokenUpdate {
+	etu.mutation.SetExpiresAt(t)
+	return etu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (etu *EmailTokenUpdate) SetNillableExpiresAt(t *time.Time) *EmailTokenUpdate {
+	if t != nil {
+		etu.SetExpiresAt(*t)
+	}
+	return etu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (etu *EmailTokenUpdate) SetUsedAt(t time.Time) *EmailTokenUpdate {
+	etu.mutation.SetUsedAt(t)
+	return etu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (etu *EmailTokenUpdate) SetNillableUsedAt(t *time.Time) *EmailTokenUpdate {
+	if t != nil {
+		etu.SetUsedAt(*t)
+	}
+	return etu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (etu *EmailTokenUpdate) ClearUsedAt() *EmailTokenUpdate {
+	etu.mutation.ClearUsedAt()
+	return etu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (etu *EmailTokenUpdate) SetCreatedAt(t time.Time) *EmailTokenUpdate {
+	etu.mutation.SetCreatedAt(t)
+	return etu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (etu *EmailTokenUpdate) SetNillableCreatedAt(t *time.Time) *EmailTokenUpdate {
+	if t != nil {
+		etu.SetCreatedAt(*t)
+	}
+	return etu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (etu *EmailTokenUpdate) SetUserID(id int) *EmailTokenUpdate {
+	etu.mutation.SetUserID(id)
+	return etu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (etu *EmailTokenUpdate) SetUser(u *User) *EmailTokenUpdate {
+	return etu.SetUserID(u.ID)
+}
+
+// Mutation returns the EmailTokenMutation object of the builder.
+func (etu *EmailTokenUpdate) Mutation() *EmailTokenMutation {
+	return etu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (etu *EmailTokenUpdate) ClearUser() *EmailTokenUpdate {
+	etu.mutation.ClearUser()
+	return etu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (etu *EmailTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, etu.sqlSave, etu.mutation, etu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (etu *EmailTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := etu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (etu *EmailTokenUpdate) Exec(ctx context.Context) error {
+	_, err := etu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (etu *EmailTokenUpdate) ExecX(ctx context.Context) {
+	if err := etu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (etu *EmailTokenUpdate) check() error {
+	if v, ok := etu.mutation.Purpose(); ok {
+		if err := emailtoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "EmailToken.purpose": %w`, err)}
+		}
+	}
+	if v, ok := etu.mutation.TokenHash(); ok {
+		if err := emailtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := etu.mutation.Email(); ok {
+		if err := emailtoken.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "EmailToken.email": %w`, err)}
+		}
+	}
+	if _, ok := etu.mutation.UserID(); etu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "EmailToken.user"`)
+	}
+	return nil
+}
+
+func (etu *EmailTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := etu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailtoken.Table, emailtoken.Columns, sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt))
+	if ps := etu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := etu.mutation.Purpose(); ok {
+		_spec.SetField(emailtoken.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := etu.mutation.TokenHash(); ok {
+		_spec.SetField(emailtoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := etu.mutation.Email(); ok {
+		_spec.SetField(emailtoken.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := etu.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := etu.mutation.UsedAt(); ok {
+		_spec.SetField(emailtoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if etu.mutation.UsedAtCleared() {
+		_spec.ClearField(emailtoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := etu.mutation.CreatedAt(); ok {
+		_spec.SetField(emailtoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if etu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   emailtoken.UserTable,
+			Columns: []string{emailtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := etu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   emailtoken.UserTable,
+			Columns: []string{emailtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, etu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	etu.mutation.done = true
+	return n, nil
+}
+
+// EmailTokenUpdateOne is the builder for updating a single EmailToken entity.
+type EmailTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailTokenMutation
+}
+
+// SetPurpose sets the "purpose" field.
+func (etuo *EmailTokenUpdateOne) SetPurpose(e emailtoken.Purpose) *EmailTokenUpdateOne {
+	etuo.mutation.SetPurpose(e)
+	return etuo
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (etuo *EmailTokenUpdateOne) SetNillablePurpose(e *emailtoken.Purpose) *EmailTokenUpdateOne {
+	if e != nil {
+		etuo.SetPurpose(*e)
+	}
+	return etuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (etuo *EmailTokenUpdateOne) SetTokenHash(s string) *EmailTokenUpdateOne {
+	etuo.mutation.SetTokenHash(s)
+	return etuo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (etuo *EmailTokenUpdateOne) SetNillableTokenHash(s *string) *EmailTokenUpdateOne {
+	if s != nil {
+		etuo.SetTokenHash(*s)
+	}
+	return etuo
+}
+
+// SetEmail sets the "email" field.
+func (etuo *EmailTokenUpdateOne) SetEmail(s string) *EmailTokenUpdateOne {
+	etuo.mutation.SetEmail(s)
+	return etuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (etuo *EmailTokenUpdateOne) SetNillableEmail(s *string) *EmailTokenUpdateOne {
+	if s != nil {
+		etuo.SetEmail(*s)
+	}
+	return etuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (etuo *EmailTokenUpdateOne) SetExpiresAt(t time.Time) *EmailTokenUpdateOne {
+	etuo.mutation.SetExpiresAt(t)
+	return etuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (etuo *EmailTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *EmailTokenUpdateOne {
+	if t != nil {
+		etuo.SetExpiresAt(*t)
+	}
+	return etuo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (etuo *EmailTokenUpdateOne) SetUsedAt(t time.Time) *EmailTokenUpdateOne {
+	etuo.mutation.SetUsedAt(t)
+	return etuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (etuo *EmailTokenUpdateOne) SetNillableUsedAt(t *time.Time) *EmailTokenUpdateOne {
+	if t != nil {
+		etuo.SetUsedAt(*t)
+	}
+	return etuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (etuo *EmailTokenUpdateOne) ClearUsedAt() *EmailTokenUpdateOne {
+	etuo.mutation.ClearUsedAt()
+	return etuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (etuo *EmailTokenUpdateOne) SetCreatedAt(t time.Time) *EmailTokenUpdateOne {
+	etuo.mutation.SetCreatedAt(t)
+	return etuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (etuo *EmailTokenUpdateOne) SetNillableCreatedAt(t *time.Time) *EmailTokenUpdateOne {
+	if t != nil {
+		etuo.SetCreatedAt(*t)
+	}
+	return etuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (etuo *EmailTokenUpdateOne) SetUserID(id int) *EmailTokenUpdateOne {
+	etuo.mutation.SetUserID(id)
+	return etuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (etuo *EmailTokenUpdateOne) SetUser(u *User) *EmailTokenUpdateOne {
+	return etuo.SetUserID(u.ID)
+}
+
+// Mutation returns the EmailTokenMutation object of the builder.
+func (etuo *EmailTokenUpdateOne) Mutation() *EmailTokenMutation {
+	return etuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (etuo *EmailTokenUpdateOne) ClearUser() *EmailTokenUpdateOne {
+	etuo.mutation.ClearUser()
+	return etuo
+}
+
+// Where appends a list predicates to the EmailTokenUpdate builder.
+func (etuo *EmailTokenUpdateOne) Where(ps ...predicate.EmailToken) *EmailTokenUpdateOne {
+	etuo.mutation.Where(ps...)
+	return etuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (etuo *EmailTokenUpdateOne) Select(field string, fields ...string) *EmailTokenUpdateOne {
+	etuo.fields = append([]string{field}, fields...)
+	return etuo
+}
+
+// Save executes the query and returns the updated EmailToken entity.
+func (etuo *EmailTokenUpdateOne) Save(ctx context.Context) (*EmailToken, error) {
+	return withHooks(ctx, etuo.sqlSave, etuo.mutation, etuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (etuo *EmailTokenUpdateOne) SaveX(ctx context.Context) *EmailToken {
+	node, err := etuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (etuo *EmailTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := etuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (etuo *EmailTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := etuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (etuo *EmailTokenUpdateOne) check() error {
+	if v, ok := etuo.mutation.Purpose(); ok {
+		if err := emailtoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "EmailToken.purpose": %w`, err)}
+		}
+	}
+	if v, ok := etuo.mutation.TokenHash(); ok {
+		if err := emailtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailToken.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := etuo.mutation.Email(); ok {
+		if err := emailtoken.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "EmailToken.email": %w`, err)}
+		}
+	}
+	if _, ok := etuo.mutation.UserID(); etuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "EmailToken.user"`)
+	}
+	return nil
+}
+
+func (etuo *EmailTokenUpdateOne) sqlSave(ctx context.Context) (_node *EmailToken, err error) {
+	if err := etuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailtoken.Table, emailtoken.Columns, sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt))
+	id, ok := etuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := etuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailtoken.FieldID)
+		for _, f := range fields {
+			if !emailtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := etuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := etuo.mutation.Purpose(); ok {
+		_spec.SetField(emailtoken.FieldPurpose, field.TypeEnum, value)
+	}
+	if value, ok := etuo.mutation.TokenHash(); ok {
+		_spec.SetField(emailtoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := etuo.mutation.Email(); ok {
+		_spec.SetField(emailtoken.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := etuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := etuo.mutation.UsedAt(); ok {
+		_spec.SetField(emailtoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if etuo.mutation.UsedAtCleared() {
+		_spec.ClearField(emailtoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := etuo.mutation.CreatedAt(); ok {
+		_spec.SetField(emailtoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if etuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   emailtoken.UserTable,
+			Columns: []string{emailtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := etuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   emailtoken.UserTable,
+			Columns: []string{emailtoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EmailToken{config: etuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, etuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	etuo.mutation.done = true
+	return _node, nil
+}
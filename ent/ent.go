@@ -12,15 +12,38 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eleven-am/enclave/ent/apikey"
 	"github.com/eleven-am/enclave/ent/calllog"
 	"github.com/eleven-am/enclave/ent/callparticipant"
 	"github.com/eleven-am/enclave/ent/contact"
+	"github.com/eleven-am/enclave/ent/credential"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/emailtoken"
 	"github.com/eleven-am/enclave/ent/favourite"
+	"github.com/eleven-am/enclave/ent/identity"
+	"github.com/eleven-am/enclave/ent/identitykey"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/keylogentry"
+	"github.com/eleven-am/enclave/ent/keyloghead"
+	"github.com/eleven-am/enclave/ent/keylognode"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/loginthrottle"
 	"github.com/eleven-am/enclave/ent/media"
 	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
 	"github.com/eleven-am/enclave/ent/notification"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+	"github.com/eleven-am/enclave/ent/recoverycode"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+	"github.com/eleven-am/enclave/ent/totpsecret"
 	"github.com/eleven-am/enclave/ent/user"
 )
 
@@ -82,16 +105,39 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			calllog.Table:         calllog.ValidColumn,
-			callparticipant.Table: callparticipant.ValidColumn,
-			contact.Table:         contact.ValidColumn,
-			favourite.Table:       favourite.ValidColumn,
-			media.Table:           media.ValidColumn,
-			message.Table:         message.ValidColumn,
-			notification.Table:    notification.ValidColumn,
-			room.Table:            room.ValidColumn,
-			roommembership.Table:  roommembership.ValidColumn,
-			user.Table:            user.ValidColumn,
+			apikey.Table:                apikey.ValidColumn,
+			calllog.Table:               calllog.ValidColumn,
+			callparticipant.Table:       callparticipant.ValidColumn,
+			contact.Table:               contact.ValidColumn,
+			credential.Table:            credential.ValidColumn,
+			device.Table:                device.ValidColumn,
+			emailtoken.Table:            emailtoken.ValidColumn,
+			favourite.Table:             favourite.ValidColumn,
+			identity.Table:              identity.ValidColumn,
+			identitykey.Table:           identitykey.ValidColumn,
+			identitykeyrecord.Table:     identitykeyrecord.ValidColumn,
+			keybackupkey.Table:          keybackupkey.ValidColumn,
+			keybackupversion.Table:      keybackupversion.ValidColumn,
+			keylogentry.Table:           keylogentry.ValidColumn,
+			keyloghead.Table:            keyloghead.ValidColumn,
+			keylognode.Table:            keylognode.ValidColumn,
+			keypackage.Table:            keypackage.ValidColumn,
+			loginthrottle.Table:         loginthrottle.ValidColumn,
+			media.Table:                 media.ValidColumn,
+			message.Table:               message.ValidColumn,
+			messageenvelope.Table:       messageenvelope.ValidColumn,
+			mlscommit.Table:             mlscommit.ValidColumn,
+			mlswelcome.Table:            mlswelcome.ValidColumn,
+			notification.Table:          notification.ValidColumn,
+			onetimeprekey.Table:         onetimeprekey.ValidColumn,
+			recoverycode.Table:          recoverycode.ValidColumn,
+			room.Table:                  room.ValidColumn,
+			roommembership.Table:        roommembership.ValidColumn,
+			senderkeydistribution.Table: senderkeydistribution.ValidColumn,
+			session.Table:               session.ValidColumn,
+			signedprekey.Table:          signedprekey.ValidColumn,
+			totpsecret.Table:            totpsecret.ValidColumn,
+			user.Table:                  user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -9,6 +9,18 @@ import (
 	"github.com/eleven-am/enclave/ent"
 )
 
+// The ApiKeyFunc type is an adapter to allow the use of ordinary
+// function as ApiKey mutator.
+type ApiKeyFunc func(context.Context, *ent.ApiKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ApiKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ApiKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApiKeyMutation", m)
+}
+
 // The CallLogFunc type is an adapter to allow the use of ordinary
 // function as CallLog mutator.
 type CallLogFunc func(context.Context, *ent.CallLogMutation) (ent.Value, error)
@@ -45,6 +57,42 @@ func (f ContactFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ContactMutation", m)
 }
 
+// The CredentialFunc type is an adapter to allow the use of ordinary
+// function as Credential mutator.
+type CredentialFunc func(context.Context, *ent.CredentialMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CredentialFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CredentialMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CredentialMutation", m)
+}
+
+// The DeviceFunc type is an adapter to allow the use of ordinary
+// function as Device mutator.
+type DeviceFunc func(context.Context, *ent.DeviceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceMutation", m)
+}
+
+// The EmailTokenFunc type is an adapter to allow the use of ordinary
+// function as EmailToken mutator.
+type EmailTokenFunc func(context.Context, *ent.EmailTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmailTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailTokenMutation", m)
+}
+
 // The FavouriteFunc type is an adapter to allow the use of ordinary
 // function as Favourite mutator.
 type FavouriteFunc func(context.Context, *ent.FavouriteMutation) (ent.Value, error)
@@ -57,6 +105,126 @@ func (f FavouriteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FavouriteMutation", m)
 }
 
+// The IdentityFunc type is an adapter to allow the use of ordinary
+// function as Identity mutator.
+type IdentityFunc func(context.Context, *ent.IdentityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdentityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdentityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdentityMutation", m)
+}
+
+// The IdentityKeyFunc type is an adapter to allow the use of ordinary
+// function as IdentityKey mutator.
+type IdentityKeyFunc func(context.Context, *ent.IdentityKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdentityKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdentityKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdentityKeyMutation", m)
+}
+
+// The IdentityKeyRecordFunc type is an adapter to allow the use of ordinary
+// function as IdentityKeyRecord mutator.
+type IdentityKeyRecordFunc func(context.Context, *ent.IdentityKeyRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdentityKeyRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdentityKeyRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdentityKeyRecordMutation", m)
+}
+
+// The KeyBackupKeyFunc type is an adapter to allow the use of ordinary
+// function as KeyBackupKey mutator.
+type KeyBackupKeyFunc func(context.Context, *ent.KeyBackupKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KeyBackupKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KeyBackupKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeyBackupKeyMutation", m)
+}
+
+// The KeyBackupVersionFunc type is an adapter to allow the use of ordinary
+// function as KeyBackupVersion mutator.
+type KeyBackupVersionFunc func(context.Context, *ent.KeyBackupVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KeyBackupVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KeyBackupVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeyBackupVersionMutation", m)
+}
+
+// The KeyLogEntryFunc type is an adapter to allow the use of ordinary
+// function as KeyLogEntry mutator.
+type KeyLogEntryFunc func(context.Context, *ent.KeyLogEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KeyLogEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KeyLogEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeyLogEntryMutation", m)
+}
+
+// The KeyLogHeadFunc type is an adapter to allow the use of ordinary
+// function as KeyLogHead mutator.
+type KeyLogHeadFunc func(context.Context, *ent.KeyLogHeadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KeyLogHeadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KeyLogHeadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeyLogHeadMutation", m)
+}
+
+// The KeyLogNodeFunc type is an adapter to allow the use of ordinary
+// function as KeyLogNode mutator.
+type KeyLogNodeFunc func(context.Context, *ent.KeyLogNodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KeyLogNodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KeyLogNodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeyLogNodeMutation", m)
+}
+
+// The KeyPackageFunc type is an adapter to allow the use of ordinary
+// function as KeyPackage mutator.
+type KeyPackageFunc func(context.Context, *ent.KeyPackageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KeyPackageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KeyPackageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KeyPackageMutation", m)
+}
+
+// The LoginThrottleFunc type is an adapter to allow the use of ordinary
+// function as LoginThrottle mutator.
+type LoginThrottleFunc func(context.Context, *ent.LoginThrottleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoginThrottleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoginThrottleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoginThrottleMutation", m)
+}
+
 // The MediaFunc type is an adapter to allow the use of ordinary
 // function as Media mutator.
 type MediaFunc func(context.Context, *ent.MediaMutation) (ent.Value, error)
@@ -81,6 +249,42 @@ func (f MessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageMutation", m)
 }
 
+// The MessageEnvelopeFunc type is an adapter to allow the use of ordinary
+// function as MessageEnvelope mutator.
+type MessageEnvelopeFunc func(context.Context, *ent.MessageEnvelopeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageEnvelopeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessageEnvelopeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageEnvelopeMutation", m)
+}
+
+// The MlsCommitFunc type is an adapter to allow the use of ordinary
+// function as MlsCommit mutator.
+type MlsCommitFunc func(context.Context, *ent.MlsCommitMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MlsCommitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MlsCommitMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MlsCommitMutation", m)
+}
+
+// The MlsWelcomeFunc type is an adapter to allow the use of ordinary
+// function as MlsWelcome mutator.
+type MlsWelcomeFunc func(context.Context, *ent.MlsWelcomeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MlsWelcomeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MlsWelcomeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MlsWelcomeMutation", m)
+}
+
 // The NotificationFunc type is an adapter to allow the use of ordinary
 // function as Notification mutator.
 type NotificationFunc func(context.Context, *ent.NotificationMutation) (ent.Value, error)
@@ -93,6 +297,30 @@ func (f NotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationMutation", m)
 }
 
+// The OneTimePreKeyFunc type is an adapter to allow the use of ordinary
+// function as OneTimePreKey mutator.
+type OneTimePreKeyFunc func(context.Context, *ent.OneTimePreKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OneTimePreKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OneTimePreKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OneTimePreKeyMutation", m)
+}
+
+// The RecoveryCodeFunc type is an adapter to allow the use of ordinary
+// function as RecoveryCode mutator.
+type RecoveryCodeFunc func(context.Context, *ent.RecoveryCodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecoveryCodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RecoveryCodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecoveryCodeMutation", m)
+}
+
 // The RoomFunc type is an adapter to allow the use of ordinary
 // function as Room mutator.
 type RoomFunc func(context.Context, *ent.RoomMutation) (ent.Value, error)
@@ -117,6 +345,54 @@ func (f RoomMembershipFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoomMembershipMutation", m)
 }
 
+// The SenderKeyDistributionFunc type is an adapter to allow the use of ordinary
+// function as SenderKeyDistribution mutator.
+type SenderKeyDistributionFunc func(context.Context, *ent.SenderKeyDistributionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SenderKeyDistributionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SenderKeyDistributionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SenderKeyDistributionMutation", m)
+}
+
+// The SessionFunc type is an adapter to allow the use of ordinary
+// function as Session mutator.
+type SessionFunc func(context.Context, *ent.SessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionMutation", m)
+}
+
+// The SignedPreKeyFunc type is an adapter to allow the use of ordinary
+// function as SignedPreKey mutator.
+type SignedPreKeyFunc func(context.Context, *ent.SignedPreKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SignedPreKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SignedPreKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SignedPreKeyMutation", m)
+}
+
+// The TotpSecretFunc type is an adapter to allow the use of ordinary
+// function as TotpSecret mutator.
+type TotpSecretFunc func(context.Context, *ent.TotpSecretMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TotpSecretFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TotpSecretMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TotpSecretMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykey"
+)
+
+// IdentityKey is the model entity for the IdentityKey schema.
+type IdentityKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey string `json:"public_key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IdentityKeyQuery when eager-loading is set.
+	Edges        IdentityKeyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// IdentityKeyEdges holds the relations/edges for other nodes in the graph.
+type IdentityKeyEdges struct {
+	// Device holds the value of the device edge.
+	Device *Device `json:"device,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DeviceOrErr returns the Device value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IdentityKeyEdges) DeviceOrErr() (*Device, error) {
+	if e.Device != nil {
+		return e.Device, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: device.Label}
+	}
+	return nil, &NotLoadedError{edge: "device"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IdentityKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case identitykey.FieldID:
+			values[i] = new(sql.NullInt64)
+		case identitykey.FieldPublicKey:
+			values[i] = new(sql.NullString)
+		case identitykey.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IdentityKey fields.
+func (ik *IdentityKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case identitykey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ik.ID = int(value.Int64)
+		case identitykey.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value.Valid {
+				ik.PublicKey = value.String
+			}
+		case identitykey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ik.CreatedAt = value.Time
+			}
+		default:
+			ik.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IdentityKey.
+// This includes values selected through modifiers, order, etc.
+func (ik *IdentityKey) Value(name string) (ent.Value, error) {
+	return ik.selectValues.Get(name)
+}
+
+// QueryDevice queries the "device" edge of the IdentityKey entity.
+func (ik *IdentityKey) QueryDevice() *DeviceQuery {
+	return NewIdentityKeyClient(ik.config).QueryDevice(ik)
+}
+
+// Update returns a builder for updating this IdentityKey.
+// Note that you need to call IdentityKey.Unwrap() before calling this method if this IdentityKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ik *IdentityKey) Update() *IdentityKeyUpdateOne {
+	return NewIdentityKeyClient(ik.config).UpdateOne(ik)
+}
+
+// Unwrap unwraps the IdentityKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ik *IdentityKey) Unwrap() *IdentityKey {
+	_tx, ok := ik.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IdentityKey is not a transactional entity")
+	}
+	ik.config.driver = _tx.drv
+	return ik
+}
+
+// String implements the fmt.Stringer.
+func (ik *IdentityKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("IdentityKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ik.ID))
+	builder.WriteString("public_key=")
+	builder.WriteString(ik.PublicKey)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ik.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IdentityKeys is a parsable slice of IdentityKey.
+type IdentityKeys []*IdentityKey
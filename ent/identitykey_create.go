@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykey"
+)
+
+// IdentityKeyCreate is the builder for creating a IdentityKey entity.
+type IdentityKeyCreate struct {
+	config
+	mutation *IdentityKeyMutation
+	hooks    []Hook
+}
+
+// SetPublicKey sets the "public_key" field.
+func (ikc *IdentityKeyCreate) SetPublicKey(s string) *IdentityKeyCreate {
+	ikc.mutation.SetPublicKey(s)
+	return ikc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ikc *IdentityKeyCreate) SetCreatedAt(t time.Time) *IdentityKeyCreate {
+	ikc.mutation.SetCreatedAt(t)
+	return ikc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ikc *IdentityKeyCreate) SetNillableCreatedAt(t *time.Time) *IdentityKeyCreate {
+	if t != nil {
+		ikc.SetCreatedAt(*t)
+	}
+	return ikc
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (ikc *IdentityKeyCreate) SetDeviceID(id int) *IdentityKeyCreate {
+	ikc.mutation.SetDeviceID(id)
+	return ikc
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (ikc *IdentityKeyCreate) SetDevice(d *Device) *IdentityKeyCreate {
+	return ikc.SetDeviceID(d.ID)
+}
+
+// Mutation returns the IdentityKeyMutation object of the builder.
+func (ikc *IdentityKeyCreate) Mutation() *IdentityKeyMutation {
+	return ikc.mutation
+}
+
+// Save creates the IdentityKey in the database.
+func (ikc *IdentityKeyCreate) Save(ctx context.Context) (*IdentityKey, error) {
+	ikc.defaults()
+	return withHooks(ctx, ikc.sqlSave, ikc.mutation, ikc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ikc *IdentityKeyCreate) SaveX(ctx context.Context) *IdentityKey {
+	v, err := ikc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikc *IdentityKeyCreate) Exec(ctx context.Context) error {
+	_, err := ikc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikc *IdentityKeyCreate) ExecX(ctx context.Context) {
+	if err := ikc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ikc *IdentityKeyCreate) defaults() {
+	if _, ok := ikc.mutation.CreatedAt(); !ok {
+		v := identitykey.DefaultCreatedAt()
+		ikc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikc *IdentityKeyCreate) check() error {
+	if _, ok := ikc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "IdentityKey.public_key"`)}
+	}
+	if v, ok := ikc.mutation.PublicKey(); ok {
+		if err := identitykey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "IdentityKey.public_key": %w`, err)}
+		}
+	}
+	if _, ok := ikc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IdentityKey.created_at"`)}
+	}
+	if _, ok := ikc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`ent: missing required edge "IdentityKey.device"`)}
+	}
+	return nil
+}
+
+func (ikc *IdentityKeyCreate) sqlSave(ctx context.Context) (*IdentityKey, error) {
+	if err := ikc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ikc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ikc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ikc.mutation.id = &_node.ID
+	ikc.mutation.done = true
+	return _node, nil
+}
+
+func (ikc *IdentityKeyCreate) createSpec() (*IdentityKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IdentityKey{config: ikc.config}
+		_spec = sqlgraph.NewCreateSpec(identitykey.Table, sqlgraph.NewFieldSpec(identitykey.FieldID, field.TypeInt))
+	)
+	if value, ok := ikc.mutation.PublicKey(); ok {
+		_spec.SetField(identitykey.FieldPublicKey, field.TypeString, value)
+		_node.PublicKey = value
+	}
+	if value, ok := ikc.mutation.CreatedAt(); ok {
+		_spec.SetField(identitykey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ikc.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   identitykey.DeviceTable,
+			Columns: []string{identitykey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IdentityKeyCreateBulk is the builder for creating many IdentityKey entities in bulk.
+type IdentityKeyCreateBulk struct {
+	config
+	err      error
+	builders []*IdentityKeyCreate
+}
+
+// Save creates the IdentityKey entities in the database.
+func (ikcb *IdentityKeyCreateBulk) Save(ctx context.Context) ([]*IdentityKey, error) {
+	if ikcb.err != nil {
+		return nil, ikcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ikcb.builders))
+	nodes := make([]*IdentityKey, len(ikcb.builders))
+	mutators := make([]Mutator, len(ikcb.builders))
+	for i := range ikcb.builders {
+		func(i int, root context.Context) {
+			builder := ikcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdentityKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ikcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ikcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ikcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikcb *IdentityKeyCreateBulk) SaveX(ctx context.Context) []*IdentityKey {
+	v, err := ikcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikcb *IdentityKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := ikcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikcb *IdentityKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := ikcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
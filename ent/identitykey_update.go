@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykey"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// IdentityKeyUpdate is the builder for updating IdentityKey entities.
+type IdentityKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdentityKeyMutation
+}
+
+// Where appends a list predicates to the IdentityKeyUpdate builder.
+func (iku *IdentityKeyUpdate) Where(ps ...predicate.IdentityKey) *IdentityKeyUpdate {
+	iku.mutation.Where(ps...)
+	return iku
+}
+
+// SetPublicKey sets the "public_key" field.
+func (iku *IdentityKeyUpdate) SetPublicKey(s string) *IdentityKeyUpdate {
+	iku.mutation.SetPublicKey(s)
+	return iku
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (iku *IdentityKeyUpdate) SetNillablePublicKey(s *string) *IdentityKeyUpdate {
+	if s != nil {
+		iku.SetPublicKey(*s)
+	}
+	return iku
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iku *IdentityKeyUpdate) SetCreatedAt(t time.Time) *IdentityKeyUpdate {
+	iku.mutation.SetCreatedAt(t)
+	return iku
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iku *IdentityKeyUpdate) SetNillableCreatedAt(t *time.Time) *IdentityKeyUpdate {
+	if t != nil {
+		iku.SetCreatedAt(*t)
+	}
+	return iku
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (iku *IdentityKeyUpdate) SetDeviceID(id int) *IdentityKeyUpdate {
+	iku.mutation.SetDeviceID(id)
+	return iku
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (iku *IdentityKeyUpdate) SetDevice(d *Device) *IdentityKeyUpdate {
+	return iku.SetDeviceID(d.ID)
+}
+
+// Mutation returns the IdentityKeyMutation object of the builder.
+func (iku *IdentityKeyUpdate) Mutation() *IdentityKeyMutation {
+	return iku.mutation
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (iku *IdentityKeyUpdate) ClearDevice() *IdentityKeyUpdate {
+	iku.mutation.ClearDevice()
+	return iku
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iku *IdentityKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iku.sqlSave, iku.mutation, iku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iku *IdentityKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := iku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iku *IdentityKeyUpdate) Exec(ctx context.Context) error {
+	_, err := iku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iku *IdentityKeyUpdate) ExecX(ctx context.Context) {
+	if err := iku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iku *IdentityKeyUpdate) check() error {
+	if v, ok := iku.mutation.PublicKey(); ok {
+		if err := identitykey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "IdentityKey.public_key": %w`, err)}
+		}
+	}
+	if _, ok := iku.mutation.DeviceID(); iku.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "IdentityKey.device"`)
+	}
+	return nil
+}
+
+func (iku *IdentityKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(identitykey.Table, identitykey.Columns, sqlgraph.NewFieldSpec(identitykey.FieldID, field.TypeInt))
+	if ps := iku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iku.mutation.PublicKey(); ok {
+		_spec.SetField(identitykey.FieldPublicKey, field.TypeString, value)
+	}
+	if value, ok := iku.mutation.CreatedAt(); ok {
+		_spec.SetField(identitykey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if iku.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   identitykey.DeviceTable,
+			Columns: []string{identitykey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iku.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   identitykey.DeviceTable,
+			Columns: []string{identitykey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{identitykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iku.mutation.done = true
+	return n, nil
+}
+
+// IdentityKeyUpdateOne is the builder for updating a single IdentityKey entity.
+type IdentityKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IdentityKeyMutation
+}
+
+// SetPublicKey sets the "public_key" field.
+func (ikuo *IdentityKeyUpdateOne) SetPublicKey(s string) *IdentityKeyUpdateOne {
+	ikuo.mutation.SetPublicKey(s)
+	return ikuo
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (ikuo *IdentityKeyUpdateOne) SetNillablePublicKey(s *string) *IdentityKeyUpdateOne {
+	if s != nil {
+		ikuo.SetPublicKey(*s)
+	}
+	return ikuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ikuo *IdentityKeyUpdateOne) SetCreatedAt(t time.Time) *IdentityKeyUpdateOne {
+	ikuo.mutation.SetCreatedAt(t)
+	return ikuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ikuo *IdentityKeyUpdateOne) SetNillableCreatedAt(t *time.Time) *IdentityKeyUpdateOne {
+	if t != nil {
+		ikuo.SetCreatedAt(*t)
+	}
+	return ikuo
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (ikuo *IdentityKeyUpdateOne) SetDeviceID(id int) *IdentityKeyUpdateOne {
+	ikuo.mutation.SetDeviceID(id)
+	return ikuo
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (ikuo *IdentityKeyUpdateOne) SetDevice(d *Device) *IdentityKeyUpdateOne {
+	return ikuo.SetDeviceID(d.ID)
+}
+
+// Mutation returns the IdentityKeyMutation object of the builder.
+func (ikuo *IdentityKeyUpdateOne) Mutation() *IdentityKeyMutation {
+	return ikuo.mutation
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (ikuo *IdentityKeyUpdateOne) ClearDevice() *IdentityKeyUpdateOne {
+	ikuo.mutation.ClearDevice()
+	return ikuo
+}
+
+// Where appends a list predicates to the IdentityKeyUpdate builder.
+func (ikuo *IdentityKeyUpdateOne) Where(ps ...predicate.IdentityKey) *IdentityKeyUpdateOne {
+	ikuo.mutation.Where(ps...)
+	return ikuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ikuo *IdentityKeyUpdateOne) Select(field string, fields ...string) *IdentityKeyUpdateOne {
+	ikuo.fields = append([]string{field}, fields...)
+	return ikuo
+}
+
+// Save executes the query and returns the updated IdentityKey entity.
+func (ikuo *IdentityKeyUpdateOne) Save(ctx context.Context) (*IdentityKey, error) {
+	return withHooks(ctx, ikuo.sqlSave, ikuo.mutation, ikuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikuo *IdentityKeyUpdateOne) SaveX(ctx context.Context) *IdentityKey {
+	node, err := ikuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ikuo *IdentityKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := ikuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikuo *IdentityKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := ikuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikuo *IdentityKeyUpdateOne) check() error {
+	if v, ok := ikuo.mutation.PublicKey(); ok {
+		if err := identitykey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "IdentityKey.public_key": %w`, err)}
+		}
+	}
+	if _, ok := ikuo.mutation.DeviceID(); ikuo.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "IdentityKey.device"`)
+	}
+	return nil
+}
+
+func (ikuo *IdentityKeyUpdateOne) sqlSave(ctx context.Context) (_node *IdentityKey, err error) {
+	if err := ikuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(identitykey.Table, identitykey.Columns, sqlgraph.NewFieldSpec(identitykey.FieldID, field.TypeInt))
+	id, ok := ikuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IdentityKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ikuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, identitykey.FieldID)
+		for _, f := range fields {
+			if !identitykey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != identitykey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ikuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ikuo.mutation.PublicKey(); ok {
+		_spec.SetField(identitykey.FieldPublicKey, field.TypeString, value)
+	}
+	if value, ok := ikuo.mutation.CreatedAt(); ok {
+		_spec.SetField(identitykey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if ikuo.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   identitykey.DeviceTable,
+			Columns: []string{identitykey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ikuo.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   identitykey.DeviceTable,
+			Columns: []string{identitykey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &IdentityKey{config: ikuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ikuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{identitykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ikuo.mutation.done = true
+	return _node, nil
+}
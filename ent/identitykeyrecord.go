@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// IdentityKeyRecord is the model entity for the IdentityKeyRecord schema.
+type IdentityKeyRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey string `json:"public_key,omitempty"`
+	// DeviceNumber holds the value of the "device_number" field.
+	DeviceNumber int `json:"device_number,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IdentityKeyRecordQuery when eager-loading is set.
+	Edges                      IdentityKeyRecordEdges `json:"edges"`
+	identity_key_record_user   *int
+	identity_key_record_device *int
+	selectValues               sql.SelectValues
+}
+
+// IdentityKeyRecordEdges holds the relations/edges for other nodes in the graph.
+type IdentityKeyRecordEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Device holds the value of the device edge.
+	Device *Device `json:"device,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IdentityKeyRecordEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// DeviceOrErr returns the Device value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IdentityKeyRecordEdges) DeviceOrErr() (*Device, error) {
+	if e.Device != nil {
+		return e.Device, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: device.Label}
+	}
+	return nil, &NotLoadedError{edge: "device"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IdentityKeyRecord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case identitykeyrecord.FieldID, identitykeyrecord.FieldDeviceNumber:
+			values[i] = new(sql.NullInt64)
+		case identitykeyrecord.FieldPublicKey:
+			values[i] = new(sql.NullString)
+		case identitykeyrecord.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case identitykeyrecord.ForeignKeys[0]: // identity_key_record_user
+			values[i] = new(sql.NullInt64)
+		case identitykeyrecord.ForeignKeys[1]: // identity_key_record_device
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IdentityKeyRecord fields.
+func (ikr *IdentityKeyRecord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case identitykeyrecord.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ikr.ID = int(value.Int64)
+		case identitykeyrecord.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value.Valid {
+				ikr.PublicKey = value.String
+			}
+		case identitykeyrecord.FieldDeviceNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field device_number", values[i])
+			} else if value.Valid {
+				ikr.DeviceNumber = int(value.Int64)
+			}
+		case identitykeyrecord.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ikr.CreatedAt = value.Time
+			}
+		case identitykeyrecord.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field identity_key_record_user", value)
+			} else if value.Valid {
+				ikr.identity_key_record_user = new(int)
+				*ikr.identity_key_record_user = int(value.Int64)
+			}
+		case identitykeyrecord.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field identity_key_record_device", value)
+			} else if value.Valid {
+				ikr.identity_key_record_device = new(int)
+				*ikr.identity_key_record_device = int(value.Int64)
+			}
+		default:
+			ikr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IdentityKeyRecord.
+// This includes values selected through modifiers, order, etc.
+func (ikr *IdentityKeyRecord) Value(name string) (ent.Value, error) {
+	return ikr.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the IdentityKeyRecord entity.
+func (ikr *IdentityKeyRecord) QueryUser() *UserQuery {
+	return NewIdentityKeyRecordClient(ikr.config).QueryUser(ikr)
+}
+
+// QueryDevice queries the "device" edge of the IdentityKeyRecord entity.
+func (ikr *IdentityKeyRecord) QueryDevice() *DeviceQuery {
+	return NewIdentityKeyRecordClient(ikr.config).QueryDevice(ikr)
+}
+
+// Update returns a builder for updating this IdentityKeyRecord.
+// Note that you need to call IdentityKeyRecord.Unwrap() before calling this method if this IdentityKeyRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ikr *IdentityKeyRecord) Update() *IdentityKeyRecordUpdateOne {
+	return NewIdentityKeyRecordClient(ikr.config).UpdateOne(ikr)
+}
+
+// Unwrap unwraps the IdentityKeyRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ikr *IdentityKeyRecord) Unwrap() *IdentityKeyRecord {
+	_tx, ok := ikr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IdentityKeyRecord is not a transactional entity")
+	}
+	ikr.config.driver = _tx.drv
+	return ikr
+}
+
+// String implements the fmt.Stringer.
+func (ikr *IdentityKeyRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("IdentityKeyRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ikr.ID))
+	builder.WriteString("public_key=")
+	builder.WriteString(ikr.PublicKey)
+	builder.WriteString(", ")
+	builder.WriteString("device_number=")
+	builder.WriteString(fmt.Sprintf("%v", ikr.DeviceNumber))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ikr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IdentityKeyRecords is a parsable slice of IdentityKeyRecord.
+type IdentityKeyRecords []*IdentityKeyRecord
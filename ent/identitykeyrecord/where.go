@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package identitykeyrecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldLTE(FieldID, id))
+}
+
+// PublicKey applies equality check predicate on the "public_key" field. It's identical to PublicKeyEQ.
+func PublicKey(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// DeviceNumber applies equality check predicate on the "device_number" field. It's identical to DeviceNumberEQ.
+func DeviceNumber(v int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldEQ(FieldDeviceNumber, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PublicKeyEQ applies the EQ predicate on the "public_key" field.
+func PublicKeyEQ(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldEQ(FieldPublicKey, v))
+}
+
+// PublicKeyNEQ applies the NEQ predicate on the "public_key" field.
+func PublicKeyNEQ(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldNEQ(FieldPublicKey, v))
+}
+
+// PublicKeyIn applies the In predicate on the "public_key" field.
+func PublicKeyIn(vs ...string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyNotIn applies the NotIn predicate on the "public_key" field.
+func PublicKeyNotIn(vs ...string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldNotIn(FieldPublicKey, vs...))
+}
+
+// PublicKeyGT applies the GT predicate on the "public_key" field.
+func PublicKeyGT(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldGT(FieldPublicKey, v))
+}
+
+// PublicKeyGTE applies the GTE predicate on the "public_key" field.
+func PublicKeyGTE(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldGTE(FieldPublicKey, v))
+}
+
+// PublicKeyLT applies the LT predicate on the "public_key" field.
+func PublicKeyLT(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldLT(FieldPublicKey, v))
+}
+
+// PublicKeyLTE applies the LTE predicate on the "public_key" field.
+func PublicKeyLTE(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldLTE(FieldPublicKey, v))
+}
+
+// PublicKeyContains applies the Contains predicate on the "public_key" field.
+func PublicKeyContains(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldContains(FieldPublicKey, v))
+}
+
+// PublicKeyHasPrefix applies the HasPrefix predicate on the "public_key" field.
+func PublicKeyHasPrefix(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldHasPrefix(FieldPublicKey, v))
+}
+
+// PublicKeyHasSuffix applies the HasSuffix predicate on the "public_key" field.
+func PublicKeyHasSuffix(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldHasSuffix(FieldPublicKey, v))
+}
+
+// PublicKeyEqualFold applies the EqualFold predicate on the "public_key" field.
+func PublicKeyEqualFold(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldEqualFold(FieldPublicKey, v))
+}
+
+// PublicKeyContainsFold applies the ContainsFold predicate on the "public_key" field.
+func PublicKeyContainsFold(v string) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldContainsFold(FieldPublicKey, v))
+}
+
+// DeviceNumberEQ applies the EQ predicate on the "device_number" field.
+func DeviceNumberEQ(v int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldEQ(FieldDeviceNumber, v))
+}
+
+// DeviceNumberNEQ applies the NEQ predicate on the "device_number" field.
+func DeviceNumberNEQ(v int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldNEQ(FieldDeviceNumber, v))
+}
+
+// DeviceNumberIn applies the In predicate on the "device_number" field.
+func DeviceNumberIn(vs ...int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldIn(FieldDeviceNumber, vs...))
+}
+
+// DeviceNumberNotIn applies the NotIn predicate on the "device_number" field.
+func DeviceNumberNotIn(vs ...int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldNotIn(FieldDeviceNumber, vs...))
+}
+
+// DeviceNumberGT applies the GT predicate on the "device_number" field.
+func DeviceNumberGT(v int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldGT(FieldDeviceNumber, v))
+}
+
+// DeviceNumberGTE applies the GTE predicate on the "device_number" field.
+func DeviceNumberGTE(v int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldGTE(FieldDeviceNumber, v))
+}
+
+// DeviceNumberLT applies the LT predicate on the "device_number" field.
+func DeviceNumberLT(v int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldLT(FieldDeviceNumber, v))
+}
+
+// DeviceNumberLTE applies the LTE predicate on the "device_number" field.
+func DeviceNumberLTE(v int) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldLTE(FieldDeviceNumber, v))
+}
+
+// DeviceNumberIsNil applies the IsNil predicate on the "device_number" field.
+func DeviceNumberIsNil() predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldIsNull(FieldDeviceNumber))
+}
+
+// DeviceNumberNotNil applies the NotNil predicate on the "device_number" field.
+func DeviceNumberNotNil() predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldNotNull(FieldDeviceNumber))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDevice applies the HasEdge predicate on the "device" edge.
+func HasDevice() predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, DeviceTable, DeviceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeviceWith applies the HasEdge predicate on the "device" edge with a given conditions (other predicates).
+func HasDeviceWith(preds ...predicate.Device) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(func(s *sql.Selector) {
+		step := newDeviceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IdentityKeyRecord) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IdentityKeyRecord) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IdentityKeyRecord) predicate.IdentityKeyRecord {
+	return predicate.IdentityKeyRecord(sql.NotPredicates(p))
+}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// IdentityKeyRecordCreate is the builder for creating a IdentityKeyRecord entity.
+type IdentityKeyRecordCreate struct {
+	config
+	mutation *IdentityKeyRecordMutation
+	hooks    []Hook
+}
+
+// SetPublicKey sets the "public_key" field.
+func (ikrc *IdentityKeyRecordCreate) SetPublicKey(s string) *IdentityKeyRecordCreate {
+	ikrc.mutation.SetPublicKey(s)
+	return ikrc
+}
+
+// SetDeviceNumber sets the "device_number" field.
+func (ikrc *IdentityKeyRecordCreate) SetDeviceNumber(i int) *IdentityKeyRecordCreate {
+	ikrc.mutation.SetDeviceNumber(i)
+	return ikrc
+}
+
+// SetNillableDeviceNumber sets the "device_number" field if the given value is not nil.
+func (ikrc *IdentityKeyRecordCreate) SetNillableDeviceNumber(i *int) *IdentityKeyRecordCreate {
+	if i != nil {
+		ikrc.SetDeviceNumber(*i)
+	}
+	return ikrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ikrc *IdentityKeyRecordCreate) SetCreatedAt(t time.Time) *IdentityKeyRecordCreate {
+	ikrc.mutation.SetCreatedAt(t)
+	return ikrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ikrc *IdentityKeyRecordCreate) SetNillableCreatedAt(t *time.Time) *IdentityKeyRecordCreate {
+	if t != nil {
+		ikrc.SetCreatedAt(*t)
+	}
+	return ikrc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ikrc *IdentityKeyRecordCreate) SetUserID(id int) *IdentityKeyRecordCreate {
+	ikrc.mutation.SetUserID(id)
+	return ikrc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (ikrc *IdentityKeyRecordCreate) SetNillableUserID(id *int) *IdentityKeyRecordCreate {
+	if id != nil {
+		ikrc = ikrc.SetUserID(*id)
+	}
+	return ikrc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ikrc *IdentityKeyRecordCreate) SetUser(u *User) *IdentityKeyRecordCreate {
+	return ikrc.SetUserID(u.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (ikrc *IdentityKeyRecordCreate) SetDeviceID(id int) *IdentityKeyRecordCreate {
+	ikrc.mutation.SetDeviceID(id)
+	return ikrc
+}
+
+// SetNillableDeviceID sets the "device" edge to the Device entity by ID if the given value is not nil.
+func (ikrc *IdentityKeyRecordCreate) SetNillableDeviceID(id *int) *IdentityKeyRecordCreate {
+	if id != nil {
+		ikrc = ikrc.SetDeviceID(*id)
+	}
+	return ikrc
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (ikrc *IdentityKeyRecordCreate) SetDevice(d *Device) *IdentityKeyRecordCreate {
+	return ikrc.SetDeviceID(d.ID)
+}
+
+// Mutation returns the IdentityKeyRecordMutation object of the builder.
+func (ikrc *IdentityKeyRecordCreate) Mutation() *IdentityKeyRecordMutation {
+	return ikrc.mutation
+}
+
+// Save creates the IdentityKeyRecord in the database.
+func (ikrc *IdentityKeyRecordCreate) Save(ctx context.Context) (*IdentityKeyRecord, error) {
+	ikrc.defaults()
+	return withHooks(ctx, ikrc.sqlSave, ikrc.mutation, ikrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ikrc *IdentityKeyRecordCreate) SaveX(ctx context.Context) *IdentityKeyRecord {
+	v, err := ikrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikrc *IdentityKeyRecordCreate) Exec(ctx context.Context) error {
+	_, err := ikrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikrc *IdentityKeyRecordCreate) ExecX(ctx context.Context) {
+	if err := ikrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ikrc *IdentityKeyRecordCreate) defaults() {
+	if _, ok := ikrc.mutation.CreatedAt(); !ok {
+		v := identitykeyrecord.DefaultCreatedAt()
+		ikrc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikrc *IdentityKeyRecordCreate) check() error {
+	if _, ok := ikrc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "IdentityKeyRecord.public_key"`)}
+	}
+	if v, ok := ikrc.mutation.PublicKey(); ok {
+		if err := identitykeyrecord.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "IdentityKeyRecord.public_key": %w`, err)}
+		}
+	}
+	if v, ok := ikrc.mutation.DeviceNumber(); ok {
+		if err := identitykeyrecord.DeviceNumberValidator(v); err != nil {
+			return &ValidationError{Name: "device_number", err: fmt.Errorf(`ent: validator failed for field "IdentityKeyRecord.device_number": %w`, err)}
+		}
+	}
+	if _, ok := ikrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IdentityKeyRecord.created_at"`)}
+	}
+	return nil
+}
+
+func (ikrc *IdentityKeyRecordCreate) sqlSave(ctx context.Context) (*IdentityKeyRecord, error) {
+	if err := ikrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ikrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ikrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ikrc.mutation.id = &_node.ID
+	ikrc.mutation.done = true
+	return _node, nil
+}
+
+func (ikrc *IdentityKeyRecordCreate) createSpec() (*IdentityKeyRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IdentityKeyRecord{config: ikrc.config}
+		_spec = sqlgraph.NewCreateSpec(identitykeyrecord.Table, sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt))
+	)
+	if value, ok := ikrc.mutation.PublicKey(); ok {
+		_spec.SetField(identitykeyrecord.FieldPublicKey, field.TypeString, value)
+		_node.PublicKey = value
+	}
+	if value, ok := ikrc.mutation.DeviceNumber(); ok {
+		_spec.SetField(identitykeyrecord.FieldDeviceNumber, field.TypeInt, value)
+		_node.DeviceNumber = value
+	}
+	if value, ok := ikrc.mutation.CreatedAt(); ok {
+		_spec.SetField(identitykeyrecord.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ikrc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitykeyrecord.UserTable,
+			Columns: []string{identitykeyrecord.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.identity_key_record_user = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ikrc.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitykeyrecord.DeviceTable,
+			Columns: []string{identitykeyrecord.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.identity_key_record_device = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IdentityKeyRecordCreateBulk is the builder for creating many IdentityKeyRecord entities in bulk.
+type IdentityKeyRecordCreateBulk struct {
+	config
+	err      error
+	builders []*IdentityKeyRecordCreate
+}
+
+// Save creates the IdentityKeyRecord entities in the database.
+func (ikrcb *IdentityKeyRecordCreateBulk) Save(ctx context.Context) ([]*IdentityKeyRecord, error) {
+	if ikrcb.err != nil {
+		return nil, ikrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ikrcb.builders))
+	nodes := make([]*IdentityKeyRecord, len(ikrcb.builders))
+	mutators := make([]Mutator, len(ikrcb.builders))
+	for i := range ikrcb.builders {
+		func(i int, root context.Context) {
+			builder := ikrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdentityKeyRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ikrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ikrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ikrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikrcb *IdentityKeyRecordCreateBulk) SaveX(ctx context.Context) []*IdentityKeyRecord {
+	v, err := ikrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikrcb *IdentityKeyRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := ikrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikrcb *IdentityKeyRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := ikrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// IdentityKeyRecordDelete is the builder for deleting a IdentityKeyRecord entity.
+type IdentityKeyRecordDelete struct {
+	config
+	hooks    []Hook
+	mutation *IdentityKeyRecordMutation
+}
+
+// Where appends a list predicates to the IdentityKeyRecordDelete builder.
+func (ikrd *IdentityKeyRecordDelete) Where(ps ...predicate.IdentityKeyRecord) *IdentityKeyRecordDelete {
+	ikrd.mutation.Where(ps...)
+	return ikrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ikrd *IdentityKeyRecordDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ikrd.sqlExec, ikrd.mutation, ikrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikrd *IdentityKeyRecordDelete) ExecX(ctx context.Context) int {
+	n, err := ikrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ikrd *IdentityKeyRecordDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(identitykeyrecord.Table, sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt))
+	if ps := ikrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ikrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ikrd.mutation.done = true
+	return affected, err
+}
+
+// IdentityKeyRecordDeleteOne is the builder for deleting a single IdentityKeyRecord entity.
+type IdentityKeyRecordDeleteOne struct {
+	ikrd *IdentityKeyRecordDelete
+}
+
+// Where appends a list predicates to the IdentityKeyRecordDelete builder.
+func (ikrdo *IdentityKeyRecordDeleteOne) Where(ps ...predicate.IdentityKeyRecord) *IdentityKeyRecordDeleteOne {
+	ikrdo.ikrd.mutation.Where(ps...)
+	return ikrdo
+}
+
+// Exec executes the deletion query.
+func (ikrdo *IdentityKeyRecordDeleteOne) Exec(ctx context.Context) error {
+	n, err := ikrdo.ikrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{identitykeyrecord.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikrdo *IdentityKeyRecordDeleteOne) ExecX(ctx context.Context) {
+	if err := ikrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
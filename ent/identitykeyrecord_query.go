@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// IdentityKeyRecordQuery is the builder for querying IdentityKeyRecord entities.
+type IdentityKeyRecordQuery struct {
+	config
+	ctx        *QueryContext
+	order      []identitykeyrecord.OrderOption
+	inters     []Interceptor
+	predicates []predicate.IdentityKeyRecord
+	withUser   *UserQuery
+	withDevice *DeviceQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IdentityKeyRecordQuery builder.
+func (ikrq *IdentityKeyRecordQuery) Where(ps ...predicate.IdentityKeyRecord) *IdentityKeyRecordQuery {
+	ikrq.predicates = append(ikrq.predicates, ps...)
+	return ikrq
+}
+
+// Limit the number of records to be returned by this query.
+func (ikrq *IdentityKeyRecordQuery) Limit(limit int) *IdentityKeyRecordQuery {
+	ikrq.ctx.Limit = &limit
+	return ikrq
+}
+
+// Offset to start from.
+func (ikrq *IdentityKeyRecordQuery) Offset(offset int) *IdentityKeyRecordQuery {
+	ikrq.ctx.Offset = &offset
+	return ikrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ikrq *IdentityKeyRecordQuery) Unique(unique bool) *IdentityKeyRecordQuery {
+	ikrq.ctx.Unique = &unique
+	return ikrq
+}
+
+// Order specifies how the records should be ordered.
+func (ikrq *IdentityKeyRecordQuery) Order(o ...identitykeyrecord.OrderOption) *IdentityKeyRecordQuery {
+	ikrq.order = append(ikrq.order, o...)
+	return ikrq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (ikrq *IdentityKeyRecordQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: ikrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ikrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ikrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identitykeyrecord.Table, identitykeyrecord.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, identitykeyrecord.UserTable, identitykeyrecord.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ikrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDevice chains the current query on the "device" edge.
+func (ikrq *IdentityKeyRecordQuery) QueryDevice() *DeviceQuery {
+	query := (&DeviceClient{config: ikrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ikrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ikrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identitykeyrecord.Table, identitykeyrecord.FieldID, selector),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, identitykeyrecord.DeviceTable, identitykeyrecord.DeviceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ikrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first IdentityKeyRecord entity from the query.
+// Returns a *NotFoundError when no IdentityKeyRecord was found.
+func (ikrq *IdentityKeyRecordQuery) First(ctx context.Context) (*IdentityKeyRecord, error) {
+	nodes, err := ikrq.Limit(1).All(setContextOp(ctx, ikrq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{identitykeyrecord.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ikrq *IdentityKeyRecordQuery) FirstX(ctx context.Context) *IdentityKeyRecord {
+	node, err := ikrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first IdentityKeyRecord ID from the query.
+// Returns a *NotFoundError when no IdentityKeyRecord ID was found.
+func (ikrq *IdentityKeyRecordQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ikrq.Limit(1).IDs(setContextOp(ctx, ikrq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{identitykeyrecord.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ikrq *IdentityKeyRecordQuery) FirstIDX(ctx context.Context) int {
+	id, err := ikrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single IdentityKeyRecord entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one IdentityKeyRecord entity is found.
+// Returns a *NotFoundError when no IdentityKeyRecord entities are found.
+func (ikrq *IdentityKeyRecordQuery) Only(ctx context.Context) (*IdentityKeyRecord, error) {
+	nodes, err := ikrq.Limit(2).All(setContextOp(ctx, ikrq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{identitykeyrecord.Label}
+	default:
+		return nil, &NotSingularError{identitykeyrecord.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ikrq *IdentityKeyRecordQuery) OnlyX(ctx context.Context) *IdentityKeyRecord {
+	node, err := ikrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only IdentityKeyRecord ID in the query.
+// Returns a *NotSingularError when more than one IdentityKeyRecord ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ikrq *IdentityKeyRecordQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ikrq.Limit(2).IDs(setContextOp(ctx, ikrq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{identitykeyrecord.Label}
+	default:
+		err = &NotSingularError{identitykeyrecord.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ikrq *IdentityKeyRecordQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ikrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of IdentityKeyRecords.
+func (ikrq *IdentityKeyRecordQuery) All(ctx context.Context) ([]*IdentityKeyRecord, error) {
+	ctx = setContextOp(ctx, ikrq.ctx, "All")
+	if err := ikrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*IdentityKeyRecord, *IdentityKeyRecordQuery]()
+	return withInterceptors[[]*IdentityKeyRecord](ctx, ikrq, qr, ikrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ikrq *IdentityKeyRecordQuery) AllX(ctx context.Context) []*IdentityKeyRecord {
+	nodes, err := ikrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of IdentityKeyRecord IDs.
+func (ikrq *IdentityKeyRecordQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ikrq.ctx.Unique == nil && ikrq.path != nil {
+		ikrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ikrq.ctx, "IDs")
+	if err = ikrq.Select(identitykeyrecord.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ikrq *IdentityKeyRecordQuery) IDsX(ctx context.Context) []int {
+	ids, err := ikrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ikrq *IdentityKeyRecordQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ikrq.ctx, "Count")
+	if err := ikrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ikrq, querierCount[*IdentityKeyRecordQuery](), ikrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ikrq *IdentityKeyRecordQuery) CountX(ctx context.Context) int {
+	count, err := ikrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ikrq *IdentityKeyRecordQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ikrq.ctx, "Exist")
+	switch _, err := ikrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ikrq *IdentityKeyRecordQuery) ExistX(ctx context.Context) bool {
+	exist, err := ikrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IdentityKeyRecordQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ikrq *IdentityKeyRecordQuery) Clone() *IdentityKeyRecordQuery {
+	if ikrq == nil {
+		return nil
+	}
+	return &IdentityKeyRecordQuery{
+		config:     ikrq.config,
+		ctx:        ikrq.ctx.Clone(),
+		order:      append([]identitykeyrecord.OrderOption{}, ikrq.order...),
+		inters:     append([]Interceptor{}, ikrq.inters...),
+		predicates: append([]predicate.IdentityKeyRecord{}, ikrq.predicates...),
+		withUser:   ikrq.withUser.Clone(),
+		withDevice: ikrq.withDevice.Clone(),
+		// clone intermediate query.
+		sql:  ikrq.sql.Clone(),
+		path: ikrq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (ikrq *IdentityKeyRecordQuery) WithUser(opts ...func(*UserQuery)) *IdentityKeyRecordQuery {
+	query := (&UserClient{config: ikrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ikrq.withUser = query
+	return ikrq
+}
+
+// WithDevice tells the query-builder to eager-load the nodes that are connected to
+// the "device" edge. The optional arguments are used to configure the query builder of the edge.
+func (ikrq *IdentityKeyRecordQuery) WithDevice(opts ...func(*DeviceQuery)) *IdentityKeyRecordQuery {
+	query := (&DeviceClient{config: ikrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ikrq.withDevice = query
+	return ikrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PublicKey string `json:"public_key,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IdentityKeyRecord.Query().
+//		GroupBy(identitykeyrecord.FieldPublicKey).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ikrq *IdentityKeyRecordQuery) GroupBy(field string, fields ...string) *IdentityKeyRecordGroupBy {
+	ikrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &IdentityKeyRecordGroupBy{build: ikrq}
+	grbuild.flds = &ikrq.ctx.Fields
+	grbuild.label = identitykeyrecord.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PublicKey string `json:"public_key,omitempty"`
+//	}
+//
+//	client.IdentityKeyRecord.Query().
+//		Select(identitykeyrecord.FieldPublicKey).
+//		Scan(ctx, &v)
+func (ikrq *IdentityKeyRecordQuery) Select(fields ...string) *IdentityKeyRecordSelect {
+	ikrq.ctx.Fields = append(ikrq.ctx.Fields, fields...)
+	sbuild := &IdentityKeyRecordSelect{IdentityKeyRecordQuery: ikrq}
+	sbuild.label = identitykeyrecord.Label
+	sbuild.flds, sbuild.scan = &ikrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a IdentityKeyRecordSelect configured with the given aggregations.
+func (ikrq *IdentityKeyRecordQuery) Aggregate(fns ...AggregateFunc) *IdentityKeyRecordSelect {
+	return ikrq.Select().Aggregate(fns...)
+}
+
+func (ikrq *IdentityKeyRecordQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ikrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ikrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ikrq.ctx.Fields {
+		if !identitykeyrecord.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ikrq.path != nil {
+		prev, err := ikrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ikrq.sql = prev
+	}
+	return nil
+}
+
+func (ikrq *IdentityKeyRecordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IdentityKeyRecord, error) {
+	var (
+		nodes       = []*IdentityKeyRecord{}
+		withFKs     = ikrq.withFKs
+		_spec       = ikrq.querySpec()
+		loadedTypes = [2]bool{
+			ikrq.withUser != nil,
+			ikrq.withDevice != nil,
+		}
+	)
+	if ikrq.withUser != nil || ikrq.withDevice != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, identitykeyrecord.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*IdentityKeyRecord).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &IdentityKeyRecord{config: ikrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ikrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ikrq.withUser; query != nil {
+		if err := ikrq.loadUser(ctx, query, nodes, nil,
+			func(n *IdentityKeyRecord, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ikrq.withDevice; query != nil {
+		if err := ikrq.loadDevice(ctx, query, nodes, nil,
+			func(n *IdentityKeyRecord, e *Device) { n.Edges.Device = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ikrq *IdentityKeyRecordQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*IdentityKeyRecord, init func(*IdentityKeyRecord), assign func(*IdentityKeyRecord, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*IdentityKeyRecord)
+	for i := range nodes {
+		if nodes[i].identity_key_record_user == nil {
+			continue
+		}
+		fk := *nodes[i].identity_key_record_user
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "identity_key_record_user" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ikrq *IdentityKeyRecordQuery) loadDevice(ctx context.Context, query *DeviceQuery, nodes []*IdentityKeyRecord, init func(*IdentityKeyRecord), assign func(*IdentityKeyRecord, *Device)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*IdentityKeyRecord)
+	for i := range nodes {
+		if nodes[i].identity_key_record_device == nil {
+			continue
+		}
+		fk := *nodes[i].identity_key_record_device
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(device.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "identity_key_record_device" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ikrq *IdentityKeyRecordQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ikrq.querySpec()
+	_spec.Node.Columns = ikrq.ctx.Fields
+	if len(ikrq.ctx.Fields) > 0 {
+		_spec.Unique = ikrq.ctx.Unique != nil && *ikrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ikrq.driver, _spec)
+}
+
+func (ikrq *IdentityKeyRecordQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(identitykeyrecord.Table, identitykeyrecord.Columns, sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt))
+	_spec.From = ikrq.sql
+	if unique := ikrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ikrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ikrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, identitykeyrecord.FieldID)
+		for i := range fields {
+			if fields[i] != identitykeyrecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ikrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ikrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ikrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ikrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ikrq *IdentityKeyRecordQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ikrq.driver.Dialect())
+	t1 := builder.Table(identitykeyrecord.Table)
+	columns := ikrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = identitykeyrecord.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ikrq.sql != nil {
+		selector = ikrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ikrq.ctx.Unique != nil && *ikrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ikrq.predicates {
+		p(selector)
+	}
+	for _, p := range ikrq.order {
+		p(selector)
+	}
+	if offset := ikrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ikrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IdentityKeyRecordGroupBy is the group-by builder for IdentityKeyRecord entities.
+type IdentityKeyRecordGroupBy struct {
+	selector
+	build *IdentityKeyRecordQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ikrgb *IdentityKeyRecordGroupBy) Aggregate(fns ...AggregateFunc) *IdentityKeyRecordGroupBy {
+	ikrgb.fns = append(ikrgb.fns, fns...)
+	return ikrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ikrgb *IdentityKeyRecordGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ikrgb.build.ctx, "GroupBy")
+	if err := ikrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IdentityKeyRecordQuery, *IdentityKeyRecordGroupBy](ctx, ikrgb.build, ikrgb, ikrgb.build.inters, v)
+}
+
+func (ikrgb *IdentityKeyRecordGroupBy) sqlScan(ctx context.Context, root *IdentityKeyRecordQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ikrgb.fns))
+	for _, fn := range ikrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ikrgb.flds)+len(ikrgb.fns))
+		for _, f := range *ikrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ikrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ikrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// IdentityKeyRecordSelect is the builder for selecting fields of IdentityKeyRecord entities.
+type IdentityKeyRecordSelect struct {
+	*IdentityKeyRecordQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ikrs *IdentityKeyRecordSelect) Aggregate(fns ...AggregateFunc) *IdentityKeyRecordSelect {
+	ikrs.fns = append(ikrs.fns, fns...)
+	return ikrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ikrs *IdentityKeyRecordSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ikrs.ctx, "Select")
+	if err := ikrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IdentityKeyRecordQuery, *IdentityKeyRecordSelect](ctx, ikrs.IdentityKeyRecordQuery, ikrs, ikrs.inters, v)
+}
+
+func (ikrs *IdentityKeyRecordSelect) sqlScan(ctx context.Context, root *IdentityKeyRecordQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ikrs.fns))
+	for _, fn := range ikrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ikrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ikrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,491 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// IdentityKeyRecordUpdate is the builder for updating IdentityKeyRecord entities.
+type IdentityKeyRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdentityKeyRecordMutation
+}
+
+// Where appends a list predicates to the IdentityKeyRecordUpdate builder.
+func (ikru *IdentityKeyRecordUpdate) Where(ps ...predicate.IdentityKeyRecord) *IdentityKeyRecordUpdate {
+	ikru.mutation.Where(ps...)
+	return ikru
+}
+
+// SetDeviceNumber sets the "device_number" field.
+func (ikru *IdentityKeyRecordUpdate) SetDeviceNumber(i int) *IdentityKeyRecordUpdate {
+	ikru.mutation.ResetDeviceNumber()
+	ikru.mutation.SetDeviceNumber(i)
+	return ikru
+}
+
+// SetNillableDeviceNumber sets the "device_number" field if the given value is not nil.
+func (ikru *IdentityKeyRecordUpdate) SetNillableDeviceNumber(i *int) *IdentityKeyRecordUpdate {
+	if i != nil {
+		ikru.SetDeviceNumber(*i)
+	}
+	return ikru
+}
+
+// AddDeviceNumber adds i to the "device_number" field.
+func (ikru *IdentityKeyRecordUpdate) AddDeviceNumber(i int) *IdentityKeyRecordUpdate {
+	ikru.mutation.AddDeviceNumber(i)
+	return ikru
+}
+
+// ClearDeviceNumber clears the value of the "device_number" field.
+func (ikru *IdentityKeyRecordUpdate) ClearDeviceNumber() *IdentityKeyRecordUpdate {
+	ikru.mutation.ClearDeviceNumber()
+	return ikru
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ikru *IdentityKeyRecordUpdate) SetUserID(id int) *IdentityKeyRecordUpdate {
+	ikru.mutation.SetUserID(id)
+	return ikru
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (ikru *IdentityKeyRecordUpdate) SetNillableUserID(id *int) *IdentityKeyRecordUpdate {
+	if id != nil {
+		ikru = ikru.SetUserID(*id)
+	}
+	return ikru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ikru *IdentityKeyRecordUpdate) SetUser(u *User) *IdentityKeyRecordUpdate {
+	return ikru.SetUserID(u.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (ikru *IdentityKeyRecordUpdate) SetDeviceID(id int) *IdentityKeyRecordUpdate {
+	ikru.mutation.SetDeviceID(id)
+	return ikru
+}
+
+// SetNillableDeviceID sets the "device" edge to the Device entity by ID if the given value is not nil.
+func (ikru *IdentityKeyRecordUpdate) SetNillableDeviceID(id *int) *IdentityKeyRecordUpdate {
+	if id != nil {
+		ikru = ikru.SetDeviceID(*id)
+	}
+	return ikru
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (ikru *IdentityKeyRecordUpdate) SetDevice(d *Device) *IdentityKeyRecordUpdate {
+	return ikru.SetDeviceID(d.ID)
+}
+
+// Mutation returns the IdentityKeyRecordMutation object of the builder.
+func (ikru *IdentityKeyRecordUpdate) Mutation() *IdentityKeyRecordMutation {
+	return ikru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ikru *IdentityKeyRecordUpdate) ClearUser() *IdentityKeyRecordUpdate {
+	ikru.mutation.ClearUser()
+	return ikru
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (ikru *IdentityKeyRecordUpdate) ClearDevice() *IdentityKeyRecordUpdate {
+	ikru.mutation.ClearDevice()
+	return ikru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ikru *IdentityKeyRecordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ikru.sqlSave, ikru.mutation, ikru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikru *IdentityKeyRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := ikru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ikru *IdentityKeyRecordUpdate) Exec(ctx context.Context) error {
+	_, err := ikru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikru *IdentityKeyRecordUpdate) ExecX(ctx context.Context) {
+	if err := ikru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikru *IdentityKeyRecordUpdate) check() error {
+	if v, ok := ikru.mutation.DeviceNumber(); ok {
+		if err := identitykeyrecord.DeviceNumberValidator(v); err != nil {
+			return &ValidationError{Name: "device_number", err: fmt.Errorf(`ent: validator failed for field "IdentityKeyRecord.device_number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ikru *IdentityKeyRecordUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ikru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(identitykeyrecord.Table, identitykeyrecord.Columns, sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt))
+	if ps := ikru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ikru.mutation.DeviceNumber(); ok {
+		_spec.SetField(identitykeyrecord.FieldDeviceNumber, field.TypeInt, value)
+	}
+	if value, ok := ikru.mutation.AddedDeviceNumber(); ok {
+		_spec.AddField(identitykeyrecord.FieldDeviceNumber, field.TypeInt, value)
+	}
+	if ikru.mutation.DeviceNumberCleared() {
+		_spec.ClearField(identitykeyrecord.FieldDeviceNumber, field.TypeInt)
+	}
+	if ikru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitykeyrecord.UserTable,
+			Columns: []string{identitykeyrecord.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ikru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitykeyrecord.UserTable,
+			Columns: []string{identitykeyrecord.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ikru.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitykeyrecord.DeviceTable,
+			Columns: []string{identitykeyrecord.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ikru.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitykeyrecord.DeviceTable,
+			Columns: []string{identitykeyrecord.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ikru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{identitykeyrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ikru.mutation.done = true
+	return n, nil
+}
+
+// IdentityKeyRecordUpdateOne is the builder for updating a single IdentityKeyRecord entity.
+type IdentityKeyRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IdentityKeyRecordMutation
+}
+
+// SetDeviceNumber sets the "device_number" field.
+func (ikruo *IdentityKeyRecordUpdateOne) SetDeviceNumber(i int) *IdentityKeyRecordUpdateOne {
+	ikruo.mutation.ResetDeviceNumber()
+	ikruo.mutation.SetDeviceNumber(i)
+	return ikruo
+}
+
+// SetNillableDeviceNumber sets the "device_number" field if the given value is not nil.
+func (ikruo *IdentityKeyRecordUpdateOne) SetNillableDeviceNumber(i *int) *IdentityKeyRecordUpdateOne {
+	if i != nil {
+		ikruo.SetDeviceNumber(*i)
+	}
+	return ikruo
+}
+
+// AddDeviceNumber adds i to the "device_number" field.
+func (ikruo *IdentityKeyRecordUpdateOne) AddDeviceNumber(i int) *IdentityKeyRecordUpdateOne {
+	ikruo.mutation.AddDeviceNumber(i)
+	return ikruo
+}
+
+// ClearDeviceNumber clears the value of the "device_number" field.
+func (ikruo *IdentityKeyRecordUpdateOne) ClearDeviceNumber() *IdentityKeyRecordUpdateOne {
+	ikruo.mutation.ClearDeviceNumber()
+	return ikruo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ikruo *IdentityKeyRecordUpdateOne) SetUserID(id int) *IdentityKeyRecordUpdateOne {
+	ikruo.mutation.SetUserID(id)
+	return ikruo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (ikruo *IdentityKeyRecordUpdateOne) SetNillableUserID(id *int) *IdentityKeyRecordUpdateOne {
+	if id != nil {
+		ikruo = ikruo.SetUserID(*id)
+	}
+	return ikruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ikruo *IdentityKeyRecordUpdateOne) SetUser(u *User) *IdentityKeyRecordUpdateOne {
+	return ikruo.SetUserID(u.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (ikruo *IdentityKeyRecordUpdateOne) SetDeviceID(id int) *IdentityKeyRecordUpdateOne {
+	ikruo.mutation.SetDeviceID(id)
+	return ikruo
+}
+
+// SetNillableDeviceID sets the "device" edge to the Device entity by ID if the given value is not nil.
+func (ikruo *IdentityKeyRecordUpdateOne) SetNillableDeviceID(id *int) *IdentityKeyRecordUpdateOne {
+	if id != nil {
+		ikruo = ikruo.SetDeviceID(*id)
+	}
+	return ikruo
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (ikruo *IdentityKeyRecordUpdateOne) SetDevice(d *Device) *IdentityKeyRecordUpdateOne {
+	return ikruo.SetDeviceID(d.ID)
+}
+
+// Mutation returns the IdentityKeyRecordMutation object of the builder.
+func (ikruo *IdentityKeyRecordUpdateOne) Mutation() *IdentityKeyRecordMutation {
+	return ikruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ikruo *IdentityKeyRecordUpdateOne) ClearUser() *IdentityKeyRecordUpdateOne {
+	ikruo.mutation.ClearUser()
+	return ikruo
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (ikruo *IdentityKeyRecordUpdateOne) ClearDevice() *IdentityKeyRecordUpdateOne {
+	ikruo.mutation.ClearDevice()
+	return ikruo
+}
+
+// Where appends a list predicates to the IdentityKeyRecordUpdate builder.
+func (ikruo *IdentityKeyRecordUpdateOne) Where(ps ...predicate.IdentityKeyRecord) *IdentityKeyRecordUpdateOne {
+	ikruo.mutation.Where(ps...)
+	return ikruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ikruo *IdentityKeyRecordUpdateOne) Select(field string, fields ...string) *IdentityKeyRecordUpdateOne {
+	ikruo.fields = append([]string{field}, fields...)
+	return ikruo
+}
+
+// Save executes the query and returns the updated IdentityKeyRecord entity.
+func (ikruo *IdentityKeyRecordUpdateOne) Save(ctx context.Context) (*IdentityKeyRecord, error) {
+	return withHooks(ctx, ikruo.sqlSave, ikruo.mutation, ikruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikruo *IdentityKeyRecordUpdateOne) SaveX(ctx context.Context) *IdentityKeyRecord {
+	node, err := ikruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ikruo *IdentityKeyRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := ikruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikruo *IdentityKeyRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := ikruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikruo *IdentityKeyRecordUpdateOne) check() error {
+	if v, ok := ikruo.mutation.DeviceNumber(); ok {
+		if err := identitykeyrecord.DeviceNumberValidator(v); err != nil {
+			return &ValidationError{Name: "device_number", err: fmt.Errorf(`ent: validator failed for field "IdentityKeyRecord.device_number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ikruo *IdentityKeyRecordUpdateOne) sqlSave(ctx context.Context) (_node *IdentityKeyRecord, err error) {
+	if err := ikruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(identitykeyrecord.Table, identitykeyrecord.Columns, sqlgraph.NewFieldSpec(identitykeyrecord.FieldID, field.TypeInt))
+	id, ok := ikruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IdentityKeyRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ikruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, identitykeyrecord.FieldID)
+		for _, f := range fields {
+			if !identitykeyrecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != identitykeyrecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ikruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ikruo.mutation.DeviceNumber(); ok {
+		_spec.SetField(identitykeyrecord.FieldDeviceNumber, field.TypeInt, value)
+	}
+	if value, ok := ikruo.mutation.AddedDeviceNumber(); ok {
+		_spec.AddField(identitykeyrecord.FieldDeviceNumber, field.TypeInt, value)
+	}
+	if ikruo.mutation.DeviceNumberCleared() {
+		_spec.ClearField(identitykeyrecord.FieldDeviceNumber, field.TypeInt)
+	}
+	if ikruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitykeyrecord.UserTable,
+			Columns: []string{identitykeyrecord.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ikruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitykeyrecord.UserTable,
+			Columns: []string{identitykeyrecord.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ikruo.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitykeyrecord.DeviceTable,
+			Columns: []string{identitykeyrecord.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ikruo.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitykeyrecord.DeviceTable,
+			Columns: []string{identitykeyrecord.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &IdentityKeyRecord{config: ikruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ikruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{identitykeyrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ikruo.mutation.done = true
+	return _node, nil
+}
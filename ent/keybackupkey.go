@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/room"
+)
+
+// KeyBackupKey is the model entity for the KeyBackupKey schema.
+type KeyBackupKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID string `json:"session_id,omitempty"`
+	// FirstMessageIndex holds the value of the "first_message_index" field.
+	FirstMessageIndex int `json:"first_message_index,omitempty"`
+	// ForwardedCount holds the value of the "forwarded_count" field.
+	ForwardedCount int `json:"forwarded_count,omitempty"`
+	// IsVerified holds the value of the "is_verified" field.
+	IsVerified bool `json:"is_verified,omitempty"`
+	// SessionData holds the value of the "session_data" field.
+	SessionData string `json:"session_data,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the KeyBackupKeyQuery when eager-loading is set.
+	Edges                  KeyBackupKeyEdges `json:"edges"`
+	key_backup_key_version *int
+	key_backup_key_room    *int
+	selectValues           sql.SelectValues
+}
+
+// KeyBackupKeyEdges holds the relations/edges for other nodes in the graph.
+type KeyBackupKeyEdges struct {
+	// Version holds the value of the version edge.
+	Version *KeyBackupVersion `json:"version,omitempty"`
+	// Room holds the value of the room edge.
+	Room *Room `json:"room,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// VersionOrErr returns the Version value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KeyBackupKeyEdges) VersionOrErr() (*KeyBackupVersion, error) {
+	if e.Version != nil {
+		return e.Version, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: keybackupversion.Label}
+	}
+	return nil, &NotLoadedError{edge: "version"}
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KeyBackupKeyEdges) RoomOrErr() (*Room, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: room.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KeyBackupKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case keybackupkey.FieldIsVerified:
+			values[i] = new(sql.NullBool)
+		case keybackupkey.FieldID, keybackupkey.FieldFirstMessageIndex, keybackupkey.FieldForwardedCount:
+			values[i] = new(sql.NullInt64)
+		case keybackupkey.FieldSessionID, keybackupkey.FieldSessionData:
+			values[i] = new(sql.NullString)
+		case keybackupkey.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case keybackupkey.ForeignKeys[0]: // key_backup_key_version
+			values[i] = new(sql.NullInt64)
+		case keybackupkey.ForeignKeys[1]: // key_backup_key_room
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KeyBackupKey fields.
+func (kbk *KeyBackupKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case keybackupkey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			kbk.ID = int(value.Int64)
+		case keybackupkey.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				kbk.SessionID = value.String
+			}
+		case keybackupkey.FieldFirstMessageIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field first_message_index", values[i])
+			} else if value.Valid {
+				kbk.FirstMessageIndex = int(value.Int64)
+			}
+		case keybackupkey.FieldForwardedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field forwarded_count", values[i])
+			} else if value.Valid {
+				kbk.ForwardedCount = int(value.Int64)
+			}
+		case keybackupkey.FieldIsVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_verified", values[i])
+			} else if value.Valid {
+				kbk.IsVerified = value.Bool
+			}
+		case keybackupkey.FieldSessionData:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_data", values[i])
+			} else if value.Valid {
+				kbk.SessionData = value.String
+			}
+		case keybackupkey.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				kbk.UpdatedAt = value.Time
+			}
+		case keybackupkey.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field key_backup_key_version", value)
+			} else if value.Valid {
+				kbk.key_backup_key_version = new(int)
+				*kbk.key_backup_key_version = int(value.Int64)
+			}
+		case keybackupkey.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field key_backup_key_room", value)
+			} else if value.Valid {
+				kbk.key_backup_key_room = new(int)
+				*kbk.key_backup_key_room = int(value.Int64)
+			}
+		default:
+			kbk.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KeyBackupKey.
+// This includes values selected through modifiers, order, etc.
+func (kbk *KeyBackupKey) Value(name string) (ent.Value, error) {
+	return kbk.selectValues.Get(name)
+}
+
+// QueryVersion queries the "version" edge of the KeyBackupKey entity.
+func (kbk *KeyBackupKey) QueryVersion() *KeyBackupVersionQuery {
+	return NewKeyBackupKeyClient(kbk.config).QueryVersion(kbk)
+}
+
+// QueryRoom queries the "room" edge of the KeyBackupKey entity.
+func (kbk *KeyBackupKey) QueryRoom() *RoomQuery {
+	return NewKeyBackupKeyClient(kbk.config).QueryRoom(kbk)
+}
+
+// Update returns a builder for updating this KeyBackupKey.
+// Note that you need to call KeyBackupKey.Unwrap() before calling this method if this KeyBackupKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (kbk *KeyBackupKey) Update() *KeyBackupKeyUpdateOne {
+	return NewKeyBackupKeyClient(kbk.config).UpdateOne(kbk)
+}
+
+// Unwrap unwraps the KeyBackupKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (kbk *KeyBackupKey) Unwrap() *KeyBackupKey {
+	_tx, ok := kbk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KeyBackupKey is not a transactional entity")
+	}
+	kbk.config.driver = _tx.drv
+	return kbk
+}
+
+// String implements the fmt.Stringer.
+func (kbk *KeyBackupKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("KeyBackupKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", kbk.ID))
+	builder.WriteString("session_id=")
+	builder.WriteString(kbk.SessionID)
+	builder.WriteString(", ")
+	builder.WriteString("first_message_index=")
+	builder.WriteString(fmt.Sprintf("%v", kbk.FirstMessageIndex))
+	builder.WriteString(", ")
+	builder.WriteString("forwarded_count=")
+	builder.WriteString(fmt.Sprintf("%v", kbk.ForwardedCount))
+	builder.WriteString(", ")
+	builder.WriteString("is_verified=")
+	builder.WriteString(fmt.Sprintf("%v", kbk.IsVerified))
+	builder.WriteString(", ")
+	builder.WriteString("session_data=")
+	builder.WriteString(kbk.SessionData)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(kbk.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KeyBackupKeys is a parsable slice of KeyBackupKey.
+type KeyBackupKeys []*KeyBackupKey
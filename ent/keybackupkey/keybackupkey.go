@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keybackupkey
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the keybackupkey type in the database.
+	Label = "key_backup_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSessionID holds the string denoting the session_id field in the database.
+	FieldSessionID = "session_id"
+	// FieldFirstMessageIndex holds the string denoting the first_message_index field in the database.
+	FieldFirstMessageIndex = "first_message_index"
+	// FieldForwardedCount holds the string denoting the forwarded_count field in the database.
+	FieldForwardedCount = "forwarded_count"
+	// FieldIsVerified holds the string denoting the is_verified field in the database.
+	FieldIsVerified = "is_verified"
+	// FieldSessionData holds the string denoting the session_data field in the database.
+	FieldSessionData = "session_data"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeVersion holds the string denoting the version edge name in mutations.
+	EdgeVersion = "version"
+	// EdgeRoom holds the string denoting the room edge name in mutations.
+	EdgeRoom = "room"
+	// Table holds the table name of the keybackupkey in the database.
+	Table = "key_backup_keys"
+	// VersionTable is the table that holds the version relation/edge.
+	VersionTable = "key_backup_keys"
+	// VersionInverseTable is the table name for the KeyBackupVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "keybackupversion" package.
+	VersionInverseTable = "key_backup_versions"
+	// VersionColumn is the table column denoting the version relation/edge.
+	VersionColumn = "key_backup_key_version"
+	// RoomTable is the table that holds the room relation/edge.
+	RoomTable = "key_backup_keys"
+	// RoomInverseTable is the table name for the Room entity.
+	// It exists in this package in order to avoid circular dependency with the "room" package.
+	RoomInverseTable = "rooms"
+	// RoomColumn is the table column denoting the room relation/edge.
+	RoomColumn = "key_backup_key_room"
+)
+
+// Columns holds all SQL columns for keybackupkey fields.
+var Columns = []string{
+	FieldID,
+	FieldSessionID,
+	FieldFirstMessageIndex,
+	FieldForwardedCount,
+	FieldIsVerified,
+	FieldSessionData,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "key_backup_keys"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"key_backup_key_version",
+	"key_backup_key_room",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eleven-am/enclave/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// SessionIDValidator is a validator for the "session_id" field. It is called by the builders before save.
+	SessionIDValidator func(string) error
+	// FirstMessageIndexValidator is a validator for the "first_message_index" field. It is called by the builders before save.
+	FirstMessageIndexValidator func(int) error
+	// ForwardedCountValidator is a validator for the "forwarded_count" field. It is called by the builders before save.
+	ForwardedCountValidator func(int) error
+	// DefaultIsVerified holds the default value on creation for the "is_verified" field.
+	DefaultIsVerified bool
+	// SessionDataValidator is a validator for the "session_data" field. It is called by the builders before save.
+	SessionDataValidator func(string) error
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the KeyBackupKey queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySessionID orders the results by the session_id field.
+func BySessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
+}
+
+// ByFirstMessageIndex orders the results by the first_message_index field.
+func ByFirstMessageIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstMessageIndex, opts...).ToFunc()
+}
+
+// ByForwardedCount orders the results by the forwarded_count field.
+func ByForwardedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForwardedCount, opts...).ToFunc()
+}
+
+// ByIsVerified orders the results by the is_verified field.
+func ByIsVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsVerified, opts...).ToFunc()
+}
+
+// BySessionData orders the results by the session_data field.
+func BySessionData(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionData, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByVersionField orders the results by version field.
+func ByVersionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVersionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRoomField orders the results by room field.
+func ByRoomField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoomStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newVersionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VersionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, VersionTable, VersionColumn),
+	)
+}
+func newRoomStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoomInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RoomTable, RoomColumn),
+	)
+}
@@ -0,0 +1,407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keybackupkey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLTE(FieldID, id))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldSessionID, v))
+}
+
+// FirstMessageIndex applies equality check predicate on the "first_message_index" field. It's identical to FirstMessageIndexEQ.
+func FirstMessageIndex(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldFirstMessageIndex, v))
+}
+
+// ForwardedCount applies equality check predicate on the "forwarded_count" field. It's identical to ForwardedCountEQ.
+func ForwardedCount(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldForwardedCount, v))
+}
+
+// IsVerified applies equality check predicate on the "is_verified" field. It's identical to IsVerifiedEQ.
+func IsVerified(v bool) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldIsVerified, v))
+}
+
+// SessionData applies equality check predicate on the "session_data" field. It's identical to SessionDataEQ.
+func SessionData(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldSessionData, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLTE(FieldSessionID, v))
+}
+
+// SessionIDContains applies the Contains predicate on the "session_id" field.
+func SessionIDContains(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldContains(FieldSessionID, v))
+}
+
+// SessionIDHasPrefix applies the HasPrefix predicate on the "session_id" field.
+func SessionIDHasPrefix(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldHasPrefix(FieldSessionID, v))
+}
+
+// SessionIDHasSuffix applies the HasSuffix predicate on the "session_id" field.
+func SessionIDHasSuffix(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldHasSuffix(FieldSessionID, v))
+}
+
+// SessionIDEqualFold applies the EqualFold predicate on the "session_id" field.
+func SessionIDEqualFold(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEqualFold(FieldSessionID, v))
+}
+
+// SessionIDContainsFold applies the ContainsFold predicate on the "session_id" field.
+func SessionIDContainsFold(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldContainsFold(FieldSessionID, v))
+}
+
+// FirstMessageIndexEQ applies the EQ predicate on the "first_message_index" field.
+func FirstMessageIndexEQ(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldFirstMessageIndex, v))
+}
+
+// FirstMessageIndexNEQ applies the NEQ predicate on the "first_message_index" field.
+func FirstMessageIndexNEQ(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNEQ(FieldFirstMessageIndex, v))
+}
+
+// FirstMessageIndexIn applies the In predicate on the "first_message_index" field.
+func FirstMessageIndexIn(vs ...int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldIn(FieldFirstMessageIndex, vs...))
+}
+
+// FirstMessageIndexNotIn applies the NotIn predicate on the "first_message_index" field.
+func FirstMessageIndexNotIn(vs ...int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNotIn(FieldFirstMessageIndex, vs...))
+}
+
+// FirstMessageIndexGT applies the GT predicate on the "first_message_index" field.
+func FirstMessageIndexGT(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGT(FieldFirstMessageIndex, v))
+}
+
+// FirstMessageIndexGTE applies the GTE predicate on the "first_message_index" field.
+func FirstMessageIndexGTE(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGTE(FieldFirstMessageIndex, v))
+}
+
+// FirstMessageIndexLT applies the LT predicate on the "first_message_index" field.
+func FirstMessageIndexLT(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLT(FieldFirstMessageIndex, v))
+}
+
+// FirstMessageIndexLTE applies the LTE predicate on the "first_message_index" field.
+func FirstMessageIndexLTE(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLTE(FieldFirstMessageIndex, v))
+}
+
+// ForwardedCountEQ applies the EQ predicate on the "forwarded_count" field.
+func ForwardedCountEQ(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldForwardedCount, v))
+}
+
+// ForwardedCountNEQ applies the NEQ predicate on the "forwarded_count" field.
+func ForwardedCountNEQ(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNEQ(FieldForwardedCount, v))
+}
+
+// ForwardedCountIn applies the In predicate on the "forwarded_count" field.
+func ForwardedCountIn(vs ...int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldIn(FieldForwardedCount, vs...))
+}
+
+// ForwardedCountNotIn applies the NotIn predicate on the "forwarded_count" field.
+func ForwardedCountNotIn(vs ...int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNotIn(FieldForwardedCount, vs...))
+}
+
+// ForwardedCountGT applies the GT predicate on the "forwarded_count" field.
+func ForwardedCountGT(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGT(FieldForwardedCount, v))
+}
+
+// ForwardedCountGTE applies the GTE predicate on the "forwarded_count" field.
+func ForwardedCountGTE(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGTE(FieldForwardedCount, v))
+}
+
+// ForwardedCountLT applies the LT predicate on the "forwarded_count" field.
+func ForwardedCountLT(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLT(FieldForwardedCount, v))
+}
+
+// ForwardedCountLTE applies the LTE predicate on the "forwarded_count" field.
+func ForwardedCountLTE(v int) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLTE(FieldForwardedCount, v))
+}
+
+// IsVerifiedEQ applies the EQ predicate on the "is_verified" field.
+func IsVerifiedEQ(v bool) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldIsVerified, v))
+}
+
+// IsVerifiedNEQ applies the NEQ predicate on the "is_verified" field.
+func IsVerifiedNEQ(v bool) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNEQ(FieldIsVerified, v))
+}
+
+// SessionDataEQ applies the EQ predicate on the "session_data" field.
+func SessionDataEQ(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldSessionData, v))
+}
+
+// SessionDataNEQ applies the NEQ predicate on the "session_data" field.
+func SessionDataNEQ(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNEQ(FieldSessionData, v))
+}
+
+// SessionDataIn applies the In predicate on the "session_data" field.
+func SessionDataIn(vs ...string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldIn(FieldSessionData, vs...))
+}
+
+// SessionDataNotIn applies the NotIn predicate on the "session_data" field.
+func SessionDataNotIn(vs ...string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNotIn(FieldSessionData, vs...))
+}
+
+// SessionDataGT applies the GT predicate on the "session_data" field.
+func SessionDataGT(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGT(FieldSessionData, v))
+}
+
+// SessionDataGTE applies the GTE predicate on the "session_data" field.
+func SessionDataGTE(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGTE(FieldSessionData, v))
+}
+
+// SessionDataLT applies the LT predicate on the "session_data" field.
+func SessionDataLT(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLT(FieldSessionData, v))
+}
+
+// SessionDataLTE applies the LTE predicate on the "session_data" field.
+func SessionDataLTE(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLTE(FieldSessionData, v))
+}
+
+// SessionDataContains applies the Contains predicate on the "session_data" field.
+func SessionDataContains(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldContains(FieldSessionData, v))
+}
+
+// SessionDataHasPrefix applies the HasPrefix predicate on the "session_data" field.
+func SessionDataHasPrefix(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldHasPrefix(FieldSessionData, v))
+}
+
+// SessionDataHasSuffix applies the HasSuffix predicate on the "session_data" field.
+func SessionDataHasSuffix(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldHasSuffix(FieldSessionData, v))
+}
+
+// SessionDataEqualFold applies the EqualFold predicate on the "session_data" field.
+func SessionDataEqualFold(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEqualFold(FieldSessionData, v))
+}
+
+// SessionDataContainsFold applies the ContainsFold predicate on the "session_data" field.
+func SessionDataContainsFold(v string) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldContainsFold(FieldSessionData, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasVersion applies the HasEdge predicate on the "version" edge.
+func HasVersion() predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, VersionTable, VersionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVersionWith applies the HasEdge predicate on the "version" edge with a given conditions (other predicates).
+func HasVersionWith(preds ...predicate.KeyBackupVersion) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(func(s *sql.Selector) {
+		step := newVersionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRoom applies the HasEdge predicate on the "room" edge.
+func HasRoom() predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RoomTable, RoomColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoomWith applies the HasEdge predicate on the "room" edge with a given conditions (other predicates).
+func HasRoomWith(preds ...predicate.Room) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(func(s *sql.Selector) {
+		step := newRoomStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KeyBackupKey) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KeyBackupKey) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KeyBackupKey) predicate.KeyBackupKey {
+	return predicate.KeyBackupKey(sql.NotPredicates(p))
+}
@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/room"
+)
+
+// KeyBackupKeyCreate is the builder for creating a KeyBackupKey entity.
+type KeyBackupKeyCreate struct {
+	config
+	mutation *KeyBackupKeyMutation
+	hooks    []Hook
+}
+
+// SetSessionID sets the "session_id" field.
+func (kbkc *KeyBackupKeyCreate) SetSessionID(s string) *KeyBackupKeyCreate {
+	kbkc.mutation.SetSessionID(s)
+	return kbkc
+}
+
+// SetFirstMessageIndex sets the "first_message_index" field.
+func (kbkc *KeyBackupKeyCreate) SetFirstMessageIndex(i int) *KeyBackupKeyCreate {
+	kbkc.mutation.SetFirstMessageIndex(i)
+	return kbkc
+}
+
+// SetForwardedCount sets the "forwarded_count" field.
+func (kbkc *KeyBackupKeyCreate) SetForwardedCount(i int) *KeyBackupKeyCreate {
+	kbkc.mutation.SetForwardedCount(i)
+	return kbkc
+}
+
+// SetIsVerified sets the "is_verified" field.
+func (kbkc *KeyBackupKeyCreate) SetIsVerified(b bool) *KeyBackupKeyCreate {
+	kbkc.mutation.SetIsVerified(b)
+	return kbkc
+}
+
+// SetNillableIsVerified sets the "is_verified" field if the given value is not nil.
+func (kbkc *KeyBackupKeyCreate) SetNillableIsVerified(b *bool) *KeyBackupKeyCreate {
+	if b != nil {
+		kbkc.SetIsVerified(*b)
+	}
+	return kbkc
+}
+
+// SetSessionData sets the "session_data" field.
+func (kbkc *KeyBackupKeyCreate) SetSessionData(s string) *KeyBackupKeyCreate {
+	kbkc.mutation.SetSessionData(s)
+	return kbkc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (kbkc *KeyBackupKeyCreate) SetUpdatedAt(t time.Time) *KeyBackupKeyCreate {
+	kbkc.mutation.SetUpdatedAt(t)
+	return kbkc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (kbkc *KeyBackupKeyCreate) SetNillableUpdatedAt(t *time.Time) *KeyBackupKeyCreate {
+	if t != nil {
+		kbkc.SetUpdatedAt(*t)
+	}
+	return kbkc
+}
+
+// SetVersionID sets the "version" edge to the KeyBackupVersion entity by ID.
+func (kbkc *KeyBackupKeyCreate) SetVersionID(id int) *KeyBackupKeyCreate {
+	kbkc.mutation.SetVersionID(id)
+	return kbkc
+}
+
+// SetVersion sets the "version" edge to the KeyBackupVersion entity.
+func (kbkc *KeyBackupKeyCreate) SetVersion(k *KeyBackupVersion) *KeyBackupKeyCreate {
+	return kbkc.SetVersionID(k.ID)
+}
+
+// SetRoomID sets the "room" edge to the Room entity by ID.
+func (kbkc *KeyBackupKeyCreate) SetRoomID(id int) *KeyBackupKeyCreate {
+	kbkc.mutation.SetRoomID(id)
+	return kbkc
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (kbkc *KeyBackupKeyCreate) SetRoom(r *Room) *KeyBackupKeyCreate {
+	return kbkc.SetRoomID(r.ID)
+}
+
+// Mutation returns the KeyBackupKeyMutation object of the builder.
+func (kbkc *KeyBackupKeyCreate) Mutation() *KeyBackupKeyMutation {
+	return kbkc.mutation
+}
+
+// Save creates the KeyBackupKey in the database.
+func (kbkc *KeyBackupKeyCreate) Save(ctx context.Context) (*KeyBackupKey, error) {
+	if err := kbkc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, kbkc.sqlSave, kbkc.mutation, kbkc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (kbkc *KeyBackupKeyCreate) SaveX(ctx context.Context) *KeyBackupKey {
+	v, err := kbkc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kbkc *KeyBackupKeyCreate) Exec(ctx context.Context) error {
+	_, err := kbkc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbkc *KeyBackupKeyCreate) ExecX(ctx context.Context) {
+	if err := kbkc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kbkc *KeyBackupKeyCreate) defaults() error {
+	if _, ok := kbkc.mutation.IsVerified(); !ok {
+		v := keybackupkey.DefaultIsVerified
+		kbkc.mutation.SetIsVerified(v)
+	}
+	if _, ok := kbkc.mutation.UpdatedAt(); !ok {
+		if keybackupkey.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized keybackupkey.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := keybackupkey.DefaultUpdatedAt()
+		kbkc.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kbkc *KeyBackupKeyCreate) check() error {
+	if _, ok := kbkc.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "KeyBackupKey.session_id"`)}
+	}
+	if v, ok := kbkc.mutation.SessionID(); ok {
+		if err := keybackupkey.SessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "session_id", err: fmt.Errorf(`ent: validator failed for field "KeyBackupKey.session_id": %w`, err)}
+		}
+	}
+	if _, ok := kbkc.mutation.FirstMessageIndex(); !ok {
+		return &ValidationError{Name: "first_message_index", err: errors.New(`ent: missing required field "KeyBackupKey.first_message_index"`)}
+	}
+	if v, ok := kbkc.mutation.FirstMessageIndex(); ok {
+		if err := keybackupkey.FirstMessageIndexValidator(v); err != nil {
+			return &ValidationError{Name: "first_message_index", err: fmt.Errorf(`ent: validator failed for field "KeyBackupKey.first_message_index": %w`, err)}
+		}
+	}
+	if _, ok := kbkc.mutation.ForwardedCount(); !ok {
+		return &ValidationError{Name: "forwarded_count", err: errors.New(`ent: missing required field "KeyBackupKey.forwarded_count"`)}
+	}
+	if v, ok := kbkc.mutation.ForwardedCount(); ok {
+		if err := keybackupkey.ForwardedCountValidator(v); err != nil {
+			return &ValidationError{Name: "forwarded_count", err: fmt.Errorf(`ent: validator failed for field "KeyBackupKey.forwarded_count": %w`, err)}
+		}
+	}
+	if _, ok := kbkc.mutation.IsVerified(); !ok {
+		return &ValidationError{Name: "is_verified", err: errors.New(`ent: missing required field "KeyBackupKey.is_verified"`)}
+	}
+	if _, ok := kbkc.mutation.SessionData(); !ok {
+		return &ValidationError{Name: "session_data", err: errors.New(`ent: missing required field "KeyBackupKey.session_data"`)}
+	}
+	if v, ok := kbkc.mutation.SessionData(); ok {
+		if err := keybackupkey.SessionDataValidator(v); err != nil {
+			return &ValidationError{Name: "session_data", err: fmt.Errorf(`ent: validator failed for field "KeyBackupKey.session_data": %w`, err)}
+		}
+	}
+	if _, ok := kbkc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "KeyBackupKey.updated_at"`)}
+	}
+	if _, ok := kbkc.mutation.VersionID(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required edge "KeyBackupKey.version"`)}
+	}
+	if _, ok := kbkc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room", err: errors.New(`ent: missing required edge "KeyBackupKey.room"`)}
+	}
+	return nil
+}
+
+func (kbkc *KeyBackupKeyCreate) sqlSave(ctx context.Context) (*KeyBackupKey, error) {
+	if err := kbkc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := kbkc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, kbkc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	kbkc.mutation.id = &_node.ID
+	kbkc.mutation.done = true
+	return _node, nil
+}
+
+func (kbkc *KeyBackupKeyCreate) createSpec() (*KeyBackupKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KeyBackupKey{config: kbkc.config}
+		_spec = sqlgraph.NewCreateSpec(keybackupkey.Table, sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt))
+	)
+	if value, ok := kbkc.mutation.SessionID(); ok {
+		_spec.SetField(keybackupkey.FieldSessionID, field.TypeString, value)
+		_node.SessionID = value
+	}
+	if value, ok := kbkc.mutation.FirstMessageIndex(); ok {
+		_spec.SetField(keybackupkey.FieldFirstMessageIndex, field.TypeInt, value)
+		_node.FirstMessageIndex = value
+	}
+	if value, ok := kbkc.mutation.ForwardedCount(); ok {
+		_spec.SetField(keybackupkey.FieldForwardedCount, field.TypeInt, value)
+		_node.ForwardedCount = value
+	}
+	if value, ok := kbkc.mutation.IsVerified(); ok {
+		_spec.SetField(keybackupkey.FieldIsVerified, field.TypeBool, value)
+		_node.IsVerified = value
+	}
+	if value, ok := kbkc.mutation.SessionData(); ok {
+		_spec.SetField(keybackupkey.FieldSessionData, field.TypeString, value)
+		_node.SessionData = value
+	}
+	if value, ok := kbkc.mutation.UpdatedAt(); ok {
+		_spec.SetField(keybackupkey.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := kbkc.mutation.VersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   keybackupkey.VersionTable,
+			Columns: []string{keybackupkey.VersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keybackupversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.key_backup_key_version = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := kbkc.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   keybackupkey.RoomTable,
+			Columns: []string{keybackupkey.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.key_backup_key_room = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// KeyBackupKeyCreateBulk is the builder for creating many KeyBackupKey entities in bulk.
+type KeyBackupKeyCreateBulk struct {
+	config
+	err      error
+	builders []*KeyBackupKeyCreate
+}
+
+// Save creates the KeyBackupKey entities in the database.
+func (kbkcb *KeyBackupKeyCreateBulk) Save(ctx context.Context) ([]*KeyBackupKey, error) {
+	if kbkcb.err != nil {
+		return nil, kbkcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(kbkcb.builders))
+	nodes := make([]*KeyBackupKey, len(kbkcb.builders))
+	mutators := make([]Mutator, len(kbkcb.builders))
+	for i := range kbkcb.builders {
+		func(i int, root context.Context) {
+			builder := kbkcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KeyBackupKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, kbkcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, kbkcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, kbkcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kbkcb *KeyBackupKeyCreateBulk) SaveX(ctx context.Context) []*KeyBackupKey {
+	v, err := kbkcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kbkcb *KeyBackupKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := kbkcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbkcb *KeyBackupKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := kbkcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
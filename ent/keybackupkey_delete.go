@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyBackupKeyDelete is the builder for deleting a KeyBackupKey entity.
+type KeyBackupKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *KeyBackupKeyMutation
+}
+
+// Where appends a list predicates to the KeyBackupKeyDelete builder.
+func (kbkd *KeyBackupKeyDelete) Where(ps ...predicate.KeyBackupKey) *KeyBackupKeyDelete {
+	kbkd.mutation.Where(ps...)
+	return kbkd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (kbkd *KeyBackupKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, kbkd.sqlExec, kbkd.mutation, kbkd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbkd *KeyBackupKeyDelete) ExecX(ctx context.Context) int {
+	n, err := kbkd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (kbkd *KeyBackupKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(keybackupkey.Table, sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt))
+	if ps := kbkd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, kbkd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	kbkd.mutation.done = true
+	return affected, err
+}
+
+// KeyBackupKeyDeleteOne is the builder for deleting a single KeyBackupKey entity.
+type KeyBackupKeyDeleteOne struct {
+	kbkd *KeyBackupKeyDelete
+}
+
+// Where appends a list predicates to the KeyBackupKeyDelete builder.
+func (kbkdo *KeyBackupKeyDeleteOne) Where(ps ...predicate.KeyBackupKey) *KeyBackupKeyDeleteOne {
+	kbkdo.kbkd.mutation.Where(ps...)
+	return kbkdo
+}
+
+// Exec executes the deletion query.
+func (kbkdo *KeyBackupKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := kbkdo.kbkd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{keybackupkey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbkdo *KeyBackupKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := kbkdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
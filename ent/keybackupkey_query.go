@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+)
+
+// KeyBackupKeyQuery is the builder for querying KeyBackupKey entities.
+type KeyBackupKeyQuery struct {
+	config
+	ctx         *QueryContext
+	order       []keybackupkey.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.KeyBackupKey
+	withVersion *KeyBackupVersionQuery
+	withRoom    *RoomQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KeyBackupKeyQuery builder.
+func (kbkq *KeyBackupKeyQuery) Where(ps ...predicate.KeyBackupKey) *KeyBackupKeyQuery {
+	kbkq.predicates = append(kbkq.predicates, ps...)
+	return kbkq
+}
+
+// Limit the number of records to be returned by this query.
+func (kbkq *KeyBackupKeyQuery) Limit(limit int) *KeyBackupKeyQuery {
+	kbkq.ctx.Limit = &limit
+	return kbkq
+}
+
+// Offset to start from.
+func (kbkq *KeyBackupKeyQuery) Offset(offset int) *KeyBackupKeyQuery {
+	kbkq.ctx.Offset = &offset
+	return kbkq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (kbkq *KeyBackupKeyQuery) Unique(unique bool) *KeyBackupKeyQuery {
+	kbkq.ctx.Unique = &unique
+	return kbkq
+}
+
+// Order specifies how the records should be ordered.
+func (kbkq *KeyBackupKeyQuery) Order(o ...keybackupkey.OrderOption) *KeyBackupKeyQuery {
+	kbkq.order = append(kbkq.order, o...)
+	return kbkq
+}
+
+// QueryVersion chains the current query on the "version" edge.
+func (kbkq *KeyBackupKeyQuery) QueryVersion() *KeyBackupVersionQuery {
+	query := (&KeyBackupVersionClient{config: kbkq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := kbkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := kbkq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keybackupkey.Table, keybackupkey.FieldID, selector),
+			sqlgraph.To(keybackupversion.Table, keybackupversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, keybackupkey.VersionTable, keybackupkey.VersionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(kbkq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRoom chains the current query on the "room" edge.
+func (kbkq *KeyBackupKeyQuery) QueryRoom() *RoomQuery {
+	query := (&RoomClient{config: kbkq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := kbkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := kbkq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keybackupkey.Table, keybackupkey.FieldID, selector),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, keybackupkey.RoomTable, keybackupkey.RoomColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(kbkq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first KeyBackupKey entity from the query.
+// Returns a *NotFoundError when no KeyBackupKey was found.
+func (kbkq *KeyBackupKeyQuery) First(ctx context.Context) (*KeyBackupKey, error) {
+	nodes, err := kbkq.Limit(1).All(setContextOp(ctx, kbkq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{keybackupkey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (kbkq *KeyBackupKeyQuery) FirstX(ctx context.Context) *KeyBackupKey {
+	node, err := kbkq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KeyBackupKey ID from the query.
+// Returns a *NotFoundError when no KeyBackupKey ID was found.
+func (kbkq *KeyBackupKeyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kbkq.Limit(1).IDs(setContextOp(ctx, kbkq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{keybackupkey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (kbkq *KeyBackupKeyQuery) FirstIDX(ctx context.Context) int {
+	id, err := kbkq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KeyBackupKey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KeyBackupKey entity is found.
+// Returns a *NotFoundError when no KeyBackupKey entities are found.
+func (kbkq *KeyBackupKeyQuery) Only(ctx context.Context) (*KeyBackupKey, error) {
+	nodes, err := kbkq.Limit(2).All(setContextOp(ctx, kbkq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{keybackupkey.Label}
+	default:
+		return nil, &NotSingularError{keybackupkey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (kbkq *KeyBackupKeyQuery) OnlyX(ctx context.Context) *KeyBackupKey {
+	node, err := kbkq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KeyBackupKey ID in the query.
+// Returns a *NotSingularError when more than one KeyBackupKey ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (kbkq *KeyBackupKeyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kbkq.Limit(2).IDs(setContextOp(ctx, kbkq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{keybackupkey.Label}
+	default:
+		err = &NotSingularError{keybackupkey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (kbkq *KeyBackupKeyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := kbkq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KeyBackupKeys.
+func (kbkq *KeyBackupKeyQuery) All(ctx context.Context) ([]*KeyBackupKey, error) {
+	ctx = setContextOp(ctx, kbkq.ctx, "All")
+	if err := kbkq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KeyBackupKey, *KeyBackupKeyQuery]()
+	return withInterceptors[[]*KeyBackupKey](ctx, kbkq, qr, kbkq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (kbkq *KeyBackupKeyQuery) AllX(ctx context.Context) []*KeyBackupKey {
+	nodes, err := kbkq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KeyBackupKey IDs.
+func (kbkq *KeyBackupKeyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if kbkq.ctx.Unique == nil && kbkq.path != nil {
+		kbkq.Unique(true)
+	}
+	ctx = setContextOp(ctx, kbkq.ctx, "IDs")
+	if err = kbkq.Select(keybackupkey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (kbkq *KeyBackupKeyQuery) IDsX(ctx context.Context) []int {
+	ids, err := kbkq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (kbkq *KeyBackupKeyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, kbkq.ctx, "Count")
+	if err := kbkq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, kbkq, querierCount[*KeyBackupKeyQuery](), kbkq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (kbkq *KeyBackupKeyQuery) CountX(ctx context.Context) int {
+	count, err := kbkq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (kbkq *KeyBackupKeyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, kbkq.ctx, "Exist")
+	switch _, err := kbkq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (kbkq *KeyBackupKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := kbkq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KeyBackupKeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (kbkq *KeyBackupKeyQuery) Clone() *KeyBackupKeyQuery {
+	if kbkq == nil {
+		return nil
+	}
+	return &KeyBackupKeyQuery{
+		config:      kbkq.config,
+		ctx:         kbkq.ctx.Clone(),
+		order:       append([]keybackupkey.OrderOption{}, kbkq.order...),
+		inters:      append([]Interceptor{}, kbkq.inters...),
+		predicates:  append([]predicate.KeyBackupKey{}, kbkq.predicates...),
+		withVersion: kbkq.withVersion.Clone(),
+		withRoom:    kbkq.withRoom.Clone(),
+		// clone intermediate query.
+		sql:  kbkq.sql.Clone(),
+		path: kbkq.path,
+	}
+}
+
+// WithVersion tells the query-builder to eager-load the nodes that are connected to
+// the "version" edge. The optional arguments are used to configure the query builder of the edge.
+func (kbkq *KeyBackupKeyQuery) WithVersion(opts ...func(*KeyBackupVersionQuery)) *KeyBackupKeyQuery {
+	query := (&KeyBackupVersionClient{config: kbkq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	kbkq.withVersion = query
+	return kbkq
+}
+
+// WithRoom tells the query-builder to eager-load the nodes that are connected to
+// the "room" edge. The optional arguments are used to configure the query builder of the edge.
+func (kbkq *KeyBackupKeyQuery) WithRoom(opts ...func(*RoomQuery)) *KeyBackupKeyQuery {
+	query := (&RoomClient{config: kbkq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	kbkq.withRoom = query
+	return kbkq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SessionID string `json:"session_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KeyBackupKey.Query().
+//		GroupBy(keybackupkey.FieldSessionID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (kbkq *KeyBackupKeyQuery) GroupBy(field string, fields ...string) *KeyBackupKeyGroupBy {
+	kbkq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KeyBackupKeyGroupBy{build: kbkq}
+	grbuild.flds = &kbkq.ctx.Fields
+	grbuild.label = keybackupkey.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SessionID string `json:"session_id,omitempty"`
+//	}
+//
+//	client.KeyBackupKey.Query().
+//		Select(keybackupkey.FieldSessionID).
+//		Scan(ctx, &v)
+func (kbkq *KeyBackupKeyQuery) Select(fields ...string) *KeyBackupKeySelect {
+	kbkq.ctx.Fields = append(kbkq.ctx.Fields, fields...)
+	sbuild := &KeyBackupKeySelect{KeyBackupKeyQuery: kbkq}
+	sbuild.label = keybackupkey.Label
+	sbuild.flds, sbuild.scan = &kbkq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KeyBackupKeySelect configured with the given aggregations.
+func (kbkq *KeyBackupKeyQuery) Aggregate(fns ...AggregateFunc) *KeyBackupKeySelect {
+	return kbkq.Select().Aggregate(fns...)
+}
+
+func (kbkq *KeyBackupKeyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range kbkq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, kbkq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range kbkq.ctx.Fields {
+		if !keybackupkey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if kbkq.path != nil {
+		prev, err := kbkq.path(ctx)
+		if err != nil {
+			return err
+		}
+		kbkq.sql = prev
+	}
+	if keybackupkey.Policy == nil {
+		return errors.New("ent: uninitialized keybackupkey.Policy (forgotten import ent/runtime?)")
+	}
+	if err := keybackupkey.Policy.EvalQuery(ctx, kbkq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (kbkq *KeyBackupKeyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KeyBackupKey, error) {
+	var (
+		nodes       = []*KeyBackupKey{}
+		withFKs     = kbkq.withFKs
+		_spec       = kbkq.querySpec()
+		loadedTypes = [2]bool{
+			kbkq.withVersion != nil,
+			kbkq.withRoom != nil,
+		}
+	)
+	if kbkq.withVersion != nil || kbkq.withRoom != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, keybackupkey.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KeyBackupKey).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KeyBackupKey{config: kbkq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, kbkq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := kbkq.withVersion; query != nil {
+		if err := kbkq.loadVersion(ctx, query, nodes, nil,
+			func(n *KeyBackupKey, e *KeyBackupVersion) { n.Edges.Version = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := kbkq.withRoom; query != nil {
+		if err := kbkq.loadRoom(ctx, query, nodes, nil,
+			func(n *KeyBackupKey, e *Room) { n.Edges.Room = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (kbkq *KeyBackupKeyQuery) loadVersion(ctx context.Context, query *KeyBackupVersionQuery, nodes []*KeyBackupKey, init func(*KeyBackupKey), assign func(*KeyBackupKey, *KeyBackupVersion)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*KeyBackupKey)
+	for i := range nodes {
+		if nodes[i].key_backup_key_version == nil {
+			continue
+		}
+		fk := *nodes[i].key_backup_key_version
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(keybackupversion.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "key_backup_key_version" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (kbkq *KeyBackupKeyQuery) loadRoom(ctx context.Context, query *RoomQuery, nodes []*KeyBackupKey, init func(*KeyBackupKey), assign func(*KeyBackupKey, *Room)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*KeyBackupKey)
+	for i := range nodes {
+		if nodes[i].key_backup_key_room == nil {
+			continue
+		}
+		fk := *nodes[i].key_backup_key_room
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(room.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "key_backup_key_room" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (kbkq *KeyBackupKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := kbkq.querySpec()
+	_spec.Node.Columns = kbkq.ctx.Fields
+	if len(kbkq.ctx.Fields) > 0 {
+		_spec.Unique = kbkq.ctx.Unique != nil && *kbkq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, kbkq.driver, _spec)
+}
+
+func (kbkq *KeyBackupKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(keybackupkey.Table, keybackupkey.Columns, sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt))
+	_spec.From = kbkq.sql
+	if unique := kbkq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if kbkq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := kbkq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keybackupkey.FieldID)
+		for i := range fields {
+			if fields[i] != keybackupkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := kbkq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := kbkq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := kbkq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := kbkq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (kbkq *KeyBackupKeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(kbkq.driver.Dialect())
+	t1 := builder.Table(keybackupkey.Table)
+	columns := kbkq.ctx.Fields
+	if len(columns) == 0 {
+		columns = keybackupkey.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if kbkq.sql != nil {
+		selector = kbkq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if kbkq.ctx.Unique != nil && *kbkq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range kbkq.predicates {
+		p(selector)
+	}
+	for _, p := range kbkq.order {
+		p(selector)
+	}
+	if offset := kbkq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := kbkq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// KeyBackupKeyGroupBy is the group-by builder for KeyBackupKey entities.
+type KeyBackupKeyGroupBy struct {
+	selector
+	build *KeyBackupKeyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (kbkgb *KeyBackupKeyGroupBy) Aggregate(fns ...AggregateFunc) *KeyBackupKeyGroupBy {
+	kbkgb.fns = append(kbkgb.fns, fns...)
+	return kbkgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kbkgb *KeyBackupKeyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kbkgb.build.ctx, "GroupBy")
+	if err := kbkgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyBackupKeyQuery, *KeyBackupKeyGroupBy](ctx, kbkgb.build, kbkgb, kbkgb.build.inters, v)
+}
+
+func (kbkgb *KeyBackupKeyGroupBy) sqlScan(ctx context.Context, root *KeyBackupKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(kbkgb.fns))
+	for _, fn := range kbkgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*kbkgb.flds)+len(kbkgb.fns))
+		for _, f := range *kbkgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*kbkgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kbkgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KeyBackupKeySelect is the builder for selecting fields of KeyBackupKey entities.
+type KeyBackupKeySelect struct {
+	*KeyBackupKeyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (kbks *KeyBackupKeySelect) Aggregate(fns ...AggregateFunc) *KeyBackupKeySelect {
+	kbks.fns = append(kbks.fns, fns...)
+	return kbks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kbks *KeyBackupKeySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kbks.ctx, "Select")
+	if err := kbks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyBackupKeyQuery, *KeyBackupKeySelect](ctx, kbks.KeyBackupKeyQuery, kbks, kbks.inters, v)
+}
+
+func (kbks *KeyBackupKeySelect) sqlScan(ctx context.Context, root *KeyBackupKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(kbks.fns))
+	for _, fn := range kbks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*kbks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kbks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyBackupKeyUpdate is the builder for updating KeyBackupKey entities.
+type KeyBackupKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KeyBackupKeyMutation
+}
+
+// Where appends a list predicates to the KeyBackupKeyUpdate builder.
+func (kbku *KeyBackupKeyUpdate) Where(ps ...predicate.KeyBackupKey) *KeyBackupKeyUpdate {
+	kbku.mutation.Where(ps...)
+	return kbku
+}
+
+// SetFirstMessageIndex sets the "first_message_index" field.
+func (kbku *KeyBackupKeyUpdate) SetFirstMessageIndex(i int) *KeyBackupKeyUpdate {
+	kbku.mutation.ResetFirstMessageIndex()
+	kbku.mutation.SetFirstMessageIndex(i)
+	return kbku
+}
+
+// SetNillableFirstMessageIndex sets the "first_message_index" field if the given value is not nil.
+func (kbku *KeyBackupKeyUpdate) SetNillableFirstMessageIndex(i *int) *KeyBackupKeyUpdate {
+	if i != nil {
+		kbku.SetFirstMessageIndex(*i)
+	}
+	return kbku
+}
+
+// AddFirstMessageIndex adds i to the "first_message_index" field.
+func (kbku *KeyBackupKeyUpdate) AddFirstMessageIndex(i int) *KeyBackupKeyUpdate {
+	kbku.mutation.AddFirstMessageIndex(i)
+	return kbku
+}
+
+// SetForwardedCount sets the "forwarded_count" field.
+func (kbku *KeyBackupKeyUpdate) SetForwardedCount(i int) *KeyBackupKeyUpdate {
+	kbku.mutation.ResetForwardedCount()
+	kbku.mutation.SetForwardedCount(i)
+	return kbku
+}
+
+// SetNillableForwardedCount sets the "forwarded_count" field if the given value is not nil.
+func (kbku *KeyBackupKeyUpdate) SetNillableForwardedCount(i *int) *KeyBackupKeyUpdate {
+	if i != nil {
+		kbku.SetForwardedCount(*i)
+	}
+	return kbku
+}
+
+// AddForwardedCount adds i to the "forwarded_count" field.
+func (kbku *KeyBackupKeyUpdate) AddForwardedCount(i int) *KeyBackupKeyUpdate {
+	kbku.mutation.AddForwardedCount(i)
+	return kbku
+}
+
+// SetIsVerified sets the "is_verified" field.
+func (kbku *KeyBackupKeyUpdate) SetIsVerified(b bool) *KeyBackupKeyUpdate {
+	kbku.mutation.SetIsVerified(b)
+	return kbku
+}
+
+// SetNillableIsVerified sets the "is_verified" field if the given value is not nil.
+func (kbku *KeyBackupKeyUpdate) SetNillableIsVerified(b *bool) *KeyBackupKeyUpdate {
+	if b != nil {
+		kbku.SetIsVerified(*b)
+	}
+	return kbku
+}
+
+// SetSessionData sets the "session_data" field.
+func (kbku *KeyBackupKeyUpdate) SetSessionData(s string) *KeyBackupKeyUpdate {
+	kbku.mutation.SetSessionData(s)
+	return kbku
+}
+
+// SetNillableSessionData sets the "session_data" field if the given value is not nil.
+func (kbku *KeyBackupKeyUpdate) SetNillableSessionData(s *string) *KeyBackupKeyUpdate {
+	if s != nil {
+		kbku.SetSessionData(*s)
+	}
+	return kbku
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (kbku *KeyBackupKeyUpdate) SetUpdatedAt(t time.Time) *KeyBackupKeyUpdate {
+	kbku.mutation.SetUpdatedAt(t)
+	return kbku
+}
+
+// Mutation returns the KeyBackupKeyMutation object of the builder.
+func (kbku *KeyBackupKeyUpdate) Mutation() *KeyBackupKeyMutation {
+	return kbku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (kbku *KeyBackupKeyUpdate) Save(ctx context.Context) (int, error) {
+	if err := kbku.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, kbku.sqlSave, kbku.mutation, kbku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kbku *KeyBackupKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := kbku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (kbku *KeyBackupKeyUpdate) Exec(ctx context.Context) error {
+	_, err := kbku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbku *KeyBackupKeyUpdate) ExecX(ctx context.Context) {
+	if err := kbku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kbku *KeyBackupKeyUpdate) defaults() error {
+	if _, ok := kbku.mutation.UpdatedAt(); !ok {
+		if keybackupkey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized keybackupkey.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := keybackupkey.UpdateDefaultUpdatedAt()
+		kbku.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kbku *KeyBackupKeyUpdate) check() error {
+	if v, ok := kbku.mutation.FirstMessageIndex(); ok {
+		if err := keybackupkey.FirstMessageIndexValidator(v); err != nil {
+			return &ValidationError{Name: "first_message_index", err: fmt.Errorf(`ent: validator failed for field "KeyBackupKey.first_message_index": %w`, err)}
+		}
+	}
+	if v, ok := kbku.mutation.ForwardedCount(); ok {
+		if err := keybackupkey.ForwardedCountValidator(v); err != nil {
+			return &ValidationError{Name: "forwarded_count", err: fmt.Errorf(`ent: validator failed for field "KeyBackupKey.forwarded_count": %w`, err)}
+		}
+	}
+	if v, ok := kbku.mutation.SessionData(); ok {
+		if err := keybackupkey.SessionDataValidator(v); err != nil {
+			return &ValidationError{Name: "session_data", err: fmt.Errorf(`ent: validator failed for field "KeyBackupKey.session_data": %w`, err)}
+		}
+	}
+	if _, ok := kbku.mutation.VersionID(); kbku.mutation.VersionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "KeyBackupKey.version"`)
+	}
+	if _, ok := kbku.mutation.RoomID(); kbku.mutation.RoomCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "KeyBackupKey.room"`)
+	}
+	return nil
+}
+
+func (kbku *KeyBackupKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := kbku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keybackupkey.Table, keybackupkey.Columns, sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt))
+	if ps := kbku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kbku.mutation.FirstMessageIndex(); ok {
+		_spec.SetField(keybackupkey.FieldFirstMessageIndex, field.TypeInt, value)
+	}
+	if value, ok := kbku.mutation.AddedFirstMessageIndex(); ok {
+		_spec.AddField(keybackupkey.FieldFirstMessageIndex, field.TypeInt, value)
+	}
+	if value, ok := kbku.mutation.ForwardedCount(); ok {
+		_spec.SetField(keybackupkey.FieldForwardedCount, field.TypeInt, value)
+	}
+	if value, ok := kbku.mutation.AddedForwardedCount(); ok {
+		_spec.AddField(keybackupkey.FieldForwardedCount, field.TypeInt, value)
+	}
+	if value, ok := kbku.mutation.IsVerified(); ok {
+		_spec.SetField(keybackupkey.FieldIsVerified, field.TypeBool, value)
+	}
+	if value, ok := kbku.mutation.SessionData(); ok {
+		_spec.SetField(keybackupkey.FieldSessionData, field.TypeString, value)
+	}
+	if value, ok := kbku.mutation.UpdatedAt(); ok {
+		_spec.SetField(keybackupkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, kbku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keybackupkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	kbku.mutation.done = true
+	return n, nil
+}
+
+// KeyBackupKeyUpdateOne is the builder for updating a single KeyBackupKey entity.
+type KeyBackupKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KeyBackupKeyMutation
+}
+
+// SetFirstMessageIndex sets the "first_message_index" field.
+func (kbkuo *KeyBackupKeyUpdateOne) SetFirstMessageIndex(i int) *KeyBackupKeyUpdateOne {
+	kbkuo.mutation.ResetFirstMessageIndex()
+	kbkuo.mutation.SetFirstMessageIndex(i)
+	return kbkuo
+}
+
+// SetNillableFirstMessageIndex sets the "first_message_index" field if the given value is not nil.
+func (kbkuo *KeyBackupKeyUpdateOne) SetNillableFirstMessageIndex(i *int) *KeyBackupKeyUpdateOne {
+	if i != nil {
+		kbkuo.SetFirstMessageIndex(*i)
+	}
+	return kbkuo
+}
+
+// AddFirstMessageIndex adds i to the "first_message_index" field.
+func (kbkuo *KeyBackupKeyUpdateOne) AddFirstMessageIndex(i int) *KeyBackupKeyUpdateOne {
+	kbkuo.mutation.AddFirstMessageIndex(i)
+	return kbkuo
+}
+
+// SetForwardedCount sets the "forwarded_count" field.
+func (kbkuo *KeyBackupKeyUpdateOne) SetForwardedCount(i int) *KeyBackupKeyUpdateOne {
+	kbkuo.mutation.ResetForwardedCount()
+	kbkuo.mutation.SetForwardedCount(i)
+	return kbkuo
+}
+
+// SetNillableForwardedCount sets the "forwarded_count" field if the given value is not nil.
+func (kbkuo *KeyBackupKeyUpdateOne) SetNillableForwardedCount(i *int) *KeyBackupKeyUpdateOne {
+	if i != nil {
+		kbkuo.SetForwardedCount(*i)
+	}
+	return kbkuo
+}
+
+// AddForwardedCount adds i to the "forwarded_count" field.
+func (kbkuo *KeyBackupKeyUpdateOne) AddForwardedCount(i int) *KeyBackupKeyUpdateOne {
+	kbkuo.mutation.AddForwardedCount(i)
+	return kbkuo
+}
+
+// SetIsVerified sets the "is_verified" field.
+func (kbkuo *KeyBackupKeyUpdateOne) SetIsVerified(b bool) *KeyBackupKeyUpdateOne {
+	kbkuo.mutation.SetIsVerified(b)
+	return kbkuo
+}
+
+// SetNillableIsVerified sets the "is_verified" field if the given value is not nil.
+func (kbkuo *KeyBackupKeyUpdateOne) SetNillableIsVerified(b *bool) *KeyBackupKeyUpdateOne {
+	if b != nil {
+		kbkuo.SetIsVerified(*b)
+	}
+	return kbkuo
+}
+
+// SetSessionData sets the "session_data" field.
+func (kbkuo *KeyBackupKeyUpdateOne) SetSessionData(s string) *KeyBackupKeyUpdateOne {
+	kbkuo.mutation.SetSessionData(s)
+	return kbkuo
+}
+
+// SetNillableSessionData sets the "session_data" field if the given value is not nil.
+func (kbkuo *KeyBackupKeyUpdateOne) SetNillableSessionData(s *string) *KeyBackupKeyUpdateOne {
+	if s != nil {
+		kbkuo.SetSessionData(*s)
+	}
+	return kbkuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (kbkuo *KeyBackupKeyUpdateOne) SetUpdatedAt(t time.Time) *KeyBackupKeyUpdateOne {
+	kbkuo.mutation.SetUpdatedAt(t)
+	return kbkuo
+}
+
+// Mutation returns the KeyBackupKeyMutation object of the builder.
+func (kbkuo *KeyBackupKeyUpdateOne) Mutation() *KeyBackupKeyMutation {
+	return kbkuo.mutation
+}
+
+// Where appends a list predicates to the KeyBackupKeyUpdate builder.
+func (kbkuo *KeyBackupKeyUpdateOne) Where(ps ...predicate.KeyBackupKey) *KeyBackupKeyUpdateOne {
+	kbkuo.mutation.Where(ps...)
+	return kbkuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (kbkuo *KeyBackupKeyUpdateOne) Select(field string, fields ...string) *KeyBackupKeyUpdateOne {
+	kbkuo.fields = append([]string{field}, fields...)
+	return kbkuo
+}
+
+// Save executes the query and returns the updated KeyBackupKey entity.
+func (kbkuo *KeyBackupKeyUpdateOne) Save(ctx context.Context) (*KeyBackupKey, error) {
+	if err := kbkuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, kbkuo.sqlSave, kbkuo.mutation, kbkuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kbkuo *KeyBackupKeyUpdateOne) SaveX(ctx context.Context) *KeyBackupKey {
+	node, err := kbkuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (kbkuo *KeyBackupKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := kbkuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbkuo *KeyBackupKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := kbkuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kbkuo *KeyBackupKeyUpdateOne) defaults() error {
+	if _, ok := kbkuo.mutation.UpdatedAt(); !ok {
+		if keybackupkey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized keybackupkey.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := keybackupkey.UpdateDefaultUpdatedAt()
+		kbkuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kbkuo *KeyBackupKeyUpdateOne) check() error {
+	if v, ok := kbkuo.mutation.FirstMessageIndex(); ok {
+		if err := keybackupkey.FirstMessageIndexValidator(v); err != nil {
+			return &ValidationError{Name: "first_message_index", err: fmt.Errorf(`ent: validator failed for field "KeyBackupKey.first_message_index": %w`, err)}
+		}
+	}
+	if v, ok := kbkuo.mutation.ForwardedCount(); ok {
+		if err := keybackupkey.ForwardedCountValidator(v); err != nil {
+			return &ValidationError{Name: "forwarded_count", err: fmt.Errorf(`ent: validator failed for field "KeyBackupKey.forwarded_count": %w`, err)}
+		}
+	}
+	if v, ok := kbkuo.mutation.SessionData(); ok {
+		if err := keybackupkey.SessionDataValidator(v); err != nil {
+			return &ValidationError{Name: "session_data", err: fmt.Errorf(`ent: validator failed for field "KeyBackupKey.session_data": %w`, err)}
+		}
+	}
+	if _, ok := kbkuo.mutation.VersionID(); kbkuo.mutation.VersionCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "KeyBackupKey.version"`)
+	}
+	if _, ok := kbkuo.mutation.RoomID(); kbkuo.mutation.RoomCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "KeyBackupKey.room"`)
+	}
+	return nil
+}
+
+func (kbkuo *KeyBackupKeyUpdateOne) sqlSave(ctx context.Context) (_node *KeyBackupKey, err error) {
+	if err := kbkuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keybackupkey.Table, keybackupkey.Columns, sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt))
+	id, ok := kbkuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KeyBackupKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := kbkuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keybackupkey.FieldID)
+		for _, f := range fields {
+			if !keybackupkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != keybackupkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := kbkuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kbkuo.mutation.FirstMessageIndex(); ok {
+		_spec.SetField(keybackupkey.FieldFirstMessageIndex, field.TypeInt, value)
+	}
+	if value, ok := kbkuo.mutation.AddedFirstMessageIndex(); ok {
+		_spec.AddField(keybackupkey.FieldFirstMessageIndex, field.TypeInt, value)
+	}
+	if value, ok := kbkuo.mutation.ForwardedCount(); ok {
+		_spec.SetField(keybackupkey.FieldForwardedCount, field.TypeInt, value)
+	}
+	if value, ok := kbkuo.mutation.AddedForwardedCount(); ok {
+		_spec.AddField(keybackupkey.FieldForwardedCount, field.TypeInt, value)
+	}
+	if value, ok := kbkuo.mutation.IsVerified(); ok {
+		_spec.SetField(keybackupkey.FieldIsVerified, field.TypeBool, value)
+	}
+	if value, ok := kbkuo.mutation.SessionData(); ok {
+		_spec.SetField(keybackupkey.FieldSessionData, field.TypeString, value)
+	}
+	if value, ok := kbkuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(keybackupkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &KeyBackupKey{config: kbkuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, kbkuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keybackupkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	kbkuo.mutation.done = true
+	return _node, nil
+}
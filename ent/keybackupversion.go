@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// KeyBackupVersion is the model entity for the KeyBackupVersion schema.
+type KeyBackupVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Algorithm holds the value of the "algorithm" field.
+	Algorithm string `json:"algorithm,omitempty"`
+	// AuthData holds the value of the "auth_data" field.
+	AuthData string `json:"auth_data,omitempty"`
+	// Etag holds the value of the "etag" field.
+	Etag int `json:"etag,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the KeyBackupVersionQuery when eager-loading is set.
+	Edges                    KeyBackupVersionEdges `json:"edges"`
+	key_backup_version_owner *int
+	selectValues             sql.SelectValues
+}
+
+// KeyBackupVersionEdges holds the relations/edges for other nodes in the graph.
+type KeyBackupVersionEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Keys holds the value of the keys edge.
+	Keys []*KeyBackupKey `json:"keys,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KeyBackupVersionEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// KeysOrErr returns the Keys value or an error if the edge
+// was not loaded in eager-loading.
+func (e KeyBackupVersionEdges) KeysOrErr() ([]*KeyBackupKey, error) {
+	if e.loadedTypes[1] {
+		return e.Keys, nil
+	}
+	return nil, &NotLoadedError{edge: "keys"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KeyBackupVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case keybackupversion.FieldID, keybackupversion.FieldEtag:
+			values[i] = new(sql.NullInt64)
+		case keybackupversion.FieldAlgorithm, keybackupversion.FieldAuthData:
+			values[i] = new(sql.NullString)
+		case keybackupversion.FieldCreatedAt, keybackupversion.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case keybackupversion.ForeignKeys[0]: // key_backup_version_owner
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KeyBackupVersion fields.
+func (kbv *KeyBackupVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case keybackupversion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			kbv.ID = int(value.Int64)
+		case keybackupversion.FieldAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field algorithm", values[i])
+			} else if value.Valid {
+				kbv.Algorithm = value.String
+			}
+		case keybackupversion.FieldAuthData:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_data", values[i])
+			} else if value.Valid {
+				kbv.AuthData = value.String
+			}
+		case keybackupversion.FieldEtag:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				kbv.Etag = int(value.Int64)
+			}
+		case keybackupversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				kbv.CreatedAt = value.Time
+			}
+		case keybackupversion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				kbv.UpdatedAt = value.Time
+			}
+		case keybackupversion.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field key_backup_version_owner", value)
+			} else if value.Valid {
+				kbv.key_backup_version_owner = new(int)
+				*kbv.key_backup_version_owner = int(value.Int64)
+			}
+		default:
+			kbv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KeyBackupVersion.
+// This includes values selected through modifiers, order, etc.
+func (kbv *KeyBackupVersion) Value(name string) (ent.Value, error) {
+	return kbv.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the KeyBackupVersion entity.
+func (kbv *KeyBackupVersion) QueryOwner() *UserQuery {
+	return NewKeyBackupVersionClient(kbv.config).QueryOwner(kbv)
+}
+
+// QueryKeys queries the "keys" edge of the KeyBackupVersion entity.
+func (kbv *KeyBackupVersion) QueryKeys() *KeyBackupKeyQuery {
+	return NewKeyBackupVersionClient(kbv.config).QueryKeys(kbv)
+}
+
+// Update returns a builder for updating this KeyBackupVersion.
+// Note that you need to call KeyBackupVersion.Unwrap() before calling this method if this KeyBackupVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (kbv *KeyBackupVersion) Update() *KeyBackupVersionUpdateOne {
+	return NewKeyBackupVersionClient(kbv.config).UpdateOne(kbv)
+}
+
+// Unwrap unwraps the KeyBackupVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (kbv *KeyBackupVersion) Unwrap() *KeyBackupVersion {
+	_tx, ok := kbv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KeyBackupVersion is not a transactional entity")
+	}
+	kbv.config.driver = _tx.drv
+	return kbv
+}
+
+// String implements the fmt.Stringer.
+func (kbv *KeyBackupVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("KeyBackupVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", kbv.ID))
+	builder.WriteString("algorithm=")
+	builder.WriteString(kbv.Algorithm)
+	builder.WriteString(", ")
+	builder.WriteString("auth_data=")
+	builder.WriteString(kbv.AuthData)
+	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(fmt.Sprintf("%v", kbv.Etag))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(kbv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(kbv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KeyBackupVersions is a parsable slice of KeyBackupVersion.
+type KeyBackupVersions []*KeyBackupVersion
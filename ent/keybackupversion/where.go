@@ -0,0 +1,392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keybackupversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLTE(FieldID, id))
+}
+
+// Algorithm applies equality check predicate on the "algorithm" field. It's identical to AlgorithmEQ.
+func Algorithm(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// AuthData applies equality check predicate on the "auth_data" field. It's identical to AuthDataEQ.
+func AuthData(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldAuthData, v))
+}
+
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldEtag, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AlgorithmEQ applies the EQ predicate on the "algorithm" field.
+func AlgorithmEQ(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmNEQ applies the NEQ predicate on the "algorithm" field.
+func AlgorithmNEQ(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNEQ(FieldAlgorithm, v))
+}
+
+// AlgorithmIn applies the In predicate on the "algorithm" field.
+func AlgorithmIn(vs ...string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmNotIn applies the NotIn predicate on the "algorithm" field.
+func AlgorithmNotIn(vs ...string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNotIn(FieldAlgorithm, vs...))
+}
+
+// AlgorithmGT applies the GT predicate on the "algorithm" field.
+func AlgorithmGT(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGT(FieldAlgorithm, v))
+}
+
+// AlgorithmGTE applies the GTE predicate on the "algorithm" field.
+func AlgorithmGTE(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGTE(FieldAlgorithm, v))
+}
+
+// AlgorithmLT applies the LT predicate on the "algorithm" field.
+func AlgorithmLT(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLT(FieldAlgorithm, v))
+}
+
+// AlgorithmLTE applies the LTE predicate on the "algorithm" field.
+func AlgorithmLTE(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLTE(FieldAlgorithm, v))
+}
+
+// AlgorithmContains applies the Contains predicate on the "algorithm" field.
+func AlgorithmContains(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldContains(FieldAlgorithm, v))
+}
+
+// AlgorithmHasPrefix applies the HasPrefix predicate on the "algorithm" field.
+func AlgorithmHasPrefix(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldHasPrefix(FieldAlgorithm, v))
+}
+
+// AlgorithmHasSuffix applies the HasSuffix predicate on the "algorithm" field.
+func AlgorithmHasSuffix(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldHasSuffix(FieldAlgorithm, v))
+}
+
+// AlgorithmEqualFold applies the EqualFold predicate on the "algorithm" field.
+func AlgorithmEqualFold(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEqualFold(FieldAlgorithm, v))
+}
+
+// AlgorithmContainsFold applies the ContainsFold predicate on the "algorithm" field.
+func AlgorithmContainsFold(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldContainsFold(FieldAlgorithm, v))
+}
+
+// AuthDataEQ applies the EQ predicate on the "auth_data" field.
+func AuthDataEQ(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldAuthData, v))
+}
+
+// AuthDataNEQ applies the NEQ predicate on the "auth_data" field.
+func AuthDataNEQ(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNEQ(FieldAuthData, v))
+}
+
+// AuthDataIn applies the In predicate on the "auth_data" field.
+func AuthDataIn(vs ...string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldIn(FieldAuthData, vs...))
+}
+
+// AuthDataNotIn applies the NotIn predicate on the "auth_data" field.
+func AuthDataNotIn(vs ...string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNotIn(FieldAuthData, vs...))
+}
+
+// AuthDataGT applies the GT predicate on the "auth_data" field.
+func AuthDataGT(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGT(FieldAuthData, v))
+}
+
+// AuthDataGTE applies the GTE predicate on the "auth_data" field.
+func AuthDataGTE(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGTE(FieldAuthData, v))
+}
+
+// AuthDataLT applies the LT predicate on the "auth_data" field.
+func AuthDataLT(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLT(FieldAuthData, v))
+}
+
+// AuthDataLTE applies the LTE predicate on the "auth_data" field.
+func AuthDataLTE(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLTE(FieldAuthData, v))
+}
+
+// AuthDataContains applies the Contains predicate on the "auth_data" field.
+func AuthDataContains(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldContains(FieldAuthData, v))
+}
+
+// AuthDataHasPrefix applies the HasPrefix predicate on the "auth_data" field.
+func AuthDataHasPrefix(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldHasPrefix(FieldAuthData, v))
+}
+
+// AuthDataHasSuffix applies the HasSuffix predicate on the "auth_data" field.
+func AuthDataHasSuffix(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldHasSuffix(FieldAuthData, v))
+}
+
+// AuthDataEqualFold applies the EqualFold predicate on the "auth_data" field.
+func AuthDataEqualFold(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEqualFold(FieldAuthData, v))
+}
+
+// AuthDataContainsFold applies the ContainsFold predicate on the "auth_data" field.
+func AuthDataContainsFold(v string) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldContainsFold(FieldAuthData, v))
+}
+
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v int) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLTE(FieldEtag, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasKeys applies the HasEdge predicate on the "keys" edge.
+func HasKeys() predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, KeysTable, KeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKeysWith applies the HasEdge predicate on the "keys" edge with a given conditions (other predicates).
+func HasKeysWith(preds ...predicate.KeyBackupKey) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(func(s *sql.Selector) {
+		step := newKeysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KeyBackupVersion) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KeyBackupVersion) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KeyBackupVersion) predicate.KeyBackupVersion {
+	return predicate.KeyBackupVersion(sql.NotPredicates(p))
+}
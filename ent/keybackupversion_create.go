@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// KeyBackupVersionCreate is the builder for creating a KeyBackupVersion entity.
+type KeyBackupVersionCreate struct {
+	config
+	mutation *KeyBackupVersionMutation
+	hooks    []Hook
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (kbvc *KeyBackupVersionCreate) SetAlgorithm(s string) *KeyBackupVersionCreate {
+	kbvc.mutation.SetAlgorithm(s)
+	return kbvc
+}
+
+// SetAuthData sets the "auth_data" field.
+func (kbvc *KeyBackupVersionCreate) SetAuthData(s string) *KeyBackupVersionCreate {
+	kbvc.mutation.SetAuthData(s)
+	return kbvc
+}
+
+// SetEtag sets the "etag" field.
+func (kbvc *KeyBackupVersionCreate) SetEtag(i int) *KeyBackupVersionCreate {
+	kbvc.mutation.SetEtag(i)
+	return kbvc
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (kbvc *KeyBackupVersionCreate) SetNillableEtag(i *int) *KeyBackupVersionCreate {
+	if i != nil {
+		kbvc.SetEtag(*i)
+	}
+	return kbvc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (kbvc *KeyBackupVersionCreate) SetCreatedAt(t time.Time) *KeyBackupVersionCreate {
+	kbvc.mutation.SetCreatedAt(t)
+	return kbvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (kbvc *KeyBackupVersionCreate) SetNillableCreatedAt(t *time.Time) *KeyBackupVersionCreate {
+	if t != nil {
+		kbvc.SetCreatedAt(*t)
+	}
+	return kbvc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (kbvc *KeyBackupVersionCreate) SetUpdatedAt(t time.Time) *KeyBackupVersionCreate {
+	kbvc.mutation.SetUpdatedAt(t)
+	return kbvc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (kbvc *KeyBackupVersionCreate) SetNillableUpdatedAt(t *time.Time) *KeyBackupVersionCreate {
+	if t != nil {
+		kbvc.SetUpdatedAt(*t)
+	}
+	return kbvc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (kbvc *KeyBackupVersionCreate) SetOwnerID(id int) *KeyBackupVersionCreate {
+	kbvc.mutation.SetOwnerID(id)
+	return kbvc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (kbvc *KeyBackupVersionCreate) SetOwner(u *User) *KeyBackupVersionCreate {
+	return kbvc.SetOwnerID(u.ID)
+}
+
+// AddKeyIDs adds the "keys" edge to the KeyBackupKey entity by IDs.
+func (kbvc *KeyBackupVersionCreate) AddKeyIDs(ids ...int) *KeyBackupVersionCreate {
+	kbvc.mutation.AddKeyIDs(ids...)
+	return kbvc
+}
+
+// AddKeys adds the "keys" edges to the KeyBackupKey entity.
+func (kbvc *KeyBackupVersionCreate) AddKeys(k ...*KeyBackupKey) *KeyBackupVersionCreate {
+	ids := make([]int, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return kbvc.AddKeyIDs(ids...)
+}
+
+// Mutation returns the KeyBackupVersionMutation object of the builder.
+func (kbvc *KeyBackupVersionCreate) Mutation() *KeyBackupVersionMutation {
+	return kbvc.mutation
+}
+
+// Save creates the KeyBackupVersion in the database.
+func (kbvc *KeyBackupVersionCreate) Save(ctx context.Context) (*KeyBackupVersion, error) {
+	if err := kbvc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, kbvc.sqlSave, kbvc.mutation, kbvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (kbvc *KeyBackupVersionCreate) SaveX(ctx context.Context) *KeyBackupVersion {
+	v, err := kbvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kbvc *KeyBackupVersionCreate) Exec(ctx context.Context) error {
+	_, err := kbvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbvc *KeyBackupVersionCreate) ExecX(ctx context.Context) {
+	if err := kbvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kbvc *KeyBackupVersionCreate) defaults() error {
+	if _, ok := kbvc.mutation.Etag(); !ok {
+		v := keybackupversion.DefaultEtag
+		kbvc.mutation.SetEtag(v)
+	}
+	if _, ok := kbvc.mutation.CreatedAt(); !ok {
+		if keybackupversion.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized keybackupversion.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := keybackupversion.DefaultCreatedAt()
+		kbvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := kbvc.mutation.UpdatedAt(); !ok {
+		if keybackupversion.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized keybackupversion.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := keybackupversion.DefaultUpdatedAt()
+		kbvc.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kbvc *KeyBackupVersionCreate) check() error {
+	if _, ok := kbvc.mutation.Algorithm(); !ok {
+		return &ValidationError{Name: "algorithm", err: errors.New(`ent: missing required field "KeyBackupVersion.algorithm"`)}
+	}
+	if v, ok := kbvc.mutation.Algorithm(); ok {
+		if err := keybackupversion.AlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "algorithm", err: fmt.Errorf(`ent: validator failed for field "KeyBackupVersion.algorithm": %w`, err)}
+		}
+	}
+	if _, ok := kbvc.mutation.AuthData(); !ok {
+		return &ValidationError{Name: "auth_data", err: errors.New(`ent: missing required field "KeyBackupVersion.auth_data"`)}
+	}
+	if v, ok := kbvc.mutation.AuthData(); ok {
+		if err := keybackupversion.AuthDataValidator(v); err != nil {
+			return &ValidationError{Name: "auth_data", err: fmt.Errorf(`ent: validator failed for field "KeyBackupVersion.auth_data": %w`, err)}
+		}
+	}
+	if _, ok := kbvc.mutation.Etag(); !ok {
+		return &ValidationError{Name: "etag", err: errors.New(`ent: missing required field "KeyBackupVersion.etag"`)}
+	}
+	if v, ok := kbvc.mutation.Etag(); ok {
+		if err := keybackupversion.EtagValidator(v); err != nil {
+			return &ValidationError{Name: "etag", err: fmt.Errorf(`ent: validator failed for field "KeyBackupVersion.etag": %w`, err)}
+		}
+	}
+	if _, ok := kbvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "KeyBackupVersion.created_at"`)}
+	}
+	if _, ok := kbvc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "KeyBackupVersion.updated_at"`)}
+	}
+	if _, ok := kbvc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "KeyBackupVersion.owner"`)}
+	}
+	return nil
+}
+
+func (kbvc *KeyBackupVersionCreate) sqlSave(ctx context.Context) (*KeyBackupVersion, error) {
+	if err := kbvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := kbvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, kbvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	kbvc.mutation.id = &_node.ID
+	kbvc.mutation.done = true
+	return _node, nil
+}
+
+func (kbvc *KeyBackupVersionCreate) createSpec() (*KeyBackupVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KeyBackupVersion{config: kbvc.config}
+		_spec = sqlgraph.NewCreateSpec(keybackupversion.Table, sqlgraph.NewFieldSpec(keybackupversion.FieldID, field.TypeInt))
+	)
+	if value, ok := kbvc.mutation.Algorithm(); ok {
+		_spec.SetField(keybackupversion.FieldAlgorithm, field.TypeString, value)
+		_node.Algorithm = value
+	}
+	if value, ok := kbvc.mutation.AuthData(); ok {
+		_spec.SetField(keybackupversion.FieldAuthData, field.TypeString, value)
+		_node.AuthData = value
+	}
+	if value, ok := kbvc.mutation.Etag(); ok {
+		_spec.SetField(keybackupversion.FieldEtag, field.TypeInt, value)
+		_node.Etag = value
+	}
+	if value, ok := kbvc.mutation.CreatedAt(); ok {
+		_spec.SetField(keybackupversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := kbvc.mutation.UpdatedAt(); ok {
+		_spec.SetField(keybackupversion.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := kbvc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   keybackupversion.OwnerTable,
+			Columns: []string{keybackupversion.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.key_backup_version_owner = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := kbvc.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   keybackupversion.KeysTable,
+			Columns: []string{keybackupversion.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// KeyBackupVersionCreateBulk is the builder for creating many KeyBackupVersion entities in bulk.
+type KeyBackupVersionCreateBulk struct {
+	config
+	err      error
+	builders []*KeyBackupVersionCreate
+}
+
+// Save creates the KeyBackupVersion entities in the database.
+func (kbvcb *KeyBackupVersionCreateBulk) Save(ctx context.Context) ([]*KeyBackupVersion, error) {
+	if kbvcb.err != nil {
+		return nil, kbvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(kbvcb.builders))
+	nodes := make([]*KeyBackupVersion, len(kbvcb.builders))
+	mutators := make([]Mutator, len(kbvcb.builders))
+	for i := range kbvcb.builders {
+		func(i int, root context.Context) {
+			builder := kbvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KeyBackupVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, kbvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, kbvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, kbvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kbvcb *KeyBackupVersionCreateBulk) SaveX(ctx context.Context) []*KeyBackupVersion {
+	v, err := kbvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kbvcb *KeyBackupVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := kbvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbvcb *KeyBackupVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := kbvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyBackupVersionDelete is the builder for deleting a KeyBackupVersion entity.
+type KeyBackupVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *KeyBackupVersionMutation
+}
+
+// Where appends a list predicates to the KeyBackupVersionDelete builder.
+func (kbvd *KeyBackupVersionDelete) Where(ps ...predicate.KeyBackupVersion) *KeyBackupVersionDelete {
+	kbvd.mutation.Where(ps...)
+	return kbvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (kbvd *KeyBackupVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, kbvd.sqlExec, kbvd.mutation, kbvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbvd *KeyBackupVersionDelete) ExecX(ctx context.Context) int {
+	n, err := kbvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (kbvd *KeyBackupVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(keybackupversion.Table, sqlgraph.NewFieldSpec(keybackupversion.FieldID, field.TypeInt))
+	if ps := kbvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, kbvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	kbvd.mutation.done = true
+	return affected, err
+}
+
+// KeyBackupVersionDeleteOne is the builder for deleting a single KeyBackupVersion entity.
+type KeyBackupVersionDeleteOne struct {
+	kbvd *KeyBackupVersionDelete
+}
+
+// Where appends a list predicates to the KeyBackupVersionDelete builder.
+func (kbvdo *KeyBackupVersionDeleteOne) Where(ps ...predicate.KeyBackupVersion) *KeyBackupVersionDeleteOne {
+	kbvdo.kbvd.mutation.Where(ps...)
+	return kbvdo
+}
+
+// Exec executes the deletion query.
+func (kbvdo *KeyBackupVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := kbvdo.kbvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{keybackupversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbvdo *KeyBackupVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := kbvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
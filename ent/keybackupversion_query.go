@@ -0,0 +1,696 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// KeyBackupVersionQuery is the builder for querying KeyBackupVersion entities.
+type KeyBackupVersionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []keybackupversion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.KeyBackupVersion
+	withOwner  *UserQuery
+	withKeys   *KeyBackupKeyQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KeyBackupVersionQuery builder.
+func (kbvq *KeyBackupVersionQuery) Where(ps ...predicate.KeyBackupVersion) *KeyBackupVersionQuery {
+	kbvq.predicates = append(kbvq.predicates, ps...)
+	return kbvq
+}
+
+// Limit the number of records to be returned by this query.
+func (kbvq *KeyBackupVersionQuery) Limit(limit int) *KeyBackupVersionQuery {
+	kbvq.ctx.Limit = &limit
+	return kbvq
+}
+
+// Offset to start from.
+func (kbvq *KeyBackupVersionQuery) Offset(offset int) *KeyBackupVersionQuery {
+	kbvq.ctx.Offset = &offset
+	return kbvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (kbvq *KeyBackupVersionQuery) Unique(unique bool) *KeyBackupVersionQuery {
+	kbvq.ctx.Unique = &unique
+	return kbvq
+}
+
+// Order specifies how the records should be ordered.
+func (kbvq *KeyBackupVersionQuery) Order(o ...keybackupversion.OrderOption) *KeyBackupVersionQuery {
+	kbvq.order = append(kbvq.order, o...)
+	return kbvq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (kbvq *KeyBackupVersionQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: kbvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := kbvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := kbvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keybackupversion.Table, keybackupversion.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, keybackupversion.OwnerTable, keybackupversion.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(kbvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryKeys chains the current query on the "keys" edge.
+func (kbvq *KeyBackupVersionQuery) QueryKeys() *KeyBackupKeyQuery {
+	query := (&KeyBackupKeyClient{config: kbvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := kbvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := kbvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keybackupversion.Table, keybackupversion.FieldID, selector),
+			sqlgraph.To(keybackupkey.Table, keybackupkey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, keybackupversion.KeysTable, keybackupversion.KeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(kbvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first KeyBackupVersion entity from the query.
+// Returns a *NotFoundError when no KeyBackupVersion was found.
+func (kbvq *KeyBackupVersionQuery) First(ctx context.Context) (*KeyBackupVersion, error) {
+	nodes, err := kbvq.Limit(1).All(setContextOp(ctx, kbvq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{keybackupversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (kbvq *KeyBackupVersionQuery) FirstX(ctx context.Context) *KeyBackupVersion {
+	node, err := kbvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KeyBackupVersion ID from the query.
+// Returns a *NotFoundError when no KeyBackupVersion ID was found.
+func (kbvq *KeyBackupVersionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kbvq.Limit(1).IDs(setContextOp(ctx, kbvq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{keybackupversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (kbvq *KeyBackupVersionQuery) FirstIDX(ctx context.Context) int {
+	id, err := kbvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KeyBackupVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KeyBackupVersion entity is found.
+// Returns a *NotFoundError when no KeyBackupVersion entities are found.
+func (kbvq *KeyBackupVersionQuery) Only(ctx context.Context) (*KeyBackupVersion, error) {
+	nodes, err := kbvq.Limit(2).All(setContextOp(ctx, kbvq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{keybackupversion.Label}
+	default:
+		return nil, &NotSingularError{keybackupversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (kbvq *KeyBackupVersionQuery) OnlyX(ctx context.Context) *KeyBackupVersion {
+	node, err := kbvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KeyBackupVersion ID in the query.
+// Returns a *NotSingularError when more than one KeyBackupVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (kbvq *KeyBackupVersionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kbvq.Limit(2).IDs(setContextOp(ctx, kbvq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{keybackupversion.Label}
+	default:
+		err = &NotSingularError{keybackupversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (kbvq *KeyBackupVersionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := kbvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KeyBackupVersions.
+func (kbvq *KeyBackupVersionQuery) All(ctx context.Context) ([]*KeyBackupVersion, error) {
+	ctx = setContextOp(ctx, kbvq.ctx, "All")
+	if err := kbvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KeyBackupVersion, *KeyBackupVersionQuery]()
+	return withInterceptors[[]*KeyBackupVersion](ctx, kbvq, qr, kbvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (kbvq *KeyBackupVersionQuery) AllX(ctx context.Context) []*KeyBackupVersion {
+	nodes, err := kbvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KeyBackupVersion IDs.
+func (kbvq *KeyBackupVersionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if kbvq.ctx.Unique == nil && kbvq.path != nil {
+		kbvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, kbvq.ctx, "IDs")
+	if err = kbvq.Select(keybackupversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (kbvq *KeyBackupVersionQuery) IDsX(ctx context.Context) []int {
+	ids, err := kbvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (kbvq *KeyBackupVersionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, kbvq.ctx, "Count")
+	if err := kbvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, kbvq, querierCount[*KeyBackupVersionQuery](), kbvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (kbvq *KeyBackupVersionQuery) CountX(ctx context.Context) int {
+	count, err := kbvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (kbvq *KeyBackupVersionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, kbvq.ctx, "Exist")
+	switch _, err := kbvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (kbvq *KeyBackupVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := kbvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KeyBackupVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (kbvq *KeyBackupVersionQuery) Clone() *KeyBackupVersionQuery {
+	if kbvq == nil {
+		return nil
+	}
+	return &KeyBackupVersionQuery{
+		config:     kbvq.config,
+		ctx:        kbvq.ctx.Clone(),
+		order:      append([]keybackupversion.OrderOption{}, kbvq.order...),
+		inters:     append([]Interceptor{}, kbvq.inters...),
+		predicates: append([]predicate.KeyBackupVersion{}, kbvq.predicates...),
+		withOwner:  kbvq.withOwner.Clone(),
+		withKeys:   kbvq.withKeys.Clone(),
+		// clone intermediate query.
+		sql:  kbvq.sql.Clone(),
+		path: kbvq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (kbvq *KeyBackupVersionQuery) WithOwner(opts ...func(*UserQuery)) *KeyBackupVersionQuery {
+	query := (&UserClient{config: kbvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	kbvq.withOwner = query
+	return kbvq
+}
+
+// WithKeys tells the query-builder to eager-load the nodes that are connected to
+// the "keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (kbvq *KeyBackupVersionQuery) WithKeys(opts ...func(*KeyBackupKeyQuery)) *KeyBackupVersionQuery {
+	query := (&KeyBackupKeyClient{config: kbvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	kbvq.withKeys = query
+	return kbvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Algorithm string `json:"algorithm,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KeyBackupVersion.Query().
+//		GroupBy(keybackupversion.FieldAlgorithm).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (kbvq *KeyBackupVersionQuery) GroupBy(field string, fields ...string) *KeyBackupVersionGroupBy {
+	kbvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KeyBackupVersionGroupBy{build: kbvq}
+	grbuild.flds = &kbvq.ctx.Fields
+	grbuild.label = keybackupversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Algorithm string `json:"algorithm,omitempty"`
+//	}
+//
+//	client.KeyBackupVersion.Query().
+//		Select(keybackupversion.FieldAlgorithm).
+//		Scan(ctx, &v)
+func (kbvq *KeyBackupVersionQuery) Select(fields ...string) *KeyBackupVersionSelect {
+	kbvq.ctx.Fields = append(kbvq.ctx.Fields, fields...)
+	sbuild := &KeyBackupVersionSelect{KeyBackupVersionQuery: kbvq}
+	sbuild.label = keybackupversion.Label
+	sbuild.flds, sbuild.scan = &kbvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KeyBackupVersionSelect configured with the given aggregations.
+func (kbvq *KeyBackupVersionQuery) Aggregate(fns ...AggregateFunc) *KeyBackupVersionSelect {
+	return kbvq.Select().Aggregate(fns...)
+}
+
+func (kbvq *KeyBackupVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range kbvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, kbvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range kbvq.ctx.Fields {
+		if !keybackupversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if kbvq.path != nil {
+		prev, err := kbvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		kbvq.sql = prev
+	}
+	if keybackupversion.Policy == nil {
+		return errors.New("ent: uninitialized keybackupversion.Policy (forgotten import ent/runtime?)")
+	}
+	if err := keybackupversion.Policy.EvalQuery(ctx, kbvq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (kbvq *KeyBackupVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KeyBackupVersion, error) {
+	var (
+		nodes       = []*KeyBackupVersion{}
+		withFKs     = kbvq.withFKs
+		_spec       = kbvq.querySpec()
+		loadedTypes = [2]bool{
+			kbvq.withOwner != nil,
+			kbvq.withKeys != nil,
+		}
+	)
+	if kbvq.withOwner != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, keybackupversion.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KeyBackupVersion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KeyBackupVersion{config: kbvq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, kbvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := kbvq.withOwner; query != nil {
+		if err := kbvq.loadOwner(ctx, query, nodes, nil,
+			func(n *KeyBackupVersion, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := kbvq.withKeys; query != nil {
+		if err := kbvq.loadKeys(ctx, query, nodes,
+			func(n *KeyBackupVersion) { n.Edges.Keys = []*KeyBackupKey{} },
+			func(n *KeyBackupVersion, e *KeyBackupKey) { n.Edges.Keys = append(n.Edges.Keys, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (kbvq *KeyBackupVersionQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*KeyBackupVersion, init func(*KeyBackupVersion), assign func(*KeyBackupVersion, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*KeyBackupVersion)
+	for i := range nodes {
+		if nodes[i].key_backup_version_owner == nil {
+			continue
+		}
+		fk := *nodes[i].key_backup_version_owner
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "key_backup_version_owner" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (kbvq *KeyBackupVersionQuery) loadKeys(ctx context.Context, query *KeyBackupKeyQuery, nodes []*KeyBackupVersion, init func(*KeyBackupVersion), assign func(*KeyBackupVersion, *KeyBackupKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*KeyBackupVersion)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.KeyBackupKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(keybackupversion.KeysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.key_backup_key_version
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "key_backup_key_version" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "key_backup_key_version" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (kbvq *KeyBackupVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := kbvq.querySpec()
+	_spec.Node.Columns = kbvq.ctx.Fields
+	if len(kbvq.ctx.Fields) > 0 {
+		_spec.Unique = kbvq.ctx.Unique != nil && *kbvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, kbvq.driver, _spec)
+}
+
+func (kbvq *KeyBackupVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(keybackupversion.Table, keybackupversion.Columns, sqlgraph.NewFieldSpec(keybackupversion.FieldID, field.TypeInt))
+	_spec.From = kbvq.sql
+	if unique := kbvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if kbvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := kbvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keybackupversion.FieldID)
+		for i := range fields {
+			if fields[i] != keybackupversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := kbvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := kbvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := kbvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := kbvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (kbvq *KeyBackupVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(kbvq.driver.Dialect())
+	t1 := builder.Table(keybackupversion.Table)
+	columns := kbvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = keybackupversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if kbvq.sql != nil {
+		selector = kbvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if kbvq.ctx.Unique != nil && *kbvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range kbvq.predicates {
+		p(selector)
+	}
+	for _, p := range kbvq.order {
+		p(selector)
+	}
+	if offset := kbvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := kbvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// KeyBackupVersionGroupBy is the group-by builder for KeyBackupVersion entities.
+type KeyBackupVersionGroupBy struct {
+	selector
+	build *KeyBackupVersionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (kbvgb *KeyBackupVersionGroupBy) Aggregate(fns ...AggregateFunc) *KeyBackupVersionGroupBy {
+	kbvgb.fns = append(kbvgb.fns, fns...)
+	return kbvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kbvgb *KeyBackupVersionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kbvgb.build.ctx, "GroupBy")
+	if err := kbvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyBackupVersionQuery, *KeyBackupVersionGroupBy](ctx, kbvgb.build, kbvgb, kbvgb.build.inters, v)
+}
+
+func (kbvgb *KeyBackupVersionGroupBy) sqlScan(ctx context.Context, root *KeyBackupVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(kbvgb.fns))
+	for _, fn := range kbvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*kbvgb.flds)+len(kbvgb.fns))
+		for _, f := range *kbvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*kbvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kbvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KeyBackupVersionSelect is the builder for selecting fields of KeyBackupVersion entities.
+type KeyBackupVersionSelect struct {
+	*KeyBackupVersionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (kbvs *KeyBackupVersionSelect) Aggregate(fns ...AggregateFunc) *KeyBackupVersionSelect {
+	kbvs.fns = append(kbvs.fns, fns...)
+	return kbvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kbvs *KeyBackupVersionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kbvs.ctx, "Select")
+	if err := kbvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyBackupVersionQuery, *KeyBackupVersionSelect](ctx, kbvs.KeyBackupVersionQuery, kbvs, kbvs.inters, v)
+}
+
+func (kbvs *KeyBackupVersionSelect) sqlScan(ctx context.Context, root *KeyBackupVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(kbvs.fns))
+	for _, fn := range kbvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*kbvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kbvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyBackupVersionUpdate is the builder for updating KeyBackupVersion entities.
+type KeyBackupVersionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KeyBackupVersionMutation
+}
+
+// Where appends a list predicates to the KeyBackupVersionUpdate builder.
+func (kbvu *KeyBackupVersionUpdate) Where(ps ...predicate.KeyBackupVersion) *KeyBackupVersionUpdate {
+	kbvu.mutation.Where(ps...)
+	return kbvu
+}
+
+// SetAuthData sets the "auth_data" field.
+func (kbvu *KeyBackupVersionUpdate) SetAuthData(s string) *KeyBackupVersionUpdate {
+	kbvu.mutation.SetAuthData(s)
+	return kbvu
+}
+
+// SetNillableAuthData sets the "auth_data" field if the given value is not nil.
+func (kbvu *KeyBackupVersionUpdate) SetNillableAuthData(s *string) *KeyBackupVersionUpdate {
+	if s != nil {
+		kbvu.SetAuthData(*s)
+	}
+	return kbvu
+}
+
+// SetEtag sets the "etag" field.
+func (kbvu *KeyBackupVersionUpdate) SetEtag(i int) *KeyBackupVersionUpdate {
+	kbvu.mutation.ResetEtag()
+	kbvu.mutation.SetEtag(i)
+	return kbvu
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (kbvu *KeyBackupVersionUpdate) SetNillableEtag(i *int) *KeyBackupVersionUpdate {
+	if i != nil {
+		kbvu.SetEtag(*i)
+	}
+	return kbvu
+}
+
+// AddEtag adds i to the "etag" field.
+func (kbvu *KeyBackupVersionUpdate) AddEtag(i int) *KeyBackupVersionUpdate {
+	kbvu.mutation.AddEtag(i)
+	return kbvu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (kbvu *KeyBackupVersionUpdate) SetUpdatedAt(t time.Time) *KeyBackupVersionUpdate {
+	kbvu.mutation.SetUpdatedAt(t)
+	return kbvu
+}
+
+// AddKeyIDs adds the "keys" edge to the KeyBackupKey entity by IDs.
+func (kbvu *KeyBackupVersionUpdate) AddKeyIDs(ids ...int) *KeyBackupVersionUpdate {
+	kbvu.mutation.AddKeyIDs(ids...)
+	return kbvu
+}
+
+// AddKeys adds the "keys" edges to the KeyBackupKey entity.
+func (kbvu *KeyBackupVersionUpdate) AddKeys(k ...*KeyBackupKey) *KeyBackupVersionUpdate {
+	ids := make([]int, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return kbvu.AddKeyIDs(ids...)
+}
+
+// Mutation returns the KeyBackupVersionMutation object of the builder.
+func (kbvu *KeyBackupVersionUpdate) Mutation() *KeyBackupVersionMutation {
+	return kbvu.mutation
+}
+
+// ClearKeys clears all "keys" edges to the KeyBackupKey entity.
+func (kbvu *KeyBackupVersionUpdate) ClearKeys() *KeyBackupVersionUpdate {
+	kbvu.mutation.ClearKeys()
+	return kbvu
+}
+
+// RemoveKeyIDs removes the "keys" edge to KeyBackupKey entities by IDs.
+func (kbvu *KeyBackupVersionUpdate) RemoveKeyIDs(ids ...int) *KeyBackupVersionUpdate {
+	kbvu.mutation.RemoveKeyIDs(ids...)
+	return kbvu
+}
+
+// RemoveKeys removes "keys" edges to KeyBackupKey entities.
+func (kbvu *KeyBackupVersionUpdate) RemoveKeys(k ...*KeyBackupKey) *KeyBackupVersionUpdate {
+	ids := make([]int, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return kbvu.RemoveKeyIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (kbvu *KeyBackupVersionUpdate) Save(ctx context.Context) (int, error) {
+	if err := kbvu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, kbvu.sqlSave, kbvu.mutation, kbvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kbvu *KeyBackupVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := kbvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (kbvu *KeyBackupVersionUpdate) Exec(ctx context.Context) error {
+	_, err := kbvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbvu *KeyBackupVersionUpdate) ExecX(ctx context.Context) {
+	if err := kbvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kbvu *KeyBackupVersionUpdate) defaults() error {
+	if _, ok := kbvu.mutation.UpdatedAt(); !ok {
+		if keybackupversion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized keybackupversion.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := keybackupversion.UpdateDefaultUpdatedAt()
+		kbvu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kbvu *KeyBackupVersionUpdate) check() error {
+	if v, ok := kbvu.mutation.AuthData(); ok {
+		if err := keybackupversion.AuthDataValidator(v); err != nil {
+			return &ValidationError{Name: "auth_data", err: fmt.Errorf(`ent: validator failed for field "KeyBackupVersion.auth_data": %w`, err)}
+		}
+	}
+	if v, ok := kbvu.mutation.Etag(); ok {
+		if err := keybackupversion.EtagValidator(v); err != nil {
+			return &ValidationError{Name: "etag", err: fmt.Errorf(`ent: validator failed for field "KeyBackupVersion.etag": %w`, err)}
+		}
+	}
+	if _, ok := kbvu.mutation.OwnerID(); kbvu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "KeyBackupVersion.owner"`)
+	}
+	return nil
+}
+
+func (kbvu *KeyBackupVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := kbvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keybackupversion.Table, keybackupversion.Columns, sqlgraph.NewFieldSpec(keybackupversion.FieldID, field.TypeInt))
+	if ps := kbvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kbvu.mutation.AuthData(); ok {
+		_spec.SetField(keybackupversion.FieldAuthData, field.TypeString, value)
+	}
+	if value, ok := kbvu.mutation.Etag(); ok {
+		_spec.SetField(keybackupversion.FieldEtag, field.TypeInt, value)
+	}
+	if value, ok := kbvu.mutation.AddedEtag(); ok {
+		_spec.AddField(keybackupversion.FieldEtag, field.TypeInt, value)
+	}
+	if value, ok := kbvu.mutation.UpdatedAt(); ok {
+		_spec.SetField(keybackupversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if kbvu.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   keybackupversion.KeysTable,
+			Columns: []string{keybackupversion.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kbvu.mutation.RemovedKeysIDs(); len(nodes) > 0 && !kbvu.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   keybackupversion.KeysTable,
+			Columns: []string{keybackupversion.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kbvu.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   keybackupversion.KeysTable,
+			Columns: []string{keybackupversion.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, kbvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keybackupversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	kbvu.mutation.done = true
+	return n, nil
+}
+
+// KeyBackupVersionUpdateOne is the builder for updating a single KeyBackupVersion entity.
+type KeyBackupVersionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KeyBackupVersionMutation
+}
+
+// SetAuthData sets the "auth_data" field.
+func (kbvuo *KeyBackupVersionUpdateOne) SetAuthData(s string) *KeyBackupVersionUpdateOne {
+	kbvuo.mutation.SetAuthData(s)
+	return kbvuo
+}
+
+// SetNillableAuthData sets the "auth_data" field if the given value is not nil.
+func (kbvuo *KeyBackupVersionUpdateOne) SetNillableAuthData(s *string) *KeyBackupVersionUpdateOne {
+	if s != nil {
+		kbvuo.SetAuthData(*s)
+	}
+	return kbvuo
+}
+
+// SetEtag sets the "etag" field.
+func (kbvuo *KeyBackupVersionUpdateOne) SetEtag(i int) *KeyBackupVersionUpdateOne {
+	kbvuo.mutation.ResetEtag()
+	kbvuo.mutation.SetEtag(i)
+	return kbvuo
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (kbvuo *KeyBackupVersionUpdateOne) SetNillableEtag(i *int) *KeyBackupVersionUpdateOne {
+	if i != nil {
+		kbvuo.SetEtag(*i)
+	}
+	return kbvuo
+}
+
+// AddEtag adds i to the "etag" field.
+func (kbvuo *KeyBackupVersionUpdateOne) AddEtag(i int) *KeyBackupVersionUpdateOne {
+	kbvuo.mutation.AddEtag(i)
+	return kbvuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (kbvuo *KeyBackupVersionUpdateOne) SetUpdatedAt(t time.Time) *KeyBackupVersionUpdateOne {
+	kbvuo.mutation.SetUpdatedAt(t)
+	return kbvuo
+}
+
+// AddKeyIDs adds the "keys" edge to the KeyBackupKey entity by IDs.
+func (kbvuo *KeyBackupVersionUpdateOne) AddKeyIDs(ids ...int) *KeyBackupVersionUpdateOne {
+	kbvuo.mutation.AddKeyIDs(ids...)
+	return kbvuo
+}
+
+// AddKeys adds the "keys" edges to the KeyBackupKey entity.
+func (kbvuo *KeyBackupVersionUpdateOne) AddKeys(k ...*KeyBackupKey) *KeyBackupVersionUpdateOne {
+	ids := make([]int, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return kbvuo.AddKeyIDs(ids...)
+}
+
+// Mutation returns the KeyBackupVersionMutation object of the builder.
+func (kbvuo *KeyBackupVersionUpdateOne) Mutation() *KeyBackupVersionMutation {
+	return kbvuo.mutation
+}
+
+// ClearKeys clears all "keys" edges to the KeyBackupKey entity.
+func (kbvuo *KeyBackupVersionUpdateOne) ClearKeys() *KeyBackupVersionUpdateOne {
+	kbvuo.mutation.ClearKeys()
+	return kbvuo
+}
+
+// RemoveKeyIDs removes the "keys" edge to KeyBackupKey entities by IDs.
+func (kbvuo *KeyBackupVersionUpdateOne) RemoveKeyIDs(ids ...int) *KeyBackupVersionUpdateOne {
+	kbvuo.mutation.RemoveKeyIDs(ids...)
+	return kbvuo
+}
+
+// RemoveKeys removes "keys" edges to KeyBackupKey entities.
+func (kbvuo *KeyBackupVersionUpdateOne) RemoveKeys(k ...*KeyBackupKey) *KeyBackupVersionUpdateOne {
+	ids := make([]int, len(k))
+	for i := range k {
+		ids[i] = k[i].ID
+	}
+	return kbvuo.RemoveKeyIDs(ids...)
+}
+
+// Where appends a list predicates to the KeyBackupVersionUpdate builder.
+func (kbvuo *KeyBackupVersionUpdateOne) Where(ps ...predicate.KeyBackupVersion) *KeyBackupVersionUpdateOne {
+	kbvuo.mutation.Where(ps...)
+	return kbvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (kbvuo *KeyBackupVersionUpdateOne) Select(field string, fields ...string) *KeyBackupVersionUpdateOne {
+	kbvuo.fields = append([]string{field}, fields...)
+	return kbvuo
+}
+
+// Save executes the query and returns the updated KeyBackupVersion entity.
+func (kbvuo *KeyBackupVersionUpdateOne) Save(ctx context.Context) (*KeyBackupVersion, error) {
+	if err := kbvuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, kbvuo.sqlSave, kbvuo.mutation, kbvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kbvuo *KeyBackupVersionUpdateOne) SaveX(ctx context.Context) *KeyBackupVersion {
+	node, err := kbvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (kbvuo *KeyBackupVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := kbvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kbvuo *KeyBackupVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := kbvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kbvuo *KeyBackupVersionUpdateOne) defaults() error {
+	if _, ok := kbvuo.mutation.UpdatedAt(); !ok {
+		if keybackupversion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized keybackupversion.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := keybackupversion.UpdateDefaultUpdatedAt()
+		kbvuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kbvuo *KeyBackupVersionUpdateOne) check() error {
+	if v, ok := kbvuo.mutation.AuthData(); ok {
+		if err := keybackupversion.AuthDataValidator(v); err != nil {
+			return &ValidationError{Name: "auth_data", err: fmt.Errorf(`ent: validator failed for field "KeyBackupVersion.auth_data": %w`, err)}
+		}
+	}
+	if v, ok := kbvuo.mutation.Etag(); ok {
+		if err := keybackupversion.EtagValidator(v); err != nil {
+			return &ValidationError{Name: "etag", err: fmt.Errorf(`ent: validator failed for field "KeyBackupVersion.etag": %w`, err)}
+		}
+	}
+	if _, ok := kbvuo.mutation.OwnerID(); kbvuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "KeyBackupVersion.owner"`)
+	}
+	return nil
+}
+
+func (kbvuo *KeyBackupVersionUpdateOne) sqlSave(ctx context.Context) (_node *KeyBackupVersion, err error) {
+	if err := kbvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keybackupversion.Table, keybackupversion.Columns, sqlgraph.NewFieldSpec(keybackupversion.FieldID, field.TypeInt))
+	id, ok := kbvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KeyBackupVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := kbvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keybackupversion.FieldID)
+		for _, f := range fields {
+			if !keybackupversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != keybackupversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := kbvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kbvuo.mutation.AuthData(); ok {
+		_spec.SetField(keybackupversion.FieldAuthData, field.TypeString, value)
+	}
+	if value, ok := kbvuo.mutation.Etag(); ok {
+		_spec.SetField(keybackupversion.FieldEtag, field.TypeInt, value)
+	}
+	if value, ok := kbvuo.mutation.AddedEtag(); ok {
+		_spec.AddField(keybackupversion.FieldEtag, field.TypeInt, value)
+	}
+	if value, ok := kbvuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(keybackupversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if kbvuo.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   keybackupversion.KeysTable,
+			Columns: []string{keybackupversion.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kbvuo.mutation.RemovedKeysIDs(); len(nodes) > 0 && !kbvuo.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   keybackupversion.KeysTable,
+			Columns: []string{keybackupversion.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kbvuo.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   keybackupversion.KeysTable,
+			Columns: []string{keybackupversion.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(keybackupkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &KeyBackupVersion{config: kbvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, kbvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keybackupversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	kbvuo.mutation.done = true
+	return _node, nil
+}
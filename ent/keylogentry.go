@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/keylogentry"
+)
+
+// KeyLogEntry is the model entity for the KeyLogEntry schema.
+type KeyLogEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// LeafIndex holds the value of the "leaf_index" field.
+	LeafIndex int `json:"leaf_index,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// DeviceID holds the value of the "device_id" field.
+	DeviceID int `json:"device_id,omitempty"`
+	// IdentityKey holds the value of the "identity_key" field.
+	IdentityKey string `json:"identity_key,omitempty"`
+	// PublishedAt holds the value of the "published_at" field.
+	PublishedAt time.Time `json:"published_at,omitempty"`
+	// LeafHash holds the value of the "leaf_hash" field.
+	LeafHash     []byte `json:"leaf_hash,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KeyLogEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case keylogentry.FieldLeafHash:
+			values[i] = new([]byte)
+		case keylogentry.FieldID, keylogentry.FieldLeafIndex, keylogentry.FieldUserID, keylogentry.FieldDeviceID:
+			values[i] = new(sql.NullInt64)
+		case keylogentry.FieldIdentityKey:
+			values[i] = new(sql.NullString)
+		case keylogentry.FieldPublishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KeyLogEntry fields.
+func (kle *KeyLogEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case keylogentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			kle.ID = int(value.Int64)
+		case keylogentry.FieldLeafIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field leaf_index", values[i])
+			} else if value.Valid {
+				kle.LeafIndex = int(value.Int64)
+			}
+		case keylogentry.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				kle.UserID = int(value.Int64)
+			}
+		case keylogentry.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				kle.DeviceID = int(value.Int64)
+			}
+		case keylogentry.FieldIdentityKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field identity_key", values[i])
+			} else if value.Valid {
+				kle.IdentityKey = value.String
+			}
+		case keylogentry.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				kle.PublishedAt = value.Time
+			}
+		case keylogentry.FieldLeafHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field leaf_hash", values[i])
+			} else if value != nil {
+				kle.LeafHash = *value
+			}
+		default:
+			kle.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KeyLogEntry.
+// This includes values selected through modifiers, order, etc.
+func (kle *KeyLogEntry) Value(name string) (ent.Value, error) {
+	return kle.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this KeyLogEntry.
+// Note that you need to call KeyLogEntry.Unwrap() before calling this method if this KeyLogEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (kle *KeyLogEntry) Update() *KeyLogEntryUpdateOne {
+	return NewKeyLogEntryClient(kle.config).UpdateOne(kle)
+}
+
+// Unwrap unwraps the KeyLogEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (kle *KeyLogEntry) Unwrap() *KeyLogEntry {
+	_tx, ok := kle.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KeyLogEntry is not a transactional entity")
+	}
+	kle.config.driver = _tx.drv
+	return kle
+}
+
+// String implements the fmt.Stringer.
+func (kle *KeyLogEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("KeyLogEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", kle.ID))
+	builder.WriteString("leaf_index=")
+	builder.WriteString(fmt.Sprintf("%v", kle.LeafIndex))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", kle.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(fmt.Sprintf("%v", kle.DeviceID))
+	builder.WriteString(", ")
+	builder.WriteString("identity_key=")
+	builder.WriteString(kle.IdentityKey)
+	builder.WriteString(", ")
+	builder.WriteString("published_at=")
+	builder.WriteString(kle.PublishedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("leaf_hash=")
+	builder.WriteString(fmt.Sprintf("%v", kle.LeafHash))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KeyLogEntries is a parsable slice of KeyLogEntry.
+type KeyLogEntries []*KeyLogEntry
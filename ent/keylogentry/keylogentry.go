@@ -0,0 +1,89 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keylogentry
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the keylogentry type in the database.
+	Label = "key_log_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLeafIndex holds the string denoting the leaf_index field in the database.
+	FieldLeafIndex = "leaf_index"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldDeviceID holds the string denoting the device_id field in the database.
+	FieldDeviceID = "device_id"
+	// FieldIdentityKey holds the string denoting the identity_key field in the database.
+	FieldIdentityKey = "identity_key"
+	// FieldPublishedAt holds the string denoting the published_at field in the database.
+	FieldPublishedAt = "published_at"
+	// FieldLeafHash holds the string denoting the leaf_hash field in the database.
+	FieldLeafHash = "leaf_hash"
+	// Table holds the table name of the keylogentry in the database.
+	Table = "key_log_entries"
+)
+
+// Columns holds all SQL columns for keylogentry fields.
+var Columns = []string{
+	FieldID,
+	FieldLeafIndex,
+	FieldUserID,
+	FieldDeviceID,
+	FieldIdentityKey,
+	FieldPublishedAt,
+	FieldLeafHash,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// LeafIndexValidator is a validator for the "leaf_index" field. It is called by the builders before save.
+	LeafIndexValidator func(int) error
+	// IdentityKeyValidator is a validator for the "identity_key" field. It is called by the builders before save.
+	IdentityKeyValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the KeyLogEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLeafIndex orders the results by the leaf_index field.
+func ByLeafIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeafIndex, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByDeviceID orders the results by the device_id field.
+func ByDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceID, opts...).ToFunc()
+}
+
+// ByIdentityKey orders the results by the identity_key field.
+func ByIdentityKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdentityKey, opts...).ToFunc()
+}
+
+// ByPublishedAt orders the results by the published_at field.
+func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
+}
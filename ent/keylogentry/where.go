@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keylogentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLTE(FieldID, id))
+}
+
+// LeafIndex applies equality check predicate on the "leaf_index" field. It's identical to LeafIndexEQ.
+func LeafIndex(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldLeafIndex, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldUserID, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// IdentityKey applies equality check predicate on the "identity_key" field. It's identical to IdentityKeyEQ.
+func IdentityKey(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldIdentityKey, v))
+}
+
+// PublishedAt applies equality check predicate on the "published_at" field. It's identical to PublishedAtEQ.
+func PublishedAt(v time.Time) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// LeafHash applies equality check predicate on the "leaf_hash" field. It's identical to LeafHashEQ.
+func LeafHash(v []byte) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldLeafHash, v))
+}
+
+// LeafIndexEQ applies the EQ predicate on the "leaf_index" field.
+func LeafIndexEQ(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldLeafIndex, v))
+}
+
+// LeafIndexNEQ applies the NEQ predicate on the "leaf_index" field.
+func LeafIndexNEQ(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNEQ(FieldLeafIndex, v))
+}
+
+// LeafIndexIn applies the In predicate on the "leaf_index" field.
+func LeafIndexIn(vs ...int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldIn(FieldLeafIndex, vs...))
+}
+
+// LeafIndexNotIn applies the NotIn predicate on the "leaf_index" field.
+func LeafIndexNotIn(vs ...int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNotIn(FieldLeafIndex, vs...))
+}
+
+// LeafIndexGT applies the GT predicate on the "leaf_index" field.
+func LeafIndexGT(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGT(FieldLeafIndex, v))
+}
+
+// LeafIndexGTE applies the GTE predicate on the "leaf_index" field.
+func LeafIndexGTE(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGTE(FieldLeafIndex, v))
+}
+
+// LeafIndexLT applies the LT predicate on the "leaf_index" field.
+func LeafIndexLT(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLT(FieldLeafIndex, v))
+}
+
+// LeafIndexLTE applies the LTE predicate on the "leaf_index" field.
+func LeafIndexLTE(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLTE(FieldLeafIndex, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLTE(FieldUserID, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v int) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// IdentityKeyEQ applies the EQ predicate on the "identity_key" field.
+func IdentityKeyEQ(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldIdentityKey, v))
+}
+
+// IdentityKeyNEQ applies the NEQ predicate on the "identity_key" field.
+func IdentityKeyNEQ(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNEQ(FieldIdentityKey, v))
+}
+
+// IdentityKeyIn applies the In predicate on the "identity_key" field.
+func IdentityKeyIn(vs ...string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldIn(FieldIdentityKey, vs...))
+}
+
+// IdentityKeyNotIn applies the NotIn predicate on the "identity_key" field.
+func IdentityKeyNotIn(vs ...string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNotIn(FieldIdentityKey, vs...))
+}
+
+// IdentityKeyGT applies the GT predicate on the "identity_key" field.
+func IdentityKeyGT(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGT(FieldIdentityKey, v))
+}
+
+// IdentityKeyGTE applies the GTE predicate on the "identity_key" field.
+func IdentityKeyGTE(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGTE(FieldIdentityKey, v))
+}
+
+// IdentityKeyLT applies the LT predicate on the "identity_key" field.
+func IdentityKeyLT(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLT(FieldIdentityKey, v))
+}
+
+// IdentityKeyLTE applies the LTE predicate on the "identity_key" field.
+func IdentityKeyLTE(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLTE(FieldIdentityKey, v))
+}
+
+// IdentityKeyContains applies the Contains predicate on the "identity_key" field.
+func IdentityKeyContains(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldContains(FieldIdentityKey, v))
+}
+
+// IdentityKeyHasPrefix applies the HasPrefix predicate on the "identity_key" field.
+func IdentityKeyHasPrefix(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldHasPrefix(FieldIdentityKey, v))
+}
+
+// IdentityKeyHasSuffix applies the HasSuffix predicate on the "identity_key" field.
+func IdentityKeyHasSuffix(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldHasSuffix(FieldIdentityKey, v))
+}
+
+// IdentityKeyEqualFold applies the EqualFold predicate on the "identity_key" field.
+func IdentityKeyEqualFold(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEqualFold(FieldIdentityKey, v))
+}
+
+// IdentityKeyContainsFold applies the ContainsFold predicate on the "identity_key" field.
+func IdentityKeyContainsFold(v string) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldContainsFold(FieldIdentityKey, v))
+}
+
+// PublishedAtEQ applies the EQ predicate on the "published_at" field.
+func PublishedAtEQ(v time.Time) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtNEQ applies the NEQ predicate on the "published_at" field.
+func PublishedAtNEQ(v time.Time) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtIn applies the In predicate on the "published_at" field.
+func PublishedAtIn(vs ...time.Time) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtNotIn applies the NotIn predicate on the "published_at" field.
+func PublishedAtNotIn(vs ...time.Time) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNotIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtGT applies the GT predicate on the "published_at" field.
+func PublishedAtGT(v time.Time) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGT(FieldPublishedAt, v))
+}
+
+// PublishedAtGTE applies the GTE predicate on the "published_at" field.
+func PublishedAtGTE(v time.Time) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGTE(FieldPublishedAt, v))
+}
+
+// PublishedAtLT applies the LT predicate on the "published_at" field.
+func PublishedAtLT(v time.Time) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLT(FieldPublishedAt, v))
+}
+
+// PublishedAtLTE applies the LTE predicate on the "published_at" field.
+func PublishedAtLTE(v time.Time) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLTE(FieldPublishedAt, v))
+}
+
+// LeafHashEQ applies the EQ predicate on the "leaf_hash" field.
+func LeafHashEQ(v []byte) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldEQ(FieldLeafHash, v))
+}
+
+// LeafHashNEQ applies the NEQ predicate on the "leaf_hash" field.
+func LeafHashNEQ(v []byte) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNEQ(FieldLeafHash, v))
+}
+
+// LeafHashIn applies the In predicate on the "leaf_hash" field.
+func LeafHashIn(vs ...[]byte) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldIn(FieldLeafHash, vs...))
+}
+
+// LeafHashNotIn applies the NotIn predicate on the "leaf_hash" field.
+func LeafHashNotIn(vs ...[]byte) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldNotIn(FieldLeafHash, vs...))
+}
+
+// LeafHashGT applies the GT predicate on the "leaf_hash" field.
+func LeafHashGT(v []byte) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGT(FieldLeafHash, v))
+}
+
+// LeafHashGTE applies the GTE predicate on the "leaf_hash" field.
+func LeafHashGTE(v []byte) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldGTE(FieldLeafHash, v))
+}
+
+// LeafHashLT applies the LT predicate on the "leaf_hash" field.
+func LeafHashLT(v []byte) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLT(FieldLeafHash, v))
+}
+
+// LeafHashLTE applies the LTE predicate on the "leaf_hash" field.
+func LeafHashLTE(v []byte) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.FieldLTE(FieldLeafHash, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KeyLogEntry) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KeyLogEntry) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KeyLogEntry) predicate.KeyLogEntry {
+	return predicate.KeyLogEntry(sql.NotPredicates(p))
+}
@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keylogentry"
+)
+
+// KeyLogEntryCreate is the builder for creating a KeyLogEntry entity.
+type KeyLogEntryCreate struct {
+	config
+	mutation *KeyLogEntryMutation
+	hooks    []Hook
+}
+
+// SetLeafIndex sets the "leaf_index" field.
+func (klec *KeyLogEntryCreate) SetLeafIndex(i int) *KeyLogEntryCreate {
+	klec.mutation.SetLeafIndex(i)
+	return klec
+}
+
+// SetUserID sets the "user_id" field.
+func (klec *KeyLogEntryCreate) SetUserID(i int) *KeyLogEntryCreate {
+	klec.mutation.SetUserID(i)
+	return klec
+}
+
+// SetDeviceID sets the "device_id" field.
+func (klec *KeyLogEntryCreate) SetDeviceID(i int) *KeyLogEntryCreate {
+	klec.mutation.SetDeviceID(i)
+	return klec
+}
+
+// SetIdentityKey sets the "identity_key" field.
+func (klec *KeyLogEntryCreate) SetIdentityKey(s string) *KeyLogEntryCreate {
+	klec.mutation.SetIdentityKey(s)
+	return klec
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (klec *KeyLogEntryCreate) SetPublishedAt(t time.Time) *KeyLogEntryCreate {
+	klec.mutation.SetPublishedAt(t)
+	return klec
+}
+
+// SetLeafHash sets the "leaf_hash" field.
+func (klec *KeyLogEntryCreate) SetLeafHash(b []byte) *KeyLogEntryCreate {
+	klec.mutation.SetLeafHash(b)
+	return klec
+}
+
+// Mutation returns the KeyLogEntryMutation object of the builder.
+func (klec *KeyLogEntryCreate) Mutation() *KeyLogEntryMutation {
+	return klec.mutation
+}
+
+// Save creates the KeyLogEntry in the database.
+func (klec *KeyLogEntryCreate) Save(ctx context.Context) (*KeyLogEntry, error) {
+	return withHooks(ctx, klec.sqlSave, klec.mutation, klec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (klec *KeyLogEntryCreate) SaveX(ctx context.Context) *KeyLogEntry {
+	v, err := klec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (klec *KeyLogEntryCreate) Exec(ctx context.Context) error {
+	_, err := klec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klec *KeyLogEntryCreate) ExecX(ctx context.Context) {
+	if err := klec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (klec *KeyLogEntryCreate) check() error {
+	if _, ok := klec.mutation.LeafIndex(); !ok {
+		return &ValidationError{Name: "leaf_index", err: errors.New(`ent: missing required field "KeyLogEntry.leaf_index"`)}
+	}
+	if v, ok := klec.mutation.LeafIndex(); ok {
+		if err := keylogentry.LeafIndexValidator(v); err != nil {
+			return &ValidationError{Name: "leaf_index", err: fmt.Errorf(`ent: validator failed for field "KeyLogEntry.leaf_index": %w`, err)}
+		}
+	}
+	if _, ok := klec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "KeyLogEntry.user_id"`)}
+	}
+	if _, ok := klec.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "KeyLogEntry.device_id"`)}
+	}
+	if _, ok := klec.mutation.IdentityKey(); !ok {
+		return &ValidationError{Name: "identity_key", err: errors.New(`ent: missing required field "KeyLogEntry.identity_key"`)}
+	}
+	if v, ok := klec.mutation.IdentityKey(); ok {
+		if err := keylogentry.IdentityKeyValidator(v); err != nil {
+			return &ValidationError{Name: "identity_key", err: fmt.Errorf(`ent: validator failed for field "KeyLogEntry.identity_key": %w`, err)}
+		}
+	}
+	if _, ok := klec.mutation.PublishedAt(); !ok {
+		return &ValidationError{Name: "published_at", err: errors.New(`ent: missing required field "KeyLogEntry.published_at"`)}
+	}
+	if _, ok := klec.mutation.LeafHash(); !ok {
+		return &ValidationError{Name: "leaf_hash", err: errors.New(`ent: missing required field "KeyLogEntry.leaf_hash"`)}
+	}
+	return nil
+}
+
+func (klec *KeyLogEntryCreate) sqlSave(ctx context.Context) (*KeyLogEntry, error) {
+	if err := klec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := klec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, klec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	klec.mutation.id = &_node.ID
+	klec.mutation.done = true
+	return _node, nil
+}
+
+func (klec *KeyLogEntryCreate) createSpec() (*KeyLogEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KeyLogEntry{config: klec.config}
+		_spec = sqlgraph.NewCreateSpec(keylogentry.Table, sqlgraph.NewFieldSpec(keylogentry.FieldID, field.TypeInt))
+	)
+	if value, ok := klec.mutation.LeafIndex(); ok {
+		_spec.SetField(keylogentry.FieldLeafIndex, field.TypeInt, value)
+		_node.LeafIndex = value
+	}
+	if value, ok := klec.mutation.UserID(); ok {
+		_spec.SetField(keylogentry.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := klec.mutation.DeviceID(); ok {
+		_spec.SetField(keylogentry.FieldDeviceID, field.TypeInt, value)
+		_node.DeviceID = value
+	}
+	if value, ok := klec.mutation.IdentityKey(); ok {
+		_spec.SetField(keylogentry.FieldIdentityKey, field.TypeString, value)
+		_node.IdentityKey = value
+	}
+	if value, ok := klec.mutation.PublishedAt(); ok {
+		_spec.SetField(keylogentry.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = value
+	}
+	if value, ok := klec.mutation.LeafHash(); ok {
+		_spec.SetField(keylogentry.FieldLeafHash, field.TypeBytes, value)
+		_node.LeafHash = value
+	}
+	return _node, _spec
+}
+
+// KeyLogEntryCreateBulk is the builder for creating many KeyLogEntry entities in bulk.
+type KeyLogEntryCreateBulk struct {
+	config
+	err      error
+	builders []*KeyLogEntryCreate
+}
+
+// Save creates the KeyLogEntry entities in the database.
+func (klecb *KeyLogEntryCreateBulk) Save(ctx context.Context) ([]*KeyLogEntry, error) {
+	if klecb.err != nil {
+		return nil, klecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(klecb.builders))
+	nodes := make([]*KeyLogEntry, len(klecb.builders))
+	mutators := make([]Mutator, len(klecb.builders))
+	for i := range klecb.builders {
+		func(i int, root context.Context) {
+			builder := klecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KeyLogEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, klecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, klecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, klecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (klecb *KeyLogEntryCreateBulk) SaveX(ctx context.Context) []*KeyLogEntry {
+	v, err := klecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (klecb *KeyLogEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := klecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klecb *KeyLogEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := klecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keylogentry"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyLogEntryDelete is the builder for deleting a KeyLogEntry entity.
+type KeyLogEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *KeyLogEntryMutation
+}
+
+// Where appends a list predicates to the KeyLogEntryDelete builder.
+func (kled *KeyLogEntryDelete) Where(ps ...predicate.KeyLogEntry) *KeyLogEntryDelete {
+	kled.mutation.Where(ps...)
+	return kled
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (kled *KeyLogEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, kled.sqlExec, kled.mutation, kled.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kled *KeyLogEntryDelete) ExecX(ctx context.Context) int {
+	n, err := kled.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (kled *KeyLogEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(keylogentry.Table, sqlgraph.NewFieldSpec(keylogentry.FieldID, field.TypeInt))
+	if ps := kled.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, kled.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	kled.mutation.done = true
+	return affected, err
+}
+
+// KeyLogEntryDeleteOne is the builder for deleting a single KeyLogEntry entity.
+type KeyLogEntryDeleteOne struct {
+	kled *KeyLogEntryDelete
+}
+
+// Where appends a list predicates to the KeyLogEntryDelete builder.
+func (kledo *KeyLogEntryDeleteOne) Where(ps ...predicate.KeyLogEntry) *KeyLogEntryDeleteOne {
+	kledo.kled.mutation.Where(ps...)
+	return kledo
+}
+
+// Exec executes the deletion query.
+func (kledo *KeyLogEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := kledo.kled.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{keylogentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kledo *KeyLogEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := kledo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
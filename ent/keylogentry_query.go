@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keylogentry"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyLogEntryQuery is the builder for querying KeyLogEntry entities.
+type KeyLogEntryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []keylogentry.OrderOption
+	inters     []Interceptor
+	predicates []predicate.KeyLogEntry
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KeyLogEntryQuery builder.
+func (kleq *KeyLogEntryQuery) Where(ps ...predicate.KeyLogEntry) *KeyLogEntryQuery {
+	kleq.predicates = append(kleq.predicates, ps...)
+	return kleq
+}
+
+// Limit the number of records to be returned by this query.
+func (kleq *KeyLogEntryQuery) Limit(limit int) *KeyLogEntryQuery {
+	kleq.ctx.Limit = &limit
+	return kleq
+}
+
+// Offset to start from.
+func (kleq *KeyLogEntryQuery) Offset(offset int) *KeyLogEntryQuery {
+	kleq.ctx.Offset = &offset
+	return kleq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (kleq *KeyLogEntryQuery) Unique(unique bool) *KeyLogEntryQuery {
+	kleq.ctx.Unique = &unique
+	return kleq
+}
+
+// Order specifies how the records should be ordered.
+func (kleq *KeyLogEntryQuery) Order(o ...keylogentry.OrderOption) *KeyLogEntryQuery {
+	kleq.order = append(kleq.order, o...)
+	return kleq
+}
+
+// First returns the first KeyLogEntry entity from the query.
+// Returns a *NotFoundError when no KeyLogEntry was found.
+func (kleq *KeyLogEntryQuery) First(ctx context.Context) (*KeyLogEntry, error) {
+	nodes, err := kleq.Limit(1).All(setContextOp(ctx, kleq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{keylogentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (kleq *KeyLogEntryQuery) FirstX(ctx context.Context) *KeyLogEntry {
+	node, err := kleq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KeyLogEntry ID from the query.
+// Returns a *NotFoundError when no KeyLogEntry ID was found.
+func (kleq *KeyLogEntryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kleq.Limit(1).IDs(setContextOp(ctx, kleq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{keylogentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (kleq *KeyLogEntryQuery) FirstIDX(ctx context.Context) int {
+	id, err := kleq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KeyLogEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KeyLogEntry entity is found.
+// Returns a *NotFoundError when no KeyLogEntry entities are found.
+func (kleq *KeyLogEntryQuery) Only(ctx context.Context) (*KeyLogEntry, error) {
+	nodes, err := kleq.Limit(2).All(setContextOp(ctx, kleq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{keylogentry.Label}
+	default:
+		return nil, &NotSingularError{keylogentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (kleq *KeyLogEntryQuery) OnlyX(ctx context.Context) *KeyLogEntry {
+	node, err := kleq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KeyLogEntry ID in the query.
+// Returns a *NotSingularError when more than one KeyLogEntry ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (kleq *KeyLogEntryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kleq.Limit(2).IDs(setContextOp(ctx, kleq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{keylogentry.Label}
+	default:
+		err = &NotSingularError{keylogentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (kleq *KeyLogEntryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := kleq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KeyLogEntries.
+func (kleq *KeyLogEntryQuery) All(ctx context.Context) ([]*KeyLogEntry, error) {
+	ctx = setContextOp(ctx, kleq.ctx, "All")
+	if err := kleq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KeyLogEntry, *KeyLogEntryQuery]()
+	return withInterceptors[[]*KeyLogEntry](ctx, kleq, qr, kleq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (kleq *KeyLogEntryQuery) AllX(ctx context.Context) []*KeyLogEntry {
+	nodes, err := kleq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KeyLogEntry IDs.
+func (kleq *KeyLogEntryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if kleq.ctx.Unique == nil && kleq.path != nil {
+		kleq.Unique(true)
+	}
+	ctx = setContextOp(ctx, kleq.ctx, "IDs")
+	if err = kleq.Select(keylogentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (kleq *KeyLogEntryQuery) IDsX(ctx context.Context) []int {
+	ids, err := kleq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (kleq *KeyLogEntryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, kleq.ctx, "Count")
+	if err := kleq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, kleq, querierCount[*KeyLogEntryQuery](), kleq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (kleq *KeyLogEntryQuery) CountX(ctx context.Context) int {
+	count, err := kleq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (kleq *KeyLogEntryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, kleq.ctx, "Exist")
+	switch _, err := kleq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (kleq *KeyLogEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := kleq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KeyLogEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (kleq *KeyLogEntryQuery) Clone() *KeyLogEntryQuery {
+	if kleq == nil {
+		return nil
+	}
+	return &KeyLogEntryQuery{
+		config:     kleq.config,
+		ctx:        kleq.ctx.Clone(),
+		order:      append([]keylogentry.OrderOption{}, kleq.order...),
+		inters:     append([]Interceptor{}, kleq.inters...),
+		predicates: append([]predicate.KeyLogEntry{}, kleq.predicates...),
+		// clone intermediate query.
+		sql:  kleq.sql.Clone(),
+		path: kleq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LeafIndex int `json:"leaf_index,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KeyLogEntry.Query().
+//		GroupBy(keylogentry.FieldLeafIndex).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (kleq *KeyLogEntryQuery) GroupBy(field string, fields ...string) *KeyLogEntryGroupBy {
+	kleq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KeyLogEntryGroupBy{build: kleq}
+	grbuild.flds = &kleq.ctx.Fields
+	grbuild.label = keylogentry.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		LeafIndex int `json:"leaf_index,omitempty"`
+//	}
+//
+//	client.KeyLogEntry.Query().
+//		Select(keylogentry.FieldLeafIndex).
+//		Scan(ctx, &v)
+func (kleq *KeyLogEntryQuery) Select(fields ...string) *KeyLogEntrySelect {
+	kleq.ctx.Fields = append(kleq.ctx.Fields, fields...)
+	sbuild := &KeyLogEntrySelect{KeyLogEntryQuery: kleq}
+	sbuild.label = keylogentry.Label
+	sbuild.flds, sbuild.scan = &kleq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KeyLogEntrySelect configured with the given aggregations.
+func (kleq *KeyLogEntryQuery) Aggregate(fns ...AggregateFunc) *KeyLogEntrySelect {
+	return kleq.Select().Aggregate(fns...)
+}
+
+func (kleq *KeyLogEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range kleq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, kleq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range kleq.ctx.Fields {
+		if !keylogentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if kleq.path != nil {
+		prev, err := kleq.path(ctx)
+		if err != nil {
+			return err
+		}
+		kleq.sql = prev
+	}
+	return nil
+}
+
+func (kleq *KeyLogEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KeyLogEntry, error) {
+	var (
+		nodes = []*KeyLogEntry{}
+		_spec = kleq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KeyLogEntry).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KeyLogEntry{config: kleq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, kleq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (kleq *KeyLogEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := kleq.querySpec()
+	_spec.Node.Columns = kleq.ctx.Fields
+	if len(kleq.ctx.Fields) > 0 {
+		_spec.Unique = kleq.ctx.Unique != nil && *kleq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, kleq.driver, _spec)
+}
+
+func (kleq *KeyLogEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(keylogentry.Table, keylogentry.Columns, sqlgraph.NewFieldSpec(keylogentry.FieldID, field.TypeInt))
+	_spec.From = kleq.sql
+	if unique := kleq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if kleq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := kleq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keylogentry.FieldID)
+		for i := range fields {
+			if fields[i] != keylogentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := kleq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := kleq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := kleq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := kleq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (kleq *KeyLogEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(kleq.driver.Dialect())
+	t1 := builder.Table(keylogentry.Table)
+	columns := kleq.ctx.Fields
+	if len(columns) == 0 {
+		columns = keylogentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if kleq.sql != nil {
+		selector = kleq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if kleq.ctx.Unique != nil && *kleq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range kleq.predicates {
+		p(selector)
+	}
+	for _, p := range kleq.order {
+		p(selector)
+	}
+	if offset := kleq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := kleq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// KeyLogEntryGroupBy is the group-by builder for KeyLogEntry entities.
+type KeyLogEntryGroupBy struct {
+	selector
+	build *KeyLogEntryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (klegb *KeyLogEntryGroupBy) Aggregate(fns ...AggregateFunc) *KeyLogEntryGroupBy {
+	klegb.fns = append(klegb.fns, fns...)
+	return klegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (klegb *KeyLogEntryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, klegb.build.ctx, "GroupBy")
+	if err := klegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyLogEntryQuery, *KeyLogEntryGroupBy](ctx, klegb.build, klegb, klegb.build.inters, v)
+}
+
+func (klegb *KeyLogEntryGroupBy) sqlScan(ctx context.Context, root *KeyLogEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(klegb.fns))
+	for _, fn := range klegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*klegb.flds)+len(klegb.fns))
+		for _, f := range *klegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*klegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := klegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KeyLogEntrySelect is the builder for selecting fields of KeyLogEntry entities.
+type KeyLogEntrySelect struct {
+	*KeyLogEntryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (kles *KeyLogEntrySelect) Aggregate(fns ...AggregateFunc) *KeyLogEntrySelect {
+	kles.fns = append(kles.fns, fns...)
+	return kles
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kles *KeyLogEntrySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kles.ctx, "Select")
+	if err := kles.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyLogEntryQuery, *KeyLogEntrySelect](ctx, kles.KeyLogEntryQuery, kles, kles.inters, v)
+}
+
+func (kles *KeyLogEntrySelect) sqlScan(ctx context.Context, root *KeyLogEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(kles.fns))
+	for _, fn := range kles.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*kles.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kles.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
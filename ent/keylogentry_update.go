@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keylogentry"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyLogEntryUpdate is the builder for updating KeyLogEntry entities.
+type KeyLogEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KeyLogEntryMutation
+}
+
+// Where appends a list predicates to the KeyLogEntryUpdate builder.
+func (kleu *KeyLogEntryUpdate) Where(ps ...predicate.KeyLogEntry) *KeyLogEntryUpdate {
+	kleu.mutation.Where(ps...)
+	return kleu
+}
+
+// Mutation returns the KeyLogEntryMutation object of the builder.
+func (kleu *KeyLogEntryUpdate) Mutation() *KeyLogEntryMutation {
+	return kleu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (kleu *KeyLogEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, kleu.sqlSave, kleu.mutation, kleu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kleu *KeyLogEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := kleu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (kleu *KeyLogEntryUpdate) Exec(ctx context.Context) error {
+	_, err := kleu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kleu *KeyLogEntryUpdate) ExecX(ctx context.Context) {
+	if err := kleu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (kleu *KeyLogEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(keylogentry.Table, keylogentry.Columns, sqlgraph.NewFieldSpec(keylogentry.FieldID, field.TypeInt))
+	if ps := kleu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, kleu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keylogentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	kleu.mutation.done = true
+	return n, nil
+}
+
+// KeyLogEntryUpdateOne is the builder for updating a single KeyLogEntry entity.
+type KeyLogEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KeyLogEntryMutation
+}
+
+// Mutation returns the KeyLogEntryMutation object of the builder.
+func (kleuo *KeyLogEntryUpdateOne) Mutation() *KeyLogEntryMutation {
+	return kleuo.mutation
+}
+
+// Where appends a list predicates to the KeyLogEntryUpdate builder.
+func (kleuo *KeyLogEntryUpdateOne) Where(ps ...predicate.KeyLogEntry) *KeyLogEntryUpdateOne {
+	kleuo.mutation.Where(ps...)
+	return kleuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (kleuo *KeyLogEntryUpdateOne) Select(field string, fields ...string) *KeyLogEntryUpdateOne {
+	kleuo.fields = append([]string{field}, fields...)
+	return kleuo
+}
+
+// Save executes the query and returns the updated KeyLogEntry entity.
+func (kleuo *KeyLogEntryUpdateOne) Save(ctx context.Context) (*KeyLogEntry, error) {
+	return withHooks(ctx, kleuo.sqlSave, kleuo.mutation, kleuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kleuo *KeyLogEntryUpdateOne) SaveX(ctx context.Context) *KeyLogEntry {
+	node, err := kleuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (kleuo *KeyLogEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := kleuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kleuo *KeyLogEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := kleuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (kleuo *KeyLogEntryUpdateOne) sqlSave(ctx context.Context) (_node *KeyLogEntry, err error) {
+	_spec := sqlgraph.NewUpdateSpec(keylogentry.Table, keylogentry.Columns, sqlgraph.NewFieldSpec(keylogentry.FieldID, field.TypeInt))
+	id, ok := kleuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KeyLogEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := kleuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keylogentry.FieldID)
+		for _, f := range fields {
+			if !keylogentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != keylogentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := kleuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &KeyLogEntry{config: kleuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, kleuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keylogentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	kleuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/keyloghead"
+)
+
+// KeyLogHead is the model entity for the KeyLogHead schema.
+type KeyLogHead struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TreeSize holds the value of the "tree_size" field.
+	TreeSize int `json:"tree_size,omitempty"`
+	// RootHash holds the value of the "root_hash" field.
+	RootHash     []byte `json:"root_hash,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KeyLogHead) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case keyloghead.FieldRootHash:
+			values[i] = new([]byte)
+		case keyloghead.FieldID, keyloghead.FieldTreeSize:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KeyLogHead fields.
+func (klh *KeyLogHead) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case keyloghead.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			klh.ID = int(value.Int64)
+		case keyloghead.FieldTreeSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tree_size", values[i])
+			} else if value.Valid {
+				klh.TreeSize = int(value.Int64)
+			}
+		case keyloghead.FieldRootHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field root_hash", values[i])
+			} else if value != nil {
+				klh.RootHash = *value
+			}
+		default:
+			klh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KeyLogHead.
+// This includes values selected through modifiers, order, etc.
+func (klh *KeyLogHead) Value(name string) (ent.Value, error) {
+	return klh.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this KeyLogHead.
+// Note that you need to call KeyLogHead.Unwrap() before calling this method if this KeyLogHead
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (klh *KeyLogHead) Update() *KeyLogHeadUpdateOne {
+	return NewKeyLogHeadClient(klh.config).UpdateOne(klh)
+}
+
+// Unwrap unwraps the KeyLogHead entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (klh *KeyLogHead) Unwrap() *KeyLogHead {
+	_tx, ok := klh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KeyLogHead is not a transactional entity")
+	}
+	klh.config.driver = _tx.drv
+	return klh
+}
+
+// String implements the fmt.Stringer.
+func (klh *KeyLogHead) String() string {
+	var builder strings.Builder
+	builder.WriteString("KeyLogHead(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", klh.ID))
+	builder.WriteString("tree_size=")
+	builder.WriteString(fmt.Sprintf("%v", klh.TreeSize))
+	builder.WriteString(", ")
+	builder.WriteString("root_hash=")
+	builder.WriteString(fmt.Sprintf("%v", klh.RootHash))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KeyLogHeads is a parsable slice of KeyLogHead.
+type KeyLogHeads []*KeyLogHead
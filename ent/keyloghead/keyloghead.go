@@ -0,0 +1,57 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keyloghead
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the keyloghead type in the database.
+	Label = "key_log_head"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTreeSize holds the string denoting the tree_size field in the database.
+	FieldTreeSize = "tree_size"
+	// FieldRootHash holds the string denoting the root_hash field in the database.
+	FieldRootHash = "root_hash"
+	// Table holds the table name of the keyloghead in the database.
+	Table = "key_log_heads"
+)
+
+// Columns holds all SQL columns for keyloghead fields.
+var Columns = []string{
+	FieldID,
+	FieldTreeSize,
+	FieldRootHash,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTreeSize holds the default value on creation for the "tree_size" field.
+	DefaultTreeSize int
+	// TreeSizeValidator is a validator for the "tree_size" field. It is called by the builders before save.
+	TreeSizeValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the KeyLogHead queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTreeSize orders the results by the tree_size field.
+func ByTreeSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTreeSize, opts...).ToFunc()
+}
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keyloghead
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldLTE(FieldID, id))
+}
+
+// TreeSize applies equality check predicate on the "tree_size" field. It's identical to TreeSizeEQ.
+func TreeSize(v int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldEQ(FieldTreeSize, v))
+}
+
+// RootHash applies equality check predicate on the "root_hash" field. It's identical to RootHashEQ.
+func RootHash(v []byte) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldEQ(FieldRootHash, v))
+}
+
+// TreeSizeEQ applies the EQ predicate on the "tree_size" field.
+func TreeSizeEQ(v int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldEQ(FieldTreeSize, v))
+}
+
+// TreeSizeNEQ applies the NEQ predicate on the "tree_size" field.
+func TreeSizeNEQ(v int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldNEQ(FieldTreeSize, v))
+}
+
+// TreeSizeIn applies the In predicate on the "tree_size" field.
+func TreeSizeIn(vs ...int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldIn(FieldTreeSize, vs...))
+}
+
+// TreeSizeNotIn applies the NotIn predicate on the "tree_size" field.
+func TreeSizeNotIn(vs ...int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldNotIn(FieldTreeSize, vs...))
+}
+
+// TreeSizeGT applies the GT predicate on the "tree_size" field.
+func TreeSizeGT(v int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldGT(FieldTreeSize, v))
+}
+
+// TreeSizeGTE applies the GTE predicate on the "tree_size" field.
+func TreeSizeGTE(v int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldGTE(FieldTreeSize, v))
+}
+
+// TreeSizeLT applies the LT predicate on the "tree_size" field.
+func TreeSizeLT(v int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldLT(FieldTreeSize, v))
+}
+
+// TreeSizeLTE applies the LTE predicate on the "tree_size" field.
+func TreeSizeLTE(v int) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldLTE(FieldTreeSize, v))
+}
+
+// RootHashEQ applies the EQ predicate on the "root_hash" field.
+func RootHashEQ(v []byte) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldEQ(FieldRootHash, v))
+}
+
+// RootHashNEQ applies the NEQ predicate on the "root_hash" field.
+func RootHashNEQ(v []byte) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldNEQ(FieldRootHash, v))
+}
+
+// RootHashIn applies the In predicate on the "root_hash" field.
+func RootHashIn(vs ...[]byte) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldIn(FieldRootHash, vs...))
+}
+
+// RootHashNotIn applies the NotIn predicate on the "root_hash" field.
+func RootHashNotIn(vs ...[]byte) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldNotIn(FieldRootHash, vs...))
+}
+
+// RootHashGT applies the GT predicate on the "root_hash" field.
+func RootHashGT(v []byte) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldGT(FieldRootHash, v))
+}
+
+// RootHashGTE applies the GTE predicate on the "root_hash" field.
+func RootHashGTE(v []byte) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldGTE(FieldRootHash, v))
+}
+
+// RootHashLT applies the LT predicate on the "root_hash" field.
+func RootHashLT(v []byte) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldLT(FieldRootHash, v))
+}
+
+// RootHashLTE applies the LTE predicate on the "root_hash" field.
+func RootHashLTE(v []byte) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.FieldLTE(FieldRootHash, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KeyLogHead) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KeyLogHead) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KeyLogHead) predicate.KeyLogHead {
+	return predicate.KeyLogHead(sql.NotPredicates(p))
+}
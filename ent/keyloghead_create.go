@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keyloghead"
+)
+
+// KeyLogHeadCreate is the builder for creating a KeyLogHead entity.
+type KeyLogHeadCreate struct {
+	config
+	mutation *KeyLogHeadMutation
+	hooks    []Hook
+}
+
+// SetTreeSize sets the "tree_size" field.
+func (klhc *KeyLogHeadCreate) SetTreeSize(i int) *KeyLogHeadCreate {
+	klhc.mutation.SetTreeSize(i)
+	return klhc
+}
+
+// SetNillableTreeSize sets the "tree_size" field if the given value is not nil.
+func (klhc *KeyLogHeadCreate) SetNillableTreeSize(i *int) *KeyLogHeadCreate {
+	if i != nil {
+		klhc.SetTreeSize(*i)
+	}
+	return klhc
+}
+
+// SetRootHash sets the "root_hash" field.
+func (klhc *KeyLogHeadCreate) SetRootHash(b []byte) *KeyLogHeadCreate {
+	klhc.mutation.SetRootHash(b)
+	return klhc
+}
+
+// Mutation returns the KeyLogHeadMutation object of the builder.
+func (klhc *KeyLogHeadCreate) Mutation() *KeyLogHeadMutation {
+	return klhc.mutation
+}
+
+// Save creates the KeyLogHead in the database.
+func (klhc *KeyLogHeadCreate) Save(ctx context.Context) (*KeyLogHead, error) {
+	klhc.defaults()
+	return withHooks(ctx, klhc.sqlSave, klhc.mutation, klhc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (klhc *KeyLogHeadCreate) SaveX(ctx context.Context) *KeyLogHead {
+	v, err := klhc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (klhc *KeyLogHeadCreate) Exec(ctx context.Context) error {
+	_, err := klhc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klhc *KeyLogHeadCreate) ExecX(ctx context.Context) {
+	if err := klhc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (klhc *KeyLogHeadCreate) defaults() {
+	if _, ok := klhc.mutation.TreeSize(); !ok {
+		v := keyloghead.DefaultTreeSize
+		klhc.mutation.SetTreeSize(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (klhc *KeyLogHeadCreate) check() error {
+	if _, ok := klhc.mutation.TreeSize(); !ok {
+		return &ValidationError{Name: "tree_size", err: errors.New(`ent: missing required field "KeyLogHead.tree_size"`)}
+	}
+	if v, ok := klhc.mutation.TreeSize(); ok {
+		if err := keyloghead.TreeSizeValidator(v); err != nil {
+			return &ValidationError{Name: "tree_size", err: fmt.Errorf(`ent: validator failed for field "KeyLogHead.tree_size": %w`, err)}
+		}
+	}
+	if _, ok := klhc.mutation.RootHash(); !ok {
+		return &ValidationError{Name: "root_hash", err: errors.New(`ent: missing required field "KeyLogHead.root_hash"`)}
+	}
+	return nil
+}
+
+func (klhc *KeyLogHeadCreate) sqlSave(ctx context.Context) (*KeyLogHead, error) {
+	if err := klhc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := klhc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, klhc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	klhc.mutation.id = &_node.ID
+	klhc.mutation.done = true
+	return _node, nil
+}
+
+func (klhc *KeyLogHeadCreate) createSpec() (*KeyLogHead, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KeyLogHead{config: klhc.config}
+		_spec = sqlgraph.NewCreateSpec(keyloghead.Table, sqlgraph.NewFieldSpec(keyloghead.FieldID, field.TypeInt))
+	)
+	if value, ok := klhc.mutation.TreeSize(); ok {
+		_spec.SetField(keyloghead.FieldTreeSize, field.TypeInt, value)
+		_node.TreeSize = value
+	}
+	if value, ok := klhc.mutation.RootHash(); ok {
+		_spec.SetField(keyloghead.FieldRootHash, field.TypeBytes, value)
+		_node.RootHash = value
+	}
+	return _node, _spec
+}
+
+// KeyLogHeadCreateBulk is the builder for creating many KeyLogHead entities in bulk.
+type KeyLogHeadCreateBulk struct {
+	config
+	err      error
+	builders []*KeyLogHeadCreate
+}
+
+// Save creates the KeyLogHead entities in the database.
+func (klhcb *KeyLogHeadCreateBulk) Save(ctx context.Context) ([]*KeyLogHead, error) {
+	if klhcb.err != nil {
+		return nil, klhcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(klhcb.builders))
+	nodes := make([]*KeyLogHead, len(klhcb.builders))
+	mutators := make([]Mutator, len(klhcb.builders))
+	for i := range klhcb.builders {
+		func(i int, root context.Context) {
+			builder := klhcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KeyLogHeadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, klhcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, klhcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, klhcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (klhcb *KeyLogHeadCreateBulk) SaveX(ctx context.Context) []*KeyLogHead {
+	v, err := klhcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (klhcb *KeyLogHeadCreateBulk) Exec(ctx context.Context) error {
+	_, err := klhcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klhcb *KeyLogHeadCreateBulk) ExecX(ctx context.Context) {
+	if err := klhcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keyloghead"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyLogHeadDelete is the builder for deleting a KeyLogHead entity.
+type KeyLogHeadDelete struct {
+	config
+	hooks    []Hook
+	mutation *KeyLogHeadMutation
+}
+
+// Where appends a list predicates to the KeyLogHeadDelete builder.
+func (klhd *KeyLogHeadDelete) Where(ps ...predicate.KeyLogHead) *KeyLogHeadDelete {
+	klhd.mutation.Where(ps...)
+	return klhd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (klhd *KeyLogHeadDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, klhd.sqlExec, klhd.mutation, klhd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klhd *KeyLogHeadDelete) ExecX(ctx context.Context) int {
+	n, err := klhd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (klhd *KeyLogHeadDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(keyloghead.Table, sqlgraph.NewFieldSpec(keyloghead.FieldID, field.TypeInt))
+	if ps := klhd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, klhd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	klhd.mutation.done = true
+	return affected, err
+}
+
+// KeyLogHeadDeleteOne is the builder for deleting a single KeyLogHead entity.
+type KeyLogHeadDeleteOne struct {
+	klhd *KeyLogHeadDelete
+}
+
+// Where appends a list predicates to the KeyLogHeadDelete builder.
+func (klhdo *KeyLogHeadDeleteOne) Where(ps ...predicate.KeyLogHead) *KeyLogHeadDeleteOne {
+	klhdo.klhd.mutation.Where(ps...)
+	return klhdo
+}
+
+// Exec executes the deletion query.
+func (klhdo *KeyLogHeadDeleteOne) Exec(ctx context.Context) error {
+	n, err := klhdo.klhd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{keyloghead.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klhdo *KeyLogHeadDeleteOne) ExecX(ctx context.Context) {
+	if err := klhdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
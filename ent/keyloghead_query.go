@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keyloghead"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyLogHeadQuery is the builder for querying KeyLogHead entities.
+type KeyLogHeadQuery struct {
+	config
+	ctx        *QueryContext
+	order      []keyloghead.OrderOption
+	inters     []Interceptor
+	predicates []predicate.KeyLogHead
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KeyLogHeadQuery builder.
+func (klhq *KeyLogHeadQuery) Where(ps ...predicate.KeyLogHead) *KeyLogHeadQuery {
+	klhq.predicates = append(klhq.predicates, ps...)
+	return klhq
+}
+
+// Limit the number of records to be returned by this query.
+func (klhq *KeyLogHeadQuery) Limit(limit int) *KeyLogHeadQuery {
+	klhq.ctx.Limit = &limit
+	return klhq
+}
+
+// Offset to start from.
+func (klhq *KeyLogHeadQuery) Offset(offset int) *KeyLogHeadQuery {
+	klhq.ctx.Offset = &offset
+	return klhq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (klhq *KeyLogHeadQuery) Unique(unique bool) *KeyLogHeadQuery {
+	klhq.ctx.Unique = &unique
+	return klhq
+}
+
+// Order specifies how the records should be ordered.
+func (klhq *KeyLogHeadQuery) Order(o ...keyloghead.OrderOption) *KeyLogHeadQuery {
+	klhq.order = append(klhq.order, o...)
+	return klhq
+}
+
+// First returns the first KeyLogHead entity from the query.
+// Returns a *NotFoundError when no KeyLogHead was found.
+func (klhq *KeyLogHeadQuery) First(ctx context.Context) (*KeyLogHead, error) {
+	nodes, err := klhq.Limit(1).All(setContextOp(ctx, klhq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{keyloghead.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (klhq *KeyLogHeadQuery) FirstX(ctx context.Context) *KeyLogHead {
+	node, err := klhq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KeyLogHead ID from the query.
+// Returns a *NotFoundError when no KeyLogHead ID was found.
+func (klhq *KeyLogHeadQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = klhq.Limit(1).IDs(setContextOp(ctx, klhq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{keyloghead.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (klhq *KeyLogHeadQuery) FirstIDX(ctx context.Context) int {
+	id, err := klhq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KeyLogHead entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KeyLogHead entity is found.
+// Returns a *NotFoundError when no KeyLogHead entities are found.
+func (klhq *KeyLogHeadQuery) Only(ctx context.Context) (*KeyLogHead, error) {
+	nodes, err := klhq.Limit(2).All(setContextOp(ctx, klhq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{keyloghead.Label}
+	default:
+		return nil, &NotSingularError{keyloghead.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (klhq *KeyLogHeadQuery) OnlyX(ctx context.Context) *KeyLogHead {
+	node, err := klhq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KeyLogHead ID in the query.
+// Returns a *NotSingularError when more than one KeyLogHead ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (klhq *KeyLogHeadQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = klhq.Limit(2).IDs(setContextOp(ctx, klhq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{keyloghead.Label}
+	default:
+		err = &NotSingularError{keyloghead.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (klhq *KeyLogHeadQuery) OnlyIDX(ctx context.Context) int {
+	id, err := klhq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KeyLogHeads.
+func (klhq *KeyLogHeadQuery) All(ctx context.Context) ([]*KeyLogHead, error) {
+	ctx = setContextOp(ctx, klhq.ctx, "All")
+	if err := klhq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KeyLogHead, *KeyLogHeadQuery]()
+	return withInterceptors[[]*KeyLogHead](ctx, klhq, qr, klhq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (klhq *KeyLogHeadQuery) AllX(ctx context.Context) []*KeyLogHead {
+	nodes, err := klhq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KeyLogHead IDs.
+func (klhq *KeyLogHeadQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if klhq.ctx.Unique == nil && klhq.path != nil {
+		klhq.Unique(true)
+	}
+	ctx = setContextOp(ctx, klhq.ctx, "IDs")
+	if err = klhq.Select(keyloghead.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (klhq *KeyLogHeadQuery) IDsX(ctx context.Context) []int {
+	ids, err := klhq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (klhq *KeyLogHeadQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, klhq.ctx, "Count")
+	if err := klhq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, klhq, querierCount[*KeyLogHeadQuery](), klhq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (klhq *KeyLogHeadQuery) CountX(ctx context.Context) int {
+	count, err := klhq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (klhq *KeyLogHeadQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, klhq.ctx, "Exist")
+	switch _, err := klhq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (klhq *KeyLogHeadQuery) ExistX(ctx context.Context) bool {
+	exist, err := klhq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KeyLogHeadQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (klhq *KeyLogHeadQuery) Clone() *KeyLogHeadQuery {
+	if klhq == nil {
+		return nil
+	}
+	return &KeyLogHeadQuery{
+		config:     klhq.config,
+		ctx:        klhq.ctx.Clone(),
+		order:      append([]keyloghead.OrderOption{}, klhq.order...),
+		inters:     append([]Interceptor{}, klhq.inters...),
+		predicates: append([]predicate.KeyLogHead{}, klhq.predicates...),
+		// clone intermediate query.
+		sql:  klhq.sql.Clone(),
+		path: klhq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TreeSize int `json:"tree_size,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KeyLogHead.Query().
+//		GroupBy(keyloghead.FieldTreeSize).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (klhq *KeyLogHeadQuery) GroupBy(field string, fields ...string) *KeyLogHeadGroupBy {
+	klhq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KeyLogHeadGroupBy{build: klhq}
+	grbuild.flds = &klhq.ctx.Fields
+	grbuild.label = keyloghead.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TreeSize int `json:"tree_size,omitempty"`
+//	}
+//
+//	client.KeyLogHead.Query().
+//		Select(keyloghead.FieldTreeSize).
+//		Scan(ctx, &v)
+func (klhq *KeyLogHeadQuery) Select(fields ...string) *KeyLogHeadSelect {
+	klhq.ctx.Fields = append(klhq.ctx.Fields, fields...)
+	sbuild := &KeyLogHeadSelect{KeyLogHeadQuery: klhq}
+	sbuild.label = keyloghead.Label
+	sbuild.flds, sbuild.scan = &klhq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KeyLogHeadSelect configured with the given aggregations.
+func (klhq *KeyLogHeadQuery) Aggregate(fns ...AggregateFunc) *KeyLogHeadSelect {
+	return klhq.Select().Aggregate(fns...)
+}
+
+func (klhq *KeyLogHeadQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range klhq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, klhq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range klhq.ctx.Fields {
+		if !keyloghead.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if klhq.path != nil {
+		prev, err := klhq.path(ctx)
+		if err != nil {
+			return err
+		}
+		klhq.sql = prev
+	}
+	return nil
+}
+
+func (klhq *KeyLogHeadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KeyLogHead, error) {
+	var (
+		nodes = []*KeyLogHead{}
+		_spec = klhq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KeyLogHead).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KeyLogHead{config: klhq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, klhq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (klhq *KeyLogHeadQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := klhq.querySpec()
+	_spec.Node.Columns = klhq.ctx.Fields
+	if len(klhq.ctx.Fields) > 0 {
+		_spec.Unique = klhq.ctx.Unique != nil && *klhq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, klhq.driver, _spec)
+}
+
+func (klhq *KeyLogHeadQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(keyloghead.Table, keyloghead.Columns, sqlgraph.NewFieldSpec(keyloghead.FieldID, field.TypeInt))
+	_spec.From = klhq.sql
+	if unique := klhq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if klhq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := klhq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keyloghead.FieldID)
+		for i := range fields {
+			if fields[i] != keyloghead.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := klhq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := klhq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := klhq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := klhq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (klhq *KeyLogHeadQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(klhq.driver.Dialect())
+	t1 := builder.Table(keyloghead.Table)
+	columns := klhq.ctx.Fields
+	if len(columns) == 0 {
+		columns = keyloghead.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if klhq.sql != nil {
+		selector = klhq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if klhq.ctx.Unique != nil && *klhq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range klhq.predicates {
+		p(selector)
+	}
+	for _, p := range klhq.order {
+		p(selector)
+	}
+	if offset := klhq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := klhq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// KeyLogHeadGroupBy is the group-by builder for KeyLogHead entities.
+type KeyLogHeadGroupBy struct {
+	selector
+	build *KeyLogHeadQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (klhgb *KeyLogHeadGroupBy) Aggregate(fns ...AggregateFunc) *KeyLogHeadGroupBy {
+	klhgb.fns = append(klhgb.fns, fns...)
+	return klhgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (klhgb *KeyLogHeadGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, klhgb.build.ctx, "GroupBy")
+	if err := klhgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyLogHeadQuery, *KeyLogHeadGroupBy](ctx, klhgb.build, klhgb, klhgb.build.inters, v)
+}
+
+func (klhgb *KeyLogHeadGroupBy) sqlScan(ctx context.Context, root *KeyLogHeadQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(klhgb.fns))
+	for _, fn := range klhgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*klhgb.flds)+len(klhgb.fns))
+		for _, f := range *klhgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*klhgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := klhgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KeyLogHeadSelect is the builder for selecting fields of KeyLogHead entities.
+type KeyLogHeadSelect struct {
+	*KeyLogHeadQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (klhs *KeyLogHeadSelect) Aggregate(fns ...AggregateFunc) *KeyLogHeadSelect {
+	klhs.fns = append(klhs.fns, fns...)
+	return klhs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (klhs *KeyLogHeadSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, klhs.ctx, "Select")
+	if err := klhs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyLogHeadQuery, *KeyLogHeadSelect](ctx, klhs.KeyLogHeadQuery, klhs, klhs.inters, v)
+}
+
+func (klhs *KeyLogHeadSelect) sqlScan(ctx context.Context, root *KeyLogHeadQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(klhs.fns))
+	for _, fn := range klhs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*klhs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := klhs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keyloghead"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyLogHeadUpdate is the builder for updating KeyLogHead entities.
+type KeyLogHeadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KeyLogHeadMutation
+}
+
+// Where appends a list predicates to the KeyLogHeadUpdate builder.
+func (klhu *KeyLogHeadUpdate) Where(ps ...predicate.KeyLogHead) *KeyLogHeadUpdate {
+	klhu.mutation.Where(ps...)
+	return klhu
+}
+
+// SetTreeSize sets the "tree_size" field.
+func (klhu *KeyLogHeadUpdate) SetTreeSize(i int) *KeyLogHeadUpdate {
+	klhu.mutation.ResetTreeSize()
+	klhu.mutation.SetTreeSize(i)
+	return klhu
+}
+
+// SetNillableTreeSize sets the "tree_size" field if the given value is not nil.
+func (klhu *KeyLogHeadUpdate) SetNillableTreeSize(i *int) *KeyLogHeadUpdate {
+	if i != nil {
+		klhu.SetTreeSize(*i)
+	}
+	return klhu
+}
+
+// AddTreeSize adds i to the "tree_size" field.
+func (klhu *KeyLogHeadUpdate) AddTreeSize(i int) *KeyLogHeadUpdate {
+	klhu.mutation.AddTreeSize(i)
+	return klhu
+}
+
+// SetRootHash sets the "root_hash" field.
+func (klhu *KeyLogHeadUpdate) SetRootHash(b []byte) *KeyLogHeadUpdate {
+	klhu.mutation.SetRootHash(b)
+	return klhu
+}
+
+// Mutation returns the KeyLogHeadMutation object of the builder.
+func (klhu *KeyLogHeadUpdate) Mutation() *KeyLogHeadMutation {
+	return klhu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (klhu *KeyLogHeadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, klhu.sqlSave, klhu.mutation, klhu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (klhu *KeyLogHeadUpdate) SaveX(ctx context.Context) int {
+	affected, err := klhu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (klhu *KeyLogHeadUpdate) Exec(ctx context.Context) error {
+	_, err := klhu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klhu *KeyLogHeadUpdate) ExecX(ctx context.Context) {
+	if err := klhu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (klhu *KeyLogHeadUpdate) check() error {
+	if v, ok := klhu.mutation.TreeSize(); ok {
+		if err := keyloghead.TreeSizeValidator(v); err != nil {
+			return &ValidationError{Name: "tree_size", err: fmt.Errorf(`ent: validator failed for field "KeyLogHead.tree_size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (klhu *KeyLogHeadUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := klhu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keyloghead.Table, keyloghead.Columns, sqlgraph.NewFieldSpec(keyloghead.FieldID, field.TypeInt))
+	if ps := klhu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := klhu.mutation.TreeSize(); ok {
+		_spec.SetField(keyloghead.FieldTreeSize, field.TypeInt, value)
+	}
+	if value, ok := klhu.mutation.AddedTreeSize(); ok {
+		_spec.AddField(keyloghead.FieldTreeSize, field.TypeInt, value)
+	}
+	if value, ok := klhu.mutation.RootHash(); ok {
+		_spec.SetField(keyloghead.FieldRootHash, field.TypeBytes, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, klhu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keyloghead.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	klhu.mutation.done = true
+	return n, nil
+}
+
+// KeyLogHeadUpdateOne is the builder for updating a single KeyLogHead entity.
+type KeyLogHeadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KeyLogHeadMutation
+}
+
+// SetTreeSize sets the "tree_size" field.
+func (klhuo *KeyLogHeadUpdateOne) SetTreeSize(i int) *KeyLogHeadUpdateOne {
+	klhuo.mutation.ResetTreeSize()
+	klhuo.mutation.SetTreeSize(i)
+	return klhuo
+}
+
+// SetNillableTreeSize sets the "tree_size" field if the given value is not nil.
+func (klhuo *KeyLogHeadUpdateOne) SetNillableTreeSize(i *int) *KeyLogHeadUpdateOne {
+	if i != nil {
+		klhuo.SetTreeSize(*i)
+	}
+	return klhuo
+}
+
+// AddTreeSize adds i to the "tree_size" field.
+func (klhuo *KeyLogHeadUpdateOne) AddTreeSize(i int) *KeyLogHeadUpdateOne {
+	klhuo.mutation.AddTreeSize(i)
+	return klhuo
+}
+
+// SetRootHash sets the "root_hash" field.
+func (klhuo *KeyLogHeadUpdateOne) SetRootHash(b []byte) *KeyLogHeadUpdateOne {
+	klhuo.mutation.SetRootHash(b)
+	return klhuo
+}
+
+// Mutation returns the KeyLogHeadMutation object of the builder.
+func (klhuo *KeyLogHeadUpdateOne) Mutation() *KeyLogHeadMutation {
+	return klhuo.mutation
+}
+
+// Where appends a list predicates to the KeyLogHeadUpdate builder.
+func (klhuo *KeyLogHeadUpdateOne) Where(ps ...predicate.KeyLogHead) *KeyLogHeadUpdateOne {
+	klhuo.mutation.Where(ps...)
+	return klhuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (klhuo *KeyLogHeadUpdateOne) Select(field string, fields ...string) *KeyLogHeadUpdateOne {
+	klhuo.fields = append([]string{field}, fields...)
+	return klhuo
+}
+
+// Save executes the query and returns the updated KeyLogHead entity.
+func (klhuo *KeyLogHeadUpdateOne) Save(ctx context.Context) (*KeyLogHead, error) {
+	return withHooks(ctx, klhuo.sqlSave, klhuo.mutation, klhuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (klhuo *KeyLogHeadUpdateOne) SaveX(ctx context.Context) *KeyLogHead {
+	node, err := klhuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (klhuo *KeyLogHeadUpdateOne) Exec(ctx context.Context) error {
+	_, err := klhuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klhuo *KeyLogHeadUpdateOne) ExecX(ctx context.Context) {
+	if err := klhuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (klhuo *KeyLogHeadUpdateOne) check() error {
+	if v, ok := klhuo.mutation.TreeSize(); ok {
+		if err := keyloghead.TreeSizeValidator(v); err != nil {
+			return &ValidationError{Name: "tree_size", err: fmt.Errorf(`ent: validator failed for field "KeyLogHead.tree_size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (klhuo *KeyLogHeadUpdateOne) sqlSave(ctx context.Context) (_node *KeyLogHead, err error) {
+	if err := klhuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keyloghead.Table, keyloghead.Columns, sqlgraph.NewFieldSpec(keyloghead.FieldID, field.TypeInt))
+	id, ok := klhuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KeyLogHead.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := klhuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keyloghead.FieldID)
+		for _, f := range fields {
+			if !keyloghead.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != keyloghead.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := klhuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := klhuo.mutation.TreeSize(); ok {
+		_spec.SetField(keyloghead.FieldTreeSize, field.TypeInt, value)
+	}
+	if value, ok := klhuo.mutation.AddedTreeSize(); ok {
+		_spec.AddField(keyloghead.FieldTreeSize, field.TypeInt, value)
+	}
+	if value, ok := klhuo.mutation.RootHash(); ok {
+		_spec.SetField(keyloghead.FieldRootHash, field.TypeBytes, value)
+	}
+	_node = &KeyLogHead{config: klhuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, klhuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keyloghead.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	klhuo.mutation.done = true
+	return _node, nil
+}
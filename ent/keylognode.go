@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/keylognode"
+)
+
+// KeyLogNode is the model entity for the KeyLogNode schema.
+type KeyLogNode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Level holds the value of the "level" field.
+	Level int `json:"level,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash         []byte `json:"hash,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KeyLogNode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case keylognode.FieldHash:
+			values[i] = new([]byte)
+		case keylognode.FieldID, keylognode.FieldLevel, keylognode.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KeyLogNode fields.
+func (kln *KeyLogNode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case keylognode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			kln.ID = int(value.Int64)
+		case keylognode.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				kln.Level = int(value.Int64)
+			}
+		case keylognode.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				kln.Position = int(value.Int64)
+			}
+		case keylognode.FieldHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value != nil {
+				kln.Hash = *value
+			}
+		default:
+			kln.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KeyLogNode.
+// This includes values selected through modifiers, order, etc.
+func (kln *KeyLogNode) Value(name string) (ent.Value, error) {
+	return kln.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this KeyLogNode.
+// Note that you need to call KeyLogNode.Unwrap() before calling this method if this KeyLogNode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (kln *KeyLogNode) Update() *KeyLogNodeUpdateOne {
+	return NewKeyLogNodeClient(kln.config).UpdateOne(kln)
+}
+
+// Unwrap unwraps the KeyLogNode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (kln *KeyLogNode) Unwrap() *KeyLogNode {
+	_tx, ok := kln.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KeyLogNode is not a transactional entity")
+	}
+	kln.config.driver = _tx.drv
+	return kln
+}
+
+// String implements the fmt.Stringer.
+func (kln *KeyLogNode) String() string {
+	var builder strings.Builder
+	builder.WriteString("KeyLogNode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", kln.ID))
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", kln.Level))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", kln.Position))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(fmt.Sprintf("%v", kln.Hash))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KeyLogNodes is a parsable slice of KeyLogNode.
+type KeyLogNodes []*KeyLogNode
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keylognode
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldLTE(FieldID, id))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldEQ(FieldLevel, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldEQ(FieldPosition, v))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v []byte) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldEQ(FieldHash, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldLTE(FieldLevel, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldLTE(FieldPosition, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v []byte) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v []byte) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...[]byte) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...[]byte) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v []byte) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v []byte) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v []byte) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v []byte) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.FieldLTE(FieldHash, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KeyLogNode) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KeyLogNode) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KeyLogNode) predicate.KeyLogNode {
+	return predicate.KeyLogNode(sql.NotPredicates(p))
+}
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keylognode"
+)
+
+// KeyLogNodeCreate is the builder for creating a KeyLogNode entity.
+type KeyLogNodeCreate struct {
+	config
+	mutation *KeyLogNodeMutation
+	hooks    []Hook
+}
+
+// SetLevel sets the "level" field.
+func (klnc *KeyLogNodeCreate) SetLevel(i int) *KeyLogNodeCreate {
+	klnc.mutation.SetLevel(i)
+	return klnc
+}
+
+// SetPosition sets the "position" field.
+func (klnc *KeyLogNodeCreate) SetPosition(i int) *KeyLogNodeCreate {
+	klnc.mutation.SetPosition(i)
+	return klnc
+}
+
+// SetHash sets the "hash" field.
+func (klnc *KeyLogNodeCreate) SetHash(b []byte) *KeyLogNodeCreate {
+	klnc.mutation.SetHash(b)
+	return klnc
+}
+
+// Mutation returns the KeyLogNodeMutation object of the builder.
+func (klnc *KeyLogNodeCreate) Mutation() *KeyLogNodeMutation {
+	return klnc.mutation
+}
+
+// Save creates the KeyLogNode in the database.
+func (klnc *KeyLogNodeCreate) Save(ctx context.Context) (*KeyLogNode, error) {
+	return withHooks(ctx, klnc.sqlSave, klnc.mutation, klnc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (klnc *KeyLogNodeCreate) SaveX(ctx context.Context) *KeyLogNode {
+	v, err := klnc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (klnc *KeyLogNodeCreate) Exec(ctx context.Context) error {
+	_, err := klnc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klnc *KeyLogNodeCreate) ExecX(ctx context.Context) {
+	if err := klnc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (klnc *KeyLogNodeCreate) check() error {
+	if _, ok := klnc.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "KeyLogNode.level"`)}
+	}
+	if v, ok := klnc.mutation.Level(); ok {
+		if err := keylognode.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "KeyLogNode.level": %w`, err)}
+		}
+	}
+	if _, ok := klnc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "KeyLogNode.position"`)}
+	}
+	if v, ok := klnc.mutation.Position(); ok {
+		if err := keylognode.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "KeyLogNode.position": %w`, err)}
+		}
+	}
+	if _, ok := klnc.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "KeyLogNode.hash"`)}
+	}
+	return nil
+}
+
+func (klnc *KeyLogNodeCreate) sqlSave(ctx context.Context) (*KeyLogNode, error) {
+	if err := klnc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := klnc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, klnc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	klnc.mutation.id = &_node.ID
+	klnc.mutation.done = true
+	return _node, nil
+}
+
+func (klnc *KeyLogNodeCreate) createSpec() (*KeyLogNode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KeyLogNode{config: klnc.config}
+		_spec = sqlgraph.NewCreateSpec(keylognode.Table, sqlgraph.NewFieldSpec(keylognode.FieldID, field.TypeInt))
+	)
+	if value, ok := klnc.mutation.Level(); ok {
+		_spec.SetField(keylognode.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := klnc.mutation.Position(); ok {
+		_spec.SetField(keylognode.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := klnc.mutation.Hash(); ok {
+		_spec.SetField(keylognode.FieldHash, field.TypeBytes, value)
+		_node.Hash = value
+	}
+	return _node, _spec
+}
+
+// KeyLogNodeCreateBulk is the builder for creating many KeyLogNode entities in bulk.
+type KeyLogNodeCreateBulk struct {
+	config
+	err      error
+	builders []*KeyLogNodeCreate
+}
+
+// Save creates the KeyLogNode entities in the database.
+func (klncb *KeyLogNodeCreateBulk) Save(ctx context.Context) ([]*KeyLogNode, error) {
+	if klncb.err != nil {
+		return nil, klncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(klncb.builders))
+	nodes := make([]*KeyLogNode, len(klncb.builders))
+	mutators := make([]Mutator, len(klncb.builders))
+	for i := range klncb.builders {
+		func(i int, root context.Context) {
+			builder := klncb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KeyLogNodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, klncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, klncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, klncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (klncb *KeyLogNodeCreateBulk) SaveX(ctx context.Context) []*KeyLogNode {
+	v, err := klncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (klncb *KeyLogNodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := klncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klncb *KeyLogNodeCreateBulk) ExecX(ctx context.Context) {
+	if err := klncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keylognode"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyLogNodeDelete is the builder for deleting a KeyLogNode entity.
+type KeyLogNodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *KeyLogNodeMutation
+}
+
+// Where appends a list predicates to the KeyLogNodeDelete builder.
+func (klnd *KeyLogNodeDelete) Where(ps ...predicate.KeyLogNode) *KeyLogNodeDelete {
+	klnd.mutation.Where(ps...)
+	return klnd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (klnd *KeyLogNodeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, klnd.sqlExec, klnd.mutation, klnd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klnd *KeyLogNodeDelete) ExecX(ctx context.Context) int {
+	n, err := klnd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (klnd *KeyLogNodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(keylognode.Table, sqlgraph.NewFieldSpec(keylognode.FieldID, field.TypeInt))
+	if ps := klnd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, klnd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	klnd.mutation.done = true
+	return affected, err
+}
+
+// KeyLogNodeDeleteOne is the builder for deleting a single KeyLogNode entity.
+type KeyLogNodeDeleteOne struct {
+	klnd *KeyLogNodeDelete
+}
+
+// Where appends a list predicates to the KeyLogNodeDelete builder.
+func (klndo *KeyLogNodeDeleteOne) Where(ps ...predicate.KeyLogNode) *KeyLogNodeDeleteOne {
+	klndo.klnd.mutation.Where(ps...)
+	return klndo
+}
+
+// Exec executes the deletion query.
+func (klndo *KeyLogNodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := klndo.klnd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{keylognode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klndo *KeyLogNodeDeleteOne) ExecX(ctx context.Context) {
+	if err := klndo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keylognode"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyLogNodeQuery is the builder for querying KeyLogNode entities.
+type KeyLogNodeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []keylognode.OrderOption
+	inters     []Interceptor
+	predicates []predicate.KeyLogNode
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KeyLogNodeQuery builder.
+func (klnq *KeyLogNodeQuery) Where(ps ...predicate.KeyLogNode) *KeyLogNodeQuery {
+	klnq.predicates = append(klnq.predicates, ps...)
+	return klnq
+}
+
+// Limit the number of records to be returned by this query.
+func (klnq *KeyLogNodeQuery) Limit(limit int) *KeyLogNodeQuery {
+	klnq.ctx.Limit = &limit
+	return klnq
+}
+
+// Offset to start from.
+func (klnq *KeyLogNodeQuery) Offset(offset int) *KeyLogNodeQuery {
+	klnq.ctx.Offset = &offset
+	return klnq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (klnq *KeyLogNodeQuery) Unique(unique bool) *KeyLogNodeQuery {
+	klnq.ctx.Unique = &unique
+	return klnq
+}
+
+// Order specifies how the records should be ordered.
+func (klnq *KeyLogNodeQuery) Order(o ...keylognode.OrderOption) *KeyLogNodeQuery {
+	klnq.order = append(klnq.order, o...)
+	return klnq
+}
+
+// First returns the first KeyLogNode entity from the query.
+// Returns a *NotFoundError when no KeyLogNode was found.
+func (klnq *KeyLogNodeQuery) First(ctx context.Context) (*KeyLogNode, error) {
+	nodes, err := klnq.Limit(1).All(setContextOp(ctx, klnq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{keylognode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (klnq *KeyLogNodeQuery) FirstX(ctx context.Context) *KeyLogNode {
+	node, err := klnq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KeyLogNode ID from the query.
+// Returns a *NotFoundError when no KeyLogNode ID was found.
+func (klnq *KeyLogNodeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = klnq.Limit(1).IDs(setContextOp(ctx, klnq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{keylognode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (klnq *KeyLogNodeQuery) FirstIDX(ctx context.Context) int {
+	id, err := klnq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KeyLogNode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KeyLogNode entity is found.
+// Returns a *NotFoundError when no KeyLogNode entities are found.
+func (klnq *KeyLogNodeQuery) Only(ctx context.Context) (*KeyLogNode, error) {
+	nodes, err := klnq.Limit(2).All(setContextOp(ctx, klnq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{keylognode.Label}
+	default:
+		return nil, &NotSingularError{keylognode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (klnq *KeyLogNodeQuery) OnlyX(ctx context.Context) *KeyLogNode {
+	node, err := klnq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KeyLogNode ID in the query.
+// Returns a *NotSingularError when more than one KeyLogNode ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (klnq *KeyLogNodeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = klnq.Limit(2).IDs(setContextOp(ctx, klnq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{keylognode.Label}
+	default:
+		err = &NotSingularError{keylognode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (klnq *KeyLogNodeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := klnq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KeyLogNodes.
+func (klnq *KeyLogNodeQuery) All(ctx context.Context) ([]*KeyLogNode, error) {
+	ctx = setContextOp(ctx, klnq.ctx, "All")
+	if err := klnq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KeyLogNode, *KeyLogNodeQuery]()
+	return withInterceptors[[]*KeyLogNode](ctx, klnq, qr, klnq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (klnq *KeyLogNodeQuery) AllX(ctx context.Context) []*KeyLogNode {
+	nodes, err := klnq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KeyLogNode IDs.
+func (klnq *KeyLogNodeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if klnq.ctx.Unique == nil && klnq.path != nil {
+		klnq.Unique(true)
+	}
+	ctx = setContextOp(ctx, klnq.ctx, "IDs")
+	if err = klnq.Select(keylognode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (klnq *KeyLogNodeQuery) IDsX(ctx context.Context) []int {
+	ids, err := klnq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (klnq *KeyLogNodeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, klnq.ctx, "Count")
+	if err := klnq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, klnq, querierCount[*KeyLogNodeQuery](), klnq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (klnq *KeyLogNodeQuery) CountX(ctx context.Context) int {
+	count, err := klnq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (klnq *KeyLogNodeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, klnq.ctx, "Exist")
+	switch _, err := klnq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (klnq *KeyLogNodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := klnq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KeyLogNodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (klnq *KeyLogNodeQuery) Clone() *KeyLogNodeQuery {
+	if klnq == nil {
+		return nil
+	}
+	return &KeyLogNodeQuery{
+		config:     klnq.config,
+		ctx:        klnq.ctx.Clone(),
+		order:      append([]keylognode.OrderOption{}, klnq.order...),
+		inters:     append([]Interceptor{}, klnq.inters...),
+		predicates: append([]predicate.KeyLogNode{}, klnq.predicates...),
+		// clone intermediate query.
+		sql:  klnq.sql.Clone(),
+		path: klnq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Level int `json:"level,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KeyLogNode.Query().
+//		GroupBy(keylognode.FieldLevel).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (klnq *KeyLogNodeQuery) GroupBy(field string, fields ...string) *KeyLogNodeGroupBy {
+	klnq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KeyLogNodeGroupBy{build: klnq}
+	grbuild.flds = &klnq.ctx.Fields
+	grbuild.label = keylognode.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Level int `json:"level,omitempty"`
+//	}
+//
+//	client.KeyLogNode.Query().
+//		Select(keylognode.FieldLevel).
+//		Scan(ctx, &v)
+func (klnq *KeyLogNodeQuery) Select(fields ...string) *KeyLogNodeSelect {
+	klnq.ctx.Fields = append(klnq.ctx.Fields, fields...)
+	sbuild := &KeyLogNodeSelect{KeyLogNodeQuery: klnq}
+	sbuild.label = keylognode.Label
+	sbuild.flds, sbuild.scan = &klnq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KeyLogNodeSelect configured with the given aggregations.
+func (klnq *KeyLogNodeQuery) Aggregate(fns ...AggregateFunc) *KeyLogNodeSelect {
+	return klnq.Select().Aggregate(fns...)
+}
+
+func (klnq *KeyLogNodeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range klnq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, klnq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range klnq.ctx.Fields {
+		if !keylognode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if klnq.path != nil {
+		prev, err := klnq.path(ctx)
+		if err != nil {
+			return err
+		}
+		klnq.sql = prev
+	}
+	return nil
+}
+
+func (klnq *KeyLogNodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KeyLogNode, error) {
+	var (
+		nodes = []*KeyLogNode{}
+		_spec = klnq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KeyLogNode).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KeyLogNode{config: klnq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, klnq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (klnq *KeyLogNodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := klnq.querySpec()
+	_spec.Node.Columns = klnq.ctx.Fields
+	if len(klnq.ctx.Fields) > 0 {
+		_spec.Unique = klnq.ctx.Unique != nil && *klnq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, klnq.driver, _spec)
+}
+
+func (klnq *KeyLogNodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(keylognode.Table, keylognode.Columns, sqlgraph.NewFieldSpec(keylognode.FieldID, field.TypeInt))
+	_spec.From = klnq.sql
+	if unique := klnq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if klnq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := klnq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keylognode.FieldID)
+		for i := range fields {
+			if fields[i] != keylognode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := klnq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := klnq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := klnq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := klnq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (klnq *KeyLogNodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(klnq.driver.Dialect())
+	t1 := builder.Table(keylognode.Table)
+	columns := klnq.ctx.Fields
+	if len(columns) == 0 {
+		columns = keylognode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if klnq.sql != nil {
+		selector = klnq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if klnq.ctx.Unique != nil && *klnq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range klnq.predicates {
+		p(selector)
+	}
+	for _, p := range klnq.order {
+		p(selector)
+	}
+	if offset := klnq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := klnq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// KeyLogNodeGroupBy is the group-by builder for KeyLogNode entities.
+type KeyLogNodeGroupBy struct {
+	selector
+	build *KeyLogNodeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (klngb *KeyLogNodeGroupBy) Aggregate(fns ...AggregateFunc) *KeyLogNodeGroupBy {
+	klngb.fns = append(klngb.fns, fns...)
+	return klngb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (klngb *KeyLogNodeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, klngb.build.ctx, "GroupBy")
+	if err := klngb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyLogNodeQuery, *KeyLogNodeGroupBy](ctx, klngb.build, klngb, klngb.build.inters, v)
+}
+
+func (klngb *KeyLogNodeGroupBy) sqlScan(ctx context.Context, root *KeyLogNodeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(klngb.fns))
+	for _, fn := range klngb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*klngb.flds)+len(klngb.fns))
+		for _, f := range *klngb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*klngb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := klngb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KeyLogNodeSelect is the builder for selecting fields of KeyLogNode entities.
+type KeyLogNodeSelect struct {
+	*KeyLogNodeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (klns *KeyLogNodeSelect) Aggregate(fns ...AggregateFunc) *KeyLogNodeSelect {
+	klns.fns = append(klns.fns, fns...)
+	return klns
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (klns *KeyLogNodeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, klns.ctx, "Select")
+	if err := klns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyLogNodeQuery, *KeyLogNodeSelect](ctx, klns.KeyLogNodeQuery, klns, klns.inters, v)
+}
+
+func (klns *KeyLogNodeSelect) sqlScan(ctx context.Context, root *KeyLogNodeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(klns.fns))
+	for _, fn := range klns.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*klns.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := klns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keylognode"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyLogNodeUpdate is the builder for updating KeyLogNode entities.
+type KeyLogNodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KeyLogNodeMutation
+}
+
+// Where appends a list predicates to the KeyLogNodeUpdate builder.
+func (klnu *KeyLogNodeUpdate) Where(ps ...predicate.KeyLogNode) *KeyLogNodeUpdate {
+	klnu.mutation.Where(ps...)
+	return klnu
+}
+
+// Mutation returns the KeyLogNodeMutation object of the builder.
+func (klnu *KeyLogNodeUpdate) Mutation() *KeyLogNodeMutation {
+	return klnu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (klnu *KeyLogNodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, klnu.sqlSave, klnu.mutation, klnu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (klnu *KeyLogNodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := klnu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (klnu *KeyLogNodeUpdate) Exec(ctx context.Context) error {
+	_, err := klnu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klnu *KeyLogNodeUpdate) ExecX(ctx context.Context) {
+	if err := klnu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (klnu *KeyLogNodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(keylognode.Table, keylognode.Columns, sqlgraph.NewFieldSpec(keylognode.FieldID, field.TypeInt))
+	if ps := klnu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, klnu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keylognode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	klnu.mutation.done = true
+	return n, nil
+}
+
+// KeyLogNodeUpdateOne is the builder for updating a single KeyLogNode entity.
+type KeyLogNodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KeyLogNodeMutation
+}
+
+// Mutation returns the KeyLogNodeMutation object of the builder.
+func (klnuo *KeyLogNodeUpdateOne) Mutation() *KeyLogNodeMutation {
+	return klnuo.mutation
+}
+
+// Where appends a list predicates to the KeyLogNodeUpdate builder.
+func (klnuo *KeyLogNodeUpdateOne) Where(ps ...predicate.KeyLogNode) *KeyLogNodeUpdateOne {
+	klnuo.mutation.Where(ps...)
+	return klnuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (klnuo *KeyLogNodeUpdateOne) Select(field string, fields ...string) *KeyLogNodeUpdateOne {
+	klnuo.fields = append([]string{field}, fields...)
+	return klnuo
+}
+
+// Save executes the query and returns the updated KeyLogNode entity.
+func (klnuo *KeyLogNodeUpdateOne) Save(ctx context.Context) (*KeyLogNode, error) {
+	return withHooks(ctx, klnuo.sqlSave, klnuo.mutation, klnuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (klnuo *KeyLogNodeUpdateOne) SaveX(ctx context.Context) *KeyLogNode {
+	node, err := klnuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (klnuo *KeyLogNodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := klnuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (klnuo *KeyLogNodeUpdateOne) ExecX(ctx context.Context) {
+	if err := klnuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (klnuo *KeyLogNodeUpdateOne) sqlSave(ctx context.Context) (_node *KeyLogNode, err error) {
+	_spec := sqlgraph.NewUpdateSpec(keylognode.Table, keylognode.Columns, sqlgraph.NewFieldSpec(keylognode.FieldID, field.TypeInt))
+	id, ok := klnuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KeyLogNode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := klnuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keylognode.FieldID)
+		for _, f := range fields {
+			if !keylognode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != keylognode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := klnuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &KeyLogNode{config: klnuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, klnuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keylognode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	klnuo.mutation.done = true
+	return _node, nil
+}
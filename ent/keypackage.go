@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/keypackage"
+)
+
+// KeyPackage is the model entity for the KeyPackage schema.
+type KeyPackage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// KeyPackage holds the value of the "key_package" field.
+	KeyPackage string `json:"key_package,omitempty"`
+	// CipherSuite holds the value of the "cipher_suite" field.
+	CipherSuite int `json:"cipher_suite,omitempty"`
+	// LastResort holds the value of the "last_resort" field.
+	LastResort bool `json:"last_resort,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the KeyPackageQuery when eager-loading is set.
+	Edges              KeyPackageEdges `json:"edges"`
+	key_package_device *int
+	selectValues       sql.SelectValues
+}
+
+// KeyPackageEdges holds the relations/edges for other nodes in the graph.
+type KeyPackageEdges struct {
+	// Device holds the value of the device edge.
+	Device *Device `json:"device,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DeviceOrErr returns the Device value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e KeyPackageEdges) DeviceOrErr() (*Device, error) {
+	if e.Device != nil {
+		return e.Device, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: device.Label}
+	}
+	return nil, &NotLoadedError{edge: "device"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KeyPackage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case keypackage.FieldLastResort:
+			values[i] = new(sql.NullBool)
+		case keypackage.FieldID, keypackage.FieldCipherSuite:
+			values[i] = new(sql.NullInt64)
+		case keypackage.FieldKeyPackage:
+			values[i] = new(sql.NullString)
+		case keypackage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case keypackage.ForeignKeys[0]: // key_package_device
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KeyPackage fields.
+func (kp *KeyPackage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case keypackage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			kp.ID = int(value.Int64)
+		case keypackage.FieldKeyPackage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_package", values[i])
+			} else if value.Valid {
+				kp.KeyPackage = value.String
+			}
+		case keypackage.FieldCipherSuite:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cipher_suite", values[i])
+			} else if value.Valid {
+				kp.CipherSuite = int(value.Int64)
+			}
+		case keypackage.FieldLastResort:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field last_resort", values[i])
+			} else if value.Valid {
+				kp.LastResort = value.Bool
+			}
+		case keypackage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				kp.CreatedAt = value.Time
+			}
+		case keypackage.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field key_package_device", value)
+			} else if value.Valid {
+				kp.key_package_device = new(int)
+				*kp.key_package_device = int(value.Int64)
+			}
+		default:
+			kp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KeyPackage.
+// This includes values selected through modifiers, order, etc.
+func (kp *KeyPackage) Value(name string) (ent.Value, error) {
+	return kp.selectValues.Get(name)
+}
+
+// QueryDevice queries the "device" edge of the KeyPackage entity.
+func (kp *KeyPackage) QueryDevice() *DeviceQuery {
+	return NewKeyPackageClient(kp.config).QueryDevice(kp)
+}
+
+// Update returns a builder for updating this KeyPackage.
+// Note that you need to call KeyPackage.Unwrap() before calling this method if this KeyPackage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (kp *KeyPackage) Update() *KeyPackageUpdateOne {
+	return NewKeyPackageClient(kp.config).UpdateOne(kp)
+}
+
+// Unwrap unwraps the KeyPackage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (kp *KeyPackage) Unwrap() *KeyPackage {
+	_tx, ok := kp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KeyPackage is not a transactional entity")
+	}
+	kp.config.driver = _tx.drv
+	return kp
+}
+
+// String implements the fmt.Stringer.
+func (kp *KeyPackage) String() string {
+	var builder strings.Builder
+	builder.WriteString("KeyPackage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", kp.ID))
+	builder.WriteString("key_package=")
+	builder.WriteString(kp.KeyPackage)
+	builder.WriteString(", ")
+	builder.WriteString("cipher_suite=")
+	builder.WriteString(fmt.Sprintf("%v", kp.CipherSuite))
+	builder.WriteString(", ")
+	builder.WriteString("last_resort=")
+	builder.WriteString(fmt.Sprintf("%v", kp.LastResort))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(kp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KeyPackages is a parsable slice of KeyPackage.
+type KeyPackages []*KeyPackage
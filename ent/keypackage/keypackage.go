@@ -0,0 +1,119 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keypackage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the keypackage type in the database.
+	Label = "key_package"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKeyPackage holds the string denoting the key_package field in the database.
+	FieldKeyPackage = "key_package"
+	// FieldCipherSuite holds the string denoting the cipher_suite field in the database.
+	FieldCipherSuite = "cipher_suite"
+	// FieldLastResort holds the string denoting the last_resort field in the database.
+	FieldLastResort = "last_resort"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeDevice holds the string denoting the device edge name in mutations.
+	EdgeDevice = "device"
+	// Table holds the table name of the keypackage in the database.
+	Table = "key_packages"
+	// DeviceTable is the table that holds the device relation/edge.
+	DeviceTable = "key_packages"
+	// DeviceInverseTable is the table name for the Device entity.
+	// It exists in this package in order to avoid circular dependency with the "device" package.
+	DeviceInverseTable = "devices"
+	// DeviceColumn is the table column denoting the device relation/edge.
+	DeviceColumn = "key_package_device"
+)
+
+// Columns holds all SQL columns for keypackage fields.
+var Columns = []string{
+	FieldID,
+	FieldKeyPackage,
+	FieldCipherSuite,
+	FieldLastResort,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "key_packages"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"key_package_device",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// KeyPackageValidator is a validator for the "key_package" field. It is called by the builders before save.
+	KeyPackageValidator func(string) error
+	// CipherSuiteValidator is a validator for the "cipher_suite" field. It is called by the builders before save.
+	CipherSuiteValidator func(int) error
+	// DefaultLastResort holds the default value on creation for the "last_resort" field.
+	DefaultLastResort bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the KeyPackage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKeyPackage orders the results by the key_package field.
+func ByKeyPackage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyPackage, opts...).ToFunc()
+}
+
+// ByCipherSuite orders the results by the cipher_suite field.
+func ByCipherSuite(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCipherSuite, opts...).ToFunc()
+}
+
+// ByLastResort orders the results by the last_resort field.
+func ByLastResort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastResort, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByDeviceField orders the results by device field.
+func ByDeviceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeviceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDeviceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeviceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, DeviceTable, DeviceColumn),
+	)
+}
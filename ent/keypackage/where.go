@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package keypackage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldLTE(FieldID, id))
+}
+
+// KeyPackage applies equality check predicate on the "key_package" field. It's identical to KeyPackageEQ.
+func KeyPackage(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldEQ(FieldKeyPackage, v))
+}
+
+// CipherSuite applies equality check predicate on the "cipher_suite" field. It's identical to CipherSuiteEQ.
+func CipherSuite(v int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldEQ(FieldCipherSuite, v))
+}
+
+// LastResort applies equality check predicate on the "last_resort" field. It's identical to LastResortEQ.
+func LastResort(v bool) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldEQ(FieldLastResort, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KeyPackageEQ applies the EQ predicate on the "key_package" field.
+func KeyPackageEQ(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldEQ(FieldKeyPackage, v))
+}
+
+// KeyPackageNEQ applies the NEQ predicate on the "key_package" field.
+func KeyPackageNEQ(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldNEQ(FieldKeyPackage, v))
+}
+
+// KeyPackageIn applies the In predicate on the "key_package" field.
+func KeyPackageIn(vs ...string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldIn(FieldKeyPackage, vs...))
+}
+
+// KeyPackageNotIn applies the NotIn predicate on the "key_package" field.
+func KeyPackageNotIn(vs ...string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldNotIn(FieldKeyPackage, vs...))
+}
+
+// KeyPackageGT applies the GT predicate on the "key_package" field.
+func KeyPackageGT(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldGT(FieldKeyPackage, v))
+}
+
+// KeyPackageGTE applies the GTE predicate on the "key_package" field.
+func KeyPackageGTE(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldGTE(FieldKeyPackage, v))
+}
+
+// KeyPackageLT applies the LT predicate on the "key_package" field.
+func KeyPackageLT(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldLT(FieldKeyPackage, v))
+}
+
+// KeyPackageLTE applies the LTE predicate on the "key_package" field.
+func KeyPackageLTE(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldLTE(FieldKeyPackage, v))
+}
+
+// KeyPackageContains applies the Contains predicate on the "key_package" field.
+func KeyPackageContains(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldContains(FieldKeyPackage, v))
+}
+
+// KeyPackageHasPrefix applies the HasPrefix predicate on the "key_package" field.
+func KeyPackageHasPrefix(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldHasPrefix(FieldKeyPackage, v))
+}
+
+// KeyPackageHasSuffix applies the HasSuffix predicate on the "key_package" field.
+func KeyPackageHasSuffix(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldHasSuffix(FieldKeyPackage, v))
+}
+
+// KeyPackageEqualFold applies the EqualFold predicate on the "key_package" field.
+func KeyPackageEqualFold(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldEqualFold(FieldKeyPackage, v))
+}
+
+// KeyPackageContainsFold applies the ContainsFold predicate on the "key_package" field.
+func KeyPackageContainsFold(v string) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldContainsFold(FieldKeyPackage, v))
+}
+
+// CipherSuiteEQ applies the EQ predicate on the "cipher_suite" field.
+func CipherSuiteEQ(v int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldEQ(FieldCipherSuite, v))
+}
+
+// CipherSuiteNEQ applies the NEQ predicate on the "cipher_suite" field.
+func CipherSuiteNEQ(v int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldNEQ(FieldCipherSuite, v))
+}
+
+// CipherSuiteIn applies the In predicate on the "cipher_suite" field.
+func CipherSuiteIn(vs ...int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldIn(FieldCipherSuite, vs...))
+}
+
+// CipherSuiteNotIn applies the NotIn predicate on the "cipher_suite" field.
+func CipherSuiteNotIn(vs ...int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldNotIn(FieldCipherSuite, vs...))
+}
+
+// CipherSuiteGT applies the GT predicate on the "cipher_suite" field.
+func CipherSuiteGT(v int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldGT(FieldCipherSuite, v))
+}
+
+// CipherSuiteGTE applies the GTE predicate on the "cipher_suite" field.
+func CipherSuiteGTE(v int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldGTE(FieldCipherSuite, v))
+}
+
+// CipherSuiteLT applies the LT predicate on the "cipher_suite" field.
+func CipherSuiteLT(v int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldLT(FieldCipherSuite, v))
+}
+
+// CipherSuiteLTE applies the LTE predicate on the "cipher_suite" field.
+func CipherSuiteLTE(v int) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldLTE(FieldCipherSuite, v))
+}
+
+// LastResortEQ applies the EQ predicate on the "last_resort" field.
+func LastResortEQ(v bool) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldEQ(FieldLastResort, v))
+}
+
+// LastResortNEQ applies the NEQ predicate on the "last_resort" field.
+func LastResortNEQ(v bool) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldNEQ(FieldLastResort, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasDevice applies the HasEdge predicate on the "device" edge.
+func HasDevice() predicate.KeyPackage {
+	return predicate.KeyPackage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, DeviceTable, DeviceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeviceWith applies the HasEdge predicate on the "device" edge with a given conditions (other predicates).
+func HasDeviceWith(preds ...predicate.Device) predicate.KeyPackage {
+	return predicate.KeyPackage(func(s *sql.Selector) {
+		step := newDeviceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KeyPackage) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KeyPackage) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KeyPackage) predicate.KeyPackage {
+	return predicate.KeyPackage(sql.NotPredicates(p))
+}
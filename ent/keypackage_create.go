@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/keypackage"
+)
+
+// KeyPackageCreate is the builder for creating a KeyPackage entity.
+type KeyPackageCreate struct {
+	config
+	mutation *KeyPackageMutation
+	hooks    []Hook
+}
+
+// SetKeyPackage sets the "key_package" field.
+func (kpc *KeyPackageCreate) SetKeyPackage(s string) *KeyPackageCreate {
+	kpc.mutation.SetKeyPackage(s)
+	return kpc
+}
+
+// SetCipherSuite sets the "cipher_suite" field.
+func (kpc *KeyPackageCreate) SetCipherSuite(i int) *KeyPackageCreate {
+	kpc.mutation.SetCipherSuite(i)
+	return kpc
+}
+
+// SetLastResort sets the "last_resort" field.
+func (kpc *KeyPackageCreate) SetLastResort(b bool) *KeyPackageCreate {
+	kpc.mutation.SetLastResort(b)
+	return kpc
+}
+
+// SetNillableLastResort sets the "last_resort" field if the given value is not nil.
+func (kpc *KeyPackageCreate) SetNillableLastResort(b *bool) *KeyPackageCreate {
+	if b != nil {
+		kpc.SetLastResort(*b)
+	}
+	return kpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (kpc *KeyPackageCreate) SetCreatedAt(t time.Time) *KeyPackageCreate {
+	kpc.mutation.SetCreatedAt(t)
+	return kpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (kpc *KeyPackageCreate) SetNillableCreatedAt(t *time.Time) *KeyPackageCreate {
+	if t != nil {
+		kpc.SetCreatedAt(*t)
+	}
+	return kpc
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (kpc *KeyPackageCreate) SetDeviceID(id int) *KeyPackageCreate {
+	kpc.mutation.SetDeviceID(id)
+	return kpc
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (kpc *KeyPackageCreate) SetDevice(d *Device) *KeyPackageCreate {
+	return kpc.SetDeviceID(d.ID)
+}
+
+// Mutation returns the KeyPackageMutation object of the builder.
+func (kpc *KeyPackageCreate) Mutation() *KeyPackageMutation {
+	return kpc.mutation
+}
+
+// Save creates the KeyPackage in the database.
+func (kpc *KeyPackageCreate) Save(ctx context.Context) (*KeyPackage, error) {
+	kpc.defaults()
+	return withHooks(ctx, kpc.sqlSave, kpc.mutation, kpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (kpc *KeyPackageCreate) SaveX(ctx context.Context) *KeyPackage {
+	v, err := kpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kpc *KeyPackageCreate) Exec(ctx context.Context) error {
+	_, err := kpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpc *KeyPackageCreate) ExecX(ctx context.Context) {
+	if err := kpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kpc *KeyPackageCreate) defaults() {
+	if _, ok := kpc.mutation.LastResort(); !ok {
+		v := keypackage.DefaultLastResort
+		kpc.mutation.SetLastResort(v)
+	}
+	if _, ok := kpc.mutation.CreatedAt(); !ok {
+		v := keypackage.DefaultCreatedAt()
+		kpc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kpc *KeyPackageCreate) check() error {
+	if _, ok := kpc.mutation.KeyPackage(); !ok {
+		return &ValidationError{Name: "key_package", err: errors.New(`ent: missing required field "KeyPackage.key_package"`)}
+	}
+	if v, ok := kpc.mutation.KeyPackage(); ok {
+		if err := keypackage.KeyPackageValidator(v); err != nil {
+			return &ValidationError{Name: "key_package", err: fmt.Errorf(`ent: validator failed for field "KeyPackage.key_package": %w`, err)}
+		}
+	}
+	if _, ok := kpc.mutation.CipherSuite(); !ok {
+		return &ValidationError{Name: "cipher_suite", err: errors.New(`ent: missing required field "KeyPackage.cipher_suite"`)}
+	}
+	if v, ok := kpc.mutation.CipherSuite(); ok {
+		if err := keypackage.CipherSuiteValidator(v); err != nil {
+			return &ValidationError{Name: "cipher_suite", err: fmt.Errorf(`ent: validator failed for field "KeyPackage.cipher_suite": %w`, err)}
+		}
+	}
+	if _, ok := kpc.mutation.LastResort(); !ok {
+		return &ValidationError{Name: "last_resort", err: errors.New(`ent: missing required field "KeyPackage.last_resort"`)}
+	}
+	if _, ok := kpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "KeyPackage.created_at"`)}
+	}
+	if _, ok := kpc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`ent: missing required edge "KeyPackage.device"`)}
+	}
+	return nil
+}
+
+func (kpc *KeyPackageCreate) sqlSave(ctx context.Context) (*KeyPackage, error) {
+	if err := kpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := kpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, kpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	kpc.mutation.id = &_node.ID
+	kpc.mutation.done = true
+	return _node, nil
+}
+
+func (kpc *KeyPackageCreate) createSpec() (*KeyPackage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KeyPackage{config: kpc.config}
+		_spec = sqlgraph.NewCreateSpec(keypackage.Table, sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt))
+	)
+	if value, ok := kpc.mutation.KeyPackage(); ok {
+		_spec.SetField(keypackage.FieldKeyPackage, field.TypeString, value)
+		_node.KeyPackage = value
+	}
+	if value, ok := kpc.mutation.CipherSuite(); ok {
+		_spec.SetField(keypackage.FieldCipherSuite, field.TypeInt, value)
+		_node.CipherSuite = value
+	}
+	if value, ok := kpc.mutation.LastResort(); ok {
+		_spec.SetField(keypackage.FieldLastResort, field.TypeBool, value)
+		_node.LastResort = value
+	}
+	if value, ok := kpc.mutation.CreatedAt(); ok {
+		_spec.SetField(keypackage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := kpc.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   keypackage.DeviceTable,
+			Columns: []string{keypackage.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.key_package_device = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// KeyPackageCreateBulk is the builder for creating many KeyPackage entities in bulk.
+type KeyPackageCreateBulk struct {
+	config
+	err      error
+	builders []*KeyPackageCreate
+}
+
+// Save creates the KeyPackage entities in the database.
+func (kpcb *KeyPackageCreateBulk) Save(ctx context.Context) ([]*KeyPackage, error) {
+	if kpcb.err != nil {
+		return nil, kpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(kpcb.builders))
+	nodes := make([]*KeyPackage, len(kpcb.builders))
+	mutators := make([]Mutator, len(kpcb.builders))
+	for i := range kpcb.builders {
+		func(i int, root context.Context) {
+			builder := kpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KeyPackageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, kpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, kpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, kpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kpcb *KeyPackageCreateBulk) SaveX(ctx context.Context) []*KeyPackage {
+	v, err := kpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kpcb *KeyPackageCreateBulk) Exec(ctx context.Context) error {
+	_, err := kpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpcb *KeyPackageCreateBulk) ExecX(ctx context.Context) {
+	if err := kpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
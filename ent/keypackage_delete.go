@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyPackageDelete is the builder for deleting a KeyPackage entity.
+type KeyPackageDelete struct {
+	config
+	hooks    []Hook
+	mutation *KeyPackageMutation
+}
+
+// Where appends a list predicates to the KeyPackageDelete builder.
+func (kpd *KeyPackageDelete) Where(ps ...predicate.KeyPackage) *KeyPackageDelete {
+	kpd.mutation.Where(ps...)
+	return kpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (kpd *KeyPackageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, kpd.sqlExec, kpd.mutation, kpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpd *KeyPackageDelete) ExecX(ctx context.Context) int {
+	n, err := kpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (kpd *KeyPackageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(keypackage.Table, sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt))
+	if ps := kpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, kpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	kpd.mutation.done = true
+	return affected, err
+}
+
+// KeyPackageDeleteOne is the builder for deleting a single KeyPackage entity.
+type KeyPackageDeleteOne struct {
+	kpd *KeyPackageDelete
+}
+
+// Where appends a list predicates to the KeyPackageDelete builder.
+func (kpdo *KeyPackageDeleteOne) Where(ps ...predicate.KeyPackage) *KeyPackageDeleteOne {
+	kpdo.kpd.mutation.Where(ps...)
+	return kpdo
+}
+
+// Exec executes the deletion query.
+func (kpdo *KeyPackageDeleteOne) Exec(ctx context.Context) error {
+	n, err := kpdo.kpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{keypackage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpdo *KeyPackageDeleteOne) ExecX(ctx context.Context) {
+	if err := kpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyPackageQuery is the builder for querying KeyPackage entities.
+type KeyPackageQuery struct {
+	config
+	ctx        *QueryContext
+	order      []keypackage.OrderOption
+	inters     []Interceptor
+	predicates []predicate.KeyPackage
+	withDevice *DeviceQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KeyPackageQuery builder.
+func (kpq *KeyPackageQuery) Where(ps ...predicate.KeyPackage) *KeyPackageQuery {
+	kpq.predicates = append(kpq.predicates, ps...)
+	return kpq
+}
+
+// Limit the number of records to be returned by this query.
+func (kpq *KeyPackageQuery) Limit(limit int) *KeyPackageQuery {
+	kpq.ctx.Limit = &limit
+	return kpq
+}
+
+// Offset to start from.
+func (kpq *KeyPackageQuery) Offset(offset int) *KeyPackageQuery {
+	kpq.ctx.Offset = &offset
+	return kpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (kpq *KeyPackageQuery) Unique(unique bool) *KeyPackageQuery {
+	kpq.ctx.Unique = &unique
+	return kpq
+}
+
+// Order specifies how the records should be ordered.
+func (kpq *KeyPackageQuery) Order(o ...keypackage.OrderOption) *KeyPackageQuery {
+	kpq.order = append(kpq.order, o...)
+	return kpq
+}
+
+// QueryDevice chains the current query on the "device" edge.
+func (kpq *KeyPackageQuery) QueryDevice() *DeviceQuery {
+	query := (&DeviceClient{config: kpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := kpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := kpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(keypackage.Table, keypackage.FieldID, selector),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, keypackage.DeviceTable, keypackage.DeviceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(kpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first KeyPackage entity from the query.
+// Returns a *NotFoundError when no KeyPackage was found.
+func (kpq *KeyPackageQuery) First(ctx context.Context) (*KeyPackage, error) {
+	nodes, err := kpq.Limit(1).All(setContextOp(ctx, kpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{keypackage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (kpq *KeyPackageQuery) FirstX(ctx context.Context) *KeyPackage {
+	node, err := kpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KeyPackage ID from the query.
+// Returns a *NotFoundError when no KeyPackage ID was found.
+func (kpq *KeyPackageQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kpq.Limit(1).IDs(setContextOp(ctx, kpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{keypackage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (kpq *KeyPackageQuery) FirstIDX(ctx context.Context) int {
+	id, err := kpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KeyPackage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KeyPackage entity is found.
+// Returns a *NotFoundError when no KeyPackage entities are found.
+func (kpq *KeyPackageQuery) Only(ctx context.Context) (*KeyPackage, error) {
+	nodes, err := kpq.Limit(2).All(setContextOp(ctx, kpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{keypackage.Label}
+	default:
+		return nil, &NotSingularError{keypackage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (kpq *KeyPackageQuery) OnlyX(ctx context.Context) *KeyPackage {
+	node, err := kpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KeyPackage ID in the query.
+// Returns a *NotSingularError when more than one KeyPackage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (kpq *KeyPackageQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kpq.Limit(2).IDs(setContextOp(ctx, kpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{keypackage.Label}
+	default:
+		err = &NotSingularError{keypackage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (kpq *KeyPackageQuery) OnlyIDX(ctx context.Context) int {
+	id, err := kpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KeyPackages.
+func (kpq *KeyPackageQuery) All(ctx context.Context) ([]*KeyPackage, error) {
+	ctx = setContextOp(ctx, kpq.ctx, "All")
+	if err := kpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KeyPackage, *KeyPackageQuery]()
+	return withInterceptors[[]*KeyPackage](ctx, kpq, qr, kpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (kpq *KeyPackageQuery) AllX(ctx context.Context) []*KeyPackage {
+	nodes, err := kpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KeyPackage IDs.
+func (kpq *KeyPackageQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if kpq.ctx.Unique == nil && kpq.path != nil {
+		kpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, kpq.ctx, "IDs")
+	if err = kpq.Select(keypackage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (kpq *KeyPackageQuery) IDsX(ctx context.Context) []int {
+	ids, err := kpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (kpq *KeyPackageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, kpq.ctx, "Count")
+	if err := kpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, kpq, querierCount[*KeyPackageQuery](), kpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (kpq *KeyPackageQuery) CountX(ctx context.Context) int {
+	count, err := kpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (kpq *KeyPackageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, kpq.ctx, "Exist")
+	switch _, err := kpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (kpq *KeyPackageQuery) ExistX(ctx context.Context) bool {
+	exist, err := kpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KeyPackageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (kpq *KeyPackageQuery) Clone() *KeyPackageQuery {
+	if kpq == nil {
+		return nil
+	}
+	return &KeyPackageQuery{
+		config:     kpq.config,
+		ctx:        kpq.ctx.Clone(),
+		order:      append([]keypackage.OrderOption{}, kpq.order...),
+		inters:     append([]Interceptor{}, kpq.inters...),
+		predicates: append([]predicate.KeyPackage{}, kpq.predicates...),
+		withDevice: kpq.withDevice.Clone(),
+		// clone intermediate query.
+		sql:  kpq.sql.Clone(),
+		path: kpq.path,
+	}
+}
+
+// WithDevice tells the query-builder to eager-load the nodes that are connected to
+// the "device" edge. The optional arguments are used to configure the query builder of the edge.
+func (kpq *KeyPackageQuery) WithDevice(opts ...func(*DeviceQuery)) *KeyPackageQuery {
+	query := (&DeviceClient{config: kpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	kpq.withDevice = query
+	return kpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		KeyPackage string `json:"key_package,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KeyPackage.Query().
+//		GroupBy(keypackage.FieldKeyPackage).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (kpq *KeyPackageQuery) GroupBy(field string, fields ...string) *KeyPackageGroupBy {
+	kpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KeyPackageGroupBy{build: kpq}
+	grbuild.flds = &kpq.ctx.Fields
+	grbuild.label = keypackage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		KeyPackage string `json:"key_package,omitempty"`
+//	}
+//
+//	client.KeyPackage.Query().
+//		Select(keypackage.FieldKeyPackage).
+//		Scan(ctx, &v)
+func (kpq *KeyPackageQuery) Select(fields ...string) *KeyPackageSelect {
+	kpq.ctx.Fields = append(kpq.ctx.Fields, fields...)
+	sbuild := &KeyPackageSelect{KeyPackageQuery: kpq}
+	sbuild.label = keypackage.Label
+	sbuild.flds, sbuild.scan = &kpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KeyPackageSelect configured with the given aggregations.
+func (kpq *KeyPackageQuery) Aggregate(fns ...AggregateFunc) *KeyPackageSelect {
+	return kpq.Select().Aggregate(fns...)
+}
+
+func (kpq *KeyPackageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range kpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, kpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range kpq.ctx.Fields {
+		if !keypackage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if kpq.path != nil {
+		prev, err := kpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		kpq.sql = prev
+	}
+	return nil
+}
+
+func (kpq *KeyPackageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KeyPackage, error) {
+	var (
+		nodes       = []*KeyPackage{}
+		withFKs     = kpq.withFKs
+		_spec       = kpq.querySpec()
+		loadedTypes = [1]bool{
+			kpq.withDevice != nil,
+		}
+	)
+	if kpq.withDevice != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, keypackage.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KeyPackage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KeyPackage{config: kpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, kpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := kpq.withDevice; query != nil {
+		if err := kpq.loadDevice(ctx, query, nodes, nil,
+			func(n *KeyPackage, e *Device) { n.Edges.Device = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (kpq *KeyPackageQuery) loadDevice(ctx context.Context, query *DeviceQuery, nodes []*KeyPackage, init func(*KeyPackage), assign func(*KeyPackage, *Device)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*KeyPackage)
+	for i := range nodes {
+		if nodes[i].key_package_device == nil {
+			continue
+		}
+		fk := *nodes[i].key_package_device
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(device.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "key_package_device" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (kpq *KeyPackageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := kpq.querySpec()
+	_spec.Node.Columns = kpq.ctx.Fields
+	if len(kpq.ctx.Fields) > 0 {
+		_spec.Unique = kpq.ctx.Unique != nil && *kpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, kpq.driver, _spec)
+}
+
+func (kpq *KeyPackageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(keypackage.Table, keypackage.Columns, sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt))
+	_spec.From = kpq.sql
+	if unique := kpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if kpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := kpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keypackage.FieldID)
+		for i := range fields {
+			if fields[i] != keypackage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := kpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := kpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := kpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := kpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (kpq *KeyPackageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(kpq.driver.Dialect())
+	t1 := builder.Table(keypackage.Table)
+	columns := kpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = keypackage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if kpq.sql != nil {
+		selector = kpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if kpq.ctx.Unique != nil && *kpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range kpq.predicates {
+		p(selector)
+	}
+	for _, p := range kpq.order {
+		p(selector)
+	}
+	if offset := kpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := kpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// KeyPackageGroupBy is the group-by builder for KeyPackage entities.
+type KeyPackageGroupBy struct {
+	selector
+	build *KeyPackageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (kpgb *KeyPackageGroupBy) Aggregate(fns ...AggregateFunc) *KeyPackageGroupBy {
+	kpgb.fns = append(kpgb.fns, fns...)
+	return kpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kpgb *KeyPackageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kpgb.build.ctx, "GroupBy")
+	if err := kpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyPackageQuery, *KeyPackageGroupBy](ctx, kpgb.build, kpgb, kpgb.build.inters, v)
+}
+
+func (kpgb *KeyPackageGroupBy) sqlScan(ctx context.Context, root *KeyPackageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(kpgb.fns))
+	for _, fn := range kpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*kpgb.flds)+len(kpgb.fns))
+		for _, f := range *kpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*kpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KeyPackageSelect is the builder for selecting fields of KeyPackage entities.
+type KeyPackageSelect struct {
+	*KeyPackageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (kps *KeyPackageSelect) Aggregate(fns ...AggregateFunc) *KeyPackageSelect {
+	kps.fns = append(kps.fns, fns...)
+	return kps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kps *KeyPackageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kps.ctx, "Select")
+	if err := kps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KeyPackageQuery, *KeyPackageSelect](ctx, kps.KeyPackageQuery, kps, kps.inters, v)
+}
+
+func (kps *KeyPackageSelect) sqlScan(ctx context.Context, root *KeyPackageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(kps.fns))
+	for _, fn := range kps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*kps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,467 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// KeyPackageUpdate is the builder for updating KeyPackage entities.
+type KeyPackageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KeyPackageMutation
+}
+
+// Where appends a list predicates to the KeyPackageUpdate builder.
+func (kpu *KeyPackageUpdate) Where(ps ...predicate.KeyPackage) *KeyPackageUpdate {
+	kpu.mutation.Where(ps...)
+	return kpu
+}
+
+// SetKeyPackage sets the "key_package" field.
+func (kpu *KeyPackageUpdate) SetKeyPackage(s string) *KeyPackageUpdate {
+	kpu.mutation.SetKeyPackage(s)
+	return kpu
+}
+
+// SetNillableKeyPackage sets the "key_package" field if the given value is not nil.
+func (kpu *KeyPackageUpdate) SetNillableKeyPackage(s *string) *KeyPackageUpdate {
+	if s != nil {
+		kpu.SetKeyPackage(*s)
+	}
+	return kpu
+}
+
+// SetCipherSuite sets the "cipher_suite" field.
+func (kpu *KeyPackageUpdate) SetCipherSuite(i int) *KeyPackageUpdate {
+	kpu.mutation.ResetCipherSuite()
+	kpu.mutation.SetCipherSuite(i)
+	return kpu
+}
+
+// SetNillableCipherSuite sets the "cipher_suite" field if the given value is not nil.
+func (kpu *KeyPackageUpdate) SetNillableCipherSuite(i *int) *KeyPackageUpdate {
+	if i != nil {
+		kpu.SetCipherSuite(*i)
+	}
+	return kpu
+}
+
+// AddCipherSuite adds i to the "cipher_suite" field.
+func (kpu *KeyPackageUpdate) AddCipherSuite(i int) *KeyPackageUpdate {
+	kpu.mutation.AddCipherSuite(i)
+	return kpu
+}
+
+// SetLastResort sets the "last_resort" field.
+func (kpu *KeyPackageUpdate) SetLastResort(b bool) *KeyPackageUpdate {
+	kpu.mutation.SetLastResort(b)
+	return kpu
+}
+
+// SetNillableLastResort sets the "last_resort" field if the given value is not nil.
+func (kpu *KeyPackageUpdate) SetNillableLastResort(b *bool) *KeyPackageUpdate {
+	if b != nil {
+		kpu.SetLastResort(*b)
+	}
+	return kpu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (kpu *KeyPackageUpdate) SetCreatedAt(t time.Time) *KeyPackageUpdate {
+	kpu.mutation.SetCreatedAt(t)
+	return kpu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (kpu *KeyPackageUpdate) SetNillableCreatedAt(t *time.Time) *KeyPackageUpdate {
+	if t != nil {
+		kpu.SetCreatedAt(*t)
+	}
+	return kpu
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (kpu *KeyPackageUpdate) SetDeviceID(id int) *KeyPackageUpdate {
+	kpu.mutation.SetDeviceID(id)
+	return kpu
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (kpu *KeyPackageUpdate) SetDevice(d *Device) *KeyPackageUpdate {
+	return kpu.SetDeviceID(d.ID)
+}
+
+// Mutation returns the KeyPackageMutation object of the builder.
+func (kpu *KeyPackageUpdate) Mutation() *KeyPackageMutation {
+	return kpu.mutation
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (kpu *KeyPackageUpdate) ClearDevice() *KeyPackageUpdate {
+	kpu.mutation.ClearDevice()
+	return kpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (kpu *KeyPackageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, kpu.sqlSave, kpu.mutation, kpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kpu *KeyPackageUpdate) SaveX(ctx context.Context) int {
+	affected, err := kpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (kpu *KeyPackageUpdate) Exec(ctx context.Context) error {
+	_, err := kpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpu *KeyPackageUpdate) ExecX(ctx context.Context) {
+	if err := kpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kpu *KeyPackageUpdate) check() error {
+	if v, ok := kpu.mutation.KeyPackage(); ok {
+		if err := keypackage.KeyPackageValidator(v); err != nil {
+			return &ValidationError{Name: "key_package", err: fmt.Errorf(`ent: validator failed for field "KeyPackage.key_package": %w`, err)}
+		}
+	}
+	if v, ok := kpu.mutation.CipherSuite(); ok {
+		if err := keypackage.CipherSuiteValidator(v); err != nil {
+			return &ValidationError{Name: "cipher_suite", err: fmt.Errorf(`ent: validator failed for field "KeyPackage.cipher_suite": %w`, err)}
+		}
+	}
+	if _, ok := kpu.mutation.DeviceID(); kpu.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "KeyPackage.device"`)
+	}
+	return nil
+}
+
+func (kpu *KeyPackageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := kpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keypackage.Table, keypackage.Columns, sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt))
+	if ps := kpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kpu.mutation.KeyPackage(); ok {
+		_spec.SetField(keypackage.FieldKeyPackage, field.TypeString, value)
+	}
+	if value, ok := kpu.mutation.CipherSuite(); ok {
+		_spec.SetField(keypackage.FieldCipherSuite, field.TypeInt, value)
+	}
+	if value, ok := kpu.mutation.AddedCipherSuite(); ok {
+		_spec.AddField(keypackage.FieldCipherSuite, field.TypeInt, value)
+	}
+	if value, ok := kpu.mutation.LastResort(); ok {
+		_spec.SetField(keypackage.FieldLastResort, field.TypeBool, value)
+	}
+	if value, ok := kpu.mutation.CreatedAt(); ok {
+		_spec.SetField(keypackage.FieldCreatedAt, field.TypeTime, value)
+	}
+	if kpu.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   keypackage.DeviceTable,
+			Columns: []string{keypackage.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kpu.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   keypackage.DeviceTable,
+			Columns: []string{keypackage.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, kpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keypackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	kpu.mutation.done = true
+	return n, nil
+}
+
+// KeyPackageUpdateOne is the builder for updating a single KeyPackage entity.
+type KeyPackageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KeyPackageMutation
+}
+
+// SetKeyPackage sets the "key_package" field.
+func (kpuo *KeyPackageUpdateOne) SetKeyPackage(s string) *KeyPackageUpdateOne {
+	kpuo.mutation.SetKeyPackage(s)
+	return kpuo
+}
+
+// SetNillableKeyPackage sets the "key_package" field if the given value is not nil.
+func (kpuo *KeyPackageUpdateOne) SetNillableKeyPackage(s *string) *KeyPackageUpdateOne {
+	if s != nil {
+		kpuo.SetKeyPackage(*s)
+	}
+	return kpuo
+}
+
+// SetCipherSuite sets the "cipher_suite" field.
+func (kpuo *KeyPackageUpdateOne) SetCipherSuite(i int) *KeyPackageUpdateOne {
+	kpuo.mutation.ResetCipherSuite()
+	kpuo.mutation.SetCipherSuite(i)
+	return kpuo
+}
+
+// SetNillableCipherSuite sets the "cipher_suite" field if the given value is not nil.
+func (kpuo *KeyPackageUpdateOne) SetNillableCipherSuite(i *int) *KeyPackageUpdateOne {
+	if i != nil {
+		kpuo.SetCipherSuite(*i)
+	}
+	return kpuo
+}
+
+// AddCipherSuite adds i to the "cipher_suite" field.
+func (kpuo *KeyPackageUpdateOne) AddCipherSuite(i int) *KeyPackageUpdateOne {
+	kpuo.mutation.AddCipherSuite(i)
+	return kpuo
+}
+
+// SetLastResort sets the "last_resort" field.
+func (kpuo *KeyPackageUpdateOne) SetLastResort(b bool) *KeyPackageUpdateOne {
+	kpuo.mutation.SetLastResort(b)
+	return kpuo
+}
+
+// SetNillableLastResort sets the "last_resort" field if the given value is not nil.
+func (kpuo *KeyPackageUpdateOne) SetNillableLastResort(b *bool) *KeyPackageUpdateOne {
+	if b != nil {
+		kpuo.SetLastResort(*b)
+	}
+	return kpuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (kpuo *KeyPackageUpdateOne) SetCreatedAt(t time.Time) *KeyPackageUpdateOne {
+	kpuo.mutation.SetCreatedAt(t)
+	return kpuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (kpuo *KeyPackageUpdateOne) SetNillableCreatedAt(t *time.Time) *KeyPackageUpdateOne {
+	if t != nil {
+		kpuo.SetCreatedAt(*t)
+	}
+	return kpuo
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (kpuo *KeyPackageUpdateOne) SetDeviceID(id int) *KeyPackageUpdateOne {
+	kpuo.mutation.SetDeviceID(id)
+	return kpuo
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (kpuo *KeyPackageUpdateOne) SetDevice(d *Device) *KeyPackageUpdateOne {
+	return kpuo.SetDeviceID(d.ID)
+}
+
+// Mutation returns the KeyPackageMutation object of the builder.
+func (kpuo *KeyPackageUpdateOne) Mutation() *KeyPackageMutation {
+	return kpuo.mutation
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (kpuo *KeyPackageUpdateOne) ClearDevice() *KeyPackageUpdateOne {
+	kpuo.mutation.ClearDevice()
+	return kpuo
+}
+
+// Where appends a list predicates to the KeyPackageUpdate builder.
+func (kpuo *KeyPackageUpdateOne) Where(ps ...predicate.KeyPackage) *KeyPackageUpdateOne {
+	kpuo.mutation.Where(ps...)
+	return kpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (kpuo *KeyPackageUpdateOne) Select(field string, fields ...string) *KeyPackageUpdateOne {
+	kpuo.fields = append([]string{field}, fields...)
+	return kpuo
+}
+
+// Save executes the query and returns the updated KeyPackage entity.
+func (kpuo *KeyPackageUpdateOne) Save(ctx context.Context) (*KeyPackage, error) {
+	return withHooks(ctx, kpuo.sqlSave, kpuo.mutation, kpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kpuo *KeyPackageUpdateOne) SaveX(ctx context.Context) *KeyPackage {
+	node, err := kpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (kpuo *KeyPackageUpdateOne) Exec(ctx context.Context) error {
+	_, err := kpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpuo *KeyPackageUpdateOne) ExecX(ctx context.Context) {
+	if err := kpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kpuo *KeyPackageUpdateOne) check() error {
+	if v, ok := kpuo.mutation.KeyPackage(); ok {
+		if err := keypackage.KeyPackageValidator(v); err != nil {
+			return &ValidationError{Name: "key_package", err: fmt.Errorf(`ent: validator failed for field "KeyPackage.key_package": %w`, err)}
+		}
+	}
+	if v, ok := kpuo.mutation.CipherSuite(); ok {
+		if err := keypackage.CipherSuiteValidator(v); err != nil {
+			return &ValidationError{Name: "cipher_suite", err: fmt.Errorf(`ent: validator failed for field "KeyPackage.cipher_suite": %w`, err)}
+		}
+	}
+	if _, ok := kpuo.mutation.DeviceID(); kpuo.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "KeyPackage.device"`)
+	}
+	return nil
+}
+
+func (kpuo *KeyPackageUpdateOne) sqlSave(ctx context.Context) (_node *KeyPackage, err error) {
+	if err := kpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(keypackage.Table, keypackage.Columns, sqlgraph.NewFieldSpec(keypackage.FieldID, field.TypeInt))
+	id, ok := kpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KeyPackage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := kpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, keypackage.FieldID)
+		for _, f := range fields {
+			if !keypackage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != keypackage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := kpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kpuo.mutation.KeyPackage(); ok {
+		_spec.SetField(keypackage.FieldKeyPackage, field.TypeString, value)
+	}
+	if value, ok := kpuo.mutation.CipherSuite(); ok {
+		_spec.SetField(keypackage.FieldCipherSuite, field.TypeInt, value)
+	}
+	if value, ok := kpuo.mutation.AddedCipherSuite(); ok {
+		_spec.AddField(keypackage.FieldCipherSuite, field.TypeInt, value)
+	}
+	if value, ok := kpuo.mutation.LastResort(); ok {
+		_spec.SetField(keypackage.FieldLastResort, field.TypeBool, value)
+	}
+	if value, ok := kpuo.mutation.CreatedAt(); ok {
+		_spec.SetField(keypackage.FieldCreatedAt, field.TypeTime, value)
+	}
+	if kpuo.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   keypackage.DeviceTable,
+			Columns: []string{keypackage.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kpuo.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   keypackage.DeviceTable,
+			Columns: []string{keypackage.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &KeyPackage{config: kpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, kpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{keypackage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	kpuo.mutation.done = true
+	return _node, nil
+}
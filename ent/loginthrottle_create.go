@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/loginthrottle"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// LoginThrottleCreate is the builder for creating a LoginThrottle entity.
+type LoginThrottleCreate struct {
+	config
+	mutation *LoginThrottleMutation
+	hooks    []Hook
+}
+
+// SetScope sets the "scope" field.
+func (ltc *LoginThrottleCreate) SetScope(l loginthrottle.Scope) *LoginThrottleCreate {
+	ltc.mutation.SetScope(l)
+	return ltc
+}
+
+// SetKey sets the "key" field.
+func (ltc *LoginThrottleCreate) SetKey(s string) *LoginThrottleCreate {
+	ltc.mutation.SetKey(s)
+	return ltc
+}
+
+// SetFailures sets the "failures" field.
+func (ltc *LoginThrottleCreate) SetFailures(i int) *LoginThrottleCreate {
+	ltc.mutation.SetFailures(i)
+	return ltc
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (ltc *LoginThrottleCreate) SetNillableFailures(i *int) *LoginThrottleCreate {
+	if i != nil {
+		ltc.SetFailures(*i)
+	}
+	return ltc
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (ltc *LoginThrottleCreate) SetLastFailureAt(t time.Time) *LoginThrottleCreate {
+	ltc.mutation.SetLastFailureAt(t)
+	return ltc
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (ltc *LoginThrottleCreate) SetRetryAt(t time.Time) *LoginThrottleCreate {
+	ltc.mutation.SetRetryAt(t)
+	return ltc
+}
+
+// SetNillableRetryAt sets the "retry_at" field if the given value is not nil.
+func (ltc *LoginThrottleCreate) SetNillableRetryAt(t *time.Time) *LoginThrottleCreate {
+	if t != nil {
+		ltc.SetRetryAt(*t)
+	}
+	return ltc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (ltc *LoginThrottleCreate) SetLockedUntil(t time.Time) *LoginThrottleCreate {
+	ltc.mutation.SetLockedUntil(t)
+	return ltc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ltc *LoginThrottleCreate) SetNillableLockedUntil(t *time.Time) *LoginThrottleCreate {
+	if t != nil {
+		ltc.SetLockedUntil(*t)
+	}
+	return ltc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ltc *LoginThrottleCreate) SetUserID(id int) *LoginThrottleCreate {
+	ltc.mutation.SetUserID(id)
+	return ltc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (ltc *LoginThrottleCreate) SetNillableUserID(id *int) *LoginThrottleCreate {
+	if id != nil {
+		ltc = ltc.SetUserID(*id)
+	}
+	return ltc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ltc *LoginThrottleCreate) SetUser(u *User) *LoginThrottleCreate {
+	return ltc.SetUserID(u.ID)
+}
+
+// Mutation returns the LoginThrottleMutation object of the builder.
+func (ltc *LoginThrottleCreate) Mutation() *LoginThrottleMutation {
+	return ltc.mutation
+}
+
+// Save creates the LoginThrottle in the database.
+func (ltc *LoginThrottleCreate) Save(ctx context.Context) (*LoginThrottle, error) {
+	ltc.defaults()
+	return withHooks(ctx, ltc.sqlSave, ltc.mutation, ltc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ltc *LoginThrottleCreate) SaveX(ctx context.Context) *LoginThrottle {
+	v, err := ltc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ltc *LoginThrottleCreate) Exec(ctx context.Context) error {
+	_, err := ltc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltc *LoginThrottleCreate) ExecX(ctx context.Context) {
+	if err := ltc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ltc *LoginThrottleCreate) defaults() {
+	if _, ok := ltc.mutation.Failures(); !ok {
+		v := loginthrottle.DefaultFailures
+		ltc.mutation.SetFailures(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ltc *LoginThrottleCreate) check() error {
+	if _, ok := ltc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "LoginThrottle.scope"`)}
+	}
+	if v, ok := ltc.mutation.Scope(); ok {
+		if err := loginthrottle.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.scope": %w`, err)}
+		}
+	}
+	if _, ok := ltc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "LoginThrottle.key"`)}
+	}
+	if v, ok := ltc.mutation.Key(); ok {
+		if err := loginthrottle.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.key": %w`, err)}
+		}
+	}
+	if _, ok := ltc.mutation.Failures(); !ok {
+		return &ValidationError{Name: "failures", err: errors.New(`ent: missing required field "LoginThrottle.failures"`)}
+	}
+	if _, ok := ltc.mutation.LastFailureAt(); !ok {
+		return &ValidationError{Name: "last_failure_at", err: errors.New(`ent: missing required field "LoginThrottle.last_failure_at"`)}
+	}
+	return nil
+}
+
+func (ltc *LoginThrottleCreate) sqlSave(ctx context.Context) (*LoginThrottle, error) {
+	if err := ltc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ltc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ltc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ltc.mutation.id = &_node.ID
+	ltc.mutation.done = true
+	return _node, nil
+}
+
+func (ltc *LoginThrottleCreate) createSpec() (*LoginThrottle, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginThrottle{config: ltc.config}
+		_spec = sqlgraph.NewCreateSpec(loginthrottle.Table, sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeInt))
+	)
+	if value, ok := ltc.mutation.Scope(); ok {
+		_spec.SetField(loginthrottle.FieldScope, field.TypeEnum, value)
+		_node.Scope = value
+	}
+	if value, ok := ltc.mutation.Key(); ok {
+		_spec.SetField(loginthrottle.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := ltc.mutation.Failures(); ok {
+		_spec.SetField(loginthrottle.FieldFailures, field.TypeInt, value)
+		_node.Failures = value
+	}
+	if value, ok := ltc.mutation.LastFailureAt(); ok {
+		_spec.SetField(loginthrottle.FieldLastFailureAt, field.TypeTime, value)
+		_node.LastFailureAt = value
+	}
+	if value, ok := ltc.mutation.RetryAt(); ok {
+		_spec.SetField(loginthrottle.FieldRetryAt, field.TypeTime, value)
+		_node.RetryAt = &value
+	}
+	if value, ok := ltc.mutation.LockedUntil(); ok {
+		_spec.SetField(loginthrottle.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if nodes := ltc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   loginthrottle.UserTable,
+			Columns: []string{loginthrottle.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.login_throttle_user = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LoginThrottleCreateBulk is the builder for creating many LoginThrottle entities in bulk.
+type LoginThrottleCreateBulk struct {
+	config
+	err      error
+	builders []*LoginThrottleCreate
+}
+
+// Save creates the LoginThrottle entities in the database.
+func (ltcb *LoginThrottleCreateBulk) Save(ctx context.Context) ([]*LoginThrottle, error) {
+	if ltcb.err != nil {
+		return nil, ltcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ltcb.builders))
+	nodes := make([]*LoginThrottle, len(ltcb.builders))
+	mutators := make([]Mutator, len(ltcb.builders))
+	for i := range ltcb.builders {
+		func(i int, root context.Context) {
+			builder := ltcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginThrottleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ltcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ltcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ltcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ltcb *LoginThrottleCreateBulk) SaveX(ctx context.Context) []*LoginThrottle {
+	v, err := ltcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ltcb *LoginThrottleCreateBulk) Exec(ctx context.Context) error {
+	_, err := ltcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltcb *LoginThrottleCreateBulk) ExecX(ctx context.Context) {
+	if err := ltcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
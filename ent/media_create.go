@@ -103,7 +103,9 @@ func (mc *MediaCreate) Mutation() *MediaMutation {
 
 // Save creates the Media in the database.
 func (mc *MediaCreate) Save(ctx context.Context) (*Media, error) {
-	mc.defaults()
+	if err := mc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
 }
 
@@ -130,11 +132,15 @@ func (mc *MediaCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (mc *MediaCreate) defaults() {
+func (mc *MediaCreate) defaults() error {
 	if _, ok := mc.mutation.CreatedAt(); !ok {
+		if media.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized media.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := media.DefaultCreatedAt()
 		mc.mutation.SetCreatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
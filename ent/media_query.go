@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -399,6 +400,12 @@ func (mq *MediaQuery) prepareQuery(ctx context.Context) error {
 		}
 		mq.sql = prev
 	}
+	if media.Policy == nil {
+		return errors.New("ent: uninitialized media.Policy (forgotten import ent/runtime?)")
+	}
+	if err := media.Policy.EvalQuery(ctx, mq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -21,12 +21,20 @@ type Message struct {
 	ID int `json:"id,omitempty"`
 	// CipherText holds the value of the "cipher_text" field.
 	CipherText string `json:"cipher_text,omitempty"`
+	// SenderDeviceID holds the value of the "sender_device_id" field.
+	SenderDeviceID *int `json:"sender_device_id,omitempty"`
 	// ContentType holds the value of the "content_type" field.
 	ContentType string `json:"content_type,omitempty"`
 	// EncryptionScheme holds the value of the "encryption_scheme" field.
 	EncryptionScheme string `json:"encryption_scheme,omitempty"`
+	// EncryptionVersion holds the value of the "encryption_version" field.
+	EncryptionVersion *int `json:"encryption_version,omitempty"`
 	// Edited holds the value of the "edited" field.
 	Edited bool `json:"edited,omitempty"`
+	// Seq holds the value of the "seq" field.
+	Seq int `json:"seq,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -47,9 +55,11 @@ type MessageEdges struct {
 	Room *Room `json:"room,omitempty"`
 	// Media holds the value of the media edge.
 	Media []*Media `json:"media,omitempty"`
+	// Envelopes holds the value of the envelopes edge.
+	Envelopes []*MessageEnvelope `json:"envelopes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // SenderOrErr returns the Sender value or an error if the edge
@@ -83,6 +93,15 @@ func (e MessageEdges) MediaOrErr() ([]*Media, error) {
 	return nil, &NotLoadedError{edge: "media"}
 }
 
+// EnvelopesOrErr returns the Envelopes value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) EnvelopesOrErr() ([]*MessageEnvelope, error) {
+	if e.loadedTypes[3] {
+		return e.Envelopes, nil
+	}
+	return nil, &NotLoadedError{edge: "envelopes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -90,11 +109,11 @@ func (*Message) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case message.FieldEdited:
 			values[i] = new(sql.NullBool)
-		case message.FieldID:
+		case message.FieldID, message.FieldSenderDeviceID, message.FieldEncryptionVersion, message.FieldSeq:
 			values[i] = new(sql.NullInt64)
 		case message.FieldCipherText, message.FieldContentType, message.FieldEncryptionScheme:
 			values[i] = new(sql.NullString)
-		case message.FieldCreatedAt, message.FieldUpdatedAt:
+		case message.FieldExpiresAt, message.FieldCreatedAt, message.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case message.ForeignKeys[0]: // message_sender
 			values[i] = new(sql.NullInt64)
@@ -127,6 +146,13 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.CipherText = value.String
 			}
+		case message.FieldSenderDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_device_id", values[i])
+			} else if value.Valid {
+				m.SenderDeviceID = new(int)
+				*m.SenderDeviceID = int(value.Int64)
+			}
 		case message.FieldContentType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field content_type", values[i])
@@ -139,12 +165,32 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.EncryptionScheme = value.String
 			}
+		case message.FieldEncryptionVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field encryption_version", values[i])
+			} else if value.Valid {
+				m.EncryptionVersion = new(int)
+				*m.EncryptionVersion = int(value.Int64)
+			}
 		case message.FieldEdited:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field edited", values[i])
 			} else if value.Valid {
 				m.Edited = value.Bool
 			}
+		case message.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				m.Seq = int(value.Int64)
+			}
+		case message.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				m.ExpiresAt = new(time.Time)
+				*m.ExpiresAt = value.Time
+			}
 		case message.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -199,6 +245,11 @@ func (m *Message) QueryMedia() *MediaQuery {
 	return NewMessageClient(m.config).QueryMedia(m)
 }
 
+// QueryEnvelopes queries the "envelopes" edge of the Message entity.
+func (m *Message) QueryEnvelopes() *MessageEnvelopeQuery {
+	return NewMessageClient(m.config).QueryEnvelopes(m)
+}
+
 // Update returns a builder for updating this Message.
 // Note that you need to call Message.Unwrap() before calling this method if this Message
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -225,15 +276,33 @@ func (m *Message) String() string {
 	builder.WriteString("cipher_text=")
 	builder.WriteString(m.CipherText)
 	builder.WriteString(", ")
+	if v := m.SenderDeviceID; v != nil {
+		builder.WriteString("sender_device_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("content_type=")
 	builder.WriteString(m.ContentType)
 	builder.WriteString(", ")
 	builder.WriteString("encryption_scheme=")
 	builder.WriteString(m.EncryptionScheme)
 	builder.WriteString(", ")
+	if v := m.EncryptionVersion; v != nil {
+		builder.WriteString("encryption_version=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("edited=")
 	builder.WriteString(fmt.Sprintf("%v", m.Edited))
 	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", m.Seq))
+	builder.WriteString(", ")
+	if v := m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
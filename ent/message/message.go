@@ -5,6 +5,7 @@ package message
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -16,12 +17,20 @@ const (
 	FieldID = "id"
 	// FieldCipherText holds the string denoting the cipher_text field in the database.
 	FieldCipherText = "cipher_text"
+	// FieldSenderDeviceID holds the string denoting the sender_device_id field in the database.
+	FieldSenderDeviceID = "sender_device_id"
 	// FieldContentType holds the string denoting the content_type field in the database.
 	FieldContentType = "content_type"
 	// FieldEncryptionScheme holds the string denoting the encryption_scheme field in the database.
 	FieldEncryptionScheme = "encryption_scheme"
+	// FieldEncryptionVersion holds the string denoting the encryption_version field in the database.
+	FieldEncryptionVersion = "encryption_version"
 	// FieldEdited holds the string denoting the edited field in the database.
 	FieldEdited = "edited"
+	// FieldSeq holds the string denoting the seq field in the database.
+	FieldSeq = "seq"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -32,6 +41,8 @@ const (
 	EdgeRoom = "room"
 	// EdgeMedia holds the string denoting the media edge name in mutations.
 	EdgeMedia = "media"
+	// EdgeEnvelopes holds the string denoting the envelopes edge name in mutations.
+	EdgeEnvelopes = "envelopes"
 	// Table holds the table name of the message in the database.
 	Table = "messages"
 	// SenderTable is the table that holds the sender relation/edge.
@@ -55,15 +66,26 @@ const (
 	MediaInverseTable = "media"
 	// MediaColumn is the table column denoting the media relation/edge.
 	MediaColumn = "media_message"
+	// EnvelopesTable is the table that holds the envelopes relation/edge.
+	EnvelopesTable = "message_envelopes"
+	// EnvelopesInverseTable is the table name for the MessageEnvelope entity.
+	// It exists in this package in order to avoid circular dependency with the "messageenvelope" package.
+	EnvelopesInverseTable = "message_envelopes"
+	// EnvelopesColumn is the table column denoting the envelopes relation/edge.
+	EnvelopesColumn = "message_envelope_message"
 )
 
 // Columns holds all SQL columns for message fields.
 var Columns = []string{
 	FieldID,
 	FieldCipherText,
+	FieldSenderDeviceID,
 	FieldContentType,
 	FieldEncryptionScheme,
+	FieldEncryptionVersion,
 	FieldEdited,
+	FieldSeq,
+	FieldExpiresAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -90,7 +112,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eleven-am/enclave/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// CipherTextValidator is a validator for the "cipher_text" field. It is called by the builders before save.
 	CipherTextValidator func(string) error
 	// DefaultContentType holds the default value on creation for the "content_type" field.
@@ -99,6 +128,8 @@ var (
 	DefaultEncryptionScheme string
 	// DefaultEdited holds the default value on creation for the "edited" field.
 	DefaultEdited bool
+	// SeqValidator is a validator for the "seq" field. It is called by the builders before save.
+	SeqValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -120,6 +151,11 @@ func ByCipherText(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCipherText, opts...).ToFunc()
 }
 
+// BySenderDeviceID orders the results by the sender_device_id field.
+func BySenderDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderDeviceID, opts...).ToFunc()
+}
+
 // ByContentType orders the results by the content_type field.
 func ByContentType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContentType, opts...).ToFunc()
@@ -130,11 +166,26 @@ func ByEncryptionScheme(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEncryptionScheme, opts...).ToFunc()
 }
 
+// ByEncryptionVersion orders the results by the encryption_version field.
+func ByEncryptionVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncryptionVersion, opts...).ToFunc()
+}
+
 // ByEdited orders the results by the edited field.
 func ByEdited(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEdited, opts...).ToFunc()
 }
 
+// BySeq orders the results by the seq field.
+func BySeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeq, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -172,6 +223,20 @@ func ByMedia(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMediaStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByEnvelopesCount orders the results by envelopes count.
+func ByEnvelopesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEnvelopesStep(), opts...)
+	}
+}
+
+// ByEnvelopes orders the results by envelopes terms.
+func ByEnvelopes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnvelopesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSenderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -193,3 +258,10 @@ func newMediaStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, MediaTable, MediaColumn),
 	)
 }
+func newEnvelopesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnvelopesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, EnvelopesTable, EnvelopesColumn),
+	)
+}
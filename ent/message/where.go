@@ -60,6 +60,11 @@ func CipherText(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCipherText, v))
 }
 
+// SenderDeviceID applies equality check predicate on the "sender_device_id" field. It's identical to SenderDeviceIDEQ.
+func SenderDeviceID(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSenderDeviceID, v))
+}
+
 // ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
 func ContentType(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldContentType, v))
@@ -70,11 +75,26 @@ func EncryptionScheme(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldEncryptionScheme, v))
 }
 
+// EncryptionVersion applies equality check predicate on the "encryption_version" field. It's identical to EncryptionVersionEQ.
+func EncryptionVersion(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEncryptionVersion, v))
+}
+
 // Edited applies equality check predicate on the "edited" field. It's identical to EditedEQ.
 func Edited(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldEdited, v))
 }
 
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSeq, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldExpiresAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
@@ -140,6 +160,16 @@ func CipherTextHasSuffix(v string) predicate.Message {
 	return predicate.Message(sql.FieldHasSuffix(FieldCipherText, v))
 }
 
+// CipherTextIsNil applies the IsNil predicate on the "cipher_text" field.
+func CipherTextIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldCipherText))
+}
+
+// CipherTextNotNil applies the NotNil predicate on the "cipher_text" field.
+func CipherTextNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldCipherText))
+}
+
 // CipherTextEqualFold applies the EqualFold predicate on the "cipher_text" field.
 func CipherTextEqualFold(v string) predicate.Message {
 	return predicate.Message(sql.FieldEqualFold(FieldCipherText, v))
@@ -150,6 +180,56 @@ func CipherTextContainsFold(v string) predicate.Message {
 	return predicate.Message(sql.FieldContainsFold(FieldCipherText, v))
 }
 
+// SenderDeviceIDEQ applies the EQ predicate on the "sender_device_id" field.
+func SenderDeviceIDEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDNEQ applies the NEQ predicate on the "sender_device_id" field.
+func SenderDeviceIDNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDIn applies the In predicate on the "sender_device_id" field.
+func SenderDeviceIDIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldSenderDeviceID, vs...))
+}
+
+// SenderDeviceIDNotIn applies the NotIn predicate on the "sender_device_id" field.
+func SenderDeviceIDNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldSenderDeviceID, vs...))
+}
+
+// SenderDeviceIDGT applies the GT predicate on the "sender_device_id" field.
+func SenderDeviceIDGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDGTE applies the GTE predicate on the "sender_device_id" field.
+func SenderDeviceIDGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDLT applies the LT predicate on the "sender_device_id" field.
+func SenderDeviceIDLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDLTE applies the LTE predicate on the "sender_device_id" field.
+func SenderDeviceIDLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDIsNil applies the IsNil predicate on the "sender_device_id" field.
+func SenderDeviceIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldSenderDeviceID))
+}
+
+// SenderDeviceIDNotNil applies the NotNil predicate on the "sender_device_id" field.
+func SenderDeviceIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldSenderDeviceID))
+}
+
 // ContentTypeEQ applies the EQ predicate on the "content_type" field.
 func ContentTypeEQ(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldContentType, v))
@@ -280,6 +360,56 @@ func EncryptionSchemeContainsFold(v string) predicate.Message {
 	return predicate.Message(sql.FieldContainsFold(FieldEncryptionScheme, v))
 }
 
+// EncryptionVersionEQ applies the EQ predicate on the "encryption_version" field.
+func EncryptionVersionEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionNEQ applies the NEQ predicate on the "encryption_version" field.
+func EncryptionVersionNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionIn applies the In predicate on the "encryption_version" field.
+func EncryptionVersionIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldEncryptionVersion, vs...))
+}
+
+// EncryptionVersionNotIn applies the NotIn predicate on the "encryption_version" field.
+func EncryptionVersionNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldEncryptionVersion, vs...))
+}
+
+// EncryptionVersionGT applies the GT predicate on the "encryption_version" field.
+func EncryptionVersionGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionGTE applies the GTE predicate on the "encryption_version" field.
+func EncryptionVersionGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionLT applies the LT predicate on the "encryption_version" field.
+func EncryptionVersionLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionLTE applies the LTE predicate on the "encryption_version" field.
+func EncryptionVersionLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionIsNil applies the IsNil predicate on the "encryption_version" field.
+func EncryptionVersionIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldEncryptionVersion))
+}
+
+// EncryptionVersionNotNil applies the NotNil predicate on the "encryption_version" field.
+func EncryptionVersionNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldEncryptionVersion))
+}
+
 // EditedEQ applies the EQ predicate on the "edited" field.
 func EditedEQ(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldEdited, v))
@@ -290,6 +420,106 @@ func EditedNEQ(v bool) predicate.Message {
 	return predicate.Message(sql.FieldNEQ(FieldEdited, v))
 }
 
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldSeq, v))
+}
+
+// SeqIsNil applies the IsNil predicate on the "seq" field.
+func SeqIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldSeq))
+}
+
+// SeqNotNil applies the NotNil predicate on the "seq" field.
+func SeqNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldSeq))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldExpiresAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
@@ -439,6 +669,29 @@ func HasMediaWith(preds ...predicate.Media) predicate.Message {
 	})
 }
 
+// HasEnvelopes applies the HasEdge predicate on the "envelopes" edge.
+func HasEnvelopes() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, EnvelopesTable, EnvelopesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvelopesWith applies the HasEdge predicate on the "envelopes" edge with a given conditions (other predicates).
+func HasEnvelopesWith(preds ...predicate.MessageEnvelope) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newEnvelopesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Message) predicate.Message {
 	return predicate.Message(sql.AndPredicates(predicates...))
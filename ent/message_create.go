@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/eleven-am/enclave/ent/media"
 	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/user"
 )
@@ -29,6 +30,28 @@ func (mc *MessageCreate) SetCipherText(s string) *MessageCreate {
 	return mc
 }
 
+// SetNillableCipherText sets the "cipher_text" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableCipherText(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetCipherText(*s)
+	}
+	return mc
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (mc *MessageCreate) SetSenderDeviceID(i int) *MessageCreate {
+	mc.mutation.SetSenderDeviceID(i)
+	return mc
+}
+
+// SetNillableSenderDeviceID sets the "sender_device_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableSenderDeviceID(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetSenderDeviceID(*i)
+	}
+	return mc
+}
+
 // SetContentType sets the "content_type" field.
 func (mc *MessageCreate) SetContentType(s string) *MessageCreate {
 	mc.mutation.SetContentType(s)
@@ -57,6 +80,20 @@ func (mc *MessageCreate) SetNillableEncryptionScheme(s *string) *MessageCreate {
 	return mc
 }
 
+// SetEncryptionVersion sets the "encryption_version" field.
+func (mc *MessageCreate) SetEncryptionVersion(i int) *MessageCreate {
+	mc.mutation.SetEncryptionVersion(i)
+	return mc
+}
+
+// SetNillableEncryptionVersion sets the "encryption_version" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableEncryptionVersion(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetEncryptionVersion(*i)
+	}
+	return mc
+}
+
 // SetEdited sets the "edited" field.
 func (mc *MessageCreate) SetEdited(b bool) *MessageCreate {
 	mc.mutation.SetEdited(b)
@@ -71,6 +108,34 @@ func (mc *MessageCreate) SetNillableEdited(b *bool) *MessageCreate {
 	return mc
 }
 
+// SetSeq sets the "seq" field.
+func (mc *MessageCreate) SetSeq(i int) *MessageCreate {
+	mc.mutation.SetSeq(i)
+	return mc
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableSeq(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetSeq(*i)
+	}
+	return mc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (mc *MessageCreate) SetExpiresAt(t time.Time) *MessageCreate {
+	mc.mutation.SetExpiresAt(t)
+	return mc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableExpiresAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetExpiresAt(*t)
+	}
+	return mc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (mc *MessageCreate) SetCreatedAt(t time.Time) *MessageCreate {
 	mc.mutation.SetCreatedAt(t)
@@ -105,6 +170,14 @@ func (mc *MessageCreate) SetSenderID(id int) *MessageCreate {
 	return mc
 }
 
+// SetNillableSenderID sets the "sender" edge to the User entity by ID if the given value is not nil.
+func (mc *MessageCreate) SetNillableSenderID(id *int) *MessageCreate {
+	if id != nil {
+		mc = mc.SetSenderID(*id)
+	}
+	return mc
+}
+
 // SetSender sets the "sender" edge to the User entity.
 func (mc *MessageCreate) SetSender(u *User) *MessageCreate {
 	return mc.SetSenderID(u.ID)
@@ -136,6 +209,21 @@ func (mc *MessageCreate) AddMedia(m ...*Media) *MessageCreate {
 	return mc.AddMediumIDs(ids...)
 }
 
+// AddEnvelopeIDs adds the "envelopes" edge to the MessageEnvelope entity by IDs.
+func (mc *MessageCreate) AddEnvelopeIDs(ids ...int) *MessageCreate {
+	mc.mutation.AddEnvelopeIDs(ids...)
+	return mc
+}
+
+// AddEnvelopes adds the "envelopes" edges to the MessageEnvelope entity.
+func (mc *MessageCreate) AddEnvelopes(m ...*MessageEnvelope) *MessageCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddEnvelopeIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mc *MessageCreate) Mutation() *MessageMutation {
 	return mc.mutation
@@ -143,7 +231,9 @@ func (mc *MessageCreate) Mutation() *MessageMutation {
 
 // Save creates the Message in the database.
 func (mc *MessageCreate) Save(ctx context.Context) (*Message, error) {
-	mc.defaults()
+	if err := mc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
 }
 
@@ -170,7 +260,7 @@ func (mc *MessageCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (mc *MessageCreate) defaults() {
+func (mc *MessageCreate) defaults() error {
 	if _, ok := mc.mutation.ContentType(); !ok {
 		v := message.DefaultContentType
 		mc.mutation.SetContentType(v)
@@ -184,20 +274,24 @@ func (mc *MessageCreate) defaults() {
 		mc.mutation.SetEdited(v)
 	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
+		if message.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized message.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := message.DefaultCreatedAt()
 		mc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		if message.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized message.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := message.DefaultUpdatedAt()
 		mc.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (mc *MessageCreate) check() error {
-	if _, ok := mc.mutation.CipherText(); !ok {
-		return &ValidationError{Name: "cipher_text", err: errors.New(`ent: missing required field "Message.cipher_text"`)}
-	}
 	if v, ok := mc.mutation.CipherText(); ok {
 		if err := message.CipherTextValidator(v); err != nil {
 			return &ValidationError{Name: "cipher_text", err: fmt.Errorf(`ent: validator failed for field "Message.cipher_text": %w`, err)}
@@ -212,15 +306,17 @@ func (mc *MessageCreate) check() error {
 	if _, ok := mc.mutation.Edited(); !ok {
 		return &ValidationError{Name: "edited", err: errors.New(`ent: missing required field "Message.edited"`)}
 	}
+	if v, ok := mc.mutation.Seq(); ok {
+		if err := message.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "Message.seq": %w`, err)}
+		}
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Message.created_at"`)}
 	}
 	if _, ok := mc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Message.updated_at"`)}
 	}
-	if _, ok := mc.mutation.SenderID(); !ok {
-		return &ValidationError{Name: "sender", err: errors.New(`ent: missing required edge "Message.sender"`)}
-	}
 	if _, ok := mc.mutation.RoomID(); !ok {
 		return &ValidationError{Name: "room", err: errors.New(`ent: missing required edge "Message.room"`)}
 	}
@@ -254,6 +350,10 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldCipherText, field.TypeString, value)
 		_node.CipherText = value
 	}
+	if value, ok := mc.mutation.SenderDeviceID(); ok {
+		_spec.SetField(message.FieldSenderDeviceID, field.TypeInt, value)
+		_node.SenderDeviceID = &value
+	}
 	if value, ok := mc.mutation.ContentType(); ok {
 		_spec.SetField(message.FieldContentType, field.TypeString, value)
 		_node.ContentType = value
@@ -262,10 +362,22 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldEncryptionScheme, field.TypeString, value)
 		_node.EncryptionScheme = value
 	}
+	if value, ok := mc.mutation.EncryptionVersion(); ok {
+		_spec.SetField(message.FieldEncryptionVersion, field.TypeInt, value)
+		_node.EncryptionVersion = &value
+	}
 	if value, ok := mc.mutation.Edited(); ok {
 		_spec.SetField(message.FieldEdited, field.TypeBool, value)
 		_node.Edited = value
 	}
+	if value, ok := mc.mutation.Seq(); ok {
+		_spec.SetField(message.FieldSeq, field.TypeInt, value)
+		_node.Seq = value
+	}
+	if value, ok := mc.mutation.ExpiresAt(); ok {
+		_spec.SetField(message.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
 	if value, ok := mc.mutation.CreatedAt(); ok {
 		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -324,6 +436,22 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mc.mutation.EnvelopesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.EnvelopesTable,
+			Columns: []string{message.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -13,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/eleven-am/enclave/ent/media"
 	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
 	"github.com/eleven-am/enclave/ent/predicate"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/user"
@@ -21,14 +23,15 @@ import (
 // MessageQuery is the builder for querying Message entities.
 type MessageQuery struct {
 	config
-	ctx        *QueryContext
-	order      []message.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Message
-	withSender *UserQuery
-	withRoom   *RoomQuery
-	withMedia  *MediaQuery
-	withFKs    bool
+	ctx           *QueryContext
+	order         []message.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Message
+	withSender    *UserQuery
+	withRoom      *RoomQuery
+	withMedia     *MediaQuery
+	withEnvelopes *MessageEnvelopeQuery
+	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +134,28 @@ func (mq *MessageQuery) QueryMedia() *MediaQuery {
 	return query
 }
 
+// QueryEnvelopes chains the current query on the "envelopes" edge.
+func (mq *MessageQuery) QueryEnvelopes() *MessageEnvelopeQuery {
+	query := (&MessageEnvelopeClient{config: mq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messageenvelope.Table, messageenvelope.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.EnvelopesTable, message.EnvelopesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Message entity from the query.
 // Returns a *NotFoundError when no Message was found.
 func (mq *MessageQuery) First(ctx context.Context) (*Message, error) {
@@ -318,14 +343,15 @@ func (mq *MessageQuery) Clone() *MessageQuery {
 		return nil
 	}
 	return &MessageQuery{
-		config:     mq.config,
-		ctx:        mq.ctx.Clone(),
-		order:      append([]message.OrderOption{}, mq.order...),
-		inters:     append([]Interceptor{}, mq.inters...),
-		predicates: append([]predicate.Message{}, mq.predicates...),
-		withSender: mq.withSender.Clone(),
-		withRoom:   mq.withRoom.Clone(),
-		withMedia:  mq.withMedia.Clone(),
+		config:        mq.config,
+		ctx:           mq.ctx.Clone(),
+		order:         append([]message.OrderOption{}, mq.order...),
+		inters:        append([]Interceptor{}, mq.inters...),
+		predicates:    append([]predicate.Message{}, mq.predicates...),
+		withSender:    mq.withSender.Clone(),
+		withRoom:      mq.withRoom.Clone(),
+		withMedia:     mq.withMedia.Clone(),
+		withEnvelopes: mq.withEnvelopes.Clone(),
 		// clone intermediate query.
 		sql:  mq.sql.Clone(),
 		path: mq.path,
@@ -365,6 +391,17 @@ func (mq *MessageQuery) WithMedia(opts ...func(*MediaQuery)) *MessageQuery {
 	return mq
 }
 
+// WithEnvelopes tells the query-builder to eager-load the nodes that are connected to
+// the "envelopes" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MessageQuery) WithEnvelopes(opts ...func(*MessageEnvelopeQuery)) *MessageQuery {
+	query := (&MessageEnvelopeClient{config: mq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withEnvelopes = query
+	return mq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -436,6 +473,12 @@ func (mq *MessageQuery) prepareQuery(ctx context.Context) error {
 		}
 		mq.sql = prev
 	}
+	if message.Policy == nil {
+		return errors.New("ent: uninitialized message.Policy (forgotten import ent/runtime?)")
+	}
+	if err := message.Policy.EvalQuery(ctx, mq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -444,10 +487,11 @@ func (mq *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 		nodes       = []*Message{}
 		withFKs     = mq.withFKs
 		_spec       = mq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			mq.withSender != nil,
 			mq.withRoom != nil,
 			mq.withMedia != nil,
+			mq.withEnvelopes != nil,
 		}
 	)
 	if mq.withSender != nil || mq.withRoom != nil {
@@ -493,6 +537,13 @@ func (mq *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 			return nil, err
 		}
 	}
+	if query := mq.withEnvelopes; query != nil {
+		if err := mq.loadEnvelopes(ctx, query, nodes,
+			func(n *Message) { n.Edges.Envelopes = []*MessageEnvelope{} },
+			func(n *Message, e *MessageEnvelope) { n.Edges.Envelopes = append(n.Edges.Envelopes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -591,6 +642,37 @@ func (mq *MessageQuery) loadMedia(ctx context.Context, query *MediaQuery, nodes
 	}
 	return nil
 }
+func (mq *MessageQuery) loadEnvelopes(ctx context.Context, query *MessageEnvelopeQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageEnvelope)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MessageEnvelope(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.EnvelopesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.message_envelope_message
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "message_envelope_message" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_envelope_message" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (mq *MessageQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mq.querySpec()
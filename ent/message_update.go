@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/eleven-am/enclave/ent/media"
 	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
 	"github.com/eleven-am/enclave/ent/predicate"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/user"
@@ -45,6 +46,39 @@ func (mu *MessageUpdate) SetNillableCipherText(s *string) *MessageUpdate {
 	return mu
 }
 
+// ClearCipherText clears the value of the "cipher_text" field.
+func (mu *MessageUpdate) ClearCipherText() *MessageUpdate {
+	mu.mutation.ClearCipherText()
+	return mu
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (mu *MessageUpdate) SetSenderDeviceID(i int) *MessageUpdate {
+	mu.mutation.ResetSenderDeviceID()
+	mu.mutation.SetSenderDeviceID(i)
+	return mu
+}
+
+// SetNillableSenderDeviceID sets the "sender_device_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableSenderDeviceID(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetSenderDeviceID(*i)
+	}
+	return mu
+}
+
+// AddSenderDeviceID adds i to the "sender_device_id" field.
+func (mu *MessageUpdate) AddSenderDeviceID(i int) *MessageUpdate {
+	mu.mutation.AddSenderDeviceID(i)
+	return mu
+}
+
+// ClearSenderDeviceID clears the value of the "sender_device_id" field.
+func (mu *MessageUpdate) ClearSenderDeviceID() *MessageUpdate {
+	mu.mutation.ClearSenderDeviceID()
+	return mu
+}
+
 // SetContentType sets the "content_type" field.
 func (mu *MessageUpdate) SetContentType(s string) *MessageUpdate {
 	mu.mutation.SetContentType(s)
@@ -73,6 +107,33 @@ func (mu *MessageUpdate) SetNillableEncryptionScheme(s *string) *MessageUpdate {
 	return mu
 }
 
+// SetEncryptionVersion sets the "encryption_version" field.
+func (mu *MessageUpdate) SetEncryptionVersion(i int) *MessageUpdate {
+	mu.mutation.ResetEncryptionVersion()
+	mu.mutation.SetEncryptionVersion(i)
+	return mu
+}
+
+// SetNillableEncryptionVersion sets the "encryption_version" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableEncryptionVersion(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetEncryptionVersion(*i)
+	}
+	return mu
+}
+
+// AddEncryptionVersion adds i to the "encryption_version" field.
+func (mu *MessageUpdate) AddEncryptionVersion(i int) *MessageUpdate {
+	mu.mutation.AddEncryptionVersion(i)
+	return mu
+}
+
+// ClearEncryptionVersion clears the value of the "encryption_version" field.
+func (mu *MessageUpdate) ClearEncryptionVersion() *MessageUpdate {
+	mu.mutation.ClearEncryptionVersion()
+	return mu
+}
+
 // SetEdited sets the "edited" field.
 func (mu *MessageUpdate) SetEdited(b bool) *MessageUpdate {
 	mu.mutation.SetEdited(b)
@@ -87,6 +148,53 @@ func (mu *MessageUpdate) SetNillableEdited(b *bool) *MessageUpdate {
 	return mu
 }
 
+// SetSeq sets the "seq" field.
+func (mu *MessageUpdate) SetSeq(i int) *MessageUpdate {
+	mu.mutation.ResetSeq()
+	mu.mutation.SetSeq(i)
+	return mu
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableSeq(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetSeq(*i)
+	}
+	return mu
+}
+
+// AddSeq adds i to the "seq" field.
+func (mu *MessageUpdate) AddSeq(i int) *MessageUpdate {
+	mu.mutation.AddSeq(i)
+	return mu
+}
+
+// ClearSeq clears the value of the "seq" field.
+func (mu *MessageUpdate) ClearSeq() *MessageUpdate {
+	mu.mutation.ClearSeq()
+	return mu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (mu *MessageUpdate) SetExpiresAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetExpiresAt(t)
+	return mu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableExpiresAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetExpiresAt(*t)
+	}
+	return mu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (mu *MessageUpdate) ClearExpiresAt() *MessageUpdate {
+	mu.mutation.ClearExpiresAt()
+	return mu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (mu *MessageUpdate) SetCreatedAt(t time.Time) *MessageUpdate {
 	mu.mutation.SetCreatedAt(t)
@@ -113,6 +221,14 @@ func (mu *MessageUpdate) SetSenderID(id int) *MessageUpdate {
 	return mu
 }
 
+// SetNillableSenderID sets the "sender" edge to the User entity by ID if the given value is not nil.
+func (mu *MessageUpdate) SetNillableSenderID(id *int) *MessageUpdate {
+	if id != nil {
+		mu = mu.SetSenderID(*id)
+	}
+	return mu
+}
+
 // SetSender sets the "sender" edge to the User entity.
 func (mu *MessageUpdate) SetSender(u *User) *MessageUpdate {
 	return mu.SetSenderID(u.ID)
@@ -144,6 +260,21 @@ func (mu *MessageUpdate) AddMedia(m ...*Media) *MessageUpdate {
 	return mu.AddMediumIDs(ids...)
 }
 
+// AddEnvelopeIDs adds the "envelopes" edge to the MessageEnvelope entity by IDs.
+func (mu *MessageUpdate) AddEnvelopeIDs(ids ...int) *MessageUpdate {
+	mu.mutation.AddEnvelopeIDs(ids...)
+	return mu
+}
+
+// AddEnvelopes adds the "envelopes" edges to the MessageEnvelope entity.
+func (mu *MessageUpdate) AddEnvelopes(m ...*MessageEnvelope) *MessageUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddEnvelopeIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (mu *MessageUpdate) Mutation() *MessageMutation {
 	return mu.mutation
@@ -182,9 +313,32 @@ func (mu *MessageUpdate) RemoveMedia(m ...*Media) *MessageUpdate {
 	return mu.RemoveMediumIDs(ids...)
 }
 
+// ClearEnvelopes clears all "envelopes" edges to the MessageEnvelope entity.
+func (mu *MessageUpdate) ClearEnvelopes() *MessageUpdate {
+	mu.mutation.ClearEnvelopes()
+	return mu
+}
+
+// RemoveEnvelopeIDs removes the "envelopes" edge to MessageEnvelope entities by IDs.
+func (mu *MessageUpdate) RemoveEnvelopeIDs(ids ...int) *MessageUpdate {
+	mu.mutation.RemoveEnvelopeIDs(ids...)
+	return mu
+}
+
+// RemoveEnvelopes removes "envelopes" edges to MessageEnvelope entities.
+func (mu *MessageUpdate) RemoveEnvelopes(m ...*MessageEnvelope) *MessageUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveEnvelopeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (mu *MessageUpdate) Save(ctx context.Context) (int, error) {
-	mu.defaults()
+	if err := mu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
 }
 
@@ -211,11 +365,15 @@ func (mu *MessageUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (mu *MessageUpdate) defaults() {
+func (mu *MessageUpdate) defaults() error {
 	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		if message.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized message.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := message.UpdateDefaultUpdatedAt()
 		mu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -225,8 +383,10 @@ func (mu *MessageUpdate) check() error {
 			return &ValidationError{Name: "cipher_text", err: fmt.Errorf(`ent: validator failed for field "Message.cipher_text": %w`, err)}
 		}
 	}
-	if _, ok := mu.mutation.SenderID(); mu.mutation.SenderCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Message.sender"`)
+	if v, ok := mu.mutation.Seq(); ok {
+		if err := message.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "Message.seq": %w`, err)}
+		}
 	}
 	if _, ok := mu.mutation.RoomID(); mu.mutation.RoomCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Message.room"`)
@@ -249,15 +409,51 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.CipherText(); ok {
 		_spec.SetField(message.FieldCipherText, field.TypeString, value)
 	}
+	if mu.mutation.CipherTextCleared() {
+		_spec.ClearField(message.FieldCipherText, field.TypeString)
+	}
+	if value, ok := mu.mutation.SenderDeviceID(); ok {
+		_spec.SetField(message.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedSenderDeviceID(); ok {
+		_spec.AddField(message.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if mu.mutation.SenderDeviceIDCleared() {
+		_spec.ClearField(message.FieldSenderDeviceID, field.TypeInt)
+	}
 	if value, ok := mu.mutation.ContentType(); ok {
 		_spec.SetField(message.FieldContentType, field.TypeString, value)
 	}
 	if value, ok := mu.mutation.EncryptionScheme(); ok {
 		_spec.SetField(message.FieldEncryptionScheme, field.TypeString, value)
 	}
+	if value, ok := mu.mutation.EncryptionVersion(); ok {
+		_spec.SetField(message.FieldEncryptionVersion, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedEncryptionVersion(); ok {
+		_spec.AddField(message.FieldEncryptionVersion, field.TypeInt, value)
+	}
+	if mu.mutation.EncryptionVersionCleared() {
+		_spec.ClearField(message.FieldEncryptionVersion, field.TypeInt)
+	}
 	if value, ok := mu.mutation.Edited(); ok {
 		_spec.SetField(message.FieldEdited, field.TypeBool, value)
 	}
+	if value, ok := mu.mutation.Seq(); ok {
+		_spec.SetField(message.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedSeq(); ok {
+		_spec.AddField(message.FieldSeq, field.TypeInt, value)
+	}
+	if mu.mutation.SeqCleared() {
+		_spec.ClearField(message.FieldSeq, field.TypeInt)
+	}
+	if value, ok := mu.mutation.ExpiresAt(); ok {
+		_spec.SetField(message.FieldExpiresAt, field.TypeTime, value)
+	}
+	if mu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(message.FieldExpiresAt, field.TypeTime)
+	}
 	if value, ok := mu.mutation.CreatedAt(); ok {
 		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -367,6 +563,51 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if mu.mutation.EnvelopesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.EnvelopesTable,
+			Columns: []string{message.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedEnvelopesIDs(); len(nodes) > 0 && !mu.mutation.EnvelopesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.EnvelopesTable,
+			Columns: []string{message.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.EnvelopesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.EnvelopesTable,
+			Columns: []string{message.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{message.Label}
@@ -401,6 +642,39 @@ func (muo *MessageUpdateOne) SetNillableCipherText(s *string) *MessageUpdateOne
 	return muo
 }
 
+// ClearCipherText clears the value of the "cipher_text" field.
+func (muo *MessageUpdateOne) ClearCipherText() *MessageUpdateOne {
+	muo.mutation.ClearCipherText()
+	return muo
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (muo *MessageUpdateOne) SetSenderDeviceID(i int) *MessageUpdateOne {
+	muo.mutation.ResetSenderDeviceID()
+	muo.mutation.SetSenderDeviceID(i)
+	return muo
+}
+
+// SetNillableSenderDeviceID sets the "sender_device_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableSenderDeviceID(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetSenderDeviceID(*i)
+	}
+	return muo
+}
+
+// AddSenderDeviceID adds i to the "sender_device_id" field.
+func (muo *MessageUpdateOne) AddSenderDeviceID(i int) *MessageUpdateOne {
+	muo.mutation.AddSenderDeviceID(i)
+	return muo
+}
+
+// ClearSenderDeviceID clears the value of the "sender_device_id" field.
+func (muo *MessageUpdateOne) ClearSenderDeviceID() *MessageUpdateOne {
+	muo.mutation.ClearSenderDeviceID()
+	return muo
+}
+
 // SetContentType sets the "content_type" field.
 func (muo *MessageUpdateOne) SetContentType(s string) *MessageUpdateOne {
 	muo.mutation.SetContentType(s)
@@ -429,6 +703,33 @@ func (muo *MessageUpdateOne) SetNillableEncryptionScheme(s *string) *MessageUpda
 	return muo
 }
 
+// SetEncryptionVersion sets the "encryption_version" field.
+func (muo *MessageUpdateOne) SetEncryptionVersion(i int) *MessageUpdateOne {
+	muo.mutation.ResetEncryptionVersion()
+	muo.mutation.SetEncryptionVersion(i)
+	return muo
+}
+
+// SetNillableEncryptionVersion sets the "encryption_version" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableEncryptionVersion(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetEncryptionVersion(*i)
+	}
+	return muo
+}
+
+// AddEncryptionVersion adds i to the "encryption_version" field.
+func (muo *MessageUpdateOne) AddEncryptionVersion(i int) *MessageUpdateOne {
+	muo.mutation.AddEncryptionVersion(i)
+	return muo
+}
+
+// ClearEncryptionVersion clears the value of the "encryption_version" field.
+func (muo *MessageUpdateOne) ClearEncryptionVersion() *MessageUpdateOne {
+	muo.mutation.ClearEncryptionVersion()
+	return muo
+}
+
 // SetEdited sets the "edited" field.
 func (muo *MessageUpdateOne) SetEdited(b bool) *MessageUpdateOne {
 	muo.mutation.SetEdited(b)
@@ -443,6 +744,53 @@ func (muo *MessageUpdateOne) SetNillableEdited(b *bool) *MessageUpdateOne {
 	return muo
 }
 
+// SetSeq sets the "seq" field.
+func (muo *MessageUpdateOne) SetSeq(i int) *MessageUpdateOne {
+	muo.mutation.ResetSeq()
+	muo.mutation.SetSeq(i)
+	return muo
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableSeq(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetSeq(*i)
+	}
+	return muo
+}
+
+// AddSeq adds i to the "seq" field.
+func (muo *MessageUpdateOne) AddSeq(i int) *MessageUpdateOne {
+	muo.mutation.AddSeq(i)
+	return muo
+}
+
+// ClearSeq clears the value of the "seq" field.
+func (muo *MessageUpdateOne) ClearSeq() *MessageUpdateOne {
+	muo.mutation.ClearSeq()
+	return muo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (muo *MessageUpdateOne) SetExpiresAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetExpiresAt(t)
+	return muo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableExpiresAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetExpiresAt(*t)
+	}
+	return muo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (muo *MessageUpdateOne) ClearExpiresAt() *MessageUpdateOne {
+	muo.mutation.ClearExpiresAt()
+	return muo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (muo *MessageUpdateOne) SetCreatedAt(t time.Time) *MessageUpdateOne {
 	muo.mutation.SetCreatedAt(t)
@@ -469,6 +817,14 @@ func (muo *MessageUpdateOne) SetSenderID(id int) *MessageUpdateOne {
 	return muo
 }
 
+// SetNillableSenderID sets the "sender" edge to the User entity by ID if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableSenderID(id *int) *MessageUpdateOne {
+	if id != nil {
+		muo = muo.SetSenderID(*id)
+	}
+	return muo
+}
+
 // SetSender sets the "sender" edge to the User entity.
 func (muo *MessageUpdateOne) SetSender(u *User) *MessageUpdateOne {
 	return muo.SetSenderID(u.ID)
@@ -500,6 +856,21 @@ func (muo *MessageUpdateOne) AddMedia(m ...*Media) *MessageUpdateOne {
 	return muo.AddMediumIDs(ids...)
 }
 
+// AddEnvelopeIDs adds the "envelopes" edge to the MessageEnvelope entity by IDs.
+func (muo *MessageUpdateOne) AddEnvelopeIDs(ids ...int) *MessageUpdateOne {
+	muo.mutation.AddEnvelopeIDs(ids...)
+	return muo
+}
+
+// AddEnvelopes adds the "envelopes" edges to the MessageEnvelope entity.
+func (muo *MessageUpdateOne) AddEnvelopes(m ...*MessageEnvelope) *MessageUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddEnvelopeIDs(ids...)
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (muo *MessageUpdateOne) Mutation() *MessageMutation {
 	return muo.mutation
@@ -538,6 +909,27 @@ func (muo *MessageUpdateOne) RemoveMedia(m ...*Media) *MessageUpdateOne {
 	return muo.RemoveMediumIDs(ids...)
 }
 
+// ClearEnvelopes clears all "envelopes" edges to the MessageEnvelope entity.
+func (muo *MessageUpdateOne) ClearEnvelopes() *MessageUpdateOne {
+	muo.mutation.ClearEnvelopes()
+	return muo
+}
+
+// RemoveEnvelopeIDs removes the "envelopes" edge to MessageEnvelope entities by IDs.
+func (muo *MessageUpdateOne) RemoveEnvelopeIDs(ids ...int) *MessageUpdateOne {
+	muo.mutation.RemoveEnvelopeIDs(ids...)
+	return muo
+}
+
+// RemoveEnvelopes removes "envelopes" edges to MessageEnvelope entities.
+func (muo *MessageUpdateOne) RemoveEnvelopes(m ...*MessageEnvelope) *MessageUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveEnvelopeIDs(ids...)
+}
+
 // Where appends a list predicates to the MessageUpdate builder.
 func (muo *MessageUpdateOne) Where(ps ...predicate.Message) *MessageUpdateOne {
 	muo.mutation.Where(ps...)
@@ -553,7 +945,9 @@ func (muo *MessageUpdateOne) Select(field string, fields ...string) *MessageUpda
 
 // Save executes the query and returns the updated Message entity.
 func (muo *MessageUpdateOne) Save(ctx context.Context) (*Message, error) {
-	muo.defaults()
+	if err := muo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
 }
 
@@ -580,11 +974,15 @@ func (muo *MessageUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (muo *MessageUpdateOne) defaults() {
+func (muo *MessageUpdateOne) defaults() error {
 	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		if message.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized message.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := message.UpdateDefaultUpdatedAt()
 		muo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -594,8 +992,10 @@ func (muo *MessageUpdateOne) check() error {
 			return &ValidationError{Name: "cipher_text", err: fmt.Errorf(`ent: validator failed for field "Message.cipher_text": %w`, err)}
 		}
 	}
-	if _, ok := muo.mutation.SenderID(); muo.mutation.SenderCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Message.sender"`)
+	if v, ok := muo.mutation.Seq(); ok {
+		if err := message.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "Message.seq": %w`, err)}
+		}
 	}
 	if _, ok := muo.mutation.RoomID(); muo.mutation.RoomCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Message.room"`)
@@ -635,15 +1035,51 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if value, ok := muo.mutation.CipherText(); ok {
 		_spec.SetField(message.FieldCipherText, field.TypeString, value)
 	}
+	if muo.mutation.CipherTextCleared() {
+		_spec.ClearField(message.FieldCipherText, field.TypeString)
+	}
+	if value, ok := muo.mutation.SenderDeviceID(); ok {
+		_spec.SetField(message.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedSenderDeviceID(); ok {
+		_spec.AddField(message.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if muo.mutation.SenderDeviceIDCleared() {
+		_spec.ClearField(message.FieldSenderDeviceID, field.TypeInt)
+	}
 	if value, ok := muo.mutation.ContentType(); ok {
 		_spec.SetField(message.FieldContentType, field.TypeString, value)
 	}
 	if value, ok := muo.mutation.EncryptionScheme(); ok {
 		_spec.SetField(message.FieldEncryptionScheme, field.TypeString, value)
 	}
+	if value, ok := muo.mutation.EncryptionVersion(); ok {
+		_spec.SetField(message.FieldEncryptionVersion, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedEncryptionVersion(); ok {
+		_spec.AddField(message.FieldEncryptionVersion, field.TypeInt, value)
+	}
+	if muo.mutation.EncryptionVersionCleared() {
+		_spec.ClearField(message.FieldEncryptionVersion, field.TypeInt)
+	}
 	if value, ok := muo.mutation.Edited(); ok {
 		_spec.SetField(message.FieldEdited, field.TypeBool, value)
 	}
+	if value, ok := muo.mutation.Seq(); ok {
+		_spec.SetField(message.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedSeq(); ok {
+		_spec.AddField(message.FieldSeq, field.TypeInt, value)
+	}
+	if muo.mutation.SeqCleared() {
+		_spec.ClearField(message.FieldSeq, field.TypeInt)
+	}
+	if value, ok := muo.mutation.ExpiresAt(); ok {
+		_spec.SetField(message.FieldExpiresAt, field.TypeTime, value)
+	}
+	if muo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(message.FieldExpiresAt, field.TypeTime)
+	}
 	if value, ok := muo.mutation.CreatedAt(); ok {
 		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -753,6 +1189,51 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if muo.mutation.EnvelopesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.EnvelopesTable,
+			Columns: []string{message.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedEnvelopesIDs(); len(nodes) > 0 && !muo.mutation.EnvelopesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.EnvelopesTable,
+			Columns: []string{message.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.EnvelopesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.EnvelopesTable,
+			Columns: []string{message.EnvelopesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Message{config: muo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+)
+
+// MessageEnvelope is the model entity for the MessageEnvelope schema.
+type MessageEnvelope struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CipherText holds the value of the "cipher_text" field.
+	CipherText string `json:"cipher_text,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageEnvelopeQuery when eager-loading is set.
+	Edges                    MessageEnvelopeEdges `json:"edges"`
+	message_envelope_message *int
+	message_envelope_device  *int
+	selectValues             sql.SelectValues
+}
+
+// MessageEnvelopeEdges holds the relations/edges for other nodes in the graph.
+type MessageEnvelopeEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// Device holds the value of the device edge.
+	Device *Device `json:"device,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEnvelopeEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// DeviceOrErr returns the Device value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEnvelopeEdges) DeviceOrErr() (*Device, error) {
+	if e.Device != nil {
+		return e.Device, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: device.Label}
+	}
+	return nil, &NotLoadedError{edge: "device"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageEnvelope) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messageenvelope.FieldID:
+			values[i] = new(sql.NullInt64)
+		case messageenvelope.FieldCipherText:
+			values[i] = new(sql.NullString)
+		case messageenvelope.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case messageenvelope.ForeignKeys[0]: // message_envelope_message
+			values[i] = new(sql.NullInt64)
+		case messageenvelope.ForeignKeys[1]: // message_envelope_device
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageEnvelope fields.
+func (me *MessageEnvelope) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messageenvelope.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			me.ID = int(value.Int64)
+		case messageenvelope.FieldCipherText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cipher_text", values[i])
+			} else if value.Valid {
+				me.CipherText = value.String
+			}
+		case messageenvelope.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				me.CreatedAt = value.Time
+			}
+		case messageenvelope.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field message_envelope_message", value)
+			} else if value.Valid {
+				me.message_envelope_message = new(int)
+				*me.message_envelope_message = int(value.Int64)
+			}
+		case messageenvelope.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field message_envelope_device", value)
+			} else if value.Valid {
+				me.message_envelope_device = new(int)
+				*me.message_envelope_device = int(value.Int64)
+			}
+		default:
+			me.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageEnvelope.
+// This includes values selected through modifiers, order, etc.
+func (me *MessageEnvelope) Value(name string) (ent.Value, error) {
+	return me.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the MessageEnvelope entity.
+func (me *MessageEnvelope) QueryMessage() *MessageQuery {
+	return NewMessageEnvelopeClient(me.config).QueryMessage(me)
+}
+
+// QueryDevice queries the "device" edge of the MessageEnvelope entity.
+func (me *MessageEnvelope) QueryDevice() *DeviceQuery {
+	return NewMessageEnvelopeClient(me.config).QueryDevice(me)
+}
+
+// Update returns a builder for updating this MessageEnvelope.
+// Note that you need to call MessageEnvelope.Unwrap() before calling this method if this MessageEnvelope
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (me *MessageEnvelope) Update() *MessageEnvelopeUpdateOne {
+	return NewMessageEnvelopeClient(me.config).UpdateOne(me)
+}
+
+// Unwrap unwraps the MessageEnvelope entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (me *MessageEnvelope) Unwrap() *MessageEnvelope {
+	_tx, ok := me.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageEnvelope is not a transactional entity")
+	}
+	me.config.driver = _tx.drv
+	return me
+}
+
+// String implements the fmt.Stringer.
+func (me *MessageEnvelope) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageEnvelope(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", me.ID))
+	builder.WriteString("cipher_text=")
+	builder.WriteString(me.CipherText)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(me.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageEnvelopes is a parsable slice of MessageEnvelope.
+type MessageEnvelopes []*MessageEnvelope
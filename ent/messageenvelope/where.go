@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messageenvelope
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldLTE(FieldID, id))
+}
+
+// CipherText applies equality check predicate on the "cipher_text" field. It's identical to CipherTextEQ.
+func CipherText(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldEQ(FieldCipherText, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CipherTextEQ applies the EQ predicate on the "cipher_text" field.
+func CipherTextEQ(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldEQ(FieldCipherText, v))
+}
+
+// CipherTextNEQ applies the NEQ predicate on the "cipher_text" field.
+func CipherTextNEQ(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldNEQ(FieldCipherText, v))
+}
+
+// CipherTextIn applies the In predicate on the "cipher_text" field.
+func CipherTextIn(vs ...string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldIn(FieldCipherText, vs...))
+}
+
+// CipherTextNotIn applies the NotIn predicate on the "cipher_text" field.
+func CipherTextNotIn(vs ...string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldNotIn(FieldCipherText, vs...))
+}
+
+// CipherTextGT applies the GT predicate on the "cipher_text" field.
+func CipherTextGT(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldGT(FieldCipherText, v))
+}
+
+// CipherTextGTE applies the GTE predicate on the "cipher_text" field.
+func CipherTextGTE(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldGTE(FieldCipherText, v))
+}
+
+// CipherTextLT applies the LT predicate on the "cipher_text" field.
+func CipherTextLT(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldLT(FieldCipherText, v))
+}
+
+// CipherTextLTE applies the LTE predicate on the "cipher_text" field.
+func CipherTextLTE(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldLTE(FieldCipherText, v))
+}
+
+// CipherTextContains applies the Contains predicate on the "cipher_text" field.
+func CipherTextContains(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldContains(FieldCipherText, v))
+}
+
+// CipherTextHasPrefix applies the HasPrefix predicate on the "cipher_text" field.
+func CipherTextHasPrefix(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldHasPrefix(FieldCipherText, v))
+}
+
+// CipherTextHasSuffix applies the HasSuffix predicate on the "cipher_text" field.
+func CipherTextHasSuffix(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldHasSuffix(FieldCipherText, v))
+}
+
+// CipherTextEqualFold applies the EqualFold predicate on the "cipher_text" field.
+func CipherTextEqualFold(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldEqualFold(FieldCipherText, v))
+}
+
+// CipherTextContainsFold applies the ContainsFold predicate on the "cipher_text" field.
+func CipherTextContainsFold(v string) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldContainsFold(FieldCipherText, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDevice applies the HasEdge predicate on the "device" edge.
+func HasDevice() predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, DeviceTable, DeviceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeviceWith applies the HasEdge predicate on the "device" edge with a given conditions (other predicates).
+func HasDeviceWith(preds ...predicate.Device) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(func(s *sql.Selector) {
+		step := newDeviceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageEnvelope) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageEnvelope) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageEnvelope) predicate.MessageEnvelope {
+	return predicate.MessageEnvelope(sql.NotPredicates(p))
+}
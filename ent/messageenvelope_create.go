@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+)
+
+// MessageEnvelopeCreate is the builder for creating a MessageEnvelope entity.
+type MessageEnvelopeCreate struct {
+	config
+	mutation *MessageEnvelopeMutation
+	hooks    []Hook
+}
+
+// SetCipherText sets the "cipher_text" field.
+func (mec *MessageEnvelopeCreate) SetCipherText(s string) *MessageEnvelopeCreate {
+	mec.mutation.SetCipherText(s)
+	return mec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mec *MessageEnvelopeCreate) SetCreatedAt(t time.Time) *MessageEnvelopeCreate {
+	mec.mutation.SetCreatedAt(t)
+	return mec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mec *MessageEnvelopeCreate) SetNillableCreatedAt(t *time.Time) *MessageEnvelopeCreate {
+	if t != nil {
+		mec.SetCreatedAt(*t)
+	}
+	return mec
+}
+
+// SetMessageID sets the "message" edge to the Message entity by ID.
+func (mec *MessageEnvelopeCreate) SetMessageID(id int) *MessageEnvelopeCreate {
+	mec.mutation.SetMessageID(id)
+	return mec
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mec *MessageEnvelopeCreate) SetMessage(m *Message) *MessageEnvelopeCreate {
+	return mec.SetMessageID(m.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (mec *MessageEnvelopeCreate) SetDeviceID(id int) *MessageEnvelopeCreate {
+	mec.mutation.SetDeviceID(id)
+	return mec
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (mec *MessageEnvelopeCreate) SetDevice(d *Device) *MessageEnvelopeCreate {
+	return mec.SetDeviceID(d.ID)
+}
+
+// Mutation returns the MessageEnvelopeMutation object of the builder.
+func (mec *MessageEnvelopeCreate) Mutation() *MessageEnvelopeMutation {
+	return mec.mutation
+}
+
+// Save creates the MessageEnvelope in the database.
+func (mec *MessageEnvelopeCreate) Save(ctx context.Context) (*MessageEnvelope, error) {
+	if err := mec.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, mec.sqlSave, mec.mutation, mec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mec *MessageEnvelopeCreate) SaveX(ctx context.Context) *MessageEnvelope {
+	v, err := mec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mec *MessageEnvelopeCreate) Exec(ctx context.Context) error {
+	_, err := mec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mec *MessageEnvelopeCreate) ExecX(ctx context.Context) {
+	if err := mec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mec *MessageEnvelopeCreate) defaults() error {
+	if _, ok := mec.mutation.CreatedAt(); !ok {
+		if messageenvelope.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized messageenvelope.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := messageenvelope.DefaultCreatedAt()
+		mec.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mec *MessageEnvelopeCreate) check() error {
+	if _, ok := mec.mutation.CipherText(); !ok {
+		return &ValidationError{Name: "cipher_text", err: errors.New(`ent: missing required field "MessageEnvelope.cipher_text"`)}
+	}
+	if v, ok := mec.mutation.CipherText(); ok {
+		if err := messageenvelope.CipherTextValidator(v); err != nil {
+			return &ValidationError{Name: "cipher_text", err: fmt.Errorf(`ent: validator failed for field "MessageEnvelope.cipher_text": %w`, err)}
+		}
+	}
+	if _, ok := mec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MessageEnvelope.created_at"`)}
+	}
+	if _, ok := mec.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "MessageEnvelope.message"`)}
+	}
+	if _, ok := mec.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`ent: missing required edge "MessageEnvelope.device"`)}
+	}
+	return nil
+}
+
+func (mec *MessageEnvelopeCreate) sqlSave(ctx context.Context) (*MessageEnvelope, error) {
+	if err := mec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mec.mutation.id = &_node.ID
+	mec.mutation.done = true
+	return _node, nil
+}
+
+func (mec *MessageEnvelopeCreate) createSpec() (*MessageEnvelope, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageEnvelope{config: mec.config}
+		_spec = sqlgraph.NewCreateSpec(messageenvelope.Table, sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt))
+	)
+	if value, ok := mec.mutation.CipherText(); ok {
+		_spec.SetField(messageenvelope.FieldCipherText, field.TypeString, value)
+		_node.CipherText = value
+	}
+	if value, ok := mec.mutation.CreatedAt(); ok {
+		_spec.SetField(messageenvelope.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mec.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messageenvelope.MessageTable,
+			Columns: []string{messageenvelope.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.message_envelope_message = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mec.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messageenvelope.DeviceTable,
+			Columns: []string{messageenvelope.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.message_envelope_device = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MessageEnvelopeCreateBulk is the builder for creating many MessageEnvelope entities in bulk.
+type MessageEnvelopeCreateBulk struct {
+	config
+	err      error
+	builders []*MessageEnvelopeCreate
+}
+
+// Save creates the MessageEnvelope entities in the database.
+func (mecb *MessageEnvelopeCreateBulk) Save(ctx context.Context) ([]*MessageEnvelope, error) {
+	if mecb.err != nil {
+		return nil, mecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mecb.builders))
+	nodes := make([]*MessageEnvelope, len(mecb.builders))
+	mutators := make([]Mutator, len(mecb.builders))
+	for i := range mecb.builders {
+		func(i int, root context.Context) {
+			builder := mecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageEnvelopeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mecb *MessageEnvelopeCreateBulk) SaveX(ctx context.Context) []*MessageEnvelope {
+	v, err := mecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mecb *MessageEnvelopeCreateBulk) Exec(ctx context.Context) error {
+	_, err := mecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mecb *MessageEnvelopeCreateBulk) ExecX(ctx context.Context) {
+	if err := mecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// MessageEnvelopeDelete is the builder for deleting a MessageEnvelope entity.
+type MessageEnvelopeDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessageEnvelopeMutation
+}
+
+// Where appends a list predicates to the MessageEnvelopeDelete builder.
+func (med *MessageEnvelopeDelete) Where(ps ...predicate.MessageEnvelope) *MessageEnvelopeDelete {
+	med.mutation.Where(ps...)
+	return med
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (med *MessageEnvelopeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, med.sqlExec, med.mutation, med.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (med *MessageEnvelopeDelete) ExecX(ctx context.Context) int {
+	n, err := med.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (med *MessageEnvelopeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(messageenvelope.Table, sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt))
+	if ps := med.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, med.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	med.mutation.done = true
+	return affected, err
+}
+
+// MessageEnvelopeDeleteOne is the builder for deleting a single MessageEnvelope entity.
+type MessageEnvelopeDeleteOne struct {
+	med *MessageEnvelopeDelete
+}
+
+// Where appends a list predicates to the MessageEnvelopeDelete builder.
+func (medo *MessageEnvelopeDeleteOne) Where(ps ...predicate.MessageEnvelope) *MessageEnvelopeDeleteOne {
+	medo.med.mutation.Where(ps...)
+	return medo
+}
+
+// Exec executes the deletion query.
+func (medo *MessageEnvelopeDeleteOne) Exec(ctx context.Context) error {
+	n, err := medo.med.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messageenvelope.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (medo *MessageEnvelopeDeleteOne) ExecX(ctx context.Context) {
+	if err := medo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
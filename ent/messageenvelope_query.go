@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// MessageEnvelopeQuery is the builder for querying MessageEnvelope entities.
+type MessageEnvelopeQuery struct {
+	config
+	ctx         *QueryContext
+	order       []messageenvelope.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.MessageEnvelope
+	withMessage *MessageQuery
+	withDevice  *DeviceQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MessageEnvelopeQuery builder.
+func (meq *MessageEnvelopeQuery) Where(ps ...predicate.MessageEnvelope) *MessageEnvelopeQuery {
+	meq.predicates = append(meq.predicates, ps...)
+	return meq
+}
+
+// Limit the number of records to be returned by this query.
+func (meq *MessageEnvelopeQuery) Limit(limit int) *MessageEnvelopeQuery {
+	meq.ctx.Limit = &limit
+	return meq
+}
+
+// Offset to start from.
+func (meq *MessageEnvelopeQuery) Offset(offset int) *MessageEnvelopeQuery {
+	meq.ctx.Offset = &offset
+	return meq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (meq *MessageEnvelopeQuery) Unique(unique bool) *MessageEnvelopeQuery {
+	meq.ctx.Unique = &unique
+	return meq
+}
+
+// Order specifies how the records should be ordered.
+func (meq *MessageEnvelopeQuery) Order(o ...messageenvelope.OrderOption) *MessageEnvelopeQuery {
+	meq.order = append(meq.order, o...)
+	return meq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (meq *MessageEnvelopeQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: meq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := meq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := meq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messageenvelope.Table, messageenvelope.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messageenvelope.MessageTable, messageenvelope.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(meq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDevice chains the current query on the "device" edge.
+func (meq *MessageEnvelopeQuery) QueryDevice() *DeviceQuery {
+	query := (&DeviceClient{config: meq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := meq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := meq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messageenvelope.Table, messageenvelope.FieldID, selector),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, messageenvelope.DeviceTable, messageenvelope.DeviceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(meq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MessageEnvelope entity from the query.
+// Returns a *NotFoundError when no MessageEnvelope was found.
+func (meq *MessageEnvelopeQuery) First(ctx context.Context) (*MessageEnvelope, error) {
+	nodes, err := meq.Limit(1).All(setContextOp(ctx, meq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{messageenvelope.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (meq *MessageEnvelopeQuery) FirstX(ctx context.Context) *MessageEnvelope {
+	node, err := meq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MessageEnvelope ID from the query.
+// Returns a *NotFoundError when no MessageEnvelope ID was found.
+func (meq *MessageEnvelopeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = meq.Limit(1).IDs(setContextOp(ctx, meq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{messageenvelope.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (meq *MessageEnvelopeQuery) FirstIDX(ctx context.Context) int {
+	id, err := meq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MessageEnvelope entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MessageEnvelope entity is found.
+// Returns a *NotFoundError when no MessageEnvelope entities are found.
+func (meq *MessageEnvelopeQuery) Only(ctx context.Context) (*MessageEnvelope, error) {
+	nodes, err := meq.Limit(2).All(setContextOp(ctx, meq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{messageenvelope.Label}
+	default:
+		return nil, &NotSingularError{messageenvelope.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (meq *MessageEnvelopeQuery) OnlyX(ctx context.Context) *MessageEnvelope {
+	node, err := meq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MessageEnvelope ID in the query.
+// Returns a *NotSingularError when more than one MessageEnvelope ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (meq *MessageEnvelopeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = meq.Limit(2).IDs(setContextOp(ctx, meq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{messageenvelope.Label}
+	default:
+		err = &NotSingularError{messageenvelope.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (meq *MessageEnvelopeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := meq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MessageEnvelopes.
+func (meq *MessageEnvelopeQuery) All(ctx context.Context) ([]*MessageEnvelope, error) {
+	ctx = setContextOp(ctx, meq.ctx, "All")
+	if err := meq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MessageEnvelope, *MessageEnvelopeQuery]()
+	return withInterceptors[[]*MessageEnvelope](ctx, meq, qr, meq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (meq *MessageEnvelopeQuery) AllX(ctx context.Context) []*MessageEnvelope {
+	nodes, err := meq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MessageEnvelope IDs.
+func (meq *MessageEnvelopeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if meq.ctx.Unique == nil && meq.path != nil {
+		meq.Unique(true)
+	}
+	ctx = setContextOp(ctx, meq.ctx, "IDs")
+	if err = meq.Select(messageenvelope.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (meq *MessageEnvelopeQuery) IDsX(ctx context.Context) []int {
+	ids, err := meq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (meq *MessageEnvelopeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, meq.ctx, "Count")
+	if err := meq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, meq, querierCount[*MessageEnvelopeQuery](), meq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (meq *MessageEnvelopeQuery) CountX(ctx context.Context) int {
+	count, err := meq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (meq *MessageEnvelopeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, meq.ctx, "Exist")
+	switch _, err := meq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (meq *MessageEnvelopeQuery) ExistX(ctx context.Context) bool {
+	exist, err := meq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MessageEnvelopeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (meq *MessageEnvelopeQuery) Clone() *MessageEnvelopeQuery {
+	if meq == nil {
+		return nil
+	}
+	return &MessageEnvelopeQuery{
+		config:      meq.config,
+		ctx:         meq.ctx.Clone(),
+		order:       append([]messageenvelope.OrderOption{}, meq.order...),
+		inters:      append([]Interceptor{}, meq.inters...),
+		predicates:  append([]predicate.MessageEnvelope{}, meq.predicates...),
+		withMessage: meq.withMessage.Clone(),
+		withDevice:  meq.withDevice.Clone(),
+		// clone intermediate query.
+		sql:  meq.sql.Clone(),
+		path: meq.path,
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (meq *MessageEnvelopeQuery) WithMessage(opts ...func(*MessageQuery)) *MessageEnvelopeQuery {
+	query := (&MessageClient{config: meq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	meq.withMessage = query
+	return meq
+}
+
+// WithDevice tells the query-builder to eager-load the nodes that are connected to
+// the "device" edge. The optional arguments are used to configure the query builder of the edge.
+func (meq *MessageEnvelopeQuery) WithDevice(opts ...func(*DeviceQuery)) *MessageEnvelopeQuery {
+	query := (&DeviceClient{config: meq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	meq.withDevice = query
+	return meq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CipherText string `json:"cipher_text,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MessageEnvelope.Query().
+//		GroupBy(messageenvelope.FieldCipherText).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (meq *MessageEnvelopeQuery) GroupBy(field string, fields ...string) *MessageEnvelopeGroupBy {
+	meq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MessageEnvelopeGroupBy{build: meq}
+	grbuild.flds = &meq.ctx.Fields
+	grbuild.label = messageenvelope.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CipherText string `json:"cipher_text,omitempty"`
+//	}
+//
+//	client.MessageEnvelope.Query().
+//		Select(messageenvelope.FieldCipherText).
+//		Scan(ctx, &v)
+func (meq *MessageEnvelopeQuery) Select(fields ...string) *MessageEnvelopeSelect {
+	meq.ctx.Fields = append(meq.ctx.Fields, fields...)
+	sbuild := &MessageEnvelopeSelect{MessageEnvelopeQuery: meq}
+	sbuild.label = messageenvelope.Label
+	sbuild.flds, sbuild.scan = &meq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MessageEnvelopeSelect configured with the given aggregations.
+func (meq *MessageEnvelopeQuery) Aggregate(fns ...AggregateFunc) *MessageEnvelopeSelect {
+	return meq.Select().Aggregate(fns...)
+}
+
+func (meq *MessageEnvelopeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range meq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, meq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range meq.ctx.Fields {
+		if !messageenvelope.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if meq.path != nil {
+		prev, err := meq.path(ctx)
+		if err != nil {
+			return err
+		}
+		meq.sql = prev
+	}
+	if messageenvelope.Policy == nil {
+		return errors.New("ent: uninitialized messageenvelope.Policy (forgotten import ent/runtime?)")
+	}
+	if err := messageenvelope.Policy.EvalQuery(ctx, meq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (meq *MessageEnvelopeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MessageEnvelope, error) {
+	var (
+		nodes       = []*MessageEnvelope{}
+		withFKs     = meq.withFKs
+		_spec       = meq.querySpec()
+		loadedTypes = [2]bool{
+			meq.withMessage != nil,
+			meq.withDevice != nil,
+		}
+	)
+	if meq.withMessage != nil || meq.withDevice != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, messageenvelope.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MessageEnvelope).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MessageEnvelope{config: meq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, meq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := meq.withMessage; query != nil {
+		if err := meq.loadMessage(ctx, query, nodes, nil,
+			func(n *MessageEnvelope, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := meq.withDevice; query != nil {
+		if err := meq.loadDevice(ctx, query, nodes, nil,
+			func(n *MessageEnvelope, e *Device) { n.Edges.Device = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (meq *MessageEnvelopeQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*MessageEnvelope, init func(*MessageEnvelope), assign func(*MessageEnvelope, *Message)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MessageEnvelope)
+	for i := range nodes {
+		if nodes[i].message_envelope_message == nil {
+			continue
+		}
+		fk := *nodes[i].message_envelope_message
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_envelope_message" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (meq *MessageEnvelopeQuery) loadDevice(ctx context.Context, query *DeviceQuery, nodes []*MessageEnvelope, init func(*MessageEnvelope), assign func(*MessageEnvelope, *Device)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MessageEnvelope)
+	for i := range nodes {
+		if nodes[i].message_envelope_device == nil {
+			continue
+		}
+		fk := *nodes[i].message_envelope_device
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(device.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_envelope_device" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (meq *MessageEnvelopeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := meq.querySpec()
+	_spec.Node.Columns = meq.ctx.Fields
+	if len(meq.ctx.Fields) > 0 {
+		_spec.Unique = meq.ctx.Unique != nil && *meq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, meq.driver, _spec)
+}
+
+func (meq *MessageEnvelopeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(messageenvelope.Table, messageenvelope.Columns, sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt))
+	_spec.From = meq.sql
+	if unique := meq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if meq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := meq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messageenvelope.FieldID)
+		for i := range fields {
+			if fields[i] != messageenvelope.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := meq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := meq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := meq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := meq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (meq *MessageEnvelopeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(meq.driver.Dialect())
+	t1 := builder.Table(messageenvelope.Table)
+	columns := meq.ctx.Fields
+	if len(columns) == 0 {
+		columns = messageenvelope.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if meq.sql != nil {
+		selector = meq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if meq.ctx.Unique != nil && *meq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range meq.predicates {
+		p(selector)
+	}
+	for _, p := range meq.order {
+		p(selector)
+	}
+	if offset := meq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := meq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MessageEnvelopeGroupBy is the group-by builder for MessageEnvelope entities.
+type MessageEnvelopeGroupBy struct {
+	selector
+	build *MessageEnvelopeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (megb *MessageEnvelopeGroupBy) Aggregate(fns ...AggregateFunc) *MessageEnvelopeGroupBy {
+	megb.fns = append(megb.fns, fns...)
+	return megb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (megb *MessageEnvelopeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, megb.build.ctx, "GroupBy")
+	if err := megb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageEnvelopeQuery, *MessageEnvelopeGroupBy](ctx, megb.build, megb, megb.build.inters, v)
+}
+
+func (megb *MessageEnvelopeGroupBy) sqlScan(ctx context.Context, root *MessageEnvelopeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(megb.fns))
+	for _, fn := range megb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*megb.flds)+len(megb.fns))
+		for _, f := range *megb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*megb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := megb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MessageEnvelopeSelect is the builder for selecting fields of MessageEnvelope entities.
+type MessageEnvelopeSelect struct {
+	*MessageEnvelopeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mes *MessageEnvelopeSelect) Aggregate(fns ...AggregateFunc) *MessageEnvelopeSelect {
+	mes.fns = append(mes.fns, fns...)
+	return mes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mes *MessageEnvelopeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mes.ctx, "Select")
+	if err := mes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageEnvelopeQuery, *MessageEnvelopeSelect](ctx, mes.MessageEnvelopeQuery, mes, mes.inters, v)
+}
+
+func (mes *MessageEnvelopeSelect) sqlScan(ctx context.Context, root *MessageEnvelopeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mes.fns))
+	for _, fn := range mes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
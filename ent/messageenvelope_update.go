@@ -0,0 +1,468 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// MessageEnvelopeUpdate is the builder for updating MessageEnvelope entities.
+type MessageEnvelopeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageEnvelopeMutation
+}
+
+// Where appends a list predicates to the MessageEnvelopeUpdate builder.
+func (meu *MessageEnvelopeUpdate) Where(ps ...predicate.MessageEnvelope) *MessageEnvelopeUpdate {
+	meu.mutation.Where(ps...)
+	return meu
+}
+
+// SetCipherText sets the "cipher_text" field.
+func (meu *MessageEnvelopeUpdate) SetCipherText(s string) *MessageEnvelopeUpdate {
+	meu.mutation.SetCipherText(s)
+	return meu
+}
+
+// SetNillableCipherText sets the "cipher_text" field if the given value is not nil.
+func (meu *MessageEnvelopeUpdate) SetNillableCipherText(s *string) *MessageEnvelopeUpdate {
+	if s != nil {
+		meu.SetCipherText(*s)
+	}
+	return meu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (meu *MessageEnvelopeUpdate) SetCreatedAt(t time.Time) *MessageEnvelopeUpdate {
+	meu.mutation.SetCreatedAt(t)
+	return meu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (meu *MessageEnvelopeUpdate) SetNillableCreatedAt(t *time.Time) *MessageEnvelopeUpdate {
+	if t != nil {
+		meu.SetCreatedAt(*t)
+	}
+	return meu
+}
+
+// SetMessageID sets the "message" edge to the Message entity by ID.
+func (meu *MessageEnvelopeUpdate) SetMessageID(id int) *MessageEnvelopeUpdate {
+	meu.mutation.SetMessageID(id)
+	return meu
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (meu *MessageEnvelopeUpdate) SetMessage(m *Message) *MessageEnvelopeUpdate {
+	return meu.SetMessageID(m.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (meu *MessageEnvelopeUpdate) SetDeviceID(id int) *MessageEnvelopeUpdate {
+	meu.mutation.SetDeviceID(id)
+	return meu
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (meu *MessageEnvelopeUpdate) SetDevice(d *Device) *MessageEnvelopeUpdate {
+	return meu.SetDeviceID(d.ID)
+}
+
+// Mutation returns the MessageEnvelopeMutation object of the builder.
+func (meu *MessageEnvelopeUpdate) Mutation() *MessageEnvelopeMutation {
+	return meu.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (meu *MessageEnvelopeUpdate) ClearMessage() *MessageEnvelopeUpdate {
+	meu.mutation.ClearMessage()
+	return meu
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (meu *MessageEnvelopeUpdate) ClearDevice() *MessageEnvelopeUpdate {
+	meu.mutation.ClearDevice()
+	return meu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (meu *MessageEnvelopeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, meu.sqlSave, meu.mutation, meu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (meu *MessageEnvelopeUpdate) SaveX(ctx context.Context) int {
+	affected, err := meu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (meu *MessageEnvelopeUpdate) Exec(ctx context.Context) error {
+	_, err := meu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (meu *MessageEnvelopeUpdate) ExecX(ctx context.Context) {
+	if err := meu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (meu *MessageEnvelopeUpdate) check() error {
+	if v, ok := meu.mutation.CipherText(); ok {
+		if err := messageenvelope.CipherTextValidator(v); err != nil {
+			return &ValidationError{Name: "cipher_text", err: fmt.Errorf(`ent: validator failed for field "MessageEnvelope.cipher_text": %w`, err)}
+		}
+	}
+	if _, ok := meu.mutation.MessageID(); meu.mutation.MessageCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MessageEnvelope.message"`)
+	}
+	if _, ok := meu.mutation.DeviceID(); meu.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MessageEnvelope.device"`)
+	}
+	return nil
+}
+
+func (meu *MessageEnvelopeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := meu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messageenvelope.Table, messageenvelope.Columns, sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt))
+	if ps := meu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := meu.mutation.CipherText(); ok {
+		_spec.SetField(messageenvelope.FieldCipherText, field.TypeString, value)
+	}
+	if value, ok := meu.mutation.CreatedAt(); ok {
+		_spec.SetField(messageenvelope.FieldCreatedAt, field.TypeTime, value)
+	}
+	if meu.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messageenvelope.MessageTable,
+			Columns: []string{messageenvelope.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := meu.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messageenvelope.MessageTable,
+			Columns: []string{messageenvelope.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if meu.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messageenvelope.DeviceTable,
+			Columns: []string{messageenvelope.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := meu.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messageenvelope.DeviceTable,
+			Columns: []string{messageenvelope.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, meu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messageenvelope.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	meu.mutation.done = true
+	return n, nil
+}
+
+// MessageEnvelopeUpdateOne is the builder for updating a single MessageEnvelope entity.
+type MessageEnvelopeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageEnvelopeMutation
+}
+
+// SetCipherText sets the "cipher_text" field.
+func (meuo *MessageEnvelopeUpdateOne) SetCipherText(s string) *MessageEnvelopeUpdateOne {
+	meuo.mutation.SetCipherText(s)
+	return meuo
+}
+
+// SetNillableCipherText sets the "cipher_text" field if the given value is not nil.
+func (meuo *MessageEnvelopeUpdateOne) SetNillableCipherText(s *string) *MessageEnvelopeUpdateOne {
+	if s != nil {
+		meuo.SetCipherText(*s)
+	}
+	return meuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (meuo *MessageEnvelopeUpdateOne) SetCreatedAt(t time.Time) *MessageEnvelopeUpdateOne {
+	meuo.mutation.SetCreatedAt(t)
+	return meuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (meuo *MessageEnvelopeUpdateOne) SetNillableCreatedAt(t *time.Time) *MessageEnvelopeUpdateOne {
+	if t != nil {
+		meuo.SetCreatedAt(*t)
+	}
+	return meuo
+}
+
+// SetMessageID sets the "message" edge to the Message entity by ID.
+func (meuo *MessageEnvelopeUpdateOne) SetMessageID(id int) *MessageEnvelopeUpdateOne {
+	meuo.mutation.SetMessageID(id)
+	return meuo
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (meuo *MessageEnvelopeUpdateOne) SetMessage(m *Message) *MessageEnvelopeUpdateOne {
+	return meuo.SetMessageID(m.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (meuo *MessageEnvelopeUpdateOne) SetDeviceID(id int) *MessageEnvelopeUpdateOne {
+	meuo.mutation.SetDeviceID(id)
+	return meuo
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (meuo *MessageEnvelopeUpdateOne) SetDevice(d *Device) *MessageEnvelopeUpdateOne {
+	return meuo.SetDeviceID(d.ID)
+}
+
+// Mutation returns the MessageEnvelopeMutation object of the builder.
+func (meuo *MessageEnvelopeUpdateOne) Mutation() *MessageEnvelopeMutation {
+	return meuo.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (meuo *MessageEnvelopeUpdateOne) ClearMessage() *MessageEnvelopeUpdateOne {
+	meuo.mutation.ClearMessage()
+	return meuo
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (meuo *MessageEnvelopeUpdateOne) ClearDevice() *MessageEnvelopeUpdateOne {
+	meuo.mutation.ClearDevice()
+	return meuo
+}
+
+// Where appends a list predicates to the MessageEnvelopeUpdate builder.
+func (meuo *MessageEnvelopeUpdateOne) Where(ps ...predicate.MessageEnvelope) *MessageEnvelopeUpdateOne {
+	meuo.mutation.Where(ps...)
+	return meuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (meuo *MessageEnvelopeUpdateOne) Select(field string, fields ...string) *MessageEnvelopeUpdateOne {
+	meuo.fields = append([]string{field}, fields...)
+	return meuo
+}
+
+// Save executes the query and returns the updated MessageEnvelope entity.
+func (meuo *MessageEnvelopeUpdateOne) Save(ctx context.Context) (*MessageEnvelope, error) {
+	return withHooks(ctx, meuo.sqlSave, meuo.mutation, meuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (meuo *MessageEnvelopeUpdateOne) SaveX(ctx context.Context) *MessageEnvelope {
+	node, err := meuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (meuo *MessageEnvelopeUpdateOne) Exec(ctx context.Context) error {
+	_, err := meuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (meuo *MessageEnvelopeUpdateOne) ExecX(ctx context.Context) {
+	if err := meuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (meuo *MessageEnvelopeUpdateOne) check() error {
+	if v, ok := meuo.mutation.CipherText(); ok {
+		if err := messageenvelope.CipherTextValidator(v); err != nil {
+			return &ValidationError{Name: "cipher_text", err: fmt.Errorf(`ent: validator failed for field "MessageEnvelope.cipher_text": %w`, err)}
+		}
+	}
+	if _, ok := meuo.mutation.MessageID(); meuo.mutation.MessageCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MessageEnvelope.message"`)
+	}
+	if _, ok := meuo.mutation.DeviceID(); meuo.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MessageEnvelope.device"`)
+	}
+	return nil
+}
+
+func (meuo *MessageEnvelopeUpdateOne) sqlSave(ctx context.Context) (_node *MessageEnvelope, err error) {
+	if err := meuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messageenvelope.Table, messageenvelope.Columns, sqlgraph.NewFieldSpec(messageenvelope.FieldID, field.TypeInt))
+	id, ok := meuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageEnvelope.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := meuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messageenvelope.FieldID)
+		for _, f := range fields {
+			if !messageenvelope.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messageenvelope.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := meuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := meuo.mutation.CipherText(); ok {
+		_spec.SetField(messageenvelope.FieldCipherText, field.TypeString, value)
+	}
+	if value, ok := meuo.mutation.CreatedAt(); ok {
+		_spec.SetField(messageenvelope.FieldCreatedAt, field.TypeTime, value)
+	}
+	if meuo.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messageenvelope.MessageTable,
+			Columns: []string{messageenvelope.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := meuo.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messageenvelope.MessageTable,
+			Columns: []string{messageenvelope.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if meuo.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messageenvelope.DeviceTable,
+			Columns: []string{messageenvelope.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := meuo.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   messageenvelope.DeviceTable,
+			Columns: []string{messageenvelope.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MessageEnvelope{config: meuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, meuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messageenvelope.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	meuo.mutation.done = true
+	return _node, nil
+}
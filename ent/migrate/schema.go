@@ -8,6 +8,40 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString, Unique: true},
+		{Name: "key_hash", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_key_user", Type: field.TypeInt},
+		{Name: "api_key_created_by", Type: field.TypeInt, Nullable: true},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_users_user",
+				Columns:    []*schema.Column{APIKeysColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "api_keys_users_created_by",
+				Columns:    []*schema.Column{APIKeysColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// CallLogsColumns holds the columns for the "call_logs" table.
 	CallLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -105,6 +139,84 @@ var (
 			},
 		},
 	}
+	// CredentialsColumns holds the columns for the "credentials" table.
+	CredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "password_updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CredentialsTable holds the schema information for the "credentials" table.
+	CredentialsTable = &schema.Table{
+		Name:       "credentials",
+		Columns:    CredentialsColumns,
+		PrimaryKey: []*schema.Column{CredentialsColumns[0]},
+	}
+	// DevicesColumns holds the columns for the "devices" table.
+	DevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "device_id", Type: field.TypeInt},
+		{Name: "registration_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "low_prekeys_notified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "device_user", Type: field.TypeInt},
+		{Name: "identity_key_device", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// DevicesTable holds the schema information for the "devices" table.
+	DevicesTable = &schema.Table{
+		Name:       "devices",
+		Columns:    DevicesColumns,
+		PrimaryKey: []*schema.Column{DevicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "devices_users_user",
+				Columns:    []*schema.Column{DevicesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "devices_identity_keys_device",
+				Columns:    []*schema.Column{DevicesColumns[8]},
+				RefColumns: []*schema.Column{IdentityKeysColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "device_device_id_device_user",
+				Unique:  true,
+				Columns: []*schema.Column{DevicesColumns[1], DevicesColumns[7]},
+			},
+		},
+	}
+	// EmailTokensColumns holds the columns for the "email_tokens" table.
+	EmailTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"verify_email", "reset_password"}},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "email_token_user", Type: field.TypeInt},
+	}
+	// EmailTokensTable holds the schema information for the "email_tokens" table.
+	EmailTokensTable = &schema.Table{
+		Name:       "email_tokens",
+		Columns:    EmailTokensColumns,
+		PrimaryKey: []*schema.Column{EmailTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_tokens_users_user",
+				Columns:    []*schema.Column{EmailTokensColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// FavouritesColumns holds the columns for the "favourites" table.
 	FavouritesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -139,6 +251,263 @@ var (
 			},
 		},
 	}
+	// IdentitiesColumns holds the columns for the "identities" table.
+	IdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "issuer", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_login_at", Type: field.TypeTime},
+		{Name: "identity_user", Type: field.TypeInt},
+	}
+	// IdentitiesTable holds the schema information for the "identities" table.
+	IdentitiesTable = &schema.Table{
+		Name:       "identities",
+		Columns:    IdentitiesColumns,
+		PrimaryKey: []*schema.Column{IdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identities_users_user",
+				Columns:    []*schema.Column{IdentitiesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "identity_issuer_subject",
+				Unique:  true,
+				Columns: []*schema.Column{IdentitiesColumns[1], IdentitiesColumns[2]},
+			},
+		},
+	}
+	// IdentityKeysColumns holds the columns for the "identity_keys" table.
+	IdentityKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "public_key", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// IdentityKeysTable holds the schema information for the "identity_keys" table.
+	IdentityKeysTable = &schema.Table{
+		Name:       "identity_keys",
+		Columns:    IdentityKeysColumns,
+		PrimaryKey: []*schema.Column{IdentityKeysColumns[0]},
+	}
+	// IdentityKeyRecordsColumns holds the columns for the "identity_key_records" table.
+	IdentityKeyRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "public_key", Type: field.TypeString},
+		{Name: "device_number", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "identity_key_record_user", Type: field.TypeInt, Nullable: true},
+		{Name: "identity_key_record_device", Type: field.TypeInt, Nullable: true},
+	}
+	// IdentityKeyRecordsTable holds the schema information for the "identity_key_records" table.
+	IdentityKeyRecordsTable = &schema.Table{
+		Name:       "identity_key_records",
+		Columns:    IdentityKeyRecordsColumns,
+		PrimaryKey: []*schema.Column{IdentityKeyRecordsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identity_key_records_users_user",
+				Columns:    []*schema.Column{IdentityKeyRecordsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "identity_key_records_devices_device",
+				Columns:    []*schema.Column{IdentityKeyRecordsColumns[5]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "identitykeyrecord_created_at_identity_key_record_device",
+				Unique:  false,
+				Columns: []*schema.Column{IdentityKeyRecordsColumns[3], IdentityKeyRecordsColumns[5]},
+			},
+			{
+				Name:    "identitykeyrecord_device_number_created_at_identity_key_record_user",
+				Unique:  false,
+				Columns: []*schema.Column{IdentityKeyRecordsColumns[2], IdentityKeyRecordsColumns[3], IdentityKeyRecordsColumns[4]},
+			},
+		},
+	}
+	// KeyBackupKeysColumns holds the columns for the "key_backup_keys" table.
+	KeyBackupKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "session_id", Type: field.TypeString},
+		{Name: "first_message_index", Type: field.TypeInt},
+		{Name: "forwarded_count", Type: field.TypeInt},
+		{Name: "is_verified", Type: field.TypeBool, Default: false},
+		{Name: "session_data", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "key_backup_key_version", Type: field.TypeInt},
+		{Name: "key_backup_key_room", Type: field.TypeInt},
+	}
+	// KeyBackupKeysTable holds the schema information for the "key_backup_keys" table.
+	KeyBackupKeysTable = &schema.Table{
+		Name:       "key_backup_keys",
+		Columns:    KeyBackupKeysColumns,
+		PrimaryKey: []*schema.Column{KeyBackupKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "key_backup_keys_key_backup_versions_version",
+				Columns:    []*schema.Column{KeyBackupKeysColumns[7]},
+				RefColumns: []*schema.Column{KeyBackupVersionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "key_backup_keys_rooms_room",
+				Columns:    []*schema.Column{KeyBackupKeysColumns[8]},
+				RefColumns: []*schema.Column{RoomsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "keybackupkey_session_id_key_backup_key_version_key_backup_key_room",
+				Unique:  true,
+				Columns: []*schema.Column{KeyBackupKeysColumns[1], KeyBackupKeysColumns[7], KeyBackupKeysColumns[8]},
+			},
+		},
+	}
+	// KeyBackupVersionsColumns holds the columns for the "key_backup_versions" table.
+	KeyBackupVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "algorithm", Type: field.TypeString},
+		{Name: "auth_data", Type: field.TypeString},
+		{Name: "etag", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "key_backup_version_owner", Type: field.TypeInt},
+	}
+	// KeyBackupVersionsTable holds the schema information for the "key_backup_versions" table.
+	KeyBackupVersionsTable = &schema.Table{
+		Name:       "key_backup_versions",
+		Columns:    KeyBackupVersionsColumns,
+		PrimaryKey: []*schema.Column{KeyBackupVersionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "key_backup_versions_users_owner",
+				Columns:    []*schema.Column{KeyBackupVersionsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// KeyLogEntriesColumns holds the columns for the "key_log_entries" table.
+	KeyLogEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "leaf_index", Type: field.TypeInt, Unique: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "device_id", Type: field.TypeInt},
+		{Name: "identity_key", Type: field.TypeString},
+		{Name: "published_at", Type: field.TypeTime},
+		{Name: "leaf_hash", Type: field.TypeBytes},
+	}
+	// KeyLogEntriesTable holds the schema information for the "key_log_entries" table.
+	KeyLogEntriesTable = &schema.Table{
+		Name:       "key_log_entries",
+		Columns:    KeyLogEntriesColumns,
+		PrimaryKey: []*schema.Column{KeyLogEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "keylogentry_user_id_device_id",
+				Unique:  false,
+				Columns: []*schema.Column{KeyLogEntriesColumns[2], KeyLogEntriesColumns[3]},
+			},
+		},
+	}
+	// KeyLogHeadsColumns holds the columns for the "key_log_heads" table.
+	KeyLogHeadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tree_size", Type: field.TypeInt, Default: 0},
+		{Name: "root_hash", Type: field.TypeBytes},
+	}
+	// KeyLogHeadsTable holds the schema information for the "key_log_heads" table.
+	KeyLogHeadsTable = &schema.Table{
+		Name:       "key_log_heads",
+		Columns:    KeyLogHeadsColumns,
+		PrimaryKey: []*schema.Column{KeyLogHeadsColumns[0]},
+	}
+	// KeyLogNodesColumns holds the columns for the "key_log_nodes" table.
+	KeyLogNodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "level", Type: field.TypeInt},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "hash", Type: field.TypeBytes},
+	}
+	// KeyLogNodesTable holds the schema information for the "key_log_nodes" table.
+	KeyLogNodesTable = &schema.Table{
+		Name:       "key_log_nodes",
+		Columns:    KeyLogNodesColumns,
+		PrimaryKey: []*schema.Column{KeyLogNodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "keylognode_level_position",
+				Unique:  true,
+				Columns: []*schema.Column{KeyLogNodesColumns[1], KeyLogNodesColumns[2]},
+			},
+		},
+	}
+	// KeyPackagesColumns holds the columns for the "key_packages" table.
+	KeyPackagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key_package", Type: field.TypeString},
+		{Name: "cipher_suite", Type: field.TypeInt},
+		{Name: "last_resort", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "key_package_device", Type: field.TypeInt},
+	}
+	// KeyPackagesTable holds the schema information for the "key_packages" table.
+	KeyPackagesTable = &schema.Table{
+		Name:       "key_packages",
+		Columns:    KeyPackagesColumns,
+		PrimaryKey: []*schema.Column{KeyPackagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "key_packages_devices_device",
+				Columns:    []*schema.Column{KeyPackagesColumns[5]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// LoginThrottlesColumns holds the columns for the "login_throttles" table.
+	LoginThrottlesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"account_ip", "account", "ip"}},
+		{Name: "key", Type: field.TypeString},
+		{Name: "failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_failure_at", Type: field.TypeTime},
+		{Name: "retry_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "login_throttle_user", Type: field.TypeInt, Nullable: true},
+	}
+	// LoginThrottlesTable holds the schema information for the "login_throttles" table.
+	LoginThrottlesTable = &schema.Table{
+		Name:       "login_throttles",
+		Columns:    LoginThrottlesColumns,
+		PrimaryKey: []*schema.Column{LoginThrottlesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "login_throttles_users_user",
+				Columns:    []*schema.Column{LoginThrottlesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginthrottle_scope_key",
+				Unique:  true,
+				Columns: []*schema.Column{LoginThrottlesColumns[1], LoginThrottlesColumns[2]},
+			},
+		},
+	}
 	// MediaColumns holds the columns for the "media" table.
 	MediaColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -174,13 +543,17 @@ var (
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "cipher_text", Type: field.TypeString},
+		{Name: "cipher_text", Type: field.TypeString, Nullable: true},
+		{Name: "sender_device_id", Type: field.TypeInt, Nullable: true},
 		{Name: "content_type", Type: field.TypeString, Default: "text/plain"},
 		{Name: "encryption_scheme", Type: field.TypeString, Default: "signal"},
+		{Name: "encryption_version", Type: field.TypeInt, Nullable: true},
 		{Name: "edited", Type: field.TypeBool, Default: false},
+		{Name: "seq", Type: field.TypeInt, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "message_sender", Type: field.TypeInt},
+		{Name: "message_sender", Type: field.TypeInt, Nullable: true},
 		{Name: "message_room", Type: field.TypeInt},
 	}
 	// MessagesTable holds the schema information for the "messages" table.
@@ -191,17 +564,137 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_users_sender",
-				Columns:    []*schema.Column{MessagesColumns[7]},
+				Columns:    []*schema.Column{MessagesColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "messages_rooms_room",
-				Columns:    []*schema.Column{MessagesColumns[8]},
+				Columns:    []*schema.Column{MessagesColumns[12]},
 				RefColumns: []*schema.Column{RoomsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "message_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[8]},
+			},
+			{
+				Name:    "message_seq_message_room",
+				Unique:  true,
+				Columns: []*schema.Column{MessagesColumns[7], MessagesColumns[12]},
+			},
+		},
+	}
+	// MessageEnvelopesColumns holds the columns for the "message_envelopes" table.
+	MessageEnvelopesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "cipher_text", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_envelope_message", Type: field.TypeInt},
+		{Name: "message_envelope_device", Type: field.TypeInt},
+	}
+	// MessageEnvelopesTable holds the schema information for the "message_envelopes" table.
+	MessageEnvelopesTable = &schema.Table{
+		Name:       "message_envelopes",
+		Columns:    MessageEnvelopesColumns,
+		PrimaryKey: []*schema.Column{MessageEnvelopesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_envelopes_messages_message",
+				Columns:    []*schema.Column{MessageEnvelopesColumns[3]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "message_envelopes_devices_device",
+				Columns:    []*schema.Column{MessageEnvelopesColumns[4]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messageenvelope_message_envelope_message_message_envelope_device",
+				Unique:  true,
+				Columns: []*schema.Column{MessageEnvelopesColumns[3], MessageEnvelopesColumns[4]},
+			},
+		},
+	}
+	// MlsCommitsColumns holds the columns for the "mls_commits" table.
+	MlsCommitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "epoch", Type: field.TypeInt},
+		{Name: "sender_device_id", Type: field.TypeInt},
+		{Name: "message", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "mls_commit_room", Type: field.TypeInt},
+		{Name: "mls_commit_sender", Type: field.TypeInt},
+	}
+	// MlsCommitsTable holds the schema information for the "mls_commits" table.
+	MlsCommitsTable = &schema.Table{
+		Name:       "mls_commits",
+		Columns:    MlsCommitsColumns,
+		PrimaryKey: []*schema.Column{MlsCommitsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "mls_commits_rooms_room",
+				Columns:    []*schema.Column{MlsCommitsColumns[5]},
+				RefColumns: []*schema.Column{RoomsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "mls_commits_users_sender",
+				Columns:    []*schema.Column{MlsCommitsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mlscommit_epoch_mls_commit_room",
+				Unique:  true,
+				Columns: []*schema.Column{MlsCommitsColumns[1], MlsCommitsColumns[5]},
+			},
+		},
+	}
+	// MlsWelcomesColumns holds the columns for the "mls_welcomes" table.
+	MlsWelcomesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "epoch", Type: field.TypeInt},
+		{Name: "message", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "mls_welcome_room", Type: field.TypeInt},
+		{Name: "mls_welcome_sender", Type: field.TypeInt},
+		{Name: "mls_welcome_device", Type: field.TypeInt},
+	}
+	// MlsWelcomesTable holds the schema information for the "mls_welcomes" table.
+	MlsWelcomesTable = &schema.Table{
+		Name:       "mls_welcomes",
+		Columns:    MlsWelcomesColumns,
+		PrimaryKey: []*schema.Column{MlsWelcomesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "mls_welcomes_rooms_room",
+				Columns:    []*schema.Column{MlsWelcomesColumns[4]},
+				RefColumns: []*schema.Column{RoomsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "mls_welcomes_users_sender",
+				Columns:    []*schema.Column{MlsWelcomesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "mls_welcomes_devices_device",
+				Columns:    []*schema.Column{MlsWelcomesColumns[6]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
 	}
 	// NotificationsColumns holds the columns for the "notifications" table.
 	NotificationsColumns = []*schema.Column{
@@ -209,6 +702,7 @@ var (
 		{Name: "kind", Type: field.TypeString},
 		{Name: "cipher_text", Type: field.TypeString},
 		{Name: "encryption_scheme", Type: field.TypeString, Default: "signal"},
+		{Name: "encryption_version", Type: field.TypeInt, Nullable: true},
 		{Name: "read", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -224,24 +718,75 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "notifications_users_recipient",
-				Columns:    []*schema.Column{NotificationsColumns[7]},
+				Columns:    []*schema.Column{NotificationsColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "notifications_rooms_room",
-				Columns:    []*schema.Column{NotificationsColumns[8]},
+				Columns:    []*schema.Column{NotificationsColumns[9]},
 				RefColumns: []*schema.Column{RoomsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "notifications_messages_message",
-				Columns:    []*schema.Column{NotificationsColumns[9]},
+				Columns:    []*schema.Column{NotificationsColumns[10]},
 				RefColumns: []*schema.Column{MessagesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// OneTimePreKeysColumns holds the columns for the "one_time_pre_keys" table.
+	OneTimePreKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key_id", Type: field.TypeInt},
+		{Name: "public_key", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "one_time_pre_key_device", Type: field.TypeInt},
+	}
+	// OneTimePreKeysTable holds the schema information for the "one_time_pre_keys" table.
+	OneTimePreKeysTable = &schema.Table{
+		Name:       "one_time_pre_keys",
+		Columns:    OneTimePreKeysColumns,
+		PrimaryKey: []*schema.Column{OneTimePreKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "one_time_pre_keys_devices_device",
+				Columns:    []*schema.Column{OneTimePreKeysColumns[4]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "onetimeprekey_key_id_one_time_pre_key_device",
+				Unique:  true,
+				Columns: []*schema.Column{OneTimePreKeysColumns[1], OneTimePreKeysColumns[4]},
+			},
+		},
+	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "recovery_code_user", Type: field.TypeInt},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_user",
+				Columns:    []*schema.Column{RecoveryCodesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// RoomsColumns holds the columns for the "rooms" table.
 	RoomsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -249,6 +794,15 @@ var (
 		{Name: "description", Type: field.TypeString, Default: ""},
 		{Name: "is_private", Type: field.TypeBool, Default: false},
 		{Name: "is_direct", Type: field.TypeBool, Default: false},
+		{Name: "mls_group_id", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "mls_epoch", Type: field.TypeInt, Default: 0},
+		{Name: "mls_pending_removals", Type: field.TypeJSON, Nullable: true},
+		{Name: "sender_keys_rotated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "encryption_policy", Type: field.TypeJSON, Nullable: true},
+		{Name: "message_ttl", Type: field.TypeInt, Nullable: true},
+		{Name: "sealed_sender", Type: field.TypeBool, Default: false},
+		{Name: "delivery_token", Type: field.TypeString, Nullable: true},
+		{Name: "message_seq", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "room_owner", Type: field.TypeInt},
@@ -261,7 +815,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "rooms_users_owner",
-				Columns:    []*schema.Column{RoomsColumns[7]},
+				Columns:    []*schema.Column{RoomsColumns[16]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -305,55 +859,251 @@ var (
 			},
 		},
 	}
+	// SenderKeyDistributionsColumns holds the columns for the "sender_key_distributions" table.
+	SenderKeyDistributionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "distribution_id", Type: field.TypeString},
+		{Name: "sender_device_id", Type: field.TypeInt},
+		{Name: "cipher_text", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "sender_key_distribution_room", Type: field.TypeInt},
+		{Name: "sender_key_distribution_sender", Type: field.TypeInt},
+		{Name: "sender_key_distribution_device", Type: field.TypeInt},
+	}
+	// SenderKeyDistributionsTable holds the schema information for the "sender_key_distributions" table.
+	SenderKeyDistributionsTable = &schema.Table{
+		Name:       "sender_key_distributions",
+		Columns:    SenderKeyDistributionsColumns,
+		PrimaryKey: []*schema.Column{SenderKeyDistributionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sender_key_distributions_rooms_room",
+				Columns:    []*schema.Column{SenderKeyDistributionsColumns[5]},
+				RefColumns: []*schema.Column{RoomsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "sender_key_distributions_users_sender",
+				Columns:    []*schema.Column{SenderKeyDistributionsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "sender_key_distributions_devices_device",
+				Columns:    []*schema.Column{SenderKeyDistributionsColumns[7]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "device_label", Type: field.TypeString, Default: ""},
+		{Name: "ip_address", Type: field.TypeString, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "refresh_token_hash", Type: field.TypeString},
+		{Name: "retired_token_hashes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revocation_reason", Type: field.TypeString, Nullable: true},
+		{Name: "mfa_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "session_user", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_user",
+				Columns:    []*schema.Column{SessionsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SignedPreKeysColumns holds the columns for the "signed_pre_keys" table.
+	SignedPreKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key_id", Type: field.TypeInt},
+		{Name: "public_key", Type: field.TypeString},
+		{Name: "signature", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "signed_pre_key_device", Type: field.TypeInt},
+	}
+	// SignedPreKeysTable holds the schema information for the "signed_pre_keys" table.
+	SignedPreKeysTable = &schema.Table{
+		Name:       "signed_pre_keys",
+		Columns:    SignedPreKeysColumns,
+		PrimaryKey: []*schema.Column{SignedPreKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "signed_pre_keys_devices_device",
+				Columns:    []*schema.Column{SignedPreKeysColumns[5]},
+				RefColumns: []*schema.Column{DevicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "signedprekey_key_id_signed_pre_key_device",
+				Unique:  true,
+				Columns: []*schema.Column{SignedPreKeysColumns[1], SignedPreKeysColumns[5]},
+			},
+		},
+	}
+	// TotpSecretsColumns holds the columns for the "totp_secrets" table.
+	TotpSecretsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "confirmed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_step", Type: field.TypeInt64, Default: 0},
+		{Name: "failed_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TotpSecretsTable holds the schema information for the "totp_secrets" table.
+	TotpSecretsTable = &schema.Table{
+		Name:       "totp_secrets",
+		Columns:    TotpSecretsColumns,
+		PrimaryKey: []*schema.Column{TotpSecretsColumns[0]},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "username", Type: field.TypeString, Unique: true},
 		{Name: "display_name", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
 		{Name: "avatar_url", Type: field.TypeString, Nullable: true},
+		{Name: "is_bot", Type: field.TypeBool, Default: false},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "moderator", "admin"}, Default: "user"},
+		{Name: "suspended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "discoverability", Type: field.TypeEnum, Enums: []string{"everyone", "contacts", "nobody"}, Default: "everyone"},
+		{Name: "deletion_scheduled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "is_tombstone", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "credential_user", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "totp_secret_user", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "user_service_accounts", Type: field.TypeInt, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "users_credentials_user",
+				Columns:    []*schema.Column{UsersColumns[15]},
+				RefColumns: []*schema.Column{CredentialsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "users_totp_secrets_user",
+				Columns:    []*schema.Column{UsersColumns[16]},
+				RefColumns: []*schema.Column{TotpSecretsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "users_users_service_accounts",
+				Columns:    []*schema.Column{UsersColumns[17]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
 		CallLogsTable,
 		CallParticipantsTable,
 		ContactsTable,
+		CredentialsTable,
+		DevicesTable,
+		EmailTokensTable,
 		FavouritesTable,
+		IdentitiesTable,
+		IdentityKeysTable,
+		IdentityKeyRecordsTable,
+		KeyBackupKeysTable,
+		KeyBackupVersionsTable,
+		KeyLogEntriesTable,
+		KeyLogHeadsTable,
+		KeyLogNodesTable,
+		KeyPackagesTable,
+		LoginThrottlesTable,
 		MediaTable,
 		MessagesTable,
+		MessageEnvelopesTable,
+		MlsCommitsTable,
+		MlsWelcomesTable,
 		NotificationsTable,
+		OneTimePreKeysTable,
+		RecoveryCodesTable,
 		RoomsTable,
 		RoomMembershipsTable,
+		SenderKeyDistributionsTable,
+		SessionsTable,
+		SignedPreKeysTable,
+		TotpSecretsTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
+	APIKeysTable.ForeignKeys[1].RefTable = UsersTable
 	CallLogsTable.ForeignKeys[0].RefTable = UsersTable
 	CallLogsTable.ForeignKeys[1].RefTable = RoomsTable
 	CallParticipantsTable.ForeignKeys[0].RefTable = CallLogsTable
 	CallParticipantsTable.ForeignKeys[1].RefTable = UsersTable
 	ContactsTable.ForeignKeys[0].RefTable = UsersTable
 	ContactsTable.ForeignKeys[1].RefTable = UsersTable
+	DevicesTable.ForeignKeys[0].RefTable = UsersTable
+	DevicesTable.ForeignKeys[1].RefTable = IdentityKeysTable
+	EmailTokensTable.ForeignKeys[0].RefTable = UsersTable
 	FavouritesTable.ForeignKeys[0].RefTable = UsersTable
 	FavouritesTable.ForeignKeys[1].RefTable = RoomsTable
+	IdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	IdentityKeyRecordsTable.ForeignKeys[0].RefTable = UsersTable
+	IdentityKeyRecordsTable.ForeignKeys[1].RefTable = DevicesTable
+	KeyBackupKeysTable.ForeignKeys[0].RefTable = KeyBackupVersionsTable
+	KeyBackupKeysTable.ForeignKeys[1].RefTable = RoomsTable
+	KeyBackupVersionsTable.ForeignKeys[0].RefTable = UsersTable
+	KeyPackagesTable.ForeignKeys[0].RefTable = DevicesTable
+	LoginThrottlesTable.ForeignKeys[0].RefTable = UsersTable
 	MediaTable.ForeignKeys[0].RefTable = UsersTable
 	MediaTable.ForeignKeys[1].RefTable = MessagesTable
 	MessagesTable.ForeignKeys[0].RefTable = UsersTable
 	MessagesTable.ForeignKeys[1].RefTable = RoomsTable
+	MessageEnvelopesTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageEnvelopesTable.ForeignKeys[1].RefTable = DevicesTable
+	MlsCommitsTable.ForeignKeys[0].RefTable = RoomsTable
+	MlsCommitsTable.ForeignKeys[1].RefTable = UsersTable
+	MlsWelcomesTable.ForeignKeys[0].RefTable = RoomsTable
+	MlsWelcomesTable.ForeignKeys[1].RefTable = UsersTable
+	MlsWelcomesTable.ForeignKeys[2].RefTable = DevicesTable
 	NotificationsTable.ForeignKeys[0].RefTable = UsersTable
 	NotificationsTable.ForeignKeys[1].RefTable = RoomsTable
 	NotificationsTable.ForeignKeys[2].RefTable = MessagesTable
+	OneTimePreKeysTable.ForeignKeys[0].RefTable = DevicesTable
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
 	RoomsTable.ForeignKeys[0].RefTable = UsersTable
 	RoomMembershipsTable.ForeignKeys[0].RefTable = UsersTable
 	RoomMembershipsTable.ForeignKeys[1].RefTable = RoomsTable
+	SenderKeyDistributionsTable.ForeignKeys[0].RefTable = RoomsTable
+	SenderKeyDistributionsTable.ForeignKeys[1].RefTable = UsersTable
+	SenderKeyDistributionsTable.ForeignKeys[2].RefTable = DevicesTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	SignedPreKeysTable.ForeignKeys[0].RefTable = DevicesTable
+	UsersTable.ForeignKeys[0].RefTable = CredentialsTable
+	UsersTable.ForeignKeys[1].RefTable = TotpSecretsTable
+	UsersTable.ForeignKeys[2].RefTable = UsersTable
 }
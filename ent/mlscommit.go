@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// MlsCommit is the model entity for the MlsCommit schema.
+type MlsCommit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Epoch holds the value of the "epoch" field.
+	Epoch int `json:"epoch,omitempty"`
+	// SenderDeviceID holds the value of the "sender_device_id" field.
+	SenderDeviceID int `json:"sender_device_id,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MlsCommitQuery when eager-loading is set.
+	Edges             MlsCommitEdges `json:"edges"`
+	mls_commit_room   *int
+	mls_commit_sender *int
+	selectValues      sql.SelectValues
+}
+
+// MlsCommitEdges holds the relations/edges for other nodes in the graph.
+type MlsCommitEdges struct {
+	// Room holds the value of the room edge.
+	Room *Room `json:"room,omitempty"`
+	// Sender holds the value of the sender edge.
+	Sender *User `json:"sender,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MlsCommitEdges) RoomOrErr() (*Room, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: room.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
+// SenderOrErr returns the Sender value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MlsCommitEdges) SenderOrErr() (*User, error) {
+	if e.Sender != nil {
+		return e.Sender, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MlsCommit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mlscommit.FieldID, mlscommit.FieldEpoch, mlscommit.FieldSenderDeviceID:
+			values[i] = new(sql.NullInt64)
+		case mlscommit.FieldMessage:
+			values[i] = new(sql.NullString)
+		case mlscommit.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case mlscommit.ForeignKeys[0]: // mls_commit_room
+			values[i] = new(sql.NullInt64)
+		case mlscommit.ForeignKeys[1]: // mls_commit_sender
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MlsCommit fields.
+func (mc *MlsCommit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mlscommit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mc.ID = int(value.Int64)
+		case mlscommit.FieldEpoch:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field epoch", values[i])
+			} else if value.Valid {
+				mc.Epoch = int(value.Int64)
+			}
+		case mlscommit.FieldSenderDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_device_id", values[i])
+			} else if value.Valid {
+				mc.SenderDeviceID = int(value.Int64)
+			}
+		case mlscommit.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				mc.Message = value.String
+			}
+		case mlscommit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mc.CreatedAt = value.Time
+			}
+		case mlscommit.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field mls_commit_room", value)
+			} else if value.Valid {
+				mc.mls_commit_room = new(int)
+				*mc.mls_commit_room = int(value.Int64)
+			}
+		case mlscommit.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field mls_commit_sender", value)
+			} else if value.Valid {
+				mc.mls_commit_sender = new(int)
+				*mc.mls_commit_sender = int(value.Int64)
+			}
+		default:
+			mc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MlsCommit.
+// This includes values selected through modifiers, order, etc.
+func (mc *MlsCommit) Value(name string) (ent.Value, error) {
+	return mc.selectValues.Get(name)
+}
+
+// QueryRoom queries the "room" edge of the MlsCommit entity.
+func (mc *MlsCommit) QueryRoom() *RoomQuery {
+	return NewMlsCommitClient(mc.config).QueryRoom(mc)
+}
+
+// QuerySender queries the "sender" edge of the MlsCommit entity.
+func (mc *MlsCommit) QuerySender() *UserQuery {
+	return NewMlsCommitClient(mc.config).QuerySender(mc)
+}
+
+// Update returns a builder for updating this MlsCommit.
+// Note that you need to call MlsCommit.Unwrap() before calling this method if this MlsCommit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mc *MlsCommit) Update() *MlsCommitUpdateOne {
+	return NewMlsCommitClient(mc.config).UpdateOne(mc)
+}
+
+// Unwrap unwraps the MlsCommit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mc *MlsCommit) Unwrap() *MlsCommit {
+	_tx, ok := mc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MlsCommit is not a transactional entity")
+	}
+	mc.config.driver = _tx.drv
+	return mc
+}
+
+// String implements the fmt.Stringer.
+func (mc *MlsCommit) String() string {
+	var builder strings.Builder
+	builder.WriteString("MlsCommit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mc.ID))
+	builder.WriteString("epoch=")
+	builder.WriteString(fmt.Sprintf("%v", mc.Epoch))
+	builder.WriteString(", ")
+	builder.WriteString("sender_device_id=")
+	builder.WriteString(fmt.Sprintf("%v", mc.SenderDeviceID))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(mc.Message)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MlsCommits is a parsable slice of MlsCommit.
+type MlsCommits []*MlsCommit
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mlscommit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldLTE(FieldID, id))
+}
+
+// Epoch applies equality check predicate on the "epoch" field. It's identical to EpochEQ.
+func Epoch(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldEQ(FieldEpoch, v))
+}
+
+// SenderDeviceID applies equality check predicate on the "sender_device_id" field. It's identical to SenderDeviceIDEQ.
+func SenderDeviceID(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldEQ(FieldSenderDeviceID, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EpochEQ applies the EQ predicate on the "epoch" field.
+func EpochEQ(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldEQ(FieldEpoch, v))
+}
+
+// EpochNEQ applies the NEQ predicate on the "epoch" field.
+func EpochNEQ(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldNEQ(FieldEpoch, v))
+}
+
+// EpochIn applies the In predicate on the "epoch" field.
+func EpochIn(vs ...int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldIn(FieldEpoch, vs...))
+}
+
+// EpochNotIn applies the NotIn predicate on the "epoch" field.
+func EpochNotIn(vs ...int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldNotIn(FieldEpoch, vs...))
+}
+
+// EpochGT applies the GT predicate on the "epoch" field.
+func EpochGT(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldGT(FieldEpoch, v))
+}
+
+// EpochGTE applies the GTE predicate on the "epoch" field.
+func EpochGTE(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldGTE(FieldEpoch, v))
+}
+
+// EpochLT applies the LT predicate on the "epoch" field.
+func EpochLT(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldLT(FieldEpoch, v))
+}
+
+// EpochLTE applies the LTE predicate on the "epoch" field.
+func EpochLTE(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldLTE(FieldEpoch, v))
+}
+
+// SenderDeviceIDEQ applies the EQ predicate on the "sender_device_id" field.
+func SenderDeviceIDEQ(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldEQ(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDNEQ applies the NEQ predicate on the "sender_device_id" field.
+func SenderDeviceIDNEQ(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldNEQ(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDIn applies the In predicate on the "sender_device_id" field.
+func SenderDeviceIDIn(vs ...int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldIn(FieldSenderDeviceID, vs...))
+}
+
+// SenderDeviceIDNotIn applies the NotIn predicate on the "sender_device_id" field.
+func SenderDeviceIDNotIn(vs ...int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldNotIn(FieldSenderDeviceID, vs...))
+}
+
+// SenderDeviceIDGT applies the GT predicate on the "sender_device_id" field.
+func SenderDeviceIDGT(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldGT(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDGTE applies the GTE predicate on the "sender_device_id" field.
+func SenderDeviceIDGTE(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldGTE(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDLT applies the LT predicate on the "sender_device_id" field.
+func SenderDeviceIDLT(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldLT(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDLTE applies the LTE predicate on the "sender_device_id" field.
+func SenderDeviceIDLTE(v int) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldLTE(FieldSenderDeviceID, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRoom applies the HasEdge predicate on the "room" edge.
+func HasRoom() predicate.MlsCommit {
+	return predicate.MlsCommit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RoomTable, RoomColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoomWith applies the HasEdge predicate on the "room" edge with a given conditions (other predicates).
+func HasRoomWith(preds ...predicate.Room) predicate.MlsCommit {
+	return predicate.MlsCommit(func(s *sql.Selector) {
+		step := newRoomStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSender applies the HasEdge predicate on the "sender" edge.
+func HasSender() predicate.MlsCommit {
+	return predicate.MlsCommit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SenderTable, SenderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderWith applies the HasEdge predicate on the "sender" edge with a given conditions (other predicates).
+func HasSenderWith(preds ...predicate.User) predicate.MlsCommit {
+	return predicate.MlsCommit(func(s *sql.Selector) {
+		step := newSenderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MlsCommit) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MlsCommit) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MlsCommit) predicate.MlsCommit {
+	return predicate.MlsCommit(sql.NotPredicates(p))
+}
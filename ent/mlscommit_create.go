@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// MlsCommitCreate is the builder for creating a MlsCommit entity.
+type MlsCommitCreate struct {
+	config
+	mutation *MlsCommitMutation
+	hooks    []Hook
+}
+
+// SetEpoch sets the "epoch" field.
+func (mcc *MlsCommitCreate) SetEpoch(i int) *MlsCommitCreate {
+	mcc.mutation.SetEpoch(i)
+	return mcc
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (mcc *MlsCommitCreate) SetSenderDeviceID(i int) *MlsCommitCreate {
+	mcc.mutation.SetSenderDeviceID(i)
+	return mcc
+}
+
+// SetMessage sets the "message" field.
+func (mcc *MlsCommitCreate) SetMessage(s string) *MlsCommitCreate {
+	mcc.mutation.SetMessage(s)
+	return mcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mcc *MlsCommitCreate) SetCreatedAt(t time.Time) *MlsCommitCreate {
+	mcc.mutation.SetCreatedAt(t)
+	return mcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mcc *MlsCommitCreate) SetNillableCreatedAt(t *time.Time) *MlsCommitCreate {
+	if t != nil {
+		mcc.SetCreatedAt(*t)
+	}
+	return mcc
+}
+
+// SetRoomID sets the "room" edge to the Room entity by ID.
+func (mcc *MlsCommitCreate) SetRoomID(id int) *MlsCommitCreate {
+	mcc.mutation.SetRoomID(id)
+	return mcc
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (mcc *MlsCommitCreate) SetRoom(r *Room) *MlsCommitCreate {
+	return mcc.SetRoomID(r.ID)
+}
+
+// SetSenderID sets the "sender" edge to the User entity by ID.
+func (mcc *MlsCommitCreate) SetSenderID(id int) *MlsCommitCreate {
+	mcc.mutation.SetSenderID(id)
+	return mcc
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (mcc *MlsCommitCreate) SetSender(u *User) *MlsCommitCreate {
+	return mcc.SetSenderID(u.ID)
+}
+
+// Mutation returns the MlsCommitMutation object of the builder.
+func (mcc *MlsCommitCreate) Mutation() *MlsCommitMutation {
+	return mcc.mutation
+}
+
+// Save creates the MlsCommit in the database.
+func (mcc *MlsCommitCreate) Save(ctx context.Context) (*MlsCommit, error) {
+	if err := mcc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, mcc.sqlSave, mcc.mutation, mcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mcc *MlsCommitCreate) SaveX(ctx context.Context) *MlsCommit {
+	v, err := mcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcc *MlsCommitCreate) Exec(ctx context.Context) error {
+	_, err := mcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcc *MlsCommitCreate) ExecX(ctx context.Context) {
+	if err := mcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mcc *MlsCommitCreate) defaults() error {
+	if _, ok := mcc.mutation.CreatedAt(); !ok {
+		if mlscommit.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized mlscommit.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := mlscommit.DefaultCreatedAt()
+		mcc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcc *MlsCommitCreate) check() error {
+	if _, ok := mcc.mutation.Epoch(); !ok {
+		return &ValidationError{Name: "epoch", err: errors.New(`ent: missing required field "MlsCommit.epoch"`)}
+	}
+	if v, ok := mcc.mutation.Epoch(); ok {
+		if err := mlscommit.EpochValidator(v); err != nil {
+			return &ValidationError{Name: "epoch", err: fmt.Errorf(`ent: validator failed for field "MlsCommit.epoch": %w`, err)}
+		}
+	}
+	if _, ok := mcc.mutation.SenderDeviceID(); !ok {
+		return &ValidationError{Name: "sender_device_id", err: errors.New(`ent: missing required field "MlsCommit.sender_device_id"`)}
+	}
+	if v, ok := mcc.mutation.SenderDeviceID(); ok {
+		if err := mlscommit.SenderDeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_device_id", err: fmt.Errorf(`ent: validator failed for field "MlsCommit.sender_device_id": %w`, err)}
+		}
+	}
+	if _, ok := mcc.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "MlsCommit.message"`)}
+	}
+	if v, ok := mcc.mutation.Message(); ok {
+		if err := mlscommit.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "MlsCommit.message": %w`, err)}
+		}
+	}
+	if _, ok := mcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MlsCommit.created_at"`)}
+	}
+	if _, ok := mcc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room", err: errors.New(`ent: missing required edge "MlsCommit.room"`)}
+	}
+	if _, ok := mcc.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender", err: errors.New(`ent: missing required edge "MlsCommit.sender"`)}
+	}
+	return nil
+}
+
+func (mcc *MlsCommitCreate) sqlSave(ctx context.Context) (*MlsCommit, error) {
+	if err := mcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mcc.mutation.id = &_node.ID
+	mcc.mutation.done = true
+	return _node, nil
+}
+
+func (mcc *MlsCommitCreate) createSpec() (*MlsCommit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MlsCommit{config: mcc.config}
+		_spec = sqlgraph.NewCreateSpec(mlscommit.Table, sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt))
+	)
+	if value, ok := mcc.mutation.Epoch(); ok {
+		_spec.SetField(mlscommit.FieldEpoch, field.TypeInt, value)
+		_node.Epoch = value
+	}
+	if value, ok := mcc.mutation.SenderDeviceID(); ok {
+		_spec.SetField(mlscommit.FieldSenderDeviceID, field.TypeInt, value)
+		_node.SenderDeviceID = value
+	}
+	if value, ok := mcc.mutation.Message(); ok {
+		_spec.SetField(mlscommit.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := mcc.mutation.CreatedAt(); ok {
+		_spec.SetField(mlscommit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mcc.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlscommit.RoomTable,
+			Columns: []string{mlscommit.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.mls_commit_room = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mcc.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlscommit.SenderTable,
+			Columns: []string{mlscommit.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.mls_commit_sender = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MlsCommitCreateBulk is the builder for creating many MlsCommit entities in bulk.
+type MlsCommitCreateBulk struct {
+	config
+	err      error
+	builders []*MlsCommitCreate
+}
+
+// Save creates the MlsCommit entities in the database.
+func (mccb *MlsCommitCreateBulk) Save(ctx context.Context) ([]*MlsCommit, error) {
+	if mccb.err != nil {
+		return nil, mccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mccb.builders))
+	nodes := make([]*MlsCommit, len(mccb.builders))
+	mutators := make([]Mutator, len(mccb.builders))
+	for i := range mccb.builders {
+		func(i int, root context.Context) {
+			builder := mccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MlsCommitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mccb *MlsCommitCreateBulk) SaveX(ctx context.Context) []*MlsCommit {
+	v, err := mccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mccb *MlsCommitCreateBulk) Exec(ctx context.Context) error {
+	_, err := mccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mccb *MlsCommitCreateBulk) ExecX(ctx context.Context) {
+	if err := mccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// MlsCommitDelete is the builder for deleting a MlsCommit entity.
+type MlsCommitDelete struct {
+	config
+	hooks    []Hook
+	mutation *MlsCommitMutation
+}
+
+// Where appends a list predicates to the MlsCommitDelete builder.
+func (mcd *MlsCommitDelete) Where(ps ...predicate.MlsCommit) *MlsCommitDelete {
+	mcd.mutation.Where(ps...)
+	return mcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mcd *MlsCommitDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mcd.sqlExec, mcd.mutation, mcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcd *MlsCommitDelete) ExecX(ctx context.Context) int {
+	n, err := mcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mcd *MlsCommitDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mlscommit.Table, sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt))
+	if ps := mcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mcd.mutation.done = true
+	return affected, err
+}
+
+// MlsCommitDeleteOne is the builder for deleting a single MlsCommit entity.
+type MlsCommitDeleteOne struct {
+	mcd *MlsCommitDelete
+}
+
+// Where appends a list predicates to the MlsCommitDelete builder.
+func (mcdo *MlsCommitDeleteOne) Where(ps ...predicate.MlsCommit) *MlsCommitDeleteOne {
+	mcdo.mcd.mutation.Where(ps...)
+	return mcdo
+}
+
+// Exec executes the deletion query.
+func (mcdo *MlsCommitDeleteOne) Exec(ctx context.Context) error {
+	n, err := mcdo.mcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mlscommit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcdo *MlsCommitDeleteOne) ExecX(ctx context.Context) {
+	if err := mcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
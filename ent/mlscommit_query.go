@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// MlsCommitQuery is the builder for querying MlsCommit entities.
+type MlsCommitQuery struct {
+	config
+	ctx        *QueryContext
+	order      []mlscommit.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MlsCommit
+	withRoom   *RoomQuery
+	withSender *UserQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MlsCommitQuery builder.
+func (mcq *MlsCommitQuery) Where(ps ...predicate.MlsCommit) *MlsCommitQuery {
+	mcq.predicates = append(mcq.predicates, ps...)
+	return mcq
+}
+
+// Limit the number of records to be returned by this query.
+func (mcq *MlsCommitQuery) Limit(limit int) *MlsCommitQuery {
+	mcq.ctx.Limit = &limit
+	return mcq
+}
+
+// Offset to start from.
+func (mcq *MlsCommitQuery) Offset(offset int) *MlsCommitQuery {
+	mcq.ctx.Offset = &offset
+	return mcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mcq *MlsCommitQuery) Unique(unique bool) *MlsCommitQuery {
+	mcq.ctx.Unique = &unique
+	return mcq
+}
+
+// Order specifies how the records should be ordered.
+func (mcq *MlsCommitQuery) Order(o ...mlscommit.OrderOption) *MlsCommitQuery {
+	mcq.order = append(mcq.order, o...)
+	return mcq
+}
+
+// QueryRoom chains the current query on the "room" edge.
+func (mcq *MlsCommitQuery) QueryRoom() *RoomQuery {
+	query := (&RoomClient{config: mcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mlscommit.Table, mlscommit.FieldID, selector),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mlscommit.RoomTable, mlscommit.RoomColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySender chains the current query on the "sender" edge.
+func (mcq *MlsCommitQuery) QuerySender() *UserQuery {
+	query := (&UserClient{config: mcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mlscommit.Table, mlscommit.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mlscommit.SenderTable, mlscommit.SenderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MlsCommit entity from the query.
+// Returns a *NotFoundError when no MlsCommit was found.
+func (mcq *MlsCommitQuery) First(ctx context.Context) (*MlsCommit, error) {
+	nodes, err := mcq.Limit(1).All(setContextOp(ctx, mcq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mlscommit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mcq *MlsCommitQuery) FirstX(ctx context.Context) *MlsCommit {
+	node, err := mcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MlsCommit ID from the query.
+// Returns a *NotFoundError when no MlsCommit ID was found.
+func (mcq *MlsCommitQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mcq.Limit(1).IDs(setContextOp(ctx, mcq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mlscommit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mcq *MlsCommitQuery) FirstIDX(ctx context.Context) int {
+	id, err := mcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MlsCommit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MlsCommit entity is found.
+// Returns a *NotFoundError when no MlsCommit entities are found.
+func (mcq *MlsCommitQuery) Only(ctx context.Context) (*MlsCommit, error) {
+	nodes, err := mcq.Limit(2).All(setContextOp(ctx, mcq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mlscommit.Label}
+	default:
+		return nil, &NotSingularError{mlscommit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mcq *MlsCommitQuery) OnlyX(ctx context.Context) *MlsCommit {
+	node, err := mcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MlsCommit ID in the query.
+// Returns a *NotSingularError when more than one MlsCommit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mcq *MlsCommitQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mcq.Limit(2).IDs(setContextOp(ctx, mcq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mlscommit.Label}
+	default:
+		err = &NotSingularError{mlscommit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mcq *MlsCommitQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MlsCommits.
+func (mcq *MlsCommitQuery) All(ctx context.Context) ([]*MlsCommit, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "All")
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MlsCommit, *MlsCommitQuery]()
+	return withInterceptors[[]*MlsCommit](ctx, mcq, qr, mcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mcq *MlsCommitQuery) AllX(ctx context.Context) []*MlsCommit {
+	nodes, err := mcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MlsCommit IDs.
+func (mcq *MlsCommitQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mcq.ctx.Unique == nil && mcq.path != nil {
+		mcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mcq.ctx, "IDs")
+	if err = mcq.Select(mlscommit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mcq *MlsCommitQuery) IDsX(ctx context.Context) []int {
+	ids, err := mcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mcq *MlsCommitQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "Count")
+	if err := mcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mcq, querierCount[*MlsCommitQuery](), mcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mcq *MlsCommitQuery) CountX(ctx context.Context) int {
+	count, err := mcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mcq *MlsCommitQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mcq.ctx, "Exist")
+	switch _, err := mcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mcq *MlsCommitQuery) ExistX(ctx context.Context) bool {
+	exist, err := mcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MlsCommitQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mcq *MlsCommitQuery) Clone() *MlsCommitQuery {
+	if mcq == nil {
+		return nil
+	}
+	return &MlsCommitQuery{
+		config:     mcq.config,
+		ctx:        mcq.ctx.Clone(),
+		order:      append([]mlscommit.OrderOption{}, mcq.order...),
+		inters:     append([]Interceptor{}, mcq.inters...),
+		predicates: append([]predicate.MlsCommit{}, mcq.predicates...),
+		withRoom:   mcq.withRoom.Clone(),
+		withSender: mcq.withSender.Clone(),
+		// clone intermediate query.
+		sql:  mcq.sql.Clone(),
+		path: mcq.path,
+	}
+}
+
+// WithRoom tells the query-builder to eager-load the nodes that are connected to
+// the "room" edge. The optional arguments are used to configure the query builder of the edge.
+func (mcq *MlsCommitQuery) WithRoom(opts ...func(*RoomQuery)) *MlsCommitQuery {
+	query := (&RoomClient{config: mcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mcq.withRoom = query
+	return mcq
+}
+
+// WithSender tells the query-builder to eager-load the nodes that are connected to
+// the "sender" edge. The optional arguments are used to configure the query builder of the edge.
+func (mcq *MlsCommitQuery) WithSender(opts ...func(*UserQuery)) *MlsCommitQuery {
+	query := (&UserClient{config: mcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mcq.withSender = query
+	return mcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Epoch int `json:"epoch,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MlsCommit.Query().
+//		GroupBy(mlscommit.FieldEpoch).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mcq *MlsCommitQuery) GroupBy(field string, fields ...string) *MlsCommitGroupBy {
+	mcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MlsCommitGroupBy{build: mcq}
+	grbuild.flds = &mcq.ctx.Fields
+	grbuild.label = mlscommit.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Epoch int `json:"epoch,omitempty"`
+//	}
+//
+//	client.MlsCommit.Query().
+//		Select(mlscommit.FieldEpoch).
+//		Scan(ctx, &v)
+func (mcq *MlsCommitQuery) Select(fields ...string) *MlsCommitSelect {
+	mcq.ctx.Fields = append(mcq.ctx.Fields, fields...)
+	sbuild := &MlsCommitSelect{MlsCommitQuery: mcq}
+	sbuild.label = mlscommit.Label
+	sbuild.flds, sbuild.scan = &mcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MlsCommitSelect configured with the given aggregations.
+func (mcq *MlsCommitQuery) Aggregate(fns ...AggregateFunc) *MlsCommitSelect {
+	return mcq.Select().Aggregate(fns...)
+}
+
+func (mcq *MlsCommitQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mcq.ctx.Fields {
+		if !mlscommit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mcq.path != nil {
+		prev, err := mcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mcq.sql = prev
+	}
+	if mlscommit.Policy == nil {
+		return errors.New("ent: uninitialized mlscommit.Policy (forgotten import ent/runtime?)")
+	}
+	if err := mlscommit.Policy.EvalQuery(ctx, mcq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (mcq *MlsCommitQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MlsCommit, error) {
+	var (
+		nodes       = []*MlsCommit{}
+		withFKs     = mcq.withFKs
+		_spec       = mcq.querySpec()
+		loadedTypes = [2]bool{
+			mcq.withRoom != nil,
+			mcq.withSender != nil,
+		}
+	)
+	if mcq.withRoom != nil || mcq.withSender != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, mlscommit.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MlsCommit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MlsCommit{config: mcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mcq.withRoom; query != nil {
+		if err := mcq.loadRoom(ctx, query, nodes, nil,
+			func(n *MlsCommit, e *Room) { n.Edges.Room = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mcq.withSender; query != nil {
+		if err := mcq.loadSender(ctx, query, nodes, nil,
+			func(n *MlsCommit, e *User) { n.Edges.Sender = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mcq *MlsCommitQuery) loadRoom(ctx context.Context, query *RoomQuery, nodes []*MlsCommit, init func(*MlsCommit), assign func(*MlsCommit, *Room)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MlsCommit)
+	for i := range nodes {
+		if nodes[i].mls_commit_room == nil {
+			continue
+		}
+		fk := *nodes[i].mls_commit_room
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(room.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "mls_commit_room" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mcq *MlsCommitQuery) loadSender(ctx context.Context, query *UserQuery, nodes []*MlsCommit, init func(*MlsCommit), assign func(*MlsCommit, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MlsCommit)
+	for i := range nodes {
+		if nodes[i].mls_commit_sender == nil {
+			continue
+		}
+		fk := *nodes[i].mls_commit_sender
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "mls_commit_sender" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mcq *MlsCommitQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mcq.querySpec()
+	_spec.Node.Columns = mcq.ctx.Fields
+	if len(mcq.ctx.Fields) > 0 {
+		_spec.Unique = mcq.ctx.Unique != nil && *mcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mcq.driver, _spec)
+}
+
+func (mcq *MlsCommitQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(mlscommit.Table, mlscommit.Columns, sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt))
+	_spec.From = mcq.sql
+	if unique := mcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mlscommit.FieldID)
+		for i := range fields {
+			if fields[i] != mlscommit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mcq *MlsCommitQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mcq.driver.Dialect())
+	t1 := builder.Table(mlscommit.Table)
+	columns := mcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = mlscommit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mcq.sql != nil {
+		selector = mcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mcq.ctx.Unique != nil && *mcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mcq.predicates {
+		p(selector)
+	}
+	for _, p := range mcq.order {
+		p(selector)
+	}
+	if offset := mcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MlsCommitGroupBy is the group-by builder for MlsCommit entities.
+type MlsCommitGroupBy struct {
+	selector
+	build *MlsCommitQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mcgb *MlsCommitGroupBy) Aggregate(fns ...AggregateFunc) *MlsCommitGroupBy {
+	mcgb.fns = append(mcgb.fns, fns...)
+	return mcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcgb *MlsCommitGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mcgb.build.ctx, "GroupBy")
+	if err := mcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MlsCommitQuery, *MlsCommitGroupBy](ctx, mcgb.build, mcgb, mcgb.build.inters, v)
+}
+
+func (mcgb *MlsCommitGroupBy) sqlScan(ctx context.Context, root *MlsCommitQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mcgb.fns))
+	for _, fn := range mcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mcgb.flds)+len(mcgb.fns))
+		for _, f := range *mcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MlsCommitSelect is the builder for selecting fields of MlsCommit entities.
+type MlsCommitSelect struct {
+	*MlsCommitQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mcs *MlsCommitSelect) Aggregate(fns ...AggregateFunc) *MlsCommitSelect {
+	mcs.fns = append(mcs.fns, fns...)
+	return mcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcs *MlsCommitSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mcs.ctx, "Select")
+	if err := mcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MlsCommitQuery, *MlsCommitSelect](ctx, mcs.MlsCommitQuery, mcs, mcs.inters, v)
+}
+
+func (mcs *MlsCommitSelect) sqlScan(ctx context.Context, root *MlsCommitQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mcs.fns))
+	for _, fn := range mcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
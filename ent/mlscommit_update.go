@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// MlsCommitUpdate is the builder for updating MlsCommit entities.
+type MlsCommitUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MlsCommitMutation
+}
+
+// Where appends a list predicates to the MlsCommitUpdate builder.
+func (mcu *MlsCommitUpdate) Where(ps ...predicate.MlsCommit) *MlsCommitUpdate {
+	mcu.mutation.Where(ps...)
+	return mcu
+}
+
+// SetEpoch sets the "epoch" field.
+func (mcu *MlsCommitUpdate) SetEpoch(i int) *MlsCommitUpdate {
+	mcu.mutation.ResetEpoch()
+	mcu.mutation.SetEpoch(i)
+	return mcu
+}
+
+// SetNillableEpoch sets the "epoch" field if the given value is not nil.
+func (mcu *MlsCommitUpdate) SetNillableEpoch(i *int) *MlsCommitUpdate {
+	if i != nil {
+		mcu.SetEpoch(*i)
+	}
+	return mcu
+}
+
+// AddEpoch adds i to the "epoch" field.
+func (mcu *MlsCommitUpdate) AddEpoch(i int) *MlsCommitUpdate {
+	mcu.mutation.AddEpoch(i)
+	return mcu
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (mcu *MlsCommitUpdate) SetSenderDeviceID(i int) *MlsCommitUpdate {
+	mcu.mutation.ResetSenderDeviceID()
+	mcu.mutation.SetSenderDeviceID(i)
+	return mcu
+}
+
+// SetNillableSenderDeviceID sets the "sender_device_id" field if the given value is not nil.
+func (mcu *MlsCommitUpdate) SetNillableSenderDeviceID(i *int) *MlsCommitUpdate {
+	if i != nil {
+		mcu.SetSenderDeviceID(*i)
+	}
+	return mcu
+}
+
+// AddSenderDeviceID adds i to the "sender_device_id" field.
+func (mcu *MlsCommitUpdate) AddSenderDeviceID(i int) *MlsCommitUpdate {
+	mcu.mutation.AddSenderDeviceID(i)
+	return mcu
+}
+
+// SetMessage sets the "message" field.
+func (mcu *MlsCommitUpdate) SetMessage(s string) *MlsCommitUpdate {
+	mcu.mutation.SetMessage(s)
+	return mcu
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (mcu *MlsCommitUpdate) SetNillableMessage(s *string) *MlsCommitUpdate {
+	if s != nil {
+		mcu.SetMessage(*s)
+	}
+	return mcu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mcu *MlsCommitUpdate) SetCreatedAt(t time.Time) *MlsCommitUpdate {
+	mcu.mutation.SetCreatedAt(t)
+	return mcu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mcu *MlsCommitUpdate) SetNillableCreatedAt(t *time.Time) *MlsCommitUpdate {
+	if t != nil {
+		mcu.SetCreatedAt(*t)
+	}
+	return mcu
+}
+
+// SetRoomID sets the "room" edge to the Room entity by ID.
+func (mcu *MlsCommitUpdate) SetRoomID(id int) *MlsCommitUpdate {
+	mcu.mutation.SetRoomID(id)
+	return mcu
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (mcu *MlsCommitUpdate) SetRoom(r *Room) *MlsCommitUpdate {
+	return mcu.SetRoomID(r.ID)
+}
+
+// SetSenderID sets the "sender" edge to the User entity by ID.
+func (mcu *MlsCommitUpdate) SetSenderID(id int) *MlsCommitUpdate {
+	mcu.mutation.SetSenderID(id)
+	return mcu
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (mcu *MlsCommitUpdate) SetSender(u *User) *MlsCommitUpdate {
+	return mcu.SetSenderID(u.ID)
+}
+
+// Mutation returns the MlsCommitMutation object of the builder.
+func (mcu *MlsCommitUpdate) Mutation() *MlsCommitMutation {
+	return mcu.mutation
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (mcu *MlsCommitUpdate) ClearRoom() *MlsCommitUpdate {
+	mcu.mutation.ClearRoom()
+	return mcu
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (mcu *MlsCommitUpdate) ClearSender() *MlsCommitUpdate {
+	mcu.mutation.ClearSender()
+	return mcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mcu *MlsCommitUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mcu.sqlSave, mcu.mutation, mcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcu *MlsCommitUpdate) SaveX(ctx context.Context) int {
+	affected, err := mcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mcu *MlsCommitUpdate) Exec(ctx context.Context) error {
+	_, err := mcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcu *MlsCommitUpdate) ExecX(ctx context.Context) {
+	if err := mcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcu *MlsCommitUpdate) check() error {
+	if v, ok := mcu.mutation.Epoch(); ok {
+		if err := mlscommit.EpochValidator(v); err != nil {
+			return &ValidationError{Name: "epoch", err: fmt.Errorf(`ent: validator failed for field "MlsCommit.epoch": %w`, err)}
+		}
+	}
+	if v, ok := mcu.mutation.SenderDeviceID(); ok {
+		if err := mlscommit.SenderDeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_device_id", err: fmt.Errorf(`ent: validator failed for field "MlsCommit.sender_device_id": %w`, err)}
+		}
+	}
+	if v, ok := mcu.mutation.Message(); ok {
+		if err := mlscommit.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "MlsCommit.message": %w`, err)}
+		}
+	}
+	if _, ok := mcu.mutation.RoomID(); mcu.mutation.RoomCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MlsCommit.room"`)
+	}
+	if _, ok := mcu.mutation.SenderID(); mcu.mutation.SenderCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MlsCommit.sender"`)
+	}
+	return nil
+}
+
+func (mcu *MlsCommitUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mlscommit.Table, mlscommit.Columns, sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt))
+	if ps := mcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcu.mutation.Epoch(); ok {
+		_spec.SetField(mlscommit.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.AddedEpoch(); ok {
+		_spec.AddField(mlscommit.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.SenderDeviceID(); ok {
+		_spec.SetField(mlscommit.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.AddedSenderDeviceID(); ok {
+		_spec.AddField(mlscommit.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.Message(); ok {
+		_spec.SetField(mlscommit.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := mcu.mutation.CreatedAt(); ok {
+		_spec.SetField(mlscommit.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mcu.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlscommit.RoomTable,
+			Columns: []string{mlscommit.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mcu.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlscommit.RoomTable,
+			Columns: []string{mlscommit.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mcu.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlscommit.SenderTable,
+			Columns: []string{mlscommit.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mcu.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlscommit.SenderTable,
+			Columns: []string{mlscommit.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mlscommit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mcu.mutation.done = true
+	return n, nil
+}
+
+// MlsCommitUpdateOne is the builder for updating a single MlsCommit entity.
+type MlsCommitUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MlsCommitMutation
+}
+
+// SetEpoch sets the "epoch" field.
+func (mcuo *MlsCommitUpdateOne) SetEpoch(i int) *MlsCommitUpdateOne {
+	mcuo.mutation.ResetEpoch()
+	mcuo.mutation.SetEpoch(i)
+	return mcuo
+}
+
+// SetNillableEpoch sets the "epoch" field if the given value is not nil.
+func (mcuo *MlsCommitUpdateOne) SetNillableEpoch(i *int) *MlsCommitUpdateOne {
+	if i != nil {
+		mcuo.SetEpoch(*i)
+	}
+	return mcuo
+}
+
+// AddEpoch adds i to the "epoch" field.
+func (mcuo *MlsCommitUpdateOne) AddEpoch(i int) *MlsCommitUpdateOne {
+	mcuo.mutation.AddEpoch(i)
+	return mcuo
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (mcuo *MlsCommitUpdateOne) SetSenderDeviceID(i int) *MlsCommitUpdateOne {
+	mcuo.mutation.ResetSenderDeviceID()
+	mcuo.mutation.SetSenderDeviceID(i)
+	return mcuo
+}
+
+// SetNillableSenderDeviceID sets the "sender_device_id" field if the given value is not nil.
+func (mcuo *MlsCommitUpdateOne) SetNillableSenderDeviceID(i *int) *MlsCommitUpdateOne {
+	if i != nil {
+		mcuo.SetSenderDeviceID(*i)
+	}
+	return mcuo
+}
+
+// AddSenderDeviceID adds i to the "sender_device_id" field.
+func (mcuo *MlsCommitUpdateOne) AddSenderDeviceID(i int) *MlsCommitUpdateOne {
+	mcuo.mutation.AddSenderDeviceID(i)
+	return mcuo
+}
+
+// SetMessage sets the "message" field.
+func (mcuo *MlsCommitUpdateOne) SetMessage(s string) *MlsCommitUpdateOne {
+	mcuo.mutation.SetMessage(s)
+	return mcuo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (mcuo *MlsCommitUpdateOne) SetNillableMessage(s *string) *MlsCommitUpdateOne {
+	if s != nil {
+		mcuo.SetMessage(*s)
+	}
+	return mcuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mcuo *MlsCommitUpdateOne) SetCreatedAt(t time.Time) *MlsCommitUpdateOne {
+	mcuo.mutation.SetCreatedAt(t)
+	return mcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mcuo *MlsCommitUpdateOne) SetNillableCreatedAt(t *time.Time) *MlsCommitUpdateOne {
+	if t != nil {
+		mcuo.SetCreatedAt(*t)
+	}
+	return mcuo
+}
+
+// SetRoomID sets the "room" edge to the Room entity by ID.
+func (mcuo *MlsCommitUpdateOne) SetRoomID(id int) *MlsCommitUpdateOne {
+	mcuo.mutation.SetRoomID(id)
+	return mcuo
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (mcuo *MlsCommitUpdateOne) SetRoom(r *Room) *MlsCommitUpdateOne {
+	return mcuo.SetRoomID(r.ID)
+}
+
+// SetSenderID sets the "sender" edge to the User entity by ID.
+func (mcuo *MlsCommitUpdateOne) SetSenderID(id int) *MlsCommitUpdateOne {
+	mcuo.mutation.SetSenderID(id)
+	return mcuo
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (mcuo *MlsCommitUpdateOne) SetSender(u *User) *MlsCommitUpdateOne {
+	return mcuo.SetSenderID(u.ID)
+}
+
+// Mutation returns the MlsCommitMutation object of the builder.
+func (mcuo *MlsCommitUpdateOne) Mutation() *MlsCommitMutation {
+	return mcuo.mutation
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (mcuo *MlsCommitUpdateOne) ClearRoom() *MlsCommitUpdateOne {
+	mcuo.mutation.ClearRoom()
+	return mcuo
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (mcuo *MlsCommitUpdateOne) ClearSender() *MlsCommitUpdateOne {
+	mcuo.mutation.ClearSender()
+	return mcuo
+}
+
+// Where appends a list predicates to the MlsCommitUpdate builder.
+func (mcuo *MlsCommitUpdateOne) Where(ps ...predicate.MlsCommit) *MlsCommitUpdateOne {
+	mcuo.mutation.Where(ps...)
+	return mcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mcuo *MlsCommitUpdateOne) Select(field string, fields ...string) *MlsCommitUpdateOne {
+	mcuo.fields = append([]string{field}, fields...)
+	return mcuo
+}
+
+// Save executes the query and returns the updated MlsCommit entity.
+func (mcuo *MlsCommitUpdateOne) Save(ctx context.Context) (*MlsCommit, error) {
+	return withHooks(ctx, mcuo.sqlSave, mcuo.mutation, mcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcuo *MlsCommitUpdateOne) SaveX(ctx context.Context) *MlsCommit {
+	node, err := mcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mcuo *MlsCommitUpdateOne) Exec(ctx context.Context) error {
+	_, err := mcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcuo *MlsCommitUpdateOne) ExecX(ctx context.Context) {
+	if err := mcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcuo *MlsCommitUpdateOne) check() error {
+	if v, ok := mcuo.mutation.Epoch(); ok {
+		if err := mlscommit.EpochValidator(v); err != nil {
+			return &ValidationError{Name: "epoch", err: fmt.Errorf(`ent: validator failed for field "MlsCommit.epoch": %w`, err)}
+		}
+	}
+	if v, ok := mcuo.mutation.SenderDeviceID(); ok {
+		if err := mlscommit.SenderDeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_device_id", err: fmt.Errorf(`ent: validator failed for field "MlsCommit.sender_device_id": %w`, err)}
+		}
+	}
+	if v, ok := mcuo.mutation.Message(); ok {
+		if err := mlscommit.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "MlsCommit.message": %w`, err)}
+		}
+	}
+	if _, ok := mcuo.mutation.RoomID(); mcuo.mutation.RoomCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MlsCommit.room"`)
+	}
+	if _, ok := mcuo.mutation.SenderID(); mcuo.mutation.SenderCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MlsCommit.sender"`)
+	}
+	return nil
+}
+
+func (mcuo *MlsCommitUpdateOne) sqlSave(ctx context.Context) (_node *MlsCommit, err error) {
+	if err := mcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mlscommit.Table, mlscommit.Columns, sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt))
+	id, ok := mcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MlsCommit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mlscommit.FieldID)
+		for _, f := range fields {
+			if !mlscommit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mlscommit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcuo.mutation.Epoch(); ok {
+		_spec.SetField(mlscommit.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.AddedEpoch(); ok {
+		_spec.AddField(mlscommit.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.SenderDeviceID(); ok {
+		_spec.SetField(mlscommit.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.AddedSenderDeviceID(); ok {
+		_spec.AddField(mlscommit.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.Message(); ok {
+		_spec.SetField(mlscommit.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := mcuo.mutation.CreatedAt(); ok {
+		_spec.SetField(mlscommit.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mcuo.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlscommit.RoomTable,
+			Columns: []string{mlscommit.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mcuo.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlscommit.RoomTable,
+			Columns: []string{mlscommit.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mcuo.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlscommit.SenderTable,
+			Columns: []string{mlscommit.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mcuo.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlscommit.SenderTable,
+			Columns: []string{mlscommit.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MlsCommit{config: mcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mlscommit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// MlsWelcome is the model entity for the MlsWelcome schema.
+type MlsWelcome struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Epoch holds the value of the "epoch" field.
+	Epoch int `json:"epoch,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MlsWelcomeQuery when eager-loading is set.
+	Edges              MlsWelcomeEdges `json:"edges"`
+	mls_welcome_room   *int
+	mls_welcome_sender *int
+	mls_welcome_device *int
+	selectValues       sql.SelectValues
+}
+
+// MlsWelcomeEdges holds the relations/edges for other nodes in the graph.
+type MlsWelcomeEdges struct {
+	// Room holds the value of the room edge.
+	Room *Room `json:"room,omitempty"`
+	// Sender holds the value of the sender edge.
+	Sender *User `json:"sender,omitempty"`
+	// Device holds the value of the device edge.
+	Device *Device `json:"device,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MlsWelcomeEdges) RoomOrErr() (*Room, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: room.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
+// SenderOrErr returns the Sender value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MlsWelcomeEdges) SenderOrErr() (*User, error) {
+	if e.Sender != nil {
+		return e.Sender, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender"}
+}
+
+// DeviceOrErr returns the Device value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MlsWelcomeEdges) DeviceOrErr() (*Device, error) {
+	if e.Device != nil {
+		return e.Device, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: device.Label}
+	}
+	return nil, &NotLoadedError{edge: "device"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MlsWelcome) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mlswelcome.FieldID, mlswelcome.FieldEpoch:
+			values[i] = new(sql.NullInt64)
+		case mlswelcome.FieldMessage:
+			values[i] = new(sql.NullString)
+		case mlswelcome.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case mlswelcome.ForeignKeys[0]: // mls_welcome_room
+			values[i] = new(sql.NullInt64)
+		case mlswelcome.ForeignKeys[1]: // mls_welcome_sender
+			values[i] = new(sql.NullInt64)
+		case mlswelcome.ForeignKeys[2]: // mls_welcome_device
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MlsWelcome fields.
+func (mw *MlsWelcome) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mlswelcome.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mw.ID = int(value.Int64)
+		case mlswelcome.FieldEpoch:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field epoch", values[i])
+			} else if value.Valid {
+				mw.Epoch = int(value.Int64)
+			}
+		case mlswelcome.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				mw.Message = value.String
+			}
+		case mlswelcome.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mw.CreatedAt = value.Time
+			}
+		case mlswelcome.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field mls_welcome_room", value)
+			} else if value.Valid {
+				mw.mls_welcome_room = new(int)
+				*mw.mls_welcome_room = int(value.Int64)
+			}
+		case mlswelcome.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field mls_welcome_sender", value)
+			} else if value.Valid {
+				mw.mls_welcome_sender = new(int)
+				*mw.mls_welcome_sender = int(value.Int64)
+			}
+		case mlswelcome.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field mls_welcome_device", value)
+			} else if value.Valid {
+				mw.mls_welcome_device = new(int)
+				*mw.mls_welcome_device = int(value.Int64)
+			}
+		default:
+			mw.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MlsWelcome.
+// This includes values selected through modifiers, order, etc.
+func (mw *MlsWelcome) Value(name string) (ent.Value, error) {
+	return mw.selectValues.Get(name)
+}
+
+// QueryRoom queries the "room" edge of the MlsWelcome entity.
+func (mw *MlsWelcome) QueryRoom() *RoomQuery {
+	return NewMlsWelcomeClient(mw.config).QueryRoom(mw)
+}
+
+// QuerySender queries the "sender" edge of the MlsWelcome entity.
+func (mw *MlsWelcome) QuerySender() *UserQuery {
+	return NewMlsWelcomeClient(mw.config).QuerySender(mw)
+}
+
+// QueryDevice queries the "device" edge of the MlsWelcome entity.
+func (mw *MlsWelcome) QueryDevice() *DeviceQuery {
+	return NewMlsWelcomeClient(mw.config).QueryDevice(mw)
+}
+
+// Update returns a builder for updating this MlsWelcome.
+// Note that you need to call MlsWelcome.Unwrap() before calling this method if this MlsWelcome
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mw *MlsWelcome) Update() *MlsWelcomeUpdateOne {
+	return NewMlsWelcomeClient(mw.config).UpdateOne(mw)
+}
+
+// Unwrap unwraps the MlsWelcome entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mw *MlsWelcome) Unwrap() *MlsWelcome {
+	_tx, ok := mw.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MlsWelcome is not a transactional entity")
+	}
+	mw.config.driver = _tx.drv
+	return mw
+}
+
+// String implements the fmt.Stringer.
+func (mw *MlsWelcome) String() string {
+	var builder strings.Builder
+	builder.WriteString("MlsWelcome(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mw.ID))
+	builder.WriteString("epoch=")
+	builder.WriteString(fmt.Sprintf("%v", mw.Epoch))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(mw.Message)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mw.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MlsWelcomes is a parsable slice of MlsWelcome.
+type MlsWelcomes []*MlsWelcome
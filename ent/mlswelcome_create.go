@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// MlsWelcomeCreate is the builder for creating a MlsWelcome entity.
+type MlsWelcomeCreate struct {
+	config
+	mutation *MlsWelcomeMutation
+	hooks    []Hook
+}
+
+// SetEpoch sets the "epoch" field.
+func (mwc *MlsWelcomeCreate) SetEpoch(i int) *MlsWelcomeCreate {
+	mwc.mutation.SetEpoch(i)
+	return mwc
+}
+
+// SetMessage sets the "message" field.
+func (mwc *MlsWelcomeCreate) SetMessage(s string) *MlsWelcomeCreate {
+	mwc.mutation.SetMessage(s)
+	return mwc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mwc *MlsWelcomeCreate) SetCreatedAt(t time.Time) *MlsWelcomeCreate {
+	mwc.mutation.SetCreatedAt(t)
+	return mwc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mwc *MlsWelcomeCreate) SetNillableCreatedAt(t *time.Time) *MlsWelcomeCreate {
+	if t != nil {
+		mwc.SetCreatedAt(*t)
+	}
+	return mwc
+}
+
+// SetRoomID sets the "room" edge to the Room entity by ID.
+func (mwc *MlsWelcomeCreate) SetRoomID(id int) *MlsWelcomeCreate {
+	mwc.mutation.SetRoomID(id)
+	return mwc
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (mwc *MlsWelcomeCreate) SetRoom(r *Room) *MlsWelcomeCreate {
+	return mwc.SetRoomID(r.ID)
+}
+
+// SetSenderID sets the "sender" edge to the User entity by ID.
+func (mwc *MlsWelcomeCreate) SetSenderID(id int) *MlsWelcomeCreate {
+	mwc.mutation.SetSenderID(id)
+	return mwc
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (mwc *MlsWelcomeCreate) SetSender(u *User) *MlsWelcomeCreate {
+	return mwc.SetSenderID(u.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (mwc *MlsWelcomeCreate) SetDeviceID(id int) *MlsWelcomeCreate {
+	mwc.mutation.SetDeviceID(id)
+	return mwc
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (mwc *MlsWelcomeCreate) SetDevice(d *Device) *MlsWelcomeCreate {
+	return mwc.SetDeviceID(d.ID)
+}
+
+// Mutation returns the MlsWelcomeMutation object of the builder.
+func (mwc *MlsWelcomeCreate) Mutation() *MlsWelcomeMutation {
+	return mwc.mutation
+}
+
+// Save creates the MlsWelcome in the database.
+func (mwc *MlsWelcomeCreate) Save(ctx context.Context) (*MlsWelcome, error) {
+	if err := mwc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, mwc.sqlSave, mwc.mutation, mwc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mwc *MlsWelcomeCreate) SaveX(ctx context.Context) *MlsWelcome {
+	v, err := mwc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwc *MlsWelcomeCreate) Exec(ctx context.Context) error {
+	_, err := mwc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwc *MlsWelcomeCreate) ExecX(ctx context.Context) {
+	if err := mwc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mwc *MlsWelcomeCreate) defaults() error {
+	if _, ok := mwc.mutation.CreatedAt(); !ok {
+		if mlswelcome.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized mlswelcome.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := mlswelcome.DefaultCreatedAt()
+		mwc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mwc *MlsWelcomeCreate) check() error {
+	if _, ok := mwc.mutation.Epoch(); !ok {
+		return &ValidationError{Name: "epoch", err: errors.New(`ent: missing required field "MlsWelcome.epoch"`)}
+	}
+	if v, ok := mwc.mutation.Epoch(); ok {
+		if err := mlswelcome.EpochValidator(v); err != nil {
+			return &ValidationError{Name: "epoch", err: fmt.Errorf(`ent: validator failed for field "MlsWelcome.epoch": %w`, err)}
+		}
+	}
+	if _, ok := mwc.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "MlsWelcome.message"`)}
+	}
+	if v, ok := mwc.mutation.Message(); ok {
+		if err := mlswelcome.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "MlsWelcome.message": %w`, err)}
+		}
+	}
+	if _, ok := mwc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MlsWelcome.created_at"`)}
+	}
+	if _, ok := mwc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room", err: errors.New(`ent: missing required edge "MlsWelcome.room"`)}
+	}
+	if _, ok := mwc.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender", err: errors.New(`ent: missing required edge "MlsWelcome.sender"`)}
+	}
+	if _, ok := mwc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`ent: missing required edge "MlsWelcome.device"`)}
+	}
+	return nil
+}
+
+func (mwc *MlsWelcomeCreate) sqlSave(ctx context.Context) (*MlsWelcome, error) {
+	if err := mwc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mwc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mwc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mwc.mutation.id = &_node.ID
+	mwc.mutation.done = true
+	return _node, nil
+}
+
+func (mwc *MlsWelcomeCreate) createSpec() (*MlsWelcome, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MlsWelcome{config: mwc.config}
+		_spec = sqlgraph.NewCreateSpec(mlswelcome.Table, sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt))
+	)
+	if value, ok := mwc.mutation.Epoch(); ok {
+		_spec.SetField(mlswelcome.FieldEpoch, field.TypeInt, value)
+		_node.Epoch = value
+	}
+	if value, ok := mwc.mutation.Message(); ok {
+		_spec.SetField(mlswelcome.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := mwc.mutation.CreatedAt(); ok {
+		_spec.SetField(mlswelcome.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mwc.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.RoomTable,
+			Columns: []string{mlswelcome.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.mls_welcome_room = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mwc.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.SenderTable,
+			Columns: []string{mlswelcome.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.mls_welcome_sender = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mwc.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.DeviceTable,
+			Columns: []string{mlswelcome.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.mls_welcome_device = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MlsWelcomeCreateBulk is the builder for creating many MlsWelcome entities in bulk.
+type MlsWelcomeCreateBulk struct {
+	config
+	err      error
+	builders []*MlsWelcomeCreate
+}
+
+// Save creates the MlsWelcome entities in the database.
+func (mwcb *MlsWelcomeCreateBulk) Save(ctx context.Context) ([]*MlsWelcome, error) {
+	if mwcb.err != nil {
+		return nil, mwcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mwcb.builders))
+	nodes := make([]*MlsWelcome, len(mwcb.builders))
+	mutators := make([]Mutator, len(mwcb.builders))
+	for i := range mwcb.builders {
+		func(i int, root context.Context) {
+			builder := mwcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MlsWelcomeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mwcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mwcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mwcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwcb *MlsWelcomeCreateBulk) SaveX(ctx context.Context) []*MlsWelcome {
+	v, err := mwcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwcb *MlsWelcomeCreateBulk) Exec(ctx context.Context) error {
+	_, err := mwcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwcb *MlsWelcomeCreateBulk) ExecX(ctx context.Context) {
+	if err := mwcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
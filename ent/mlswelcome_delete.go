@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// MlsWelcomeDelete is the builder for deleting a MlsWelcome entity.
+type MlsWelcomeDelete struct {
+	config
+	hooks    []Hook
+	mutation *MlsWelcomeMutation
+}
+
+// Where appends a list predicates to the MlsWelcomeDelete builder.
+func (mwd *MlsWelcomeDelete) Where(ps ...predicate.MlsWelcome) *MlsWelcomeDelete {
+	mwd.mutation.Where(ps...)
+	return mwd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mwd *MlsWelcomeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mwd.sqlExec, mwd.mutation, mwd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwd *MlsWelcomeDelete) ExecX(ctx context.Context) int {
+	n, err := mwd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mwd *MlsWelcomeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mlswelcome.Table, sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt))
+	if ps := mwd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mwd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mwd.mutation.done = true
+	return affected, err
+}
+
+// MlsWelcomeDeleteOne is the builder for deleting a single MlsWelcome entity.
+type MlsWelcomeDeleteOne struct {
+	mwd *MlsWelcomeDelete
+}
+
+// Where appends a list predicates to the MlsWelcomeDelete builder.
+func (mwdo *MlsWelcomeDeleteOne) Where(ps ...predicate.MlsWelcome) *MlsWelcomeDeleteOne {
+	mwdo.mwd.mutation.Where(ps...)
+	return mwdo
+}
+
+// Exec executes the deletion query.
+func (mwdo *MlsWelcomeDeleteOne) Exec(ctx context.Context) error {
+	n, err := mwdo.mwd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mlswelcome.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwdo *MlsWelcomeDeleteOne) ExecX(ctx context.Context) {
+	if err := mwdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
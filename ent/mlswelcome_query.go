@@ -0,0 +1,770 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// MlsWelcomeQuery is the builder for querying MlsWelcome entities.
+type MlsWelcomeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []mlswelcome.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MlsWelcome
+	withRoom   *RoomQuery
+	withSender *UserQuery
+	withDevice *DeviceQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MlsWelcomeQuery builder.
+func (mwq *MlsWelcomeQuery) Where(ps ...predicate.MlsWelcome) *MlsWelcomeQuery {
+	mwq.predicates = append(mwq.predicates, ps...)
+	return mwq
+}
+
+// Limit the number of records to be returned by this query.
+func (mwq *MlsWelcomeQuery) Limit(limit int) *MlsWelcomeQuery {
+	mwq.ctx.Limit = &limit
+	return mwq
+}
+
+// Offset to start from.
+func (mwq *MlsWelcomeQuery) Offset(offset int) *MlsWelcomeQuery {
+	mwq.ctx.Offset = &offset
+	return mwq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mwq *MlsWelcomeQuery) Unique(unique bool) *MlsWelcomeQuery {
+	mwq.ctx.Unique = &unique
+	return mwq
+}
+
+// Order specifies how the records should be ordered.
+func (mwq *MlsWelcomeQuery) Order(o ...mlswelcome.OrderOption) *MlsWelcomeQuery {
+	mwq.order = append(mwq.order, o...)
+	return mwq
+}
+
+// QueryRoom chains the current query on the "room" edge.
+func (mwq *MlsWelcomeQuery) QueryRoom() *RoomQuery {
+	query := (&RoomClient{config: mwq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mwq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mwq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mlswelcome.Table, mlswelcome.FieldID, selector),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mlswelcome.RoomTable, mlswelcome.RoomColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mwq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySender chains the current query on the "sender" edge.
+func (mwq *MlsWelcomeQuery) QuerySender() *UserQuery {
+	query := (&UserClient{config: mwq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mwq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mwq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mlswelcome.Table, mlswelcome.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mlswelcome.SenderTable, mlswelcome.SenderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mwq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDevice chains the current query on the "device" edge.
+func (mwq *MlsWelcomeQuery) QueryDevice() *DeviceQuery {
+	query := (&DeviceClient{config: mwq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mwq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mwq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mlswelcome.Table, mlswelcome.FieldID, selector),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, mlswelcome.DeviceTable, mlswelcome.DeviceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mwq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MlsWelcome entity from the query.
+// Returns a *NotFoundError when no MlsWelcome was found.
+func (mwq *MlsWelcomeQuery) First(ctx context.Context) (*MlsWelcome, error) {
+	nodes, err := mwq.Limit(1).All(setContextOp(ctx, mwq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mlswelcome.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mwq *MlsWelcomeQuery) FirstX(ctx context.Context) *MlsWelcome {
+	node, err := mwq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MlsWelcome ID from the query.
+// Returns a *NotFoundError when no MlsWelcome ID was found.
+func (mwq *MlsWelcomeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mwq.Limit(1).IDs(setContextOp(ctx, mwq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mlswelcome.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mwq *MlsWelcomeQuery) FirstIDX(ctx context.Context) int {
+	id, err := mwq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MlsWelcome entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MlsWelcome entity is found.
+// Returns a *NotFoundError when no MlsWelcome entities are found.
+func (mwq *MlsWelcomeQuery) Only(ctx context.Context) (*MlsWelcome, error) {
+	nodes, err := mwq.Limit(2).All(setContextOp(ctx, mwq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mlswelcome.Label}
+	default:
+		return nil, &NotSingularError{mlswelcome.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mwq *MlsWelcomeQuery) OnlyX(ctx context.Context) *MlsWelcome {
+	node, err := mwq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MlsWelcome ID in the query.
+// Returns a *NotSingularError when more than one MlsWelcome ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mwq *MlsWelcomeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mwq.Limit(2).IDs(setContextOp(ctx, mwq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mlswelcome.Label}
+	default:
+		err = &NotSingularError{mlswelcome.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mwq *MlsWelcomeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mwq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MlsWelcomes.
+func (mwq *MlsWelcomeQuery) All(ctx context.Context) ([]*MlsWelcome, error) {
+	ctx = setContextOp(ctx, mwq.ctx, "All")
+	if err := mwq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MlsWelcome, *MlsWelcomeQuery]()
+	return withInterceptors[[]*MlsWelcome](ctx, mwq, qr, mwq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mwq *MlsWelcomeQuery) AllX(ctx context.Context) []*MlsWelcome {
+	nodes, err := mwq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MlsWelcome IDs.
+func (mwq *MlsWelcomeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mwq.ctx.Unique == nil && mwq.path != nil {
+		mwq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mwq.ctx, "IDs")
+	if err = mwq.Select(mlswelcome.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mwq *MlsWelcomeQuery) IDsX(ctx context.Context) []int {
+	ids, err := mwq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mwq *MlsWelcomeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mwq.ctx, "Count")
+	if err := mwq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mwq, querierCount[*MlsWelcomeQuery](), mwq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mwq *MlsWelcomeQuery) CountX(ctx context.Context) int {
+	count, err := mwq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mwq *MlsWelcomeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mwq.ctx, "Exist")
+	switch _, err := mwq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mwq *MlsWelcomeQuery) ExistX(ctx context.Context) bool {
+	exist, err := mwq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MlsWelcomeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mwq *MlsWelcomeQuery) Clone() *MlsWelcomeQuery {
+	if mwq == nil {
+		return nil
+	}
+	return &MlsWelcomeQuery{
+		config:     mwq.config,
+		ctx:        mwq.ctx.Clone(),
+		order:      append([]mlswelcome.OrderOption{}, mwq.order...),
+		inters:     append([]Interceptor{}, mwq.inters...),
+		predicates: append([]predicate.MlsWelcome{}, mwq.predicates...),
+		withRoom:   mwq.withRoom.Clone(),
+		withSender: mwq.withSender.Clone(),
+		withDevice: mwq.withDevice.Clone(),
+		// clone intermediate query.
+		sql:  mwq.sql.Clone(),
+		path: mwq.path,
+	}
+}
+
+// WithRoom tells the query-builder to eager-load the nodes that are connected to
+// the "room" edge. The optional arguments are used to configure the query builder of the edge.
+func (mwq *MlsWelcomeQuery) WithRoom(opts ...func(*RoomQuery)) *MlsWelcomeQuery {
+	query := (&RoomClient{config: mwq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mwq.withRoom = query
+	return mwq
+}
+
+// WithSender tells the query-builder to eager-load the nodes that are connected to
+// the "sender" edge. The optional arguments are used to configure the query builder of the edge.
+func (mwq *MlsWelcomeQuery) WithSender(opts ...func(*UserQuery)) *MlsWelcomeQuery {
+	query := (&UserClient{config: mwq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mwq.withSender = query
+	return mwq
+}
+
+// WithDevice tells the query-builder to eager-load the nodes that are connected to
+// the "device" edge. The optional arguments are used to configure the query builder of the edge.
+func (mwq *MlsWelcomeQuery) WithDevice(opts ...func(*DeviceQuery)) *MlsWelcomeQuery {
+	query := (&DeviceClient{config: mwq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mwq.withDevice = query
+	return mwq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Epoch int `json:"epoch,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MlsWelcome.Query().
+//		GroupBy(mlswelcome.FieldEpoch).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mwq *MlsWelcomeQuery) GroupBy(field string, fields ...string) *MlsWelcomeGroupBy {
+	mwq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MlsWelcomeGroupBy{build: mwq}
+	grbuild.flds = &mwq.ctx.Fields
+	grbuild.label = mlswelcome.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Epoch int `json:"epoch,omitempty"`
+//	}
+//
+//	client.MlsWelcome.Query().
+//		Select(mlswelcome.FieldEpoch).
+//		Scan(ctx, &v)
+func (mwq *MlsWelcomeQuery) Select(fields ...string) *MlsWelcomeSelect {
+	mwq.ctx.Fields = append(mwq.ctx.Fields, fields...)
+	sbuild := &MlsWelcomeSelect{MlsWelcomeQuery: mwq}
+	sbuild.label = mlswelcome.Label
+	sbuild.flds, sbuild.scan = &mwq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MlsWelcomeSelect configured with the given aggregations.
+func (mwq *MlsWelcomeQuery) Aggregate(fns ...AggregateFunc) *MlsWelcomeSelect {
+	return mwq.Select().Aggregate(fns...)
+}
+
+func (mwq *MlsWelcomeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mwq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mwq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mwq.ctx.Fields {
+		if !mlswelcome.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mwq.path != nil {
+		prev, err := mwq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mwq.sql = prev
+	}
+	if mlswelcome.Policy == nil {
+		return errors.New("ent: uninitialized mlswelcome.Policy (forgotten import ent/runtime?)")
+	}
+	if err := mlswelcome.Policy.EvalQuery(ctx, mwq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (mwq *MlsWelcomeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MlsWelcome, error) {
+	var (
+		nodes       = []*MlsWelcome{}
+		withFKs     = mwq.withFKs
+		_spec       = mwq.querySpec()
+		loadedTypes = [3]bool{
+			mwq.withRoom != nil,
+			mwq.withSender != nil,
+			mwq.withDevice != nil,
+		}
+	)
+	if mwq.withRoom != nil || mwq.withSender != nil || mwq.withDevice != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, mlswelcome.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MlsWelcome).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MlsWelcome{config: mwq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mwq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mwq.withRoom; query != nil {
+		if err := mwq.loadRoom(ctx, query, nodes, nil,
+			func(n *MlsWelcome, e *Room) { n.Edges.Room = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mwq.withSender; query != nil {
+		if err := mwq.loadSender(ctx, query, nodes, nil,
+			func(n *MlsWelcome, e *User) { n.Edges.Sender = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mwq.withDevice; query != nil {
+		if err := mwq.loadDevice(ctx, query, nodes, nil,
+			func(n *MlsWelcome, e *Device) { n.Edges.Device = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mwq *MlsWelcomeQuery) loadRoom(ctx context.Context, query *RoomQuery, nodes []*MlsWelcome, init func(*MlsWelcome), assign func(*MlsWelcome, *Room)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MlsWelcome)
+	for i := range nodes {
+		if nodes[i].mls_welcome_room == nil {
+			continue
+		}
+		fk := *nodes[i].mls_welcome_room
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(room.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "mls_welcome_room" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mwq *MlsWelcomeQuery) loadSender(ctx context.Context, query *UserQuery, nodes []*MlsWelcome, init func(*MlsWelcome), assign func(*MlsWelcome, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MlsWelcome)
+	for i := range nodes {
+		if nodes[i].mls_welcome_sender == nil {
+			continue
+		}
+		fk := *nodes[i].mls_welcome_sender
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "mls_welcome_sender" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mwq *MlsWelcomeQuery) loadDevice(ctx context.Context, query *DeviceQuery, nodes []*MlsWelcome, init func(*MlsWelcome), assign func(*MlsWelcome, *Device)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MlsWelcome)
+	for i := range nodes {
+		if nodes[i].mls_welcome_device == nil {
+			continue
+		}
+		fk := *nodes[i].mls_welcome_device
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(device.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "mls_welcome_device" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mwq *MlsWelcomeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mwq.querySpec()
+	_spec.Node.Columns = mwq.ctx.Fields
+	if len(mwq.ctx.Fields) > 0 {
+		_spec.Unique = mwq.ctx.Unique != nil && *mwq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mwq.driver, _spec)
+}
+
+func (mwq *MlsWelcomeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(mlswelcome.Table, mlswelcome.Columns, sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt))
+	_spec.From = mwq.sql
+	if unique := mwq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mwq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mwq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mlswelcome.FieldID)
+		for i := range fields {
+			if fields[i] != mlswelcome.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mwq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mwq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mwq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mwq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mwq *MlsWelcomeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mwq.driver.Dialect())
+	t1 := builder.Table(mlswelcome.Table)
+	columns := mwq.ctx.Fields
+	if len(columns) == 0 {
+		columns = mlswelcome.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mwq.sql != nil {
+		selector = mwq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mwq.ctx.Unique != nil && *mwq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mwq.predicates {
+		p(selector)
+	}
+	for _, p := range mwq.order {
+		p(selector)
+	}
+	if offset := mwq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mwq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MlsWelcomeGroupBy is the group-by builder for MlsWelcome entities.
+type MlsWelcomeGroupBy struct {
+	selector
+	build *MlsWelcomeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mwgb *MlsWelcomeGroupBy) Aggregate(fns ...AggregateFunc) *MlsWelcomeGroupBy {
+	mwgb.fns = append(mwgb.fns, fns...)
+	return mwgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mwgb *MlsWelcomeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mwgb.build.ctx, "GroupBy")
+	if err := mwgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MlsWelcomeQuery, *MlsWelcomeGroupBy](ctx, mwgb.build, mwgb, mwgb.build.inters, v)
+}
+
+func (mwgb *MlsWelcomeGroupBy) sqlScan(ctx context.Context, root *MlsWelcomeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mwgb.fns))
+	for _, fn := range mwgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mwgb.flds)+len(mwgb.fns))
+		for _, f := range *mwgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mwgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mwgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MlsWelcomeSelect is the builder for selecting fields of MlsWelcome entities.
+type MlsWelcomeSelect struct {
+	*MlsWelcomeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mws *MlsWelcomeSelect) Aggregate(fns ...AggregateFunc) *MlsWelcomeSelect {
+	mws.fns = append(mws.fns, fns...)
+	return mws
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mws *MlsWelcomeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mws.ctx, "Select")
+	if err := mws.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MlsWelcomeQuery, *MlsWelcomeSelect](ctx, mws.MlsWelcomeQuery, mws, mws.inters, v)
+}
+
+func (mws *MlsWelcomeSelect) sqlScan(ctx context.Context, root *MlsWelcomeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mws.fns))
+	for _, fn := range mws.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mws.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// MlsWelcomeUpdate is the builder for updating MlsWelcome entities.
+type MlsWelcomeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MlsWelcomeMutation
+}
+
+// Where appends a list predicates to the MlsWelcomeUpdate builder.
+func (mwu *MlsWelcomeUpdate) Where(ps ...predicate.MlsWelcome) *MlsWelcomeUpdate {
+	mwu.mutation.Where(ps...)
+	return mwu
+}
+
+// SetEpoch sets the "epoch" field.
+func (mwu *MlsWelcomeUpdate) SetEpoch(i int) *MlsWelcomeUpdate {
+	mwu.mutation.ResetEpoch()
+	mwu.mutation.SetEpoch(i)
+	return mwu
+}
+
+// SetNillableEpoch sets the "epoch" field if the given value is not nil.
+func (mwu *MlsWelcomeUpdate) SetNillableEpoch(i *int) *MlsWelcomeUpdate {
+	if i != nil {
+		mwu.SetEpoch(*i)
+	}
+	return mwu
+}
+
+// AddEpoch adds i to the "epoch" field.
+func (mwu *MlsWelcomeUpdate) AddEpoch(i int) *MlsWelcomeUpdate {
+	mwu.mutation.AddEpoch(i)
+	return mwu
+}
+
+// SetMessage sets the "message" field.
+func (mwu *MlsWelcomeUpdate) SetMessage(s string) *MlsWelcomeUpdate {
+	mwu.mutation.SetMessage(s)
+	return mwu
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (mwu *MlsWelcomeUpdate) SetNillableMessage(s *string) *MlsWelcomeUpdate {
+	if s != nil {
+		mwu.SetMessage(*s)
+	}
+	return mwu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mwu *MlsWelcomeUpdate) SetCreatedAt(t time.Time) *MlsWelcomeUpdate {
+	mwu.mutation.SetCreatedAt(t)
+	return mwu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mwu *MlsWelcomeUpdate) SetNillableCreatedAt(t *time.Time) *MlsWelcomeUpdate {
+	if t != nil {
+		mwu.SetCreatedAt(*t)
+	}
+	return mwu
+}
+
+// SetRoomID sets the "room" edge to the Room entity by ID.
+func (mwu *MlsWelcomeUpdate) SetRoomID(id int) *MlsWelcomeUpdate {
+	mwu.mutation.SetRoomID(id)
+	return mwu
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (mwu *MlsWelcomeUpdate) SetRoom(r *Room) *MlsWelcomeUpdate {
+	return mwu.SetRoomID(r.ID)
+}
+
+// SetSenderID sets the "sender" edge to the User entity by ID.
+func (mwu *MlsWelcomeUpdate) SetSenderID(id int) *MlsWelcomeUpdate {
+	mwu.mutation.SetSenderID(id)
+	return mwu
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (mwu *MlsWelcomeUpdate) SetSender(u *User) *MlsWelcomeUpdate {
+	return mwu.SetSenderID(u.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (mwu *MlsWelcomeUpdate) SetDeviceID(id int) *MlsWelcomeUpdate {
+	mwu.mutation.SetDeviceID(id)
+	return mwu
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (mwu *MlsWelcomeUpdate) SetDevice(d *Device) *MlsWelcomeUpdate {
+	return mwu.SetDeviceID(d.ID)
+}
+
+// Mutation returns the MlsWelcomeMutation object of the builder.
+func (mwu *MlsWelcomeUpdate) Mutation() *MlsWelcomeMutation {
+	return mwu.mutation
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (mwu *MlsWelcomeUpdate) ClearRoom() *MlsWelcomeUpdate {
+	mwu.mutation.ClearRoom()
+	return mwu
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (mwu *MlsWelcomeUpdate) ClearSender() *MlsWelcomeUpdate {
+	mwu.mutation.ClearSender()
+	return mwu
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (mwu *MlsWelcomeUpdate) ClearDevice() *MlsWelcomeUpdate {
+	mwu.mutation.ClearDevice()
+	return mwu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mwu *MlsWelcomeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mwu.sqlSave, mwu.mutation, mwu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwu *MlsWelcomeUpdate) SaveX(ctx context.Context) int {
+	affected, err := mwu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mwu *MlsWelcomeUpdate) Exec(ctx context.Context) error {
+	_, err := mwu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwu *MlsWelcomeUpdate) ExecX(ctx context.Context) {
+	if err := mwu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mwu *MlsWelcomeUpdate) check() error {
+	if v, ok := mwu.mutation.Epoch(); ok {
+		if err := mlswelcome.EpochValidator(v); err != nil {
+			return &ValidationError{Name: "epoch", err: fmt.Errorf(`ent: validator failed for field "MlsWelcome.epoch": %w`, err)}
+		}
+	}
+	if v, ok := mwu.mutation.Message(); ok {
+		if err := mlswelcome.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "MlsWelcome.message": %w`, err)}
+		}
+	}
+	if _, ok := mwu.mutation.RoomID(); mwu.mutation.RoomCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MlsWelcome.room"`)
+	}
+	if _, ok := mwu.mutation.SenderID(); mwu.mutation.SenderCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MlsWelcome.sender"`)
+	}
+	if _, ok := mwu.mutation.DeviceID(); mwu.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MlsWelcome.device"`)
+	}
+	return nil
+}
+
+func (mwu *MlsWelcomeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mwu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mlswelcome.Table, mlswelcome.Columns, sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt))
+	if ps := mwu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwu.mutation.Epoch(); ok {
+		_spec.SetField(mlswelcome.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := mwu.mutation.AddedEpoch(); ok {
+		_spec.AddField(mlswelcome.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := mwu.mutation.Message(); ok {
+		_spec.SetField(mlswelcome.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := mwu.mutation.CreatedAt(); ok {
+		_spec.SetField(mlswelcome.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mwu.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.RoomTable,
+			Columns: []string{mlswelcome.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwu.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.RoomTable,
+			Columns: []string{mlswelcome.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mwu.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.SenderTable,
+			Columns: []string{mlswelcome.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwu.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.SenderTable,
+			Columns: []string{mlswelcome.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mwu.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.DeviceTable,
+			Columns: []string{mlswelcome.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwu.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.DeviceTable,
+			Columns: []string{mlswelcome.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mwu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mlswelcome.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mwu.mutation.done = true
+	return n, nil
+}
+
+// MlsWelcomeUpdateOne is the builder for updating a single MlsWelcome entity.
+type MlsWelcomeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MlsWelcomeMutation
+}
+
+// SetEpoch sets the "epoch" field.
+func (mwuo *MlsWelcomeUpdateOne) SetEpoch(i int) *MlsWelcomeUpdateOne {
+	mwuo.mutation.ResetEpoch()
+	mwuo.mutation.SetEpoch(i)
+	return mwuo
+}
+
+// SetNillableEpoch sets the "epoch" field if the given value is not nil.
+func (mwuo *MlsWelcomeUpdateOne) SetNillableEpoch(i *int) *MlsWelcomeUpdateOne {
+	if i != nil {
+		mwuo.SetEpoch(*i)
+	}
+	return mwuo
+}
+
+// AddEpoch adds i to the "epoch" field.
+func (mwuo *MlsWelcomeUpdateOne) AddEpoch(i int) *MlsWelcomeUpdateOne {
+	mwuo.mutation.AddEpoch(i)
+	return mwuo
+}
+
+// SetMessage sets the "message" field.
+func (mwuo *MlsWelcomeUpdateOne) SetMessage(s string) *MlsWelcomeUpdateOne {
+	mwuo.mutation.SetMessage(s)
+	return mwuo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (mwuo *MlsWelcomeUpdateOne) SetNillableMessage(s *string) *MlsWelcomeUpdateOne {
+	if s != nil {
+		mwuo.SetMessage(*s)
+	}
+	return mwuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mwuo *MlsWelcomeUpdateOne) SetCreatedAt(t time.Time) *MlsWelcomeUpdateOne {
+	mwuo.mutation.SetCreatedAt(t)
+	return mwuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mwuo *MlsWelcomeUpdateOne) SetNillableCreatedAt(t *time.Time) *MlsWelcomeUpdateOne {
+	if t != nil {
+		mwuo.SetCreatedAt(*t)
+	}
+	return mwuo
+}
+
+// SetRoomID sets the "room" edge to the Room entity by ID.
+func (mwuo *MlsWelcomeUpdateOne) SetRoomID(id int) *MlsWelcomeUpdateOne {
+	mwuo.mutation.SetRoomID(id)
+	return mwuo
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (mwuo *MlsWelcomeUpdateOne) SetRoom(r *Room) *MlsWelcomeUpdateOne {
+	return mwuo.SetRoomID(r.ID)
+}
+
+// SetSenderID sets the "sender" edge to the User entity by ID.
+func (mwuo *MlsWelcomeUpdateOne) SetSenderID(id int) *MlsWelcomeUpdateOne {
+	mwuo.mutation.SetSenderID(id)
+	return mwuo
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (mwuo *MlsWelcomeUpdateOne) SetSender(u *User) *MlsWelcomeUpdateOne {
+	return mwuo.SetSenderID(u.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (mwuo *MlsWelcomeUpdateOne) SetDeviceID(id int) *MlsWelcomeUpdateOne {
+	mwuo.mutation.SetDeviceID(id)
+	return mwuo
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (mwuo *MlsWelcomeUpdateOne) SetDevice(d *Device) *MlsWelcomeUpdateOne {
+	return mwuo.SetDeviceID(d.ID)
+}
+
+// Mutation returns the MlsWelcomeMutation object of the builder.
+func (mwuo *MlsWelcomeUpdateOne) Mutation() *MlsWelcomeMutation {
+	return mwuo.mutation
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (mwuo *MlsWelcomeUpdateOne) ClearRoom() *MlsWelcomeUpdateOne {
+	mwuo.mutation.ClearRoom()
+	return mwuo
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (mwuo *MlsWelcomeUpdateOne) ClearSender() *MlsWelcomeUpdateOne {
+	mwuo.mutation.ClearSender()
+	return mwuo
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (mwuo *MlsWelcomeUpdateOne) ClearDevice() *MlsWelcomeUpdateOne {
+	mwuo.mutation.ClearDevice()
+	return mwuo
+}
+
+// Where appends a list predicates to the MlsWelcomeUpdate builder.
+func (mwuo *MlsWelcomeUpdateOne) Where(ps ...predicate.MlsWelcome) *MlsWelcomeUpdateOne {
+	mwuo.mutation.Where(ps...)
+	return mwuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mwuo *MlsWelcomeUpdateOne) Select(field string, fields ...string) *MlsWelcomeUpdateOne {
+	mwuo.fields = append([]string{field}, fields...)
+	return mwuo
+}
+
+// Save executes the query and returns the updated MlsWelcome entity.
+func (mwuo *MlsWelcomeUpdateOne) Save(ctx context.Context) (*MlsWelcome, error) {
+	return withHooks(ctx, mwuo.sqlSave, mwuo.mutation, mwuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwuo *MlsWelcomeUpdateOne) SaveX(ctx context.Context) *MlsWelcome {
+	node, err := mwuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mwuo *MlsWelcomeUpdateOne) Exec(ctx context.Context) error {
+	_, err := mwuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwuo *MlsWelcomeUpdateOne) ExecX(ctx context.Context) {
+	if err := mwuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mwuo *MlsWelcomeUpdateOne) check() error {
+	if v, ok := mwuo.mutation.Epoch(); ok {
+		if err := mlswelcome.EpochValidator(v); err != nil {
+			return &ValidationError{Name: "epoch", err: fmt.Errorf(`ent: validator failed for field "MlsWelcome.epoch": %w`, err)}
+		}
+	}
+	if v, ok := mwuo.mutation.Message(); ok {
+		if err := mlswelcome.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "MlsWelcome.message": %w`, err)}
+		}
+	}
+	if _, ok := mwuo.mutation.RoomID(); mwuo.mutation.RoomCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MlsWelcome.room"`)
+	}
+	if _, ok := mwuo.mutation.SenderID(); mwuo.mutation.SenderCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MlsWelcome.sender"`)
+	}
+	if _, ok := mwuo.mutation.DeviceID(); mwuo.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MlsWelcome.device"`)
+	}
+	return nil
+}
+
+func (mwuo *MlsWelcomeUpdateOne) sqlSave(ctx context.Context) (_node *MlsWelcome, err error) {
+	if err := mwuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mlswelcome.Table, mlswelcome.Columns, sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt))
+	id, ok := mwuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MlsWelcome.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mwuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mlswelcome.FieldID)
+		for _, f := range fields {
+			if !mlswelcome.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mlswelcome.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mwuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwuo.mutation.Epoch(); ok {
+		_spec.SetField(mlswelcome.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := mwuo.mutation.AddedEpoch(); ok {
+		_spec.AddField(mlswelcome.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := mwuo.mutation.Message(); ok {
+		_spec.SetField(mlswelcome.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := mwuo.mutation.CreatedAt(); ok {
+		_spec.SetField(mlswelcome.FieldCreatedAt, field.TypeTime, value)
+	}
+	if mwuo.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.RoomTable,
+			Columns: []string{mlswelcome.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwuo.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.RoomTable,
+			Columns: []string{mlswelcome.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mwuo.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.SenderTable,
+			Columns: []string{mlswelcome.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwuo.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.SenderTable,
+			Columns: []string{mlswelcome.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if mwuo.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.DeviceTable,
+			Columns: []string{mlswelcome.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mwuo.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   mlswelcome.DeviceTable,
+			Columns: []string{mlswelcome.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MlsWelcome{config: mwuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mwuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mlswelcome.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mwuo.mutation.done = true
+	return _node, nil
+}
@@ -11,17 +11,41 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/apikey"
 	"github.com/eleven-am/enclave/ent/calllog"
 	"github.com/eleven-am/enclave/ent/callparticipant"
 	"github.com/eleven-am/enclave/ent/contact"
+	"github.com/eleven-am/enclave/ent/credential"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/emailtoken"
 	"github.com/eleven-am/enclave/ent/favourite"
+	"github.com/eleven-am/enclave/ent/identity"
+	"github.com/eleven-am/enclave/ent/identitykey"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/keylogentry"
+	"github.com/eleven-am/enclave/ent/keyloghead"
+	"github.com/eleven-am/enclave/ent/keylognode"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/loginthrottle"
 	"github.com/eleven-am/enclave/ent/media"
 	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
 	"github.com/eleven-am/enclave/ent/notification"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
 	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/recoverycode"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+	"github.com/eleven-am/enclave/ent/totpsecret"
 	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/encryption"
 )
 
 const (
@@ -33,52 +57,77 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCallLog         = "CallLog"
-	TypeCallParticipant = "CallParticipant"
-	TypeContact         = "Contact"
-	TypeFavourite       = "Favourite"
-	TypeMedia           = "Media"
-	TypeMessage         = "Message"
-	TypeNotification    = "Notification"
-	TypeRoom            = "Room"
-	TypeRoomMembership  = "RoomMembership"
-	TypeUser            = "User"
+	TypeApiKey                = "ApiKey"
+	TypeCallLog               = "CallLog"
+	TypeCallParticipant       = "CallParticipant"
+	TypeContact               = "Contact"
+	TypeCredential            = "Credential"
+	TypeDevice                = "Device"
+	TypeEmailToken            = "EmailToken"
+	TypeFavourite             = "Favourite"
+	TypeIdentity              = "Identity"
+	TypeIdentityKey           = "IdentityKey"
+	TypeIdentityKeyRecord     = "IdentityKeyRecord"
+	TypeKeyBackupKey          = "KeyBackupKey"
+	TypeKeyBackupVersion      = "KeyBackupVersion"
+	TypeKeyLogEntry           = "KeyLogEntry"
+	TypeKeyLogHead            = "KeyLogHead"
+	TypeKeyLogNode            = "KeyLogNode"
+	TypeKeyPackage            = "KeyPackage"
+	TypeLoginThrottle         = "LoginThrottle"
+	TypeMedia                 = "Media"
+	TypeMessage               = "Message"
+	TypeMessageEnvelope       = "MessageEnvelope"
+	TypeMlsCommit             = "MlsCommit"
+	TypeMlsWelcome            = "MlsWelcome"
+	TypeNotification          = "Notification"
+	TypeOneTimePreKey         = "OneTimePreKey"
+	TypeRecoveryCode          = "RecoveryCode"
+	TypeRoom                  = "Room"
+	TypeRoomMembership        = "RoomMembership"
+	TypeSenderKeyDistribution = "SenderKeyDistribution"
+	TypeSession               = "Session"
+	TypeSignedPreKey          = "SignedPreKey"
+	TypeTotpSecret            = "TotpSecret"
+	TypeUser                  = "User"
 )
 
-// CallLogMutation represents an operation that mutates the CallLog nodes in the graph.
-type CallLogMutation struct {
+// ApiKeyMutation represents an operation that mutates the ApiKey nodes in the graph.
+type ApiKeyMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	status              *calllog.Status
-	started_at          *time.Time
-	ended_at            *time.Time
-	created_at          *time.Time
-	clearedFields       map[string]struct{}
-	initiator           *int
-	clearedinitiator    bool
-	room                *int
-	clearedroom         bool
-	participants        map[int]struct{}
-	removedparticipants map[int]struct{}
-	clearedparticipants bool
-	done                bool
-	oldValue            func(context.Context) (*CallLog, error)
-	predicates          []predicate.CallLog
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	prefix            *string
+	key_hash          *string
+	scopes            *[]string
+	appendscopes      []string
+	created_at        *time.Time
+	last_used_at      *time.Time
+	expires_at        *time.Time
+	revoked_at        *time.Time
+	clearedFields     map[string]struct{}
+	user              *int
+	cleareduser       bool
+	created_by        *int
+	clearedcreated_by bool
+	done              bool
+	oldValue          func(context.Context) (*ApiKey, error)
+	predicates        []predicate.ApiKey
 }
 
-var _ ent.Mutation = (*CallLogMutation)(nil)
+var _ ent.Mutation = (*ApiKeyMutation)(nil)
 
-// calllogOption allows management of the mutation configuration using functional options.
-type calllogOption func(*CallLogMutation)
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*ApiKeyMutation)
 
-// newCallLogMutation creates new mutation for the CallLog entity.
-func newCallLogMutation(c config, op Op, opts ...calllogOption) *CallLogMutation {
-	m := &CallLogMutation{
+// newApiKeyMutation creates new mutation for the ApiKey entity.
+func newApiKeyMutation(c config, op Op, opts ...apikeyOption) *ApiKeyMutation {
+	m := &ApiKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCallLog,
+		typ:           TypeApiKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -87,20 +136,20 @@ func newCallLogMutation(c config, op Op, opts ...calllogOption) *CallLogMutation
 	return m
 }
 
-// withCallLogID sets the ID field of the mutation.
-func withCallLogID(id int) calllogOption {
-	return func(m *CallLogMutation) {
+// withApiKeyID sets the ID field of the mutation.
+func withApiKeyID(id int) apikeyOption {
+	return func(m *ApiKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CallLog
+			value *ApiKey
 		)
-		m.oldValue = func(ctx context.Context) (*CallLog, error) {
+		m.oldValue = func(ctx context.Context) (*ApiKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CallLog.Get(ctx, id)
+					value, err = m.Client().ApiKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -109,10 +158,10 @@ func withCallLogID(id int) calllogOption {
 	}
 }
 
-// withCallLog sets the old CallLog of the mutation.
-func withCallLog(node *CallLog) calllogOption {
-	return func(m *CallLogMutation) {
-		m.oldValue = func(context.Context) (*CallLog, error) {
+// withApiKey sets the old ApiKey of the mutation.
+func withApiKey(node *ApiKey) apikeyOption {
+	return func(m *ApiKeyMutation) {
+		m.oldValue = func(context.Context) (*ApiKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -121,7 +170,7 @@ func withCallLog(node *CallLog) calllogOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CallLogMutation) Client() *Client {
+func (m ApiKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -129,7 +178,7 @@ func (m CallLogMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CallLogMutation) Tx() (*Tx, error) {
+func (m ApiKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -140,7 +189,7 @@ func (m CallLogMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CallLogMutation) ID() (id int, exists bool) {
+func (m *ApiKeyMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -151,7 +200,7 @@ func (m *CallLogMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CallLogMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ApiKeyMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -160,140 +209,178 @@ func (m *CallLogMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CallLog.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ApiKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStatus sets the "status" field.
-func (m *CallLogMutation) SetStatus(c calllog.Status) {
-	m.status = &c
+// SetName sets the "name" field.
+func (m *ApiKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *CallLogMutation) Status() (r calllog.Status, exists bool) {
-	v := m.status
+// Name returns the value of the "name" field in the mutation.
+func (m *ApiKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the CallLog entity.
-// If the CallLog object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CallLogMutation) OldStatus(ctx context.Context) (v calllog.Status, err error) {
+func (m *ApiKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Name, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *CallLogMutation) ResetStatus() {
-	m.status = nil
+// ResetName resets all changes to the "name" field.
+func (m *ApiKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *CallLogMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetPrefix sets the "prefix" field.
+func (m *ApiKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *CallLogMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *ApiKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the CallLog entity.
-// If the CallLog object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CallLogMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ApiKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.StartedAt, nil
+	return oldValue.Prefix, nil
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *CallLogMutation) ResetStartedAt() {
-	m.started_at = nil
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *ApiKeyMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetEndedAt sets the "ended_at" field.
-func (m *CallLogMutation) SetEndedAt(t time.Time) {
-	m.ended_at = &t
+// SetKeyHash sets the "key_hash" field.
+func (m *ApiKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
 }
 
-// EndedAt returns the value of the "ended_at" field in the mutation.
-func (m *CallLogMutation) EndedAt() (r time.Time, exists bool) {
-	v := m.ended_at
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *ApiKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndedAt returns the old "ended_at" field's value of the CallLog entity.
-// If the CallLog object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyHash returns the old "key_hash" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CallLogMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ApiKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
 	}
-	return oldValue.EndedAt, nil
+	return oldValue.KeyHash, nil
 }
 
-// ClearEndedAt clears the value of the "ended_at" field.
-func (m *CallLogMutation) ClearEndedAt() {
-	m.ended_at = nil
-	m.clearedFields[calllog.FieldEndedAt] = struct{}{}
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *ApiKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
 }
 
-// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
-func (m *CallLogMutation) EndedAtCleared() bool {
-	_, ok := m.clearedFields[calllog.FieldEndedAt]
-	return ok
+// SetScopes sets the "scopes" field.
+func (m *ApiKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// ResetEndedAt resets all changes to the "ended_at" field.
-func (m *CallLogMutation) ResetEndedAt() {
-	m.ended_at = nil
-	delete(m.clearedFields, calllog.FieldEndedAt)
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *ApiKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *ApiKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *ApiKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *ApiKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *CallLogMutation) SetCreatedAt(t time.Time) {
+func (m *ApiKeyMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CallLogMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ApiKeyMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -301,10 +388,10 @@ func (m *CallLogMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the CallLog entity.
-// If the CallLog object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CallLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ApiKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -319,151 +406,244 @@ func (m *CallLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CallLogMutation) ResetCreatedAt() {
+func (m *ApiKeyMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetInitiatorID sets the "initiator" edge to the User entity by id.
-func (m *CallLogMutation) SetInitiatorID(id int) {
-	m.initiator = &id
-}
-
-// ClearInitiator clears the "initiator" edge to the User entity.
-func (m *CallLogMutation) ClearInitiator() {
-	m.clearedinitiator = true
-}
-
-// InitiatorCleared reports if the "initiator" edge to the User entity was cleared.
-func (m *CallLogMutation) InitiatorCleared() bool {
-	return m.clearedinitiator
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *ApiKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// InitiatorID returns the "initiator" edge ID in the mutation.
-func (m *CallLogMutation) InitiatorID() (id int, exists bool) {
-	if m.initiator != nil {
-		return *m.initiator, true
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *ApiKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// InitiatorIDs returns the "initiator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// InitiatorID instead. It exists only for internal usage by the builders.
-func (m *CallLogMutation) InitiatorIDs() (ids []int) {
-	if id := m.initiator; id != nil {
-		ids = append(ids, *id)
+// OldLastUsedAt returns the old "last_used_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetInitiator resets all changes to the "initiator" edge.
-func (m *CallLogMutation) ResetInitiator() {
-	m.initiator = nil
-	m.clearedinitiator = false
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *ApiKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
 }
 
-// SetRoomID sets the "room" edge to the Room entity by id.
-func (m *CallLogMutation) SetRoomID(id int) {
-	m.room = &id
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *ApiKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
+	return ok
 }
 
-// ClearRoom clears the "room" edge to the Room entity.
-func (m *CallLogMutation) ClearRoom() {
-	m.clearedroom = true
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *ApiKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
 }
 
-// RoomCleared reports if the "room" edge to the Room entity was cleared.
-func (m *CallLogMutation) RoomCleared() bool {
-	return m.clearedroom
+// SetExpiresAt sets the "expires_at" field.
+func (m *ApiKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// RoomID returns the "room" edge ID in the mutation.
-func (m *CallLogMutation) RoomID() (id int, exists bool) {
-	if m.room != nil {
-		return *m.room, true
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ApiKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// RoomIDs returns the "room" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoomID instead. It exists only for internal usage by the builders.
-func (m *CallLogMutation) RoomIDs() (ids []int) {
-	if id := m.room; id != nil {
-		ids = append(ids, *id)
+// OldExpiresAt returns the old "expires_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiKeyMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetRoom resets all changes to the "room" edge.
-func (m *CallLogMutation) ResetRoom() {
-	m.room = nil
-	m.clearedroom = false
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ApiKeyMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apikey.FieldExpiresAt] = struct{}{}
 }
 
-// AddParticipantIDs adds the "participants" edge to the CallParticipant entity by ids.
-func (m *CallLogMutation) AddParticipantIDs(ids ...int) {
-	if m.participants == nil {
-		m.participants = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.participants[ids[i]] = struct{}{}
-	}
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ApiKeyMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldExpiresAt]
+	return ok
 }
 
-// ClearParticipants clears the "participants" edge to the CallParticipant entity.
-func (m *CallLogMutation) ClearParticipants() {
-	m.clearedparticipants = true
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ApiKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apikey.FieldExpiresAt)
 }
 
-// ParticipantsCleared reports if the "participants" edge to the CallParticipant entity was cleared.
-func (m *CallLogMutation) ParticipantsCleared() bool {
-	return m.clearedparticipants
+// SetRevokedAt sets the "revoked_at" field.
+func (m *ApiKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// RemoveParticipantIDs removes the "participants" edge to the CallParticipant entity by IDs.
-func (m *CallLogMutation) RemoveParticipantIDs(ids ...int) {
-	if m.removedparticipants == nil {
-		m.removedparticipants = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.participants, ids[i])
-		m.removedparticipants[ids[i]] = struct{}{}
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *ApiKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedParticipants returns the removed IDs of the "participants" edge to the CallParticipant entity.
-func (m *CallLogMutation) RemovedParticipantsIDs() (ids []int) {
-	for id := range m.removedparticipants {
-		ids = append(ids, id)
+// OldRevokedAt returns the old "revoked_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApiKeyMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
 }
 
-// ParticipantsIDs returns the "participants" edge IDs in the mutation.
-func (m *CallLogMutation) ParticipantsIDs() (ids []int) {
-	for id := range m.participants {
-		ids = append(ids, id)
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *ApiKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikey.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *ApiKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *ApiKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikey.FieldRevokedAt)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ApiKeyMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ApiKeyMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ApiKeyMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ApiKeyMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// ResetParticipants resets all changes to the "participants" edge.
-func (m *CallLogMutation) ResetParticipants() {
-	m.participants = nil
-	m.clearedparticipants = false
-	m.removedparticipants = nil
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ApiKeyMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// Where appends a list predicates to the CallLogMutation builder.
-func (m *CallLogMutation) Where(ps ...predicate.CallLog) {
+// ResetUser resets all changes to the "user" edge.
+func (m *ApiKeyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetCreatedByID sets the "created_by" edge to the User entity by id.
+func (m *ApiKeyMutation) SetCreatedByID(id int) {
+	m.created_by = &id
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (m *ApiKeyMutation) ClearCreatedBy() {
+	m.clearedcreated_by = true
+}
+
+// CreatedByCleared reports if the "created_by" edge to the User entity was cleared.
+func (m *ApiKeyMutation) CreatedByCleared() bool {
+	return m.clearedcreated_by
+}
+
+// CreatedByID returns the "created_by" edge ID in the mutation.
+func (m *ApiKeyMutation) CreatedByID() (id int, exists bool) {
+	if m.created_by != nil {
+		return *m.created_by, true
+	}
+	return
+}
+
+// CreatedByIDs returns the "created_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatedByID instead. It exists only for internal usage by the builders.
+func (m *ApiKeyMutation) CreatedByIDs() (ids []int) {
+	if id := m.created_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreatedBy resets all changes to the "created_by" edge.
+func (m *ApiKeyMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.clearedcreated_by = false
+}
+
+// Where appends a list predicates to the ApiKeyMutation builder.
+func (m *ApiKeyMutation) Where(ps ...predicate.ApiKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CallLogMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ApiKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CallLogMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CallLog, len(ps))
+func (m *ApiKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ApiKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -471,36 +651,48 @@ func (m *CallLogMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CallLogMutation) Op() Op {
+func (m *ApiKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CallLogMutation) SetOp(op Op) {
+func (m *ApiKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CallLog).
-func (m *CallLogMutation) Type() string {
+// Type returns the node type of this mutation (ApiKey).
+func (m *ApiKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CallLogMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.status != nil {
-		fields = append(fields, calllog.FieldStatus)
+func (m *ApiKeyMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
 	}
-	if m.started_at != nil {
-		fields = append(fields, calllog.FieldStartedAt)
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
 	}
-	if m.ended_at != nil {
-		fields = append(fields, calllog.FieldEndedAt)
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
+	}
+	if m.scopes != nil {
+		fields = append(fields, apikey.FieldScopes)
 	}
 	if m.created_at != nil {
-		fields = append(fields, calllog.FieldCreatedAt)
+		fields = append(fields, apikey.FieldCreatedAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, apikey.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
@@ -508,16 +700,24 @@ func (m *CallLogMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CallLogMutation) Field(name string) (ent.Value, bool) {
+func (m *ApiKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case calllog.FieldStatus:
-		return m.Status()
-	case calllog.FieldStartedAt:
-		return m.StartedAt()
-	case calllog.FieldEndedAt:
-		return m.EndedAt()
-	case calllog.FieldCreatedAt:
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldScopes:
+		return m.Scopes()
+	case apikey.FieldCreatedAt:
 		return m.CreatedAt()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
 	}
 	return nil, false
 }
@@ -525,277 +725,313 @@ func (m *CallLogMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CallLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ApiKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case calllog.FieldStatus:
-		return m.OldStatus(ctx)
-	case calllog.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case calllog.FieldEndedAt:
-		return m.OldEndedAt(ctx)
-	case calllog.FieldCreatedAt:
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case apikey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown CallLog field %s", name)
+	return nil, fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CallLogMutation) SetField(name string, value ent.Value) error {
+func (m *ApiKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case calllog.FieldStatus:
-		v, ok := value.(calllog.Status)
+	case apikey.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetName(v)
 		return nil
-	case calllog.FieldStartedAt:
+	case apikey.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case apikey.FieldKeyHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyHash(v)
+		return nil
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case apikey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case calllog.FieldEndedAt:
+	case apikey.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndedAt(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case calllog.FieldCreatedAt:
+	case apikey.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetExpiresAt(v)
+		return nil
+	case apikey.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CallLog field %s", name)
+	return fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CallLogMutation) AddedFields() []string {
+func (m *ApiKeyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CallLogMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ApiKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CallLogMutation) AddField(name string, value ent.Value) error {
+func (m *ApiKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown CallLog numeric field %s", name)
+	return fmt.Errorf("unknown ApiKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CallLogMutation) ClearedFields() []string {
+func (m *ApiKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(calllog.FieldEndedAt) {
-		fields = append(fields, calllog.FieldEndedAt)
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.FieldCleared(apikey.FieldExpiresAt) {
+		fields = append(fields, apikey.FieldExpiresAt)
+	}
+	if m.FieldCleared(apikey.FieldRevokedAt) {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CallLogMutation) FieldCleared(name string) bool {
+func (m *ApiKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CallLogMutation) ClearField(name string) error {
+func (m *ApiKeyMutation) ClearField(name string) error {
 	switch name {
-	case calllog.FieldEndedAt:
-		m.ClearEndedAt()
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apikey.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CallLog nullable field %s", name)
+	return fmt.Errorf("unknown ApiKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CallLogMutation) ResetField(name string) error {
+func (m *ApiKeyMutation) ResetField(name string) error {
 	switch name {
-	case calllog.FieldStatus:
-		m.ResetStatus()
+	case apikey.FieldName:
+		m.ResetName()
 		return nil
-	case calllog.FieldStartedAt:
-		m.ResetStartedAt()
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
 		return nil
-	case calllog.FieldEndedAt:
-		m.ResetEndedAt()
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
 		return nil
-	case calllog.FieldCreatedAt:
+	case apikey.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case apikey.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case apikey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown CallLog field %s", name)
+	return fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CallLogMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.initiator != nil {
-		edges = append(edges, calllog.EdgeInitiator)
-	}
-	if m.room != nil {
-		edges = append(edges, calllog.EdgeRoom)
+func (m *ApiKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, apikey.EdgeUser)
 	}
-	if m.participants != nil {
-		edges = append(edges, calllog.EdgeParticipants)
+	if m.created_by != nil {
+		edges = append(edges, apikey.EdgeCreatedBy)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CallLogMutation) AddedIDs(name string) []ent.Value {
+func (m *ApiKeyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case calllog.EdgeInitiator:
-		if id := m.initiator; id != nil {
+	case apikey.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case calllog.EdgeRoom:
-		if id := m.room; id != nil {
+	case apikey.EdgeCreatedBy:
+		if id := m.created_by; id != nil {
 			return []ent.Value{*id}
 		}
-	case calllog.EdgeParticipants:
-		ids := make([]ent.Value, 0, len(m.participants))
-		for id := range m.participants {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CallLogMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedparticipants != nil {
-		edges = append(edges, calllog.EdgeParticipants)
-	}
+func (m *ApiKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CallLogMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case calllog.EdgeParticipants:
-		ids := make([]ent.Value, 0, len(m.removedparticipants))
-		for id := range m.removedparticipants {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ApiKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CallLogMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedinitiator {
-		edges = append(edges, calllog.EdgeInitiator)
-	}
-	if m.clearedroom {
-		edges = append(edges, calllog.EdgeRoom)
+func (m *ApiKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, apikey.EdgeUser)
 	}
-	if m.clearedparticipants {
-		edges = append(edges, calllog.EdgeParticipants)
+	if m.clearedcreated_by {
+		edges = append(edges, apikey.EdgeCreatedBy)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CallLogMutation) EdgeCleared(name string) bool {
+func (m *ApiKeyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case calllog.EdgeInitiator:
-		return m.clearedinitiator
-	case calllog.EdgeRoom:
-		return m.clearedroom
-	case calllog.EdgeParticipants:
-		return m.clearedparticipants
+	case apikey.EdgeUser:
+		return m.cleareduser
+	case apikey.EdgeCreatedBy:
+		return m.clearedcreated_by
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CallLogMutation) ClearEdge(name string) error {
+func (m *ApiKeyMutation) ClearEdge(name string) error {
 	switch name {
-	case calllog.EdgeInitiator:
-		m.ClearInitiator()
+	case apikey.EdgeUser:
+		m.ClearUser()
 		return nil
-	case calllog.EdgeRoom:
-		m.ClearRoom()
+	case apikey.EdgeCreatedBy:
+		m.ClearCreatedBy()
 		return nil
 	}
-	return fmt.Errorf("unknown CallLog unique edge %s", name)
+	return fmt.Errorf("unknown ApiKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CallLogMutation) ResetEdge(name string) error {
+func (m *ApiKeyMutation) ResetEdge(name string) error {
 	switch name {
-	case calllog.EdgeInitiator:
-		m.ResetInitiator()
-		return nil
-	case calllog.EdgeRoom:
-		m.ResetRoom()
+	case apikey.EdgeUser:
+		m.ResetUser()
 		return nil
-	case calllog.EdgeParticipants:
-		m.ResetParticipants()
+	case apikey.EdgeCreatedBy:
+		m.ResetCreatedBy()
 		return nil
 	}
-	return fmt.Errorf("unknown CallLog edge %s", name)
+	return fmt.Errorf("unknown ApiKey edge %s", name)
 }
 
-// CallParticipantMutation represents an operation that mutates the CallParticipant nodes in the graph.
-type CallParticipantMutation struct {
+// CallLogMutation represents an operation that mutates the CallLog nodes in the graph.
+type CallLogMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	role               *callparticipant.Role
-	joined_at          *time.Time
-	left_at            *time.Time
-	clearedFields      map[string]struct{}
-	call               *int
-	clearedcall        bool
-	participant        *int
-	clearedparticipant bool
-	done               bool
-	oldValue           func(context.Context) (*CallParticipant, error)
-	predicates         []predicate.CallParticipant
+	op                  Op
+	typ                 string
+	id                  *int
+	status              *calllog.Status
+	started_at          *time.Time
+	ended_at            *time.Time
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	initiator           *int
+	clearedinitiator    bool
+	room                *int
+	clearedroom         bool
+	participants        map[int]struct{}
+	removedparticipants map[int]struct{}
+	clearedparticipants bool
+	done                bool
+	oldValue            func(context.Context) (*CallLog, error)
+	predicates          []predicate.CallLog
 }
 
-var _ ent.Mutation = (*CallParticipantMutation)(nil)
+var _ ent.Mutation = (*CallLogMutation)(nil)
 
-// callparticipantOption allows management of the mutation configuration using functional options.
-type callparticipantOption func(*CallParticipantMutation)
+// calllogOption allows management of the mutation configuration using functional options.
+type calllogOption func(*CallLogMutation)
 
-// newCallParticipantMutation creates new mutation for the CallParticipant entity.
-func newCallParticipantMutation(c config, op Op, opts ...callparticipantOption) *CallParticipantMutation {
-	m := &CallParticipantMutation{
+// newCallLogMutation creates new mutation for the CallLog entity.
+func newCallLogMutation(c config, op Op, opts ...calllogOption) *CallLogMutation {
+	m := &CallLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCallParticipant,
+		typ:           TypeCallLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -804,20 +1040,20 @@ func newCallParticipantMutation(c config, op Op, opts ...callparticipantOption)
 	return m
 }
 
-// withCallParticipantID sets the ID field of the mutation.
-func withCallParticipantID(id int) callparticipantOption {
-	return func(m *CallParticipantMutation) {
+// withCallLogID sets the ID field of the mutation.
+func withCallLogID(id int) calllogOption {
+	return func(m *CallLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CallParticipant
+			value *CallLog
 		)
-		m.oldValue = func(ctx context.Context) (*CallParticipant, error) {
+		m.oldValue = func(ctx context.Context) (*CallLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CallParticipant.Get(ctx, id)
+					value, err = m.Client().CallLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -826,10 +1062,10 @@ func withCallParticipantID(id int) callparticipantOption {
 	}
 }
 
-// withCallParticipant sets the old CallParticipant of the mutation.
-func withCallParticipant(node *CallParticipant) callparticipantOption {
-	return func(m *CallParticipantMutation) {
-		m.oldValue = func(context.Context) (*CallParticipant, error) {
+// withCallLog sets the old CallLog of the mutation.
+func withCallLog(node *CallLog) calllogOption {
+	return func(m *CallLogMutation) {
+		m.oldValue = func(context.Context) (*CallLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -838,7 +1074,7 @@ func withCallParticipant(node *CallParticipant) callparticipantOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CallParticipantMutation) Client() *Client {
+func (m CallLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -846,7 +1082,7 @@ func (m CallParticipantMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CallParticipantMutation) Tx() (*Tx, error) {
+func (m CallLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -857,7 +1093,7 @@ func (m CallParticipantMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CallParticipantMutation) ID() (id int, exists bool) {
+func (m *CallLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -868,7 +1104,7 @@ func (m *CallParticipantMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CallParticipantMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CallLogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -877,220 +1113,310 @@ func (m *CallParticipantMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CallParticipant.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CallLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRole sets the "role" field.
-func (m *CallParticipantMutation) SetRole(c callparticipant.Role) {
-	m.role = &c
+// SetStatus sets the "status" field.
+func (m *CallLogMutation) SetStatus(c calllog.Status) {
+	m.status = &c
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *CallParticipantMutation) Role() (r callparticipant.Role, exists bool) {
-	v := m.role
+// Status returns the value of the "status" field in the mutation.
+func (m *CallLogMutation) Status() (r calllog.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the CallParticipant entity.
-// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the CallLog entity.
+// If the CallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CallParticipantMutation) OldRole(ctx context.Context) (v callparticipant.Role, err error) {
+func (m *CallLogMutation) OldStatus(ctx context.Context) (v calllog.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.Status, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *CallParticipantMutation) ResetRole() {
-	m.role = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *CallLogMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetJoinedAt sets the "joined_at" field.
-func (m *CallParticipantMutation) SetJoinedAt(t time.Time) {
-	m.joined_at = &t
+// SetStartedAt sets the "started_at" field.
+func (m *CallLogMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// JoinedAt returns the value of the "joined_at" field in the mutation.
-func (m *CallParticipantMutation) JoinedAt() (r time.Time, exists bool) {
-	v := m.joined_at
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *CallLogMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJoinedAt returns the old "joined_at" field's value of the CallParticipant entity.
-// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the CallLog entity.
+// If the CallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CallParticipantMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CallLogMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.JoinedAt, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetJoinedAt resets all changes to the "joined_at" field.
-func (m *CallParticipantMutation) ResetJoinedAt() {
-	m.joined_at = nil
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *CallLogMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetLeftAt sets the "left_at" field.
-func (m *CallParticipantMutation) SetLeftAt(t time.Time) {
-	m.left_at = &t
+// SetEndedAt sets the "ended_at" field.
+func (m *CallLogMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
 }
 
-// LeftAt returns the value of the "left_at" field in the mutation.
-func (m *CallParticipantMutation) LeftAt() (r time.Time, exists bool) {
-	v := m.left_at
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *CallLogMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLeftAt returns the old "left_at" field's value of the CallParticipant entity.
-// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
+// OldEndedAt returns the old "ended_at" field's value of the CallLog entity.
+// If the CallLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CallParticipantMutation) OldLeftAt(ctx context.Context) (v *time.Time, err error) {
+func (m *CallLogMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLeftAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLeftAt requires an ID field in the mutation")
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLeftAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
 	}
-	return oldValue.LeftAt, nil
+	return oldValue.EndedAt, nil
 }
 
-// ClearLeftAt clears the value of the "left_at" field.
-func (m *CallParticipantMutation) ClearLeftAt() {
-	m.left_at = nil
-	m.clearedFields[callparticipant.FieldLeftAt] = struct{}{}
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *CallLogMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[calllog.FieldEndedAt] = struct{}{}
 }
 
-// LeftAtCleared returns if the "left_at" field was cleared in this mutation.
-func (m *CallParticipantMutation) LeftAtCleared() bool {
-	_, ok := m.clearedFields[callparticipant.FieldLeftAt]
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *CallLogMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[calllog.FieldEndedAt]
 	return ok
 }
 
-// ResetLeftAt resets all changes to the "left_at" field.
-func (m *CallParticipantMutation) ResetLeftAt() {
-	m.left_at = nil
-	delete(m.clearedFields, callparticipant.FieldLeftAt)
-}
-
-// SetCallID sets the "call" edge to the CallLog entity by id.
-func (m *CallParticipantMutation) SetCallID(id int) {
-	m.call = &id
-}
-
-// ClearCall clears the "call" edge to the CallLog entity.
-func (m *CallParticipantMutation) ClearCall() {
-	m.clearedcall = true
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *CallLogMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, calllog.FieldEndedAt)
 }
 
-// CallCleared reports if the "call" edge to the CallLog entity was cleared.
-func (m *CallParticipantMutation) CallCleared() bool {
-	return m.clearedcall
+// SetCreatedAt sets the "created_at" field.
+func (m *CallLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// CallID returns the "call" edge ID in the mutation.
-func (m *CallParticipantMutation) CallID() (id int, exists bool) {
-	if m.call != nil {
-		return *m.call, true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CallLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CallIDs returns the "call" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CallID instead. It exists only for internal usage by the builders.
-func (m *CallParticipantMutation) CallIDs() (ids []int) {
-	if id := m.call; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the CallLog entity.
+// If the CallLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CallLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetCall resets all changes to the "call" edge.
-func (m *CallParticipantMutation) ResetCall() {
-	m.call = nil
-	m.clearedcall = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CallLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetParticipantID sets the "participant" edge to the User entity by id.
-func (m *CallParticipantMutation) SetParticipantID(id int) {
-	m.participant = &id
+// SetInitiatorID sets the "initiator" edge to the User entity by id.
+func (m *CallLogMutation) SetInitiatorID(id int) {
+	m.initiator = &id
 }
 
-// ClearParticipant clears the "participant" edge to the User entity.
-func (m *CallParticipantMutation) ClearParticipant() {
-	m.clearedparticipant = true
+// ClearInitiator clears the "initiator" edge to the User entity.
+func (m *CallLogMutation) ClearInitiator() {
+	m.clearedinitiator = true
 }
 
-// ParticipantCleared reports if the "participant" edge to the User entity was cleared.
-func (m *CallParticipantMutation) ParticipantCleared() bool {
-	return m.clearedparticipant
+// InitiatorCleared reports if the "initiator" edge to the User entity was cleared.
+func (m *CallLogMutation) InitiatorCleared() bool {
+	return m.clearedinitiator
 }
 
-// ParticipantID returns the "participant" edge ID in the mutation.
-func (m *CallParticipantMutation) ParticipantID() (id int, exists bool) {
-	if m.participant != nil {
-		return *m.participant, true
+// InitiatorID returns the "initiator" edge ID in the mutation.
+func (m *CallLogMutation) InitiatorID() (id int, exists bool) {
+	if m.initiator != nil {
+		return *m.initiator, true
 	}
 	return
 }
 
-// ParticipantIDs returns the "participant" edge IDs in the mutation.
+// InitiatorIDs returns the "initiator" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParticipantID instead. It exists only for internal usage by the builders.
-func (m *CallParticipantMutation) ParticipantIDs() (ids []int) {
-	if id := m.participant; id != nil {
+// InitiatorID instead. It exists only for internal usage by the builders.
+func (m *CallLogMutation) InitiatorIDs() (ids []int) {
+	if id := m.initiator; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetParticipant resets all changes to the "participant" edge.
-func (m *CallParticipantMutation) ResetParticipant() {
-	m.participant = nil
-	m.clearedparticipant = false
+// ResetInitiator resets all changes to the "initiator" edge.
+func (m *CallLogMutation) ResetInitiator() {
+	m.initiator = nil
+	m.clearedinitiator = false
 }
 
-// Where appends a list predicates to the CallParticipantMutation builder.
-func (m *CallParticipantMutation) Where(ps ...predicate.CallParticipant) {
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *CallLogMutation) SetRoomID(id int) {
+	m.room = &id
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *CallLogMutation) ClearRoom() {
+	m.clearedroom = true
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *CallLogMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *CallLogMutation) RoomID() (id int, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *CallLogMutation) RoomIDs() (ids []int) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *CallLogMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// AddParticipantIDs adds the "participants" edge to the CallParticipant entity by ids.
+func (m *CallLogMutation) AddParticipantIDs(ids ...int) {
+	if m.participants == nil {
+		m.participants = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.participants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParticipants clears the "participants" edge to the CallParticipant entity.
+func (m *CallLogMutation) ClearParticipants() {
+	m.clearedparticipants = true
+}
+
+// ParticipantsCleared reports if the "participants" edge to the CallParticipant entity was cleared.
+func (m *CallLogMutation) ParticipantsCleared() bool {
+	return m.clearedparticipants
+}
+
+// RemoveParticipantIDs removes the "participants" edge to the CallParticipant entity by IDs.
+func (m *CallLogMutation) RemoveParticipantIDs(ids ...int) {
+	if m.removedparticipants == nil {
+		m.removedparticipants = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.participants, ids[i])
+		m.removedparticipants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParticipants returns the removed IDs of the "participants" edge to the CallParticipant entity.
+func (m *CallLogMutation) RemovedParticipantsIDs() (ids []int) {
+	for id := range m.removedparticipants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParticipantsIDs returns the "participants" edge IDs in the mutation.
+func (m *CallLogMutation) ParticipantsIDs() (ids []int) {
+	for id := range m.participants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParticipants resets all changes to the "participants" edge.
+func (m *CallLogMutation) ResetParticipants() {
+	m.participants = nil
+	m.clearedparticipants = false
+	m.removedparticipants = nil
+}
+
+// Where appends a list predicates to the CallLogMutation builder.
+func (m *CallLogMutation) Where(ps ...predicate.CallLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CallParticipantMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CallLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CallParticipantMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CallParticipant, len(ps))
+func (m *CallLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CallLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1098,33 +1424,36 @@ func (m *CallParticipantMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CallParticipantMutation) Op() Op {
+func (m *CallLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CallParticipantMutation) SetOp(op Op) {
+func (m *CallLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CallParticipant).
-func (m *CallParticipantMutation) Type() string {
+// Type returns the node type of this mutation (CallLog).
+func (m *CallLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CallParticipantMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.role != nil {
-		fields = append(fields, callparticipant.FieldRole)
+func (m *CallLogMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.status != nil {
+		fields = append(fields, calllog.FieldStatus)
 	}
-	if m.joined_at != nil {
-		fields = append(fields, callparticipant.FieldJoinedAt)
+	if m.started_at != nil {
+		fields = append(fields, calllog.FieldStartedAt)
 	}
-	if m.left_at != nil {
-		fields = append(fields, callparticipant.FieldLeftAt)
+	if m.ended_at != nil {
+		fields = append(fields, calllog.FieldEndedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, calllog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1132,14 +1461,16 @@ func (m *CallParticipantMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CallParticipantMutation) Field(name string) (ent.Value, bool) {
+func (m *CallLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case callparticipant.FieldRole:
-		return m.Role()
-	case callparticipant.FieldJoinedAt:
-		return m.JoinedAt()
-	case callparticipant.FieldLeftAt:
-		return m.LeftAt()
+	case calllog.FieldStatus:
+		return m.Status()
+	case calllog.FieldStartedAt:
+		return m.StartedAt()
+	case calllog.FieldEndedAt:
+		return m.EndedAt()
+	case calllog.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1147,239 +1478,277 @@ func (m *CallParticipantMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CallParticipantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CallLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case callparticipant.FieldRole:
-		return m.OldRole(ctx)
-	case callparticipant.FieldJoinedAt:
-		return m.OldJoinedAt(ctx)
-	case callparticipant.FieldLeftAt:
-		return m.OldLeftAt(ctx)
+	case calllog.FieldStatus:
+		return m.OldStatus(ctx)
+	case calllog.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case calllog.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case calllog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown CallParticipant field %s", name)
+	return nil, fmt.Errorf("unknown CallLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CallParticipantMutation) SetField(name string, value ent.Value) error {
+func (m *CallLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case callparticipant.FieldRole:
-		v, ok := value.(callparticipant.Role)
+	case calllog.FieldStatus:
+		v, ok := value.(calllog.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetStatus(v)
 		return nil
-	case callparticipant.FieldJoinedAt:
+	case calllog.FieldStartedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJoinedAt(v)
+		m.SetStartedAt(v)
 		return nil
-	case callparticipant.FieldLeftAt:
+	case calllog.FieldEndedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLeftAt(v)
+		m.SetEndedAt(v)
+		return nil
+	case calllog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CallParticipant field %s", name)
+	return fmt.Errorf("unknown CallLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CallParticipantMutation) AddedFields() []string {
+func (m *CallLogMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CallParticipantMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CallLogMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CallParticipantMutation) AddField(name string, value ent.Value) error {
+func (m *CallLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown CallParticipant numeric field %s", name)
+	return fmt.Errorf("unknown CallLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CallParticipantMutation) ClearedFields() []string {
+func (m *CallLogMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(callparticipant.FieldLeftAt) {
-		fields = append(fields, callparticipant.FieldLeftAt)
+	if m.FieldCleared(calllog.FieldEndedAt) {
+		fields = append(fields, calllog.FieldEndedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CallParticipantMutation) FieldCleared(name string) bool {
+func (m *CallLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CallParticipantMutation) ClearField(name string) error {
+func (m *CallLogMutation) ClearField(name string) error {
 	switch name {
-	case callparticipant.FieldLeftAt:
-		m.ClearLeftAt()
+	case calllog.FieldEndedAt:
+		m.ClearEndedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CallParticipant nullable field %s", name)
+	return fmt.Errorf("unknown CallLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CallParticipantMutation) ResetField(name string) error {
+func (m *CallLogMutation) ResetField(name string) error {
 	switch name {
-	case callparticipant.FieldRole:
-		m.ResetRole()
+	case calllog.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case callparticipant.FieldJoinedAt:
-		m.ResetJoinedAt()
+	case calllog.FieldStartedAt:
+		m.ResetStartedAt()
 		return nil
-	case callparticipant.FieldLeftAt:
-		m.ResetLeftAt()
+	case calllog.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case calllog.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CallParticipant field %s", name)
+	return fmt.Errorf("unknown CallLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CallParticipantMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.call != nil {
-		edges = append(edges, callparticipant.EdgeCall)
+func (m *CallLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.initiator != nil {
+		edges = append(edges, calllog.EdgeInitiator)
 	}
-	if m.participant != nil {
-		edges = append(edges, callparticipant.EdgeParticipant)
+	if m.room != nil {
+		edges = append(edges, calllog.EdgeRoom)
+	}
+	if m.participants != nil {
+		edges = append(edges, calllog.EdgeParticipants)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CallParticipantMutation) AddedIDs(name string) []ent.Value {
+func (m *CallLogMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case callparticipant.EdgeCall:
-		if id := m.call; id != nil {
+	case calllog.EdgeInitiator:
+		if id := m.initiator; id != nil {
 			return []ent.Value{*id}
 		}
-	case callparticipant.EdgeParticipant:
-		if id := m.participant; id != nil {
+	case calllog.EdgeRoom:
+		if id := m.room; id != nil {
 			return []ent.Value{*id}
 		}
+	case calllog.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.participants))
+		for id := range m.participants {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CallParticipantMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CallLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedparticipants != nil {
+		edges = append(edges, calllog.EdgeParticipants)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CallParticipantMutation) RemovedIDs(name string) []ent.Value {
+func (m *CallLogMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case calllog.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.removedparticipants))
+		for id := range m.removedparticipants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CallParticipantMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedcall {
-		edges = append(edges, callparticipant.EdgeCall)
+func (m *CallLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedinitiator {
+		edges = append(edges, calllog.EdgeInitiator)
 	}
-	if m.clearedparticipant {
-		edges = append(edges, callparticipant.EdgeParticipant)
+	if m.clearedroom {
+		edges = append(edges, calllog.EdgeRoom)
+	}
+	if m.clearedparticipants {
+		edges = append(edges, calllog.EdgeParticipants)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CallParticipantMutation) EdgeCleared(name string) bool {
+func (m *CallLogMutation) EdgeCleared(name string) bool {
 	switch name {
-	case callparticipant.EdgeCall:
-		return m.clearedcall
-	case callparticipant.EdgeParticipant:
-		return m.clearedparticipant
+	case calllog.EdgeInitiator:
+		return m.clearedinitiator
+	case calllog.EdgeRoom:
+		return m.clearedroom
+	case calllog.EdgeParticipants:
+		return m.clearedparticipants
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CallParticipantMutation) ClearEdge(name string) error {
+func (m *CallLogMutation) ClearEdge(name string) error {
 	switch name {
-	case callparticipant.EdgeCall:
-		m.ClearCall()
+	case calllog.EdgeInitiator:
+		m.ClearInitiator()
 		return nil
-	case callparticipant.EdgeParticipant:
-		m.ClearParticipant()
+	case calllog.EdgeRoom:
+		m.ClearRoom()
 		return nil
 	}
-	return fmt.Errorf("unknown CallParticipant unique edge %s", name)
+	return fmt.Errorf("unknown CallLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CallParticipantMutation) ResetEdge(name string) error {
+func (m *CallLogMutation) ResetEdge(name string) error {
 	switch name {
-	case callparticipant.EdgeCall:
-		m.ResetCall()
+	case calllog.EdgeInitiator:
+		m.ResetInitiator()
 		return nil
-	case callparticipant.EdgeParticipant:
-		m.ResetParticipant()
+	case calllog.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case calllog.EdgeParticipants:
+		m.ResetParticipants()
 		return nil
 	}
-	return fmt.Errorf("unknown CallParticipant edge %s", name)
+	return fmt.Errorf("unknown CallLog edge %s", name)
 }
 
-// ContactMutation represents an operation that mutates the Contact nodes in the graph.
-type ContactMutation struct {
+// CallParticipantMutation represents an operation that mutates the CallParticipant nodes in the graph.
+type CallParticipantMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	is_favourite   *bool
-	is_blocked     *bool
-	alias          *string
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	owner          *int
-	clearedowner   bool
-	contact        *int
-	clearedcontact bool
-	done           bool
-	oldValue       func(context.Context) (*Contact, error)
-	predicates     []predicate.Contact
+	op                 Op
+	typ                string
+	id                 *int
+	role               *callparticipant.Role
+	joined_at          *time.Time
+	left_at            *time.Time
+	clearedFields      map[string]struct{}
+	call               *int
+	clearedcall        bool
+	participant        *int
+	clearedparticipant bool
+	done               bool
+	oldValue           func(context.Context) (*CallParticipant, error)
+	predicates         []predicate.CallParticipant
 }
 
-var _ ent.Mutation = (*ContactMutation)(nil)
+var _ ent.Mutation = (*CallParticipantMutation)(nil)
 
-// contactOption allows management of the mutation configuration using functional options.
-type contactOption func(*ContactMutation)
+// callparticipantOption allows management of the mutation configuration using functional options.
+type callparticipantOption func(*CallParticipantMutation)
 
-// newContactMutation creates new mutation for the Contact entity.
-func newContactMutation(c config, op Op, opts ...contactOption) *ContactMutation {
-	m := &ContactMutation{
+// newCallParticipantMutation creates new mutation for the CallParticipant entity.
+func newCallParticipantMutation(c config, op Op, opts ...callparticipantOption) *CallParticipantMutation {
+	m := &CallParticipantMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeContact,
+		typ:           TypeCallParticipant,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1388,20 +1757,20 @@ func newContactMutation(c config, op Op, opts ...contactOption) *ContactMutation
 	return m
 }
 
-// withContactID sets the ID field of the mutation.
-func withContactID(id int) contactOption {
-	return func(m *ContactMutation) {
+// withCallParticipantID sets the ID field of the mutation.
+func withCallParticipantID(id int) callparticipantOption {
+	return func(m *CallParticipantMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Contact
+			value *CallParticipant
 		)
-		m.oldValue = func(ctx context.Context) (*Contact, error) {
+		m.oldValue = func(ctx context.Context) (*CallParticipant, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Contact.Get(ctx, id)
+					value, err = m.Client().CallParticipant.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1410,10 +1779,10 @@ func withContactID(id int) contactOption {
 	}
 }
 
-// withContact sets the old Contact of the mutation.
-func withContact(node *Contact) contactOption {
-	return func(m *ContactMutation) {
-		m.oldValue = func(context.Context) (*Contact, error) {
+// withCallParticipant sets the old CallParticipant of the mutation.
+func withCallParticipant(node *CallParticipant) callparticipantOption {
+	return func(m *CallParticipantMutation) {
+		m.oldValue = func(context.Context) (*CallParticipant, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1422,7 +1791,7 @@ func withContact(node *Contact) contactOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ContactMutation) Client() *Client {
+func (m CallParticipantMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1430,7 +1799,7 @@ func (m ContactMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ContactMutation) Tx() (*Tx, error) {
+func (m CallParticipantMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1441,7 +1810,7 @@ func (m ContactMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ContactMutation) ID() (id int, exists bool) {
+func (m *CallParticipantMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1452,7 +1821,7 @@ func (m *ContactMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ContactMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CallParticipantMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1461,279 +1830,220 @@ func (m *ContactMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Contact.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CallParticipant.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetIsFavourite sets the "is_favourite" field.
-func (m *ContactMutation) SetIsFavourite(b bool) {
-	m.is_favourite = &b
+// SetRole sets the "role" field.
+func (m *CallParticipantMutation) SetRole(c callparticipant.Role) {
+	m.role = &c
 }
 
-// IsFavourite returns the value of the "is_favourite" field in the mutation.
-func (m *ContactMutation) IsFavourite() (r bool, exists bool) {
-	v := m.is_favourite
+// Role returns the value of the "role" field in the mutation.
+func (m *CallParticipantMutation) Role() (r callparticipant.Role, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsFavourite returns the old "is_favourite" field's value of the Contact entity.
-// If the Contact object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContactMutation) OldIsFavourite(ctx context.Context) (v bool, err error) {
+func (m *CallParticipantMutation) OldRole(ctx context.Context) (v callparticipant.Role, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsFavourite is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsFavourite requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsFavourite: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.IsFavourite, nil
+	return oldValue.Role, nil
 }
 
-// ResetIsFavourite resets all changes to the "is_favourite" field.
-func (m *ContactMutation) ResetIsFavourite() {
-	m.is_favourite = nil
+// ResetRole resets all changes to the "role" field.
+func (m *CallParticipantMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetIsBlocked sets the "is_blocked" field.
-func (m *ContactMutation) SetIsBlocked(b bool) {
-	m.is_blocked = &b
+// SetJoinedAt sets the "joined_at" field.
+func (m *CallParticipantMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
 }
 
-// IsBlocked returns the value of the "is_blocked" field in the mutation.
-func (m *ContactMutation) IsBlocked() (r bool, exists bool) {
-	v := m.is_blocked
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *CallParticipantMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBlocked returns the old "is_blocked" field's value of the Contact entity.
-// If the Contact object wasn't provided to the builder, the object is fetched from the database.
+// OldJoinedAt returns the old "joined_at" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContactMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+func (m *CallParticipantMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
 	}
-	return oldValue.IsBlocked, nil
+	return oldValue.JoinedAt, nil
 }
 
-// ResetIsBlocked resets all changes to the "is_blocked" field.
-func (m *ContactMutation) ResetIsBlocked() {
-	m.is_blocked = nil
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *CallParticipantMutation) ResetJoinedAt() {
+	m.joined_at = nil
 }
 
-// SetAlias sets the "alias" field.
-func (m *ContactMutation) SetAlias(s string) {
-	m.alias = &s
+// SetLeftAt sets the "left_at" field.
+func (m *CallParticipantMutation) SetLeftAt(t time.Time) {
+	m.left_at = &t
 }
 
-// Alias returns the value of the "alias" field in the mutation.
-func (m *ContactMutation) Alias() (r string, exists bool) {
-	v := m.alias
+// LeftAt returns the value of the "left_at" field in the mutation.
+func (m *CallParticipantMutation) LeftAt() (r time.Time, exists bool) {
+	v := m.left_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAlias returns the old "alias" field's value of the Contact entity.
-// If the Contact object wasn't provided to the builder, the object is fetched from the database.
+// OldLeftAt returns the old "left_at" field's value of the CallParticipant entity.
+// If the CallParticipant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContactMutation) OldAlias(ctx context.Context) (v string, err error) {
+func (m *CallParticipantMutation) OldLeftAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+		return v, errors.New("OldLeftAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAlias requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
-	}
-	return oldValue.Alias, nil
-}
-
-// ResetAlias resets all changes to the "alias" field.
-func (m *ContactMutation) ResetAlias() {
-	m.alias = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *ContactMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ContactMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Contact entity.
-// If the Contact object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContactMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLeftAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLeftAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ContactMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ContactMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+	return oldValue.LeftAt, nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ContactMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearLeftAt clears the value of the "left_at" field.
+func (m *CallParticipantMutation) ClearLeftAt() {
+	m.left_at = nil
+	m.clearedFields[callparticipant.FieldLeftAt] = struct{}{}
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Contact entity.
-// If the Contact object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ContactMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
+// LeftAtCleared returns if the "left_at" field was cleared in this mutation.
+func (m *CallParticipantMutation) LeftAtCleared() bool {
+	_, ok := m.clearedFields[callparticipant.FieldLeftAt]
+	return ok
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ContactMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetLeftAt resets all changes to the "left_at" field.
+func (m *CallParticipantMutation) ResetLeftAt() {
+	m.left_at = nil
+	delete(m.clearedFields, callparticipant.FieldLeftAt)
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *ContactMutation) SetOwnerID(id int) {
-	m.owner = &id
+// SetCallID sets the "call" edge to the CallLog entity by id.
+func (m *CallParticipantMutation) SetCallID(id int) {
+	m.call = &id
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *ContactMutation) ClearOwner() {
-	m.clearedowner = true
+// ClearCall clears the "call" edge to the CallLog entity.
+func (m *CallParticipantMutation) ClearCall() {
+	m.clearedcall = true
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *ContactMutation) OwnerCleared() bool {
-	return m.clearedowner
+// CallCleared reports if the "call" edge to the CallLog entity was cleared.
+func (m *CallParticipantMutation) CallCleared() bool {
+	return m.clearedcall
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *ContactMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// CallID returns the "call" edge ID in the mutation.
+func (m *CallParticipantMutation) CallID() (id int, exists bool) {
+	if m.call != nil {
+		return *m.call, true
 	}
 	return
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
+// CallIDs returns the "call" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *ContactMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
+// CallID instead. It exists only for internal usage by the builders.
+func (m *CallParticipantMutation) CallIDs() (ids []int) {
+	if id := m.call; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *ContactMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ResetCall resets all changes to the "call" edge.
+func (m *CallParticipantMutation) ResetCall() {
+	m.call = nil
+	m.clearedcall = false
 }
 
-// SetContactID sets the "contact" edge to the User entity by id.
-func (m *ContactMutation) SetContactID(id int) {
-	m.contact = &id
+// SetParticipantID sets the "participant" edge to the User entity by id.
+func (m *CallParticipantMutation) SetParticipantID(id int) {
+	m.participant = &id
 }
 
-// ClearContact clears the "contact" edge to the User entity.
-func (m *ContactMutation) ClearContact() {
-	m.clearedcontact = true
+// ClearParticipant clears the "participant" edge to the User entity.
+func (m *CallParticipantMutation) ClearParticipant() {
+	m.clearedparticipant = true
 }
 
-// ContactCleared reports if the "contact" edge to the User entity was cleared.
-func (m *ContactMutation) ContactCleared() bool {
-	return m.clearedcontact
+// ParticipantCleared reports if the "participant" edge to the User entity was cleared.
+func (m *CallParticipantMutation) ParticipantCleared() bool {
+	return m.clearedparticipant
 }
 
-// ContactID returns the "contact" edge ID in the mutation.
-func (m *ContactMutation) ContactID() (id int, exists bool) {
-	if m.contact != nil {
-		return *m.contact, true
+// ParticipantID returns the "participant" edge ID in the mutation.
+func (m *CallParticipantMutation) ParticipantID() (id int, exists bool) {
+	if m.participant != nil {
+		return *m.participant, true
 	}
 	return
 }
 
-// ContactIDs returns the "contact" edge IDs in the mutation.
+// ParticipantIDs returns the "participant" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ContactID instead. It exists only for internal usage by the builders.
-func (m *ContactMutation) ContactIDs() (ids []int) {
-	if id := m.contact; id != nil {
+// ParticipantID instead. It exists only for internal usage by the builders.
+func (m *CallParticipantMutation) ParticipantIDs() (ids []int) {
+	if id := m.participant; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetContact resets all changes to the "contact" edge.
-func (m *ContactMutation) ResetContact() {
-	m.contact = nil
-	m.clearedcontact = false
+// ResetParticipant resets all changes to the "participant" edge.
+func (m *CallParticipantMutation) ResetParticipant() {
+	m.participant = nil
+	m.clearedparticipant = false
 }
 
-// Where appends a list predicates to the ContactMutation builder.
-func (m *ContactMutation) Where(ps ...predicate.Contact) {
+// Where appends a list predicates to the CallParticipantMutation builder.
+func (m *CallParticipantMutation) Where(ps ...predicate.CallParticipant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ContactMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CallParticipantMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ContactMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Contact, len(ps))
+func (m *CallParticipantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CallParticipant, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1741,39 +2051,33 @@ func (m *ContactMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ContactMutation) Op() Op {
+func (m *CallParticipantMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ContactMutation) SetOp(op Op) {
+func (m *CallParticipantMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Contact).
-func (m *ContactMutation) Type() string {
+// Type returns the node type of this mutation (CallParticipant).
+func (m *CallParticipantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ContactMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.is_favourite != nil {
-		fields = append(fields, contact.FieldIsFavourite)
-	}
-	if m.is_blocked != nil {
-		fields = append(fields, contact.FieldIsBlocked)
-	}
-	if m.alias != nil {
-		fields = append(fields, contact.FieldAlias)
+func (m *CallParticipantMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.role != nil {
+		fields = append(fields, callparticipant.FieldRole)
 	}
-	if m.created_at != nil {
-		fields = append(fields, contact.FieldCreatedAt)
+	if m.joined_at != nil {
+		fields = append(fields, callparticipant.FieldJoinedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, contact.FieldUpdatedAt)
+	if m.left_at != nil {
+		fields = append(fields, callparticipant.FieldLeftAt)
 	}
 	return fields
 }
@@ -1781,18 +2085,14 @@ func (m *ContactMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ContactMutation) Field(name string) (ent.Value, bool) {
+func (m *CallParticipantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case contact.FieldIsFavourite:
-		return m.IsFavourite()
-	case contact.FieldIsBlocked:
-		return m.IsBlocked()
-	case contact.FieldAlias:
-		return m.Alias()
-	case contact.FieldCreatedAt:
-		return m.CreatedAt()
-	case contact.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case callparticipant.FieldRole:
+		return m.Role()
+	case callparticipant.FieldJoinedAt:
+		return m.JoinedAt()
+	case callparticipant.FieldLeftAt:
+		return m.LeftAt()
 	}
 	return nil, false
 }
@@ -1800,152 +2100,137 @@ func (m *ContactMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ContactMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CallParticipantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case contact.FieldIsFavourite:
-		return m.OldIsFavourite(ctx)
-	case contact.FieldIsBlocked:
-		return m.OldIsBlocked(ctx)
-	case contact.FieldAlias:
-		return m.OldAlias(ctx)
-	case contact.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case contact.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case callparticipant.FieldRole:
+		return m.OldRole(ctx)
+	case callparticipant.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case callparticipant.FieldLeftAt:
+		return m.OldLeftAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Contact field %s", name)
+	return nil, fmt.Errorf("unknown CallParticipant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ContactMutation) SetField(name string, value ent.Value) error {
+func (m *CallParticipantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case contact.FieldIsFavourite:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsFavourite(v)
-		return nil
-	case contact.FieldIsBlocked:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsBlocked(v)
-		return nil
-	case contact.FieldAlias:
-		v, ok := value.(string)
+	case callparticipant.FieldRole:
+		v, ok := value.(callparticipant.Role)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAlias(v)
+		m.SetRole(v)
 		return nil
-	case contact.FieldCreatedAt:
+	case callparticipant.FieldJoinedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetJoinedAt(v)
 		return nil
-	case contact.FieldUpdatedAt:
+	case callparticipant.FieldLeftAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetLeftAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Contact field %s", name)
+	return fmt.Errorf("unknown CallParticipant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ContactMutation) AddedFields() []string {
+func (m *CallParticipantMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ContactMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CallParticipantMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ContactMutation) AddField(name string, value ent.Value) error {
+func (m *CallParticipantMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Contact numeric field %s", name)
+	return fmt.Errorf("unknown CallParticipant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ContactMutation) ClearedFields() []string {
-	return nil
+func (m *CallParticipantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(callparticipant.FieldLeftAt) {
+		fields = append(fields, callparticipant.FieldLeftAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ContactMutation) FieldCleared(name string) bool {
+func (m *CallParticipantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ContactMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Contact nullable field %s", name)
+func (m *CallParticipantMutation) ClearField(name string) error {
+	switch name {
+	case callparticipant.FieldLeftAt:
+		m.ClearLeftAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CallParticipant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ContactMutation) ResetField(name string) error {
+func (m *CallParticipantMutation) ResetField(name string) error {
 	switch name {
-	case contact.FieldIsFavourite:
-		m.ResetIsFavourite()
-		return nil
-	case contact.FieldIsBlocked:
-		m.ResetIsBlocked()
-		return nil
-	case contact.FieldAlias:
-		m.ResetAlias()
+	case callparticipant.FieldRole:
+		m.ResetRole()
 		return nil
-	case contact.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case callparticipant.FieldJoinedAt:
+		m.ResetJoinedAt()
 		return nil
-	case contact.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case callparticipant.FieldLeftAt:
+		m.ResetLeftAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Contact field %s", name)
+	return fmt.Errorf("unknown CallParticipant field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ContactMutation) AddedEdges() []string {
+func (m *CallParticipantMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.owner != nil {
-		edges = append(edges, contact.EdgeOwner)
+	if m.call != nil {
+		edges = append(edges, callparticipant.EdgeCall)
 	}
-	if m.contact != nil {
-		edges = append(edges, contact.EdgeContact)
+	if m.participant != nil {
+		edges = append(edges, callparticipant.EdgeParticipant)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ContactMutation) AddedIDs(name string) []ent.Value {
+func (m *CallParticipantMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case contact.EdgeOwner:
-		if id := m.owner; id != nil {
+	case callparticipant.EdgeCall:
+		if id := m.call; id != nil {
 			return []ent.Value{*id}
 		}
-	case contact.EdgeContact:
-		if id := m.contact; id != nil {
+	case callparticipant.EdgeParticipant:
+		if id := m.participant; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1953,97 +2238,101 @@ func (m *ContactMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ContactMutation) RemovedEdges() []string {
+func (m *CallParticipantMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ContactMutation) RemovedIDs(name string) []ent.Value {
+func (m *CallParticipantMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ContactMutation) ClearedEdges() []string {
+func (m *CallParticipantMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedowner {
-		edges = append(edges, contact.EdgeOwner)
+	if m.clearedcall {
+		edges = append(edges, callparticipant.EdgeCall)
 	}
-	if m.clearedcontact {
-		edges = append(edges, contact.EdgeContact)
+	if m.clearedparticipant {
+		edges = append(edges, callparticipant.EdgeParticipant)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ContactMutation) EdgeCleared(name string) bool {
+func (m *CallParticipantMutation) EdgeCleared(name string) bool {
 	switch name {
-	case contact.EdgeOwner:
-		return m.clearedowner
-	case contact.EdgeContact:
-		return m.clearedcontact
+	case callparticipant.EdgeCall:
+		return m.clearedcall
+	case callparticipant.EdgeParticipant:
+		return m.clearedparticipant
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ContactMutation) ClearEdge(name string) error {
+func (m *CallParticipantMutation) ClearEdge(name string) error {
 	switch name {
-	case contact.EdgeOwner:
-		m.ClearOwner()
+	case callparticipant.EdgeCall:
+		m.ClearCall()
 		return nil
-	case contact.EdgeContact:
-		m.ClearContact()
+	case callparticipant.EdgeParticipant:
+		m.ClearParticipant()
 		return nil
 	}
-	return fmt.Errorf("unknown Contact unique edge %s", name)
+	return fmt.Errorf("unknown CallParticipant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ContactMutation) ResetEdge(name string) error {
+func (m *CallParticipantMutation) ResetEdge(name string) error {
 	switch name {
-	case contact.EdgeOwner:
-		m.ResetOwner()
+	case callparticipant.EdgeCall:
+		m.ResetCall()
 		return nil
-	case contact.EdgeContact:
-		m.ResetContact()
+	case callparticipant.EdgeParticipant:
+		m.ResetParticipant()
 		return nil
 	}
-	return fmt.Errorf("unknown Contact edge %s", name)
+	return fmt.Errorf("unknown CallParticipant edge %s", name)
 }
 
-// FavouriteMutation represents an operation that mutates the Favourite nodes in the graph.
-type FavouriteMutation struct {
+// ContactMutation represents an operation that mutates the Contact nodes in the graph.
+type ContactMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	room          *int
-	clearedroom   bool
-	done          bool
-	oldValue      func(context.Context) (*Favourite, error)
-	predicates    []predicate.Favourite
+	op             Op
+	typ            string
+	id             *int
+	is_favourite   *bool
+	is_blocked     *bool
+	alias          *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	owner          *int
+	clearedowner   bool
+	contact        *int
+	clearedcontact bool
+	done           bool
+	oldValue       func(context.Context) (*Contact, error)
+	predicates     []predicate.Contact
 }
 
-var _ ent.Mutation = (*FavouriteMutation)(nil)
+var _ ent.Mutation = (*ContactMutation)(nil)
 
-// favouriteOption allows management of the mutation configuration using functional options.
-type favouriteOption func(*FavouriteMutation)
+// contactOption allows management of the mutation configuration using functional options.
+type contactOption func(*ContactMutation)
 
-// newFavouriteMutation creates new mutation for the Favourite entity.
-func newFavouriteMutation(c config, op Op, opts ...favouriteOption) *FavouriteMutation {
-	m := &FavouriteMutation{
+// newContactMutation creates new mutation for the Contact entity.
+func newContactMutation(c config, op Op, opts ...contactOption) *ContactMutation {
+	m := &ContactMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFavourite,
+		typ:           TypeContact,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2052,20 +2341,20 @@ func newFavouriteMutation(c config, op Op, opts ...favouriteOption) *FavouriteMu
 	return m
 }
 
-// withFavouriteID sets the ID field of the mutation.
-func withFavouriteID(id int) favouriteOption {
-	return func(m *FavouriteMutation) {
+// withContactID sets the ID field of the mutation.
+func withContactID(id int) contactOption {
+	return func(m *ContactMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Favourite
+			value *Contact
 		)
-		m.oldValue = func(ctx context.Context) (*Favourite, error) {
+		m.oldValue = func(ctx context.Context) (*Contact, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Favourite.Get(ctx, id)
+					value, err = m.Client().Contact.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2074,10 +2363,10 @@ func withFavouriteID(id int) favouriteOption {
 	}
 }
 
-// withFavourite sets the old Favourite of the mutation.
-func withFavourite(node *Favourite) favouriteOption {
-	return func(m *FavouriteMutation) {
-		m.oldValue = func(context.Context) (*Favourite, error) {
+// withContact sets the old Contact of the mutation.
+func withContact(node *Contact) contactOption {
+	return func(m *ContactMutation) {
+		m.oldValue = func(context.Context) (*Contact, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2086,7 +2375,7 @@ func withFavourite(node *Favourite) favouriteOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FavouriteMutation) Client() *Client {
+func (m ContactMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2094,7 +2383,7 @@ func (m FavouriteMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FavouriteMutation) Tx() (*Tx, error) {
+func (m ContactMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2105,7 +2394,7 @@ func (m FavouriteMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FavouriteMutation) ID() (id int, exists bool) {
+func (m *ContactMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2116,7 +2405,7 @@ func (m *FavouriteMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FavouriteMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ContactMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2125,135 +2414,279 @@ func (m *FavouriteMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Favourite.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Contact.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FavouriteMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetIsFavourite sets the "is_favourite" field.
+func (m *ContactMutation) SetIsFavourite(b bool) {
+	m.is_favourite = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FavouriteMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// IsFavourite returns the value of the "is_favourite" field in the mutation.
+func (m *ContactMutation) IsFavourite() (r bool, exists bool) {
+	v := m.is_favourite
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Favourite entity.
-// If the Favourite object wasn't provided to the builder, the object is fetched from the database.
+// OldIsFavourite returns the old "is_favourite" field's value of the Contact entity.
+// If the Contact object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FavouriteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ContactMutation) OldIsFavourite(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsFavourite is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldIsFavourite requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsFavourite: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.IsFavourite, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FavouriteMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetIsFavourite resets all changes to the "is_favourite" field.
+func (m *ContactMutation) ResetIsFavourite() {
+	m.is_favourite = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *FavouriteMutation) SetUserID(id int) {
-	m.user = &id
-}
+// SetIsBlocked sets the "is_blocked" field.
+func (m *ContactMutation) SetIsBlocked(b bool) {
+	m.is_blocked = &b
+}
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *FavouriteMutation) ClearUser() {
-	m.cleareduser = true
+// IsBlocked returns the value of the "is_blocked" field in the mutation.
+func (m *ContactMutation) IsBlocked() (r bool, exists bool) {
+	v := m.is_blocked
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *FavouriteMutation) UserCleared() bool {
-	return m.cleareduser
+// OldIsBlocked returns the old "is_blocked" field's value of the Contact entity.
+// If the Contact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContactMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+	}
+	return oldValue.IsBlocked, nil
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *FavouriteMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// ResetIsBlocked resets all changes to the "is_blocked" field.
+func (m *ContactMutation) ResetIsBlocked() {
+	m.is_blocked = nil
+}
+
+// SetAlias sets the "alias" field.
+func (m *ContactMutation) SetAlias(s string) {
+	m.alias = &s
+}
+
+// Alias returns the value of the "alias" field in the mutation.
+func (m *ContactMutation) Alias() (r string, exists bool) {
+	v := m.alias
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlias returns the old "alias" field's value of the Contact entity.
+// If the Contact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContactMutation) OldAlias(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlias requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	}
+	return oldValue.Alias, nil
+}
+
+// ResetAlias resets all changes to the "alias" field.
+func (m *ContactMutation) ResetAlias() {
+	m.alias = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ContactMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ContactMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Contact entity.
+// If the Contact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContactMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ContactMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ContactMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ContactMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Contact entity.
+// If the Contact object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContactMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ContactMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *ContactMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ContactMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ContactMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *ContactMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// OwnerIDs returns the "owner" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *FavouriteMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ContactMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *FavouriteMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ContactMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// SetRoomID sets the "room" edge to the Room entity by id.
-func (m *FavouriteMutation) SetRoomID(id int) {
-	m.room = &id
+// SetContactID sets the "contact" edge to the User entity by id.
+func (m *ContactMutation) SetContactID(id int) {
+	m.contact = &id
 }
 
-// ClearRoom clears the "room" edge to the Room entity.
-func (m *FavouriteMutation) ClearRoom() {
-	m.clearedroom = true
+// ClearContact clears the "contact" edge to the User entity.
+func (m *ContactMutation) ClearContact() {
+	m.clearedcontact = true
 }
 
-// RoomCleared reports if the "room" edge to the Room entity was cleared.
-func (m *FavouriteMutation) RoomCleared() bool {
-	return m.clearedroom
+// ContactCleared reports if the "contact" edge to the User entity was cleared.
+func (m *ContactMutation) ContactCleared() bool {
+	return m.clearedcontact
 }
 
-// RoomID returns the "room" edge ID in the mutation.
-func (m *FavouriteMutation) RoomID() (id int, exists bool) {
-	if m.room != nil {
-		return *m.room, true
+// ContactID returns the "contact" edge ID in the mutation.
+func (m *ContactMutation) ContactID() (id int, exists bool) {
+	if m.contact != nil {
+		return *m.contact, true
 	}
 	return
 }
 
-// RoomIDs returns the "room" edge IDs in the mutation.
+// ContactIDs returns the "contact" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoomID instead. It exists only for internal usage by the builders.
-func (m *FavouriteMutation) RoomIDs() (ids []int) {
-	if id := m.room; id != nil {
+// ContactID instead. It exists only for internal usage by the builders.
+func (m *ContactMutation) ContactIDs() (ids []int) {
+	if id := m.contact; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRoom resets all changes to the "room" edge.
-func (m *FavouriteMutation) ResetRoom() {
-	m.room = nil
-	m.clearedroom = false
+// ResetContact resets all changes to the "contact" edge.
+func (m *ContactMutation) ResetContact() {
+	m.contact = nil
+	m.clearedcontact = false
 }
 
-// Where appends a list predicates to the FavouriteMutation builder.
-func (m *FavouriteMutation) Where(ps ...predicate.Favourite) {
+// Where appends a list predicates to the ContactMutation builder.
+func (m *ContactMutation) Where(ps ...predicate.Contact) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FavouriteMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ContactMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FavouriteMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Favourite, len(ps))
+func (m *ContactMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Contact, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2261,38 +2694,58 @@ func (m *FavouriteMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FavouriteMutation) Op() Op {
+func (m *ContactMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FavouriteMutation) SetOp(op Op) {
+func (m *ContactMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Favourite).
-func (m *FavouriteMutation) Type() string {
+// Type returns the node type of this mutation (Contact).
+func (m *ContactMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FavouriteMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.created_at != nil {
-		fields = append(fields, favourite.FieldCreatedAt)
+func (m *ContactMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.is_favourite != nil {
+		fields = append(fields, contact.FieldIsFavourite)
 	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
+	if m.is_blocked != nil {
+		fields = append(fields, contact.FieldIsBlocked)
+	}
+	if m.alias != nil {
+		fields = append(fields, contact.FieldAlias)
+	}
+	if m.created_at != nil {
+		fields = append(fields, contact.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, contact.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FavouriteMutation) Field(name string) (ent.Value, bool) {
+func (m *ContactMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case favourite.FieldCreatedAt:
+	case contact.FieldIsFavourite:
+		return m.IsFavourite()
+	case contact.FieldIsBlocked:
+		return m.IsBlocked()
+	case contact.FieldAlias:
+		return m.Alias()
+	case contact.FieldCreatedAt:
 		return m.CreatedAt()
+	case contact.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2300,104 +2753,152 @@ func (m *FavouriteMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FavouriteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ContactMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case favourite.FieldCreatedAt:
+	case contact.FieldIsFavourite:
+		return m.OldIsFavourite(ctx)
+	case contact.FieldIsBlocked:
+		return m.OldIsBlocked(ctx)
+	case contact.FieldAlias:
+		return m.OldAlias(ctx)
+	case contact.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case contact.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Favourite field %s", name)
+	return nil, fmt.Errorf("unknown Contact field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FavouriteMutation) SetField(name string, value ent.Value) error {
+func (m *ContactMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case favourite.FieldCreatedAt:
+	case contact.FieldIsFavourite:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsFavourite(v)
+		return nil
+	case contact.FieldIsBlocked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBlocked(v)
+		return nil
+	case contact.FieldAlias:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlias(v)
+		return nil
+	case contact.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case contact.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Favourite field %s", name)
+	return fmt.Errorf("unknown Contact field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FavouriteMutation) AddedFields() []string {
+func (m *ContactMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FavouriteMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ContactMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FavouriteMutation) AddField(name string, value ent.Value) error {
+func (m *ContactMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Favourite numeric field %s", name)
+	return fmt.Errorf("unknown Contact numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FavouriteMutation) ClearedFields() []string {
+func (m *ContactMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FavouriteMutation) FieldCleared(name string) bool {
+func (m *ContactMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FavouriteMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Favourite nullable field %s", name)
+func (m *ContactMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Contact nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FavouriteMutation) ResetField(name string) error {
+func (m *ContactMutation) ResetField(name string) error {
 	switch name {
-	case favourite.FieldCreatedAt:
+	case contact.FieldIsFavourite:
+		m.ResetIsFavourite()
+		return nil
+	case contact.FieldIsBlocked:
+		m.ResetIsBlocked()
+		return nil
+	case contact.FieldAlias:
+		m.ResetAlias()
+		return nil
+	case contact.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case contact.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Favourite field %s", name)
+	return fmt.Errorf("unknown Contact field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FavouriteMutation) AddedEdges() []string {
+func (m *ContactMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, favourite.EdgeUser)
+	if m.owner != nil {
+		edges = append(edges, contact.EdgeOwner)
 	}
-	if m.room != nil {
-		edges = append(edges, favourite.EdgeRoom)
+	if m.contact != nil {
+		edges = append(edges, contact.EdgeContact)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FavouriteMutation) AddedIDs(name string) []ent.Value {
+func (m *ContactMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case favourite.EdgeUser:
-		if id := m.user; id != nil {
+	case contact.EdgeOwner:
+		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
-	case favourite.EdgeRoom:
-		if id := m.room; id != nil {
+	case contact.EdgeContact:
+		if id := m.contact; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2405,103 +2906,98 @@ func (m *FavouriteMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FavouriteMutation) RemovedEdges() []string {
+func (m *ContactMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FavouriteMutation) RemovedIDs(name string) []ent.Value {
+func (m *ContactMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FavouriteMutation) ClearedEdges() []string {
+func (m *ContactMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, favourite.EdgeUser)
+	if m.clearedowner {
+		edges = append(edges, contact.EdgeOwner)
 	}
-	if m.clearedroom {
-		edges = append(edges, favourite.EdgeRoom)
+	if m.clearedcontact {
+		edges = append(edges, contact.EdgeContact)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FavouriteMutation) EdgeCleared(name string) bool {
+func (m *ContactMutation) EdgeCleared(name string) bool {
 	switch name {
-	case favourite.EdgeUser:
-		return m.cleareduser
-	case favourite.EdgeRoom:
-		return m.clearedroom
+	case contact.EdgeOwner:
+		return m.clearedowner
+	case contact.EdgeContact:
+		return m.clearedcontact
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FavouriteMutation) ClearEdge(name string) error {
+func (m *ContactMutation) ClearEdge(name string) error {
 	switch name {
-	case favourite.EdgeUser:
-		m.ClearUser()
+	case contact.EdgeOwner:
+		m.ClearOwner()
 		return nil
-	case favourite.EdgeRoom:
-		m.ClearRoom()
+	case contact.EdgeContact:
+		m.ClearContact()
 		return nil
 	}
-	return fmt.Errorf("unknown Favourite unique edge %s", name)
+	return fmt.Errorf("unknown Contact unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FavouriteMutation) ResetEdge(name string) error {
+func (m *ContactMutation) ResetEdge(name string) error {
 	switch name {
-	case favourite.EdgeUser:
-		m.ResetUser()
+	case contact.EdgeOwner:
+		m.ResetOwner()
 		return nil
-	case favourite.EdgeRoom:
-		m.ResetRoom()
+	case contact.EdgeContact:
+		m.ResetContact()
 		return nil
 	}
-	return fmt.Errorf("unknown Favourite edge %s", name)
+	return fmt.Errorf("unknown Contact edge %s", name)
 }
 
-// MediaMutation represents an operation that mutates the Media nodes in the graph.
-type MediaMutation struct {
+// CredentialMutation represents an operation that mutates the Credential nodes in the graph.
+type CredentialMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	filename        *string
-	content_type    *string
-	storage_path    *string
-	checksum        *string
-	size_bytes      *int64
-	addsize_bytes   *int64
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	uploader        *int
-	cleareduploader bool
-	message         *int
-	clearedmessage  bool
-	done            bool
-	oldValue        func(context.Context) (*Media, error)
-	predicates      []predicate.Media
+	op                  Op
+	typ                 string
+	id                  *int
+	password_hash       *string
+	password_updated_at *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	user                *int
+	cleareduser         bool
+	done                bool
+	oldValue            func(context.Context) (*Credential, error)
+	predicates          []predicate.Credential
 }
 
-var _ ent.Mutation = (*MediaMutation)(nil)
+var _ ent.Mutation = (*CredentialMutation)(nil)
 
-// mediaOption allows management of the mutation configuration using functional options.
-type mediaOption func(*MediaMutation)
+// credentialOption allows management of the mutation configuration using functional options.
+type credentialOption func(*CredentialMutation)
 
-// newMediaMutation creates new mutation for the Media entity.
-func newMediaMutation(c config, op Op, opts ...mediaOption) *MediaMutation {
-	m := &MediaMutation{
+// newCredentialMutation creates new mutation for the Credential entity.
+func newCredentialMutation(c config, op Op, opts ...credentialOption) *CredentialMutation {
+	m := &CredentialMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMedia,
+		typ:           TypeCredential,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2510,20 +3006,20 @@ func newMediaMutation(c config, op Op, opts ...mediaOption) *MediaMutation {
 	return m
 }
 
-// withMediaID sets the ID field of the mutation.
-func withMediaID(id int) mediaOption {
-	return func(m *MediaMutation) {
+// withCredentialID sets the ID field of the mutation.
+func withCredentialID(id int) credentialOption {
+	return func(m *CredentialMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Media
+			value *Credential
 		)
-		m.oldValue = func(ctx context.Context) (*Media, error) {
+		m.oldValue = func(ctx context.Context) (*Credential, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Media.Get(ctx, id)
+					value, err = m.Client().Credential.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2532,10 +3028,10 @@ func withMediaID(id int) mediaOption {
 	}
 }
 
-// withMedia sets the old Media of the mutation.
-func withMedia(node *Media) mediaOption {
-	return func(m *MediaMutation) {
-		m.oldValue = func(context.Context) (*Media, error) {
+// withCredential sets the old Credential of the mutation.
+func withCredential(node *Credential) credentialOption {
+	return func(m *CredentialMutation) {
+		m.oldValue = func(context.Context) (*Credential, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2544,7 +3040,7 @@ func withMedia(node *Media) mediaOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MediaMutation) Client() *Client {
+func (m CredentialMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2552,7 +3048,7 @@ func (m MediaMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MediaMutation) Tx() (*Tx, error) {
+func (m CredentialMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2563,7 +3059,7 @@ func (m MediaMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MediaMutation) ID() (id int, exists bool) {
+func (m *CredentialMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2574,7 +3070,7 @@ func (m *MediaMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MediaMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CredentialMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2583,219 +3079,91 @@ func (m *MediaMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Media.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Credential.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFilename sets the "filename" field.
-func (m *MediaMutation) SetFilename(s string) {
-	m.filename = &s
+// SetPasswordHash sets the "password_hash" field.
+func (m *CredentialMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// Filename returns the value of the "filename" field in the mutation.
-func (m *MediaMutation) Filename() (r string, exists bool) {
-	v := m.filename
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *CredentialMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilename returns the old "filename" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldFilename(ctx context.Context) (v string, err error) {
+func (m *CredentialMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilename requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return oldValue.Filename, nil
+	return oldValue.PasswordHash, nil
 }
 
-// ResetFilename resets all changes to the "filename" field.
-func (m *MediaMutation) ResetFilename() {
-	m.filename = nil
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *CredentialMutation) ResetPasswordHash() {
+	m.password_hash = nil
 }
 
-// SetContentType sets the "content_type" field.
-func (m *MediaMutation) SetContentType(s string) {
-	m.content_type = &s
+// SetPasswordUpdatedAt sets the "password_updated_at" field.
+func (m *CredentialMutation) SetPasswordUpdatedAt(t time.Time) {
+	m.password_updated_at = &t
 }
 
-// ContentType returns the value of the "content_type" field in the mutation.
-func (m *MediaMutation) ContentType() (r string, exists bool) {
-	v := m.content_type
+// PasswordUpdatedAt returns the value of the "password_updated_at" field in the mutation.
+func (m *CredentialMutation) PasswordUpdatedAt() (r time.Time, exists bool) {
+	v := m.password_updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentType returns the old "content_type" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordUpdatedAt returns the old "password_updated_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldContentType(ctx context.Context) (v string, err error) {
+func (m *CredentialMutation) OldPasswordUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentType requires an ID field in the mutation")
+		return v, errors.New("OldPasswordUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordUpdatedAt: %w", err)
 	}
-	return oldValue.ContentType, nil
+	return oldValue.PasswordUpdatedAt, nil
 }
 
-// ResetContentType resets all changes to the "content_type" field.
-func (m *MediaMutation) ResetContentType() {
-	m.content_type = nil
-}
-
-// SetStoragePath sets the "storage_path" field.
-func (m *MediaMutation) SetStoragePath(s string) {
-	m.storage_path = &s
-}
-
-// StoragePath returns the value of the "storage_path" field in the mutation.
-func (m *MediaMutation) StoragePath() (r string, exists bool) {
-	v := m.storage_path
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStoragePath returns the old "storage_path" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldStoragePath(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStoragePath is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStoragePath requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStoragePath: %w", err)
-	}
-	return oldValue.StoragePath, nil
-}
-
-// ResetStoragePath resets all changes to the "storage_path" field.
-func (m *MediaMutation) ResetStoragePath() {
-	m.storage_path = nil
-}
-
-// SetChecksum sets the "checksum" field.
-func (m *MediaMutation) SetChecksum(s string) {
-	m.checksum = &s
-}
-
-// Checksum returns the value of the "checksum" field in the mutation.
-func (m *MediaMutation) Checksum() (r string, exists bool) {
-	v := m.checksum
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldChecksum returns the old "checksum" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldChecksum(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChecksum requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
-	}
-	return oldValue.Checksum, nil
-}
-
-// ResetChecksum resets all changes to the "checksum" field.
-func (m *MediaMutation) ResetChecksum() {
-	m.checksum = nil
-}
-
-// SetSizeBytes sets the "size_bytes" field.
-func (m *MediaMutation) SetSizeBytes(i int64) {
-	m.size_bytes = &i
-	m.addsize_bytes = nil
-}
-
-// SizeBytes returns the value of the "size_bytes" field in the mutation.
-func (m *MediaMutation) SizeBytes() (r int64, exists bool) {
-	v := m.size_bytes
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSizeBytes returns the old "size_bytes" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldSizeBytes(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSizeBytes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSizeBytes requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSizeBytes: %w", err)
-	}
-	return oldValue.SizeBytes, nil
-}
-
-// AddSizeBytes adds i to the "size_bytes" field.
-func (m *MediaMutation) AddSizeBytes(i int64) {
-	if m.addsize_bytes != nil {
-		*m.addsize_bytes += i
-	} else {
-		m.addsize_bytes = &i
-	}
-}
-
-// AddedSizeBytes returns the value that was added to the "size_bytes" field in this mutation.
-func (m *MediaMutation) AddedSizeBytes() (r int64, exists bool) {
-	v := m.addsize_bytes
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetSizeBytes resets all changes to the "size_bytes" field.
-func (m *MediaMutation) ResetSizeBytes() {
-	m.size_bytes = nil
-	m.addsize_bytes = nil
+// ResetPasswordUpdatedAt resets all changes to the "password_updated_at" field.
+func (m *CredentialMutation) ResetPasswordUpdatedAt() {
+	m.password_updated_at = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MediaMutation) SetCreatedAt(t time.Time) {
+func (m *CredentialMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MediaMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CredentialMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2803,10 +3171,10 @@ func (m *MediaMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2821,97 +3189,94 @@ func (m *MediaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MediaMutation) ResetCreatedAt() {
+func (m *CredentialMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUploaderID sets the "uploader" edge to the User entity by id.
-func (m *MediaMutation) SetUploaderID(id int) {
-	m.uploader = &id
-}
-
-// ClearUploader clears the "uploader" edge to the User entity.
-func (m *MediaMutation) ClearUploader() {
-	m.cleareduploader = true
-}
-
-// UploaderCleared reports if the "uploader" edge to the User entity was cleared.
-func (m *MediaMutation) UploaderCleared() bool {
-	return m.cleareduploader
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CredentialMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// UploaderID returns the "uploader" edge ID in the mutation.
-func (m *MediaMutation) UploaderID() (id int, exists bool) {
-	if m.uploader != nil {
-		return *m.uploader, true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CredentialMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UploaderIDs returns the "uploader" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UploaderID instead. It exists only for internal usage by the builders.
-func (m *MediaMutation) UploaderIDs() (ids []int) {
-	if id := m.uploader; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetUploader resets all changes to the "uploader" edge.
-func (m *MediaMutation) ResetUploader() {
-	m.uploader = nil
-	m.cleareduploader = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CredentialMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetMessageID sets the "message" edge to the Message entity by id.
-func (m *MediaMutation) SetMessageID(id int) {
-	m.message = &id
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *CredentialMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ClearMessage clears the "message" edge to the Message entity.
-func (m *MediaMutation) ClearMessage() {
-	m.clearedmessage = true
+// ClearUser clears the "user" edge to the User entity.
+func (m *CredentialMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *MediaMutation) MessageCleared() bool {
-	return m.clearedmessage
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *CredentialMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// MessageID returns the "message" edge ID in the mutation.
-func (m *MediaMutation) MessageID() (id int, exists bool) {
-	if m.message != nil {
-		return *m.message, true
+// UserID returns the "user" edge ID in the mutation.
+func (m *CredentialMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// MessageIDs returns the "message" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageID instead. It exists only for internal usage by the builders.
-func (m *MediaMutation) MessageIDs() (ids []int) {
-	if id := m.message; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *CredentialMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMessage resets all changes to the "message" edge.
-func (m *MediaMutation) ResetMessage() {
-	m.message = nil
-	m.clearedmessage = false
+// ResetUser resets all changes to the "user" edge.
+func (m *CredentialMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the MediaMutation builder.
-func (m *MediaMutation) Where(ps ...predicate.Media) {
+// Where appends a list predicates to the CredentialMutation builder.
+func (m *CredentialMutation) Where(ps ...predicate.Credential) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MediaMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CredentialMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MediaMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Media, len(ps))
+func (m *CredentialMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Credential, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2919,42 +3284,36 @@ func (m *MediaMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MediaMutation) Op() Op {
+func (m *CredentialMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MediaMutation) SetOp(op Op) {
+func (m *CredentialMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Media).
-func (m *MediaMutation) Type() string {
+// Type returns the node type of this mutation (Credential).
+func (m *CredentialMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MediaMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.filename != nil {
-		fields = append(fields, media.FieldFilename)
-	}
-	if m.content_type != nil {
-		fields = append(fields, media.FieldContentType)
-	}
-	if m.storage_path != nil {
-		fields = append(fields, media.FieldStoragePath)
-	}
-	if m.checksum != nil {
-		fields = append(fields, media.FieldChecksum)
+func (m *CredentialMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.password_hash != nil {
+		fields = append(fields, credential.FieldPasswordHash)
 	}
-	if m.size_bytes != nil {
-		fields = append(fields, media.FieldSizeBytes)
+	if m.password_updated_at != nil {
+		fields = append(fields, credential.FieldPasswordUpdatedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, media.FieldCreatedAt)
+		fields = append(fields, credential.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, credential.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2962,20 +3321,16 @@ func (m *MediaMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MediaMutation) Field(name string) (ent.Value, bool) {
+func (m *CredentialMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case media.FieldFilename:
-		return m.Filename()
-	case media.FieldContentType:
-		return m.ContentType()
-	case media.FieldStoragePath:
-		return m.StoragePath()
-	case media.FieldChecksum:
-		return m.Checksum()
-	case media.FieldSizeBytes:
-		return m.SizeBytes()
-	case media.FieldCreatedAt:
+	case credential.FieldPasswordHash:
+		return m.PasswordHash()
+	case credential.FieldPasswordUpdatedAt:
+		return m.PasswordUpdatedAt()
+	case credential.FieldCreatedAt:
 		return m.CreatedAt()
+	case credential.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2983,179 +3338,133 @@ func (m *MediaMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MediaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case media.FieldFilename:
-		return m.OldFilename(ctx)
-	case media.FieldContentType:
-		return m.OldContentType(ctx)
-	case media.FieldStoragePath:
-		return m.OldStoragePath(ctx)
-	case media.FieldChecksum:
-		return m.OldChecksum(ctx)
-	case media.FieldSizeBytes:
-		return m.OldSizeBytes(ctx)
-	case media.FieldCreatedAt:
+	case credential.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case credential.FieldPasswordUpdatedAt:
+		return m.OldPasswordUpdatedAt(ctx)
+	case credential.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case credential.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Media field %s", name)
+	return nil, fmt.Errorf("unknown Credential field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MediaMutation) SetField(name string, value ent.Value) error {
+func (m *CredentialMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case media.FieldFilename:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFilename(v)
-		return nil
-	case media.FieldContentType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContentType(v)
-		return nil
-	case media.FieldStoragePath:
+	case credential.FieldPasswordHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStoragePath(v)
+		m.SetPasswordHash(v)
 		return nil
-	case media.FieldChecksum:
-		v, ok := value.(string)
+	case credential.FieldPasswordUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChecksum(v)
+		m.SetPasswordUpdatedAt(v)
 		return nil
-	case media.FieldSizeBytes:
-		v, ok := value.(int64)
+	case credential.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSizeBytes(v)
+		m.SetCreatedAt(v)
 		return nil
-	case media.FieldCreatedAt:
+	case credential.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Media field %s", name)
+	return fmt.Errorf("unknown Credential field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MediaMutation) AddedFields() []string {
-	var fields []string
-	if m.addsize_bytes != nil {
-		fields = append(fields, media.FieldSizeBytes)
-	}
-	return fields
+func (m *CredentialMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MediaMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case media.FieldSizeBytes:
-		return m.AddedSizeBytes()
-	}
+func (m *CredentialMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MediaMutation) AddField(name string, value ent.Value) error {
+func (m *CredentialMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case media.FieldSizeBytes:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSizeBytes(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Media numeric field %s", name)
+	return fmt.Errorf("unknown Credential numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MediaMutation) ClearedFields() []string {
+func (m *CredentialMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MediaMutation) FieldCleared(name string) bool {
+func (m *CredentialMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MediaMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Media nullable field %s", name)
+func (m *CredentialMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Credential nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MediaMutation) ResetField(name string) error {
+func (m *CredentialMutation) ResetField(name string) error {
 	switch name {
-	case media.FieldFilename:
-		m.ResetFilename()
-		return nil
-	case media.FieldContentType:
-		m.ResetContentType()
-		return nil
-	case media.FieldStoragePath:
-		m.ResetStoragePath()
+	case credential.FieldPasswordHash:
+		m.ResetPasswordHash()
 		return nil
-	case media.FieldChecksum:
-		m.ResetChecksum()
-		return nil
-	case media.FieldSizeBytes:
-		m.ResetSizeBytes()
+	case credential.FieldPasswordUpdatedAt:
+		m.ResetPasswordUpdatedAt()
 		return nil
-	case media.FieldCreatedAt:
+	case credential.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case credential.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Media field %s", name)
+	return fmt.Errorf("unknown Credential field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MediaMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.uploader != nil {
-		edges = append(edges, media.EdgeUploader)
-	}
-	if m.message != nil {
-		edges = append(edges, media.EdgeMessage)
+func (m *CredentialMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, credential.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MediaMutation) AddedIDs(name string) []ent.Value {
+func (m *CredentialMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case media.EdgeUploader:
-		if id := m.uploader; id != nil {
-			return []ent.Value{*id}
-		}
-	case media.EdgeMessage:
-		if id := m.message; id != nil {
+	case credential.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3163,105 +3472,114 @@ func (m *MediaMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MediaMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *CredentialMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MediaMutation) RemovedIDs(name string) []ent.Value {
+func (m *CredentialMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MediaMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduploader {
-		edges = append(edges, media.EdgeUploader)
-	}
-	if m.clearedmessage {
-		edges = append(edges, media.EdgeMessage)
+func (m *CredentialMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, credential.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MediaMutation) EdgeCleared(name string) bool {
+func (m *CredentialMutation) EdgeCleared(name string) bool {
 	switch name {
-	case media.EdgeUploader:
-		return m.cleareduploader
-	case media.EdgeMessage:
-		return m.clearedmessage
+	case credential.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MediaMutation) ClearEdge(name string) error {
+func (m *CredentialMutation) ClearEdge(name string) error {
 	switch name {
-	case media.EdgeUploader:
-		m.ClearUploader()
-		return nil
-	case media.EdgeMessage:
-		m.ClearMessage()
+	case credential.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Media unique edge %s", name)
+	return fmt.Errorf("unknown Credential unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MediaMutation) ResetEdge(name string) error {
+func (m *CredentialMutation) ResetEdge(name string) error {
 	switch name {
-	case media.EdgeUploader:
-		m.ResetUploader()
-		return nil
-	case media.EdgeMessage:
-		m.ResetMessage()
+	case credential.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Media edge %s", name)
+	return fmt.Errorf("unknown Credential edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// DeviceMutation represents an operation that mutates the Device nodes in the graph.
+type DeviceMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	cipher_text       *string
-	content_type      *string
-	encryption_scheme *string
-	edited            *bool
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	sender            *int
-	clearedsender     bool
-	room              *int
-	clearedroom       bool
-	media             map[int]struct{}
-	removedmedia      map[int]struct{}
-	clearedmedia      bool
-	done              bool
-	oldValue          func(context.Context) (*Message, error)
-	predicates        []predicate.Message
-}
-
-var _ ent.Mutation = (*MessageMutation)(nil)
-
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
-
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+	op                              Op
+	typ                             string
+	id                              *int
+	device_id                       *int
+	adddevice_id                    *int
+	registration_id                 *int
+	addregistration_id              *int
+	name                            *string
+	low_prekeys_notified_at         *time.Time
+	created_at                      *time.Time
+	updated_at                      *time.Time
+	clearedFields                   map[string]struct{}
+	user                            *int
+	cleareduser                     bool
+	identity_key                    *int
+	clearedidentity_key             bool
+	identity_key_history            map[int]struct{}
+	removedidentity_key_history     map[int]struct{}
+	clearedidentity_key_history     bool
+	signed_pre_keys                 map[int]struct{}
+	removedsigned_pre_keys          map[int]struct{}
+	clearedsigned_pre_keys          bool
+	one_time_pre_keys               map[int]struct{}
+	removedone_time_pre_keys        map[int]struct{}
+	clearedone_time_pre_keys        bool
+	envelopes                       map[int]struct{}
+	removedenvelopes                map[int]struct{}
+	clearedenvelopes                bool
+	key_packages                    map[int]struct{}
+	removedkey_packages             map[int]struct{}
+	clearedkey_packages             bool
+	mls_welcomes                    map[int]struct{}
+	removedmls_welcomes             map[int]struct{}
+	clearedmls_welcomes             bool
+	sender_key_distributions        map[int]struct{}
+	removedsender_key_distributions map[int]struct{}
+	clearedsender_key_distributions bool
+	done                            bool
+	oldValue                        func(context.Context) (*Device, error)
+	predicates                      []predicate.Device
+}
+
+var _ ent.Mutation = (*DeviceMutation)(nil)
+
+// deviceOption allows management of the mutation configuration using functional options.
+type deviceOption func(*DeviceMutation)
+
+// newDeviceMutation creates new mutation for the Device entity.
+func newDeviceMutation(c config, op Op, opts ...deviceOption) *DeviceMutation {
+	m := &DeviceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeDevice,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3270,20 +3588,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id int) messageOption {
-	return func(m *MessageMutation) {
+// withDeviceID sets the ID field of the mutation.
+func withDeviceID(id int) deviceOption {
+	return func(m *DeviceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *Device
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*Device, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().Device.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3292,10 +3610,10 @@ func withMessageID(id int) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withDevice sets the old Device of the mutation.
+func withDevice(node *Device) deviceOption {
+	return func(m *DeviceMutation) {
+		m.oldValue = func(context.Context) (*Device, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3304,7 +3622,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m DeviceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3312,7 +3630,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m DeviceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3323,7 +3641,7 @@ func (m MessageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id int, exists bool) {
+func (m *DeviceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3334,7 +3652,7 @@ func (m *MessageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DeviceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3343,163 +3661,216 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Device.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCipherText sets the "cipher_text" field.
-func (m *MessageMutation) SetCipherText(s string) {
-	m.cipher_text = &s
+// SetDeviceID sets the "device_id" field.
+func (m *DeviceMutation) SetDeviceID(i int) {
+	m.device_id = &i
+	m.adddevice_id = nil
 }
 
-// CipherText returns the value of the "cipher_text" field in the mutation.
-func (m *MessageMutation) CipherText() (r string, exists bool) {
-	v := m.cipher_text
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *DeviceMutation) DeviceID() (r int, exists bool) {
+	v := m.device_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCipherText returns the old "cipher_text" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldDeviceID returns the old "device_id" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCipherText(ctx context.Context) (v string, err error) {
+func (m *DeviceMutation) OldDeviceID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCipherText is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCipherText requires an ID field in the mutation")
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCipherText: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
 	}
-	return oldValue.CipherText, nil
+	return oldValue.DeviceID, nil
 }
 
-// ResetCipherText resets all changes to the "cipher_text" field.
-func (m *MessageMutation) ResetCipherText() {
-	m.cipher_text = nil
+// AddDeviceID adds i to the "device_id" field.
+func (m *DeviceMutation) AddDeviceID(i int) {
+	if m.adddevice_id != nil {
+		*m.adddevice_id += i
+	} else {
+		m.adddevice_id = &i
+	}
 }
 
-// SetContentType sets the "content_type" field.
-func (m *MessageMutation) SetContentType(s string) {
-	m.content_type = &s
+// AddedDeviceID returns the value that was added to the "device_id" field in this mutation.
+func (m *DeviceMutation) AddedDeviceID() (r int, exists bool) {
+	v := m.adddevice_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ContentType returns the value of the "content_type" field in the mutation.
-func (m *MessageMutation) ContentType() (r string, exists bool) {
-	v := m.content_type
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *DeviceMutation) ResetDeviceID() {
+	m.device_id = nil
+	m.adddevice_id = nil
+}
+
+// SetRegistrationID sets the "registration_id" field.
+func (m *DeviceMutation) SetRegistrationID(i int) {
+	m.registration_id = &i
+	m.addregistration_id = nil
+}
+
+// RegistrationID returns the value of the "registration_id" field in the mutation.
+func (m *DeviceMutation) RegistrationID() (r int, exists bool) {
+	v := m.registration_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContentType returns the old "content_type" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldRegistrationID returns the old "registration_id" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContentType(ctx context.Context) (v string, err error) {
+func (m *DeviceMutation) OldRegistrationID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+		return v, errors.New("OldRegistrationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContentType requires an ID field in the mutation")
+		return v, errors.New("OldRegistrationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+		return v, fmt.Errorf("querying old value for OldRegistrationID: %w", err)
 	}
-	return oldValue.ContentType, nil
+	return oldValue.RegistrationID, nil
 }
 
-// ResetContentType resets all changes to the "content_type" field.
-func (m *MessageMutation) ResetContentType() {
-	m.content_type = nil
+// AddRegistrationID adds i to the "registration_id" field.
+func (m *DeviceMutation) AddRegistrationID(i int) {
+	if m.addregistration_id != nil {
+		*m.addregistration_id += i
+	} else {
+		m.addregistration_id = &i
+	}
 }
 
-// SetEncryptionScheme sets the "encryption_scheme" field.
-func (m *MessageMutation) SetEncryptionScheme(s string) {
-	m.encryption_scheme = &s
+// AddedRegistrationID returns the value that was added to the "registration_id" field in this mutation.
+func (m *DeviceMutation) AddedRegistrationID() (r int, exists bool) {
+	v := m.addregistration_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EncryptionScheme returns the value of the "encryption_scheme" field in the mutation.
-func (m *MessageMutation) EncryptionScheme() (r string, exists bool) {
-	v := m.encryption_scheme
+// ResetRegistrationID resets all changes to the "registration_id" field.
+func (m *DeviceMutation) ResetRegistrationID() {
+	m.registration_id = nil
+	m.addregistration_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *DeviceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DeviceMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptionScheme returns the old "encryption_scheme" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldEncryptionScheme(ctx context.Context) (v string, err error) {
+func (m *DeviceMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptionScheme is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptionScheme requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptionScheme: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.EncryptionScheme, nil
+	return oldValue.Name, nil
 }
 
-// ResetEncryptionScheme resets all changes to the "encryption_scheme" field.
-func (m *MessageMutation) ResetEncryptionScheme() {
-	m.encryption_scheme = nil
+// ResetName resets all changes to the "name" field.
+func (m *DeviceMutation) ResetName() {
+	m.name = nil
 }
 
-// SetEdited sets the "edited" field.
-func (m *MessageMutation) SetEdited(b bool) {
-	m.edited = &b
+// SetLowPrekeysNotifiedAt sets the "low_prekeys_notified_at" field.
+func (m *DeviceMutation) SetLowPrekeysNotifiedAt(t time.Time) {
+	m.low_prekeys_notified_at = &t
 }
 
-// Edited returns the value of the "edited" field in the mutation.
-func (m *MessageMutation) Edited() (r bool, exists bool) {
-	v := m.edited
+// LowPrekeysNotifiedAt returns the value of the "low_prekeys_notified_at" field in the mutation.
+func (m *DeviceMutation) LowPrekeysNotifiedAt() (r time.Time, exists bool) {
+	v := m.low_prekeys_notified_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEdited returns the old "edited" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldLowPrekeysNotifiedAt returns the old "low_prekeys_notified_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldEdited(ctx context.Context) (v bool, err error) {
+func (m *DeviceMutation) OldLowPrekeysNotifiedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEdited is only allowed on UpdateOne operations")
+		return v, errors.New("OldLowPrekeysNotifiedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEdited requires an ID field in the mutation")
+		return v, errors.New("OldLowPrekeysNotifiedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEdited: %w", err)
+		return v, fmt.Errorf("querying old value for OldLowPrekeysNotifiedAt: %w", err)
 	}
-	return oldValue.Edited, nil
+	return oldValue.LowPrekeysNotifiedAt, nil
 }
 
-// ResetEdited resets all changes to the "edited" field.
-func (m *MessageMutation) ResetEdited() {
-	m.edited = nil
+// ClearLowPrekeysNotifiedAt clears the value of the "low_prekeys_notified_at" field.
+func (m *DeviceMutation) ClearLowPrekeysNotifiedAt() {
+	m.low_prekeys_notified_at = nil
+	m.clearedFields[device.FieldLowPrekeysNotifiedAt] = struct{}{}
+}
+
+// LowPrekeysNotifiedAtCleared returns if the "low_prekeys_notified_at" field was cleared in this mutation.
+func (m *DeviceMutation) LowPrekeysNotifiedAtCleared() bool {
+	_, ok := m.clearedFields[device.FieldLowPrekeysNotifiedAt]
+	return ok
+}
+
+// ResetLowPrekeysNotifiedAt resets all changes to the "low_prekeys_notified_at" field.
+func (m *DeviceMutation) ResetLowPrekeysNotifiedAt() {
+	m.low_prekeys_notified_at = nil
+	delete(m.clearedFields, device.FieldLowPrekeysNotifiedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MessageMutation) SetCreatedAt(t time.Time) {
+func (m *DeviceMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DeviceMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3507,10 +3878,10 @@ func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3525,17 +3896,17 @@ func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageMutation) ResetCreatedAt() {
+func (m *DeviceMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MessageMutation) SetUpdatedAt(t time.Time) {
+func (m *DeviceMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *DeviceMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3543,10 +3914,10 @@ func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3561,281 +3932,605 @@ func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MessageMutation) ResetUpdatedAt() {
+func (m *DeviceMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetSenderID sets the "sender" edge to the User entity by id.
-func (m *MessageMutation) SetSenderID(id int) {
-	m.sender = &id
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *DeviceMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ClearSender clears the "sender" edge to the User entity.
-func (m *MessageMutation) ClearSender() {
-	m.clearedsender = true
+// ClearUser clears the "user" edge to the User entity.
+func (m *DeviceMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// SenderCleared reports if the "sender" edge to the User entity was cleared.
-func (m *MessageMutation) SenderCleared() bool {
-	return m.clearedsender
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *DeviceMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// SenderID returns the "sender" edge ID in the mutation.
-func (m *MessageMutation) SenderID() (id int, exists bool) {
-	if m.sender != nil {
-		return *m.sender, true
+// UserID returns the "user" edge ID in the mutation.
+func (m *DeviceMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// SenderIDs returns the "sender" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SenderID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) SenderIDs() (ids []int) {
-	if id := m.sender; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *DeviceMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSender resets all changes to the "sender" edge.
-func (m *MessageMutation) ResetSender() {
-	m.sender = nil
-	m.clearedsender = false
+// ResetUser resets all changes to the "user" edge.
+func (m *DeviceMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SetRoomID sets the "room" edge to the Room entity by id.
-func (m *MessageMutation) SetRoomID(id int) {
-	m.room = &id
+// SetIdentityKeyID sets the "identity_key" edge to the IdentityKey entity by id.
+func (m *DeviceMutation) SetIdentityKeyID(id int) {
+	m.identity_key = &id
 }
 
-// ClearRoom clears the "room" edge to the Room entity.
-func (m *MessageMutation) ClearRoom() {
-	m.clearedroom = true
+// ClearIdentityKey clears the "identity_key" edge to the IdentityKey entity.
+func (m *DeviceMutation) ClearIdentityKey() {
+	m.clearedidentity_key = true
 }
 
-// RoomCleared reports if the "room" edge to the Room entity was cleared.
-func (m *MessageMutation) RoomCleared() bool {
-	return m.clearedroom
+// IdentityKeyCleared reports if the "identity_key" edge to the IdentityKey entity was cleared.
+func (m *DeviceMutation) IdentityKeyCleared() bool {
+	return m.clearedidentity_key
 }
 
-// RoomID returns the "room" edge ID in the mutation.
-func (m *MessageMutation) RoomID() (id int, exists bool) {
-	if m.room != nil {
-		return *m.room, true
+// IdentityKeyID returns the "identity_key" edge ID in the mutation.
+func (m *DeviceMutation) IdentityKeyID() (id int, exists bool) {
+	if m.identity_key != nil {
+		return *m.identity_key, true
 	}
 	return
 }
 
-// RoomIDs returns the "room" edge IDs in the mutation.
+// IdentityKeyIDs returns the "identity_key" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoomID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) RoomIDs() (ids []int) {
-	if id := m.room; id != nil {
+// IdentityKeyID instead. It exists only for internal usage by the builders.
+func (m *DeviceMutation) IdentityKeyIDs() (ids []int) {
+	if id := m.identity_key; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRoom resets all changes to the "room" edge.
-func (m *MessageMutation) ResetRoom() {
-	m.room = nil
-	m.clearedroom = false
+// ResetIdentityKey resets all changes to the "identity_key" edge.
+func (m *DeviceMutation) ResetIdentityKey() {
+	m.identity_key = nil
+	m.clearedidentity_key = false
 }
 
-// AddMediumIDs adds the "media" edge to the Media entity by ids.
-func (m *MessageMutation) AddMediumIDs(ids ...int) {
-	if m.media == nil {
-		m.media = make(map[int]struct{})
+// AddIdentityKeyHistoryIDs adds the "identity_key_history" edge to the IdentityKeyRecord entity by ids.
+func (m *DeviceMutation) AddIdentityKeyHistoryIDs(ids ...int) {
+	if m.identity_key_history == nil {
+		m.identity_key_history = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.media[ids[i]] = struct{}{}
+		m.identity_key_history[ids[i]] = struct{}{}
 	}
 }
 
-// ClearMedia clears the "media" edge to the Media entity.
-func (m *MessageMutation) ClearMedia() {
-	m.clearedmedia = true
+// ClearIdentityKeyHistory clears the "identity_key_history" edge to the IdentityKeyRecord entity.
+func (m *DeviceMutation) ClearIdentityKeyHistory() {
+	m.clearedidentity_key_history = true
 }
 
-// MediaCleared reports if the "media" edge to the Media entity was cleared.
-func (m *MessageMutation) MediaCleared() bool {
-	return m.clearedmedia
+// IdentityKeyHistoryCleared reports if the "identity_key_history" edge to the IdentityKeyRecord entity was cleared.
+func (m *DeviceMutation) IdentityKeyHistoryCleared() bool {
+	return m.clearedidentity_key_history
 }
 
-// RemoveMediumIDs removes the "media" edge to the Media entity by IDs.
-func (m *MessageMutation) RemoveMediumIDs(ids ...int) {
-	if m.removedmedia == nil {
-		m.removedmedia = make(map[int]struct{})
+// RemoveIdentityKeyHistoryIDs removes the "identity_key_history" edge to the IdentityKeyRecord entity by IDs.
+func (m *DeviceMutation) RemoveIdentityKeyHistoryIDs(ids ...int) {
+	if m.removedidentity_key_history == nil {
+		m.removedidentity_key_history = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.media, ids[i])
-		m.removedmedia[ids[i]] = struct{}{}
+		delete(m.identity_key_history, ids[i])
+		m.removedidentity_key_history[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedMedia returns the removed IDs of the "media" edge to the Media entity.
-func (m *MessageMutation) RemovedMediaIDs() (ids []int) {
-	for id := range m.removedmedia {
+// RemovedIdentityKeyHistory returns the removed IDs of the "identity_key_history" edge to the IdentityKeyRecord entity.
+func (m *DeviceMutation) RemovedIdentityKeyHistoryIDs() (ids []int) {
+	for id := range m.removedidentity_key_history {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// MediaIDs returns the "media" edge IDs in the mutation.
-func (m *MessageMutation) MediaIDs() (ids []int) {
-	for id := range m.media {
+// IdentityKeyHistoryIDs returns the "identity_key_history" edge IDs in the mutation.
+func (m *DeviceMutation) IdentityKeyHistoryIDs() (ids []int) {
+	for id := range m.identity_key_history {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMedia resets all changes to the "media" edge.
-func (m *MessageMutation) ResetMedia() {
-	m.media = nil
-	m.clearedmedia = false
-	m.removedmedia = nil
+// ResetIdentityKeyHistory resets all changes to the "identity_key_history" edge.
+func (m *DeviceMutation) ResetIdentityKeyHistory() {
+	m.identity_key_history = nil
+	m.clearedidentity_key_history = false
+	m.removedidentity_key_history = nil
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
-	m.predicates = append(m.predicates, ps...)
+// AddSignedPreKeyIDs adds the "signed_pre_keys" edge to the SignedPreKey entity by ids.
+func (m *DeviceMutation) AddSignedPreKeyIDs(ids ...int) {
+	if m.signed_pre_keys == nil {
+		m.signed_pre_keys = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.signed_pre_keys[ids[i]] = struct{}{}
+	}
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ClearSignedPreKeys clears the "signed_pre_keys" edge to the SignedPreKey entity.
+func (m *DeviceMutation) ClearSignedPreKeys() {
+	m.clearedsigned_pre_keys = true
+}
+
+// SignedPreKeysCleared reports if the "signed_pre_keys" edge to the SignedPreKey entity was cleared.
+func (m *DeviceMutation) SignedPreKeysCleared() bool {
+	return m.clearedsigned_pre_keys
+}
+
+// RemoveSignedPreKeyIDs removes the "signed_pre_keys" edge to the SignedPreKey entity by IDs.
+func (m *DeviceMutation) RemoveSignedPreKeyIDs(ids ...int) {
+	if m.removedsigned_pre_keys == nil {
+		m.removedsigned_pre_keys = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.signed_pre_keys, ids[i])
+		m.removedsigned_pre_keys[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *MessageMutation) Op() Op {
-	return m.op
+// RemovedSignedPreKeys returns the removed IDs of the "signed_pre_keys" edge to the SignedPreKey entity.
+func (m *DeviceMutation) RemovedSignedPreKeysIDs() (ids []int) {
+	for id := range m.removedsigned_pre_keys {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
-	m.op = op
+// SignedPreKeysIDs returns the "signed_pre_keys" edge IDs in the mutation.
+func (m *DeviceMutation) SignedPreKeysIDs() (ids []int) {
+	for id := range m.signed_pre_keys {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
-	return m.typ
+// ResetSignedPreKeys resets all changes to the "signed_pre_keys" edge.
+func (m *DeviceMutation) ResetSignedPreKeys() {
+	m.signed_pre_keys = nil
+	m.clearedsigned_pre_keys = false
+	m.removedsigned_pre_keys = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.cipher_text != nil {
-		fields = append(fields, message.FieldCipherText)
+// AddOneTimePreKeyIDs adds the "one_time_pre_keys" edge to the OneTimePreKey entity by ids.
+func (m *DeviceMutation) AddOneTimePreKeyIDs(ids ...int) {
+	if m.one_time_pre_keys == nil {
+		m.one_time_pre_keys = make(map[int]struct{})
 	}
-	if m.content_type != nil {
-		fields = append(fields, message.FieldContentType)
+	for i := range ids {
+		m.one_time_pre_keys[ids[i]] = struct{}{}
 	}
-	if m.encryption_scheme != nil {
-		fields = append(fields, message.FieldEncryptionScheme)
+}
+
+// ClearOneTimePreKeys clears the "one_time_pre_keys" edge to the OneTimePreKey entity.
+func (m *DeviceMutation) ClearOneTimePreKeys() {
+	m.clearedone_time_pre_keys = true
+}
+
+// OneTimePreKeysCleared reports if the "one_time_pre_keys" edge to the OneTimePreKey entity was cleared.
+func (m *DeviceMutation) OneTimePreKeysCleared() bool {
+	return m.clearedone_time_pre_keys
+}
+
+// RemoveOneTimePreKeyIDs removes the "one_time_pre_keys" edge to the OneTimePreKey entity by IDs.
+func (m *DeviceMutation) RemoveOneTimePreKeyIDs(ids ...int) {
+	if m.removedone_time_pre_keys == nil {
+		m.removedone_time_pre_keys = make(map[int]struct{})
 	}
-	if m.edited != nil {
-		fields = append(fields, message.FieldEdited)
+	for i := range ids {
+		delete(m.one_time_pre_keys, ids[i])
+		m.removedone_time_pre_keys[ids[i]] = struct{}{}
 	}
-	if m.created_at != nil {
-		fields = append(fields, message.FieldCreatedAt)
+}
+
+// RemovedOneTimePreKeys returns the removed IDs of the "one_time_pre_keys" edge to the OneTimePreKey entity.
+func (m *DeviceMutation) RemovedOneTimePreKeysIDs() (ids []int) {
+	for id := range m.removedone_time_pre_keys {
+		ids = append(ids, id)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, message.FieldUpdatedAt)
+	return
+}
+
+// OneTimePreKeysIDs returns the "one_time_pre_keys" edge IDs in the mutation.
+func (m *DeviceMutation) OneTimePreKeysIDs() (ids []int) {
+	for id := range m.one_time_pre_keys {
+		ids = append(ids, id)
 	}
-	return fields
+	return
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case message.FieldCipherText:
-		return m.CipherText()
-	case message.FieldContentType:
-		return m.ContentType()
-	case message.FieldEncryptionScheme:
-		return m.EncryptionScheme()
-	case message.FieldEdited:
-		return m.Edited()
-	case message.FieldCreatedAt:
-		return m.CreatedAt()
-	case message.FieldUpdatedAt:
-		return m.UpdatedAt()
+// ResetOneTimePreKeys resets all changes to the "one_time_pre_keys" edge.
+func (m *DeviceMutation) ResetOneTimePreKeys() {
+	m.one_time_pre_keys = nil
+	m.clearedone_time_pre_keys = false
+	m.removedone_time_pre_keys = nil
+}
+
+// AddEnvelopeIDs adds the "envelopes" edge to the MessageEnvelope entity by ids.
+func (m *DeviceMutation) AddEnvelopeIDs(ids ...int) {
+	if m.envelopes == nil {
+		m.envelopes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.envelopes[ids[i]] = struct{}{}
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case message.FieldCipherText:
-		return m.OldCipherText(ctx)
-	case message.FieldContentType:
-		return m.OldContentType(ctx)
-	case message.FieldEncryptionScheme:
-		return m.OldEncryptionScheme(ctx)
-	case message.FieldEdited:
-		return m.OldEdited(ctx)
-	case message.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case message.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// ClearEnvelopes clears the "envelopes" edge to the MessageEnvelope entity.
+func (m *DeviceMutation) ClearEnvelopes() {
+	m.clearedenvelopes = true
+}
+
+// EnvelopesCleared reports if the "envelopes" edge to the MessageEnvelope entity was cleared.
+func (m *DeviceMutation) EnvelopesCleared() bool {
+	return m.clearedenvelopes
+}
+
+// RemoveEnvelopeIDs removes the "envelopes" edge to the MessageEnvelope entity by IDs.
+func (m *DeviceMutation) RemoveEnvelopeIDs(ids ...int) {
+	if m.removedenvelopes == nil {
+		m.removedenvelopes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.envelopes, ids[i])
+		m.removedenvelopes[ids[i]] = struct{}{}
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case message.FieldCipherText:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCipherText(v)
-		return nil
-	case message.FieldContentType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContentType(v)
-		return nil
-	case message.FieldEncryptionScheme:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEncryptionScheme(v)
-		return nil
-	case message.FieldEdited:
-		v, ok := value.(bool)
+// RemovedEnvelopes returns the removed IDs of the "envelopes" edge to the MessageEnvelope entity.
+func (m *DeviceMutation) RemovedEnvelopesIDs() (ids []int) {
+	for id := range m.removedenvelopes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EnvelopesIDs returns the "envelopes" edge IDs in the mutation.
+func (m *DeviceMutation) EnvelopesIDs() (ids []int) {
+	for id := range m.envelopes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEnvelopes resets all changes to the "envelopes" edge.
+func (m *DeviceMutation) ResetEnvelopes() {
+	m.envelopes = nil
+	m.clearedenvelopes = false
+	m.removedenvelopes = nil
+}
+
+// AddKeyPackageIDs adds the "key_packages" edge to the KeyPackage entity by ids.
+func (m *DeviceMutation) AddKeyPackageIDs(ids ...int) {
+	if m.key_packages == nil {
+		m.key_packages = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.key_packages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearKeyPackages clears the "key_packages" edge to the KeyPackage entity.
+func (m *DeviceMutation) ClearKeyPackages() {
+	m.clearedkey_packages = true
+}
+
+// KeyPackagesCleared reports if the "key_packages" edge to the KeyPackage entity was cleared.
+func (m *DeviceMutation) KeyPackagesCleared() bool {
+	return m.clearedkey_packages
+}
+
+// RemoveKeyPackageIDs removes the "key_packages" edge to the KeyPackage entity by IDs.
+func (m *DeviceMutation) RemoveKeyPackageIDs(ids ...int) {
+	if m.removedkey_packages == nil {
+		m.removedkey_packages = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.key_packages, ids[i])
+		m.removedkey_packages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedKeyPackages returns the removed IDs of the "key_packages" edge to the KeyPackage entity.
+func (m *DeviceMutation) RemovedKeyPackagesIDs() (ids []int) {
+	for id := range m.removedkey_packages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// KeyPackagesIDs returns the "key_packages" edge IDs in the mutation.
+func (m *DeviceMutation) KeyPackagesIDs() (ids []int) {
+	for id := range m.key_packages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetKeyPackages resets all changes to the "key_packages" edge.
+func (m *DeviceMutation) ResetKeyPackages() {
+	m.key_packages = nil
+	m.clearedkey_packages = false
+	m.removedkey_packages = nil
+}
+
+// AddMlsWelcomeIDs adds the "mls_welcomes" edge to the MlsWelcome entity by ids.
+func (m *DeviceMutation) AddMlsWelcomeIDs(ids ...int) {
+	if m.mls_welcomes == nil {
+		m.mls_welcomes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.mls_welcomes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMlsWelcomes clears the "mls_welcomes" edge to the MlsWelcome entity.
+func (m *DeviceMutation) ClearMlsWelcomes() {
+	m.clearedmls_welcomes = true
+}
+
+// MlsWelcomesCleared reports if the "mls_welcomes" edge to the MlsWelcome entity was cleared.
+func (m *DeviceMutation) MlsWelcomesCleared() bool {
+	return m.clearedmls_welcomes
+}
+
+// RemoveMlsWelcomeIDs removes the "mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (m *DeviceMutation) RemoveMlsWelcomeIDs(ids ...int) {
+	if m.removedmls_welcomes == nil {
+		m.removedmls_welcomes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.mls_welcomes, ids[i])
+		m.removedmls_welcomes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMlsWelcomes returns the removed IDs of the "mls_welcomes" edge to the MlsWelcome entity.
+func (m *DeviceMutation) RemovedMlsWelcomesIDs() (ids []int) {
+	for id := range m.removedmls_welcomes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MlsWelcomesIDs returns the "mls_welcomes" edge IDs in the mutation.
+func (m *DeviceMutation) MlsWelcomesIDs() (ids []int) {
+	for id := range m.mls_welcomes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMlsWelcomes resets all changes to the "mls_welcomes" edge.
+func (m *DeviceMutation) ResetMlsWelcomes() {
+	m.mls_welcomes = nil
+	m.clearedmls_welcomes = false
+	m.removedmls_welcomes = nil
+}
+
+// AddSenderKeyDistributionIDs adds the "sender_key_distributions" edge to the SenderKeyDistribution entity by ids.
+func (m *DeviceMutation) AddSenderKeyDistributionIDs(ids ...int) {
+	if m.sender_key_distributions == nil {
+		m.sender_key_distributions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sender_key_distributions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSenderKeyDistributions clears the "sender_key_distributions" edge to the SenderKeyDistribution entity.
+func (m *DeviceMutation) ClearSenderKeyDistributions() {
+	m.clearedsender_key_distributions = true
+}
+
+// SenderKeyDistributionsCleared reports if the "sender_key_distributions" edge to the SenderKeyDistribution entity was cleared.
+func (m *DeviceMutation) SenderKeyDistributionsCleared() bool {
+	return m.clearedsender_key_distributions
+}
+
+// RemoveSenderKeyDistributionIDs removes the "sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (m *DeviceMutation) RemoveSenderKeyDistributionIDs(ids ...int) {
+	if m.removedsender_key_distributions == nil {
+		m.removedsender_key_distributions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sender_key_distributions, ids[i])
+		m.removedsender_key_distributions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSenderKeyDistributions returns the removed IDs of the "sender_key_distributions" edge to the SenderKeyDistribution entity.
+func (m *DeviceMutation) RemovedSenderKeyDistributionsIDs() (ids []int) {
+	for id := range m.removedsender_key_distributions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SenderKeyDistributionsIDs returns the "sender_key_distributions" edge IDs in the mutation.
+func (m *DeviceMutation) SenderKeyDistributionsIDs() (ids []int) {
+	for id := range m.sender_key_distributions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSenderKeyDistributions resets all changes to the "sender_key_distributions" edge.
+func (m *DeviceMutation) ResetSenderKeyDistributions() {
+	m.sender_key_distributions = nil
+	m.clearedsender_key_distributions = false
+	m.removedsender_key_distributions = nil
+}
+
+// Where appends a list predicates to the DeviceMutation builder.
+func (m *DeviceMutation) Where(ps ...predicate.Device) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeviceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Device, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeviceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeviceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Device).
+func (m *DeviceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeviceMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.device_id != nil {
+		fields = append(fields, device.FieldDeviceID)
+	}
+	if m.registration_id != nil {
+		fields = append(fields, device.FieldRegistrationID)
+	}
+	if m.name != nil {
+		fields = append(fields, device.FieldName)
+	}
+	if m.low_prekeys_notified_at != nil {
+		fields = append(fields, device.FieldLowPrekeysNotifiedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, device.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, device.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case device.FieldDeviceID:
+		return m.DeviceID()
+	case device.FieldRegistrationID:
+		return m.RegistrationID()
+	case device.FieldName:
+		return m.Name()
+	case device.FieldLowPrekeysNotifiedAt:
+		return m.LowPrekeysNotifiedAt()
+	case device.FieldCreatedAt:
+		return m.CreatedAt()
+	case device.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeviceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case device.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case device.FieldRegistrationID:
+		return m.OldRegistrationID(ctx)
+	case device.FieldName:
+		return m.OldName(ctx)
+	case device.FieldLowPrekeysNotifiedAt:
+		return m.OldLowPrekeysNotifiedAt(ctx)
+	case device.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case device.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Device field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case device.FieldDeviceID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEdited(v)
+		m.SetDeviceID(v)
 		return nil
-	case message.FieldCreatedAt:
+	case device.FieldRegistrationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistrationID(v)
+		return nil
+	case device.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case device.FieldLowPrekeysNotifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLowPrekeysNotifiedAt(v)
+		return nil
+	case device.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case message.FieldUpdatedAt:
+	case device.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3843,129 +4538,273 @@ func (m *MessageMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Device field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
-	return nil
+func (m *DeviceMutation) AddedFields() []string {
+	var fields []string
+	if m.adddevice_id != nil {
+		fields = append(fields, device.FieldDeviceID)
+	}
+	if m.addregistration_id != nil {
+		fields = append(fields, device.FieldRegistrationID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case device.FieldDeviceID:
+		return m.AddedDeviceID()
+	case device.FieldRegistrationID:
+		return m.AddedRegistrationID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *DeviceMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case device.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeviceID(v)
+		return nil
+	case device.FieldRegistrationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRegistrationID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown Device numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
-	return nil
+func (m *DeviceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(device.FieldLowPrekeysNotifiedAt) {
+		fields = append(fields, device.FieldLowPrekeysNotifiedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *DeviceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Message nullable field %s", name)
+func (m *DeviceMutation) ClearField(name string) error {
+	switch name {
+	case device.FieldLowPrekeysNotifiedAt:
+		m.ClearLowPrekeysNotifiedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Device nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *DeviceMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldCipherText:
-		m.ResetCipherText()
+	case device.FieldDeviceID:
+		m.ResetDeviceID()
 		return nil
-	case message.FieldContentType:
-		m.ResetContentType()
+	case device.FieldRegistrationID:
+		m.ResetRegistrationID()
 		return nil
-	case message.FieldEncryptionScheme:
-		m.ResetEncryptionScheme()
+	case device.FieldName:
+		m.ResetName()
 		return nil
-	case message.FieldEdited:
-		m.ResetEdited()
+	case device.FieldLowPrekeysNotifiedAt:
+		m.ResetLowPrekeysNotifiedAt()
 		return nil
-	case message.FieldCreatedAt:
+	case device.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case message.FieldUpdatedAt:
+	case device.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Device field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.sender != nil {
-		edges = append(edges, message.EdgeSender)
+func (m *DeviceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.user != nil {
+		edges = append(edges, device.EdgeUser)
 	}
-	if m.room != nil {
-		edges = append(edges, message.EdgeRoom)
+	if m.identity_key != nil {
+		edges = append(edges, device.EdgeIdentityKey)
 	}
-	if m.media != nil {
-		edges = append(edges, message.EdgeMedia)
+	if m.identity_key_history != nil {
+		edges = append(edges, device.EdgeIdentityKeyHistory)
+	}
+	if m.signed_pre_keys != nil {
+		edges = append(edges, device.EdgeSignedPreKeys)
+	}
+	if m.one_time_pre_keys != nil {
+		edges = append(edges, device.EdgeOneTimePreKeys)
+	}
+	if m.envelopes != nil {
+		edges = append(edges, device.EdgeEnvelopes)
+	}
+	if m.key_packages != nil {
+		edges = append(edges, device.EdgeKeyPackages)
+	}
+	if m.mls_welcomes != nil {
+		edges = append(edges, device.EdgeMlsWelcomes)
+	}
+	if m.sender_key_distributions != nil {
+		edges = append(edges, device.EdgeSenderKeyDistributions)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *DeviceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeSender:
-		if id := m.sender; id != nil {
+	case device.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeRoom:
-		if id := m.room; id != nil {
+	case device.EdgeIdentityKey:
+		if id := m.identity_key; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeMedia:
-		ids := make([]ent.Value, 0, len(m.media))
-		for id := range m.media {
+	case device.EdgeIdentityKeyHistory:
+		ids := make([]ent.Value, 0, len(m.identity_key_history))
+		for id := range m.identity_key_history {
 			ids = append(ids, id)
 		}
 		return ids
-	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedmedia != nil {
-		edges = append(edges, message.EdgeMedia)
-	}
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	case device.EdgeSignedPreKeys:
+		ids := make([]ent.Value, 0, len(m.signed_pre_keys))
+		for id := range m.signed_pre_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeOneTimePreKeys:
+		ids := make([]ent.Value, 0, len(m.one_time_pre_keys))
+		for id := range m.one_time_pre_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeEnvelopes:
+		ids := make([]ent.Value, 0, len(m.envelopes))
+		for id := range m.envelopes {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeKeyPackages:
+		ids := make([]ent.Value, 0, len(m.key_packages))
+		for id := range m.key_packages {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeMlsWelcomes:
+		ids := make([]ent.Value, 0, len(m.mls_welcomes))
+		for id := range m.mls_welcomes {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeSenderKeyDistributions:
+		ids := make([]ent.Value, 0, len(m.sender_key_distributions))
+		for id := range m.sender_key_distributions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedidentity_key_history != nil {
+		edges = append(edges, device.EdgeIdentityKeyHistory)
+	}
+	if m.removedsigned_pre_keys != nil {
+		edges = append(edges, device.EdgeSignedPreKeys)
+	}
+	if m.removedone_time_pre_keys != nil {
+		edges = append(edges, device.EdgeOneTimePreKeys)
+	}
+	if m.removedenvelopes != nil {
+		edges = append(edges, device.EdgeEnvelopes)
+	}
+	if m.removedkey_packages != nil {
+		edges = append(edges, device.EdgeKeyPackages)
+	}
+	if m.removedmls_welcomes != nil {
+		edges = append(edges, device.EdgeMlsWelcomes)
+	}
+	if m.removedsender_key_distributions != nil {
+		edges = append(edges, device.EdgeSenderKeyDistributions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeMedia:
-		ids := make([]ent.Value, 0, len(m.removedmedia))
-		for id := range m.removedmedia {
+	case device.EdgeIdentityKeyHistory:
+		ids := make([]ent.Value, 0, len(m.removedidentity_key_history))
+		for id := range m.removedidentity_key_history {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeSignedPreKeys:
+		ids := make([]ent.Value, 0, len(m.removedsigned_pre_keys))
+		for id := range m.removedsigned_pre_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeOneTimePreKeys:
+		ids := make([]ent.Value, 0, len(m.removedone_time_pre_keys))
+		for id := range m.removedone_time_pre_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeEnvelopes:
+		ids := make([]ent.Value, 0, len(m.removedenvelopes))
+		for id := range m.removedenvelopes {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeKeyPackages:
+		ids := make([]ent.Value, 0, len(m.removedkey_packages))
+		for id := range m.removedkey_packages {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeMlsWelcomes:
+		ids := make([]ent.Value, 0, len(m.removedmls_welcomes))
+		for id := range m.removedmls_welcomes {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeSenderKeyDistributions:
+		ids := make([]ent.Value, 0, len(m.removedsender_key_distributions))
+		for id := range m.removedsender_key_distributions {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3974,100 +4813,144 @@ func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedsender {
-		edges = append(edges, message.EdgeSender)
+func (m *DeviceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.cleareduser {
+		edges = append(edges, device.EdgeUser)
 	}
-	if m.clearedroom {
-		edges = append(edges, message.EdgeRoom)
+	if m.clearedidentity_key {
+		edges = append(edges, device.EdgeIdentityKey)
 	}
-	if m.clearedmedia {
-		edges = append(edges, message.EdgeMedia)
+	if m.clearedidentity_key_history {
+		edges = append(edges, device.EdgeIdentityKeyHistory)
+	}
+	if m.clearedsigned_pre_keys {
+		edges = append(edges, device.EdgeSignedPreKeys)
+	}
+	if m.clearedone_time_pre_keys {
+		edges = append(edges, device.EdgeOneTimePreKeys)
+	}
+	if m.clearedenvelopes {
+		edges = append(edges, device.EdgeEnvelopes)
+	}
+	if m.clearedkey_packages {
+		edges = append(edges, device.EdgeKeyPackages)
+	}
+	if m.clearedmls_welcomes {
+		edges = append(edges, device.EdgeMlsWelcomes)
+	}
+	if m.clearedsender_key_distributions {
+		edges = append(edges, device.EdgeSenderKeyDistributions)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *DeviceMutation) EdgeCleared(name string) bool {
 	switch name {
-	case message.EdgeSender:
-		return m.clearedsender
-	case message.EdgeRoom:
-		return m.clearedroom
-	case message.EdgeMedia:
-		return m.clearedmedia
+	case device.EdgeUser:
+		return m.cleareduser
+	case device.EdgeIdentityKey:
+		return m.clearedidentity_key
+	case device.EdgeIdentityKeyHistory:
+		return m.clearedidentity_key_history
+	case device.EdgeSignedPreKeys:
+		return m.clearedsigned_pre_keys
+	case device.EdgeOneTimePreKeys:
+		return m.clearedone_time_pre_keys
+	case device.EdgeEnvelopes:
+		return m.clearedenvelopes
+	case device.EdgeKeyPackages:
+		return m.clearedkey_packages
+	case device.EdgeMlsWelcomes:
+		return m.clearedmls_welcomes
+	case device.EdgeSenderKeyDistributions:
+		return m.clearedsender_key_distributions
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
+func (m *DeviceMutation) ClearEdge(name string) error {
 	switch name {
-	case message.EdgeSender:
-		m.ClearSender()
+	case device.EdgeUser:
+		m.ClearUser()
 		return nil
-	case message.EdgeRoom:
-		m.ClearRoom()
+	case device.EdgeIdentityKey:
+		m.ClearIdentityKey()
 		return nil
 	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+	return fmt.Errorf("unknown Device unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
+func (m *DeviceMutation) ResetEdge(name string) error {
 	switch name {
-	case message.EdgeSender:
-		m.ResetSender()
+	case device.EdgeUser:
+		m.ResetUser()
 		return nil
-	case message.EdgeRoom:
-		m.ResetRoom()
+	case device.EdgeIdentityKey:
+		m.ResetIdentityKey()
 		return nil
-	case message.EdgeMedia:
-		m.ResetMedia()
+	case device.EdgeIdentityKeyHistory:
+		m.ResetIdentityKeyHistory()
+		return nil
+	case device.EdgeSignedPreKeys:
+		m.ResetSignedPreKeys()
+		return nil
+	case device.EdgeOneTimePreKeys:
+		m.ResetOneTimePreKeys()
+		return nil
+	case device.EdgeEnvelopes:
+		m.ResetEnvelopes()
+		return nil
+	case device.EdgeKeyPackages:
+		m.ResetKeyPackages()
+		return nil
+	case device.EdgeMlsWelcomes:
+		m.ResetMlsWelcomes()
+		return nil
+	case device.EdgeSenderKeyDistributions:
+		m.ResetSenderKeyDistributions()
 		return nil
 	}
-	return fmt.Errorf("unknown Message edge %s", name)
+	return fmt.Errorf("unknown Device edge %s", name)
 }
 
-// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
-type NotificationMutation struct {
+// EmailTokenMutation represents an operation that mutates the EmailToken nodes in the graph.
+type EmailTokenMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	kind              *string
-	cipher_text       *string
-	encryption_scheme *string
-	read              *bool
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	recipient         *int
-	clearedrecipient  bool
-	room              *int
-	clearedroom       bool
-	message           *int
-	clearedmessage    bool
-	done              bool
-	oldValue          func(context.Context) (*Notification, error)
-	predicates        []predicate.Notification
+	op            Op
+	typ           string
+	id            *int
+	purpose       *emailtoken.Purpose
+	token_hash    *string
+	email         *string
+	expires_at    *time.Time
+	used_at       *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*EmailToken, error)
+	predicates    []predicate.EmailToken
 }
 
-var _ ent.Mutation = (*NotificationMutation)(nil)
+var _ ent.Mutation = (*EmailTokenMutation)(nil)
 
-// notificationOption allows management of the mutation configuration using functional options.
-type notificationOption func(*NotificationMutation)
+// emailtokenOption allows management of the mutation configuration using functional options.
+type emailtokenOption func(*EmailTokenMutation)
 
-// newNotificationMutation creates new mutation for the Notification entity.
-func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
-	m := &NotificationMutation{
+// newEmailTokenMutation creates new mutation for the EmailToken entity.
+func newEmailTokenMutation(c config, op Op, opts ...emailtokenOption) *EmailTokenMutation {
+	m := &EmailTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNotification,
+		typ:           TypeEmailToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4076,20 +4959,20 @@ func newNotificationMutation(c config, op Op, opts ...notificationOption) *Notif
 	return m
 }
 
-// withNotificationID sets the ID field of the mutation.
-func withNotificationID(id int) notificationOption {
-	return func(m *NotificationMutation) {
+// withEmailTokenID sets the ID field of the mutation.
+func withEmailTokenID(id int) emailtokenOption {
+	return func(m *EmailTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Notification
+			value *EmailToken
 		)
-		m.oldValue = func(ctx context.Context) (*Notification, error) {
+		m.oldValue = func(ctx context.Context) (*EmailToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Notification.Get(ctx, id)
+					value, err = m.Client().EmailToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4098,10 +4981,10 @@ func withNotificationID(id int) notificationOption {
 	}
 }
 
-// withNotification sets the old Notification of the mutation.
-func withNotification(node *Notification) notificationOption {
-	return func(m *NotificationMutation) {
-		m.oldValue = func(context.Context) (*Notification, error) {
+// withEmailToken sets the old EmailToken of the mutation.
+func withEmailToken(node *EmailToken) emailtokenOption {
+	return func(m *EmailTokenMutation) {
+		m.oldValue = func(context.Context) (*EmailToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4110,7 +4993,7 @@ func withNotification(node *Notification) notificationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NotificationMutation) Client() *Client {
+func (m EmailTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4118,7 +5001,7 @@ func (m NotificationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NotificationMutation) Tx() (*Tx, error) {
+func (m EmailTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4129,7 +5012,7 @@ func (m NotificationMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NotificationMutation) ID() (id int, exists bool) {
+func (m *EmailTokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4140,7 +5023,7 @@ func (m *NotificationMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NotificationMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *EmailTokenMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4149,354 +5032,289 @@ func (m *NotificationMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EmailToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKind sets the "kind" field.
-func (m *NotificationMutation) SetKind(s string) {
-	m.kind = &s
+// SetPurpose sets the "purpose" field.
+func (m *EmailTokenMutation) SetPurpose(e emailtoken.Purpose) {
+	m.purpose = &e
 }
 
-// Kind returns the value of the "kind" field in the mutation.
-func (m *NotificationMutation) Kind() (r string, exists bool) {
-	v := m.kind
+// Purpose returns the value of the "purpose" field in the mutation.
+func (m *EmailTokenMutation) Purpose() (r emailtoken.Purpose, exists bool) {
+	v := m.purpose
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKind returns the old "kind" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldPurpose returns the old "purpose" field's value of the EmailToken entity.
+// If the EmailToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldKind(ctx context.Context) (v string, err error) {
+func (m *EmailTokenMutation) OldPurpose(ctx context.Context) (v emailtoken.Purpose, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKind requires an ID field in the mutation")
+		return v, errors.New("OldPurpose requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
 	}
-	return oldValue.Kind, nil
+	return oldValue.Purpose, nil
 }
 
-// ResetKind resets all changes to the "kind" field.
-func (m *NotificationMutation) ResetKind() {
-	m.kind = nil
+// ResetPurpose resets all changes to the "purpose" field.
+func (m *EmailTokenMutation) ResetPurpose() {
+	m.purpose = nil
 }
 
-// SetCipherText sets the "cipher_text" field.
-func (m *NotificationMutation) SetCipherText(s string) {
-	m.cipher_text = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *EmailTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// CipherText returns the value of the "cipher_text" field in the mutation.
-func (m *NotificationMutation) CipherText() (r string, exists bool) {
-	v := m.cipher_text
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *EmailTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCipherText returns the old "cipher_text" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the EmailToken entity.
+// If the EmailToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldCipherText(ctx context.Context) (v string, err error) {
+func (m *EmailTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCipherText is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCipherText requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCipherText: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.CipherText, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetCipherText resets all changes to the "cipher_text" field.
-func (m *NotificationMutation) ResetCipherText() {
-	m.cipher_text = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *EmailTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetEncryptionScheme sets the "encryption_scheme" field.
-func (m *NotificationMutation) SetEncryptionScheme(s string) {
-	m.encryption_scheme = &s
+// SetEmail sets the "email" field.
+func (m *EmailTokenMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// EncryptionScheme returns the value of the "encryption_scheme" field in the mutation.
-func (m *NotificationMutation) EncryptionScheme() (r string, exists bool) {
-	v := m.encryption_scheme
+// Email returns the value of the "email" field in the mutation.
+func (m *EmailTokenMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptionScheme returns the old "encryption_scheme" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the EmailToken entity.
+// If the EmailToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldEncryptionScheme(ctx context.Context) (v string, err error) {
+func (m *EmailTokenMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptionScheme is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptionScheme requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptionScheme: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.EncryptionScheme, nil
+	return oldValue.Email, nil
 }
 
-// ResetEncryptionScheme resets all changes to the "encryption_scheme" field.
-func (m *NotificationMutation) ResetEncryptionScheme() {
-	m.encryption_scheme = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *EmailTokenMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetRead sets the "read" field.
-func (m *NotificationMutation) SetRead(b bool) {
-	m.read = &b
+// SetExpiresAt sets the "expires_at" field.
+func (m *EmailTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Read returns the value of the "read" field in the mutation.
-func (m *NotificationMutation) Read() (r bool, exists bool) {
-	v := m.read
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *EmailTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRead returns the old "read" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the EmailToken entity.
+// If the EmailToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldRead(ctx context.Context) (v bool, err error) {
+func (m *EmailTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRead is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRead requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRead: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Read, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetRead resets all changes to the "read" field.
-func (m *NotificationMutation) ResetRead() {
-	m.read = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *EmailTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NotificationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUsedAt sets the "used_at" field.
+func (m *EmailTokenMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *EmailTokenMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldUsedAt returns the old "used_at" field's value of the EmailToken entity.
+// If the EmailToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EmailTokenMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UsedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NotificationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *EmailTokenMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[emailtoken.FieldUsedAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *NotificationMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *EmailTokenMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[emailtoken.FieldUsedAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NotificationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *EmailTokenMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, emailtoken.FieldUsedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EmailTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmailTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the EmailToken entity.
+// If the EmailToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EmailTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NotificationMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetRecipientID sets the "recipient" edge to the User entity by id.
-func (m *NotificationMutation) SetRecipientID(id int) {
-	m.recipient = &id
-}
-
-// ClearRecipient clears the "recipient" edge to the User entity.
-func (m *NotificationMutation) ClearRecipient() {
-	m.clearedrecipient = true
-}
-
-// RecipientCleared reports if the "recipient" edge to the User entity was cleared.
-func (m *NotificationMutation) RecipientCleared() bool {
-	return m.clearedrecipient
-}
-
-// RecipientID returns the "recipient" edge ID in the mutation.
-func (m *NotificationMutation) RecipientID() (id int, exists bool) {
-	if m.recipient != nil {
-		return *m.recipient, true
-	}
-	return
-}
-
-// RecipientIDs returns the "recipient" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RecipientID instead. It exists only for internal usage by the builders.
-func (m *NotificationMutation) RecipientIDs() (ids []int) {
-	if id := m.recipient; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetRecipient resets all changes to the "recipient" edge.
-func (m *NotificationMutation) ResetRecipient() {
-	m.recipient = nil
-	m.clearedrecipient = false
-}
-
-// SetRoomID sets the "room" edge to the Room entity by id.
-func (m *NotificationMutation) SetRoomID(id int) {
-	m.room = &id
-}
-
-// ClearRoom clears the "room" edge to the Room entity.
-func (m *NotificationMutation) ClearRoom() {
-	m.clearedroom = true
-}
-
-// RoomCleared reports if the "room" edge to the Room entity was cleared.
-func (m *NotificationMutation) RoomCleared() bool {
-	return m.clearedroom
-}
-
-// RoomID returns the "room" edge ID in the mutation.
-func (m *NotificationMutation) RoomID() (id int, exists bool) {
-	if m.room != nil {
-		return *m.room, true
-	}
-	return
-}
-
-// RoomIDs returns the "room" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoomID instead. It exists only for internal usage by the builders.
-func (m *NotificationMutation) RoomIDs() (ids []int) {
-	if id := m.room; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+	return oldValue.CreatedAt, nil
 }
 
-// ResetRoom resets all changes to the "room" edge.
-func (m *NotificationMutation) ResetRoom() {
-	m.room = nil
-	m.clearedroom = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmailTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetMessageID sets the "message" edge to the Message entity by id.
-func (m *NotificationMutation) SetMessageID(id int) {
-	m.message = &id
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *EmailTokenMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ClearMessage clears the "message" edge to the Message entity.
-func (m *NotificationMutation) ClearMessage() {
-	m.clearedmessage = true
+// ClearUser clears the "user" edge to the User entity.
+func (m *EmailTokenMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *NotificationMutation) MessageCleared() bool {
-	return m.clearedmessage
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *EmailTokenMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// MessageID returns the "message" edge ID in the mutation.
-func (m *NotificationMutation) MessageID() (id int, exists bool) {
-	if m.message != nil {
-		return *m.message, true
+// UserID returns the "user" edge ID in the mutation.
+func (m *EmailTokenMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// MessageIDs returns the "message" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageID instead. It exists only for internal usage by the builders.
-func (m *NotificationMutation) MessageIDs() (ids []int) {
-	if id := m.message; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *EmailTokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMessage resets all changes to the "message" edge.
-func (m *NotificationMutation) ResetMessage() {
-	m.message = nil
-	m.clearedmessage = false
+// ResetUser resets all changes to the "user" edge.
+func (m *EmailTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the NotificationMutation builder.
-func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+// Where appends a list predicates to the EmailTokenMutation builder.
+func (m *EmailTokenMutation) Where(ps ...predicate.EmailToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the EmailTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Notification, len(ps))
+func (m *EmailTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmailToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4504,42 +5322,42 @@ func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NotificationMutation) Op() Op {
+func (m *EmailTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NotificationMutation) SetOp(op Op) {
+func (m *EmailTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Notification).
-func (m *NotificationMutation) Type() string {
+// Type returns the node type of this mutation (EmailToken).
+func (m *EmailTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NotificationMutation) Fields() []string {
+func (m *EmailTokenMutation) Fields() []string {
 	fields := make([]string, 0, 6)
-	if m.kind != nil {
-		fields = append(fields, notification.FieldKind)
+	if m.purpose != nil {
+		fields = append(fields, emailtoken.FieldPurpose)
 	}
-	if m.cipher_text != nil {
-		fields = append(fields, notification.FieldCipherText)
+	if m.token_hash != nil {
+		fields = append(fields, emailtoken.FieldTokenHash)
 	}
-	if m.encryption_scheme != nil {
-		fields = append(fields, notification.FieldEncryptionScheme)
+	if m.email != nil {
+		fields = append(fields, emailtoken.FieldEmail)
 	}
-	if m.read != nil {
-		fields = append(fields, notification.FieldRead)
+	if m.expires_at != nil {
+		fields = append(fields, emailtoken.FieldExpiresAt)
 	}
-	if m.created_at != nil {
-		fields = append(fields, notification.FieldCreatedAt)
+	if m.used_at != nil {
+		fields = append(fields, emailtoken.FieldUsedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, notification.FieldUpdatedAt)
+	if m.created_at != nil {
+		fields = append(fields, emailtoken.FieldCreatedAt)
 	}
 	return fields
 }
@@ -4547,20 +5365,20 @@ func (m *NotificationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+func (m *EmailTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case notification.FieldKind:
-		return m.Kind()
-	case notification.FieldCipherText:
-		return m.CipherText()
-	case notification.FieldEncryptionScheme:
-		return m.EncryptionScheme()
-	case notification.FieldRead:
-		return m.Read()
-	case notification.FieldCreatedAt:
+	case emailtoken.FieldPurpose:
+		return m.Purpose()
+	case emailtoken.FieldTokenHash:
+		return m.TokenHash()
+	case emailtoken.FieldEmail:
+		return m.Email()
+	case emailtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case emailtoken.FieldUsedAt:
+		return m.UsedAt()
+	case emailtoken.FieldCreatedAt:
 		return m.CreatedAt()
-	case notification.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -4568,171 +5386,166 @@ func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EmailTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case notification.FieldKind:
-		return m.OldKind(ctx)
-	case notification.FieldCipherText:
-		return m.OldCipherText(ctx)
-	case notification.FieldEncryptionScheme:
-		return m.OldEncryptionScheme(ctx)
-	case notification.FieldRead:
-		return m.OldRead(ctx)
-	case notification.FieldCreatedAt:
+	case emailtoken.FieldPurpose:
+		return m.OldPurpose(ctx)
+	case emailtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case emailtoken.FieldEmail:
+		return m.OldEmail(ctx)
+	case emailtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case emailtoken.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case emailtoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case notification.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Notification field %s", name)
+	return nil, fmt.Errorf("unknown EmailToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+func (m *EmailTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case notification.FieldKind:
-		v, ok := value.(string)
+	case emailtoken.FieldPurpose:
+		v, ok := value.(emailtoken.Purpose)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKind(v)
+		m.SetPurpose(v)
 		return nil
-	case notification.FieldCipherText:
+	case emailtoken.FieldTokenHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCipherText(v)
+		m.SetTokenHash(v)
 		return nil
-	case notification.FieldEncryptionScheme:
+	case emailtoken.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptionScheme(v)
+		m.SetEmail(v)
 		return nil
-	case notification.FieldRead:
-		v, ok := value.(bool)
+	case emailtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRead(v)
+		m.SetExpiresAt(v)
 		return nil
-	case notification.FieldCreatedAt:
+	case emailtoken.FieldUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUsedAt(v)
 		return nil
-	case notification.FieldUpdatedAt:
+	case emailtoken.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Notification field %s", name)
+	return fmt.Errorf("unknown EmailToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NotificationMutation) AddedFields() []string {
+func (m *EmailTokenMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EmailTokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+func (m *EmailTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Notification numeric field %s", name)
+	return fmt.Errorf("unknown EmailToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NotificationMutation) ClearedFields() []string {
-	return nil
+func (m *EmailTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(emailtoken.FieldUsedAt) {
+		fields = append(fields, emailtoken.FieldUsedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NotificationMutation) FieldCleared(name string) bool {
+func (m *EmailTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NotificationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Notification nullable field %s", name)
+func (m *EmailTokenMutation) ClearField(name string) error {
+	switch name {
+	case emailtoken.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NotificationMutation) ResetField(name string) error {
+func (m *EmailTokenMutation) ResetField(name string) error {
 	switch name {
-	case notification.FieldKind:
-		m.ResetKind()
+	case emailtoken.FieldPurpose:
+		m.ResetPurpose()
 		return nil
-	case notification.FieldCipherText:
-		m.ResetCipherText()
+	case emailtoken.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case notification.FieldEncryptionScheme:
-		m.ResetEncryptionScheme()
+	case emailtoken.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case notification.FieldRead:
-		m.ResetRead()
+	case emailtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case notification.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case emailtoken.FieldUsedAt:
+		m.ResetUsedAt()
 		return nil
-	case notification.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case emailtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Notification field %s", name)
+	return fmt.Errorf("unknown EmailToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NotificationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.recipient != nil {
-		edges = append(edges, notification.EdgeRecipient)
-	}
-	if m.room != nil {
-		edges = append(edges, notification.EdgeRoom)
-	}
-	if m.message != nil {
-		edges = append(edges, notification.EdgeMessage)
+func (m *EmailTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, emailtoken.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+func (m *EmailTokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case notification.EdgeRecipient:
-		if id := m.recipient; id != nil {
-			return []ent.Value{*id}
-		}
-	case notification.EdgeRoom:
-		if id := m.room; id != nil {
-			return []ent.Value{*id}
-		}
-	case notification.EdgeMessage:
-		if id := m.message; id != nil {
+	case emailtoken.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4740,123 +5553,86 @@ func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NotificationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *EmailTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+func (m *EmailTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NotificationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedrecipient {
-		edges = append(edges, notification.EdgeRecipient)
-	}
-	if m.clearedroom {
-		edges = append(edges, notification.EdgeRoom)
-	}
-	if m.clearedmessage {
-		edges = append(edges, notification.EdgeMessage)
+func (m *EmailTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, emailtoken.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NotificationMutation) EdgeCleared(name string) bool {
+func (m *EmailTokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case notification.EdgeRecipient:
-		return m.clearedrecipient
-	case notification.EdgeRoom:
-		return m.clearedroom
-	case notification.EdgeMessage:
-		return m.clearedmessage
+	case emailtoken.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NotificationMutation) ClearEdge(name string) error {
+func (m *EmailTokenMutation) ClearEdge(name string) error {
 	switch name {
-	case notification.EdgeRecipient:
-		m.ClearRecipient()
-		return nil
-	case notification.EdgeRoom:
-		m.ClearRoom()
-		return nil
-	case notification.EdgeMessage:
-		m.ClearMessage()
+	case emailtoken.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Notification unique edge %s", name)
+	return fmt.Errorf("unknown EmailToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NotificationMutation) ResetEdge(name string) error {
+func (m *EmailTokenMutation) ResetEdge(name string) error {
 	switch name {
-	case notification.EdgeRecipient:
-		m.ResetRecipient()
-		return nil
-	case notification.EdgeRoom:
-		m.ResetRoom()
-		return nil
-	case notification.EdgeMessage:
-		m.ResetMessage()
+	case emailtoken.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Notification edge %s", name)
+	return fmt.Errorf("unknown EmailToken edge %s", name)
 }
 
-// RoomMutation represents an operation that mutates the Room nodes in the graph.
-type RoomMutation struct {
+// FavouriteMutation represents an operation that mutates the Favourite nodes in the graph.
+type FavouriteMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	description        *string
-	is_private         *bool
-	is_direct          *bool
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	owner              *int
-	clearedowner       bool
-	memberships        map[int]struct{}
-	removedmemberships map[int]struct{}
-	clearedmemberships bool
-	messages           map[int]struct{}
-	removedmessages    map[int]struct{}
-	clearedmessages    bool
-	favourites         map[int]struct{}
-	removedfavourites  map[int]struct{}
-	clearedfavourites  bool
-	call_logs          map[int]struct{}
-	removedcall_logs   map[int]struct{}
-	clearedcall_logs   bool
-	done               bool
-	oldValue           func(context.Context) (*Room, error)
-	predicates         []predicate.Room
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	room          *int
+	clearedroom   bool
+	done          bool
+	oldValue      func(context.Context) (*Favourite, error)
+	predicates    []predicate.Favourite
 }
 
-var _ ent.Mutation = (*RoomMutation)(nil)
+var _ ent.Mutation = (*FavouriteMutation)(nil)
 
-// roomOption allows management of the mutation configuration using functional options.
-type roomOption func(*RoomMutation)
+// favouriteOption allows management of the mutation configuration using functional options.
+type favouriteOption func(*FavouriteMutation)
 
-// newRoomMutation creates new mutation for the Room entity.
-func newRoomMutation(c config, op Op, opts ...roomOption) *RoomMutation {
-	m := &RoomMutation{
+// newFavouriteMutation creates new mutation for the Favourite entity.
+func newFavouriteMutation(c config, op Op, opts ...favouriteOption) *FavouriteMutation {
+	m := &FavouriteMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRoom,
+		typ:           TypeFavourite,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4865,20 +5641,20 @@ func newRoomMutation(c config, op Op, opts ...roomOption) *RoomMutation {
 	return m
 }
 
-// withRoomID sets the ID field of the mutation.
-func withRoomID(id int) roomOption {
-	return func(m *RoomMutation) {
+// withFavouriteID sets the ID field of the mutation.
+func withFavouriteID(id int) favouriteOption {
+	return func(m *FavouriteMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Room
+			value *Favourite
 		)
-		m.oldValue = func(ctx context.Context) (*Room, error) {
+		m.oldValue = func(ctx context.Context) (*Favourite, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Room.Get(ctx, id)
+					value, err = m.Client().Favourite.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4887,10 +5663,10 @@ func withRoomID(id int) roomOption {
 	}
 }
 
-// withRoom sets the old Room of the mutation.
-func withRoom(node *Room) roomOption {
-	return func(m *RoomMutation) {
-		m.oldValue = func(context.Context) (*Room, error) {
+// withFavourite sets the old Favourite of the mutation.
+func withFavourite(node *Favourite) favouriteOption {
+	return func(m *FavouriteMutation) {
+		m.oldValue = func(context.Context) (*Favourite, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4899,7 +5675,7 @@ func withRoom(node *Room) roomOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoomMutation) Client() *Client {
+func (m FavouriteMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4907,7 +5683,7 @@ func (m RoomMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoomMutation) Tx() (*Tx, error) {
+func (m FavouriteMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4918,7 +5694,7 @@ func (m RoomMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoomMutation) ID() (id int, exists bool) {
+func (m *FavouriteMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4929,7 +5705,7 @@ func (m *RoomMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoomMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FavouriteMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4938,535 +5714,734 @@ func (m *RoomMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Room.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Favourite.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *RoomMutation) SetName(s string) {
-	m.name = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *FavouriteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RoomMutation) Name() (r string, exists bool) {
-	v := m.name
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FavouriteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Favourite entity.
+// If the Favourite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *FavouriteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RoomMutation) ResetName() {
-	m.name = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FavouriteMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *RoomMutation) SetDescription(s string) {
-	m.description = &s
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *FavouriteMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *RoomMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearUser clears the "user" edge to the User entity.
+func (m *FavouriteMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// OldDescription returns the old "description" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *FavouriteMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *FavouriteMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *FavouriteMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Description, nil
+	return
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *RoomMutation) ResetDescription() {
-	m.description = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *FavouriteMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SetIsPrivate sets the "is_private" field.
-func (m *RoomMutation) SetIsPrivate(b bool) {
-	m.is_private = &b
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *FavouriteMutation) SetRoomID(id int) {
+	m.room = &id
 }
 
-// IsPrivate returns the value of the "is_private" field in the mutation.
-func (m *RoomMutation) IsPrivate() (r bool, exists bool) {
-	v := m.is_private
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *FavouriteMutation) ClearRoom() {
+	m.clearedroom = true
 }
 
-// OldIsPrivate returns the old "is_private" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldIsPrivate(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPrivate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPrivate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPrivate: %w", err)
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *FavouriteMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *FavouriteMutation) RoomID() (id int, exists bool) {
+	if m.room != nil {
+		return *m.room, true
 	}
-	return oldValue.IsPrivate, nil
+	return
 }
 
-// ResetIsPrivate resets all changes to the "is_private" field.
-func (m *RoomMutation) ResetIsPrivate() {
-	m.is_private = nil
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *FavouriteMutation) RoomIDs() (ids []int) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// SetIsDirect sets the "is_direct" field.
-func (m *RoomMutation) SetIsDirect(b bool) {
-	m.is_direct = &b
+// ResetRoom resets all changes to the "room" edge.
+func (m *FavouriteMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
 }
 
-// IsDirect returns the value of the "is_direct" field in the mutation.
-func (m *RoomMutation) IsDirect() (r bool, exists bool) {
-	v := m.is_direct
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the FavouriteMutation builder.
+func (m *FavouriteMutation) Where(ps ...predicate.Favourite) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldIsDirect returns the old "is_direct" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldIsDirect(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDirect is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDirect requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDirect: %w", err)
+// WhereP appends storage-level predicates to the FavouriteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FavouriteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Favourite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.IsDirect, nil
+	m.Where(p...)
 }
 
-// ResetIsDirect resets all changes to the "is_direct" field.
-func (m *RoomMutation) ResetIsDirect() {
-	m.is_direct = nil
+// Op returns the operation name.
+func (m *FavouriteMutation) Op() Op {
+	return m.op
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RoomMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetOp allows setting the mutation operation.
+func (m *FavouriteMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RoomMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Favourite).
+func (m *FavouriteMutation) Type() string {
+	return m.typ
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FavouriteMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, favourite.FieldCreatedAt)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RoomMutation) ResetCreatedAt() {
-	m.created_at = nil
+	return fields
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RoomMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FavouriteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case favourite.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RoomMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FavouriteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case favourite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Favourite field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Room entity.
-// If the Room object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FavouriteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case favourite.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return oldValue.UpdatedAt, nil
+	return fmt.Errorf("unknown Favourite field %s", name)
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RoomMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FavouriteMutation) AddedFields() []string {
+	return nil
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *RoomMutation) SetOwnerID(id int) {
-	m.owner = &id
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FavouriteMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *RoomMutation) ClearOwner() {
-	m.clearedowner = true
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FavouriteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Favourite numeric field %s", name)
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *RoomMutation) OwnerCleared() bool {
-	return m.clearedowner
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FavouriteMutation) ClearedFields() []string {
+	return nil
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *RoomMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
-	}
-	return
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FavouriteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *RoomMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FavouriteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Favourite nullable field %s", name)
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *RoomMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FavouriteMutation) ResetField(name string) error {
+	switch name {
+	case favourite.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Favourite field %s", name)
 }
 
-// AddMembershipIDs adds the "memberships" edge to the RoomMembership entity by ids.
-func (m *RoomMutation) AddMembershipIDs(ids ...int) {
-	if m.memberships == nil {
-		m.memberships = make(map[int]struct{})
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FavouriteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, favourite.EdgeUser)
 	}
-	for i := range ids {
-		m.memberships[ids[i]] = struct{}{}
+	if m.room != nil {
+		edges = append(edges, favourite.EdgeRoom)
 	}
+	return edges
 }
 
-// ClearMemberships clears the "memberships" edge to the RoomMembership entity.
-func (m *RoomMutation) ClearMemberships() {
-	m.clearedmemberships = true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FavouriteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case favourite.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case favourite.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// MembershipsCleared reports if the "memberships" edge to the RoomMembership entity was cleared.
-func (m *RoomMutation) MembershipsCleared() bool {
-	return m.clearedmemberships
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FavouriteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// RemoveMembershipIDs removes the "memberships" edge to the RoomMembership entity by IDs.
-func (m *RoomMutation) RemoveMembershipIDs(ids ...int) {
-	if m.removedmemberships == nil {
-		m.removedmemberships = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.memberships, ids[i])
-		m.removedmemberships[ids[i]] = struct{}{}
-	}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FavouriteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemovedMemberships returns the removed IDs of the "memberships" edge to the RoomMembership entity.
-func (m *RoomMutation) RemovedMembershipsIDs() (ids []int) {
-	for id := range m.removedmemberships {
-		ids = append(ids, id)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FavouriteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, favourite.EdgeUser)
 	}
-	return
+	if m.clearedroom {
+		edges = append(edges, favourite.EdgeRoom)
+	}
+	return edges
 }
 
-// MembershipsIDs returns the "memberships" edge IDs in the mutation.
-func (m *RoomMutation) MembershipsIDs() (ids []int) {
-	for id := range m.memberships {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FavouriteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case favourite.EdgeUser:
+		return m.cleareduser
+	case favourite.EdgeRoom:
+		return m.clearedroom
 	}
-	return
+	return false
 }
 
-// ResetMemberships resets all changes to the "memberships" edge.
-func (m *RoomMutation) ResetMemberships() {
-	m.memberships = nil
-	m.clearedmemberships = false
-	m.removedmemberships = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FavouriteMutation) ClearEdge(name string) error {
+	switch name {
+	case favourite.EdgeUser:
+		m.ClearUser()
+		return nil
+	case favourite.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	}
+	return fmt.Errorf("unknown Favourite unique edge %s", name)
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *RoomMutation) AddMessageIDs(ids ...int) {
-	if m.messages == nil {
-		m.messages = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FavouriteMutation) ResetEdge(name string) error {
+	switch name {
+	case favourite.EdgeUser:
+		m.ResetUser()
+		return nil
+	case favourite.EdgeRoom:
+		m.ResetRoom()
+		return nil
 	}
+	return fmt.Errorf("unknown Favourite edge %s", name)
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *RoomMutation) ClearMessages() {
-	m.clearedmessages = true
+// IdentityMutation represents an operation that mutates the Identity nodes in the graph.
+type IdentityMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	issuer        *string
+	subject       *string
+	email         *string
+	created_at    *time.Time
+	last_login_at *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Identity, error)
+	predicates    []predicate.Identity
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *RoomMutation) MessagesCleared() bool {
-	return m.clearedmessages
-}
+var _ ent.Mutation = (*IdentityMutation)(nil)
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *RoomMutation) RemoveMessageIDs(ids ...int) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[int]struct{})
+// identityOption allows management of the mutation configuration using functional options.
+type identityOption func(*IdentityMutation)
+
+// newIdentityMutation creates new mutation for the Identity entity.
+func newIdentityMutation(c config, op Op, opts ...identityOption) *IdentityMutation {
+	m := &IdentityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdentity,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *RoomMutation) RemovedMessagesIDs() (ids []int) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+// withIdentityID sets the ID field of the mutation.
+func withIdentityID(id int) identityOption {
+	return func(m *IdentityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Identity
+		)
+		m.oldValue = func(ctx context.Context) (*Identity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Identity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *RoomMutation) MessagesIDs() (ids []int) {
-	for id := range m.messages {
-		ids = append(ids, id)
+// withIdentity sets the old Identity of the mutation.
+func withIdentity(node *Identity) identityOption {
+	return func(m *IdentityMutation) {
+		m.oldValue = func(context.Context) (*Identity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *RoomMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdentityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddFavouriteIDs adds the "favourites" edge to the Favourite entity by ids.
-func (m *RoomMutation) AddFavouriteIDs(ids ...int) {
-	if m.favourites == nil {
-		m.favourites = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.favourites[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdentityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearFavourites clears the "favourites" edge to the Favourite entity.
-func (m *RoomMutation) ClearFavourites() {
-	m.clearedfavourites = true
-}
-
-// FavouritesCleared reports if the "favourites" edge to the Favourite entity was cleared.
-func (m *RoomMutation) FavouritesCleared() bool {
-	return m.clearedfavourites
-}
-
-// RemoveFavouriteIDs removes the "favourites" edge to the Favourite entity by IDs.
-func (m *RoomMutation) RemoveFavouriteIDs(ids ...int) {
-	if m.removedfavourites == nil {
-		m.removedfavourites = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.favourites, ids[i])
-		m.removedfavourites[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdentityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// RemovedFavourites returns the removed IDs of the "favourites" edge to the Favourite entity.
-func (m *RoomMutation) RemovedFavouritesIDs() (ids []int) {
-	for id := range m.removedfavourites {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdentityMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Identity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// FavouritesIDs returns the "favourites" edge IDs in the mutation.
-func (m *RoomMutation) FavouritesIDs() (ids []int) {
-	for id := range m.favourites {
-		ids = append(ids, id)
-	}
-	return
+// SetIssuer sets the "issuer" field.
+func (m *IdentityMutation) SetIssuer(s string) {
+	m.issuer = &s
 }
 
-// ResetFavourites resets all changes to the "favourites" edge.
-func (m *RoomMutation) ResetFavourites() {
-	m.favourites = nil
-	m.clearedfavourites = false
-	m.removedfavourites = nil
+// Issuer returns the value of the "issuer" field in the mutation.
+func (m *IdentityMutation) Issuer() (r string, exists bool) {
+	v := m.issuer
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddCallLogIDs adds the "call_logs" edge to the CallLog entity by ids.
-func (m *RoomMutation) AddCallLogIDs(ids ...int) {
-	if m.call_logs == nil {
-		m.call_logs = make(map[int]struct{})
+// OldIssuer returns the old "issuer" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldIssuer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuer is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.call_logs[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuer: %w", err)
 	}
+	return oldValue.Issuer, nil
 }
 
-// ClearCallLogs clears the "call_logs" edge to the CallLog entity.
-func (m *RoomMutation) ClearCallLogs() {
-	m.clearedcall_logs = true
+// ResetIssuer resets all changes to the "issuer" field.
+func (m *IdentityMutation) ResetIssuer() {
+	m.issuer = nil
 }
 
-// CallLogsCleared reports if the "call_logs" edge to the CallLog entity was cleared.
-func (m *RoomMutation) CallLogsCleared() bool {
-	return m.clearedcall_logs
+// SetSubject sets the "subject" field.
+func (m *IdentityMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// RemoveCallLogIDs removes the "call_logs" edge to the CallLog entity by IDs.
-func (m *RoomMutation) RemoveCallLogIDs(ids ...int) {
-	if m.removedcall_logs == nil {
-		m.removedcall_logs = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.call_logs, ids[i])
-		m.removedcall_logs[ids[i]] = struct{}{}
+// Subject returns the value of the "subject" field in the mutation.
+func (m *IdentityMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedCallLogs returns the removed IDs of the "call_logs" edge to the CallLog entity.
-func (m *RoomMutation) RemovedCallLogsIDs() (ids []int) {
-	for id := range m.removedcall_logs {
-		ids = append(ids, id)
+// OldSubject returns the old "subject" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// CallLogsIDs returns the "call_logs" edge IDs in the mutation.
-func (m *RoomMutation) CallLogsIDs() (ids []int) {
-	for id := range m.call_logs {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
 }
 
-// ResetCallLogs resets all changes to the "call_logs" edge.
-func (m *RoomMutation) ResetCallLogs() {
-	m.call_logs = nil
-	m.clearedcall_logs = false
-	m.removedcall_logs = nil
+// ResetSubject resets all changes to the "subject" field.
+func (m *IdentityMutation) ResetSubject() {
+	m.subject = nil
 }
 
-// Where appends a list predicates to the RoomMutation builder.
-func (m *RoomMutation) Where(ps ...predicate.Room) {
-	m.predicates = append(m.predicates, ps...)
+// SetEmail sets the "email" field.
+func (m *IdentityMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// WhereP appends storage-level predicates to the RoomMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoomMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Room, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Email returns the value of the "email" field in the mutation.
+func (m *IdentityMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *RoomMutation) Op() Op {
-	return m.op
+// OldEmail returns the old "email" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *IdentityMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdentityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdentityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdentityMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (m *IdentityMutation) SetLastLoginAt(t time.Time) {
+	m.last_login_at = &t
+}
+
+// LastLoginAt returns the value of the "last_login_at" field in the mutation.
+func (m *IdentityMutation) LastLoginAt() (r time.Time, exists bool) {
+	v := m.last_login_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastLoginAt returns the old "last_login_at" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldLastLoginAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
+	}
+	return oldValue.LastLoginAt, nil
+}
+
+// ResetLastLoginAt resets all changes to the "last_login_at" field.
+func (m *IdentityMutation) ResetLastLoginAt() {
+	m.last_login_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *IdentityMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *IdentityMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *IdentityMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *IdentityMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *IdentityMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *IdentityMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the IdentityMutation builder.
+func (m *IdentityMutation) Where(ps ...predicate.Identity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdentityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdentityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Identity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdentityMutation) Op() Op {
+	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoomMutation) SetOp(op Op) {
+func (m *IdentityMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Room).
-func (m *RoomMutation) Type() string {
+// Type returns the node type of this mutation (Identity).
+func (m *IdentityMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoomMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, room.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, room.FieldDescription)
+func (m *IdentityMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.issuer != nil {
+		fields = append(fields, identity.FieldIssuer)
 	}
-	if m.is_private != nil {
-		fields = append(fields, room.FieldIsPrivate)
+	if m.subject != nil {
+		fields = append(fields, identity.FieldSubject)
 	}
-	if m.is_direct != nil {
-		fields = append(fields, room.FieldIsDirect)
+	if m.email != nil {
+		fields = append(fields, identity.FieldEmail)
 	}
 	if m.created_at != nil {
-		fields = append(fields, room.FieldCreatedAt)
+		fields = append(fields, identity.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, room.FieldUpdatedAt)
+	if m.last_login_at != nil {
+		fields = append(fields, identity.FieldLastLoginAt)
 	}
 	return fields
 }
@@ -5474,20 +6449,18 @@ func (m *RoomMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoomMutation) Field(name string) (ent.Value, bool) {
+func (m *IdentityMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case room.FieldName:
-		return m.Name()
-	case room.FieldDescription:
-		return m.Description()
-	case room.FieldIsPrivate:
-		return m.IsPrivate()
-	case room.FieldIsDirect:
-		return m.IsDirect()
-	case room.FieldCreatedAt:
+	case identity.FieldIssuer:
+		return m.Issuer()
+	case identity.FieldSubject:
+		return m.Subject()
+	case identity.FieldEmail:
+		return m.Email()
+	case identity.FieldCreatedAt:
 		return m.CreatedAt()
-	case room.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case identity.FieldLastLoginAt:
+		return m.LastLoginAt()
 	}
 	return nil, false
 }
@@ -5495,354 +6468,231 @@ func (m *RoomMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoomMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *IdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case room.FieldName:
-		return m.OldName(ctx)
-	case room.FieldDescription:
-		return m.OldDescription(ctx)
-	case room.FieldIsPrivate:
-		return m.OldIsPrivate(ctx)
-	case room.FieldIsDirect:
-		return m.OldIsDirect(ctx)
-	case room.FieldCreatedAt:
+	case identity.FieldIssuer:
+		return m.OldIssuer(ctx)
+	case identity.FieldSubject:
+		return m.OldSubject(ctx)
+	case identity.FieldEmail:
+		return m.OldEmail(ctx)
+	case identity.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case room.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case identity.FieldLastLoginAt:
+		return m.OldLastLoginAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Room field %s", name)
+	return nil, fmt.Errorf("unknown Identity field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMutation) SetField(name string, value ent.Value) error {
+func (m *IdentityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case room.FieldName:
+	case identity.FieldIssuer:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetIssuer(v)
 		return nil
-	case room.FieldDescription:
+	case identity.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
-		return nil
-	case room.FieldIsPrivate:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsPrivate(v)
+		m.SetSubject(v)
 		return nil
-	case room.FieldIsDirect:
-		v, ok := value.(bool)
+	case identity.FieldEmail:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDirect(v)
+		m.SetEmail(v)
 		return nil
-	case room.FieldCreatedAt:
+	case identity.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case room.FieldUpdatedAt:
+	case identity.FieldLastLoginAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetLastLoginAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Room field %s", name)
+	return fmt.Errorf("unknown Identity field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoomMutation) AddedFields() []string {
+func (m *IdentityMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoomMutation) AddedField(name string) (ent.Value, bool) {
+func (m *IdentityMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMutation) AddField(name string, value ent.Value) error {
+func (m *IdentityMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Room numeric field %s", name)
+	return fmt.Errorf("unknown Identity numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoomMutation) ClearedFields() []string {
+func (m *IdentityMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoomMutation) FieldCleared(name string) bool {
+func (m *IdentityMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoomMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Room nullable field %s", name)
+func (m *IdentityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Identity nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoomMutation) ResetField(name string) error {
+func (m *IdentityMutation) ResetField(name string) error {
 	switch name {
-	case room.FieldName:
-		m.ResetName()
-		return nil
-	case room.FieldDescription:
-		m.ResetDescription()
+	case identity.FieldIssuer:
+		m.ResetIssuer()
 		return nil
-	case room.FieldIsPrivate:
-		m.ResetIsPrivate()
+	case identity.FieldSubject:
+		m.ResetSubject()
 		return nil
-	case room.FieldIsDirect:
-		m.ResetIsDirect()
+	case identity.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case room.FieldCreatedAt:
+	case identity.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case room.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case identity.FieldLastLoginAt:
+		m.ResetLastLoginAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Room field %s", name)
+	return fmt.Errorf("unknown Identity field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoomMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.owner != nil {
-		edges = append(edges, room.EdgeOwner)
-	}
-	if m.memberships != nil {
-		edges = append(edges, room.EdgeMemberships)
-	}
-	if m.messages != nil {
-		edges = append(edges, room.EdgeMessages)
-	}
-	if m.favourites != nil {
-		edges = append(edges, room.EdgeFavourites)
-	}
-	if m.call_logs != nil {
-		edges = append(edges, room.EdgeCallLogs)
+func (m *IdentityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, identity.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoomMutation) AddedIDs(name string) []ent.Value {
+func (m *IdentityMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case room.EdgeOwner:
-		if id := m.owner; id != nil {
+	case identity.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case room.EdgeMemberships:
-		ids := make([]ent.Value, 0, len(m.memberships))
-		for id := range m.memberships {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeFavourites:
-		ids := make([]ent.Value, 0, len(m.favourites))
-		for id := range m.favourites {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeCallLogs:
-		ids := make([]ent.Value, 0, len(m.call_logs))
-		for id := range m.call_logs {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoomMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedmemberships != nil {
-		edges = append(edges, room.EdgeMemberships)
-	}
-	if m.removedmessages != nil {
-		edges = append(edges, room.EdgeMessages)
-	}
-	if m.removedfavourites != nil {
-		edges = append(edges, room.EdgeFavourites)
-	}
-	if m.removedcall_logs != nil {
-		edges = append(edges, room.EdgeCallLogs)
-	}
+func (m *IdentityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoomMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case room.EdgeMemberships:
-		ids := make([]ent.Value, 0, len(m.removedmemberships))
-		for id := range m.removedmemberships {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeFavourites:
-		ids := make([]ent.Value, 0, len(m.removedfavourites))
-		for id := range m.removedfavourites {
-			ids = append(ids, id)
-		}
-		return ids
-	case room.EdgeCallLogs:
-		ids := make([]ent.Value, 0, len(m.removedcall_logs))
-		for id := range m.removedcall_logs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *IdentityMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoomMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedowner {
-		edges = append(edges, room.EdgeOwner)
-	}
-	if m.clearedmemberships {
-		edges = append(edges, room.EdgeMemberships)
-	}
-	if m.clearedmessages {
-		edges = append(edges, room.EdgeMessages)
-	}
-	if m.clearedfavourites {
-		edges = append(edges, room.EdgeFavourites)
-	}
-	if m.clearedcall_logs {
-		edges = append(edges, room.EdgeCallLogs)
+func (m *IdentityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, identity.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoomMutation) EdgeCleared(name string) bool {
+func (m *IdentityMutation) EdgeCleared(name string) bool {
 	switch name {
-	case room.EdgeOwner:
-		return m.clearedowner
-	case room.EdgeMemberships:
-		return m.clearedmemberships
-	case room.EdgeMessages:
-		return m.clearedmessages
-	case room.EdgeFavourites:
-		return m.clearedfavourites
-	case room.EdgeCallLogs:
-		return m.clearedcall_logs
+	case identity.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoomMutation) ClearEdge(name string) error {
+func (m *IdentityMutation) ClearEdge(name string) error {
 	switch name {
-	case room.EdgeOwner:
-		m.ClearOwner()
+	case identity.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Room unique edge %s", name)
+	return fmt.Errorf("unknown Identity unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoomMutation) ResetEdge(name string) error {
+func (m *IdentityMutation) ResetEdge(name string) error {
 	switch name {
-	case room.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case room.EdgeMemberships:
-		m.ResetMemberships()
-		return nil
-	case room.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	case room.EdgeFavourites:
-		m.ResetFavourites()
-		return nil
-	case room.EdgeCallLogs:
-		m.ResetCallLogs()
+	case identity.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Room edge %s", name)
+	return fmt.Errorf("unknown Identity edge %s", name)
 }
 
-// RoomMembershipMutation represents an operation that mutates the RoomMembership nodes in the graph.
-type RoomMembershipMutation struct {
+// IdentityKeyMutation represents an operation that mutates the IdentityKey nodes in the graph.
+type IdentityKeyMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	role          *roommembership.Role
-	can_post      *bool
-	can_call      *bool
-	joined_at     *time.Time
-	updated_at    *time.Time
+	public_key    *string
+	created_at    *time.Time
 	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	room          *int
-	clearedroom   bool
+	device        *int
+	cleareddevice bool
 	done          bool
-	oldValue      func(context.Context) (*RoomMembership, error)
-	predicates    []predicate.RoomMembership
+	oldValue      func(context.Context) (*IdentityKey, error)
+	predicates    []predicate.IdentityKey
 }
 
-var _ ent.Mutation = (*RoomMembershipMutation)(nil)
+var _ ent.Mutation = (*IdentityKeyMutation)(nil)
 
-// roommembershipOption allows management of the mutation configuration using functional options.
-type roommembershipOption func(*RoomMembershipMutation)
+// identitykeyOption allows management of the mutation configuration using functional options.
+type identitykeyOption func(*IdentityKeyMutation)
 
-// newRoomMembershipMutation creates new mutation for the RoomMembership entity.
-func newRoomMembershipMutation(c config, op Op, opts ...roommembershipOption) *RoomMembershipMutation {
-	m := &RoomMembershipMutation{
+// newIdentityKeyMutation creates new mutation for the IdentityKey entity.
+func newIdentityKeyMutation(c config, op Op, opts ...identitykeyOption) *IdentityKeyMutation {
+	m := &IdentityKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRoomMembership,
+		typ:           TypeIdentityKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5851,20 +6701,20 @@ func newRoomMembershipMutation(c config, op Op, opts ...roommembershipOption) *R
 	return m
 }
 
-// withRoomMembershipID sets the ID field of the mutation.
-func withRoomMembershipID(id int) roommembershipOption {
-	return func(m *RoomMembershipMutation) {
+// withIdentityKeyID sets the ID field of the mutation.
+func withIdentityKeyID(id int) identitykeyOption {
+	return func(m *IdentityKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RoomMembership
+			value *IdentityKey
 		)
-		m.oldValue = func(ctx context.Context) (*RoomMembership, error) {
+		m.oldValue = func(ctx context.Context) (*IdentityKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RoomMembership.Get(ctx, id)
+					value, err = m.Client().IdentityKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5873,10 +6723,10 @@ func withRoomMembershipID(id int) roommembershipOption {
 	}
 }
 
-// withRoomMembership sets the old RoomMembership of the mutation.
-func withRoomMembership(node *RoomMembership) roommembershipOption {
-	return func(m *RoomMembershipMutation) {
-		m.oldValue = func(context.Context) (*RoomMembership, error) {
+// withIdentityKey sets the old IdentityKey of the mutation.
+func withIdentityKey(node *IdentityKey) identitykeyOption {
+	return func(m *IdentityKeyMutation) {
+		m.oldValue = func(context.Context) (*IdentityKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5885,7 +6735,7 @@ func withRoomMembership(node *RoomMembership) roommembershipOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoomMembershipMutation) Client() *Client {
+func (m IdentityKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5893,7 +6743,7 @@ func (m RoomMembershipMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoomMembershipMutation) Tx() (*Tx, error) {
+func (m IdentityKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5904,7 +6754,7 @@ func (m RoomMembershipMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RoomMembershipMutation) ID() (id int, exists bool) {
+func (m *IdentityKeyMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5915,7 +6765,7 @@ func (m *RoomMembershipMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RoomMembershipMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *IdentityKeyMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5924,338 +6774,176 @@ func (m *RoomMembershipMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RoomMembership.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().IdentityKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRole sets the "role" field.
-func (m *RoomMembershipMutation) SetRole(r roommembership.Role) {
-	m.role = &r
+// SetPublicKey sets the "public_key" field.
+func (m *IdentityKeyMutation) SetPublicKey(s string) {
+	m.public_key = &s
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *RoomMembershipMutation) Role() (r roommembership.Role, exists bool) {
-	v := m.role
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *IdentityKeyMutation) PublicKey() (r string, exists bool) {
+	v := m.public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the RoomMembership entity.
-// If the RoomMembership object wasn't provided to the builder, the object is fetched from the database.
+// OldPublicKey returns the old "public_key" field's value of the IdentityKey entity.
+// If the IdentityKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMembershipMutation) OldRole(ctx context.Context) (v roommembership.Role, err error) {
+func (m *IdentityKeyMutation) OldPublicKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.PublicKey, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *RoomMembershipMutation) ResetRole() {
-	m.role = nil
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *IdentityKeyMutation) ResetPublicKey() {
+	m.public_key = nil
 }
 
-// SetCanPost sets the "can_post" field.
-func (m *RoomMembershipMutation) SetCanPost(b bool) {
-	m.can_post = &b
+// SetCreatedAt sets the "created_at" field.
+func (m *IdentityKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// CanPost returns the value of the "can_post" field in the mutation.
-func (m *RoomMembershipMutation) CanPost() (r bool, exists bool) {
-	v := m.can_post
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdentityKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCanPost returns the old "can_post" field's value of the RoomMembership entity.
-// If the RoomMembership object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the IdentityKey entity.
+// If the IdentityKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMembershipMutation) OldCanPost(ctx context.Context) (v bool, err error) {
+func (m *IdentityKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCanPost is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCanPost requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCanPost: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.CanPost, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetCanPost resets all changes to the "can_post" field.
-func (m *RoomMembershipMutation) ResetCanPost() {
-	m.can_post = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdentityKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCanCall sets the "can_call" field.
-func (m *RoomMembershipMutation) SetCanCall(b bool) {
-	m.can_call = &b
+// SetDeviceID sets the "device" edge to the Device entity by id.
+func (m *IdentityKeyMutation) SetDeviceID(id int) {
+	m.device = &id
 }
 
-// CanCall returns the value of the "can_call" field in the mutation.
-func (m *RoomMembershipMutation) CanCall() (r bool, exists bool) {
-	v := m.can_call
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearDevice clears the "device" edge to the Device entity.
+func (m *IdentityKeyMutation) ClearDevice() {
+	m.cleareddevice = true
 }
 
-// OldCanCall returns the old "can_call" field's value of the RoomMembership entity.
-// If the RoomMembership object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMembershipMutation) OldCanCall(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCanCall is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCanCall requires an ID field in the mutation")
+// DeviceCleared reports if the "device" edge to the Device entity was cleared.
+func (m *IdentityKeyMutation) DeviceCleared() bool {
+	return m.cleareddevice
+}
+
+// DeviceID returns the "device" edge ID in the mutation.
+func (m *IdentityKeyMutation) DeviceID() (id int, exists bool) {
+	if m.device != nil {
+		return *m.device, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCanCall: %w", err)
+	return
+}
+
+// DeviceIDs returns the "device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeviceID instead. It exists only for internal usage by the builders.
+func (m *IdentityKeyMutation) DeviceIDs() (ids []int) {
+	if id := m.device; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.CanCall, nil
+	return
 }
 
-// ResetCanCall resets all changes to the "can_call" field.
-func (m *RoomMembershipMutation) ResetCanCall() {
-	m.can_call = nil
+// ResetDevice resets all changes to the "device" edge.
+func (m *IdentityKeyMutation) ResetDevice() {
+	m.device = nil
+	m.cleareddevice = false
 }
 
-// SetJoinedAt sets the "joined_at" field.
-func (m *RoomMembershipMutation) SetJoinedAt(t time.Time) {
-	m.joined_at = &t
+// Where appends a list predicates to the IdentityKeyMutation builder.
+func (m *IdentityKeyMutation) Where(ps ...predicate.IdentityKey) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// JoinedAt returns the value of the "joined_at" field in the mutation.
-func (m *RoomMembershipMutation) JoinedAt() (r time.Time, exists bool) {
-	v := m.joined_at
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the IdentityKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdentityKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdentityKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldJoinedAt returns the old "joined_at" field's value of the RoomMembership entity.
-// If the RoomMembership object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMembershipMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
-	}
-	return oldValue.JoinedAt, nil
+// Op returns the operation name.
+func (m *IdentityKeyMutation) Op() Op {
+	return m.op
 }
 
-// ResetJoinedAt resets all changes to the "joined_at" field.
-func (m *RoomMembershipMutation) ResetJoinedAt() {
-	m.joined_at = nil
+// SetOp allows setting the mutation operation.
+func (m *IdentityKeyMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RoomMembershipMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// Type returns the node type of this mutation (IdentityKey).
+func (m *IdentityKeyMutation) Type() string {
+	return m.typ
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RoomMembershipMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdentityKeyMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.public_key != nil {
+		fields = append(fields, identitykey.FieldPublicKey)
 	}
-	return *v, true
+	if m.created_at != nil {
+		fields = append(fields, identitykey.FieldCreatedAt)
+	}
+	return fields
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the RoomMembership entity.
-// If the RoomMembership object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoomMembershipMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RoomMembershipMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *RoomMembershipMutation) SetUserID(id int) {
-	m.user = &id
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *RoomMembershipMutation) ClearUser() {
-	m.cleareduser = true
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *RoomMembershipMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserID returns the "user" edge ID in the mutation.
-func (m *RoomMembershipMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *RoomMembershipMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *RoomMembershipMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// SetRoomID sets the "room" edge to the Room entity by id.
-func (m *RoomMembershipMutation) SetRoomID(id int) {
-	m.room = &id
-}
-
-// ClearRoom clears the "room" edge to the Room entity.
-func (m *RoomMembershipMutation) ClearRoom() {
-	m.clearedroom = true
-}
-
-// RoomCleared reports if the "room" edge to the Room entity was cleared.
-func (m *RoomMembershipMutation) RoomCleared() bool {
-	return m.clearedroom
-}
-
-// RoomID returns the "room" edge ID in the mutation.
-func (m *RoomMembershipMutation) RoomID() (id int, exists bool) {
-	if m.room != nil {
-		return *m.room, true
-	}
-	return
-}
-
-// RoomIDs returns the "room" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RoomID instead. It exists only for internal usage by the builders.
-func (m *RoomMembershipMutation) RoomIDs() (ids []int) {
-	if id := m.room; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetRoom resets all changes to the "room" edge.
-func (m *RoomMembershipMutation) ResetRoom() {
-	m.room = nil
-	m.clearedroom = false
-}
-
-// Where appends a list predicates to the RoomMembershipMutation builder.
-func (m *RoomMembershipMutation) Where(ps ...predicate.RoomMembership) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the RoomMembershipMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoomMembershipMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RoomMembership, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *RoomMembershipMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *RoomMembershipMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (RoomMembership).
-func (m *RoomMembershipMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RoomMembershipMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.role != nil {
-		fields = append(fields, roommembership.FieldRole)
-	}
-	if m.can_post != nil {
-		fields = append(fields, roommembership.FieldCanPost)
-	}
-	if m.can_call != nil {
-		fields = append(fields, roommembership.FieldCanCall)
-	}
-	if m.joined_at != nil {
-		fields = append(fields, roommembership.FieldJoinedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, roommembership.FieldUpdatedAt)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *RoomMembershipMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case roommembership.FieldRole:
-		return m.Role()
-	case roommembership.FieldCanPost:
-		return m.CanPost()
-	case roommembership.FieldCanCall:
-		return m.CanCall()
-	case roommembership.FieldJoinedAt:
-		return m.JoinedAt()
-	case roommembership.FieldUpdatedAt:
-		return m.UpdatedAt()
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdentityKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case identitykey.FieldPublicKey:
+		return m.PublicKey()
+	case identitykey.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -6263,152 +6951,109 @@ func (m *RoomMembershipMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoomMembershipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *IdentityKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case roommembership.FieldRole:
-		return m.OldRole(ctx)
-	case roommembership.FieldCanPost:
-		return m.OldCanPost(ctx)
-	case roommembership.FieldCanCall:
-		return m.OldCanCall(ctx)
-	case roommembership.FieldJoinedAt:
-		return m.OldJoinedAt(ctx)
-	case roommembership.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case identitykey.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case identitykey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RoomMembership field %s", name)
+	return nil, fmt.Errorf("unknown IdentityKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMembershipMutation) SetField(name string, value ent.Value) error {
+func (m *IdentityKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case roommembership.FieldRole:
-		v, ok := value.(roommembership.Role)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRole(v)
-		return nil
-	case roommembership.FieldCanPost:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCanPost(v)
-		return nil
-	case roommembership.FieldCanCall:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCanCall(v)
-		return nil
-	case roommembership.FieldJoinedAt:
-		v, ok := value.(time.Time)
+	case identitykey.FieldPublicKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJoinedAt(v)
+		m.SetPublicKey(v)
 		return nil
-	case roommembership.FieldUpdatedAt:
+	case identitykey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMembership field %s", name)
+	return fmt.Errorf("unknown IdentityKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoomMembershipMutation) AddedFields() []string {
+func (m *IdentityKeyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoomMembershipMutation) AddedField(name string) (ent.Value, bool) {
+func (m *IdentityKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoomMembershipMutation) AddField(name string, value ent.Value) error {
+func (m *IdentityKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown RoomMembership numeric field %s", name)
+	return fmt.Errorf("unknown IdentityKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoomMembershipMutation) ClearedFields() []string {
+func (m *IdentityKeyMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoomMembershipMutation) FieldCleared(name string) bool {
+func (m *IdentityKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoomMembershipMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown RoomMembership nullable field %s", name)
+func (m *IdentityKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IdentityKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoomMembershipMutation) ResetField(name string) error {
+func (m *IdentityKeyMutation) ResetField(name string) error {
 	switch name {
-	case roommembership.FieldRole:
-		m.ResetRole()
-		return nil
-	case roommembership.FieldCanPost:
-		m.ResetCanPost()
-		return nil
-	case roommembership.FieldCanCall:
-		m.ResetCanCall()
-		return nil
-	case roommembership.FieldJoinedAt:
-		m.ResetJoinedAt()
+	case identitykey.FieldPublicKey:
+		m.ResetPublicKey()
 		return nil
-	case roommembership.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case identitykey.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMembership field %s", name)
+	return fmt.Errorf("unknown IdentityKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoomMembershipMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, roommembership.EdgeUser)
-	}
-	if m.room != nil {
-		edges = append(edges, roommembership.EdgeRoom)
+func (m *IdentityKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.device != nil {
+		edges = append(edges, identitykey.EdgeDevice)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoomMembershipMutation) AddedIDs(name string) []ent.Value {
+func (m *IdentityKeyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case roommembership.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case roommembership.EdgeRoom:
-		if id := m.room; id != nil {
+	case identitykey.EdgeDevice:
+		if id := m.device; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -6416,126 +7061,89 @@ func (m *RoomMembershipMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoomMembershipMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *IdentityKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoomMembershipMutation) RemovedIDs(name string) []ent.Value {
+func (m *IdentityKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoomMembershipMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, roommembership.EdgeUser)
-	}
-	if m.clearedroom {
-		edges = append(edges, roommembership.EdgeRoom)
+func (m *IdentityKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddevice {
+		edges = append(edges, identitykey.EdgeDevice)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoomMembershipMutation) EdgeCleared(name string) bool {
+func (m *IdentityKeyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case roommembership.EdgeUser:
-		return m.cleareduser
-	case roommembership.EdgeRoom:
-		return m.clearedroom
+	case identitykey.EdgeDevice:
+		return m.cleareddevice
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoomMembershipMutation) ClearEdge(name string) error {
+func (m *IdentityKeyMutation) ClearEdge(name string) error {
 	switch name {
-	case roommembership.EdgeUser:
-		m.ClearUser()
-		return nil
-	case roommembership.EdgeRoom:
-		m.ClearRoom()
+	case identitykey.EdgeDevice:
+		m.ClearDevice()
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMembership unique edge %s", name)
+	return fmt.Errorf("unknown IdentityKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RoomMembershipMutation) ResetEdge(name string) error {
+func (m *IdentityKeyMutation) ResetEdge(name string) error {
 	switch name {
-	case roommembership.EdgeUser:
-		m.ResetUser()
-		return nil
-	case roommembership.EdgeRoom:
-		m.ResetRoom()
+	case identitykey.EdgeDevice:
+		m.ResetDevice()
 		return nil
 	}
-	return fmt.Errorf("unknown RoomMembership edge %s", name)
+	return fmt.Errorf("unknown IdentityKey edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// IdentityKeyRecordMutation represents an operation that mutates the IdentityKeyRecord nodes in the graph.
+type IdentityKeyRecordMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *int
-	username                   *string
-	display_name               *string
-	email                      *string
-	avatar_url                 *string
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	last_seen_at               *time.Time
-	clearedFields              map[string]struct{}
-	memberships                map[int]struct{}
-	removedmemberships         map[int]struct{}
-	clearedmemberships         bool
-	messages                   map[int]struct{}
-	removedmessages            map[int]struct{}
-	clearedmessages            bool
-	uploaded_media             map[int]struct{}
-	removeduploaded_media      map[int]struct{}
-	cleareduploaded_media      bool
-	owned_rooms                map[int]struct{}
-	removedowned_rooms         map[int]struct{}
-	clearedowned_rooms         bool
-	contacts                   map[int]struct{}
-	removedcontacts            map[int]struct{}
-	clearedcontacts            bool
-	contact_entries            map[int]struct{}
-	removedcontact_entries     map[int]struct{}
-	clearedcontact_entries     bool
-	favourites                 map[int]struct{}
-	removedfavourites          map[int]struct{}
-	clearedfavourites          bool
-	initiated_calls            map[int]struct{}
-	removedinitiated_calls     map[int]struct{}
-	clearedinitiated_calls     bool
-	call_participations        map[int]struct{}
-	removedcall_participations map[int]struct{}
-	clearedcall_participations bool
-	done                       bool
-	oldValue                   func(context.Context) (*User, error)
-	predicates                 []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+	op               Op
+	typ              string
+	id               *int
+	public_key       *string
+	device_number    *int
+	adddevice_number *int
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *int
+	cleareduser      bool
+	device           *int
+	cleareddevice    bool
+	done             bool
+	oldValue         func(context.Context) (*IdentityKeyRecord, error)
+	predicates       []predicate.IdentityKeyRecord
+}
+
+var _ ent.Mutation = (*IdentityKeyRecordMutation)(nil)
+
+// identitykeyrecordOption allows management of the mutation configuration using functional options.
+type identitykeyrecordOption func(*IdentityKeyRecordMutation)
+
+// newIdentityKeyRecordMutation creates new mutation for the IdentityKeyRecord entity.
+func newIdentityKeyRecordMutation(c config, op Op, opts ...identitykeyrecordOption) *IdentityKeyRecordMutation {
+	m := &IdentityKeyRecordMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeIdentityKeyRecord,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6544,20 +7152,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withIdentityKeyRecordID sets the ID field of the mutation.
+func withIdentityKeyRecordID(id int) identitykeyrecordOption {
+	return func(m *IdentityKeyRecordMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *IdentityKeyRecord
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*IdentityKeyRecord, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().IdentityKeyRecord.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6566,10 +7174,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withIdentityKeyRecord sets the old IdentityKeyRecord of the mutation.
+func withIdentityKeyRecord(node *IdentityKeyRecord) identitykeyrecordOption {
+	return func(m *IdentityKeyRecordMutation) {
+		m.oldValue = func(context.Context) (*IdentityKeyRecord, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6578,7 +7186,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m IdentityKeyRecordMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6586,7 +7194,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m IdentityKeyRecordMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6597,7 +7205,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *IdentityKeyRecordMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6608,7 +7216,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *IdentityKeyRecordMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6617,761 +7225,18544 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().IdentityKeyRecord.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// SetPublicKey sets the "public_key" field.
+func (m *IdentityKeyRecordMutation) SetPublicKey(s string) {
+	m.public_key = &s
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *IdentityKeyRecordMutation) PublicKey() (r string, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the IdentityKeyRecord entity.
+// If the IdentityKeyRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityKeyRecordMutation) OldPublicKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *IdentityKeyRecordMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetDeviceNumber sets the "device_number" field.
+func (m *IdentityKeyRecordMutation) SetDeviceNumber(i int) {
+	m.device_number = &i
+	m.adddevice_number = nil
+}
+
+// DeviceNumber returns the value of the "device_number" field in the mutation.
+func (m *IdentityKeyRecordMutation) DeviceNumber() (r int, exists bool) {
+	v := m.device_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceNumber returns the old "device_number" field's value of the IdentityKeyRecord entity.
+// If the IdentityKeyRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityKeyRecordMutation) OldDeviceNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceNumber: %w", err)
+	}
+	return oldValue.DeviceNumber, nil
+}
+
+// AddDeviceNumber adds i to the "device_number" field.
+func (m *IdentityKeyRecordMutation) AddDeviceNumber(i int) {
+	if m.adddevice_number != nil {
+		*m.adddevice_number += i
+	} else {
+		m.adddevice_number = &i
+	}
+}
+
+// AddedDeviceNumber returns the value that was added to the "device_number" field in this mutation.
+func (m *IdentityKeyRecordMutation) AddedDeviceNumber() (r int, exists bool) {
+	v := m.adddevice_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDeviceNumber clears the value of the "device_number" field.
+func (m *IdentityKeyRecordMutation) ClearDeviceNumber() {
+	m.device_number = nil
+	m.adddevice_number = nil
+	m.clearedFields[identitykeyrecord.FieldDeviceNumber] = struct{}{}
+}
+
+// DeviceNumberCleared returns if the "device_number" field was cleared in this mutation.
+func (m *IdentityKeyRecordMutation) DeviceNumberCleared() bool {
+	_, ok := m.clearedFields[identitykeyrecord.FieldDeviceNumber]
+	return ok
+}
+
+// ResetDeviceNumber resets all changes to the "device_number" field.
+func (m *IdentityKeyRecordMutation) ResetDeviceNumber() {
+	m.device_number = nil
+	m.adddevice_number = nil
+	delete(m.clearedFields, identitykeyrecord.FieldDeviceNumber)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdentityKeyRecordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdentityKeyRecordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdentityKeyRecord entity.
+// If the IdentityKeyRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityKeyRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdentityKeyRecordMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *IdentityKeyRecordMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *IdentityKeyRecordMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *IdentityKeyRecordMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *IdentityKeyRecordMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *IdentityKeyRecordMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *IdentityKeyRecordMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by id.
+func (m *IdentityKeyRecordMutation) SetDeviceID(id int) {
+	m.device = &id
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (m *IdentityKeyRecordMutation) ClearDevice() {
+	m.cleareddevice = true
+}
+
+// DeviceCleared reports if the "device" edge to the Device entity was cleared.
+func (m *IdentityKeyRecordMutation) DeviceCleared() bool {
+	return m.cleareddevice
+}
+
+// DeviceID returns the "device" edge ID in the mutation.
+func (m *IdentityKeyRecordMutation) DeviceID() (id int, exists bool) {
+	if m.device != nil {
+		return *m.device, true
+	}
+	return
+}
+
+// DeviceIDs returns the "device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeviceID instead. It exists only for internal usage by the builders.
+func (m *IdentityKeyRecordMutation) DeviceIDs() (ids []int) {
+	if id := m.device; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDevice resets all changes to the "device" edge.
+func (m *IdentityKeyRecordMutation) ResetDevice() {
+	m.device = nil
+	m.cleareddevice = false
+}
+
+// Where appends a list predicates to the IdentityKeyRecordMutation builder.
+func (m *IdentityKeyRecordMutation) Where(ps ...predicate.IdentityKeyRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdentityKeyRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdentityKeyRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdentityKeyRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdentityKeyRecordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdentityKeyRecordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdentityKeyRecord).
+func (m *IdentityKeyRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdentityKeyRecordMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.public_key != nil {
+		fields = append(fields, identitykeyrecord.FieldPublicKey)
+	}
+	if m.device_number != nil {
+		fields = append(fields, identitykeyrecord.FieldDeviceNumber)
+	}
+	if m.created_at != nil {
+		fields = append(fields, identitykeyrecord.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdentityKeyRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case identitykeyrecord.FieldPublicKey:
+		return m.PublicKey()
+	case identitykeyrecord.FieldDeviceNumber:
+		return m.DeviceNumber()
+	case identitykeyrecord.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdentityKeyRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case identitykeyrecord.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case identitykeyrecord.FieldDeviceNumber:
+		return m.OldDeviceNumber(ctx)
+	case identitykeyrecord.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdentityKeyRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdentityKeyRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case identitykeyrecord.FieldPublicKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case identitykeyrecord.FieldDeviceNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceNumber(v)
+		return nil
+	case identitykeyrecord.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdentityKeyRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdentityKeyRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.adddevice_number != nil {
+		fields = append(fields, identitykeyrecord.FieldDeviceNumber)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdentityKeyRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case identitykeyrecord.FieldDeviceNumber:
+		return m.AddedDeviceNumber()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdentityKeyRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case identitykeyrecord.FieldDeviceNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeviceNumber(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdentityKeyRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdentityKeyRecordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(identitykeyrecord.FieldDeviceNumber) {
+		fields = append(fields, identitykeyrecord.FieldDeviceNumber)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdentityKeyRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdentityKeyRecordMutation) ClearField(name string) error {
+	switch name {
+	case identitykeyrecord.FieldDeviceNumber:
+		m.ClearDeviceNumber()
+		return nil
+	}
+	return fmt.Errorf("unknown IdentityKeyRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdentityKeyRecordMutation) ResetField(name string) error {
+	switch name {
+	case identitykeyrecord.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case identitykeyrecord.FieldDeviceNumber:
+		m.ResetDeviceNumber()
+		return nil
+	case identitykeyrecord.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdentityKeyRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdentityKeyRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, identitykeyrecord.EdgeUser)
+	}
+	if m.device != nil {
+		edges = append(edges, identitykeyrecord.EdgeDevice)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdentityKeyRecordMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case identitykeyrecord.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case identitykeyrecord.EdgeDevice:
+		if id := m.device; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdentityKeyRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdentityKeyRecordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdentityKeyRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, identitykeyrecord.EdgeUser)
+	}
+	if m.cleareddevice {
+		edges = append(edges, identitykeyrecord.EdgeDevice)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdentityKeyRecordMutation) EdgeCleared(name string) bool {
+	switch name {
+	case identitykeyrecord.EdgeUser:
+		return m.cleareduser
+	case identitykeyrecord.EdgeDevice:
+		return m.cleareddevice
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdentityKeyRecordMutation) ClearEdge(name string) error {
+	switch name {
+	case identitykeyrecord.EdgeUser:
+		m.ClearUser()
+		return nil
+	case identitykeyrecord.EdgeDevice:
+		m.ClearDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown IdentityKeyRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdentityKeyRecordMutation) ResetEdge(name string) error {
+	switch name {
+	case identitykeyrecord.EdgeUser:
+		m.ResetUser()
+		return nil
+	case identitykeyrecord.EdgeDevice:
+		m.ResetDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown IdentityKeyRecord edge %s", name)
+}
+
+// KeyBackupKeyMutation represents an operation that mutates the KeyBackupKey nodes in the graph.
+type KeyBackupKeyMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	session_id             *string
+	first_message_index    *int
+	addfirst_message_index *int
+	forwarded_count        *int
+	addforwarded_count     *int
+	is_verified            *bool
+	session_data           *string
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	version                *int
+	clearedversion         bool
+	room                   *int
+	clearedroom            bool
+	done                   bool
+	oldValue               func(context.Context) (*KeyBackupKey, error)
+	predicates             []predicate.KeyBackupKey
+}
+
+var _ ent.Mutation = (*KeyBackupKeyMutation)(nil)
+
+// keybackupkeyOption allows management of the mutation configuration using functional options.
+type keybackupkeyOption func(*KeyBackupKeyMutation)
+
+// newKeyBackupKeyMutation creates new mutation for the KeyBackupKey entity.
+func newKeyBackupKeyMutation(c config, op Op, opts ...keybackupkeyOption) *KeyBackupKeyMutation {
+	m := &KeyBackupKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeyBackupKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeyBackupKeyID sets the ID field of the mutation.
+func withKeyBackupKeyID(id int) keybackupkeyOption {
+	return func(m *KeyBackupKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KeyBackupKey
+		)
+		m.oldValue = func(ctx context.Context) (*KeyBackupKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KeyBackupKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeyBackupKey sets the old KeyBackupKey of the mutation.
+func withKeyBackupKey(node *KeyBackupKey) keybackupkeyOption {
+	return func(m *KeyBackupKeyMutation) {
+		m.oldValue = func(context.Context) (*KeyBackupKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeyBackupKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeyBackupKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeyBackupKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeyBackupKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KeyBackupKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *KeyBackupKeyMutation) SetSessionID(s string) {
+	m.session_id = &s
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *KeyBackupKeyMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the KeyBackupKey entity.
+// If the KeyBackupKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyBackupKeyMutation) OldSessionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *KeyBackupKeyMutation) ResetSessionID() {
+	m.session_id = nil
+}
+
+// SetFirstMessageIndex sets the "first_message_index" field.
+func (m *KeyBackupKeyMutation) SetFirstMessageIndex(i int) {
+	m.first_message_index = &i
+	m.addfirst_message_index = nil
+}
+
+// FirstMessageIndex returns the value of the "first_message_index" field in the mutation.
+func (m *KeyBackupKeyMutation) FirstMessageIndex() (r int, exists bool) {
+	v := m.first_message_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstMessageIndex returns the old "first_message_index" field's value of the KeyBackupKey entity.
+// If the KeyBackupKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyBackupKeyMutation) OldFirstMessageIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstMessageIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstMessageIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstMessageIndex: %w", err)
+	}
+	return oldValue.FirstMessageIndex, nil
+}
+
+// AddFirstMessageIndex adds i to the "first_message_index" field.
+func (m *KeyBackupKeyMutation) AddFirstMessageIndex(i int) {
+	if m.addfirst_message_index != nil {
+		*m.addfirst_message_index += i
+	} else {
+		m.addfirst_message_index = &i
+	}
+}
+
+// AddedFirstMessageIndex returns the value that was added to the "first_message_index" field in this mutation.
+func (m *KeyBackupKeyMutation) AddedFirstMessageIndex() (r int, exists bool) {
+	v := m.addfirst_message_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFirstMessageIndex resets all changes to the "first_message_index" field.
+func (m *KeyBackupKeyMutation) ResetFirstMessageIndex() {
+	m.first_message_index = nil
+	m.addfirst_message_index = nil
+}
+
+// SetForwardedCount sets the "forwarded_count" field.
+func (m *KeyBackupKeyMutation) SetForwardedCount(i int) {
+	m.forwarded_count = &i
+	m.addforwarded_count = nil
+}
+
+// ForwardedCount returns the value of the "forwarded_count" field in the mutation.
+func (m *KeyBackupKeyMutation) ForwardedCount() (r int, exists bool) {
+	v := m.forwarded_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForwardedCount returns the old "forwarded_count" field's value of the KeyBackupKey entity.
+// If the KeyBackupKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyBackupKeyMutation) OldForwardedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForwardedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForwardedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForwardedCount: %w", err)
+	}
+	return oldValue.ForwardedCount, nil
+}
+
+// AddForwardedCount adds i to the "forwarded_count" field.
+func (m *KeyBackupKeyMutation) AddForwardedCount(i int) {
+	if m.addforwarded_count != nil {
+		*m.addforwarded_count += i
+	} else {
+		m.addforwarded_count = &i
+	}
+}
+
+// AddedForwardedCount returns the value that was added to the "forwarded_count" field in this mutation.
+func (m *KeyBackupKeyMutation) AddedForwardedCount() (r int, exists bool) {
+	v := m.addforwarded_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetForwardedCount resets all changes to the "forwarded_count" field.
+func (m *KeyBackupKeyMutation) ResetForwardedCount() {
+	m.forwarded_count = nil
+	m.addforwarded_count = nil
+}
+
+// SetIsVerified sets the "is_verified" field.
+func (m *KeyBackupKeyMutation) SetIsVerified(b bool) {
+	m.is_verified = &b
+}
+
+// IsVerified returns the value of the "is_verified" field in the mutation.
+func (m *KeyBackupKeyMutation) IsVerified() (r bool, exists bool) {
+	v := m.is_verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVerified returns the old "is_verified" field's value of the KeyBackupKey entity.
+// If the KeyBackupKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyBackupKeyMutation) OldIsVerified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVerified: %w", err)
+	}
+	return oldValue.IsVerified, nil
+}
+
+// ResetIsVerified resets all changes to the "is_verified" field.
+func (m *KeyBackupKeyMutation) ResetIsVerified() {
+	m.is_verified = nil
+}
+
+// SetSessionData sets the "session_data" field.
+func (m *KeyBackupKeyMutation) SetSessionData(s string) {
+	m.session_data = &s
+}
+
+// SessionData returns the value of the "session_data" field in the mutation.
+func (m *KeyBackupKeyMutation) SessionData() (r string, exists bool) {
+	v := m.session_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionData returns the old "session_data" field's value of the KeyBackupKey entity.
+// If the KeyBackupKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyBackupKeyMutation) OldSessionData(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionData: %w", err)
+	}
+	return oldValue.SessionData, nil
+}
+
+// ResetSessionData resets all changes to the "session_data" field.
+func (m *KeyBackupKeyMutation) ResetSessionData() {
+	m.session_data = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *KeyBackupKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *KeyBackupKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the KeyBackupKey entity.
+// If the KeyBackupKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyBackupKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *KeyBackupKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetVersionID sets the "version" edge to the KeyBackupVersion entity by id.
+func (m *KeyBackupKeyMutation) SetVersionID(id int) {
+	m.version = &id
+}
+
+// ClearVersion clears the "version" edge to the KeyBackupVersion entity.
+func (m *KeyBackupKeyMutation) ClearVersion() {
+	m.clearedversion = true
+}
+
+// VersionCleared reports if the "version" edge to the KeyBackupVersion entity was cleared.
+func (m *KeyBackupKeyMutation) VersionCleared() bool {
+	return m.clearedversion
+}
+
+// VersionID returns the "version" edge ID in the mutation.
+func (m *KeyBackupKeyMutation) VersionID() (id int, exists bool) {
+	if m.version != nil {
+		return *m.version, true
+	}
+	return
+}
+
+// VersionIDs returns the "version" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VersionID instead. It exists only for internal usage by the builders.
+func (m *KeyBackupKeyMutation) VersionIDs() (ids []int) {
+	if id := m.version; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVersion resets all changes to the "version" edge.
+func (m *KeyBackupKeyMutation) ResetVersion() {
+	m.version = nil
+	m.clearedversion = false
+}
+
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *KeyBackupKeyMutation) SetRoomID(id int) {
+	m.room = &id
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *KeyBackupKeyMutation) ClearRoom() {
+	m.clearedroom = true
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *KeyBackupKeyMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *KeyBackupKeyMutation) RoomID() (id int, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *KeyBackupKeyMutation) RoomIDs() (ids []int) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *KeyBackupKeyMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// Where appends a list predicates to the KeyBackupKeyMutation builder.
+func (m *KeyBackupKeyMutation) Where(ps ...predicate.KeyBackupKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeyBackupKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeyBackupKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KeyBackupKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeyBackupKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeyBackupKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KeyBackupKey).
+func (m *KeyBackupKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeyBackupKeyMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.session_id != nil {
+		fields = append(fields, keybackupkey.FieldSessionID)
+	}
+	if m.first_message_index != nil {
+		fields = append(fields, keybackupkey.FieldFirstMessageIndex)
+	}
+	if m.forwarded_count != nil {
+		fields = append(fields, keybackupkey.FieldForwardedCount)
+	}
+	if m.is_verified != nil {
+		fields = append(fields, keybackupkey.FieldIsVerified)
+	}
+	if m.session_data != nil {
+		fields = append(fields, keybackupkey.FieldSessionData)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, keybackupkey.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeyBackupKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keybackupkey.FieldSessionID:
+		return m.SessionID()
+	case keybackupkey.FieldFirstMessageIndex:
+		return m.FirstMessageIndex()
+	case keybackupkey.FieldForwardedCount:
+		return m.ForwardedCount()
+	case keybackupkey.FieldIsVerified:
+		return m.IsVerified()
+	case keybackupkey.FieldSessionData:
+		return m.SessionData()
+	case keybackupkey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeyBackupKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keybackupkey.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case keybackupkey.FieldFirstMessageIndex:
+		return m.OldFirstMessageIndex(ctx)
+	case keybackupkey.FieldForwardedCount:
+		return m.OldForwardedCount(ctx)
+	case keybackupkey.FieldIsVerified:
+		return m.OldIsVerified(ctx)
+	case keybackupkey.FieldSessionData:
+		return m.OldSessionData(ctx)
+	case keybackupkey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown KeyBackupKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyBackupKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keybackupkey.FieldSessionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case keybackupkey.FieldFirstMessageIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstMessageIndex(v)
+		return nil
+	case keybackupkey.FieldForwardedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForwardedCount(v)
+		return nil
+	case keybackupkey.FieldIsVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVerified(v)
+		return nil
+	case keybackupkey.FieldSessionData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionData(v)
+		return nil
+	case keybackupkey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyBackupKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeyBackupKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addfirst_message_index != nil {
+		fields = append(fields, keybackupkey.FieldFirstMessageIndex)
+	}
+	if m.addforwarded_count != nil {
+		fields = append(fields, keybackupkey.FieldForwardedCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeyBackupKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case keybackupkey.FieldFirstMessageIndex:
+		return m.AddedFirstMessageIndex()
+	case keybackupkey.FieldForwardedCount:
+		return m.AddedForwardedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyBackupKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case keybackupkey.FieldFirstMessageIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFirstMessageIndex(v)
+		return nil
+	case keybackupkey.FieldForwardedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddForwardedCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyBackupKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeyBackupKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeyBackupKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeyBackupKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown KeyBackupKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeyBackupKeyMutation) ResetField(name string) error {
+	switch name {
+	case keybackupkey.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case keybackupkey.FieldFirstMessageIndex:
+		m.ResetFirstMessageIndex()
+		return nil
+	case keybackupkey.FieldForwardedCount:
+		m.ResetForwardedCount()
+		return nil
+	case keybackupkey.FieldIsVerified:
+		m.ResetIsVerified()
+		return nil
+	case keybackupkey.FieldSessionData:
+		m.ResetSessionData()
+		return nil
+	case keybackupkey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyBackupKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeyBackupKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.version != nil {
+		edges = append(edges, keybackupkey.EdgeVersion)
+	}
+	if m.room != nil {
+		edges = append(edges, keybackupkey.EdgeRoom)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeyBackupKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case keybackupkey.EdgeVersion:
+		if id := m.version; id != nil {
+			return []ent.Value{*id}
+		}
+	case keybackupkey.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeyBackupKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeyBackupKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeyBackupKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedversion {
+		edges = append(edges, keybackupkey.EdgeVersion)
+	}
+	if m.clearedroom {
+		edges = append(edges, keybackupkey.EdgeRoom)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeyBackupKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case keybackupkey.EdgeVersion:
+		return m.clearedversion
+	case keybackupkey.EdgeRoom:
+		return m.clearedroom
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeyBackupKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case keybackupkey.EdgeVersion:
+		m.ClearVersion()
+		return nil
+	case keybackupkey.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyBackupKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeyBackupKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case keybackupkey.EdgeVersion:
+		m.ResetVersion()
+		return nil
+	case keybackupkey.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyBackupKey edge %s", name)
+}
+
+// KeyBackupVersionMutation represents an operation that mutates the KeyBackupVersion nodes in the graph.
+type KeyBackupVersionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	algorithm     *string
+	auth_data     *string
+	etag          *int
+	addetag       *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *int
+	clearedowner  bool
+	keys          map[int]struct{}
+	removedkeys   map[int]struct{}
+	clearedkeys   bool
+	done          bool
+	oldValue      func(context.Context) (*KeyBackupVersion, error)
+	predicates    []predicate.KeyBackupVersion
+}
+
+var _ ent.Mutation = (*KeyBackupVersionMutation)(nil)
+
+// keybackupversionOption allows management of the mutation configuration using functional options.
+type keybackupversionOption func(*KeyBackupVersionMutation)
+
+// newKeyBackupVersionMutation creates new mutation for the KeyBackupVersion entity.
+func newKeyBackupVersionMutation(c config, op Op, opts ...keybackupversionOption) *KeyBackupVersionMutation {
+	m := &KeyBackupVersionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeyBackupVersion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeyBackupVersionID sets the ID field of the mutation.
+func withKeyBackupVersionID(id int) keybackupversionOption {
+	return func(m *KeyBackupVersionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KeyBackupVersion
+		)
+		m.oldValue = func(ctx context.Context) (*KeyBackupVersion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KeyBackupVersion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeyBackupVersion sets the old KeyBackupVersion of the mutation.
+func withKeyBackupVersion(node *KeyBackupVersion) keybackupversionOption {
+	return func(m *KeyBackupVersionMutation) {
+		m.oldValue = func(context.Context) (*KeyBackupVersion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeyBackupVersionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeyBackupVersionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeyBackupVersionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeyBackupVersionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KeyBackupVersion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (m *KeyBackupVersionMutation) SetAlgorithm(s string) {
+	m.algorithm = &s
+}
+
+// Algorithm returns the value of the "algorithm" field in the mutation.
+func (m *KeyBackupVersionMutation) Algorithm() (r string, exists bool) {
+	v := m.algorithm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlgorithm returns the old "algorithm" field's value of the KeyBackupVersion entity.
+// If the KeyBackupVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyBackupVersionMutation) OldAlgorithm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlgorithm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlgorithm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlgorithm: %w", err)
+	}
+	return oldValue.Algorithm, nil
+}
+
+// ResetAlgorithm resets all changes to the "algorithm" field.
+func (m *KeyBackupVersionMutation) ResetAlgorithm() {
+	m.algorithm = nil
+}
+
+// SetAuthData sets the "auth_data" field.
+func (m *KeyBackupVersionMutation) SetAuthData(s string) {
+	m.auth_data = &s
+}
+
+// AuthData returns the value of the "auth_data" field in the mutation.
+func (m *KeyBackupVersionMutation) AuthData() (r string, exists bool) {
+	v := m.auth_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthData returns the old "auth_data" field's value of the KeyBackupVersion entity.
+// If the KeyBackupVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyBackupVersionMutation) OldAuthData(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthData: %w", err)
+	}
+	return oldValue.AuthData, nil
+}
+
+// ResetAuthData resets all changes to the "auth_data" field.
+func (m *KeyBackupVersionMutation) ResetAuthData() {
+	m.auth_data = nil
+}
+
+// SetEtag sets the "etag" field.
+func (m *KeyBackupVersionMutation) SetEtag(i int) {
+	m.etag = &i
+	m.addetag = nil
+}
+
+// Etag returns the value of the "etag" field in the mutation.
+func (m *KeyBackupVersionMutation) Etag() (r int, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEtag returns the old "etag" field's value of the KeyBackupVersion entity.
+// If the KeyBackupVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyBackupVersionMutation) OldEtag(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
+	}
+	return oldValue.Etag, nil
+}
+
+// AddEtag adds i to the "etag" field.
+func (m *KeyBackupVersionMutation) AddEtag(i int) {
+	if m.addetag != nil {
+		*m.addetag += i
+	} else {
+		m.addetag = &i
+	}
+}
+
+// AddedEtag returns the value that was added to the "etag" field in this mutation.
+func (m *KeyBackupVersionMutation) AddedEtag() (r int, exists bool) {
+	v := m.addetag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEtag resets all changes to the "etag" field.
+func (m *KeyBackupVersionMutation) ResetEtag() {
+	m.etag = nil
+	m.addetag = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *KeyBackupVersionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *KeyBackupVersionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the KeyBackupVersion entity.
+// If the KeyBackupVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyBackupVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *KeyBackupVersionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *KeyBackupVersionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *KeyBackupVersionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the KeyBackupVersion entity.
+// If the KeyBackupVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyBackupVersionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *KeyBackupVersionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *KeyBackupVersionMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *KeyBackupVersionMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *KeyBackupVersionMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *KeyBackupVersionMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *KeyBackupVersionMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *KeyBackupVersionMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddKeyIDs adds the "keys" edge to the KeyBackupKey entity by ids.
+func (m *KeyBackupVersionMutation) AddKeyIDs(ids ...int) {
+	if m.keys == nil {
+		m.keys = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearKeys clears the "keys" edge to the KeyBackupKey entity.
+func (m *KeyBackupVersionMutation) ClearKeys() {
+	m.clearedkeys = true
+}
+
+// KeysCleared reports if the "keys" edge to the KeyBackupKey entity was cleared.
+func (m *KeyBackupVersionMutation) KeysCleared() bool {
+	return m.clearedkeys
+}
+
+// RemoveKeyIDs removes the "keys" edge to the KeyBackupKey entity by IDs.
+func (m *KeyBackupVersionMutation) RemoveKeyIDs(ids ...int) {
+	if m.removedkeys == nil {
+		m.removedkeys = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.keys, ids[i])
+		m.removedkeys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedKeys returns the removed IDs of the "keys" edge to the KeyBackupKey entity.
+func (m *KeyBackupVersionMutation) RemovedKeysIDs() (ids []int) {
+	for id := range m.removedkeys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// KeysIDs returns the "keys" edge IDs in the mutation.
+func (m *KeyBackupVersionMutation) KeysIDs() (ids []int) {
+	for id := range m.keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetKeys resets all changes to the "keys" edge.
+func (m *KeyBackupVersionMutation) ResetKeys() {
+	m.keys = nil
+	m.clearedkeys = false
+	m.removedkeys = nil
+}
+
+// Where appends a list predicates to the KeyBackupVersionMutation builder.
+func (m *KeyBackupVersionMutation) Where(ps ...predicate.KeyBackupVersion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeyBackupVersionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeyBackupVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KeyBackupVersion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeyBackupVersionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeyBackupVersionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KeyBackupVersion).
+func (m *KeyBackupVersionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeyBackupVersionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.algorithm != nil {
+		fields = append(fields, keybackupversion.FieldAlgorithm)
+	}
+	if m.auth_data != nil {
+		fields = append(fields, keybackupversion.FieldAuthData)
+	}
+	if m.etag != nil {
+		fields = append(fields, keybackupversion.FieldEtag)
+	}
+	if m.created_at != nil {
+		fields = append(fields, keybackupversion.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, keybackupversion.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeyBackupVersionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keybackupversion.FieldAlgorithm:
+		return m.Algorithm()
+	case keybackupversion.FieldAuthData:
+		return m.AuthData()
+	case keybackupversion.FieldEtag:
+		return m.Etag()
+	case keybackupversion.FieldCreatedAt:
+		return m.CreatedAt()
+	case keybackupversion.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeyBackupVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keybackupversion.FieldAlgorithm:
+		return m.OldAlgorithm(ctx)
+	case keybackupversion.FieldAuthData:
+		return m.OldAuthData(ctx)
+	case keybackupversion.FieldEtag:
+		return m.OldEtag(ctx)
+	case keybackupversion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case keybackupversion.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown KeyBackupVersion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyBackupVersionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keybackupversion.FieldAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlgorithm(v)
+		return nil
+	case keybackupversion.FieldAuthData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthData(v)
+		return nil
+	case keybackupversion.FieldEtag:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case keybackupversion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case keybackupversion.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyBackupVersion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeyBackupVersionMutation) AddedFields() []string {
+	var fields []string
+	if m.addetag != nil {
+		fields = append(fields, keybackupversion.FieldEtag)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeyBackupVersionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case keybackupversion.FieldEtag:
+		return m.AddedEtag()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyBackupVersionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case keybackupversion.FieldEtag:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEtag(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyBackupVersion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeyBackupVersionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeyBackupVersionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeyBackupVersionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown KeyBackupVersion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeyBackupVersionMutation) ResetField(name string) error {
+	switch name {
+	case keybackupversion.FieldAlgorithm:
+		m.ResetAlgorithm()
+		return nil
+	case keybackupversion.FieldAuthData:
+		m.ResetAuthData()
+		return nil
+	case keybackupversion.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case keybackupversion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case keybackupversion.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyBackupVersion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeyBackupVersionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, keybackupversion.EdgeOwner)
+	}
+	if m.keys != nil {
+		edges = append(edges, keybackupversion.EdgeKeys)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeyBackupVersionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case keybackupversion.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case keybackupversion.EdgeKeys:
+		ids := make([]ent.Value, 0, len(m.keys))
+		for id := range m.keys {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeyBackupVersionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedkeys != nil {
+		edges = append(edges, keybackupversion.EdgeKeys)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeyBackupVersionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case keybackupversion.EdgeKeys:
+		ids := make([]ent.Value, 0, len(m.removedkeys))
+		for id := range m.removedkeys {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeyBackupVersionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, keybackupversion.EdgeOwner)
+	}
+	if m.clearedkeys {
+		edges = append(edges, keybackupversion.EdgeKeys)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeyBackupVersionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case keybackupversion.EdgeOwner:
+		return m.clearedowner
+	case keybackupversion.EdgeKeys:
+		return m.clearedkeys
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeyBackupVersionMutation) ClearEdge(name string) error {
+	switch name {
+	case keybackupversion.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyBackupVersion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeyBackupVersionMutation) ResetEdge(name string) error {
+	switch name {
+	case keybackupversion.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case keybackupversion.EdgeKeys:
+		m.ResetKeys()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyBackupVersion edge %s", name)
+}
+
+// KeyLogEntryMutation represents an operation that mutates the KeyLogEntry nodes in the graph.
+type KeyLogEntryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	leaf_index    *int
+	addleaf_index *int
+	user_id       *int
+	adduser_id    *int
+	device_id     *int
+	adddevice_id  *int
+	identity_key  *string
+	published_at  *time.Time
+	leaf_hash     *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*KeyLogEntry, error)
+	predicates    []predicate.KeyLogEntry
+}
+
+var _ ent.Mutation = (*KeyLogEntryMutation)(nil)
+
+// keylogentryOption allows management of the mutation configuration using functional options.
+type keylogentryOption func(*KeyLogEntryMutation)
+
+// newKeyLogEntryMutation creates new mutation for the KeyLogEntry entity.
+func newKeyLogEntryMutation(c config, op Op, opts ...keylogentryOption) *KeyLogEntryMutation {
+	m := &KeyLogEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeyLogEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeyLogEntryID sets the ID field of the mutation.
+func withKeyLogEntryID(id int) keylogentryOption {
+	return func(m *KeyLogEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KeyLogEntry
+		)
+		m.oldValue = func(ctx context.Context) (*KeyLogEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KeyLogEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeyLogEntry sets the old KeyLogEntry of the mutation.
+func withKeyLogEntry(node *KeyLogEntry) keylogentryOption {
+	return func(m *KeyLogEntryMutation) {
+		m.oldValue = func(context.Context) (*KeyLogEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeyLogEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeyLogEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeyLogEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeyLogEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KeyLogEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLeafIndex sets the "leaf_index" field.
+func (m *KeyLogEntryMutation) SetLeafIndex(i int) {
+	m.leaf_index = &i
+	m.addleaf_index = nil
+}
+
+// LeafIndex returns the value of the "leaf_index" field in the mutation.
+func (m *KeyLogEntryMutation) LeafIndex() (r int, exists bool) {
+	v := m.leaf_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeafIndex returns the old "leaf_index" field's value of the KeyLogEntry entity.
+// If the KeyLogEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyLogEntryMutation) OldLeafIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeafIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeafIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeafIndex: %w", err)
+	}
+	return oldValue.LeafIndex, nil
+}
+
+// AddLeafIndex adds i to the "leaf_index" field.
+func (m *KeyLogEntryMutation) AddLeafIndex(i int) {
+	if m.addleaf_index != nil {
+		*m.addleaf_index += i
+	} else {
+		m.addleaf_index = &i
+	}
+}
+
+// AddedLeafIndex returns the value that was added to the "leaf_index" field in this mutation.
+func (m *KeyLogEntryMutation) AddedLeafIndex() (r int, exists bool) {
+	v := m.addleaf_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLeafIndex resets all changes to the "leaf_index" field.
+func (m *KeyLogEntryMutation) ResetLeafIndex() {
+	m.leaf_index = nil
+	m.addleaf_index = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *KeyLogEntryMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *KeyLogEntryMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the KeyLogEntry entity.
+// If the KeyLogEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyLogEntryMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *KeyLogEntryMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *KeyLogEntryMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *KeyLogEntryMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *KeyLogEntryMutation) SetDeviceID(i int) {
+	m.device_id = &i
+	m.adddevice_id = nil
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *KeyLogEntryMutation) DeviceID() (r int, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the KeyLogEntry entity.
+// If the KeyLogEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyLogEntryMutation) OldDeviceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (m *KeyLogEntryMutation) AddDeviceID(i int) {
+	if m.adddevice_id != nil {
+		*m.adddevice_id += i
+	} else {
+		m.adddevice_id = &i
+	}
+}
+
+// AddedDeviceID returns the value that was added to the "device_id" field in this mutation.
+func (m *KeyLogEntryMutation) AddedDeviceID() (r int, exists bool) {
+	v := m.adddevice_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *KeyLogEntryMutation) ResetDeviceID() {
+	m.device_id = nil
+	m.adddevice_id = nil
+}
+
+// SetIdentityKey sets the "identity_key" field.
+func (m *KeyLogEntryMutation) SetIdentityKey(s string) {
+	m.identity_key = &s
+}
+
+// IdentityKey returns the value of the "identity_key" field in the mutation.
+func (m *KeyLogEntryMutation) IdentityKey() (r string, exists bool) {
+	v := m.identity_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdentityKey returns the old "identity_key" field's value of the KeyLogEntry entity.
+// If the KeyLogEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyLogEntryMutation) OldIdentityKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdentityKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdentityKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdentityKey: %w", err)
+	}
+	return oldValue.IdentityKey, nil
+}
+
+// ResetIdentityKey resets all changes to the "identity_key" field.
+func (m *KeyLogEntryMutation) ResetIdentityKey() {
+	m.identity_key = nil
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (m *KeyLogEntryMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
+}
+
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *KeyLogEntryMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedAt returns the old "published_at" field's value of the KeyLogEntry entity.
+// If the KeyLogEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyLogEntryMutation) OldPublishedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
+}
+
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *KeyLogEntryMutation) ResetPublishedAt() {
+	m.published_at = nil
+}
+
+// SetLeafHash sets the "leaf_hash" field.
+func (m *KeyLogEntryMutation) SetLeafHash(b []byte) {
+	m.leaf_hash = &b
+}
+
+// LeafHash returns the value of the "leaf_hash" field in the mutation.
+func (m *KeyLogEntryMutation) LeafHash() (r []byte, exists bool) {
+	v := m.leaf_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeafHash returns the old "leaf_hash" field's value of the KeyLogEntry entity.
+// If the KeyLogEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyLogEntryMutation) OldLeafHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeafHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeafHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeafHash: %w", err)
+	}
+	return oldValue.LeafHash, nil
+}
+
+// ResetLeafHash resets all changes to the "leaf_hash" field.
+func (m *KeyLogEntryMutation) ResetLeafHash() {
+	m.leaf_hash = nil
+}
+
+// Where appends a list predicates to the KeyLogEntryMutation builder.
+func (m *KeyLogEntryMutation) Where(ps ...predicate.KeyLogEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeyLogEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeyLogEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KeyLogEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeyLogEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeyLogEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KeyLogEntry).
+func (m *KeyLogEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeyLogEntryMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.leaf_index != nil {
+		fields = append(fields, keylogentry.FieldLeafIndex)
+	}
+	if m.user_id != nil {
+		fields = append(fields, keylogentry.FieldUserID)
+	}
+	if m.device_id != nil {
+		fields = append(fields, keylogentry.FieldDeviceID)
+	}
+	if m.identity_key != nil {
+		fields = append(fields, keylogentry.FieldIdentityKey)
+	}
+	if m.published_at != nil {
+		fields = append(fields, keylogentry.FieldPublishedAt)
+	}
+	if m.leaf_hash != nil {
+		fields = append(fields, keylogentry.FieldLeafHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeyLogEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keylogentry.FieldLeafIndex:
+		return m.LeafIndex()
+	case keylogentry.FieldUserID:
+		return m.UserID()
+	case keylogentry.FieldDeviceID:
+		return m.DeviceID()
+	case keylogentry.FieldIdentityKey:
+		return m.IdentityKey()
+	case keylogentry.FieldPublishedAt:
+		return m.PublishedAt()
+	case keylogentry.FieldLeafHash:
+		return m.LeafHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeyLogEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keylogentry.FieldLeafIndex:
+		return m.OldLeafIndex(ctx)
+	case keylogentry.FieldUserID:
+		return m.OldUserID(ctx)
+	case keylogentry.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case keylogentry.FieldIdentityKey:
+		return m.OldIdentityKey(ctx)
+	case keylogentry.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case keylogentry.FieldLeafHash:
+		return m.OldLeafHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown KeyLogEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyLogEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keylogentry.FieldLeafIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeafIndex(v)
+		return nil
+	case keylogentry.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case keylogentry.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case keylogentry.FieldIdentityKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdentityKey(v)
+		return nil
+	case keylogentry.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	case keylogentry.FieldLeafHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeafHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyLogEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeyLogEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addleaf_index != nil {
+		fields = append(fields, keylogentry.FieldLeafIndex)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, keylogentry.FieldUserID)
+	}
+	if m.adddevice_id != nil {
+		fields = append(fields, keylogentry.FieldDeviceID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeyLogEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case keylogentry.FieldLeafIndex:
+		return m.AddedLeafIndex()
+	case keylogentry.FieldUserID:
+		return m.AddedUserID()
+	case keylogentry.FieldDeviceID:
+		return m.AddedDeviceID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyLogEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case keylogentry.FieldLeafIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLeafIndex(v)
+		return nil
+	case keylogentry.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case keylogentry.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeviceID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyLogEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeyLogEntryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeyLogEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeyLogEntryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown KeyLogEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeyLogEntryMutation) ResetField(name string) error {
+	switch name {
+	case keylogentry.FieldLeafIndex:
+		m.ResetLeafIndex()
+		return nil
+	case keylogentry.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case keylogentry.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case keylogentry.FieldIdentityKey:
+		m.ResetIdentityKey()
+		return nil
+	case keylogentry.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
+	case keylogentry.FieldLeafHash:
+		m.ResetLeafHash()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyLogEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeyLogEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeyLogEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeyLogEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeyLogEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeyLogEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeyLogEntryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeyLogEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown KeyLogEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeyLogEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown KeyLogEntry edge %s", name)
+}
+
+// KeyLogHeadMutation represents an operation that mutates the KeyLogHead nodes in the graph.
+type KeyLogHeadMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	tree_size     *int
+	addtree_size  *int
+	root_hash     *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*KeyLogHead, error)
+	predicates    []predicate.KeyLogHead
+}
+
+var _ ent.Mutation = (*KeyLogHeadMutation)(nil)
+
+// keylogheadOption allows management of the mutation configuration using functional options.
+type keylogheadOption func(*KeyLogHeadMutation)
+
+// newKeyLogHeadMutation creates new mutation for the KeyLogHead entity.
+func newKeyLogHeadMutation(c config, op Op, opts ...keylogheadOption) *KeyLogHeadMutation {
+	m := &KeyLogHeadMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeyLogHead,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeyLogHeadID sets the ID field of the mutation.
+func withKeyLogHeadID(id int) keylogheadOption {
+	return func(m *KeyLogHeadMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KeyLogHead
+		)
+		m.oldValue = func(ctx context.Context) (*KeyLogHead, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KeyLogHead.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeyLogHead sets the old KeyLogHead of the mutation.
+func withKeyLogHead(node *KeyLogHead) keylogheadOption {
+	return func(m *KeyLogHeadMutation) {
+		m.oldValue = func(context.Context) (*KeyLogHead, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeyLogHeadMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeyLogHeadMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeyLogHeadMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeyLogHeadMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KeyLogHead.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTreeSize sets the "tree_size" field.
+func (m *KeyLogHeadMutation) SetTreeSize(i int) {
+	m.tree_size = &i
+	m.addtree_size = nil
+}
+
+// TreeSize returns the value of the "tree_size" field in the mutation.
+func (m *KeyLogHeadMutation) TreeSize() (r int, exists bool) {
+	v := m.tree_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTreeSize returns the old "tree_size" field's value of the KeyLogHead entity.
+// If the KeyLogHead object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyLogHeadMutation) OldTreeSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTreeSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTreeSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTreeSize: %w", err)
+	}
+	return oldValue.TreeSize, nil
+}
+
+// AddTreeSize adds i to the "tree_size" field.
+func (m *KeyLogHeadMutation) AddTreeSize(i int) {
+	if m.addtree_size != nil {
+		*m.addtree_size += i
+	} else {
+		m.addtree_size = &i
+	}
+}
+
+// AddedTreeSize returns the value that was added to the "tree_size" field in this mutation.
+func (m *KeyLogHeadMutation) AddedTreeSize() (r int, exists bool) {
+	v := m.addtree_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTreeSize resets all changes to the "tree_size" field.
+func (m *KeyLogHeadMutation) ResetTreeSize() {
+	m.tree_size = nil
+	m.addtree_size = nil
+}
+
+// SetRootHash sets the "root_hash" field.
+func (m *KeyLogHeadMutation) SetRootHash(b []byte) {
+	m.root_hash = &b
+}
+
+// RootHash returns the value of the "root_hash" field in the mutation.
+func (m *KeyLogHeadMutation) RootHash() (r []byte, exists bool) {
+	v := m.root_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRootHash returns the old "root_hash" field's value of the KeyLogHead entity.
+// If the KeyLogHead object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyLogHeadMutation) OldRootHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRootHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRootHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRootHash: %w", err)
+	}
+	return oldValue.RootHash, nil
+}
+
+// ResetRootHash resets all changes to the "root_hash" field.
+func (m *KeyLogHeadMutation) ResetRootHash() {
+	m.root_hash = nil
+}
+
+// Where appends a list predicates to the KeyLogHeadMutation builder.
+func (m *KeyLogHeadMutation) Where(ps ...predicate.KeyLogHead) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeyLogHeadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeyLogHeadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KeyLogHead, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeyLogHeadMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeyLogHeadMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KeyLogHead).
+func (m *KeyLogHeadMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeyLogHeadMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.tree_size != nil {
+		fields = append(fields, keyloghead.FieldTreeSize)
+	}
+	if m.root_hash != nil {
+		fields = append(fields, keyloghead.FieldRootHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeyLogHeadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keyloghead.FieldTreeSize:
+		return m.TreeSize()
+	case keyloghead.FieldRootHash:
+		return m.RootHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeyLogHeadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keyloghead.FieldTreeSize:
+		return m.OldTreeSize(ctx)
+	case keyloghead.FieldRootHash:
+		return m.OldRootHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown KeyLogHead field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyLogHeadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keyloghead.FieldTreeSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTreeSize(v)
+		return nil
+	case keyloghead.FieldRootHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRootHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyLogHead field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeyLogHeadMutation) AddedFields() []string {
+	var fields []string
+	if m.addtree_size != nil {
+		fields = append(fields, keyloghead.FieldTreeSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeyLogHeadMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case keyloghead.FieldTreeSize:
+		return m.AddedTreeSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyLogHeadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case keyloghead.FieldTreeSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTreeSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyLogHead numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeyLogHeadMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeyLogHeadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeyLogHeadMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown KeyLogHead nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeyLogHeadMutation) ResetField(name string) error {
+	switch name {
+	case keyloghead.FieldTreeSize:
+		m.ResetTreeSize()
+		return nil
+	case keyloghead.FieldRootHash:
+		m.ResetRootHash()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyLogHead field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeyLogHeadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeyLogHeadMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeyLogHeadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeyLogHeadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeyLogHeadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeyLogHeadMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeyLogHeadMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown KeyLogHead unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeyLogHeadMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown KeyLogHead edge %s", name)
+}
+
+// KeyLogNodeMutation represents an operation that mutates the KeyLogNode nodes in the graph.
+type KeyLogNodeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	level         *int
+	addlevel      *int
+	position      *int
+	addposition   *int
+	hash          *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*KeyLogNode, error)
+	predicates    []predicate.KeyLogNode
+}
+
+var _ ent.Mutation = (*KeyLogNodeMutation)(nil)
+
+// keylognodeOption allows management of the mutation configuration using functional options.
+type keylognodeOption func(*KeyLogNodeMutation)
+
+// newKeyLogNodeMutation creates new mutation for the KeyLogNode entity.
+func newKeyLogNodeMutation(c config, op Op, opts ...keylognodeOption) *KeyLogNodeMutation {
+	m := &KeyLogNodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeyLogNode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeyLogNodeID sets the ID field of the mutation.
+func withKeyLogNodeID(id int) keylognodeOption {
+	return func(m *KeyLogNodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KeyLogNode
+		)
+		m.oldValue = func(ctx context.Context) (*KeyLogNode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KeyLogNode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeyLogNode sets the old KeyLogNode of the mutation.
+func withKeyLogNode(node *KeyLogNode) keylognodeOption {
+	return func(m *KeyLogNodeMutation) {
+		m.oldValue = func(context.Context) (*KeyLogNode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeyLogNodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeyLogNodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeyLogNodeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeyLogNodeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KeyLogNode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLevel sets the "level" field.
+func (m *KeyLogNodeMutation) SetLevel(i int) {
+	m.level = &i
+	m.addlevel = nil
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *KeyLogNodeMutation) Level() (r int, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the KeyLogNode entity.
+// If the KeyLogNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyLogNodeMutation) OldLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// AddLevel adds i to the "level" field.
+func (m *KeyLogNodeMutation) AddLevel(i int) {
+	if m.addlevel != nil {
+		*m.addlevel += i
+	} else {
+		m.addlevel = &i
+	}
+}
+
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *KeyLogNodeMutation) AddedLevel() (r int, exists bool) {
+	v := m.addlevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *KeyLogNodeMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *KeyLogNodeMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *KeyLogNodeMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the KeyLogNode entity.
+// If the KeyLogNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyLogNodeMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *KeyLogNodeMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *KeyLogNodeMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *KeyLogNodeMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *KeyLogNodeMutation) SetHash(b []byte) {
+	m.hash = &b
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *KeyLogNodeMutation) Hash() (r []byte, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the KeyLogNode entity.
+// If the KeyLogNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyLogNodeMutation) OldHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *KeyLogNodeMutation) ResetHash() {
+	m.hash = nil
+}
+
+// Where appends a list predicates to the KeyLogNodeMutation builder.
+func (m *KeyLogNodeMutation) Where(ps ...predicate.KeyLogNode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeyLogNodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeyLogNodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KeyLogNode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeyLogNodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeyLogNodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KeyLogNode).
+func (m *KeyLogNodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeyLogNodeMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.level != nil {
+		fields = append(fields, keylognode.FieldLevel)
+	}
+	if m.position != nil {
+		fields = append(fields, keylognode.FieldPosition)
+	}
+	if m.hash != nil {
+		fields = append(fields, keylognode.FieldHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeyLogNodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keylognode.FieldLevel:
+		return m.Level()
+	case keylognode.FieldPosition:
+		return m.Position()
+	case keylognode.FieldHash:
+		return m.Hash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeyLogNodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keylognode.FieldLevel:
+		return m.OldLevel(ctx)
+	case keylognode.FieldPosition:
+		return m.OldPosition(ctx)
+	case keylognode.FieldHash:
+		return m.OldHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown KeyLogNode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyLogNodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keylognode.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case keylognode.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case keylognode.FieldHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyLogNode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeyLogNodeMutation) AddedFields() []string {
+	var fields []string
+	if m.addlevel != nil {
+		fields = append(fields, keylognode.FieldLevel)
+	}
+	if m.addposition != nil {
+		fields = append(fields, keylognode.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeyLogNodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case keylognode.FieldLevel:
+		return m.AddedLevel()
+	case keylognode.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyLogNodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case keylognode.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
+	case keylognode.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyLogNode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeyLogNodeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeyLogNodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeyLogNodeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown KeyLogNode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeyLogNodeMutation) ResetField(name string) error {
+	switch name {
+	case keylognode.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case keylognode.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case keylognode.FieldHash:
+		m.ResetHash()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyLogNode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeyLogNodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeyLogNodeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeyLogNodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeyLogNodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeyLogNodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeyLogNodeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeyLogNodeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown KeyLogNode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeyLogNodeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown KeyLogNode edge %s", name)
+}
+
+// KeyPackageMutation represents an operation that mutates the KeyPackage nodes in the graph.
+type KeyPackageMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	key_package     *string
+	cipher_suite    *int
+	addcipher_suite *int
+	last_resort     *bool
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	device          *int
+	cleareddevice   bool
+	done            bool
+	oldValue        func(context.Context) (*KeyPackage, error)
+	predicates      []predicate.KeyPackage
+}
+
+var _ ent.Mutation = (*KeyPackageMutation)(nil)
+
+// keypackageOption allows management of the mutation configuration using functional options.
+type keypackageOption func(*KeyPackageMutation)
+
+// newKeyPackageMutation creates new mutation for the KeyPackage entity.
+func newKeyPackageMutation(c config, op Op, opts ...keypackageOption) *KeyPackageMutation {
+	m := &KeyPackageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKeyPackage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKeyPackageID sets the ID field of the mutation.
+func withKeyPackageID(id int) keypackageOption {
+	return func(m *KeyPackageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KeyPackage
+		)
+		m.oldValue = func(ctx context.Context) (*KeyPackage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KeyPackage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKeyPackage sets the old KeyPackage of the mutation.
+func withKeyPackage(node *KeyPackage) keypackageOption {
+	return func(m *KeyPackageMutation) {
+		m.oldValue = func(context.Context) (*KeyPackage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KeyPackageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KeyPackageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KeyPackageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KeyPackageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KeyPackage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKeyPackage sets the "key_package" field.
+func (m *KeyPackageMutation) SetKeyPackage(s string) {
+	m.key_package = &s
+}
+
+// KeyPackage returns the value of the "key_package" field in the mutation.
+func (m *KeyPackageMutation) KeyPackage() (r string, exists bool) {
+	v := m.key_package
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyPackage returns the old "key_package" field's value of the KeyPackage entity.
+// If the KeyPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyPackageMutation) OldKeyPackage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyPackage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyPackage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyPackage: %w", err)
+	}
+	return oldValue.KeyPackage, nil
+}
+
+// ResetKeyPackage resets all changes to the "key_package" field.
+func (m *KeyPackageMutation) ResetKeyPackage() {
+	m.key_package = nil
+}
+
+// SetCipherSuite sets the "cipher_suite" field.
+func (m *KeyPackageMutation) SetCipherSuite(i int) {
+	m.cipher_suite = &i
+	m.addcipher_suite = nil
+}
+
+// CipherSuite returns the value of the "cipher_suite" field in the mutation.
+func (m *KeyPackageMutation) CipherSuite() (r int, exists bool) {
+	v := m.cipher_suite
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCipherSuite returns the old "cipher_suite" field's value of the KeyPackage entity.
+// If the KeyPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyPackageMutation) OldCipherSuite(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCipherSuite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCipherSuite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCipherSuite: %w", err)
+	}
+	return oldValue.CipherSuite, nil
+}
+
+// AddCipherSuite adds i to the "cipher_suite" field.
+func (m *KeyPackageMutation) AddCipherSuite(i int) {
+	if m.addcipher_suite != nil {
+		*m.addcipher_suite += i
+	} else {
+		m.addcipher_suite = &i
+	}
+}
+
+// AddedCipherSuite returns the value that was added to the "cipher_suite" field in this mutation.
+func (m *KeyPackageMutation) AddedCipherSuite() (r int, exists bool) {
+	v := m.addcipher_suite
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCipherSuite resets all changes to the "cipher_suite" field.
+func (m *KeyPackageMutation) ResetCipherSuite() {
+	m.cipher_suite = nil
+	m.addcipher_suite = nil
+}
+
+// SetLastResort sets the "last_resort" field.
+func (m *KeyPackageMutation) SetLastResort(b bool) {
+	m.last_resort = &b
+}
+
+// LastResort returns the value of the "last_resort" field in the mutation.
+func (m *KeyPackageMutation) LastResort() (r bool, exists bool) {
+	v := m.last_resort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastResort returns the old "last_resort" field's value of the KeyPackage entity.
+// If the KeyPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyPackageMutation) OldLastResort(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastResort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastResort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastResort: %w", err)
+	}
+	return oldValue.LastResort, nil
+}
+
+// ResetLastResort resets all changes to the "last_resort" field.
+func (m *KeyPackageMutation) ResetLastResort() {
+	m.last_resort = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *KeyPackageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *KeyPackageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the KeyPackage entity.
+// If the KeyPackage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KeyPackageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *KeyPackageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by id.
+func (m *KeyPackageMutation) SetDeviceID(id int) {
+	m.device = &id
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (m *KeyPackageMutation) ClearDevice() {
+	m.cleareddevice = true
+}
+
+// DeviceCleared reports if the "device" edge to the Device entity was cleared.
+func (m *KeyPackageMutation) DeviceCleared() bool {
+	return m.cleareddevice
+}
+
+// DeviceID returns the "device" edge ID in the mutation.
+func (m *KeyPackageMutation) DeviceID() (id int, exists bool) {
+	if m.device != nil {
+		return *m.device, true
+	}
+	return
+}
+
+// DeviceIDs returns the "device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeviceID instead. It exists only for internal usage by the builders.
+func (m *KeyPackageMutation) DeviceIDs() (ids []int) {
+	if id := m.device; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDevice resets all changes to the "device" edge.
+func (m *KeyPackageMutation) ResetDevice() {
+	m.device = nil
+	m.cleareddevice = false
+}
+
+// Where appends a list predicates to the KeyPackageMutation builder.
+func (m *KeyPackageMutation) Where(ps ...predicate.KeyPackage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KeyPackageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KeyPackageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KeyPackage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KeyPackageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KeyPackageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KeyPackage).
+func (m *KeyPackageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KeyPackageMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.key_package != nil {
+		fields = append(fields, keypackage.FieldKeyPackage)
+	}
+	if m.cipher_suite != nil {
+		fields = append(fields, keypackage.FieldCipherSuite)
+	}
+	if m.last_resort != nil {
+		fields = append(fields, keypackage.FieldLastResort)
+	}
+	if m.created_at != nil {
+		fields = append(fields, keypackage.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KeyPackageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case keypackage.FieldKeyPackage:
+		return m.KeyPackage()
+	case keypackage.FieldCipherSuite:
+		return m.CipherSuite()
+	case keypackage.FieldLastResort:
+		return m.LastResort()
+	case keypackage.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KeyPackageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case keypackage.FieldKeyPackage:
+		return m.OldKeyPackage(ctx)
+	case keypackage.FieldCipherSuite:
+		return m.OldCipherSuite(ctx)
+	case keypackage.FieldLastResort:
+		return m.OldLastResort(ctx)
+	case keypackage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown KeyPackage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyPackageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case keypackage.FieldKeyPackage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyPackage(v)
+		return nil
+	case keypackage.FieldCipherSuite:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCipherSuite(v)
+		return nil
+	case keypackage.FieldLastResort:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastResort(v)
+		return nil
+	case keypackage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyPackage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KeyPackageMutation) AddedFields() []string {
+	var fields []string
+	if m.addcipher_suite != nil {
+		fields = append(fields, keypackage.FieldCipherSuite)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KeyPackageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case keypackage.FieldCipherSuite:
+		return m.AddedCipherSuite()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KeyPackageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case keypackage.FieldCipherSuite:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCipherSuite(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KeyPackage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KeyPackageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KeyPackageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KeyPackageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown KeyPackage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KeyPackageMutation) ResetField(name string) error {
+	switch name {
+	case keypackage.FieldKeyPackage:
+		m.ResetKeyPackage()
+		return nil
+	case keypackage.FieldCipherSuite:
+		m.ResetCipherSuite()
+		return nil
+	case keypackage.FieldLastResort:
+		m.ResetLastResort()
+		return nil
+	case keypackage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyPackage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KeyPackageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.device != nil {
+		edges = append(edges, keypackage.EdgeDevice)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KeyPackageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case keypackage.EdgeDevice:
+		if id := m.device; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KeyPackageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KeyPackageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KeyPackageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddevice {
+		edges = append(edges, keypackage.EdgeDevice)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KeyPackageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case keypackage.EdgeDevice:
+		return m.cleareddevice
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KeyPackageMutation) ClearEdge(name string) error {
+	switch name {
+	case keypackage.EdgeDevice:
+		m.ClearDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyPackage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KeyPackageMutation) ResetEdge(name string) error {
+	switch name {
+	case keypackage.EdgeDevice:
+		m.ResetDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown KeyPackage edge %s", name)
+}
+
+// LoginThrottleMutation represents an operation that mutates the LoginThrottle nodes in the graph.
+type LoginThrottleMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	scope           *loginthrottle.Scope
+	key             *string
+	failures        *int
+	addfailures     *int
+	last_failure_at *time.Time
+	retry_at        *time.Time
+	locked_until    *time.Time
+	clearedFields   map[string]struct{}
+	user            *int
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*LoginThrottle, error)
+	predicates      []predicate.LoginThrottle
+}
+
+var _ ent.Mutation = (*LoginThrottleMutation)(nil)
+
+// loginthrottleOption allows management of the mutation configuration using functional options.
+type loginthrottleOption func(*LoginThrottleMutation)
+
+// newLoginThrottleMutation creates new mutation for the LoginThrottle entity.
+func newLoginThrottleMutation(c config, op Op, opts ...loginthrottleOption) *LoginThrottleMutation {
+	m := &LoginThrottleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoginThrottle,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoginThrottleID sets the ID field of the mutation.
+func withLoginThrottleID(id int) loginthrottleOption {
+	return func(m *LoginThrottleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoginThrottle
+		)
+		m.oldValue = func(ctx context.Context) (*LoginThrottle, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoginThrottle.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoginThrottle sets the old LoginThrottle of the mutation.
+func withLoginThrottle(node *LoginThrottle) loginthrottleOption {
+	return func(m *LoginThrottleMutation) {
+		m.oldValue = func(context.Context) (*LoginThrottle, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoginThrottleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoginThrottleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoginThrottleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoginThrottleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoginThrottle.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetScope sets the "scope" field.
+func (m *LoginThrottleMutation) SetScope(l loginthrottle.Scope) {
+	m.scope = &l
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *LoginThrottleMutation) Scope() (r loginthrottle.Scope, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the LoginThrottle entity.
+// If the LoginThrottle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginThrottleMutation) OldScope(ctx context.Context) (v loginthrottle.Scope, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *LoginThrottleMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetKey sets the "key" field.
+func (m *LoginThrottleMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *LoginThrottleMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the LoginThrottle entity.
+// If the LoginThrottle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginThrottleMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *LoginThrottleMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetFailures sets the "failures" field.
+func (m *LoginThrottleMutation) SetFailures(i int) {
+	m.failures = &i
+	m.addfailures = nil
+}
+
+// Failures returns the value of the "failures" field in the mutation.
+func (m *LoginThrottleMutation) Failures() (r int, exists bool) {
+	v := m.failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailures returns the old "failures" field's value of the LoginThrottle entity.
+// If the LoginThrottle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginThrottleMutation) OldFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailures: %w", err)
+	}
+	return oldValue.Failures, nil
+}
+
+// AddFailures adds i to the "failures" field.
+func (m *LoginThrottleMutation) AddFailures(i int) {
+	if m.addfailures != nil {
+		*m.addfailures += i
+	} else {
+		m.addfailures = &i
+	}
+}
+
+// AddedFailures returns the value that was added to the "failures" field in this mutation.
+func (m *LoginThrottleMutation) AddedFailures() (r int, exists bool) {
+	v := m.addfailures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailures resets all changes to the "failures" field.
+func (m *LoginThrottleMutation) ResetFailures() {
+	m.failures = nil
+	m.addfailures = nil
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (m *LoginThrottleMutation) SetLastFailureAt(t time.Time) {
+	m.last_failure_at = &t
+}
+
+// LastFailureAt returns the value of the "last_failure_at" field in the mutation.
+func (m *LoginThrottleMutation) LastFailureAt() (r time.Time, exists bool) {
+	v := m.last_failure_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastFailureAt returns the old "last_failure_at" field's value of the LoginThrottle entity.
+// If the LoginThrottle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginThrottleMutation) OldLastFailureAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFailureAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFailureAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFailureAt: %w", err)
+	}
+	return oldValue.LastFailureAt, nil
+}
+
+// ResetLastFailureAt resets all changes to the "last_failure_at" field.
+func (m *LoginThrottleMutation) ResetLastFailureAt() {
+	m.last_failure_at = nil
+}
+
+// SetRetryAt sets the "retry_at" field.
+func (m *LoginThrottleMutation) SetRetryAt(t time.Time) {
+	m.retry_at = &t
+}
+
+// RetryAt returns the value of the "retry_at" field in the mutation.
+func (m *LoginThrottleMutation) RetryAt() (r time.Time, exists bool) {
+	v := m.retry_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetryAt returns the old "retry_at" field's value of the LoginThrottle entity.
+// If the LoginThrottle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginThrottleMutation) OldRetryAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetryAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetryAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetryAt: %w", err)
+	}
+	return oldValue.RetryAt, nil
+}
+
+// ClearRetryAt clears the value of the "retry_at" field.
+func (m *LoginThrottleMutation) ClearRetryAt() {
+	m.retry_at = nil
+	m.clearedFields[loginthrottle.FieldRetryAt] = struct{}{}
+}
+
+// RetryAtCleared returns if the "retry_at" field was cleared in this mutation.
+func (m *LoginThrottleMutation) RetryAtCleared() bool {
+	_, ok := m.clearedFields[loginthrottle.FieldRetryAt]
+	return ok
+}
+
+// ResetRetryAt resets all changes to the "retry_at" field.
+func (m *LoginThrottleMutation) ResetRetryAt() {
+	m.retry_at = nil
+	delete(m.clearedFields, loginthrottle.FieldRetryAt)
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *LoginThrottleMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *LoginThrottleMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the LoginThrottle entity.
+// If the LoginThrottle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginThrottleMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *LoginThrottleMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[loginthrottle.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *LoginThrottleMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[loginthrottle.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *LoginThrottleMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, loginthrottle.FieldLockedUntil)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *LoginThrottleMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *LoginThrottleMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *LoginThrottleMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *LoginThrottleMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *LoginThrottleMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *LoginThrottleMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the LoginThrottleMutation builder.
+func (m *LoginThrottleMutation) Where(ps ...predicate.LoginThrottle) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoginThrottleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoginThrottleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoginThrottle, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoginThrottleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoginThrottleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LoginThrottle).
+func (m *LoginThrottleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoginThrottleMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.scope != nil {
+		fields = append(fields, loginthrottle.FieldScope)
+	}
+	if m.key != nil {
+		fields = append(fields, loginthrottle.FieldKey)
+	}
+	if m.failures != nil {
+		fields = append(fields, loginthrottle.FieldFailures)
+	}
+	if m.last_failure_at != nil {
+		fields = append(fields, loginthrottle.FieldLastFailureAt)
+	}
+	if m.retry_at != nil {
+		fields = append(fields, loginthrottle.FieldRetryAt)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, loginthrottle.FieldLockedUntil)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoginThrottleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loginthrottle.FieldScope:
+		return m.Scope()
+	case loginthrottle.FieldKey:
+		return m.Key()
+	case loginthrottle.FieldFailures:
+		return m.Failures()
+	case loginthrottle.FieldLastFailureAt:
+		return m.LastFailureAt()
+	case loginthrottle.FieldRetryAt:
+		return m.RetryAt()
+	case loginthrottle.FieldLockedUntil:
+		return m.LockedUntil()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoginThrottleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loginthrottle.FieldScope:
+		return m.OldScope(ctx)
+	case loginthrottle.FieldKey:
+		return m.OldKey(ctx)
+	case loginthrottle.FieldFailures:
+		return m.OldFailures(ctx)
+	case loginthrottle.FieldLastFailureAt:
+		return m.OldLastFailureAt(ctx)
+	case loginthrottle.FieldRetryAt:
+		return m.OldRetryAt(ctx)
+	case loginthrottle.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoginThrottle field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginThrottleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loginthrottle.FieldScope:
+		v, ok := value.(loginthrottle.Scope)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case loginthrottle.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case loginthrottle.FieldFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailures(v)
+		return nil
+	case loginthrottle.FieldLastFailureAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastFailureAt(v)
+		return nil
+	case loginthrottle.FieldRetryAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetryAt(v)
+		return nil
+	case loginthrottle.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginThrottle field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoginThrottleMutation) AddedFields() []string {
+	var fields []string
+	if m.addfailures != nil {
+		fields = append(fields, loginthrottle.FieldFailures)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoginThrottleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case loginthrottle.FieldFailures:
+		return m.AddedFailures()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginThrottleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case loginthrottle.FieldFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailures(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginThrottle numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoginThrottleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(loginthrottle.FieldRetryAt) {
+		fields = append(fields, loginthrottle.FieldRetryAt)
+	}
+	if m.FieldCleared(loginthrottle.FieldLockedUntil) {
+		fields = append(fields, loginthrottle.FieldLockedUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoginThrottleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoginThrottleMutation) ClearField(name string) error {
+	switch name {
+	case loginthrottle.FieldRetryAt:
+		m.ClearRetryAt()
+		return nil
+	case loginthrottle.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginThrottle nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoginThrottleMutation) ResetField(name string) error {
+	switch name {
+	case loginthrottle.FieldScope:
+		m.ResetScope()
+		return nil
+	case loginthrottle.FieldKey:
+		m.ResetKey()
+		return nil
+	case loginthrottle.FieldFailures:
+		m.ResetFailures()
+		return nil
+	case loginthrottle.FieldLastFailureAt:
+		m.ResetLastFailureAt()
+		return nil
+	case loginthrottle.FieldRetryAt:
+		m.ResetRetryAt()
+		return nil
+	case loginthrottle.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginThrottle field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoginThrottleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, loginthrottle.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoginThrottleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case loginthrottle.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoginThrottleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoginThrottleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoginThrottleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, loginthrottle.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoginThrottleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case loginthrottle.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoginThrottleMutation) ClearEdge(name string) error {
+	switch name {
+	case loginthrottle.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginThrottle unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoginThrottleMutation) ResetEdge(name string) error {
+	switch name {
+	case loginthrottle.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginThrottle edge %s", name)
+}
+
+// MediaMutation represents an operation that mutates the Media nodes in the graph.
+type MediaMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	filename        *string
+	content_type    *string
+	storage_path    *string
+	checksum        *string
+	size_bytes      *int64
+	addsize_bytes   *int64
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	uploader        *int
+	cleareduploader bool
+	message         *int
+	clearedmessage  bool
+	done            bool
+	oldValue        func(context.Context) (*Media, error)
+	predicates      []predicate.Media
+}
+
+var _ ent.Mutation = (*MediaMutation)(nil)
+
+// mediaOption allows management of the mutation configuration using functional options.
+type mediaOption func(*MediaMutation)
+
+// newMediaMutation creates new mutation for the Media entity.
+func newMediaMutation(c config, op Op, opts ...mediaOption) *MediaMutation {
+	m := &MediaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMedia,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMediaID sets the ID field of the mutation.
+func withMediaID(id int) mediaOption {
+	return func(m *MediaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Media
+		)
+		m.oldValue = func(ctx context.Context) (*Media, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Media.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMedia sets the old Media of the mutation.
+func withMedia(node *Media) mediaOption {
+	return func(m *MediaMutation) {
+		m.oldValue = func(context.Context) (*Media, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MediaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MediaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MediaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MediaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Media.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFilename sets the "filename" field.
+func (m *MediaMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *MediaMutation) Filename() (r string, exists bool) {
+	v := m.filename
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilename returns the old "filename" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *MediaMutation) ResetFilename() {
+	m.filename = nil
+}
+
+// SetContentType sets the "content_type" field.
+func (m *MediaMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *MediaMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *MediaMutation) ResetContentType() {
+	m.content_type = nil
+}
+
+// SetStoragePath sets the "storage_path" field.
+func (m *MediaMutation) SetStoragePath(s string) {
+	m.storage_path = &s
+}
+
+// StoragePath returns the value of the "storage_path" field in the mutation.
+func (m *MediaMutation) StoragePath() (r string, exists bool) {
+	v := m.storage_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStoragePath returns the old "storage_path" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldStoragePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoragePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoragePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoragePath: %w", err)
+	}
+	return oldValue.StoragePath, nil
+}
+
+// ResetStoragePath resets all changes to the "storage_path" field.
+func (m *MediaMutation) ResetStoragePath() {
+	m.storage_path = nil
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *MediaMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *MediaMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *MediaMutation) ResetChecksum() {
+	m.checksum = nil
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (m *MediaMutation) SetSizeBytes(i int64) {
+	m.size_bytes = &i
+	m.addsize_bytes = nil
+}
+
+// SizeBytes returns the value of the "size_bytes" field in the mutation.
+func (m *MediaMutation) SizeBytes() (r int64, exists bool) {
+	v := m.size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizeBytes returns the old "size_bytes" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldSizeBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeBytes: %w", err)
+	}
+	return oldValue.SizeBytes, nil
+}
+
+// AddSizeBytes adds i to the "size_bytes" field.
+func (m *MediaMutation) AddSizeBytes(i int64) {
+	if m.addsize_bytes != nil {
+		*m.addsize_bytes += i
+	} else {
+		m.addsize_bytes = &i
+	}
+}
+
+// AddedSizeBytes returns the value that was added to the "size_bytes" field in this mutation.
+func (m *MediaMutation) AddedSizeBytes() (r int64, exists bool) {
+	v := m.addsize_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSizeBytes resets all changes to the "size_bytes" field.
+func (m *MediaMutation) ResetSizeBytes() {
+	m.size_bytes = nil
+	m.addsize_bytes = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MediaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MediaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MediaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUploaderID sets the "uploader" edge to the User entity by id.
+func (m *MediaMutation) SetUploaderID(id int) {
+	m.uploader = &id
+}
+
+// ClearUploader clears the "uploader" edge to the User entity.
+func (m *MediaMutation) ClearUploader() {
+	m.cleareduploader = true
+}
+
+// UploaderCleared reports if the "uploader" edge to the User entity was cleared.
+func (m *MediaMutation) UploaderCleared() bool {
+	return m.cleareduploader
+}
+
+// UploaderID returns the "uploader" edge ID in the mutation.
+func (m *MediaMutation) UploaderID() (id int, exists bool) {
+	if m.uploader != nil {
+		return *m.uploader, true
+	}
+	return
+}
+
+// UploaderIDs returns the "uploader" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UploaderID instead. It exists only for internal usage by the builders.
+func (m *MediaMutation) UploaderIDs() (ids []int) {
+	if id := m.uploader; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUploader resets all changes to the "uploader" edge.
+func (m *MediaMutation) ResetUploader() {
+	m.uploader = nil
+	m.cleareduploader = false
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MediaMutation) SetMessageID(id int) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MediaMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MediaMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MediaMutation) MessageID() (id int, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MediaMutation) MessageIDs() (ids []int) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MediaMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the MediaMutation builder.
+func (m *MediaMutation) Where(ps ...predicate.Media) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MediaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MediaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Media, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MediaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MediaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Media).
+func (m *MediaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MediaMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.filename != nil {
+		fields = append(fields, media.FieldFilename)
+	}
+	if m.content_type != nil {
+		fields = append(fields, media.FieldContentType)
+	}
+	if m.storage_path != nil {
+		fields = append(fields, media.FieldStoragePath)
+	}
+	if m.checksum != nil {
+		fields = append(fields, media.FieldChecksum)
+	}
+	if m.size_bytes != nil {
+		fields = append(fields, media.FieldSizeBytes)
+	}
+	if m.created_at != nil {
+		fields = append(fields, media.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MediaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case media.FieldFilename:
+		return m.Filename()
+	case media.FieldContentType:
+		return m.ContentType()
+	case media.FieldStoragePath:
+		return m.StoragePath()
+	case media.FieldChecksum:
+		return m.Checksum()
+	case media.FieldSizeBytes:
+		return m.SizeBytes()
+	case media.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MediaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case media.FieldFilename:
+		return m.OldFilename(ctx)
+	case media.FieldContentType:
+		return m.OldContentType(ctx)
+	case media.FieldStoragePath:
+		return m.OldStoragePath(ctx)
+	case media.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case media.FieldSizeBytes:
+		return m.OldSizeBytes(ctx)
+	case media.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Media field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case media.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case media.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case media.FieldStoragePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoragePath(v)
+		return nil
+	case media.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
+	case media.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeBytes(v)
+		return nil
+	case media.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Media field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MediaMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize_bytes != nil {
+		fields = append(fields, media.FieldSizeBytes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MediaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case media.FieldSizeBytes:
+		return m.AddedSizeBytes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case media.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Media numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MediaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MediaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MediaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Media nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MediaMutation) ResetField(name string) error {
+	switch name {
+	case media.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case media.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case media.FieldStoragePath:
+		m.ResetStoragePath()
+		return nil
+	case media.FieldChecksum:
+		m.ResetChecksum()
+		return nil
+	case media.FieldSizeBytes:
+		m.ResetSizeBytes()
+		return nil
+	case media.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Media field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MediaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.uploader != nil {
+		edges = append(edges, media.EdgeUploader)
+	}
+	if m.message != nil {
+		edges = append(edges, media.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MediaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case media.EdgeUploader:
+		if id := m.uploader; id != nil {
+			return []ent.Value{*id}
+		}
+	case media.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MediaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MediaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MediaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduploader {
+		edges = append(edges, media.EdgeUploader)
+	}
+	if m.clearedmessage {
+		edges = append(edges, media.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MediaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case media.EdgeUploader:
+		return m.cleareduploader
+	case media.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MediaMutation) ClearEdge(name string) error {
+	switch name {
+	case media.EdgeUploader:
+		m.ClearUploader()
+		return nil
+	case media.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown Media unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MediaMutation) ResetEdge(name string) error {
+	switch name {
+	case media.EdgeUploader:
+		m.ResetUploader()
+		return nil
+	case media.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown Media edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	cipher_text           *string
+	sender_device_id      *int
+	addsender_device_id   *int
+	content_type          *string
+	encryption_scheme     *string
+	encryption_version    *int
+	addencryption_version *int
+	edited                *bool
+	seq                   *int
+	addseq                *int
+	expires_at            *time.Time
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	sender                *int
+	clearedsender         bool
+	room                  *int
+	clearedroom           bool
+	media                 map[int]struct{}
+	removedmedia          map[int]struct{}
+	clearedmedia          bool
+	envelopes             map[int]struct{}
+	removedenvelopes      map[int]struct{}
+	clearedenvelopes      bool
+	done                  bool
+	oldValue              func(context.Context) (*Message, error)
+	predicates            []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id int) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCipherText sets the "cipher_text" field.
+func (m *MessageMutation) SetCipherText(s string) {
+	m.cipher_text = &s
+}
+
+// CipherText returns the value of the "cipher_text" field in the mutation.
+func (m *MessageMutation) CipherText() (r string, exists bool) {
+	v := m.cipher_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCipherText returns the old "cipher_text" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCipherText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCipherText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCipherText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCipherText: %w", err)
+	}
+	return oldValue.CipherText, nil
+}
+
+// ClearCipherText clears the value of the "cipher_text" field.
+func (m *MessageMutation) ClearCipherText() {
+	m.cipher_text = nil
+	m.clearedFields[message.FieldCipherText] = struct{}{}
+}
+
+// CipherTextCleared returns if the "cipher_text" field was cleared in this mutation.
+func (m *MessageMutation) CipherTextCleared() bool {
+	_, ok := m.clearedFields[message.FieldCipherText]
+	return ok
+}
+
+// ResetCipherText resets all changes to the "cipher_text" field.
+func (m *MessageMutation) ResetCipherText() {
+	m.cipher_text = nil
+	delete(m.clearedFields, message.FieldCipherText)
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (m *MessageMutation) SetSenderDeviceID(i int) {
+	m.sender_device_id = &i
+	m.addsender_device_id = nil
+}
+
+// SenderDeviceID returns the value of the "sender_device_id" field in the mutation.
+func (m *MessageMutation) SenderDeviceID() (r int, exists bool) {
+	v := m.sender_device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderDeviceID returns the old "sender_device_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSenderDeviceID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderDeviceID: %w", err)
+	}
+	return oldValue.SenderDeviceID, nil
+}
+
+// AddSenderDeviceID adds i to the "sender_device_id" field.
+func (m *MessageMutation) AddSenderDeviceID(i int) {
+	if m.addsender_device_id != nil {
+		*m.addsender_device_id += i
+	} else {
+		m.addsender_device_id = &i
+	}
+}
+
+// AddedSenderDeviceID returns the value that was added to the "sender_device_id" field in this mutation.
+func (m *MessageMutation) AddedSenderDeviceID() (r int, exists bool) {
+	v := m.addsender_device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSenderDeviceID clears the value of the "sender_device_id" field.
+func (m *MessageMutation) ClearSenderDeviceID() {
+	m.sender_device_id = nil
+	m.addsender_device_id = nil
+	m.clearedFields[message.FieldSenderDeviceID] = struct{}{}
+}
+
+// SenderDeviceIDCleared returns if the "sender_device_id" field was cleared in this mutation.
+func (m *MessageMutation) SenderDeviceIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldSenderDeviceID]
+	return ok
+}
+
+// ResetSenderDeviceID resets all changes to the "sender_device_id" field.
+func (m *MessageMutation) ResetSenderDeviceID() {
+	m.sender_device_id = nil
+	m.addsender_device_id = nil
+	delete(m.clearedFields, message.FieldSenderDeviceID)
+}
+
+// SetContentType sets the "content_type" field.
+func (m *MessageMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *MessageMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *MessageMutation) ResetContentType() {
+	m.content_type = nil
+}
+
+// SetEncryptionScheme sets the "encryption_scheme" field.
+func (m *MessageMutation) SetEncryptionScheme(s string) {
+	m.encryption_scheme = &s
+}
+
+// EncryptionScheme returns the value of the "encryption_scheme" field in the mutation.
+func (m *MessageMutation) EncryptionScheme() (r string, exists bool) {
+	v := m.encryption_scheme
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptionScheme returns the old "encryption_scheme" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEncryptionScheme(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptionScheme is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptionScheme requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptionScheme: %w", err)
+	}
+	return oldValue.EncryptionScheme, nil
+}
+
+// ResetEncryptionScheme resets all changes to the "encryption_scheme" field.
+func (m *MessageMutation) ResetEncryptionScheme() {
+	m.encryption_scheme = nil
+}
+
+// SetEncryptionVersion sets the "encryption_version" field.
+func (m *MessageMutation) SetEncryptionVersion(i int) {
+	m.encryption_version = &i
+	m.addencryption_version = nil
+}
+
+// EncryptionVersion returns the value of the "encryption_version" field in the mutation.
+func (m *MessageMutation) EncryptionVersion() (r int, exists bool) {
+	v := m.encryption_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptionVersion returns the old "encryption_version" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEncryptionVersion(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptionVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptionVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptionVersion: %w", err)
+	}
+	return oldValue.EncryptionVersion, nil
+}
+
+// AddEncryptionVersion adds i to the "encryption_version" field.
+func (m *MessageMutation) AddEncryptionVersion(i int) {
+	if m.addencryption_version != nil {
+		*m.addencryption_version += i
+	} else {
+		m.addencryption_version = &i
+	}
+}
+
+// AddedEncryptionVersion returns the value that was added to the "encryption_version" field in this mutation.
+func (m *MessageMutation) AddedEncryptionVersion() (r int, exists bool) {
+	v := m.addencryption_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEncryptionVersion clears the value of the "encryption_version" field.
+func (m *MessageMutation) ClearEncryptionVersion() {
+	m.encryption_version = nil
+	m.addencryption_version = nil
+	m.clearedFields[message.FieldEncryptionVersion] = struct{}{}
+}
+
+// EncryptionVersionCleared returns if the "encryption_version" field was cleared in this mutation.
+func (m *MessageMutation) EncryptionVersionCleared() bool {
+	_, ok := m.clearedFields[message.FieldEncryptionVersion]
+	return ok
+}
+
+// ResetEncryptionVersion resets all changes to the "encryption_version" field.
+func (m *MessageMutation) ResetEncryptionVersion() {
+	m.encryption_version = nil
+	m.addencryption_version = nil
+	delete(m.clearedFields, message.FieldEncryptionVersion)
+}
+
+// SetEdited sets the "edited" field.
+func (m *MessageMutation) SetEdited(b bool) {
+	m.edited = &b
+}
+
+// Edited returns the value of the "edited" field in the mutation.
+func (m *MessageMutation) Edited() (r bool, exists bool) {
+	v := m.edited
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEdited returns the old "edited" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEdited(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEdited is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEdited requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEdited: %w", err)
+	}
+	return oldValue.Edited, nil
+}
+
+// ResetEdited resets all changes to the "edited" field.
+func (m *MessageMutation) ResetEdited() {
+	m.edited = nil
+}
+
+// SetSeq sets the "seq" field.
+func (m *MessageMutation) SetSeq(i int) {
+	m.seq = &i
+	m.addseq = nil
+}
+
+// Seq returns the value of the "seq" field in the mutation.
+func (m *MessageMutation) Seq() (r int, exists bool) {
+	v := m.seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeq returns the old "seq" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSeq(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
+	}
+	return oldValue.Seq, nil
+}
+
+// AddSeq adds i to the "seq" field.
+func (m *MessageMutation) AddSeq(i int) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
+}
+
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *MessageMutation) AddedSeq() (r int, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSeq clears the value of the "seq" field.
+func (m *MessageMutation) ClearSeq() {
+	m.seq = nil
+	m.addseq = nil
+	m.clearedFields[message.FieldSeq] = struct{}{}
+}
+
+// SeqCleared returns if the "seq" field was cleared in this mutation.
+func (m *MessageMutation) SeqCleared() bool {
+	_, ok := m.clearedFields[message.FieldSeq]
+	return ok
+}
+
+// ResetSeq resets all changes to the "seq" field.
+func (m *MessageMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
+	delete(m.clearedFields, message.FieldSeq)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *MessageMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *MessageMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *MessageMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[message.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *MessageMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *MessageMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, message.FieldExpiresAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MessageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetSenderID sets the "sender" edge to the User entity by id.
+func (m *MessageMutation) SetSenderID(id int) {
+	m.sender = &id
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (m *MessageMutation) ClearSender() {
+	m.clearedsender = true
+}
+
+// SenderCleared reports if the "sender" edge to the User entity was cleared.
+func (m *MessageMutation) SenderCleared() bool {
+	return m.clearedsender
+}
+
+// SenderID returns the "sender" edge ID in the mutation.
+func (m *MessageMutation) SenderID() (id int, exists bool) {
+	if m.sender != nil {
+		return *m.sender, true
+	}
+	return
+}
+
+// SenderIDs returns the "sender" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SenderID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) SenderIDs() (ids []int) {
+	if id := m.sender; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSender resets all changes to the "sender" edge.
+func (m *MessageMutation) ResetSender() {
+	m.sender = nil
+	m.clearedsender = false
+}
+
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *MessageMutation) SetRoomID(id int) {
+	m.room = &id
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *MessageMutation) ClearRoom() {
+	m.clearedroom = true
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *MessageMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *MessageMutation) RoomID() (id int, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) RoomIDs() (ids []int) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *MessageMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// AddMediumIDs adds the "media" edge to the Media entity by ids.
+func (m *MessageMutation) AddMediumIDs(ids ...int) {
+	if m.media == nil {
+		m.media = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.media[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *MessageMutation) ClearMedia() {
+	m.clearedmedia = true
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *MessageMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// RemoveMediumIDs removes the "media" edge to the Media entity by IDs.
+func (m *MessageMutation) RemoveMediumIDs(ids ...int) {
+	if m.removedmedia == nil {
+		m.removedmedia = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.media, ids[i])
+		m.removedmedia[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMedia returns the removed IDs of the "media" edge to the Media entity.
+func (m *MessageMutation) RemovedMediaIDs() (ids []int) {
+	for id := range m.removedmedia {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+func (m *MessageMutation) MediaIDs() (ids []int) {
+	for id := range m.media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *MessageMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+	m.removedmedia = nil
+}
+
+// AddEnvelopeIDs adds the "envelopes" edge to the MessageEnvelope entity by ids.
+func (m *MessageMutation) AddEnvelopeIDs(ids ...int) {
+	if m.envelopes == nil {
+		m.envelopes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.envelopes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEnvelopes clears the "envelopes" edge to the MessageEnvelope entity.
+func (m *MessageMutation) ClearEnvelopes() {
+	m.clearedenvelopes = true
+}
+
+// EnvelopesCleared reports if the "envelopes" edge to the MessageEnvelope entity was cleared.
+func (m *MessageMutation) EnvelopesCleared() bool {
+	return m.clearedenvelopes
+}
+
+// RemoveEnvelopeIDs removes the "envelopes" edge to the MessageEnvelope entity by IDs.
+func (m *MessageMutation) RemoveEnvelopeIDs(ids ...int) {
+	if m.removedenvelopes == nil {
+		m.removedenvelopes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.envelopes, ids[i])
+		m.removedenvelopes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEnvelopes returns the removed IDs of the "envelopes" edge to the MessageEnvelope entity.
+func (m *MessageMutation) RemovedEnvelopesIDs() (ids []int) {
+	for id := range m.removedenvelopes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EnvelopesIDs returns the "envelopes" edge IDs in the mutation.
+func (m *MessageMutation) EnvelopesIDs() (ids []int) {
+	for id := range m.envelopes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEnvelopes resets all changes to the "envelopes" edge.
+func (m *MessageMutation) ResetEnvelopes() {
+	m.envelopes = nil
+	m.clearedenvelopes = false
+	m.removedenvelopes = nil
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.cipher_text != nil {
+		fields = append(fields, message.FieldCipherText)
+	}
+	if m.sender_device_id != nil {
+		fields = append(fields, message.FieldSenderDeviceID)
+	}
+	if m.content_type != nil {
+		fields = append(fields, message.FieldContentType)
+	}
+	if m.encryption_scheme != nil {
+		fields = append(fields, message.FieldEncryptionScheme)
+	}
+	if m.encryption_version != nil {
+		fields = append(fields, message.FieldEncryptionVersion)
+	}
+	if m.edited != nil {
+		fields = append(fields, message.FieldEdited)
+	}
+	if m.seq != nil {
+		fields = append(fields, message.FieldSeq)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, message.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, message.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldCipherText:
+		return m.CipherText()
+	case message.FieldSenderDeviceID:
+		return m.SenderDeviceID()
+	case message.FieldContentType:
+		return m.ContentType()
+	case message.FieldEncryptionScheme:
+		return m.EncryptionScheme()
+	case message.FieldEncryptionVersion:
+		return m.EncryptionVersion()
+	case message.FieldEdited:
+		return m.Edited()
+	case message.FieldSeq:
+		return m.Seq()
+	case message.FieldExpiresAt:
+		return m.ExpiresAt()
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	case message.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldCipherText:
+		return m.OldCipherText(ctx)
+	case message.FieldSenderDeviceID:
+		return m.OldSenderDeviceID(ctx)
+	case message.FieldContentType:
+		return m.OldContentType(ctx)
+	case message.FieldEncryptionScheme:
+		return m.OldEncryptionScheme(ctx)
+	case message.FieldEncryptionVersion:
+		return m.OldEncryptionVersion(ctx)
+	case message.FieldEdited:
+		return m.OldEdited(ctx)
+	case message.FieldSeq:
+		return m.OldSeq(ctx)
+	case message.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case message.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldCipherText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCipherText(v)
+		return nil
+	case message.FieldSenderDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderDeviceID(v)
+		return nil
+	case message.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case message.FieldEncryptionScheme:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptionScheme(v)
+		return nil
+	case message.FieldEncryptionVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptionVersion(v)
+		return nil
+	case message.FieldEdited:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEdited(v)
+		return nil
+	case message.FieldSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeq(v)
+		return nil
+	case message.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case message.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addsender_device_id != nil {
+		fields = append(fields, message.FieldSenderDeviceID)
+	}
+	if m.addencryption_version != nil {
+		fields = append(fields, message.FieldEncryptionVersion)
+	}
+	if m.addseq != nil {
+		fields = append(fields, message.FieldSeq)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldSenderDeviceID:
+		return m.AddedSenderDeviceID()
+	case message.FieldEncryptionVersion:
+		return m.AddedEncryptionVersion()
+	case message.FieldSeq:
+		return m.AddedSeq()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldSenderDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSenderDeviceID(v)
+		return nil
+	case message.FieldEncryptionVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEncryptionVersion(v)
+		return nil
+	case message.FieldSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeq(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldCipherText) {
+		fields = append(fields, message.FieldCipherText)
+	}
+	if m.FieldCleared(message.FieldSenderDeviceID) {
+		fields = append(fields, message.FieldSenderDeviceID)
+	}
+	if m.FieldCleared(message.FieldEncryptionVersion) {
+		fields = append(fields, message.FieldEncryptionVersion)
+	}
+	if m.FieldCleared(message.FieldSeq) {
+		fields = append(fields, message.FieldSeq)
+	}
+	if m.FieldCleared(message.FieldExpiresAt) {
+		fields = append(fields, message.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldCipherText:
+		m.ClearCipherText()
+		return nil
+	case message.FieldSenderDeviceID:
+		m.ClearSenderDeviceID()
+		return nil
+	case message.FieldEncryptionVersion:
+		m.ClearEncryptionVersion()
+		return nil
+	case message.FieldSeq:
+		m.ClearSeq()
+		return nil
+	case message.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldCipherText:
+		m.ResetCipherText()
+		return nil
+	case message.FieldSenderDeviceID:
+		m.ResetSenderDeviceID()
+		return nil
+	case message.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case message.FieldEncryptionScheme:
+		m.ResetEncryptionScheme()
+		return nil
+	case message.FieldEncryptionVersion:
+		m.ResetEncryptionVersion()
+		return nil
+	case message.FieldEdited:
+		m.ResetEdited()
+		return nil
+	case message.FieldSeq:
+		m.ResetSeq()
+		return nil
+	case message.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case message.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.sender != nil {
+		edges = append(edges, message.EdgeSender)
+	}
+	if m.room != nil {
+		edges = append(edges, message.EdgeRoom)
+	}
+	if m.media != nil {
+		edges = append(edges, message.EdgeMedia)
+	}
+	if m.envelopes != nil {
+		edges = append(edges, message.EdgeEnvelopes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeSender:
+		if id := m.sender; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.media))
+		for id := range m.media {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeEnvelopes:
+		ids := make([]ent.Value, 0, len(m.envelopes))
+		for id := range m.envelopes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedmedia != nil {
+		edges = append(edges, message.EdgeMedia)
+	}
+	if m.removedenvelopes != nil {
+		edges = append(edges, message.EdgeEnvelopes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.removedmedia))
+		for id := range m.removedmedia {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeEnvelopes:
+		ids := make([]ent.Value, 0, len(m.removedenvelopes))
+		for id := range m.removedenvelopes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedsender {
+		edges = append(edges, message.EdgeSender)
+	}
+	if m.clearedroom {
+		edges = append(edges, message.EdgeRoom)
+	}
+	if m.clearedmedia {
+		edges = append(edges, message.EdgeMedia)
+	}
+	if m.clearedenvelopes {
+		edges = append(edges, message.EdgeEnvelopes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeSender:
+		return m.clearedsender
+	case message.EdgeRoom:
+		return m.clearedroom
+	case message.EdgeMedia:
+		return m.clearedmedia
+	case message.EdgeEnvelopes:
+		return m.clearedenvelopes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeSender:
+		m.ClearSender()
+		return nil
+	case message.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeSender:
+		m.ResetSender()
+		return nil
+	case message.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case message.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	case message.EdgeEnvelopes:
+		m.ResetEnvelopes()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// MessageEnvelopeMutation represents an operation that mutates the MessageEnvelope nodes in the graph.
+type MessageEnvelopeMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	cipher_text    *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *int
+	clearedmessage bool
+	device         *int
+	cleareddevice  bool
+	done           bool
+	oldValue       func(context.Context) (*MessageEnvelope, error)
+	predicates     []predicate.MessageEnvelope
+}
+
+var _ ent.Mutation = (*MessageEnvelopeMutation)(nil)
+
+// messageenvelopeOption allows management of the mutation configuration using functional options.
+type messageenvelopeOption func(*MessageEnvelopeMutation)
+
+// newMessageEnvelopeMutation creates new mutation for the MessageEnvelope entity.
+func newMessageEnvelopeMutation(c config, op Op, opts ...messageenvelopeOption) *MessageEnvelopeMutation {
+	m := &MessageEnvelopeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageEnvelope,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageEnvelopeID sets the ID field of the mutation.
+func withMessageEnvelopeID(id int) messageenvelopeOption {
+	return func(m *MessageEnvelopeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageEnvelope
+		)
+		m.oldValue = func(ctx context.Context) (*MessageEnvelope, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageEnvelope.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageEnvelope sets the old MessageEnvelope of the mutation.
+func withMessageEnvelope(node *MessageEnvelope) messageenvelopeOption {
+	return func(m *MessageEnvelopeMutation) {
+		m.oldValue = func(context.Context) (*MessageEnvelope, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageEnvelopeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageEnvelopeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageEnvelopeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageEnvelopeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageEnvelope.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCipherText sets the "cipher_text" field.
+func (m *MessageEnvelopeMutation) SetCipherText(s string) {
+	m.cipher_text = &s
+}
+
+// CipherText returns the value of the "cipher_text" field in the mutation.
+func (m *MessageEnvelopeMutation) CipherText() (r string, exists bool) {
+	v := m.cipher_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCipherText returns the old "cipher_text" field's value of the MessageEnvelope entity.
+// If the MessageEnvelope object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageEnvelopeMutation) OldCipherText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCipherText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCipherText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCipherText: %w", err)
+	}
+	return oldValue.CipherText, nil
+}
+
+// ResetCipherText resets all changes to the "cipher_text" field.
+func (m *MessageEnvelopeMutation) ResetCipherText() {
+	m.cipher_text = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageEnvelopeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageEnvelopeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageEnvelope entity.
+// If the MessageEnvelope object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageEnvelopeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageEnvelopeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessageEnvelopeMutation) SetMessageID(id int) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageEnvelopeMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageEnvelopeMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessageEnvelopeMutation) MessageID() (id int, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageEnvelopeMutation) MessageIDs() (ids []int) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageEnvelopeMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by id.
+func (m *MessageEnvelopeMutation) SetDeviceID(id int) {
+	m.device = &id
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (m *MessageEnvelopeMutation) ClearDevice() {
+	m.cleareddevice = true
+}
+
+// DeviceCleared reports if the "device" edge to the Device entity was cleared.
+func (m *MessageEnvelopeMutation) DeviceCleared() bool {
+	return m.cleareddevice
+}
+
+// DeviceID returns the "device" edge ID in the mutation.
+func (m *MessageEnvelopeMutation) DeviceID() (id int, exists bool) {
+	if m.device != nil {
+		return *m.device, true
+	}
+	return
+}
+
+// DeviceIDs returns the "device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeviceID instead. It exists only for internal usage by the builders.
+func (m *MessageEnvelopeMutation) DeviceIDs() (ids []int) {
+	if id := m.device; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDevice resets all changes to the "device" edge.
+func (m *MessageEnvelopeMutation) ResetDevice() {
+	m.device = nil
+	m.cleareddevice = false
+}
+
+// Where appends a list predicates to the MessageEnvelopeMutation builder.
+func (m *MessageEnvelopeMutation) Where(ps ...predicate.MessageEnvelope) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageEnvelopeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageEnvelopeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageEnvelope, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageEnvelopeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageEnvelopeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageEnvelope).
+func (m *MessageEnvelopeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageEnvelopeMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.cipher_text != nil {
+		fields = append(fields, messageenvelope.FieldCipherText)
+	}
+	if m.created_at != nil {
+		fields = append(fields, messageenvelope.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageEnvelopeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messageenvelope.FieldCipherText:
+		return m.CipherText()
+	case messageenvelope.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageEnvelopeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messageenvelope.FieldCipherText:
+		return m.OldCipherText(ctx)
+	case messageenvelope.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageEnvelope field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageEnvelopeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messageenvelope.FieldCipherText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCipherText(v)
+		return nil
+	case messageenvelope.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageEnvelope field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageEnvelopeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageEnvelopeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageEnvelopeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageEnvelope numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageEnvelopeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageEnvelopeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageEnvelopeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageEnvelope nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageEnvelopeMutation) ResetField(name string) error {
+	switch name {
+	case messageenvelope.FieldCipherText:
+		m.ResetCipherText()
+		return nil
+	case messageenvelope.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageEnvelope field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageEnvelopeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.message != nil {
+		edges = append(edges, messageenvelope.EdgeMessage)
+	}
+	if m.device != nil {
+		edges = append(edges, messageenvelope.EdgeDevice)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageEnvelopeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messageenvelope.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messageenvelope.EdgeDevice:
+		if id := m.device; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageEnvelopeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageEnvelopeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageEnvelopeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessage {
+		edges = append(edges, messageenvelope.EdgeMessage)
+	}
+	if m.cleareddevice {
+		edges = append(edges, messageenvelope.EdgeDevice)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageEnvelopeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messageenvelope.EdgeMessage:
+		return m.clearedmessage
+	case messageenvelope.EdgeDevice:
+		return m.cleareddevice
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageEnvelopeMutation) ClearEdge(name string) error {
+	switch name {
+	case messageenvelope.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case messageenvelope.EdgeDevice:
+		m.ClearDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageEnvelope unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageEnvelopeMutation) ResetEdge(name string) error {
+	switch name {
+	case messageenvelope.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messageenvelope.EdgeDevice:
+		m.ResetDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageEnvelope edge %s", name)
+}
+
+// MlsCommitMutation represents an operation that mutates the MlsCommit nodes in the graph.
+type MlsCommitMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	epoch               *int
+	addepoch            *int
+	sender_device_id    *int
+	addsender_device_id *int
+	message             *string
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	room                *int
+	clearedroom         bool
+	sender              *int
+	clearedsender       bool
+	done                bool
+	oldValue            func(context.Context) (*MlsCommit, error)
+	predicates          []predicate.MlsCommit
+}
+
+var _ ent.Mutation = (*MlsCommitMutation)(nil)
+
+// mlscommitOption allows management of the mutation configuration using functional options.
+type mlscommitOption func(*MlsCommitMutation)
+
+// newMlsCommitMutation creates new mutation for the MlsCommit entity.
+func newMlsCommitMutation(c config, op Op, opts ...mlscommitOption) *MlsCommitMutation {
+	m := &MlsCommitMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMlsCommit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMlsCommitID sets the ID field of the mutation.
+func withMlsCommitID(id int) mlscommitOption {
+	return func(m *MlsCommitMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MlsCommit
+		)
+		m.oldValue = func(ctx context.Context) (*MlsCommit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MlsCommit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMlsCommit sets the old MlsCommit of the mutation.
+func withMlsCommit(node *MlsCommit) mlscommitOption {
+	return func(m *MlsCommitMutation) {
+		m.oldValue = func(context.Context) (*MlsCommit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MlsCommitMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MlsCommitMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MlsCommitMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MlsCommitMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MlsCommit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEpoch sets the "epoch" field.
+func (m *MlsCommitMutation) SetEpoch(i int) {
+	m.epoch = &i
+	m.addepoch = nil
+}
+
+// Epoch returns the value of the "epoch" field in the mutation.
+func (m *MlsCommitMutation) Epoch() (r int, exists bool) {
+	v := m.epoch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEpoch returns the old "epoch" field's value of the MlsCommit entity.
+// If the MlsCommit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MlsCommitMutation) OldEpoch(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEpoch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEpoch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEpoch: %w", err)
+	}
+	return oldValue.Epoch, nil
+}
+
+// AddEpoch adds i to the "epoch" field.
+func (m *MlsCommitMutation) AddEpoch(i int) {
+	if m.addepoch != nil {
+		*m.addepoch += i
+	} else {
+		m.addepoch = &i
+	}
+}
+
+// AddedEpoch returns the value that was added to the "epoch" field in this mutation.
+func (m *MlsCommitMutation) AddedEpoch() (r int, exists bool) {
+	v := m.addepoch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEpoch resets all changes to the "epoch" field.
+func (m *MlsCommitMutation) ResetEpoch() {
+	m.epoch = nil
+	m.addepoch = nil
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (m *MlsCommitMutation) SetSenderDeviceID(i int) {
+	m.sender_device_id = &i
+	m.addsender_device_id = nil
+}
+
+// SenderDeviceID returns the value of the "sender_device_id" field in the mutation.
+func (m *MlsCommitMutation) SenderDeviceID() (r int, exists bool) {
+	v := m.sender_device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderDeviceID returns the old "sender_device_id" field's value of the MlsCommit entity.
+// If the MlsCommit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MlsCommitMutation) OldSenderDeviceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderDeviceID: %w", err)
+	}
+	return oldValue.SenderDeviceID, nil
+}
+
+// AddSenderDeviceID adds i to the "sender_device_id" field.
+func (m *MlsCommitMutation) AddSenderDeviceID(i int) {
+	if m.addsender_device_id != nil {
+		*m.addsender_device_id += i
+	} else {
+		m.addsender_device_id = &i
+	}
+}
+
+// AddedSenderDeviceID returns the value that was added to the "sender_device_id" field in this mutation.
+func (m *MlsCommitMutation) AddedSenderDeviceID() (r int, exists bool) {
+	v := m.addsender_device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSenderDeviceID resets all changes to the "sender_device_id" field.
+func (m *MlsCommitMutation) ResetSenderDeviceID() {
+	m.sender_device_id = nil
+	m.addsender_device_id = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *MlsCommitMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *MlsCommitMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the MlsCommit entity.
+// If the MlsCommit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MlsCommitMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *MlsCommitMutation) ResetMessage() {
+	m.message = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MlsCommitMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MlsCommitMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MlsCommit entity.
+// If the MlsCommit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MlsCommitMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MlsCommitMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *MlsCommitMutation) SetRoomID(id int) {
+	m.room = &id
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *MlsCommitMutation) ClearRoom() {
+	m.clearedroom = true
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *MlsCommitMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *MlsCommitMutation) RoomID() (id int, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *MlsCommitMutation) RoomIDs() (ids []int) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *MlsCommitMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// SetSenderID sets the "sender" edge to the User entity by id.
+func (m *MlsCommitMutation) SetSenderID(id int) {
+	m.sender = &id
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (m *MlsCommitMutation) ClearSender() {
+	m.clearedsender = true
+}
+
+// SenderCleared reports if the "sender" edge to the User entity was cleared.
+func (m *MlsCommitMutation) SenderCleared() bool {
+	return m.clearedsender
+}
+
+// SenderID returns the "sender" edge ID in the mutation.
+func (m *MlsCommitMutation) SenderID() (id int, exists bool) {
+	if m.sender != nil {
+		return *m.sender, true
+	}
+	return
+}
+
+// SenderIDs returns the "sender" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SenderID instead. It exists only for internal usage by the builders.
+func (m *MlsCommitMutation) SenderIDs() (ids []int) {
+	if id := m.sender; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSender resets all changes to the "sender" edge.
+func (m *MlsCommitMutation) ResetSender() {
+	m.sender = nil
+	m.clearedsender = false
+}
+
+// Where appends a list predicates to the MlsCommitMutation builder.
+func (m *MlsCommitMutation) Where(ps ...predicate.MlsCommit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MlsCommitMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MlsCommitMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MlsCommit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MlsCommitMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MlsCommitMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MlsCommit).
+func (m *MlsCommitMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MlsCommitMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.epoch != nil {
+		fields = append(fields, mlscommit.FieldEpoch)
+	}
+	if m.sender_device_id != nil {
+		fields = append(fields, mlscommit.FieldSenderDeviceID)
+	}
+	if m.message != nil {
+		fields = append(fields, mlscommit.FieldMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, mlscommit.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MlsCommitMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mlscommit.FieldEpoch:
+		return m.Epoch()
+	case mlscommit.FieldSenderDeviceID:
+		return m.SenderDeviceID()
+	case mlscommit.FieldMessage:
+		return m.Message()
+	case mlscommit.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MlsCommitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mlscommit.FieldEpoch:
+		return m.OldEpoch(ctx)
+	case mlscommit.FieldSenderDeviceID:
+		return m.OldSenderDeviceID(ctx)
+	case mlscommit.FieldMessage:
+		return m.OldMessage(ctx)
+	case mlscommit.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MlsCommit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MlsCommitMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mlscommit.FieldEpoch:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEpoch(v)
+		return nil
+	case mlscommit.FieldSenderDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderDeviceID(v)
+		return nil
+	case mlscommit.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case mlscommit.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MlsCommit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MlsCommitMutation) AddedFields() []string {
+	var fields []string
+	if m.addepoch != nil {
+		fields = append(fields, mlscommit.FieldEpoch)
+	}
+	if m.addsender_device_id != nil {
+		fields = append(fields, mlscommit.FieldSenderDeviceID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MlsCommitMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case mlscommit.FieldEpoch:
+		return m.AddedEpoch()
+	case mlscommit.FieldSenderDeviceID:
+		return m.AddedSenderDeviceID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MlsCommitMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case mlscommit.FieldEpoch:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEpoch(v)
+		return nil
+	case mlscommit.FieldSenderDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSenderDeviceID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MlsCommit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MlsCommitMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MlsCommitMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MlsCommitMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MlsCommit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MlsCommitMutation) ResetField(name string) error {
+	switch name {
+	case mlscommit.FieldEpoch:
+		m.ResetEpoch()
+		return nil
+	case mlscommit.FieldSenderDeviceID:
+		m.ResetSenderDeviceID()
+		return nil
+	case mlscommit.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case mlscommit.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MlsCommit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MlsCommitMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.room != nil {
+		edges = append(edges, mlscommit.EdgeRoom)
+	}
+	if m.sender != nil {
+		edges = append(edges, mlscommit.EdgeSender)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MlsCommitMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mlscommit.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case mlscommit.EdgeSender:
+		if id := m.sender; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MlsCommitMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MlsCommitMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MlsCommitMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedroom {
+		edges = append(edges, mlscommit.EdgeRoom)
+	}
+	if m.clearedsender {
+		edges = append(edges, mlscommit.EdgeSender)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MlsCommitMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mlscommit.EdgeRoom:
+		return m.clearedroom
+	case mlscommit.EdgeSender:
+		return m.clearedsender
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MlsCommitMutation) ClearEdge(name string) error {
+	switch name {
+	case mlscommit.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case mlscommit.EdgeSender:
+		m.ClearSender()
+		return nil
+	}
+	return fmt.Errorf("unknown MlsCommit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MlsCommitMutation) ResetEdge(name string) error {
+	switch name {
+	case mlscommit.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case mlscommit.EdgeSender:
+		m.ResetSender()
+		return nil
+	}
+	return fmt.Errorf("unknown MlsCommit edge %s", name)
+}
+
+// MlsWelcomeMutation represents an operation that mutates the MlsWelcome nodes in the graph.
+type MlsWelcomeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	epoch         *int
+	addepoch      *int
+	message       *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	room          *int
+	clearedroom   bool
+	sender        *int
+	clearedsender bool
+	device        *int
+	cleareddevice bool
+	done          bool
+	oldValue      func(context.Context) (*MlsWelcome, error)
+	predicates    []predicate.MlsWelcome
+}
+
+var _ ent.Mutation = (*MlsWelcomeMutation)(nil)
+
+// mlswelcomeOption allows management of the mutation configuration using functional options.
+type mlswelcomeOption func(*MlsWelcomeMutation)
+
+// newMlsWelcomeMutation creates new mutation for the MlsWelcome entity.
+func newMlsWelcomeMutation(c config, op Op, opts ...mlswelcomeOption) *MlsWelcomeMutation {
+	m := &MlsWelcomeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMlsWelcome,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMlsWelcomeID sets the ID field of the mutation.
+func withMlsWelcomeID(id int) mlswelcomeOption {
+	return func(m *MlsWelcomeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MlsWelcome
+		)
+		m.oldValue = func(ctx context.Context) (*MlsWelcome, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MlsWelcome.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMlsWelcome sets the old MlsWelcome of the mutation.
+func withMlsWelcome(node *MlsWelcome) mlswelcomeOption {
+	return func(m *MlsWelcomeMutation) {
+		m.oldValue = func(context.Context) (*MlsWelcome, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MlsWelcomeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MlsWelcomeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MlsWelcomeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MlsWelcomeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MlsWelcome.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEpoch sets the "epoch" field.
+func (m *MlsWelcomeMutation) SetEpoch(i int) {
+	m.epoch = &i
+	m.addepoch = nil
+}
+
+// Epoch returns the value of the "epoch" field in the mutation.
+func (m *MlsWelcomeMutation) Epoch() (r int, exists bool) {
+	v := m.epoch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEpoch returns the old "epoch" field's value of the MlsWelcome entity.
+// If the MlsWelcome object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MlsWelcomeMutation) OldEpoch(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEpoch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEpoch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEpoch: %w", err)
+	}
+	return oldValue.Epoch, nil
+}
+
+// AddEpoch adds i to the "epoch" field.
+func (m *MlsWelcomeMutation) AddEpoch(i int) {
+	if m.addepoch != nil {
+		*m.addepoch += i
+	} else {
+		m.addepoch = &i
+	}
+}
+
+// AddedEpoch returns the value that was added to the "epoch" field in this mutation.
+func (m *MlsWelcomeMutation) AddedEpoch() (r int, exists bool) {
+	v := m.addepoch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEpoch resets all changes to the "epoch" field.
+func (m *MlsWelcomeMutation) ResetEpoch() {
+	m.epoch = nil
+	m.addepoch = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *MlsWelcomeMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *MlsWelcomeMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the MlsWelcome entity.
+// If the MlsWelcome object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MlsWelcomeMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *MlsWelcomeMutation) ResetMessage() {
+	m.message = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MlsWelcomeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MlsWelcomeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MlsWelcome entity.
+// If the MlsWelcome object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MlsWelcomeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MlsWelcomeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *MlsWelcomeMutation) SetRoomID(id int) {
+	m.room = &id
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *MlsWelcomeMutation) ClearRoom() {
+	m.clearedroom = true
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *MlsWelcomeMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *MlsWelcomeMutation) RoomID() (id int, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *MlsWelcomeMutation) RoomIDs() (ids []int) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *MlsWelcomeMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// SetSenderID sets the "sender" edge to the User entity by id.
+func (m *MlsWelcomeMutation) SetSenderID(id int) {
+	m.sender = &id
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (m *MlsWelcomeMutation) ClearSender() {
+	m.clearedsender = true
+}
+
+// SenderCleared reports if the "sender" edge to the User entity was cleared.
+func (m *MlsWelcomeMutation) SenderCleared() bool {
+	return m.clearedsender
+}
+
+// SenderID returns the "sender" edge ID in the mutation.
+func (m *MlsWelcomeMutation) SenderID() (id int, exists bool) {
+	if m.sender != nil {
+		return *m.sender, true
+	}
+	return
+}
+
+// SenderIDs returns the "sender" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SenderID instead. It exists only for internal usage by the builders.
+func (m *MlsWelcomeMutation) SenderIDs() (ids []int) {
+	if id := m.sender; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSender resets all changes to the "sender" edge.
+func (m *MlsWelcomeMutation) ResetSender() {
+	m.sender = nil
+	m.clearedsender = false
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by id.
+func (m *MlsWelcomeMutation) SetDeviceID(id int) {
+	m.device = &id
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (m *MlsWelcomeMutation) ClearDevice() {
+	m.cleareddevice = true
+}
+
+// DeviceCleared reports if the "device" edge to the Device entity was cleared.
+func (m *MlsWelcomeMutation) DeviceCleared() bool {
+	return m.cleareddevice
+}
+
+// DeviceID returns the "device" edge ID in the mutation.
+func (m *MlsWelcomeMutation) DeviceID() (id int, exists bool) {
+	if m.device != nil {
+		return *m.device, true
+	}
+	return
+}
+
+// DeviceIDs returns the "device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeviceID instead. It exists only for internal usage by the builders.
+func (m *MlsWelcomeMutation) DeviceIDs() (ids []int) {
+	if id := m.device; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDevice resets all changes to the "device" edge.
+func (m *MlsWelcomeMutation) ResetDevice() {
+	m.device = nil
+	m.cleareddevice = false
+}
+
+// Where appends a list predicates to the MlsWelcomeMutation builder.
+func (m *MlsWelcomeMutation) Where(ps ...predicate.MlsWelcome) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MlsWelcomeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MlsWelcomeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MlsWelcome, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MlsWelcomeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MlsWelcomeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MlsWelcome).
+func (m *MlsWelcomeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MlsWelcomeMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.epoch != nil {
+		fields = append(fields, mlswelcome.FieldEpoch)
+	}
+	if m.message != nil {
+		fields = append(fields, mlswelcome.FieldMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, mlswelcome.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MlsWelcomeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mlswelcome.FieldEpoch:
+		return m.Epoch()
+	case mlswelcome.FieldMessage:
+		return m.Message()
+	case mlswelcome.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MlsWelcomeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mlswelcome.FieldEpoch:
+		return m.OldEpoch(ctx)
+	case mlswelcome.FieldMessage:
+		return m.OldMessage(ctx)
+	case mlswelcome.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MlsWelcome field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MlsWelcomeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mlswelcome.FieldEpoch:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEpoch(v)
+		return nil
+	case mlswelcome.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case mlswelcome.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MlsWelcome field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MlsWelcomeMutation) AddedFields() []string {
+	var fields []string
+	if m.addepoch != nil {
+		fields = append(fields, mlswelcome.FieldEpoch)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MlsWelcomeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case mlswelcome.FieldEpoch:
+		return m.AddedEpoch()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MlsWelcomeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case mlswelcome.FieldEpoch:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEpoch(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MlsWelcome numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MlsWelcomeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MlsWelcomeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MlsWelcomeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MlsWelcome nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MlsWelcomeMutation) ResetField(name string) error {
+	switch name {
+	case mlswelcome.FieldEpoch:
+		m.ResetEpoch()
+		return nil
+	case mlswelcome.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case mlswelcome.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MlsWelcome field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MlsWelcomeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.room != nil {
+		edges = append(edges, mlswelcome.EdgeRoom)
+	}
+	if m.sender != nil {
+		edges = append(edges, mlswelcome.EdgeSender)
+	}
+	if m.device != nil {
+		edges = append(edges, mlswelcome.EdgeDevice)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MlsWelcomeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mlswelcome.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case mlswelcome.EdgeSender:
+		if id := m.sender; id != nil {
+			return []ent.Value{*id}
+		}
+	case mlswelcome.EdgeDevice:
+		if id := m.device; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MlsWelcomeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MlsWelcomeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MlsWelcomeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedroom {
+		edges = append(edges, mlswelcome.EdgeRoom)
+	}
+	if m.clearedsender {
+		edges = append(edges, mlswelcome.EdgeSender)
+	}
+	if m.cleareddevice {
+		edges = append(edges, mlswelcome.EdgeDevice)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MlsWelcomeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mlswelcome.EdgeRoom:
+		return m.clearedroom
+	case mlswelcome.EdgeSender:
+		return m.clearedsender
+	case mlswelcome.EdgeDevice:
+		return m.cleareddevice
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MlsWelcomeMutation) ClearEdge(name string) error {
+	switch name {
+	case mlswelcome.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case mlswelcome.EdgeSender:
+		m.ClearSender()
+		return nil
+	case mlswelcome.EdgeDevice:
+		m.ClearDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown MlsWelcome unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MlsWelcomeMutation) ResetEdge(name string) error {
+	switch name {
+	case mlswelcome.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case mlswelcome.EdgeSender:
+		m.ResetSender()
+		return nil
+	case mlswelcome.EdgeDevice:
+		m.ResetDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown MlsWelcome edge %s", name)
+}
+
+// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
+type NotificationMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	kind                  *string
+	cipher_text           *string
+	encryption_scheme     *string
+	encryption_version    *int
+	addencryption_version *int
+	read                  *bool
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	recipient             *int
+	clearedrecipient      bool
+	room                  *int
+	clearedroom           bool
+	message               *int
+	clearedmessage        bool
+	done                  bool
+	oldValue              func(context.Context) (*Notification, error)
+	predicates            []predicate.Notification
+}
+
+var _ ent.Mutation = (*NotificationMutation)(nil)
+
+// notificationOption allows management of the mutation configuration using functional options.
+type notificationOption func(*NotificationMutation)
+
+// newNotificationMutation creates new mutation for the Notification entity.
+func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
+	m := &NotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationID sets the ID field of the mutation.
+func withNotificationID(id int) notificationOption {
+	return func(m *NotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notification
+		)
+		m.oldValue = func(ctx context.Context) (*Notification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotification sets the old Notification of the mutation.
+func withNotification(node *Notification) notificationOption {
+	return func(m *NotificationMutation) {
+		m.oldValue = func(context.Context) (*Notification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKind sets the "kind" field.
+func (m *NotificationMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *NotificationMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *NotificationMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCipherText sets the "cipher_text" field.
+func (m *NotificationMutation) SetCipherText(s string) {
+	m.cipher_text = &s
+}
+
+// CipherText returns the value of the "cipher_text" field in the mutation.
+func (m *NotificationMutation) CipherText() (r string, exists bool) {
+	v := m.cipher_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCipherText returns the old "cipher_text" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCipherText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCipherText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCipherText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCipherText: %w", err)
+	}
+	return oldValue.CipherText, nil
+}
+
+// ResetCipherText resets all changes to the "cipher_text" field.
+func (m *NotificationMutation) ResetCipherText() {
+	m.cipher_text = nil
+}
+
+// SetEncryptionScheme sets the "encryption_scheme" field.
+func (m *NotificationMutation) SetEncryptionScheme(s string) {
+	m.encryption_scheme = &s
+}
+
+// EncryptionScheme returns the value of the "encryption_scheme" field in the mutation.
+func (m *NotificationMutation) EncryptionScheme() (r string, exists bool) {
+	v := m.encryption_scheme
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptionScheme returns the old "encryption_scheme" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldEncryptionScheme(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptionScheme is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptionScheme requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptionScheme: %w", err)
+	}
+	return oldValue.EncryptionScheme, nil
+}
+
+// ResetEncryptionScheme resets all changes to the "encryption_scheme" field.
+func (m *NotificationMutation) ResetEncryptionScheme() {
+	m.encryption_scheme = nil
+}
+
+// SetEncryptionVersion sets the "encryption_version" field.
+func (m *NotificationMutation) SetEncryptionVersion(i int) {
+	m.encryption_version = &i
+	m.addencryption_version = nil
+}
+
+// EncryptionVersion returns the value of the "encryption_version" field in the mutation.
+func (m *NotificationMutation) EncryptionVersion() (r int, exists bool) {
+	v := m.encryption_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptionVersion returns the old "encryption_version" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldEncryptionVersion(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptionVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptionVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptionVersion: %w", err)
+	}
+	return oldValue.EncryptionVersion, nil
+}
+
+// AddEncryptionVersion adds i to the "encryption_version" field.
+func (m *NotificationMutation) AddEncryptionVersion(i int) {
+	if m.addencryption_version != nil {
+		*m.addencryption_version += i
+	} else {
+		m.addencryption_version = &i
+	}
+}
+
+// AddedEncryptionVersion returns the value that was added to the "encryption_version" field in this mutation.
+func (m *NotificationMutation) AddedEncryptionVersion() (r int, exists bool) {
+	v := m.addencryption_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEncryptionVersion clears the value of the "encryption_version" field.
+func (m *NotificationMutation) ClearEncryptionVersion() {
+	m.encryption_version = nil
+	m.addencryption_version = nil
+	m.clearedFields[notification.FieldEncryptionVersion] = struct{}{}
+}
+
+// EncryptionVersionCleared returns if the "encryption_version" field was cleared in this mutation.
+func (m *NotificationMutation) EncryptionVersionCleared() bool {
+	_, ok := m.clearedFields[notification.FieldEncryptionVersion]
+	return ok
+}
+
+// ResetEncryptionVersion resets all changes to the "encryption_version" field.
+func (m *NotificationMutation) ResetEncryptionVersion() {
+	m.encryption_version = nil
+	m.addencryption_version = nil
+	delete(m.clearedFields, notification.FieldEncryptionVersion)
+}
+
+// SetRead sets the "read" field.
+func (m *NotificationMutation) SetRead(b bool) {
+	m.read = &b
+}
+
+// Read returns the value of the "read" field in the mutation.
+func (m *NotificationMutation) Read() (r bool, exists bool) {
+	v := m.read
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRead returns the old "read" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldRead(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRead is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRead requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRead: %w", err)
+	}
+	return oldValue.Read, nil
+}
+
+// ResetRead resets all changes to the "read" field.
+func (m *NotificationMutation) ResetRead() {
+	m.read = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRecipientID sets the "recipient" edge to the User entity by id.
+func (m *NotificationMutation) SetRecipientID(id int) {
+	m.recipient = &id
+}
+
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (m *NotificationMutation) ClearRecipient() {
+	m.clearedrecipient = true
+}
+
+// RecipientCleared reports if the "recipient" edge to the User entity was cleared.
+func (m *NotificationMutation) RecipientCleared() bool {
+	return m.clearedrecipient
+}
+
+// RecipientID returns the "recipient" edge ID in the mutation.
+func (m *NotificationMutation) RecipientID() (id int, exists bool) {
+	if m.recipient != nil {
+		return *m.recipient, true
+	}
+	return
+}
+
+// RecipientIDs returns the "recipient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RecipientID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) RecipientIDs() (ids []int) {
+	if id := m.recipient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRecipient resets all changes to the "recipient" edge.
+func (m *NotificationMutation) ResetRecipient() {
+	m.recipient = nil
+	m.clearedrecipient = false
+}
+
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *NotificationMutation) SetRoomID(id int) {
+	m.room = &id
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *NotificationMutation) ClearRoom() {
+	m.clearedroom = true
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *NotificationMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *NotificationMutation) RoomID() (id int, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) RoomIDs() (ids []int) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *NotificationMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *NotificationMutation) SetMessageID(id int) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *NotificationMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *NotificationMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *NotificationMutation) MessageID() (id int, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) MessageIDs() (ids []int) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *NotificationMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the NotificationMutation builder.
+func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Notification).
+func (m *NotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.kind != nil {
+		fields = append(fields, notification.FieldKind)
+	}
+	if m.cipher_text != nil {
+		fields = append(fields, notification.FieldCipherText)
+	}
+	if m.encryption_scheme != nil {
+		fields = append(fields, notification.FieldEncryptionScheme)
+	}
+	if m.encryption_version != nil {
+		fields = append(fields, notification.FieldEncryptionVersion)
+	}
+	if m.read != nil {
+		fields = append(fields, notification.FieldRead)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notification.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notification.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldKind:
+		return m.Kind()
+	case notification.FieldCipherText:
+		return m.CipherText()
+	case notification.FieldEncryptionScheme:
+		return m.EncryptionScheme()
+	case notification.FieldEncryptionVersion:
+		return m.EncryptionVersion()
+	case notification.FieldRead:
+		return m.Read()
+	case notification.FieldCreatedAt:
+		return m.CreatedAt()
+	case notification.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notification.FieldKind:
+		return m.OldKind(ctx)
+	case notification.FieldCipherText:
+		return m.OldCipherText(ctx)
+	case notification.FieldEncryptionScheme:
+		return m.OldEncryptionScheme(ctx)
+	case notification.FieldEncryptionVersion:
+		return m.OldEncryptionVersion(ctx)
+	case notification.FieldRead:
+		return m.OldRead(ctx)
+	case notification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notification.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case notification.FieldCipherText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCipherText(v)
+		return nil
+	case notification.FieldEncryptionScheme:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptionScheme(v)
+		return nil
+	case notification.FieldEncryptionVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptionVersion(v)
+		return nil
+	case notification.FieldRead:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRead(v)
+		return nil
+	case notification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notification.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationMutation) AddedFields() []string {
+	var fields []string
+	if m.addencryption_version != nil {
+		fields = append(fields, notification.FieldEncryptionVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldEncryptionVersion:
+		return m.AddedEncryptionVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldEncryptionVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEncryptionVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notification.FieldEncryptionVersion) {
+		fields = append(fields, notification.FieldEncryptionVersion)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationMutation) ClearField(name string) error {
+	switch name {
+	case notification.FieldEncryptionVersion:
+		m.ClearEncryptionVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationMutation) ResetField(name string) error {
+	switch name {
+	case notification.FieldKind:
+		m.ResetKind()
+		return nil
+	case notification.FieldCipherText:
+		m.ResetCipherText()
+		return nil
+	case notification.FieldEncryptionScheme:
+		m.ResetEncryptionScheme()
+		return nil
+	case notification.FieldEncryptionVersion:
+		m.ResetEncryptionVersion()
+		return nil
+	case notification.FieldRead:
+		m.ResetRead()
+		return nil
+	case notification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notification.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.recipient != nil {
+		edges = append(edges, notification.EdgeRecipient)
+	}
+	if m.room != nil {
+		edges = append(edges, notification.EdgeRoom)
+	}
+	if m.message != nil {
+		edges = append(edges, notification.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notification.EdgeRecipient:
+		if id := m.recipient; id != nil {
+			return []ent.Value{*id}
+		}
+	case notification.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case notification.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedrecipient {
+		edges = append(edges, notification.EdgeRecipient)
+	}
+	if m.clearedroom {
+		edges = append(edges, notification.EdgeRoom)
+	}
+	if m.clearedmessage {
+		edges = append(edges, notification.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notification.EdgeRecipient:
+		return m.clearedrecipient
+	case notification.EdgeRoom:
+		return m.clearedroom
+	case notification.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationMutation) ClearEdge(name string) error {
+	switch name {
+	case notification.EdgeRecipient:
+		m.ClearRecipient()
+		return nil
+	case notification.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case notification.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationMutation) ResetEdge(name string) error {
+	switch name {
+	case notification.EdgeRecipient:
+		m.ResetRecipient()
+		return nil
+	case notification.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case notification.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification edge %s", name)
+}
+
+// OneTimePreKeyMutation represents an operation that mutates the OneTimePreKey nodes in the graph.
+type OneTimePreKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key_id        *int
+	addkey_id     *int
+	public_key    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	device        *int
+	cleareddevice bool
+	done          bool
+	oldValue      func(context.Context) (*OneTimePreKey, error)
+	predicates    []predicate.OneTimePreKey
+}
+
+var _ ent.Mutation = (*OneTimePreKeyMutation)(nil)
+
+// onetimeprekeyOption allows management of the mutation configuration using functional options.
+type onetimeprekeyOption func(*OneTimePreKeyMutation)
+
+// newOneTimePreKeyMutation creates new mutation for the OneTimePreKey entity.
+func newOneTimePreKeyMutation(c config, op Op, opts ...onetimeprekeyOption) *OneTimePreKeyMutation {
+	m := &OneTimePreKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOneTimePreKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOneTimePreKeyID sets the ID field of the mutation.
+func withOneTimePreKeyID(id int) onetimeprekeyOption {
+	return func(m *OneTimePreKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OneTimePreKey
+		)
+		m.oldValue = func(ctx context.Context) (*OneTimePreKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OneTimePreKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOneTimePreKey sets the old OneTimePreKey of the mutation.
+func withOneTimePreKey(node *OneTimePreKey) onetimeprekeyOption {
+	return func(m *OneTimePreKeyMutation) {
+		m.oldValue = func(context.Context) (*OneTimePreKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OneTimePreKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OneTimePreKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OneTimePreKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OneTimePreKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OneTimePreKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKeyID sets the "key_id" field.
+func (m *OneTimePreKeyMutation) SetKeyID(i int) {
+	m.key_id = &i
+	m.addkey_id = nil
+}
+
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *OneTimePreKeyMutation) KeyID() (r int, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyID returns the old "key_id" field's value of the OneTimePreKey entity.
+// If the OneTimePreKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OneTimePreKeyMutation) OldKeyID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
+	}
+	return oldValue.KeyID, nil
+}
+
+// AddKeyID adds i to the "key_id" field.
+func (m *OneTimePreKeyMutation) AddKeyID(i int) {
+	if m.addkey_id != nil {
+		*m.addkey_id += i
+	} else {
+		m.addkey_id = &i
+	}
+}
+
+// AddedKeyID returns the value that was added to the "key_id" field in this mutation.
+func (m *OneTimePreKeyMutation) AddedKeyID() (r int, exists bool) {
+	v := m.addkey_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *OneTimePreKeyMutation) ResetKeyID() {
+	m.key_id = nil
+	m.addkey_id = nil
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *OneTimePreKeyMutation) SetPublicKey(s string) {
+	m.public_key = &s
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *OneTimePreKeyMutation) PublicKey() (r string, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the OneTimePreKey entity.
+// If the OneTimePreKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OneTimePreKeyMutation) OldPublicKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *OneTimePreKeyMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OneTimePreKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OneTimePreKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OneTimePreKey entity.
+// If the OneTimePreKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OneTimePreKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OneTimePreKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by id.
+func (m *OneTimePreKeyMutation) SetDeviceID(id int) {
+	m.device = &id
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (m *OneTimePreKeyMutation) ClearDevice() {
+	m.cleareddevice = true
+}
+
+// DeviceCleared reports if the "device" edge to the Device entity was cleared.
+func (m *OneTimePreKeyMutation) DeviceCleared() bool {
+	return m.cleareddevice
+}
+
+// DeviceID returns the "device" edge ID in the mutation.
+func (m *OneTimePreKeyMutation) DeviceID() (id int, exists bool) {
+	if m.device != nil {
+		return *m.device, true
+	}
+	return
+}
+
+// DeviceIDs returns the "device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeviceID instead. It exists only for internal usage by the builders.
+func (m *OneTimePreKeyMutation) DeviceIDs() (ids []int) {
+	if id := m.device; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDevice resets all changes to the "device" edge.
+func (m *OneTimePreKeyMutation) ResetDevice() {
+	m.device = nil
+	m.cleareddevice = false
+}
+
+// Where appends a list predicates to the OneTimePreKeyMutation builder.
+func (m *OneTimePreKeyMutation) Where(ps ...predicate.OneTimePreKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OneTimePreKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OneTimePreKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OneTimePreKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OneTimePreKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OneTimePreKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OneTimePreKey).
+func (m *OneTimePreKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OneTimePreKeyMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.key_id != nil {
+		fields = append(fields, onetimeprekey.FieldKeyID)
+	}
+	if m.public_key != nil {
+		fields = append(fields, onetimeprekey.FieldPublicKey)
+	}
+	if m.created_at != nil {
+		fields = append(fields, onetimeprekey.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OneTimePreKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case onetimeprekey.FieldKeyID:
+		return m.KeyID()
+	case onetimeprekey.FieldPublicKey:
+		return m.PublicKey()
+	case onetimeprekey.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OneTimePreKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case onetimeprekey.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case onetimeprekey.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case onetimeprekey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OneTimePreKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OneTimePreKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case onetimeprekey.FieldKeyID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyID(v)
+		return nil
+	case onetimeprekey.FieldPublicKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case onetimeprekey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OneTimePreKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OneTimePreKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addkey_id != nil {
+		fields = append(fields, onetimeprekey.FieldKeyID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OneTimePreKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case onetimeprekey.FieldKeyID:
+		return m.AddedKeyID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OneTimePreKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case onetimeprekey.FieldKeyID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeyID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OneTimePreKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OneTimePreKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OneTimePreKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OneTimePreKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OneTimePreKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OneTimePreKeyMutation) ResetField(name string) error {
+	switch name {
+	case onetimeprekey.FieldKeyID:
+		m.ResetKeyID()
+		return nil
+	case onetimeprekey.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case onetimeprekey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OneTimePreKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OneTimePreKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.device != nil {
+		edges = append(edges, onetimeprekey.EdgeDevice)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OneTimePreKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case onetimeprekey.EdgeDevice:
+		if id := m.device; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OneTimePreKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OneTimePreKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OneTimePreKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddevice {
+		edges = append(edges, onetimeprekey.EdgeDevice)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OneTimePreKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case onetimeprekey.EdgeDevice:
+		return m.cleareddevice
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OneTimePreKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case onetimeprekey.EdgeDevice:
+		m.ClearDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown OneTimePreKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OneTimePreKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case onetimeprekey.EdgeDevice:
+		m.ResetDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown OneTimePreKey edge %s", name)
+}
+
+// RecoveryCodeMutation represents an operation that mutates the RecoveryCode nodes in the graph.
+type RecoveryCodeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	code_hash     *string
+	used_at       *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RecoveryCode, error)
+	predicates    []predicate.RecoveryCode
+}
+
+var _ ent.Mutation = (*RecoveryCodeMutation)(nil)
+
+// recoverycodeOption allows management of the mutation configuration using functional options.
+type recoverycodeOption func(*RecoveryCodeMutation)
+
+// newRecoveryCodeMutation creates new mutation for the RecoveryCode entity.
+func newRecoveryCodeMutation(c config, op Op, opts ...recoverycodeOption) *RecoveryCodeMutation {
+	m := &RecoveryCodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecoveryCode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecoveryCodeID sets the ID field of the mutation.
+func withRecoveryCodeID(id int) recoverycodeOption {
+	return func(m *RecoveryCodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecoveryCode
+		)
+		m.oldValue = func(ctx context.Context) (*RecoveryCode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecoveryCode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecoveryCode sets the old RecoveryCode of the mutation.
+func withRecoveryCode(node *RecoveryCode) recoverycodeOption {
+	return func(m *RecoveryCodeMutation) {
+		m.oldValue = func(context.Context) (*RecoveryCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecoveryCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecoveryCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecoveryCodeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecoveryCodeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecoveryCode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (m *RecoveryCodeMutation) SetCodeHash(s string) {
+	m.code_hash = &s
+}
+
+// CodeHash returns the value of the "code_hash" field in the mutation.
+func (m *RecoveryCodeMutation) CodeHash() (r string, exists bool) {
+	v := m.code_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeHash returns the old "code_hash" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecoveryCodeMutation) OldCodeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeHash: %w", err)
+	}
+	return oldValue.CodeHash, nil
+}
+
+// ResetCodeHash resets all changes to the "code_hash" field.
+func (m *RecoveryCodeMutation) ResetCodeHash() {
+	m.code_hash = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *RecoveryCodeMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *RecoveryCodeMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecoveryCodeMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *RecoveryCodeMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[recoverycode.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *RecoveryCodeMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[recoverycode.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *RecoveryCodeMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, recoverycode.FieldUsedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecoveryCodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecoveryCodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecoveryCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecoveryCodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *RecoveryCodeMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RecoveryCodeMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RecoveryCodeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *RecoveryCodeMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RecoveryCodeMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RecoveryCodeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the RecoveryCodeMutation builder.
+func (m *RecoveryCodeMutation) Where(ps ...predicate.RecoveryCode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecoveryCodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecoveryCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecoveryCode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecoveryCodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecoveryCodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecoveryCode).
+func (m *RecoveryCodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecoveryCodeMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.code_hash != nil {
+		fields = append(fields, recoverycode.FieldCodeHash)
+	}
+	if m.used_at != nil {
+		fields = append(fields, recoverycode.FieldUsedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recoverycode.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecoveryCodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		return m.CodeHash()
+	case recoverycode.FieldUsedAt:
+		return m.UsedAt()
+	case recoverycode.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecoveryCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		return m.OldCodeHash(ctx)
+	case recoverycode.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case recoverycode.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecoveryCode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecoveryCodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeHash(v)
+		return nil
+	case recoverycode.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case recoverycode.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecoveryCodeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecoveryCodeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecoveryCodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RecoveryCode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecoveryCodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recoverycode.FieldUsedAt) {
+		fields = append(fields, recoverycode.FieldUsedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecoveryCodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecoveryCodeMutation) ClearField(name string) error {
+	switch name {
+	case recoverycode.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecoveryCodeMutation) ResetField(name string) error {
+	switch name {
+	case recoverycode.FieldCodeHash:
+		m.ResetCodeHash()
+		return nil
+	case recoverycode.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case recoverycode.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecoveryCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, recoverycode.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecoveryCodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recoverycode.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecoveryCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecoveryCodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecoveryCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, recoverycode.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecoveryCodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recoverycode.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecoveryCodeMutation) ClearEdge(name string) error {
+	switch name {
+	case recoverycode.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecoveryCodeMutation) ResetEdge(name string) error {
+	switch name {
+	case recoverycode.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode edge %s", name)
+}
+
+// RoomMutation represents an operation that mutates the Room nodes in the graph.
+type RoomMutation struct {
+	config
+	op                              Op
+	typ                             string
+	id                              *int
+	name                            *string
+	description                     *string
+	is_private                      *bool
+	is_direct                       *bool
+	mls_group_id                    *string
+	mls_epoch                       *int
+	addmls_epoch                    *int
+	mls_pending_removals            *[]int
+	appendmls_pending_removals      []int
+	sender_keys_rotated_at          *time.Time
+	encryption_policy               *encryption.Policy
+	appendencryption_policy         encryption.Policy
+	message_ttl                     *int
+	addmessage_ttl                  *int
+	sealed_sender                   *bool
+	delivery_token                  *string
+	message_seq                     *int
+	addmessage_seq                  *int
+	created_at                      *time.Time
+	updated_at                      *time.Time
+	clearedFields                   map[string]struct{}
+	owner                           *int
+	clearedowner                    bool
+	memberships                     map[int]struct{}
+	removedmemberships              map[int]struct{}
+	clearedmemberships              bool
+	messages                        map[int]struct{}
+	removedmessages                 map[int]struct{}
+	clearedmessages                 bool
+	favourites                      map[int]struct{}
+	removedfavourites               map[int]struct{}
+	clearedfavourites               bool
+	call_logs                       map[int]struct{}
+	removedcall_logs                map[int]struct{}
+	clearedcall_logs                bool
+	mls_commits                     map[int]struct{}
+	removedmls_commits              map[int]struct{}
+	clearedmls_commits              bool
+	mls_welcomes                    map[int]struct{}
+	removedmls_welcomes             map[int]struct{}
+	clearedmls_welcomes             bool
+	sender_key_distributions        map[int]struct{}
+	removedsender_key_distributions map[int]struct{}
+	clearedsender_key_distributions bool
+	done                            bool
+	oldValue                        func(context.Context) (*Room, error)
+	predicates                      []predicate.Room
+}
+
+var _ ent.Mutation = (*RoomMutation)(nil)
+
+// roomOption allows management of the mutation configuration using functional options.
+type roomOption func(*RoomMutation)
+
+// newRoomMutation creates new mutation for the Room entity.
+func newRoomMutation(c config, op Op, opts ...roomOption) *RoomMutation {
+	m := &RoomMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoom,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoomID sets the ID field of the mutation.
+func withRoomID(id int) roomOption {
+	return func(m *RoomMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Room
+		)
+		m.oldValue = func(ctx context.Context) (*Room, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Room.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoom sets the old Room of the mutation.
+func withRoom(node *Room) roomOption {
+	return func(m *RoomMutation) {
+		m.oldValue = func(context.Context) (*Room, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoomMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoomMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoomMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoomMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Room.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RoomMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoomMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoomMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RoomMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RoomMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RoomMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (m *RoomMutation) SetIsPrivate(b bool) {
+	m.is_private = &b
+}
+
+// IsPrivate returns the value of the "is_private" field in the mutation.
+func (m *RoomMutation) IsPrivate() (r bool, exists bool) {
+	v := m.is_private
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPrivate returns the old "is_private" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldIsPrivate(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPrivate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPrivate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPrivate: %w", err)
+	}
+	return oldValue.IsPrivate, nil
+}
+
+// ResetIsPrivate resets all changes to the "is_private" field.
+func (m *RoomMutation) ResetIsPrivate() {
+	m.is_private = nil
+}
+
+// SetIsDirect sets the "is_direct" field.
+func (m *RoomMutation) SetIsDirect(b bool) {
+	m.is_direct = &b
+}
+
+// IsDirect returns the value of the "is_direct" field in the mutation.
+func (m *RoomMutation) IsDirect() (r bool, exists bool) {
+	v := m.is_direct
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDirect returns the old "is_direct" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldIsDirect(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDirect is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDirect requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDirect: %w", err)
+	}
+	return oldValue.IsDirect, nil
+}
+
+// ResetIsDirect resets all changes to the "is_direct" field.
+func (m *RoomMutation) ResetIsDirect() {
+	m.is_direct = nil
+}
+
+// SetMlsGroupID sets the "mls_group_id" field.
+func (m *RoomMutation) SetMlsGroupID(s string) {
+	m.mls_group_id = &s
+}
+
+// MlsGroupID returns the value of the "mls_group_id" field in the mutation.
+func (m *RoomMutation) MlsGroupID() (r string, exists bool) {
+	v := m.mls_group_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMlsGroupID returns the old "mls_group_id" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldMlsGroupID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMlsGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMlsGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMlsGroupID: %w", err)
+	}
+	return oldValue.MlsGroupID, nil
+}
+
+// ClearMlsGroupID clears the value of the "mls_group_id" field.
+func (m *RoomMutation) ClearMlsGroupID() {
+	m.mls_group_id = nil
+	m.clearedFields[room.FieldMlsGroupID] = struct{}{}
+}
+
+// MlsGroupIDCleared returns if the "mls_group_id" field was cleared in this mutation.
+func (m *RoomMutation) MlsGroupIDCleared() bool {
+	_, ok := m.clearedFields[room.FieldMlsGroupID]
+	return ok
+}
+
+// ResetMlsGroupID resets all changes to the "mls_group_id" field.
+func (m *RoomMutation) ResetMlsGroupID() {
+	m.mls_group_id = nil
+	delete(m.clearedFields, room.FieldMlsGroupID)
+}
+
+// SetMlsEpoch sets the "mls_epoch" field.
+func (m *RoomMutation) SetMlsEpoch(i int) {
+	m.mls_epoch = &i
+	m.addmls_epoch = nil
+}
+
+// MlsEpoch returns the value of the "mls_epoch" field in the mutation.
+func (m *RoomMutation) MlsEpoch() (r int, exists bool) {
+	v := m.mls_epoch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMlsEpoch returns the old "mls_epoch" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldMlsEpoch(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMlsEpoch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMlsEpoch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMlsEpoch: %w", err)
+	}
+	return oldValue.MlsEpoch, nil
+}
+
+// AddMlsEpoch adds i to the "mls_epoch" field.
+func (m *RoomMutation) AddMlsEpoch(i int) {
+	if m.addmls_epoch != nil {
+		*m.addmls_epoch += i
+	} else {
+		m.addmls_epoch = &i
+	}
+}
+
+// AddedMlsEpoch returns the value that was added to the "mls_epoch" field in this mutation.
+func (m *RoomMutation) AddedMlsEpoch() (r int, exists bool) {
+	v := m.addmls_epoch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMlsEpoch resets all changes to the "mls_epoch" field.
+func (m *RoomMutation) ResetMlsEpoch() {
+	m.mls_epoch = nil
+	m.addmls_epoch = nil
+}
+
+// SetMlsPendingRemovals sets the "mls_pending_removals" field.
+func (m *RoomMutation) SetMlsPendingRemovals(i []int) {
+	m.mls_pending_removals = &i
+	m.appendmls_pending_removals = nil
+}
+
+// MlsPendingRemovals returns the value of the "mls_pending_removals" field in the mutation.
+func (m *RoomMutation) MlsPendingRemovals() (r []int, exists bool) {
+	v := m.mls_pending_removals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMlsPendingRemovals returns the old "mls_pending_removals" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldMlsPendingRemovals(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMlsPendingRemovals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMlsPendingRemovals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMlsPendingRemovals: %w", err)
+	}
+	return oldValue.MlsPendingRemovals, nil
+}
+
+// AppendMlsPendingRemovals adds i to the "mls_pending_removals" field.
+func (m *RoomMutation) AppendMlsPendingRemovals(i []int) {
+	m.appendmls_pending_removals = append(m.appendmls_pending_removals, i...)
+}
+
+// AppendedMlsPendingRemovals returns the list of values that were appended to the "mls_pending_removals" field in this mutation.
+func (m *RoomMutation) AppendedMlsPendingRemovals() ([]int, bool) {
+	if len(m.appendmls_pending_removals) == 0 {
+		return nil, false
+	}
+	return m.appendmls_pending_removals, true
+}
+
+// ClearMlsPendingRemovals clears the value of the "mls_pending_removals" field.
+func (m *RoomMutation) ClearMlsPendingRemovals() {
+	m.mls_pending_removals = nil
+	m.appendmls_pending_removals = nil
+	m.clearedFields[room.FieldMlsPendingRemovals] = struct{}{}
+}
+
+// MlsPendingRemovalsCleared returns if the "mls_pending_removals" field was cleared in this mutation.
+func (m *RoomMutation) MlsPendingRemovalsCleared() bool {
+	_, ok := m.clearedFields[room.FieldMlsPendingRemovals]
+	return ok
+}
+
+// ResetMlsPendingRemovals resets all changes to the "mls_pending_removals" field.
+func (m *RoomMutation) ResetMlsPendingRemovals() {
+	m.mls_pending_removals = nil
+	m.appendmls_pending_removals = nil
+	delete(m.clearedFields, room.FieldMlsPendingRemovals)
+}
+
+// SetSenderKeysRotatedAt sets the "sender_keys_rotated_at" field.
+func (m *RoomMutation) SetSenderKeysRotatedAt(t time.Time) {
+	m.sender_keys_rotated_at = &t
+}
+
+// SenderKeysRotatedAt returns the value of the "sender_keys_rotated_at" field in the mutation.
+func (m *RoomMutation) SenderKeysRotatedAt() (r time.Time, exists bool) {
+	v := m.sender_keys_rotated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderKeysRotatedAt returns the old "sender_keys_rotated_at" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldSenderKeysRotatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderKeysRotatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderKeysRotatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderKeysRotatedAt: %w", err)
+	}
+	return oldValue.SenderKeysRotatedAt, nil
+}
+
+// ClearSenderKeysRotatedAt clears the value of the "sender_keys_rotated_at" field.
+func (m *RoomMutation) ClearSenderKeysRotatedAt() {
+	m.sender_keys_rotated_at = nil
+	m.clearedFields[room.FieldSenderKeysRotatedAt] = struct{}{}
+}
+
+// SenderKeysRotatedAtCleared returns if the "sender_keys_rotated_at" field was cleared in this mutation.
+func (m *RoomMutation) SenderKeysRotatedAtCleared() bool {
+	_, ok := m.clearedFields[room.FieldSenderKeysRotatedAt]
+	return ok
+}
+
+// ResetSenderKeysRotatedAt resets all changes to the "sender_keys_rotated_at" field.
+func (m *RoomMutation) ResetSenderKeysRotatedAt() {
+	m.sender_keys_rotated_at = nil
+	delete(m.clearedFields, room.FieldSenderKeysRotatedAt)
+}
+
+// SetEncryptionPolicy sets the "encryption_policy" field.
+func (m *RoomMutation) SetEncryptionPolicy(e encryption.Policy) {
+	m.encryption_policy = &e
+	m.appendencryption_policy = nil
+}
+
+// EncryptionPolicy returns the value of the "encryption_policy" field in the mutation.
+func (m *RoomMutation) EncryptionPolicy() (r encryption.Policy, exists bool) {
+	v := m.encryption_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptionPolicy returns the old "encryption_policy" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldEncryptionPolicy(ctx context.Context) (v encryption.Policy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptionPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptionPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptionPolicy: %w", err)
+	}
+	return oldValue.EncryptionPolicy, nil
+}
+
+// AppendEncryptionPolicy adds e to the "encryption_policy" field.
+func (m *RoomMutation) AppendEncryptionPolicy(e encryption.Policy) {
+	m.appendencryption_policy = append(m.appendencryption_policy, e...)
+}
+
+// AppendedEncryptionPolicy returns the list of values that were appended to the "encryption_policy" field in this mutation.
+func (m *RoomMutation) AppendedEncryptionPolicy() (encryption.Policy, bool) {
+	if len(m.appendencryption_policy) == 0 {
+		return nil, false
+	}
+	return m.appendencryption_policy, true
+}
+
+// ClearEncryptionPolicy clears the value of the "encryption_policy" field.
+func (m *RoomMutation) ClearEncryptionPolicy() {
+	m.encryption_policy = nil
+	m.appendencryption_policy = nil
+	m.clearedFields[room.FieldEncryptionPolicy] = struct{}{}
+}
+
+// EncryptionPolicyCleared returns if the "encryption_policy" field was cleared in this mutation.
+func (m *RoomMutation) EncryptionPolicyCleared() bool {
+	_, ok := m.clearedFields[room.FieldEncryptionPolicy]
+	return ok
+}
+
+// ResetEncryptionPolicy resets all changes to the "encryption_policy" field.
+func (m *RoomMutation) ResetEncryptionPolicy() {
+	m.encryption_policy = nil
+	m.appendencryption_policy = nil
+	delete(m.clearedFields, room.FieldEncryptionPolicy)
+}
+
+// SetMessageTTL sets the "message_ttl" field.
+func (m *RoomMutation) SetMessageTTL(i int) {
+	m.message_ttl = &i
+	m.addmessage_ttl = nil
+}
+
+// MessageTTL returns the value of the "message_ttl" field in the mutation.
+func (m *RoomMutation) MessageTTL() (r int, exists bool) {
+	v := m.message_ttl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageTTL returns the old "message_ttl" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldMessageTTL(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageTTL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageTTL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageTTL: %w", err)
+	}
+	return oldValue.MessageTTL, nil
+}
+
+// AddMessageTTL adds i to the "message_ttl" field.
+func (m *RoomMutation) AddMessageTTL(i int) {
+	if m.addmessage_ttl != nil {
+		*m.addmessage_ttl += i
+	} else {
+		m.addmessage_ttl = &i
+	}
+}
+
+// AddedMessageTTL returns the value that was added to the "message_ttl" field in this mutation.
+func (m *RoomMutation) AddedMessageTTL() (r int, exists bool) {
+	v := m.addmessage_ttl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMessageTTL clears the value of the "message_ttl" field.
+func (m *RoomMutation) ClearMessageTTL() {
+	m.message_ttl = nil
+	m.addmessage_ttl = nil
+	m.clearedFields[room.FieldMessageTTL] = struct{}{}
+}
+
+// MessageTTLCleared returns if the "message_ttl" field was cleared in this mutation.
+func (m *RoomMutation) MessageTTLCleared() bool {
+	_, ok := m.clearedFields[room.FieldMessageTTL]
+	return ok
+}
+
+// ResetMessageTTL resets all changes to the "message_ttl" field.
+func (m *RoomMutation) ResetMessageTTL() {
+	m.message_ttl = nil
+	m.addmessage_ttl = nil
+	delete(m.clearedFields, room.FieldMessageTTL)
+}
+
+// SetSealedSender sets the "sealed_sender" field.
+func (m *RoomMutation) SetSealedSender(b bool) {
+	m.sealed_sender = &b
+}
+
+// SealedSender returns the value of the "sealed_sender" field in the mutation.
+func (m *RoomMutation) SealedSender() (r bool, exists bool) {
+	v := m.sealed_sender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSealedSender returns the old "sealed_sender" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldSealedSender(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSealedSender is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSealedSender requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSealedSender: %w", err)
+	}
+	return oldValue.SealedSender, nil
+}
+
+// ResetSealedSender resets all changes to the "sealed_sender" field.
+func (m *RoomMutation) ResetSealedSender() {
+	m.sealed_sender = nil
+}
+
+// SetDeliveryToken sets the "delivery_token" field.
+func (m *RoomMutation) SetDeliveryToken(s string) {
+	m.delivery_token = &s
+}
+
+// DeliveryToken returns the value of the "delivery_token" field in the mutation.
+func (m *RoomMutation) DeliveryToken() (r string, exists bool) {
+	v := m.delivery_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveryToken returns the old "delivery_token" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldDeliveryToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveryToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveryToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveryToken: %w", err)
+	}
+	return oldValue.DeliveryToken, nil
+}
+
+// ClearDeliveryToken clears the value of the "delivery_token" field.
+func (m *RoomMutation) ClearDeliveryToken() {
+	m.delivery_token = nil
+	m.clearedFields[room.FieldDeliveryToken] = struct{}{}
+}
+
+// DeliveryTokenCleared returns if the "delivery_token" field was cleared in this mutation.
+func (m *RoomMutation) DeliveryTokenCleared() bool {
+	_, ok := m.clearedFields[room.FieldDeliveryToken]
+	return ok
+}
+
+// ResetDeliveryToken resets all changes to the "delivery_token" field.
+func (m *RoomMutation) ResetDeliveryToken() {
+	m.delivery_token = nil
+	delete(m.clearedFields, room.FieldDeliveryToken)
+}
+
+// SetMessageSeq sets the "message_seq" field.
+func (m *RoomMutation) SetMessageSeq(i int) {
+	m.message_seq = &i
+	m.addmessage_seq = nil
+}
+
+// MessageSeq returns the value of the "message_seq" field in the mutation.
+func (m *RoomMutation) MessageSeq() (r int, exists bool) {
+	v := m.message_seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageSeq returns the old "message_seq" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldMessageSeq(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageSeq: %w", err)
+	}
+	return oldValue.MessageSeq, nil
+}
+
+// AddMessageSeq adds i to the "message_seq" field.
+func (m *RoomMutation) AddMessageSeq(i int) {
+	if m.addmessage_seq != nil {
+		*m.addmessage_seq += i
+	} else {
+		m.addmessage_seq = &i
+	}
+}
+
+// AddedMessageSeq returns the value that was added to the "message_seq" field in this mutation.
+func (m *RoomMutation) AddedMessageSeq() (r int, exists bool) {
+	v := m.addmessage_seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageSeq resets all changes to the "message_seq" field.
+func (m *RoomMutation) ResetMessageSeq() {
+	m.message_seq = nil
+	m.addmessage_seq = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoomMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoomMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoomMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoomMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoomMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Room entity.
+// If the Room object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoomMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *RoomMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *RoomMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *RoomMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *RoomMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *RoomMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *RoomMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddMembershipIDs adds the "memberships" edge to the RoomMembership entity by ids.
+func (m *RoomMutation) AddMembershipIDs(ids ...int) {
+	if m.memberships == nil {
+		m.memberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberships clears the "memberships" edge to the RoomMembership entity.
+func (m *RoomMutation) ClearMemberships() {
+	m.clearedmemberships = true
+}
+
+// MembershipsCleared reports if the "memberships" edge to the RoomMembership entity was cleared.
+func (m *RoomMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to the RoomMembership entity by IDs.
+func (m *RoomMutation) RemoveMembershipIDs(ids ...int) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.memberships, ids[i])
+		m.removedmemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the RoomMembership entity.
+func (m *RoomMutation) RemovedMembershipsIDs() (ids []int) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *RoomMutation) MembershipsIDs() (ids []int) {
+	for id := range m.memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *RoomMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *RoomMutation) AddMessageIDs(ids ...int) {
+	if m.messages == nil {
+		m.messages = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *RoomMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *RoomMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *RoomMutation) RemoveMessageIDs(ids ...int) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *RoomMutation) RemovedMessagesIDs() (ids []int) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *RoomMutation) MessagesIDs() (ids []int) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *RoomMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// AddFavouriteIDs adds the "favourites" edge to the Favourite entity by ids.
+func (m *RoomMutation) AddFavouriteIDs(ids ...int) {
+	if m.favourites == nil {
+		m.favourites = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favourites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFavourites clears the "favourites" edge to the Favourite entity.
+func (m *RoomMutation) ClearFavourites() {
+	m.clearedfavourites = true
+}
+
+// FavouritesCleared reports if the "favourites" edge to the Favourite entity was cleared.
+func (m *RoomMutation) FavouritesCleared() bool {
+	return m.clearedfavourites
+}
+
+// RemoveFavouriteIDs removes the "favourites" edge to the Favourite entity by IDs.
+func (m *RoomMutation) RemoveFavouriteIDs(ids ...int) {
+	if m.removedfavourites == nil {
+		m.removedfavourites = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.favourites, ids[i])
+		m.removedfavourites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFavourites returns the removed IDs of the "favourites" edge to the Favourite entity.
+func (m *RoomMutation) RemovedFavouritesIDs() (ids []int) {
+	for id := range m.removedfavourites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FavouritesIDs returns the "favourites" edge IDs in the mutation.
+func (m *RoomMutation) FavouritesIDs() (ids []int) {
+	for id := range m.favourites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFavourites resets all changes to the "favourites" edge.
+func (m *RoomMutation) ResetFavourites() {
+	m.favourites = nil
+	m.clearedfavourites = false
+	m.removedfavourites = nil
+}
+
+// AddCallLogIDs adds the "call_logs" edge to the CallLog entity by ids.
+func (m *RoomMutation) AddCallLogIDs(ids ...int) {
+	if m.call_logs == nil {
+		m.call_logs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.call_logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCallLogs clears the "call_logs" edge to the CallLog entity.
+func (m *RoomMutation) ClearCallLogs() {
+	m.clearedcall_logs = true
+}
+
+// CallLogsCleared reports if the "call_logs" edge to the CallLog entity was cleared.
+func (m *RoomMutation) CallLogsCleared() bool {
+	return m.clearedcall_logs
+}
+
+// RemoveCallLogIDs removes the "call_logs" edge to the CallLog entity by IDs.
+func (m *RoomMutation) RemoveCallLogIDs(ids ...int) {
+	if m.removedcall_logs == nil {
+		m.removedcall_logs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.call_logs, ids[i])
+		m.removedcall_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCallLogs returns the removed IDs of the "call_logs" edge to the CallLog entity.
+func (m *RoomMutation) RemovedCallLogsIDs() (ids []int) {
+	for id := range m.removedcall_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CallLogsIDs returns the "call_logs" edge IDs in the mutation.
+func (m *RoomMutation) CallLogsIDs() (ids []int) {
+	for id := range m.call_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCallLogs resets all changes to the "call_logs" edge.
+func (m *RoomMutation) ResetCallLogs() {
+	m.call_logs = nil
+	m.clearedcall_logs = false
+	m.removedcall_logs = nil
+}
+
+// AddMlsCommitIDs adds the "mls_commits" edge to the MlsCommit entity by ids.
+func (m *RoomMutation) AddMlsCommitIDs(ids ...int) {
+	if m.mls_commits == nil {
+		m.mls_commits = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.mls_commits[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMlsCommits clears the "mls_commits" edge to the MlsCommit entity.
+func (m *RoomMutation) ClearMlsCommits() {
+	m.clearedmls_commits = true
+}
+
+// MlsCommitsCleared reports if the "mls_commits" edge to the MlsCommit entity was cleared.
+func (m *RoomMutation) MlsCommitsCleared() bool {
+	return m.clearedmls_commits
+}
+
+// RemoveMlsCommitIDs removes the "mls_commits" edge to the MlsCommit entity by IDs.
+func (m *RoomMutation) RemoveMlsCommitIDs(ids ...int) {
+	if m.removedmls_commits == nil {
+		m.removedmls_commits = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.mls_commits, ids[i])
+		m.removedmls_commits[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMlsCommits returns the removed IDs of the "mls_commits" edge to the MlsCommit entity.
+func (m *RoomMutation) RemovedMlsCommitsIDs() (ids []int) {
+	for id := range m.removedmls_commits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MlsCommitsIDs returns the "mls_commits" edge IDs in the mutation.
+func (m *RoomMutation) MlsCommitsIDs() (ids []int) {
+	for id := range m.mls_commits {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMlsCommits resets all changes to the "mls_commits" edge.
+func (m *RoomMutation) ResetMlsCommits() {
+	m.mls_commits = nil
+	m.clearedmls_commits = false
+	m.removedmls_commits = nil
+}
+
+// AddMlsWelcomeIDs adds the "mls_welcomes" edge to the MlsWelcome entity by ids.
+func (m *RoomMutation) AddMlsWelcomeIDs(ids ...int) {
+	if m.mls_welcomes == nil {
+		m.mls_welcomes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.mls_welcomes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMlsWelcomes clears the "mls_welcomes" edge to the MlsWelcome entity.
+func (m *RoomMutation) ClearMlsWelcomes() {
+	m.clearedmls_welcomes = true
+}
+
+// MlsWelcomesCleared reports if the "mls_welcomes" edge to the MlsWelcome entity was cleared.
+func (m *RoomMutation) MlsWelcomesCleared() bool {
+	return m.clearedmls_welcomes
+}
+
+// RemoveMlsWelcomeIDs removes the "mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (m *RoomMutation) RemoveMlsWelcomeIDs(ids ...int) {
+	if m.removedmls_welcomes == nil {
+		m.removedmls_welcomes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.mls_welcomes, ids[i])
+		m.removedmls_welcomes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMlsWelcomes returns the removed IDs of the "mls_welcomes" edge to the MlsWelcome entity.
+func (m *RoomMutation) RemovedMlsWelcomesIDs() (ids []int) {
+	for id := range m.removedmls_welcomes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MlsWelcomesIDs returns the "mls_welcomes" edge IDs in the mutation.
+func (m *RoomMutation) MlsWelcomesIDs() (ids []int) {
+	for id := range m.mls_welcomes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMlsWelcomes resets all changes to the "mls_welcomes" edge.
+func (m *RoomMutation) ResetMlsWelcomes() {
+	m.mls_welcomes = nil
+	m.clearedmls_welcomes = false
+	m.removedmls_welcomes = nil
+}
+
+// AddSenderKeyDistributionIDs adds the "sender_key_distributions" edge to the SenderKeyDistribution entity by ids.
+func (m *RoomMutation) AddSenderKeyDistributionIDs(ids ...int) {
+	if m.sender_key_distributions == nil {
+		m.sender_key_distributions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sender_key_distributions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSenderKeyDistributions clears the "sender_key_distributions" edge to the SenderKeyDistribution entity.
+func (m *RoomMutation) ClearSenderKeyDistributions() {
+	m.clearedsender_key_distributions = true
+}
+
+// SenderKeyDistributionsCleared reports if the "sender_key_distributions" edge to the SenderKeyDistribution entity was cleared.
+func (m *RoomMutation) SenderKeyDistributionsCleared() bool {
+	return m.clearedsender_key_distributions
+}
+
+// RemoveSenderKeyDistributionIDs removes the "sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (m *RoomMutation) RemoveSenderKeyDistributionIDs(ids ...int) {
+	if m.removedsender_key_distributions == nil {
+		m.removedsender_key_distributions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sender_key_distributions, ids[i])
+		m.removedsender_key_distributions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSenderKeyDistributions returns the removed IDs of the "sender_key_distributions" edge to the SenderKeyDistribution entity.
+func (m *RoomMutation) RemovedSenderKeyDistributionsIDs() (ids []int) {
+	for id := range m.removedsender_key_distributions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SenderKeyDistributionsIDs returns the "sender_key_distributions" edge IDs in the mutation.
+func (m *RoomMutation) SenderKeyDistributionsIDs() (ids []int) {
+	for id := range m.sender_key_distributions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSenderKeyDistributions resets all changes to the "sender_key_distributions" edge.
+func (m *RoomMutation) ResetSenderKeyDistributions() {
+	m.sender_key_distributions = nil
+	m.clearedsender_key_distributions = false
+	m.removedsender_key_distributions = nil
+}
+
+// Where appends a list predicates to the RoomMutation builder.
+func (m *RoomMutation) Where(ps ...predicate.Room) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoomMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoomMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Room, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoomMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoomMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Room).
+func (m *RoomMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoomMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.name != nil {
+		fields = append(fields, room.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, room.FieldDescription)
+	}
+	if m.is_private != nil {
+		fields = append(fields, room.FieldIsPrivate)
+	}
+	if m.is_direct != nil {
+		fields = append(fields, room.FieldIsDirect)
+	}
+	if m.mls_group_id != nil {
+		fields = append(fields, room.FieldMlsGroupID)
+	}
+	if m.mls_epoch != nil {
+		fields = append(fields, room.FieldMlsEpoch)
+	}
+	if m.mls_pending_removals != nil {
+		fields = append(fields, room.FieldMlsPendingRemovals)
+	}
+	if m.sender_keys_rotated_at != nil {
+		fields = append(fields, room.FieldSenderKeysRotatedAt)
+	}
+	if m.encryption_policy != nil {
+		fields = append(fields, room.FieldEncryptionPolicy)
+	}
+	if m.message_ttl != nil {
+		fields = append(fields, room.FieldMessageTTL)
+	}
+	if m.sealed_sender != nil {
+		fields = append(fields, room.FieldSealedSender)
+	}
+	if m.delivery_token != nil {
+		fields = append(fields, room.FieldDeliveryToken)
+	}
+	if m.message_seq != nil {
+		fields = append(fields, room.FieldMessageSeq)
+	}
+	if m.created_at != nil {
+		fields = append(fields, room.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, room.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoomMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case room.FieldName:
+		return m.Name()
+	case room.FieldDescription:
+		return m.Description()
+	case room.FieldIsPrivate:
+		return m.IsPrivate()
+	case room.FieldIsDirect:
+		return m.IsDirect()
+	case room.FieldMlsGroupID:
+		return m.MlsGroupID()
+	case room.FieldMlsEpoch:
+		return m.MlsEpoch()
+	case room.FieldMlsPendingRemovals:
+		return m.MlsPendingRemovals()
+	case room.FieldSenderKeysRotatedAt:
+		return m.SenderKeysRotatedAt()
+	case room.FieldEncryptionPolicy:
+		return m.EncryptionPolicy()
+	case room.FieldMessageTTL:
+		return m.MessageTTL()
+	case room.FieldSealedSender:
+		return m.SealedSender()
+	case room.FieldDeliveryToken:
+		return m.DeliveryToken()
+	case room.FieldMessageSeq:
+		return m.MessageSeq()
+	case room.FieldCreatedAt:
+		return m.CreatedAt()
+	case room.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoomMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case room.FieldName:
+		return m.OldName(ctx)
+	case room.FieldDescription:
+		return m.OldDescription(ctx)
+	case room.FieldIsPrivate:
+		return m.OldIsPrivate(ctx)
+	case room.FieldIsDirect:
+		return m.OldIsDirect(ctx)
+	case room.FieldMlsGroupID:
+		return m.OldMlsGroupID(ctx)
+	case room.FieldMlsEpoch:
+		return m.OldMlsEpoch(ctx)
+	case room.FieldMlsPendingRemovals:
+		return m.OldMlsPendingRemovals(ctx)
+	case room.FieldSenderKeysRotatedAt:
+		return m.OldSenderKeysRotatedAt(ctx)
+	case room.FieldEncryptionPolicy:
+		return m.OldEncryptionPolicy(ctx)
+	case room.FieldMessageTTL:
+		return m.OldMessageTTL(ctx)
+	case room.FieldSealedSender:
+		return m.OldSealedSender(ctx)
+	case room.FieldDeliveryToken:
+		return m.OldDeliveryToken(ctx)
+	case room.FieldMessageSeq:
+		return m.OldMessageSeq(ctx)
+	case room.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case room.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Room field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case room.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case room.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case room.FieldIsPrivate:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPrivate(v)
+		return nil
+	case room.FieldIsDirect:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDirect(v)
+		return nil
+	case room.FieldMlsGroupID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMlsGroupID(v)
+		return nil
+	case room.FieldMlsEpoch:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMlsEpoch(v)
+		return nil
+	case room.FieldMlsPendingRemovals:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMlsPendingRemovals(v)
+		return nil
+	case room.FieldSenderKeysRotatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderKeysRotatedAt(v)
+		return nil
+	case room.FieldEncryptionPolicy:
+		v, ok := value.(encryption.Policy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptionPolicy(v)
+		return nil
+	case room.FieldMessageTTL:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageTTL(v)
+		return nil
+	case room.FieldSealedSender:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSealedSender(v)
+		return nil
+	case room.FieldDeliveryToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveryToken(v)
+		return nil
+	case room.FieldMessageSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageSeq(v)
+		return nil
+	case room.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case room.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Room field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoomMutation) AddedFields() []string {
+	var fields []string
+	if m.addmls_epoch != nil {
+		fields = append(fields, room.FieldMlsEpoch)
+	}
+	if m.addmessage_ttl != nil {
+		fields = append(fields, room.FieldMessageTTL)
+	}
+	if m.addmessage_seq != nil {
+		fields = append(fields, room.FieldMessageSeq)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoomMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case room.FieldMlsEpoch:
+		return m.AddedMlsEpoch()
+	case room.FieldMessageTTL:
+		return m.AddedMessageTTL()
+	case room.FieldMessageSeq:
+		return m.AddedMessageSeq()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case room.FieldMlsEpoch:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMlsEpoch(v)
+		return nil
+	case room.FieldMessageTTL:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageTTL(v)
+		return nil
+	case room.FieldMessageSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageSeq(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Room numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoomMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(room.FieldMlsGroupID) {
+		fields = append(fields, room.FieldMlsGroupID)
+	}
+	if m.FieldCleared(room.FieldMlsPendingRemovals) {
+		fields = append(fields, room.FieldMlsPendingRemovals)
+	}
+	if m.FieldCleared(room.FieldSenderKeysRotatedAt) {
+		fields = append(fields, room.FieldSenderKeysRotatedAt)
+	}
+	if m.FieldCleared(room.FieldEncryptionPolicy) {
+		fields = append(fields, room.FieldEncryptionPolicy)
+	}
+	if m.FieldCleared(room.FieldMessageTTL) {
+		fields = append(fields, room.FieldMessageTTL)
+	}
+	if m.FieldCleared(room.FieldDeliveryToken) {
+		fields = append(fields, room.FieldDeliveryToken)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoomMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoomMutation) ClearField(name string) error {
+	switch name {
+	case room.FieldMlsGroupID:
+		m.ClearMlsGroupID()
+		return nil
+	case room.FieldMlsPendingRemovals:
+		m.ClearMlsPendingRemovals()
+		return nil
+	case room.FieldSenderKeysRotatedAt:
+		m.ClearSenderKeysRotatedAt()
+		return nil
+	case room.FieldEncryptionPolicy:
+		m.ClearEncryptionPolicy()
+		return nil
+	case room.FieldMessageTTL:
+		m.ClearMessageTTL()
+		return nil
+	case room.FieldDeliveryToken:
+		m.ClearDeliveryToken()
+		return nil
+	}
+	return fmt.Errorf("unknown Room nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoomMutation) ResetField(name string) error {
+	switch name {
+	case room.FieldName:
+		m.ResetName()
+		return nil
+	case room.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case room.FieldIsPrivate:
+		m.ResetIsPrivate()
+		return nil
+	case room.FieldIsDirect:
+		m.ResetIsDirect()
+		return nil
+	case room.FieldMlsGroupID:
+		m.ResetMlsGroupID()
+		return nil
+	case room.FieldMlsEpoch:
+		m.ResetMlsEpoch()
+		return nil
+	case room.FieldMlsPendingRemovals:
+		m.ResetMlsPendingRemovals()
+		return nil
+	case room.FieldSenderKeysRotatedAt:
+		m.ResetSenderKeysRotatedAt()
+		return nil
+	case room.FieldEncryptionPolicy:
+		m.ResetEncryptionPolicy()
+		return nil
+	case room.FieldMessageTTL:
+		m.ResetMessageTTL()
+		return nil
+	case room.FieldSealedSender:
+		m.ResetSealedSender()
+		return nil
+	case room.FieldDeliveryToken:
+		m.ResetDeliveryToken()
+		return nil
+	case room.FieldMessageSeq:
+		m.ResetMessageSeq()
+		return nil
+	case room.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case room.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Room field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoomMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.owner != nil {
+		edges = append(edges, room.EdgeOwner)
+	}
+	if m.memberships != nil {
+		edges = append(edges, room.EdgeMemberships)
+	}
+	if m.messages != nil {
+		edges = append(edges, room.EdgeMessages)
+	}
+	if m.favourites != nil {
+		edges = append(edges, room.EdgeFavourites)
+	}
+	if m.call_logs != nil {
+		edges = append(edges, room.EdgeCallLogs)
+	}
+	if m.mls_commits != nil {
+		edges = append(edges, room.EdgeMlsCommits)
+	}
+	if m.mls_welcomes != nil {
+		edges = append(edges, room.EdgeMlsWelcomes)
+	}
+	if m.sender_key_distributions != nil {
+		edges = append(edges, room.EdgeSenderKeyDistributions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoomMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case room.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case room.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeFavourites:
+		ids := make([]ent.Value, 0, len(m.favourites))
+		for id := range m.favourites {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeCallLogs:
+		ids := make([]ent.Value, 0, len(m.call_logs))
+		for id := range m.call_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMlsCommits:
+		ids := make([]ent.Value, 0, len(m.mls_commits))
+		for id := range m.mls_commits {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMlsWelcomes:
+		ids := make([]ent.Value, 0, len(m.mls_welcomes))
+		for id := range m.mls_welcomes {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeSenderKeyDistributions:
+		ids := make([]ent.Value, 0, len(m.sender_key_distributions))
+		for id := range m.sender_key_distributions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoomMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedmemberships != nil {
+		edges = append(edges, room.EdgeMemberships)
+	}
+	if m.removedmessages != nil {
+		edges = append(edges, room.EdgeMessages)
+	}
+	if m.removedfavourites != nil {
+		edges = append(edges, room.EdgeFavourites)
+	}
+	if m.removedcall_logs != nil {
+		edges = append(edges, room.EdgeCallLogs)
+	}
+	if m.removedmls_commits != nil {
+		edges = append(edges, room.EdgeMlsCommits)
+	}
+	if m.removedmls_welcomes != nil {
+		edges = append(edges, room.EdgeMlsWelcomes)
+	}
+	if m.removedsender_key_distributions != nil {
+		edges = append(edges, room.EdgeSenderKeyDistributions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoomMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case room.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeFavourites:
+		ids := make([]ent.Value, 0, len(m.removedfavourites))
+		for id := range m.removedfavourites {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeCallLogs:
+		ids := make([]ent.Value, 0, len(m.removedcall_logs))
+		for id := range m.removedcall_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMlsCommits:
+		ids := make([]ent.Value, 0, len(m.removedmls_commits))
+		for id := range m.removedmls_commits {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeMlsWelcomes:
+		ids := make([]ent.Value, 0, len(m.removedmls_welcomes))
+		for id := range m.removedmls_welcomes {
+			ids = append(ids, id)
+		}
+		return ids
+	case room.EdgeSenderKeyDistributions:
+		ids := make([]ent.Value, 0, len(m.removedsender_key_distributions))
+		for id := range m.removedsender_key_distributions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoomMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedowner {
+		edges = append(edges, room.EdgeOwner)
+	}
+	if m.clearedmemberships {
+		edges = append(edges, room.EdgeMemberships)
+	}
+	if m.clearedmessages {
+		edges = append(edges, room.EdgeMessages)
+	}
+	if m.clearedfavourites {
+		edges = append(edges, room.EdgeFavourites)
+	}
+	if m.clearedcall_logs {
+		edges = append(edges, room.EdgeCallLogs)
+	}
+	if m.clearedmls_commits {
+		edges = append(edges, room.EdgeMlsCommits)
+	}
+	if m.clearedmls_welcomes {
+		edges = append(edges, room.EdgeMlsWelcomes)
+	}
+	if m.clearedsender_key_distributions {
+		edges = append(edges, room.EdgeSenderKeyDistributions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoomMutation) EdgeCleared(name string) bool {
+	switch name {
+	case room.EdgeOwner:
+		return m.clearedowner
+	case room.EdgeMemberships:
+		return m.clearedmemberships
+	case room.EdgeMessages:
+		return m.clearedmessages
+	case room.EdgeFavourites:
+		return m.clearedfavourites
+	case room.EdgeCallLogs:
+		return m.clearedcall_logs
+	case room.EdgeMlsCommits:
+		return m.clearedmls_commits
+	case room.EdgeMlsWelcomes:
+		return m.clearedmls_welcomes
+	case room.EdgeSenderKeyDistributions:
+		return m.clearedsender_key_distributions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoomMutation) ClearEdge(name string) error {
+	switch name {
+	case room.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Room unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoomMutation) ResetEdge(name string) error {
+	switch name {
+	case room.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case room.EdgeMemberships:
+		m.ResetMemberships()
+		return nil
+	case room.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	case room.EdgeFavourites:
+		m.ResetFavourites()
+		return nil
+	case room.EdgeCallLogs:
+		m.ResetCallLogs()
+		return nil
+	case room.EdgeMlsCommits:
+		m.ResetMlsCommits()
+		return nil
+	case room.EdgeMlsWelcomes:
+		m.ResetMlsWelcomes()
+		return nil
+	case room.EdgeSenderKeyDistributions:
+		m.ResetSenderKeyDistributions()
+		return nil
+	}
+	return fmt.Errorf("unknown Room edge %s", name)
+}
+
+// RoomMembershipMutation represents an operation that mutates the RoomMembership nodes in the graph.
+type RoomMembershipMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	role          *roommembership.Role
+	can_post      *bool
+	can_call      *bool
+	joined_at     *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	room          *int
+	clearedroom   bool
+	done          bool
+	oldValue      func(context.Context) (*RoomMembership, error)
+	predicates    []predicate.RoomMembership
+}
+
+var _ ent.Mutation = (*RoomMembershipMutation)(nil)
+
+// roommembershipOption allows management of the mutation configuration using functional options.
+type roommembershipOption func(*RoomMembershipMutation)
+
+// newRoomMembershipMutation creates new mutation for the RoomMembership entity.
+func newRoomMembershipMutation(c config, op Op, opts ...roommembershipOption) *RoomMembershipMutation {
+	m := &RoomMembershipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoomMembership,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoomMembershipID sets the ID field of the mutation.
+func withRoomMembershipID(id int) roommembershipOption {
+	return func(m *RoomMembershipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoomMembership
+		)
+		m.oldValue = func(ctx context.Context) (*RoomMembership, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoomMembership.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoomMembership sets the old RoomMembership of the mutation.
+func withRoomMembership(node *RoomMembership) roommembershipOption {
+	return func(m *RoomMembershipMutation) {
+		m.oldValue = func(context.Context) (*RoomMembership, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoomMembershipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoomMembershipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoomMembershipMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoomMembershipMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoomMembership.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRole sets the "role" field.
+func (m *RoomMembershipMutation) SetRole(r roommembership.Role) {
+	m.role = &r
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *RoomMembershipMutation) Role() (r roommembership.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the RoomMembership entity.
+// If the RoomMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMembershipMutation) OldRole(ctx context.Context) (v roommembership.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *RoomMembershipMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetCanPost sets the "can_post" field.
+func (m *RoomMembershipMutation) SetCanPost(b bool) {
+	m.can_post = &b
+}
+
+// CanPost returns the value of the "can_post" field in the mutation.
+func (m *RoomMembershipMutation) CanPost() (r bool, exists bool) {
+	v := m.can_post
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanPost returns the old "can_post" field's value of the RoomMembership entity.
+// If the RoomMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMembershipMutation) OldCanPost(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanPost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanPost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanPost: %w", err)
+	}
+	return oldValue.CanPost, nil
+}
+
+// ResetCanPost resets all changes to the "can_post" field.
+func (m *RoomMembershipMutation) ResetCanPost() {
+	m.can_post = nil
+}
+
+// SetCanCall sets the "can_call" field.
+func (m *RoomMembershipMutation) SetCanCall(b bool) {
+	m.can_call = &b
+}
+
+// CanCall returns the value of the "can_call" field in the mutation.
+func (m *RoomMembershipMutation) CanCall() (r bool, exists bool) {
+	v := m.can_call
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanCall returns the old "can_call" field's value of the RoomMembership entity.
+// If the RoomMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMembershipMutation) OldCanCall(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanCall is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanCall requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanCall: %w", err)
+	}
+	return oldValue.CanCall, nil
+}
+
+// ResetCanCall resets all changes to the "can_call" field.
+func (m *RoomMembershipMutation) ResetCanCall() {
+	m.can_call = nil
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *RoomMembershipMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *RoomMembershipMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the RoomMembership entity.
+// If the RoomMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMembershipMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *RoomMembershipMutation) ResetJoinedAt() {
+	m.joined_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoomMembershipMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoomMembershipMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RoomMembership entity.
+// If the RoomMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoomMembershipMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoomMembershipMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *RoomMembershipMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RoomMembershipMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RoomMembershipMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *RoomMembershipMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RoomMembershipMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RoomMembershipMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *RoomMembershipMutation) SetRoomID(id int) {
+	m.room = &id
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *RoomMembershipMutation) ClearRoom() {
+	m.clearedroom = true
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *RoomMembershipMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *RoomMembershipMutation) RoomID() (id int, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *RoomMembershipMutation) RoomIDs() (ids []int) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *RoomMembershipMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// Where appends a list predicates to the RoomMembershipMutation builder.
+func (m *RoomMembershipMutation) Where(ps ...predicate.RoomMembership) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoomMembershipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoomMembershipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoomMembership, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoomMembershipMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoomMembershipMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoomMembership).
+func (m *RoomMembershipMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoomMembershipMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.role != nil {
+		fields = append(fields, roommembership.FieldRole)
+	}
+	if m.can_post != nil {
+		fields = append(fields, roommembership.FieldCanPost)
+	}
+	if m.can_call != nil {
+		fields = append(fields, roommembership.FieldCanCall)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, roommembership.FieldJoinedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, roommembership.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoomMembershipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roommembership.FieldRole:
+		return m.Role()
+	case roommembership.FieldCanPost:
+		return m.CanPost()
+	case roommembership.FieldCanCall:
+		return m.CanCall()
+	case roommembership.FieldJoinedAt:
+		return m.JoinedAt()
+	case roommembership.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoomMembershipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roommembership.FieldRole:
+		return m.OldRole(ctx)
+	case roommembership.FieldCanPost:
+		return m.OldCanPost(ctx)
+	case roommembership.FieldCanCall:
+		return m.OldCanCall(ctx)
+	case roommembership.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case roommembership.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoomMembership field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMembershipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roommembership.FieldRole:
+		v, ok := value.(roommembership.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case roommembership.FieldCanPost:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanPost(v)
+		return nil
+	case roommembership.FieldCanCall:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanCall(v)
+		return nil
+	case roommembership.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	case roommembership.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMembership field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoomMembershipMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoomMembershipMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoomMembershipMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RoomMembership numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoomMembershipMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoomMembershipMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoomMembershipMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RoomMembership nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoomMembershipMutation) ResetField(name string) error {
+	switch name {
+	case roommembership.FieldRole:
+		m.ResetRole()
+		return nil
+	case roommembership.FieldCanPost:
+		m.ResetCanPost()
+		return nil
+	case roommembership.FieldCanCall:
+		m.ResetCanCall()
+		return nil
+	case roommembership.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	case roommembership.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMembership field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoomMembershipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, roommembership.EdgeUser)
+	}
+	if m.room != nil {
+		edges = append(edges, roommembership.EdgeRoom)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoomMembershipMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case roommembership.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case roommembership.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoomMembershipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoomMembershipMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoomMembershipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, roommembership.EdgeUser)
+	}
+	if m.clearedroom {
+		edges = append(edges, roommembership.EdgeRoom)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoomMembershipMutation) EdgeCleared(name string) bool {
+	switch name {
+	case roommembership.EdgeUser:
+		return m.cleareduser
+	case roommembership.EdgeRoom:
+		return m.clearedroom
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoomMembershipMutation) ClearEdge(name string) error {
+	switch name {
+	case roommembership.EdgeUser:
+		m.ClearUser()
+		return nil
+	case roommembership.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMembership unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoomMembershipMutation) ResetEdge(name string) error {
+	switch name {
+	case roommembership.EdgeUser:
+		m.ResetUser()
+		return nil
+	case roommembership.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	}
+	return fmt.Errorf("unknown RoomMembership edge %s", name)
+}
+
+// SenderKeyDistributionMutation represents an operation that mutates the SenderKeyDistribution nodes in the graph.
+type SenderKeyDistributionMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	distribution_id     *string
+	sender_device_id    *int
+	addsender_device_id *int
+	cipher_text         *string
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	room                *int
+	clearedroom         bool
+	sender              *int
+	clearedsender       bool
+	device              *int
+	cleareddevice       bool
+	done                bool
+	oldValue            func(context.Context) (*SenderKeyDistribution, error)
+	predicates          []predicate.SenderKeyDistribution
+}
+
+var _ ent.Mutation = (*SenderKeyDistributionMutation)(nil)
+
+// senderkeydistributionOption allows management of the mutation configuration using functional options.
+type senderkeydistributionOption func(*SenderKeyDistributionMutation)
+
+// newSenderKeyDistributionMutation creates new mutation for the SenderKeyDistribution entity.
+func newSenderKeyDistributionMutation(c config, op Op, opts ...senderkeydistributionOption) *SenderKeyDistributionMutation {
+	m := &SenderKeyDistributionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSenderKeyDistribution,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSenderKeyDistributionID sets the ID field of the mutation.
+func withSenderKeyDistributionID(id int) senderkeydistributionOption {
+	return func(m *SenderKeyDistributionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SenderKeyDistribution
+		)
+		m.oldValue = func(ctx context.Context) (*SenderKeyDistribution, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SenderKeyDistribution.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSenderKeyDistribution sets the old SenderKeyDistribution of the mutation.
+func withSenderKeyDistribution(node *SenderKeyDistribution) senderkeydistributionOption {
+	return func(m *SenderKeyDistributionMutation) {
+		m.oldValue = func(context.Context) (*SenderKeyDistribution, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SenderKeyDistributionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SenderKeyDistributionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SenderKeyDistributionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SenderKeyDistributionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SenderKeyDistribution.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDistributionID sets the "distribution_id" field.
+func (m *SenderKeyDistributionMutation) SetDistributionID(s string) {
+	m.distribution_id = &s
+}
+
+// DistributionID returns the value of the "distribution_id" field in the mutation.
+func (m *SenderKeyDistributionMutation) DistributionID() (r string, exists bool) {
+	v := m.distribution_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDistributionID returns the old "distribution_id" field's value of the SenderKeyDistribution entity.
+// If the SenderKeyDistribution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderKeyDistributionMutation) OldDistributionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDistributionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDistributionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDistributionID: %w", err)
+	}
+	return oldValue.DistributionID, nil
+}
+
+// ResetDistributionID resets all changes to the "distribution_id" field.
+func (m *SenderKeyDistributionMutation) ResetDistributionID() {
+	m.distribution_id = nil
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (m *SenderKeyDistributionMutation) SetSenderDeviceID(i int) {
+	m.sender_device_id = &i
+	m.addsender_device_id = nil
+}
+
+// SenderDeviceID returns the value of the "sender_device_id" field in the mutation.
+func (m *SenderKeyDistributionMutation) SenderDeviceID() (r int, exists bool) {
+	v := m.sender_device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderDeviceID returns the old "sender_device_id" field's value of the SenderKeyDistribution entity.
+// If the SenderKeyDistribution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderKeyDistributionMutation) OldSenderDeviceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderDeviceID: %w", err)
+	}
+	return oldValue.SenderDeviceID, nil
+}
+
+// AddSenderDeviceID adds i to the "sender_device_id" field.
+func (m *SenderKeyDistributionMutation) AddSenderDeviceID(i int) {
+	if m.addsender_device_id != nil {
+		*m.addsender_device_id += i
+	} else {
+		m.addsender_device_id = &i
+	}
+}
+
+// AddedSenderDeviceID returns the value that was added to the "sender_device_id" field in this mutation.
+func (m *SenderKeyDistributionMutation) AddedSenderDeviceID() (r int, exists bool) {
+	v := m.addsender_device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSenderDeviceID resets all changes to the "sender_device_id" field.
+func (m *SenderKeyDistributionMutation) ResetSenderDeviceID() {
+	m.sender_device_id = nil
+	m.addsender_device_id = nil
+}
+
+// SetCipherText sets the "cipher_text" field.
+func (m *SenderKeyDistributionMutation) SetCipherText(s string) {
+	m.cipher_text = &s
+}
+
+// CipherText returns the value of the "cipher_text" field in the mutation.
+func (m *SenderKeyDistributionMutation) CipherText() (r string, exists bool) {
+	v := m.cipher_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCipherText returns the old "cipher_text" field's value of the SenderKeyDistribution entity.
+// If the SenderKeyDistribution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderKeyDistributionMutation) OldCipherText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCipherText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCipherText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCipherText: %w", err)
+	}
+	return oldValue.CipherText, nil
+}
+
+// ResetCipherText resets all changes to the "cipher_text" field.
+func (m *SenderKeyDistributionMutation) ResetCipherText() {
+	m.cipher_text = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SenderKeyDistributionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SenderKeyDistributionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SenderKeyDistribution entity.
+// If the SenderKeyDistribution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderKeyDistributionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SenderKeyDistributionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRoomID sets the "room" edge to the Room entity by id.
+func (m *SenderKeyDistributionMutation) SetRoomID(id int) {
+	m.room = &id
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (m *SenderKeyDistributionMutation) ClearRoom() {
+	m.clearedroom = true
+}
+
+// RoomCleared reports if the "room" edge to the Room entity was cleared.
+func (m *SenderKeyDistributionMutation) RoomCleared() bool {
+	return m.clearedroom
+}
+
+// RoomID returns the "room" edge ID in the mutation.
+func (m *SenderKeyDistributionMutation) RoomID() (id int, exists bool) {
+	if m.room != nil {
+		return *m.room, true
+	}
+	return
+}
+
+// RoomIDs returns the "room" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoomID instead. It exists only for internal usage by the builders.
+func (m *SenderKeyDistributionMutation) RoomIDs() (ids []int) {
+	if id := m.room; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoom resets all changes to the "room" edge.
+func (m *SenderKeyDistributionMutation) ResetRoom() {
+	m.room = nil
+	m.clearedroom = false
+}
+
+// SetSenderID sets the "sender" edge to the User entity by id.
+func (m *SenderKeyDistributionMutation) SetSenderID(id int) {
+	m.sender = &id
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (m *SenderKeyDistributionMutation) ClearSender() {
+	m.clearedsender = true
+}
+
+// SenderCleared reports if the "sender" edge to the User entity was cleared.
+func (m *SenderKeyDistributionMutation) SenderCleared() bool {
+	return m.clearedsender
+}
+
+// SenderID returns the "sender" edge ID in the mutation.
+func (m *SenderKeyDistributionMutation) SenderID() (id int, exists bool) {
+	if m.sender != nil {
+		return *m.sender, true
+	}
+	return
+}
+
+// SenderIDs returns the "sender" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SenderID instead. It exists only for internal usage by the builders.
+func (m *SenderKeyDistributionMutation) SenderIDs() (ids []int) {
+	if id := m.sender; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSender resets all changes to the "sender" edge.
+func (m *SenderKeyDistributionMutation) ResetSender() {
+	m.sender = nil
+	m.clearedsender = false
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by id.
+func (m *SenderKeyDistributionMutation) SetDeviceID(id int) {
+	m.device = &id
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (m *SenderKeyDistributionMutation) ClearDevice() {
+	m.cleareddevice = true
+}
+
+// DeviceCleared reports if the "device" edge to the Device entity was cleared.
+func (m *SenderKeyDistributionMutation) DeviceCleared() bool {
+	return m.cleareddevice
+}
+
+// DeviceID returns the "device" edge ID in the mutation.
+func (m *SenderKeyDistributionMutation) DeviceID() (id int, exists bool) {
+	if m.device != nil {
+		return *m.device, true
+	}
+	return
+}
+
+// DeviceIDs returns the "device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeviceID instead. It exists only for internal usage by the builders.
+func (m *SenderKeyDistributionMutation) DeviceIDs() (ids []int) {
+	if id := m.device; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDevice resets all changes to the "device" edge.
+func (m *SenderKeyDistributionMutation) ResetDevice() {
+	m.device = nil
+	m.cleareddevice = false
+}
+
+// Where appends a list predicates to the SenderKeyDistributionMutation builder.
+func (m *SenderKeyDistributionMutation) Where(ps ...predicate.SenderKeyDistribution) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SenderKeyDistributionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SenderKeyDistributionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SenderKeyDistribution, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SenderKeyDistributionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SenderKeyDistributionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SenderKeyDistribution).
+func (m *SenderKeyDistributionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SenderKeyDistributionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.distribution_id != nil {
+		fields = append(fields, senderkeydistribution.FieldDistributionID)
+	}
+	if m.sender_device_id != nil {
+		fields = append(fields, senderkeydistribution.FieldSenderDeviceID)
+	}
+	if m.cipher_text != nil {
+		fields = append(fields, senderkeydistribution.FieldCipherText)
+	}
+	if m.created_at != nil {
+		fields = append(fields, senderkeydistribution.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SenderKeyDistributionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case senderkeydistribution.FieldDistributionID:
+		return m.DistributionID()
+	case senderkeydistribution.FieldSenderDeviceID:
+		return m.SenderDeviceID()
+	case senderkeydistribution.FieldCipherText:
+		return m.CipherText()
+	case senderkeydistribution.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SenderKeyDistributionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case senderkeydistribution.FieldDistributionID:
+		return m.OldDistributionID(ctx)
+	case senderkeydistribution.FieldSenderDeviceID:
+		return m.OldSenderDeviceID(ctx)
+	case senderkeydistribution.FieldCipherText:
+		return m.OldCipherText(ctx)
+	case senderkeydistribution.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SenderKeyDistribution field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SenderKeyDistributionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case senderkeydistribution.FieldDistributionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDistributionID(v)
+		return nil
+	case senderkeydistribution.FieldSenderDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderDeviceID(v)
+		return nil
+	case senderkeydistribution.FieldCipherText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCipherText(v)
+		return nil
+	case senderkeydistribution.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SenderKeyDistribution field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SenderKeyDistributionMutation) AddedFields() []string {
+	var fields []string
+	if m.addsender_device_id != nil {
+		fields = append(fields, senderkeydistribution.FieldSenderDeviceID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SenderKeyDistributionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case senderkeydistribution.FieldSenderDeviceID:
+		return m.AddedSenderDeviceID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SenderKeyDistributionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case senderkeydistribution.FieldSenderDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSenderDeviceID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SenderKeyDistribution numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SenderKeyDistributionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SenderKeyDistributionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SenderKeyDistributionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SenderKeyDistribution nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SenderKeyDistributionMutation) ResetField(name string) error {
+	switch name {
+	case senderkeydistribution.FieldDistributionID:
+		m.ResetDistributionID()
+		return nil
+	case senderkeydistribution.FieldSenderDeviceID:
+		m.ResetSenderDeviceID()
+		return nil
+	case senderkeydistribution.FieldCipherText:
+		m.ResetCipherText()
+		return nil
+	case senderkeydistribution.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SenderKeyDistribution field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SenderKeyDistributionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.room != nil {
+		edges = append(edges, senderkeydistribution.EdgeRoom)
+	}
+	if m.sender != nil {
+		edges = append(edges, senderkeydistribution.EdgeSender)
+	}
+	if m.device != nil {
+		edges = append(edges, senderkeydistribution.EdgeDevice)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SenderKeyDistributionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case senderkeydistribution.EdgeRoom:
+		if id := m.room; id != nil {
+			return []ent.Value{*id}
+		}
+	case senderkeydistribution.EdgeSender:
+		if id := m.sender; id != nil {
+			return []ent.Value{*id}
+		}
+	case senderkeydistribution.EdgeDevice:
+		if id := m.device; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SenderKeyDistributionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SenderKeyDistributionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SenderKeyDistributionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedroom {
+		edges = append(edges, senderkeydistribution.EdgeRoom)
+	}
+	if m.clearedsender {
+		edges = append(edges, senderkeydistribution.EdgeSender)
+	}
+	if m.cleareddevice {
+		edges = append(edges, senderkeydistribution.EdgeDevice)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SenderKeyDistributionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case senderkeydistribution.EdgeRoom:
+		return m.clearedroom
+	case senderkeydistribution.EdgeSender:
+		return m.clearedsender
+	case senderkeydistribution.EdgeDevice:
+		return m.cleareddevice
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SenderKeyDistributionMutation) ClearEdge(name string) error {
+	switch name {
+	case senderkeydistribution.EdgeRoom:
+		m.ClearRoom()
+		return nil
+	case senderkeydistribution.EdgeSender:
+		m.ClearSender()
+		return nil
+	case senderkeydistribution.EdgeDevice:
+		m.ClearDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown SenderKeyDistribution unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SenderKeyDistributionMutation) ResetEdge(name string) error {
+	switch name {
+	case senderkeydistribution.EdgeRoom:
+		m.ResetRoom()
+		return nil
+	case senderkeydistribution.EdgeSender:
+		m.ResetSender()
+		return nil
+	case senderkeydistribution.EdgeDevice:
+		m.ResetDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown SenderKeyDistribution edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int
+	device_label               *string
+	ip_address                 *string
+	user_agent                 *string
+	refresh_token_hash         *string
+	retired_token_hashes       *[]string
+	appendretired_token_hashes []string
+	created_at                 *time.Time
+	last_used_at               *time.Time
+	expires_at                 *time.Time
+	revoked_at                 *time.Time
+	revocation_reason          *string
+	mfa_verified_at            *time.Time
+	clearedFields              map[string]struct{}
+	user                       *int
+	cleareduser                bool
+	done                       bool
+	oldValue                   func(context.Context) (*Session, error)
+	predicates                 []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeviceLabel sets the "device_label" field.
+func (m *SessionMutation) SetDeviceLabel(s string) {
+	m.device_label = &s
+}
+
+// DeviceLabel returns the value of the "device_label" field in the mutation.
+func (m *SessionMutation) DeviceLabel() (r string, exists bool) {
+	v := m.device_label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceLabel returns the old "device_label" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldDeviceLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceLabel: %w", err)
+	}
+	return oldValue.DeviceLabel, nil
+}
+
+// ResetDeviceLabel resets all changes to the "device_label" field.
+func (m *SessionMutation) ResetDeviceLabel() {
+	m.device_label = nil
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *SessionMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *SessionMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *SessionMutation) ResetIPAddress() {
+	m.ip_address = nil
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *SessionMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SessionMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SessionMutation) ResetUserAgent() {
+	m.user_agent = nil
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (m *SessionMutation) SetRefreshTokenHash(s string) {
+	m.refresh_token_hash = &s
+}
+
+// RefreshTokenHash returns the value of the "refresh_token_hash" field in the mutation.
+func (m *SessionMutation) RefreshTokenHash() (r string, exists bool) {
+	v := m.refresh_token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefreshTokenHash returns the old "refresh_token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRefreshTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefreshTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefreshTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefreshTokenHash: %w", err)
+	}
+	return oldValue.RefreshTokenHash, nil
+}
+
+// ResetRefreshTokenHash resets all changes to the "refresh_token_hash" field.
+func (m *SessionMutation) ResetRefreshTokenHash() {
+	m.refresh_token_hash = nil
+}
+
+// SetRetiredTokenHashes sets the "retired_token_hashes" field.
+func (m *SessionMutation) SetRetiredTokenHashes(s []string) {
+	m.retired_token_hashes = &s
+	m.appendretired_token_hashes = nil
+}
+
+// RetiredTokenHashes returns the value of the "retired_token_hashes" field in the mutation.
+func (m *SessionMutation) RetiredTokenHashes() (r []string, exists bool) {
+	v := m.retired_token_hashes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetiredTokenHashes returns the old "retired_token_hashes" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRetiredTokenHashes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetiredTokenHashes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetiredTokenHashes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetiredTokenHashes: %w", err)
+	}
+	return oldValue.RetiredTokenHashes, nil
+}
+
+// AppendRetiredTokenHashes adds s to the "retired_token_hashes" field.
+func (m *SessionMutation) AppendRetiredTokenHashes(s []string) {
+	m.appendretired_token_hashes = append(m.appendretired_token_hashes, s...)
+}
+
+// AppendedRetiredTokenHashes returns the list of values that were appended to the "retired_token_hashes" field in this mutation.
+func (m *SessionMutation) AppendedRetiredTokenHashes() ([]string, bool) {
+	if len(m.appendretired_token_hashes) == 0 {
+		return nil, false
+	}
+	return m.appendretired_token_hashes, true
+}
+
+// ClearRetiredTokenHashes clears the value of the "retired_token_hashes" field.
+func (m *SessionMutation) ClearRetiredTokenHashes() {
+	m.retired_token_hashes = nil
+	m.appendretired_token_hashes = nil
+	m.clearedFields[session.FieldRetiredTokenHashes] = struct{}{}
+}
+
+// RetiredTokenHashesCleared returns if the "retired_token_hashes" field was cleared in this mutation.
+func (m *SessionMutation) RetiredTokenHashesCleared() bool {
+	_, ok := m.clearedFields[session.FieldRetiredTokenHashes]
+	return ok
+}
+
+// ResetRetiredTokenHashes resets all changes to the "retired_token_hashes" field.
+func (m *SessionMutation) ResetRetiredTokenHashes() {
+	m.retired_token_hashes = nil
+	m.appendretired_token_hashes = nil
+	delete(m.clearedFields, session.FieldRetiredTokenHashes)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *SessionMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *SessionMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedAt returns the old "last_used_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldLastUsedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *SessionMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *SessionMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *SessionMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *SessionMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[session.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *SessionMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *SessionMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, session.FieldRevokedAt)
+}
+
+// SetRevocationReason sets the "revocation_reason" field.
+func (m *SessionMutation) SetRevocationReason(s string) {
+	m.revocation_reason = &s
+}
+
+// RevocationReason returns the value of the "revocation_reason" field in the mutation.
+func (m *SessionMutation) RevocationReason() (r string, exists bool) {
+	v := m.revocation_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevocationReason returns the old "revocation_reason" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRevocationReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevocationReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevocationReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevocationReason: %w", err)
+	}
+	return oldValue.RevocationReason, nil
+}
+
+// ClearRevocationReason clears the value of the "revocation_reason" field.
+func (m *SessionMutation) ClearRevocationReason() {
+	m.revocation_reason = nil
+	m.clearedFields[session.FieldRevocationReason] = struct{}{}
+}
+
+// RevocationReasonCleared returns if the "revocation_reason" field was cleared in this mutation.
+func (m *SessionMutation) RevocationReasonCleared() bool {
+	_, ok := m.clearedFields[session.FieldRevocationReason]
+	return ok
+}
+
+// ResetRevocationReason resets all changes to the "revocation_reason" field.
+func (m *SessionMutation) ResetRevocationReason() {
+	m.revocation_reason = nil
+	delete(m.clearedFields, session.FieldRevocationReason)
+}
+
+// SetMfaVerifiedAt sets the "mfa_verified_at" field.
+func (m *SessionMutation) SetMfaVerifiedAt(t time.Time) {
+	m.mfa_verified_at = &t
+}
+
+// MfaVerifiedAt returns the value of the "mfa_verified_at" field in the mutation.
+func (m *SessionMutation) MfaVerifiedAt() (r time.Time, exists bool) {
+	v := m.mfa_verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaVerifiedAt returns the old "mfa_verified_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldMfaVerifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaVerifiedAt: %w", err)
+	}
+	return oldValue.MfaVerifiedAt, nil
+}
+
+// ClearMfaVerifiedAt clears the value of the "mfa_verified_at" field.
+func (m *SessionMutation) ClearMfaVerifiedAt() {
+	m.mfa_verified_at = nil
+	m.clearedFields[session.FieldMfaVerifiedAt] = struct{}{}
+}
+
+// MfaVerifiedAtCleared returns if the "mfa_verified_at" field was cleared in this mutation.
+func (m *SessionMutation) MfaVerifiedAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldMfaVerifiedAt]
+	return ok
+}
+
+// ResetMfaVerifiedAt resets all changes to the "mfa_verified_at" field.
+func (m *SessionMutation) ResetMfaVerifiedAt() {
+	m.mfa_verified_at = nil
+	delete(m.clearedFields, session.FieldMfaVerifiedAt)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SessionMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *SessionMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.device_label != nil {
+		fields = append(fields, session.FieldDeviceLabel)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, session.FieldIPAddress)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.refresh_token_hash != nil {
+		fields = append(fields, session.FieldRefreshTokenHash)
+	}
+	if m.retired_token_hashes != nil {
+		fields = append(fields, session.FieldRetiredTokenHashes)
+	}
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, session.FieldLastUsedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, session.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	if m.revocation_reason != nil {
+		fields = append(fields, session.FieldRevocationReason)
+	}
+	if m.mfa_verified_at != nil {
+		fields = append(fields, session.FieldMfaVerifiedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldDeviceLabel:
+		return m.DeviceLabel()
+	case session.FieldIPAddress:
+		return m.IPAddress()
+	case session.FieldUserAgent:
+		return m.UserAgent()
+	case session.FieldRefreshTokenHash:
+		return m.RefreshTokenHash()
+	case session.FieldRetiredTokenHashes:
+		return m.RetiredTokenHashes()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	case session.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	case session.FieldRevokedAt:
+		return m.RevokedAt()
+	case session.FieldRevocationReason:
+		return m.RevocationReason()
+	case session.FieldMfaVerifiedAt:
+		return m.MfaVerifiedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldDeviceLabel:
+		return m.OldDeviceLabel(ctx)
+	case session.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case session.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case session.FieldRefreshTokenHash:
+		return m.OldRefreshTokenHash(ctx)
+	case session.FieldRetiredTokenHashes:
+		return m.OldRetiredTokenHashes(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case session.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case session.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case session.FieldRevocationReason:
+		return m.OldRevocationReason(ctx)
+	case session.FieldMfaVerifiedAt:
+		return m.OldMfaVerifiedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldDeviceLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceLabel(v)
+		return nil
+	case session.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case session.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case session.FieldRefreshTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshTokenHash(v)
+		return nil
+	case session.FieldRetiredTokenHashes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetiredTokenHashes(v)
+		return nil
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case session.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case session.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case session.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case session.FieldRevocationReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevocationReason(v)
+		return nil
+	case session.FieldMfaVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaVerifiedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Session numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldRetiredTokenHashes) {
+		fields = append(fields, session.FieldRetiredTokenHashes)
+	}
+	if m.FieldCleared(session.FieldRevokedAt) {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	if m.FieldCleared(session.FieldRevocationReason) {
+		fields = append(fields, session.FieldRevocationReason)
+	}
+	if m.FieldCleared(session.FieldMfaVerifiedAt) {
+		fields = append(fields, session.FieldMfaVerifiedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldRetiredTokenHashes:
+		m.ClearRetiredTokenHashes()
+		return nil
+	case session.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	case session.FieldRevocationReason:
+		m.ClearRevocationReason()
+		return nil
+	case session.FieldMfaVerifiedAt:
+		m.ClearMfaVerifiedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldDeviceLabel:
+		m.ResetDeviceLabel()
+		return nil
+	case session.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case session.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case session.FieldRefreshTokenHash:
+		m.ResetRefreshTokenHash()
+		return nil
+	case session.FieldRetiredTokenHashes:
+		m.ResetRetiredTokenHashes()
+		return nil
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case session.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case session.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case session.FieldRevocationReason:
+		m.ResetRevocationReason()
+		return nil
+	case session.FieldMfaVerifiedAt:
+		m.ResetMfaVerifiedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
+}
+
+// SignedPreKeyMutation represents an operation that mutates the SignedPreKey nodes in the graph.
+type SignedPreKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key_id        *int
+	addkey_id     *int
+	public_key    *string
+	signature     *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	device        *int
+	cleareddevice bool
+	done          bool
+	oldValue      func(context.Context) (*SignedPreKey, error)
+	predicates    []predicate.SignedPreKey
+}
+
+var _ ent.Mutation = (*SignedPreKeyMutation)(nil)
+
+// signedprekeyOption allows management of the mutation configuration using functional options.
+type signedprekeyOption func(*SignedPreKeyMutation)
+
+// newSignedPreKeyMutation creates new mutation for the SignedPreKey entity.
+func newSignedPreKeyMutation(c config, op Op, opts ...signedprekeyOption) *SignedPreKeyMutation {
+	m := &SignedPreKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSignedPreKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSignedPreKeyID sets the ID field of the mutation.
+func withSignedPreKeyID(id int) signedprekeyOption {
+	return func(m *SignedPreKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SignedPreKey
+		)
+		m.oldValue = func(ctx context.Context) (*SignedPreKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SignedPreKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSignedPreKey sets the old SignedPreKey of the mutation.
+func withSignedPreKey(node *SignedPreKey) signedprekeyOption {
+	return func(m *SignedPreKeyMutation) {
+		m.oldValue = func(context.Context) (*SignedPreKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SignedPreKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SignedPreKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SignedPreKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SignedPreKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SignedPreKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKeyID sets the "key_id" field.
+func (m *SignedPreKeyMutation) SetKeyID(i int) {
+	m.key_id = &i
+	m.addkey_id = nil
+}
+
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *SignedPreKeyMutation) KeyID() (r int, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyID returns the old "key_id" field's value of the SignedPreKey entity.
+// If the SignedPreKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignedPreKeyMutation) OldKeyID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
+	}
+	return oldValue.KeyID, nil
+}
+
+// AddKeyID adds i to the "key_id" field.
+func (m *SignedPreKeyMutation) AddKeyID(i int) {
+	if m.addkey_id != nil {
+		*m.addkey_id += i
+	} else {
+		m.addkey_id = &i
+	}
+}
+
+// AddedKeyID returns the value that was added to the "key_id" field in this mutation.
+func (m *SignedPreKeyMutation) AddedKeyID() (r int, exists bool) {
+	v := m.addkey_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *SignedPreKeyMutation) ResetKeyID() {
+	m.key_id = nil
+	m.addkey_id = nil
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *SignedPreKeyMutation) SetPublicKey(s string) {
+	m.public_key = &s
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *SignedPreKeyMutation) PublicKey() (r string, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the SignedPreKey entity.
+// If the SignedPreKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignedPreKeyMutation) OldPublicKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *SignedPreKeyMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetSignature sets the "signature" field.
+func (m *SignedPreKeyMutation) SetSignature(s string) {
+	m.signature = &s
+}
+
+// Signature returns the value of the "signature" field in the mutation.
+func (m *SignedPreKeyMutation) Signature() (r string, exists bool) {
+	v := m.signature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignature returns the old "signature" field's value of the SignedPreKey entity.
+// If the SignedPreKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignedPreKeyMutation) OldSignature(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+	}
+	return oldValue.Signature, nil
+}
+
+// ResetSignature resets all changes to the "signature" field.
+func (m *SignedPreKeyMutation) ResetSignature() {
+	m.signature = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SignedPreKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SignedPreKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SignedPreKey entity.
+// If the SignedPreKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SignedPreKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SignedPreKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by id.
+func (m *SignedPreKeyMutation) SetDeviceID(id int) {
+	m.device = &id
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (m *SignedPreKeyMutation) ClearDevice() {
+	m.cleareddevice = true
+}
+
+// DeviceCleared reports if the "device" edge to the Device entity was cleared.
+func (m *SignedPreKeyMutation) DeviceCleared() bool {
+	return m.cleareddevice
+}
+
+// DeviceID returns the "device" edge ID in the mutation.
+func (m *SignedPreKeyMutation) DeviceID() (id int, exists bool) {
+	if m.device != nil {
+		return *m.device, true
+	}
+	return
+}
+
+// DeviceIDs returns the "device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeviceID instead. It exists only for internal usage by the builders.
+func (m *SignedPreKeyMutation) DeviceIDs() (ids []int) {
+	if id := m.device; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDevice resets all changes to the "device" edge.
+func (m *SignedPreKeyMutation) ResetDevice() {
+	m.device = nil
+	m.cleareddevice = false
+}
+
+// Where appends a list predicates to the SignedPreKeyMutation builder.
+func (m *SignedPreKeyMutation) Where(ps ...predicate.SignedPreKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SignedPreKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SignedPreKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SignedPreKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SignedPreKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SignedPreKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SignedPreKey).
+func (m *SignedPreKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SignedPreKeyMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.key_id != nil {
+		fields = append(fields, signedprekey.FieldKeyID)
+	}
+	if m.public_key != nil {
+		fields = append(fields, signedprekey.FieldPublicKey)
+	}
+	if m.signature != nil {
+		fields = append(fields, signedprekey.FieldSignature)
+	}
+	if m.created_at != nil {
+		fields = append(fields, signedprekey.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SignedPreKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case signedprekey.FieldKeyID:
+		return m.KeyID()
+	case signedprekey.FieldPublicKey:
+		return m.PublicKey()
+	case signedprekey.FieldSignature:
+		return m.Signature()
+	case signedprekey.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SignedPreKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case signedprekey.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case signedprekey.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case signedprekey.FieldSignature:
+		return m.OldSignature(ctx)
+	case signedprekey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SignedPreKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SignedPreKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case signedprekey.FieldKeyID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyID(v)
+		return nil
+	case signedprekey.FieldPublicKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicKey(v)
+		return nil
+	case signedprekey.FieldSignature:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignature(v)
+		return nil
+	case signedprekey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SignedPreKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SignedPreKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addkey_id != nil {
+		fields = append(fields, signedprekey.FieldKeyID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SignedPreKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case signedprekey.FieldKeyID:
+		return m.AddedKeyID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SignedPreKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case signedprekey.FieldKeyID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeyID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SignedPreKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SignedPreKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SignedPreKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SignedPreKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SignedPreKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SignedPreKeyMutation) ResetField(name string) error {
+	switch name {
+	case signedprekey.FieldKeyID:
+		m.ResetKeyID()
+		return nil
+	case signedprekey.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case signedprekey.FieldSignature:
+		m.ResetSignature()
+		return nil
+	case signedprekey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SignedPreKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SignedPreKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.device != nil {
+		edges = append(edges, signedprekey.EdgeDevice)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SignedPreKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case signedprekey.EdgeDevice:
+		if id := m.device; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SignedPreKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SignedPreKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SignedPreKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddevice {
+		edges = append(edges, signedprekey.EdgeDevice)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SignedPreKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case signedprekey.EdgeDevice:
+		return m.cleareddevice
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SignedPreKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case signedprekey.EdgeDevice:
+		m.ClearDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown SignedPreKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SignedPreKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case signedprekey.EdgeDevice:
+		m.ResetDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown SignedPreKey edge %s", name)
+}
+
+// TotpSecretMutation represents an operation that mutates the TotpSecret nodes in the graph.
+type TotpSecretMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	secret             *string
+	confirmed_at       *time.Time
+	last_used_step     *int64
+	addlast_used_step  *int64
+	failed_attempts    *int
+	addfailed_attempts *int
+	locked_until       *time.Time
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	user               *int
+	cleareduser        bool
+	done               bool
+	oldValue           func(context.Context) (*TotpSecret, error)
+	predicates         []predicate.TotpSecret
+}
+
+var _ ent.Mutation = (*TotpSecretMutation)(nil)
+
+// totpsecretOption allows management of the mutation configuration using functional options.
+type totpsecretOption func(*TotpSecretMutation)
+
+// newTotpSecretMutation creates new mutation for the TotpSecret entity.
+func newTotpSecretMutation(c config, op Op, opts ...totpsecretOption) *TotpSecretMutation {
+	m := &TotpSecretMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTotpSecret,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTotpSecretID sets the ID field of the mutation.
+func withTotpSecretID(id int) totpsecretOption {
+	return func(m *TotpSecretMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TotpSecret
+		)
+		m.oldValue = func(ctx context.Context) (*TotpSecret, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TotpSecret.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTotpSecret sets the old TotpSecret of the mutation.
+func withTotpSecret(node *TotpSecret) totpsecretOption {
+	return func(m *TotpSecretMutation) {
+		m.oldValue = func(context.Context) (*TotpSecret, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TotpSecretMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TotpSecretMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TotpSecretMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TotpSecretMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TotpSecret.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSecret sets the "secret" field.
+func (m *TotpSecretMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *TotpSecretMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the TotpSecret entity.
+// If the TotpSecret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TotpSecretMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *TotpSecretMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (m *TotpSecretMutation) SetConfirmedAt(t time.Time) {
+	m.confirmed_at = &t
+}
+
+// ConfirmedAt returns the value of the "confirmed_at" field in the mutation.
+func (m *TotpSecretMutation) ConfirmedAt() (r time.Time, exists bool) {
+	v := m.confirmed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfirmedAt returns the old "confirmed_at" field's value of the TotpSecret entity.
+// If the TotpSecret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TotpSecretMutation) OldConfirmedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfirmedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfirmedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfirmedAt: %w", err)
+	}
+	return oldValue.ConfirmedAt, nil
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (m *TotpSecretMutation) ClearConfirmedAt() {
+	m.confirmed_at = nil
+	m.clearedFields[totpsecret.FieldConfirmedAt] = struct{}{}
+}
+
+// ConfirmedAtCleared returns if the "confirmed_at" field was cleared in this mutation.
+func (m *TotpSecretMutation) ConfirmedAtCleared() bool {
+	_, ok := m.clearedFields[totpsecret.FieldConfirmedAt]
+	return ok
+}
+
+// ResetConfirmedAt resets all changes to the "confirmed_at" field.
+func (m *TotpSecretMutation) ResetConfirmedAt() {
+	m.confirmed_at = nil
+	delete(m.clearedFields, totpsecret.FieldConfirmedAt)
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (m *TotpSecretMutation) SetLastUsedStep(i int64) {
+	m.last_used_step = &i
+	m.addlast_used_step = nil
+}
+
+// LastUsedStep returns the value of the "last_used_step" field in the mutation.
+func (m *TotpSecretMutation) LastUsedStep() (r int64, exists bool) {
+	v := m.last_used_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedStep returns the old "last_used_step" field's value of the TotpSecret entity.
+// If the TotpSecret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TotpSecretMutation) OldLastUsedStep(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedStep: %w", err)
+	}
+	return oldValue.LastUsedStep, nil
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (m *TotpSecretMutation) AddLastUsedStep(i int64) {
+	if m.addlast_used_step != nil {
+		*m.addlast_used_step += i
+	} else {
+		m.addlast_used_step = &i
+	}
+}
+
+// AddedLastUsedStep returns the value that was added to the "last_used_step" field in this mutation.
+func (m *TotpSecretMutation) AddedLastUsedStep() (r int64, exists bool) {
+	v := m.addlast_used_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastUsedStep resets all changes to the "last_used_step" field.
+func (m *TotpSecretMutation) ResetLastUsedStep() {
+	m.last_used_step = nil
+	m.addlast_used_step = nil
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (m *TotpSecretMutation) SetFailedAttempts(i int) {
+	m.failed_attempts = &i
+	m.addfailed_attempts = nil
+}
+
+// FailedAttempts returns the value of the "failed_attempts" field in the mutation.
+func (m *TotpSecretMutation) FailedAttempts() (r int, exists bool) {
+	v := m.failed_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedAttempts returns the old "failed_attempts" field's value of the TotpSecret entity.
+// If the TotpSecret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TotpSecretMutation) OldFailedAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedAttempts: %w", err)
+	}
+	return oldValue.FailedAttempts, nil
+}
+
+// AddFailedAttempts adds i to the "failed_attempts" field.
+func (m *TotpSecretMutation) AddFailedAttempts(i int) {
+	if m.addfailed_attempts != nil {
+		*m.addfailed_attempts += i
+	} else {
+		m.addfailed_attempts = &i
+	}
+}
+
+// AddedFailedAttempts returns the value that was added to the "failed_attempts" field in this mutation.
+func (m *TotpSecretMutation) AddedFailedAttempts() (r int, exists bool) {
+	v := m.addfailed_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailedAttempts resets all changes to the "failed_attempts" field.
+func (m *TotpSecretMutation) ResetFailedAttempts() {
+	m.failed_attempts = nil
+	m.addfailed_attempts = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *TotpSecretMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *TotpSecretMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the TotpSecret entity.
+// If the TotpSecret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TotpSecretMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *TotpSecretMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[totpsecret.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *TotpSecretMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[totpsecret.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *TotpSecretMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, totpsecret.FieldLockedUntil)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TotpSecretMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TotpSecretMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TotpSecret entity.
+// If the TotpSecret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TotpSecretMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TotpSecretMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *TotpSecretMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *TotpSecretMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *TotpSecretMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *TotpSecretMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *TotpSecretMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *TotpSecretMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the TotpSecretMutation builder.
+func (m *TotpSecretMutation) Where(ps ...predicate.TotpSecret) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TotpSecretMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TotpSecretMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TotpSecret, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TotpSecretMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TotpSecretMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TotpSecret).
+func (m *TotpSecretMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TotpSecretMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.secret != nil {
+		fields = append(fields, totpsecret.FieldSecret)
+	}
+	if m.confirmed_at != nil {
+		fields = append(fields, totpsecret.FieldConfirmedAt)
+	}
+	if m.last_used_step != nil {
+		fields = append(fields, totpsecret.FieldLastUsedStep)
+	}
+	if m.failed_attempts != nil {
+		fields = append(fields, totpsecret.FieldFailedAttempts)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, totpsecret.FieldLockedUntil)
+	}
+	if m.created_at != nil {
+		fields = append(fields, totpsecret.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TotpSecretMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case totpsecret.FieldSecret:
+		return m.Secret()
+	case totpsecret.FieldConfirmedAt:
+		return m.ConfirmedAt()
+	case totpsecret.FieldLastUsedStep:
+		return m.LastUsedStep()
+	case totpsecret.FieldFailedAttempts:
+		return m.FailedAttempts()
+	case totpsecret.FieldLockedUntil:
+		return m.LockedUntil()
+	case totpsecret.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TotpSecretMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case totpsecret.FieldSecret:
+		return m.OldSecret(ctx)
+	case totpsecret.FieldConfirmedAt:
+		return m.OldConfirmedAt(ctx)
+	case totpsecret.FieldLastUsedStep:
+		return m.OldLastUsedStep(ctx)
+	case totpsecret.FieldFailedAttempts:
+		return m.OldFailedAttempts(ctx)
+	case totpsecret.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case totpsecret.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TotpSecret field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TotpSecretMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case totpsecret.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case totpsecret.FieldConfirmedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfirmedAt(v)
+		return nil
+	case totpsecret.FieldLastUsedStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedStep(v)
+		return nil
+	case totpsecret.FieldFailedAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedAttempts(v)
+		return nil
+	case totpsecret.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case totpsecret.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TotpSecret field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TotpSecretMutation) AddedFields() []string {
+	var fields []string
+	if m.addlast_used_step != nil {
+		fields = append(fields, totpsecret.FieldLastUsedStep)
+	}
+	if m.addfailed_attempts != nil {
+		fields = append(fields, totpsecret.FieldFailedAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TotpSecretMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case totpsecret.FieldLastUsedStep:
+		return m.AddedLastUsedStep()
+	case totpsecret.FieldFailedAttempts:
+		return m.AddedFailedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TotpSecretMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case totpsecret.FieldLastUsedStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastUsedStep(v)
+		return nil
+	case totpsecret.FieldFailedAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TotpSecret numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TotpSecretMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(totpsecret.FieldConfirmedAt) {
+		fields = append(fields, totpsecret.FieldConfirmedAt)
+	}
+	if m.FieldCleared(totpsecret.FieldLockedUntil) {
+		fields = append(fields, totpsecret.FieldLockedUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TotpSecretMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TotpSecretMutation) ClearField(name string) error {
+	switch name {
+	case totpsecret.FieldConfirmedAt:
+		m.ClearConfirmedAt()
+		return nil
+	case totpsecret.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown TotpSecret nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TotpSecretMutation) ResetField(name string) error {
+	switch name {
+	case totpsecret.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case totpsecret.FieldConfirmedAt:
+		m.ResetConfirmedAt()
+		return nil
+	case totpsecret.FieldLastUsedStep:
+		m.ResetLastUsedStep()
+		return nil
+	case totpsecret.FieldFailedAttempts:
+		m.ResetFailedAttempts()
+		return nil
+	case totpsecret.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case totpsecret.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TotpSecret field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TotpSecretMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, totpsecret.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TotpSecretMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case totpsecret.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TotpSecretMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TotpSecretMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TotpSecretMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, totpsecret.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TotpSecretMutation) EdgeCleared(name string) bool {
+	switch name {
+	case totpsecret.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TotpSecretMutation) ClearEdge(name string) error {
+	switch name {
+	case totpsecret.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown TotpSecret unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TotpSecretMutation) ResetEdge(name string) error {
+	switch name {
+	case totpsecret.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown TotpSecret edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                                   Op
+	typ                                  string
+	id                                   *int
+	username                             *string
+	display_name                         *string
+	email                                *string
+	verified_at                          *time.Time
+	avatar_url                           *string
+	is_bot                               *bool
+	role                                 *user.Role
+	suspended_at                         *time.Time
+	discoverability                      *user.Discoverability
+	deletion_scheduled_at                *time.Time
+	is_tombstone                         *bool
+	created_at                           *time.Time
+	updated_at                           *time.Time
+	last_seen_at                         *time.Time
+	clearedFields                        map[string]struct{}
+	memberships                          map[int]struct{}
+	removedmemberships                   map[int]struct{}
+	clearedmemberships                   bool
+	messages                             map[int]struct{}
+	removedmessages                      map[int]struct{}
+	clearedmessages                      bool
+	uploaded_media                       map[int]struct{}
+	removeduploaded_media                map[int]struct{}
+	cleareduploaded_media                bool
+	owned_rooms                          map[int]struct{}
+	removedowned_rooms                   map[int]struct{}
+	clearedowned_rooms                   bool
+	contacts                             map[int]struct{}
+	removedcontacts                      map[int]struct{}
+	clearedcontacts                      bool
+	contact_entries                      map[int]struct{}
+	removedcontact_entries               map[int]struct{}
+	clearedcontact_entries               bool
+	favourites                           map[int]struct{}
+	removedfavourites                    map[int]struct{}
+	clearedfavourites                    bool
+	initiated_calls                      map[int]struct{}
+	removedinitiated_calls               map[int]struct{}
+	clearedinitiated_calls               bool
+	call_participations                  map[int]struct{}
+	removedcall_participations           map[int]struct{}
+	clearedcall_participations           bool
+	credential                           *int
+	clearedcredential                    bool
+	sessions                             map[int]struct{}
+	removedsessions                      map[int]struct{}
+	clearedsessions                      bool
+	identities                           map[int]struct{}
+	removedidentities                    map[int]struct{}
+	clearedidentities                    bool
+	totp_secret                          *int
+	clearedtotp_secret                   bool
+	recovery_codes                       map[int]struct{}
+	removedrecovery_codes                map[int]struct{}
+	clearedrecovery_codes                bool
+	api_keys                             map[int]struct{}
+	removedapi_keys                      map[int]struct{}
+	clearedapi_keys                      bool
+	email_tokens                         map[int]struct{}
+	removedemail_tokens                  map[int]struct{}
+	clearedemail_tokens                  bool
+	login_throttles                      map[int]struct{}
+	removedlogin_throttles               map[int]struct{}
+	clearedlogin_throttles               bool
+	devices                              map[int]struct{}
+	removeddevices                       map[int]struct{}
+	cleareddevices                       bool
+	mls_commits                          map[int]struct{}
+	removedmls_commits                   map[int]struct{}
+	clearedmls_commits                   bool
+	sent_mls_welcomes                    map[int]struct{}
+	removedsent_mls_welcomes             map[int]struct{}
+	clearedsent_mls_welcomes             bool
+	sent_sender_key_distributions        map[int]struct{}
+	removedsent_sender_key_distributions map[int]struct{}
+	clearedsent_sender_key_distributions bool
+	service_owner                        *int
+	clearedservice_owner                 bool
+	service_accounts                     map[int]struct{}
+	removedservice_accounts              map[int]struct{}
+	clearedservice_accounts              bool
+	done                                 bool
+	oldValue                             func(context.Context) (*User, error)
+	predicates                           []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *UserMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *UserMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *UserMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (m *UserMutation) SetVerifiedAt(t time.Time) {
+	m.verified_at = &t
+}
+
+// VerifiedAt returns the value of the "verified_at" field in the mutation.
+func (m *UserMutation) VerifiedAt() (r time.Time, exists bool) {
+	v := m.verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifiedAt returns the old "verified_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldVerifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifiedAt: %w", err)
+	}
+	return oldValue.VerifiedAt, nil
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (m *UserMutation) ClearVerifiedAt() {
+	m.verified_at = nil
+	m.clearedFields[user.FieldVerifiedAt] = struct{}{}
+}
+
+// VerifiedAtCleared returns if the "verified_at" field was cleared in this mutation.
+func (m *UserMutation) VerifiedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldVerifiedAt]
+	return ok
+}
+
+// ResetVerifiedAt resets all changes to the "verified_at" field.
+func (m *UserMutation) ResetVerifiedAt() {
+	m.verified_at = nil
+	delete(m.clearedFields, user.FieldVerifiedAt)
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (m *UserMutation) SetAvatarURL(s string) {
+	m.avatar_url = &s
+}
+
+// AvatarURL returns the value of the "avatar_url" field in the mutation.
+func (m *UserMutation) AvatarURL() (r string, exists bool) {
+	v := m.avatar_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatarURL returns the old "avatar_url" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatarURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatarURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatarURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatarURL: %w", err)
+	}
+	return oldValue.AvatarURL, nil
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (m *UserMutation) ClearAvatarURL() {
+	m.avatar_url = nil
+	m.clearedFields[user.FieldAvatarURL] = struct{}{}
+}
+
+// AvatarURLCleared returns if the "avatar_url" field was cleared in this mutation.
+func (m *UserMutation) AvatarURLCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatarURL]
+	return ok
+}
+
+// ResetAvatarURL resets all changes to the "avatar_url" field.
+func (m *UserMutation) ResetAvatarURL() {
+	m.avatar_url = nil
+	delete(m.clearedFields, user.FieldAvatarURL)
+}
+
+// SetIsBot sets the "is_bot" field.
+func (m *UserMutation) SetIsBot(b bool) {
+	m.is_bot = &b
+}
+
+// IsBot returns the value of the "is_bot" field in the mutation.
+func (m *UserMutation) IsBot() (r bool, exists bool) {
+	v := m.is_bot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBot returns the old "is_bot" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsBot(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBot: %w", err)
+	}
+	return oldValue.IsBot, nil
+}
+
+// ResetIsBot resets all changes to the "is_bot" field.
+func (m *UserMutation) ResetIsBot() {
+	m.is_bot = nil
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (m *UserMutation) SetSuspendedAt(t time.Time) {
+	m.suspended_at = &t
+}
+
+// SuspendedAt returns the value of the "suspended_at" field in the mutation.
+func (m *UserMutation) SuspendedAt() (r time.Time, exists bool) {
+	v := m.suspended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuspendedAt returns the old "suspended_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSuspendedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuspendedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuspendedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuspendedAt: %w", err)
+	}
+	return oldValue.SuspendedAt, nil
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (m *UserMutation) ClearSuspendedAt() {
+	m.suspended_at = nil
+	m.clearedFields[user.FieldSuspendedAt] = struct{}{}
+}
+
+// SuspendedAtCleared returns if the "suspended_at" field was cleared in this mutation.
+func (m *UserMutation) SuspendedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldSuspendedAt]
+	return ok
+}
+
+// ResetSuspendedAt resets all changes to the "suspended_at" field.
+func (m *UserMutation) ResetSuspendedAt() {
+	m.suspended_at = nil
+	delete(m.clearedFields, user.FieldSuspendedAt)
+}
+
+// SetDiscoverability sets the "discoverability" field.
+func (m *UserMutation) SetDiscoverability(u user.Discoverability) {
+	m.discoverability = &u
+}
+
+// Discoverability returns the value of the "discoverability" field in the mutation.
+func (m *UserMutation) Discoverability() (r user.Discoverability, exists bool) {
+	v := m.discoverability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscoverability returns the old "discoverability" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDiscoverability(ctx context.Context) (v user.Discoverability, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscoverability is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscoverability requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscoverability: %w", err)
+	}
+	return oldValue.Discoverability, nil
+}
+
+// ResetDiscoverability resets all changes to the "discoverability" field.
+func (m *UserMutation) ResetDiscoverability() {
+	m.discoverability = nil
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (m *UserMutation) SetDeletionScheduledAt(t time.Time) {
+	m.deletion_scheduled_at = &t
+}
+
+// DeletionScheduledAt returns the value of the "deletion_scheduled_at" field in the mutation.
+func (m *UserMutation) DeletionScheduledAt() (r time.Time, exists bool) {
+	v := m.deletion_scheduled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletionScheduledAt returns the old "deletion_scheduled_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletionScheduledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletionScheduledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletionScheduledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletionScheduledAt: %w", err)
+	}
+	return oldValue.DeletionScheduledAt, nil
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (m *UserMutation) ClearDeletionScheduledAt() {
+	m.deletion_scheduled_at = nil
+	m.clearedFields[user.FieldDeletionScheduledAt] = struct{}{}
+}
+
+// DeletionScheduledAtCleared returns if the "deletion_scheduled_at" field was cleared in this mutation.
+func (m *UserMutation) DeletionScheduledAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletionScheduledAt]
+	return ok
+}
+
+// ResetDeletionScheduledAt resets all changes to the "deletion_scheduled_at" field.
+func (m *UserMutation) ResetDeletionScheduledAt() {
+	m.deletion_scheduled_at = nil
+	delete(m.clearedFields, user.FieldDeletionScheduledAt)
+}
+
+// SetIsTombstone sets the "is_tombstone" field.
+func (m *UserMutation) SetIsTombstone(b bool) {
+	m.is_tombstone = &b
+}
+
+// IsTombstone returns the value of the "is_tombstone" field in the mutation.
+func (m *UserMutation) IsTombstone() (r bool, exists bool) {
+	v := m.is_tombstone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsTombstone returns the old "is_tombstone" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsTombstone(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsTombstone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsTombstone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsTombstone: %w", err)
+	}
+	return oldValue.IsTombstone, nil
+}
+
+// ResetIsTombstone resets all changes to the "is_tombstone" field.
+func (m *UserMutation) ResetIsTombstone() {
+	m.is_tombstone = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *UserMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *UserMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *UserMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+}
+
+// AddMembershipIDs adds the "memberships" edge to the RoomMembership entity by ids.
+func (m *UserMutation) AddMembershipIDs(ids ...int) {
+	if m.memberships == nil {
+		m.memberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberships clears the "memberships" edge to the RoomMembership entity.
+func (m *UserMutation) ClearMemberships() {
+	m.clearedmemberships = true
+}
+
+// MembershipsCleared reports if the "memberships" edge to the RoomMembership entity was cleared.
+func (m *UserMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to the RoomMembership entity by IDs.
+func (m *UserMutation) RemoveMembershipIDs(ids ...int) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.memberships, ids[i])
+		m.removedmemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the RoomMembership entity.
+func (m *UserMutation) RemovedMembershipsIDs() (ids []int) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *UserMutation) MembershipsIDs() (ids []int) {
+	for id := range m.memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *UserMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *UserMutation) AddMessageIDs(ids ...int) {
+	if m.messages == nil {
+		m.messages = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *UserMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *UserMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *UserMutation) RemoveMessageIDs(ids ...int) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *UserMutation) RemovedMessagesIDs() (ids []int) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *UserMutation) MessagesIDs() (ids []int) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *UserMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// AddUploadedMediumIDs adds the "uploaded_media" edge to the Media entity by ids.
+func (m *UserMutation) AddUploadedMediumIDs(ids ...int) {
+	if m.uploaded_media == nil {
+		m.uploaded_media = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.uploaded_media[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUploadedMedia clears the "uploaded_media" edge to the Media entity.
+func (m *UserMutation) ClearUploadedMedia() {
+	m.cleareduploaded_media = true
+}
+
+// UploadedMediaCleared reports if the "uploaded_media" edge to the Media entity was cleared.
+func (m *UserMutation) UploadedMediaCleared() bool {
+	return m.cleareduploaded_media
+}
+
+// RemoveUploadedMediumIDs removes the "uploaded_media" edge to the Media entity by IDs.
+func (m *UserMutation) RemoveUploadedMediumIDs(ids ...int) {
+	if m.removeduploaded_media == nil {
+		m.removeduploaded_media = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.uploaded_media, ids[i])
+		m.removeduploaded_media[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUploadedMedia returns the removed IDs of the "uploaded_media" edge to the Media entity.
+func (m *UserMutation) RemovedUploadedMediaIDs() (ids []int) {
+	for id := range m.removeduploaded_media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UploadedMediaIDs returns the "uploaded_media" edge IDs in the mutation.
+func (m *UserMutation) UploadedMediaIDs() (ids []int) {
+	for id := range m.uploaded_media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUploadedMedia resets all changes to the "uploaded_media" edge.
+func (m *UserMutation) ResetUploadedMedia() {
+	m.uploaded_media = nil
+	m.cleareduploaded_media = false
+	m.removeduploaded_media = nil
+}
+
+// AddOwnedRoomIDs adds the "owned_rooms" edge to the Room entity by ids.
+func (m *UserMutation) AddOwnedRoomIDs(ids ...int) {
+	if m.owned_rooms == nil {
+		m.owned_rooms = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.owned_rooms[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOwnedRooms clears the "owned_rooms" edge to the Room entity.
+func (m *UserMutation) ClearOwnedRooms() {
+	m.clearedowned_rooms = true
+}
+
+// OwnedRoomsCleared reports if the "owned_rooms" edge to the Room entity was cleared.
+func (m *UserMutation) OwnedRoomsCleared() bool {
+	return m.clearedowned_rooms
+}
+
+// RemoveOwnedRoomIDs removes the "owned_rooms" edge to the Room entity by IDs.
+func (m *UserMutation) RemoveOwnedRoomIDs(ids ...int) {
+	if m.removedowned_rooms == nil {
+		m.removedowned_rooms = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.owned_rooms, ids[i])
+		m.removedowned_rooms[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOwnedRooms returns the removed IDs of the "owned_rooms" edge to the Room entity.
+func (m *UserMutation) RemovedOwnedRoomsIDs() (ids []int) {
+	for id := range m.removedowned_rooms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OwnedRoomsIDs returns the "owned_rooms" edge IDs in the mutation.
+func (m *UserMutation) OwnedRoomsIDs() (ids []int) {
+	for id := range m.owned_rooms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOwnedRooms resets all changes to the "owned_rooms" edge.
+func (m *UserMutation) ResetOwnedRooms() {
+	m.owned_rooms = nil
+	m.clearedowned_rooms = false
+	m.removedowned_rooms = nil
+}
+
+// AddContactIDs adds the "contacts" edge to the Contact entity by ids.
+func (m *UserMutation) AddContactIDs(ids ...int) {
+	if m.contacts == nil {
+		m.contacts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.contacts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContacts clears the "contacts" edge to the Contact entity.
+func (m *UserMutation) ClearContacts() {
+	m.clearedcontacts = true
+}
+
+// ContactsCleared reports if the "contacts" edge to the Contact entity was cleared.
+func (m *UserMutation) ContactsCleared() bool {
+	return m.clearedcontacts
+}
+
+// RemoveContactIDs removes the "contacts" edge to the Contact entity by IDs.
+func (m *UserMutation) RemoveContactIDs(ids ...int) {
+	if m.removedcontacts == nil {
+		m.removedcontacts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.contacts, ids[i])
+		m.removedcontacts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContacts returns the removed IDs of the "contacts" edge to the Contact entity.
+func (m *UserMutation) RemovedContactsIDs() (ids []int) {
+	for id := range m.removedcontacts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContactsIDs returns the "contacts" edge IDs in the mutation.
+func (m *UserMutation) ContactsIDs() (ids []int) {
+	for id := range m.contacts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContacts resets all changes to the "contacts" edge.
+func (m *UserMutation) ResetContacts() {
+	m.contacts = nil
+	m.clearedcontacts = false
+	m.removedcontacts = nil
+}
+
+// AddContactEntryIDs adds the "contact_entries" edge to the Contact entity by ids.
+func (m *UserMutation) AddContactEntryIDs(ids ...int) {
+	if m.contact_entries == nil {
+		m.contact_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.contact_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContactEntries clears the "contact_entries" edge to the Contact entity.
+func (m *UserMutation) ClearContactEntries() {
+	m.clearedcontact_entries = true
+}
+
+// ContactEntriesCleared reports if the "contact_entries" edge to the Contact entity was cleared.
+func (m *UserMutation) ContactEntriesCleared() bool {
+	return m.clearedcontact_entries
+}
+
+// RemoveContactEntryIDs removes the "contact_entries" edge to the Contact entity by IDs.
+func (m *UserMutation) RemoveContactEntryIDs(ids ...int) {
+	if m.removedcontact_entries == nil {
+		m.removedcontact_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.contact_entries, ids[i])
+		m.removedcontact_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContactEntries returns the removed IDs of the "contact_entries" edge to the Contact entity.
+func (m *UserMutation) RemovedContactEntriesIDs() (ids []int) {
+	for id := range m.removedcontact_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContactEntriesIDs returns the "contact_entries" edge IDs in the mutation.
+func (m *UserMutation) ContactEntriesIDs() (ids []int) {
+	for id := range m.contact_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContactEntries resets all changes to the "contact_entries" edge.
+func (m *UserMutation) ResetContactEntries() {
+	m.contact_entries = nil
+	m.clearedcontact_entries = false
+	m.removedcontact_entries = nil
+}
+
+// AddFavouriteIDs adds the "favourites" edge to the Favourite entity by ids.
+func (m *UserMutation) AddFavouriteIDs(ids ...int) {
+	if m.favourites == nil {
+		m.favourites = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.favourites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFavourites clears the "favourites" edge to the Favourite entity.
+func (m *UserMutation) ClearFavourites() {
+	m.clearedfavourites = true
+}
+
+// FavouritesCleared reports if the "favourites" edge to the Favourite entity was cleared.
+func (m *UserMutation) FavouritesCleared() bool {
+	return m.clearedfavourites
+}
+
+// RemoveFavouriteIDs removes the "favourites" edge to the Favourite entity by IDs.
+func (m *UserMutation) RemoveFavouriteIDs(ids ...int) {
+	if m.removedfavourites == nil {
+		m.removedfavourites = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.favourites, ids[i])
+		m.removedfavourites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFavourites returns the removed IDs of the "favourites" edge to the Favourite entity.
+func (m *UserMutation) RemovedFavouritesIDs() (ids []int) {
+	for id := range m.removedfavourites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FavouritesIDs returns the "favourites" edge IDs in the mutation.
+func (m *UserMutation) FavouritesIDs() (ids []int) {
+	for id := range m.favourites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFavourites resets all changes to the "favourites" edge.
+func (m *UserMutation) ResetFavourites() {
+	m.favourites = nil
+	m.clearedfavourites = false
+	m.removedfavourites = nil
+}
+
+// AddInitiatedCallIDs adds the "initiated_calls" edge to the CallLog entity by ids.
+func (m *UserMutation) AddInitiatedCallIDs(ids ...int) {
+	if m.initiated_calls == nil {
+		m.initiated_calls = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.initiated_calls[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInitiatedCalls clears the "initiated_calls" edge to the CallLog entity.
+func (m *UserMutation) ClearInitiatedCalls() {
+	m.clearedinitiated_calls = true
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
-	if v == nil {
-		return
-	}
-	return *v, true
+// InitiatedCallsCleared reports if the "initiated_calls" edge to the CallLog entity was cleared.
+func (m *UserMutation) InitiatedCallsCleared() bool {
+	return m.clearedinitiated_calls
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+// RemoveInitiatedCallIDs removes the "initiated_calls" edge to the CallLog entity by IDs.
+func (m *UserMutation) RemoveInitiatedCallIDs(ids ...int) {
+	if m.removedinitiated_calls == nil {
+		m.removedinitiated_calls = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	for i := range ids {
+		delete(m.initiated_calls, ids[i])
+		m.removedinitiated_calls[ids[i]] = struct{}{}
 	}
-	return oldValue.Username, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+// RemovedInitiatedCalls returns the removed IDs of the "initiated_calls" edge to the CallLog entity.
+func (m *UserMutation) RemovedInitiatedCallsIDs() (ids []int) {
+	for id := range m.removedinitiated_calls {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *UserMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// InitiatedCallsIDs returns the "initiated_calls" edge IDs in the mutation.
+func (m *UserMutation) InitiatedCallsIDs() (ids []int) {
+	for id := range m.initiated_calls {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *UserMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetInitiatedCalls resets all changes to the "initiated_calls" edge.
+func (m *UserMutation) ResetInitiatedCalls() {
+	m.initiated_calls = nil
+	m.clearedinitiated_calls = false
+	m.removedinitiated_calls = nil
 }
 
-// OldDisplayName returns the old "display_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDisplayName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+// AddCallParticipationIDs adds the "call_participations" edge to the CallParticipant entity by ids.
+func (m *UserMutation) AddCallParticipationIDs(ids ...int) {
+	if m.call_participations == nil {
+		m.call_participations = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	for i := range ids {
+		m.call_participations[ids[i]] = struct{}{}
 	}
-	return oldValue.DisplayName, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *UserMutation) ResetDisplayName() {
-	m.display_name = nil
+// ClearCallParticipations clears the "call_participations" edge to the CallParticipant entity.
+func (m *UserMutation) ClearCallParticipations() {
+	m.clearedcall_participations = true
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// CallParticipationsCleared reports if the "call_participations" edge to the CallParticipant entity was cleared.
+func (m *UserMutation) CallParticipationsCleared() bool {
+	return m.clearedcall_participations
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
+// RemoveCallParticipationIDs removes the "call_participations" edge to the CallParticipant entity by IDs.
+func (m *UserMutation) RemoveCallParticipationIDs(ids ...int) {
+	if m.removedcall_participations == nil {
+		m.removedcall_participations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.call_participations, ids[i])
+		m.removedcall_participations[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+// RemovedCallParticipations returns the removed IDs of the "call_participations" edge to the CallParticipant entity.
+func (m *UserMutation) RemovedCallParticipationsIDs() (ids []int) {
+	for id := range m.removedcall_participations {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	return
+}
+
+// CallParticipationsIDs returns the "call_participations" edge IDs in the mutation.
+func (m *UserMutation) CallParticipationsIDs() (ids []int) {
+	for id := range m.call_participations {
+		ids = append(ids, id)
 	}
-	return oldValue.Email, nil
+	return
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetCallParticipations resets all changes to the "call_participations" edge.
+func (m *UserMutation) ResetCallParticipations() {
+	m.call_participations = nil
+	m.clearedcall_participations = false
+	m.removedcall_participations = nil
 }
 
-// SetAvatarURL sets the "avatar_url" field.
-func (m *UserMutation) SetAvatarURL(s string) {
-	m.avatar_url = &s
+// SetCredentialID sets the "credential" edge to the Credential entity by id.
+func (m *UserMutation) SetCredentialID(id int) {
+	m.credential = &id
 }
 
-// AvatarURL returns the value of the "avatar_url" field in the mutation.
-func (m *UserMutation) AvatarURL() (r string, exists bool) {
-	v := m.avatar_url
-	if v == nil {
-		return
+// ClearCredential clears the "credential" edge to the Credential entity.
+func (m *UserMutation) ClearCredential() {
+	m.clearedcredential = true
+}
+
+// CredentialCleared reports if the "credential" edge to the Credential entity was cleared.
+func (m *UserMutation) CredentialCleared() bool {
+	return m.clearedcredential
+}
+
+// CredentialID returns the "credential" edge ID in the mutation.
+func (m *UserMutation) CredentialID() (id int, exists bool) {
+	if m.credential != nil {
+		return *m.credential, true
 	}
-	return *v, true
+	return
 }
 
-// OldAvatarURL returns the old "avatar_url" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatarURL(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatarURL is only allowed on UpdateOne operations")
+// CredentialIDs returns the "credential" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CredentialID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) CredentialIDs() (ids []int) {
+	if id := m.credential; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatarURL requires an ID field in the mutation")
+	return
+}
+
+// ResetCredential resets all changes to the "credential" edge.
+func (m *UserMutation) ResetCredential() {
+	m.credential = nil
+	m.clearedcredential = false
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...int) {
+	if m.sessions == nil {
+		m.sessions = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatarURL: %w", err)
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
 	}
-	return oldValue.AvatarURL, nil
 }
 
-// ClearAvatarURL clears the value of the "avatar_url" field.
-func (m *UserMutation) ClearAvatarURL() {
-	m.avatar_url = nil
-	m.clearedFields[user.FieldAvatarURL] = struct{}{}
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
 }
 
-// AvatarURLCleared returns if the "avatar_url" field was cleared in this mutation.
-func (m *UserMutation) AvatarURLCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatarURL]
-	return ok
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
 }
 
-// ResetAvatarURL resets all changes to the "avatar_url" field.
-func (m *UserMutation) ResetAvatarURL() {
-	m.avatar_url = nil
-	delete(m.clearedFields, user.FieldAvatarURL)
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...int) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []int) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []int) {
+	for id := range m.sessions {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by ids.
+func (m *UserMutation) AddIdentityIDs(ids ...int) {
+	if m.identities == nil {
+		m.identities = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearIdentities clears the "identities" edge to the Identity entity.
+func (m *UserMutation) ClearIdentities() {
+	m.clearedidentities = true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// IdentitiesCleared reports if the "identities" edge to the Identity entity was cleared.
+func (m *UserMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// RemoveIdentityIDs removes the "identities" edge to the Identity entity by IDs.
+func (m *UserMutation) RemoveIdentityIDs(ids ...int) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[int]struct{})
 	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	for i := range ids {
+		delete(m.identities, ids[i])
+		m.removedidentities[ids[i]] = struct{}{}
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+}
+
+// RemovedIdentities returns the removed IDs of the "identities" edge to the Identity entity.
+func (m *UserMutation) RemovedIdentitiesIDs() (ids []int) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return
+}
+
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *UserMutation) IdentitiesIDs() (ids []int) {
+	for id := range m.identities {
+		ids = append(ids, id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *UserMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
 }
 
-// SetLastSeenAt sets the "last_seen_at" field.
-func (m *UserMutation) SetLastSeenAt(t time.Time) {
-	m.last_seen_at = &t
+// SetTotpSecretID sets the "totp_secret" edge to the TotpSecret entity by id.
+func (m *UserMutation) SetTotpSecretID(id int) {
+	m.totp_secret = &id
 }
 
-// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
-func (m *UserMutation) LastSeenAt() (r time.Time, exists bool) {
-	v := m.last_seen_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearTotpSecret clears the "totp_secret" edge to the TotpSecret entity.
+func (m *UserMutation) ClearTotpSecret() {
+	m.clearedtotp_secret = true
 }
 
-// OldLastSeenAt returns the old "last_seen_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+// TotpSecretCleared reports if the "totp_secret" edge to the TotpSecret entity was cleared.
+func (m *UserMutation) TotpSecretCleared() bool {
+	return m.clearedtotp_secret
+}
+
+// TotpSecretID returns the "totp_secret" edge ID in the mutation.
+func (m *UserMutation) TotpSecretID() (id int, exists bool) {
+	if m.totp_secret != nil {
+		return *m.totp_secret, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	return
+}
+
+// TotpSecretIDs returns the "totp_secret" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TotpSecretID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) TotpSecretIDs() (ids []int) {
+	if id := m.totp_secret; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.LastSeenAt, nil
+	return
 }
 
-// ResetLastSeenAt resets all changes to the "last_seen_at" field.
-func (m *UserMutation) ResetLastSeenAt() {
-	m.last_seen_at = nil
+// ResetTotpSecret resets all changes to the "totp_secret" edge.
+func (m *UserMutation) ResetTotpSecret() {
+	m.totp_secret = nil
+	m.clearedtotp_secret = false
 }
 
-// AddMembershipIDs adds the "memberships" edge to the RoomMembership entity by ids.
-func (m *UserMutation) AddMembershipIDs(ids ...int) {
-	if m.memberships == nil {
-		m.memberships = make(map[int]struct{})
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by ids.
+func (m *UserMutation) AddRecoveryCodeIDs(ids ...int) {
+	if m.recovery_codes == nil {
+		m.recovery_codes = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.memberships[ids[i]] = struct{}{}
+		m.recovery_codes[ids[i]] = struct{}{}
 	}
 }
 
-// ClearMemberships clears the "memberships" edge to the RoomMembership entity.
-func (m *UserMutation) ClearMemberships() {
-	m.clearedmemberships = true
+// ClearRecoveryCodes clears the "recovery_codes" edge to the RecoveryCode entity.
+func (m *UserMutation) ClearRecoveryCodes() {
+	m.clearedrecovery_codes = true
 }
 
-// MembershipsCleared reports if the "memberships" edge to the RoomMembership entity was cleared.
-func (m *UserMutation) MembershipsCleared() bool {
-	return m.clearedmemberships
+// RecoveryCodesCleared reports if the "recovery_codes" edge to the RecoveryCode entity was cleared.
+func (m *UserMutation) RecoveryCodesCleared() bool {
+	return m.clearedrecovery_codes
 }
 
-// RemoveMembershipIDs removes the "memberships" edge to the RoomMembership entity by IDs.
-func (m *UserMutation) RemoveMembershipIDs(ids ...int) {
-	if m.removedmemberships == nil {
-		m.removedmemberships = make(map[int]struct{})
+// RemoveRecoveryCodeIDs removes the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (m *UserMutation) RemoveRecoveryCodeIDs(ids ...int) {
+	if m.removedrecovery_codes == nil {
+		m.removedrecovery_codes = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.memberships, ids[i])
-		m.removedmemberships[ids[i]] = struct{}{}
+		delete(m.recovery_codes, ids[i])
+		m.removedrecovery_codes[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedMemberships returns the removed IDs of the "memberships" edge to the RoomMembership entity.
-func (m *UserMutation) RemovedMembershipsIDs() (ids []int) {
-	for id := range m.removedmemberships {
+// RemovedRecoveryCodes returns the removed IDs of the "recovery_codes" edge to the RecoveryCode entity.
+func (m *UserMutation) RemovedRecoveryCodesIDs() (ids []int) {
+	for id := range m.removedrecovery_codes {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// MembershipsIDs returns the "memberships" edge IDs in the mutation.
-func (m *UserMutation) MembershipsIDs() (ids []int) {
-	for id := range m.memberships {
+// RecoveryCodesIDs returns the "recovery_codes" edge IDs in the mutation.
+func (m *UserMutation) RecoveryCodesIDs() (ids []int) {
+	for id := range m.recovery_codes {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMemberships resets all changes to the "memberships" edge.
-func (m *UserMutation) ResetMemberships() {
-	m.memberships = nil
-	m.clearedmemberships = false
-	m.removedmemberships = nil
+// ResetRecoveryCodes resets all changes to the "recovery_codes" edge.
+func (m *UserMutation) ResetRecoveryCodes() {
+	m.recovery_codes = nil
+	m.clearedrecovery_codes = false
+	m.removedrecovery_codes = nil
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *UserMutation) AddMessageIDs(ids ...int) {
-	if m.messages == nil {
-		m.messages = make(map[int]struct{})
+// AddAPIKeyIDs adds the "api_keys" edge to the ApiKey entity by ids.
+func (m *UserMutation) AddAPIKeyIDs(ids ...int) {
+	if m.api_keys == nil {
+		m.api_keys = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+		m.api_keys[ids[i]] = struct{}{}
 	}
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *UserMutation) ClearMessages() {
-	m.clearedmessages = true
+// ClearAPIKeys clears the "api_keys" edge to the ApiKey entity.
+func (m *UserMutation) ClearAPIKeys() {
+	m.clearedapi_keys = true
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *UserMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// APIKeysCleared reports if the "api_keys" edge to the ApiKey entity was cleared.
+func (m *UserMutation) APIKeysCleared() bool {
+	return m.clearedapi_keys
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *UserMutation) RemoveMessageIDs(ids ...int) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[int]struct{})
+// RemoveAPIKeyIDs removes the "api_keys" edge to the ApiKey entity by IDs.
+func (m *UserMutation) RemoveAPIKeyIDs(ids ...int) {
+	if m.removedapi_keys == nil {
+		m.removedapi_keys = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
+		delete(m.api_keys, ids[i])
+		m.removedapi_keys[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *UserMutation) RemovedMessagesIDs() (ids []int) {
-	for id := range m.removedmessages {
+// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the ApiKey entity.
+func (m *UserMutation) RemovedAPIKeysIDs() (ids []int) {
+	for id := range m.removedapi_keys {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *UserMutation) MessagesIDs() (ids []int) {
-	for id := range m.messages {
+// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
+func (m *UserMutation) APIKeysIDs() (ids []int) {
+	for id := range m.api_keys {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *UserMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// ResetAPIKeys resets all changes to the "api_keys" edge.
+func (m *UserMutation) ResetAPIKeys() {
+	m.api_keys = nil
+	m.clearedapi_keys = false
+	m.removedapi_keys = nil
 }
 
-// AddUploadedMediumIDs adds the "uploaded_media" edge to the Media entity by ids.
-func (m *UserMutation) AddUploadedMediumIDs(ids ...int) {
-	if m.uploaded_media == nil {
-		m.uploaded_media = make(map[int]struct{})
+// AddEmailTokenIDs adds the "email_tokens" edge to the EmailToken entity by ids.
+func (m *UserMutation) AddEmailTokenIDs(ids ...int) {
+	if m.email_tokens == nil {
+		m.email_tokens = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.uploaded_media[ids[i]] = struct{}{}
+		m.email_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUploadedMedia clears the "uploaded_media" edge to the Media entity.
-func (m *UserMutation) ClearUploadedMedia() {
-	m.cleareduploaded_media = true
+// ClearEmailTokens clears the "email_tokens" edge to the EmailToken entity.
+func (m *UserMutation) ClearEmailTokens() {
+	m.clearedemail_tokens = true
 }
 
-// UploadedMediaCleared reports if the "uploaded_media" edge to the Media entity was cleared.
-func (m *UserMutation) UploadedMediaCleared() bool {
-	return m.cleareduploaded_media
+// EmailTokensCleared reports if the "email_tokens" edge to the EmailToken entity was cleared.
+func (m *UserMutation) EmailTokensCleared() bool {
+	return m.clearedemail_tokens
 }
 
-// RemoveUploadedMediumIDs removes the "uploaded_media" edge to the Media entity by IDs.
-func (m *UserMutation) RemoveUploadedMediumIDs(ids ...int) {
-	if m.removeduploaded_media == nil {
-		m.removeduploaded_media = make(map[int]struct{})
+// RemoveEmailTokenIDs removes the "email_tokens" edge to the EmailToken entity by IDs.
+func (m *UserMutation) RemoveEmailTokenIDs(ids ...int) {
+	if m.removedemail_tokens == nil {
+		m.removedemail_tokens = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.uploaded_media, ids[i])
-		m.removeduploaded_media[ids[i]] = struct{}{}
+		delete(m.email_tokens, ids[i])
+		m.removedemail_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUploadedMedia returns the removed IDs of the "uploaded_media" edge to the Media entity.
-func (m *UserMutation) RemovedUploadedMediaIDs() (ids []int) {
-	for id := range m.removeduploaded_media {
+// RemovedEmailTokens returns the removed IDs of the "email_tokens" edge to the EmailToken entity.
+func (m *UserMutation) RemovedEmailTokensIDs() (ids []int) {
+	for id := range m.removedemail_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UploadedMediaIDs returns the "uploaded_media" edge IDs in the mutation.
-func (m *UserMutation) UploadedMediaIDs() (ids []int) {
-	for id := range m.uploaded_media {
+// EmailTokensIDs returns the "email_tokens" edge IDs in the mutation.
+func (m *UserMutation) EmailTokensIDs() (ids []int) {
+	for id := range m.email_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUploadedMedia resets all changes to the "uploaded_media" edge.
-func (m *UserMutation) ResetUploadedMedia() {
-	m.uploaded_media = nil
-	m.cleareduploaded_media = false
-	m.removeduploaded_media = nil
+// ResetEmailTokens resets all changes to the "email_tokens" edge.
+func (m *UserMutation) ResetEmailTokens() {
+	m.email_tokens = nil
+	m.clearedemail_tokens = false
+	m.removedemail_tokens = nil
 }
 
-// AddOwnedRoomIDs adds the "owned_rooms" edge to the Room entity by ids.
-func (m *UserMutation) AddOwnedRoomIDs(ids ...int) {
-	if m.owned_rooms == nil {
-		m.owned_rooms = make(map[int]struct{})
+// AddLoginThrottleIDs adds the "login_throttles" edge to the LoginThrottle entity by ids.
+func (m *UserMutation) AddLoginThrottleIDs(ids ...int) {
+	if m.login_throttles == nil {
+		m.login_throttles = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.owned_rooms[ids[i]] = struct{}{}
+		m.login_throttles[ids[i]] = struct{}{}
 	}
 }
 
-// ClearOwnedRooms clears the "owned_rooms" edge to the Room entity.
-func (m *UserMutation) ClearOwnedRooms() {
-	m.clearedowned_rooms = true
+// ClearLoginThrottles clears the "login_throttles" edge to the LoginThrottle entity.
+func (m *UserMutation) ClearLoginThrottles() {
+	m.clearedlogin_throttles = true
 }
 
-// OwnedRoomsCleared reports if the "owned_rooms" edge to the Room entity was cleared.
-func (m *UserMutation) OwnedRoomsCleared() bool {
-	return m.clearedowned_rooms
+// LoginThrottlesCleared reports if the "login_throttles" edge to the LoginThrottle entity was cleared.
+func (m *UserMutation) LoginThrottlesCleared() bool {
+	return m.clearedlogin_throttles
 }
 
-// RemoveOwnedRoomIDs removes the "owned_rooms" edge to the Room entity by IDs.
-func (m *UserMutation) RemoveOwnedRoomIDs(ids ...int) {
-	if m.removedowned_rooms == nil {
-		m.removedowned_rooms = make(map[int]struct{})
+// RemoveLoginThrottleIDs removes the "login_throttles" edge to the LoginThrottle entity by IDs.
+func (m *UserMutation) RemoveLoginThrottleIDs(ids ...int) {
+	if m.removedlogin_throttles == nil {
+		m.removedlogin_throttles = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.owned_rooms, ids[i])
-		m.removedowned_rooms[ids[i]] = struct{}{}
+		delete(m.login_throttles, ids[i])
+		m.removedlogin_throttles[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedOwnedRooms returns the removed IDs of the "owned_rooms" edge to the Room entity.
-func (m *UserMutation) RemovedOwnedRoomsIDs() (ids []int) {
-	for id := range m.removedowned_rooms {
+// RemovedLoginThrottles returns the removed IDs of the "login_throttles" edge to the LoginThrottle entity.
+func (m *UserMutation) RemovedLoginThrottlesIDs() (ids []int) {
+	for id := range m.removedlogin_throttles {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// OwnedRoomsIDs returns the "owned_rooms" edge IDs in the mutation.
-func (m *UserMutation) OwnedRoomsIDs() (ids []int) {
-	for id := range m.owned_rooms {
+// LoginThrottlesIDs returns the "login_throttles" edge IDs in the mutation.
+func (m *UserMutation) LoginThrottlesIDs() (ids []int) {
+	for id := range m.login_throttles {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetOwnedRooms resets all changes to the "owned_rooms" edge.
-func (m *UserMutation) ResetOwnedRooms() {
-	m.owned_rooms = nil
-	m.clearedowned_rooms = false
-	m.removedowned_rooms = nil
+// ResetLoginThrottles resets all changes to the "login_throttles" edge.
+func (m *UserMutation) ResetLoginThrottles() {
+	m.login_throttles = nil
+	m.clearedlogin_throttles = false
+	m.removedlogin_throttles = nil
 }
 
-// AddContactIDs adds the "contacts" edge to the Contact entity by ids.
-func (m *UserMutation) AddContactIDs(ids ...int) {
-	if m.contacts == nil {
-		m.contacts = make(map[int]struct{})
+// AddDeviceIDs adds the "devices" edge to the Device entity by ids.
+func (m *UserMutation) AddDeviceIDs(ids ...int) {
+	if m.devices == nil {
+		m.devices = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.contacts[ids[i]] = struct{}{}
+		m.devices[ids[i]] = struct{}{}
 	}
 }
 
-// ClearContacts clears the "contacts" edge to the Contact entity.
-func (m *UserMutation) ClearContacts() {
-	m.clearedcontacts = true
+// ClearDevices clears the "devices" edge to the Device entity.
+func (m *UserMutation) ClearDevices() {
+	m.cleareddevices = true
 }
 
-// ContactsCleared reports if the "contacts" edge to the Contact entity was cleared.
-func (m *UserMutation) ContactsCleared() bool {
-	return m.clearedcontacts
+// DevicesCleared reports if the "devices" edge to the Device entity was cleared.
+func (m *UserMutation) DevicesCleared() bool {
+	return m.cleareddevices
 }
 
-// RemoveContactIDs removes the "contacts" edge to the Contact entity by IDs.
-func (m *UserMutation) RemoveContactIDs(ids ...int) {
-	if m.removedcontacts == nil {
-		m.removedcontacts = make(map[int]struct{})
+// RemoveDeviceIDs removes the "devices" edge to the Device entity by IDs.
+func (m *UserMutation) RemoveDeviceIDs(ids ...int) {
+	if m.removeddevices == nil {
+		m.removeddevices = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.contacts, ids[i])
-		m.removedcontacts[ids[i]] = struct{}{}
+		delete(m.devices, ids[i])
+		m.removeddevices[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedContacts returns the removed IDs of the "contacts" edge to the Contact entity.
-func (m *UserMutation) RemovedContactsIDs() (ids []int) {
-	for id := range m.removedcontacts {
+// RemovedDevices returns the removed IDs of the "devices" edge to the Device entity.
+func (m *UserMutation) RemovedDevicesIDs() (ids []int) {
+	for id := range m.removeddevices {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ContactsIDs returns the "contacts" edge IDs in the mutation.
-func (m *UserMutation) ContactsIDs() (ids []int) {
-	for id := range m.contacts {
+// DevicesIDs returns the "devices" edge IDs in the mutation.
+func (m *UserMutation) DevicesIDs() (ids []int) {
+	for id := range m.devices {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetContacts resets all changes to the "contacts" edge.
-func (m *UserMutation) ResetContacts() {
-	m.contacts = nil
-	m.clearedcontacts = false
-	m.removedcontacts = nil
+// ResetDevices resets all changes to the "devices" edge.
+func (m *UserMutation) ResetDevices() {
+	m.devices = nil
+	m.cleareddevices = false
+	m.removeddevices = nil
 }
 
-// AddContactEntryIDs adds the "contact_entries" edge to the Contact entity by ids.
-func (m *UserMutation) AddContactEntryIDs(ids ...int) {
-	if m.contact_entries == nil {
-		m.contact_entries = make(map[int]struct{})
+// AddMlsCommitIDs adds the "mls_commits" edge to the MlsCommit entity by ids.
+func (m *UserMutation) AddMlsCommitIDs(ids ...int) {
+	if m.mls_commits == nil {
+		m.mls_commits = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.contact_entries[ids[i]] = struct{}{}
+		m.mls_commits[ids[i]] = struct{}{}
 	}
 }
 
-// ClearContactEntries clears the "contact_entries" edge to the Contact entity.
-func (m *UserMutation) ClearContactEntries() {
-	m.clearedcontact_entries = true
+// ClearMlsCommits clears the "mls_commits" edge to the MlsCommit entity.
+func (m *UserMutation) ClearMlsCommits() {
+	m.clearedmls_commits = true
 }
 
-// ContactEntriesCleared reports if the "contact_entries" edge to the Contact entity was cleared.
-func (m *UserMutation) ContactEntriesCleared() bool {
-	return m.clearedcontact_entries
+// MlsCommitsCleared reports if the "mls_commits" edge to the MlsCommit entity was cleared.
+func (m *UserMutation) MlsCommitsCleared() bool {
+	return m.clearedmls_commits
 }
 
-// RemoveContactEntryIDs removes the "contact_entries" edge to the Contact entity by IDs.
-func (m *UserMutation) RemoveContactEntryIDs(ids ...int) {
-	if m.removedcontact_entries == nil {
-		m.removedcontact_entries = make(map[int]struct{})
+// RemoveMlsCommitIDs removes the "mls_commits" edge to the MlsCommit entity by IDs.
+func (m *UserMutation) RemoveMlsCommitIDs(ids ...int) {
+	if m.removedmls_commits == nil {
+		m.removedmls_commits = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.contact_entries, ids[i])
-		m.removedcontact_entries[ids[i]] = struct{}{}
+		delete(m.mls_commits, ids[i])
+		m.removedmls_commits[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedContactEntries returns the removed IDs of the "contact_entries" edge to the Contact entity.
-func (m *UserMutation) RemovedContactEntriesIDs() (ids []int) {
-	for id := range m.removedcontact_entries {
+// RemovedMlsCommits returns the removed IDs of the "mls_commits" edge to the MlsCommit entity.
+func (m *UserMutation) RemovedMlsCommitsIDs() (ids []int) {
+	for id := range m.removedmls_commits {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ContactEntriesIDs returns the "contact_entries" edge IDs in the mutation.
-func (m *UserMutation) ContactEntriesIDs() (ids []int) {
-	for id := range m.contact_entries {
+// MlsCommitsIDs returns the "mls_commits" edge IDs in the mutation.
+func (m *UserMutation) MlsCommitsIDs() (ids []int) {
+	for id := range m.mls_commits {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetContactEntries resets all changes to the "contact_entries" edge.
-func (m *UserMutation) ResetContactEntries() {
-	m.contact_entries = nil
-	m.clearedcontact_entries = false
-	m.removedcontact_entries = nil
+// ResetMlsCommits resets all changes to the "mls_commits" edge.
+func (m *UserMutation) ResetMlsCommits() {
+	m.mls_commits = nil
+	m.clearedmls_commits = false
+	m.removedmls_commits = nil
 }
 
-// AddFavouriteIDs adds the "favourites" edge to the Favourite entity by ids.
-func (m *UserMutation) AddFavouriteIDs(ids ...int) {
-	if m.favourites == nil {
-		m.favourites = make(map[int]struct{})
+// AddSentMlsWelcomeIDs adds the "sent_mls_welcomes" edge to the MlsWelcome entity by ids.
+func (m *UserMutation) AddSentMlsWelcomeIDs(ids ...int) {
+	if m.sent_mls_welcomes == nil {
+		m.sent_mls_welcomes = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.favourites[ids[i]] = struct{}{}
+		m.sent_mls_welcomes[ids[i]] = struct{}{}
 	}
 }
 
-// ClearFavourites clears the "favourites" edge to the Favourite entity.
-func (m *UserMutation) ClearFavourites() {
-	m.clearedfavourites = true
+// ClearSentMlsWelcomes clears the "sent_mls_welcomes" edge to the MlsWelcome entity.
+func (m *UserMutation) ClearSentMlsWelcomes() {
+	m.clearedsent_mls_welcomes = true
 }
 
-// FavouritesCleared reports if the "favourites" edge to the Favourite entity was cleared.
-func (m *UserMutation) FavouritesCleared() bool {
-	return m.clearedfavourites
+// SentMlsWelcomesCleared reports if the "sent_mls_welcomes" edge to the MlsWelcome entity was cleared.
+func (m *UserMutation) SentMlsWelcomesCleared() bool {
+	return m.clearedsent_mls_welcomes
 }
 
-// RemoveFavouriteIDs removes the "favourites" edge to the Favourite entity by IDs.
-func (m *UserMutation) RemoveFavouriteIDs(ids ...int) {
-	if m.removedfavourites == nil {
-		m.removedfavourites = make(map[int]struct{})
+// RemoveSentMlsWelcomeIDs removes the "sent_mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (m *UserMutation) RemoveSentMlsWelcomeIDs(ids ...int) {
+	if m.removedsent_mls_welcomes == nil {
+		m.removedsent_mls_welcomes = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.favourites, ids[i])
-		m.removedfavourites[ids[i]] = struct{}{}
+		delete(m.sent_mls_welcomes, ids[i])
+		m.removedsent_mls_welcomes[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedFavourites returns the removed IDs of the "favourites" edge to the Favourite entity.
-func (m *UserMutation) RemovedFavouritesIDs() (ids []int) {
-	for id := range m.removedfavourites {
+// RemovedSentMlsWelcomes returns the removed IDs of the "sent_mls_welcomes" edge to the MlsWelcome entity.
+func (m *UserMutation) RemovedSentMlsWelcomesIDs() (ids []int) {
+	for id := range m.removedsent_mls_welcomes {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// FavouritesIDs returns the "favourites" edge IDs in the mutation.
-func (m *UserMutation) FavouritesIDs() (ids []int) {
-	for id := range m.favourites {
+// SentMlsWelcomesIDs returns the "sent_mls_welcomes" edge IDs in the mutation.
+func (m *UserMutation) SentMlsWelcomesIDs() (ids []int) {
+	for id := range m.sent_mls_welcomes {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetFavourites resets all changes to the "favourites" edge.
-func (m *UserMutation) ResetFavourites() {
-	m.favourites = nil
-	m.clearedfavourites = false
-	m.removedfavourites = nil
+// ResetSentMlsWelcomes resets all changes to the "sent_mls_welcomes" edge.
+func (m *UserMutation) ResetSentMlsWelcomes() {
+	m.sent_mls_welcomes = nil
+	m.clearedsent_mls_welcomes = false
+	m.removedsent_mls_welcomes = nil
 }
 
-// AddInitiatedCallIDs adds the "initiated_calls" edge to the CallLog entity by ids.
-func (m *UserMutation) AddInitiatedCallIDs(ids ...int) {
-	if m.initiated_calls == nil {
-		m.initiated_calls = make(map[int]struct{})
+// AddSentSenderKeyDistributionIDs adds the "sent_sender_key_distributions" edge to the SenderKeyDistribution entity by ids.
+func (m *UserMutation) AddSentSenderKeyDistributionIDs(ids ...int) {
+	if m.sent_sender_key_distributions == nil {
+		m.sent_sender_key_distributions = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.initiated_calls[ids[i]] = struct{}{}
+		m.sent_sender_key_distributions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearInitiatedCalls clears the "initiated_calls" edge to the CallLog entity.
-func (m *UserMutation) ClearInitiatedCalls() {
-	m.clearedinitiated_calls = true
+// ClearSentSenderKeyDistributions clears the "sent_sender_key_distributions" edge to the SenderKeyDistribution entity.
+func (m *UserMutation) ClearSentSenderKeyDistributions() {
+	m.clearedsent_sender_key_distributions = true
 }
 
-// InitiatedCallsCleared reports if the "initiated_calls" edge to the CallLog entity was cleared.
-func (m *UserMutation) InitiatedCallsCleared() bool {
-	return m.clearedinitiated_calls
+// SentSenderKeyDistributionsCleared reports if the "sent_sender_key_distributions" edge to the SenderKeyDistribution entity was cleared.
+func (m *UserMutation) SentSenderKeyDistributionsCleared() bool {
+	return m.clearedsent_sender_key_distributions
 }
 
-// RemoveInitiatedCallIDs removes the "initiated_calls" edge to the CallLog entity by IDs.
-func (m *UserMutation) RemoveInitiatedCallIDs(ids ...int) {
-	if m.removedinitiated_calls == nil {
-		m.removedinitiated_calls = make(map[int]struct{})
+// RemoveSentSenderKeyDistributionIDs removes the "sent_sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (m *UserMutation) RemoveSentSenderKeyDistributionIDs(ids ...int) {
+	if m.removedsent_sender_key_distributions == nil {
+		m.removedsent_sender_key_distributions = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.initiated_calls, ids[i])
-		m.removedinitiated_calls[ids[i]] = struct{}{}
+		delete(m.sent_sender_key_distributions, ids[i])
+		m.removedsent_sender_key_distributions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedInitiatedCalls returns the removed IDs of the "initiated_calls" edge to the CallLog entity.
-func (m *UserMutation) RemovedInitiatedCallsIDs() (ids []int) {
-	for id := range m.removedinitiated_calls {
+// RemovedSentSenderKeyDistributions returns the removed IDs of the "sent_sender_key_distributions" edge to the SenderKeyDistribution entity.
+func (m *UserMutation) RemovedSentSenderKeyDistributionsIDs() (ids []int) {
+	for id := range m.removedsent_sender_key_distributions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// InitiatedCallsIDs returns the "initiated_calls" edge IDs in the mutation.
-func (m *UserMutation) InitiatedCallsIDs() (ids []int) {
-	for id := range m.initiated_calls {
+// SentSenderKeyDistributionsIDs returns the "sent_sender_key_distributions" edge IDs in the mutation.
+func (m *UserMutation) SentSenderKeyDistributionsIDs() (ids []int) {
+	for id := range m.sent_sender_key_distributions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetInitiatedCalls resets all changes to the "initiated_calls" edge.
-func (m *UserMutation) ResetInitiatedCalls() {
-	m.initiated_calls = nil
-	m.clearedinitiated_calls = false
-	m.removedinitiated_calls = nil
+// ResetSentSenderKeyDistributions resets all changes to the "sent_sender_key_distributions" edge.
+func (m *UserMutation) ResetSentSenderKeyDistributions() {
+	m.sent_sender_key_distributions = nil
+	m.clearedsent_sender_key_distributions = false
+	m.removedsent_sender_key_distributions = nil
 }
 
-// AddCallParticipationIDs adds the "call_participations" edge to the CallParticipant entity by ids.
-func (m *UserMutation) AddCallParticipationIDs(ids ...int) {
-	if m.call_participations == nil {
-		m.call_participations = make(map[int]struct{})
+// SetServiceOwnerID sets the "service_owner" edge to the User entity by id.
+func (m *UserMutation) SetServiceOwnerID(id int) {
+	m.service_owner = &id
+}
+
+// ClearServiceOwner clears the "service_owner" edge to the User entity.
+func (m *UserMutation) ClearServiceOwner() {
+	m.clearedservice_owner = true
+}
+
+// ServiceOwnerCleared reports if the "service_owner" edge to the User entity was cleared.
+func (m *UserMutation) ServiceOwnerCleared() bool {
+	return m.clearedservice_owner
+}
+
+// ServiceOwnerID returns the "service_owner" edge ID in the mutation.
+func (m *UserMutation) ServiceOwnerID() (id int, exists bool) {
+	if m.service_owner != nil {
+		return *m.service_owner, true
+	}
+	return
+}
+
+// ServiceOwnerIDs returns the "service_owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ServiceOwnerID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) ServiceOwnerIDs() (ids []int) {
+	if id := m.service_owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetServiceOwner resets all changes to the "service_owner" edge.
+func (m *UserMutation) ResetServiceOwner() {
+	m.service_owner = nil
+	m.clearedservice_owner = false
+}
+
+// AddServiceAccountIDs adds the "service_accounts" edge to the User entity by ids.
+func (m *UserMutation) AddServiceAccountIDs(ids ...int) {
+	if m.service_accounts == nil {
+		m.service_accounts = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.call_participations[ids[i]] = struct{}{}
+		m.service_accounts[ids[i]] = struct{}{}
 	}
 }
 
-// ClearCallParticipations clears the "call_participations" edge to the CallParticipant entity.
-func (m *UserMutation) ClearCallParticipations() {
-	m.clearedcall_participations = true
+// ClearServiceAccounts clears the "service_accounts" edge to the User entity.
+func (m *UserMutation) ClearServiceAccounts() {
+	m.clearedservice_accounts = true
 }
 
-// CallParticipationsCleared reports if the "call_participations" edge to the CallParticipant entity was cleared.
-func (m *UserMutation) CallParticipationsCleared() bool {
-	return m.clearedcall_participations
+// ServiceAccountsCleared reports if the "service_accounts" edge to the User entity was cleared.
+func (m *UserMutation) ServiceAccountsCleared() bool {
+	return m.clearedservice_accounts
 }
 
-// RemoveCallParticipationIDs removes the "call_participations" edge to the CallParticipant entity by IDs.
-func (m *UserMutation) RemoveCallParticipationIDs(ids ...int) {
-	if m.removedcall_participations == nil {
-		m.removedcall_participations = make(map[int]struct{})
+// RemoveServiceAccountIDs removes the "service_accounts" edge to the User entity by IDs.
+func (m *UserMutation) RemoveServiceAccountIDs(ids ...int) {
+	if m.removedservice_accounts == nil {
+		m.removedservice_accounts = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.call_participations, ids[i])
-		m.removedcall_participations[ids[i]] = struct{}{}
+		delete(m.service_accounts, ids[i])
+		m.removedservice_accounts[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedCallParticipations returns the removed IDs of the "call_participations" edge to the CallParticipant entity.
-func (m *UserMutation) RemovedCallParticipationsIDs() (ids []int) {
-	for id := range m.removedcall_participations {
+// RemovedServiceAccounts returns the removed IDs of the "service_accounts" edge to the User entity.
+func (m *UserMutation) RemovedServiceAccountsIDs() (ids []int) {
+	for id := range m.removedservice_accounts {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// CallParticipationsIDs returns the "call_participations" edge IDs in the mutation.
-func (m *UserMutation) CallParticipationsIDs() (ids []int) {
-	for id := range m.call_participations {
+// ServiceAccountsIDs returns the "service_accounts" edge IDs in the mutation.
+func (m *UserMutation) ServiceAccountsIDs() (ids []int) {
+	for id := range m.service_accounts {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetCallParticipations resets all changes to the "call_participations" edge.
-func (m *UserMutation) ResetCallParticipations() {
-	m.call_participations = nil
-	m.clearedcall_participations = false
-	m.removedcall_participations = nil
+// ResetServiceAccounts resets all changes to the "service_accounts" edge.
+func (m *UserMutation) ResetServiceAccounts() {
+	m.service_accounts = nil
+	m.clearedservice_accounts = false
+	m.removedservice_accounts = nil
 }
 
 // Where appends a list predicates to the UserMutation builder.
@@ -7408,7 +25799,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 14)
 	if m.username != nil {
 		fields = append(fields, user.FieldUsername)
 	}
@@ -7418,9 +25809,30 @@ func (m *UserMutation) Fields() []string {
 	if m.email != nil {
 		fields = append(fields, user.FieldEmail)
 	}
+	if m.verified_at != nil {
+		fields = append(fields, user.FieldVerifiedAt)
+	}
 	if m.avatar_url != nil {
 		fields = append(fields, user.FieldAvatarURL)
 	}
+	if m.is_bot != nil {
+		fields = append(fields, user.FieldIsBot)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.suspended_at != nil {
+		fields = append(fields, user.FieldSuspendedAt)
+	}
+	if m.discoverability != nil {
+		fields = append(fields, user.FieldDiscoverability)
+	}
+	if m.deletion_scheduled_at != nil {
+		fields = append(fields, user.FieldDeletionScheduledAt)
+	}
+	if m.is_tombstone != nil {
+		fields = append(fields, user.FieldIsTombstone)
+	}
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -7444,8 +25856,22 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.DisplayName()
 	case user.FieldEmail:
 		return m.Email()
+	case user.FieldVerifiedAt:
+		return m.VerifiedAt()
 	case user.FieldAvatarURL:
 		return m.AvatarURL()
+	case user.FieldIsBot:
+		return m.IsBot()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldSuspendedAt:
+		return m.SuspendedAt()
+	case user.FieldDiscoverability:
+		return m.Discoverability()
+	case user.FieldDeletionScheduledAt:
+		return m.DeletionScheduledAt()
+	case user.FieldIsTombstone:
+		return m.IsTombstone()
 	case user.FieldCreatedAt:
 		return m.CreatedAt()
 	case user.FieldUpdatedAt:
@@ -7467,8 +25893,22 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldDisplayName(ctx)
 	case user.FieldEmail:
 		return m.OldEmail(ctx)
+	case user.FieldVerifiedAt:
+		return m.OldVerifiedAt(ctx)
 	case user.FieldAvatarURL:
 		return m.OldAvatarURL(ctx)
+	case user.FieldIsBot:
+		return m.OldIsBot(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldSuspendedAt:
+		return m.OldSuspendedAt(ctx)
+	case user.FieldDiscoverability:
+		return m.OldDiscoverability(ctx)
+	case user.FieldDeletionScheduledAt:
+		return m.OldDeletionScheduledAt(ctx)
+	case user.FieldIsTombstone:
+		return m.OldIsTombstone(ctx)
 	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case user.FieldUpdatedAt:
@@ -7505,6 +25945,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetEmail(v)
 		return nil
+	case user.FieldVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifiedAt(v)
+		return nil
 	case user.FieldAvatarURL:
 		v, ok := value.(string)
 		if !ok {
@@ -7512,6 +25959,48 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAvatarURL(v)
 		return nil
+	case user.FieldIsBot:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBot(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(user.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldSuspendedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspendedAt(v)
+		return nil
+	case user.FieldDiscoverability:
+		v, ok := value.(user.Discoverability)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscoverability(v)
+		return nil
+	case user.FieldDeletionScheduledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletionScheduledAt(v)
+		return nil
+	case user.FieldIsTombstone:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsTombstone(v)
+		return nil
 	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -7563,9 +26052,18 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *UserMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(user.FieldVerifiedAt) {
+		fields = append(fields, user.FieldVerifiedAt)
+	}
 	if m.FieldCleared(user.FieldAvatarURL) {
 		fields = append(fields, user.FieldAvatarURL)
 	}
+	if m.FieldCleared(user.FieldSuspendedAt) {
+		fields = append(fields, user.FieldSuspendedAt)
+	}
+	if m.FieldCleared(user.FieldDeletionScheduledAt) {
+		fields = append(fields, user.FieldDeletionScheduledAt)
+	}
 	return fields
 }
 
@@ -7580,9 +26078,18 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
 	switch name {
+	case user.FieldVerifiedAt:
+		m.ClearVerifiedAt()
+		return nil
 	case user.FieldAvatarURL:
 		m.ClearAvatarURL()
 		return nil
+	case user.FieldSuspendedAt:
+		m.ClearSuspendedAt()
+		return nil
+	case user.FieldDeletionScheduledAt:
+		m.ClearDeletionScheduledAt()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -7600,9 +26107,30 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldEmail:
 		m.ResetEmail()
 		return nil
+	case user.FieldVerifiedAt:
+		m.ResetVerifiedAt()
+		return nil
 	case user.FieldAvatarURL:
 		m.ResetAvatarURL()
 		return nil
+	case user.FieldIsBot:
+		m.ResetIsBot()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldSuspendedAt:
+		m.ResetSuspendedAt()
+		return nil
+	case user.FieldDiscoverability:
+		m.ResetDiscoverability()
+		return nil
+	case user.FieldDeletionScheduledAt:
+		m.ResetDeletionScheduledAt()
+		return nil
+	case user.FieldIsTombstone:
+		m.ResetIsTombstone()
+		return nil
 	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -7618,7 +26146,7 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 9)
+	edges := make([]string, 0, 23)
 	if m.memberships != nil {
 		edges = append(edges, user.EdgeMemberships)
 	}
@@ -7646,6 +26174,48 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.call_participations != nil {
 		edges = append(edges, user.EdgeCallParticipations)
 	}
+	if m.credential != nil {
+		edges = append(edges, user.EdgeCredential)
+	}
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.identities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.totp_secret != nil {
+		edges = append(edges, user.EdgeTotpSecret)
+	}
+	if m.recovery_codes != nil {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
+	if m.api_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.email_tokens != nil {
+		edges = append(edges, user.EdgeEmailTokens)
+	}
+	if m.login_throttles != nil {
+		edges = append(edges, user.EdgeLoginThrottles)
+	}
+	if m.devices != nil {
+		edges = append(edges, user.EdgeDevices)
+	}
+	if m.mls_commits != nil {
+		edges = append(edges, user.EdgeMlsCommits)
+	}
+	if m.sent_mls_welcomes != nil {
+		edges = append(edges, user.EdgeSentMlsWelcomes)
+	}
+	if m.sent_sender_key_distributions != nil {
+		edges = append(edges, user.EdgeSentSenderKeyDistributions)
+	}
+	if m.service_owner != nil {
+		edges = append(edges, user.EdgeServiceOwner)
+	}
+	if m.service_accounts != nil {
+		edges = append(edges, user.EdgeServiceAccounts)
+	}
 	return edges
 }
 
@@ -7707,13 +26277,91 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeCredential:
+		if id := m.credential; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.identities))
+		for id := range m.identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTotpSecret:
+		if id := m.totp_secret; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeRecoveryCodes:
+		ids := make([]ent.Value, 0, len(m.recovery_codes))
+		for id := range m.recovery_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.api_keys))
+		for id := range m.api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeEmailTokens:
+		ids := make([]ent.Value, 0, len(m.email_tokens))
+		for id := range m.email_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLoginThrottles:
+		ids := make([]ent.Value, 0, len(m.login_throttles))
+		for id := range m.login_throttles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDevices:
+		ids := make([]ent.Value, 0, len(m.devices))
+		for id := range m.devices {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMlsCommits:
+		ids := make([]ent.Value, 0, len(m.mls_commits))
+		for id := range m.mls_commits {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSentMlsWelcomes:
+		ids := make([]ent.Value, 0, len(m.sent_mls_welcomes))
+		for id := range m.sent_mls_welcomes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSentSenderKeyDistributions:
+		ids := make([]ent.Value, 0, len(m.sent_sender_key_distributions))
+		for id := range m.sent_sender_key_distributions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeServiceOwner:
+		if id := m.service_owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeServiceAccounts:
+		ids := make([]ent.Value, 0, len(m.service_accounts))
+		for id := range m.service_accounts {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 9)
+	edges := make([]string, 0, 23)
 	if m.removedmemberships != nil {
 		edges = append(edges, user.EdgeMemberships)
 	}
@@ -7741,6 +26389,39 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedcall_participations != nil {
 		edges = append(edges, user.EdgeCallParticipations)
 	}
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.removedidentities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.removedrecovery_codes != nil {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
+	if m.removedapi_keys != nil {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.removedemail_tokens != nil {
+		edges = append(edges, user.EdgeEmailTokens)
+	}
+	if m.removedlogin_throttles != nil {
+		edges = append(edges, user.EdgeLoginThrottles)
+	}
+	if m.removeddevices != nil {
+		edges = append(edges, user.EdgeDevices)
+	}
+	if m.removedmls_commits != nil {
+		edges = append(edges, user.EdgeMlsCommits)
+	}
+	if m.removedsent_mls_welcomes != nil {
+		edges = append(edges, user.EdgeSentMlsWelcomes)
+	}
+	if m.removedsent_sender_key_distributions != nil {
+		edges = append(edges, user.EdgeSentSenderKeyDistributions)
+	}
+	if m.removedservice_accounts != nil {
+		edges = append(edges, user.EdgeServiceAccounts)
+	}
 	return edges
 }
 
@@ -7802,13 +26483,79 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.removedidentities))
+		for id := range m.removedidentities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecoveryCodes:
+		ids := make([]ent.Value, 0, len(m.removedrecovery_codes))
+		for id := range m.removedrecovery_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedapi_keys))
+		for id := range m.removedapi_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeEmailTokens:
+		ids := make([]ent.Value, 0, len(m.removedemail_tokens))
+		for id := range m.removedemail_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLoginThrottles:
+		ids := make([]ent.Value, 0, len(m.removedlogin_throttles))
+		for id := range m.removedlogin_throttles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDevices:
+		ids := make([]ent.Value, 0, len(m.removeddevices))
+		for id := range m.removeddevices {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMlsCommits:
+		ids := make([]ent.Value, 0, len(m.removedmls_commits))
+		for id := range m.removedmls_commits {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSentMlsWelcomes:
+		ids := make([]ent.Value, 0, len(m.removedsent_mls_welcomes))
+		for id := range m.removedsent_mls_welcomes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSentSenderKeyDistributions:
+		ids := make([]ent.Value, 0, len(m.removedsent_sender_key_distributions))
+		for id := range m.removedsent_sender_key_distributions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeServiceAccounts:
+		ids := make([]ent.Value, 0, len(m.removedservice_accounts))
+		for id := range m.removedservice_accounts {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 9)
+	edges := make([]string, 0, 23)
 	if m.clearedmemberships {
 		edges = append(edges, user.EdgeMemberships)
 	}
@@ -7836,6 +26583,48 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedcall_participations {
 		edges = append(edges, user.EdgeCallParticipations)
 	}
+	if m.clearedcredential {
+		edges = append(edges, user.EdgeCredential)
+	}
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.clearedidentities {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.clearedtotp_secret {
+		edges = append(edges, user.EdgeTotpSecret)
+	}
+	if m.clearedrecovery_codes {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
+	if m.clearedapi_keys {
+		edges = append(edges, user.EdgeAPIKeys)
+	}
+	if m.clearedemail_tokens {
+		edges = append(edges, user.EdgeEmailTokens)
+	}
+	if m.clearedlogin_throttles {
+		edges = append(edges, user.EdgeLoginThrottles)
+	}
+	if m.cleareddevices {
+		edges = append(edges, user.EdgeDevices)
+	}
+	if m.clearedmls_commits {
+		edges = append(edges, user.EdgeMlsCommits)
+	}
+	if m.clearedsent_mls_welcomes {
+		edges = append(edges, user.EdgeSentMlsWelcomes)
+	}
+	if m.clearedsent_sender_key_distributions {
+		edges = append(edges, user.EdgeSentSenderKeyDistributions)
+	}
+	if m.clearedservice_owner {
+		edges = append(edges, user.EdgeServiceOwner)
+	}
+	if m.clearedservice_accounts {
+		edges = append(edges, user.EdgeServiceAccounts)
+	}
 	return edges
 }
 
@@ -7861,6 +26650,34 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedinitiated_calls
 	case user.EdgeCallParticipations:
 		return m.clearedcall_participations
+	case user.EdgeCredential:
+		return m.clearedcredential
+	case user.EdgeSessions:
+		return m.clearedsessions
+	case user.EdgeIdentities:
+		return m.clearedidentities
+	case user.EdgeTotpSecret:
+		return m.clearedtotp_secret
+	case user.EdgeRecoveryCodes:
+		return m.clearedrecovery_codes
+	case user.EdgeAPIKeys:
+		return m.clearedapi_keys
+	case user.EdgeEmailTokens:
+		return m.clearedemail_tokens
+	case user.EdgeLoginThrottles:
+		return m.clearedlogin_throttles
+	case user.EdgeDevices:
+		return m.cleareddevices
+	case user.EdgeMlsCommits:
+		return m.clearedmls_commits
+	case user.EdgeSentMlsWelcomes:
+		return m.clearedsent_mls_welcomes
+	case user.EdgeSentSenderKeyDistributions:
+		return m.clearedsent_sender_key_distributions
+	case user.EdgeServiceOwner:
+		return m.clearedservice_owner
+	case user.EdgeServiceAccounts:
+		return m.clearedservice_accounts
 	}
 	return false
 }
@@ -7869,6 +26686,15 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 // if that edge is not defined in the schema.
 func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
+	case user.EdgeCredential:
+		m.ClearCredential()
+		return nil
+	case user.EdgeTotpSecret:
+		m.ClearTotpSecret()
+		return nil
+	case user.EdgeServiceOwner:
+		m.ClearServiceOwner()
+		return nil
 	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
@@ -7904,6 +26730,48 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeCallParticipations:
 		m.ResetCallParticipations()
 		return nil
+	case user.EdgeCredential:
+		m.ResetCredential()
+		return nil
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case user.EdgeIdentities:
+		m.ResetIdentities()
+		return nil
+	case user.EdgeTotpSecret:
+		m.ResetTotpSecret()
+		return nil
+	case user.EdgeRecoveryCodes:
+		m.ResetRecoveryCodes()
+		return nil
+	case user.EdgeAPIKeys:
+		m.ResetAPIKeys()
+		return nil
+	case user.EdgeEmailTokens:
+		m.ResetEmailTokens()
+		return nil
+	case user.EdgeLoginThrottles:
+		m.ResetLoginThrottles()
+		return nil
+	case user.EdgeDevices:
+		m.ResetDevices()
+		return nil
+	case user.EdgeMlsCommits:
+		m.ResetMlsCommits()
+		return nil
+	case user.EdgeSentMlsWelcomes:
+		m.ResetSentMlsWelcomes()
+		return nil
+	case user.EdgeSentSenderKeyDistributions:
+		m.ResetSentSenderKeyDistributions()
+		return nil
+	case user.EdgeServiceOwner:
+		m.ResetServiceOwner()
+		return nil
+	case user.EdgeServiceAccounts:
+		m.ResetServiceAccounts()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
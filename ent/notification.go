@@ -26,6 +26,8 @@ type Notification struct {
 	CipherText string `json:"cipher_text,omitempty"`
 	// EncryptionScheme holds the value of the "encryption_scheme" field.
 	EncryptionScheme string `json:"encryption_scheme,omitempty"`
+	// EncryptionVersion holds the value of the "encryption_version" field.
+	EncryptionVersion *int `json:"encryption_version,omitempty"`
 	// Read holds the value of the "read" field.
 	Read bool `json:"read,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -94,7 +96,7 @@ func (*Notification) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case notification.FieldRead:
 			values[i] = new(sql.NullBool)
-		case notification.FieldID:
+		case notification.FieldID, notification.FieldEncryptionVersion:
 			values[i] = new(sql.NullInt64)
 		case notification.FieldKind, notification.FieldCipherText, notification.FieldEncryptionScheme:
 			values[i] = new(sql.NullString)
@@ -145,6 +147,13 @@ func (n *Notification) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				n.EncryptionScheme = value.String
 			}
+		case notification.FieldEncryptionVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field encryption_version", values[i])
+			} else if value.Valid {
+				n.EncryptionVersion = new(int)
+				*n.EncryptionVersion = int(value.Int64)
+			}
 		case notification.FieldRead:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field read", values[i])
@@ -244,6 +253,11 @@ func (n *Notification) String() string {
 	builder.WriteString("encryption_scheme=")
 	builder.WriteString(n.EncryptionScheme)
 	builder.WriteString(", ")
+	if v := n.EncryptionVersion; v != nil {
+		builder.WriteString("encryption_version=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("read=")
 	builder.WriteString(fmt.Sprintf("%v", n.Read))
 	builder.WriteString(", ")
@@ -5,6 +5,7 @@ package notification
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -20,6 +21,8 @@ const (
 	FieldCipherText = "cipher_text"
 	// FieldEncryptionScheme holds the string denoting the encryption_scheme field in the database.
 	FieldEncryptionScheme = "encryption_scheme"
+	// FieldEncryptionVersion holds the string denoting the encryption_version field in the database.
+	FieldEncryptionVersion = "encryption_version"
 	// FieldRead holds the string denoting the read field in the database.
 	FieldRead = "read"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -63,6 +66,7 @@ var Columns = []string{
 	FieldKind,
 	FieldCipherText,
 	FieldEncryptionScheme,
+	FieldEncryptionVersion,
 	FieldRead,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -91,7 +95,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eleven-am/enclave/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// KindValidator is a validator for the "kind" field. It is called by the builders before save.
 	KindValidator func(string) error
 	// CipherTextValidator is a validator for the "cipher_text" field. It is called by the builders before save.
@@ -131,6 +142,11 @@ func ByEncryptionScheme(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEncryptionScheme, opts...).ToFunc()
 }
 
+// ByEncryptionVersion orders the results by the encryption_version field.
+func ByEncryptionVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEncryptionVersion, opts...).ToFunc()
+}
+
 // ByRead orders the results by the read field.
 func ByRead(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRead, opts...).ToFunc()
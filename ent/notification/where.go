@@ -70,6 +70,11 @@ func EncryptionScheme(v string) predicate.Notification {
 	return predicate.Notification(sql.FieldEQ(FieldEncryptionScheme, v))
 }
 
+// EncryptionVersion applies equality check predicate on the "encryption_version" field. It's identical to EncryptionVersionEQ.
+func EncryptionVersion(v int) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldEncryptionVersion, v))
+}
+
 // Read applies equality check predicate on the "read" field. It's identical to ReadEQ.
 func Read(v bool) predicate.Notification {
 	return predicate.Notification(sql.FieldEQ(FieldRead, v))
@@ -280,6 +285,56 @@ func EncryptionSchemeContainsFold(v string) predicate.Notification {
 	return predicate.Notification(sql.FieldContainsFold(FieldEncryptionScheme, v))
 }
 
+// EncryptionVersionEQ applies the EQ predicate on the "encryption_version" field.
+func EncryptionVersionEQ(v int) predicate.Notification {
+	return predicate.Notification(sql.FieldEQ(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionNEQ applies the NEQ predicate on the "encryption_version" field.
+func EncryptionVersionNEQ(v int) predicate.Notification {
+	return predicate.Notification(sql.FieldNEQ(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionIn applies the In predicate on the "encryption_version" field.
+func EncryptionVersionIn(vs ...int) predicate.Notification {
+	return predicate.Notification(sql.FieldIn(FieldEncryptionVersion, vs...))
+}
+
+// EncryptionVersionNotIn applies the NotIn predicate on the "encryption_version" field.
+func EncryptionVersionNotIn(vs ...int) predicate.Notification {
+	return predicate.Notification(sql.FieldNotIn(FieldEncryptionVersion, vs...))
+}
+
+// EncryptionVersionGT applies the GT predicate on the "encryption_version" field.
+func EncryptionVersionGT(v int) predicate.Notification {
+	return predicate.Notification(sql.FieldGT(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionGTE applies the GTE predicate on the "encryption_version" field.
+func EncryptionVersionGTE(v int) predicate.Notification {
+	return predicate.Notification(sql.FieldGTE(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionLT applies the LT predicate on the "encryption_version" field.
+func EncryptionVersionLT(v int) predicate.Notification {
+	return predicate.Notification(sql.FieldLT(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionLTE applies the LTE predicate on the "encryption_version" field.
+func EncryptionVersionLTE(v int) predicate.Notification {
+	return predicate.Notification(sql.FieldLTE(FieldEncryptionVersion, v))
+}
+
+// EncryptionVersionIsNil applies the IsNil predicate on the "encryption_version" field.
+func EncryptionVersionIsNil() predicate.Notification {
+	return predicate.Notification(sql.FieldIsNull(FieldEncryptionVersion))
+}
+
+// EncryptionVersionNotNil applies the NotNil predicate on the "encryption_version" field.
+func EncryptionVersionNotNil() predicate.Notification {
+	return predicate.Notification(sql.FieldNotNull(FieldEncryptionVersion))
+}
+
 // ReadEQ applies the EQ predicate on the "read" field.
 func ReadEQ(v bool) predicate.Notification {
 	return predicate.Notification(sql.FieldEQ(FieldRead, v))
@@ -49,6 +49,20 @@ func (nc *NotificationCreate) SetNillableEncryptionScheme(s *string) *Notificati
 	return nc
 }
 
+// SetEncryptionVersion sets the "encryption_version" field.
+func (nc *NotificationCreate) SetEncryptionVersion(i int) *NotificationCreate {
+	nc.mutation.SetEncryptionVersion(i)
+	return nc
+}
+
+// SetNillableEncryptionVersion sets the "encryption_version" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableEncryptionVersion(i *int) *NotificationCreate {
+	if i != nil {
+		nc.SetEncryptionVersion(*i)
+	}
+	return nc
+}
+
 // SetRead sets the "read" field.
 func (nc *NotificationCreate) SetRead(b bool) *NotificationCreate {
 	nc.mutation.SetRead(b)
@@ -147,7 +161,9 @@ func (nc *NotificationCreate) Mutation() *NotificationMutation {
 
 // Save creates the Notification in the database.
 func (nc *NotificationCreate) Save(ctx context.Context) (*Notification, error) {
-	nc.defaults()
+	if err := nc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, nc.sqlSave, nc.mutation, nc.hooks)
 }
 
@@ -174,7 +190,7 @@ func (nc *NotificationCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (nc *NotificationCreate) defaults() {
+func (nc *NotificationCreate) defaults() error {
 	if _, ok := nc.mutation.EncryptionScheme(); !ok {
 		v := notification.DefaultEncryptionScheme
 		nc.mutation.SetEncryptionScheme(v)
@@ -184,13 +200,20 @@ func (nc *NotificationCreate) defaults() {
 		nc.mutation.SetRead(v)
 	}
 	if _, ok := nc.mutation.CreatedAt(); !ok {
+		if notification.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized notification.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := notification.DefaultCreatedAt()
 		nc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := nc.mutation.UpdatedAt(); !ok {
+		if notification.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized notification.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := notification.DefaultUpdatedAt()
 		nc.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -264,6 +287,10 @@ func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec)
 		_spec.SetField(notification.FieldEncryptionScheme, field.TypeString, value)
 		_node.EncryptionScheme = value
 	}
+	if value, ok := nc.mutation.EncryptionVersion(); ok {
+		_spec.SetField(notification.FieldEncryptionVersion, field.TypeInt, value)
+		_node.EncryptionVersion = &value
+	}
 	if value, ok := nc.mutation.Read(); ok {
 		_spec.SetField(notification.FieldRead, field.TypeBool, value)
 		_node.Read = value
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -435,6 +436,12 @@ func (nq *NotificationQuery) prepareQuery(ctx context.Context) error {
 		}
 		nq.sql = prev
 	}
+	if notification.Policy == nil {
+		return errors.New("ent: uninitialized notification.Policy (forgotten import ent/runtime?)")
+	}
+	if err := notification.Policy.EvalQuery(ctx, nq); err != nil {
+		return err
+	}
 	return nil
 }
 
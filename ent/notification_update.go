@@ -73,6 +73,33 @@ func (nu *NotificationUpdate) SetNillableEncryptionScheme(s *string) *Notificati
 	return nu
 }
 
+// SetEncryptionVersion sets the "encryption_version" field.
+func (nu *NotificationUpdate) SetEncryptionVersion(i int) *NotificationUpdate {
+	nu.mutation.ResetEncryptionVersion()
+	nu.mutation.SetEncryptionVersion(i)
+	return nu
+}
+
+// SetNillableEncryptionVersion sets the "encryption_version" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableEncryptionVersion(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetEncryptionVersion(*i)
+	}
+	return nu
+}
+
+// AddEncryptionVersion adds i to the "encryption_version" field.
+func (nu *NotificationUpdate) AddEncryptionVersion(i int) *NotificationUpdate {
+	nu.mutation.AddEncryptionVersion(i)
+	return nu
+}
+
+// ClearEncryptionVersion clears the value of the "encryption_version" field.
+func (nu *NotificationUpdate) ClearEncryptionVersion() *NotificationUpdate {
+	nu.mutation.ClearEncryptionVersion()
+	return nu
+}
+
 // SetRead sets the "read" field.
 func (nu *NotificationUpdate) SetRead(b bool) *NotificationUpdate {
 	nu.mutation.SetRead(b)
@@ -181,7 +208,9 @@ func (nu *NotificationUpdate) ClearMessage() *NotificationUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (nu *NotificationUpdate) Save(ctx context.Context) (int, error) {
-	nu.defaults()
+	if err := nu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, nu.sqlSave, nu.mutation, nu.hooks)
 }
 
@@ -208,11 +237,15 @@ func (nu *NotificationUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (nu *NotificationUpdate) defaults() {
+func (nu *NotificationUpdate) defaults() error {
 	if _, ok := nu.mutation.UpdatedAt(); !ok {
+		if notification.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized notification.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := notification.UpdateDefaultUpdatedAt()
 		nu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -254,6 +287,15 @@ func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := nu.mutation.EncryptionScheme(); ok {
 		_spec.SetField(notification.FieldEncryptionScheme, field.TypeString, value)
 	}
+	if value, ok := nu.mutation.EncryptionVersion(); ok {
+		_spec.SetField(notification.FieldEncryptionVersion, field.TypeInt, value)
+	}
+	if value, ok := nu.mutation.AddedEncryptionVersion(); ok {
+		_spec.AddField(notification.FieldEncryptionVersion, field.TypeInt, value)
+	}
+	if nu.mutation.EncryptionVersionCleared() {
+		_spec.ClearField(notification.FieldEncryptionVersion, field.TypeInt)
+	}
 	if value, ok := nu.mutation.Read(); ok {
 		_spec.SetField(notification.FieldRead, field.TypeBool, value)
 	}
@@ -412,6 +454,33 @@ func (nuo *NotificationUpdateOne) SetNillableEncryptionScheme(s *string) *Notifi
 	return nuo
 }
 
+// SetEncryptionVersion sets the "encryption_version" field.
+func (nuo *NotificationUpdateOne) SetEncryptionVersion(i int) *NotificationUpdateOne {
+	nuo.mutation.ResetEncryptionVersion()
+	nuo.mutation.SetEncryptionVersion(i)
+	return nuo
+}
+
+// SetNillableEncryptionVersion sets the "encryption_version" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableEncryptionVersion(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetEncryptionVersion(*i)
+	}
+	return nuo
+}
+
+// AddEncryptionVersion adds i to the "encryption_version" field.
+func (nuo *NotificationUpdateOne) AddEncryptionVersion(i int) *NotificationUpdateOne {
+	nuo.mutation.AddEncryptionVersion(i)
+	return nuo
+}
+
+// ClearEncryptionVersion clears the value of the "encryption_version" field.
+func (nuo *NotificationUpdateOne) ClearEncryptionVersion() *NotificationUpdateOne {
+	nuo.mutation.ClearEncryptionVersion()
+	return nuo
+}
+
 // SetRead sets the "read" field.
 func (nuo *NotificationUpdateOne) SetRead(b bool) *NotificationUpdateOne {
 	nuo.mutation.SetRead(b)
@@ -533,7 +602,9 @@ func (nuo *NotificationUpdateOne) Select(field string, fields ...string) *Notifi
 
 // Save executes the query and returns the updated Notification entity.
 func (nuo *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
-	nuo.defaults()
+	if err := nuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, nuo.sqlSave, nuo.mutation, nuo.hooks)
 }
 
@@ -560,11 +631,15 @@ func (nuo *NotificationUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (nuo *NotificationUpdateOne) defaults() {
+func (nuo *NotificationUpdateOne) defaults() error {
 	if _, ok := nuo.mutation.UpdatedAt(); !ok {
+		if notification.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized notification.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := notification.UpdateDefaultUpdatedAt()
 		nuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -623,6 +698,15 @@ func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notificat
 	if value, ok := nuo.mutation.EncryptionScheme(); ok {
 		_spec.SetField(notification.FieldEncryptionScheme, field.TypeString, value)
 	}
+	if value, ok := nuo.mutation.EncryptionVersion(); ok {
+		_spec.SetField(notification.FieldEncryptionVersion, field.TypeInt, value)
+	}
+	if value, ok := nuo.mutation.AddedEncryptionVersion(); ok {
+		_spec.AddField(notification.FieldEncryptionVersion, field.TypeInt, value)
+	}
+	if nuo.mutation.EncryptionVersionCleared() {
+		_spec.ClearField(notification.FieldEncryptionVersion, field.TypeInt)
+	}
 	if value, ok := nuo.mutation.Read(); ok {
 		_spec.SetField(notification.FieldRead, field.TypeBool, value)
 	}
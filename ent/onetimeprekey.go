@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+)
+
+// OneTimePreKey is the model entity for the OneTimePreKey schema.
+type OneTimePreKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// KeyID holds the value of the "key_id" field.
+	KeyID int `json:"key_id,omitempty"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey string `json:"public_key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OneTimePreKeyQuery when eager-loading is set.
+	Edges                   OneTimePreKeyEdges `json:"edges"`
+	one_time_pre_key_device *int
+	selectValues            sql.SelectValues
+}
+
+// OneTimePreKeyEdges holds the relations/edges for other nodes in the graph.
+type OneTimePreKeyEdges struct {
+	// Device holds the value of the device edge.
+	Device *Device `json:"device,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DeviceOrErr returns the Device value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OneTimePreKeyEdges) DeviceOrErr() (*Device, error) {
+	if e.Device != nil {
+		return e.Device, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: device.Label}
+	}
+	return nil, &NotLoadedError{edge: "device"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OneTimePreKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case onetimeprekey.FieldID, onetimeprekey.FieldKeyID:
+			values[i] = new(sql.NullInt64)
+		case onetimeprekey.FieldPublicKey:
+			values[i] = new(sql.NullString)
+		case onetimeprekey.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case onetimeprekey.ForeignKeys[0]: // one_time_pre_key_device
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OneTimePreKey fields.
+func (otpk *OneTimePreKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case onetimeprekey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			otpk.ID = int(value.Int64)
+		case onetimeprekey.FieldKeyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				otpk.KeyID = int(value.Int64)
+			}
+		case onetimeprekey.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value.Valid {
+				otpk.PublicKey = value.String
+			}
+		case onetimeprekey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				otpk.CreatedAt = value.Time
+			}
+		case onetimeprekey.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field one_time_pre_key_device", value)
+			} else if value.Valid {
+				otpk.one_time_pre_key_device = new(int)
+				*otpk.one_time_pre_key_device = int(value.Int64)
+			}
+		default:
+			otpk.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OneTimePreKey.
+// This includes values selected through modifiers, order, etc.
+func (otpk *OneTimePreKey) Value(name string) (ent.Value, error) {
+	return otpk.selectValues.Get(name)
+}
+
+// QueryDevice queries the "device" edge of the OneTimePreKey entity.
+func (otpk *OneTimePreKey) QueryDevice() *DeviceQuery {
+	return NewOneTimePreKeyClient(otpk.config).QueryDevice(otpk)
+}
+
+// Update returns a builder for updating this OneTimePreKey.
+// Note that you need to call OneTimePreKey.Unwrap() before calling this method if this OneTimePreKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (otpk *OneTimePreKey) Update() *OneTimePreKeyUpdateOne {
+	return NewOneTimePreKeyClient(otpk.config).UpdateOne(otpk)
+}
+
+// Unwrap unwraps the OneTimePreKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (otpk *OneTimePreKey) Unwrap() *OneTimePreKey {
+	_tx, ok := otpk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OneTimePreKey is not a transactional entity")
+	}
+	otpk.config.driver = _tx.drv
+	return otpk
+}
+
+// String implements the fmt.Stringer.
+func (otpk *OneTimePreKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("OneTimePreKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", otpk.ID))
+	builder.WriteString("key_id=")
+	builder.WriteString(fmt.Sprintf("%v", otpk.KeyID))
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(otpk.PublicKey)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(otpk.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OneTimePreKeys is a parsable slice of OneTimePreKey.
+type OneTimePreKeys []*OneTimePreKey
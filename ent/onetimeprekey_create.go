@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+)
+
+// OneTimePreKeyCreate is the builder for creating a OneTimePreKey entity.
+type OneTimePreKeyCreate struct {
+	config
+	mutation *OneTimePreKeyMutation
+	hooks    []Hook
+}
+
+// SetKeyID sets the "key_id" field.
+func (otpkc *OneTimePreKeyCreate) SetKeyID(i int) *OneTimePreKeyCreate {
+	otpkc.mutation.SetKeyID(i)
+	return otpkc
+}
+
+// SetPublicKey sets the "public_key" field.
+func (otpkc *OneTimePreKeyCreate) SetPublicKey(s string) *OneTimePreKeyCreate {
+	otpkc.mutation.SetPublicKey(s)
+	return otpkc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (otpkc *OneTimePreKeyCreate) SetCreatedAt(t time.Time) *OneTimePreKeyCreate {
+	otpkc.mutation.SetCreatedAt(t)
+	return otpkc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (otpkc *OneTimePreKeyCreate) SetNillableCreatedAt(t *time.Time) *OneTimePreKeyCreate {
+	if t != nil {
+		otpkc.SetCreatedAt(*t)
+	}
+	return otpkc
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (otpkc *OneTimePreKeyCreate) SetDeviceID(id int) *OneTimePreKeyCreate {
+	otpkc.mutation.SetDeviceID(id)
+	return otpkc
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (otpkc *OneTimePreKeyCreate) SetDevice(d *Device) *OneTimePreKeyCreate {
+	return otpkc.SetDeviceID(d.ID)
+}
+
+// Mutation returns the OneTimePreKeyMutation object of the builder.
+func (otpkc *OneTimePreKeyCreate) Mutation() *OneTimePreKeyMutation {
+	return otpkc.mutation
+}
+
+// Save creates the OneTimePreKey in the database.
+func (otpkc *OneTimePreKeyCreate) Save(ctx context.Context) (*OneTimePreKey, error) {
+	otpkc.defaults()
+	return withHooks(ctx, otpkc.sqlSave, otpkc.mutation, otpkc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (otpkc *OneTimePreKeyCreate) SaveX(ctx context.Context) *OneTimePreKey {
+	v, err := otpkc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (otpkc *OneTimePreKeyCreate) Exec(ctx context.Context) error {
+	_, err := otpkc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otpkc *OneTimePreKeyCreate) ExecX(ctx context.Context) {
+	if err := otpkc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (otpkc *OneTimePreKeyCreate) defaults() {
+	if _, ok := otpkc.mutation.CreatedAt(); !ok {
+		v := onetimeprekey.DefaultCreatedAt()
+		otpkc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otpkc *OneTimePreKeyCreate) check() error {
+	if _, ok := otpkc.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "OneTimePreKey.key_id"`)}
+	}
+	if v, ok := otpkc.mutation.KeyID(); ok {
+		if err := onetimeprekey.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "OneTimePreKey.key_id": %w`, err)}
+		}
+	}
+	if _, ok := otpkc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "OneTimePreKey.public_key"`)}
+	}
+	if v, ok := otpkc.mutation.PublicKey(); ok {
+		if err := onetimeprekey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "OneTimePreKey.public_key": %w`, err)}
+		}
+	}
+	if _, ok := otpkc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OneTimePreKey.created_at"`)}
+	}
+	if _, ok := otpkc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`ent: missing required edge "OneTimePreKey.device"`)}
+	}
+	return nil
+}
+
+func (otpkc *OneTimePreKeyCreate) sqlSave(ctx context.Context) (*OneTimePreKey, error) {
+	if err := otpkc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := otpkc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, otpkc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	otpkc.mutation.id = &_node.ID
+	otpkc.mutation.done = true
+	return _node, nil
+}
+
+func (otpkc *OneTimePreKeyCreate) createSpec() (*OneTimePreKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OneTimePreKey{config: otpkc.config}
+		_spec = sqlgraph.NewCreateSpec(onetimeprekey.Table, sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt))
+	)
+	if value, ok := otpkc.mutation.KeyID(); ok {
+		_spec.SetField(onetimeprekey.FieldKeyID, field.TypeInt, value)
+		_node.KeyID = value
+	}
+	if value, ok := otpkc.mutation.PublicKey(); ok {
+		_spec.SetField(onetimeprekey.FieldPublicKey, field.TypeString, value)
+		_node.PublicKey = value
+	}
+	if value, ok := otpkc.mutation.CreatedAt(); ok {
+		_spec.SetField(onetimeprekey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := otpkc.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   onetimeprekey.DeviceTable,
+			Columns: []string{onetimeprekey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.one_time_pre_key_device = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OneTimePreKeyCreateBulk is the builder for creating many OneTimePreKey entities in bulk.
+type OneTimePreKeyCreateBulk struct {
+	config
+	err      error
+	builders []*OneTimePreKeyCreate
+}
+
+// Save creates the OneTimePreKey entities in the database.
+func (otpkcb *OneTimePreKeyCreateBulk) Save(ctx context.Context) ([]*OneTimePreKey, error) {
+	if otpkcb.err != nil {
+		return nil, otpkcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(otpkcb.builders))
+	nodes := make([]*OneTimePreKey, len(otpkcb.builders))
+	mutators := make([]Mutator, len(otpkcb.builders))
+	for i := range otpkcb.builders {
+		func(i int, root context.Context) {
+			builder := otpkcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OneTimePreKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, otpkcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, otpkcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, otpkcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otpkcb *OneTimePreKeyCreateBulk) SaveX(ctx context.Context) []*OneTimePreKey {
+	v, err := otpkcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (otpkcb *OneTimePreKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := otpkcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otpkcb *OneTimePreKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := otpkcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
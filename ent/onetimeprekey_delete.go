@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// OneTimePreKeyDelete is the builder for deleting a OneTimePreKey entity.
+type OneTimePreKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *OneTimePreKeyMutation
+}
+
+// Where appends a list predicates to the OneTimePreKeyDelete builder.
+func (otpkd *OneTimePreKeyDelete) Where(ps ...predicate.OneTimePreKey) *OneTimePreKeyDelete {
+	otpkd.mutation.Where(ps...)
+	return otpkd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (otpkd *OneTimePreKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, otpkd.sqlExec, otpkd.mutation, otpkd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otpkd *OneTimePreKeyDelete) ExecX(ctx context.Context) int {
+	n, err := otpkd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (otpkd *OneTimePreKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(onetimeprekey.Table, sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt))
+	if ps := otpkd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, otpkd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	otpkd.mutation.done = true
+	return affected, err
+}
+
+// OneTimePreKeyDeleteOne is the builder for deleting a single OneTimePreKey entity.
+type OneTimePreKeyDeleteOne struct {
+	otpkd *OneTimePreKeyDelete
+}
+
+// Where appends a list predicates to the OneTimePreKeyDelete builder.
+func (otpkdo *OneTimePreKeyDeleteOne) Where(ps ...predicate.OneTimePreKey) *OneTimePreKeyDeleteOne {
+	otpkdo.otpkd.mutation.Where(ps...)
+	return otpkdo
+}
+
+// Exec executes the deletion query.
+func (otpkdo *OneTimePreKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := otpkdo.otpkd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{onetimeprekey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otpkdo *OneTimePreKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := otpkdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// OneTimePreKeyQuery is the builder for querying OneTimePreKey entities.
+type OneTimePreKeyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []onetimeprekey.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OneTimePreKey
+	withDevice *DeviceQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OneTimePreKeyQuery builder.
+func (otpkq *OneTimePreKeyQuery) Where(ps ...predicate.OneTimePreKey) *OneTimePreKeyQuery {
+	otpkq.predicates = append(otpkq.predicates, ps...)
+	return otpkq
+}
+
+// Limit the number of records to be returned by this query.
+func (otpkq *OneTimePreKeyQuery) Limit(limit int) *OneTimePreKeyQuery {
+	otpkq.ctx.Limit = &limit
+	return otpkq
+}
+
+// Offset to start from.
+func (otpkq *OneTimePreKeyQuery) Offset(offset int) *OneTimePreKeyQuery {
+	otpkq.ctx.Offset = &offset
+	return otpkq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (otpkq *OneTimePreKeyQuery) Unique(unique bool) *OneTimePreKeyQuery {
+	otpkq.ctx.Unique = &unique
+	return otpkq
+}
+
+// Order specifies how the records should be ordered.
+func (otpkq *OneTimePreKeyQuery) Order(o ...onetimeprekey.OrderOption) *OneTimePreKeyQuery {
+	otpkq.order = append(otpkq.order, o...)
+	return otpkq
+}
+
+// QueryDevice chains the current query on the "device" edge.
+func (otpkq *OneTimePreKeyQuery) QueryDevice() *DeviceQuery {
+	query := (&DeviceClient{config: otpkq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := otpkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := otpkq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(onetimeprekey.Table, onetimeprekey.FieldID, selector),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, onetimeprekey.DeviceTable, onetimeprekey.DeviceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(otpkq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OneTimePreKey entity from the query.
+// Returns a *NotFoundError when no OneTimePreKey was found.
+func (otpkq *OneTimePreKeyQuery) First(ctx context.Context) (*OneTimePreKey, error) {
+	nodes, err := otpkq.Limit(1).All(setContextOp(ctx, otpkq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{onetimeprekey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (otpkq *OneTimePreKeyQuery) FirstX(ctx context.Context) *OneTimePreKey {
+	node, err := otpkq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OneTimePreKey ID from the query.
+// Returns a *NotFoundError when no OneTimePreKey ID was found.
+func (otpkq *OneTimePreKeyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = otpkq.Limit(1).IDs(setContextOp(ctx, otpkq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{onetimeprekey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (otpkq *OneTimePreKeyQuery) FirstIDX(ctx context.Context) int {
+	id, err := otpkq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OneTimePreKey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OneTimePreKey entity is found.
+// Returns a *NotFoundError when no OneTimePreKey entities are found.
+func (otpkq *OneTimePreKeyQuery) Only(ctx context.Context) (*OneTimePreKey, error) {
+	nodes, err := otpkq.Limit(2).All(setContextOp(ctx, otpkq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{onetimeprekey.Label}
+	default:
+		return nil, &NotSingularError{onetimeprekey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (otpkq *OneTimePreKeyQuery) OnlyX(ctx context.Context) *OneTimePreKey {
+	node, err := otpkq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OneTimePreKey ID in the query.
+// Returns a *NotSingularError when more than one OneTimePreKey ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (otpkq *OneTimePreKeyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = otpkq.Limit(2).IDs(setContextOp(ctx, otpkq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{onetimeprekey.Label}
+	default:
+		err = &NotSingularError{onetimeprekey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (otpkq *OneTimePreKeyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := otpkq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OneTimePreKeys.
+func (otpkq *OneTimePreKeyQuery) All(ctx context.Context) ([]*OneTimePreKey, error) {
+	ctx = setContextOp(ctx, otpkq.ctx, "All")
+	if err := otpkq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OneTimePreKey, *OneTimePreKeyQuery]()
+	return withInterceptors[[]*OneTimePreKey](ctx, otpkq, qr, otpkq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (otpkq *OneTimePreKeyQuery) AllX(ctx context.Context) []*OneTimePreKey {
+	nodes, err := otpkq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OneTimePreKey IDs.
+func (otpkq *OneTimePreKeyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if otpkq.ctx.Unique == nil && otpkq.path != nil {
+		otpkq.Unique(true)
+	}
+	ctx = setContextOp(ctx, otpkq.ctx, "IDs")
+	if err = otpkq.Select(onetimeprekey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (otpkq *OneTimePreKeyQuery) IDsX(ctx context.Context) []int {
+	ids, err := otpkq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (otpkq *OneTimePreKeyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, otpkq.ctx, "Count")
+	if err := otpkq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, otpkq, querierCount[*OneTimePreKeyQuery](), otpkq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (otpkq *OneTimePreKeyQuery) CountX(ctx context.Context) int {
+	count, err := otpkq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (otpkq *OneTimePreKeyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, otpkq.ctx, "Exist")
+	switch _, err := otpkq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (otpkq *OneTimePreKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := otpkq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OneTimePreKeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (otpkq *OneTimePreKeyQuery) Clone() *OneTimePreKeyQuery {
+	if otpkq == nil {
+		return nil
+	}
+	return &OneTimePreKeyQuery{
+		config:     otpkq.config,
+		ctx:        otpkq.ctx.Clone(),
+		order:      append([]onetimeprekey.OrderOption{}, otpkq.order...),
+		inters:     append([]Interceptor{}, otpkq.inters...),
+		predicates: append([]predicate.OneTimePreKey{}, otpkq.predicates...),
+		withDevice: otpkq.withDevice.Clone(),
+		// clone intermediate query.
+		sql:  otpkq.sql.Clone(),
+		path: otpkq.path,
+	}
+}
+
+// WithDevice tells the query-builder to eager-load the nodes that are connected to
+// the "device" edge. The optional arguments are used to configure the query builder of the edge.
+func (otpkq *OneTimePreKeyQuery) WithDevice(opts ...func(*DeviceQuery)) *OneTimePreKeyQuery {
+	query := (&DeviceClient{config: otpkq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	otpkq.withDevice = query
+	return otpkq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		KeyID int `json:"key_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OneTimePreKey.Query().
+//		GroupBy(onetimeprekey.FieldKeyID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (otpkq *OneTimePreKeyQuery) GroupBy(field string, fields ...string) *OneTimePreKeyGroupBy {
+	otpkq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OneTimePreKeyGroupBy{build: otpkq}
+	grbuild.flds = &otpkq.ctx.Fields
+	grbuild.label = onetimeprekey.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		KeyID int `json:"key_id,omitempty"`
+//	}
+//
+//	client.OneTimePreKey.Query().
+//		Select(onetimeprekey.FieldKeyID).
+//		Scan(ctx, &v)
+func (otpkq *OneTimePreKeyQuery) Select(fields ...string) *OneTimePreKeySelect {
+	otpkq.ctx.Fields = append(otpkq.ctx.Fields, fields...)
+	sbuild := &OneTimePreKeySelect{OneTimePreKeyQuery: otpkq}
+	sbuild.label = onetimeprekey.Label
+	sbuild.flds, sbuild.scan = &otpkq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OneTimePreKeySelect configured with the given aggregations.
+func (otpkq *OneTimePreKeyQuery) Aggregate(fns ...AggregateFunc) *OneTimePreKeySelect {
+	return otpkq.Select().Aggregate(fns...)
+}
+
+func (otpkq *OneTimePreKeyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range otpkq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, otpkq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range otpkq.ctx.Fields {
+		if !onetimeprekey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if otpkq.path != nil {
+		prev, err := otpkq.path(ctx)
+		if err != nil {
+			return err
+		}
+		otpkq.sql = prev
+	}
+	return nil
+}
+
+func (otpkq *OneTimePreKeyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OneTimePreKey, error) {
+	var (
+		nodes       = []*OneTimePreKey{}
+		withFKs     = otpkq.withFKs
+		_spec       = otpkq.querySpec()
+		loadedTypes = [1]bool{
+			otpkq.withDevice != nil,
+		}
+	)
+	if otpkq.withDevice != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, onetimeprekey.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OneTimePreKey).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OneTimePreKey{config: otpkq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, otpkq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := otpkq.withDevice; query != nil {
+		if err := otpkq.loadDevice(ctx, query, nodes, nil,
+			func(n *OneTimePreKey, e *Device) { n.Edges.Device = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (otpkq *OneTimePreKeyQuery) loadDevice(ctx context.Context, query *DeviceQuery, nodes []*OneTimePreKey, init func(*OneTimePreKey), assign func(*OneTimePreKey, *Device)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OneTimePreKey)
+	for i := range nodes {
+		if nodes[i].one_time_pre_key_device == nil {
+			continue
+		}
+		fk := *nodes[i].one_time_pre_key_device
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(device.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "one_time_pre_key_device" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (otpkq *OneTimePreKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := otpkq.querySpec()
+	_spec.Node.Columns = otpkq.ctx.Fields
+	if len(otpkq.ctx.Fields) > 0 {
+		_spec.Unique = otpkq.ctx.Unique != nil && *otpkq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, otpkq.driver, _spec)
+}
+
+func (otpkq *OneTimePreKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(onetimeprekey.Table, onetimeprekey.Columns, sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt))
+	_spec.From = otpkq.sql
+	if unique := otpkq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if otpkq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := otpkq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, onetimeprekey.FieldID)
+		for i := range fields {
+			if fields[i] != onetimeprekey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := otpkq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := otpkq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := otpkq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := otpkq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (otpkq *OneTimePreKeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(otpkq.driver.Dialect())
+	t1 := builder.Table(onetimeprekey.Table)
+	columns := otpkq.ctx.Fields
+	if len(columns) == 0 {
+		columns = onetimeprekey.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if otpkq.sql != nil {
+		selector = otpkq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if otpkq.ctx.Unique != nil && *otpkq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range otpkq.predicates {
+		p(selector)
+	}
+	for _, p := range otpkq.order {
+		p(selector)
+	}
+	if offset := otpkq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := otpkq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OneTimePreKeyGroupBy is the group-by builder for OneTimePreKey entities.
+type OneTimePreKeyGroupBy struct {
+	selector
+	build *OneTimePreKeyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (otpkgb *OneTimePreKeyGroupBy) Aggregate(fns ...AggregateFunc) *OneTimePreKeyGroupBy {
+	otpkgb.fns = append(otpkgb.fns, fns...)
+	return otpkgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (otpkgb *OneTimePreKeyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, otpkgb.build.ctx, "GroupBy")
+	if err := otpkgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OneTimePreKeyQuery, *OneTimePreKeyGroupBy](ctx, otpkgb.build, otpkgb, otpkgb.build.inters, v)
+}
+
+func (otpkgb *OneTimePreKeyGroupBy) sqlScan(ctx context.Context, root *OneTimePreKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(otpkgb.fns))
+	for _, fn := range otpkgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*otpkgb.flds)+len(otpkgb.fns))
+		for _, f := range *otpkgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*otpkgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := otpkgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OneTimePreKeySelect is the builder for selecting fields of OneTimePreKey entities.
+type OneTimePreKeySelect struct {
+	*OneTimePreKeyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (otpks *OneTimePreKeySelect) Aggregate(fns ...AggregateFunc) *OneTimePreKeySelect {
+	otpks.fns = append(otpks.fns, fns...)
+	return otpks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (otpks *OneTimePreKeySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, otpks.ctx, "Select")
+	if err := otpks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OneTimePreKeyQuery, *OneTimePreKeySelect](ctx, otpks.OneTimePreKeyQuery, otpks, otpks.inters, v)
+}
+
+func (otpks *OneTimePreKeySelect) sqlScan(ctx context.Context, root *OneTimePreKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(otpks.fns))
+	for _, fn := range otpks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*otpks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := otpks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// OneTimePreKeyUpdate is the builder for updating OneTimePreKey entities.
+type OneTimePreKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OneTimePreKeyMutation
+}
+
+// Where appends a list predicates to the OneTimePreKeyUpdate builder.
+func (otpku *OneTimePreKeyUpdate) Where(ps ...predicate.OneTimePreKey) *OneTimePreKeyUpdate {
+	otpku.mutation.Where(ps...)
+	return otpku
+}
+
+// SetKeyID sets the "key_id" field.
+func (otpku *OneTimePreKeyUpdate) SetKeyID(i int) *OneTimePreKeyUpdate {
+	otpku.mutation.ResetKeyID()
+	otpku.mutation.SetKeyID(i)
+	return otpku
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (otpku *OneTimePreKeyUpdate) SetNillableKeyID(i *int) *OneTimePreKeyUpdate {
+	if i != nil {
+		otpku.SetKeyID(*i)
+	}
+	return otpku
+}
+
+// AddKeyID adds i to the "key_id" field.
+func (otpku *OneTimePreKeyUpdate) AddKeyID(i int) *OneTimePreKeyUpdate {
+	otpku.mutation.AddKeyID(i)
+	return otpku
+}
+
+// SetPublicKey sets the "public_key" field.
+func (otpku *OneTimePreKeyUpdate) SetPublicKey(s string) *OneTimePreKeyUpdate {
+	otpku.mutation.SetPublicKey(s)
+	return otpku
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (otpku *OneTimePreKeyUpdate) SetNillablePublicKey(s *string) *OneTimePreKeyUpdate {
+	if s != nil {
+		otpku.SetPublicKey(*s)
+	}
+	return otpku
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (otpku *OneTimePreKeyUpdate) SetCreatedAt(t time.Time) *OneTimePreKeyUpdate {
+	otpku.mutation.SetCreatedAt(t)
+	return otpku
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (otpku *OneTimePreKeyUpdate) SetNillableCreatedAt(t *time.Time) *OneTimePreKeyUpdate {
+	if t != nil {
+		otpku.SetCreatedAt(*t)
+	}
+	return otpku
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (otpku *OneTimePreKeyUpdate) SetDeviceID(id int) *OneTimePreKeyUpdate {
+	otpku.mutation.SetDeviceID(id)
+	return otpku
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (otpku *OneTimePreKeyUpdate) SetDevice(d *Device) *OneTimePreKeyUpdate {
+	return otpku.SetDeviceID(d.ID)
+}
+
+// Mutation returns the OneTimePreKeyMutation object of the builder.
+func (otpku *OneTimePreKeyUpdate) Mutation() *OneTimePreKeyMutation {
+	return otpku.mutation
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (otpku *OneTimePreKeyUpdate) ClearDevice() *OneTimePreKeyUpdate {
+	otpku.mutation.ClearDevice()
+	return otpku
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (otpku *OneTimePreKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, otpku.sqlSave, otpku.mutation, otpku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otpku *OneTimePreKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := otpku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (otpku *OneTimePreKeyUpdate) Exec(ctx context.Context) error {
+	_, err := otpku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otpku *OneTimePreKeyUpdate) ExecX(ctx context.Context) {
+	if err := otpku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otpku *OneTimePreKeyUpdate) check() error {
+	if v, ok := otpku.mutation.KeyID(); ok {
+		if err := onetimeprekey.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "OneTimePreKey.key_id": %w`, err)}
+		}
+	}
+	if v, ok := otpku.mutation.PublicKey(); ok {
+		if err := onetimeprekey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "OneTimePreKey.public_key": %w`, err)}
+		}
+	}
+	if _, ok := otpku.mutation.DeviceID(); otpku.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OneTimePreKey.device"`)
+	}
+	return nil
+}
+
+func (otpku *OneTimePreKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := otpku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(onetimeprekey.Table, onetimeprekey.Columns, sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt))
+	if ps := otpku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := otpku.mutation.KeyID(); ok {
+		_spec.SetField(onetimeprekey.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := otpku.mutation.AddedKeyID(); ok {
+		_spec.AddField(onetimeprekey.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := otpku.mutation.PublicKey(); ok {
+		_spec.SetField(onetimeprekey.FieldPublicKey, field.TypeString, value)
+	}
+	if value, ok := otpku.mutation.CreatedAt(); ok {
+		_spec.SetField(onetimeprekey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if otpku.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   onetimeprekey.DeviceTable,
+			Columns: []string{onetimeprekey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := otpku.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   onetimeprekey.DeviceTable,
+			Columns: []string{onetimeprekey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, otpku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{onetimeprekey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	otpku.mutation.done = true
+	return n, nil
+}
+
+// OneTimePreKeyUpdateOne is the builder for updating a single OneTimePreKey entity.
+type OneTimePreKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OneTimePreKeyMutation
+}
+
+// SetKeyID sets the "key_id" field.
+func (otpkuo *OneTimePreKeyUpdateOne) SetKeyID(i int) *OneTimePreKeyUpdateOne {
+	otpkuo.mutation.ResetKeyID()
+	otpkuo.mutation.SetKeyID(i)
+	return otpkuo
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (otpkuo *OneTimePreKeyUpdateOne) SetNillableKeyID(i *int) *OneTimePreKeyUpdateOne {
+	if i != nil {
+		otpkuo.SetKeyID(*i)
+	}
+	return otpkuo
+}
+
+// AddKeyID adds i to the "key_id" field.
+func (otpkuo *OneTimePreKeyUpdateOne) AddKeyID(i int) *OneTimePreKeyUpdateOne {
+	otpkuo.mutation.AddKeyID(i)
+	return otpkuo
+}
+
+// SetPublicKey sets the "public_key" field.
+func (otpkuo *OneTimePreKeyUpdateOne) SetPublicKey(s string) *OneTimePreKeyUpdateOne {
+	otpkuo.mutation.SetPublicKey(s)
+	return otpkuo
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (otpkuo *OneTimePreKeyUpdateOne) SetNillablePublicKey(s *string) *OneTimePreKeyUpdateOne {
+	if s != nil {
+		otpkuo.SetPublicKey(*s)
+	}
+	return otpkuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (otpkuo *OneTimePreKeyUpdateOne) SetCreatedAt(t time.Time) *OneTimePreKeyUpdateOne {
+	otpkuo.mutation.SetCreatedAt(t)
+	return otpkuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (otpkuo *OneTimePreKeyUpdateOne) SetNillableCreatedAt(t *time.Time) *OneTimePreKeyUpdateOne {
+	if t != nil {
+		otpkuo.SetCreatedAt(*t)
+	}
+	return otpkuo
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (otpkuo *OneTimePreKeyUpdateOne) SetDeviceID(id int) *OneTimePreKeyUpdateOne {
+	otpkuo.mutation.SetDeviceID(id)
+	return otpkuo
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (otpkuo *OneTimePreKeyUpdateOne) SetDevice(d *Device) *OneTimePreKeyUpdateOne {
+	return otpkuo.SetDeviceID(d.ID)
+}
+
+// Mutation returns the OneTimePreKeyMutation object of the builder.
+func (otpkuo *OneTimePreKeyUpdateOne) Mutation() *OneTimePreKeyMutation {
+	return otpkuo.mutation
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (otpkuo *OneTimePreKeyUpdateOne) ClearDevice() *OneTimePreKeyUpdateOne {
+	otpkuo.mutation.ClearDevice()
+	return otpkuo
+}
+
+// Where appends a list predicates to the OneTimePreKeyUpdate builder.
+func (otpkuo *OneTimePreKeyUpdateOne) Where(ps ...predicate.OneTimePreKey) *OneTimePreKeyUpdateOne {
+	otpkuo.mutation.Where(ps...)
+	return otpkuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (otpkuo *OneTimePreKeyUpdateOne) Select(field string, fields ...string) *OneTimePreKeyUpdateOne {
+	otpkuo.fields = append([]string{field}, fields...)
+	return otpkuo
+}
+
+// Save executes the query and returns the updated OneTimePreKey entity.
+func (otpkuo *OneTimePreKeyUpdateOne) Save(ctx context.Context) (*OneTimePreKey, error) {
+	return withHooks(ctx, otpkuo.sqlSave, otpkuo.mutation, otpkuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (otpkuo *OneTimePreKeyUpdateOne) SaveX(ctx context.Context) *OneTimePreKey {
+	node, err := otpkuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (otpkuo *OneTimePreKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := otpkuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (otpkuo *OneTimePreKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := otpkuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (otpkuo *OneTimePreKeyUpdateOne) check() error {
+	if v, ok := otpkuo.mutation.KeyID(); ok {
+		if err := onetimeprekey.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "OneTimePreKey.key_id": %w`, err)}
+		}
+	}
+	if v, ok := otpkuo.mutation.PublicKey(); ok {
+		if err := onetimeprekey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "OneTimePreKey.public_key": %w`, err)}
+		}
+	}
+	if _, ok := otpkuo.mutation.DeviceID(); otpkuo.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OneTimePreKey.device"`)
+	}
+	return nil
+}
+
+func (otpkuo *OneTimePreKeyUpdateOne) sqlSave(ctx context.Context) (_node *OneTimePreKey, err error) {
+	if err := otpkuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(onetimeprekey.Table, onetimeprekey.Columns, sqlgraph.NewFieldSpec(onetimeprekey.FieldID, field.TypeInt))
+	id, ok := otpkuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OneTimePreKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := otpkuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, onetimeprekey.FieldID)
+		for _, f := range fields {
+			if !onetimeprekey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != onetimeprekey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := otpkuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := otpkuo.mutation.KeyID(); ok {
+		_spec.SetField(onetimeprekey.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := otpkuo.mutation.AddedKeyID(); ok {
+		_spec.AddField(onetimeprekey.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := otpkuo.mutation.PublicKey(); ok {
+		_spec.SetField(onetimeprekey.FieldPublicKey, field.TypeString, value)
+	}
+	if value, ok := otpkuo.mutation.CreatedAt(); ok {
+		_spec.SetField(onetimeprekey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if otpkuo.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   onetimeprekey.DeviceTable,
+			Columns: []string{onetimeprekey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := otpkuo.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   onetimeprekey.DeviceTable,
+			Columns: []string{onetimeprekey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OneTimePreKey{config: otpkuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, otpkuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{onetimeprekey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	otpkuo.mutation.done = true
+	return _node, nil
+}
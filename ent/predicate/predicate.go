@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ApiKey is the predicate function for apikey builders.
+type ApiKey func(*sql.Selector)
+
 // CallLog is the predicate function for calllog builders.
 type CallLog func(*sql.Selector)
 
@@ -15,23 +18,89 @@ type CallParticipant func(*sql.Selector)
 // Contact is the predicate function for contact builders.
 type Contact func(*sql.Selector)
 
+// Credential is the predicate function for credential builders.
+type Credential func(*sql.Selector)
+
+// Device is the predicate function for device builders.
+type Device func(*sql.Selector)
+
+// EmailToken is the predicate function for emailtoken builders.
+type EmailToken func(*sql.Selector)
+
 // Favourite is the predicate function for favourite builders.
 type Favourite func(*sql.Selector)
 
+// Identity is the predicate function for identity builders.
+type Identity func(*sql.Selector)
+
+// IdentityKey is the predicate function for identitykey builders.
+type IdentityKey func(*sql.Selector)
+
+// IdentityKeyRecord is the predicate function for identitykeyrecord builders.
+type IdentityKeyRecord func(*sql.Selector)
+
+// KeyBackupKey is the predicate function for keybackupkey builders.
+type KeyBackupKey func(*sql.Selector)
+
+// KeyBackupVersion is the predicate function for keybackupversion builders.
+type KeyBackupVersion func(*sql.Selector)
+
+// KeyLogEntry is the predicate function for keylogentry builders.
+type KeyLogEntry func(*sql.Selector)
+
+// KeyLogHead is the predicate function for keyloghead builders.
+type KeyLogHead func(*sql.Selector)
+
+// KeyLogNode is the predicate function for keylognode builders.
+type KeyLogNode func(*sql.Selector)
+
+// KeyPackage is the predicate function for keypackage builders.
+type KeyPackage func(*sql.Selector)
+
+// LoginThrottle is the predicate function for loginthrottle builders.
+type LoginThrottle func(*sql.Selector)
+
 // Media is the predicate function for media builders.
 type Media func(*sql.Selector)
 
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// MessageEnvelope is the predicate function for messageenvelope builders.
+type MessageEnvelope func(*sql.Selector)
+
+// MlsCommit is the predicate function for mlscommit builders.
+type MlsCommit func(*sql.Selector)
+
+// MlsWelcome is the predicate function for mlswelcome builders.
+type MlsWelcome func(*sql.Selector)
+
 // Notification is the predicate function for notification builders.
 type Notification func(*sql.Selector)
 
+// OneTimePreKey is the predicate function for onetimeprekey builders.
+type OneTimePreKey func(*sql.Selector)
+
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
 // Room is the predicate function for room builders.
 type Room func(*sql.Selector)
 
 // RoomMembership is the predicate function for roommembership builders.
 type RoomMembership func(*sql.Selector)
 
+// SenderKeyDistribution is the predicate function for senderkeydistribution builders.
+type SenderKeyDistribution func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// SignedPreKey is the predicate function for signedprekey builders.
+type SignedPreKey func(*sql.Selector)
+
+// TotpSecret is the predicate function for totpsecret builders.
+type TotpSecret func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/encryption"
 )
 
 // Room is the model entity for the Room schema.
@@ -26,6 +28,24 @@ type Room struct {
 	IsPrivate bool `json:"is_private,omitempty"`
 	// IsDirect holds the value of the "is_direct" field.
 	IsDirect bool `json:"is_direct,omitempty"`
+	// MlsGroupID holds the value of the "mls_group_id" field.
+	MlsGroupID *string `json:"mls_group_id,omitempty"`
+	// MlsEpoch holds the value of the "mls_epoch" field.
+	MlsEpoch int `json:"mls_epoch,omitempty"`
+	// MlsPendingRemovals holds the value of the "mls_pending_removals" field.
+	MlsPendingRemovals []int `json:"mls_pending_removals,omitempty"`
+	// SenderKeysRotatedAt holds the value of the "sender_keys_rotated_at" field.
+	SenderKeysRotatedAt *time.Time `json:"sender_keys_rotated_at,omitempty"`
+	// EncryptionPolicy holds the value of the "encryption_policy" field.
+	EncryptionPolicy encryption.Policy `json:"encryption_policy,omitempty"`
+	// MessageTTL holds the value of the "message_ttl" field.
+	MessageTTL *int `json:"message_ttl,omitempty"`
+	// SealedSender holds the value of the "sealed_sender" field.
+	SealedSender bool `json:"sealed_sender,omitempty"`
+	// DeliveryToken holds the value of the "delivery_token" field.
+	DeliveryToken *string `json:"-"`
+	// MessageSeq holds the value of the "message_seq" field.
+	MessageSeq int `json:"message_seq,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -49,9 +69,15 @@ type RoomEdges struct {
 	Favourites []*Favourite `json:"favourites,omitempty"`
 	// CallLogs holds the value of the call_logs edge.
 	CallLogs []*CallLog `json:"call_logs,omitempty"`
+	// MlsCommits holds the value of the mls_commits edge.
+	MlsCommits []*MlsCommit `json:"mls_commits,omitempty"`
+	// MlsWelcomes holds the value of the mls_welcomes edge.
+	MlsWelcomes []*MlsWelcome `json:"mls_welcomes,omitempty"`
+	// SenderKeyDistributions holds the value of the sender_key_distributions edge.
+	SenderKeyDistributions []*SenderKeyDistribution `json:"sender_key_distributions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [8]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -101,18 +127,47 @@ func (e RoomEdges) CallLogsOrErr() ([]*CallLog, error) {
 	return nil, &NotLoadedError{edge: "call_logs"}
 }
 
+// MlsCommitsOrErr returns the MlsCommits value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoomEdges) MlsCommitsOrErr() ([]*MlsCommit, error) {
+	if e.loadedTypes[5] {
+		return e.MlsCommits, nil
+	}
+	return nil, &NotLoadedError{edge: "mls_commits"}
+}
+
+// MlsWelcomesOrErr returns the MlsWelcomes value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoomEdges) MlsWelcomesOrErr() ([]*MlsWelcome, error) {
+	if e.loadedTypes[6] {
+		return e.MlsWelcomes, nil
+	}
+	return nil, &NotLoadedError{edge: "mls_welcomes"}
+}
+
+// SenderKeyDistributionsOrErr returns the SenderKeyDistributions value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoomEdges) SenderKeyDistributionsOrErr() ([]*SenderKeyDistribution, error) {
+	if e.loadedTypes[7] {
+		return e.SenderKeyDistributions, nil
+	}
+	return nil, &NotLoadedError{edge: "sender_key_distributions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Room) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case room.FieldIsPrivate, room.FieldIsDirect:
+		case room.FieldMlsPendingRemovals, room.FieldEncryptionPolicy:
+			values[i] = new([]byte)
+		case room.FieldIsPrivate, room.FieldIsDirect, room.FieldSealedSender:
 			values[i] = new(sql.NullBool)
-		case room.FieldID:
+		case room.FieldID, room.FieldMlsEpoch, room.FieldMessageTTL, room.FieldMessageSeq:
 			values[i] = new(sql.NullInt64)
-		case room.FieldName, room.FieldDescription:
+		case room.FieldName, room.FieldDescription, room.FieldMlsGroupID, room.FieldDeliveryToken:
 			values[i] = new(sql.NullString)
-		case room.FieldCreatedAt, room.FieldUpdatedAt:
+		case room.FieldSenderKeysRotatedAt, room.FieldCreatedAt, room.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case room.ForeignKeys[0]: // room_owner
 			values[i] = new(sql.NullInt64)
@@ -161,6 +216,68 @@ func (r *Room) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				r.IsDirect = value.Bool
 			}
+		case room.FieldMlsGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mls_group_id", values[i])
+			} else if value.Valid {
+				r.MlsGroupID = new(string)
+				*r.MlsGroupID = value.String
+			}
+		case room.FieldMlsEpoch:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mls_epoch", values[i])
+			} else if value.Valid {
+				r.MlsEpoch = int(value.Int64)
+			}
+		case room.FieldMlsPendingRemovals:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mls_pending_removals", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.MlsPendingRemovals); err != nil {
+					return fmt.Errorf("unmarshal field mls_pending_removals: %w", err)
+				}
+			}
+		case room.FieldSenderKeysRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_keys_rotated_at", values[i])
+			} else if value.Valid {
+				r.SenderKeysRotatedAt = new(time.Time)
+				*r.SenderKeysRotatedAt = value.Time
+			}
+		case room.FieldEncryptionPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field encryption_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.EncryptionPolicy); err != nil {
+					return fmt.Errorf("unmarshal field encryption_policy: %w", err)
+				}
+			}
+		case room.FieldMessageTTL:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_ttl", values[i])
+			} else if value.Valid {
+				r.MessageTTL = new(int)
+				*r.MessageTTL = int(value.Int64)
+			}
+		case room.FieldSealedSender:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sealed_sender", values[i])
+			} else if value.Valid {
+				r.SealedSender = value.Bool
+			}
+		case room.FieldDeliveryToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_token", values[i])
+			} else if value.Valid {
+				r.DeliveryToken = new(string)
+				*r.DeliveryToken = value.String
+			}
+		case room.FieldMessageSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_seq", values[i])
+			} else if value.Valid {
+				r.MessageSeq = int(value.Int64)
+			}
 		case room.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -218,6 +335,21 @@ func (r *Room) QueryCallLogs() *CallLogQuery {
 	return NewRoomClient(r.config).QueryCallLogs(r)
 }
 
+// QueryMlsCommits queries the "mls_commits" edge of the Room entity.
+func (r *Room) QueryMlsCommits() *MlsCommitQuery {
+	return NewRoomClient(r.config).QueryMlsCommits(r)
+}
+
+// QueryMlsWelcomes queries the "mls_welcomes" edge of the Room entity.
+func (r *Room) QueryMlsWelcomes() *MlsWelcomeQuery {
+	return NewRoomClient(r.config).QueryMlsWelcomes(r)
+}
+
+// QuerySenderKeyDistributions queries the "sender_key_distributions" edge of the Room entity.
+func (r *Room) QuerySenderKeyDistributions() *SenderKeyDistributionQuery {
+	return NewRoomClient(r.config).QuerySenderKeyDistributions(r)
+}
+
 // Update returns a builder for updating this Room.
 // Note that you need to call Room.Unwrap() before calling this method if this Room
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -253,6 +385,38 @@ func (r *Room) String() string {
 	builder.WriteString("is_direct=")
 	builder.WriteString(fmt.Sprintf("%v", r.IsDirect))
 	builder.WriteString(", ")
+	if v := r.MlsGroupID; v != nil {
+		builder.WriteString("mls_group_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("mls_epoch=")
+	builder.WriteString(fmt.Sprintf("%v", r.MlsEpoch))
+	builder.WriteString(", ")
+	builder.WriteString("mls_pending_removals=")
+	builder.WriteString(fmt.Sprintf("%v", r.MlsPendingRemovals))
+	builder.WriteString(", ")
+	if v := r.SenderKeysRotatedAt; v != nil {
+		builder.WriteString("sender_keys_rotated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("encryption_policy=")
+	builder.WriteString(fmt.Sprintf("%v", r.EncryptionPolicy))
+	builder.WriteString(", ")
+	if v := r.MessageTTL; v != nil {
+		builder.WriteString("message_ttl=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sealed_sender=")
+	builder.WriteString(fmt.Sprintf("%v", r.SealedSender))
+	builder.WriteString(", ")
+	builder.WriteString("delivery_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("message_seq=")
+	builder.WriteString(fmt.Sprintf("%v", r.MessageSeq))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
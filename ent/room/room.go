@@ -22,6 +22,24 @@ const (
 	FieldIsPrivate = "is_private"
 	// FieldIsDirect holds the string denoting the is_direct field in the database.
 	FieldIsDirect = "is_direct"
+	// FieldMlsGroupID holds the string denoting the mls_group_id field in the database.
+	FieldMlsGroupID = "mls_group_id"
+	// FieldMlsEpoch holds the string denoting the mls_epoch field in the database.
+	FieldMlsEpoch = "mls_epoch"
+	// FieldMlsPendingRemovals holds the string denoting the mls_pending_removals field in the database.
+	FieldMlsPendingRemovals = "mls_pending_removals"
+	// FieldSenderKeysRotatedAt holds the string denoting the sender_keys_rotated_at field in the database.
+	FieldSenderKeysRotatedAt = "sender_keys_rotated_at"
+	// FieldEncryptionPolicy holds the string denoting the encryption_policy field in the database.
+	FieldEncryptionPolicy = "encryption_policy"
+	// FieldMessageTTL holds the string denoting the message_ttl field in the database.
+	FieldMessageTTL = "message_ttl"
+	// FieldSealedSender holds the string denoting the sealed_sender field in the database.
+	FieldSealedSender = "sealed_sender"
+	// FieldDeliveryToken holds the string denoting the delivery_token field in the database.
+	FieldDeliveryToken = "delivery_token"
+	// FieldMessageSeq holds the string denoting the message_seq field in the database.
+	FieldMessageSeq = "message_seq"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -36,6 +54,12 @@ const (
 	EdgeFavourites = "favourites"
 	// EdgeCallLogs holds the string denoting the call_logs edge name in mutations.
 	EdgeCallLogs = "call_logs"
+	// EdgeMlsCommits holds the string denoting the mls_commits edge name in mutations.
+	EdgeMlsCommits = "mls_commits"
+	// EdgeMlsWelcomes holds the string denoting the mls_welcomes edge name in mutations.
+	EdgeMlsWelcomes = "mls_welcomes"
+	// EdgeSenderKeyDistributions holds the string denoting the sender_key_distributions edge name in mutations.
+	EdgeSenderKeyDistributions = "sender_key_distributions"
 	// Table holds the table name of the room in the database.
 	Table = "rooms"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -73,6 +97,27 @@ const (
 	CallLogsInverseTable = "call_logs"
 	// CallLogsColumn is the table column denoting the call_logs relation/edge.
 	CallLogsColumn = "call_log_room"
+	// MlsCommitsTable is the table that holds the mls_commits relation/edge.
+	MlsCommitsTable = "mls_commits"
+	// MlsCommitsInverseTable is the table name for the MlsCommit entity.
+	// It exists in this package in order to avoid circular dependency with the "mlscommit" package.
+	MlsCommitsInverseTable = "mls_commits"
+	// MlsCommitsColumn is the table column denoting the mls_commits relation/edge.
+	MlsCommitsColumn = "mls_commit_room"
+	// MlsWelcomesTable is the table that holds the mls_welcomes relation/edge.
+	MlsWelcomesTable = "mls_welcomes"
+	// MlsWelcomesInverseTable is the table name for the MlsWelcome entity.
+	// It exists in this package in order to avoid circular dependency with the "mlswelcome" package.
+	MlsWelcomesInverseTable = "mls_welcomes"
+	// MlsWelcomesColumn is the table column denoting the mls_welcomes relation/edge.
+	MlsWelcomesColumn = "mls_welcome_room"
+	// SenderKeyDistributionsTable is the table that holds the sender_key_distributions relation/edge.
+	SenderKeyDistributionsTable = "sender_key_distributions"
+	// SenderKeyDistributionsInverseTable is the table name for the SenderKeyDistribution entity.
+	// It exists in this package in order to avoid circular dependency with the "senderkeydistribution" package.
+	SenderKeyDistributionsInverseTable = "sender_key_distributions"
+	// SenderKeyDistributionsColumn is the table column denoting the sender_key_distributions relation/edge.
+	SenderKeyDistributionsColumn = "sender_key_distribution_room"
 )
 
 // Columns holds all SQL columns for room fields.
@@ -82,6 +127,15 @@ var Columns = []string{
 	FieldDescription,
 	FieldIsPrivate,
 	FieldIsDirect,
+	FieldMlsGroupID,
+	FieldMlsEpoch,
+	FieldMlsPendingRemovals,
+	FieldSenderKeysRotatedAt,
+	FieldEncryptionPolicy,
+	FieldMessageTTL,
+	FieldSealedSender,
+	FieldDeliveryToken,
+	FieldMessageSeq,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -116,6 +170,18 @@ var (
 	DefaultIsPrivate bool
 	// DefaultIsDirect holds the default value on creation for the "is_direct" field.
 	DefaultIsDirect bool
+	// DefaultMlsEpoch holds the default value on creation for the "mls_epoch" field.
+	DefaultMlsEpoch int
+	// MlsEpochValidator is a validator for the "mls_epoch" field. It is called by the builders before save.
+	MlsEpochValidator func(int) error
+	// MessageTTLValidator is a validator for the "message_ttl" field. It is called by the builders before save.
+	MessageTTLValidator func(int) error
+	// DefaultSealedSender holds the default value on creation for the "sealed_sender" field.
+	DefaultSealedSender bool
+	// DefaultMessageSeq holds the default value on creation for the "message_seq" field.
+	DefaultMessageSeq int
+	// MessageSeqValidator is a validator for the "message_seq" field. It is called by the builders before save.
+	MessageSeqValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -152,6 +218,41 @@ func ByIsDirect(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsDirect, opts...).ToFunc()
 }
 
+// ByMlsGroupID orders the results by the mls_group_id field.
+func ByMlsGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMlsGroupID, opts...).ToFunc()
+}
+
+// ByMlsEpoch orders the results by the mls_epoch field.
+func ByMlsEpoch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMlsEpoch, opts...).ToFunc()
+}
+
+// BySenderKeysRotatedAt orders the results by the sender_keys_rotated_at field.
+func BySenderKeysRotatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderKeysRotatedAt, opts...).ToFunc()
+}
+
+// ByMessageTTL orders the results by the message_ttl field.
+func ByMessageTTL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageTTL, opts...).ToFunc()
+}
+
+// BySealedSender orders the results by the sealed_sender field.
+func BySealedSender(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSealedSender, opts...).ToFunc()
+}
+
+// ByDeliveryToken orders the results by the delivery_token field.
+func ByDeliveryToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryToken, opts...).ToFunc()
+}
+
+// ByMessageSeq orders the results by the message_seq field.
+func ByMessageSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageSeq, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -224,6 +325,48 @@ func ByCallLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCallLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByMlsCommitsCount orders the results by mls_commits count.
+func ByMlsCommitsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMlsCommitsStep(), opts...)
+	}
+}
+
+// ByMlsCommits orders the results by mls_commits terms.
+func ByMlsCommits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMlsCommitsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMlsWelcomesCount orders the results by mls_welcomes count.
+func ByMlsWelcomesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMlsWelcomesStep(), opts...)
+	}
+}
+
+// ByMlsWelcomes orders the results by mls_welcomes terms.
+func ByMlsWelcomes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMlsWelcomesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySenderKeyDistributionsCount orders the results by sender_key_distributions count.
+func BySenderKeyDistributionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSenderKeyDistributionsStep(), opts...)
+	}
+}
+
+// BySenderKeyDistributions orders the results by sender_key_distributions terms.
+func BySenderKeyDistributions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSenderKeyDistributionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -259,3 +402,24 @@ func newCallLogsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, CallLogsTable, CallLogsColumn),
 	)
 }
+func newMlsCommitsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MlsCommitsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MlsCommitsTable, MlsCommitsColumn),
+	)
+}
+func newMlsWelcomesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MlsWelcomesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MlsWelcomesTable, MlsWelcomesColumn),
+	)
+}
+func newSenderKeyDistributionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SenderKeyDistributionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SenderKeyDistributionsTable, SenderKeyDistributionsColumn),
+	)
+}
@@ -75,6 +75,41 @@ func IsDirect(v bool) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldIsDirect, v))
 }
 
+// MlsGroupID applies equality check predicate on the "mls_group_id" field. It's identical to MlsGroupIDEQ.
+func MlsGroupID(v string) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldMlsGroupID, v))
+}
+
+// MlsEpoch applies equality check predicate on the "mls_epoch" field. It's identical to MlsEpochEQ.
+func MlsEpoch(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldMlsEpoch, v))
+}
+
+// SenderKeysRotatedAt applies equality check predicate on the "sender_keys_rotated_at" field. It's identical to SenderKeysRotatedAtEQ.
+func SenderKeysRotatedAt(v time.Time) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldSenderKeysRotatedAt, v))
+}
+
+// MessageTTL applies equality check predicate on the "message_ttl" field. It's identical to MessageTTLEQ.
+func MessageTTL(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldMessageTTL, v))
+}
+
+// SealedSender applies equality check predicate on the "sealed_sender" field. It's identical to SealedSenderEQ.
+func SealedSender(v bool) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldSealedSender, v))
+}
+
+// DeliveryToken applies equality check predicate on the "delivery_token" field. It's identical to DeliveryTokenEQ.
+func DeliveryToken(v string) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldDeliveryToken, v))
+}
+
+// MessageSeq applies equality check predicate on the "message_seq" field. It's identical to MessageSeqEQ.
+func MessageSeq(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldMessageSeq, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldCreatedAt, v))
@@ -235,6 +270,366 @@ func IsDirectNEQ(v bool) predicate.Room {
 	return predicate.Room(sql.FieldNEQ(FieldIsDirect, v))
 }
 
+// MlsGroupIDEQ applies the EQ predicate on the "mls_group_id" field.
+func MlsGroupIDEQ(v string) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldMlsGroupID, v))
+}
+
+// MlsGroupIDNEQ applies the NEQ predicate on the "mls_group_id" field.
+func MlsGroupIDNEQ(v string) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldMlsGroupID, v))
+}
+
+// MlsGroupIDIn applies the In predicate on the "mls_group_id" field.
+func MlsGroupIDIn(vs ...string) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldMlsGroupID, vs...))
+}
+
+// MlsGroupIDNotIn applies the NotIn predicate on the "mls_group_id" field.
+func MlsGroupIDNotIn(vs ...string) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldMlsGroupID, vs...))
+}
+
+// MlsGroupIDGT applies the GT predicate on the "mls_group_id" field.
+func MlsGroupIDGT(v string) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldMlsGroupID, v))
+}
+
+// MlsGroupIDGTE applies the GTE predicate on the "mls_group_id" field.
+func MlsGroupIDGTE(v string) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldMlsGroupID, v))
+}
+
+// MlsGroupIDLT applies the LT predicate on the "mls_group_id" field.
+func MlsGroupIDLT(v string) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldMlsGroupID, v))
+}
+
+// MlsGroupIDLTE applies the LTE predicate on the "mls_group_id" field.
+func MlsGroupIDLTE(v string) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldMlsGroupID, v))
+}
+
+// MlsGroupIDContains applies the Contains predicate on the "mls_group_id" field.
+func MlsGroupIDContains(v string) predicate.Room {
+	return predicate.Room(sql.FieldContains(FieldMlsGroupID, v))
+}
+
+// MlsGroupIDHasPrefix applies the HasPrefix predicate on the "mls_group_id" field.
+func MlsGroupIDHasPrefix(v string) predicate.Room {
+	return predicate.Room(sql.FieldHasPrefix(FieldMlsGroupID, v))
+}
+
+// MlsGroupIDHasSuffix applies the HasSuffix predicate on the "mls_group_id" field.
+func MlsGroupIDHasSuffix(v string) predicate.Room {
+	return predicate.Room(sql.FieldHasSuffix(FieldMlsGroupID, v))
+}
+
+// MlsGroupIDIsNil applies the IsNil predicate on the "mls_group_id" field.
+func MlsGroupIDIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldMlsGroupID))
+}
+
+// MlsGroupIDNotNil applies the NotNil predicate on the "mls_group_id" field.
+func MlsGroupIDNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldMlsGroupID))
+}
+
+// MlsGroupIDEqualFold applies the EqualFold predicate on the "mls_group_id" field.
+func MlsGroupIDEqualFold(v string) predicate.Room {
+	return predicate.Room(sql.FieldEqualFold(FieldMlsGroupID, v))
+}
+
+// MlsGroupIDContainsFold applies the ContainsFold predicate on the "mls_group_id" field.
+func MlsGroupIDContainsFold(v string) predicate.Room {
+	return predicate.Room(sql.FieldContainsFold(FieldMlsGroupID, v))
+}
+
+// MlsEpochEQ applies the EQ predicate on the "mls_epoch" field.
+func MlsEpochEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldMlsEpoch, v))
+}
+
+// MlsEpochNEQ applies the NEQ predicate on the "mls_epoch" field.
+func MlsEpochNEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldMlsEpoch, v))
+}
+
+// MlsEpochIn applies the In predicate on the "mls_epoch" field.
+func MlsEpochIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldMlsEpoch, vs...))
+}
+
+// MlsEpochNotIn applies the NotIn predicate on the "mls_epoch" field.
+func MlsEpochNotIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldMlsEpoch, vs...))
+}
+
+// MlsEpochGT applies the GT predicate on the "mls_epoch" field.
+func MlsEpochGT(v int) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldMlsEpoch, v))
+}
+
+// MlsEpochGTE applies the GTE predicate on the "mls_epoch" field.
+func MlsEpochGTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldMlsEpoch, v))
+}
+
+// MlsEpochLT applies the LT predicate on the "mls_epoch" field.
+func MlsEpochLT(v int) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldMlsEpoch, v))
+}
+
+// MlsEpochLTE applies the LTE predicate on the "mls_epoch" field.
+func MlsEpochLTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldMlsEpoch, v))
+}
+
+// MlsPendingRemovalsIsNil applies the IsNil predicate on the "mls_pending_removals" field.
+func MlsPendingRemovalsIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldMlsPendingRemovals))
+}
+
+// MlsPendingRemovalsNotNil applies the NotNil predicate on the "mls_pending_removals" field.
+func MlsPendingRemovalsNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldMlsPendingRemovals))
+}
+
+// SenderKeysRotatedAtEQ applies the EQ predicate on the "sender_keys_rotated_at" field.
+func SenderKeysRotatedAtEQ(v time.Time) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldSenderKeysRotatedAt, v))
+}
+
+// SenderKeysRotatedAtNEQ applies the NEQ predicate on the "sender_keys_rotated_at" field.
+func SenderKeysRotatedAtNEQ(v time.Time) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldSenderKeysRotatedAt, v))
+}
+
+// SenderKeysRotatedAtIn applies the In predicate on the "sender_keys_rotated_at" field.
+func SenderKeysRotatedAtIn(vs ...time.Time) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldSenderKeysRotatedAt, vs...))
+}
+
+// SenderKeysRotatedAtNotIn applies the NotIn predicate on the "sender_keys_rotated_at" field.
+func SenderKeysRotatedAtNotIn(vs ...time.Time) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldSenderKeysRotatedAt, vs...))
+}
+
+// SenderKeysRotatedAtGT applies the GT predicate on the "sender_keys_rotated_at" field.
+func SenderKeysRotatedAtGT(v time.Time) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldSenderKeysRotatedAt, v))
+}
+
+// SenderKeysRotatedAtGTE applies the GTE predicate on the "sender_keys_rotated_at" field.
+func SenderKeysRotatedAtGTE(v time.Time) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldSenderKeysRotatedAt, v))
+}
+
+// SenderKeysRotatedAtLT applies the LT predicate on the "sender_keys_rotated_at" field.
+func SenderKeysRotatedAtLT(v time.Time) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldSenderKeysRotatedAt, v))
+}
+
+// SenderKeysRotatedAtLTE applies the LTE predicate on the "sender_keys_rotated_at" field.
+func SenderKeysRotatedAtLTE(v time.Time) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldSenderKeysRotatedAt, v))
+}
+
+// SenderKeysRotatedAtIsNil applies the IsNil predicate on the "sender_keys_rotated_at" field.
+func SenderKeysRotatedAtIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldSenderKeysRotatedAt))
+}
+
+// SenderKeysRotatedAtNotNil applies the NotNil predicate on the "sender_keys_rotated_at" field.
+func SenderKeysRotatedAtNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldSenderKeysRotatedAt))
+}
+
+// EncryptionPolicyIsNil applies the IsNil predicate on the "encryption_policy" field.
+func EncryptionPolicyIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldEncryptionPolicy))
+}
+
+// EncryptionPolicyNotNil applies the NotNil predicate on the "encryption_policy" field.
+func EncryptionPolicyNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldEncryptionPolicy))
+}
+
+// MessageTTLEQ applies the EQ predicate on the "message_ttl" field.
+func MessageTTLEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldMessageTTL, v))
+}
+
+// MessageTTLNEQ applies the NEQ predicate on the "message_ttl" field.
+func MessageTTLNEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldMessageTTL, v))
+}
+
+// MessageTTLIn applies the In predicate on the "message_ttl" field.
+func MessageTTLIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldMessageTTL, vs...))
+}
+
+// MessageTTLNotIn applies the NotIn predicate on the "message_ttl" field.
+func MessageTTLNotIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldMessageTTL, vs...))
+}
+
+// MessageTTLGT applies the GT predicate on the "message_ttl" field.
+func MessageTTLGT(v int) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldMessageTTL, v))
+}
+
+// MessageTTLGTE applies the GTE predicate on the "message_ttl" field.
+func MessageTTLGTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldMessageTTL, v))
+}
+
+// MessageTTLLT applies the LT predicate on the "message_ttl" field.
+func MessageTTLLT(v int) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldMessageTTL, v))
+}
+
+// MessageTTLLTE applies the LTE predicate on the "message_ttl" field.
+func MessageTTLLTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldMessageTTL, v))
+}
+
+// MessageTTLIsNil applies the IsNil predicate on the "message_ttl" field.
+func MessageTTLIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldMessageTTL))
+}
+
+// MessageTTLNotNil applies the NotNil predicate on the "message_ttl" field.
+func MessageTTLNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldMessageTTL))
+}
+
+// SealedSenderEQ applies the EQ predicate on the "sealed_sender" field.
+func SealedSenderEQ(v bool) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldSealedSender, v))
+}
+
+// SealedSenderNEQ applies the NEQ predicate on the "sealed_sender" field.
+func SealedSenderNEQ(v bool) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldSealedSender, v))
+}
+
+// DeliveryTokenEQ applies the EQ predicate on the "delivery_token" field.
+func DeliveryTokenEQ(v string) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldDeliveryToken, v))
+}
+
+// DeliveryTokenNEQ applies the NEQ predicate on the "delivery_token" field.
+func DeliveryTokenNEQ(v string) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldDeliveryToken, v))
+}
+
+// DeliveryTokenIn applies the In predicate on the "delivery_token" field.
+func DeliveryTokenIn(vs ...string) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldDeliveryToken, vs...))
+}
+
+// DeliveryTokenNotIn applies the NotIn predicate on the "delivery_token" field.
+func DeliveryTokenNotIn(vs ...string) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldDeliveryToken, vs...))
+}
+
+// DeliveryTokenGT applies the GT predicate on the "delivery_token" field.
+func DeliveryTokenGT(v string) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldDeliveryToken, v))
+}
+
+// DeliveryTokenGTE applies the GTE predicate on the "delivery_token" field.
+func DeliveryTokenGTE(v string) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldDeliveryToken, v))
+}
+
+// DeliveryTokenLT applies the LT predicate on the "delivery_token" field.
+func DeliveryTokenLT(v string) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldDeliveryToken, v))
+}
+
+// DeliveryTokenLTE applies the LTE predicate on the "delivery_token" field.
+func DeliveryTokenLTE(v string) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldDeliveryToken, v))
+}
+
+// DeliveryTokenContains applies the Contains predicate on the "delivery_token" field.
+func DeliveryTokenContains(v string) predicate.Room {
+	return predicate.Room(sql.FieldContains(FieldDeliveryToken, v))
+}
+
+// DeliveryTokenHasPrefix applies the HasPrefix predicate on the "delivery_token" field.
+func DeliveryTokenHasPrefix(v string) predicate.Room {
+	return predicate.Room(sql.FieldHasPrefix(FieldDeliveryToken, v))
+}
+
+// DeliveryTokenHasSuffix applies the HasSuffix predicate on the "delivery_token" field.
+func DeliveryTokenHasSuffix(v string) predicate.Room {
+	return predicate.Room(sql.FieldHasSuffix(FieldDeliveryToken, v))
+}
+
+// DeliveryTokenIsNil applies the IsNil predicate on the "delivery_token" field.
+func DeliveryTokenIsNil() predicate.Room {
+	return predicate.Room(sql.FieldIsNull(FieldDeliveryToken))
+}
+
+// DeliveryTokenNotNil applies the NotNil predicate on the "delivery_token" field.
+func DeliveryTokenNotNil() predicate.Room {
+	return predicate.Room(sql.FieldNotNull(FieldDeliveryToken))
+}
+
+// DeliveryTokenEqualFold applies the EqualFold predicate on the "delivery_token" field.
+func DeliveryTokenEqualFold(v string) predicate.Room {
+	return predicate.Room(sql.FieldEqualFold(FieldDeliveryToken, v))
+}
+
+// DeliveryTokenContainsFold applies the ContainsFold predicate on the "delivery_token" field.
+func DeliveryTokenContainsFold(v string) predicate.Room {
+	return predicate.Room(sql.FieldContainsFold(FieldDeliveryToken, v))
+}
+
+// MessageSeqEQ applies the EQ predicate on the "message_seq" field.
+func MessageSeqEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldEQ(FieldMessageSeq, v))
+}
+
+// MessageSeqNEQ applies the NEQ predicate on the "message_seq" field.
+func MessageSeqNEQ(v int) predicate.Room {
+	return predicate.Room(sql.FieldNEQ(FieldMessageSeq, v))
+}
+
+// MessageSeqIn applies the In predicate on the "message_seq" field.
+func MessageSeqIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldIn(FieldMessageSeq, vs...))
+}
+
+// MessageSeqNotIn applies the NotIn predicate on the "message_seq" field.
+func MessageSeqNotIn(vs ...int) predicate.Room {
+	return predicate.Room(sql.FieldNotIn(FieldMessageSeq, vs...))
+}
+
+// MessageSeqGT applies the GT predicate on the "message_seq" field.
+func MessageSeqGT(v int) predicate.Room {
+	return predicate.Room(sql.FieldGT(FieldMessageSeq, v))
+}
+
+// MessageSeqGTE applies the GTE predicate on the "message_seq" field.
+func MessageSeqGTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldGTE(FieldMessageSeq, v))
+}
+
+// MessageSeqLT applies the LT predicate on the "message_seq" field.
+func MessageSeqLT(v int) predicate.Room {
+	return predicate.Room(sql.FieldLT(FieldMessageSeq, v))
+}
+
+// MessageSeqLTE applies the LTE predicate on the "message_seq" field.
+func MessageSeqLTE(v int) predicate.Room {
+	return predicate.Room(sql.FieldLTE(FieldMessageSeq, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Room {
 	return predicate.Room(sql.FieldEQ(FieldCreatedAt, v))
@@ -430,6 +825,75 @@ func HasCallLogsWith(preds ...predicate.CallLog) predicate.Room {
 	})
 }
 
+// HasMlsCommits applies the HasEdge predicate on the "mls_commits" edge.
+func HasMlsCommits() predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MlsCommitsTable, MlsCommitsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMlsCommitsWith applies the HasEdge predicate on the "mls_commits" edge with a given conditions (other predicates).
+func HasMlsCommitsWith(preds ...predicate.MlsCommit) predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := newMlsCommitsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMlsWelcomes applies the HasEdge predicate on the "mls_welcomes" edge.
+func HasMlsWelcomes() predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MlsWelcomesTable, MlsWelcomesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMlsWelcomesWith applies the HasEdge predicate on the "mls_welcomes" edge with a given conditions (other predicates).
+func HasMlsWelcomesWith(preds ...predicate.MlsWelcome) predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := newMlsWelcomesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSenderKeyDistributions applies the HasEdge predicate on the "sender_key_distributions" edge.
+func HasSenderKeyDistributions() predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SenderKeyDistributionsTable, SenderKeyDistributionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderKeyDistributionsWith applies the HasEdge predicate on the "sender_key_distributions" edge with a given conditions (other predicates).
+func HasSenderKeyDistributionsWith(preds ...predicate.SenderKeyDistribution) predicate.Room {
+	return predicate.Room(func(s *sql.Selector) {
+		step := newSenderKeyDistributionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Room) predicate.Room {
 	return predicate.Room(sql.AndPredicates(predicates...))
@@ -13,9 +13,13 @@ import (
 	"github.com/eleven-am/enclave/ent/calllog"
 	"github.com/eleven-am/enclave/ent/favourite"
 	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
 	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/encryption"
 )
 
 // RoomCreate is the builder for creating a Room entity.
@@ -73,6 +77,116 @@ func (rc *RoomCreate) SetNillableIsDirect(b *bool) *RoomCreate {
 	return rc
 }
 
+// SetMlsGroupID sets the "mls_group_id" field.
+func (rc *RoomCreate) SetMlsGroupID(s string) *RoomCreate {
+	rc.mutation.SetMlsGroupID(s)
+	return rc
+}
+
+// SetNillableMlsGroupID sets the "mls_group_id" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableMlsGroupID(s *string) *RoomCreate {
+	if s != nil {
+		rc.SetMlsGroupID(*s)
+	}
+	return rc
+}
+
+// SetMlsEpoch sets the "mls_epoch" field.
+func (rc *RoomCreate) SetMlsEpoch(i int) *RoomCreate {
+	rc.mutation.SetMlsEpoch(i)
+	return rc
+}
+
+// SetNillableMlsEpoch sets the "mls_epoch" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableMlsEpoch(i *int) *RoomCreate {
+	if i != nil {
+		rc.SetMlsEpoch(*i)
+	}
+	return rc
+}
+
+// SetMlsPendingRemovals sets the "mls_pending_removals" field.
+func (rc *RoomCreate) SetMlsPendingRemovals(i []int) *RoomCreate {
+	rc.mutation.SetMlsPendingRemovals(i)
+	return rc
+}
+
+// SetSenderKeysRotatedAt sets the "sender_keys_rotated_at" field.
+func (rc *RoomCreate) SetSenderKeysRotatedAt(t time.Time) *RoomCreate {
+	rc.mutation.SetSenderKeysRotatedAt(t)
+	return rc
+}
+
+// SetNillableSenderKeysRotatedAt sets the "sender_keys_rotated_at" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableSenderKeysRotatedAt(t *time.Time) *RoomCreate {
+	if t != nil {
+		rc.SetSenderKeysRotatedAt(*t)
+	}
+	return rc
+}
+
+// SetEncryptionPolicy sets the "encryption_policy" field.
+func (rc *RoomCreate) SetEncryptionPolicy(e encryption.Policy) *RoomCreate {
+	rc.mutation.SetEncryptionPolicy(e)
+	return rc
+}
+
+// SetMessageTTL sets the "message_ttl" field.
+func (rc *RoomCreate) SetMessageTTL(i int) *RoomCreate {
+	rc.mutation.SetMessageTTL(i)
+	return rc
+}
+
+// SetNillableMessageTTL sets the "message_ttl" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableMessageTTL(i *int) *RoomCreate {
+	if i != nil {
+		rc.SetMessageTTL(*i)
+	}
+	return rc
+}
+
+// SetSealedSender sets the "sealed_sender" field.
+func (rc *RoomCreate) SetSealedSender(b bool) *RoomCreate {
+	rc.mutation.SetSealedSender(b)
+	return rc
+}
+
+// SetNillableSealedSender sets the "sealed_sender" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableSealedSender(b *bool) *RoomCreate {
+	if b != nil {
+		rc.SetSealedSender(*b)
+	}
+	return rc
+}
+
+// SetDeliveryToken sets the "delivery_token" field.
+func (rc *RoomCreate) SetDeliveryToken(s string) *RoomCreate {
+	rc.mutation.SetDeliveryToken(s)
+	return rc
+}
+
+// SetNillableDeliveryToken sets the "delivery_token" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableDeliveryToken(s *string) *RoomCreate {
+	if s != nil {
+		rc.SetDeliveryToken(*s)
+	}
+	return rc
+}
+
+// SetMessageSeq sets the "message_seq" field.
+func (rc *RoomCreate) SetMessageSeq(i int) *RoomCreate {
+	rc.mutation.SetMessageSeq(i)
+	return rc
+}
+
+// SetNillableMessageSeq sets the "message_seq" field if the given value is not nil.
+func (rc *RoomCreate) SetNillableMessageSeq(i *int) *RoomCreate {
+	if i != nil {
+		rc.SetMessageSeq(*i)
+	}
+	return rc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (rc *RoomCreate) SetCreatedAt(t time.Time) *RoomCreate {
 	rc.mutation.SetCreatedAt(t)
@@ -172,6 +286,51 @@ func (rc *RoomCreate) AddCallLogs(c ...*CallLog) *RoomCreate {
 	return rc.AddCallLogIDs(ids...)
 }
 
+// AddMlsCommitIDs adds the "mls_commits" edge to the MlsCommit entity by IDs.
+func (rc *RoomCreate) AddMlsCommitIDs(ids ...int) *RoomCreate {
+	rc.mutation.AddMlsCommitIDs(ids...)
+	return rc
+}
+
+// AddMlsCommits adds the "mls_commits" edges to the MlsCommit entity.
+func (rc *RoomCreate) AddMlsCommits(m ...*MlsCommit) *RoomCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return rc.AddMlsCommitIDs(ids...)
+}
+
+// AddMlsWelcomeIDs adds the "mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (rc *RoomCreate) AddMlsWelcomeIDs(ids ...int) *RoomCreate {
+	rc.mutation.AddMlsWelcomeIDs(ids...)
+	return rc
+}
+
+// AddMlsWelcomes adds the "mls_welcomes" edges to the MlsWelcome entity.
+func (rc *RoomCreate) AddMlsWelcomes(m ...*MlsWelcome) *RoomCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return rc.AddMlsWelcomeIDs(ids...)
+}
+
+// AddSenderKeyDistributionIDs adds the "sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (rc *RoomCreate) AddSenderKeyDistributionIDs(ids ...int) *RoomCreate {
+	rc.mutation.AddSenderKeyDistributionIDs(ids...)
+	return rc
+}
+
+// AddSenderKeyDistributions adds the "sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (rc *RoomCreate) AddSenderKeyDistributions(s ...*SenderKeyDistribution) *RoomCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rc.AddSenderKeyDistributionIDs(ids...)
+}
+
 // Mutation returns the RoomMutation object of the builder.
 func (rc *RoomCreate) Mutation() *RoomMutation {
 	return rc.mutation
@@ -219,6 +378,18 @@ func (rc *RoomCreate) defaults() {
 		v := room.DefaultIsDirect
 		rc.mutation.SetIsDirect(v)
 	}
+	if _, ok := rc.mutation.MlsEpoch(); !ok {
+		v := room.DefaultMlsEpoch
+		rc.mutation.SetMlsEpoch(v)
+	}
+	if _, ok := rc.mutation.SealedSender(); !ok {
+		v := room.DefaultSealedSender
+		rc.mutation.SetSealedSender(v)
+	}
+	if _, ok := rc.mutation.MessageSeq(); !ok {
+		v := room.DefaultMessageSeq
+		rc.mutation.SetMessageSeq(v)
+	}
 	if _, ok := rc.mutation.CreatedAt(); !ok {
 		v := room.DefaultCreatedAt()
 		rc.mutation.SetCreatedAt(v)
@@ -248,6 +419,35 @@ func (rc *RoomCreate) check() error {
 	if _, ok := rc.mutation.IsDirect(); !ok {
 		return &ValidationError{Name: "is_direct", err: errors.New(`ent: missing required field "Room.is_direct"`)}
 	}
+	if _, ok := rc.mutation.MlsEpoch(); !ok {
+		return &ValidationError{Name: "mls_epoch", err: errors.New(`ent: missing required field "Room.mls_epoch"`)}
+	}
+	if v, ok := rc.mutation.MlsEpoch(); ok {
+		if err := room.MlsEpochValidator(v); err != nil {
+			return &ValidationError{Name: "mls_epoch", err: fmt.Errorf(`ent: validator failed for field "Room.mls_epoch": %w`, err)}
+		}
+	}
+	if v, ok := rc.mutation.EncryptionPolicy(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "encryption_policy", err: fmt.Errorf(`ent: validator failed for field "Room.encryption_policy": %w`, err)}
+		}
+	}
+	if v, ok := rc.mutation.MessageTTL(); ok {
+		if err := room.MessageTTLValidator(v); err != nil {
+			return &ValidationError{Name: "message_ttl", err: fmt.Errorf(`ent: validator failed for field "Room.message_ttl": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.SealedSender(); !ok {
+		return &ValidationError{Name: "sealed_sender", err: errors.New(`ent: missing required field "Room.sealed_sender"`)}
+	}
+	if _, ok := rc.mutation.MessageSeq(); !ok {
+		return &ValidationError{Name: "message_seq", err: errors.New(`ent: missing required field "Room.message_seq"`)}
+	}
+	if v, ok := rc.mutation.MessageSeq(); ok {
+		if err := room.MessageSeqValidator(v); err != nil {
+			return &ValidationError{Name: "message_seq", err: fmt.Errorf(`ent: validator failed for field "Room.message_seq": %w`, err)}
+		}
+	}
 	if _, ok := rc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Room.created_at"`)}
 	}
@@ -299,6 +499,42 @@ func (rc *RoomCreate) createSpec() (*Room, *sqlgraph.CreateSpec) {
 		_spec.SetField(room.FieldIsDirect, field.TypeBool, value)
 		_node.IsDirect = value
 	}
+	if value, ok := rc.mutation.MlsGroupID(); ok {
+		_spec.SetField(room.FieldMlsGroupID, field.TypeString, value)
+		_node.MlsGroupID = &value
+	}
+	if value, ok := rc.mutation.MlsEpoch(); ok {
+		_spec.SetField(room.FieldMlsEpoch, field.TypeInt, value)
+		_node.MlsEpoch = value
+	}
+	if value, ok := rc.mutation.MlsPendingRemovals(); ok {
+		_spec.SetField(room.FieldMlsPendingRemovals, field.TypeJSON, value)
+		_node.MlsPendingRemovals = value
+	}
+	if value, ok := rc.mutation.SenderKeysRotatedAt(); ok {
+		_spec.SetField(room.FieldSenderKeysRotatedAt, field.TypeTime, value)
+		_node.SenderKeysRotatedAt = &value
+	}
+	if value, ok := rc.mutation.EncryptionPolicy(); ok {
+		_spec.SetField(room.FieldEncryptionPolicy, field.TypeJSON, value)
+		_node.EncryptionPolicy = value
+	}
+	if value, ok := rc.mutation.MessageTTL(); ok {
+		_spec.SetField(room.FieldMessageTTL, field.TypeInt, value)
+		_node.MessageTTL = &value
+	}
+	if value, ok := rc.mutation.SealedSender(); ok {
+		_spec.SetField(room.FieldSealedSender, field.TypeBool, value)
+		_node.SealedSender = value
+	}
+	if value, ok := rc.mutation.DeliveryToken(); ok {
+		_spec.SetField(room.FieldDeliveryToken, field.TypeString, value)
+		_node.DeliveryToken = &value
+	}
+	if value, ok := rc.mutation.MessageSeq(); ok {
+		_spec.SetField(room.FieldMessageSeq, field.TypeInt, value)
+		_node.MessageSeq = value
+	}
 	if value, ok := rc.mutation.CreatedAt(); ok {
 		_spec.SetField(room.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -388,6 +624,54 @@ func (rc *RoomCreate) createSpec() (*Room, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.MlsCommitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsCommitsTable,
+			Columns: []string{room.MlsCommitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.MlsWelcomesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsWelcomesTable,
+			Columns: []string{room.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.SenderKeyDistributionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.SenderKeyDistributionsTable,
+			Columns: []string{room.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,25 +14,31 @@ import (
 	"github.com/eleven-am/enclave/ent/calllog"
 	"github.com/eleven-am/enclave/ent/favourite"
 	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
 	"github.com/eleven-am/enclave/ent/predicate"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
 	"github.com/eleven-am/enclave/ent/user"
 )
 
 // RoomQuery is the builder for querying Room entities.
 type RoomQuery struct {
 	config
-	ctx             *QueryContext
-	order           []room.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Room
-	withOwner       *UserQuery
-	withMemberships *RoomMembershipQuery
-	withMessages    *MessageQuery
-	withFavourites  *FavouriteQuery
-	withCallLogs    *CallLogQuery
-	withFKs         bool
+	ctx                        *QueryContext
+	order                      []room.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.Room
+	withOwner                  *UserQuery
+	withMemberships            *RoomMembershipQuery
+	withMessages               *MessageQuery
+	withFavourites             *FavouriteQuery
+	withCallLogs               *CallLogQuery
+	withMlsCommits             *MlsCommitQuery
+	withMlsWelcomes            *MlsWelcomeQuery
+	withSenderKeyDistributions *SenderKeyDistributionQuery
+	withFKs                    bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -179,6 +185,72 @@ func (rq *RoomQuery) QueryCallLogs() *CallLogQuery {
 	return query
 }
 
+// QueryMlsCommits chains the current query on the "mls_commits" edge.
+func (rq *RoomQuery) QueryMlsCommits() *MlsCommitQuery {
+	query := (&MlsCommitClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, selector),
+			sqlgraph.To(mlscommit.Table, mlscommit.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, room.MlsCommitsTable, room.MlsCommitsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMlsWelcomes chains the current query on the "mls_welcomes" edge.
+func (rq *RoomQuery) QueryMlsWelcomes() *MlsWelcomeQuery {
+	query := (&MlsWelcomeClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, selector),
+			sqlgraph.To(mlswelcome.Table, mlswelcome.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, room.MlsWelcomesTable, room.MlsWelcomesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySenderKeyDistributions chains the current query on the "sender_key_distributions" edge.
+func (rq *RoomQuery) QuerySenderKeyDistributions() *SenderKeyDistributionQuery {
+	query := (&SenderKeyDistributionClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(room.Table, room.FieldID, selector),
+			sqlgraph.To(senderkeydistribution.Table, senderkeydistribution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, room.SenderKeyDistributionsTable, room.SenderKeyDistributionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Room entity from the query.
 // Returns a *NotFoundError when no Room was found.
 func (rq *RoomQuery) First(ctx context.Context) (*Room, error) {
@@ -366,16 +438,19 @@ func (rq *RoomQuery) Clone() *RoomQuery {
 		return nil
 	}
 	return &RoomQuery{
-		config:          rq.config,
-		ctx:             rq.ctx.Clone(),
-		order:           append([]room.OrderOption{}, rq.order...),
-		inters:          append([]Interceptor{}, rq.inters...),
-		predicates:      append([]predicate.Room{}, rq.predicates...),
-		withOwner:       rq.withOwner.Clone(),
-		withMemberships: rq.withMemberships.Clone(),
-		withMessages:    rq.withMessages.Clone(),
-		withFavourites:  rq.withFavourites.Clone(),
-		withCallLogs:    rq.withCallLogs.Clone(),
+		config:                     rq.config,
+		ctx:                        rq.ctx.Clone(),
+		order:                      append([]room.OrderOption{}, rq.order...),
+		inters:                     append([]Interceptor{}, rq.inters...),
+		predicates:                 append([]predicate.Room{}, rq.predicates...),
+		withOwner:                  rq.withOwner.Clone(),
+		withMemberships:            rq.withMemberships.Clone(),
+		withMessages:               rq.withMessages.Clone(),
+		withFavourites:             rq.withFavourites.Clone(),
+		withCallLogs:               rq.withCallLogs.Clone(),
+		withMlsCommits:             rq.withMlsCommits.Clone(),
+		withMlsWelcomes:            rq.withMlsWelcomes.Clone(),
+		withSenderKeyDistributions: rq.withSenderKeyDistributions.Clone(),
 		// clone intermediate query.
 		sql:  rq.sql.Clone(),
 		path: rq.path,
@@ -437,6 +512,39 @@ func (rq *RoomQuery) WithCallLogs(opts ...func(*CallLogQuery)) *RoomQuery {
 	return rq
 }
 
+// WithMlsCommits tells the query-builder to eager-load the nodes that are connected to
+// the "mls_commits" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoomQuery) WithMlsCommits(opts ...func(*MlsCommitQuery)) *RoomQuery {
+	query := (&MlsCommitClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withMlsCommits = query
+	return rq
+}
+
+// WithMlsWelcomes tells the query-builder to eager-load the nodes that are connected to
+// the "mls_welcomes" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoomQuery) WithMlsWelcomes(opts ...func(*MlsWelcomeQuery)) *RoomQuery {
+	query := (&MlsWelcomeClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withMlsWelcomes = query
+	return rq
+}
+
+// WithSenderKeyDistributions tells the query-builder to eager-load the nodes that are connected to
+// the "sender_key_distributions" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoomQuery) WithSenderKeyDistributions(opts ...func(*SenderKeyDistributionQuery)) *RoomQuery {
+	query := (&SenderKeyDistributionClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withSenderKeyDistributions = query
+	return rq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -516,12 +624,15 @@ func (rq *RoomQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Room, e
 		nodes       = []*Room{}
 		withFKs     = rq.withFKs
 		_spec       = rq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [8]bool{
 			rq.withOwner != nil,
 			rq.withMemberships != nil,
 			rq.withMessages != nil,
 			rq.withFavourites != nil,
 			rq.withCallLogs != nil,
+			rq.withMlsCommits != nil,
+			rq.withMlsWelcomes != nil,
+			rq.withSenderKeyDistributions != nil,
 		}
 	)
 	if rq.withOwner != nil {
@@ -582,6 +693,29 @@ func (rq *RoomQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Room, e
 			return nil, err
 		}
 	}
+	if query := rq.withMlsCommits; query != nil {
+		if err := rq.loadMlsCommits(ctx, query, nodes,
+			func(n *Room) { n.Edges.MlsCommits = []*MlsCommit{} },
+			func(n *Room, e *MlsCommit) { n.Edges.MlsCommits = append(n.Edges.MlsCommits, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withMlsWelcomes; query != nil {
+		if err := rq.loadMlsWelcomes(ctx, query, nodes,
+			func(n *Room) { n.Edges.MlsWelcomes = []*MlsWelcome{} },
+			func(n *Room, e *MlsWelcome) { n.Edges.MlsWelcomes = append(n.Edges.MlsWelcomes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withSenderKeyDistributions; query != nil {
+		if err := rq.loadSenderKeyDistributions(ctx, query, nodes,
+			func(n *Room) { n.Edges.SenderKeyDistributions = []*SenderKeyDistribution{} },
+			func(n *Room, e *SenderKeyDistribution) {
+				n.Edges.SenderKeyDistributions = append(n.Edges.SenderKeyDistributions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -741,6 +875,99 @@ func (rq *RoomQuery) loadCallLogs(ctx context.Context, query *CallLogQuery, node
 	}
 	return nil
 }
+func (rq *RoomQuery) loadMlsCommits(ctx context.Context, query *MlsCommitQuery, nodes []*Room, init func(*Room), assign func(*Room, *MlsCommit)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Room)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MlsCommit(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(room.MlsCommitsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.mls_commit_room
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "mls_commit_room" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "mls_commit_room" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *RoomQuery) loadMlsWelcomes(ctx context.Context, query *MlsWelcomeQuery, nodes []*Room, init func(*Room), assign func(*Room, *MlsWelcome)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Room)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MlsWelcome(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(room.MlsWelcomesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.mls_welcome_room
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "mls_welcome_room" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "mls_welcome_room" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *RoomQuery) loadSenderKeyDistributions(ctx context.Context, query *SenderKeyDistributionQuery, nodes []*Room, init func(*Room), assign func(*Room, *SenderKeyDistribution)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Room)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SenderKeyDistribution(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(room.SenderKeyDistributionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_key_distribution_room
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_key_distribution_room" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_key_distribution_room" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (rq *RoomQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rq.querySpec()
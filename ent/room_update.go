@@ -10,14 +10,19 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/eleven-am/enclave/ent/calllog"
 	"github.com/eleven-am/enclave/ent/favourite"
 	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
 	"github.com/eleven-am/enclave/ent/predicate"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
 	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/encryption"
 )
 
 // RoomUpdate is the builder for updating Room entities.
@@ -89,6 +94,185 @@ func (ru *RoomUpdate) SetNillableIsDirect(b *bool) *RoomUpdate {
 	return ru
 }
 
+// SetMlsGroupID sets the "mls_group_id" field.
+func (ru *RoomUpdate) SetMlsGroupID(s string) *RoomUpdate {
+	ru.mutation.SetMlsGroupID(s)
+	return ru
+}
+
+// SetNillableMlsGroupID sets the "mls_group_id" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableMlsGroupID(s *string) *RoomUpdate {
+	if s != nil {
+		ru.SetMlsGroupID(*s)
+	}
+	return ru
+}
+
+// ClearMlsGroupID clears the value of the "mls_group_id" field.
+func (ru *RoomUpdate) ClearMlsGroupID() *RoomUpdate {
+	ru.mutation.ClearMlsGroupID()
+	return ru
+}
+
+// SetMlsEpoch sets the "mls_epoch" field.
+func (ru *RoomUpdate) SetMlsEpoch(i int) *RoomUpdate {
+	ru.mutation.ResetMlsEpoch()
+	ru.mutation.SetMlsEpoch(i)
+	return ru
+}
+
+// SetNillableMlsEpoch sets the "mls_epoch" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableMlsEpoch(i *int) *RoomUpdate {
+	if i != nil {
+		ru.SetMlsEpoch(*i)
+	}
+	return ru
+}
+
+// AddMlsEpoch adds i to the "mls_epoch" field.
+func (ru *RoomUpdate) AddMlsEpoch(i int) *RoomUpdate {
+	ru.mutation.AddMlsEpoch(i)
+	return ru
+}
+
+// SetMlsPendingRemovals sets the "mls_pending_removals" field.
+func (ru *RoomUpdate) SetMlsPendingRemovals(i []int) *RoomUpdate {
+	ru.mutation.SetMlsPendingRemovals(i)
+	return ru
+}
+
+// AppendMlsPendingRemovals appends i to the "mls_pending_removals" field.
+func (ru *RoomUpdate) AppendMlsPendingRemovals(i []int) *RoomUpdate {
+	ru.mutation.AppendMlsPendingRemovals(i)
+	return ru
+}
+
+// ClearMlsPendingRemovals clears the value of the "mls_pending_removals" field.
+func (ru *RoomUpdate) ClearMlsPendingRemovals() *RoomUpdate {
+	ru.mutation.ClearMlsPendingRemovals()
+	return ru
+}
+
+// SetSenderKeysRotatedAt sets the "sender_keys_rotated_at" field.
+func (ru *RoomUpdate) SetSenderKeysRotatedAt(t time.Time) *RoomUpdate {
+	ru.mutation.SetSenderKeysRotatedAt(t)
+	return ru
+}
+
+// SetNillableSenderKeysRotatedAt sets the "sender_keys_rotated_at" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableSenderKeysRotatedAt(t *time.Time) *RoomUpdate {
+	if t != nil {
+		ru.SetSenderKeysRotatedAt(*t)
+	}
+	return ru
+}
+
+// ClearSenderKeysRotatedAt clears the value of the "sender_keys_rotated_at" field.
+func (ru *RoomUpdate) ClearSenderKeysRotatedAt() *RoomUpdate {
+	ru.mutation.ClearSenderKeysRotatedAt()
+	return ru
+}
+
+// SetEncryptionPolicy sets the "encryption_policy" field.
+func (ru *RoomUpdate) SetEncryptionPolicy(e encryption.Policy) *RoomUpdate {
+	ru.mutation.SetEncryptionPolicy(e)
+	return ru
+}
+
+// AppendEncryptionPolicy appends e to the "encryption_policy" field.
+func (ru *RoomUpdate) AppendEncryptionPolicy(e encryption.Policy) *RoomUpdate {
+	ru.mutation.AppendEncryptionPolicy(e)
+	return ru
+}
+
+// ClearEncryptionPolicy clears the value of the "encryption_policy" field.
+func (ru *RoomUpdate) ClearEncryptionPolicy() *RoomUpdate {
+	ru.mutation.ClearEncryptionPolicy()
+	return ru
+}
+
+// SetMessageTTL sets the "message_ttl" field.
+func (ru *RoomUpdate) SetMessageTTL(i int) *RoomUpdate {
+	ru.mutation.ResetMessageTTL()
+	ru.mutation.SetMessageTTL(i)
+	return ru
+}
+
+// SetNillableMessageTTL sets the "message_ttl" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableMessageTTL(i *int) *RoomUpdate {
+	if i != nil {
+		ru.SetMessageTTL(*i)
+	}
+	return ru
+}
+
+// AddMessageTTL adds i to the "message_ttl" field.
+func (ru *RoomUpdate) AddMessageTTL(i int) *RoomUpdate {
+	ru.mutation.AddMessageTTL(i)
+	return ru
+}
+
+// ClearMessageTTL clears the value of the "message_ttl" field.
+func (ru *RoomUpdate) ClearMessageTTL() *RoomUpdate {
+	ru.mutation.ClearMessageTTL()
+	return ru
+}
+
+// SetSealedSender sets the "sealed_sender" field.
+func (ru *RoomUpdate) SetSealedSender(b bool) *RoomUpdate {
+	ru.mutation.SetSealedSender(b)
+	return ru
+}
+
+// SetNillableSealedSender sets the "sealed_sender" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableSealedSender(b *bool) *RoomUpdate {
+	if b != nil {
+		ru.SetSealedSender(*b)
+	}
+	return ru
+}
+
+// SetDeliveryToken sets the "delivery_token" field.
+func (ru *RoomUpdate) SetDeliveryToken(s string) *RoomUpdate {
+	ru.mutation.SetDeliveryToken(s)
+	return ru
+}
+
+// SetNillableDeliveryToken sets the "delivery_token" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableDeliveryToken(s *string) *RoomUpdate {
+	if s != nil {
+		ru.SetDeliveryToken(*s)
+	}
+	return ru
+}
+
+// ClearDeliveryToken clears the value of the "delivery_token" field.
+func (ru *RoomUpdate) ClearDeliveryToken() *RoomUpdate {
+	ru.mutation.ClearDeliveryToken()
+	return ru
+}
+
+// SetMessageSeq sets the "message_seq" field.
+func (ru *RoomUpdate) SetMessageSeq(i int) *RoomUpdate {
+	ru.mutation.ResetMessageSeq()
+	ru.mutation.SetMessageSeq(i)
+	return ru
+}
+
+// SetNillableMessageSeq sets the "message_seq" field if the given value is not nil.
+func (ru *RoomUpdate) SetNillableMessageSeq(i *int) *RoomUpdate {
+	if i != nil {
+		ru.SetMessageSeq(*i)
+	}
+	return ru
+}
+
+// AddMessageSeq adds i to the "message_seq" field.
+func (ru *RoomUpdate) AddMessageSeq(i int) *RoomUpdate {
+	ru.mutation.AddMessageSeq(i)
+	return ru
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ru *RoomUpdate) SetCreatedAt(t time.Time) *RoomUpdate {
 	ru.mutation.SetCreatedAt(t)
@@ -180,6 +364,51 @@ func (ru *RoomUpdate) AddCallLogs(c ...*CallLog) *RoomUpdate {
 	return ru.AddCallLogIDs(ids...)
 }
 
+// AddMlsCommitIDs adds the "mls_commits" edge to the MlsCommit entity by IDs.
+func (ru *RoomUpdate) AddMlsCommitIDs(ids ...int) *RoomUpdate {
+	ru.mutation.AddMlsCommitIDs(ids...)
+	return ru
+}
+
+// AddMlsCommits adds the "mls_commits" edges to the MlsCommit entity.
+func (ru *RoomUpdate) AddMlsCommits(m ...*MlsCommit) *RoomUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ru.AddMlsCommitIDs(ids...)
+}
+
+// AddMlsWelcomeIDs adds the "mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (ru *RoomUpdate) AddMlsWelcomeIDs(ids ...int) *RoomUpdate {
+	ru.mutation.AddMlsWelcomeIDs(ids...)
+	return ru
+}
+
+// AddMlsWelcomes adds the "mls_welcomes" edges to the MlsWelcome entity.
+func (ru *RoomUpdate) AddMlsWelcomes(m ...*MlsWelcome) *RoomUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ru.AddMlsWelcomeIDs(ids...)
+}
+
+// AddSenderKeyDistributionIDs adds the "sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (ru *RoomUpdate) AddSenderKeyDistributionIDs(ids ...int) *RoomUpdate {
+	ru.mutation.AddSenderKeyDistributionIDs(ids...)
+	return ru
+}
+
+// AddSenderKeyDistributions adds the "sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (ru *RoomUpdate) AddSenderKeyDistributions(s ...*SenderKeyDistribution) *RoomUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ru.AddSenderKeyDistributionIDs(ids...)
+}
+
 // Mutation returns the RoomMutation object of the builder.
 func (ru *RoomUpdate) Mutation() *RoomMutation {
 	return ru.mutation
@@ -275,6 +504,69 @@ func (ru *RoomUpdate) RemoveCallLogs(c ...*CallLog) *RoomUpdate {
 	return ru.RemoveCallLogIDs(ids...)
 }
 
+// ClearMlsCommits clears all "mls_commits" edges to the MlsCommit entity.
+func (ru *RoomUpdate) ClearMlsCommits() *RoomUpdate {
+	ru.mutation.ClearMlsCommits()
+	return ru
+}
+
+// RemoveMlsCommitIDs removes the "mls_commits" edge to MlsCommit entities by IDs.
+func (ru *RoomUpdate) RemoveMlsCommitIDs(ids ...int) *RoomUpdate {
+	ru.mutation.RemoveMlsCommitIDs(ids...)
+	return ru
+}
+
+// RemoveMlsCommits removes "mls_commits" edges to MlsCommit entities.
+func (ru *RoomUpdate) RemoveMlsCommits(m ...*MlsCommit) *RoomUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ru.RemoveMlsCommitIDs(ids...)
+}
+
+// ClearMlsWelcomes clears all "mls_welcomes" edges to the MlsWelcome entity.
+func (ru *RoomUpdate) ClearMlsWelcomes() *RoomUpdate {
+	ru.mutation.ClearMlsWelcomes()
+	return ru
+}
+
+// RemoveMlsWelcomeIDs removes the "mls_welcomes" edge to MlsWelcome entities by IDs.
+func (ru *RoomUpdate) RemoveMlsWelcomeIDs(ids ...int) *RoomUpdate {
+	ru.mutation.RemoveMlsWelcomeIDs(ids...)
+	return ru
+}
+
+// RemoveMlsWelcomes removes "mls_welcomes" edges to MlsWelcome entities.
+func (ru *RoomUpdate) RemoveMlsWelcomes(m ...*MlsWelcome) *RoomUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ru.RemoveMlsWelcomeIDs(ids...)
+}
+
+// ClearSenderKeyDistributions clears all "sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (ru *RoomUpdate) ClearSenderKeyDistributions() *RoomUpdate {
+	ru.mutation.ClearSenderKeyDistributions()
+	return ru
+}
+
+// RemoveSenderKeyDistributionIDs removes the "sender_key_distributions" edge to SenderKeyDistribution entities by IDs.
+func (ru *RoomUpdate) RemoveSenderKeyDistributionIDs(ids ...int) *RoomUpdate {
+	ru.mutation.RemoveSenderKeyDistributionIDs(ids...)
+	return ru
+}
+
+// RemoveSenderKeyDistributions removes "sender_key_distributions" edges to SenderKeyDistribution entities.
+func (ru *RoomUpdate) RemoveSenderKeyDistributions(s ...*SenderKeyDistribution) *RoomUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ru.RemoveSenderKeyDistributionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *RoomUpdate) Save(ctx context.Context) (int, error) {
 	ru.defaults()
@@ -318,6 +610,26 @@ func (ru *RoomUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Room.name": %w`, err)}
 		}
 	}
+	if v, ok := ru.mutation.MlsEpoch(); ok {
+		if err := room.MlsEpochValidator(v); err != nil {
+			return &ValidationError{Name: "mls_epoch", err: fmt.Errorf(`ent: validator failed for field "Room.mls_epoch": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.EncryptionPolicy(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "encryption_policy", err: fmt.Errorf(`ent: validator failed for field "Room.encryption_policy": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.MessageTTL(); ok {
+		if err := room.MessageTTLValidator(v); err != nil {
+			return &ValidationError{Name: "message_ttl", err: fmt.Errorf(`ent: validator failed for field "Room.message_ttl": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.MessageSeq(); ok {
+		if err := room.MessageSeqValidator(v); err != nil {
+			return &ValidationError{Name: "message_seq", err: fmt.Errorf(`ent: validator failed for field "Room.message_seq": %w`, err)}
+		}
+	}
 	if _, ok := ru.mutation.OwnerID(); ru.mutation.OwnerCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Room.owner"`)
 	}
@@ -348,6 +660,70 @@ func (ru *RoomUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ru.mutation.IsDirect(); ok {
 		_spec.SetField(room.FieldIsDirect, field.TypeBool, value)
 	}
+	if value, ok := ru.mutation.MlsGroupID(); ok {
+		_spec.SetField(room.FieldMlsGroupID, field.TypeString, value)
+	}
+	if ru.mutation.MlsGroupIDCleared() {
+		_spec.ClearField(room.FieldMlsGroupID, field.TypeString)
+	}
+	if value, ok := ru.mutation.MlsEpoch(); ok {
+		_spec.SetField(room.FieldMlsEpoch, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedMlsEpoch(); ok {
+		_spec.AddField(room.FieldMlsEpoch, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.MlsPendingRemovals(); ok {
+		_spec.SetField(room.FieldMlsPendingRemovals, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedMlsPendingRemovals(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, room.FieldMlsPendingRemovals, value)
+		})
+	}
+	if ru.mutation.MlsPendingRemovalsCleared() {
+		_spec.ClearField(room.FieldMlsPendingRemovals, field.TypeJSON)
+	}
+	if value, ok := ru.mutation.SenderKeysRotatedAt(); ok {
+		_spec.SetField(room.FieldSenderKeysRotatedAt, field.TypeTime, value)
+	}
+	if ru.mutation.SenderKeysRotatedAtCleared() {
+		_spec.ClearField(room.FieldSenderKeysRotatedAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.EncryptionPolicy(); ok {
+		_spec.SetField(room.FieldEncryptionPolicy, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedEncryptionPolicy(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, room.FieldEncryptionPolicy, value)
+		})
+	}
+	if ru.mutation.EncryptionPolicyCleared() {
+		_spec.ClearField(room.FieldEncryptionPolicy, field.TypeJSON)
+	}
+	if value, ok := ru.mutation.MessageTTL(); ok {
+		_spec.SetField(room.FieldMessageTTL, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedMessageTTL(); ok {
+		_spec.AddField(room.FieldMessageTTL, field.TypeInt, value)
+	}
+	if ru.mutation.MessageTTLCleared() {
+		_spec.ClearField(room.FieldMessageTTL, field.TypeInt)
+	}
+	if value, ok := ru.mutation.SealedSender(); ok {
+		_spec.SetField(room.FieldSealedSender, field.TypeBool, value)
+	}
+	if value, ok := ru.mutation.DeliveryToken(); ok {
+		_spec.SetField(room.FieldDeliveryToken, field.TypeString, value)
+	}
+	if ru.mutation.DeliveryTokenCleared() {
+		_spec.ClearField(room.FieldDeliveryToken, field.TypeString)
+	}
+	if value, ok := ru.mutation.MessageSeq(); ok {
+		_spec.SetField(room.FieldMessageSeq, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedMessageSeq(); ok {
+		_spec.AddField(room.FieldMessageSeq, field.TypeInt, value)
+	}
 	if value, ok := ru.mutation.CreatedAt(); ok {
 		_spec.SetField(room.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -563,6 +939,141 @@ func (ru *RoomUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.MlsCommitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsCommitsTable,
+			Columns: []string{room.MlsCommitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedMlsCommitsIDs(); len(nodes) > 0 && !ru.mutation.MlsCommitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsCommitsTable,
+			Columns: []string{room.MlsCommitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.MlsCommitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsCommitsTable,
+			Columns: []string{room.MlsCommitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.MlsWelcomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsWelcomesTable,
+			Columns: []string{room.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedMlsWelcomesIDs(); len(nodes) > 0 && !ru.mutation.MlsWelcomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsWelcomesTable,
+			Columns: []string{room.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.MlsWelcomesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsWelcomesTable,
+			Columns: []string{room.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.SenderKeyDistributionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.SenderKeyDistributionsTable,
+			Columns: []string{room.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedSenderKeyDistributionsIDs(); len(nodes) > 0 && !ru.mutation.SenderKeyDistributionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.SenderKeyDistributionsTable,
+			Columns: []string{room.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.SenderKeyDistributionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.SenderKeyDistributionsTable,
+			Columns: []string{room.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{room.Label}
@@ -575,70 +1086,249 @@ func (ru *RoomUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
-// RoomUpdateOne is the builder for updating a single Room entity.
-type RoomUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *RoomMutation
+// RoomUpdateOne is the builder for updating a single Room entity.
+type RoomUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoomMutation
+}
+
+// SetName sets the "name" field.
+func (ruo *RoomUpdateOne) SetName(s string) *RoomUpdateOne {
+	ruo.mutation.SetName(s)
+	return ruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableName(s *string) *RoomUpdateOne {
+	if s != nil {
+		ruo.SetName(*s)
+	}
+	return ruo
+}
+
+// SetDescription sets the "description" field.
+func (ruo *RoomUpdateOne) SetDescription(s string) *RoomUpdateOne {
+	ruo.mutation.SetDescription(s)
+	return ruo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableDescription(s *string) *RoomUpdateOne {
+	if s != nil {
+		ruo.SetDescription(*s)
+	}
+	return ruo
+}
+
+// SetIsPrivate sets the "is_private" field.
+func (ruo *RoomUpdateOne) SetIsPrivate(b bool) *RoomUpdateOne {
+	ruo.mutation.SetIsPrivate(b)
+	return ruo
+}
+
+// SetNillableIsPrivate sets the "is_private" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableIsPrivate(b *bool) *RoomUpdateOne {
+	if b != nil {
+		ruo.SetIsPrivate(*b)
+	}
+	return ruo
+}
+
+// SetIsDirect sets the "is_direct" field.
+func (ruo *RoomUpdateOne) SetIsDirect(b bool) *RoomUpdateOne {
+	ruo.mutation.SetIsDirect(b)
+	return ruo
+}
+
+// SetNillableIsDirect sets the "is_direct" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableIsDirect(b *bool) *RoomUpdateOne {
+	if b != nil {
+		ruo.SetIsDirect(*b)
+	}
+	return ruo
+}
+
+// SetMlsGroupID sets the "mls_group_id" field.
+func (ruo *RoomUpdateOne) SetMlsGroupID(s string) *RoomUpdateOne {
+	ruo.mutation.SetMlsGroupID(s)
+	return ruo
+}
+
+// SetNillableMlsGroupID sets the "mls_group_id" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableMlsGroupID(s *string) *RoomUpdateOne {
+	if s != nil {
+		ruo.SetMlsGroupID(*s)
+	}
+	return ruo
+}
+
+// ClearMlsGroupID clears the value of the "mls_group_id" field.
+func (ruo *RoomUpdateOne) ClearMlsGroupID() *RoomUpdateOne {
+	ruo.mutation.ClearMlsGroupID()
+	return ruo
+}
+
+// SetMlsEpoch sets the "mls_epoch" field.
+func (ruo *RoomUpdateOne) SetMlsEpoch(i int) *RoomUpdateOne {
+	ruo.mutation.ResetMlsEpoch()
+	ruo.mutation.SetMlsEpoch(i)
+	return ruo
+}
+
+// SetNillableMlsEpoch sets the "mls_epoch" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableMlsEpoch(i *int) *RoomUpdateOne {
+	if i != nil {
+		ruo.SetMlsEpoch(*i)
+	}
+	return ruo
+}
+
+// AddMlsEpoch adds i to the "mls_epoch" field.
+func (ruo *RoomUpdateOne) AddMlsEpoch(i int) *RoomUpdateOne {
+	ruo.mutation.AddMlsEpoch(i)
+	return ruo
+}
+
+// SetMlsPendingRemovals sets the "mls_pending_removals" field.
+func (ruo *RoomUpdateOne) SetMlsPendingRemovals(i []int) *RoomUpdateOne {
+	ruo.mutation.SetMlsPendingRemovals(i)
+	return ruo
+}
+
+// AppendMlsPendingRemovals appends i to the "mls_pending_removals" field.
+func (ruo *RoomUpdateOne) AppendMlsPendingRemovals(i []int) *RoomUpdateOne {
+	ruo.mutation.AppendMlsPendingRemovals(i)
+	return ruo
 }
 
-// SetName sets the "name" field.
-func (ruo *RoomUpdateOne) SetName(s string) *RoomUpdateOne {
-	ruo.mutation.SetName(s)
+// ClearMlsPendingRemovals clears the value of the "mls_pending_removals" field.
+func (ruo *RoomUpdateOne) ClearMlsPendingRemovals() *RoomUpdateOne {
+	ruo.mutation.ClearMlsPendingRemovals()
 	return ruo
 }
 
-// SetNillableName sets the "name" field if the given value is not nil.
-func (ruo *RoomUpdateOne) SetNillableName(s *string) *RoomUpdateOne {
-	if s != nil {
-		ruo.SetName(*s)
+// SetSenderKeysRotatedAt sets the "sender_keys_rotated_at" field.
+func (ruo *RoomUpdateOne) SetSenderKeysRotatedAt(t time.Time) *RoomUpdateOne {
+	ruo.mutation.SetSenderKeysRotatedAt(t)
+	return ruo
+}
+
+// SetNillableSenderKeysRotatedAt sets the "sender_keys_rotated_at" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableSenderKeysRotatedAt(t *time.Time) *RoomUpdateOne {
+	if t != nil {
+		ruo.SetSenderKeysRotatedAt(*t)
 	}
 	return ruo
 }
 
-// SetDescription sets the "description" field.
-func (ruo *RoomUpdateOne) SetDescription(s string) *RoomUpdateOne {
-	ruo.mutation.SetDescription(s)
+// ClearSenderKeysRotatedAt clears the value of the "sender_keys_rotated_at" field.
+func (ruo *RoomUpdateOne) ClearSenderKeysRotatedAt() *RoomUpdateOne {
+	ruo.mutation.ClearSenderKeysRotatedAt()
 	return ruo
 }
 
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (ruo *RoomUpdateOne) SetNillableDescription(s *string) *RoomUpdateOne {
-	if s != nil {
-		ruo.SetDescription(*s)
+// SetEncryptionPolicy sets the "encryption_policy" field.
+func (ruo *RoomUpdateOne) SetEncryptionPolicy(e encryption.Policy) *RoomUpdateOne {
+	ruo.mutation.SetEncryptionPolicy(e)
+	return ruo
+}
+
+// AppendEncryptionPolicy appends e to the "encryption_policy" field.
+func (ruo *RoomUpdateOne) AppendEncryptionPolicy(e encryption.Policy) *RoomUpdateOne {
+	ruo.mutation.AppendEncryptionPolicy(e)
+	return ruo
+}
+
+// ClearEncryptionPolicy clears the value of the "encryption_policy" field.
+func (ruo *RoomUpdateOne) ClearEncryptionPolicy() *RoomUpdateOne {
+	ruo.mutation.ClearEncryptionPolicy()
+	return ruo
+}
+
+// SetMessageTTL sets the "message_ttl" field.
+func (ruo *RoomUpdateOne) SetMessageTTL(i int) *RoomUpdateOne {
+	ruo.mutation.ResetMessageTTL()
+	ruo.mutation.SetMessageTTL(i)
+	return ruo
+}
+
+// SetNillableMessageTTL sets the "message_ttl" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableMessageTTL(i *int) *RoomUpdateOne {
+	if i != nil {
+		ruo.SetMessageTTL(*i)
 	}
 	return ruo
 }
 
-// SetIsPrivate sets the "is_private" field.
-func (ruo *RoomUpdateOne) SetIsPrivate(b bool) *RoomUpdateOne {
-	ruo.mutation.SetIsPrivate(b)
+// AddMessageTTL adds i to the "message_ttl" field.
+func (ruo *RoomUpdateOne) AddMessageTTL(i int) *RoomUpdateOne {
+	ruo.mutation.AddMessageTTL(i)
 	return ruo
 }
 
-// SetNillableIsPrivate sets the "is_private" field if the given value is not nil.
-func (ruo *RoomUpdateOne) SetNillableIsPrivate(b *bool) *RoomUpdateOne {
+// ClearMessageTTL clears the value of the "message_ttl" field.
+func (ruo *RoomUpdateOne) ClearMessageTTL() *RoomUpdateOne {
+	ruo.mutation.ClearMessageTTL()
+	return ruo
+}
+
+// SetSealedSender sets the "sealed_sender" field.
+func (ruo *RoomUpdateOne) SetSealedSender(b bool) *RoomUpdateOne {
+	ruo.mutation.SetSealedSender(b)
+	return ruo
+}
+
+// SetNillableSealedSender sets the "sealed_sender" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableSealedSender(b *bool) *RoomUpdateOne {
 	if b != nil {
-		ruo.SetIsPrivate(*b)
+		ruo.SetSealedSender(*b)
 	}
 	return ruo
 }
 
-// SetIsDirect sets the "is_direct" field.
-func (ruo *RoomUpdateOne) SetIsDirect(b bool) *RoomUpdateOne {
-	ruo.mutation.SetIsDirect(b)
+// SetDeliveryToken sets the "delivery_token" field.
+func (ruo *RoomUpdateOne) SetDeliveryToken(s string) *RoomUpdateOne {
+	ruo.mutation.SetDeliveryToken(s)
 	return ruo
 }
 
-// SetNillableIsDirect sets the "is_direct" field if the given value is not nil.
-func (ruo *RoomUpdateOne) SetNillableIsDirect(b *bool) *RoomUpdateOne {
-	if b != nil {
-		ruo.SetIsDirect(*b)
+// SetNillableDeliveryToken sets the "delivery_token" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableDeliveryToken(s *string) *RoomUpdateOne {
+	if s != nil {
+		ruo.SetDeliveryToken(*s)
+	}
+	return ruo
+}
+
+// ClearDeliveryToken clears the value of the "delivery_token" field.
+func (ruo *RoomUpdateOne) ClearDeliveryToken() *RoomUpdateOne {
+	ruo.mutation.ClearDeliveryToken()
+	return ruo
+}
+
+// SetMessageSeq sets the "message_seq" field.
+func (ruo *RoomUpdateOne) SetMessageSeq(i int) *RoomUpdateOne {
+	ruo.mutation.ResetMessageSeq()
+	ruo.mutation.SetMessageSeq(i)
+	return ruo
+}
+
+// SetNillableMessageSeq sets the "message_seq" field if the given value is not nil.
+func (ruo *RoomUpdateOne) SetNillableMessageSeq(i *int) *RoomUpdateOne {
+	if i != nil {
+		ruo.SetMessageSeq(*i)
 	}
 	return ruo
 }
 
+// AddMessageSeq adds i to the "message_seq" field.
+func (ruo *RoomUpdateOne) AddMessageSeq(i int) *RoomUpdateOne {
+	ruo.mutation.AddMessageSeq(i)
+	return ruo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ruo *RoomUpdateOne) SetCreatedAt(t time.Time) *RoomUpdateOne {
 	ruo.mutation.SetCreatedAt(t)
@@ -730,6 +1420,51 @@ func (ruo *RoomUpdateOne) AddCallLogs(c ...*CallLog) *RoomUpdateOne {
 	return ruo.AddCallLogIDs(ids...)
 }
 
+// AddMlsCommitIDs adds the "mls_commits" edge to the MlsCommit entity by IDs.
+func (ruo *RoomUpdateOne) AddMlsCommitIDs(ids ...int) *RoomUpdateOne {
+	ruo.mutation.AddMlsCommitIDs(ids...)
+	return ruo
+}
+
+// AddMlsCommits adds the "mls_commits" edges to the MlsCommit entity.
+func (ruo *RoomUpdateOne) AddMlsCommits(m ...*MlsCommit) *RoomUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ruo.AddMlsCommitIDs(ids...)
+}
+
+// AddMlsWelcomeIDs adds the "mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (ruo *RoomUpdateOne) AddMlsWelcomeIDs(ids ...int) *RoomUpdateOne {
+	ruo.mutation.AddMlsWelcomeIDs(ids...)
+	return ruo
+}
+
+// AddMlsWelcomes adds the "mls_welcomes" edges to the MlsWelcome entity.
+func (ruo *RoomUpdateOne) AddMlsWelcomes(m ...*MlsWelcome) *RoomUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ruo.AddMlsWelcomeIDs(ids...)
+}
+
+// AddSenderKeyDistributionIDs adds the "sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (ruo *RoomUpdateOne) AddSenderKeyDistributionIDs(ids ...int) *RoomUpdateOne {
+	ruo.mutation.AddSenderKeyDistributionIDs(ids...)
+	return ruo
+}
+
+// AddSenderKeyDistributions adds the "sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (ruo *RoomUpdateOne) AddSenderKeyDistributions(s ...*SenderKeyDistribution) *RoomUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ruo.AddSenderKeyDistributionIDs(ids...)
+}
+
 // Mutation returns the RoomMutation object of the builder.
 func (ruo *RoomUpdateOne) Mutation() *RoomMutation {
 	return ruo.mutation
@@ -825,6 +1560,69 @@ func (ruo *RoomUpdateOne) RemoveCallLogs(c ...*CallLog) *RoomUpdateOne {
 	return ruo.RemoveCallLogIDs(ids...)
 }
 
+// ClearMlsCommits clears all "mls_commits" edges to the MlsCommit entity.
+func (ruo *RoomUpdateOne) ClearMlsCommits() *RoomUpdateOne {
+	ruo.mutation.ClearMlsCommits()
+	return ruo
+}
+
+// RemoveMlsCommitIDs removes the "mls_commits" edge to MlsCommit entities by IDs.
+func (ruo *RoomUpdateOne) RemoveMlsCommitIDs(ids ...int) *RoomUpdateOne {
+	ruo.mutation.RemoveMlsCommitIDs(ids...)
+	return ruo
+}
+
+// RemoveMlsCommits removes "mls_commits" edges to MlsCommit entities.
+func (ruo *RoomUpdateOne) RemoveMlsCommits(m ...*MlsCommit) *RoomUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ruo.RemoveMlsCommitIDs(ids...)
+}
+
+// ClearMlsWelcomes clears all "mls_welcomes" edges to the MlsWelcome entity.
+func (ruo *RoomUpdateOne) ClearMlsWelcomes() *RoomUpdateOne {
+	ruo.mutation.ClearMlsWelcomes()
+	return ruo
+}
+
+// RemoveMlsWelcomeIDs removes the "mls_welcomes" edge to MlsWelcome entities by IDs.
+func (ruo *RoomUpdateOne) RemoveMlsWelcomeIDs(ids ...int) *RoomUpdateOne {
+	ruo.mutation.RemoveMlsWelcomeIDs(ids...)
+	return ruo
+}
+
+// RemoveMlsWelcomes removes "mls_welcomes" edges to MlsWelcome entities.
+func (ruo *RoomUpdateOne) RemoveMlsWelcomes(m ...*MlsWelcome) *RoomUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ruo.RemoveMlsWelcomeIDs(ids...)
+}
+
+// ClearSenderKeyDistributions clears all "sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (ruo *RoomUpdateOne) ClearSenderKeyDistributions() *RoomUpdateOne {
+	ruo.mutation.ClearSenderKeyDistributions()
+	return ruo
+}
+
+// RemoveSenderKeyDistributionIDs removes the "sender_key_distributions" edge to SenderKeyDistribution entities by IDs.
+func (ruo *RoomUpdateOne) RemoveSenderKeyDistributionIDs(ids ...int) *RoomUpdateOne {
+	ruo.mutation.RemoveSenderKeyDistributionIDs(ids...)
+	return ruo
+}
+
+// RemoveSenderKeyDistributions removes "sender_key_distributions" edges to SenderKeyDistribution entities.
+func (ruo *RoomUpdateOne) RemoveSenderKeyDistributions(s ...*SenderKeyDistribution) *RoomUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ruo.RemoveSenderKeyDistributionIDs(ids...)
+}
+
 // Where appends a list predicates to the RoomUpdate builder.
 func (ruo *RoomUpdateOne) Where(ps ...predicate.Room) *RoomUpdateOne {
 	ruo.mutation.Where(ps...)
@@ -881,6 +1679,26 @@ func (ruo *RoomUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Room.name": %w`, err)}
 		}
 	}
+	if v, ok := ruo.mutation.MlsEpoch(); ok {
+		if err := room.MlsEpochValidator(v); err != nil {
+			return &ValidationError{Name: "mls_epoch", err: fmt.Errorf(`ent: validator failed for field "Room.mls_epoch": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.EncryptionPolicy(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "encryption_policy", err: fmt.Errorf(`ent: validator failed for field "Room.encryption_policy": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.MessageTTL(); ok {
+		if err := room.MessageTTLValidator(v); err != nil {
+			return &ValidationError{Name: "message_ttl", err: fmt.Errorf(`ent: validator failed for field "Room.message_ttl": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.MessageSeq(); ok {
+		if err := room.MessageSeqValidator(v); err != nil {
+			return &ValidationError{Name: "message_seq", err: fmt.Errorf(`ent: validator failed for field "Room.message_seq": %w`, err)}
+		}
+	}
 	if _, ok := ruo.mutation.OwnerID(); ruo.mutation.OwnerCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Room.owner"`)
 	}
@@ -928,6 +1746,70 @@ func (ruo *RoomUpdateOne) sqlSave(ctx context.Context) (_node *Room, err error)
 	if value, ok := ruo.mutation.IsDirect(); ok {
 		_spec.SetField(room.FieldIsDirect, field.TypeBool, value)
 	}
+	if value, ok := ruo.mutation.MlsGroupID(); ok {
+		_spec.SetField(room.FieldMlsGroupID, field.TypeString, value)
+	}
+	if ruo.mutation.MlsGroupIDCleared() {
+		_spec.ClearField(room.FieldMlsGroupID, field.TypeString)
+	}
+	if value, ok := ruo.mutation.MlsEpoch(); ok {
+		_spec.SetField(room.FieldMlsEpoch, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedMlsEpoch(); ok {
+		_spec.AddField(room.FieldMlsEpoch, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.MlsPendingRemovals(); ok {
+		_spec.SetField(room.FieldMlsPendingRemovals, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedMlsPendingRemovals(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, room.FieldMlsPendingRemovals, value)
+		})
+	}
+	if ruo.mutation.MlsPendingRemovalsCleared() {
+		_spec.ClearField(room.FieldMlsPendingRemovals, field.TypeJSON)
+	}
+	if value, ok := ruo.mutation.SenderKeysRotatedAt(); ok {
+		_spec.SetField(room.FieldSenderKeysRotatedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.SenderKeysRotatedAtCleared() {
+		_spec.ClearField(room.FieldSenderKeysRotatedAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.EncryptionPolicy(); ok {
+		_spec.SetField(room.FieldEncryptionPolicy, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedEncryptionPolicy(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, room.FieldEncryptionPolicy, value)
+		})
+	}
+	if ruo.mutation.EncryptionPolicyCleared() {
+		_spec.ClearField(room.FieldEncryptionPolicy, field.TypeJSON)
+	}
+	if value, ok := ruo.mutation.MessageTTL(); ok {
+		_spec.SetField(room.FieldMessageTTL, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedMessageTTL(); ok {
+		_spec.AddField(room.FieldMessageTTL, field.TypeInt, value)
+	}
+	if ruo.mutation.MessageTTLCleared() {
+		_spec.ClearField(room.FieldMessageTTL, field.TypeInt)
+	}
+	if value, ok := ruo.mutation.SealedSender(); ok {
+		_spec.SetField(room.FieldSealedSender, field.TypeBool, value)
+	}
+	if value, ok := ruo.mutation.DeliveryToken(); ok {
+		_spec.SetField(room.FieldDeliveryToken, field.TypeString, value)
+	}
+	if ruo.mutation.DeliveryTokenCleared() {
+		_spec.ClearField(room.FieldDeliveryToken, field.TypeString)
+	}
+	if value, ok := ruo.mutation.MessageSeq(); ok {
+		_spec.SetField(room.FieldMessageSeq, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedMessageSeq(); ok {
+		_spec.AddField(room.FieldMessageSeq, field.TypeInt, value)
+	}
 	if value, ok := ruo.mutation.CreatedAt(); ok {
 		_spec.SetField(room.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -1143,6 +2025,141 @@ func (ruo *RoomUpdateOne) sqlSave(ctx context.Context) (_node *Room, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.MlsCommitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsCommitsTable,
+			Columns: []string{room.MlsCommitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedMlsCommitsIDs(); len(nodes) > 0 && !ruo.mutation.MlsCommitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsCommitsTable,
+			Columns: []string{room.MlsCommitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.MlsCommitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsCommitsTable,
+			Columns: []string{room.MlsCommitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.MlsWelcomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsWelcomesTable,
+			Columns: []string{room.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedMlsWelcomesIDs(); len(nodes) > 0 && !ruo.mutation.MlsWelcomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsWelcomesTable,
+			Columns: []string{room.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.MlsWelcomesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.MlsWelcomesTable,
+			Columns: []string{room.MlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.SenderKeyDistributionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.SenderKeyDistributionsTable,
+			Columns: []string{room.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedSenderKeyDistributionsIDs(); len(nodes) > 0 && !ruo.mutation.SenderKeyDistributionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.SenderKeyDistributionsTable,
+			Columns: []string{room.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.SenderKeyDistributionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   room.SenderKeyDistributionsTable,
+			Columns: []string{room.SenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Room{config: ruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -121,7 +121,9 @@ func (rmc *RoomMembershipCreate) Mutation() *RoomMembershipMutation {
 
 // Save creates the RoomMembership in the database.
 func (rmc *RoomMembershipCreate) Save(ctx context.Context) (*RoomMembership, error) {
-	rmc.defaults()
+	if err := rmc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, rmc.sqlSave, rmc.mutation, rmc.hooks)
 }
 
@@ -148,7 +150,7 @@ func (rmc *RoomMembershipCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (rmc *RoomMembershipCreate) defaults() {
+func (rmc *RoomMembershipCreate) defaults() error {
 	if _, ok := rmc.mutation.Role(); !ok {
 		v := roommembership.DefaultRole
 		rmc.mutation.SetRole(v)
@@ -162,13 +164,20 @@ func (rmc *RoomMembershipCreate) defaults() {
 		rmc.mutation.SetCanCall(v)
 	}
 	if _, ok := rmc.mutation.JoinedAt(); !ok {
+		if roommembership.DefaultJoinedAt == nil {
+			return fmt.Errorf("ent: uninitialized roommembership.DefaultJoinedAt (forgotten import ent/runtime?)")
+		}
 		v := roommembership.DefaultJoinedAt()
 		rmc.mutation.SetJoinedAt(v)
 	}
 	if _, ok := rmc.mutation.UpdatedAt(); !ok {
+		if roommembership.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized roommembership.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := roommembership.DefaultUpdatedAt()
 		rmc.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -399,6 +400,12 @@ func (rmq *RoomMembershipQuery) prepareQuery(ctx context.Context) error {
 		}
 		rmq.sql = prev
 	}
+	if roommembership.Policy == nil {
+		return errors.New("ent: uninitialized roommembership.Policy (forgotten import ent/runtime?)")
+	}
+	if err := roommembership.Policy.EvalQuery(ctx, rmq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -133,7 +133,9 @@ func (rmu *RoomMembershipUpdate) ClearRoom() *RoomMembershipUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (rmu *RoomMembershipUpdate) Save(ctx context.Context) (int, error) {
-	rmu.defaults()
+	if err := rmu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, rmu.sqlSave, rmu.mutation, rmu.hooks)
 }
 
@@ -160,11 +162,15 @@ func (rmu *RoomMembershipUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (rmu *RoomMembershipUpdate) defaults() {
+func (rmu *RoomMembershipUpdate) defaults() error {
 	if _, ok := rmu.mutation.UpdatedAt(); !ok {
+		if roommembership.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized roommembership.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := roommembership.UpdateDefaultUpdatedAt()
 		rmu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -404,7 +410,9 @@ func (rmuo *RoomMembershipUpdateOne) Select(field string, fields ...string) *Roo
 
 // Save executes the query and returns the updated RoomMembership entity.
 func (rmuo *RoomMembershipUpdateOne) Save(ctx context.Context) (*RoomMembership, error) {
-	rmuo.defaults()
+	if err := rmuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, rmuo.sqlSave, rmuo.mutation, rmuo.hooks)
 }
 
@@ -431,11 +439,15 @@ func (rmuo *RoomMembershipUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (rmuo *RoomMembershipUpdateOne) defaults() {
+func (rmuo *RoomMembershipUpdateOne) defaults() error {
 	if _, ok := rmuo.mutation.UpdatedAt(); !ok {
+		if roommembership.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized roommembership.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := roommembership.UpdateDefaultUpdatedAt()
 		rmuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
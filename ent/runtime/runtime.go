@@ -2,9 +2,789 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/eleven-am/enclave/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/eleven-am/enclave/ent/apikey"
+	"github.com/eleven-am/enclave/ent/calllog"
+	"github.com/eleven-am/enclave/ent/callparticipant"
+	"github.com/eleven-am/enclave/ent/contact"
+	"github.com/eleven-am/enclave/ent/credential"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/emailtoken"
+	"github.com/eleven-am/enclave/ent/favourite"
+	"github.com/eleven-am/enclave/ent/identity"
+	"github.com/eleven-am/enclave/ent/identitykey"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/keylogentry"
+	"github.com/eleven-am/enclave/ent/keyloghead"
+	"github.com/eleven-am/enclave/ent/keylognode"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/loginthrottle"
+	"github.com/eleven-am/enclave/ent/media"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/notification"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+	"github.com/eleven-am/enclave/ent/recoverycode"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/schema"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+	"github.com/eleven-am/enclave/ent/totpsecret"
+	"github.com/eleven-am/enclave/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	apikeyFields := schema.ApiKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[0].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[1].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[2].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[4].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	calllog.Policy = privacy.NewPolicies(schema.CallLog{})
+	calllog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := calllog.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	calllogFields := schema.CallLog{}.Fields()
+	_ = calllogFields
+	// calllogDescStartedAt is the schema descriptor for started_at field.
+	calllogDescStartedAt := calllogFields[1].Descriptor()
+	// calllog.DefaultStartedAt holds the default value on creation for the started_at field.
+	calllog.DefaultStartedAt = calllogDescStartedAt.Default.(func() time.Time)
+	// calllogDescCreatedAt is the schema descriptor for created_at field.
+	calllogDescCreatedAt := calllogFields[3].Descriptor()
+	// calllog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	calllog.DefaultCreatedAt = calllogDescCreatedAt.Default.(func() time.Time)
+	callparticipant.Policy = privacy.NewPolicies(schema.CallParticipant{})
+	callparticipant.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := callparticipant.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	callparticipantFields := schema.CallParticipant{}.Fields()
+	_ = callparticipantFields
+	// callparticipantDescJoinedAt is the schema descriptor for joined_at field.
+	callparticipantDescJoinedAt := callparticipantFields[1].Descriptor()
+	// callparticipant.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	callparticipant.DefaultJoinedAt = callparticipantDescJoinedAt.Default.(func() time.Time)
+	contact.Policy = privacy.NewPolicies(schema.Contact{})
+	contact.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := contact.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	contactFields := schema.Contact{}.Fields()
+	_ = contactFields
+	// contactDescIsFavourite is the schema descriptor for is_favourite field.
+	contactDescIsFavourite := contactFields[0].Descriptor()
+	// contact.DefaultIsFavourite holds the default value on creation for the is_favourite field.
+	contact.DefaultIsFavourite = contactDescIsFavourite.Default.(bool)
+	// contactDescIsBlocked is the schema descriptor for is_blocked field.
+	contactDescIsBlocked := contactFields[1].Descriptor()
+	// contact.DefaultIsBlocked holds the default value on creation for the is_blocked field.
+	contact.DefaultIsBlocked = contactDescIsBlocked.Default.(bool)
+	// contactDescAlias is the schema descriptor for alias field.
+	contactDescAlias := contactFields[2].Descriptor()
+	// contact.DefaultAlias holds the default value on creation for the alias field.
+	contact.DefaultAlias = contactDescAlias.Default.(string)
+	// contactDescCreatedAt is the schema descriptor for created_at field.
+	contactDescCreatedAt := contactFields[3].Descriptor()
+	// contact.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contact.DefaultCreatedAt = contactDescCreatedAt.Default.(func() time.Time)
+	// contactDescUpdatedAt is the schema descriptor for updated_at field.
+	contactDescUpdatedAt := contactFields[4].Descriptor()
+	// contact.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	contact.DefaultUpdatedAt = contactDescUpdatedAt.Default.(func() time.Time)
+	// contact.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	contact.UpdateDefaultUpdatedAt = contactDescUpdatedAt.UpdateDefault.(func() time.Time)
+	credentialFields := schema.Credential{}.Fields()
+	_ = credentialFields
+	// credentialDescPasswordHash is the schema descriptor for password_hash field.
+	credentialDescPasswordHash := credentialFields[0].Descriptor()
+	// credential.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	credential.PasswordHashValidator = credentialDescPasswordHash.Validators[0].(func(string) error)
+	// credentialDescPasswordUpdatedAt is the schema descriptor for password_updated_at field.
+	credentialDescPasswordUpdatedAt := credentialFields[1].Descriptor()
+	// credential.DefaultPasswordUpdatedAt holds the default value on creation for the password_updated_at field.
+	credential.DefaultPasswordUpdatedAt = credentialDescPasswordUpdatedAt.Default.(func() time.Time)
+	// credentialDescCreatedAt is the schema descriptor for created_at field.
+	credentialDescCreatedAt := credentialFields[2].Descriptor()
+	// credential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credential.DefaultCreatedAt = credentialDescCreatedAt.Default.(func() time.Time)
+	// credentialDescUpdatedAt is the schema descriptor for updated_at field.
+	credentialDescUpdatedAt := credentialFields[3].Descriptor()
+	// credential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	credential.DefaultUpdatedAt = credentialDescUpdatedAt.Default.(func() time.Time)
+	// credential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	credential.UpdateDefaultUpdatedAt = credentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	deviceFields := schema.Device{}.Fields()
+	_ = deviceFields
+	// deviceDescDeviceID is the schema descriptor for device_id field.
+	deviceDescDeviceID := deviceFields[0].Descriptor()
+	// device.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	device.DeviceIDValidator = deviceDescDeviceID.Validators[0].(func(int) error)
+	// deviceDescRegistrationID is the schema descriptor for registration_id field.
+	deviceDescRegistrationID := deviceFields[1].Descriptor()
+	// device.RegistrationIDValidator is a validator for the "registration_id" field. It is called by the builders before save.
+	device.RegistrationIDValidator = deviceDescRegistrationID.Validators[0].(func(int) error)
+	// deviceDescName is the schema descriptor for name field.
+	deviceDescName := deviceFields[2].Descriptor()
+	// device.DefaultName holds the default value on creation for the name field.
+	device.DefaultName = deviceDescName.Default.(string)
+	// deviceDescCreatedAt is the schema descriptor for created_at field.
+	deviceDescCreatedAt := deviceFields[4].Descriptor()
+	// device.DefaultCreatedAt holds the default value on creation for the created_at field.
+	device.DefaultCreatedAt = deviceDescCreatedAt.Default.(func() time.Time)
+	// deviceDescUpdatedAt is the schema descriptor for updated_at field.
+	deviceDescUpdatedAt := deviceFields[5].Descriptor()
+	// device.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	device.DefaultUpdatedAt = deviceDescUpdatedAt.Default.(func() time.Time)
+	// device.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	device.UpdateDefaultUpdatedAt = deviceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	emailtokenFields := schema.EmailToken{}.Fields()
+	_ = emailtokenFields
+	// emailtokenDescTokenHash is the schema descriptor for token_hash field.
+	emailtokenDescTokenHash := emailtokenFields[1].Descriptor()
+	// emailtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	emailtoken.TokenHashValidator = emailtokenDescTokenHash.Validators[0].(func(string) error)
+	// emailtokenDescEmail is the schema descriptor for email field.
+	emailtokenDescEmail := emailtokenFields[2].Descriptor()
+	// emailtoken.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	emailtoken.EmailValidator = emailtokenDescEmail.Validators[0].(func(string) error)
+	// emailtokenDescCreatedAt is the schema descriptor for created_at field.
+	emailtokenDescCreatedAt := emailtokenFields[5].Descriptor()
+	// emailtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailtoken.DefaultCreatedAt = emailtokenDescCreatedAt.Default.(func() time.Time)
+	favouriteFields := schema.Favourite{}.Fields()
+	_ = favouriteFields
+	// favouriteDescCreatedAt is the schema descriptor for created_at field.
+	favouriteDescCreatedAt := favouriteFields[0].Descriptor()
+	// favourite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	favourite.DefaultCreatedAt = favouriteDescCreatedAt.Default.(func() time.Time)
+	identityFields := schema.Identity{}.Fields()
+	_ = identityFields
+	// identityDescIssuer is the schema descriptor for issuer field.
+	identityDescIssuer := identityFields[0].Descriptor()
+	// identity.IssuerValidator is a validator for the "issuer" field. It is called by the builders before save.
+	identity.IssuerValidator = identityDescIssuer.Validators[0].(func(string) error)
+	// identityDescSubject is the schema descriptor for subject field.
+	identityDescSubject := identityFields[1].Descriptor()
+	// identity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	identity.SubjectValidator = identityDescSubject.Validators[0].(func(string) error)
+	// identityDescEmail is the schema descriptor for email field.
+	identityDescEmail := identityFields[2].Descriptor()
+	// identity.DefaultEmail holds the default value on creation for the email field.
+	identity.DefaultEmail = identityDescEmail.Default.(string)
+	// identityDescCreatedAt is the schema descriptor for created_at field.
+	identityDescCreatedAt := identityFields[3].Descriptor()
+	// identity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identity.DefaultCreatedAt = identityDescCreatedAt.Default.(func() time.Time)
+	// identityDescLastLoginAt is the schema descriptor for last_login_at field.
+	identityDescLastLoginAt := identityFields[4].Descriptor()
+	// identity.DefaultLastLoginAt holds the default value on creation for the last_login_at field.
+	identity.DefaultLastLoginAt = identityDescLastLoginAt.Default.(func() time.Time)
+	identitykeyFields := schema.IdentityKey{}.Fields()
+	_ = identitykeyFields
+	// identitykeyDescPublicKey is the schema descriptor for public_key field.
+	identitykeyDescPublicKey := identitykeyFields[0].Descriptor()
+	// identitykey.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	identitykey.PublicKeyValidator = identitykeyDescPublicKey.Validators[0].(func(string) error)
+	// identitykeyDescCreatedAt is the schema descriptor for created_at field.
+	identitykeyDescCreatedAt := identitykeyFields[1].Descriptor()
+	// identitykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identitykey.DefaultCreatedAt = identitykeyDescCreatedAt.Default.(func() time.Time)
+	identitykeyrecordFields := schema.IdentityKeyRecord{}.Fields()
+	_ = identitykeyrecordFields
+	// identitykeyrecordDescPublicKey is the schema descriptor for public_key field.
+	identitykeyrecordDescPublicKey := identitykeyrecordFields[0].Descriptor()
+	// identitykeyrecord.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	identitykeyrecord.PublicKeyValidator = identitykeyrecordDescPublicKey.Validators[0].(func(string) error)
+	// identitykeyrecordDescDeviceNumber is the schema descriptor for device_number field.
+	identitykeyrecordDescDeviceNumber := identitykeyrecordFields[1].Descriptor()
+	// identitykeyrecord.DeviceNumberValidator is a validator for the "device_number" field. It is called by the builders before save.
+	identitykeyrecord.DeviceNumberValidator = identitykeyrecordDescDeviceNumber.Validators[0].(func(int) error)
+	// identitykeyrecordDescCreatedAt is the schema descriptor for created_at field.
+	identitykeyrecordDescCreatedAt := identitykeyrecordFields[2].Descriptor()
+	// identitykeyrecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identitykeyrecord.DefaultCreatedAt = identitykeyrecordDescCreatedAt.Default.(func() time.Time)
+	keybackupkey.Policy = privacy.NewPolicies(schema.KeyBackupKey{})
+	keybackupkey.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := keybackupkey.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	keybackupkeyFields := schema.KeyBackupKey{}.Fields()
+	_ = keybackupkeyFields
+	// keybackupkeyDescSessionID is the schema descriptor for session_id field.
+	keybackupkeyDescSessionID := keybackupkeyFields[0].Descriptor()
+	// keybackupkey.SessionIDValidator is a validator for the "session_id" field. It is called by the builders before save.
+	keybackupkey.SessionIDValidator = keybackupkeyDescSessionID.Validators[0].(func(string) error)
+	// keybackupkeyDescFirstMessageIndex is the schema descriptor for first_message_index field.
+	keybackupkeyDescFirstMessageIndex := keybackupkeyFields[1].Descriptor()
+	// keybackupkey.FirstMessageIndexValidator is a validator for the "first_message_index" field. It is called by the builders before save.
+	keybackupkey.FirstMessageIndexValidator = keybackupkeyDescFirstMessageIndex.Validators[0].(func(int) error)
+	// keybackupkeyDescForwardedCount is the schema descriptor for forwarded_count field.
+	keybackupkeyDescForwardedCount := keybackupkeyFields[2].Descriptor()
+	// keybackupkey.ForwardedCountValidator is a validator for the "forwarded_count" field. It is called by the builders before save.
+	keybackupkey.ForwardedCountValidator = keybackupkeyDescForwardedCount.Validators[0].(func(int) error)
+	// keybackupkeyDescIsVerified is the schema descriptor for is_verified field.
+	keybackupkeyDescIsVerified := keybackupkeyFields[3].Descriptor()
+	// keybackupkey.DefaultIsVerified holds the default value on creation for the is_verified field.
+	keybackupkey.DefaultIsVerified = keybackupkeyDescIsVerified.Default.(bool)
+	// keybackupkeyDescSessionData is the schema descriptor for session_data field.
+	keybackupkeyDescSessionData := keybackupkeyFields[4].Descriptor()
+	// keybackupkey.SessionDataValidator is a validator for the "session_data" field. It is called by the builders before save.
+	keybackupkey.SessionDataValidator = keybackupkeyDescSessionData.Validators[0].(func(string) error)
+	// keybackupkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	keybackupkeyDescUpdatedAt := keybackupkeyFields[5].Descriptor()
+	// keybackupkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	keybackupkey.DefaultUpdatedAt = keybackupkeyDescUpdatedAt.Default.(func() time.Time)
+	// keybackupkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	keybackupkey.UpdateDefaultUpdatedAt = keybackupkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	keybackupversion.Policy = privacy.NewPolicies(schema.KeyBackupVersion{})
+	keybackupversion.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := keybackupversion.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	keybackupversionFields := schema.KeyBackupVersion{}.Fields()
+	_ = keybackupversionFields
+	// keybackupversionDescAlgorithm is the schema descriptor for algorithm field.
+	keybackupversionDescAlgorithm := keybackupversionFields[0].Descriptor()
+	// keybackupversion.AlgorithmValidator is a validator for the "algorithm" field. It is called by the builders before save.
+	keybackupversion.AlgorithmValidator = keybackupversionDescAlgorithm.Validators[0].(func(string) error)
+	// keybackupversionDescAuthData is the schema descriptor for auth_data field.
+	keybackupversionDescAuthData := keybackupversionFields[1].Descriptor()
+	// keybackupversion.AuthDataValidator is a validator for the "auth_data" field. It is called by the builders before save.
+	keybackupversion.AuthDataValidator = keybackupversionDescAuthData.Validators[0].(func(string) error)
+	// keybackupversionDescEtag is the schema descriptor for etag field.
+	keybackupversionDescEtag := keybackupversionFields[2].Descriptor()
+	// keybackupversion.DefaultEtag holds the default value on creation for the etag field.
+	keybackupversion.DefaultEtag = keybackupversionDescEtag.Default.(int)
+	// keybackupversion.EtagValidator is a validator for the "etag" field. It is called by the builders before save.
+	keybackupversion.EtagValidator = keybackupversionDescEtag.Validators[0].(func(int) error)
+	// keybackupversionDescCreatedAt is the schema descriptor for created_at field.
+	keybackupversionDescCreatedAt := keybackupversionFields[3].Descriptor()
+	// keybackupversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	keybackupversion.DefaultCreatedAt = keybackupversionDescCreatedAt.Default.(func() time.Time)
+	// keybackupversionDescUpdatedAt is the schema descriptor for updated_at field.
+	keybackupversionDescUpdatedAt := keybackupversionFields[4].Descriptor()
+	// keybackupversion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	keybackupversion.DefaultUpdatedAt = keybackupversionDescUpdatedAt.Default.(func() time.Time)
+	// keybackupversion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	keybackupversion.UpdateDefaultUpdatedAt = keybackupversionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	keylogentryFields := schema.KeyLogEntry{}.Fields()
+	_ = keylogentryFields
+	// keylogentryDescLeafIndex is the schema descriptor for leaf_index field.
+	keylogentryDescLeafIndex := keylogentryFields[0].Descriptor()
+	// keylogentry.LeafIndexValidator is a validator for the "leaf_index" field. It is called by the builders before save.
+	keylogentry.LeafIndexValidator = keylogentryDescLeafIndex.Validators[0].(func(int) error)
+	// keylogentryDescIdentityKey is the schema descriptor for identity_key field.
+	keylogentryDescIdentityKey := keylogentryFields[3].Descriptor()
+	// keylogentry.IdentityKeyValidator is a validator for the "identity_key" field. It is called by the builders before save.
+	keylogentry.IdentityKeyValidator = keylogentryDescIdentityKey.Validators[0].(func(string) error)
+	keylogheadFields := schema.KeyLogHead{}.Fields()
+	_ = keylogheadFields
+	// keylogheadDescTreeSize is the schema descriptor for tree_size field.
+	keylogheadDescTreeSize := keylogheadFields[0].Descriptor()
+	// keyloghead.DefaultTreeSize holds the default value on creation for the tree_size field.
+	keyloghead.DefaultTreeSize = keylogheadDescTreeSize.Default.(int)
+	// keyloghead.TreeSizeValidator is a validator for the "tree_size" field. It is called by the builders before save.
+	keyloghead.TreeSizeValidator = keylogheadDescTreeSize.Validators[0].(func(int) error)
+	keylognodeFields := schema.KeyLogNode{}.Fields()
+	_ = keylognodeFields
+	// keylognodeDescLevel is the schema descriptor for level field.
+	keylognodeDescLevel := keylognodeFields[0].Descriptor()
+	// keylognode.LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	keylognode.LevelValidator = keylognodeDescLevel.Validators[0].(func(int) error)
+	// keylognodeDescPosition is the schema descriptor for position field.
+	keylognodeDescPosition := keylognodeFields[1].Descriptor()
+	// keylognode.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	keylognode.PositionValidator = keylognodeDescPosition.Validators[0].(func(int) error)
+	keypackageFields := schema.KeyPackage{}.Fields()
+	_ = keypackageFields
+	// keypackageDescKeyPackage is the schema descriptor for key_package field.
+	keypackageDescKeyPackage := keypackageFields[0].Descriptor()
+	// keypackage.KeyPackageValidator is a validator for the "key_package" field. It is called by the builders before save.
+	keypackage.KeyPackageValidator = keypackageDescKeyPackage.Validators[0].(func(string) error)
+	// keypackageDescCipherSuite is the schema descriptor for cipher_suite field.
+	keypackageDescCipherSuite := keypackageFields[1].Descriptor()
+	// keypackage.CipherSuiteValidator is a validator for the "cipher_suite" field. It is called by the builders before save.
+	keypackage.CipherSuiteValidator = keypackageDescCipherSuite.Validators[0].(func(int) error)
+	// keypackageDescLastResort is the schema descriptor for last_resort field.
+	keypackageDescLastResort := keypackageFields[2].Descriptor()
+	// keypackage.DefaultLastResort holds the default value on creation for the last_resort field.
+	keypackage.DefaultLastResort = keypackageDescLastResort.Default.(bool)
+	// keypackageDescCreatedAt is the schema descriptor for created_at field.
+	keypackageDescCreatedAt := keypackageFields[3].Descriptor()
+	// keypackage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	keypackage.DefaultCreatedAt = keypackageDescCreatedAt.Default.(func() time.Time)
+	loginthrottleFields := schema.LoginThrottle{}.Fields()
+	_ = loginthrottleFields
+	// loginthrottleDescKey is the schema descriptor for key field.
+	loginthrottleDescKey := loginthrottleFields[1].Descriptor()
+	// loginthrottle.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	loginthrottle.KeyValidator = loginthrottleDescKey.Validators[0].(func(string) error)
+	// loginthrottleDescFailures is the schema descriptor for failures field.
+	loginthrottleDescFailures := loginthrottleFields[2].Descriptor()
+	// loginthrottle.DefaultFailures holds the default value on creation for the failures field.
+	loginthrottle.DefaultFailures = loginthrottleDescFailures.Default.(int)
+	media.Policy = privacy.NewPolicies(schema.Media{})
+	media.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := media.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	mediaFields := schema.Media{}.Fields()
+	_ = mediaFields
+	// mediaDescFilename is the schema descriptor for filename field.
+	mediaDescFilename := mediaFields[0].Descriptor()
+	// media.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	media.FilenameValidator = mediaDescFilename.Validators[0].(func(string) error)
+	// mediaDescContentType is the schema descriptor for content_type field.
+	mediaDescContentType := mediaFields[1].Descriptor()
+	// media.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	media.ContentTypeValidator = mediaDescContentType.Validators[0].(func(string) error)
+	// mediaDescStoragePath is the schema descriptor for storage_path field.
+	mediaDescStoragePath := mediaFields[2].Descriptor()
+	// media.StoragePathValidator is a validator for the "storage_path" field. It is called by the builders before save.
+	media.StoragePathValidator = mediaDescStoragePath.Validators[0].(func(string) error)
+	// mediaDescChecksum is the schema descriptor for checksum field.
+	mediaDescChecksum := mediaFields[3].Descriptor()
+	// media.ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	media.ChecksumValidator = mediaDescChecksum.Validators[0].(func(string) error)
+	// mediaDescSizeBytes is the schema descriptor for size_bytes field.
+	mediaDescSizeBytes := mediaFields[4].Descriptor()
+	// media.SizeBytesValidator is a validator for the "size_bytes" field. It is called by the builders before save.
+	media.SizeBytesValidator = mediaDescSizeBytes.Validators[0].(func(int64) error)
+	// mediaDescCreatedAt is the schema descriptor for created_at field.
+	mediaDescCreatedAt := mediaFields[5].Descriptor()
+	// media.DefaultCreatedAt holds the default value on creation for the created_at field.
+	media.DefaultCreatedAt = mediaDescCreatedAt.Default.(func() time.Time)
+	message.Policy = privacy.NewPolicies(schema.Message{})
+	message.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := message.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	messageFields := schema.Message{}.Fields()
+	_ = messageFields
+	// messageDescCipherText is the schema descriptor for cipher_text field.
+	messageDescCipherText := messageFields[0].Descriptor()
+	// message.CipherTextValidator is a validator for the "cipher_text" field. It is called by the builders before save.
+	message.CipherTextValidator = messageDescCipherText.Validators[0].(func(string) error)
+	// messageDescContentType is the schema descriptor for content_type field.
+	messageDescContentType := messageFields[2].Descriptor()
+	// message.DefaultContentType holds the default value on creation for the content_type field.
+	message.DefaultContentType = messageDescContentType.Default.(string)
+	// messageDescEncryptionScheme is the schema descriptor for encryption_scheme field.
+	messageDescEncryptionScheme := messageFields[3].Descriptor()
+	// message.DefaultEncryptionScheme holds the default value on creation for the encryption_scheme field.
+	message.DefaultEncryptionScheme = messageDescEncryptionScheme.Default.(string)
+	// messageDescEdited is the schema descriptor for edited field.
+	messageDescEdited := messageFields[5].Descriptor()
+	// message.DefaultEdited holds the default value on creation for the edited field.
+	message.DefaultEdited = messageDescEdited.Default.(bool)
+	// messageDescSeq is the schema descriptor for seq field.
+	messageDescSeq := messageFields[6].Descriptor()
+	// message.SeqValidator is a validator for the "seq" field. It is called by the builders before save.
+	message.SeqValidator = messageDescSeq.Validators[0].(func(int) error)
+	// messageDescCreatedAt is the schema descriptor for created_at field.
+	messageDescCreatedAt := messageFields[8].Descriptor()
+	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
+	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
+	// messageDescUpdatedAt is the schema descriptor for updated_at field.
+	messageDescUpdatedAt := messageFields[9].Descriptor()
+	// message.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	message.DefaultUpdatedAt = messageDescUpdatedAt.Default.(func() time.Time)
+	// message.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	message.UpdateDefaultUpdatedAt = messageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	messageenvelope.Policy = privacy.NewPolicies(schema.MessageEnvelope{})
+	messageenvelope.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := messageenvelope.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	messageenvelopeFields := schema.MessageEnvelope{}.Fields()
+	_ = messageenvelopeFields
+	// messageenvelopeDescCipherText is the schema descriptor for cipher_text field.
+	messageenvelopeDescCipherText := messageenvelopeFields[0].Descriptor()
+	// messageenvelope.CipherTextValidator is a validator for the "cipher_text" field. It is called by the builders before save.
+	messageenvelope.CipherTextValidator = messageenvelopeDescCipherText.Validators[0].(func(string) error)
+	// messageenvelopeDescCreatedAt is the schema descriptor for created_at field.
+	messageenvelopeDescCreatedAt := messageenvelopeFields[1].Descriptor()
+	// messageenvelope.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messageenvelope.DefaultCreatedAt = messageenvelopeDescCreatedAt.Default.(func() time.Time)
+	mlscommit.Policy = privacy.NewPolicies(schema.MlsCommit{})
+	mlscommit.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := mlscommit.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	mlscommitFields := schema.MlsCommit{}.Fields()
+	_ = mlscommitFields
+	// mlscommitDescEpoch is the schema descriptor for epoch field.
+	mlscommitDescEpoch := mlscommitFields[0].Descriptor()
+	// mlscommit.EpochValidator is a validator for the "epoch" field. It is called by the builders before save.
+	mlscommit.EpochValidator = mlscommitDescEpoch.Validators[0].(func(int) error)
+	// mlscommitDescSenderDeviceID is the schema descriptor for sender_device_id field.
+	mlscommitDescSenderDeviceID := mlscommitFields[1].Descriptor()
+	// mlscommit.SenderDeviceIDValidator is a validator for the "sender_device_id" field. It is called by the builders before save.
+	mlscommit.SenderDeviceIDValidator = mlscommitDescSenderDeviceID.Validators[0].(func(int) error)
+	// mlscommitDescMessage is the schema descriptor for message field.
+	mlscommitDescMessage := mlscommitFields[2].Descriptor()
+	// mlscommit.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	mlscommit.MessageValidator = mlscommitDescMessage.Validators[0].(func(string) error)
+	// mlscommitDescCreatedAt is the schema descriptor for created_at field.
+	mlscommitDescCreatedAt := mlscommitFields[3].Descriptor()
+	// mlscommit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mlscommit.DefaultCreatedAt = mlscommitDescCreatedAt.Default.(func() time.Time)
+	mlswelcome.Policy = privacy.NewPolicies(schema.MlsWelcome{})
+	mlswelcome.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := mlswelcome.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	mlswelcomeFields := schema.MlsWelcome{}.Fields()
+	_ = mlswelcomeFields
+	// mlswelcomeDescEpoch is the schema descriptor for epoch field.
+	mlswelcomeDescEpoch := mlswelcomeFields[0].Descriptor()
+	// mlswelcome.EpochValidator is a validator for the "epoch" field. It is called by the builders before save.
+	mlswelcome.EpochValidator = mlswelcomeDescEpoch.Validators[0].(func(int) error)
+	// mlswelcomeDescMessage is the schema descriptor for message field.
+	mlswelcomeDescMessage := mlswelcomeFields[1].Descriptor()
+	// mlswelcome.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	mlswelcome.MessageValidator = mlswelcomeDescMessage.Validators[0].(func(string) error)
+	// mlswelcomeDescCreatedAt is the schema descriptor for created_at field.
+	mlswelcomeDescCreatedAt := mlswelcomeFields[2].Descriptor()
+	// mlswelcome.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mlswelcome.DefaultCreatedAt = mlswelcomeDescCreatedAt.Default.(func() time.Time)
+	notification.Policy = privacy.NewPolicies(schema.Notification{})
+	notification.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := notification.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescKind is the schema descriptor for kind field.
+	notificationDescKind := notificationFields[0].Descriptor()
+	// notification.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	notification.KindValidator = notificationDescKind.Validators[0].(func(string) error)
+	// notificationDescCipherText is the schema descriptor for cipher_text field.
+	notificationDescCipherText := notificationFields[1].Descriptor()
+	// notification.CipherTextValidator is a validator for the "cipher_text" field. It is called by the builders before save.
+	notification.CipherTextValidator = notificationDescCipherText.Validators[0].(func(string) error)
+	// notificationDescEncryptionScheme is the schema descriptor for encryption_scheme field.
+	notificationDescEncryptionScheme := notificationFields[2].Descriptor()
+	// notification.DefaultEncryptionScheme holds the default value on creation for the encryption_scheme field.
+	notification.DefaultEncryptionScheme = notificationDescEncryptionScheme.Default.(string)
+	// notificationDescRead is the schema descriptor for read field.
+	notificationDescRead := notificationFields[4].Descriptor()
+	// notification.DefaultRead holds the default value on creation for the read field.
+	notification.DefaultRead = notificationDescRead.Default.(bool)
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[5].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationDescUpdatedAt := notificationFields[6].Descriptor()
+	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
+	// notification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notification.UpdateDefaultUpdatedAt = notificationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	onetimeprekeyFields := schema.OneTimePreKey{}.Fields()
+	_ = onetimeprekeyFields
+	// onetimeprekeyDescKeyID is the schema descriptor for key_id field.
+	onetimeprekeyDescKeyID := onetimeprekeyFields[0].Descriptor()
+	// onetimeprekey.KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	onetimeprekey.KeyIDValidator = onetimeprekeyDescKeyID.Validators[0].(func(int) error)
+	// onetimeprekeyDescPublicKey is the schema descriptor for public_key field.
+	onetimeprekeyDescPublicKey := onetimeprekeyFields[1].Descriptor()
+	// onetimeprekey.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	onetimeprekey.PublicKeyValidator = onetimeprekeyDescPublicKey.Validators[0].(func(string) error)
+	// onetimeprekeyDescCreatedAt is the schema descriptor for created_at field.
+	onetimeprekeyDescCreatedAt := onetimeprekeyFields[2].Descriptor()
+	// onetimeprekey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	onetimeprekey.DefaultCreatedAt = onetimeprekeyDescCreatedAt.Default.(func() time.Time)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[0].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = recoverycodeDescCodeHash.Validators[0].(func(string) error)
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[2].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	roomFields := schema.Room{}.Fields()
+	_ = roomFields
+	// roomDescName is the schema descriptor for name field.
+	roomDescName := roomFields[0].Descriptor()
+	// room.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	room.NameValidator = roomDescName.Validators[0].(func(string) error)
+	// roomDescDescription is the schema descriptor for description field.
+	roomDescDescription := roomFields[1].Descriptor()
+	// room.DefaultDescription holds the default value on creation for the description field.
+	room.DefaultDescription = roomDescDescription.Default.(string)
+	// roomDescIsPrivate is the schema descriptor for is_private field.
+	roomDescIsPrivate := roomFields[2].Descriptor()
+	// room.DefaultIsPrivate holds the default value on creation for the is_private field.
+	room.DefaultIsPrivate = roomDescIsPrivate.Default.(bool)
+	// roomDescIsDirect is the schema descriptor for is_direct field.
+	roomDescIsDirect := roomFields[3].Descriptor()
+	// room.DefaultIsDirect holds the default value on creation for the is_direct field.
+	room.DefaultIsDirect = roomDescIsDirect.Default.(bool)
+	// roomDescMlsEpoch is the schema descriptor for mls_epoch field.
+	roomDescMlsEpoch := roomFields[5].Descriptor()
+	// room.DefaultMlsEpoch holds the default value on creation for the mls_epoch field.
+	room.DefaultMlsEpoch = roomDescMlsEpoch.Default.(int)
+	// room.MlsEpochValidator is a validator for the "mls_epoch" field. It is called by the builders before save.
+	room.MlsEpochValidator = roomDescMlsEpoch.Validators[0].(func(int) error)
+	// roomDescMessageTTL is the schema descriptor for message_ttl field.
+	roomDescMessageTTL := roomFields[9].Descriptor()
+	// room.MessageTTLValidator is a validator for the "message_ttl" field. It is called by the builders before save.
+	room.MessageTTLValidator = roomDescMessageTTL.Validators[0].(func(int) error)
+	// roomDescSealedSender is the schema descriptor for sealed_sender field.
+	roomDescSealedSender := roomFields[10].Descriptor()
+	// room.DefaultSealedSender holds the default value on creation for the sealed_sender field.
+	room.DefaultSealedSender = roomDescSealedSender.Default.(bool)
+	// roomDescMessageSeq is the schema descriptor for message_seq field.
+	roomDescMessageSeq := roomFields[12].Descriptor()
+	// room.DefaultMessageSeq holds the default value on creation for the message_seq field.
+	room.DefaultMessageSeq = roomDescMessageSeq.Default.(int)
+	// room.MessageSeqValidator is a validator for the "message_seq" field. It is called by the builders before save.
+	room.MessageSeqValidator = roomDescMessageSeq.Validators[0].(func(int) error)
+	// roomDescCreatedAt is the schema descriptor for created_at field.
+	roomDescCreatedAt := roomFields[13].Descriptor()
+	// room.DefaultCreatedAt holds the default value on creation for the created_at field.
+	room.DefaultCreatedAt = roomDescCreatedAt.Default.(func() time.Time)
+	// roomDescUpdatedAt is the schema descriptor for updated_at field.
+	roomDescUpdatedAt := roomFields[14].Descriptor()
+	// room.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	room.DefaultUpdatedAt = roomDescUpdatedAt.Default.(func() time.Time)
+	// room.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	room.UpdateDefaultUpdatedAt = roomDescUpdatedAt.UpdateDefault.(func() time.Time)
+	roommembership.Policy = privacy.NewPolicies(schema.RoomMembership{})
+	roommembership.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := roommembership.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	roommembershipFields := schema.RoomMembership{}.Fields()
+	_ = roommembershipFields
+	// roommembershipDescCanPost is the schema descriptor for can_post field.
+	roommembershipDescCanPost := roommembershipFields[1].Descriptor()
+	// roommembership.DefaultCanPost holds the default value on creation for the can_post field.
+	roommembership.DefaultCanPost = roommembershipDescCanPost.Default.(bool)
+	// roommembershipDescCanCall is the schema descriptor for can_call field.
+	roommembershipDescCanCall := roommembershipFields[2].Descriptor()
+	// roommembership.DefaultCanCall holds the default value on creation for the can_call field.
+	roommembership.DefaultCanCall = roommembershipDescCanCall.Default.(bool)
+	// roommembershipDescJoinedAt is the schema descriptor for joined_at field.
+	roommembershipDescJoinedAt := roommembershipFields[3].Descriptor()
+	// roommembership.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	roommembership.DefaultJoinedAt = roommembershipDescJoinedAt.Default.(func() time.Time)
+	// roommembershipDescUpdatedAt is the schema descriptor for updated_at field.
+	roommembershipDescUpdatedAt := roommembershipFields[4].Descriptor()
+	// roommembership.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	roommembership.DefaultUpdatedAt = roommembershipDescUpdatedAt.Default.(func() time.Time)
+	// roommembership.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	roommembership.UpdateDefaultUpdatedAt = roommembershipDescUpdatedAt.UpdateDefault.(func() time.Time)
+	senderkeydistribution.Policy = privacy.NewPolicies(schema.SenderKeyDistribution{})
+	senderkeydistribution.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := senderkeydistribution.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	senderkeydistributionFields := schema.SenderKeyDistribution{}.Fields()
+	_ = senderkeydistributionFields
+	// senderkeydistributionDescDistributionID is the schema descriptor for distribution_id field.
+	senderkeydistributionDescDistributionID := senderkeydistributionFields[0].Descriptor()
+	// senderkeydistribution.DistributionIDValidator is a validator for the "distribution_id" field. It is called by the builders before save.
+	senderkeydistribution.DistributionIDValidator = senderkeydistributionDescDistributionID.Validators[0].(func(string) error)
+	// senderkeydistributionDescSenderDeviceID is the schema descriptor for sender_device_id field.
+	senderkeydistributionDescSenderDeviceID := senderkeydistributionFields[1].Descriptor()
+	// senderkeydistribution.SenderDeviceIDValidator is a validator for the "sender_device_id" field. It is called by the builders before save.
+	senderkeydistribution.SenderDeviceIDValidator = senderkeydistributionDescSenderDeviceID.Validators[0].(func(int) error)
+	// senderkeydistributionDescCipherText is the schema descriptor for cipher_text field.
+	senderkeydistributionDescCipherText := senderkeydistributionFields[2].Descriptor()
+	// senderkeydistribution.CipherTextValidator is a validator for the "cipher_text" field. It is called by the builders before save.
+	senderkeydistribution.CipherTextValidator = senderkeydistributionDescCipherText.Validators[0].(func(string) error)
+	// senderkeydistributionDescCreatedAt is the schema descriptor for created_at field.
+	senderkeydistributionDescCreatedAt := senderkeydistributionFields[3].Descriptor()
+	// senderkeydistribution.DefaultCreatedAt holds the default value on creation for the created_at field.
+	senderkeydistribution.DefaultCreatedAt = senderkeydistributionDescCreatedAt.Default.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescDeviceLabel is the schema descriptor for device_label field.
+	sessionDescDeviceLabel := sessionFields[0].Descriptor()
+	// session.DefaultDeviceLabel holds the default value on creation for the device_label field.
+	session.DefaultDeviceLabel = sessionDescDeviceLabel.Default.(string)
+	// sessionDescIPAddress is the schema descriptor for ip_address field.
+	sessionDescIPAddress := sessionFields[1].Descriptor()
+	// session.DefaultIPAddress holds the default value on creation for the ip_address field.
+	session.DefaultIPAddress = sessionDescIPAddress.Default.(string)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[2].Descriptor()
+	// session.DefaultUserAgent holds the default value on creation for the user_agent field.
+	session.DefaultUserAgent = sessionDescUserAgent.Default.(string)
+	// sessionDescRefreshTokenHash is the schema descriptor for refresh_token_hash field.
+	sessionDescRefreshTokenHash := sessionFields[3].Descriptor()
+	// session.RefreshTokenHashValidator is a validator for the "refresh_token_hash" field. It is called by the builders before save.
+	session.RefreshTokenHashValidator = sessionDescRefreshTokenHash.Validators[0].(func(string) error)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[5].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescLastUsedAt is the schema descriptor for last_used_at field.
+	sessionDescLastUsedAt := sessionFields[6].Descriptor()
+	// session.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	session.DefaultLastUsedAt = sessionDescLastUsedAt.Default.(func() time.Time)
+	signedprekeyFields := schema.SignedPreKey{}.Fields()
+	_ = signedprekeyFields
+	// signedprekeyDescKeyID is the schema descriptor for key_id field.
+	signedprekeyDescKeyID := signedprekeyFields[0].Descriptor()
+	// signedprekey.KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	signedprekey.KeyIDValidator = signedprekeyDescKeyID.Validators[0].(func(int) error)
+	// signedprekeyDescPublicKey is the schema descriptor for public_key field.
+	signedprekeyDescPublicKey := signedprekeyFields[1].Descriptor()
+	// signedprekey.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	signedprekey.PublicKeyValidator = signedprekeyDescPublicKey.Validators[0].(func(string) error)
+	// signedprekeyDescSignature is the schema descriptor for signature field.
+	signedprekeyDescSignature := signedprekeyFields[2].Descriptor()
+	// signedprekey.SignatureValidator is a validator for the "signature" field. It is called by the builders before save.
+	signedprekey.SignatureValidator = signedprekeyDescSignature.Validators[0].(func(string) error)
+	// signedprekeyDescCreatedAt is the schema descriptor for created_at field.
+	signedprekeyDescCreatedAt := signedprekeyFields[3].Descriptor()
+	// signedprekey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signedprekey.DefaultCreatedAt = signedprekeyDescCreatedAt.Default.(func() time.Time)
+	totpsecretFields := schema.TotpSecret{}.Fields()
+	_ = totpsecretFields
+	// totpsecretDescSecret is the schema descriptor for secret field.
+	totpsecretDescSecret := totpsecretFields[0].Descriptor()
+	// totpsecret.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	totpsecret.SecretValidator = totpsecretDescSecret.Validators[0].(func(string) error)
+	// totpsecretDescLastUsedStep is the schema descriptor for last_used_step field.
+	totpsecretDescLastUsedStep := totpsecretFields[2].Descriptor()
+	// totpsecret.DefaultLastUsedStep holds the default value on creation for the last_used_step field.
+	totpsecret.DefaultLastUsedStep = totpsecretDescLastUsedStep.Default.(int64)
+	// totpsecretDescFailedAttempts is the schema descriptor for failed_attempts field.
+	totpsecretDescFailedAttempts := totpsecretFields[3].Descriptor()
+	// totpsecret.DefaultFailedAttempts holds the default value on creation for the failed_attempts field.
+	totpsecret.DefaultFailedAttempts = totpsecretDescFailedAttempts.Default.(int)
+	// totpsecretDescCreatedAt is the schema descriptor for created_at field.
+	totpsecretDescCreatedAt := totpsecretFields[5].Descriptor()
+	// totpsecret.DefaultCreatedAt holds the default value on creation for the created_at field.
+	totpsecret.DefaultCreatedAt = totpsecretDescCreatedAt.Default.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[0].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	// userDescDisplayName is the schema descriptor for display_name field.
+	userDescDisplayName := userFields[1].Descriptor()
+	// user.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	user.DisplayNameValidator = userDescDisplayName.Validators[0].(func(string) error)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[2].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescIsBot is the schema descriptor for is_bot field.
+	userDescIsBot := userFields[5].Descriptor()
+	// user.DefaultIsBot holds the default value on creation for the is_bot field.
+	user.DefaultIsBot = userDescIsBot.Default.(bool)
+	// userDescIsTombstone is the schema descriptor for is_tombstone field.
+	userDescIsTombstone := userFields[10].Descriptor()
+	// user.DefaultIsTombstone holds the default value on creation for the is_tombstone field.
+	user.DefaultIsTombstone = userDescIsTombstone.Default.(bool)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[11].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[12].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescLastSeenAt is the schema descriptor for last_seen_at field.
+	userDescLastSeenAt := userFields[13].Descriptor()
+	// user.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	user.DefaultLastSeenAt = userDescLastSeenAt.Default.(func() time.Time)
+	// user.UpdateDefaultLastSeenAt holds the default value on update for the last_seen_at field.
+	user.UpdateDefaultLastSeenAt = userDescLastSeenAt.UpdateDefault.(func() time.Time)
+}
 
 const (
-	Version = "v0.13.1"                                         // Version of ent codegen.
+	Version = "v0.13.1"                                        // Version of ent codegen.
 	Sum     = "h1:uD8QwN1h6SNphdCCzmkMN3feSUzNnVvV/WIkHKMbzOE=" // Sum of ent codegen.
 )
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ApiKey holds the schema definition for the ApiKey entity, a long-lived
+// credential that authenticates as its user with a restricted set of scopes.
+type ApiKey struct {
+	ent.Schema
+}
+
+// Fields of the ApiKey.
+func (ApiKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("prefix").NotEmpty().Unique(),
+		field.String("key_hash").NotEmpty().Sensitive(),
+		field.Strings("scopes"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the ApiKey.
+func (ApiKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required(),
+		edge.To("created_by", User.Type).
+			Unique(),
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
 )
 
 // CallLog holds the schema definition for the CallLog entity.
@@ -34,3 +37,17 @@ func (CallLog) Edges() []ent.Edge {
 		edge.From("participants", CallParticipant.Type).Ref("call"),
 	}
 }
+
+// Policy of the CallLog.
+func (CallLog) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeCallLogMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterCallLogs(),
+		},
+	}
+}
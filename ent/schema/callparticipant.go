@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
 )
 
 // CallParticipant holds the schema definition for the CallParticipant entity.
@@ -33,3 +36,17 @@ func (CallParticipant) Edges() []ent.Edge {
 			Required(),
 	}
 }
+
+// Policy of the CallParticipant.
+func (CallParticipant) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeCallParticipantMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterCallParticipants(),
+		},
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
 )
 
 // Contact holds the schema definition for the Contact entity.
@@ -43,3 +46,17 @@ func (Contact) Indexes() []ent.Index {
 		index.Edges("owner", "contact").Unique(),
 	}
 }
+
+// Policy of the Contact.
+func (Contact) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeContactMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterContacts(),
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Device holds the schema definition for the Device entity, one installation
+// of a client that holds its own Signal protocol keys.
+type Device struct {
+	ent.Schema
+}
+
+// Fields of the Device.
+func (Device) Fields() []ent.Field {
+	return []ent.Field{
+		// device_id is chosen by the client and unique per user, as in the
+		// Signal protocol address (user, device).
+		field.Int("device_id").Positive(),
+		field.Int("registration_id").NonNegative(),
+		field.String("name").Default(""),
+		field.Time("low_prekeys_notified_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Device.
+func (Device) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required(),
+		edge.From("identity_key", IdentityKey.Type).Ref("device").Unique(),
+		edge.From("identity_key_history", IdentityKeyRecord.Type).Ref("device"),
+		edge.From("signed_pre_keys", SignedPreKey.Type).Ref("device"),
+		edge.From("one_time_pre_keys", OneTimePreKey.Type).Ref("device"),
+		edge.From("envelopes", MessageEnvelope.Type).Ref("device"),
+		edge.From("key_packages", KeyPackage.Type).Ref("device"),
+		edge.From("mls_welcomes", MlsWelcome.Type).Ref("device"),
+		edge.From("sender_key_distributions", SenderKeyDistribution.Type).Ref("device"),
+	}
+}
+
+// Indexes of the Device.
+func (Device) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("device_id").Edges("user").Unique(),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailToken holds the schema definition for the EmailToken entity, a
+// single-use secret mailed to a user to verify their address or reset their
+// password. Only the hash of the secret is stored.
+type EmailToken struct {
+	ent.Schema
+}
+
+// Fields of the EmailToken.
+func (EmailToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("purpose").Values("verify_email", "reset_password"),
+		field.String("token_hash").NotEmpty().Unique().Sensitive(),
+		field.String("email").NotEmpty(),
+		field.Time("expires_at"),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the EmailToken.
+func (EmailToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required(),
+	}
+}
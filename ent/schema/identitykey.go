@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// IdentityKey holds the schema definition for the IdentityKey entity, the
+// long-term public identity key of a device.
+type IdentityKey struct {
+	ent.Schema
+}
+
+// Fields of the IdentityKey.
+func (IdentityKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("public_key").NotEmpty(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the IdentityKey.
+func (IdentityKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("device", Device.Type).
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdentityKeyRecord holds the schema definition for the IdentityKeyRecord
+// entity, one entry in the append-only history of a device's identity keys.
+// Records are only ever added. They outlive their device and are removed only
+// with their user's account.
+type IdentityKeyRecord struct {
+	ent.Schema
+}
+
+// Fields of the IdentityKeyRecord.
+func (IdentityKeyRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("public_key").NotEmpty().Immutable(),
+		// device_number is the deviceId the user gave the device. It is kept
+		// after the device is removed, so a device registered again under the
+		// same number is compared with the keys it used before.
+		field.Int("device_number").Positive().Optional(),
+		// created_at is when the device started using the key.
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the IdentityKeyRecord.
+func (IdentityKeyRecord) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique(),
+		// device is cleared when the device is removed.
+		edge.To("device", Device.Type).
+			Unique(),
+	}
+}
+
+// Indexes of the IdentityKeyRecord.
+func (IdentityKeyRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at").Edges("device"),
+		index.Fields("device_number", "created_at").Edges("user"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// KeyBackupKey holds the schema definition for the KeyBackupKey entity, one
+// room session key in a key backup version. session_data is encrypted by the
+// client and opaque to the server.
+type KeyBackupKey struct {
+	ent.Schema
+}
+
+// Fields of the KeyBackupKey.
+func (KeyBackupKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("session_id").NotEmpty().Immutable(),
+		// first_message_index, forwarded_count and is_verified are reported by
+		// the client in the clear so the server can keep the better of two
+		// copies of a session without decrypting them.
+		field.Int("first_message_index").NonNegative(),
+		field.Int("forwarded_count").NonNegative(),
+		field.Bool("is_verified").Default(false),
+		field.String("session_data").NotEmpty(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the KeyBackupKey.
+func (KeyBackupKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("version", KeyBackupVersion.Type).
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("room", Room.Type).
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the KeyBackupKey.
+func (KeyBackupKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_id").Edges("version", "room").Unique(),
+	}
+}
+
+// Policy of the KeyBackupKey.
+func (KeyBackupKey) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeKeyBackupKeyMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterKeyBackupKeys(),
+		},
+	}
+}
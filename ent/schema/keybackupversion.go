@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// KeyBackupVersion holds the schema definition for the KeyBackupVersion
+// entity, one version of a user's server-side room key backup. Keys are
+// encrypted to the backup's public key; the private key never reaches the
+// server.
+type KeyBackupVersion struct {
+	ent.Schema
+}
+
+// Fields of the KeyBackupVersion.
+func (KeyBackupVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("algorithm").NotEmpty().Immutable(),
+		// auth_data holds the algorithm's public parameters, such as the backup
+		// public key and its signatures, as JSON chosen by the client.
+		field.String("auth_data").NotEmpty(),
+		// etag changes whenever the keys stored in the version change.
+		field.Int("etag").NonNegative().Default(0),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the KeyBackupVersion.
+func (KeyBackupVersion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("owner", User.Type).
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("keys", KeyBackupKey.Type).Ref("version"),
+	}
+}
+
+// Policy of the KeyBackupVersion.
+func (KeyBackupVersion) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeKeyBackupVersionMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterKeyBackupVersions(),
+		},
+	}
+}
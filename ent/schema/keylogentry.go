@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// KeyLogEntry holds the schema definition for the KeyLogEntry entity, one
+// leaf of the key transparency log. Entries are never changed or removed, so
+// they keep plain user and device IDs instead of edges that would tie them to
+// rows that can be deleted.
+type KeyLogEntry struct {
+	ent.Schema
+}
+
+// Fields of the KeyLogEntry.
+func (KeyLogEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("leaf_index").NonNegative().Unique().Immutable(),
+		field.Int("user_id").Immutable(),
+		field.Int("device_id").Immutable(),
+		field.String("identity_key").NotEmpty().Immutable(),
+		// published_at is stored with the millisecond precision it is hashed with.
+		field.Time("published_at").Immutable(),
+		field.Bytes("leaf_hash").Immutable(),
+	}
+}
+
+// Indexes of the KeyLogEntry.
+func (KeyLogEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "device_id"),
+	}
+}
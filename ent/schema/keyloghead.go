@@ -0,0 +1,22 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// KeyLogHead holds the schema definition for the KeyLogHead entity, the
+// single row recording the size and root of the key transparency log.
+// Publishing a key updates it before anything else, so concurrent
+// publications are given leaf indexes one at a time.
+type KeyLogHead struct {
+	ent.Schema
+}
+
+// Fields of the KeyLogHead.
+func (KeyLogHead) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tree_size").NonNegative().Default(0),
+		field.Bytes("root_hash"),
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// KeyLogNode holds the schema definition for the KeyLogNode entity, the hash
+// of one complete subtree of the key transparency log above the leaves.
+// Proofs are built from these instead of from every leaf. Nodes are never
+// changed or removed.
+type KeyLogNode struct {
+	ent.Schema
+}
+
+// Fields of the KeyLogNode.
+func (KeyLogNode) Fields() []ent.Field {
+	return []ent.Field{
+		// level is the height of the subtree, which holds 2^level leaves.
+		field.Int("level").Positive().Immutable(),
+		// position counts the subtrees of the same level from the left.
+		field.Int("position").NonNegative().Immutable(),
+		field.Bytes("hash").Immutable(),
+	}
+}
+
+// Indexes of the KeyLogNode.
+func (KeyLogNode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("level", "position").Unique(),
+	}
+}
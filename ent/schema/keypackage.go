@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// KeyPackage holds the schema definition for the KeyPackage entity, an MLS
+// KeyPackage a device publishes so others can add it to groups.
+type KeyPackage struct {
+	ent.Schema
+}
+
+// Fields of the KeyPackage.
+func (KeyPackage) Fields() []ent.Field {
+	return []ent.Field{
+		// key_package is the base64 encoded MLSMessage carrying the KeyPackage.
+		field.String("key_package").NotEmpty(),
+		field.Int("cipher_suite").NonNegative(),
+		// last_resort packages are handed out when no other package is left
+		// and are never consumed.
+		field.Bool("last_resort").Default(false),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the KeyPackage.
+func (KeyPackage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("device", Device.Type).
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginThrottle holds the schema definition for the LoginThrottle entity, the
+// recent failed password logins for one account from one client IP address,
+// for one account from anywhere, or from one client IP address.
+type LoginThrottle struct {
+	ent.Schema
+}
+
+// Fields of the LoginThrottle.
+func (LoginThrottle) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("scope").Values("account_ip", "account", "ip"),
+		// key is the user ID for known accounts or the normalised login
+		// identifier for unknown ones, followed by "|" and the IP address for
+		// account_ip; for ip it is the IP address.
+		field.String("key").NotEmpty(),
+		field.Int("failures").Default(0),
+		field.Time("last_failure_at"),
+		field.Time("retry_at").Optional().Nillable(),
+		field.Time("locked_until").Optional().Nillable(),
+	}
+}
+
+// Edges of the LoginThrottle.
+func (LoginThrottle) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique(),
+	}
+}
+
+// Indexes of the LoginThrottle.
+func (LoginThrottle) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope", "key").Unique(),
+	}
+}
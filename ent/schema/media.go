@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
 )
 
 // Media holds the schema definition for the Media entity.
@@ -35,3 +38,17 @@ func (Media) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Policy of the Media.
+func (Media) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeMediaMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterMedia(),
+		},
+	}
+}
@@ -6,6 +6,10 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
 )
 
 // Message holds the schema definition for the Message entity.
@@ -16,10 +20,22 @@ type Message struct {
 // Fields of the Message.
 func (Message) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("cipher_text").NotEmpty(),
+		// cipher_text is empty for messages sent as per-device envelopes.
+		field.String("cipher_text").Optional().NotEmpty(),
+		// sender_device_id is the client-chosen ID of the sending device, so
+		// recipients can pick the session or sender key to decrypt with.
+		field.Int("sender_device_id").Optional().Nillable(),
 		field.String("content_type").Default("text/plain"),
+		// encryption_scheme and encryption_version name an entry of the
+		// internal/encryption registry; the version is unset on older messages.
 		field.String("encryption_scheme").Default("signal"),
+		field.Int("encryption_version").Optional().Nillable(),
 		field.Bool("edited").Default(false),
+		// seq numbers the room's messages 1, 2, 3, ... in the order they were
+		// stored. Messages stored before it existed are numbered at startup.
+		field.Int("seq").Positive().Optional(),
+		// expires_at is when a disappearing message is deleted.
+		field.Time("expires_at").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -28,12 +44,36 @@ func (Message) Fields() []ent.Field {
 // Edges of the Message.
 func (Message) Edges() []ent.Edge {
 	return []ent.Edge{
+		// sender is unset for sealed sender messages, whose sender is only
+		// known to the recipients.
 		edge.To("sender", User.Type).
-			Unique().
-			Required(),
+			Unique(),
 		edge.To("room", Room.Type).
 			Unique().
 			Required(),
 		edge.From("media", Media.Type).Ref("message"),
+		edge.From("envelopes", MessageEnvelope.Type).Ref("message"),
+	}
+}
+
+// Indexes of the Message.
+func (Message) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+		index.Fields("seq").Edges("room").Unique(),
+	}
+}
+
+// Policy of the Message.
+func (Message) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeMessageMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterMessages(),
+		},
 	}
 }
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// MessageEnvelope holds the schema definition for the MessageEnvelope entity,
+// the copy of a message encrypted for one recipient device.
+type MessageEnvelope struct {
+	ent.Schema
+}
+
+// Fields of the MessageEnvelope.
+func (MessageEnvelope) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("cipher_text").NotEmpty(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the MessageEnvelope.
+func (MessageEnvelope) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("message", Message.Type).
+			Unique().
+			Required(),
+		edge.To("device", Device.Type).
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MessageEnvelope.
+func (MessageEnvelope) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("message", "device").Unique(),
+	}
+}
+
+// Policy of the MessageEnvelope.
+func (MessageEnvelope) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeMessageEnvelopeMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterMessageEnvelopes(),
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// MlsCommit holds the schema definition for the MlsCommit entity, an MLS
+// Commit accepted for a room's group. Commits are totally ordered by epoch.
+type MlsCommit struct {
+	ent.Schema
+}
+
+// Fields of the MlsCommit.
+func (MlsCommit) Fields() []ent.Field {
+	return []ent.Field{
+		// epoch is the group epoch the commit was made in; applying it moves
+		// the group to epoch + 1.
+		field.Int("epoch").NonNegative(),
+		field.Int("sender_device_id").Positive(),
+		// message is the base64 encoded MLSMessage carrying the Commit.
+		field.String("message").NotEmpty(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the MlsCommit.
+func (MlsCommit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("room", Room.Type).
+			Unique().
+			Required(),
+		edge.To("sender", User.Type).
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MlsCommit.
+func (MlsCommit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("epoch").Edges("room").Unique(),
+	}
+}
+
+// Policy of the MlsCommit.
+func (MlsCommit) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeMlsCommitMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterMlsCommits(),
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// MlsWelcome holds the schema definition for the MlsWelcome entity, an MLS
+// Welcome waiting for one device that was added to a room's group.
+type MlsWelcome struct {
+	ent.Schema
+}
+
+// Fields of the MlsWelcome.
+func (MlsWelcome) Fields() []ent.Field {
+	return []ent.Field{
+		// epoch is the group epoch the new member joins at.
+		field.Int("epoch").NonNegative(),
+		// message is the base64 encoded MLSMessage carrying the Welcome.
+		field.String("message").NotEmpty(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the MlsWelcome.
+func (MlsWelcome) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("room", Room.Type).
+			Unique().
+			Required(),
+		edge.To("sender", User.Type).
+			Unique().
+			Required(),
+		edge.To("device", Device.Type).
+			Unique().
+			Required(),
+	}
+}
+
+// Policy of the MlsWelcome.
+func (MlsWelcome) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeMlsWelcomeMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterMlsWelcomes(),
+		},
+	}
+}
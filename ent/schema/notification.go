@@ -6,6 +6,9 @@ import (
         "entgo.io/ent"
         "entgo.io/ent/schema/edge"
         "entgo.io/ent/schema/field"
+
+        "github.com/eleven-am/enclave/ent/privacy"
+        "github.com/eleven-am/enclave/internal/rule"
 )
 
 // Notification holds the schema definition for the Notification entity.
@@ -19,6 +22,7 @@ func (Notification) Fields() []ent.Field {
                 field.String("kind").NotEmpty(),
                 field.String("cipher_text").NotEmpty(),
                 field.String("encryption_scheme").Default("signal"),
+                field.Int("encryption_version").Optional().Nillable(),
                 field.Bool("read").Default(false),
                 field.Time("created_at").Default(time.Now),
                 field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
@@ -37,3 +41,17 @@ func (Notification) Edges() []ent.Edge {
                         Unique(),
         }
 }
+
+// Policy of the Notification.
+func (Notification) Policy() ent.Policy {
+        return privacy.Policy{
+                Mutation: privacy.MutationPolicy{
+                        rule.DenyIfNoViewer(),
+                        rule.AuthorizeNotificationMutation(),
+                },
+                Query: privacy.QueryPolicy{
+                        rule.DenyIfNoViewer(),
+                        rule.FilterNotifications(),
+                },
+        }
+}
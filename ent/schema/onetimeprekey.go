@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OneTimePreKey holds the schema definition for the OneTimePreKey entity. Each
+// one is handed out in at most one prekey bundle and deleted when claimed.
+type OneTimePreKey struct {
+	ent.Schema
+}
+
+// Fields of the OneTimePreKey.
+func (OneTimePreKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("key_id").NonNegative(),
+		field.String("public_key").NotEmpty(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the OneTimePreKey.
+func (OneTimePreKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("device", Device.Type).
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the OneTimePreKey.
+func (OneTimePreKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key_id").Edges("device").Unique(),
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+
+	"github.com/eleven-am/enclave/internal/encryption"
 )
 
 // Room holds the schema definition for the Room entity.
@@ -20,6 +22,29 @@ func (Room) Fields() []ent.Field {
 		field.String("description").Default(""),
 		field.Bool("is_private").Default(false),
 		field.Bool("is_direct").Default(false),
+		// mls_group_id is set once the room is an MLS group. Its membership
+		// then changes only through commits, which advance mls_epoch.
+		field.String("mls_group_id").Optional().Nillable().Unique(),
+		field.Int("mls_epoch").NonNegative().Default(0),
+		// mls_pending_removals lists users the server removed from the room
+		// outside of a commit, for example when their account was purged. The
+		// group's next commit must remove them too.
+		field.JSON("mls_pending_removals", []int{}).Optional(),
+		// sender_keys_rotated_at is when a member last left, after which sender
+		// keys created earlier must be replaced.
+		field.Time("sender_keys_rotated_at").Optional().Nillable(),
+		// encryption_policy restricts the schemes messages and notifications in
+		// the room may use; when empty only end-to-end schemes are accepted.
+		field.JSON("encryption_policy", encryption.Policy{}).Optional(),
+		// message_ttl is how long messages in the room are kept, in seconds,
+		// when disappearing messages are on.
+		field.Int("message_ttl").Positive().Optional().Nillable(),
+		// sealed_sender lets members send messages without revealing who sent
+		// them, authorized by delivery_token instead of the sender's account.
+		field.Bool("sealed_sender").Default(false),
+		field.String("delivery_token").Optional().Nillable().Sensitive(),
+		// message_seq is the sequence number given to the room's latest message.
+		field.Int("message_seq").NonNegative().Default(0),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -35,5 +60,8 @@ func (Room) Edges() []ent.Edge {
 		edge.From("messages", Message.Type).Ref("room"),
 		edge.From("favourites", Favourite.Type).Ref("room"),
 		edge.From("call_logs", CallLog.Type).Ref("room"),
+		edge.From("mls_commits", MlsCommit.Type).Ref("room"),
+		edge.From("mls_welcomes", MlsWelcome.Type).Ref("room"),
+		edge.From("sender_key_distributions", SenderKeyDistribution.Type).Ref("room"),
 	}
 }
@@ -7,6 +7,9 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
 )
 
 // RoomMembership holds the schema definition for the RoomMembership entity.
@@ -43,3 +46,17 @@ func (RoomMembership) Indexes() []ent.Index {
 		index.Edges("user", "room").Unique(),
 	}
 }
+
+// Policy of the RoomMembership.
+func (RoomMembership) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeRoomMembershipMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterRoomMemberships(),
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// SenderKeyDistribution holds the schema definition for the
+// SenderKeyDistribution entity, a sender key distribution message waiting for
+// one device of a room member. It is encrypted pairwise for that device.
+type SenderKeyDistribution struct {
+	ent.Schema
+}
+
+// Fields of the SenderKeyDistribution.
+func (SenderKeyDistribution) Fields() []ent.Field {
+	return []ent.Field{
+		// distribution_id is chosen by the sender and names the sender key.
+		field.String("distribution_id").NotEmpty(),
+		field.Int("sender_device_id").Positive(),
+		field.String("cipher_text").NotEmpty(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the SenderKeyDistribution.
+func (SenderKeyDistribution) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("room", Room.Type).
+			Unique().
+			Required(),
+		edge.To("sender", User.Type).
+			Unique().
+			Required(),
+		edge.To("device", Device.Type).
+			Unique().
+			Required(),
+	}
+}
+
+// Policy of the SenderKeyDistribution.
+func (SenderKeyDistribution) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AuthorizeSenderKeyDistributionMutation(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterSenderKeyDistributions(),
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SignedPreKey holds the schema definition for the SignedPreKey entity, a
+// medium-term prekey signed by the device's identity key. The newest one is
+// handed out in prekey bundles.
+type SignedPreKey struct {
+	ent.Schema
+}
+
+// Fields of the SignedPreKey.
+func (SignedPreKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("key_id").NonNegative(),
+		field.String("public_key").NotEmpty(),
+		field.String("signature").NotEmpty(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the SignedPreKey.
+func (SignedPreKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("device", Device.Type).
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the SignedPreKey.
+func (SignedPreKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key_id").Edges("device").Unique(),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TotpSecret holds the schema definition for the TotpSecret entity, a user's
+// RFC 6238 authenticator enrollment.
+type TotpSecret struct {
+	ent.Schema
+}
+
+// Fields of the TotpSecret.
+func (TotpSecret) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("secret").NotEmpty().Sensitive(),
+		field.Time("confirmed_at").Optional().Nillable(),
+		field.Int64("last_used_step").Default(0),
+		field.Int("failed_attempts").Default(0),
+		field.Time("locked_until").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the TotpSecret.
+func (TotpSecret) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required(),
+	}
+}
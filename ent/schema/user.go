@@ -19,7 +19,14 @@ func (User) Fields() []ent.Field {
 		field.String("username").NotEmpty().Unique(),
 		field.String("display_name").NotEmpty(),
 		field.String("email").NotEmpty().Unique(),
+		field.Time("verified_at").Optional().Nillable(),
 		field.String("avatar_url").Optional().Nillable(),
+		field.Bool("is_bot").Default(false),
+		field.Enum("role").Values("user", "moderator", "admin").Default("user"),
+		field.Time("suspended_at").Optional().Nillable(),
+		field.Enum("discoverability").Values("everyone", "contacts", "nobody").Default("everyone"),
+		field.Time("deletion_scheduled_at").Optional().Nillable(),
+		field.Bool("is_tombstone").Default(false),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("last_seen_at").Default(time.Now).UpdateDefault(time.Now),
@@ -38,5 +45,20 @@ func (User) Edges() []ent.Edge {
 		edge.From("favourites", Favourite.Type).Ref("user"),
 		edge.From("initiated_calls", CallLog.Type).Ref("initiator"),
 		edge.From("call_participations", CallParticipant.Type).Ref("participant"),
+		edge.From("credential", Credential.Type).Ref("user").Unique(),
+		edge.From("sessions", Session.Type).Ref("user"),
+		edge.From("identities", Identity.Type).Ref("user"),
+		edge.From("totp_secret", TotpSecret.Type).Ref("user").Unique(),
+		edge.From("recovery_codes", RecoveryCode.Type).Ref("user"),
+		edge.From("api_keys", ApiKey.Type).Ref("user"),
+		edge.From("email_tokens", EmailToken.Type).Ref("user"),
+		edge.From("login_throttles", LoginThrottle.Type).Ref("user"),
+		edge.From("devices", Device.Type).Ref("user"),
+		edge.From("mls_commits", MlsCommit.Type).Ref("sender"),
+		edge.From("sent_mls_welcomes", MlsWelcome.Type).Ref("sender"),
+		edge.From("sent_sender_key_distributions", SenderKeyDistribution.Type).Ref("sender"),
+		edge.To("service_accounts", User.Type).
+			From("service_owner").
+			Unique(),
 	}
 }
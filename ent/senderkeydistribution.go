@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// SenderKeyDistribution is the model entity for the SenderKeyDistribution schema.
+type SenderKeyDistribution struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DistributionID holds the value of the "distribution_id" field.
+	DistributionID string `json:"distribution_id,omitempty"`
+	// SenderDeviceID holds the value of the "sender_device_id" field.
+	SenderDeviceID int `json:"sender_device_id,omitempty"`
+	// CipherText holds the value of the "cipher_text" field.
+	CipherText string `json:"cipher_text,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SenderKeyDistributionQuery when eager-loading is set.
+	Edges                          SenderKeyDistributionEdges `json:"edges"`
+	sender_key_distribution_room   *int
+	sender_key_distribution_sender *int
+	sender_key_distribution_device *int
+	selectValues                   sql.SelectValues
+}
+
+// SenderKeyDistributionEdges holds the relations/edges for other nodes in the graph.
+type SenderKeyDistributionEdges struct {
+	// Room holds the value of the room edge.
+	Room *Room `json:"room,omitempty"`
+	// Sender holds the value of the sender edge.
+	Sender *User `json:"sender,omitempty"`
+	// Device holds the value of the device edge.
+	Device *Device `json:"device,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// RoomOrErr returns the Room value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SenderKeyDistributionEdges) RoomOrErr() (*Room, error) {
+	if e.Room != nil {
+		return e.Room, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: room.Label}
+	}
+	return nil, &NotLoadedError{edge: "room"}
+}
+
+// SenderOrErr returns the Sender value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SenderKeyDistributionEdges) SenderOrErr() (*User, error) {
+	if e.Sender != nil {
+		return e.Sender, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender"}
+}
+
+// DeviceOrErr returns the Device value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SenderKeyDistributionEdges) DeviceOrErr() (*Device, error) {
+	if e.Device != nil {
+		return e.Device, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: device.Label}
+	}
+	return nil, &NotLoadedError{edge: "device"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SenderKeyDistribution) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case senderkeydistribution.FieldID, senderkeydistribution.FieldSenderDeviceID:
+			values[i] = new(sql.NullInt64)
+		case senderkeydistribution.FieldDistributionID, senderkeydistribution.FieldCipherText:
+			values[i] = new(sql.NullString)
+		case senderkeydistribution.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case senderkeydistribution.ForeignKeys[0]: // sender_key_distribution_room
+			values[i] = new(sql.NullInt64)
+		case senderkeydistribution.ForeignKeys[1]: // sender_key_distribution_sender
+			values[i] = new(sql.NullInt64)
+		case senderkeydistribution.ForeignKeys[2]: // sender_key_distribution_device
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SenderKeyDistribution fields.
+func (skd *SenderKeyDistribution) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case senderkeydistribution.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			skd.ID = int(value.Int64)
+		case senderkeydistribution.FieldDistributionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field distribution_id", values[i])
+			} else if value.Valid {
+				skd.DistributionID = value.String
+			}
+		case senderkeydistribution.FieldSenderDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_device_id", values[i])
+			} else if value.Valid {
+				skd.SenderDeviceID = int(value.Int64)
+			}
+		case senderkeydistribution.FieldCipherText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cipher_text", values[i])
+			} else if value.Valid {
+				skd.CipherText = value.String
+			}
+		case senderkeydistribution.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				skd.CreatedAt = value.Time
+			}
+		case senderkeydistribution.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field sender_key_distribution_room", value)
+			} else if value.Valid {
+				skd.sender_key_distribution_room = new(int)
+				*skd.sender_key_distribution_room = int(value.Int64)
+			}
+		case senderkeydistribution.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field sender_key_distribution_sender", value)
+			} else if value.Valid {
+				skd.sender_key_distribution_sender = new(int)
+				*skd.sender_key_distribution_sender = int(value.Int64)
+			}
+		case senderkeydistribution.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field sender_key_distribution_device", value)
+			} else if value.Valid {
+				skd.sender_key_distribution_device = new(int)
+				*skd.sender_key_distribution_device = int(value.Int64)
+			}
+		default:
+			skd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SenderKeyDistribution.
+// This includes values selected through modifiers, order, etc.
+func (skd *SenderKeyDistribution) Value(name string) (ent.Value, error) {
+	return skd.selectValues.Get(name)
+}
+
+// QueryRoom queries the "room" edge of the SenderKeyDistribution entity.
+func (skd *SenderKeyDistribution) QueryRoom() *RoomQuery {
+	return NewSenderKeyDistributionClient(skd.config).QueryRoom(skd)
+}
+
+// QuerySender queries the "sender" edge of the SenderKeyDistribution entity.
+func (skd *SenderKeyDistribution) QuerySender() *UserQuery {
+	return NewSenderKeyDistributionClient(skd.config).QuerySender(skd)
+}
+
+// QueryDevice queries the "device" edge of the SenderKeyDistribution entity.
+func (skd *SenderKeyDistribution) QueryDevice() *DeviceQuery {
+	return NewSenderKeyDistributionClient(skd.config).QueryDevice(skd)
+}
+
+// Update returns a builder for updating this SenderKeyDistribution.
+// Note that you need to call SenderKeyDistribution.Unwrap() before calling this method if this SenderKeyDistribution
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (skd *SenderKeyDistribution) Update() *SenderKeyDistributionUpdateOne {
+	return NewSenderKeyDistributionClient(skd.config).UpdateOne(skd)
+}
+
+// Unwrap unwraps the SenderKeyDistribution entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (skd *SenderKeyDistribution) Unwrap() *SenderKeyDistribution {
+	_tx, ok := skd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SenderKeyDistribution is not a transactional entity")
+	}
+	skd.config.driver = _tx.drv
+	return skd
+}
+
+// String implements the fmt.Stringer.
+func (skd *SenderKeyDistribution) String() string {
+	var builder strings.Builder
+	builder.WriteString("SenderKeyDistribution(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", skd.ID))
+	builder.WriteString("distribution_id=")
+	builder.WriteString(skd.DistributionID)
+	builder.WriteString(", ")
+	builder.WriteString("sender_device_id=")
+	builder.WriteString(fmt.Sprintf("%v", skd.SenderDeviceID))
+	builder.WriteString(", ")
+	builder.WriteString("cipher_text=")
+	builder.WriteString(skd.CipherText)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(skd.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SenderKeyDistributions is a parsable slice of SenderKeyDistribution.
+type SenderKeyDistributions []*SenderKeyDistribution
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package senderkeydistribution
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the senderkeydistribution type in the database.
+	Label = "sender_key_distribution"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDistributionID holds the string denoting the distribution_id field in the database.
+	FieldDistributionID = "distribution_id"
+	// FieldSenderDeviceID holds the string denoting the sender_device_id field in the database.
+	FieldSenderDeviceID = "sender_device_id"
+	// FieldCipherText holds the string denoting the cipher_text field in the database.
+	FieldCipherText = "cipher_text"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRoom holds the string denoting the room edge name in mutations.
+	EdgeRoom = "room"
+	// EdgeSender holds the string denoting the sender edge name in mutations.
+	EdgeSender = "sender"
+	// EdgeDevice holds the string denoting the device edge name in mutations.
+	EdgeDevice = "device"
+	// Table holds the table name of the senderkeydistribution in the database.
+	Table = "sender_key_distributions"
+	// RoomTable is the table that holds the room relation/edge.
+	RoomTable = "sender_key_distributions"
+	// RoomInverseTable is the table name for the Room entity.
+	// It exists in this package in order to avoid circular dependency with the "room" package.
+	RoomInverseTable = "rooms"
+	// RoomColumn is the table column denoting the room relation/edge.
+	RoomColumn = "sender_key_distribution_room"
+	// SenderTable is the table that holds the sender relation/edge.
+	SenderTable = "sender_key_distributions"
+	// SenderInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	SenderInverseTable = "users"
+	// SenderColumn is the table column denoting the sender relation/edge.
+	SenderColumn = "sender_key_distribution_sender"
+	// DeviceTable is the table that holds the device relation/edge.
+	DeviceTable = "sender_key_distributions"
+	// DeviceInverseTable is the table name for the Device entity.
+	// It exists in this package in order to avoid circular dependency with the "device" package.
+	DeviceInverseTable = "devices"
+	// DeviceColumn is the table column denoting the device relation/edge.
+	DeviceColumn = "sender_key_distribution_device"
+)
+
+// Columns holds all SQL columns for senderkeydistribution fields.
+var Columns = []string{
+	FieldID,
+	FieldDistributionID,
+	FieldSenderDeviceID,
+	FieldCipherText,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "sender_key_distributions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"sender_key_distribution_room",
+	"sender_key_distribution_sender",
+	"sender_key_distribution_device",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eleven-am/enclave/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DistributionIDValidator is a validator for the "distribution_id" field. It is called by the builders before save.
+	DistributionIDValidator func(string) error
+	// SenderDeviceIDValidator is a validator for the "sender_device_id" field. It is called by the builders before save.
+	SenderDeviceIDValidator func(int) error
+	// CipherTextValidator is a validator for the "cipher_text" field. It is called by the builders before save.
+	CipherTextValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the SenderKeyDistribution queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDistributionID orders the results by the distribution_id field.
+func ByDistributionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDistributionID, opts...).ToFunc()
+}
+
+// BySenderDeviceID orders the results by the sender_device_id field.
+func BySenderDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderDeviceID, opts...).ToFunc()
+}
+
+// ByCipherText orders the results by the cipher_text field.
+func ByCipherText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCipherText, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRoomField orders the results by room field.
+func ByRoomField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoomStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySenderField orders the results by sender field.
+func BySenderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSenderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDeviceField orders the results by device field.
+func ByDeviceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeviceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRoomStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoomInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RoomTable, RoomColumn),
+	)
+}
+func newSenderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SenderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SenderTable, SenderColumn),
+	)
+}
+func newDeviceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeviceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, DeviceTable, DeviceColumn),
+	)
+}
@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package senderkeydistribution
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldLTE(FieldID, id))
+}
+
+// DistributionID applies equality check predicate on the "distribution_id" field. It's identical to DistributionIDEQ.
+func DistributionID(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEQ(FieldDistributionID, v))
+}
+
+// SenderDeviceID applies equality check predicate on the "sender_device_id" field. It's identical to SenderDeviceIDEQ.
+func SenderDeviceID(v int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEQ(FieldSenderDeviceID, v))
+}
+
+// CipherText applies equality check predicate on the "cipher_text" field. It's identical to CipherTextEQ.
+func CipherText(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEQ(FieldCipherText, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DistributionIDEQ applies the EQ predicate on the "distribution_id" field.
+func DistributionIDEQ(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEQ(FieldDistributionID, v))
+}
+
+// DistributionIDNEQ applies the NEQ predicate on the "distribution_id" field.
+func DistributionIDNEQ(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldNEQ(FieldDistributionID, v))
+}
+
+// DistributionIDIn applies the In predicate on the "distribution_id" field.
+func DistributionIDIn(vs ...string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldIn(FieldDistributionID, vs...))
+}
+
+// DistributionIDNotIn applies the NotIn predicate on the "distribution_id" field.
+func DistributionIDNotIn(vs ...string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldNotIn(FieldDistributionID, vs...))
+}
+
+// DistributionIDGT applies the GT predicate on the "distribution_id" field.
+func DistributionIDGT(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldGT(FieldDistributionID, v))
+}
+
+// DistributionIDGTE applies the GTE predicate on the "distribution_id" field.
+func DistributionIDGTE(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldGTE(FieldDistributionID, v))
+}
+
+// DistributionIDLT applies the LT predicate on the "distribution_id" field.
+func DistributionIDLT(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldLT(FieldDistributionID, v))
+}
+
+// DistributionIDLTE applies the LTE predicate on the "distribution_id" field.
+func DistributionIDLTE(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldLTE(FieldDistributionID, v))
+}
+
+// DistributionIDContains applies the Contains predicate on the "distribution_id" field.
+func DistributionIDContains(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldContains(FieldDistributionID, v))
+}
+
+// DistributionIDHasPrefix applies the HasPrefix predicate on the "distribution_id" field.
+func DistributionIDHasPrefix(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldHasPrefix(FieldDistributionID, v))
+}
+
+// DistributionIDHasSuffix applies the HasSuffix predicate on the "distribution_id" field.
+func DistributionIDHasSuffix(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldHasSuffix(FieldDistributionID, v))
+}
+
+// DistributionIDEqualFold applies the EqualFold predicate on the "distribution_id" field.
+func DistributionIDEqualFold(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEqualFold(FieldDistributionID, v))
+}
+
+// DistributionIDContainsFold applies the ContainsFold predicate on the "distribution_id" field.
+func DistributionIDContainsFold(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldContainsFold(FieldDistributionID, v))
+}
+
+// SenderDeviceIDEQ applies the EQ predicate on the "sender_device_id" field.
+func SenderDeviceIDEQ(v int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEQ(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDNEQ applies the NEQ predicate on the "sender_device_id" field.
+func SenderDeviceIDNEQ(v int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldNEQ(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDIn applies the In predicate on the "sender_device_id" field.
+func SenderDeviceIDIn(vs ...int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldIn(FieldSenderDeviceID, vs...))
+}
+
+// SenderDeviceIDNotIn applies the NotIn predicate on the "sender_device_id" field.
+func SenderDeviceIDNotIn(vs ...int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldNotIn(FieldSenderDeviceID, vs...))
+}
+
+// SenderDeviceIDGT applies the GT predicate on the "sender_device_id" field.
+func SenderDeviceIDGT(v int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldGT(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDGTE applies the GTE predicate on the "sender_device_id" field.
+func SenderDeviceIDGTE(v int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldGTE(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDLT applies the LT predicate on the "sender_device_id" field.
+func SenderDeviceIDLT(v int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldLT(FieldSenderDeviceID, v))
+}
+
+// SenderDeviceIDLTE applies the LTE predicate on the "sender_device_id" field.
+func SenderDeviceIDLTE(v int) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldLTE(FieldSenderDeviceID, v))
+}
+
+// CipherTextEQ applies the EQ predicate on the "cipher_text" field.
+func CipherTextEQ(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEQ(FieldCipherText, v))
+}
+
+// CipherTextNEQ applies the NEQ predicate on the "cipher_text" field.
+func CipherTextNEQ(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldNEQ(FieldCipherText, v))
+}
+
+// CipherTextIn applies the In predicate on the "cipher_text" field.
+func CipherTextIn(vs ...string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldIn(FieldCipherText, vs...))
+}
+
+// CipherTextNotIn applies the NotIn predicate on the "cipher_text" field.
+func CipherTextNotIn(vs ...string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldNotIn(FieldCipherText, vs...))
+}
+
+// CipherTextGT applies the GT predicate on the "cipher_text" field.
+func CipherTextGT(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldGT(FieldCipherText, v))
+}
+
+// CipherTextGTE applies the GTE predicate on the "cipher_text" field.
+func CipherTextGTE(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldGTE(FieldCipherText, v))
+}
+
+// CipherTextLT applies the LT predicate on the "cipher_text" field.
+func CipherTextLT(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldLT(FieldCipherText, v))
+}
+
+// CipherTextLTE applies the LTE predicate on the "cipher_text" field.
+func CipherTextLTE(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldLTE(FieldCipherText, v))
+}
+
+// CipherTextContains applies the Contains predicate on the "cipher_text" field.
+func CipherTextContains(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldContains(FieldCipherText, v))
+}
+
+// CipherTextHasPrefix applies the HasPrefix predicate on the "cipher_text" field.
+func CipherTextHasPrefix(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldHasPrefix(FieldCipherText, v))
+}
+
+// CipherTextHasSuffix applies the HasSuffix predicate on the "cipher_text" field.
+func CipherTextHasSuffix(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldHasSuffix(FieldCipherText, v))
+}
+
+// CipherTextEqualFold applies the EqualFold predicate on the "cipher_text" field.
+func CipherTextEqualFold(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEqualFold(FieldCipherText, v))
+}
+
+// CipherTextContainsFold applies the ContainsFold predicate on the "cipher_text" field.
+func CipherTextContainsFold(v string) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldContainsFold(FieldCipherText, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRoom applies the HasEdge predicate on the "room" edge.
+func HasRoom() predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RoomTable, RoomColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoomWith applies the HasEdge predicate on the "room" edge with a given conditions (other predicates).
+func HasRoomWith(preds ...predicate.Room) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(func(s *sql.Selector) {
+		step := newRoomStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSender applies the HasEdge predicate on the "sender" edge.
+func HasSender() predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SenderTable, SenderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderWith applies the HasEdge predicate on the "sender" edge with a given conditions (other predicates).
+func HasSenderWith(preds ...predicate.User) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(func(s *sql.Selector) {
+		step := newSenderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDevice applies the HasEdge predicate on the "device" edge.
+func HasDevice() predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, DeviceTable, DeviceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeviceWith applies the HasEdge predicate on the "device" edge with a given conditions (other predicates).
+func HasDeviceWith(preds ...predicate.Device) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(func(s *sql.Selector) {
+		step := newDeviceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SenderKeyDistribution) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SenderKeyDistribution) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SenderKeyDistribution) predicate.SenderKeyDistribution {
+	return predicate.SenderKeyDistribution(sql.NotPredicates(p))
+}
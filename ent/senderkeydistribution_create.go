@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// SenderKeyDistributionCreate is the builder for creating a SenderKeyDistribution entity.
+type SenderKeyDistributionCreate struct {
+	config
+	mutation *SenderKeyDistributionMutation
+	hooks    []Hook
+}
+
+// SetDistributionID sets the "distribution_id" field.
+func (skdc *SenderKeyDistributionCreate) SetDistributionID(s string) *SenderKeyDistributionCreate {
+	skdc.mutation.SetDistributionID(s)
+	return skdc
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (skdc *SenderKeyDistributionCreate) SetSenderDeviceID(i int) *SenderKeyDistributionCreate {
+	skdc.mutation.SetSenderDeviceID(i)
+	return skdc
+}
+
+// SetCipherText sets the "cipher_text" field.
+func (skdc *SenderKeyDistributionCreate) SetCipherText(s string) *SenderKeyDistributionCreate {
+	skdc.mutation.SetCipherText(s)
+	return skdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skdc *SenderKeyDistributionCreate) SetCreatedAt(t time.Time) *SenderKeyDistributionCreate {
+	skdc.mutation.SetCreatedAt(t)
+	return skdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skdc *SenderKeyDistributionCreate) SetNillableCreatedAt(t *time.Time) *SenderKeyDistributionCreate {
+	if t != nil {
+		skdc.SetCreatedAt(*t)
+	}
+	return skdc
+}
+
+// SetRoomID sets the "room" edge to the Room entity by ID.
+func (skdc *SenderKeyDistributionCreate) SetRoomID(id int) *SenderKeyDistributionCreate {
+	skdc.mutation.SetRoomID(id)
+	return skdc
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (skdc *SenderKeyDistributionCreate) SetRoom(r *Room) *SenderKeyDistributionCreate {
+	return skdc.SetRoomID(r.ID)
+}
+
+// SetSenderID sets the "sender" edge to the User entity by ID.
+func (skdc *SenderKeyDistributionCreate) SetSenderID(id int) *SenderKeyDistributionCreate {
+	skdc.mutation.SetSenderID(id)
+	return skdc
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (skdc *SenderKeyDistributionCreate) SetSender(u *User) *SenderKeyDistributionCreate {
+	return skdc.SetSenderID(u.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (skdc *SenderKeyDistributionCreate) SetDeviceID(id int) *SenderKeyDistributionCreate {
+	skdc.mutation.SetDeviceID(id)
+	return skdc
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (skdc *SenderKeyDistributionCreate) SetDevice(d *Device) *SenderKeyDistributionCreate {
+	return skdc.SetDeviceID(d.ID)
+}
+
+// Mutation returns the SenderKeyDistributionMutation object of the builder.
+func (skdc *SenderKeyDistributionCreate) Mutation() *SenderKeyDistributionMutation {
+	return skdc.mutation
+}
+
+// Save creates the SenderKeyDistribution in the database.
+func (skdc *SenderKeyDistributionCreate) Save(ctx context.Context) (*SenderKeyDistribution, error) {
+	if err := skdc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, skdc.sqlSave, skdc.mutation, skdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (skdc *SenderKeyDistributionCreate) SaveX(ctx context.Context) *SenderKeyDistribution {
+	v, err := skdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skdc *SenderKeyDistributionCreate) Exec(ctx context.Context) error {
+	_, err := skdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skdc *SenderKeyDistributionCreate) ExecX(ctx context.Context) {
+	if err := skdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skdc *SenderKeyDistributionCreate) defaults() error {
+	if _, ok := skdc.mutation.CreatedAt(); !ok {
+		if senderkeydistribution.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized senderkeydistribution.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := senderkeydistribution.DefaultCreatedAt()
+		skdc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skdc *SenderKeyDistributionCreate) check() error {
+	if _, ok := skdc.mutation.DistributionID(); !ok {
+		return &ValidationError{Name: "distribution_id", err: errors.New(`ent: missing required field "SenderKeyDistribution.distribution_id"`)}
+	}
+	if v, ok := skdc.mutation.DistributionID(); ok {
+		if err := senderkeydistribution.DistributionIDValidator(v); err != nil {
+			return &ValidationError{Name: "distribution_id", err: fmt.Errorf(`ent: validator failed for field "SenderKeyDistribution.distribution_id": %w`, err)}
+		}
+	}
+	if _, ok := skdc.mutation.SenderDeviceID(); !ok {
+		return &ValidationError{Name: "sender_device_id", err: errors.New(`ent: missing required field "SenderKeyDistribution.sender_device_id"`)}
+	}
+	if v, ok := skdc.mutation.SenderDeviceID(); ok {
+		if err := senderkeydistribution.SenderDeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_device_id", err: fmt.Errorf(`ent: validator failed for field "SenderKeyDistribution.sender_device_id": %w`, err)}
+		}
+	}
+	if _, ok := skdc.mutation.CipherText(); !ok {
+		return &ValidationError{Name: "cipher_text", err: errors.New(`ent: missing required field "SenderKeyDistribution.cipher_text"`)}
+	}
+	if v, ok := skdc.mutation.CipherText(); ok {
+		if err := senderkeydistribution.CipherTextValidator(v); err != nil {
+			return &ValidationError{Name: "cipher_text", err: fmt.Errorf(`ent: validator failed for field "SenderKeyDistribution.cipher_text": %w`, err)}
+		}
+	}
+	if _, ok := skdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SenderKeyDistribution.created_at"`)}
+	}
+	if _, ok := skdc.mutation.RoomID(); !ok {
+		return &ValidationError{Name: "room", err: errors.New(`ent: missing required edge "SenderKeyDistribution.room"`)}
+	}
+	if _, ok := skdc.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender", err: errors.New(`ent: missing required edge "SenderKeyDistribution.sender"`)}
+	}
+	if _, ok := skdc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`ent: missing required edge "SenderKeyDistribution.device"`)}
+	}
+	return nil
+}
+
+func (skdc *SenderKeyDistributionCreate) sqlSave(ctx context.Context) (*SenderKeyDistribution, error) {
+	if err := skdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := skdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, skdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	skdc.mutation.id = &_node.ID
+	skdc.mutation.done = true
+	return _node, nil
+}
+
+func (skdc *SenderKeyDistributionCreate) createSpec() (*SenderKeyDistribution, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SenderKeyDistribution{config: skdc.config}
+		_spec = sqlgraph.NewCreateSpec(senderkeydistribution.Table, sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt))
+	)
+	if value, ok := skdc.mutation.DistributionID(); ok {
+		_spec.SetField(senderkeydistribution.FieldDistributionID, field.TypeString, value)
+		_node.DistributionID = value
+	}
+	if value, ok := skdc.mutation.SenderDeviceID(); ok {
+		_spec.SetField(senderkeydistribution.FieldSenderDeviceID, field.TypeInt, value)
+		_node.SenderDeviceID = value
+	}
+	if value, ok := skdc.mutation.CipherText(); ok {
+		_spec.SetField(senderkeydistribution.FieldCipherText, field.TypeString, value)
+		_node.CipherText = value
+	}
+	if value, ok := skdc.mutation.CreatedAt(); ok {
+		_spec.SetField(senderkeydistribution.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := skdc.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.RoomTable,
+			Columns: []string{senderkeydistribution.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_key_distribution_room = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := skdc.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.SenderTable,
+			Columns: []string{senderkeydistribution.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_key_distribution_sender = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := skdc.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.DeviceTable,
+			Columns: []string{senderkeydistribution.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_key_distribution_device = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SenderKeyDistributionCreateBulk is the builder for creating many SenderKeyDistribution entities in bulk.
+type SenderKeyDistributionCreateBulk struct {
+	config
+	err      error
+	builders []*SenderKeyDistributionCreate
+}
+
+// Save creates the SenderKeyDistribution entities in the database.
+func (skdcb *SenderKeyDistributionCreateBulk) Save(ctx context.Context) ([]*SenderKeyDistribution, error) {
+	if skdcb.err != nil {
+		return nil, skdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(skdcb.builders))
+	nodes := make([]*SenderKeyDistribution, len(skdcb.builders))
+	mutators := make([]Mutator, len(skdcb.builders))
+	for i := range skdcb.builders {
+		func(i int, root context.Context) {
+			builder := skdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SenderKeyDistributionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, skdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, skdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, skdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skdcb *SenderKeyDistributionCreateBulk) SaveX(ctx context.Context) []*SenderKeyDistribution {
+	v, err := skdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skdcb *SenderKeyDistributionCreateBulk) Exec(ctx context.Context) error {
+	_, err := skdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skdcb *SenderKeyDistributionCreateBulk) ExecX(ctx context.Context) {
+	if err := skdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
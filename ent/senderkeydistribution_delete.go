@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+)
+
+// SenderKeyDistributionDelete is the builder for deleting a SenderKeyDistribution entity.
+type SenderKeyDistributionDelete struct {
+	config
+	hooks    []Hook
+	mutation *SenderKeyDistributionMutation
+}
+
+// Where appends a list predicates to the SenderKeyDistributionDelete builder.
+func (skdd *SenderKeyDistributionDelete) Where(ps ...predicate.SenderKeyDistribution) *SenderKeyDistributionDelete {
+	skdd.mutation.Where(ps...)
+	return skdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (skdd *SenderKeyDistributionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, skdd.sqlExec, skdd.mutation, skdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skdd *SenderKeyDistributionDelete) ExecX(ctx context.Context) int {
+	n, err := skdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (skdd *SenderKeyDistributionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(senderkeydistribution.Table, sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt))
+	if ps := skdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, skdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	skdd.mutation.done = true
+	return affected, err
+}
+
+// SenderKeyDistributionDeleteOne is the builder for deleting a single SenderKeyDistribution entity.
+type SenderKeyDistributionDeleteOne struct {
+	skdd *SenderKeyDistributionDelete
+}
+
+// Where appends a list predicates to the SenderKeyDistributionDelete builder.
+func (skddo *SenderKeyDistributionDeleteOne) Where(ps ...predicate.SenderKeyDistribution) *SenderKeyDistributionDeleteOne {
+	skddo.skdd.mutation.Where(ps...)
+	return skddo
+}
+
+// Exec executes the deletion query.
+func (skddo *SenderKeyDistributionDeleteOne) Exec(ctx context.Context) error {
+	n, err := skddo.skdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{senderkeydistribution.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skddo *SenderKeyDistributionDeleteOne) ExecX(ctx context.Context) {
+	if err := skddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,770 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// SenderKeyDistributionQuery is the builder for querying SenderKeyDistribution entities.
+type SenderKeyDistributionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []senderkeydistribution.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SenderKeyDistribution
+	withRoom   *RoomQuery
+	withSender *UserQuery
+	withDevice *DeviceQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SenderKeyDistributionQuery builder.
+func (skdq *SenderKeyDistributionQuery) Where(ps ...predicate.SenderKeyDistribution) *SenderKeyDistributionQuery {
+	skdq.predicates = append(skdq.predicates, ps...)
+	return skdq
+}
+
+// Limit the number of records to be returned by this query.
+func (skdq *SenderKeyDistributionQuery) Limit(limit int) *SenderKeyDistributionQuery {
+	skdq.ctx.Limit = &limit
+	return skdq
+}
+
+// Offset to start from.
+func (skdq *SenderKeyDistributionQuery) Offset(offset int) *SenderKeyDistributionQuery {
+	skdq.ctx.Offset = &offset
+	return skdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (skdq *SenderKeyDistributionQuery) Unique(unique bool) *SenderKeyDistributionQuery {
+	skdq.ctx.Unique = &unique
+	return skdq
+}
+
+// Order specifies how the records should be ordered.
+func (skdq *SenderKeyDistributionQuery) Order(o ...senderkeydistribution.OrderOption) *SenderKeyDistributionQuery {
+	skdq.order = append(skdq.order, o...)
+	return skdq
+}
+
+// QueryRoom chains the current query on the "room" edge.
+func (skdq *SenderKeyDistributionQuery) QueryRoom() *RoomQuery {
+	query := (&RoomClient{config: skdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := skdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := skdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderkeydistribution.Table, senderkeydistribution.FieldID, selector),
+			sqlgraph.To(room.Table, room.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, senderkeydistribution.RoomTable, senderkeydistribution.RoomColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(skdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySender chains the current query on the "sender" edge.
+func (skdq *SenderKeyDistributionQuery) QuerySender() *UserQuery {
+	query := (&UserClient{config: skdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := skdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := skdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderkeydistribution.Table, senderkeydistribution.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, senderkeydistribution.SenderTable, senderkeydistribution.SenderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(skdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDevice chains the current query on the "device" edge.
+func (skdq *SenderKeyDistributionQuery) QueryDevice() *DeviceQuery {
+	query := (&DeviceClient{config: skdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := skdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := skdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderkeydistribution.Table, senderkeydistribution.FieldID, selector),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, senderkeydistribution.DeviceTable, senderkeydistribution.DeviceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(skdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SenderKeyDistribution entity from the query.
+// Returns a *NotFoundError when no SenderKeyDistribution was found.
+func (skdq *SenderKeyDistributionQuery) First(ctx context.Context) (*SenderKeyDistribution, error) {
+	nodes, err := skdq.Limit(1).All(setContextOp(ctx, skdq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{senderkeydistribution.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (skdq *SenderKeyDistributionQuery) FirstX(ctx context.Context) *SenderKeyDistribution {
+	node, err := skdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SenderKeyDistribution ID from the query.
+// Returns a *NotFoundError when no SenderKeyDistribution ID was found.
+func (skdq *SenderKeyDistributionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = skdq.Limit(1).IDs(setContextOp(ctx, skdq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{senderkeydistribution.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (skdq *SenderKeyDistributionQuery) FirstIDX(ctx context.Context) int {
+	id, err := skdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SenderKeyDistribution entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SenderKeyDistribution entity is found.
+// Returns a *NotFoundError when no SenderKeyDistribution entities are found.
+func (skdq *SenderKeyDistributionQuery) Only(ctx context.Context) (*SenderKeyDistribution, error) {
+	nodes, err := skdq.Limit(2).All(setContextOp(ctx, skdq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{senderkeydistribution.Label}
+	default:
+		return nil, &NotSingularError{senderkeydistribution.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (skdq *SenderKeyDistributionQuery) OnlyX(ctx context.Context) *SenderKeyDistribution {
+	node, err := skdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SenderKeyDistribution ID in the query.
+// Returns a *NotSingularError when more than one SenderKeyDistribution ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (skdq *SenderKeyDistributionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = skdq.Limit(2).IDs(setContextOp(ctx, skdq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{senderkeydistribution.Label}
+	default:
+		err = &NotSingularError{senderkeydistribution.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (skdq *SenderKeyDistributionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := skdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SenderKeyDistributions.
+func (skdq *SenderKeyDistributionQuery) All(ctx context.Context) ([]*SenderKeyDistribution, error) {
+	ctx = setContextOp(ctx, skdq.ctx, "All")
+	if err := skdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SenderKeyDistribution, *SenderKeyDistributionQuery]()
+	return withInterceptors[[]*SenderKeyDistribution](ctx, skdq, qr, skdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (skdq *SenderKeyDistributionQuery) AllX(ctx context.Context) []*SenderKeyDistribution {
+	nodes, err := skdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SenderKeyDistribution IDs.
+func (skdq *SenderKeyDistributionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if skdq.ctx.Unique == nil && skdq.path != nil {
+		skdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, skdq.ctx, "IDs")
+	if err = skdq.Select(senderkeydistribution.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (skdq *SenderKeyDistributionQuery) IDsX(ctx context.Context) []int {
+	ids, err := skdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (skdq *SenderKeyDistributionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, skdq.ctx, "Count")
+	if err := skdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, skdq, querierCount[*SenderKeyDistributionQuery](), skdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (skdq *SenderKeyDistributionQuery) CountX(ctx context.Context) int {
+	count, err := skdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (skdq *SenderKeyDistributionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, skdq.ctx, "Exist")
+	switch _, err := skdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (skdq *SenderKeyDistributionQuery) ExistX(ctx context.Context) bool {
+	exist, err := skdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SenderKeyDistributionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (skdq *SenderKeyDistributionQuery) Clone() *SenderKeyDistributionQuery {
+	if skdq == nil {
+		return nil
+	}
+	return &SenderKeyDistributionQuery{
+		config:     skdq.config,
+		ctx:        skdq.ctx.Clone(),
+		order:      append([]senderkeydistribution.OrderOption{}, skdq.order...),
+		inters:     append([]Interceptor{}, skdq.inters...),
+		predicates: append([]predicate.SenderKeyDistribution{}, skdq.predicates...),
+		withRoom:   skdq.withRoom.Clone(),
+		withSender: skdq.withSender.Clone(),
+		withDevice: skdq.withDevice.Clone(),
+		// clone intermediate query.
+		sql:  skdq.sql.Clone(),
+		path: skdq.path,
+	}
+}
+
+// WithRoom tells the query-builder to eager-load the nodes that are connected to
+// the "room" edge. The optional arguments are used to configure the query builder of the edge.
+func (skdq *SenderKeyDistributionQuery) WithRoom(opts ...func(*RoomQuery)) *SenderKeyDistributionQuery {
+	query := (&RoomClient{config: skdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	skdq.withRoom = query
+	return skdq
+}
+
+// WithSender tells the query-builder to eager-load the nodes that are connected to
+// the "sender" edge. The optional arguments are used to configure the query builder of the edge.
+func (skdq *SenderKeyDistributionQuery) WithSender(opts ...func(*UserQuery)) *SenderKeyDistributionQuery {
+	query := (&UserClient{config: skdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	skdq.withSender = query
+	return skdq
+}
+
+// WithDevice tells the query-builder to eager-load the nodes that are connected to
+// the "device" edge. The optional arguments are used to configure the query builder of the edge.
+func (skdq *SenderKeyDistributionQuery) WithDevice(opts ...func(*DeviceQuery)) *SenderKeyDistributionQuery {
+	query := (&DeviceClient{config: skdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	skdq.withDevice = query
+	return skdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		DistributionID string `json:"distribution_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SenderKeyDistribution.Query().
+//		GroupBy(senderkeydistribution.FieldDistributionID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (skdq *SenderKeyDistributionQuery) GroupBy(field string, fields ...string) *SenderKeyDistributionGroupBy {
+	skdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SenderKeyDistributionGroupBy{build: skdq}
+	grbuild.flds = &skdq.ctx.Fields
+	grbuild.label = senderkeydistribution.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		DistributionID string `json:"distribution_id,omitempty"`
+//	}
+//
+//	client.SenderKeyDistribution.Query().
+//		Select(senderkeydistribution.FieldDistributionID).
+//		Scan(ctx, &v)
+func (skdq *SenderKeyDistributionQuery) Select(fields ...string) *SenderKeyDistributionSelect {
+	skdq.ctx.Fields = append(skdq.ctx.Fields, fields...)
+	sbuild := &SenderKeyDistributionSelect{SenderKeyDistributionQuery: skdq}
+	sbuild.label = senderkeydistribution.Label
+	sbuild.flds, sbuild.scan = &skdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SenderKeyDistributionSelect configured with the given aggregations.
+func (skdq *SenderKeyDistributionQuery) Aggregate(fns ...AggregateFunc) *SenderKeyDistributionSelect {
+	return skdq.Select().Aggregate(fns...)
+}
+
+func (skdq *SenderKeyDistributionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range skdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, skdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range skdq.ctx.Fields {
+		if !senderkeydistribution.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if skdq.path != nil {
+		prev, err := skdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		skdq.sql = prev
+	}
+	if senderkeydistribution.Policy == nil {
+		return errors.New("ent: uninitialized senderkeydistribution.Policy (forgotten import ent/runtime?)")
+	}
+	if err := senderkeydistribution.Policy.EvalQuery(ctx, skdq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (skdq *SenderKeyDistributionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SenderKeyDistribution, error) {
+	var (
+		nodes       = []*SenderKeyDistribution{}
+		withFKs     = skdq.withFKs
+		_spec       = skdq.querySpec()
+		loadedTypes = [3]bool{
+			skdq.withRoom != nil,
+			skdq.withSender != nil,
+			skdq.withDevice != nil,
+		}
+	)
+	if skdq.withRoom != nil || skdq.withSender != nil || skdq.withDevice != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, senderkeydistribution.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SenderKeyDistribution).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SenderKeyDistribution{config: skdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, skdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := skdq.withRoom; query != nil {
+		if err := skdq.loadRoom(ctx, query, nodes, nil,
+			func(n *SenderKeyDistribution, e *Room) { n.Edges.Room = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := skdq.withSender; query != nil {
+		if err := skdq.loadSender(ctx, query, nodes, nil,
+			func(n *SenderKeyDistribution, e *User) { n.Edges.Sender = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := skdq.withDevice; query != nil {
+		if err := skdq.loadDevice(ctx, query, nodes, nil,
+			func(n *SenderKeyDistribution, e *Device) { n.Edges.Device = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (skdq *SenderKeyDistributionQuery) loadRoom(ctx context.Context, query *RoomQuery, nodes []*SenderKeyDistribution, init func(*SenderKeyDistribution), assign func(*SenderKeyDistribution, *Room)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SenderKeyDistribution)
+	for i := range nodes {
+		if nodes[i].sender_key_distribution_room == nil {
+			continue
+		}
+		fk := *nodes[i].sender_key_distribution_room
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(room.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_key_distribution_room" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (skdq *SenderKeyDistributionQuery) loadSender(ctx context.Context, query *UserQuery, nodes []*SenderKeyDistribution, init func(*SenderKeyDistribution), assign func(*SenderKeyDistribution, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SenderKeyDistribution)
+	for i := range nodes {
+		if nodes[i].sender_key_distribution_sender == nil {
+			continue
+		}
+		fk := *nodes[i].sender_key_distribution_sender
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_key_distribution_sender" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (skdq *SenderKeyDistributionQuery) loadDevice(ctx context.Context, query *DeviceQuery, nodes []*SenderKeyDistribution, init func(*SenderKeyDistribution), assign func(*SenderKeyDistribution, *Device)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SenderKeyDistribution)
+	for i := range nodes {
+		if nodes[i].sender_key_distribution_device == nil {
+			continue
+		}
+		fk := *nodes[i].sender_key_distribution_device
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(device.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_key_distribution_device" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (skdq *SenderKeyDistributionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := skdq.querySpec()
+	_spec.Node.Columns = skdq.ctx.Fields
+	if len(skdq.ctx.Fields) > 0 {
+		_spec.Unique = skdq.ctx.Unique != nil && *skdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, skdq.driver, _spec)
+}
+
+func (skdq *SenderKeyDistributionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(senderkeydistribution.Table, senderkeydistribution.Columns, sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt))
+	_spec.From = skdq.sql
+	if unique := skdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if skdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := skdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, senderkeydistribution.FieldID)
+		for i := range fields {
+			if fields[i] != senderkeydistribution.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := skdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := skdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := skdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := skdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (skdq *SenderKeyDistributionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(skdq.driver.Dialect())
+	t1 := builder.Table(senderkeydistribution.Table)
+	columns := skdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = senderkeydistribution.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if skdq.sql != nil {
+		selector = skdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if skdq.ctx.Unique != nil && *skdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range skdq.predicates {
+		p(selector)
+	}
+	for _, p := range skdq.order {
+		p(selector)
+	}
+	if offset := skdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := skdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SenderKeyDistributionGroupBy is the group-by builder for SenderKeyDistribution entities.
+type SenderKeyDistributionGroupBy struct {
+	selector
+	build *SenderKeyDistributionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (skdgb *SenderKeyDistributionGroupBy) Aggregate(fns ...AggregateFunc) *SenderKeyDistributionGroupBy {
+	skdgb.fns = append(skdgb.fns, fns...)
+	return skdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (skdgb *SenderKeyDistributionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, skdgb.build.ctx, "GroupBy")
+	if err := skdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SenderKeyDistributionQuery, *SenderKeyDistributionGroupBy](ctx, skdgb.build, skdgb, skdgb.build.inters, v)
+}
+
+func (skdgb *SenderKeyDistributionGroupBy) sqlScan(ctx context.Context, root *SenderKeyDistributionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(skdgb.fns))
+	for _, fn := range skdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*skdgb.flds)+len(skdgb.fns))
+		for _, f := range *skdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*skdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := skdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SenderKeyDistributionSelect is the builder for selecting fields of SenderKeyDistribution entities.
+type SenderKeyDistributionSelect struct {
+	*SenderKeyDistributionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (skds *SenderKeyDistributionSelect) Aggregate(fns ...AggregateFunc) *SenderKeyDistributionSelect {
+	skds.fns = append(skds.fns, fns...)
+	return skds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (skds *SenderKeyDistributionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, skds.ctx, "Select")
+	if err := skds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SenderKeyDistributionQuery, *SenderKeyDistributionSelect](ctx, skds.SenderKeyDistributionQuery, skds, skds.inters, v)
+}
+
+func (skds *SenderKeyDistributionSelect) sqlScan(ctx context.Context, root *SenderKeyDistributionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(skds.fns))
+	for _, fn := range skds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*skds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := skds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,675 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// SenderKeyDistributionUpdate is the builder for updating SenderKeyDistribution entities.
+type SenderKeyDistributionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SenderKeyDistributionMutation
+}
+
+// Where appends a list predicates to the SenderKeyDistributionUpdate builder.
+func (skdu *SenderKeyDistributionUpdate) Where(ps ...predicate.SenderKeyDistribution) *SenderKeyDistributionUpdate {
+	skdu.mutation.Where(ps...)
+	return skdu
+}
+
+// SetDistributionID sets the "distribution_id" field.
+func (skdu *SenderKeyDistributionUpdate) SetDistributionID(s string) *SenderKeyDistributionUpdate {
+	skdu.mutation.SetDistributionID(s)
+	return skdu
+}
+
+// SetNillableDistributionID sets the "distribution_id" field if the given value is not nil.
+func (skdu *SenderKeyDistributionUpdate) SetNillableDistributionID(s *string) *SenderKeyDistributionUpdate {
+	if s != nil {
+		skdu.SetDistributionID(*s)
+	}
+	return skdu
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (skdu *SenderKeyDistributionUpdate) SetSenderDeviceID(i int) *SenderKeyDistributionUpdate {
+	skdu.mutation.ResetSenderDeviceID()
+	skdu.mutation.SetSenderDeviceID(i)
+	return skdu
+}
+
+// SetNillableSenderDeviceID sets the "sender_device_id" field if the given value is not nil.
+func (skdu *SenderKeyDistributionUpdate) SetNillableSenderDeviceID(i *int) *SenderKeyDistributionUpdate {
+	if i != nil {
+		skdu.SetSenderDeviceID(*i)
+	}
+	return skdu
+}
+
+// AddSenderDeviceID adds i to the "sender_device_id" field.
+func (skdu *SenderKeyDistributionUpdate) AddSenderDeviceID(i int) *SenderKeyDistributionUpdate {
+	skdu.mutation.AddSenderDeviceID(i)
+	return skdu
+}
+
+// SetCipherText sets the "cipher_text" field.
+func (skdu *SenderKeyDistributionUpdate) SetCipherText(s string) *SenderKeyDistributionUpdate {
+	skdu.mutation.SetCipherText(s)
+	return skdu
+}
+
+// SetNillableCipherText sets the "cipher_text" field if the given value is not nil.
+func (skdu *SenderKeyDistributionUpdate) SetNillableCipherText(s *string) *SenderKeyDistributionUpdate {
+	if s != nil {
+		skdu.SetCipherText(*s)
+	}
+	return skdu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skdu *SenderKeyDistributionUpdate) SetCreatedAt(t time.Time) *SenderKeyDistributionUpdate {
+	skdu.mutation.SetCreatedAt(t)
+	return skdu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skdu *SenderKeyDistributionUpdate) SetNillableCreatedAt(t *time.Time) *SenderKeyDistributionUpdate {
+	if t != nil {
+		skdu.SetCreatedAt(*t)
+	}
+	return skdu
+}
+
+// SetRoomID sets the "room" edge to the Room entity by ID.
+func (skdu *SenderKeyDistributionUpdate) SetRoomID(id int) *SenderKeyDistributionUpdate {
+	skdu.mutation.SetRoomID(id)
+	return skdu
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (skdu *SenderKeyDistributionUpdate) SetRoom(r *Room) *SenderKeyDistributionUpdate {
+	return skdu.SetRoomID(r.ID)
+}
+
+// SetSenderID sets the "sender" edge to the User entity by ID.
+func (skdu *SenderKeyDistributionUpdate) SetSenderID(id int) *SenderKeyDistributionUpdate {
+	skdu.mutation.SetSenderID(id)
+	return skdu
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (skdu *SenderKeyDistributionUpdate) SetSender(u *User) *SenderKeyDistributionUpdate {
+	return skdu.SetSenderID(u.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (skdu *SenderKeyDistributionUpdate) SetDeviceID(id int) *SenderKeyDistributionUpdate {
+	skdu.mutation.SetDeviceID(id)
+	return skdu
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (skdu *SenderKeyDistributionUpdate) SetDevice(d *Device) *SenderKeyDistributionUpdate {
+	return skdu.SetDeviceID(d.ID)
+}
+
+// Mutation returns the SenderKeyDistributionMutation object of the builder.
+func (skdu *SenderKeyDistributionUpdate) Mutation() *SenderKeyDistributionMutation {
+	return skdu.mutation
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (skdu *SenderKeyDistributionUpdate) ClearRoom() *SenderKeyDistributionUpdate {
+	skdu.mutation.ClearRoom()
+	return skdu
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (skdu *SenderKeyDistributionUpdate) ClearSender() *SenderKeyDistributionUpdate {
+	skdu.mutation.ClearSender()
+	return skdu
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (skdu *SenderKeyDistributionUpdate) ClearDevice() *SenderKeyDistributionUpdate {
+	skdu.mutation.ClearDevice()
+	return skdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (skdu *SenderKeyDistributionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, skdu.sqlSave, skdu.mutation, skdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skdu *SenderKeyDistributionUpdate) SaveX(ctx context.Context) int {
+	affected, err := skdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (skdu *SenderKeyDistributionUpdate) Exec(ctx context.Context) error {
+	_, err := skdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skdu *SenderKeyDistributionUpdate) ExecX(ctx context.Context) {
+	if err := skdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skdu *SenderKeyDistributionUpdate) check() error {
+	if v, ok := skdu.mutation.DistributionID(); ok {
+		if err := senderkeydistribution.DistributionIDValidator(v); err != nil {
+			return &ValidationError{Name: "distribution_id", err: fmt.Errorf(`ent: validator failed for field "SenderKeyDistribution.distribution_id": %w`, err)}
+		}
+	}
+	if v, ok := skdu.mutation.SenderDeviceID(); ok {
+		if err := senderkeydistribution.SenderDeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_device_id", err: fmt.Errorf(`ent: validator failed for field "SenderKeyDistribution.sender_device_id": %w`, err)}
+		}
+	}
+	if v, ok := skdu.mutation.CipherText(); ok {
+		if err := senderkeydistribution.CipherTextValidator(v); err != nil {
+			return &ValidationError{Name: "cipher_text", err: fmt.Errorf(`ent: validator failed for field "SenderKeyDistribution.cipher_text": %w`, err)}
+		}
+	}
+	if _, ok := skdu.mutation.RoomID(); skdu.mutation.RoomCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SenderKeyDistribution.room"`)
+	}
+	if _, ok := skdu.mutation.SenderID(); skdu.mutation.SenderCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SenderKeyDistribution.sender"`)
+	}
+	if _, ok := skdu.mutation.DeviceID(); skdu.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SenderKeyDistribution.device"`)
+	}
+	return nil
+}
+
+func (skdu *SenderKeyDistributionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := skdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(senderkeydistribution.Table, senderkeydistribution.Columns, sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt))
+	if ps := skdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := skdu.mutation.DistributionID(); ok {
+		_spec.SetField(senderkeydistribution.FieldDistributionID, field.TypeString, value)
+	}
+	if value, ok := skdu.mutation.SenderDeviceID(); ok {
+		_spec.SetField(senderkeydistribution.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if value, ok := skdu.mutation.AddedSenderDeviceID(); ok {
+		_spec.AddField(senderkeydistribution.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if value, ok := skdu.mutation.CipherText(); ok {
+		_spec.SetField(senderkeydistribution.FieldCipherText, field.TypeString, value)
+	}
+	if value, ok := skdu.mutation.CreatedAt(); ok {
+		_spec.SetField(senderkeydistribution.FieldCreatedAt, field.TypeTime, value)
+	}
+	if skdu.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.RoomTable,
+			Columns: []string{senderkeydistribution.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skdu.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.RoomTable,
+			Columns: []string{senderkeydistribution.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if skdu.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.SenderTable,
+			Columns: []string{senderkeydistribution.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skdu.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.SenderTable,
+			Columns: []string{senderkeydistribution.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if skdu.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.DeviceTable,
+			Columns: []string{senderkeydistribution.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skdu.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.DeviceTable,
+			Columns: []string{senderkeydistribution.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, skdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{senderkeydistribution.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	skdu.mutation.done = true
+	return n, nil
+}
+
+// SenderKeyDistributionUpdateOne is the builder for updating a single SenderKeyDistribution entity.
+type SenderKeyDistributionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SenderKeyDistributionMutation
+}
+
+// SetDistributionID sets the "distribution_id" field.
+func (skduo *SenderKeyDistributionUpdateOne) SetDistributionID(s string) *SenderKeyDistributionUpdateOne {
+	skduo.mutation.SetDistributionID(s)
+	return skduo
+}
+
+// SetNillableDistributionID sets the "distribution_id" field if the given value is not nil.
+func (skduo *SenderKeyDistributionUpdateOne) SetNillableDistributionID(s *string) *SenderKeyDistributionUpdateOne {
+	if s != nil {
+		skduo.SetDistributionID(*s)
+	}
+	return skduo
+}
+
+// SetSenderDeviceID sets the "sender_device_id" field.
+func (skduo *SenderKeyDistributionUpdateOne) SetSenderDeviceID(i int) *SenderKeyDistributionUpdateOne {
+	skduo.mutation.ResetSenderDeviceID()
+	skduo.mutation.SetSenderDeviceID(i)
+	return skduo
+}
+
+// SetNillableSenderDeviceID sets the "sender_device_id" field if the given value is not nil.
+func (skduo *SenderKeyDistributionUpdateOne) SetNillableSenderDeviceID(i *int) *SenderKeyDistributionUpdateOne {
+	if i != nil {
+		skduo.SetSenderDeviceID(*i)
+	}
+	return skduo
+}
+
+// AddSenderDeviceID adds i to the "sender_device_id" field.
+func (skduo *SenderKeyDistributionUpdateOne) AddSenderDeviceID(i int) *SenderKeyDistributionUpdateOne {
+	skduo.mutation.AddSenderDeviceID(i)
+	return skduo
+}
+
+// SetCipherText sets the "cipher_text" field.
+func (skduo *SenderKeyDistributionUpdateOne) SetCipherText(s string) *SenderKeyDistributionUpdateOne {
+	skduo.mutation.SetCipherText(s)
+	return skduo
+}
+
+// SetNillableCipherText sets the "cipher_text" field if the given value is not nil.
+func (skduo *SenderKeyDistributionUpdateOne) SetNillableCipherText(s *string) *SenderKeyDistributionUpdateOne {
+	if s != nil {
+		skduo.SetCipherText(*s)
+	}
+	return skduo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skduo *SenderKeyDistributionUpdateOne) SetCreatedAt(t time.Time) *SenderKeyDistributionUpdateOne {
+	skduo.mutation.SetCreatedAt(t)
+	return skduo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skduo *SenderKeyDistributionUpdateOne) SetNillableCreatedAt(t *time.Time) *SenderKeyDistributionUpdateOne {
+	if t != nil {
+		skduo.SetCreatedAt(*t)
+	}
+	return skduo
+}
+
+// SetRoomID sets the "room" edge to the Room entity by ID.
+func (skduo *SenderKeyDistributionUpdateOne) SetRoomID(id int) *SenderKeyDistributionUpdateOne {
+	skduo.mutation.SetRoomID(id)
+	return skduo
+}
+
+// SetRoom sets the "room" edge to the Room entity.
+func (skduo *SenderKeyDistributionUpdateOne) SetRoom(r *Room) *SenderKeyDistributionUpdateOne {
+	return skduo.SetRoomID(r.ID)
+}
+
+// SetSenderID sets the "sender" edge to the User entity by ID.
+func (skduo *SenderKeyDistributionUpdateOne) SetSenderID(id int) *SenderKeyDistributionUpdateOne {
+	skduo.mutation.SetSenderID(id)
+	return skduo
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (skduo *SenderKeyDistributionUpdateOne) SetSender(u *User) *SenderKeyDistributionUpdateOne {
+	return skduo.SetSenderID(u.ID)
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (skduo *SenderKeyDistributionUpdateOne) SetDeviceID(id int) *SenderKeyDistributionUpdateOne {
+	skduo.mutation.SetDeviceID(id)
+	return skduo
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (skduo *SenderKeyDistributionUpdateOne) SetDevice(d *Device) *SenderKeyDistributionUpdateOne {
+	return skduo.SetDeviceID(d.ID)
+}
+
+// Mutation returns the SenderKeyDistributionMutation object of the builder.
+func (skduo *SenderKeyDistributionUpdateOne) Mutation() *SenderKeyDistributionMutation {
+	return skduo.mutation
+}
+
+// ClearRoom clears the "room" edge to the Room entity.
+func (skduo *SenderKeyDistributionUpdateOne) ClearRoom() *SenderKeyDistributionUpdateOne {
+	skduo.mutation.ClearRoom()
+	return skduo
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (skduo *SenderKeyDistributionUpdateOne) ClearSender() *SenderKeyDistributionUpdateOne {
+	skduo.mutation.ClearSender()
+	return skduo
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (skduo *SenderKeyDistributionUpdateOne) ClearDevice() *SenderKeyDistributionUpdateOne {
+	skduo.mutation.ClearDevice()
+	return skduo
+}
+
+// Where appends a list predicates to the SenderKeyDistributionUpdate builder.
+func (skduo *SenderKeyDistributionUpdateOne) Where(ps ...predicate.SenderKeyDistribution) *SenderKeyDistributionUpdateOne {
+	skduo.mutation.Where(ps...)
+	return skduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (skduo *SenderKeyDistributionUpdateOne) Select(field string, fields ...string) *SenderKeyDistributionUpdateOne {
+	skduo.fields = append([]string{field}, fields...)
+	return skduo
+}
+
+// Save executes the query and returns the updated SenderKeyDistribution entity.
+func (skduo *SenderKeyDistributionUpdateOne) Save(ctx context.Context) (*SenderKeyDistribution, error) {
+	return withHooks(ctx, skduo.sqlSave, skduo.mutation, skduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skduo *SenderKeyDistributionUpdateOne) SaveX(ctx context.Context) *SenderKeyDistribution {
+	node, err := skduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (skduo *SenderKeyDistributionUpdateOne) Exec(ctx context.Context) error {
+	_, err := skduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skduo *SenderKeyDistributionUpdateOne) ExecX(ctx context.Context) {
+	if err := skduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skduo *SenderKeyDistributionUpdateOne) check() error {
+	if v, ok := skduo.mutation.DistributionID(); ok {
+		if err := senderkeydistribution.DistributionIDValidator(v); err != nil {
+			return &ValidationError{Name: "distribution_id", err: fmt.Errorf(`ent: validator failed for field "SenderKeyDistribution.distribution_id": %w`, err)}
+		}
+	}
+	if v, ok := skduo.mutation.SenderDeviceID(); ok {
+		if err := senderkeydistribution.SenderDeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_device_id", err: fmt.Errorf(`ent: validator failed for field "SenderKeyDistribution.sender_device_id": %w`, err)}
+		}
+	}
+	if v, ok := skduo.mutation.CipherText(); ok {
+		if err := senderkeydistribution.CipherTextValidator(v); err != nil {
+			return &ValidationError{Name: "cipher_text", err: fmt.Errorf(`ent: validator failed for field "SenderKeyDistribution.cipher_text": %w`, err)}
+		}
+	}
+	if _, ok := skduo.mutation.RoomID(); skduo.mutation.RoomCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SenderKeyDistribution.room"`)
+	}
+	if _, ok := skduo.mutation.SenderID(); skduo.mutation.SenderCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SenderKeyDistribution.sender"`)
+	}
+	if _, ok := skduo.mutation.DeviceID(); skduo.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SenderKeyDistribution.device"`)
+	}
+	return nil
+}
+
+func (skduo *SenderKeyDistributionUpdateOne) sqlSave(ctx context.Context) (_node *SenderKeyDistribution, err error) {
+	if err := skduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(senderkeydistribution.Table, senderkeydistribution.Columns, sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt))
+	id, ok := skduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SenderKeyDistribution.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := skduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, senderkeydistribution.FieldID)
+		for _, f := range fields {
+			if !senderkeydistribution.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != senderkeydistribution.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := skduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := skduo.mutation.DistributionID(); ok {
+		_spec.SetField(senderkeydistribution.FieldDistributionID, field.TypeString, value)
+	}
+	if value, ok := skduo.mutation.SenderDeviceID(); ok {
+		_spec.SetField(senderkeydistribution.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if value, ok := skduo.mutation.AddedSenderDeviceID(); ok {
+		_spec.AddField(senderkeydistribution.FieldSenderDeviceID, field.TypeInt, value)
+	}
+	if value, ok := skduo.mutation.CipherText(); ok {
+		_spec.SetField(senderkeydistribution.FieldCipherText, field.TypeString, value)
+	}
+	if value, ok := skduo.mutation.CreatedAt(); ok {
+		_spec.SetField(senderkeydistribution.FieldCreatedAt, field.TypeTime, value)
+	}
+	if skduo.mutation.RoomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.RoomTable,
+			Columns: []string{senderkeydistribution.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skduo.mutation.RoomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.RoomTable,
+			Columns: []string{senderkeydistribution.RoomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if skduo.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.SenderTable,
+			Columns: []string{senderkeydistribution.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skduo.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.SenderTable,
+			Columns: []string{senderkeydistribution.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if skduo.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.DeviceTable,
+			Columns: []string{senderkeydistribution.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skduo.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   senderkeydistribution.DeviceTable,
+			Columns: []string{senderkeydistribution.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SenderKeyDistribution{config: skduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, skduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{senderkeydistribution.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	skduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,754 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// SessionUpdate is the builder for updating Session entities.
+type SessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SessionMutation
+}
+
+// Where appends a list predicates to the SessionUpdate builder.
+func (su *SessionUpdate) Where(ps ...predicate.Session) *SessionUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetDeviceLabel sets the "device_label" field.
+func (su *SessionUpdate) SetDeviceLabel(s string) *SessionUpdate {
+	su.mutation.SetDeviceLabel(s)
+	return su
+}
+
+// SetNillableDeviceLabel sets the "device_label" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableDeviceLabel(s *string) *SessionUpdate {
+	if s != nil {
+		su.SetDeviceLabel(*s)
+	}
+	return su
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (su *SessionUpdate) SetIPAddress(s string) *SessionUpdate {
+	su.mutation.SetIPAddress(s)
+	return su
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableIPAddress(s *string) *SessionUpdate {
+	if s != nil {
+		su.SetIPAddress(*s)
+	}
+	return su
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (su *SessionUpdate) SetUserAgent(s string) *SessionUpdate {
+	su.mutation.SetUserAgent(s)
+	return su
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableUserAgent(s *string) *SessionUpdate {
+	if s != nil {
+		su.SetUserAgent(*s)
+	}
+	return su
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (su *SessionUpdate) SetRefreshTokenHash(s string) *SessionUpdate {
+	su.mutation.SetRefreshTokenHash(s)
+	return su
+}
+
+// SetNillableRefreshTokenHash sets the "refresh_token_hash" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableRefreshTokenHash(s *string) *SessionUpdate {
+	if s != nil {
+		su.SetRefreshTokenHash(*s)
+	}
+	return su
+}
+
+// SetRetiredTokenHashes sets the "retired_token_hashes" field.
+func (su *SessionUpdate) SetRetiredTokenHashes(s []string) *SessionUpdate {
+	su.mutation.SetRetiredTokenHashes(s)
+	return su
+}
+
+// AppendRetiredTokenHashes appends s to the "retired_token_hashes" field.
+func (su *SessionUpdate) AppendRetiredTokenHashes(s []string) *SessionUpdate {
+	su.mutation.AppendRetiredTokenHashes(s)
+	return su
+}
+
+// ClearRetiredTokenHashes clears the value of the "retired_token_hashes" field.
+func (su *SessionUpdate) ClearRetiredTokenHashes() *SessionUpdate {
+	su.mutation.ClearRetiredTokenHashes()
+	return su
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (su *SessionUpdate) SetCreatedAt(t time.Time) *SessionUpdate {
+	su.mutation.SetCreatedAt(t)
+	return su
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableCreatedAt(t *time.Time) *SessionUpdate {
+	if t != nil {
+		su.SetCreatedAt(*t)
+	}
+	return su
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (su *SessionUpdate) SetLastUsedAt(t time.Time) *SessionUpdate {
+	su.mutation.SetLastUsedAt(t)
+	return su
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableLastUsedAt(t *time.Time) *SessionUpdate {
+	if t != nil {
+		su.SetLastUsedAt(*t)
+	}
+	return su
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (su *SessionUpdate) SetExpiresAt(t time.Time) *SessionUpdate {
+	su.mutation.SetExpiresAt(t)
+	return su
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableExpiresAt(t *time.Time) *SessionUpdate {
+	if t != nil {
+		su.SetExpiresAt(*t)
+	}
+	return su
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (su *SessionUpdate) SetRevokedAt(t time.Time) *SessionUpdate {
+	su.mutation.SetRevokedAt(t)
+	return su
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableRevokedAt(t *time.Time) *SessionUpdate {
+	if t != nil {
+		su.SetRevokedAt(*t)
+	}
+	return su
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (su *SessionUpdate) ClearRevokedAt() *SessionUpdate {
+	su.mutation.ClearRevokedAt()
+	return su
+}
+
+// SetRevocationReason sets the "revocation_reason" field.
+func (su *SessionUpdate) SetRevocationReason(s string) *SessionUpdate {
+	su.mutation.SetRevocationReason(s)
+	return su
+}
+
+// SetNillableRevocationReason sets the "revocation_reason" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableRevocationReason(s *string) *SessionUpdate {
+	if s != nil {
+		su.SetRevocationReason(*s)
+	}
+	return su
+}
+
+// ClearRevocationReason clears the value of the "revocation_reason" field.
+func (su *SessionUpdate) ClearRevocationReason() *SessionUpdate {
+	su.mutation.ClearRevocationReason()
+	return su
+}
+
+// SetMfaVerifiedAt sets the "mfa_verified_at" field.
+func (su *SessionUpdate) SetMfaVerifiedAt(t time.Time) *SessionUpdate {
+	su.mutation.SetMfaVerifiedAt(t)
+	return su
+}
+
+// SetNillableMfaVerifiedAt sets the "mfa_verified_at" field if the given value is not nil.
+func (su *SessionUpdate) SetNillableMfaVerifiedAt(t *time.Time) *SessionUpdate {
+	if t != nil {
+		su.SetMfaVerifiedAt(*t)
+	}
+	return su
+}
+
+// ClearMfaVerifiedAt clears the value of the "mfa_verified_at" field.
+func (su *SessionUpdate) ClearMfaVerifiedAt() *SessionUpdate {
+	su.mutation.ClearMfaVerifiedAt()
+	return su
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (su *SessionUpdate) SetUserID(id int) *SessionUpdate {
+	su.mutation.SetUserID(id)
+	return su
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (su *SessionUpdate) SetUser(u *User) *SessionUpdate {
+	return su.SetUserID(u.ID)
+}
+
+// Mutation returns the SessionMutation object of the builder.
+func (su *SessionUpdate) Mutation() *SessionMutation {
+	return su.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (su *SessionUpdate) ClearUser() *SessionUpdate {
+	su.mutation.ClearUser()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SessionUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SessionUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SessionUpdate) check() error {
+	if v, ok := su.mutation.RefreshTokenHash(); ok {
+		if err := session.RefreshTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_token_hash", err: fmt.Errorf(`ent: validator failed for field "Session.refresh_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := su.mutation.UserID(); su.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Session.user"`)
+	}
+	return nil
+}
+
+func (su *SessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(session.Table, session.Columns, sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.DeviceLabel(); ok {
+		_spec.SetField(session.FieldDeviceLabel, field.TypeString, value)
+	}
+	if value, ok := su.mutation.IPAddress(); ok {
+		_spec.SetField(session.FieldIPAddress, field.TypeString, value)
+	}
+	if value, ok := su.mutation.UserAgent(); ok {
+		_spec.SetField(session.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := su.mutation.RefreshTokenHash(); ok {
+		_spec.SetField(session.FieldRefreshTokenHash, field.TypeString, value)
+	}
+	if value, ok := su.mutation.RetiredTokenHashes(); ok {
+		_spec.SetField(session.FieldRetiredTokenHashes, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedRetiredTokenHashes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, session.FieldRetiredTokenHashes, value)
+		})
+	}
+	if su.mutation.RetiredTokenHashesCleared() {
+		_spec.ClearField(session.FieldRetiredTokenHashes, field.TypeJSON)
+	}
+	if value, ok := su.mutation.CreatedAt(); ok {
+		_spec.SetField(session.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.LastUsedAt(); ok {
+		_spec.SetField(session.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.ExpiresAt(); ok {
+		_spec.SetField(session.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.RevokedAt(); ok {
+		_spec.SetField(session.FieldRevokedAt, field.TypeTime, value)
+	}
+	if su.mutation.RevokedAtCleared() {
+		_spec.ClearField(session.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.RevocationReason(); ok {
+		_spec.SetField(session.FieldRevocationReason, field.TypeString, value)
+	}
+	if su.mutation.RevocationReasonCleared() {
+		_spec.ClearField(session.FieldRevocationReason, field.TypeString)
+	}
+	if value, ok := su.mutation.MfaVerifiedAt(); ok {
+		_spec.SetField(session.FieldMfaVerifiedAt, field.TypeTime, value)
+	}
+	if su.mutation.MfaVerifiedAtCleared() {
+		_spec.ClearField(session.FieldMfaVerifiedAt, field.TypeTime)
+	}
+	if su.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   session.UserTable,
+			Columns: []string{session.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   session.UserTable,
+			Columns: []string{session.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{session.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SessionUpdateOne is the builder for updating a single Session entity.
+type SessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SessionMutation
+}
+
+// SetDeviceLabel sets the "device_label" field.
+func (suo *SessionUpdateOne) SetDeviceLabel(s string) *SessionUpdateOne {
+	suo.mutation.SetDeviceLabel(s)
+	return suo
+}
+
+// SetNillableDeviceLabel sets the "device_label" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableDeviceLabel(s *string) *SessionUpdateOne {
+	if s != nil {
+		suo.SetDeviceLabel(*s)
+	}
+	return suo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (suo *SessionUpdateOne) SetIPAddress(s string) *SessionUpdateOne {
+	suo.mutation.SetIPAddress(s)
+	return suo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableIPAddress(s *string) *SessionUpdateOne {
+	if s != nil {
+		suo.SetIPAddress(*s)
+	}
+	return suo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (suo *SessionUpdateOne) SetUserAgent(s string) *SessionUpdateOne {
+	suo.mutation.SetUserAgent(s)
+	return suo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableUserAgent(s *string) *SessionUpdateOne {
+	if s != nil {
+		suo.SetUserAgent(*s)
+	}
+	return suo
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (suo *SessionUpdateOne) SetRefreshTokenHash(s string) *SessionUpdateOne {
+	suo.mutation.SetRefreshTokenHash(s)
+	return suo
+}
+
+// SetNillableRefreshTokenHash sets the "refresh_token_hash" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableRefreshTokenHash(s *string) *SessionUpdateOne {
+	if s != nil {
+		suo.SetRefreshTokenHash(*s)
+	}
+	return suo
+}
+
+// SetRetiredTokenHashes sets the "retired_token_hashes" field.
+func (suo *SessionUpdateOne) SetRetiredTokenHashes(s []string) *SessionUpdateOne {
+	suo.mutation.SetRetiredTokenHashes(s)
+	return suo
+}
+
+// AppendRetiredTokenHashes appends s to the "retired_token_hashes" field.
+func (suo *SessionUpdateOne) AppendRetiredTokenHashes(s []string) *SessionUpdateOne {
+	suo.mutation.AppendRetiredTokenHashes(s)
+	return suo
+}
+
+// ClearRetiredTokenHashes clears the value of the "retired_token_hashes" field.
+func (suo *SessionUpdateOne) ClearRetiredTokenHashes() *SessionUpdateOne {
+	suo.mutation.ClearRetiredTokenHashes()
+	return suo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (suo *SessionUpdateOne) SetCreatedAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetCreatedAt(t)
+	return suo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableCreatedAt(t *time.Time) *SessionUpdateOne {
+	if t != nil {
+		suo.SetCreatedAt(*t)
+	}
+	return suo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (suo *SessionUpdateOne) SetLastUsedAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetLastUsedAt(t)
+	return suo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableLastUsedAt(t *time.Time) *SessionUpdateOne {
+	if t != nil {
+		suo.SetLastUsedAt(*t)
+	}
+	return suo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (suo *SessionUpdateOne) SetExpiresAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetExpiresAt(t)
+	return suo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableExpiresAt(t *time.Time) *SessionUpdateOne {
+	if t != nil {
+		suo.SetExpiresAt(*t)
+	}
+	return suo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (suo *SessionUpdateOne) SetRevokedAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetRevokedAt(t)
+	return suo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableRevokedAt(t *time.Time) *SessionUpdateOne {
+	if t != nil {
+		suo.SetRevokedAt(*t)
+	}
+	return suo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (suo *SessionUpdateOne) ClearRevokedAt() *SessionUpdateOne {
+	suo.mutation.ClearRevokedAt()
+	return suo
+}
+
+// SetRevocationReason sets the "revocation_reason" field.
+func (suo *SessionUpdateOne) SetRevocationReason(s string) *SessionUpdateOne {
+	suo.mutation.SetRevocationReason(s)
+	return suo
+}
+
+// SetNillableRevocationReason sets the "revocation_reason" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableRevocationReason(s *string) *SessionUpdateOne {
+	if s != nil {
+		suo.SetRevocationReason(*s)
+	}
+	return suo
+}
+
+// ClearRevocationReason clears the value of the "revocation_reason" field.
+func (suo *SessionUpdateOne) ClearRevocationReason() *SessionUpdateOne {
+	suo.mutation.ClearRevocationReason()
+	return suo
+}
+
+// SetMfaVerifiedAt sets the "mfa_verified_at" field.
+func (suo *SessionUpdateOne) SetMfaVerifiedAt(t time.Time) *SessionUpdateOne {
+	suo.mutation.SetMfaVerifiedAt(t)
+	return suo
+}
+
+// SetNillableMfaVerifiedAt sets the "mfa_verified_at" field if the given value is not nil.
+func (suo *SessionUpdateOne) SetNillableMfaVerifiedAt(t *time.Time) *SessionUpdateOne {
+	if t != nil {
+		suo.SetMfaVerifiedAt(*t)
+	}
+	return suo
+}
+
+// ClearMfaVerifiedAt clears the value of the "mfa_verified_at" field.
+func (suo *SessionUpdateOne) ClearMfaVerifiedAt() *SessionUpdateOne {
+	suo.mutation.ClearMfaVerifiedAt()
+	return suo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (suo *SessionUpdateOne) SetUserID(id int) *SessionUpdateOne {
+	suo.mutation.SetUserID(id)
+	return suo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (suo *SessionUpdateOne) SetUser(u *User) *SessionUpdateOne {
+	return suo.SetUserID(u.ID)
+}
+
+// Mutation returns the SessionMutation object of the builder.
+func (suo *SessionUpdateOne) Mutation() *SessionMutation {
+	return suo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (suo *SessionUpdateOne) ClearUser() *SessionUpdateOne {
+	suo.mutation.ClearUser()
+	return suo
+}
+
+// Where appends a list predicates to the SessionUpdate builder.
+func (suo *SessionUpdateOne) Where(ps ...predicate.Session) *SessionUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SessionUpdateOne) Select(field string, fields ...string) *SessionUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Session entity.
+func (suo *SessionUpdateOne) Save(ctx context.Context) (*Session, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SessionUpdateOne) SaveX(ctx context.Context) *Session {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SessionUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SessionUpdateOne) check() error {
+	if v, ok := suo.mutation.RefreshTokenHash(); ok {
+		if err := session.RefreshTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "refresh_token_hash", err: fmt.Errorf(`ent: validator failed for field "Session.refresh_token_hash": %w`, err)}
+		}
+	}
+	if _, ok := suo.mutation.UserID(); suo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Session.user"`)
+	}
+	return nil
+}
+
+func (suo *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(session.Table, session.Columns, sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Session.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, session.FieldID)
+		for _, f := range fields {
+			if !session.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != session.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.DeviceLabel(); ok {
+		_spec.SetField(session.FieldDeviceLabel, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.IPAddress(); ok {
+		_spec.SetField(session.FieldIPAddress, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.UserAgent(); ok {
+		_spec.SetField(session.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.RefreshTokenHash(); ok {
+		_spec.SetField(session.FieldRefreshTokenHash, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.RetiredTokenHashes(); ok {
+		_spec.SetField(session.FieldRetiredTokenHashes, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedRetiredTokenHashes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, session.FieldRetiredTokenHashes, value)
+		})
+	}
+	if suo.mutation.RetiredTokenHashesCleared() {
+		_spec.ClearField(session.FieldRetiredTokenHashes, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.CreatedAt(); ok {
+		_spec.SetField(session.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.LastUsedAt(); ok {
+		_spec.SetField(session.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.ExpiresAt(); ok {
+		_spec.SetField(session.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.RevokedAt(); ok {
+		_spec.SetField(session.FieldRevokedAt, field.TypeTime, value)
+	}
+	if suo.mutation.RevokedAtCleared() {
+		_spec.ClearField(session.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.RevocationReason(); ok {
+		_spec.SetField(session.FieldRevocationReason, field.TypeString, value)
+	}
+	if suo.mutation.RevocationReasonCleared() {
+		_spec.ClearField(session.FieldRevocationReason, field.TypeString)
+	}
+	if value, ok := suo.mutation.MfaVerifiedAt(); ok {
+		_spec.SetField(session.FieldMfaVerifiedAt, field.TypeTime, value)
+	}
+	if suo.mutation.MfaVerifiedAtCleared() {
+		_spec.ClearField(session.FieldMfaVerifiedAt, field.TypeTime)
+	}
+	if suo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   session.UserTable,
+			Columns: []string{session.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   session.UserTable,
+			Columns: []string{session.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Session{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{session.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+)
+
+// SignedPreKey is the model entity for the SignedPreKey schema.
+type SignedPreKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// KeyID holds the value of the "key_id" field.
+	KeyID int `json:"key_id,omitempty"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey string `json:"public_key,omitempty"`
+	// Signature holds the value of the "signature" field.
+	Signature string `json:"signature,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SignedPreKeyQuery when eager-loading is set.
+	Edges                 SignedPreKeyEdges `json:"edges"`
+	signed_pre_key_device *int
+	selectValues          sql.SelectValues
+}
+
+// SignedPreKeyEdges holds the relations/edges for other nodes in the graph.
+type SignedPreKeyEdges struct {
+	// Device holds the value of the device edge.
+	Device *Device `json:"device,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DeviceOrErr returns the Device value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SignedPreKeyEdges) DeviceOrErr() (*Device, error) {
+	if e.Device != nil {
+		return e.Device, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: device.Label}
+	}
+	return nil, &NotLoadedError{edge: "device"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SignedPreKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case signedprekey.FieldID, signedprekey.FieldKeyID:
+			values[i] = new(sql.NullInt64)
+		case signedprekey.FieldPublicKey, signedprekey.FieldSignature:
+			values[i] = new(sql.NullString)
+		case signedprekey.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case signedprekey.ForeignKeys[0]: // signed_pre_key_device
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SignedPreKey fields.
+func (spk *SignedPreKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case signedprekey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			spk.ID = int(value.Int64)
+		case signedprekey.FieldKeyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				spk.KeyID = int(value.Int64)
+			}
+		case signedprekey.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value.Valid {
+				spk.PublicKey = value.String
+			}
+		case signedprekey.FieldSignature:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signature", values[i])
+			} else if value.Valid {
+				spk.Signature = value.String
+			}
+		case signedprekey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				spk.CreatedAt = value.Time
+			}
+		case signedprekey.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field signed_pre_key_device", value)
+			} else if value.Valid {
+				spk.signed_pre_key_device = new(int)
+				*spk.signed_pre_key_device = int(value.Int64)
+			}
+		default:
+			spk.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SignedPreKey.
+// This includes values selected through modifiers, order, etc.
+func (spk *SignedPreKey) Value(name string) (ent.Value, error) {
+	return spk.selectValues.Get(name)
+}
+
+// QueryDevice queries the "device" edge of the SignedPreKey entity.
+func (spk *SignedPreKey) QueryDevice() *DeviceQuery {
+	return NewSignedPreKeyClient(spk.config).QueryDevice(spk)
+}
+
+// Update returns a builder for updating this SignedPreKey.
+// Note that you need to call SignedPreKey.Unwrap() before calling this method if this SignedPreKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (spk *SignedPreKey) Update() *SignedPreKeyUpdateOne {
+	return NewSignedPreKeyClient(spk.config).UpdateOne(spk)
+}
+
+// Unwrap unwraps the SignedPreKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (spk *SignedPreKey) Unwrap() *SignedPreKey {
+	_tx, ok := spk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SignedPreKey is not a transactional entity")
+	}
+	spk.config.driver = _tx.drv
+	return spk
+}
+
+// String implements the fmt.Stringer.
+func (spk *SignedPreKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("SignedPreKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", spk.ID))
+	builder.WriteString("key_id=")
+	builder.WriteString(fmt.Sprintf("%v", spk.KeyID))
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(spk.PublicKey)
+	builder.WriteString(", ")
+	builder.WriteString("signature=")
+	builder.WriteString(spk.Signature)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(spk.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SignedPreKeys is a parsable slice of SignedPreKey.
+type SignedPreKeys []*SignedPreKey
@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+)
+
+// SignedPreKeyCreate is the builder for creating a SignedPreKey entity.
+type SignedPreKeyCreate struct {
+	config
+	mutation *SignedPreKeyMutation
+	hooks    []Hook
+}
+
+// SetKeyID sets the "key_id" field.
+func (spkc *SignedPreKeyCreate) SetKeyID(i int) *SignedPreKeyCreate {
+	spkc.mutation.SetKeyID(i)
+	return spkc
+}
+
+// SetPublicKey sets the "public_key" field.
+func (spkc *SignedPreKeyCreate) SetPublicKey(s string) *SignedPreKeyCreate {
+	spkc.mutation.SetPublicKey(s)
+	return spkc
+}
+
+// SetSignature sets the "signature" field.
+func (spkc *SignedPreKeyCreate) SetSignature(s string) *SignedPreKeyCreate {
+	spkc.mutation.SetSignature(s)
+	return spkc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (spkc *SignedPreKeyCreate) SetCreatedAt(t time.Time) *SignedPreKeyCreate {
+	spkc.mutation.SetCreatedAt(t)
+	return spkc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (spkc *SignedPreKeyCreate) SetNillableCreatedAt(t *time.Time) *SignedPreKeyCreate {
+	if t != nil {
+		spkc.SetCreatedAt(*t)
+	}
+	return spkc
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (spkc *SignedPreKeyCreate) SetDeviceID(id int) *SignedPreKeyCreate {
+	spkc.mutation.SetDeviceID(id)
+	return spkc
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (spkc *SignedPreKeyCreate) SetDevice(d *Device) *SignedPreKeyCreate {
+	return spkc.SetDeviceID(d.ID)
+}
+
+// Mutation returns the SignedPreKeyMutation object of the builder.
+func (spkc *SignedPreKeyCreate) Mutation() *SignedPreKeyMutation {
+	return spkc.mutation
+}
+
+// Save creates the SignedPreKey in the database.
+func (spkc *SignedPreKeyCreate) Save(ctx context.Context) (*SignedPreKey, error) {
+	spkc.defaults()
+	return withHooks(ctx, spkc.sqlSave, spkc.mutation, spkc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (spkc *SignedPreKeyCreate) SaveX(ctx context.Context) *SignedPreKey {
+	v, err := spkc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (spkc *SignedPreKeyCreate) Exec(ctx context.Context) error {
+	_, err := spkc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spkc *SignedPreKeyCreate) ExecX(ctx context.Context) {
+	if err := spkc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (spkc *SignedPreKeyCreate) defaults() {
+	if _, ok := spkc.mutation.CreatedAt(); !ok {
+		v := signedprekey.DefaultCreatedAt()
+		spkc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spkc *SignedPreKeyCreate) check() error {
+	if _, ok := spkc.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "SignedPreKey.key_id"`)}
+	}
+	if v, ok := spkc.mutation.KeyID(); ok {
+		if err := signedprekey.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "SignedPreKey.key_id": %w`, err)}
+		}
+	}
+	if _, ok := spkc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "SignedPreKey.public_key"`)}
+	}
+	if v, ok := spkc.mutation.PublicKey(); ok {
+		if err := signedprekey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "SignedPreKey.public_key": %w`, err)}
+		}
+	}
+	if _, ok := spkc.mutation.Signature(); !ok {
+		return &ValidationError{Name: "signature", err: errors.New(`ent: missing required field "SignedPreKey.signature"`)}
+	}
+	if v, ok := spkc.mutation.Signature(); ok {
+		if err := signedprekey.SignatureValidator(v); err != nil {
+			return &ValidationError{Name: "signature", err: fmt.Errorf(`ent: validator failed for field "SignedPreKey.signature": %w`, err)}
+		}
+	}
+	if _, ok := spkc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SignedPreKey.created_at"`)}
+	}
+	if _, ok := spkc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`ent: missing required edge "SignedPreKey.device"`)}
+	}
+	return nil
+}
+
+func (spkc *SignedPreKeyCreate) sqlSave(ctx context.Context) (*SignedPreKey, error) {
+	if err := spkc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := spkc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, spkc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	spkc.mutation.id = &_node.ID
+	spkc.mutation.done = true
+	return _node, nil
+}
+
+func (spkc *SignedPreKeyCreate) createSpec() (*SignedPreKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SignedPreKey{config: spkc.config}
+		_spec = sqlgraph.NewCreateSpec(signedprekey.Table, sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt))
+	)
+	if value, ok := spkc.mutation.KeyID(); ok {
+		_spec.SetField(signedprekey.FieldKeyID, field.TypeInt, value)
+		_node.KeyID = value
+	}
+	if value, ok := spkc.mutation.PublicKey(); ok {
+		_spec.SetField(signedprekey.FieldPublicKey, field.TypeString, value)
+		_node.PublicKey = value
+	}
+	if value, ok := spkc.mutation.Signature(); ok {
+		_spec.SetField(signedprekey.FieldSignature, field.TypeString, value)
+		_node.Signature = value
+	}
+	if value, ok := spkc.mutation.CreatedAt(); ok {
+		_spec.SetField(signedprekey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := spkc.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   signedprekey.DeviceTable,
+			Columns: []string{signedprekey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.signed_pre_key_device = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SignedPreKeyCreateBulk is the builder for creating many SignedPreKey entities in bulk.
+type SignedPreKeyCreateBulk struct {
+	config
+	err      error
+	builders []*SignedPreKeyCreate
+}
+
+// Save creates the SignedPreKey entities in the database.
+func (spkcb *SignedPreKeyCreateBulk) Save(ctx context.Context) ([]*SignedPreKey, error) {
+	if spkcb.err != nil {
+		return nil, spkcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(spkcb.builders))
+	nodes := make([]*SignedPreKey, len(spkcb.builders))
+	mutators := make([]Mutator, len(spkcb.builders))
+	for i := range spkcb.builders {
+		func(i int, root context.Context) {
+			builder := spkcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SignedPreKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, spkcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, spkcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, spkcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spkcb *SignedPreKeyCreateBulk) SaveX(ctx context.Context) []*SignedPreKey {
+	v, err := spkcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (spkcb *SignedPreKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := spkcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spkcb *SignedPreKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := spkcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
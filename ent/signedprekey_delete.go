@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+)
+
+// SignedPreKeyDelete is the builder for deleting a SignedPreKey entity.
+type SignedPreKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *SignedPreKeyMutation
+}
+
+// Where appends a list predicates to the SignedPreKeyDelete builder.
+func (spkd *SignedPreKeyDelete) Where(ps ...predicate.SignedPreKey) *SignedPreKeyDelete {
+	spkd.mutation.Where(ps...)
+	return spkd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (spkd *SignedPreKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, spkd.sqlExec, spkd.mutation, spkd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spkd *SignedPreKeyDelete) ExecX(ctx context.Context) int {
+	n, err := spkd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (spkd *SignedPreKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(signedprekey.Table, sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt))
+	if ps := spkd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, spkd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	spkd.mutation.done = true
+	return affected, err
+}
+
+// SignedPreKeyDeleteOne is the builder for deleting a single SignedPreKey entity.
+type SignedPreKeyDeleteOne struct {
+	spkd *SignedPreKeyDelete
+}
+
+// Where appends a list predicates to the SignedPreKeyDelete builder.
+func (spkdo *SignedPreKeyDeleteOne) Where(ps ...predicate.SignedPreKey) *SignedPreKeyDeleteOne {
+	spkdo.spkd.mutation.Where(ps...)
+	return spkdo
+}
+
+// Exec executes the deletion query.
+func (spkdo *SignedPreKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := spkdo.spkd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{signedprekey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spkdo *SignedPreKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := spkdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
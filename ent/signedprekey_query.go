@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+)
+
+// SignedPreKeyQuery is the builder for querying SignedPreKey entities.
+type SignedPreKeyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []signedprekey.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SignedPreKey
+	withDevice *DeviceQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SignedPreKeyQuery builder.
+func (spkq *SignedPreKeyQuery) Where(ps ...predicate.SignedPreKey) *SignedPreKeyQuery {
+	spkq.predicates = append(spkq.predicates, ps...)
+	return spkq
+}
+
+// Limit the number of records to be returned by this query.
+func (spkq *SignedPreKeyQuery) Limit(limit int) *SignedPreKeyQuery {
+	spkq.ctx.Limit = &limit
+	return spkq
+}
+
+// Offset to start from.
+func (spkq *SignedPreKeyQuery) Offset(offset int) *SignedPreKeyQuery {
+	spkq.ctx.Offset = &offset
+	return spkq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (spkq *SignedPreKeyQuery) Unique(unique bool) *SignedPreKeyQuery {
+	spkq.ctx.Unique = &unique
+	return spkq
+}
+
+// Order specifies how the records should be ordered.
+func (spkq *SignedPreKeyQuery) Order(o ...signedprekey.OrderOption) *SignedPreKeyQuery {
+	spkq.order = append(spkq.order, o...)
+	return spkq
+}
+
+// QueryDevice chains the current query on the "device" edge.
+func (spkq *SignedPreKeyQuery) QueryDevice() *DeviceQuery {
+	query := (&DeviceClient{config: spkq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spkq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(signedprekey.Table, signedprekey.FieldID, selector),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, signedprekey.DeviceTable, signedprekey.DeviceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spkq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SignedPreKey entity from the query.
+// Returns a *NotFoundError when no SignedPreKey was found.
+func (spkq *SignedPreKeyQuery) First(ctx context.Context) (*SignedPreKey, error) {
+	nodes, err := spkq.Limit(1).All(setContextOp(ctx, spkq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{signedprekey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (spkq *SignedPreKeyQuery) FirstX(ctx context.Context) *SignedPreKey {
+	node, err := spkq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SignedPreKey ID from the query.
+// Returns a *NotFoundError when no SignedPreKey ID was found.
+func (spkq *SignedPreKeyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = spkq.Limit(1).IDs(setContextOp(ctx, spkq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{signedprekey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (spkq *SignedPreKeyQuery) FirstIDX(ctx context.Context) int {
+	id, err := spkq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SignedPreKey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SignedPreKey entity is found.
+// Returns a *NotFoundError when no SignedPreKey entities are found.
+func (spkq *SignedPreKeyQuery) Only(ctx context.Context) (*SignedPreKey, error) {
+	nodes, err := spkq.Limit(2).All(setContextOp(ctx, spkq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{signedprekey.Label}
+	default:
+		return nil, &NotSingularError{signedprekey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (spkq *SignedPreKeyQuery) OnlyX(ctx context.Context) *SignedPreKey {
+	node, err := spkq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SignedPreKey ID in the query.
+// Returns a *NotSingularError when more than one SignedPreKey ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (spkq *SignedPreKeyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = spkq.Limit(2).IDs(setContextOp(ctx, spkq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{signedprekey.Label}
+	default:
+		err = &NotSingularError{signedprekey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (spkq *SignedPreKeyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := spkq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SignedPreKeys.
+func (spkq *SignedPreKeyQuery) All(ctx context.Context) ([]*SignedPreKey, error) {
+	ctx = setContextOp(ctx, spkq.ctx, "All")
+	if err := spkq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SignedPreKey, *SignedPreKeyQuery]()
+	return withInterceptors[[]*SignedPreKey](ctx, spkq, qr, spkq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (spkq *SignedPreKeyQuery) AllX(ctx context.Context) []*SignedPreKey {
+	nodes, err := spkq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SignedPreKey IDs.
+func (spkq *SignedPreKeyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if spkq.ctx.Unique == nil && spkq.path != nil {
+		spkq.Unique(true)
+	}
+	ctx = setContextOp(ctx, spkq.ctx, "IDs")
+	if err = spkq.Select(signedprekey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (spkq *SignedPreKeyQuery) IDsX(ctx context.Context) []int {
+	ids, err := spkq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (spkq *SignedPreKeyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, spkq.ctx, "Count")
+	if err := spkq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, spkq, querierCount[*SignedPreKeyQuery](), spkq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (spkq *SignedPreKeyQuery) CountX(ctx context.Context) int {
+	count, err := spkq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (spkq *SignedPreKeyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, spkq.ctx, "Exist")
+	switch _, err := spkq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (spkq *SignedPreKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := spkq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SignedPreKeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (spkq *SignedPreKeyQuery) Clone() *SignedPreKeyQuery {
+	if spkq == nil {
+		return nil
+	}
+	return &SignedPreKeyQuery{
+		config:     spkq.config,
+		ctx:        spkq.ctx.Clone(),
+		order:      append([]signedprekey.OrderOption{}, spkq.order...),
+		inters:     append([]Interceptor{}, spkq.inters...),
+		predicates: append([]predicate.SignedPreKey{}, spkq.predicates...),
+		withDevice: spkq.withDevice.Clone(),
+		// clone intermediate query.
+		sql:  spkq.sql.Clone(),
+		path: spkq.path,
+	}
+}
+
+// WithDevice tells the query-builder to eager-load the nodes that are connected to
+// the "device" edge. The optional arguments are used to configure the query builder of the edge.
+func (spkq *SignedPreKeyQuery) WithDevice(opts ...func(*DeviceQuery)) *SignedPreKeyQuery {
+	query := (&DeviceClient{config: spkq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spkq.withDevice = query
+	return spkq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		KeyID int `json:"key_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SignedPreKey.Query().
+//		GroupBy(signedprekey.FieldKeyID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (spkq *SignedPreKeyQuery) GroupBy(field string, fields ...string) *SignedPreKeyGroupBy {
+	spkq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SignedPreKeyGroupBy{build: spkq}
+	grbuild.flds = &spkq.ctx.Fields
+	grbuild.label = signedprekey.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		KeyID int `json:"key_id,omitempty"`
+//	}
+//
+//	client.SignedPreKey.Query().
+//		Select(signedprekey.FieldKeyID).
+//		Scan(ctx, &v)
+func (spkq *SignedPreKeyQuery) Select(fields ...string) *SignedPreKeySelect {
+	spkq.ctx.Fields = append(spkq.ctx.Fields, fields...)
+	sbuild := &SignedPreKeySelect{SignedPreKeyQuery: spkq}
+	sbuild.label = signedprekey.Label
+	sbuild.flds, sbuild.scan = &spkq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SignedPreKeySelect configured with the given aggregations.
+func (spkq *SignedPreKeyQuery) Aggregate(fns ...AggregateFunc) *SignedPreKeySelect {
+	return spkq.Select().Aggregate(fns...)
+}
+
+func (spkq *SignedPreKeyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range spkq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, spkq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range spkq.ctx.Fields {
+		if !signedprekey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if spkq.path != nil {
+		prev, err := spkq.path(ctx)
+		if err != nil {
+			return err
+		}
+		spkq.sql = prev
+	}
+	return nil
+}
+
+func (spkq *SignedPreKeyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SignedPreKey, error) {
+	var (
+		nodes       = []*SignedPreKey{}
+		withFKs     = spkq.withFKs
+		_spec       = spkq.querySpec()
+		loadedTypes = [1]bool{
+			spkq.withDevice != nil,
+		}
+	)
+	if spkq.withDevice != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, signedprekey.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SignedPreKey).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SignedPreKey{config: spkq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, spkq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := spkq.withDevice; query != nil {
+		if err := spkq.loadDevice(ctx, query, nodes, nil,
+			func(n *SignedPreKey, e *Device) { n.Edges.Device = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (spkq *SignedPreKeyQuery) loadDevice(ctx context.Context, query *DeviceQuery, nodes []*SignedPreKey, init func(*SignedPreKey), assign func(*SignedPreKey, *Device)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SignedPreKey)
+	for i := range nodes {
+		if nodes[i].signed_pre_key_device == nil {
+			continue
+		}
+		fk := *nodes[i].signed_pre_key_device
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(device.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "signed_pre_key_device" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (spkq *SignedPreKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := spkq.querySpec()
+	_spec.Node.Columns = spkq.ctx.Fields
+	if len(spkq.ctx.Fields) > 0 {
+		_spec.Unique = spkq.ctx.Unique != nil && *spkq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, spkq.driver, _spec)
+}
+
+func (spkq *SignedPreKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(signedprekey.Table, signedprekey.Columns, sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt))
+	_spec.From = spkq.sql
+	if unique := spkq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if spkq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := spkq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signedprekey.FieldID)
+		for i := range fields {
+			if fields[i] != signedprekey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := spkq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := spkq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := spkq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := spkq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (spkq *SignedPreKeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(spkq.driver.Dialect())
+	t1 := builder.Table(signedprekey.Table)
+	columns := spkq.ctx.Fields
+	if len(columns) == 0 {
+		columns = signedprekey.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if spkq.sql != nil {
+		selector = spkq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if spkq.ctx.Unique != nil && *spkq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range spkq.predicates {
+		p(selector)
+	}
+	for _, p := range spkq.order {
+		p(selector)
+	}
+	if offset := spkq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := spkq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SignedPreKeyGroupBy is the group-by builder for SignedPreKey entities.
+type SignedPreKeyGroupBy struct {
+	selector
+	build *SignedPreKeyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (spkgb *SignedPreKeyGroupBy) Aggregate(fns ...AggregateFunc) *SignedPreKeyGroupBy {
+	spkgb.fns = append(spkgb.fns, fns...)
+	return spkgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (spkgb *SignedPreKeyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, spkgb.build.ctx, "GroupBy")
+	if err := spkgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SignedPreKeyQuery, *SignedPreKeyGroupBy](ctx, spkgb.build, spkgb, spkgb.build.inters, v)
+}
+
+func (spkgb *SignedPreKeyGroupBy) sqlScan(ctx context.Context, root *SignedPreKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(spkgb.fns))
+	for _, fn := range spkgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*spkgb.flds)+len(spkgb.fns))
+		for _, f := range *spkgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*spkgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := spkgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SignedPreKeySelect is the builder for selecting fields of SignedPreKey entities.
+type SignedPreKeySelect struct {
+	*SignedPreKeyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (spks *SignedPreKeySelect) Aggregate(fns ...AggregateFunc) *SignedPreKeySelect {
+	spks.fns = append(spks.fns, fns...)
+	return spks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (spks *SignedPreKeySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, spks.ctx, "Select")
+	if err := spks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SignedPreKeyQuery, *SignedPreKeySelect](ctx, spks.SignedPreKeyQuery, spks, spks.inters, v)
+}
+
+func (spks *SignedPreKeySelect) sqlScan(ctx context.Context, root *SignedPreKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(spks.fns))
+	for _, fn := range spks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*spks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := spks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+)
+
+// SignedPreKeyUpdate is the builder for updating SignedPreKey entities.
+type SignedPreKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SignedPreKeyMutation
+}
+
+// Where appends a list predicates to the SignedPreKeyUpdate builder.
+func (spku *SignedPreKeyUpdate) Where(ps ...predicate.SignedPreKey) *SignedPreKeyUpdate {
+	spku.mutation.Where(ps...)
+	return spku
+}
+
+// SetKeyID sets the "key_id" field.
+func (spku *SignedPreKeyUpdate) SetKeyID(i int) *SignedPreKeyUpdate {
+	spku.mutation.ResetKeyID()
+	spku.mutation.SetKeyID(i)
+	return spku
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (spku *SignedPreKeyUpdate) SetNillableKeyID(i *int) *SignedPreKeyUpdate {
+	if i != nil {
+		spku.SetKeyID(*i)
+	}
+	return spku
+}
+
+// AddKeyID adds i to the "key_id" field.
+func (spku *SignedPreKeyUpdate) AddKeyID(i int) *SignedPreKeyUpdate {
+	spku.mutation.AddKeyID(i)
+	return spku
+}
+
+// SetPublicKey sets the "public_key" field.
+func (spku *SignedPreKeyUpdate) SetPublicKey(s string) *SignedPreKeyUpdate {
+	spku.mutation.SetPublicKey(s)
+	return spku
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (spku *SignedPreKeyUpdate) SetNillablePublicKey(s *string) *SignedPreKeyUpdate {
+	if s != nil {
+		spku.SetPublicKey(*s)
+	}
+	return spku
+}
+
+// SetSignature sets the "signature" field.
+func (spku *SignedPreKeyUpdate) SetSignature(s string) *SignedPreKeyUpdate {
+	spku.mutation.SetSignature(s)
+	return spku
+}
+
+// SetNillableSignature sets the "signature" field if the given value is not nil.
+func (spku *SignedPreKeyUpdate) SetNillableSignature(s *string) *SignedPreKeyUpdate {
+	if s != nil {
+		spku.SetSignature(*s)
+	}
+	return spku
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (spku *SignedPreKeyUpdate) SetCreatedAt(t time.Time) *SignedPreKeyUpdate {
+	spku.mutation.SetCreatedAt(t)
+	return spku
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (spku *SignedPreKeyUpdate) SetNillableCreatedAt(t *time.Time) *SignedPreKeyUpdate {
+	if t != nil {
+		spku.SetCreatedAt(*t)
+	}
+	return spku
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (spku *SignedPreKeyUpdate) SetDeviceID(id int) *SignedPreKeyUpdate {
+	spku.mutation.SetDeviceID(id)
+	return spku
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (spku *SignedPreKeyUpdate) SetDevice(d *Device) *SignedPreKeyUpdate {
+	return spku.SetDeviceID(d.ID)
+}
+
+// Mutation returns the SignedPreKeyMutation object of the builder.
+func (spku *SignedPreKeyUpdate) Mutation() *SignedPreKeyMutation {
+	return spku.mutation
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (spku *SignedPreKeyUpdate) ClearDevice() *SignedPreKeyUpdate {
+	spku.mutation.ClearDevice()
+	return spku
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (spku *SignedPreKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, spku.sqlSave, spku.mutation, spku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spku *SignedPreKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := spku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (spku *SignedPreKeyUpdate) Exec(ctx context.Context) error {
+	_, err := spku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spku *SignedPreKeyUpdate) ExecX(ctx context.Context) {
+	if err := spku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spku *SignedPreKeyUpdate) check() error {
+	if v, ok := spku.mutation.KeyID(); ok {
+		if err := signedprekey.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "SignedPreKey.key_id": %w`, err)}
+		}
+	}
+	if v, ok := spku.mutation.PublicKey(); ok {
+		if err := signedprekey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "SignedPreKey.public_key": %w`, err)}
+		}
+	}
+	if v, ok := spku.mutation.Signature(); ok {
+		if err := signedprekey.SignatureValidator(v); err != nil {
+			return &ValidationError{Name: "signature", err: fmt.Errorf(`ent: validator failed for field "SignedPreKey.signature": %w`, err)}
+		}
+	}
+	if _, ok := spku.mutation.DeviceID(); spku.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SignedPreKey.device"`)
+	}
+	return nil
+}
+
+func (spku *SignedPreKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := spku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signedprekey.Table, signedprekey.Columns, sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt))
+	if ps := spku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := spku.mutation.KeyID(); ok {
+		_spec.SetField(signedprekey.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := spku.mutation.AddedKeyID(); ok {
+		_spec.AddField(signedprekey.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := spku.mutation.PublicKey(); ok {
+		_spec.SetField(signedprekey.FieldPublicKey, field.TypeString, value)
+	}
+	if value, ok := spku.mutation.Signature(); ok {
+		_spec.SetField(signedprekey.FieldSignature, field.TypeString, value)
+	}
+	if value, ok := spku.mutation.CreatedAt(); ok {
+		_spec.SetField(signedprekey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if spku.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   signedprekey.DeviceTable,
+			Columns: []string{signedprekey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spku.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   signedprekey.DeviceTable,
+			Columns: []string{signedprekey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, spku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signedprekey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	spku.mutation.done = true
+	return n, nil
+}
+
+// SignedPreKeyUpdateOne is the builder for updating a single SignedPreKey entity.
+type SignedPreKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SignedPreKeyMutation
+}
+
+// SetKeyID sets the "key_id" field.
+func (spkuo *SignedPreKeyUpdateOne) SetKeyID(i int) *SignedPreKeyUpdateOne {
+	spkuo.mutation.ResetKeyID()
+	spkuo.mutation.SetKeyID(i)
+	return spkuo
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (spkuo *SignedPreKeyUpdateOne) SetNillableKeyID(i *int) *SignedPreKeyUpdateOne {
+	if i != nil {
+		spkuo.SetKeyID(*i)
+	}
+	return spkuo
+}
+
+// AddKeyID adds i to the "key_id" field.
+func (spkuo *SignedPreKeyUpdateOne) AddKeyID(i int) *SignedPreKeyUpdateOne {
+	spkuo.mutation.AddKeyID(i)
+	return spkuo
+}
+
+// SetPublicKey sets the "public_key" field.
+func (spkuo *SignedPreKeyUpdateOne) SetPublicKey(s string) *SignedPreKeyUpdateOne {
+	spkuo.mutation.SetPublicKey(s)
+	return spkuo
+}
+
+// SetNillablePublicKey sets the "public_key" field if the given value is not nil.
+func (spkuo *SignedPreKeyUpdateOne) SetNillablePublicKey(s *string) *SignedPreKeyUpdateOne {
+	if s != nil {
+		spkuo.SetPublicKey(*s)
+	}
+	return spkuo
+}
+
+// SetSignature sets the "signature" field.
+func (spkuo *SignedPreKeyUpdateOne) SetSignature(s string) *SignedPreKeyUpdateOne {
+	spkuo.mutation.SetSignature(s)
+	return spkuo
+}
+
+// SetNillableSignature sets the "signature" field if the given value is not nil.
+func (spkuo *SignedPreKeyUpdateOne) SetNillableSignature(s *string) *SignedPreKeyUpdateOne {
+	if s != nil {
+		spkuo.SetSignature(*s)
+	}
+	return spkuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (spkuo *SignedPreKeyUpdateOne) SetCreatedAt(t time.Time) *SignedPreKeyUpdateOne {
+	spkuo.mutation.SetCreatedAt(t)
+	return spkuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (spkuo *SignedPreKeyUpdateOne) SetNillableCreatedAt(t *time.Time) *SignedPreKeyUpdateOne {
+	if t != nil {
+		spkuo.SetCreatedAt(*t)
+	}
+	return spkuo
+}
+
+// SetDeviceID sets the "device" edge to the Device entity by ID.
+func (spkuo *SignedPreKeyUpdateOne) SetDeviceID(id int) *SignedPreKeyUpdateOne {
+	spkuo.mutation.SetDeviceID(id)
+	return spkuo
+}
+
+// SetDevice sets the "device" edge to the Device entity.
+func (spkuo *SignedPreKeyUpdateOne) SetDevice(d *Device) *SignedPreKeyUpdateOne {
+	return spkuo.SetDeviceID(d.ID)
+}
+
+// Mutation returns the SignedPreKeyMutation object of the builder.
+func (spkuo *SignedPreKeyUpdateOne) Mutation() *SignedPreKeyMutation {
+	return spkuo.mutation
+}
+
+// ClearDevice clears the "device" edge to the Device entity.
+func (spkuo *SignedPreKeyUpdateOne) ClearDevice() *SignedPreKeyUpdateOne {
+	spkuo.mutation.ClearDevice()
+	return spkuo
+}
+
+// Where appends a list predicates to the SignedPreKeyUpdate builder.
+func (spkuo *SignedPreKeyUpdateOne) Where(ps ...predicate.SignedPreKey) *SignedPreKeyUpdateOne {
+	spkuo.mutation.Where(ps...)
+	return spkuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (spkuo *SignedPreKeyUpdateOne) Select(field string, fields ...string) *SignedPreKeyUpdateOne {
+	spkuo.fields = append([]string{field}, fields...)
+	return spkuo
+}
+
+// Save executes the query and returns the updated SignedPreKey entity.
+func (spkuo *SignedPreKeyUpdateOne) Save(ctx context.Context) (*SignedPreKey, error) {
+	return withHooks(ctx, spkuo.sqlSave, spkuo.mutation, spkuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spkuo *SignedPreKeyUpdateOne) SaveX(ctx context.Context) *SignedPreKey {
+	node, err := spkuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (spkuo *SignedPreKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := spkuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spkuo *SignedPreKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := spkuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spkuo *SignedPreKeyUpdateOne) check() error {
+	if v, ok := spkuo.mutation.KeyID(); ok {
+		if err := signedprekey.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "SignedPreKey.key_id": %w`, err)}
+		}
+	}
+	if v, ok := spkuo.mutation.PublicKey(); ok {
+		if err := signedprekey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "SignedPreKey.public_key": %w`, err)}
+		}
+	}
+	if v, ok := spkuo.mutation.Signature(); ok {
+		if err := signedprekey.SignatureValidator(v); err != nil {
+			return &ValidationError{Name: "signature", err: fmt.Errorf(`ent: validator failed for field "SignedPreKey.signature": %w`, err)}
+		}
+	}
+	if _, ok := spkuo.mutation.DeviceID(); spkuo.mutation.DeviceCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SignedPreKey.device"`)
+	}
+	return nil
+}
+
+func (spkuo *SignedPreKeyUpdateOne) sqlSave(ctx context.Context) (_node *SignedPreKey, err error) {
+	if err := spkuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signedprekey.Table, signedprekey.Columns, sqlgraph.NewFieldSpec(signedprekey.FieldID, field.TypeInt))
+	id, ok := spkuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SignedPreKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := spkuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signedprekey.FieldID)
+		for _, f := range fields {
+			if !signedprekey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signedprekey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := spkuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := spkuo.mutation.KeyID(); ok {
+		_spec.SetField(signedprekey.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := spkuo.mutation.AddedKeyID(); ok {
+		_spec.AddField(signedprekey.FieldKeyID, field.TypeInt, value)
+	}
+	if value, ok := spkuo.mutation.PublicKey(); ok {
+		_spec.SetField(signedprekey.FieldPublicKey, field.TypeString, value)
+	}
+	if value, ok := spkuo.mutation.Signature(); ok {
+		_spec.SetField(signedprekey.FieldSignature, field.TypeString, value)
+	}
+	if value, ok := spkuo.mutation.CreatedAt(); ok {
+		_spec.SetField(signedprekey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if spkuo.mutation.DeviceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   signedprekey.DeviceTable,
+			Columns: []string{signedprekey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spkuo.mutation.DeviceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   signedprekey.DeviceTable,
+			Columns: []string{signedprekey.DeviceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SignedPreKey{config: spkuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, spkuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signedprekey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	spkuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/totpsecret"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// TotpSecret is the model entity for the TotpSecret schema.
+type TotpSecret struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// ConfirmedAt holds the value of the "confirmed_at" field.
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+	// LastUsedStep holds the value of the "last_used_step" field.
+	LastUsedStep int64 `json:"last_used_step,omitempty"`
+	// FailedAttempts holds the value of the "failed_attempts" field.
+	FailedAttempts int `json:"failed_attempts,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TotpSecretQuery when eager-loading is set.
+	Edges        TotpSecretEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TotpSecretEdges holds the relations/edges for other nodes in the graph.
+type TotpSecretEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TotpSecretEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TotpSecret) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case totpsecret.FieldID, totpsecret.FieldLastUsedStep, totpsecret.FieldFailedAttempts:
+			values[i] = new(sql.NullInt64)
+		case totpsecret.FieldSecret:
+			values[i] = new(sql.NullString)
+		case totpsecret.FieldConfirmedAt, totpsecret.FieldLockedUntil, totpsecret.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TotpSecret fields.
+func (ts *TotpSecret) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case totpsecret.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ts.ID = int(value.Int64)
+		case totpsecret.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				ts.Secret = value.String
+			}
+		case totpsecret.FieldConfirmedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field confirmed_at", values[i])
+			} else if value.Valid {
+				ts.ConfirmedAt = new(time.Time)
+				*ts.ConfirmedAt = value.Time
+			}
+		case totpsecret.FieldLastUsedStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_step", values[i])
+			} else if value.Valid {
+				ts.LastUsedStep = value.Int64
+			}
+		case totpsecret.FieldFailedAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_attempts", values[i])
+			} else if value.Valid {
+				ts.FailedAttempts = int(value.Int64)
+			}
+		case totpsecret.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				ts.LockedUntil = new(time.Time)
+				*ts.LockedUntil = value.Time
+			}
+		case totpsecret.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ts.CreatedAt = value.Time
+			}
+		default:
+			ts.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TotpSecret.
+// This includes values selected through modifiers, order, etc.
+func (ts *TotpSecret) Value(name string) (ent.Value, error) {
+	return ts.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the TotpSecret entity.
+func (ts *TotpSecret) QueryUser() *UserQuery {
+	return NewTotpSecretClient(ts.config).QueryUser(ts)
+}
+
+// Update returns a builder for updating this TotpSecret.
+// Note that you need to call TotpSecret.Unwrap() before calling this method if this TotpSecret
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ts *TotpSecret) Update() *TotpSecretUpdateOne {
+	return NewTotpSecretClient(ts.config).UpdateOne(ts)
+}
+
+// Unwrap unwraps the TotpSecret entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ts *TotpSecret) Unwrap() *TotpSecret {
+	_tx, ok := ts.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TotpSecret is not a transactional entity")
+	}
+	ts.config.driver = _tx.drv
+	return ts
+}
+
+// String implements the fmt.Stringer.
+func (ts *TotpSecret) String() string {
+	var builder strings.Builder
+	builder.WriteString("TotpSecret(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ts.ID))
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := ts.ConfirmedAt; v != nil {
+		builder.WriteString("confirmed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_step=")
+	builder.WriteString(fmt.Sprintf("%v", ts.LastUsedStep))
+	builder.WriteString(", ")
+	builder.WriteString("failed_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", ts.FailedAttempts))
+	builder.WriteString(", ")
+	if v := ts.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ts.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TotpSecrets is a parsable slice of TotpSecret.
+type TotpSecrets []*TotpSecret
@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/totpsecret"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// TotpSecretCreate is the builder for creating a TotpSecret entity.
+type TotpSecretCreate struct {
+	config
+	mutation *TotpSecretMutation
+	hooks    []Hook
+}
+
+// SetSecret sets the "secret" field.
+func (tsc *TotpSecretCreate) SetSecret(s string) *TotpSecretCreate {
+	tsc.mutation.SetSecret(s)
+	return tsc
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (tsc *TotpSecretCreate) SetConfirmedAt(t time.Time) *TotpSecretCreate {
+	tsc.mutation.SetConfirmedAt(t)
+	return tsc
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (tsc *TotpSecretCreate) SetNillableConfirmedAt(t *time.Time) *TotpSecretCreate {
+	if t != nil {
+		tsc.SetConfirmedAt(*t)
+	}
+	return tsc
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (tsc *TotpSecretCreate) SetLastUsedStep(i int64) *TotpSecretCreate {
+	tsc.mutation.SetLastUsedStep(i)
+	return tsc
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (tsc *TotpSecretCreate) SetNillableLastUsedStep(i *int64) *TotpSecretCreate {
+	if i != nil {
+		tsc.SetLastUsedStep(*i)
+	}
+	return tsc
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (tsc *TotpSecretCreate) SetFailedAttempts(i int) *TotpSecretCreate {
+	tsc.mutation.SetFailedAttempts(i)
+	return tsc
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (tsc *TotpSecretCreate) SetNillableFailedAttempts(i *int) *TotpSecretCreate {
+	if i != nil {
+		tsc.SetFailedAttempts(*i)
+	}
+	return tsc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (tsc *TotpSecretCreate) SetLockedUntil(t time.Time) *TotpSecretCreate {
+	tsc.mutation.SetLockedUntil(t)
+	return tsc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (tsc *TotpSecretCreate) SetNillableLockedUntil(t *time.Time) *TotpSecretCreate {
+	if t != nil {
+		tsc.SetLockedUntil(*t)
+	}
+	return tsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsc *TotpSecretCreate) SetCreatedAt(t time.Time) *TotpSecretCreate {
+	tsc.mutation.SetCreatedAt(t)
+	return tsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsc *TotpSecretCreate) SetNillableCreatedAt(t *time.Time) *TotpSecretCreate {
+	if t != nil {
+		tsc.SetCreatedAt(*t)
+	}
+	return tsc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (tsc *TotpSecretCreate) SetUserID(id int) *TotpSecretCreate {
+	tsc.mutation.SetUserID(id)
+	return tsc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tsc *TotpSecretCreate) SetUser(u *User) *TotpSecretCreate {
+	return tsc.SetUserID(u.ID)
+}
+
+// Mutation returns the TotpSecretMutation object of the builder.
+func (tsc *TotpSecretCreate) Mutation() *TotpSecretMutation {
+	return tsc.mutation
+}
+
+// Save creates the TotpSecret in the database.
+func (tsc *TotpSecretCreate) Save(ctx context.Context) (*TotpSecret, error) {
+	tsc.defaults()
+	return withHooks(ctx, tsc.sqlSave, tsc.mutation, tsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsc *TotpSecretCreate) SaveX(ctx context.Context) *TotpSecret {
+	v, err := tsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsc *TotpSecretCreate) Exec(ctx context.Context) error {
+	_, err := tsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsc *TotpSecretCreate) ExecX(ctx context.Context) {
+	if err := tsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsc *TotpSecretCreate) defaults() {
+	if _, ok := tsc.mutation.LastUsedStep(); !ok {
+		v := totpsecret.DefaultLastUsedStep
+		tsc.mutation.SetLastUsedStep(v)
+	}
+	if _, ok := tsc.mutation.FailedAttempts(); !ok {
+		v := totpsecret.DefaultFailedAttempts
+		tsc.mutation.SetFailedAttempts(v)
+	}
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		v := totpsecret.DefaultCreatedAt()
+		tsc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsc *TotpSecretCreate) check() error {
+	if _, ok := tsc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "TotpSecret.secret"`)}
+	}
+	if v, ok := tsc.mutation.Secret(); ok {
+		if err := totpsecret.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TotpSecret.secret": %w`, err)}
+		}
+	}
+	if _, ok := tsc.mutation.LastUsedStep(); !ok {
+		return &ValidationError{Name: "last_used_step", err: errors.New(`ent: missing required field "TotpSecret.last_used_step"`)}
+	}
+	if _, ok := tsc.mutation.FailedAttempts(); !ok {
+		return &ValidationError{Name: "failed_attempts", err: errors.New(`ent: missing required field "TotpSecret.failed_attempts"`)}
+	}
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TotpSecret.created_at"`)}
+	}
+	if _, ok := tsc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TotpSecret.user"`)}
+	}
+	return nil
+}
+
+func (tsc *TotpSecretCreate) sqlSave(ctx context.Context) (*TotpSecret, error) {
+	if err := tsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tsc.mutation.id = &_node.ID
+	tsc.mutation.done = true
+	return _node, nil
+}
+
+func (tsc *TotpSecretCreate) createSpec() (*TotpSecret, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TotpSecret{config: tsc.config}
+		_spec = sqlgraph.NewCreateSpec(totpsecret.Table, sqlgraph.NewFieldSpec(totpsecret.FieldID, field.TypeInt))
+	)
+	if value, ok := tsc.mutation.Secret(); ok {
+		_spec.SetField(totpsecret.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := tsc.mutation.ConfirmedAt(); ok {
+		_spec.SetField(totpsecret.FieldConfirmedAt, field.TypeTime, value)
+		_node.ConfirmedAt = &value
+	}
+	if value, ok := tsc.mutation.LastUsedStep(); ok {
+		_spec.SetField(totpsecret.FieldLastUsedStep, field.TypeInt64, value)
+		_node.LastUsedStep = value
+	}
+	if value, ok := tsc.mutation.FailedAttempts(); ok {
+		_spec.SetField(totpsecret.FieldFailedAttempts, field.TypeInt, value)
+		_node.FailedAttempts = value
+	}
+	if value, ok := tsc.mutation.LockedUntil(); ok {
+		_spec.SetField(totpsecret.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := tsc.mutation.CreatedAt(); ok {
+		_spec.SetField(totpsecret.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tsc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   totpsecret.UserTable,
+			Columns: []string{totpsecret.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TotpSecretCreateBulk is the builder for creating many TotpSecret entities in bulk.
+type TotpSecretCreateBulk struct {
+	config
+	err      error
+	builders []*TotpSecretCreate
+}
+
+// Save creates the TotpSecret entities in the database.
+func (tscb *TotpSecretCreateBulk) Save(ctx context.Context) ([]*TotpSecret, error) {
+	if tscb.err != nil {
+		return nil, tscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tscb.builders))
+	nodes := make([]*TotpSecret, len(tscb.builders))
+	mutators := make([]Mutator, len(tscb.builders))
+	for i := range tscb.builders {
+		func(i int, root context.Context) {
+			builder := tscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TotpSecretMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tscb *TotpSecretCreateBulk) SaveX(ctx context.Context) []*TotpSecret {
+	v, err := tscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tscb *TotpSecretCreateBulk) Exec(ctx context.Context) error {
+	_, err := tscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscb *TotpSecretCreateBulk) ExecX(ctx context.Context) {
+	if err := tscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,602 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/totpsecret"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// TotpSecretQuery is the builder for querying TotpSecret entities.
+type TotpSecretQuery struct {
+	config
+	ctx        *QueryContext
+	order      []totpsecret.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TotpSecret
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TotpSecretQuery builder.
+func (tsq *TotpSecretQuery) Where(ps ...predicate.TotpSecret) *TotpSecretQuery {
+	tsq.predicates = append(tsq.predicates, ps...)
+	return tsq
+}
+
+// Limit the number of records to be returned by this query.
+func (tsq *TotpSecretQuery) Limit(limit int) *TotpSecretQuery {
+	tsq.ctx.Limit = &limit
+	return tsq
+}
+
+// Offset to start from.
+func (tsq *TotpSecretQuery) Offset(offset int) *TotpSecretQuery {
+	tsq.ctx.Offset = &offset
+	return tsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tsq *TotpSecretQuery) Unique(unique bool) *TotpSecretQuery {
+	tsq.ctx.Unique = &unique
+	return tsq
+}
+
+// Order specifies how the records should be ordered.
+func (tsq *TotpSecretQuery) Order(o ...totpsecret.OrderOption) *TotpSecretQuery {
+	tsq.order = append(tsq.order, o...)
+	return tsq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (tsq *TotpSecretQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(totpsecret.Table, totpsecret.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, totpsecret.UserTable, totpsecret.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TotpSecret entity from the query.
+// Returns a *NotFoundError when no TotpSecret was found.
+func (tsq *TotpSecretQuery) First(ctx context.Context) (*TotpSecret, error) {
+	nodes, err := tsq.Limit(1).All(setContextOp(ctx, tsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{totpsecret.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tsq *TotpSecretQuery) FirstX(ctx context.Context) *TotpSecret {
+	node, err := tsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TotpSecret ID from the query.
+// Returns a *NotFoundError when no TotpSecret ID was found.
+func (tsq *TotpSecretQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tsq.Limit(1).IDs(setContextOp(ctx, tsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{totpsecret.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tsq *TotpSecretQuery) FirstIDX(ctx context.Context) int {
+	id, err := tsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TotpSecret entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TotpSecret entity is found.
+// Returns a *NotFoundError when no TotpSecret entities are found.
+func (tsq *TotpSecretQuery) Only(ctx context.Context) (*TotpSecret, error) {
+	nodes, err := tsq.Limit(2).All(setContextOp(ctx, tsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{totpsecret.Label}
+	default:
+		return nil, &NotSingularError{totpsecret.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tsq *TotpSecretQuery) OnlyX(ctx context.Context) *TotpSecret {
+	node, err := tsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TotpSecret ID in the query.
+// Returns a *NotSingularError when more than one TotpSecret ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tsq *TotpSecretQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tsq.Limit(2).IDs(setContextOp(ctx, tsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{totpsecret.Label}
+	default:
+		err = &NotSingularError{totpsecret.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tsq *TotpSecretQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TotpSecrets.
+func (tsq *TotpSecretQuery) All(ctx context.Context) ([]*TotpSecret, error) {
+	ctx = setContextOp(ctx, tsq.ctx, "All")
+	if err := tsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TotpSecret, *TotpSecretQuery]()
+	return withInterceptors[[]*TotpSecret](ctx, tsq, qr, tsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tsq *TotpSecretQuery) AllX(ctx context.Context) []*TotpSecret {
+	nodes, err := tsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TotpSecret IDs.
+func (tsq *TotpSecretQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tsq.ctx.Unique == nil && tsq.path != nil {
+		tsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tsq.ctx, "IDs")
+	if err = tsq.Select(totpsecret.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tsq *TotpSecretQuery) IDsX(ctx context.Context) []int {
+	ids, err := tsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tsq *TotpSecretQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tsq.ctx, "Count")
+	if err := tsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tsq, querierCount[*TotpSecretQuery](), tsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tsq *TotpSecretQuery) CountX(ctx context.Context) int {
+	count, err := tsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tsq *TotpSecretQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tsq.ctx, "Exist")
+	switch _, err := tsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tsq *TotpSecretQuery) ExistX(ctx context.Context) bool {
+	exist, err := tsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TotpSecretQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tsq *TotpSecretQuery) Clone() *TotpSecretQuery {
+	if tsq == nil {
+		return nil
+	}
+	return &TotpSecretQuery{
+		config:     tsq.config,
+		ctx:        tsq.ctx.Clone(),
+		order:      append([]totpsecret.OrderOption{}, tsq.order...),
+		inters:     append([]Interceptor{}, tsq.inters...),
+		predicates: append([]predicate.TotpSecret{}, tsq.predicates...),
+		withUser:   tsq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  tsq.sql.Clone(),
+		path: tsq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TotpSecretQuery) WithUser(opts ...func(*UserQuery)) *TotpSecretQuery {
+	query := (&UserClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withUser = query
+	return tsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Secret string `json:"secret,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TotpSecret.Query().
+//		GroupBy(totpsecret.FieldSecret).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tsq *TotpSecretQuery) GroupBy(field string, fields ...string) *TotpSecretGroupBy {
+	tsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TotpSecretGroupBy{build: tsq}
+	grbuild.flds = &tsq.ctx.Fields
+	grbuild.label = totpsecret.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Secret string `json:"secret,omitempty"`
+//	}
+//
+//	client.TotpSecret.Query().
+//		Select(totpsecret.FieldSecret).
+//		Scan(ctx, &v)
+func (tsq *TotpSecretQuery) Select(fields ...string) *TotpSecretSelect {
+	tsq.ctx.Fields = append(tsq.ctx.Fields, fields...)
+	sbuild := &TotpSecretSelect{TotpSecretQuery: tsq}
+	sbuild.label = totpsecret.Label
+	sbuild.flds, sbuild.scan = &tsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TotpSecretSelect configured with the given aggregations.
+func (tsq *TotpSecretQuery) Aggregate(fns ...AggregateFunc) *TotpSecretSelect {
+	return tsq.Select().Aggregate(fns...)
+}
+
+func (tsq *TotpSecretQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tsq.ctx.Fields {
+		if !totpsecret.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tsq.path != nil {
+		prev, err := tsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tsq.sql = prev
+	}
+	return nil
+}
+
+func (tsq *TotpSecretQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TotpSecret, error) {
+	var (
+		nodes       = []*TotpSecret{}
+		_spec       = tsq.querySpec()
+		loadedTypes = [1]bool{
+			tsq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TotpSecret).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TotpSecret{config: tsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tsq.withUser; query != nil {
+		if err := tsq.loadUser(ctx, query, nodes, nil,
+			func(n *TotpSecret, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tsq *TotpSecretQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*TotpSecret, init func(*TotpSecret), assign func(*TotpSecret, *User)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*TotpSecret)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.User(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(totpsecret.UserColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.totp_secret_user
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "totp_secret_user" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "totp_secret_user" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (tsq *TotpSecretQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tsq.querySpec()
+	_spec.Node.Columns = tsq.ctx.Fields
+	if len(tsq.ctx.Fields) > 0 {
+		_spec.Unique = tsq.ctx.Unique != nil && *tsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tsq.driver, _spec)
+}
+
+func (tsq *TotpSecretQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(totpsecret.Table, totpsecret.Columns, sqlgraph.NewFieldSpec(totpsecret.FieldID, field.TypeInt))
+	_spec.From = tsq.sql
+	if unique := tsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, totpsecret.FieldID)
+		for i := range fields {
+			if fields[i] != totpsecret.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tsq *TotpSecretQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tsq.driver.Dialect())
+	t1 := builder.Table(totpsecret.Table)
+	columns := tsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = totpsecret.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tsq.sql != nil {
+		selector = tsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tsq.ctx.Unique != nil && *tsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tsq.predicates {
+		p(selector)
+	}
+	for _, p := range tsq.order {
+		p(selector)
+	}
+	if offset := tsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TotpSecretGroupBy is the group-by builder for TotpSecret entities.
+type TotpSecretGroupBy struct {
+	selector
+	build *TotpSecretQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tsgb *TotpSecretGroupBy) Aggregate(fns ...AggregateFunc) *TotpSecretGroupBy {
+	tsgb.fns = append(tsgb.fns, fns...)
+	return tsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsgb *TotpSecretGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsgb.build.ctx, "GroupBy")
+	if err := tsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TotpSecretQuery, *TotpSecretGroupBy](ctx, tsgb.build, tsgb, tsgb.build.inters, v)
+}
+
+func (tsgb *TotpSecretGroupBy) sqlScan(ctx context.Context, root *TotpSecretQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tsgb.fns))
+	for _, fn := range tsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tsgb.flds)+len(tsgb.fns))
+		for _, f := range *tsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TotpSecretSelect is the builder for selecting fields of TotpSecret entities.
+type TotpSecretSelect struct {
+	*TotpSecretQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tss *TotpSecretSelect) Aggregate(fns ...AggregateFunc) *TotpSecretSelect {
+	tss.fns = append(tss.fns, fns...)
+	return tss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tss *TotpSecretSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tss.ctx, "Select")
+	if err := tss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TotpSecretQuery, *TotpSecretSelect](ctx, tss.TotpSecretQuery, tss, tss.inters, v)
+}
+
+func (tss *TotpSecretSelect) sqlScan(ctx context.Context, root *TotpSecretQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tss.fns))
+	for _, fn := range tss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
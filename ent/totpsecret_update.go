@@ -0,0 +1,581 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/totpsecret"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// TotpSecretUpdate is the builder for updating TotpSecret entities.
+type TotpSecretUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TotpSecretMutation
+}
+
+// Where appends a list predicates to the TotpSecretUpdate builder.
+func (tsu *TotpSecretUpdate) Where(ps ...predicate.TotpSecret) *TotpSecretUpdate {
+	tsu.mutation.Where(ps...)
+	return tsu
+}
+
+// SetSecret sets the "secret" field.
+func (tsu *TotpSecretUpdate) SetSecret(s string) *TotpSecretUpdate {
+	tsu.mutation.SetSecret(s)
+	return tsu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (tsu *TotpSecretUpdate) SetNillableSecret(s *string) *TotpSecretUpdate {
+	if s != nil {
+		tsu.SetSecret(*s)
+	}
+	return tsu
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (tsu *TotpSecretUpdate) SetConfirmedAt(t time.Time) *TotpSecretUpdate {
+	tsu.mutation.SetConfirmedAt(t)
+	return tsu
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (tsu *TotpSecretUpdate) SetNillableConfirmedAt(t *time.Time) *TotpSecretUpdate {
+	if t != nil {
+		tsu.SetConfirmedAt(*t)
+	}
+	return tsu
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (tsu *TotpSecretUpdate) ClearConfirmedAt() *TotpSecretUpdate {
+	tsu.mutation.ClearConfirmedAt()
+	return tsu
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (tsu *TotpSecretUpdate) SetLastUsedStep(i int64) *TotpSecretUpdate {
+	tsu.mutation.ResetLastUsedStep()
+	tsu.mutation.SetLastUsedStep(i)
+	return tsu
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (tsu *TotpSecretUpdate) SetNillableLastUsedStep(i *int64) *TotpSecretUpdate {
+	if i != nil {
+		tsu.SetLastUsedStep(*i)
+	}
+	return tsu
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (tsu *TotpSecretUpdate) AddLastUsedStep(i int64) *TotpSecretUpdate {
+	tsu.mutation.AddLastUsedStep(i)
+	return tsu
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (tsu *TotpSecretUpdate) SetFailedAttempts(i int) *TotpSecretUpdate {
+	tsu.mutation.ResetFailedAttempts()
+	tsu.mutation.SetFailedAttempts(i)
+	return tsu
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (tsu *TotpSecretUpdate) SetNillableFailedAttempts(i *int) *TotpSecretUpdate {
+	if i != nil {
+		tsu.SetFailedAttempts(*i)
+	}
+	return tsu
+}
+
+// AddFailedAttempts adds i to the "failed_attempts" field.
+func (tsu *TotpSecretUpdate) AddFailedAttempts(i int) *TotpSecretUpdate {
+	tsu.mutation.AddFailedAttempts(i)
+	return tsu
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (tsu *TotpSecretUpdate) SetLockedUntil(t time.Time) *TotpSecretUpdate {
+	tsu.mutation.SetLockedUntil(t)
+	return tsu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (tsu *TotpSecretUpdate) SetNillableLockedUntil(t *time.Time) *TotpSecretUpdate {
+	if t != nil {
+		tsu.SetLockedUntil(*t)
+	}
+	return tsu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (tsu *TotpSecretUpdate) ClearLockedUntil() *TotpSecretUpdate {
+	tsu.mutation.ClearLockedUntil()
+	return tsu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsu *TotpSecretUpdate) SetCreatedAt(t time.Time) *TotpSecretUpdate {
+	tsu.mutation.SetCreatedAt(t)
+	return tsu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsu *TotpSecretUpdate) SetNillableCreatedAt(t *time.Time) *TotpSecretUpdate {
+	if t != nil {
+		tsu.SetCreatedAt(*t)
+	}
+	return tsu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (tsu *TotpSecretUpdate) SetUserID(id int) *TotpSecretUpdate {
+	tsu.mutation.SetUserID(id)
+	return tsu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tsu *TotpSecretUpdate) SetUser(u *User) *TotpSecretUpdate {
+	return tsu.SetUserID(u.ID)
+}
+
+// Mutation returns the TotpSecretMutation object of the builder.
+func (tsu *TotpSecretUpdate) Mutation() *TotpSecretMutation {
+	return tsu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (tsu *TotpSecretUpdate) ClearUser() *TotpSecretUpdate {
+	tsu.mutation.ClearUser()
+	return tsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsu *TotpSecretUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsu.sqlSave, tsu.mutation, tsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsu *TotpSecretUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsu *TotpSecretUpdate) Exec(ctx context.Context) error {
+	_, err := tsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsu *TotpSecretUpdate) ExecX(ctx context.Context) {
+	if err := tsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsu *TotpSecretUpdate) check() error {
+	if v, ok := tsu.mutation.Secret(); ok {
+		if err := totpsecret.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TotpSecret.secret": %w`, err)}
+		}
+	}
+	if _, ok := tsu.mutation.UserID(); tsu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "TotpSecret.user"`)
+	}
+	return nil
+}
+
+func (tsu *TotpSecretUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(totpsecret.Table, totpsecret.Columns, sqlgraph.NewFieldSpec(totpsecret.FieldID, field.TypeInt))
+	if ps := tsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsu.mutation.Secret(); ok {
+		_spec.SetField(totpsecret.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := tsu.mutation.ConfirmedAt(); ok {
+		_spec.SetField(totpsecret.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if tsu.mutation.ConfirmedAtCleared() {
+		_spec.ClearField(totpsecret.FieldConfirmedAt, field.TypeTime)
+	}
+	if value, ok := tsu.mutation.LastUsedStep(); ok {
+		_spec.SetField(totpsecret.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := tsu.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(totpsecret.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := tsu.mutation.FailedAttempts(); ok {
+		_spec.SetField(totpsecret.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := tsu.mutation.AddedFailedAttempts(); ok {
+		_spec.AddField(totpsecret.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := tsu.mutation.LockedUntil(); ok {
+		_spec.SetField(totpsecret.FieldLockedUntil, field.TypeTime, value)
+	}
+	if tsu.mutation.LockedUntilCleared() {
+		_spec.ClearField(totpsecret.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := tsu.mutation.CreatedAt(); ok {
+		_spec.SetField(totpsecret.FieldCreatedAt, field.TypeTime, value)
+	}
+	if tsu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   totpsecret.UserTable,
+			Columns: []string{totpsecret.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   totpsecret.UserTable,
+			Columns: []string{totpsecret.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{totpsecret.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsu.mutation.done = true
+	return n, nil
+}
+
+// TotpSecretUpdateOne is the builder for updating a single TotpSecret entity.
+type TotpSecretUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TotpSecretMutation
+}
+
+// SetSecret sets the "secret" field.
+func (tsuo *TotpSecretUpdateOne) SetSecret(s string) *TotpSecretUpdateOne {
+	tsuo.mutation.SetSecret(s)
+	return tsuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (tsuo *TotpSecretUpdateOne) SetNillableSecret(s *string) *TotpSecretUpdateOne {
+	if s != nil {
+		tsuo.SetSecret(*s)
+	}
+	return tsuo
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (tsuo *TotpSecretUpdateOne) SetConfirmedAt(t time.Time) *TotpSecretUpdateOne {
+	tsuo.mutation.SetConfirmedAt(t)
+	return tsuo
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (tsuo *TotpSecretUpdateOne) SetNillableConfirmedAt(t *time.Time) *TotpSecretUpdateOne {
+	if t != nil {
+		tsuo.SetConfirmedAt(*t)
+	}
+	return tsuo
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (tsuo *TotpSecretUpdateOne) ClearConfirmedAt() *TotpSecretUpdateOne {
+	tsuo.mutation.ClearConfirmedAt()
+	return tsuo
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (tsuo *TotpSecretUpdateOne) SetLastUsedStep(i int64) *TotpSecretUpdateOne {
+	tsuo.mutation.ResetLastUsedStep()
+	tsuo.mutation.SetLastUsedStep(i)
+	return tsuo
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (tsuo *TotpSecretUpdateOne) SetNillableLastUsedStep(i *int64) *TotpSecretUpdateOne {
+	if i != nil {
+		tsuo.SetLastUsedStep(*i)
+	}
+	return tsuo
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (tsuo *TotpSecretUpdateOne) AddLastUsedStep(i int64) *TotpSecretUpdateOne {
+	tsuo.mutation.AddLastUsedStep(i)
+	return tsuo
+}
+
+// SetFailedAttempts sets the "failed_attempts" field.
+func (tsuo *TotpSecretUpdateOne) SetFailedAttempts(i int) *TotpSecretUpdateOne {
+	tsuo.mutation.ResetFailedAttempts()
+	tsuo.mutation.SetFailedAttempts(i)
+	return tsuo
+}
+
+// SetNillableFailedAttempts sets the "failed_attempts" field if the given value is not nil.
+func (tsuo *TotpSecretUpdateOne) SetNillableFailedAttempts(i *int) *TotpSecretUpdateOne {
+	if i != nil {
+		tsuo.SetFailedAttempts(*i)
+	}
+	return tsuo
+}
+
+// AddFailedAttempts adds i to the "failed_attempts" field.
+func (tsuo *TotpSecretUpdateOne) AddFailedAttempts(i int) *TotpSecretUpdateOne {
+	tsuo.mutation.AddFailedAttempts(i)
+	return tsuo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (tsuo *TotpSecretUpdateOne) SetLockedUntil(t time.Time) *TotpSecretUpdateOne {
+	tsuo.mutation.SetLockedUntil(t)
+	return tsuo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (tsuo *TotpSecretUpdateOne) SetNillableLockedUntil(t *time.Time) *TotpSecretUpdateOne {
+	if t != nil {
+		tsuo.SetLockedUntil(*t)
+	}
+	return tsuo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (tsuo *TotpSecretUpdateOne) ClearLockedUntil() *TotpSecretUpdateOne {
+	tsuo.mutation.ClearLockedUntil()
+	return tsuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsuo *TotpSecretUpdateOne) SetCreatedAt(t time.Time) *TotpSecretUpdateOne {
+	tsuo.mutation.SetCreatedAt(t)
+	return tsuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsuo *TotpSecretUpdateOne) SetNillableCreatedAt(t *time.Time) *TotpSecretUpdateOne {
+	if t != nil {
+		tsuo.SetCreatedAt(*t)
+	}
+	return tsuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (tsuo *TotpSecretUpdateOne) SetUserID(id int) *TotpSecretUpdateOne {
+	tsuo.mutation.SetUserID(id)
+	return tsuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tsuo *TotpSecretUpdateOne) SetUser(u *User) *TotpSecretUpdateOne {
+	return tsuo.SetUserID(u.ID)
+}
+
+// Mutation returns the TotpSecretMutation object of the builder.
+func (tsuo *TotpSecretUpdateOne) Mutation() *TotpSecretMutation {
+	return tsuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (tsuo *TotpSecretUpdateOne) ClearUser() *TotpSecretUpdateOne {
+	tsuo.mutation.ClearUser()
+	return tsuo
+}
+
+// Where appends a list predicates to the TotpSecretUpdate builder.
+func (tsuo *TotpSecretUpdateOne) Where(ps ...predicate.TotpSecret) *TotpSecretUpdateOne {
+	tsuo.mutation.Where(ps...)
+	return tsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsuo *TotpSecretUpdateOne) Select(field string, fields ...string) *TotpSecretUpdateOne {
+	tsuo.fields = append([]string{field}, fields...)
+	return tsuo
+}
+
+// Save executes the query and returns the updated TotpSecret entity.
+func (tsuo *TotpSecretUpdateOne) Save(ctx context.Context) (*TotpSecret, error) {
+	return withHooks(ctx, tsuo.sqlSave, tsuo.mutation, tsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsuo *TotpSecretUpdateOne) SaveX(ctx context.Context) *TotpSecret {
+	node, err := tsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsuo *TotpSecretUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsuo *TotpSecretUpdateOne) ExecX(ctx context.Context) {
+	if err := tsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsuo *TotpSecretUpdateOne) check() error {
+	if v, ok := tsuo.mutation.Secret(); ok {
+		if err := totpsecret.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TotpSecret.secret": %w`, err)}
+		}
+	}
+	if _, ok := tsuo.mutation.UserID(); tsuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "TotpSecret.user"`)
+	}
+	return nil
+}
+
+func (tsuo *TotpSecretUpdateOne) sqlSave(ctx context.Context) (_node *TotpSecret, err error) {
+	if err := tsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(totpsecret.Table, totpsecret.Columns, sqlgraph.NewFieldSpec(totpsecret.FieldID, field.TypeInt))
+	id, ok := tsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TotpSecret.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, totpsecret.FieldID)
+		for _, f := range fields {
+			if !totpsecret.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != totpsecret.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsuo.mutation.Secret(); ok {
+		_spec.SetField(totpsecret.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := tsuo.mutation.ConfirmedAt(); ok {
+		_spec.SetField(totpsecret.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if tsuo.mutation.ConfirmedAtCleared() {
+		_spec.ClearField(totpsecret.FieldConfirmedAt, field.TypeTime)
+	}
+	if value, ok := tsuo.mutation.LastUsedStep(); ok {
+		_spec.SetField(totpsecret.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := tsuo.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(totpsecret.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := tsuo.mutation.FailedAttempts(); ok {
+		_spec.SetField(totpsecret.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := tsuo.mutation.AddedFailedAttempts(); ok {
+		_spec.AddField(totpsecret.FieldFailedAttempts, field.TypeInt, value)
+	}
+	if value, ok := tsuo.mutation.LockedUntil(); ok {
+		_spec.SetField(totpsecret.FieldLockedUntil, field.TypeTime, value)
+	}
+	if tsuo.mutation.LockedUntilCleared() {
+		_spec.ClearField(totpsecret.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := tsuo.mutation.CreatedAt(); ok {
+		_spec.SetField(totpsecret.FieldCreatedAt, field.TypeTime, value)
+	}
+	if tsuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   totpsecret.UserTable,
+			Columns: []string{totpsecret.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   totpsecret.UserTable,
+			Columns: []string{totpsecret.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TotpSecret{config: tsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{totpsecret.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsuo.mutation.done = true
+	return _node, nil
+}
@@ -12,24 +12,70 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
 	// CallLog is the client for interacting with the CallLog builders.
 	CallLog *CallLogClient
 	// CallParticipant is the client for interacting with the CallParticipant builders.
 	CallParticipant *CallParticipantClient
 	// Contact is the client for interacting with the Contact builders.
 	Contact *ContactClient
+	// Credential is the client for interacting with the Credential builders.
+	Credential *CredentialClient
+	// Device is the client for interacting with the Device builders.
+	Device *DeviceClient
+	// EmailToken is the client for interacting with the EmailToken builders.
+	EmailToken *EmailTokenClient
 	// Favourite is the client for interacting with the Favourite builders.
 	Favourite *FavouriteClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
+	// IdentityKey is the client for interacting with the IdentityKey builders.
+	IdentityKey *IdentityKeyClient
+	// IdentityKeyRecord is the client for interacting with the IdentityKeyRecord builders.
+	IdentityKeyRecord *IdentityKeyRecordClient
+	// KeyBackupKey is the client for interacting with the KeyBackupKey builders.
+	KeyBackupKey *KeyBackupKeyClient
+	// KeyBackupVersion is the client for interacting with the KeyBackupVersion builders.
+	KeyBackupVersion *KeyBackupVersionClient
+	// KeyLogEntry is the client for interacting with the KeyLogEntry builders.
+	KeyLogEntry *KeyLogEntryClient
+	// KeyLogHead is the client for interacting with the KeyLogHead builders.
+	KeyLogHead *KeyLogHeadClient
+	// KeyLogNode is the client for interacting with the KeyLogNode builders.
+	KeyLogNode *KeyLogNodeClient
+	// KeyPackage is the client for interacting with the KeyPackage builders.
+	KeyPackage *KeyPackageClient
+	// LoginThrottle is the client for interacting with the LoginThrottle builders.
+	LoginThrottle *LoginThrottleClient
 	// Media is the client for interacting with the Media builders.
 	Media *MediaClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageEnvelope is the client for interacting with the MessageEnvelope builders.
+	MessageEnvelope *MessageEnvelopeClient
+	// MlsCommit is the client for interacting with the MlsCommit builders.
+	MlsCommit *MlsCommitClient
+	// MlsWelcome is the client for interacting with the MlsWelcome builders.
+	MlsWelcome *MlsWelcomeClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
+	// OneTimePreKey is the client for interacting with the OneTimePreKey builders.
+	OneTimePreKey *OneTimePreKeyClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
 	// Room is the client for interacting with the Room builders.
 	Room *RoomClient
 	// RoomMembership is the client for interacting with the RoomMembership builders.
 	RoomMembership *RoomMembershipClient
+	// SenderKeyDistribution is the client for interacting with the SenderKeyDistribution builders.
+	SenderKeyDistribution *SenderKeyDistributionClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// SignedPreKey is the client for interacting with the SignedPreKey builders.
+	SignedPreKey *SignedPreKeyClient
+	// TotpSecret is the client for interacting with the TotpSecret builders.
+	TotpSecret *TotpSecretClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -163,15 +209,38 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApiKey = NewApiKeyClient(tx.config)
 	tx.CallLog = NewCallLogClient(tx.config)
 	tx.CallParticipant = NewCallParticipantClient(tx.config)
 	tx.Contact = NewContactClient(tx.config)
+	tx.Credential = NewCredentialClient(tx.config)
+	tx.Device = NewDeviceClient(tx.config)
+	tx.EmailToken = NewEmailTokenClient(tx.config)
 	tx.Favourite = NewFavouriteClient(tx.config)
+	tx.Identity = NewIdentityClient(tx.config)
+	tx.IdentityKey = NewIdentityKeyClient(tx.config)
+	tx.IdentityKeyRecord = NewIdentityKeyRecordClient(tx.config)
+	tx.KeyBackupKey = NewKeyBackupKeyClient(tx.config)
+	tx.KeyBackupVersion = NewKeyBackupVersionClient(tx.config)
+	tx.KeyLogEntry = NewKeyLogEntryClient(tx.config)
+	tx.KeyLogHead = NewKeyLogHeadClient(tx.config)
+	tx.KeyLogNode = NewKeyLogNodeClient(tx.config)
+	tx.KeyPackage = NewKeyPackageClient(tx.config)
+	tx.LoginThrottle = NewLoginThrottleClient(tx.config)
 	tx.Media = NewMediaClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.MessageEnvelope = NewMessageEnvelopeClient(tx.config)
+	tx.MlsCommit = NewMlsCommitClient(tx.config)
+	tx.MlsWelcome = NewMlsWelcomeClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
+	tx.OneTimePreKey = NewOneTimePreKeyClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
 	tx.Room = NewRoomClient(tx.config)
 	tx.RoomMembership = NewRoomMembershipClient(tx.config)
+	tx.SenderKeyDistribution = NewSenderKeyDistributionClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.SignedPreKey = NewSignedPreKeyClient(tx.config)
+	tx.TotpSecret = NewTotpSecretClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -182,7 +251,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: CallLog.QueryXXX(), the query will be executed
+// applies a query, for example: ApiKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -9,6 +9,8 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/eleven-am/enclave/ent/credential"
+	"github.com/eleven-am/enclave/ent/totpsecret"
 	"github.com/eleven-am/enclave/ent/user"
 )
 
@@ -23,8 +25,22 @@ type User struct {
 	DisplayName string `json:"display_name,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
+	// VerifiedAt holds the value of the "verified_at" field.
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
 	// AvatarURL holds the value of the "avatar_url" field.
 	AvatarURL *string `json:"avatar_url,omitempty"`
+	// IsBot holds the value of the "is_bot" field.
+	IsBot bool `json:"is_bot,omitempty"`
+	// Role holds the value of the "role" field.
+	Role user.Role `json:"role,omitempty"`
+	// SuspendedAt holds the value of the "suspended_at" field.
+	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
+	// Discoverability holds the value of the "discoverability" field.
+	Discoverability user.Discoverability `json:"discoverability,omitempty"`
+	// DeletionScheduledAt holds the value of the "deletion_scheduled_at" field.
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
+	// IsTombstone holds the value of the "is_tombstone" field.
+	IsTombstone bool `json:"is_tombstone,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -33,8 +49,11 @@ type User struct {
 	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
-	Edges        UserEdges `json:"edges"`
-	selectValues sql.SelectValues
+	Edges                 UserEdges `json:"edges"`
+	credential_user       *int
+	totp_secret_user      *int
+	user_service_accounts *int
+	selectValues          sql.SelectValues
 }
 
 // UserEdges holds the relations/edges for other nodes in the graph.
@@ -57,9 +76,37 @@ type UserEdges struct {
 	InitiatedCalls []*CallLog `json:"initiated_calls,omitempty"`
 	// CallParticipations holds the value of the call_participations edge.
 	CallParticipations []*CallParticipant `json:"call_participations,omitempty"`
+	// Credential holds the value of the credential edge.
+	Credential *Credential `json:"credential,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// Identities holds the value of the identities edge.
+	Identities []*Identity `json:"identities,omitempty"`
+	// TotpSecret holds the value of the totp_secret edge.
+	TotpSecret *TotpSecret `json:"totp_secret,omitempty"`
+	// RecoveryCodes holds the value of the recovery_codes edge.
+	RecoveryCodes []*RecoveryCode `json:"recovery_codes,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*ApiKey `json:"api_keys,omitempty"`
+	// EmailTokens holds the value of the email_tokens edge.
+	EmailTokens []*EmailToken `json:"email_tokens,omitempty"`
+	// LoginThrottles holds the value of the login_throttles edge.
+	LoginThrottles []*LoginThrottle `json:"login_throttles,omitempty"`
+	// Devices holds the value of the devices edge.
+	Devices []*Device `json:"devices,omitempty"`
+	// MlsCommits holds the value of the mls_commits edge.
+	MlsCommits []*MlsCommit `json:"mls_commits,omitempty"`
+	// SentMlsWelcomes holds the value of the sent_mls_welcomes edge.
+	SentMlsWelcomes []*MlsWelcome `json:"sent_mls_welcomes,omitempty"`
+	// SentSenderKeyDistributions holds the value of the sent_sender_key_distributions edge.
+	SentSenderKeyDistributions []*SenderKeyDistribution `json:"sent_sender_key_distributions,omitempty"`
+	// ServiceOwner holds the value of the service_owner edge.
+	ServiceOwner *User `json:"service_owner,omitempty"`
+	// ServiceAccounts holds the value of the service_accounts edge.
+	ServiceAccounts []*User `json:"service_accounts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [9]bool
+	loadedTypes [23]bool
 }
 
 // MembershipsOrErr returns the Memberships value or an error if the edge
@@ -143,17 +190,157 @@ func (e UserEdges) CallParticipationsOrErr() ([]*CallParticipant, error) {
 	return nil, &NotLoadedError{edge: "call_participations"}
 }
 
+// CredentialOrErr returns the Credential value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) CredentialOrErr() (*Credential, error) {
+	if e.Credential != nil {
+		return e.Credential, nil
+	} else if e.loadedTypes[9] {
+		return nil, &NotFoundError{label: credential.Label}
+	}
+	return nil, &NotLoadedError{edge: "credential"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[10] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) IdentitiesOrErr() ([]*Identity, error) {
+	if e.loadedTypes[11] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// TotpSecretOrErr returns the TotpSecret value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) TotpSecretOrErr() (*TotpSecret, error) {
+	if e.TotpSecret != nil {
+		return e.TotpSecret, nil
+	} else if e.loadedTypes[12] {
+		return nil, &NotFoundError{label: totpsecret.Label}
+	}
+	return nil, &NotLoadedError{edge: "totp_secret"}
+}
+
+// RecoveryCodesOrErr returns the RecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecoveryCodesOrErr() ([]*RecoveryCode, error) {
+	if e.loadedTypes[13] {
+		return e.RecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "recovery_codes"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APIKeysOrErr() ([]*ApiKey, error) {
+	if e.loadedTypes[14] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// EmailTokensOrErr returns the EmailTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailTokensOrErr() ([]*EmailToken, error) {
+	if e.loadedTypes[15] {
+		return e.EmailTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "email_tokens"}
+}
+
+// LoginThrottlesOrErr returns the LoginThrottles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LoginThrottlesOrErr() ([]*LoginThrottle, error) {
+	if e.loadedTypes[16] {
+		return e.LoginThrottles, nil
+	}
+	return nil, &NotLoadedError{edge: "login_throttles"}
+}
+
+// DevicesOrErr returns the Devices value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DevicesOrErr() ([]*Device, error) {
+	if e.loadedTypes[17] {
+		return e.Devices, nil
+	}
+	return nil, &NotLoadedError{edge: "devices"}
+}
+
+// MlsCommitsOrErr returns the MlsCommits value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MlsCommitsOrErr() ([]*MlsCommit, error) {
+	if e.loadedTypes[18] {
+		return e.MlsCommits, nil
+	}
+	return nil, &NotLoadedError{edge: "mls_commits"}
+}
+
+// SentMlsWelcomesOrErr returns the SentMlsWelcomes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SentMlsWelcomesOrErr() ([]*MlsWelcome, error) {
+	if e.loadedTypes[19] {
+		return e.SentMlsWelcomes, nil
+	}
+	return nil, &NotLoadedError{edge: "sent_mls_welcomes"}
+}
+
+// SentSenderKeyDistributionsOrErr returns the SentSenderKeyDistributions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SentSenderKeyDistributionsOrErr() ([]*SenderKeyDistribution, error) {
+	if e.loadedTypes[20] {
+		return e.SentSenderKeyDistributions, nil
+	}
+	return nil, &NotLoadedError{edge: "sent_sender_key_distributions"}
+}
+
+// ServiceOwnerOrErr returns the ServiceOwner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) ServiceOwnerOrErr() (*User, error) {
+	if e.ServiceOwner != nil {
+		return e.ServiceOwner, nil
+	} else if e.loadedTypes[21] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "service_owner"}
+}
+
+// ServiceAccountsOrErr returns the ServiceAccounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ServiceAccountsOrErr() ([]*User, error) {
+	if e.loadedTypes[22] {
+		return e.ServiceAccounts, nil
+	}
+	return nil, &NotLoadedError{edge: "service_accounts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldIsBot, user.FieldIsTombstone:
+			values[i] = new(sql.NullBool)
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldDisplayName, user.FieldEmail, user.FieldAvatarURL:
+		case user.FieldUsername, user.FieldDisplayName, user.FieldEmail, user.FieldAvatarURL, user.FieldRole, user.FieldDiscoverability:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLastSeenAt:
+		case user.FieldVerifiedAt, user.FieldSuspendedAt, user.FieldDeletionScheduledAt, user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLastSeenAt:
 			values[i] = new(sql.NullTime)
+		case user.ForeignKeys[0]: // credential_user
+			values[i] = new(sql.NullInt64)
+		case user.ForeignKeys[1]: // totp_secret_user
+			values[i] = new(sql.NullInt64)
+		case user.ForeignKeys[2]: // user_service_accounts
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -193,6 +380,13 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Email = value.String
 			}
+		case user.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				u.VerifiedAt = new(time.Time)
+				*u.VerifiedAt = value.Time
+			}
 		case user.FieldAvatarURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field avatar_url", values[i])
@@ -200,6 +394,44 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.AvatarURL = new(string)
 				*u.AvatarURL = value.String
 			}
+		case user.FieldIsBot:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_bot", values[i])
+			} else if value.Valid {
+				u.IsBot = value.Bool
+			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				u.Role = user.Role(value.String)
+			}
+		case user.FieldSuspendedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_at", values[i])
+			} else if value.Valid {
+				u.SuspendedAt = new(time.Time)
+				*u.SuspendedAt = value.Time
+			}
+		case user.FieldDiscoverability:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discoverability", values[i])
+			} else if value.Valid {
+				u.Discoverability = user.Discoverability(value.String)
+			}
+		case user.FieldDeletionScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_scheduled_at", values[i])
+			} else if value.Valid {
+				u.DeletionScheduledAt = new(time.Time)
+				*u.DeletionScheduledAt = value.Time
+			}
+		case user.FieldIsTombstone:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_tombstone", values[i])
+			} else if value.Valid {
+				u.IsTombstone = value.Bool
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -218,6 +450,27 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.LastSeenAt = value.Time
 			}
+		case user.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field credential_user", value)
+			} else if value.Valid {
+				u.credential_user = new(int)
+				*u.credential_user = int(value.Int64)
+			}
+		case user.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field totp_secret_user", value)
+			} else if value.Valid {
+				u.totp_secret_user = new(int)
+				*u.totp_secret_user = int(value.Int64)
+			}
+		case user.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_service_accounts", value)
+			} else if value.Valid {
+				u.user_service_accounts = new(int)
+				*u.user_service_accounts = int(value.Int64)
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -276,6 +529,76 @@ func (u *User) QueryCallParticipations() *CallParticipantQuery {
 	return NewUserClient(u.config).QueryCallParticipations(u)
 }
 
+// QueryCredential queries the "credential" edge of the User entity.
+func (u *User) QueryCredential() *CredentialQuery {
+	return NewUserClient(u.config).QueryCredential(u)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (u *User) QuerySessions() *SessionQuery {
+	return NewUserClient(u.config).QuerySessions(u)
+}
+
+// QueryIdentities queries the "identities" edge of the User entity.
+func (u *User) QueryIdentities() *IdentityQuery {
+	return NewUserClient(u.config).QueryIdentities(u)
+}
+
+// QueryTotpSecret queries the "totp_secret" edge of the User entity.
+func (u *User) QueryTotpSecret() *TotpSecretQuery {
+	return NewUserClient(u.config).QueryTotpSecret(u)
+}
+
+// QueryRecoveryCodes queries the "recovery_codes" edge of the User entity.
+func (u *User) QueryRecoveryCodes() *RecoveryCodeQuery {
+	return NewUserClient(u.config).QueryRecoveryCodes(u)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the User entity.
+func (u *User) QueryAPIKeys() *ApiKeyQuery {
+	return NewUserClient(u.config).QueryAPIKeys(u)
+}
+
+// QueryEmailTokens queries the "email_tokens" edge of the User entity.
+func (u *User) QueryEmailTokens() *EmailTokenQuery {
+	return NewUserClient(u.config).QueryEmailTokens(u)
+}
+
+// QueryLoginThrottles queries the "login_throttles" edge of the User entity.
+func (u *User) QueryLoginThrottles() *LoginThrottleQuery {
+	return NewUserClient(u.config).QueryLoginThrottles(u)
+}
+
+// QueryDevices queries the "devices" edge of the User entity.
+func (u *User) QueryDevices() *DeviceQuery {
+	return NewUserClient(u.config).QueryDevices(u)
+}
+
+// QueryMlsCommits queries the "mls_commits" edge of the User entity.
+func (u *User) QueryMlsCommits() *MlsCommitQuery {
+	return NewUserClient(u.config).QueryMlsCommits(u)
+}
+
+// QuerySentMlsWelcomes queries the "sent_mls_welcomes" edge of the User entity.
+func (u *User) QuerySentMlsWelcomes() *MlsWelcomeQuery {
+	return NewUserClient(u.config).QuerySentMlsWelcomes(u)
+}
+
+// QuerySentSenderKeyDistributions queries the "sent_sender_key_distributions" edge of the User entity.
+func (u *User) QuerySentSenderKeyDistributions() *SenderKeyDistributionQuery {
+	return NewUserClient(u.config).QuerySentSenderKeyDistributions(u)
+}
+
+// QueryServiceOwner queries the "service_owner" edge of the User entity.
+func (u *User) QueryServiceOwner() *UserQuery {
+	return NewUserClient(u.config).QueryServiceOwner(u)
+}
+
+// QueryServiceAccounts queries the "service_accounts" edge of the User entity.
+func (u *User) QueryServiceAccounts() *UserQuery {
+	return NewUserClient(u.config).QueryServiceAccounts(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -308,11 +631,38 @@ func (u *User) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(u.Email)
 	builder.WriteString(", ")
+	if v := u.VerifiedAt; v != nil {
+		builder.WriteString("verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := u.AvatarURL; v != nil {
 		builder.WriteString("avatar_url=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("is_bot=")
+	builder.WriteString(fmt.Sprintf("%v", u.IsBot))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", u.Role))
+	builder.WriteString(", ")
+	if v := u.SuspendedAt; v != nil {
+		builder.WriteString("suspended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("discoverability=")
+	builder.WriteString(fmt.Sprintf("%v", u.Discoverability))
+	builder.WriteString(", ")
+	if v := u.DeletionScheduledAt; v != nil {
+		builder.WriteString("deletion_scheduled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_tombstone=")
+	builder.WriteString(fmt.Sprintf("%v", u.IsTombstone))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
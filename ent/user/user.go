@@ -3,6 +3,7 @@
 package user
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -20,8 +21,22 @@ const (
 	FieldDisplayName = "display_name"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldVerifiedAt holds the string denoting the verified_at field in the database.
+	FieldVerifiedAt = "verified_at"
 	// FieldAvatarURL holds the string denoting the avatar_url field in the database.
 	FieldAvatarURL = "avatar_url"
+	// FieldIsBot holds the string denoting the is_bot field in the database.
+	FieldIsBot = "is_bot"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldSuspendedAt holds the string denoting the suspended_at field in the database.
+	FieldSuspendedAt = "suspended_at"
+	// FieldDiscoverability holds the string denoting the discoverability field in the database.
+	FieldDiscoverability = "discoverability"
+	// FieldDeletionScheduledAt holds the string denoting the deletion_scheduled_at field in the database.
+	FieldDeletionScheduledAt = "deletion_scheduled_at"
+	// FieldIsTombstone holds the string denoting the is_tombstone field in the database.
+	FieldIsTombstone = "is_tombstone"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -46,6 +61,34 @@ const (
 	EdgeInitiatedCalls = "initiated_calls"
 	// EdgeCallParticipations holds the string denoting the call_participations edge name in mutations.
 	EdgeCallParticipations = "call_participations"
+	// EdgeCredential holds the string denoting the credential edge name in mutations.
+	EdgeCredential = "credential"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// EdgeIdentities holds the string denoting the identities edge name in mutations.
+	EdgeIdentities = "identities"
+	// EdgeTotpSecret holds the string denoting the totp_secret edge name in mutations.
+	EdgeTotpSecret = "totp_secret"
+	// EdgeRecoveryCodes holds the string denoting the recovery_codes edge name in mutations.
+	EdgeRecoveryCodes = "recovery_codes"
+	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
+	EdgeAPIKeys = "api_keys"
+	// EdgeEmailTokens holds the string denoting the email_tokens edge name in mutations.
+	EdgeEmailTokens = "email_tokens"
+	// EdgeLoginThrottles holds the string denoting the login_throttles edge name in mutations.
+	EdgeLoginThrottles = "login_throttles"
+	// EdgeDevices holds the string denoting the devices edge name in mutations.
+	EdgeDevices = "devices"
+	// EdgeMlsCommits holds the string denoting the mls_commits edge name in mutations.
+	EdgeMlsCommits = "mls_commits"
+	// EdgeSentMlsWelcomes holds the string denoting the sent_mls_welcomes edge name in mutations.
+	EdgeSentMlsWelcomes = "sent_mls_welcomes"
+	// EdgeSentSenderKeyDistributions holds the string denoting the sent_sender_key_distributions edge name in mutations.
+	EdgeSentSenderKeyDistributions = "sent_sender_key_distributions"
+	// EdgeServiceOwner holds the string denoting the service_owner edge name in mutations.
+	EdgeServiceOwner = "service_owner"
+	// EdgeServiceAccounts holds the string denoting the service_accounts edge name in mutations.
+	EdgeServiceAccounts = "service_accounts"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// MembershipsTable is the table that holds the memberships relation/edge.
@@ -111,6 +154,98 @@ const (
 	CallParticipationsInverseTable = "call_participants"
 	// CallParticipationsColumn is the table column denoting the call_participations relation/edge.
 	CallParticipationsColumn = "call_participant_participant"
+	// CredentialTable is the table that holds the credential relation/edge.
+	CredentialTable = "users"
+	// CredentialInverseTable is the table name for the Credential entity.
+	// It exists in this package in order to avoid circular dependency with the "credential" package.
+	CredentialInverseTable = "credentials"
+	// CredentialColumn is the table column denoting the credential relation/edge.
+	CredentialColumn = "credential_user"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "session_user"
+	// IdentitiesTable is the table that holds the identities relation/edge.
+	IdentitiesTable = "identities"
+	// IdentitiesInverseTable is the table name for the Identity entity.
+	// It exists in this package in order to avoid circular dependency with the "identity" package.
+	IdentitiesInverseTable = "identities"
+	// IdentitiesColumn is the table column denoting the identities relation/edge.
+	IdentitiesColumn = "identity_user"
+	// TotpSecretTable is the table that holds the totp_secret relation/edge.
+	TotpSecretTable = "users"
+	// TotpSecretInverseTable is the table name for the TotpSecret entity.
+	// It exists in this package in order to avoid circular dependency with the "totpsecret" package.
+	TotpSecretInverseTable = "totp_secrets"
+	// TotpSecretColumn is the table column denoting the totp_secret relation/edge.
+	TotpSecretColumn = "totp_secret_user"
+	// RecoveryCodesTable is the table that holds the recovery_codes relation/edge.
+	RecoveryCodesTable = "recovery_codes"
+	// RecoveryCodesInverseTable is the table name for the RecoveryCode entity.
+	// It exists in this package in order to avoid circular dependency with the "recoverycode" package.
+	RecoveryCodesInverseTable = "recovery_codes"
+	// RecoveryCodesColumn is the table column denoting the recovery_codes relation/edge.
+	RecoveryCodesColumn = "recovery_code_user"
+	// APIKeysTable is the table that holds the api_keys relation/edge.
+	APIKeysTable = "api_keys"
+	// APIKeysInverseTable is the table name for the ApiKey entity.
+	// It exists in this package in order to avoid circular dependency with the "apikey" package.
+	APIKeysInverseTable = "api_keys"
+	// APIKeysColumn is the table column denoting the api_keys relation/edge.
+	APIKeysColumn = "api_key_user"
+	// EmailTokensTable is the table that holds the email_tokens relation/edge.
+	EmailTokensTable = "email_tokens"
+	// EmailTokensInverseTable is the table name for the EmailToken entity.
+	// It exists in this package in order to avoid circular dependency with the "emailtoken" package.
+	EmailTokensInverseTable = "email_tokens"
+	// EmailTokensColumn is the table column denoting the email_tokens relation/edge.
+	EmailTokensColumn = "email_token_user"
+	// LoginThrottlesTable is the table that holds the login_throttles relation/edge.
+	LoginThrottlesTable = "login_throttles"
+	// LoginThrottlesInverseTable is the table name for the LoginThrottle entity.
+	// It exists in this package in order to avoid circular dependency with the "loginthrottle" package.
+	LoginThrottlesInverseTable = "login_throttles"
+	// LoginThrottlesColumn is the table column denoting the login_throttles relation/edge.
+	LoginThrottlesColumn = "login_throttle_user"
+	// DevicesTable is the table that holds the devices relation/edge.
+	DevicesTable = "devices"
+	// DevicesInverseTable is the table name for the Device entity.
+	// It exists in this package in order to avoid circular dependency with the "device" package.
+	DevicesInverseTable = "devices"
+	// DevicesColumn is the table column denoting the devices relation/edge.
+	DevicesColumn = "device_user"
+	// MlsCommitsTable is the table that holds the mls_commits relation/edge.
+	MlsCommitsTable = "mls_commits"
+	// MlsCommitsInverseTable is the table name for the MlsCommit entity.
+	// It exists in this package in order to avoid circular dependency with the "mlscommit" package.
+	MlsCommitsInverseTable = "mls_commits"
+	// MlsCommitsColumn is the table column denoting the mls_commits relation/edge.
+	MlsCommitsColumn = "mls_commit_sender"
+	// SentMlsWelcomesTable is the table that holds the sent_mls_welcomes relation/edge.
+	SentMlsWelcomesTable = "mls_welcomes"
+	// SentMlsWelcomesInverseTable is the table name for the MlsWelcome entity.
+	// It exists in this package in order to avoid circular dependency with the "mlswelcome" package.
+	SentMlsWelcomesInverseTable = "mls_welcomes"
+	// SentMlsWelcomesColumn is the table column denoting the sent_mls_welcomes relation/edge.
+	SentMlsWelcomesColumn = "mls_welcome_sender"
+	// SentSenderKeyDistributionsTable is the table that holds the sent_sender_key_distributions relation/edge.
+	SentSenderKeyDistributionsTable = "sender_key_distributions"
+	// SentSenderKeyDistributionsInverseTable is the table name for the SenderKeyDistribution entity.
+	// It exists in this package in order to avoid circular dependency with the "senderkeydistribution" package.
+	SentSenderKeyDistributionsInverseTable = "sender_key_distributions"
+	// SentSenderKeyDistributionsColumn is the table column denoting the sent_sender_key_distributions relation/edge.
+	SentSenderKeyDistributionsColumn = "sender_key_distribution_sender"
+	// ServiceOwnerTable is the table that holds the service_owner relation/edge.
+	ServiceOwnerTable = "users"
+	// ServiceOwnerColumn is the table column denoting the service_owner relation/edge.
+	ServiceOwnerColumn = "user_service_accounts"
+	// ServiceAccountsTable is the table that holds the service_accounts relation/edge.
+	ServiceAccountsTable = "users"
+	// ServiceAccountsColumn is the table column denoting the service_accounts relation/edge.
+	ServiceAccountsColumn = "user_service_accounts"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -119,12 +254,27 @@ var Columns = []string{
 	FieldUsername,
 	FieldDisplayName,
 	FieldEmail,
+	FieldVerifiedAt,
 	FieldAvatarURL,
+	FieldIsBot,
+	FieldRole,
+	FieldSuspendedAt,
+	FieldDiscoverability,
+	FieldDeletionScheduledAt,
+	FieldIsTombstone,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldLastSeenAt,
 }
 
+// ForeignKeys holds the SQL foreign-keys that are owned by the "users"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"credential_user",
+	"totp_secret_user",
+	"user_service_accounts",
+}
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -132,6 +282,11 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
@@ -142,6 +297,10 @@ var (
 	DisplayNameValidator func(string) error
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	EmailValidator func(string) error
+	// DefaultIsBot holds the default value on creation for the "is_bot" field.
+	DefaultIsBot bool
+	// DefaultIsTombstone holds the default value on creation for the "is_tombstone" field.
+	DefaultIsTombstone bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -154,6 +313,60 @@ var (
 	UpdateDefaultLastSeenAt func() time.Time
 )
 
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleUser is the default value of the Role enum.
+const DefaultRole = RoleUser
+
+// Role values.
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleUser, RoleModerator, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
+
+// Discoverability defines the type for the "discoverability" enum field.
+type Discoverability string
+
+// DiscoverabilityEveryone is the default value of the Discoverability enum.
+const DefaultDiscoverability = DiscoverabilityEveryone
+
+// Discoverability values.
+const (
+	DiscoverabilityEveryone Discoverability = "everyone"
+	DiscoverabilityContacts Discoverability = "contacts"
+	DiscoverabilityNobody   Discoverability = "nobody"
+)
+
+func (d Discoverability) String() string {
+	return string(d)
+}
+
+// DiscoverabilityValidator is a validator for the "discoverability" field enum values. It is called by the builders before save.
+func DiscoverabilityValidator(d Discoverability) error {
+	switch d {
+	case DiscoverabilityEveryone, DiscoverabilityContacts, DiscoverabilityNobody:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for discoverability field: %q", d)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -177,11 +390,46 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByVerifiedAt orders the results by the verified_at field.
+func ByVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedAt, opts...).ToFunc()
+}
+
 // ByAvatarURL orders the results by the avatar_url field.
 func ByAvatarURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvatarURL, opts...).ToFunc()
 }
 
+// ByIsBot orders the results by the is_bot field.
+func ByIsBot(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsBot, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// BySuspendedAt orders the results by the suspended_at field.
+func BySuspendedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedAt, opts...).ToFunc()
+}
+
+// ByDiscoverability orders the results by the discoverability field.
+func ByDiscoverability(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscoverability, opts...).ToFunc()
+}
+
+// ByDeletionScheduledAt orders the results by the deletion_scheduled_at field.
+func ByDeletionScheduledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionScheduledAt, opts...).ToFunc()
+}
+
+// ByIsTombstone orders the results by the is_tombstone field.
+func ByIsTombstone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsTombstone, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -322,6 +570,181 @@ func ByCallParticipations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newCallParticipationsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCredentialField orders the results by credential field.
+func ByCredentialField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCredentialStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIdentitiesCount orders the results by identities count.
+func ByIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIdentitiesStep(), opts...)
+	}
+}
+
+// ByIdentities orders the results by identities terms.
+func ByIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTotpSecretField orders the results by totp_secret field.
+func ByTotpSecretField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTotpSecretStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRecoveryCodesCount orders the results by recovery_codes count.
+func ByRecoveryCodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecoveryCodesStep(), opts...)
+	}
+}
+
+// ByRecoveryCodes orders the results by recovery_codes terms.
+func ByRecoveryCodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecoveryCodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAPIKeysCount orders the results by api_keys count.
+func ByAPIKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPIKeysStep(), opts...)
+	}
+}
+
+// ByAPIKeys orders the results by api_keys terms.
+func ByAPIKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPIKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmailTokensCount orders the results by email_tokens count.
+func ByEmailTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmailTokensStep(), opts...)
+	}
+}
+
+// ByEmailTokens orders the results by email_tokens terms.
+func ByEmailTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmailTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLoginThrottlesCount orders the results by login_throttles count.
+func ByLoginThrottlesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLoginThrottlesStep(), opts...)
+	}
+}
+
+// ByLoginThrottles orders the results by login_throttles terms.
+func ByLoginThrottles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoginThrottlesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDevicesCount orders the results by devices count.
+func ByDevicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDevicesStep(), opts...)
+	}
+}
+
+// ByDevices orders the results by devices terms.
+func ByDevices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDevicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMlsCommitsCount orders the results by mls_commits count.
+func ByMlsCommitsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMlsCommitsStep(), opts...)
+	}
+}
+
+// ByMlsCommits orders the results by mls_commits terms.
+func ByMlsCommits(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMlsCommitsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySentMlsWelcomesCount orders the results by sent_mls_welcomes count.
+func BySentMlsWelcomesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSentMlsWelcomesStep(), opts...)
+	}
+}
+
+// BySentMlsWelcomes orders the results by sent_mls_welcomes terms.
+func BySentMlsWelcomes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSentMlsWelcomesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySentSenderKeyDistributionsCount orders the results by sent_sender_key_distributions count.
+func BySentSenderKeyDistributionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSentSenderKeyDistributionsStep(), opts...)
+	}
+}
+
+// BySentSenderKeyDistributions orders the results by sent_sender_key_distributions terms.
+func BySentSenderKeyDistributions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSentSenderKeyDistributionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByServiceOwnerField orders the results by service_owner field.
+func ByServiceOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newServiceOwnerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByServiceAccountsCount orders the results by service_accounts count.
+func ByServiceAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newServiceAccountsStep(), opts...)
+	}
+}
+
+// ByServiceAccounts orders the results by service_accounts terms.
+func ByServiceAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newServiceAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMembershipsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -385,3 +808,101 @@ func newCallParticipationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, CallParticipationsTable, CallParticipationsColumn),
 	)
 }
+func newCredentialStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CredentialInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, CredentialTable, CredentialColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SessionsTable, SessionsColumn),
+	)
+}
+func newIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IdentitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, IdentitiesTable, IdentitiesColumn),
+	)
+}
+func newTotpSecretStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TotpSecretInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, TotpSecretTable, TotpSecretColumn),
+	)
+}
+func newRecoveryCodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecoveryCodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, RecoveryCodesTable, RecoveryCodesColumn),
+	)
+}
+func newAPIKeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(APIKeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, APIKeysTable, APIKeysColumn),
+	)
+}
+func newEmailTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmailTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, EmailTokensTable, EmailTokensColumn),
+	)
+}
+func newLoginThrottlesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoginThrottlesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, LoginThrottlesTable, LoginThrottlesColumn),
+	)
+}
+func newDevicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DevicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, DevicesTable, DevicesColumn),
+	)
+}
+func newMlsCommitsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MlsCommitsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MlsCommitsTable, MlsCommitsColumn),
+	)
+}
+func newSentMlsWelcomesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SentMlsWelcomesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SentMlsWelcomesTable, SentMlsWelcomesColumn),
+	)
+}
+func newSentSenderKeyDistributionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SentSenderKeyDistributionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SentSenderKeyDistributionsTable, SentSenderKeyDistributionsColumn),
+	)
+}
+func newServiceOwnerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ServiceOwnerTable, ServiceOwnerColumn),
+	)
+}
+func newServiceAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ServiceAccountsTable, ServiceAccountsColumn),
+	)
+}
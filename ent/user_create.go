@@ -10,14 +10,26 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/apikey"
 	"github.com/eleven-am/enclave/ent/calllog"
 	"github.com/eleven-am/enclave/ent/callparticipant"
 	"github.com/eleven-am/enclave/ent/contact"
+	"github.com/eleven-am/enclave/ent/credential"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/emailtoken"
 	"github.com/eleven-am/enclave/ent/favourite"
+	"github.com/eleven-am/enclave/ent/identity"
+	"github.com/eleven-am/enclave/ent/loginthrottle"
 	"github.com/eleven-am/enclave/ent/media"
 	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/recoverycode"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/totpsecret"
 	"github.com/eleven-am/enclave/ent/user"
 )
 
@@ -46,6 +58,20 @@ func (uc *UserCreate) SetEmail(s string) *UserCreate {
 	return uc
 }
 
+// SetVerifiedAt sets the "verified_at" field.
+func (uc *UserCreate) SetVerifiedAt(t time.Time) *UserCreate {
+	uc.mutation.SetVerifiedAt(t)
+	return uc
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableVerifiedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetVerifiedAt(*t)
+	}
+	return uc
+}
+
 // SetAvatarURL sets the "avatar_url" field.
 func (uc *UserCreate) SetAvatarURL(s string) *UserCreate {
 	uc.mutation.SetAvatarURL(s)
@@ -60,6 +86,90 @@ func (uc *UserCreate) SetNillableAvatarURL(s *string) *UserCreate {
 	return uc
 }
 
+// SetIsBot sets the "is_bot" field.
+func (uc *UserCreate) SetIsBot(b bool) *UserCreate {
+	uc.mutation.SetIsBot(b)
+	return uc
+}
+
+// SetNillableIsBot sets the "is_bot" field if the given value is not nil.
+func (uc *UserCreate) SetNillableIsBot(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetIsBot(*b)
+	}
+	return uc
+}
+
+// SetRole sets the "role" field.
+func (uc *UserCreate) SetRole(u user.Role) *UserCreate {
+	uc.mutation.SetRole(u)
+	return uc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uc *UserCreate) SetNillableRole(u *user.Role) *UserCreate {
+	if u != nil {
+		uc.SetRole(*u)
+	}
+	return uc
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (uc *UserCreate) SetSuspendedAt(t time.Time) *UserCreate {
+	uc.mutation.SetSuspendedAt(t)
+	return uc
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSuspendedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetSuspendedAt(*t)
+	}
+	return uc
+}
+
+// SetDiscoverability sets the "discoverability" field.
+func (uc *UserCreate) SetDiscoverability(u user.Discoverability) *UserCreate {
+	uc.mutation.SetDiscoverability(u)
+	return uc
+}
+
+// SetNillableDiscoverability sets the "discoverability" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDiscoverability(u *user.Discoverability) *UserCreate {
+	if u != nil {
+		uc.SetDiscoverability(*u)
+	}
+	return uc
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uc *UserCreate) SetDeletionScheduledAt(t time.Time) *UserCreate {
+	uc.mutation.SetDeletionScheduledAt(t)
+	return uc
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDeletionScheduledAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetDeletionScheduledAt(*t)
+	}
+	return uc
+}
+
+// SetIsTombstone sets the "is_tombstone" field.
+func (uc *UserCreate) SetIsTombstone(b bool) *UserCreate {
+	uc.mutation.SetIsTombstone(b)
+	return uc
+}
+
+// SetNillableIsTombstone sets the "is_tombstone" field if the given value is not nil.
+func (uc *UserCreate) SetNillableIsTombstone(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetIsTombstone(*b)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -237,6 +347,228 @@ func (uc *UserCreate) AddCallParticipations(c ...*CallParticipant) *UserCreate {
 	return uc.AddCallParticipationIDs(ids...)
 }
 
+// SetCredentialID sets the "credential" edge to the Credential entity by ID.
+func (uc *UserCreate) SetCredentialID(id int) *UserCreate {
+	uc.mutation.SetCredentialID(id)
+	return uc
+}
+
+// SetNillableCredentialID sets the "credential" edge to the Credential entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableCredentialID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetCredentialID(*id)
+	}
+	return uc
+}
+
+// SetCredential sets the "credential" edge to the Credential entity.
+func (uc *UserCreate) SetCredential(c *Credential) *UserCreate {
+	return uc.SetCredentialID(c.ID)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uc *UserCreate) AddSessionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSessionIDs(ids...)
+	return uc
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uc *UserCreate) AddSessions(s ...*Session) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSessionIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uc *UserCreate) AddIdentityIDs(ids ...int) *UserCreate {
+	uc.mutation.AddIdentityIDs(ids...)
+	return uc
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uc *UserCreate) AddIdentities(i ...*Identity) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddIdentityIDs(ids...)
+}
+
+// SetTotpSecretID sets the "totp_secret" edge to the TotpSecret entity by ID.
+func (uc *UserCreate) SetTotpSecretID(id int) *UserCreate {
+	uc.mutation.SetTotpSecretID(id)
+	return uc
+}
+
+// SetNillableTotpSecretID sets the "totp_secret" edge to the TotpSecret entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpSecretID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetTotpSecretID(*id)
+	}
+	return uc
+}
+
+// SetTotpSecret sets the "totp_secret" edge to the TotpSecret entity.
+func (uc *UserCreate) SetTotpSecret(t *TotpSecret) *UserCreate {
+	return uc.SetTotpSecretID(t.ID)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (uc *UserCreate) AddRecoveryCodeIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRecoveryCodeIDs(ids...)
+	return uc
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (uc *UserCreate) AddRecoveryCodes(r ...*RecoveryCode) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRecoveryCodeIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the ApiKey entity by IDs.
+func (uc *UserCreate) AddAPIKeyIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAPIKeyIDs(ids...)
+	return uc
+}
+
+// AddAPIKeys adds the "api_keys" edges to the ApiKey entity.
+func (uc *UserCreate) AddAPIKeys(a ...*ApiKey) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAPIKeyIDs(ids...)
+}
+
+// AddEmailTokenIDs adds the "email_tokens" edge to the EmailToken entity by IDs.
+func (uc *UserCreate) AddEmailTokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddEmailTokenIDs(ids...)
+	return uc
+}
+
+// AddEmailTokens adds the "email_tokens" edges to the EmailToken entity.
+func (uc *UserCreate) AddEmailTokens(e ...*EmailToken) *UserCreate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uc.AddEmailTokenIDs(ids...)
+}
+
+// AddLoginThrottleIDs adds the "login_throttles" edge to the LoginThrottle entity by IDs.
+func (uc *UserCreate) AddLoginThrottleIDs(ids ...int) *UserCreate {
+	uc.mutation.AddLoginThrottleIDs(ids...)
+	return uc
+}
+
+// AddLoginThrottles adds the "login_throttles" edges to the LoginThrottle entity.
+func (uc *UserCreate) AddLoginThrottles(l ...*LoginThrottle) *UserCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uc.AddLoginThrottleIDs(ids...)
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (uc *UserCreate) AddDeviceIDs(ids ...int) *UserCreate {
+	uc.mutation.AddDeviceIDs(ids...)
+	return uc
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (uc *UserCreate) AddDevices(d ...*Device) *UserCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uc.AddDeviceIDs(ids...)
+}
+
+// AddMlsCommitIDs adds the "mls_commits" edge to the MlsCommit entity by IDs.
+func (uc *UserCreate) AddMlsCommitIDs(ids ...int) *UserCreate {
+	uc.mutation.AddMlsCommitIDs(ids...)
+	return uc
+}
+
+// AddMlsCommits adds the "mls_commits" edges to the MlsCommit entity.
+func (uc *UserCreate) AddMlsCommits(m ...*MlsCommit) *UserCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMlsCommitIDs(ids...)
+}
+
+// AddSentMlsWelcomeIDs adds the "sent_mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (uc *UserCreate) AddSentMlsWelcomeIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSentMlsWelcomeIDs(ids...)
+	return uc
+}
+
+// AddSentMlsWelcomes adds the "sent_mls_welcomes" edges to the MlsWelcome entity.
+func (uc *UserCreate) AddSentMlsWelcomes(m ...*MlsWelcome) *UserCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddSentMlsWelcomeIDs(ids...)
+}
+
+// AddSentSenderKeyDistributionIDs adds the "sent_sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (uc *UserCreate) AddSentSenderKeyDistributionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSentSenderKeyDistributionIDs(ids...)
+	return uc
+}
+
+// AddSentSenderKeyDistributions adds the "sent_sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (uc *UserCreate) AddSentSenderKeyDistributions(s ...*SenderKeyDistribution) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSentSenderKeyDistributionIDs(ids...)
+}
+
+// SetServiceOwnerID sets the "service_owner" edge to the User entity by ID.
+func (uc *UserCreate) SetServiceOwnerID(id int) *UserCreate {
+	uc.mutation.SetServiceOwnerID(id)
+	return uc
+}
+
+// SetNillableServiceOwnerID sets the "service_owner" edge to the User entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableServiceOwnerID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetServiceOwnerID(*id)
+	}
+	return uc
+}
+
+// SetServiceOwner sets the "service_owner" edge to the User entity.
+func (uc *UserCreate) SetServiceOwner(u *User) *UserCreate {
+	return uc.SetServiceOwnerID(u.ID)
+}
+
+// AddServiceAccountIDs adds the "service_accounts" edge to the User entity by IDs.
+func (uc *UserCreate) AddServiceAccountIDs(ids ...int) *UserCreate {
+	uc.mutation.AddServiceAccountIDs(ids...)
+	return uc
+}
+
+// AddServiceAccounts adds the "service_accounts" edges to the User entity.
+func (uc *UserCreate) AddServiceAccounts(u ...*User) *UserCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddServiceAccountIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -272,6 +604,22 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (uc *UserCreate) defaults() {
+	if _, ok := uc.mutation.IsBot(); !ok {
+		v := user.DefaultIsBot
+		uc.mutation.SetIsBot(v)
+	}
+	if _, ok := uc.mutation.Role(); !ok {
+		v := user.DefaultRole
+		uc.mutation.SetRole(v)
+	}
+	if _, ok := uc.mutation.Discoverability(); !ok {
+		v := user.DefaultDiscoverability
+		uc.mutation.SetDiscoverability(v)
+	}
+	if _, ok := uc.mutation.IsTombstone(); !ok {
+		v := user.DefaultIsTombstone
+		uc.mutation.SetIsTombstone(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
@@ -312,6 +660,28 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
 		}
 	}
+	if _, ok := uc.mutation.IsBot(); !ok {
+		return &ValidationError{Name: "is_bot", err: errors.New(`ent: missing required field "User.is_bot"`)}
+	}
+	if _, ok := uc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := uc.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.Discoverability(); !ok {
+		return &ValidationError{Name: "discoverability", err: errors.New(`ent: missing required field "User.discoverability"`)}
+	}
+	if v, ok := uc.mutation.Discoverability(); ok {
+		if err := user.DiscoverabilityValidator(v); err != nil {
+			return &ValidationError{Name: "discoverability", err: fmt.Errorf(`ent: validator failed for field "User.discoverability": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.IsTombstone(); !ok {
+		return &ValidationError{Name: "is_tombstone", err: errors.New(`ent: missing required field "User.is_tombstone"`)}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -359,10 +729,38 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := uc.mutation.VerifiedAt(); ok {
+		_spec.SetField(user.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
 	if value, ok := uc.mutation.AvatarURL(); ok {
 		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
 		_node.AvatarURL = &value
 	}
+	if value, ok := uc.mutation.IsBot(); ok {
+		_spec.SetField(user.FieldIsBot, field.TypeBool, value)
+		_node.IsBot = value
+	}
+	if value, ok := uc.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := uc.mutation.SuspendedAt(); ok {
+		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+		_node.SuspendedAt = &value
+	}
+	if value, ok := uc.mutation.Discoverability(); ok {
+		_spec.SetField(user.FieldDiscoverability, field.TypeEnum, value)
+		_node.Discoverability = value
+	}
+	if value, ok := uc.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+		_node.DeletionScheduledAt = &value
+	}
+	if value, ok := uc.mutation.IsTombstone(); ok {
+		_spec.SetField(user.FieldIsTombstone, field.TypeBool, value)
+		_node.IsTombstone = value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -519,6 +917,233 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.CredentialTable,
+			Columns: []string{user.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.credential_user = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.TotpSecretIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.TotpSecretTable,
+			Columns: []string{user.TotpSecretColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(totpsecret.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.totp_secret_user = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.EmailTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.EmailTokensTable,
+			Columns: []string{user.EmailTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.LoginThrottlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LoginThrottlesTable,
+			Columns: []string{user.LoginThrottlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.DevicesTable,
+			Columns: []string{user.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MlsCommitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MlsCommitsTable,
+			Columns: []string{user.MlsCommitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SentMlsWelcomesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SentMlsWelcomesTable,
+			Columns: []string{user.SentMlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SentSenderKeyDistributionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SentSenderKeyDistributionsTable,
+			Columns: []string{user.SentSenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ServiceOwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.ServiceOwnerTable,
+			Columns: []string{user.ServiceOwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_service_accounts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ServiceAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ServiceAccountsTable,
+			Columns: []string{user.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -11,15 +11,27 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/eleven-am/enclave/ent/apikey"
 	"github.com/eleven-am/enclave/ent/calllog"
 	"github.com/eleven-am/enclave/ent/callparticipant"
 	"github.com/eleven-am/enclave/ent/contact"
+	"github.com/eleven-am/enclave/ent/credential"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/emailtoken"
 	"github.com/eleven-am/enclave/ent/favourite"
+	"github.com/eleven-am/enclave/ent/identity"
+	"github.com/eleven-am/enclave/ent/loginthrottle"
 	"github.com/eleven-am/enclave/ent/media"
 	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
 	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/recoverycode"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/totpsecret"
 	"github.com/eleven-am/enclave/ent/user"
 )
 
@@ -78,6 +90,26 @@ func (uu *UserUpdate) SetNillableEmail(s *string) *UserUpdate {
 	return uu
 }
 
+// SetVerifiedAt sets the "verified_at" field.
+func (uu *UserUpdate) SetVerifiedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetVerifiedAt(t)
+	return uu
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableVerifiedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetVerifiedAt(*t)
+	}
+	return uu
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (uu *UserUpdate) ClearVerifiedAt() *UserUpdate {
+	uu.mutation.ClearVerifiedAt()
+	return uu
+}
+
 // SetAvatarURL sets the "avatar_url" field.
 func (uu *UserUpdate) SetAvatarURL(s string) *UserUpdate {
 	uu.mutation.SetAvatarURL(s)
@@ -98,6 +130,102 @@ func (uu *UserUpdate) ClearAvatarURL() *UserUpdate {
 	return uu
 }
 
+// SetIsBot sets the "is_bot" field.
+func (uu *UserUpdate) SetIsBot(b bool) *UserUpdate {
+	uu.mutation.SetIsBot(b)
+	return uu
+}
+
+// SetNillableIsBot sets the "is_bot" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableIsBot(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetIsBot(*b)
+	}
+	return uu
+}
+
+// SetRole sets the "role" field.
+func (uu *UserUpdate) SetRole(u user.Role) *UserUpdate {
+	uu.mutation.SetRole(u)
+	return uu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRole(u *user.Role) *UserUpdate {
+	if u != nil {
+		uu.SetRole(*u)
+	}
+	return uu
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (uu *UserUpdate) SetSuspendedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetSuspendedAt(t)
+	return uu
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSuspendedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetSuspendedAt(*t)
+	}
+	return uu
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (uu *UserUpdate) ClearSuspendedAt() *UserUpdate {
+	uu.mutation.ClearSuspendedAt()
+	return uu
+}
+
+// SetDiscoverability sets the "discoverability" field.
+func (uu *UserUpdate) SetDiscoverability(u user.Discoverability) *UserUpdate {
+	uu.mutation.SetDiscoverability(u)
+	return uu
+}
+
+// SetNillableDiscoverability sets the "discoverability" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDiscoverability(u *user.Discoverability) *UserUpdate {
+	if u != nil {
+		uu.SetDiscoverability(*u)
+	}
+	return uu
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uu *UserUpdate) SetDeletionScheduledAt(t time.Time) *UserUpdate {
+	uu.mutation.SetDeletionScheduledAt(t)
+	return uu
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDeletionScheduledAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetDeletionScheduledAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (uu *UserUpdate) ClearDeletionScheduledAt() *UserUpdate {
+	uu.mutation.ClearDeletionScheduledAt()
+	return uu
+}
+
+// SetIsTombstone sets the "is_tombstone" field.
+func (uu *UserUpdate) SetIsTombstone(b bool) *UserUpdate {
+	uu.mutation.SetIsTombstone(b)
+	return uu
+}
+
+// SetNillableIsTombstone sets the "is_tombstone" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableIsTombstone(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetIsTombstone(*b)
+	}
+	return uu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uu *UserUpdate) SetCreatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetCreatedAt(t)
@@ -259,6 +387,228 @@ func (uu *UserUpdate) AddCallParticipations(c ...*CallParticipant) *UserUpdate {
 	return uu.AddCallParticipationIDs(ids...)
 }
 
+// SetCredentialID sets the "credential" edge to the Credential entity by ID.
+func (uu *UserUpdate) SetCredentialID(id int) *UserUpdate {
+	uu.mutation.SetCredentialID(id)
+	return uu
+}
+
+// SetNillableCredentialID sets the "credential" edge to the Credential entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableCredentialID(id *int) *UserUpdate {
+	if id != nil {
+		uu = uu.SetCredentialID(*id)
+	}
+	return uu
+}
+
+// SetCredential sets the "credential" edge to the Credential entity.
+func (uu *UserUpdate) SetCredential(c *Credential) *UserUpdate {
+	return uu.SetCredentialID(c.ID)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uu *UserUpdate) AddSessionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSessionIDs(ids...)
+	return uu
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uu *UserUpdate) AddSessions(s ...*Session) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSessionIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uu *UserUpdate) AddIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddIdentityIDs(ids...)
+	return uu
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uu *UserUpdate) AddIdentities(i ...*Identity) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddIdentityIDs(ids...)
+}
+
+// SetTotpSecretID sets the "totp_secret" edge to the TotpSecret entity by ID.
+func (uu *UserUpdate) SetTotpSecretID(id int) *UserUpdate {
+	uu.mutation.SetTotpSecretID(id)
+	return uu
+}
+
+// SetNillableTotpSecretID sets the "totp_secret" edge to the TotpSecret entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpSecretID(id *int) *UserUpdate {
+	if id != nil {
+		uu = uu.SetTotpSecretID(*id)
+	}
+	return uu
+}
+
+// SetTotpSecret sets the "totp_secret" edge to the TotpSecret entity.
+func (uu *UserUpdate) SetTotpSecret(t *TotpSecret) *UserUpdate {
+	return uu.SetTotpSecretID(t.ID)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (uu *UserUpdate) AddRecoveryCodeIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddRecoveryCodeIDs(ids...)
+	return uu
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (uu *UserUpdate) AddRecoveryCodes(r ...*RecoveryCode) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRecoveryCodeIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the ApiKey entity by IDs.
+func (uu *UserUpdate) AddAPIKeyIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAPIKeyIDs(ids...)
+	return uu
+}
+
+// AddAPIKeys adds the "api_keys" edges to the ApiKey entity.
+func (uu *UserUpdate) AddAPIKeys(a ...*ApiKey) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAPIKeyIDs(ids...)
+}
+
+// AddEmailTokenIDs adds the "email_tokens" edge to the EmailToken entity by IDs.
+func (uu *UserUpdate) AddEmailTokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddEmailTokenIDs(ids...)
+	return uu
+}
+
+// AddEmailTokens adds the "email_tokens" edges to the EmailToken entity.
+func (uu *UserUpdate) AddEmailTokens(e ...*EmailToken) *UserUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uu.AddEmailTokenIDs(ids...)
+}
+
+// AddLoginThrottleIDs adds the "login_throttles" edge to the LoginThrottle entity by IDs.
+func (uu *UserUpdate) AddLoginThrottleIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddLoginThrottleIDs(ids...)
+	return uu
+}
+
+// AddLoginThrottles adds the "login_throttles" edges to the LoginThrottle entity.
+func (uu *UserUpdate) AddLoginThrottles(l ...*LoginThrottle) *UserUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uu.AddLoginThrottleIDs(ids...)
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (uu *UserUpdate) AddDeviceIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddDeviceIDs(ids...)
+	return uu
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (uu *UserUpdate) AddDevices(d ...*Device) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.AddDeviceIDs(ids...)
+}
+
+// AddMlsCommitIDs adds the "mls_commits" edge to the MlsCommit entity by IDs.
+func (uu *UserUpdate) AddMlsCommitIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddMlsCommitIDs(ids...)
+	return uu
+}
+
+// AddMlsCommits adds the "mls_commits" edges to the MlsCommit entity.
+func (uu *UserUpdate) AddMlsCommits(m ...*MlsCommit) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMlsCommitIDs(ids...)
+}
+
+// AddSentMlsWelcomeIDs adds the "sent_mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (uu *UserUpdate) AddSentMlsWelcomeIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSentMlsWelcomeIDs(ids...)
+	return uu
+}
+
+// AddSentMlsWelcomes adds the "sent_mls_welcomes" edges to the MlsWelcome entity.
+func (uu *UserUpdate) AddSentMlsWelcomes(m ...*MlsWelcome) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddSentMlsWelcomeIDs(ids...)
+}
+
+// AddSentSenderKeyDistributionIDs adds the "sent_sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (uu *UserUpdate) AddSentSenderKeyDistributionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSentSenderKeyDistributionIDs(ids...)
+	return uu
+}
+
+// AddSentSenderKeyDistributions adds the "sent_sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (uu *UserUpdate) AddSentSenderKeyDistributions(s ...*SenderKeyDistribution) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSentSenderKeyDistributionIDs(ids...)
+}
+
+// SetServiceOwnerID sets the "service_owner" edge to the User entity by ID.
+func (uu *UserUpdate) SetServiceOwnerID(id int) *UserUpdate {
+	uu.mutation.SetServiceOwnerID(id)
+	return uu
+}
+
+// SetNillableServiceOwnerID sets the "service_owner" edge to the User entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableServiceOwnerID(id *int) *UserUpdate {
+	if id != nil {
+		uu = uu.SetServiceOwnerID(*id)
+	}
+	return uu
+}
+
+// SetServiceOwner sets the "service_owner" edge to the User entity.
+func (uu *UserUpdate) SetServiceOwner(u *User) *UserUpdate {
+	return uu.SetServiceOwnerID(u.ID)
+}
+
+// AddServiceAccountIDs adds the "service_accounts" edge to the User entity by IDs.
+func (uu *UserUpdate) AddServiceAccountIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddServiceAccountIDs(ids...)
+	return uu
+}
+
+// AddServiceAccounts adds the "service_accounts" edges to the User entity.
+func (uu *UserUpdate) AddServiceAccounts(u ...*User) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddServiceAccountIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -453,74 +803,333 @@ func (uu *UserUpdate) RemoveCallParticipations(c ...*CallParticipant) *UserUpdat
 	return uu.RemoveCallParticipationIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-	uu.defaults()
-	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+// ClearCredential clears the "credential" edge to the Credential entity.
+func (uu *UserUpdate) ClearCredential() *UserUpdate {
+	uu.mutation.ClearCredential()
+	return uu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (uu *UserUpdate) ClearSessions() *UserUpdate {
+	uu.mutation.ClearSessions()
+	return uu
 }
 
-// Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
-	return err
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (uu *UserUpdate) RemoveSessionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSessionIDs(ids...)
+	return uu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
-		panic(err)
+// RemoveSessions removes "sessions" edges to Session entities.
+func (uu *UserUpdate) RemoveSessions(s ...*Session) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
+	return uu.RemoveSessionIDs(ids...)
 }
 
-// defaults sets the default values of the builder before save.
-func (uu *UserUpdate) defaults() {
-	if _, ok := uu.mutation.UpdatedAt(); !ok {
-		v := user.UpdateDefaultUpdatedAt()
-		uu.mutation.SetUpdatedAt(v)
-	}
-	if _, ok := uu.mutation.LastSeenAt(); !ok {
-		v := user.UpdateDefaultLastSeenAt()
-		uu.mutation.SetLastSeenAt(v)
+// ClearIdentities clears all "identities" edges to the Identity entity.
+func (uu *UserUpdate) ClearIdentities() *UserUpdate {
+	uu.mutation.ClearIdentities()
+	return uu
+}
+
+// RemoveIdentityIDs removes the "identities" edge to Identity entities by IDs.
+func (uu *UserUpdate) RemoveIdentityIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveIdentityIDs(ids...)
+	return uu
+}
+
+// RemoveIdentities removes "identities" edges to Identity entities.
+func (uu *UserUpdate) RemoveIdentities(i ...*Identity) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
 	}
+	return uu.RemoveIdentityIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (uu *UserUpdate) check() error {
-	if v, ok := uu.mutation.Username(); ok {
-		if err := user.UsernameValidator(v); err != nil {
-			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
-		}
+// ClearTotpSecret clears the "totp_secret" edge to the TotpSecret entity.
+func (uu *UserUpdate) ClearTotpSecret() *UserUpdate {
+	uu.mutation.ClearTotpSecret()
+	return uu
+}
+
+// ClearRecoveryCodes clears all "recovery_codes" edges to the RecoveryCode entity.
+func (uu *UserUpdate) ClearRecoveryCodes() *UserUpdate {
+	uu.mutation.ClearRecoveryCodes()
+	return uu
+}
+
+// RemoveRecoveryCodeIDs removes the "recovery_codes" edge to RecoveryCode entities by IDs.
+func (uu *UserUpdate) RemoveRecoveryCodeIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveRecoveryCodeIDs(ids...)
+	return uu
+}
+
+// RemoveRecoveryCodes removes "recovery_codes" edges to RecoveryCode entities.
+func (uu *UserUpdate) RemoveRecoveryCodes(r ...*RecoveryCode) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
 	}
-	if v, ok := uu.mutation.DisplayName(); ok {
-		if err := user.DisplayNameValidator(v); err != nil {
-			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "User.display_name": %w`, err)}
-		}
+	return uu.RemoveRecoveryCodeIDs(ids...)
+}
+
+// ClearAPIKeys clears all "api_keys" edges to the ApiKey entity.
+func (uu *UserUpdate) ClearAPIKeys() *UserUpdate {
+	uu.mutation.ClearAPIKeys()
+	return uu
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to ApiKey entities by IDs.
+func (uu *UserUpdate) RemoveAPIKeyIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAPIKeyIDs(ids...)
+	return uu
+}
+
+// RemoveAPIKeys removes "api_keys" edges to ApiKey entities.
+func (uu *UserUpdate) RemoveAPIKeys(a ...*ApiKey) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	if v, ok := uu.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
-		}
+	return uu.RemoveAPIKeyIDs(ids...)
+}
+
+// ClearEmailTokens clears all "email_tokens" edges to the EmailToken entity.
+func (uu *UserUpdate) ClearEmailTokens() *UserUpdate {
+	uu.mutation.ClearEmailTokens()
+	return uu
+}
+
+// RemoveEmailTokenIDs removes the "email_tokens" edge to EmailToken entities by IDs.
+func (uu *UserUpdate) RemoveEmailTokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveEmailTokenIDs(ids...)
+	return uu
+}
+
+// RemoveEmailTokens removes "email_tokens" edges to EmailToken entities.
+func (uu *UserUpdate) RemoveEmailTokens(e ...*EmailToken) *UserUpdate {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
 	}
-	return nil
+	return uu.RemoveEmailTokenIDs(ids...)
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := uu.check(); err != nil {
-		return n, err
+// ClearLoginThrottles clears all "login_throttles" edges to the LoginThrottle entity.
+func (uu *UserUpdate) ClearLoginThrottles() *UserUpdate {
+	uu.mutation.ClearLoginThrottles()
+	return uu
+}
+
+// RemoveLoginThrottleIDs removes the "login_throttles" edge to LoginThrottle entities by IDs.
+func (uu *UserUpdate) RemoveLoginThrottleIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveLoginThrottleIDs(ids...)
+	return uu
+}
+
+// RemoveLoginThrottles removes "login_throttles" edges to LoginThrottle entities.
+func (uu *UserUpdate) RemoveLoginThrottles(l ...*LoginThrottle) *UserUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
-	if ps := uu.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
+	return uu.RemoveLoginThrottleIDs(ids...)
+}
+
+// ClearDevices clears all "devices" edges to the Device entity.
+func (uu *UserUpdate) ClearDevices() *UserUpdate {
+	uu.mutation.ClearDevices()
+	return uu
+}
+
+// RemoveDeviceIDs removes the "devices" edge to Device entities by IDs.
+func (uu *UserUpdate) RemoveDeviceIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveDeviceIDs(ids...)
+	return uu
+}
+
+// RemoveDevices removes "devices" edges to Device entities.
+func (uu *UserUpdate) RemoveDevices(d ...*Device) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.RemoveDeviceIDs(ids...)
+}
+
+// ClearMlsCommits clears all "mls_commits" edges to the MlsCommit entity.
+func (uu *UserUpdate) ClearMlsCommits() *UserUpdate {
+	uu.mutation.ClearMlsCommits()
+	return uu
+}
+
+// RemoveMlsCommitIDs removes the "mls_commits" edge to MlsCommit entities by IDs.
+func (uu *UserUpdate) RemoveMlsCommitIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveMlsCommitIDs(ids...)
+	return uu
+}
+
+// RemoveMlsCommits removes "mls_commits" edges to MlsCommit entities.
+func (uu *UserUpdate) RemoveMlsCommits(m ...*MlsCommit) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMlsCommitIDs(ids...)
+}
+
+// ClearSentMlsWelcomes clears all "sent_mls_welcomes" edges to the MlsWelcome entity.
+func (uu *UserUpdate) ClearSentMlsWelcomes() *UserUpdate {
+	uu.mutation.ClearSentMlsWelcomes()
+	return uu
+}
+
+// RemoveSentMlsWelcomeIDs removes the "sent_mls_welcomes" edge to MlsWelcome entities by IDs.
+func (uu *UserUpdate) RemoveSentMlsWelcomeIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSentMlsWelcomeIDs(ids...)
+	return uu
+}
+
+// RemoveSentMlsWelcomes removes "sent_mls_welcomes" edges to MlsWelcome entities.
+func (uu *UserUpdate) RemoveSentMlsWelcomes(m ...*MlsWelcome) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveSentMlsWelcomeIDs(ids...)
+}
+
+// ClearSentSenderKeyDistributions clears all "sent_sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (uu *UserUpdate) ClearSentSenderKeyDistributions() *UserUpdate {
+	uu.mutation.ClearSentSenderKeyDistributions()
+	return uu
+}
+
+// RemoveSentSenderKeyDistributionIDs removes the "sent_sender_key_distributions" edge to SenderKeyDistribution entities by IDs.
+func (uu *UserUpdate) RemoveSentSenderKeyDistributionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSentSenderKeyDistributionIDs(ids...)
+	return uu
+}
+
+// RemoveSentSenderKeyDistributions removes "sent_sender_key_distributions" edges to SenderKeyDistribution entities.
+func (uu *UserUpdate) RemoveSentSenderKeyDistributions(s ...*SenderKeyDistribution) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveSentSenderKeyDistributionIDs(ids...)
+}
+
+// ClearServiceOwner clears the "service_owner" edge to the User entity.
+func (uu *UserUpdate) ClearServiceOwner() *UserUpdate {
+	uu.mutation.ClearServiceOwner()
+	return uu
+}
+
+// ClearServiceAccounts clears all "service_accounts" edges to the User entity.
+func (uu *UserUpdate) ClearServiceAccounts() *UserUpdate {
+	uu.mutation.ClearServiceAccounts()
+	return uu
+}
+
+// RemoveServiceAccountIDs removes the "service_accounts" edge to User entities by IDs.
+func (uu *UserUpdate) RemoveServiceAccountIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveServiceAccountIDs(ids...)
+	return uu
+}
+
+// RemoveServiceAccounts removes "service_accounts" edges to User entities.
+func (uu *UserUpdate) RemoveServiceAccounts(u ...*User) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.RemoveServiceAccountIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+	uu.defaults()
+	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uu *UserUpdate) SaveX(ctx context.Context) int {
+	affected, err := uu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uu *UserUpdate) Exec(ctx context.Context) error {
+	_, err := uu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uu *UserUpdate) ExecX(ctx context.Context) {
+	if err := uu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uu *UserUpdate) defaults() {
+	if _, ok := uu.mutation.UpdatedAt(); !ok {
+		v := user.UpdateDefaultUpdatedAt()
+		uu.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := uu.mutation.LastSeenAt(); !ok {
+		v := user.UpdateDefaultLastSeenAt()
+		uu.mutation.SetLastSeenAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.Username(); ok {
+		if err := user.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.DisplayName(); ok {
+		if err := user.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "User.display_name": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Discoverability(); ok {
+		if err := user.DiscoverabilityValidator(v); err != nil {
+			return &ValidationError{Name: "discoverability", err: fmt.Errorf(`ent: validator failed for field "User.discoverability": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	if ps := uu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
 				ps[i](selector)
 			}
 		}
@@ -534,12 +1143,42 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Email(); ok {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.VerifiedAt(); ok {
+		_spec.SetField(user.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if uu.mutation.VerifiedAtCleared() {
+		_spec.ClearField(user.FieldVerifiedAt, field.TypeTime)
+	}
 	if value, ok := uu.mutation.AvatarURL(); ok {
 		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
 	}
 	if uu.mutation.AvatarURLCleared() {
 		_spec.ClearField(user.FieldAvatarURL, field.TypeString)
 	}
+	if value, ok := uu.mutation.IsBot(); ok {
+		_spec.SetField(user.FieldIsBot, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := uu.mutation.SuspendedAt(); ok {
+		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if uu.mutation.SuspendedAtCleared() {
+		_spec.ClearField(user.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.Discoverability(); ok {
+		_spec.SetField(user.FieldDiscoverability, field.TypeEnum, value)
+	}
+	if value, ok := uu.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(user.FieldDeletionScheduledAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.IsTombstone(); ok {
+		_spec.SetField(user.FieldIsTombstone, field.TypeBool, value)
+	}
 	if value, ok := uu.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -954,591 +1593,2353 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.CredentialCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.CredentialTable,
+			Columns: []string{user.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetUsername sets the "username" field.
-func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
-	uuo.mutation.SetUsername(s)
-	return uuo
-}
-
-// SetNillableUsername sets the "username" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetUsername(*s)
+	if nodes := uu.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.CredentialTable,
+			Columns: []string{user.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetDisplayName sets the "display_name" field.
-func (uuo *UserUpdateOne) SetDisplayName(s string) *UserUpdateOne {
-	uuo.mutation.SetDisplayName(s)
-	return uuo
-}
-
-// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableDisplayName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetDisplayName(*s)
+	if uu.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+	if nodes := uu.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uu.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetAvatarURL sets the "avatar_url" field.
-func (uuo *UserUpdateOne) SetAvatarURL(s string) *UserUpdateOne {
-	uuo.mutation.SetAvatarURL(s)
-	return uuo
-}
-
-// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAvatarURL(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetAvatarURL(*s)
+	if nodes := uu.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// ClearAvatarURL clears the value of the "avatar_url" field.
-func (uuo *UserUpdateOne) ClearAvatarURL() *UserUpdateOne {
-	uuo.mutation.ClearAvatarURL()
-	return uuo
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetCreatedAt(t)
-	return uuo
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetCreatedAt(*t)
+	if uu.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetUpdatedAt(t)
-	return uuo
-}
-
-// SetLastSeenAt sets the "last_seen_at" field.
-func (uuo *UserUpdateOne) SetLastSeenAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetLastSeenAt(t)
-	return uuo
-}
-
-// AddMembershipIDs adds the "memberships" edge to the RoomMembership entity by IDs.
-func (uuo *UserUpdateOne) AddMembershipIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddMembershipIDs(ids...)
-	return uuo
-}
-
-// AddMemberships adds the "memberships" edges to the RoomMembership entity.
-func (uuo *UserUpdateOne) AddMemberships(r ...*RoomMembership) *UserUpdateOne {
-	ids := make([]int, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+	if nodes := uu.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !uu.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddMembershipIDs(ids...)
-}
-
-// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
-func (uuo *UserUpdateOne) AddMessageIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddMessageIDs(ids...)
-	return uuo
-}
-
-// AddMessages adds the "messages" edges to the Message entity.
-func (uuo *UserUpdateOne) AddMessages(m ...*Message) *UserUpdateOne {
-	ids := make([]int, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+	if nodes := uu.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddMessageIDs(ids...)
-}
-
-// AddUploadedMediumIDs adds the "uploaded_media" edge to the Media entity by IDs.
-func (uuo *UserUpdateOne) AddUploadedMediumIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddUploadedMediumIDs(ids...)
-	return uuo
-}
-
-// AddUploadedMedia adds the "uploaded_media" edges to the Media entity.
-func (uuo *UserUpdateOne) AddUploadedMedia(m ...*Media) *UserUpdateOne {
-	ids := make([]int, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+	if uu.mutation.TotpSecretCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.TotpSecretTable,
+			Columns: []string{user.TotpSecretColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(totpsecret.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddUploadedMediumIDs(ids...)
-}
-
-// AddOwnedRoomIDs adds the "owned_rooms" edge to the Room entity by IDs.
-func (uuo *UserUpdateOne) AddOwnedRoomIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddOwnedRoomIDs(ids...)
-	return uuo
-}
-
-// AddOwnedRooms adds the "owned_rooms" edges to the Room entity.
-func (uuo *UserUpdateOne) AddOwnedRooms(r ...*Room) *UserUpdateOne {
-	ids := make([]int, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+	if nodes := uu.mutation.TotpSecretIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.TotpSecretTable,
+			Columns: []string{user.TotpSecretColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(totpsecret.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddOwnedRoomIDs(ids...)
-}
-
-// AddContactIDs adds the "contacts" edge to the Contact entity by IDs.
-func (uuo *UserUpdateOne) AddContactIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddContactIDs(ids...)
-	return uuo
-}
-
-// AddContacts adds the "contacts" edges to the Contact entity.
-func (uuo *UserUpdateOne) AddContacts(c ...*Contact) *UserUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if uu.mutation.RecoveryCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddContactIDs(ids...)
-}
-
-// AddContactEntryIDs adds the "contact_entries" edge to the Contact entity by IDs.
-func (uuo *UserUpdateOne) AddContactEntryIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddContactEntryIDs(ids...)
-	return uuo
-}
-
-// AddContactEntries adds the "contact_entries" edges to the Contact entity.
-func (uuo *UserUpdateOne) AddContactEntries(c ...*Contact) *UserUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if nodes := uu.mutation.RemovedRecoveryCodesIDs(); len(nodes) > 0 && !uu.mutation.RecoveryCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddContactEntryIDs(ids...)
-}
-
-// AddFavouriteIDs adds the "favourites" edge to the Favourite entity by IDs.
-func (uuo *UserUpdateOne) AddFavouriteIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddFavouriteIDs(ids...)
-	return uuo
-}
-
-// AddFavourites adds the "favourites" edges to the Favourite entity.
-func (uuo *UserUpdateOne) AddFavourites(f ...*Favourite) *UserUpdateOne {
-	ids := make([]int, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if nodes := uu.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddFavouriteIDs(ids...)
-}
-
-// AddInitiatedCallIDs adds the "initiated_calls" edge to the CallLog entity by IDs.
-func (uuo *UserUpdateOne) AddInitiatedCallIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddInitiatedCallIDs(ids...)
-	return uuo
-}
-
-// AddInitiatedCalls adds the "initiated_calls" edges to the CallLog entity.
-func (uuo *UserUpdateOne) AddInitiatedCalls(c ...*CallLog) *UserUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if uu.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddInitiatedCallIDs(ids...)
-}
-
-// AddCallParticipationIDs adds the "call_participations" edge to the CallParticipant entity by IDs.
-func (uuo *UserUpdateOne) AddCallParticipationIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddCallParticipationIDs(ids...)
-	return uuo
-}
-
-// AddCallParticipations adds the "call_participations" edges to the CallParticipant entity.
-func (uuo *UserUpdateOne) AddCallParticipations(c ...*CallParticipant) *UserUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if nodes := uu.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !uu.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddCallParticipationIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
-}
-
+	if nodes := uu.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.EmailTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.EmailTokensTable,
+			Columns: []string{user.EmailTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedEmailTokensIDs(); len(nodes) > 0 && !uu.mutation.EmailTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.EmailTokensTable,
+			Columns: []string{user.EmailTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.EmailTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.EmailTokensTable,
+			Columns: []string{user.EmailTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.LoginThrottlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LoginThrottlesTable,
+			Columns: []string{user.LoginThrottlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedLoginThrottlesIDs(); len(nodes) > 0 && !uu.mutation.LoginThrottlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LoginThrottlesTable,
+			Columns: []string{user.LoginThrottlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.LoginThrottlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LoginThrottlesTable,
+			Columns: []string{user.LoginThrottlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.DevicesTable,
+			Columns: []string{user.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedDevicesIDs(); len(nodes) > 0 && !uu.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.DevicesTable,
+			Columns: []string{user.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.DevicesTable,
+			Columns: []string{user.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MlsCommitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MlsCommitsTable,
+			Columns: []string{user.MlsCommitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMlsCommitsIDs(); len(nodes) > 0 && !uu.mutation.MlsCommitsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MlsCommitsTable,
+			Columns: []string{user.MlsCommitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MlsCommitsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MlsCommitsTable,
+			Columns: []string{user.MlsCommitsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SentMlsWelcomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SentMlsWelcomesTable,
+			Columns: []string{user.SentMlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSentMlsWelcomesIDs(); len(nodes) > 0 && !uu.mutation.SentMlsWelcomesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SentMlsWelcomesTable,
+			Columns: []string{user.SentMlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SentMlsWelcomesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SentMlsWelcomesTable,
+			Columns: []string{user.SentMlsWelcomesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SentSenderKeyDistributionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SentSenderKeyDistributionsTable,
+			Columns: []string{user.SentSenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSentSenderKeyDistributionsIDs(); len(nodes) > 0 && !uu.mutation.SentSenderKeyDistributionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SentSenderKeyDistributionsTable,
+			Columns: []string{user.SentSenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SentSenderKeyDistributionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SentSenderKeyDistributionsTable,
+			Columns: []string{user.SentSenderKeyDistributionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ServiceOwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.ServiceOwnerTable,
+			Columns: []string{user.ServiceOwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ServiceOwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.ServiceOwnerTable,
+			Columns: []string{user.ServiceOwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ServiceAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ServiceAccountsTable,
+			Columns: []string{user.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedServiceAccountsIDs(); len(nodes) > 0 && !uu.mutation.ServiceAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ServiceAccountsTable,
+			Columns: []string{user.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ServiceAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ServiceAccountsTable,
+			Columns: []string{user.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetUsername sets the "username" field.
+func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
+	uuo.mutation.SetUsername(s)
+	return uuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableUsername(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetUsername(*s)
+	}
+	return uuo
+}
+
+// SetDisplayName sets the "display_name" field.
+func (uuo *UserUpdateOne) SetDisplayName(s string) *UserUpdateOne {
+	uuo.mutation.SetDisplayName(s)
+	return uuo
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDisplayName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetDisplayName(*s)
+	}
+	return uuo
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (uuo *UserUpdateOne) SetVerifiedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetVerifiedAt(t)
+	return uuo
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableVerifiedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetVerifiedAt(*t)
+	}
+	return uuo
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (uuo *UserUpdateOne) ClearVerifiedAt() *UserUpdateOne {
+	uuo.mutation.ClearVerifiedAt()
+	return uuo
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (uuo *UserUpdateOne) SetAvatarURL(s string) *UserUpdateOne {
+	uuo.mutation.SetAvatarURL(s)
+	return uuo
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAvatarURL(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAvatarURL(*s)
+	}
+	return uuo
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (uuo *UserUpdateOne) ClearAvatarURL() *UserUpdateOne {
+	uuo.mutation.ClearAvatarURL()
+	return uuo
+}
+
+// SetIsBot sets the "is_bot" field.
+func (uuo *UserUpdateOne) SetIsBot(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsBot(b)
+	return uuo
+}
+
+// SetNillableIsBot sets the "is_bot" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsBot(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsBot(*b)
+	}
+	return uuo
+}
+
+// SetRole sets the "role" field.
+func (uuo *UserUpdateOne) SetRole(u user.Role) *UserUpdateOne {
+	uuo.mutation.SetRole(u)
+	return uuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRole(u *user.Role) *UserUpdateOne {
+	if u != nil {
+		uuo.SetRole(*u)
+	}
+	return uuo
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (uuo *UserUpdateOne) SetSuspendedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetSuspendedAt(t)
+	return uuo
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSuspendedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetSuspendedAt(*t)
+	}
+	return uuo
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (uuo *UserUpdateOne) ClearSuspendedAt() *UserUpdateOne {
+	uuo.mutation.ClearSuspendedAt()
+	return uuo
+}
+
+// SetDiscoverability sets the "discoverability" field.
+func (uuo *UserUpdateOne) SetDiscoverability(u user.Discoverability) *UserUpdateOne {
+	uuo.mutation.SetDiscoverability(u)
+	return uuo
+}
+
+// SetNillableDiscoverability sets the "discoverability" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDiscoverability(u *user.Discoverability) *UserUpdateOne {
+	if u != nil {
+		uuo.SetDiscoverability(*u)
+	}
+	return uuo
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uuo *UserUpdateOne) SetDeletionScheduledAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletionScheduledAt(t)
+	return uuo
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletionScheduledAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletionScheduledAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (uuo *UserUpdateOne) ClearDeletionScheduledAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletionScheduledAt()
+	return uuo
+}
+
+// SetIsTombstone sets the "is_tombstone" field.
+func (uuo *UserUpdateOne) SetIsTombstone(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsTombstone(b)
+	return uuo
+}
+
+// SetNillableIsTombstone sets the "is_tombstone" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsTombstone(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsTombstone(*b)
+	}
+	return uuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uuo *UserUpdateOne) SetCreatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetCreatedAt(t)
+	return uuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCreatedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetCreatedAt(*t)
+	}
+	return uuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
+	return uuo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (uuo *UserUpdateOne) SetLastSeenAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLastSeenAt(t)
+	return uuo
+}
+
+// AddMembershipIDs adds the "memberships" edge to the RoomMembership entity by IDs.
+func (uuo *UserUpdateOne) AddMembershipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddMembershipIDs(ids...)
+	return uuo
+}
+
+// AddMemberships adds the "memberships" edges to the RoomMembership entity.
+func (uuo *UserUpdateOne) AddMemberships(r ...*RoomMembership) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddMembershipIDs(ids...)
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (uuo *UserUpdateOne) AddMessageIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddMessageIDs(ids...)
+	return uuo
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (uuo *UserUpdateOne) AddMessages(m ...*Message) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMessageIDs(ids...)
+}
+
+// AddUploadedMediumIDs adds the "uploaded_media" edge to the Media entity by IDs.
+func (uuo *UserUpdateOne) AddUploadedMediumIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddUploadedMediumIDs(ids...)
+	return uuo
+}
+
+// AddUploadedMedia adds the "uploaded_media" edges to the Media entity.
+func (uuo *UserUpdateOne) AddUploadedMedia(m ...*Media) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddUploadedMediumIDs(ids...)
+}
+
+// AddOwnedRoomIDs adds the "owned_rooms" edge to the Room entity by IDs.
+func (uuo *UserUpdateOne) AddOwnedRoomIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddOwnedRoomIDs(ids...)
+	return uuo
+}
+
+// AddOwnedRooms adds the "owned_rooms" edges to the Room entity.
+func (uuo *UserUpdateOne) AddOwnedRooms(r ...*Room) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddOwnedRoomIDs(ids...)
+}
+
+// AddContactIDs adds the "contacts" edge to the Contact entity by IDs.
+func (uuo *UserUpdateOne) AddContactIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddContactIDs(ids...)
+	return uuo
+}
+
+// AddContacts adds the "contacts" edges to the Contact entity.
+func (uuo *UserUpdateOne) AddContacts(c ...*Contact) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddContactIDs(ids...)
+}
+
+// AddContactEntryIDs adds the "contact_entries" edge to the Contact entity by IDs.
+func (uuo *UserUpdateOne) AddContactEntryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddContactEntryIDs(ids...)
+	return uuo
+}
+
+// AddContactEntries adds the "contact_entries" edges to the Contact entity.
+func (uuo *UserUpdateOne) AddContactEntries(c ...*Contact) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddContactEntryIDs(ids...)
+}
+
+// AddFavouriteIDs adds the "favourites" edge to the Favourite entity by IDs.
+func (uuo *UserUpdateOne) AddFavouriteIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddFavouriteIDs(ids...)
+	return uuo
+}
+
+// AddFavourites adds the "favourites" edges to the Favourite entity.
+func (uuo *UserUpdateOne) AddFavourites(f ...*Favourite) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFavouriteIDs(ids...)
+}
+
+// AddInitiatedCallIDs adds the "initiated_calls" edge to the CallLog entity by IDs.
+func (uuo *UserUpdateOne) AddInitiatedCallIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddInitiatedCallIDs(ids...)
+	return uuo
+}
+
+// AddInitiatedCalls adds the "initiated_calls" edges to the CallLog entity.
+func (uuo *UserUpdateOne) AddInitiatedCalls(c ...*CallLog) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddInitiatedCallIDs(ids...)
+}
+
+// AddCallParticipationIDs adds the "call_participations" edge to the CallParticipant entity by IDs.
+func (uuo *UserUpdateOne) AddCallParticipationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddCallParticipationIDs(ids...)
+	return uuo
+}
+
+// AddCallParticipations adds the "call_participations" edges to the CallParticipant entity.
+func (uuo *UserUpdateOne) AddCallParticipations(c ...*CallParticipant) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCallParticipationIDs(ids...)
+}
+
+// SetCredentialID sets the "credential" edge to the Credential entity by ID.
+func (uuo *UserUpdateOne) SetCredentialID(id int) *UserUpdateOne {
+	uuo.mutation.SetCredentialID(id)
+	return uuo
+}
+
+// SetNillableCredentialID sets the "credential" edge to the Credential entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableCredentialID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetCredentialID(*id)
+	}
+	return uuo
+}
+
+// SetCredential sets the "credential" edge to the Credential entity.
+func (uuo *UserUpdateOne) SetCredential(c *Credential) *UserUpdateOne {
+	return uuo.SetCredentialID(c.ID)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uuo *UserUpdateOne) AddSessionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSessionIDs(ids...)
+	return uuo
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uuo *UserUpdateOne) AddSessions(s ...*Session) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSessionIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uuo *UserUpdateOne) AddIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddIdentityIDs(ids...)
+	return uuo
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uuo *UserUpdateOne) AddIdentities(i ...*Identity) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddIdentityIDs(ids...)
+}
+
+// SetTotpSecretID sets the "totp_secret" edge to the TotpSecret entity by ID.
+func (uuo *UserUpdateOne) SetTotpSecretID(id int) *UserUpdateOne {
+	uuo.mutation.SetTotpSecretID(id)
+	return uuo
+}
+
+// SetNillableTotpSecretID sets the "totp_secret" edge to the TotpSecret entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpSecretID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetTotpSecretID(*id)
+	}
+	return uuo
+}
+
+// SetTotpSecret sets the "totp_secret" edge to the TotpSecret entity.
+func (uuo *UserUpdateOne) SetTotpSecret(t *TotpSecret) *UserUpdateOne {
+	return uuo.SetTotpSecretID(t.ID)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (uuo *UserUpdateOne) AddRecoveryCodeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddRecoveryCodeIDs(ids...)
+	return uuo
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (uuo *UserUpdateOne) AddRecoveryCodes(r ...*RecoveryCode) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRecoveryCodeIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the ApiKey entity by IDs.
+func (uuo *UserUpdateOne) AddAPIKeyIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAPIKeyIDs(ids...)
+	return uuo
+}
+
+// AddAPIKeys adds the "api_keys" edges to the ApiKey entity.
+func (uuo *UserUpdateOne) AddAPIKeys(a ...*ApiKey) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAPIKeyIDs(ids...)
+}
+
+// AddEmailTokenIDs adds the "email_tokens" edge to the EmailToken entity by IDs.
+func (uuo *UserUpdateOne) AddEmailTokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddEmailTokenIDs(ids...)
+	return uuo
+}
+
+// AddEmailTokens adds the "email_tokens" edges to the EmailToken entity.
+func (uuo *UserUpdateOne) AddEmailTokens(e ...*EmailToken) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.AddEmailTokenIDs(ids...)
+}
+
+// AddLoginThrottleIDs adds the "login_throttles" edge to the LoginThrottle entity by IDs.
+func (uuo *UserUpdateOne) AddLoginThrottleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddLoginThrottleIDs(ids...)
+	return uuo
+}
+
+// AddLoginThrottles adds the "login_throttles" edges to the LoginThrottle entity.
+func (uuo *UserUpdateOne) AddLoginThrottles(l ...*LoginThrottle) *UserUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.AddLoginThrottleIDs(ids...)
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (uuo *UserUpdateOne) AddDeviceIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddDeviceIDs(ids...)
+	return uuo
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (uuo *UserUpdateOne) AddDevices(d ...*Device) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDeviceIDs(ids...)
+}
+
+// AddMlsCommitIDs adds the "mls_commits" edge to the MlsCommit entity by IDs.
+func (uuo *UserUpdateOne) AddMlsCommitIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddMlsCommitIDs(ids...)
+	return uuo
+}
+
+// AddMlsCommits adds the "mls_commits" edges to the MlsCommit entity.
+func (uuo *UserUpdateOne) AddMlsCommits(m ...*MlsCommit) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMlsCommitIDs(ids...)
+}
+
+// AddSentMlsWelcomeIDs adds the "sent_mls_welcomes" edge to the MlsWelcome entity by IDs.
+func (uuo *UserUpdateOne) AddSentMlsWelcomeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSentMlsWelcomeIDs(ids...)
+	return uuo
+}
+
+// AddSentMlsWelcomes adds the "sent_mls_welcomes" edges to the MlsWelcome entity.
+func (uuo *UserUpdateOne) AddSentMlsWelcomes(m ...*MlsWelcome) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddSentMlsWelcomeIDs(ids...)
+}
+
+// AddSentSenderKeyDistributionIDs adds the "sent_sender_key_distributions" edge to the SenderKeyDistribution entity by IDs.
+func (uuo *UserUpdateOne) AddSentSenderKeyDistributionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSentSenderKeyDistributionIDs(ids...)
+	return uuo
+}
+
+// AddSentSenderKeyDistributions adds the "sent_sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (uuo *UserUpdateOne) AddSentSenderKeyDistributions(s ...*SenderKeyDistribution) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSentSenderKeyDistributionIDs(ids...)
+}
+
+// SetServiceOwnerID sets the "service_owner" edge to the User entity by ID.
+func (uuo *UserUpdateOne) SetServiceOwnerID(id int) *UserUpdateOne {
+	uuo.mutation.SetServiceOwnerID(id)
+	return uuo
+}
+
+// SetNillableServiceOwnerID sets the "service_owner" edge to the User entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableServiceOwnerID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetServiceOwnerID(*id)
+	}
+	return uuo
+}
+
+// SetServiceOwner sets the "service_owner" edge to the User entity.
+func (uuo *UserUpdateOne) SetServiceOwner(u *User) *UserUpdateOne {
+	return uuo.SetServiceOwnerID(u.ID)
+}
+
+// AddServiceAccountIDs adds the "service_accounts" edge to the User entity by IDs.
+func (uuo *UserUpdateOne) AddServiceAccountIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddServiceAccountIDs(ids...)
+	return uuo
+}
+
+// AddServiceAccounts adds the "service_accounts" edges to the User entity.
+func (uuo *UserUpdateOne) AddServiceAccounts(u ...*User) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddServiceAccountIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
 // ClearMemberships clears all "memberships" edges to the RoomMembership entity.
 func (uuo *UserUpdateOne) ClearMemberships() *UserUpdateOne {
 	uuo.mutation.ClearMemberships()
 	return uuo
 }
 
-// RemoveMembershipIDs removes the "memberships" edge to RoomMembership entities by IDs.
-func (uuo *UserUpdateOne) RemoveMembershipIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveMembershipIDs(ids...)
+// RemoveMembershipIDs removes the "memberships" edge to RoomMembership entities by IDs.
+func (uuo *UserUpdateOne) RemoveMembershipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveMembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveMemberships removes "memberships" edges to RoomMembership entities.
+func (uuo *UserUpdateOne) RemoveMemberships(r ...*RoomMembership) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveMembershipIDs(ids...)
+}
+
+// ClearMessages clears all "messages" edges to the Message entity.
+func (uuo *UserUpdateOne) ClearMessages() *UserUpdateOne {
+	uuo.mutation.ClearMessages()
+	return uuo
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (uuo *UserUpdateOne) RemoveMessageIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveMessageIDs(ids...)
+	return uuo
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (uuo *UserUpdateOne) RemoveMessages(m ...*Message) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMessageIDs(ids...)
+}
+
+// ClearUploadedMedia clears all "uploaded_media" edges to the Media entity.
+func (uuo *UserUpdateOne) ClearUploadedMedia() *UserUpdateOne {
+	uuo.mutation.ClearUploadedMedia()
+	return uuo
+}
+
+// RemoveUploadedMediumIDs removes the "uploaded_media" edge to Media entities by IDs.
+func (uuo *UserUpdateOne) RemoveUploadedMediumIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveUploadedMediumIDs(ids...)
+	return uuo
+}
+
+// RemoveUploadedMedia removes "uploaded_media" edges to Media entities.
+func (uuo *UserUpdateOne) RemoveUploadedMedia(m ...*Media) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveUploadedMediumIDs(ids...)
+}
+
+// ClearOwnedRooms clears all "owned_rooms" edges to the Room entity.
+func (uuo *UserUpdateOne) ClearOwnedRooms() *UserUpdateOne {
+	uuo.mutation.ClearOwnedRooms()
+	return uuo
+}
+
+// RemoveOwnedRoomIDs removes the "owned_rooms" edge to Room entities by IDs.
+func (uuo *UserUpdateOne) RemoveOwnedRoomIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveOwnedRoomIDs(ids...)
+	return uuo
+}
+
+// RemoveOwnedRooms removes "owned_rooms" edges to Room entities.
+func (uuo *UserUpdateOne) RemoveOwnedRooms(r ...*Room) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveOwnedRoomIDs(ids...)
+}
+
+// ClearContacts clears all "contacts" edges to the Contact entity.
+func (uuo *UserUpdateOne) ClearContacts() *UserUpdateOne {
+	uuo.mutation.ClearContacts()
+	return uuo
+}
+
+// RemoveContactIDs removes the "contacts" edge to Contact entities by IDs.
+func (uuo *UserUpdateOne) RemoveContactIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveContactIDs(ids...)
+	return uuo
+}
+
+// RemoveContacts removes "contacts" edges to Contact entities.
+func (uuo *UserUpdateOne) RemoveContacts(c ...*Contact) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveContactIDs(ids...)
+}
+
+// ClearContactEntries clears all "contact_entries" edges to the Contact entity.
+func (uuo *UserUpdateOne) ClearContactEntries() *UserUpdateOne {
+	uuo.mutation.ClearContactEntries()
+	return uuo
+}
+
+// RemoveContactEntryIDs removes the "contact_entries" edge to Contact entities by IDs.
+func (uuo *UserUpdateOne) RemoveContactEntryIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveContactEntryIDs(ids...)
+	return uuo
+}
+
+// RemoveContactEntries removes "contact_entries" edges to Contact entities.
+func (uuo *UserUpdateOne) RemoveContactEntries(c ...*Contact) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveContactEntryIDs(ids...)
+}
+
+// ClearFavourites clears all "favourites" edges to the Favourite entity.
+func (uuo *UserUpdateOne) ClearFavourites() *UserUpdateOne {
+	uuo.mutation.ClearFavourites()
+	return uuo
+}
+
+// RemoveFavouriteIDs removes the "favourites" edge to Favourite entities by IDs.
+func (uuo *UserUpdateOne) RemoveFavouriteIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveFavouriteIDs(ids...)
+	return uuo
+}
+
+// RemoveFavourites removes "favourites" edges to Favourite entities.
+func (uuo *UserUpdateOne) RemoveFavourites(f ...*Favourite) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFavouriteIDs(ids...)
+}
+
+// ClearInitiatedCalls clears all "initiated_calls" edges to the CallLog entity.
+func (uuo *UserUpdateOne) ClearInitiatedCalls() *UserUpdateOne {
+	uuo.mutation.ClearInitiatedCalls()
+	return uuo
+}
+
+// RemoveInitiatedCallIDs removes the "initiated_calls" edge to CallLog entities by IDs.
+func (uuo *UserUpdateOne) RemoveInitiatedCallIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveInitiatedCallIDs(ids...)
+	return uuo
+}
+
+// RemoveInitiatedCalls removes "initiated_calls" edges to CallLog entities.
+func (uuo *UserUpdateOne) RemoveInitiatedCalls(c ...*CallLog) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveInitiatedCallIDs(ids...)
+}
+
+// ClearCallParticipations clears all "call_participations" edges to the CallParticipant entity.
+func (uuo *UserUpdateOne) ClearCallParticipations() *UserUpdateOne {
+	uuo.mutation.ClearCallParticipations()
+	return uuo
+}
+
+// RemoveCallParticipationIDs removes the "call_participations" edge to CallParticipant entities by IDs.
+func (uuo *UserUpdateOne) RemoveCallParticipationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveCallParticipationIDs(ids...)
+	return uuo
+}
+
+// RemoveCallParticipations removes "call_participations" edges to CallParticipant entities.
+func (uuo *UserUpdateOne) RemoveCallParticipations(c ...*CallParticipant) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveCallParticipationIDs(ids...)
+}
+
+// ClearCredential clears the "credential" edge to the Credential entity.
+func (uuo *UserUpdateOne) ClearCredential() *UserUpdateOne {
+	uuo.mutation.ClearCredential()
+	return uuo
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (uuo *UserUpdateOne) ClearSessions() *UserUpdateOne {
+	uuo.mutation.ClearSessions()
+	return uuo
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (uuo *UserUpdateOne) RemoveSessionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSessionIDs(ids...)
+	return uuo
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (uuo *UserUpdateOne) RemoveSessions(s ...*Session) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSessionIDs(ids...)
+}
+
+// ClearIdentities clears all "identities" edges to the Identity entity.
+func (uuo *UserUpdateOne) ClearIdentities() *UserUpdateOne {
+	uuo.mutation.ClearIdentities()
+	return uuo
+}
+
+// RemoveIdentityIDs removes the "identities" edge to Identity entities by IDs.
+func (uuo *UserUpdateOne) RemoveIdentityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveIdentityIDs(ids...)
+	return uuo
+}
+
+// RemoveIdentities removes "identities" edges to Identity entities.
+func (uuo *UserUpdateOne) RemoveIdentities(i ...*Identity) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveIdentityIDs(ids...)
+}
+
+// ClearTotpSecret clears the "totp_secret" edge to the TotpSecret entity.
+func (uuo *UserUpdateOne) ClearTotpSecret() *UserUpdateOne {
+	uuo.mutation.ClearTotpSecret()
+	return uuo
+}
+
+// ClearRecoveryCodes clears all "recovery_codes" edges to the RecoveryCode entity.
+func (uuo *UserUpdateOne) ClearRecoveryCodes() *UserUpdateOne {
+	uuo.mutation.ClearRecoveryCodes()
+	return uuo
+}
+
+// RemoveRecoveryCodeIDs removes the "recovery_codes" edge to RecoveryCode entities by IDs.
+func (uuo *UserUpdateOne) RemoveRecoveryCodeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveRecoveryCodeIDs(ids...)
+	return uuo
+}
+
+// RemoveRecoveryCodes removes "recovery_codes" edges to RecoveryCode entities.
+func (uuo *UserUpdateOne) RemoveRecoveryCodes(r ...*RecoveryCode) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRecoveryCodeIDs(ids...)
+}
+
+// ClearAPIKeys clears all "api_keys" edges to the ApiKey entity.
+func (uuo *UserUpdateOne) ClearAPIKeys() *UserUpdateOne {
+	uuo.mutation.ClearAPIKeys()
+	return uuo
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to ApiKey entities by IDs.
+func (uuo *UserUpdateOne) RemoveAPIKeyIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAPIKeyIDs(ids...)
+	return uuo
+}
+
+// RemoveAPIKeys removes "api_keys" edges to ApiKey entities.
+func (uuo *UserUpdateOne) RemoveAPIKeys(a ...*ApiKey) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAPIKeyIDs(ids...)
+}
+
+// ClearEmailTokens clears all "email_tokens" edges to the EmailToken entity.
+func (uuo *UserUpdateOne) ClearEmailTokens() *UserUpdateOne {
+	uuo.mutation.ClearEmailTokens()
+	return uuo
+}
+
+// RemoveEmailTokenIDs removes the "email_tokens" edge to EmailToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveEmailTokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveEmailTokenIDs(ids...)
+	return uuo
+}
+
+// RemoveEmailTokens removes "email_tokens" edges to EmailToken entities.
+func (uuo *UserUpdateOne) RemoveEmailTokens(e ...*EmailToken) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.RemoveEmailTokenIDs(ids...)
+}
+
+// ClearLoginThrottles clears all "login_throttles" edges to the LoginThrottle entity.
+func (uuo *UserUpdateOne) ClearLoginThrottles() *UserUpdateOne {
+	uuo.mutation.ClearLoginThrottles()
+	return uuo
+}
+
+// RemoveLoginThrottleIDs removes the "login_throttles" edge to LoginThrottle entities by IDs.
+func (uuo *UserUpdateOne) RemoveLoginThrottleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveLoginThrottleIDs(ids...)
+	return uuo
+}
+
+// RemoveLoginThrottles removes "login_throttles" edges to LoginThrottle entities.
+func (uuo *UserUpdateOne) RemoveLoginThrottles(l ...*LoginThrottle) *UserUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.RemoveLoginThrottleIDs(ids...)
+}
+
+// ClearDevices clears all "devices" edges to the Device entity.
+func (uuo *UserUpdateOne) ClearDevices() *UserUpdateOne {
+	uuo.mutation.ClearDevices()
+	return uuo
+}
+
+// RemoveDeviceIDs removes the "devices" edge to Device entities by IDs.
+func (uuo *UserUpdateOne) RemoveDeviceIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveDeviceIDs(ids...)
+	return uuo
+}
+
+// RemoveDevices removes "devices" edges to Device entities.
+func (uuo *UserUpdateOne) RemoveDevices(d ...*Device) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveDeviceIDs(ids...)
+}
+
+// ClearMlsCommits clears all "mls_commits" edges to the MlsCommit entity.
+func (uuo *UserUpdateOne) ClearMlsCommits() *UserUpdateOne {
+	uuo.mutation.ClearMlsCommits()
+	return uuo
+}
+
+// RemoveMlsCommitIDs removes the "mls_commits" edge to MlsCommit entities by IDs.
+func (uuo *UserUpdateOne) RemoveMlsCommitIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveMlsCommitIDs(ids...)
+	return uuo
+}
+
+// RemoveMlsCommits removes "mls_commits" edges to MlsCommit entities.
+func (uuo *UserUpdateOne) RemoveMlsCommits(m ...*MlsCommit) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMlsCommitIDs(ids...)
+}
+
+// ClearSentMlsWelcomes clears all "sent_mls_welcomes" edges to the MlsWelcome entity.
+func (uuo *UserUpdateOne) ClearSentMlsWelcomes() *UserUpdateOne {
+	uuo.mutation.ClearSentMlsWelcomes()
+	return uuo
+}
+
+// RemoveSentMlsWelcomeIDs removes the "sent_mls_welcomes" edge to MlsWelcome entities by IDs.
+func (uuo *UserUpdateOne) RemoveSentMlsWelcomeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSentMlsWelcomeIDs(ids...)
+	return uuo
+}
+
+// RemoveSentMlsWelcomes removes "sent_mls_welcomes" edges to MlsWelcome entities.
+func (uuo *UserUpdateOne) RemoveSentMlsWelcomes(m ...*MlsWelcome) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveSentMlsWelcomeIDs(ids...)
+}
+
+// ClearSentSenderKeyDistributions clears all "sent_sender_key_distributions" edges to the SenderKeyDistribution entity.
+func (uuo *UserUpdateOne) ClearSentSenderKeyDistributions() *UserUpdateOne {
+	uuo.mutation.ClearSentSenderKeyDistributions()
+	return uuo
+}
+
+// RemoveSentSenderKeyDistributionIDs removes the "sent_sender_key_distributions" edge to SenderKeyDistribution entities by IDs.
+func (uuo *UserUpdateOne) RemoveSentSenderKeyDistributionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSentSenderKeyDistributionIDs(ids...)
+	return uuo
+}
+
+// RemoveSentSenderKeyDistributions removes "sent_sender_key_distributions" edges to SenderKeyDistribution entities.
+func (uuo *UserUpdateOne) RemoveSentSenderKeyDistributions(s ...*SenderKeyDistribution) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSentSenderKeyDistributionIDs(ids...)
+}
+
+// ClearServiceOwner clears the "service_owner" edge to the User entity.
+func (uuo *UserUpdateOne) ClearServiceOwner() *UserUpdateOne {
+	uuo.mutation.ClearServiceOwner()
+	return uuo
+}
+
+// ClearServiceAccounts clears all "service_accounts" edges to the User entity.
+func (uuo *UserUpdateOne) ClearServiceAccounts() *UserUpdateOne {
+	uuo.mutation.ClearServiceAccounts()
+	return uuo
+}
+
+// RemoveServiceAccountIDs removes the "service_accounts" edge to User entities by IDs.
+func (uuo *UserUpdateOne) RemoveServiceAccountIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveServiceAccountIDs(ids...)
 	return uuo
 }
 
-// RemoveMemberships removes "memberships" edges to RoomMembership entities.
-func (uuo *UserUpdateOne) RemoveMemberships(r ...*RoomMembership) *UserUpdateOne {
-	ids := make([]int, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+// RemoveServiceAccounts removes "service_accounts" edges to User entities.
+func (uuo *UserUpdateOne) RemoveServiceAccounts(u ...*User) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveServiceAccountIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
+	return uuo
+}
+
+// Save executes the query and returns the updated User entity.
+func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	uuo.defaults()
+	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uuo *UserUpdateOne) defaults() {
+	if _, ok := uuo.mutation.UpdatedAt(); !ok {
+		v := user.UpdateDefaultUpdatedAt()
+		uuo.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := uuo.mutation.LastSeenAt(); !ok {
+		v := user.UpdateDefaultLastSeenAt()
+		uuo.mutation.SetLastSeenAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Username(); ok {
+		if err := user.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.DisplayName(); ok {
+		if err := user.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "User.display_name": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Discoverability(); ok {
+		if err := user.DiscoverabilityValidator(v); err != nil {
+			return &ValidationError{Name: "discoverability", err: fmt.Errorf(`ent: validator failed for field "User.discoverability": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.Username(); ok {
+		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.DisplayName(); ok {
+		_spec.SetField(user.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.VerifiedAt(); ok {
+		_spec.SetField(user.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.VerifiedAtCleared() {
+		_spec.ClearField(user.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.AvatarURL(); ok {
+		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
+	}
+	if uuo.mutation.AvatarURLCleared() {
+		_spec.ClearField(user.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := uuo.mutation.IsBot(); ok {
+		_spec.SetField(user.FieldIsBot, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.SuspendedAt(); ok {
+		_spec.SetField(user.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.SuspendedAtCleared() {
+		_spec.ClearField(user.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.Discoverability(); ok {
+		_spec.SetField(user.FieldDiscoverability, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(user.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(user.FieldDeletionScheduledAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.IsTombstone(); ok {
+		_spec.SetField(user.FieldIsTombstone, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.CreatedAt(); ok {
+		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if uuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roommembership.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roommembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roommembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !uuo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.MessagesTable,
+			Columns: []string{user.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.UploadedMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedUploadedMediaIDs(); len(nodes) > 0 && !uuo.mutation.UploadedMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveMembershipIDs(ids...)
-}
-
-// ClearMessages clears all "messages" edges to the Message entity.
-func (uuo *UserUpdateOne) ClearMessages() *UserUpdateOne {
-	uuo.mutation.ClearMessages()
-	return uuo
-}
-
-// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
-func (uuo *UserUpdateOne) RemoveMessageIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveMessageIDs(ids...)
-	return uuo
-}
-
-// RemoveMessages removes "messages" edges to Message entities.
-func (uuo *UserUpdateOne) RemoveMessages(m ...*Message) *UserUpdateOne {
-	ids := make([]int, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+	if nodes := uuo.mutation.UploadedMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveMessageIDs(ids...)
-}
-
-// ClearUploadedMedia clears all "uploaded_media" edges to the Media entity.
-func (uuo *UserUpdateOne) ClearUploadedMedia() *UserUpdateOne {
-	uuo.mutation.ClearUploadedMedia()
-	return uuo
-}
-
-// RemoveUploadedMediumIDs removes the "uploaded_media" edge to Media entities by IDs.
-func (uuo *UserUpdateOne) RemoveUploadedMediumIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveUploadedMediumIDs(ids...)
-	return uuo
-}
-
-// RemoveUploadedMedia removes "uploaded_media" edges to Media entities.
-func (uuo *UserUpdateOne) RemoveUploadedMedia(m ...*Media) *UserUpdateOne {
-	ids := make([]int, len(m))
-	for i := range m {
-		ids[i] = m[i].ID
+	if uuo.mutation.OwnedRoomsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.OwnedRoomsTable,
+			Columns: []string{user.OwnedRoomsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveUploadedMediumIDs(ids...)
-}
-
-// ClearOwnedRooms clears all "owned_rooms" edges to the Room entity.
-func (uuo *UserUpdateOne) ClearOwnedRooms() *UserUpdateOne {
-	uuo.mutation.ClearOwnedRooms()
-	return uuo
-}
-
-// RemoveOwnedRoomIDs removes the "owned_rooms" edge to Room entities by IDs.
-func (uuo *UserUpdateOne) RemoveOwnedRoomIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveOwnedRoomIDs(ids...)
-	return uuo
-}
-
-// RemoveOwnedRooms removes "owned_rooms" edges to Room entities.
-func (uuo *UserUpdateOne) RemoveOwnedRooms(r ...*Room) *UserUpdateOne {
-	ids := make([]int, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+	if nodes := uuo.mutation.RemovedOwnedRoomsIDs(); len(nodes) > 0 && !uuo.mutation.OwnedRoomsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.OwnedRoomsTable,
+			Columns: []string{user.OwnedRoomsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveOwnedRoomIDs(ids...)
-}
-
-// ClearContacts clears all "contacts" edges to the Contact entity.
-func (uuo *UserUpdateOne) ClearContacts() *UserUpdateOne {
-	uuo.mutation.ClearContacts()
-	return uuo
-}
-
-// RemoveContactIDs removes the "contacts" edge to Contact entities by IDs.
-func (uuo *UserUpdateOne) RemoveContactIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveContactIDs(ids...)
-	return uuo
-}
-
-// RemoveContacts removes "contacts" edges to Contact entities.
-func (uuo *UserUpdateOne) RemoveContacts(c ...*Contact) *UserUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if nodes := uuo.mutation.OwnedRoomsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.OwnedRoomsTable,
+			Columns: []string{user.OwnedRoomsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveContactIDs(ids...)
-}
-
-// ClearContactEntries clears all "contact_entries" edges to the Contact entity.
-func (uuo *UserUpdateOne) ClearContactEntries() *UserUpdateOne {
-	uuo.mutation.ClearContactEntries()
-	return uuo
-}
-
-// RemoveContactEntryIDs removes the "contact_entries" edge to Contact entities by IDs.
-func (uuo *UserUpdateOne) RemoveContactEntryIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveContactEntryIDs(ids...)
-	return uuo
-}
-
-// RemoveContactEntries removes "contact_entries" edges to Contact entities.
-func (uuo *UserUpdateOne) RemoveContactEntries(c ...*Contact) *UserUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if uuo.mutation.ContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ContactsTable,
+			Columns: []string{user.ContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveContactEntryIDs(ids...)
-}
-
-// ClearFavourites clears all "favourites" edges to the Favourite entity.
-func (uuo *UserUpdateOne) ClearFavourites() *UserUpdateOne {
-	uuo.mutation.ClearFavourites()
-	return uuo
-}
-
-// RemoveFavouriteIDs removes the "favourites" edge to Favourite entities by IDs.
-func (uuo *UserUpdateOne) RemoveFavouriteIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveFavouriteIDs(ids...)
-	return uuo
-}
-
-// RemoveFavourites removes "favourites" edges to Favourite entities.
-func (uuo *UserUpdateOne) RemoveFavourites(f ...*Favourite) *UserUpdateOne {
-	ids := make([]int, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if nodes := uuo.mutation.RemovedContactsIDs(); len(nodes) > 0 && !uuo.mutation.ContactsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ContactsTable,
+			Columns: []string{user.ContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveFavouriteIDs(ids...)
-}
-
-// ClearInitiatedCalls clears all "initiated_calls" edges to the CallLog entity.
-func (uuo *UserUpdateOne) ClearInitiatedCalls() *UserUpdateOne {
-	uuo.mutation.ClearInitiatedCalls()
-	return uuo
-}
-
-// RemoveInitiatedCallIDs removes the "initiated_calls" edge to CallLog entities by IDs.
-func (uuo *UserUpdateOne) RemoveInitiatedCallIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveInitiatedCallIDs(ids...)
-	return uuo
-}
-
-// RemoveInitiatedCalls removes "initiated_calls" edges to CallLog entities.
-func (uuo *UserUpdateOne) RemoveInitiatedCalls(c ...*CallLog) *UserUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if nodes := uuo.mutation.ContactsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ContactsTable,
+			Columns: []string{user.ContactsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ContactEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ContactEntriesTable,
+			Columns: []string{user.ContactEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveInitiatedCallIDs(ids...)
-}
-
-// ClearCallParticipations clears all "call_participations" edges to the CallParticipant entity.
-func (uuo *UserUpdateOne) ClearCallParticipations() *UserUpdateOne {
-	uuo.mutation.ClearCallParticipations()
-	return uuo
-}
-
-// RemoveCallParticipationIDs removes the "call_participations" edge to CallParticipant entities by IDs.
-func (uuo *UserUpdateOne) RemoveCallParticipationIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveCallParticipationIDs(ids...)
-	return uuo
-}
-
-// RemoveCallParticipations removes "call_participations" edges to CallParticipant entities.
-func (uuo *UserUpdateOne) RemoveCallParticipations(c ...*CallParticipant) *UserUpdateOne {
-	ids := make([]int, len(c))
-	for i := range c {
-		ids[i] = c[i].ID
+	if nodes := uuo.mutation.RemovedContactEntriesIDs(); len(nodes) > 0 && !uuo.mutation.ContactEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ContactEntriesTable,
+			Columns: []string{user.ContactEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveCallParticipationIDs(ids...)
-}
-
-// Where appends a list predicates to the UserUpdate builder.
-func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	uuo.mutation.Where(ps...)
-	return uuo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	uuo.fields = append([]string{field}, fields...)
-	return uuo
-}
-
-// Save executes the query and returns the updated User entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	uuo.defaults()
-	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := uuo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := uuo.mutation.ContactEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.ContactEntriesTable,
+			Columns: []string{user.ContactEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
-		panic(err)
+	if uuo.mutation.FavouritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FavouritesTable,
+			Columns: []string{user.FavouritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favourite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (uuo *UserUpdateOne) defaults() {
-	if _, ok := uuo.mutation.UpdatedAt(); !ok {
-		v := user.UpdateDefaultUpdatedAt()
-		uuo.mutation.SetUpdatedAt(v)
+	if nodes := uuo.mutation.RemovedFavouritesIDs(); len(nodes) > 0 && !uuo.mutation.FavouritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FavouritesTable,
+			Columns: []string{user.FavouritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favourite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _, ok := uuo.mutation.LastSeenAt(); !ok {
-		v := user.UpdateDefaultLastSeenAt()
-		uuo.mutation.SetLastSeenAt(v)
+	if nodes := uuo.mutation.FavouritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FavouritesTable,
+			Columns: []string{user.FavouritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(favourite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (uuo *UserUpdateOne) check() error {
-	if v, ok := uuo.mutation.Username(); ok {
-		if err := user.UsernameValidator(v); err != nil {
-			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+	if uuo.mutation.InitiatedCallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.InitiatedCallsTable,
+			Columns: []string{user.InitiatedCallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.DisplayName(); ok {
-		if err := user.DisplayNameValidator(v); err != nil {
-			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "User.display_name": %w`, err)}
+	if nodes := uuo.mutation.RemovedInitiatedCallsIDs(); len(nodes) > 0 && !uuo.mutation.InitiatedCallsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.InitiatedCallsTable,
+			Columns: []string{user.InitiatedCallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+	if nodes := uuo.mutation.InitiatedCallsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.InitiatedCallsTable,
+			Columns: []string{user.InitiatedCallsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return nil
-}
-
-func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := uuo.check(); err != nil {
-		return _node, err
+	if uuo.mutation.CallParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.CallParticipationsTable,
+			Columns: []string{user.CallParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
-	id, ok := uuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	if nodes := uuo.mutation.RemovedCallParticipationsIDs(); len(nodes) > 0 && !uuo.mutation.CallParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.CallParticipationsTable,
+			Columns: []string{user.CallParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.CallParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.CallParticipationsTable,
+			Columns: []string{user.CallParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := uuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if uuo.mutation.CredentialCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.CredentialTable,
+			Columns: []string{user.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := uuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := uuo.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.CredentialTable,
+			Columns: []string{user.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(credential.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Username(); ok {
-		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	if uuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.DisplayName(); ok {
-		_spec.SetField(user.FieldDisplayName, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !uuo.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	if nodes := uuo.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.AvatarURL(); ok {
-		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
+	if uuo.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.AvatarURLCleared() {
-		_spec.ClearField(user.FieldAvatarURL, field.TypeString)
+	if nodes := uuo.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !uuo.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.CreatedAt(); ok {
-		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
+	if nodes := uuo.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	if uuo.mutation.TotpSecretCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.TotpSecretTable,
+			Columns: []string{user.TotpSecretColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(totpsecret.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.LastSeenAt(); ok {
-		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+	if nodes := uuo.mutation.TotpSecretIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.TotpSecretTable,
+			Columns: []string{user.TotpSecretColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(totpsecret.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.MembershipsCleared() {
+	if uuo.mutation.RecoveryCodesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.MembershipsTable,
-			Columns: []string{user.MembershipsColumn},
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(roommembership.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.MembershipsCleared() {
+	if nodes := uuo.mutation.RemovedRecoveryCodesIDs(); len(nodes) > 0 && !uuo.mutation.RecoveryCodesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.MembershipsTable,
-			Columns: []string{user.MembershipsColumn},
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(roommembership.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1546,15 +3947,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.MembershipsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.MembershipsTable,
-			Columns: []string{user.MembershipsColumn},
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(roommembership.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1562,28 +3963,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.MessagesCleared() {
+	if uuo.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.MessagesTable,
-			Columns: []string{user.MessagesColumn},
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !uuo.mutation.MessagesCleared() {
+	if nodes := uuo.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !uuo.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.MessagesTable,
-			Columns: []string{user.MessagesColumn},
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1591,15 +3992,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.MessagesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.APIKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.MessagesTable,
-			Columns: []string{user.MessagesColumn},
+			Table:   user.APIKeysTable,
+			Columns: []string{user.APIKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1607,28 +4008,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.UploadedMediaCleared() {
+	if uuo.mutation.EmailTokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.UploadedMediaTable,
-			Columns: []string{user.UploadedMediaColumn},
+			Table:   user.EmailTokensTable,
+			Columns: []string{user.EmailTokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedUploadedMediaIDs(); len(nodes) > 0 && !uuo.mutation.UploadedMediaCleared() {
+	if nodes := uuo.mutation.RemovedEmailTokensIDs(); len(nodes) > 0 && !uuo.mutation.EmailTokensCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.UploadedMediaTable,
-			Columns: []string{user.UploadedMediaColumn},
+			Table:   user.EmailTokensTable,
+			Columns: []string{user.EmailTokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1636,15 +4037,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.UploadedMediaIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.EmailTokensIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.UploadedMediaTable,
-			Columns: []string{user.UploadedMediaColumn},
+			Table:   user.EmailTokensTable,
+			Columns: []string{user.EmailTokensColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(emailtoken.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1652,28 +4053,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.OwnedRoomsCleared() {
+	if uuo.mutation.LoginThrottlesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.OwnedRoomsTable,
-			Columns: []string{user.OwnedRoomsColumn},
+			Table:   user.LoginThrottlesTable,
+			Columns: []string{user.LoginThrottlesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedOwnedRoomsIDs(); len(nodes) > 0 && !uuo.mutation.OwnedRoomsCleared() {
+	if nodes := uuo.mutation.RemovedLoginThrottlesIDs(); len(nodes) > 0 && !uuo.mutation.LoginThrottlesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.OwnedRoomsTable,
-			Columns: []string{user.OwnedRoomsColumn},
+			Table:   user.LoginThrottlesTable,
+			Columns: []string{user.LoginThrottlesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1681,15 +4082,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.OwnedRoomsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.LoginThrottlesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.OwnedRoomsTable,
-			Columns: []string{user.OwnedRoomsColumn},
+			Table:   user.LoginThrottlesTable,
+			Columns: []string{user.LoginThrottlesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(room.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1697,28 +4098,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.ContactsCleared() {
+	if uuo.mutation.DevicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.ContactsTable,
-			Columns: []string{user.ContactsColumn},
+			Table:   user.DevicesTable,
+			Columns: []string{user.DevicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedContactsIDs(); len(nodes) > 0 && !uuo.mutation.ContactsCleared() {
+	if nodes := uuo.mutation.RemovedDevicesIDs(); len(nodes) > 0 && !uuo.mutation.DevicesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.ContactsTable,
-			Columns: []string{user.ContactsColumn},
+			Table:   user.DevicesTable,
+			Columns: []string{user.DevicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1726,15 +4127,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.ContactsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.DevicesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.ContactsTable,
-			Columns: []string{user.ContactsColumn},
+			Table:   user.DevicesTable,
+			Columns: []string{user.DevicesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1742,28 +4143,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.ContactEntriesCleared() {
+	if uuo.mutation.MlsCommitsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.ContactEntriesTable,
-			Columns: []string{user.ContactEntriesColumn},
+			Table:   user.MlsCommitsTable,
+			Columns: []string{user.MlsCommitsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedContactEntriesIDs(); len(nodes) > 0 && !uuo.mutation.ContactEntriesCleared() {
+	if nodes := uuo.mutation.RemovedMlsCommitsIDs(); len(nodes) > 0 && !uuo.mutation.MlsCommitsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.ContactEntriesTable,
-			Columns: []string{user.ContactEntriesColumn},
+			Table:   user.MlsCommitsTable,
+			Columns: []string{user.MlsCommitsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1771,15 +4172,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.ContactEntriesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.MlsCommitsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.ContactEntriesTable,
-			Columns: []string{user.ContactEntriesColumn},
+			Table:   user.MlsCommitsTable,
+			Columns: []string{user.MlsCommitsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(contact.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mlscommit.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1787,28 +4188,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.FavouritesCleared() {
+	if uuo.mutation.SentMlsWelcomesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.FavouritesTable,
-			Columns: []string{user.FavouritesColumn},
+			Table:   user.SentMlsWelcomesTable,
+			Columns: []string{user.SentMlsWelcomesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(favourite.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedFavouritesIDs(); len(nodes) > 0 && !uuo.mutation.FavouritesCleared() {
+	if nodes := uuo.mutation.RemovedSentMlsWelcomesIDs(); len(nodes) > 0 && !uuo.mutation.SentMlsWelcomesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.FavouritesTable,
-			Columns: []string{user.FavouritesColumn},
+			Table:   user.SentMlsWelcomesTable,
+			Columns: []string{user.SentMlsWelcomesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(favourite.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1816,15 +4217,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.FavouritesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.SentMlsWelcomesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.FavouritesTable,
-			Columns: []string{user.FavouritesColumn},
+			Table:   user.SentMlsWelcomesTable,
+			Columns: []string{user.SentMlsWelcomesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(favourite.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(mlswelcome.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1832,28 +4233,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.InitiatedCallsCleared() {
+	if uuo.mutation.SentSenderKeyDistributionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InitiatedCallsTable,
-			Columns: []string{user.InitiatedCallsColumn},
+			Table:   user.SentSenderKeyDistributionsTable,
+			Columns: []string{user.SentSenderKeyDistributionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedInitiatedCallsIDs(); len(nodes) > 0 && !uuo.mutation.InitiatedCallsCleared() {
+	if nodes := uuo.mutation.RemovedSentSenderKeyDistributionsIDs(); len(nodes) > 0 && !uuo.mutation.SentSenderKeyDistributionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InitiatedCallsTable,
-			Columns: []string{user.InitiatedCallsColumn},
+			Table:   user.SentSenderKeyDistributionsTable,
+			Columns: []string{user.SentSenderKeyDistributionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1861,15 +4262,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.InitiatedCallsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.SentSenderKeyDistributionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   user.InitiatedCallsTable,
-			Columns: []string{user.InitiatedCallsColumn},
+			Table:   user.SentSenderKeyDistributionsTable,
+			Columns: []string{user.SentSenderKeyDistributionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(calllog.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(senderkeydistribution.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1877,28 +4278,57 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.CallParticipationsCleared() {
+	if uuo.mutation.ServiceOwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   user.CallParticipationsTable,
-			Columns: []string{user.CallParticipationsColumn},
+			Table:   user.ServiceOwnerTable,
+			Columns: []string{user.ServiceOwnerColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedCallParticipationsIDs(); len(nodes) > 0 && !uuo.mutation.CallParticipationsCleared() {
+	if nodes := uuo.mutation.ServiceOwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   user.CallParticipationsTable,
-			Columns: []string{user.CallParticipationsColumn},
+			Table:   user.ServiceOwnerTable,
+			Columns: []string{user.ServiceOwnerColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ServiceAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ServiceAccountsTable,
+			Columns: []string{user.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedServiceAccountsIDs(); len(nodes) > 0 && !uuo.mutation.ServiceAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ServiceAccountsTable,
+			Columns: []string{user.ServiceAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1906,15 +4336,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.CallParticipationsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.ServiceAccountsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.CallParticipationsTable,
-			Columns: []string{user.CallParticipationsColumn},
+			Inverse: false,
+			Table:   user.ServiceAccountsTable,
+			Columns: []string{user.ServiceAccountsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(callparticipant.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
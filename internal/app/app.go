@@ -2,10 +2,13 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -16,14 +19,63 @@ import (
 
 // Config captures runtime configuration for the enclave service.
 type Config struct {
-	DatabasePath string
-	ListenAddr   string
+	DatabasePath    string
+	ListenAddr      string
+	KeySetPath      string
+	TokenSecret     string
+	TokenIssuer     string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+
+	OIDCIssuer       string
+	OIDCClientID     string
+	OIDCClientSecret string
+	OIDCRedirectURL  string
+	OIDCScopes       []string
+
+	AdminUsernames []string
+
+	AccountDeletionGrace time.Duration
+
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	MailFrom     string
+	AppURL       string
+
+	KeyLogKeyPath string
+
+	MessageReapInterval time.Duration
+
+	TrustedProxies []string
 }
 
-func provideConfig() Config {
+func provideConfig() (Config, error) {
 	cfg := Config{
 		DatabasePath: os.Getenv("ENCLAVE_DATABASE"),
 		ListenAddr:   os.Getenv("ENCLAVE_ADDR"),
+		KeySetPath:   os.Getenv("ENCLAVE_JWT_KEYSET"),
+		TokenSecret:  os.Getenv("ENCLAVE_JWT_SECRET"),
+		TokenIssuer:  os.Getenv("ENCLAVE_JWT_ISSUER"),
+
+		OIDCIssuer:       os.Getenv("ENCLAVE_OIDC_ISSUER"),
+		OIDCClientID:     os.Getenv("ENCLAVE_OIDC_CLIENT_ID"),
+		OIDCClientSecret: os.Getenv("ENCLAVE_OIDC_CLIENT_SECRET"),
+		OIDCRedirectURL:  os.Getenv("ENCLAVE_OIDC_REDIRECT_URL"),
+		OIDCScopes:       strings.Fields(os.Getenv("ENCLAVE_OIDC_SCOPES")),
+
+		AdminUsernames: strings.Fields(strings.ReplaceAll(os.Getenv("ENCLAVE_ADMIN_USERNAMES"), ",", " ")),
+
+		SMTPHost:     os.Getenv("ENCLAVE_SMTP_HOST"),
+		SMTPUsername: os.Getenv("ENCLAVE_SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("ENCLAVE_SMTP_PASSWORD"),
+		MailFrom:     os.Getenv("ENCLAVE_MAIL_FROM"),
+		AppURL:       os.Getenv("ENCLAVE_APP_URL"),
+
+		KeyLogKeyPath: os.Getenv("ENCLAVE_KEY_LOG_KEY"),
+
+		TrustedProxies: strings.Fields(strings.ReplaceAll(os.Getenv("ENCLAVE_TRUSTED_PROXIES"), ",", " ")),
 	}
 	if cfg.DatabasePath == "" {
 		cfg.DatabasePath = "enclave.db"
@@ -31,7 +83,42 @@ func provideConfig() Config {
 	if cfg.ListenAddr == "" {
 		cfg.ListenAddr = ":8080"
 	}
-	return cfg
+	if raw := os.Getenv("ENCLAVE_ACCESS_TOKEN_TTL"); raw != "" {
+		ttl, err := time.ParseDuration(raw)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid ENCLAVE_ACCESS_TOKEN_TTL: %w", err)
+		}
+		cfg.AccessTokenTTL = ttl
+	}
+	if raw := os.Getenv("ENCLAVE_REFRESH_TOKEN_TTL"); raw != "" {
+		ttl, err := time.ParseDuration(raw)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid ENCLAVE_REFRESH_TOKEN_TTL: %w", err)
+		}
+		cfg.RefreshTokenTTL = ttl
+	}
+	if raw := os.Getenv("ENCLAVE_SMTP_PORT"); raw != "" {
+		port, err := strconv.Atoi(raw)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid ENCLAVE_SMTP_PORT: %w", err)
+		}
+		cfg.SMTPPort = port
+	}
+	if raw := os.Getenv("ENCLAVE_ACCOUNT_DELETION_GRACE"); raw != "" {
+		grace, err := time.ParseDuration(raw)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid ENCLAVE_ACCOUNT_DELETION_GRACE: %w", err)
+		}
+		cfg.AccountDeletionGrace = grace
+	}
+	if raw := os.Getenv("ENCLAVE_MESSAGE_REAP_INTERVAL"); raw != "" {
+		interval, err := time.ParseDuration(raw)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid ENCLAVE_MESSAGE_REAP_INTERVAL: %w", err)
+		}
+		cfg.MessageReapInterval = interval
+	}
+	return cfg, nil
 }
 
 type serverParams struct {
@@ -41,7 +128,35 @@ type serverParams struct {
 }
 
 func provideServer(p serverParams) (*server.Server, error) {
-	return server.New(context.Background(), server.Config{DatabasePath: p.Config.DatabasePath})
+	return server.New(context.Background(), server.Config{
+		DatabasePath:    p.Config.DatabasePath,
+		KeySetPath:      p.Config.KeySetPath,
+		TokenSecret:     p.Config.TokenSecret,
+		TokenIssuer:     p.Config.TokenIssuer,
+		AccessTokenTTL:  p.Config.AccessTokenTTL,
+		RefreshTokenTTL: p.Config.RefreshTokenTTL,
+
+		OIDCIssuer:       p.Config.OIDCIssuer,
+		OIDCClientID:     p.Config.OIDCClientID,
+		OIDCClientSecret: p.Config.OIDCClientSecret,
+		OIDCRedirectURL:  p.Config.OIDCRedirectURL,
+		OIDCScopes:       p.Config.OIDCScopes,
+
+		AdminUsernames: p.Config.AdminUsernames,
+
+		AccountDeletionGrace: p.Config.AccountDeletionGrace,
+
+		SMTPHost:     p.Config.SMTPHost,
+		SMTPPort:     p.Config.SMTPPort,
+		SMTPUsername: p.Config.SMTPUsername,
+		SMTPPassword: p.Config.SMTPPassword,
+		MailFrom:     p.Config.MailFrom,
+		AppURL:       p.Config.AppURL,
+
+		KeyLogKeyPath: p.Config.KeyLogKeyPath,
+
+		TrustedProxies: p.Config.TrustedProxies,
+	})
 }
 
 type lifecycleParams struct {
@@ -86,7 +201,7 @@ func New(opts ...fx.Option) *fx.App {
 			provideConfig,
 			provideServer,
 		),
-		fx.Invoke(registerLifecycle),
+		fx.Invoke(registerLifecycle, registerMessageReaper, registerAccountPurge),
 	)
 	all := append([]fx.Option{base}, opts...)
 	return fx.New(all...)
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gql "github.com/eleven-am/enclave/internal/graphql"
+)
+
+// accountPurgeInterval is how often accounts past their deletion grace period
+// are looked for.
+const accountPurgeInterval = time.Hour
+
+// registerAccountPurge purges accounts whose deletion grace period has ended
+// while the application is started.
+func registerAccountPurge(p workerParams) {
+	startWorker(p.Lifecycle, func(ctx context.Context) {
+		purgeAccounts(ctx, p.Server.Resolver, accountPurgeInterval)
+	})
+}
+
+// purgeAccounts purges accounts whose deletion grace period has ended, once
+// immediately and then every interval until ctx is cancelled.
+func purgeAccounts(ctx context.Context, resolver *gql.Resolver, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := resolver.PurgeDueAccounts(ctx, time.Now())
+		if err != nil && ctx.Err() == nil {
+			log.Printf("account purge: %v", err)
+		}
+		if n > 0 {
+			log.Printf("account purge: purged %d accounts", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
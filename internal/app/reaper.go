@@ -0,0 +1,46 @@
+package app
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gql "github.com/eleven-am/enclave/internal/graphql"
+)
+
+// defaultMessageReapInterval is how often expired messages are deleted when
+// ENCLAVE_MESSAGE_REAP_INTERVAL is not set.
+const defaultMessageReapInterval = time.Minute
+
+// registerMessageReaper runs the disappearing message reaper while the
+// application is started.
+func registerMessageReaper(p workerParams) {
+	interval := p.Config.MessageReapInterval
+	if interval <= 0 {
+		interval = defaultMessageReapInterval
+	}
+	startWorker(p.Lifecycle, func(ctx context.Context) {
+		reapMessages(ctx, p.Server.Resolver, interval)
+	})
+}
+
+// reapMessages deletes expired messages once immediately and then every
+// interval until ctx is cancelled.
+func reapMessages(ctx context.Context, resolver *gql.Resolver, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n, err := resolver.ReapExpiredMessages(ctx, time.Now())
+		if err != nil && ctx.Err() == nil {
+			log.Printf("message reaper: %v", err)
+		}
+		if n > 0 {
+			log.Printf("message reaper: deleted %d expired messages", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+
+	"github.com/eleven-am/enclave/internal/server"
+)
+
+type workerParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Server    *server.Server
+	Config    Config
+}
+
+// startWorker runs work in the background while the application is started.
+// Stopping the application cancels the context given to work and waits for it
+// to return. Workers are registered after the server, so they stop before
+// the database is closed.
+func startWorker(lc fx.Lifecycle, work func(ctx context.Context)) {
+	var stop context.CancelFunc
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			stop = cancel
+			go func() {
+				defer close(done)
+				work(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			stop()
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+}
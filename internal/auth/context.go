@@ -4,40 +4,70 @@ import (
 	"context"
 	"errors"
 	"net/http"
-	"strconv"
+	"strings"
 )
 
-const userIDKey contextKey = "userID"
+const (
+	claimsKey      contextKey = "claims"
+	requestInfoKey contextKey = "requestInfo"
+)
 
 type contextKey string
 
-// ContextWithUserID injects the authenticated user ID into the context.
-func ContextWithUserID(ctx context.Context, id int) context.Context {
-	return context.WithValue(ctx, userIDKey, id)
+// ContextWithClaims injects the verified token claims into the context.
+func ContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey, claims)
 }
 
-// UserIDFromContext extracts the user ID from the context if present.
-func UserIDFromContext(ctx context.Context) (int, error) {
-	value := ctx.Value(userIDKey)
+// ClaimsFromContext extracts the verified token claims from the context if present.
+func ClaimsFromContext(ctx context.Context) (*Claims, error) {
+	value := ctx.Value(claimsKey)
 	if value == nil {
-		return 0, errors.New("user id not found in context")
+		return nil, errors.New("claims not found in context")
 	}
-	id, ok := value.(int)
-	if !ok {
-		return 0, errors.New("user id has unexpected type")
+	claims, ok := value.(*Claims)
+	if !ok || claims == nil {
+		return nil, errors.New("claims have unexpected type")
+	}
+	return claims, nil
+}
+
+// UserIDFromContext extracts the authenticated user ID from the context if present.
+func UserIDFromContext(ctx context.Context) (int, error) {
+	claims, err := ClaimsFromContext(ctx)
+	if err != nil {
+		return 0, err
 	}
-	return id, nil
+	return claims.UserID, nil
 }
 
-// UserIDFromRequest extracts the user ID from the X-User-ID header.
-func UserIDFromRequest(r *http.Request) (int, error) {
-	raw := r.Header.Get("X-User-ID")
+// BearerToken extracts the bearer token from the Authorization header.
+func BearerToken(r *http.Request) (string, error) {
+	raw := r.Header.Get("Authorization")
 	if raw == "" {
-		return 0, errors.New("missing X-User-ID header")
+		return "", errors.New("missing Authorization header")
 	}
-	id, err := strconv.Atoi(raw)
-	if err != nil {
-		return 0, errors.New("invalid X-User-ID header")
+	scheme, token, ok := strings.Cut(raw, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", errors.New("invalid Authorization header")
 	}
-	return id, nil
+	return strings.TrimSpace(token), nil
+}
+
+// RequestInfo describes the client a request originated from.
+type RequestInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
+// ContextWithRequestInfo injects details about the calling client into the context.
+func ContextWithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey, info)
+}
+
+// RequestInfoFromContext returns details about the calling client, or the zero
+// value when the context did not originate from an HTTP request.
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoKey).(RequestInfo)
+	return info
 }
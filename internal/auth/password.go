@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// MinPasswordLength is the minimum number of characters accepted for a password.
+	MinPasswordLength = 12
+	// MaxPasswordLength caps password length so hashing cost stays bounded.
+	MaxPasswordLength = 128
+)
+
+// ErrWeakPassword indicates a password does not satisfy the password policy.
+var ErrWeakPassword = errors.New("password does not meet policy")
+
+// ErrPasswordMismatch indicates a password does not match the stored hash.
+var ErrPasswordMismatch = errors.New("password does not match")
+
+// argon2Params are the argon2id cost parameters used for new hashes.
+type argon2Params struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	saltLen int
+	keyLen  uint32
+}
+
+var defaultArgon2Params = argon2Params{
+	memory:  64 * 1024,
+	time:    3,
+	threads: 2,
+	saltLen: 16,
+	keyLen:  32,
+}
+
+// dummyHash is verified against when no credential exists so that unknown
+// accounts take as long to reject as wrong passwords.
+var (
+	dummyHash     string
+	dummyHashOnce sync.Once
+)
+
+// ValidatePassword checks a candidate password against the password policy. The
+// identifiers (username, email, ...) of the account must not appear in it.
+func ValidatePassword(password string, identifiers ...string) error {
+	length := utf8.RuneCountInString(password)
+	if length < MinPasswordLength {
+		return fmt.Errorf("%w: must be at least %d characters", ErrWeakPassword, MinPasswordLength)
+	}
+	if length > MaxPasswordLength {
+		return fmt.Errorf("%w: must be at most %d characters", ErrWeakPassword, MaxPasswordLength)
+	}
+	if strings.TrimSpace(password) == "" {
+		return fmt.Errorf("%w: must not be blank", ErrWeakPassword)
+	}
+	distinct := map[rune]struct{}{}
+	for _, r := range password {
+		distinct[r] = struct{}{}
+	}
+	if len(distinct) < 4 {
+		return fmt.Errorf("%w: must contain at least 4 distinct characters", ErrWeakPassword)
+	}
+	lowered := strings.ToLower(password)
+	for _, identifier := range identifiers {
+		identifier = strings.ToLower(strings.TrimSpace(identifier))
+		if local, _, ok := strings.Cut(identifier, "@"); ok {
+			identifier = local
+		}
+		if len(identifier) >= 3 && strings.Contains(lowered, identifier) {
+			return fmt.Errorf("%w: must not contain your username or email", ErrWeakPassword)
+		}
+	}
+	return nil
+}
+
+// HashPassword derives an argon2id hash of the password encoded in PHC string format.
+func HashPassword(password string) (string, error) {
+	p := defaultArgon2Params
+	salt := make([]byte, p.saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed generating salt: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, p.time, p.memory, p.threads, p.keyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.memory, p.time, p.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// VerifyPassword compares a password with an encoded argon2id hash.
+func VerifyPassword(password, encoded string) error {
+	p, salt, key, err := decodeArgon2Hash(encoded)
+	if err != nil {
+		return err
+	}
+	candidate := argon2.IDKey([]byte(password), salt, p.time, p.memory, p.threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(candidate, key) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
+
+// RejectPassword burns the same work as VerifyPassword and always fails. Use it
+// when the account being authenticated does not exist.
+func RejectPassword(password string) error {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = HashPassword("enclave-timing-equaliser")
+	})
+	_ = VerifyPassword(password, dummyHash)
+	return ErrPasswordMismatch
+}
+
+func decodeArgon2Hash(encoded string) (argon2Params, []byte, []byte, error) {
+	var p argon2Params
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return p, nil, nil, errors.New("unsupported password hash format")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, errors.New("unsupported argon2 version")
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return p, nil, nil, fmt.Errorf("invalid argon2 parameters: %w", err)
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("invalid argon2 salt: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("invalid argon2 hash: %w", err)
+	}
+	return p, salt, key, nil
+}
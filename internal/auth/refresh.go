@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrMalformedRefreshToken indicates a refresh token is not in the expected format.
+var ErrMalformedRefreshToken = errors.New("malformed refresh token")
+
+// NewSecret returns a URL-safe random secret with n bytes of entropy.
+func NewSecret(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed generating secret: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// HashToken returns the hex encoded SHA-256 digest of a high-entropy token.
+// Only the digest is stored so a database leak does not expose usable tokens.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewRefreshToken creates an opaque refresh token bound to a session. It returns
+// the token to hand to the client and the hash to store.
+func NewRefreshToken(sessionID int) (string, string, error) {
+	secret, err := NewSecret(32)
+	if err != nil {
+		return "", "", err
+	}
+	return fmt.Sprintf("%d.%s", sessionID, secret), HashToken(secret), nil
+}
+
+// ParseRefreshToken splits a refresh token into its session ID and secret hash.
+func ParseRefreshToken(raw string) (int, string, error) {
+	prefix, secret, ok := strings.Cut(raw, ".")
+	if !ok || secret == "" {
+		return 0, "", ErrMalformedRefreshToken
+	}
+	sessionID, err := strconv.Atoi(prefix)
+	if err != nil || sessionID <= 0 {
+		return 0, "", ErrMalformedRefreshToken
+	}
+	return sessionID, HashToken(secret), nil
+}
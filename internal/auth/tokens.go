@@ -0,0 +1,294 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// TokenTypeAccess marks tokens that authorize API requests.
+	TokenTypeAccess = "access"
+	// TokenTypeOIDCState marks tokens that carry an in-flight OIDC login between
+	// the redirect to the identity provider and the callback.
+	TokenTypeOIDCState = "oidc_state"
+	// TokenTypeMFAChallenge marks tokens proving the first login factor was
+	// accepted while the second factor is still outstanding.
+	TokenTypeMFAChallenge = "mfa_challenge"
+	// TokenTypeOIDCLink marks tokens that let a signed-in user start an OIDC
+	// flow which links the provider identity to their account.
+	TokenTypeOIDCLink = "oidc_link"
+)
+
+const (
+	// oidcStateTTL bounds how long a user may take to complete an OIDC login.
+	oidcStateTTL = 10 * time.Minute
+	// mfaChallengeTTL bounds how long a user may take to enter their second factor.
+	mfaChallengeTTL = 5 * time.Minute
+	// oidcLinkTTL bounds how long a link ticket may wait before the flow starts.
+	oidcLinkTTL = 5 * time.Minute
+)
+
+// RoleAdmin is the server-wide role of instance operators.
+const RoleAdmin = "admin"
+
+// ErrInvalidToken indicates a token failed signature, expiry or claim validation.
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims describes the authenticated principal carried by a verified token.
+type Claims struct {
+	jwt.RegisteredClaims
+	TokenType string `json:"typ"`
+	SessionID int    `json:"sid,omitempty"`
+	// DeviceLabel carries the client's device label through an MFA challenge.
+	DeviceLabel string `json:"dev,omitempty"`
+	// Binding ties an OIDC link ticket to the browser that requested it.
+	Binding string `json:"bnd,omitempty"`
+
+	// UserID is derived from the subject claim during verification.
+	UserID int `json:"-"`
+	// APIKeyID and Scopes are set when the request authenticated with an API
+	// key instead of an access token.
+	APIKeyID int      `json:"-"`
+	Scopes   []string `json:"-"`
+	// Role is the user's server-wide role, loaded from the database when the
+	// request is authenticated rather than trusted from the token.
+	Role string `json:"-"`
+}
+
+// IsAdmin reports whether the principal holds the server-wide admin role.
+func (c *Claims) IsAdmin() bool {
+	return c.Role == RoleAdmin
+}
+
+// HasScope reports whether the principal may perform operations guarded by scope.
+// Access tokens carry the full authority of their user.
+func (c *Claims) HasScope(scope string) bool {
+	if c.APIKeyID == 0 {
+		return true
+	}
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// TokenConfig configures token issuance and verification.
+type TokenConfig struct {
+	Keys       *KeySet
+	Issuer     string
+	Audience   string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
+// TokenService issues and verifies signed JWTs.
+type TokenService struct {
+	keys       *KeySet
+	issuer     string
+	audience   string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	now        func() time.Time
+}
+
+// NewTokenService constructs a token service from the provided configuration.
+func NewTokenService(cfg TokenConfig) (*TokenService, error) {
+	if cfg.Keys == nil {
+		return nil, errors.New("token service requires a keyset")
+	}
+	if cfg.Issuer == "" {
+		cfg.Issuer = "enclave"
+	}
+	if cfg.Audience == "" {
+		cfg.Audience = "enclave"
+	}
+	if cfg.AccessTTL <= 0 {
+		cfg.AccessTTL = 15 * time.Minute
+	}
+	if cfg.RefreshTTL <= 0 {
+		cfg.RefreshTTL = 30 * 24 * time.Hour
+	}
+	return &TokenService{
+		keys:       cfg.Keys,
+		issuer:     cfg.Issuer,
+		audience:   cfg.Audience,
+		accessTTL:  cfg.AccessTTL,
+		refreshTTL: cfg.RefreshTTL,
+		now:        time.Now,
+	}, nil
+}
+
+// RefreshTTL reports how long a session stays valid after its refresh token was last rotated.
+func (s *TokenService) RefreshTTL() time.Duration {
+	return s.refreshTTL
+}
+
+// IssueAccessToken signs a short-lived access token for the given user and session.
+func (s *TokenService) IssueAccessToken(userID, sessionID int) (string, time.Time, error) {
+	return s.issue(&Claims{TokenType: TokenTypeAccess, SessionID: sessionID}, userID, s.accessTTL)
+}
+
+// IssueMFAChallenge signs a short-lived token that lets the holder finish a
+// login for the user by presenting a second factor.
+func (s *TokenService) IssueMFAChallenge(userID int, deviceLabel string) (string, time.Time, error) {
+	return s.issue(&Claims{TokenType: TokenTypeMFAChallenge, DeviceLabel: deviceLabel}, userID, mfaChallengeTTL)
+}
+
+// VerifyMFAChallenge validates a token produced by IssueMFAChallenge.
+func (s *TokenService) VerifyMFAChallenge(raw string) (*Claims, error) {
+	return s.verify(raw, TokenTypeMFAChallenge)
+}
+
+// IssueOIDCLink signs a short-lived ticket that starts an OIDC flow linking
+// the provider identity to the user's account. The flow must be started by
+// the holder of binding.
+func (s *TokenService) IssueOIDCLink(userID int, binding string) (string, time.Time, error) {
+	return s.issue(&Claims{TokenType: TokenTypeOIDCLink, Binding: binding}, userID, oidcLinkTTL)
+}
+
+// VerifyOIDCLink validates a token produced by IssueOIDCLink.
+func (s *TokenService) VerifyOIDCLink(raw string) (*Claims, error) {
+	return s.verify(raw, TokenTypeOIDCLink)
+}
+
+// oidcStateClaims carries an OIDC auth request through the user agent.
+type oidcStateClaims struct {
+	jwt.RegisteredClaims
+	TokenType    string `json:"typ"`
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"cv"`
+	DeviceLabel  string `json:"dev,omitempty"`
+	LinkUserID   int    `json:"link,omitempty"`
+}
+
+// IssueOIDCState signs an OIDC auth request so it can be stored client side,
+// typically in a short-lived cookie, until the provider redirects back.
+func (s *TokenService) IssueOIDCState(req *OIDCAuthRequest, deviceLabel string) (string, error) {
+	key, err := s.keys.signingKey()
+	if err != nil {
+		return "", err
+	}
+	now := s.now()
+	claims := &oidcStateClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.issuer,
+			Audience:  jwt.ClaimStrings{s.audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(oidcStateTTL)),
+		},
+		TokenType:    TokenTypeOIDCState,
+		State:        req.State,
+		Nonce:        req.Nonce,
+		CodeVerifier: req.CodeVerifier,
+		DeviceLabel:  deviceLabel,
+		LinkUserID:   req.LinkUserID,
+	}
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.kid
+	signed, err := token.SignedString(key.sign)
+	if err != nil {
+		return "", fmt.Errorf("failed signing token: %w", err)
+	}
+	return signed, nil
+}
+
+// VerifyOIDCState validates a token produced by IssueOIDCState and returns the
+// auth request and device label it carries.
+func (s *TokenService) VerifyOIDCState(raw string) (*OIDCAuthRequest, string, error) {
+	claims := &oidcStateClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, s.keyFunc, s.parserOptions()...)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if claims.TokenType != TokenTypeOIDCState || claims.State == "" {
+		return nil, "", fmt.Errorf("%w: unexpected token type %q", ErrInvalidToken, claims.TokenType)
+	}
+	return &OIDCAuthRequest{
+		State:        claims.State,
+		Nonce:        claims.Nonce,
+		CodeVerifier: claims.CodeVerifier,
+		LinkUserID:   claims.LinkUserID,
+	}, claims.DeviceLabel, nil
+}
+
+func (s *TokenService) issue(claims *Claims, userID int, ttl time.Duration) (string, time.Time, error) {
+	key, err := s.keys.signingKey()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	now := s.now()
+	expiresAt := now.Add(ttl)
+	claims.Issuer = s.issuer
+	claims.Subject = strconv.Itoa(userID)
+	claims.Audience = jwt.ClaimStrings{s.audience}
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.NotBefore = jwt.NewNumericDate(now)
+	claims.ExpiresAt = jwt.NewNumericDate(expiresAt)
+
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.kid
+	signed, err := token.SignedString(key.sign)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed signing token: %w", err)
+	}
+	return signed, expiresAt, nil
+}
+
+// VerifyAccessToken validates an access token and returns its claims.
+func (s *TokenService) VerifyAccessToken(raw string) (*Claims, error) {
+	return s.verify(raw, TokenTypeAccess)
+}
+
+func (s *TokenService) verify(raw, tokenType string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(raw, claims, s.keyFunc, s.parserOptions()...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if claims.TokenType != tokenType {
+		return nil, fmt.Errorf("%w: unexpected token type %q", ErrInvalidToken, claims.TokenType)
+	}
+	id, err := strconv.Atoi(claims.Subject)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("%w: invalid subject", ErrInvalidToken)
+	}
+	claims.UserID = id
+	return claims, nil
+}
+
+func (s *TokenService) parserOptions() []jwt.ParserOption {
+	return []jwt.ParserOption{
+		jwt.WithValidMethods([]string{
+			jwt.SigningMethodHS256.Alg(),
+			jwt.SigningMethodEdDSA.Alg(),
+			jwt.SigningMethodRS256.Alg(),
+		}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithAudience(s.audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(s.now),
+	}
+}
+
+func (s *TokenService) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("missing kid header")
+	}
+	key, err := s.keys.verificationKey(kid)
+	if err != nil {
+		return nil, err
+	}
+	// Pin each key to its own algorithm so an RSA public key can never be used as an HMAC secret.
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("algorithm %q does not match key %q", token.Method.Alg(), kid)
+	}
+	return key.verify, nil
+}
@@ -0,0 +1,27 @@
+// Package directory maintains the full-text index behind user search. Built
+// with the sqlite_fts5 tag it uses an SQLite FTS5 table kept in sync with the
+// users table by triggers; without the tag, go-sqlite3 lacks FTS5 and search
+// falls back to plain prefix matching.
+package directory
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/eleven-am/enclave/ent/predicate"
+)
+
+// Index matches users against search terms.
+type Index struct{}
+
+// Match returns a predicate selecting users whose username or display name has
+// a word starting with every term in query. ok is false when query has no terms.
+func (ix *Index) Match(query string) (p predicate.User, ok bool) {
+	terms := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	if len(terms) == 0 {
+		return nil, false
+	}
+	return matchTerms(terms), true
+}
@@ -0,0 +1,104 @@
+package directory
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/eleven-am/enclave/ent"
+	_ "github.com/eleven-am/enclave/ent/runtime"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// newTestIndex opens a fresh database with the users table and its index.
+func newTestIndex(t *testing.T) (*ent.Client, *Index) {
+	t.Helper()
+	drv, err := entsql.Open(dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "enclave.db")+"?_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ix, err := Open(ctx, drv.DB())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, ix
+}
+
+func addUser(t *testing.T, client *ent.Client, username, displayName string) *ent.User {
+	t.Helper()
+	u, err := client.User.Create().
+		SetUsername(username).
+		SetDisplayName(displayName).
+		SetEmail(username + "@example.com").
+		Save(rule.SystemContext(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+// search returns the usernames matching query, sorted.
+func search(t *testing.T, client *ent.Client, ix *Index, query string) []string {
+	t.Helper()
+	p, ok := ix.Match(query)
+	if !ok {
+		t.Fatalf("%q has no terms", query)
+	}
+	names, err := client.User.Query().Where(p).Select("username").Strings(rule.SystemContext(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func equalNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMatch(t *testing.T) {
+	client, ix := newTestIndex(t)
+	addUser(t, client, "alice", "Alice Smith")
+	addUser(t, client, "alistair", "Al Jones")
+	addUser(t, client, "bob", "Bobby Alison")
+	addUser(t, client, "carol", "Carol Lister")
+
+	cases := map[string][]string{
+		"ali":       {"alice", "alistair", "bob"},
+		"ALI":       {"alice", "alistair", "bob"},
+		"smi":       {"alice"},
+		"ali smi":   {"alice"},
+		"ali, jon!": {"alistair"},
+		"ice":       nil,
+		"dave":      nil,
+	}
+	for query, want := range cases {
+		if got := search(t, client, ix, query); !equalNames(got, want) {
+			t.Errorf("%q matched %v, want %v", query, got, want)
+		}
+	}
+	for _, query := range []string{"", "  ", "!?"} {
+		if _, ok := ix.Match(query); ok {
+			t.Errorf("%q has terms", query)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+//go:build sqlite_fts5
+
+package directory
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// schema creates the index and the triggers that keep it current. The index
+// stores no content of its own; it reads usernames and display names from users.
+var schema = []string{
+	`CREATE VIRTUAL TABLE IF NOT EXISTS user_directory USING fts5(
+		username, display_name,
+		content='users', content_rowid='id',
+		tokenize='unicode61 remove_diacritics 2', prefix='2 3'
+	)`,
+	`CREATE TRIGGER IF NOT EXISTS user_directory_ai AFTER INSERT ON users BEGIN
+		INSERT INTO user_directory(rowid, username, display_name) VALUES (new.id, new.username, new.display_name);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS user_directory_ad AFTER DELETE ON users BEGIN
+		INSERT INTO user_directory(user_directory, rowid, username, display_name) VALUES ('delete', old.id, old.username, old.display_name);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS user_directory_au AFTER UPDATE OF username, display_name ON users BEGIN
+		INSERT INTO user_directory(user_directory, rowid, username, display_name) VALUES ('delete', old.id, old.username, old.display_name);
+		INSERT INTO user_directory(rowid, username, display_name) VALUES (new.id, new.username, new.display_name);
+	END`,
+	`INSERT INTO user_directory(user_directory) VALUES ('rebuild')`,
+}
+
+// Open prepares the search index on db, which must already hold the users
+// table. The build asked for FTS5, so failing to set it up stops startup
+// rather than quietly searching without it.
+func Open(ctx context.Context, db *sql.DB) (*Index, error) {
+	for _, stmt := range schema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("failed creating user directory index: %w", err)
+		}
+	}
+	return &Index{}, nil
+}
+
+// matchTerms looks every term up as a prefix in the FTS5 index.
+func matchTerms(terms []string) predicate.User {
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		quoted = append(quoted, `"`+term+`"*`)
+	}
+	match := strings.Join(quoted, " ")
+	return predicate.User(func(s *entsql.Selector) {
+		s.Where(entsql.ExprP(
+			s.C(user.FieldID)+" IN (SELECT rowid FROM user_directory WHERE user_directory MATCH ?)",
+			match,
+		))
+	})
+}
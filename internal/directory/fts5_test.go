@@ -0,0 +1,41 @@
+//go:build sqlite_fts5
+
+package directory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+func TestFTSFoldsDiacritics(t *testing.T) {
+	client, ix := newTestIndex(t)
+	addUser(t, client, "jose", "José Núñez")
+	for _, query := range []string{"nun", "núñ", "JOS"} {
+		if got := search(t, client, ix, query); !equalNames(got, []string{"jose"}) {
+			t.Errorf("%q matched %v", query, got)
+		}
+	}
+}
+
+func TestFTSFollowsUserChanges(t *testing.T) {
+	client, ix := newTestIndex(t)
+	ctx := rule.SystemContext(context.Background())
+	u := addUser(t, client, "dana", "Dana Scully")
+	if err := u.Update().SetDisplayName("Dana Mulder").Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := search(t, client, ix, "scu"); len(got) != 0 {
+		t.Errorf("old display name still matches %v", got)
+	}
+	if got := search(t, client, ix, "mul"); !equalNames(got, []string{"dana"}) {
+		t.Errorf("new display name matched %v", got)
+	}
+	if err := client.User.DeleteOne(u).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := search(t, client, ix, "dana"); len(got) != 0 {
+		t.Errorf("deleted user still matches %v", got)
+	}
+}
@@ -0,0 +1,32 @@
+//go:build !sqlite_fts5
+
+package directory
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// Open prepares prefix matching. Builds without the sqlite_fts5 tag have no
+// FTS5 in SQLite, so there is no index to create.
+func Open(ctx context.Context, db *sql.DB) (*Index, error) {
+	log.Printf("built without the sqlite_fts5 tag; user search falls back to prefix matching")
+	return &Index{}, nil
+}
+
+// matchTerms scans usernames and the words of display names for each term.
+func matchTerms(terms []string) predicate.User {
+	preds := make([]predicate.User, 0, len(terms))
+	for _, term := range terms {
+		preds = append(preds, user.Or(
+			user.UsernameHasPrefix(term),
+			user.DisplayNameHasPrefix(term),
+			user.DisplayNameContains(" "+term),
+		))
+	}
+	return user.And(preds...)
+}
@@ -0,0 +1,244 @@
+// Package encryption is the registry of encryption schemes clients may declare
+// on messages and notifications, and the per-room policies that restrict them.
+// The server never sees plaintext of end-to-end schemes; the registry only
+// keeps clients honest about what they claim to send.
+package encryption
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Names of the registered schemes.
+const (
+	// Signal is a pairwise Signal protocol session (X3DH or PQXDH and the
+	// Double Ratchet).
+	Signal = "signal"
+	// SenderKey is a Signal sender key shared with a group's devices.
+	SenderKey = "signal-sender-key"
+	// MLS is a Messaging Layer Security group (RFC 9420).
+	MLS = "mls"
+	// Plaintext payloads are readable by the server.
+	Plaintext = "plaintext"
+	// None marks JSON payloads written by the server itself.
+	None = "none"
+)
+
+// Version is one version of a scheme.
+type Version struct {
+	Number      int
+	Description string
+}
+
+// Scheme describes a registered encryption scheme.
+type Scheme struct {
+	Name        string
+	Description string
+	// EndToEnd is false for schemes whose payloads the server can read.
+	EndToEnd bool
+	// ServerOnly schemes are written by the server and never accepted from
+	// clients.
+	ServerOnly bool
+	// Versions lists the supported versions, oldest first. The last one is
+	// the current version, recorded when a client does not name one.
+	Versions []Version
+}
+
+// CurrentVersion returns the newest supported version.
+func (s *Scheme) CurrentVersion() int {
+	return s.Versions[len(s.Versions)-1].Number
+}
+
+// MinVersion returns the oldest supported version.
+func (s *Scheme) MinVersion() int {
+	return s.Versions[0].Number
+}
+
+// Supports reports whether version is a supported version of the scheme.
+func (s *Scheme) Supports(version int) bool {
+	for _, v := range s.Versions {
+		if v.Number == version {
+			return true
+		}
+	}
+	return false
+}
+
+var registry = map[string]*Scheme{
+	Signal: {
+		Name:        Signal,
+		Description: "Pairwise Signal protocol sessions.",
+		EndToEnd:    true,
+		Versions: []Version{
+			{Number: 3, Description: "X3DH key agreement with the Double Ratchet."},
+			{Number: 4, Description: "PQXDH post-quantum key agreement with the Double Ratchet."},
+		},
+	},
+	SenderKey: {
+		Name:        SenderKey,
+		Description: "Signal sender keys distributed to the room's devices.",
+		EndToEnd:    true,
+		Versions: []Version{
+			{Number: 3, Description: "Sender key messages as in libsignal."},
+		},
+	},
+	MLS: {
+		Name:        MLS,
+		Description: "Messaging Layer Security groups.",
+		EndToEnd:    true,
+		Versions: []Version{
+			{Number: 1, Description: "MLS 1.0 (RFC 9420)."},
+		},
+	},
+	Plaintext: {
+		Name:        Plaintext,
+		Description: "Unencrypted payloads the server can read.",
+		Versions: []Version{
+			{Number: 1, Description: "Raw payload."},
+		},
+	},
+	None: {
+		Name:        None,
+		Description: "JSON payloads of notifications written by the server.",
+		ServerOnly:  true,
+		Versions: []Version{
+			{Number: 1, Description: "JSON payload."},
+		},
+	},
+}
+
+// Lookup returns the registered scheme with the given name.
+func Lookup(name string) (*Scheme, bool) {
+	s, ok := registry[name]
+	return s, ok
+}
+
+// Schemes lists the registered schemes by name.
+func Schemes() []*Scheme {
+	schemes := make([]*Scheme, 0, len(registry))
+	for _, s := range registry {
+		schemes = append(schemes, s)
+	}
+	sort.Slice(schemes, func(i, j int) bool { return schemes[i].Name < schemes[j].Name })
+	return schemes
+}
+
+// Requirement allows a scheme in a room from a minimum version on.
+type Requirement struct {
+	Scheme string `json:"scheme"`
+	// MinVersion of zero accepts every supported version.
+	MinVersion int `json:"minVersion,omitempty"`
+}
+
+// Policy restricts the schemes a room accepts. An empty policy is the
+// default, which accepts every end-to-end scheme at any supported version.
+type Policy []Requirement
+
+// Validate checks that every requirement names a client scheme once and a
+// version the scheme supports.
+func (p Policy) Validate() error {
+	seen := make(map[string]bool, len(p))
+	for _, req := range p {
+		scheme, ok := Lookup(req.Scheme)
+		if !ok || scheme.ServerOnly {
+			return fmt.Errorf("unknown encryption scheme %q", req.Scheme)
+		}
+		if seen[req.Scheme] {
+			return fmt.Errorf("encryption scheme %q is listed twice", req.Scheme)
+		}
+		seen[req.Scheme] = true
+		if req.MinVersion != 0 && !scheme.Supports(req.MinVersion) {
+			return fmt.Errorf("encryption scheme %q has no version %d", req.Scheme, req.MinVersion)
+		}
+	}
+	return nil
+}
+
+// Allowed lists the names of the schemes the policy accepts.
+func (p Policy) Allowed() []string {
+	var names []string
+	if len(p) == 0 {
+		for _, s := range Schemes() {
+			if s.EndToEnd {
+				names = append(names, s.Name)
+			}
+		}
+		return names
+	}
+	for _, req := range p {
+		names = append(names, req.Scheme)
+	}
+	return names
+}
+
+// Resolve checks a scheme and version declared by a client. An empty name
+// selects fallback and a zero version the scheme's current version.
+func Resolve(name string, version int, fallback string) (*Scheme, int, error) {
+	if name == "" {
+		name = fallback
+	}
+	scheme, ok := Lookup(name)
+	if !ok || scheme.ServerOnly {
+		return nil, 0, &PolicyError{Scheme: name, Version: version, Reason: fmt.Sprintf("unknown encryption scheme %q", name)}
+	}
+	if version == 0 {
+		version = scheme.CurrentVersion()
+	}
+	if !scheme.Supports(version) {
+		return nil, 0, &PolicyError{Scheme: name, Version: version, Reason: fmt.Sprintf("encryption scheme %q has no version %d", name, version)}
+	}
+	return scheme, version, nil
+}
+
+// Check reports a PolicyError unless the policy accepts version of scheme.
+func (p Policy) Check(scheme *Scheme, version int) error {
+	if len(p) == 0 {
+		if !scheme.EndToEnd {
+			return p.deny(scheme.Name, version, fmt.Sprintf("encryption scheme %q is not end-to-end encrypted and this room requires end-to-end encryption", scheme.Name))
+		}
+		return nil
+	}
+	for _, req := range p {
+		if req.Scheme != scheme.Name {
+			continue
+		}
+		if version < req.MinVersion {
+			return p.deny(scheme.Name, version, fmt.Sprintf("encryption scheme %q version %d is below this room's minimum of %d", scheme.Name, version, req.MinVersion))
+		}
+		return nil
+	}
+	return p.deny(scheme.Name, version, fmt.Sprintf("encryption scheme %q is not allowed in this room; use one of %s", scheme.Name, strings.Join(p.Allowed(), ", ")))
+}
+
+func (p Policy) deny(scheme string, version int, reason string) error {
+	return &PolicyError{Scheme: scheme, Version: version, Reason: reason, Allowed: p.Allowed()}
+}
+
+// PolicyError rejects a payload whose declared scheme or version is unknown
+// or not accepted by the room.
+type PolicyError struct {
+	Scheme  string
+	Version int
+	Reason  string
+	// Allowed lists the schemes the room accepts, when a room policy applied.
+	Allowed []string
+}
+
+func (e *PolicyError) Error() string {
+	return e.Reason
+}
+
+// Extensions reports the rejected scheme and the accepted ones in the
+// GraphQL error.
+func (e *PolicyError) Extensions() map[string]interface{} {
+	ext := map[string]interface{}{
+		"code":    "ENCRYPTION_POLICY",
+		"scheme":  e.Scheme,
+		"version": e.Version,
+	}
+	if e.Allowed != nil {
+		ext["allowedSchemes"] = e.Allowed
+	}
+	return ext
+}
@@ -0,0 +1,257 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+)
+
+// ErrInvalidCredentials is returned for any failed login so callers cannot
+// distinguish unknown accounts from wrong passwords.
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// AuthPayload is returned by every operation that establishes or renews a login.
+// When the account has a second factor enrolled, login only returns an
+// MFAChallenge and the tokens are issued by completeMfaLogin.
+type AuthPayload struct {
+	AccessToken           string        `json:"accessToken,omitempty"`
+	AccessTokenExpiresAt  time.Time     `json:"accessTokenExpiresAt"`
+	RefreshToken          string        `json:"refreshToken,omitempty"`
+	RefreshTokenExpiresAt time.Time     `json:"refreshTokenExpiresAt"`
+	User                  *ent.User     `json:"user,omitempty"`
+	MFAChallenge          *mfaChallenge `json:"mfaChallenge,omitempty"`
+}
+
+func (r *Resolver) authPayloadType() *graphql.Object {
+	if r.authPayloadObj == nil {
+		r.authPayloadObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "AuthPayload",
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return graphql.Fields{
+					"accessToken":           &graphql.Field{Type: graphql.String, Resolve: resolveStringPointerField("AccessToken")},
+					"accessTokenExpiresAt":  &graphql.Field{Type: graphql.DateTime, Resolve: resolveOptionalTimeField("AccessTokenExpiresAt")},
+					"refreshToken":          &graphql.Field{Type: graphql.String, Resolve: resolveStringPointerField("RefreshToken")},
+					"refreshTokenExpiresAt": &graphql.Field{Type: graphql.DateTime, Resolve: resolveOptionalTimeField("RefreshTokenExpiresAt")},
+					"user":                  &graphql.Field{Type: r.userType()},
+					"mfaChallenge":          &graphql.Field{Type: r.mfaChallengeType()},
+				}
+			}),
+		})
+	}
+	return r.authPayloadObj
+}
+
+func (r *Resolver) accountMutationFields() graphql.Fields {
+	return graphql.Fields{
+		"register": &graphql.Field{
+			Type: r.authPayloadType(),
+			Args: graphql.FieldConfigArgument{
+				"username":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"displayName": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"email":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"password":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"avatarUrl":   &graphql.ArgumentConfig{Type: graphql.String},
+				"deviceLabel": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				username := strings.TrimSpace(p.Args["username"].(string))
+				email := strings.TrimSpace(p.Args["email"].(string))
+				password := p.Args["password"].(string)
+				if err := validateUsername(username); err != nil {
+					return nil, err
+				}
+				if err := auth.ValidatePassword(password, username, email); err != nil {
+					return nil, err
+				}
+				hash, err := auth.HashPassword(password)
+				if err != nil {
+					return nil, err
+				}
+				tx, err := r.Client.Tx(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				defer rollbackOnError(tx, &err)
+
+				builder := tx.User.Create().
+					SetUsername(username).
+					SetDisplayName(p.Args["displayName"].(string)).
+					SetEmail(email)
+				if avatar, ok := p.Args["avatarUrl"].(string); ok && avatar != "" {
+					builder.SetAvatarURL(avatar)
+				}
+				newUser, err := builder.Save(p.Context)
+				if err != nil {
+					if ent.IsConstraintError(err) {
+						err = errors.New("username or email is already registered")
+					}
+					return nil, err
+				}
+				_, err = tx.Credential.Create().
+					SetUser(newUser).
+					SetPasswordHash(hash).
+					Save(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				if err = tx.Commit(); err != nil {
+					return nil, err
+				}
+				// The account is usable before its address is verified, so a mail
+				// outage must not fail registration; sendVerificationEmail retries.
+				if err := r.sendVerificationEmail(p.Context, newUser); err != nil {
+					log.Printf("verification email for user %d: %v", newUser.ID, err)
+				}
+				deviceLabel, _ := p.Args["deviceLabel"].(string)
+				return r.startSession(p.Context, newUser, deviceLabel, nil)
+			},
+		},
+		"login": &graphql.Field{
+			Type: r.authPayloadType(),
+			Args: graphql.FieldConfigArgument{
+				"identifier":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String), Description: "Username or email address."},
+				"password":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"deviceLabel": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				identifier := strings.TrimSpace(p.Args["identifier"].(string))
+				password := p.Args["password"].(string)
+				usr, cred, err := r.lookupCredential(p.Context, identifier)
+				if err != nil {
+					return nil, err
+				}
+				attempt, err := r.reserveLoginAttempt(p.Context, loginThrottleKeys(p.Context, identifier, usr))
+				if err != nil {
+					return nil, err
+				}
+				if cred == nil {
+					_ = auth.RejectPassword(password)
+					return nil, r.loginFailed(p.Context, attempt)
+				}
+				if err := auth.VerifyPassword(password, cred.PasswordHash); err != nil {
+					return nil, r.loginFailed(p.Context, attempt)
+				}
+				if err := r.loginSucceeded(p.Context, attempt); err != nil {
+					return nil, err
+				}
+				if usr.SuspendedAt != nil {
+					return nil, ErrAccountSuspended
+				}
+				deviceLabel, _ := p.Args["deviceLabel"].(string)
+				enrolled, err := r.mfaEnrolled(p.Context, usr.ID)
+				if err != nil {
+					return nil, err
+				}
+				if enrolled {
+					return r.issueMFAChallenge(usr.ID, deviceLabel)
+				}
+				return r.startSession(p.Context, usr, deviceLabel, nil)
+			},
+		},
+		"logout": &graphql.Field{
+			Type: graphql.Boolean,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				claims, err := auth.ClaimsFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				if _, err := r.revokeSessions(p.Context, "logged out", session.ID(claims.SessionID)); err != nil {
+					return nil, err
+				}
+				return true, nil
+			},
+		},
+		"changePassword": &graphql.Field{
+			Type: r.authPayloadType(),
+			Args: graphql.FieldConfigArgument{
+				"currentPassword": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"newPassword":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				claims, err := auth.ClaimsFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				usr, err := r.Client.User.Query().
+					Where(user.ID(claims.UserID)).
+					WithCredential().
+					Only(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				cred := usr.Edges.Credential
+				if cred == nil {
+					return nil, errors.New("account has no password")
+				}
+				if err := auth.VerifyPassword(p.Args["currentPassword"].(string), cred.PasswordHash); err != nil {
+					return nil, ErrInvalidCredentials
+				}
+				newPassword := p.Args["newPassword"].(string)
+				if err := auth.ValidatePassword(newPassword, usr.Username, usr.Email); err != nil {
+					return nil, err
+				}
+				hash, err := auth.HashPassword(newPassword)
+				if err != nil {
+					return nil, err
+				}
+				err = r.Client.Credential.UpdateOneID(cred.ID).
+					SetPasswordHash(hash).
+					SetPasswordUpdatedAt(time.Now()).
+					Exec(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				// Every existing session, including the caller's, is signed out and
+				// the caller continues on a fresh one.
+				deviceLabel := ""
+				var mfaVerifiedAt *time.Time
+				if current, err := r.Client.Session.Get(p.Context, claims.SessionID); err == nil {
+					deviceLabel = current.DeviceLabel
+					mfaVerifiedAt = current.MfaVerifiedAt
+				}
+				if _, err := r.revokeSessions(p.Context, "password changed", session.HasUserWith(user.ID(usr.ID))); err != nil {
+					return nil, err
+				}
+				return r.startSession(p.Context, usr, deviceLabel, mfaVerifiedAt)
+			},
+		},
+	}
+}
+
+// validateUsername rejects usernames that could be mistaken for an email
+// address, which lookupCredential tells apart by the @.
+func validateUsername(username string) error {
+	if strings.Contains(username, "@") {
+		return errors.New("usernames cannot contain @")
+	}
+	return nil
+}
+
+// lookupCredential resolves a username or email to its user and password
+// credential. A missing user or credential is reported as a nil credential.
+func (r *Resolver) lookupCredential(ctx context.Context, identifier string) (*ent.User, *ent.Credential, error) {
+	where := user.Username(identifier)
+	if strings.Contains(identifier, "@") {
+		where = user.Email(identifier)
+	}
+	usr, err := r.Client.User.Query().
+		Where(where).
+		WithCredential().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return usr, usr.Edges.Credential, nil
+}
@@ -0,0 +1,298 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/apikey"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+)
+
+// serviceAccountEmailDomain is used for the placeholder email address of
+// service accounts, which never receive mail.
+const serviceAccountEmailDomain = "service-accounts.invalid"
+
+// rootFieldScopes maps the top-level operations API keys may call to the scope
+// they require. Operations missing from the map are not available to API keys.
+var rootFieldScopes = map[string]string{
+	"users":                auth.ScopeUsersRead,
+	"user":                 auth.ScopeUsersRead,
+	"searchUsers":          auth.ScopeUsersRead,
+	"devices":              auth.ScopeUsersRead,
+	"identityKeyHistory":   auth.ScopeUsersRead,
+	"rooms":                auth.ScopeRoomsRead,
+	"room":                 auth.ScopeRoomsRead,
+	"createRoom":           auth.ScopeRoomsWrite,
+	"updateRoom":           auth.ScopeRoomsWrite,
+	"addRoomMembers":       auth.ScopeRoomsWrite,
+	"updateRoomMembership": auth.ScopeRoomsWrite,
+	"removeRoomMember":     auth.ScopeRoomsWrite,
+	"messages":             auth.ScopeMessagesRead,
+	"createMessage":        auth.ScopeMessagesWrite,
+	"claimKeyPackages":     auth.ScopeMessagesWrite,
+	"mlsCommits":           auth.ScopeMessagesRead,
+	"sendMlsCommit":        auth.ScopeRoomsWrite,
+	"updateMessage":        auth.ScopeMessagesWrite,
+	"deleteMessage":        auth.ScopeMessagesWrite,
+	"createMedia":          auth.ScopeMediaWrite,
+	"deleteMedia":          auth.ScopeMediaWrite,
+	"notifications":        auth.ScopeNotificationsRead,
+	"notification":         auth.ScopeNotificationsRead,
+	"createNotification":   auth.ScopeNotificationsWrite,
+	"updateNotification":   auth.ScopeNotificationsWrite,
+	"deleteNotification":   auth.ScopeNotificationsWrite,
+}
+
+// createdAPIKey is returned once, when the key is created; the secret is not stored.
+type createdAPIKey struct {
+	Key    string      `json:"key"`
+	APIKey *ent.ApiKey `json:"apiKey"`
+}
+
+func (r *Resolver) apiKeyType() *graphql.Object {
+	if r.apiKeyObj == nil {
+		r.apiKeyObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "ApiKey",
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return graphql.Fields{
+					"id":         &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+					"name":       &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("Name")},
+					"prefix":     &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("Prefix")},
+					"scopes":     &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+					"createdAt":  &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+					"lastUsedAt": &graphql.Field{Type: graphql.DateTime, Resolve: resolveOptionalTimeField("LastUsedAt")},
+					"expiresAt":  &graphql.Field{Type: graphql.DateTime, Resolve: resolveOptionalTimeField("ExpiresAt")},
+					"revokedAt":  &graphql.Field{Type: graphql.DateTime, Resolve: resolveOptionalTimeField("RevokedAt")},
+					"user": &graphql.Field{
+						Type: r.userType(),
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							key := p.Source.(*ent.ApiKey)
+							return key.QueryUser().Only(p.Context)
+						},
+					},
+				}
+			}),
+		})
+	}
+	return r.apiKeyObj
+}
+
+func (r *Resolver) createdAPIKeyType() *graphql.Object {
+	if r.createdAPIKeyObj == nil {
+		r.createdAPIKeyObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "CreatedApiKey",
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return graphql.Fields{
+					"key":    &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "The secret key. It is only shown once."},
+					"apiKey": &graphql.Field{Type: graphql.NewNonNull(r.apiKeyType())},
+				}
+			}),
+		})
+	}
+	return r.createdAPIKeyObj
+}
+
+func (r *Resolver) apiKeyQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"apiKeys": &graphql.Field{
+			Type:        graphql.NewList(r.apiKeyType()),
+			Description: "Lists the API keys of the caller, or of one of the caller's service accounts.",
+			Args: graphql.FieldConfigArgument{
+				"userId": &graphql.ArgumentConfig{Type: graphql.ID},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				target, err := r.apiKeyTarget(p.Context, uid, p.Args["userId"])
+				if err != nil {
+					return nil, err
+				}
+				return r.Client.ApiKey.Query().
+					Where(apikey.HasUserWith(user.ID(target))).
+					Order(ent.Desc(apikey.FieldCreatedAt)).
+					All(p.Context)
+			},
+		},
+		"serviceAccounts": &graphql.Field{
+			Type: graphql.NewList(r.userType()),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				return r.Client.User.Query().
+					Where(user.HasServiceOwnerWith(user.ID(uid))).
+					Order(ent.Asc(user.FieldUsername)).
+					All(p.Context)
+			},
+		},
+	}
+}
+
+func (r *Resolver) apiKeyMutationFields() graphql.Fields {
+	return graphql.Fields{
+		"createServiceAccount": &graphql.Field{
+			Type:        r.userType(),
+			Description: "Creates a bot user owned by the caller. Service accounts cannot log in and authenticate with API keys only.",
+			Args: graphql.FieldConfigArgument{
+				"username":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"displayName": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"avatarUrl":   &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				username := strings.TrimSpace(p.Args["username"].(string))
+				if err := validateUsername(username); err != nil {
+					return nil, err
+				}
+				builder := r.Client.User.Create().
+					SetUsername(username).
+					SetDisplayName(p.Args["displayName"].(string)).
+					SetEmail(username + "@" + serviceAccountEmailDomain).
+					SetIsBot(true).
+					SetServiceOwnerID(uid)
+				if avatar, ok := p.Args["avatarUrl"].(string); ok && avatar != "" {
+					builder.SetAvatarURL(avatar)
+				}
+				bot, err := builder.Save(p.Context)
+				if ent.IsConstraintError(err) {
+					return nil, errors.New("username is already taken")
+				}
+				return bot, err
+			},
+		},
+		"createApiKey": &graphql.Field{
+			Type: r.createdAPIKeyType(),
+			Args: graphql.FieldConfigArgument{
+				"name":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"scopes":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String)))},
+				"expiresAt": &graphql.ArgumentConfig{Type: graphql.DateTime},
+				"userId":    &graphql.ArgumentConfig{Type: graphql.ID, Description: "A service account owned by the caller. Defaults to the caller."},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				target, err := r.apiKeyTarget(p.Context, uid, p.Args["userId"])
+				if err != nil {
+					return nil, err
+				}
+				var scopes []string
+				for _, scope := range p.Args["scopes"].([]interface{}) {
+					scopes = append(scopes, scope.(string))
+				}
+				if err := auth.ValidateScopes(scopes); err != nil {
+					return nil, err
+				}
+				key, prefix, hash, err := auth.NewAPIKey()
+				if err != nil {
+					return nil, err
+				}
+				builder := r.Client.ApiKey.Create().
+					SetName(p.Args["name"].(string)).
+					SetPrefix(prefix).
+					SetKeyHash(hash).
+					SetScopes(scopes).
+					SetUserID(target).
+					SetCreatedByID(uid)
+				if expiresAt, ok := p.Args["expiresAt"].(time.Time); ok {
+					if !expiresAt.After(time.Now()) {
+						return nil, errors.New("expiresAt must be in the future")
+					}
+					builder.SetExpiresAt(expiresAt)
+				}
+				created, err := builder.Save(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				return &createdAPIKey{Key: key, APIKey: created}, nil
+			},
+		},
+		"revokeApiKey": &graphql.Field{
+			Type: graphql.Boolean,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				id, err := decodeID(p.Args["id"])
+				if err != nil {
+					return nil, err
+				}
+				key, err := r.Client.ApiKey.Query().Where(apikey.ID(id)).WithUser().Only(p.Context)
+				if ent.IsNotFound(err) {
+					return nil, ErrForbidden
+				}
+				if err != nil {
+					return nil, err
+				}
+				if _, err := r.apiKeyTarget(p.Context, uid, key.Edges.User.ID); err != nil {
+					return nil, err
+				}
+				if key.RevokedAt != nil {
+					return true, nil
+				}
+				return true, key.Update().SetRevokedAt(time.Now()).Exec(p.Context)
+			},
+		},
+	}
+}
+
+// apiKeyTarget resolves whose API keys the caller is managing: their own when
+// raw is nil, otherwise a service account they own.
+func (r *Resolver) apiKeyTarget(ctx context.Context, callerID int, raw interface{}) (int, error) {
+	if raw == nil {
+		return callerID, nil
+	}
+	target, err := decodeID(raw)
+	if err != nil {
+		return 0, err
+	}
+	if target == callerID {
+		return target, nil
+	}
+	owned, err := r.Client.User.Query().
+		Where(user.ID(target), user.IsBot(true), user.HasServiceOwnerWith(user.ID(callerID))).
+		Exist(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !owned {
+		return 0, ErrForbidden
+	}
+	return target, nil
+}
+
+// withScopes guards every root field so API keys can only call the operations
+// their scopes allow. Access tokens pass through unchanged.
+func withScopes(fields graphql.Fields) graphql.Fields {
+	for name, field := range fields {
+		if field.Resolve == nil {
+			continue
+		}
+		scope := rootFieldScopes[name]
+		resolve := field.Resolve
+		field.Resolve = func(p graphql.ResolveParams) (interface{}, error) {
+			if claims, err := auth.ClaimsFromContext(p.Context); err == nil && !claims.HasScope(scope) {
+				return nil, auth.ErrInsufficientScope
+			}
+			return resolve(p)
+		}
+	}
+	return fields
+}
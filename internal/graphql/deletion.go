@@ -0,0 +1,556 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/apikey"
+	"github.com/eleven-am/enclave/ent/calllog"
+	"github.com/eleven-am/enclave/ent/callparticipant"
+	"github.com/eleven-am/enclave/ent/contact"
+	"github.com/eleven-am/enclave/ent/credential"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/emailtoken"
+	"github.com/eleven-am/enclave/ent/favourite"
+	"github.com/eleven-am/enclave/ent/identity"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/loginthrottle"
+	"github.com/eleven-am/enclave/ent/media"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/notification"
+	"github.com/eleven-am/enclave/ent/recoverycode"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/totpsecret"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// DefaultDeletionGracePeriod is how long a deleted account can still be
+// restored before it is purged.
+const DefaultDeletionGracePeriod = 14 * 24 * time.Hour
+
+// tombstoneEmailDomain is used for the placeholder email of the tombstone user.
+const tombstoneEmailDomain = "tombstones.invalid"
+
+// AccountExport is the JSON document a user can download before deleting their
+// account. Message bodies are end-to-end encrypted and are not included.
+type AccountExport struct {
+	ExportedAt  time.Time                 `json:"exportedAt"`
+	Profile     accountExportProfile      `json:"profile"`
+	Memberships []accountExportMembership `json:"memberships"`
+	Messages    []accountExportMessage    `json:"messages"`
+	Contacts    []accountExportContact    `json:"contacts"`
+	Favourites  []accountExportFavourite  `json:"favourites"`
+	Calls       []accountExportCall       `json:"calls"`
+}
+
+type accountExportProfile struct {
+	ID                  int        `json:"id"`
+	Username            string     `json:"username"`
+	DisplayName         string     `json:"displayName"`
+	Email               string     `json:"email"`
+	VerifiedAt          *time.Time `json:"verifiedAt,omitempty"`
+	AvatarURL           *string    `json:"avatarUrl,omitempty"`
+	Discoverability     string     `json:"discoverability"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	LastSeenAt          time.Time  `json:"lastSeenAt"`
+	DeletionScheduledAt *time.Time `json:"deletionScheduledAt,omitempty"`
+}
+
+type accountExportMembership struct {
+	RoomID   int       `json:"roomId"`
+	RoomName string    `json:"roomName"`
+	Role     string    `json:"role"`
+	CanPost  bool      `json:"canPost"`
+	CanCall  bool      `json:"canCall"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+type accountExportMessage struct {
+	ID                int       `json:"id"`
+	RoomID            int       `json:"roomId"`
+	ContentType       string    `json:"contentType"`
+	EncryptionScheme  string    `json:"encryptionScheme"`
+	EncryptionVersion *int      `json:"encryptionVersion,omitempty"`
+	Edited            bool      `json:"edited"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
+
+type accountExportContact struct {
+	UserID      int       `json:"userId"`
+	Username    string    `json:"username"`
+	Alias       string    `json:"alias"`
+	IsFavourite bool      `json:"isFavourite"`
+	IsBlocked   bool      `json:"isBlocked"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type accountExportFavourite struct {
+	RoomID    int       `json:"roomId"`
+	RoomName  string    `json:"roomName"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type accountExportCall struct {
+	ID        int        `json:"id"`
+	RoomID    *int       `json:"roomId,omitempty"`
+	Initiated bool       `json:"initiated"`
+	Role      string     `json:"role,omitempty"`
+	Status    string     `json:"status"`
+	StartedAt time.Time  `json:"startedAt"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+}
+
+func (r *Resolver) deletionMutationFields() graphql.Fields {
+	return graphql.Fields{
+		"cancelAccountDeletion": &graphql.Field{
+			Type:        r.userType(),
+			Description: "Keeps an account that is scheduled for deletion.",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				return r.Client.User.UpdateOneID(uid).ClearDeletionScheduledAt().Save(p.Context)
+			},
+		},
+	}
+}
+
+// resolveDeletionScheduledAt shows when an account will be purged only to its user.
+func resolveDeletionScheduledAt(p graphql.ResolveParams) (interface{}, error) {
+	usr := p.Source.(*ent.User)
+	uid, err := auth.UserIDFromContext(p.Context)
+	if err != nil || uid != usr.ID || usr.DeletionScheduledAt == nil {
+		return nil, nil
+	}
+	return *usr.DeletionScheduledAt, nil
+}
+
+// scheduleAccountDeletion marks the account for purging once the grace period
+// has passed. Scheduling again keeps the original date.
+func (r *Resolver) scheduleAccountDeletion(ctx context.Context, uid int) (*ent.User, error) {
+	usr, err := r.Client.User.Get(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if usr.DeletionScheduledAt != nil {
+		return usr, nil
+	}
+	return usr.Update().SetDeletionScheduledAt(time.Now().Add(r.deletionGrace)).Save(ctx)
+}
+
+// ExportAccount collects the rows that belong to a user.
+func (r *Resolver) ExportAccount(ctx context.Context, uid int) (*AccountExport, error) {
+	ctx = rule.SystemContext(ctx)
+	usr, err := r.Client.User.Get(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	export := &AccountExport{
+		ExportedAt: time.Now().UTC(),
+		Profile: accountExportProfile{
+			ID:                  usr.ID,
+			Username:            usr.Username,
+			DisplayName:         usr.DisplayName,
+			Email:               usr.Email,
+			VerifiedAt:          usr.VerifiedAt,
+			AvatarURL:           usr.AvatarURL,
+			Discoverability:     string(usr.Discoverability),
+			CreatedAt:           usr.CreatedAt,
+			LastSeenAt:          usr.LastSeenAt,
+			DeletionScheduledAt: usr.DeletionScheduledAt,
+		},
+		Memberships: []accountExportMembership{},
+		Messages:    []accountExportMessage{},
+		Contacts:    []accountExportContact{},
+		Favourites:  []accountExportFavourite{},
+		Calls:       []accountExportCall{},
+	}
+
+	memberships, err := r.Client.RoomMembership.Query().
+		Where(roommembership.HasUserWith(user.ID(uid))).
+		WithRoom().
+		Order(ent.Asc(roommembership.FieldJoinedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range memberships {
+		export.Memberships = append(export.Memberships, accountExportMembership{
+			RoomID:   m.Edges.Room.ID,
+			RoomName: m.Edges.Room.Name,
+			Role:     string(m.Role),
+			CanPost:  m.CanPost,
+			CanCall:  m.CanCall,
+			JoinedAt: m.JoinedAt,
+		})
+	}
+
+	messages, err := r.Client.Message.Query().
+		Where(message.HasSenderWith(user.ID(uid))).
+		WithRoom().
+		Order(ent.Asc(message.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range messages {
+		export.Messages = append(export.Messages, accountExportMessage{
+			ID:                msg.ID,
+			RoomID:            msg.Edges.Room.ID,
+			ContentType:       msg.ContentType,
+			EncryptionScheme:  msg.EncryptionScheme,
+			EncryptionVersion: msg.EncryptionVersion,
+			Edited:            msg.Edited,
+			CreatedAt:         msg.CreatedAt,
+			UpdatedAt:         msg.UpdatedAt,
+		})
+	}
+
+	contacts, err := r.Client.Contact.Query().
+		Where(contact.HasOwnerWith(user.ID(uid))).
+		WithContact().
+		Order(ent.Asc(contact.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range contacts {
+		export.Contacts = append(export.Contacts, accountExportContact{
+			UserID:      c.Edges.Contact.ID,
+			Username:    c.Edges.Contact.Username,
+			Alias:       c.Alias,
+			IsFavourite: c.IsFavourite,
+			IsBlocked:   c.IsBlocked,
+			CreatedAt:   c.CreatedAt,
+		})
+	}
+
+	favourites, err := r.Client.Favourite.Query().
+		Where(favourite.HasUserWith(user.ID(uid))).
+		WithRoom().
+		Order(ent.Asc(favourite.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range favourites {
+		export.Favourites = append(export.Favourites, accountExportFavourite{
+			RoomID:    f.Edges.Room.ID,
+			RoomName:  f.Edges.Room.Name,
+			CreatedAt: f.CreatedAt,
+		})
+	}
+
+	calls, err := r.Client.CallLog.Query().
+		Where(calllog.Or(
+			calllog.HasInitiatorWith(user.ID(uid)),
+			calllog.HasParticipantsWith(callparticipant.HasParticipantWith(user.ID(uid))),
+		)).
+		WithInitiator().
+		WithRoom().
+		WithParticipants(func(q *ent.CallParticipantQuery) {
+			q.Where(callparticipant.HasParticipantWith(user.ID(uid)))
+		}).
+		Order(ent.Asc(calllog.FieldStartedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, call := range calls {
+		entry := accountExportCall{
+			ID:        call.ID,
+			Initiated: call.Edges.Initiator != nil && call.Edges.Initiator.ID == uid,
+			Status:    string(call.Status),
+			StartedAt: call.StartedAt,
+			EndedAt:   call.EndedAt,
+		}
+		if call.Edges.Room != nil {
+			entry.RoomID = &call.Edges.Room.ID
+		}
+		if len(call.Edges.Participants) > 0 {
+			entry.Role = string(call.Edges.Participants[0].Role)
+		}
+		export.Calls = append(export.Calls, entry)
+	}
+	return export, nil
+}
+
+// PurgeDueAccounts purges every account whose deletion grace period ended
+// before now and returns how many were purged.
+func (r *Resolver) PurgeDueAccounts(ctx context.Context, now time.Time) (int, error) {
+	ctx = rule.SystemContext(ctx)
+	ids, err := r.Client.User.Query().
+		Where(user.DeletionScheduledAtLTE(now)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	var errs []error
+	for _, id := range ids {
+		if err := r.purgeAccount(ctx, id); err != nil {
+			errs = append(errs, fmt.Errorf("purging user %d: %w", id, err))
+			continue
+		}
+		purged++
+	}
+	return purged, errors.Join(errs...)
+}
+
+// purgeAccount removes a user. Owned rooms pass to another member or are
+// deleted when nobody else is left, authored messages, attachments and calls
+// move to the tombstone user, and everything else tied to the account is
+// deleted. Service accounts owned by the user are purged with it. The context
+// must already bypass privacy policies.
+func (r *Resolver) purgeAccount(ctx context.Context, uid int) (err error) {
+	bots, err := r.Client.User.Query().Where(user.HasServiceOwnerWith(user.ID(uid))).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, bot := range bots {
+		if err := r.purgeAccount(ctx, bot); err != nil {
+			return err
+		}
+	}
+	sessionIDs, err := r.Client.Session.Query().
+		Where(session.HasUserWith(user.ID(uid)), session.RevokedAtIsNil()).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer rollbackOnError(tx, &err)
+
+	tombstone, err := tombstoneTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+	owned, err := tx.Room.Query().Where(room.HasOwnerWith(user.ID(uid))).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, roomID := range owned {
+		if err = transferOrDeleteRoomTx(ctx, tx, roomID, uid); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Message.Update().
+		Where(message.HasSenderWith(user.ID(uid))).
+		SetSenderID(tombstone.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if err = tx.MlsCommit.Update().
+		Where(mlscommit.HasSenderWith(user.ID(uid))).
+		SetSenderID(tombstone.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if err = tx.MlsWelcome.Update().
+		Where(mlswelcome.HasSenderWith(user.ID(uid))).
+		SetSenderID(tombstone.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if err = tx.Media.Update().
+		Where(media.HasUploaderWith(user.ID(uid)), media.HasMessage()).
+		SetUploaderID(tombstone.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if err = tx.CallLog.Update().
+		Where(calllog.HasInitiatorWith(user.ID(uid))).
+		SetInitiatorID(tombstone.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if err = tx.CallParticipant.Update().
+		Where(callparticipant.HasParticipantWith(user.ID(uid))).
+		SetParticipantID(tombstone.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if err = tx.ApiKey.Update().
+		Where(apikey.HasCreatedByWith(user.ID(uid))).
+		ClearCreatedBy().
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if err = deleteDevicesTx(ctx, tx, device.HasUserWith(user.ID(uid))); err != nil {
+		return err
+	}
+	if err = rotateDeliveryTokens(ctx, tx.Client(), room.HasMembershipsWith(roommembership.HasUserWith(user.ID(uid)))); err != nil {
+		return err
+	}
+	if err = recordMLSRemovalsTx(ctx, tx, uid, room.HasMembershipsWith(roommembership.HasUserWith(user.ID(uid)))); err != nil {
+		return err
+	}
+	keyRooms, err := tx.Room.Query().
+		Where(room.HasMembershipsWith(roommembership.HasUserWith(user.ID(uid))), room.MlsGroupIDIsNil()).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	rotatedAt := time.Now()
+	for _, roomID := range keyRooms {
+		if err = rotateSenderKeysTx(ctx, tx, roomID, uid, rotatedAt); err != nil {
+			return err
+		}
+	}
+	deletions := []func() (int, error){
+		func() (int, error) {
+			return tx.Media.Delete().Where(media.HasUploaderWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.Notification.Delete().Where(notification.HasRecipientWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.IdentityKeyRecord.Delete().Where(identitykeyrecord.HasUserWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.SenderKeyDistribution.Delete().Where(senderkeydistribution.HasSenderWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.RoomMembership.Delete().Where(roommembership.HasUserWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.KeyBackupKey.Delete().
+				Where(keybackupkey.HasVersionWith(keybackupversion.HasOwnerWith(user.ID(uid)))).
+				Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.KeyBackupVersion.Delete().Where(keybackupversion.HasOwnerWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.Contact.Delete().
+				Where(contact.Or(contact.HasOwnerWith(user.ID(uid)), contact.HasContactWith(user.ID(uid)))).
+				Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.Favourite.Delete().Where(favourite.HasUserWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.Session.Delete().Where(session.HasUserWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.Credential.Delete().Where(credential.HasUserWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.Identity.Delete().Where(identity.HasUserWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.TotpSecret.Delete().Where(totpsecret.HasUserWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.RecoveryCode.Delete().Where(recoverycode.HasUserWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.ApiKey.Delete().Where(apikey.HasUserWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.EmailToken.Delete().Where(emailtoken.HasUserWith(user.ID(uid))).Exec(ctx)
+		},
+		func() (int, error) {
+			return tx.LoginThrottle.Delete().Where(loginthrottle.HasUserWith(user.ID(uid))).Exec(ctx)
+		},
+	}
+	for _, del := range deletions {
+		if _, err = del(); err != nil {
+			return err
+		}
+	}
+	if err = tx.User.DeleteOneID(uid).Exec(ctx); err != nil {
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	for _, roomID := range keyRooms {
+		r.notifySenderKeyRotation(ctx, roomID, uid, rotatedAt)
+	}
+	if len(sessionIDs) > 0 {
+		for _, listener := range r.sessionRevokedListeners {
+			listener(ctx, sessionIDs)
+		}
+	}
+	return nil
+}
+
+// transferOrDeleteRoomTx hands a room owned by a departing user to the longest
+// serving admin, or failing that the longest serving member. Rooms nobody else
+// belongs to are deleted.
+func transferOrDeleteRoomTx(ctx context.Context, tx *ent.Tx, roomID, ownerID int) error {
+	candidates := tx.RoomMembership.Query().
+		Where(
+			roommembership.HasRoomWith(room.ID(roomID)),
+			roommembership.HasUserWith(user.IDNEQ(ownerID), user.DeletionScheduledAtIsNil()),
+		).
+		Order(ent.Asc(roommembership.FieldJoinedAt))
+	successor, err := candidates.Clone().
+		Where(roommembership.RoleIn(roommembership.RoleOwner, roommembership.RoleAdmin)).
+		WithUser().
+		First(ctx)
+	if ent.IsNotFound(err) {
+		successor, err = candidates.Clone().WithUser().First(ctx)
+	}
+	if ent.IsNotFound(err) {
+		return deleteRoomTx(ctx, tx, roomID)
+	}
+	if err != nil {
+		return err
+	}
+	// The owner role follows the owner edge, so the edge moves first.
+	if err := tx.Room.UpdateOneID(roomID).
+		SetOwnerID(successor.Edges.User.ID).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return err
+	}
+	return tx.RoomMembership.UpdateOne(successor).
+		SetRole(roommembership.RoleOwner).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+}
+
+// tombstoneTx returns the user that stands in for deleted accounts on the
+// content they leave behind, creating it on first use.
+func tombstoneTx(ctx context.Context, tx *ent.Tx) (*ent.User, error) {
+	tombstone, err := tx.User.Query().Where(user.IsTombstone(true)).First(ctx)
+	if !ent.IsNotFound(err) {
+		return tombstone, err
+	}
+	suffix, err := auth.NewSecret(4)
+	if err != nil {
+		return nil, err
+	}
+	username := "deleted-user-" + suffix
+	return tx.User.Create().
+		SetUsername(username).
+		SetDisplayName("Deleted user").
+		SetEmail(username + "@" + tombstoneEmailDomain).
+		SetIsTombstone(true).
+		SetDiscoverability(user.DiscoverabilityNobody).
+		Save(ctx)
+}
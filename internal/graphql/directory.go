@@ -0,0 +1,207 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/contact"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// errInvalidCursor is returned for pagination cursors the server did not issue.
+var errInvalidCursor = errors.New("invalid cursor")
+
+// pageInfo describes the position of a page within a connection.
+type pageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+type userEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *ent.User `json:"node"`
+}
+
+type userConnection struct {
+	Edges    []*userEdge `json:"edges"`
+	PageInfo *pageInfo   `json:"pageInfo"`
+}
+
+func (r *Resolver) pageInfoType() *graphql.Object {
+	if r.pageInfoObj == nil {
+		r.pageInfoObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "PageInfo",
+			Fields: graphql.Fields{
+				"hasNextPage":     &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+				"hasPreviousPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Description: "Only set when paging backward with last or before."},
+				"startCursor":     &graphql.Field{Type: graphql.String},
+				"endCursor":       &graphql.Field{Type: graphql.String},
+			},
+		})
+	}
+	return r.pageInfoObj
+}
+
+func (r *Resolver) userConnectionType() *graphql.Object {
+	if r.userConnectionObj == nil {
+		edge := graphql.NewObject(graphql.ObjectConfig{
+			Name: "UserEdge",
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return graphql.Fields{
+					"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+					"node":   &graphql.Field{Type: graphql.NewNonNull(r.userType())},
+				}
+			}),
+		})
+		r.userConnectionObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "UserConnection",
+			Fields: graphql.Fields{
+				"edges":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edge)))},
+				"pageInfo": &graphql.Field{Type: graphql.NewNonNull(r.pageInfoType())},
+			},
+		})
+	}
+	return r.userConnectionObj
+}
+
+func (r *Resolver) directoryQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"searchUsers": &graphql.Field{
+			Type:        graphql.NewNonNull(r.userConnectionType()),
+			Description: "Finds users whose username or display name has a word starting with each search term. Users who opted out of the directory are not returned.",
+			Args: graphql.FieldConfigArgument{
+				"query": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"first": &graphql.ArgumentConfig{Type: graphql.Int},
+				"after": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				first := defaultSearchPageSize
+				if v, ok := p.Args["first"].(int); ok && v > 0 {
+					first = v
+				}
+				if first > maxSearchPageSize {
+					first = maxSearchPageSize
+				}
+				conn := &userConnection{Edges: []*userEdge{}, PageInfo: &pageInfo{}}
+				match, ok := r.directory.Match(p.Args["query"].(string))
+				if !ok {
+					return conn, nil
+				}
+				query := r.Client.User.Query().Where(match, discoverableBy(uid))
+				if raw, ok := p.Args["after"].(string); ok && raw != "" {
+					after, err := decodeCursor("user", raw)
+					if err != nil {
+						return nil, err
+					}
+					query.Where(user.UsernameGT(after))
+				}
+				users, err := query.Order(ent.Asc(user.FieldUsername)).Limit(first + 1).All(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				if len(users) > first {
+					users = users[:first]
+					conn.PageInfo.HasNextPage = true
+				}
+				for _, usr := range users {
+					conn.Edges = append(conn.Edges, &userEdge{Cursor: encodeCursor("user", usr.Username), Node: usr})
+				}
+				if n := len(conn.Edges); n > 0 {
+					conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+					conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+				}
+				return conn, nil
+			},
+		},
+	}
+}
+
+// discoverableBy matches the users the viewer may find in the directory: those
+// listed for everyone, those listed for contacts who added the viewer, and the
+// viewer themselves. Suspended users are never listed.
+func discoverableBy(viewerID int) predicate.User {
+	return user.And(
+		user.SuspendedAtIsNil(),
+		user.Or(
+			user.ID(viewerID),
+			user.DiscoverabilityEQ(user.DiscoverabilityEveryone),
+			user.And(
+				user.DiscoverabilityEQ(user.DiscoverabilityContacts),
+				user.HasContactsWith(contact.HasContactWith(user.ID(viewerID))),
+			),
+		),
+	)
+}
+
+// resolveEmail shows a user's email address only to that user and to admins.
+// Anonymous requests can only reach a user through the AuthPayload of a login
+// or registration, which always describes the caller.
+func resolveEmail(p graphql.ResolveParams) (interface{}, error) {
+	usr := p.Source.(*ent.User)
+	claims, err := auth.ClaimsFromContext(p.Context)
+	if err != nil {
+		return usr.Email, nil
+	}
+	if claims.UserID != usr.ID && !claims.IsAdmin() {
+		return nil, nil
+	}
+	return usr.Email, nil
+}
+
+// resolveVerifiedAt follows the visibility of the email address it describes.
+func resolveVerifiedAt(p graphql.ResolveParams) (interface{}, error) {
+	email, err := resolveEmail(p)
+	if email == nil || err != nil {
+		return nil, err
+	}
+	usr := p.Source.(*ent.User)
+	if usr.VerifiedAt == nil {
+		return nil, nil
+	}
+	return *usr.VerifiedAt, nil
+}
+
+// resolveDiscoverability shows a user's directory setting only to that user.
+func resolveDiscoverability(p graphql.ResolveParams) (interface{}, error) {
+	usr := p.Source.(*ent.User)
+	uid, err := auth.UserIDFromContext(p.Context)
+	if err != nil || uid != usr.ID {
+		return nil, nil
+	}
+	return usr.Discoverability, nil
+}
+
+// encodeCursor builds an opaque pagination cursor for a position in a listing
+// of the given kind.
+func encodeCursor(kind, value string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(kind + ":" + value))
+}
+
+func decodeCursor(kind, cursor string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", errInvalidCursor
+	}
+	value, ok := strings.CutPrefix(string(raw), kind+":")
+	if !ok {
+		return "", errInvalidCursor
+	}
+	return value, nil
+}
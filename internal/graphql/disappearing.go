@@ -0,0 +1,133 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/media"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/notification"
+	"github.com/eleven-am/enclave/internal/encryption"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+const (
+	// ContentTypeMessageTTLChanged is the content type of the message the
+	// server adds to a room's timeline when its disappearing message timer
+	// changes.
+	ContentTypeMessageTTLChanged = "application/vnd.enclave.message-ttl-changed+json"
+	// maxMessageTTL bounds room timers and per-message expiries, in seconds.
+	maxMessageTTL = 365 * 24 * 60 * 60
+	// reapBatchSize is how many expired messages are deleted per transaction.
+	reapBatchSize = 500
+)
+
+func validateMessageTTL(name string, seconds int) error {
+	if seconds < 1 || seconds > maxMessageTTL {
+		return fmt.Errorf("%s must be between 1 and %d seconds", name, maxMessageTTL)
+	}
+	return nil
+}
+
+// messageExpiry returns when a message sent to rm at now disappears. A
+// positive expiresIn overrides the room's timer.
+func messageExpiry(rm *ent.Room, expiresIn int, now time.Time) *time.Time {
+	ttl := expiresIn
+	if ttl == 0 && rm.MessageTTL != nil {
+		ttl = *rm.MessageTTL
+	}
+	if ttl == 0 {
+		return nil
+	}
+	expiresAt := now.Add(time.Duration(ttl) * time.Second)
+	return &expiresAt
+}
+
+// createMessageTTLNoticeTx records in the room's timeline that changedBy set
+// its disappearing message timer to ttl seconds, or turned it off when ttl is
+// nil. The notice is a message without a sender whose plain JSON cipherText
+// holds the new messageTtl and who changedBy. It does not expire.
+func createMessageTTLNoticeTx(ctx context.Context, tx *ent.Tx, roomID, changedBy int, ttl *int) (*ent.Message, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"messageTtl": ttl,
+		"changedBy":  strconv.Itoa(changedBy),
+	})
+	if err != nil {
+		return nil, err
+	}
+	seq, err := nextMessageSeqTx(ctx, tx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	return tx.Message.Create().
+		SetRoomID(roomID).
+		SetSeq(seq).
+		SetCipherText(string(payload)).
+		SetContentType(ContentTypeMessageTTLChanged).
+		SetEncryptionScheme(encryption.None).
+		Save(rule.SystemContext(ctx))
+}
+
+// ReapExpiredMessages deletes every message that expired before now, with
+// its envelopes, attachments and notifications, and returns how many
+// messages were deleted.
+func (r *Resolver) ReapExpiredMessages(ctx context.Context, now time.Time) (int, error) {
+	ctx = rule.SystemContext(ctx)
+	reaped := 0
+	for {
+		n, err := r.reapExpiredBatch(ctx, now)
+		reaped += n
+		if err != nil || n < reapBatchSize {
+			return reaped, err
+		}
+	}
+}
+
+// reapExpiredBatch deletes up to reapBatchSize expired messages in one
+// transaction and publishes their deletion. The context must already bypass
+// privacy policies.
+func (r *Resolver) reapExpiredBatch(ctx context.Context, now time.Time) (n int, err error) {
+	messages, err := r.Client.Message.Query().
+		Where(message.ExpiresAtLTE(now)).
+		WithRoom().
+		Limit(reapBatchSize).
+		All(ctx)
+	if err != nil || len(messages) == 0 {
+		return 0, err
+	}
+	ids := make([]int, 0, len(messages))
+	for _, msg := range messages {
+		ids = append(ids, msg.ID)
+	}
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	expired := message.IDIn(ids...)
+	if _, err = tx.MessageEnvelope.Delete().Where(messageenvelope.HasMessageWith(expired)).Exec(ctx); err != nil {
+		return 0, err
+	}
+	if _, err = tx.Media.Delete().Where(media.HasMessageWith(expired)).Exec(ctx); err != nil {
+		return 0, err
+	}
+	if _, err = tx.Notification.Delete().Where(notification.HasMessageWith(expired)).Exec(ctx); err != nil {
+		return 0, err
+	}
+	if n, err = tx.Message.Delete().Where(expired).Exec(ctx); err != nil {
+		return 0, err
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	for _, msg := range messages {
+		r.publishMessageEvent(ctx, &MessageEvent{Kind: MessageEventDeleted, RoomID: msg.Edges.Room.ID, MessageID: msg.ID})
+	}
+	return n, nil
+}
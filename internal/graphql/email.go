@@ -0,0 +1,269 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/credential"
+	"github.com/eleven-am/enclave/ent/emailtoken"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/internal/mail"
+)
+
+const (
+	// emailVerificationTTL is how long an address verification link stays valid.
+	emailVerificationTTL = 48 * time.Hour
+	// passwordResetTTL is how long a password reset link stays valid.
+	passwordResetTTL = time.Hour
+)
+
+// ErrInvalidEmailToken is returned for verification and reset tokens that are
+// unknown, expired or already used.
+var ErrInvalidEmailToken = errors.New("invalid or expired token")
+
+func (r *Resolver) emailMutationFields() graphql.Fields {
+	return graphql.Fields{
+		"sendVerificationEmail": &graphql.Field{
+			Type:        graphql.Boolean,
+			Description: "Mails a new verification link to the caller's address. Earlier links stop working.",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				usr, err := r.Client.User.Get(p.Context, uid)
+				if err != nil {
+					return nil, err
+				}
+				if usr.VerifiedAt != nil {
+					return nil, errors.New("email address is already verified")
+				}
+				if err := r.sendVerificationEmail(p.Context, usr); err != nil {
+					return nil, err
+				}
+				return true, nil
+			},
+		},
+		"verifyEmail": &graphql.Field{
+			Type: graphql.Boolean,
+			Args: graphql.FieldConfigArgument{
+				"token": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				tx, err := r.Client.Tx(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				defer rollbackOnError(tx, &err)
+				tok, err := consumeEmailToken(p.Context, tx, emailtoken.PurposeVerifyEmail, p.Args["token"].(string))
+				if err != nil {
+					return nil, err
+				}
+				usr := tok.Edges.User
+				if usr.Email != tok.Email {
+					err = ErrInvalidEmailToken
+					return nil, err
+				}
+				if usr.VerifiedAt == nil {
+					if err = tx.User.UpdateOne(usr).SetVerifiedAt(time.Now()).Exec(p.Context); err != nil {
+						return nil, err
+					}
+				}
+				if err = tx.Commit(); err != nil {
+					return nil, err
+				}
+				return true, nil
+			},
+		},
+		"requestPasswordReset": &graphql.Field{
+			Type:        graphql.Boolean,
+			Description: "Mails a password reset link if the address belongs to an account with a password. Always returns true so addresses cannot be probed.",
+			Args: graphql.FieldConfigArgument{
+				"email": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				email := strings.TrimSpace(p.Args["email"].(string))
+				usr, err := r.Client.User.Query().
+					Where(
+						user.Email(email),
+						user.HasCredential(),
+						user.SuspendedAtIsNil(),
+						user.IsTombstone(false),
+					).
+					Only(p.Context)
+				if ent.IsNotFound(err) {
+					return true, nil
+				}
+				if err != nil {
+					return nil, err
+				}
+				if err := r.sendPasswordReset(p.Context, usr); err != nil {
+					log.Printf("password reset for user %d: %v", usr.ID, err)
+				}
+				return true, nil
+			},
+		},
+		"resetPassword": &graphql.Field{
+			Type:        graphql.Boolean,
+			Description: "Sets a new password with a token from requestPasswordReset and signs out every session.",
+			Args: graphql.FieldConfigArgument{
+				"token":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"newPassword": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				tx, err := r.Client.Tx(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				defer rollbackOnError(tx, &err)
+				tok, err := consumeEmailToken(p.Context, tx, emailtoken.PurposeResetPassword, p.Args["token"].(string))
+				if err != nil {
+					return nil, err
+				}
+				usr := tok.Edges.User
+				newPassword := p.Args["newPassword"].(string)
+				if err = auth.ValidatePassword(newPassword, usr.Username, usr.Email); err != nil {
+					return nil, err
+				}
+				hash, err := auth.HashPassword(newPassword)
+				if err != nil {
+					return nil, err
+				}
+				cred, err := tx.Credential.Query().Where(credential.HasUserWith(user.ID(usr.ID))).Only(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				err = tx.Credential.UpdateOne(cred).
+					SetPasswordHash(hash).
+					SetPasswordUpdatedAt(time.Now()).
+					Exec(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				// The link reached the mailbox, which verifies the address too.
+				if usr.VerifiedAt == nil && usr.Email == tok.Email {
+					if err = tx.User.UpdateOne(usr).SetVerifiedAt(time.Now()).Exec(p.Context); err != nil {
+						return nil, err
+					}
+				}
+				if err = tx.Commit(); err != nil {
+					return nil, err
+				}
+				if _, err := r.revokeSessions(p.Context, "password reset", session.HasUserWith(user.ID(usr.ID))); err != nil {
+					return nil, err
+				}
+				return true, nil
+			},
+		},
+	}
+}
+
+// sendVerificationEmail mails a link that verifies the user's current address.
+func (r *Resolver) sendVerificationEmail(ctx context.Context, usr *ent.User) error {
+	token, err := r.issueEmailToken(ctx, usr, emailtoken.PurposeVerifyEmail, emailVerificationTTL)
+	if err != nil {
+		return err
+	}
+	body := fmt.Sprintf("Hi %s,\n\nConfirm that this is your email address:\n\n%s\n\nThe link expires in %s. If you did not create an account, ignore this email.\n",
+		usr.DisplayName, r.emailLink("/verify-email", token), formatTTL(emailVerificationTTL))
+	return r.mailer.Send(ctx, mail.Message{To: usr.Email, Subject: "Verify your email address", Body: body})
+}
+
+// sendPasswordReset mails a link that lets the user choose a new password.
+func (r *Resolver) sendPasswordReset(ctx context.Context, usr *ent.User) error {
+	token, err := r.issueEmailToken(ctx, usr, emailtoken.PurposeResetPassword, passwordResetTTL)
+	if err != nil {
+		return err
+	}
+	body := fmt.Sprintf("Hi %s,\n\nSomeone asked to reset the password of your account %q. Choose a new password here:\n\n%s\n\nThe link expires in %s. If it was not you, ignore this email and your password stays the same.\n",
+		usr.DisplayName, usr.Username, r.emailLink("/reset-password", token), formatTTL(passwordResetTTL))
+	return r.mailer.Send(ctx, mail.Message{To: usr.Email, Subject: "Reset your password", Body: body})
+}
+
+// issueEmailToken stores a new token for the user and returns its secret.
+// Unused tokens issued earlier for the same purpose are discarded.
+func (r *Resolver) issueEmailToken(ctx context.Context, usr *ent.User, purpose emailtoken.Purpose, ttl time.Duration) (string, error) {
+	secret, err := auth.NewSecret(32)
+	if err != nil {
+		return "", err
+	}
+	_, err = r.Client.EmailToken.Delete().
+		Where(
+			emailtoken.HasUserWith(user.ID(usr.ID)),
+			emailtoken.PurposeEQ(purpose),
+			emailtoken.UsedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+	err = r.Client.EmailToken.Create().
+		SetUser(usr).
+		SetPurpose(purpose).
+		SetTokenHash(auth.HashToken(secret)).
+		SetEmail(usr.Email).
+		SetExpiresAt(time.Now().Add(ttl)).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// consumeEmailToken marks a token as used and returns it with its user. The
+// update is conditional on the token still being unused and comes before any
+// read, so concurrent requests wait for the transaction's write lock and only
+// the first can redeem it.
+func consumeEmailToken(ctx context.Context, tx *ent.Tx, purpose emailtoken.Purpose, secret string) (*ent.EmailToken, error) {
+	now := time.Now()
+	hash := auth.HashToken(secret)
+	n, err := tx.EmailToken.Update().
+		Where(
+			emailtoken.TokenHash(hash),
+			emailtoken.PurposeEQ(purpose),
+			emailtoken.UsedAtIsNil(),
+			emailtoken.ExpiresAtGT(now),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrInvalidEmailToken
+	}
+	return tx.EmailToken.Query().
+		Where(emailtoken.TokenHash(hash), emailtoken.PurposeEQ(purpose)).
+		WithUser().
+		Only(ctx)
+}
+
+// emailLink points at a page of the client app that submits token, or is the
+// bare token when no app URL is configured.
+func (r *Resolver) emailLink(path, token string) string {
+	if r.appURL == "" {
+		return token
+	}
+	return strings.TrimRight(r.appURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+func formatTTL(ttl time.Duration) string {
+	if ttl >= time.Hour && ttl%time.Hour == 0 {
+		hours := int(ttl / time.Hour)
+		if hours == 1 {
+			return "1 hour"
+		}
+		return fmt.Sprintf("%d hours", hours)
+	}
+	return ttl.String()
+}
@@ -0,0 +1,57 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eleven-am/enclave/ent/emailtoken"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+func TestVerifyEmailTokenIsSingleUse(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := rule.SystemContext(context.Background())
+	usr, err := env.client.User.Get(ctx, env.addUser("alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := env.r.issueEmailToken(ctx, usr, emailtoken.PurposeVerifyEmail, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := fmt.Sprintf(`mutation { verifyEmail(token: %q) }`, token)
+
+	const redemptions = 8
+	errs := make([]error, redemptions)
+	var wg sync.WaitGroup
+	for i := 0; i < redemptions; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = env.exec(0, query)
+		}(i)
+	}
+	wg.Wait()
+	redeemed := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			redeemed++
+		case !errors.Is(err, ErrInvalidEmailToken):
+			t.Fatalf("redemption returned %v, want %v", err, ErrInvalidEmailToken)
+		}
+	}
+	if redeemed != 1 {
+		t.Fatalf("token was redeemed %d times, want once", redeemed)
+	}
+	if _, err := env.exec(0, query); !errors.Is(err, ErrInvalidEmailToken) {
+		t.Fatalf("later redemption returned %v, want %v", err, ErrInvalidEmailToken)
+	}
+	if usr, err = env.client.User.Get(ctx, usr.ID); err != nil || usr.VerifiedAt == nil {
+		t.Fatalf("address is not verified (%v)", err)
+	}
+}
@@ -0,0 +1,394 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// DeviceAddress names one device of one user, as in a Signal protocol address.
+type DeviceAddress struct {
+	UserID   int
+	DeviceID int
+}
+
+// DeviceMismatchError rejects a send whose envelopes do not match the
+// registered devices of the room's members. Clients refresh their device list
+// from Missing and Extra and retry.
+type DeviceMismatchError struct {
+	// Missing are registered devices the send has no envelope for.
+	Missing []DeviceAddress
+	// Extra are envelopes for devices that are not registered or not in the room.
+	Extra []DeviceAddress
+}
+
+func (e *DeviceMismatchError) Error() string {
+	return fmt.Sprintf("message envelopes do not match the room's devices: %d missing, %d extra", len(e.Missing), len(e.Extra))
+}
+
+// Extensions lists the mismatched devices in the GraphQL error.
+func (e *DeviceMismatchError) Extensions() map[string]interface{} {
+	list := func(addresses []DeviceAddress) []map[string]interface{} {
+		out := make([]map[string]interface{}, 0, len(addresses))
+		for _, a := range addresses {
+			out = append(out, map[string]interface{}{"userId": strconv.Itoa(a.UserID), "deviceId": a.DeviceID})
+		}
+		return out
+	}
+	return map[string]interface{}{
+		"code":           "DEVICE_MISMATCH",
+		"missingDevices": list(e.Missing),
+		"extraDevices":   list(e.Extra),
+	}
+}
+
+// envelopeInput is one decoded MessageEnvelopeInput.
+type envelopeInput struct {
+	address    DeviceAddress
+	cipherText string
+}
+
+func (r *Resolver) messageEnvelopeType() *graphql.Object {
+	if r.messageEnvelopeObj == nil {
+		r.messageEnvelopeObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "MessageEnvelope",
+			Fields: graphql.Fields{
+				"id":         &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"cipherText": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("CipherText")},
+				"createdAt":  &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+			},
+		})
+	}
+	return r.messageEnvelopeObj
+}
+
+func (r *Resolver) messageEnvelopeInputType() *graphql.InputObject {
+	if r.messageEnvelopeInputObj == nil {
+		r.messageEnvelopeInputObj = graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: "MessageEnvelopeInput",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"userId":     &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"deviceId":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"cipherText": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String), Description: "The message encrypted for this device."},
+			},
+		})
+	}
+	return r.messageEnvelopeInputObj
+}
+
+// resolveMessageEnvelope returns the envelope of a message addressed to one of
+// the viewer's devices, or nil if there is none.
+func (r *Resolver) resolveMessageEnvelope(p graphql.ResolveParams) (interface{}, error) {
+	uid, err := auth.UserIDFromContext(p.Context)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+	env, err := r.Client.MessageEnvelope.Query().
+		Where(
+			messageenvelope.HasMessageWith(message.ID(p.Source.(*ent.Message).ID)),
+			messageenvelope.HasDeviceWith(device.DeviceID(p.Args["deviceId"].(int)), device.HasUserWith(user.ID(uid))),
+		).
+		Only(p.Context)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return env, err
+}
+
+// readableBy matches the messages one of the user's devices can read: messages
+// sent without envelopes, those with an envelope for the device, and those the
+// device sent itself.
+func readableBy(uid, deviceID int) predicate.Message {
+	return message.Or(
+		message.CipherTextNotNil(),
+		message.HasEnvelopesWith(messageenvelope.HasDeviceWith(device.DeviceID(deviceID), device.HasUserWith(user.ID(uid)))),
+		message.And(message.HasSenderWith(user.ID(uid)), message.SenderDeviceID(deviceID)),
+	)
+}
+
+// outgoingMessage holds the createMessage arguments that do not depend on
+// how the sender is identified.
+type outgoingMessage struct {
+	cipherText string
+	// envelopes is nil for messages sent with a single cipherText.
+	envelopes         []envelopeInput
+	contentType       string
+	encryptionScheme  string
+	encryptionVersion int
+	expiresIn         int
+}
+
+func decodeOutgoingMessage(args map[string]interface{}) (outgoingMessage, error) {
+	var out outgoingMessage
+	out.contentType, _ = args["contentType"].(string)
+	out.encryptionScheme, out.encryptionVersion = declaredEncryption(args)
+	if expiresIn, ok := args["expiresIn"].(int); ok {
+		if err := validateMessageTTL("expiresIn", expiresIn); err != nil {
+			return out, err
+		}
+		out.expiresIn = expiresIn
+	}
+	cipherText, hasCipherText := args["cipherText"].(string)
+	rawEnvelopes, hasEnvelopes := args["envelopes"].([]interface{})
+	if hasCipherText == hasEnvelopes {
+		return out, errors.New("exactly one of cipherText and envelopes is required")
+	}
+	if hasEnvelopes {
+		envelopes, err := decodeEnvelopes(rawEnvelopes)
+		if err != nil {
+			return out, err
+		}
+		out.envelopes = envelopes
+	}
+	out.cipherText = cipherText
+	return out, nil
+}
+
+// createEnvelopeMessage sends a message as one envelope per recipient device.
+// The envelopes must cover every registered device of the room's members
+// except the sending device, and nothing else.
+func (r *Resolver) createEnvelopeMessage(ctx context.Context, uid, roomID, senderDeviceID int, out outgoingMessage) (msg *ent.Message, err error) {
+	if _, err := r.ownDevice(ctx, uid, senderDeviceID); err != nil {
+		return nil, err
+	}
+	rm, err := r.Client.Room.Get(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if rm.MlsGroupID != nil {
+		return nil, errors.New("messages to MLS rooms are encrypted for the group; send a single cipherText")
+	}
+	scheme, version, err := roomEncryption(rm, out.encryptionScheme, out.encryptionVersion)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	seq, err := nextMessageSeqTx(ctx, tx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	builder := tx.Message.Create().
+		SetRoomID(roomID).
+		SetSenderID(uid).
+		SetSenderDeviceID(senderDeviceID).
+		SetSeq(seq).
+		SetEncryptionScheme(scheme).
+		SetEncryptionVersion(version).
+		SetNillableExpiresAt(messageExpiry(rm, out.expiresIn, time.Now()))
+	if out.contentType != "" {
+		builder.SetContentType(out.contentType)
+	}
+	if msg, err = builder.Save(ctx); err != nil {
+		return nil, err
+	}
+	if err = attachEnvelopesTx(ctx, tx, msg, roomID, DeviceAddress{UserID: uid, DeviceID: senderDeviceID}, out.envelopes); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	msg = msg.Unwrap()
+	r.publishMessageEvent(ctx, &MessageEvent{Kind: MessageEventCreated, RoomID: roomID, MessageID: msg.ID, Message: msg})
+	return msg, nil
+}
+
+// updateEnvelopeMessage edits a message sent as envelopes by replacing all of
+// its envelopes, checked against the room's current devices and encryption
+// policy. Without a declared scheme the message keeps its current one.
+func (r *Resolver) updateEnvelopeMessage(ctx context.Context, uid, id, senderDeviceID int, envelopes []envelopeInput, contentType, encryptionScheme string, encryptionVersion int) (msg *ent.Message, err error) {
+	if _, err := r.ownDevice(ctx, uid, senderDeviceID); err != nil {
+		return nil, err
+	}
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	msg, err = tx.Message.Query().Where(message.ID(id)).WithRoom().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.CipherText != "" {
+		err = errors.New("message was not sent as envelopes; edit its cipherText")
+		return nil, err
+	}
+	encryptionScheme, encryptionVersion = editedEncryption(msg, encryptionScheme, encryptionVersion)
+	scheme, version, err := roomEncryption(msg.Edges.Room, encryptionScheme, encryptionVersion)
+	if err != nil {
+		return nil, err
+	}
+	roomID := msg.Edges.Room.ID
+	update := tx.Message.UpdateOne(msg).
+		SetEdited(true).
+		SetSenderDeviceID(senderDeviceID).
+		SetEncryptionScheme(scheme).
+		SetEncryptionVersion(version)
+	if contentType != "" {
+		update.SetContentType(contentType)
+	}
+	if msg, err = update.Save(ctx); err != nil {
+		return nil, err
+	}
+	if _, err = tx.MessageEnvelope.Delete().Where(messageenvelope.HasMessageWith(message.ID(id))).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if err = attachEnvelopesTx(ctx, tx, msg, roomID, DeviceAddress{UserID: uid, DeviceID: senderDeviceID}, envelopes); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	if msg, err = r.Client.Message.Query().Where(message.ID(id)).WithSender().Only(ctx); err != nil {
+		return nil, err
+	}
+	r.publishMessageEvent(ctx, &MessageEvent{Kind: MessageEventEdited, RoomID: roomID, MessageID: id, Message: msg})
+	return msg, nil
+}
+
+// attachEnvelopesTx checks envelopes against the devices of the room's members
+// and stores them for msg.
+func attachEnvelopesTx(ctx context.Context, tx *ent.Tx, msg *ent.Message, roomID int, sender DeviceAddress, envelopes []envelopeInput) error {
+	devices, err := matchRoomDevicesTx(ctx, tx, roomID, sender, envelopes)
+	if err != nil {
+		return err
+	}
+	if len(envelopes) == 0 {
+		return nil
+	}
+	builders := make([]*ent.MessageEnvelopeCreate, 0, len(envelopes))
+	for _, env := range envelopes {
+		builders = append(builders, tx.MessageEnvelope.Create().
+			SetMessage(msg).
+			SetDevice(devices[env.address]).
+			SetCipherText(env.cipherText))
+	}
+	return tx.MessageEnvelope.CreateBulk(builders...).Exec(ctx)
+}
+
+// matchRoomDevicesTx checks that envelopes address every registered device of
+// the room's members except the sending one, each exactly once, and returns
+// the devices by address. The send must already be authorized, so the room's
+// devices are read without privacy checks.
+func matchRoomDevicesTx(ctx context.Context, tx *ent.Tx, roomID int, sender DeviceAddress, envelopes []envelopeInput) (map[DeviceAddress]*ent.Device, error) {
+	devices, err := tx.Device.Query().
+		Where(device.HasUserWith(user.HasMembershipsWith(roommembership.HasRoomWith(room.ID(roomID))))).
+		WithUser().
+		All(rule.SystemContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	expected := make(map[DeviceAddress]*ent.Device, len(devices))
+	for _, dev := range devices {
+		addr := DeviceAddress{UserID: dev.Edges.User.ID, DeviceID: dev.DeviceID}
+		if addr != sender {
+			expected[addr] = dev
+		}
+	}
+	mismatch := &DeviceMismatchError{}
+	given := make(map[DeviceAddress]bool, len(envelopes))
+	for _, env := range envelopes {
+		if given[env.address] {
+			return nil, fmt.Errorf("more than one envelope for device %d of user %d", env.address.DeviceID, env.address.UserID)
+		}
+		given[env.address] = true
+		if _, ok := expected[env.address]; !ok {
+			mismatch.Extra = append(mismatch.Extra, env.address)
+		}
+	}
+	for addr := range expected {
+		if !given[addr] {
+			mismatch.Missing = append(mismatch.Missing, addr)
+		}
+	}
+	if len(mismatch.Missing) > 0 || len(mismatch.Extra) > 0 {
+		sortDeviceAddresses(mismatch.Missing)
+		sortDeviceAddresses(mismatch.Extra)
+		return nil, mismatch
+	}
+	return expected, nil
+}
+
+// deleteMessage removes a message and its envelopes. Envelopes addressed to
+// other users are invisible to the viewer, so they are deleted without privacy
+// checks; the message delete that follows is still authorized and rolls them
+// back when it is denied.
+func (r *Resolver) deleteMessage(ctx context.Context, id int) (err error) {
+	// The room is looked up only to publish the deletion; the delete below
+	// decides whether the viewer may make it.
+	roomID, err := r.Client.Message.Query().Where(message.ID(id)).QueryRoom().OnlyID(rule.SystemContext(ctx))
+	if err != nil {
+		return err
+	}
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer rollbackOnError(tx, &err)
+
+	if _, err = tx.MessageEnvelope.Delete().
+		Where(messageenvelope.HasMessageWith(message.ID(id))).
+		Exec(rule.SystemContext(ctx)); err != nil {
+		return err
+	}
+	if err = tx.Message.DeleteOneID(id).Exec(ctx); err != nil {
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	r.publishMessageEvent(ctx, &MessageEvent{Kind: MessageEventDeleted, RoomID: roomID, MessageID: id})
+	return nil
+}
+
+func decodeEnvelopes(raw []interface{}) ([]envelopeInput, error) {
+	envelopes := make([]envelopeInput, 0, len(raw))
+	for _, item := range raw {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("invalid message envelope")
+		}
+		userID, err := decodeID(fields["userId"])
+		if err != nil {
+			return nil, err
+		}
+		env := envelopeInput{address: DeviceAddress{UserID: userID}}
+		env.address.DeviceID, _ = fields["deviceId"].(int)
+		env.cipherText, _ = fields["cipherText"].(string)
+		if env.cipherText == "" {
+			return nil, errors.New("message envelopes need a cipher text")
+		}
+		envelopes = append(envelopes, env)
+	}
+	return envelopes, nil
+}
+
+func sortDeviceAddresses(addresses []DeviceAddress) {
+	sort.Slice(addresses, func(i, j int) bool {
+		if addresses[i].UserID != addresses[j].UserID {
+			return addresses[i].UserID < addresses[j].UserID
+		}
+		return addresses[i].DeviceID < addresses[j].DeviceID
+	})
+}
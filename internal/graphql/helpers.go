@@ -2,9 +2,13 @@ package graphql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/graphql-go/graphql"
+
 	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/privacy"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/roommembership"
 	"github.com/eleven-am/enclave/ent/user"
@@ -39,15 +43,18 @@ func (r *Resolver) ensureRoomAdmin(ctx context.Context, roomID, userID int) erro
 	return ErrForbidden
 }
 
+// ensureRoomOwner follows the room's owner edge rather than membership roles.
 func (r *Resolver) ensureRoomOwner(ctx context.Context, roomID, userID int) error {
-	membership, err := r.ensureRoomMember(ctx, roomID, userID)
+	owned, err := r.Client.Room.Query().
+		Where(room.ID(roomID), room.HasOwnerWith(user.ID(userID))).
+		Exist(ctx)
 	if err != nil {
 		return err
 	}
-	if membership.Role == roommembership.RoleOwner {
-		return nil
+	if !owned {
+		return ErrForbidden
 	}
-	return ErrForbidden
+	return nil
 }
 
 func rollbackOnError(tx *ent.Tx, errPtr *error) {
@@ -58,3 +65,33 @@ func rollbackOnError(tx *ent.Tx, errPtr *error) {
 		*errPtr = fmt.Errorf("rollback failed: %w (original error: %v)", rollbackErr, *errPtr)
 	}
 }
+
+// mergeFields copies every field from extra into base, so feature-specific
+// operations can live next to their types instead of in one giant literal.
+func mergeFields(base graphql.Fields, extra ...graphql.Fields) graphql.Fields {
+	for _, fields := range extra {
+		for name, field := range fields {
+			base[name] = field
+		}
+	}
+	return base
+}
+
+// withPrivacyErrors reports operations rejected by an ent privacy policy as
+// ErrForbidden instead of leaking the rule that denied them.
+func withPrivacyErrors(fields graphql.Fields) graphql.Fields {
+	for _, field := range fields {
+		if field.Resolve == nil {
+			continue
+		}
+		resolve := field.Resolve
+		field.Resolve = func(p graphql.ResolveParams) (interface{}, error) {
+			result, err := resolve(p)
+			if errors.Is(err, privacy.Deny) {
+				return nil, ErrForbidden
+			}
+			return result, err
+		}
+	}
+	return fields
+}
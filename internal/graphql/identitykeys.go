@@ -0,0 +1,184 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/internal/rule"
+	"github.com/eleven-am/enclave/transparency"
+)
+
+// NotificationKindIdentityKeyChanged is the kind of the notification sent to
+// everyone sharing a room with a user whose device changed its identity key.
+const NotificationKindIdentityKeyChanged = "keys.identity_changed"
+
+// identityKeyEntry is one key in a device's identity key history.
+type identityKeyEntry struct {
+	DeviceID   int        `json:"deviceId"`
+	PublicKey  string     `json:"publicKey"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ReplacedAt *time.Time `json:"replacedAt"`
+}
+
+func (r *Resolver) identityKeyRecordType() *graphql.Object {
+	if r.identityKeyRecordObj == nil {
+		r.identityKeyRecordObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "IdentityKeyRecord",
+			Fields: graphql.Fields{
+				"deviceId":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"publicKey":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"createdAt":  &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Description: "When the device started using the key."},
+				"replacedAt": &graphql.Field{Type: graphql.DateTime, Description: "When the device switched to its next key; null for the current key."},
+			},
+		})
+	}
+	return r.identityKeyRecordObj
+}
+
+func (r *Resolver) identityKeyQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"identityKeyHistory": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.identityKeyRecordType()))),
+			Description: "Lists every identity key the user's devices have used, including removed devices, ordered by device and then by age.",
+			Args: graphql.FieldConfigArgument{
+				"userId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				userID, err := decodeID(p.Args["userId"])
+				if err != nil {
+					return nil, err
+				}
+				return r.identityKeyHistory(p.Context, userID)
+			},
+		},
+	}
+}
+
+func (r *Resolver) identityKeyHistory(ctx context.Context, userID int) ([]*identityKeyEntry, error) {
+	records, err := r.Client.IdentityKeyRecord.Query().
+		Where(identitykeyrecord.HasUserWith(user.ID(userID))).
+		Order(ent.Asc(identitykeyrecord.FieldCreatedAt), ent.Asc(identitykeyrecord.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*identityKeyEntry, 0, len(records))
+	latest := make(map[int]*identityKeyEntry)
+	for _, rec := range records {
+		entry := &identityKeyEntry{
+			DeviceID:  rec.DeviceNumber,
+			PublicKey: rec.PublicKey,
+			CreatedAt: rec.CreatedAt,
+		}
+		if prev := latest[entry.DeviceID]; prev != nil {
+			replacedAt := rec.CreatedAt
+			prev.ReplacedAt = &replacedAt
+		}
+		latest[entry.DeviceID] = entry
+		entries = append(entries, entry)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].DeviceID < entries[j].DeviceID
+	})
+	return entries, nil
+}
+
+// recordIdentityKeyTx appends a key to the device's identity key history and
+// publishes it in the key transparency log.
+func recordIdentityKeyTx(ctx context.Context, tx *ent.Tx, userID int, dev *ent.Device, publicKey string, since time.Time) error {
+	err := tx.IdentityKeyRecord.Create().
+		SetUserID(userID).
+		SetDeviceID(dev.ID).
+		SetDeviceNumber(dev.DeviceID).
+		SetPublicKey(publicKey).
+		SetCreatedAt(since).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return appendKeyLogTx(ctx, tx, transparency.KeyEntry{
+		UserID:      userID,
+		DeviceID:    dev.DeviceID,
+		IdentityKey: publicKey,
+		Timestamp:   since,
+	})
+}
+
+// lastIdentityKeyTx returns the latest key in the history of the user's
+// device number, which may belong to a device that has since been removed.
+// It returns nil if the number was never used.
+func lastIdentityKeyTx(ctx context.Context, tx *ent.Tx, userID, deviceNumber int) (*ent.IdentityKeyRecord, error) {
+	rec, err := tx.IdentityKeyRecord.Query().
+		Where(identitykeyrecord.HasUserWith(user.ID(userID)), identitykeyrecord.DeviceNumber(deviceNumber)).
+		Order(ent.Desc(identitykeyrecord.FieldCreatedAt), ent.Desc(identitykeyrecord.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return rec, err
+}
+
+// BackfillIdentityKeyRecords fills in the user and device number of history
+// records stored before records outlived their device. It is run at startup.
+func BackfillIdentityKeyRecords(ctx context.Context, client *ent.Client) error {
+	ctx = rule.SystemContext(ctx)
+	devices, err := client.Device.Query().
+		Where(device.HasIdentityKeyHistoryWith(identitykeyrecord.Not(identitykeyrecord.HasUser()))).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, dev := range devices {
+		err := client.IdentityKeyRecord.Update().
+			Where(identitykeyrecord.HasDeviceWith(device.ID(dev.ID)), identitykeyrecord.Not(identitykeyrecord.HasUser())).
+			SetUserID(dev.Edges.User.ID).
+			SetDeviceNumber(dev.DeviceID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("device %d: %w", dev.ID, err)
+		}
+	}
+	return nil
+}
+
+// notifyIdentityKeyChanged tells everyone who shares a room with the user that
+// one of their devices now uses a different identity key, so they can verify
+// the new safety number.
+func (r *Resolver) notifyIdentityKeyChanged(ctx context.Context, userID, deviceID int, publicKey string) {
+	sys := rule.SystemContext(ctx)
+	shared := roommembership.HasRoomWith(room.HasMembershipsWith(roommembership.HasUserWith(user.ID(userID))))
+	recipients, err := r.Client.User.Query().
+		Where(user.HasMembershipsWith(shared), user.IDNEQ(userID)).
+		IDs(sys)
+	if err != nil {
+		log.Printf("identity key change notices for user %d: %v", userID, err)
+		return
+	}
+	payload := map[string]interface{}{
+		"userId":      strconv.Itoa(userID),
+		"deviceId":    deviceID,
+		"identityKey": publicKey,
+	}
+	for _, recipient := range recipients {
+		if err := r.publishServerNotification(ctx, recipient, NotificationKindIdentityKeyChanged, payload); err != nil {
+			log.Printf("identity key change notice for user %d: %v", recipient, err)
+		}
+	}
+}
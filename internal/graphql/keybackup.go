@@ -0,0 +1,538 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/internal/auth"
+)
+
+const (
+	// maxBackupAlgorithmLength bounds the name of a key backup algorithm.
+	maxBackupAlgorithmLength = 128
+	// maxBackupAuthDataLength bounds a key backup's public auth data.
+	maxBackupAuthDataLength = 16 << 10
+	// maxBackupSessionIDLength bounds the session IDs of backed up keys.
+	maxBackupSessionIDLength = 256
+	// maxBackupSessionDataLength bounds one encrypted session key.
+	maxBackupSessionDataLength = 64 << 10
+	// maxBackupKeysPerUpload bounds how many keys one upload may store.
+	maxBackupKeysPerUpload = 1000
+)
+
+// ErrKeyBackupNotFound is returned for key backup versions that do not exist
+// or belong to another user.
+var ErrKeyBackupNotFound = errors.New("key backup version not found")
+
+// WrongKeyBackupVersionError rejects an upload to a key backup version other
+// than the caller's latest one. The client checks the current version's auth
+// data before trusting it and uploads there instead.
+type WrongKeyBackupVersionError struct {
+	Version string
+	Current string
+}
+
+func (e *WrongKeyBackupVersionError) Error() string {
+	if e.Current == "" {
+		return fmt.Sprintf("key backup version %s is not current; no key backup exists", e.Version)
+	}
+	return fmt.Sprintf("key backup version %s is not current; the current version is %s", e.Version, e.Current)
+}
+
+// Extensions reports the caller's current version in the GraphQL error.
+func (e *WrongKeyBackupVersionError) Extensions() map[string]interface{} {
+	var current interface{}
+	if e.Current != "" {
+		current = e.Current
+	}
+	return map[string]interface{}{
+		"code":           "WRONG_KEY_BACKUP_VERSION",
+		"currentVersion": current,
+	}
+}
+
+// backupKeyInput is one session key in an upload.
+type backupKeyInput struct {
+	roomID            int
+	sessionID         string
+	firstMessageIndex int
+	forwardedCount    int
+	isVerified        bool
+	sessionData       string
+}
+
+func (r *Resolver) keyBackupVersionType() *graphql.Object {
+	if r.keyBackupVersionObj == nil {
+		r.keyBackupVersionObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "KeyBackupVersion",
+			Fields: graphql.Fields{
+				"version": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return strconv.Itoa(p.Source.(*ent.KeyBackupVersion).ID), nil
+				}},
+				"algorithm": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("Algorithm")},
+				"authData":  &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "The algorithm's public parameters as JSON, such as the backup public key and its signatures.", Resolve: resolveStringField("AuthData")},
+				"etag": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "Changes whenever keys are stored in or deleted from the version.", Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return strconv.Itoa(p.Source.(*ent.KeyBackupVersion).Etag), nil
+				}},
+				"count": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "The number of keys stored in the version.", Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*ent.KeyBackupVersion).QueryKeys().Count(p.Context)
+				}},
+				"createdAt": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+				"updatedAt": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("UpdatedAt")},
+			},
+		})
+	}
+	return r.keyBackupVersionObj
+}
+
+func (r *Resolver) keyBackupKeyType() *graphql.Object {
+	if r.keyBackupKeyObj == nil {
+		r.keyBackupKeyObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "KeyBackupKey",
+			Fields: graphql.Fields{
+				"roomId": &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					rm, err := p.Source.(*ent.KeyBackupKey).Edges.RoomOrErr()
+					if err != nil {
+						return nil, err
+					}
+					return rm.ID, nil
+				}},
+				"sessionId":         &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("SessionID")},
+				"firstMessageIndex": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: resolveInt64Field("FirstMessageIndex")},
+				"forwardedCount":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: resolveInt64Field("ForwardedCount")},
+				"isVerified":        &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Resolve: resolveBoolField("IsVerified")},
+				"sessionData":       &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "The session key encrypted to the backup's public key.", Resolve: resolveStringField("SessionData")},
+				"updatedAt":         &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("UpdatedAt")},
+			},
+		})
+	}
+	return r.keyBackupKeyObj
+}
+
+func (r *Resolver) keyBackupKeyInputType() *graphql.InputObject {
+	if r.keyBackupKeyInputObj == nil {
+		r.keyBackupKeyInputObj = graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: "KeyBackupKeyInput",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"roomId":            &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"sessionId":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+				"firstMessageIndex": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"forwardedCount":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"isVerified":        &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Boolean)},
+				"sessionData":       &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+		})
+	}
+	return r.keyBackupKeyInputObj
+}
+
+func (r *Resolver) keyBackupQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"keyBackupVersion": &graphql.Field{
+			Type:        r.keyBackupVersionType(),
+			Description: "Returns one of the caller's key backup versions, or the latest when version is omitted. Returns null when there is no such backup.",
+			Args: graphql.FieldConfigArgument{
+				"version": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				if raw, ok := p.Args["version"].(string); ok {
+					ver, err := r.keyBackupVersion(p.Context, raw)
+					if errors.Is(err, ErrKeyBackupNotFound) {
+						return nil, nil
+					}
+					return ver, err
+				}
+				ver, err := r.latestKeyBackupVersion(p.Context)
+				if ent.IsNotFound(err) {
+					return nil, nil
+				}
+				return ver, err
+			},
+		},
+		"keyBackupKeys": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.keyBackupKeyType()))),
+			Description: "Downloads the keys stored in one of the caller's key backup versions, optionally for one room or one session of it.",
+			Args: graphql.FieldConfigArgument{
+				"version":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"roomId":    &graphql.ArgumentConfig{Type: graphql.ID},
+				"sessionId": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				ver, err := r.keyBackupVersion(p.Context, p.Args["version"].(string))
+				if err != nil {
+					return nil, err
+				}
+				where, err := backupKeyFilter(ver.ID, p.Args)
+				if err != nil {
+					return nil, err
+				}
+				return r.Client.KeyBackupKey.Query().
+					Where(where...).
+					WithRoom().
+					Order(ent.Asc(keybackupkey.FieldID)).
+					All(p.Context)
+			},
+		},
+	}
+}
+
+func (r *Resolver) keyBackupMutationFields() graphql.Fields {
+	return graphql.Fields{
+		"createKeyBackupVersion": &graphql.Field{
+			Type:        graphql.NewNonNull(r.keyBackupVersionType()),
+			Description: "Starts a new key backup version, which becomes the caller's current one. Keys are encrypted by the client to the public key in authData; the server never sees them in the clear.",
+			Args: graphql.FieldConfigArgument{
+				"algorithm": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"authData":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				algorithm := p.Args["algorithm"].(string)
+				if algorithm == "" || len(algorithm) > maxBackupAlgorithmLength {
+					return nil, fmt.Errorf("algorithm must be 1 to %d characters", maxBackupAlgorithmLength)
+				}
+				authData := p.Args["authData"].(string)
+				if err := validateBackupAuthData(authData); err != nil {
+					return nil, err
+				}
+				return r.Client.KeyBackupVersion.Create().
+					SetOwnerID(uid).
+					SetAlgorithm(algorithm).
+					SetAuthData(authData).
+					Save(p.Context)
+			},
+		},
+		"updateKeyBackupVersion": &graphql.Field{
+			Type:        graphql.NewNonNull(r.keyBackupVersionType()),
+			Description: "Replaces the auth data of a key backup version, for example to add a signature. The algorithm cannot change; create a new version instead.",
+			Args: graphql.FieldConfigArgument{
+				"version":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"algorithm": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"authData":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				ver, err := r.keyBackupVersion(p.Context, p.Args["version"].(string))
+				if err != nil {
+					return nil, err
+				}
+				if p.Args["algorithm"].(string) != ver.Algorithm {
+					return nil, errors.New("the algorithm of a key backup version cannot change")
+				}
+				authData := p.Args["authData"].(string)
+				if err := validateBackupAuthData(authData); err != nil {
+					return nil, err
+				}
+				return r.Client.KeyBackupVersion.UpdateOne(ver).SetAuthData(authData).Save(p.Context)
+			},
+		},
+		"deleteKeyBackupVersion": &graphql.Field{
+			Type:        graphql.Boolean,
+			Description: "Deletes a key backup version with all of its keys. The previous version, if any, becomes current again.",
+			Args: graphql.FieldConfigArgument{
+				"version": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				ver, err := r.keyBackupVersion(p.Context, p.Args["version"].(string))
+				if err != nil {
+					return nil, err
+				}
+				if err := r.deleteKeyBackupVersion(p.Context, ver.ID); err != nil {
+					return nil, err
+				}
+				return true, nil
+			},
+		},
+		"uploadKeyBackupKeys": &graphql.Field{
+			Type:        graphql.NewNonNull(r.keyBackupVersionType()),
+			Description: "Stores session keys in the caller's current key backup version. A key replaces a stored copy of the same session unless the stored one is verified and the new one is not, or it starts at an earlier message index, or it was forwarded fewer times.",
+			Args: graphql.FieldConfigArgument{
+				"version": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"keys":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.keyBackupKeyInputType())))},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				keys, err := decodeBackupKeys(p.Args["keys"].([]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				return r.uploadKeyBackupKeys(p.Context, uid, p.Args["version"].(string), keys)
+			},
+		},
+		"deleteKeyBackupKeys": &graphql.Field{
+			Type:        graphql.NewNonNull(r.keyBackupVersionType()),
+			Description: "Deletes keys from one of the caller's key backup versions: all of them, one room's, or one session's.",
+			Args: graphql.FieldConfigArgument{
+				"version":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"roomId":    &graphql.ArgumentConfig{Type: graphql.ID},
+				"sessionId": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				ver, err := r.keyBackupVersion(p.Context, p.Args["version"].(string))
+				if err != nil {
+					return nil, err
+				}
+				where, err := backupKeyFilter(ver.ID, p.Args)
+				if err != nil {
+					return nil, err
+				}
+				return r.deleteKeyBackupKeys(p.Context, ver.ID, where)
+			},
+		},
+	}
+}
+
+// keyBackupVersion loads one of the viewer's key backup versions by its
+// version string.
+func (r *Resolver) keyBackupVersion(ctx context.Context, version string) (*ent.KeyBackupVersion, error) {
+	id, err := strconv.Atoi(version)
+	if err != nil {
+		return nil, ErrKeyBackupNotFound
+	}
+	ver, err := r.Client.KeyBackupVersion.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, ErrKeyBackupNotFound
+	}
+	return ver, err
+}
+
+// latestKeyBackupVersion loads the viewer's current key backup version, the
+// one created last.
+func (r *Resolver) latestKeyBackupVersion(ctx context.Context) (*ent.KeyBackupVersion, error) {
+	return r.Client.KeyBackupVersion.Query().
+		Order(ent.Desc(keybackupversion.FieldID)).
+		First(ctx)
+}
+
+func validateBackupAuthData(authData string) error {
+	if len(authData) > maxBackupAuthDataLength {
+		return fmt.Errorf("authData must be at most %d bytes", maxBackupAuthDataLength)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(authData), &fields); err != nil || fields == nil {
+		return errors.New("authData must be a JSON object")
+	}
+	return nil
+}
+
+// backupKeyFilter builds the predicates selecting keys of a version from the
+// optional roomId and sessionId arguments. A session is identified within a
+// room, so sessionId requires roomId.
+func backupKeyFilter(versionID int, args map[string]interface{}) ([]predicate.KeyBackupKey, error) {
+	where := []predicate.KeyBackupKey{keybackupkey.HasVersionWith(keybackupversion.ID(versionID))}
+	raw, hasRoom := args["roomId"]
+	if hasRoom && raw != nil {
+		roomID, err := decodeID(raw)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, keybackupkey.HasRoomWith(room.ID(roomID)))
+	} else {
+		hasRoom = false
+	}
+	if sessionID, ok := args["sessionId"].(string); ok {
+		if !hasRoom {
+			return nil, errors.New("sessionId requires roomId")
+		}
+		where = append(where, keybackupkey.SessionID(sessionID))
+	}
+	return where, nil
+}
+
+// decodeBackupKeys reads the keys argument of uploadKeyBackupKeys.
+func decodeBackupKeys(raw []interface{}) ([]backupKeyInput, error) {
+	if len(raw) == 0 || len(raw) > maxBackupKeysPerUpload {
+		return nil, fmt.Errorf("keys must contain 1 to %d keys", maxBackupKeysPerUpload)
+	}
+	keys := make([]backupKeyInput, 0, len(raw))
+	for _, item := range raw {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("invalid key backup key")
+		}
+		roomID, err := decodeID(fields["roomId"])
+		if err != nil {
+			return nil, err
+		}
+		key := backupKeyInput{roomID: roomID}
+		key.sessionID, _ = fields["sessionId"].(string)
+		key.firstMessageIndex, _ = fields["firstMessageIndex"].(int)
+		key.forwardedCount, _ = fields["forwardedCount"].(int)
+		key.isVerified, _ = fields["isVerified"].(bool)
+		key.sessionData, _ = fields["sessionData"].(string)
+		if key.sessionID == "" || len(key.sessionID) > maxBackupSessionIDLength {
+			return nil, fmt.Errorf("sessionId must be 1 to %d characters", maxBackupSessionIDLength)
+		}
+		if key.firstMessageIndex < 0 || key.forwardedCount < 0 {
+			return nil, errors.New("firstMessageIndex and forwardedCount must not be negative")
+		}
+		if key.sessionData == "" || len(key.sessionData) > maxBackupSessionDataLength {
+			return nil, fmt.Errorf("sessionData must be 1 to %d bytes", maxBackupSessionDataLength)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// replacesBackupKey reports whether an uploaded key should replace the stored
+// copy of its session: verified keys beat unverified ones, then keys that
+// decrypt more of the session, then keys forwarded fewer times.
+func replacesBackupKey(in backupKeyInput, stored *ent.KeyBackupKey) bool {
+	if in.isVerified != stored.IsVerified {
+		return in.isVerified
+	}
+	if in.firstMessageIndex != stored.FirstMessageIndex {
+		return in.firstMessageIndex < stored.FirstMessageIndex
+	}
+	return in.forwardedCount <= stored.ForwardedCount
+}
+
+// uploadKeyBackupKeys stores keys in the viewer's current key backup version
+// and bumps its etag when anything changed. Keys may only be backed up for
+// rooms the viewer belongs to.
+func (r *Resolver) uploadKeyBackupKeys(ctx context.Context, uid int, version string, keys []backupKeyInput) (ver *ent.KeyBackupVersion, err error) {
+	latest, err := r.latestKeyBackupVersion(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if latest == nil || strconv.Itoa(latest.ID) != version {
+		wrong := &WrongKeyBackupVersionError{Version: version}
+		if latest != nil {
+			wrong.Current = strconv.Itoa(latest.ID)
+		}
+		return nil, wrong
+	}
+	checked := make(map[int]bool)
+	for _, key := range keys {
+		if checked[key.roomID] {
+			continue
+		}
+		if err := r.ensureRoomAccess(ctx, key.roomID, uid); err != nil {
+			return nil, err
+		}
+		checked[key.roomID] = true
+	}
+
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	changed := false
+	for _, key := range keys {
+		stored, err := tx.KeyBackupKey.Query().
+			Where(
+				keybackupkey.HasVersionWith(keybackupversion.ID(latest.ID)),
+				keybackupkey.HasRoomWith(room.ID(key.roomID)),
+				keybackupkey.SessionID(key.sessionID),
+			).
+			Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			err = tx.KeyBackupKey.Create().
+				SetVersionID(latest.ID).
+				SetRoomID(key.roomID).
+				SetSessionID(key.sessionID).
+				SetFirstMessageIndex(key.firstMessageIndex).
+				SetForwardedCount(key.forwardedCount).
+				SetIsVerified(key.isVerified).
+				SetSessionData(key.sessionData).
+				Exec(ctx)
+		case err != nil:
+			return nil, err
+		case replacesBackupKey(key, stored):
+			err = tx.KeyBackupKey.UpdateOne(stored).
+				SetFirstMessageIndex(key.firstMessageIndex).
+				SetForwardedCount(key.forwardedCount).
+				SetIsVerified(key.isVerified).
+				SetSessionData(key.sessionData).
+				Exec(ctx)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		changed = true
+	}
+	if changed {
+		if err = tx.KeyBackupVersion.UpdateOneID(latest.ID).AddEtag(1).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return r.Client.KeyBackupVersion.Get(ctx, latest.ID)
+}
+
+// deleteKeyBackupKeys deletes the keys of a version matching where and bumps
+// its etag when any were deleted.
+func (r *Resolver) deleteKeyBackupKeys(ctx context.Context, versionID int, where []predicate.KeyBackupKey) (ver *ent.KeyBackupVersion, err error) {
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	n, err := tx.KeyBackupKey.Delete().Where(where...).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		if err = tx.KeyBackupVersion.UpdateOneID(versionID).AddEtag(1).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return r.Client.KeyBackupVersion.Get(ctx, versionID)
+}
+
+func (r *Resolver) deleteKeyBackupVersion(ctx context.Context, versionID int) (err error) {
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer rollbackOnError(tx, &err)
+
+	if _, err = tx.KeyBackupKey.Delete().Where(keybackupkey.HasVersionWith(keybackupversion.ID(versionID))).Exec(ctx); err != nil {
+		return err
+	}
+	if err = tx.KeyBackupVersion.DeleteOneID(versionID).Exec(ctx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,193 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/internal/auth"
+)
+
+// Kinds of message events.
+const (
+	MessageEventCreated = "created"
+	MessageEventEdited  = "edited"
+	MessageEventDeleted = "deleted"
+)
+
+// messageEventBuffer is how many events a subscriber may fall behind by
+// before further events are dropped for it.
+const messageEventBuffer = 16
+
+// MessageEvent describes a message being created, edited or deleted in a
+// room. Message is nil for deleted messages.
+type MessageEvent struct {
+	Kind      string       `json:"kind"`
+	RoomID    int          `json:"roomId"`
+	MessageID int          `json:"messageId"`
+	Message   *ent.Message `json:"message"`
+}
+
+// MessageListener is notified of every message event.
+type MessageListener func(context.Context, *MessageEvent)
+
+type messageBroker struct {
+	mu          sync.RWMutex
+	subscribers map[int]map[chan *MessageEvent]struct{}
+}
+
+func newMessageBroker() *messageBroker {
+	return &messageBroker{
+		subscribers: make(map[int]map[chan *MessageEvent]struct{}),
+	}
+}
+
+func (b *messageBroker) Subscribe(roomID int) (<-chan *MessageEvent, func()) {
+	ch := make(chan *MessageEvent, messageEventBuffer)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscribers[roomID]; !ok {
+		b.subscribers[roomID] = make(map[chan *MessageEvent]struct{})
+	}
+	b.subscribers[roomID][ch] = struct{}{}
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if subs, ok := b.subscribers[roomID]; ok {
+			if _, exists := subs[ch]; exists {
+				delete(subs, ch)
+				close(ch)
+				if len(subs) == 0 {
+					delete(b.subscribers, roomID)
+				}
+			}
+		}
+	}
+}
+
+func (b *messageBroker) Publish(event *MessageEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for ch := range b.subscribers[event.RoomID] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// RegisterMessageListener registers a callback invoked for every message event.
+func (r *Resolver) RegisterMessageListener(fn MessageListener) {
+	if fn == nil {
+		return
+	}
+	r.messageListeners = append(r.messageListeners, fn)
+}
+
+func (r *Resolver) publishMessageEvent(ctx context.Context, event *MessageEvent) {
+	r.messageBroker.Publish(event)
+	for _, listener := range r.messageListeners {
+		listener(ctx, event)
+	}
+}
+
+func (r *Resolver) messageEventType() *graphql.Object {
+	if r.messageEventObj == nil {
+		r.messageEventObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "MessageEvent",
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return graphql.Fields{
+					"kind":      &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "created, edited or deleted."},
+					"roomId":    &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+					"messageId": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+					"message":   &graphql.Field{Type: r.messageType(), Description: "The message as created or edited; null when it was deleted."},
+				}
+			}),
+		})
+	}
+	return r.messageEventObj
+}
+
+func (r *Resolver) messageEventSubscriptionFields() graphql.Fields {
+	return graphql.Fields{
+		"messageEvents": &graphql.Field{
+			Type:        r.messageEventType(),
+			Description: "Streams messages created, edited and deleted in a room. Only members may subscribe, and the stream ends when the caller leaves the room.",
+			Args: graphql.FieldConfigArgument{
+				"roomId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			// Resolve delivers an event to subscribers of its room who are still
+			// members. Executed without an event, it only checks access, which lets
+			// transports that do not call Subscribe gate new subscriptions.
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				roomID, err := decodeID(p.Args["roomId"])
+				if err != nil {
+					return nil, err
+				}
+				event, ok := p.Source.(*MessageEvent)
+				if ok && event.RoomID != roomID {
+					return nil, nil
+				}
+				if err := r.ensureRoomAccess(p.Context, roomID, uid); err != nil {
+					return nil, err
+				}
+				if !ok {
+					return nil, nil
+				}
+				return event, nil
+			},
+			Subscribe: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				roomID, err := decodeID(p.Args["roomId"])
+				if err != nil {
+					return nil, err
+				}
+				if err := r.ensureRoomAccess(p.Context, roomID, uid); err != nil {
+					return nil, err
+				}
+				ch, unsubscribe := r.messageBroker.Subscribe(roomID)
+				stream := make(chan interface{})
+				go func() {
+					defer close(stream)
+					defer unsubscribe()
+					for {
+						select {
+						case <-p.Context.Done():
+							return
+						case event, ok := <-ch:
+							if !ok {
+								return
+							}
+							// Membership is checked again for every event, so members
+							// who leave or are removed stop receiving.
+							if err := r.ensureRoomAccess(p.Context, roomID, uid); err != nil {
+								if errors.Is(err, ErrForbidden) {
+									return
+								}
+								log.Printf("message events for user %d in room %d: %v", uid, roomID, err)
+								continue
+							}
+							select {
+							case stream <- event:
+							case <-p.Context.Done():
+								return
+							}
+						}
+					}
+				}()
+				return stream, nil
+			},
+		},
+	}
+}
@@ -0,0 +1,443 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/recoverycode"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/totpsecret"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+)
+
+const (
+	// totpIssuer labels enrollments in authenticator apps.
+	totpIssuer = "Enclave"
+	// mfaStepUpWindow is how long a second-factor verification keeps authorizing
+	// sensitive mutations on a session.
+	mfaStepUpWindow = 10 * time.Minute
+	// maxMFAFailures is how many wrong codes in a row lock the second factor.
+	maxMFAFailures = 5
+	// mfaLockout is how long the second factor stays locked after too many failures.
+	mfaLockout = 5 * time.Minute
+)
+
+// ErrMFARequired is returned by sensitive mutations when the session has not
+// recently presented a second factor.
+var ErrMFARequired = errors.New("recent second-factor verification required")
+
+// ErrMFALocked is returned while the second factor is locked after repeated failures.
+var ErrMFALocked = errors.New("too many failed verification attempts, try again later")
+
+// mfaChallenge is handed out by login in place of tokens when a second factor is enrolled.
+type mfaChallenge struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Methods   []string  `json:"methods"`
+}
+
+// totpEnrollment is returned by enableTotp for display to the user.
+type totpEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// twoFactorStatus summarizes the caller's second-factor enrollment.
+type twoFactorStatus struct {
+	Enabled                bool `json:"enabled"`
+	RecoveryCodesRemaining int  `json:"recoveryCodesRemaining"`
+}
+
+func (r *Resolver) mfaChallengeType() *graphql.Object {
+	if r.mfaChallengeObj == nil {
+		r.mfaChallengeObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "MfaChallenge",
+			Fields: graphql.Fields{
+				"token":     &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "Pass to completeMfaLogin together with a code."},
+				"expiresAt": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+				"methods":   &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+			},
+		})
+	}
+	return r.mfaChallengeObj
+}
+
+func (r *Resolver) totpEnrollmentType() *graphql.Object {
+	if r.totpEnrollmentObj == nil {
+		r.totpEnrollmentObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "TotpEnrollment",
+			Fields: graphql.Fields{
+				"secret": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"uri":    &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "otpauth:// URI to render as a QR code."},
+			},
+		})
+	}
+	return r.totpEnrollmentObj
+}
+
+func (r *Resolver) twoFactorStatusType() *graphql.Object {
+	if r.twoFactorStatusObj == nil {
+		r.twoFactorStatusObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "TwoFactorStatus",
+			Fields: graphql.Fields{
+				"enabled":                &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+				"recoveryCodesRemaining": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			},
+		})
+	}
+	return r.twoFactorStatusObj
+}
+
+func (r *Resolver) mfaQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"twoFactorStatus": &graphql.Field{
+			Type: r.twoFactorStatusType(),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				enabled, err := r.mfaEnrolled(p.Context, uid)
+				if err != nil {
+					return nil, err
+				}
+				remaining, err := r.Client.RecoveryCode.Query().
+					Where(recoverycode.HasUserWith(user.ID(uid)), recoverycode.UsedAtIsNil()).
+					Count(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				return &twoFactorStatus{Enabled: enabled, RecoveryCodesRemaining: remaining}, nil
+			},
+		},
+	}
+}
+
+func (r *Resolver) mfaMutationFields() graphql.Fields {
+	return graphql.Fields{
+		"enableTotp": &graphql.Field{
+			Type:        r.totpEnrollmentType(),
+			Description: "Starts TOTP enrollment. The secret only takes effect once confirmTotp succeeds.",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				enrolled, err := r.mfaEnrolled(p.Context, uid)
+				if err != nil {
+					return nil, err
+				}
+				if enrolled {
+					return nil, errors.New("two-factor authentication is already enabled")
+				}
+				usr, err := r.Client.User.Get(p.Context, uid)
+				if err != nil {
+					return nil, err
+				}
+				secret, err := auth.NewTOTPSecret()
+				if err != nil {
+					return nil, err
+				}
+				tx, err := r.Client.Tx(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				defer rollbackOnError(tx, &err)
+				if _, err = tx.TotpSecret.Delete().Where(totpsecret.HasUserWith(user.ID(uid))).Exec(p.Context); err != nil {
+					return nil, err
+				}
+				if _, err = tx.TotpSecret.Create().SetUserID(uid).SetSecret(secret).Save(p.Context); err != nil {
+					return nil, err
+				}
+				if err = tx.Commit(); err != nil {
+					return nil, err
+				}
+				return &totpEnrollment{Secret: secret, URI: auth.TOTPURI(totpIssuer, usr.Username, secret)}, nil
+			},
+		},
+		"confirmTotp": &graphql.Field{
+			Type:        graphql.NewList(graphql.NewNonNull(graphql.String)),
+			Description: "Completes TOTP enrollment with a code from the authenticator and returns single-use recovery codes.",
+			Args: graphql.FieldConfigArgument{
+				"code": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				claims, err := auth.ClaimsFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				secret, err := r.Client.TotpSecret.Query().
+					Where(totpsecret.HasUserWith(user.ID(claims.UserID)), totpsecret.ConfirmedAtIsNil()).
+					Only(p.Context)
+				if ent.IsNotFound(err) {
+					return nil, errors.New("no pending two-factor enrollment")
+				}
+				if err != nil {
+					return nil, err
+				}
+				if err := r.checkTOTP(p.Context, secret, p.Args["code"].(string)); err != nil {
+					return nil, err
+				}
+				if err := secret.Update().SetConfirmedAt(time.Now()).Exec(p.Context); err != nil {
+					return nil, err
+				}
+				codes, err := r.replaceRecoveryCodes(p.Context, claims.UserID)
+				if err != nil {
+					return nil, err
+				}
+				if err := r.markSessionMFAVerified(p.Context, claims.SessionID); err != nil {
+					return nil, err
+				}
+				return codes, nil
+			},
+		},
+		"disableTotp": &graphql.Field{
+			Type: graphql.Boolean,
+			Args: graphql.FieldConfigArgument{
+				"code": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String), Description: "A TOTP or recovery code."},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				if err := r.verifySecondFactor(p.Context, uid, p.Args["code"].(string)); err != nil {
+					return nil, err
+				}
+				tx, err := r.Client.Tx(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				defer rollbackOnError(tx, &err)
+				if _, err = tx.TotpSecret.Delete().Where(totpsecret.HasUserWith(user.ID(uid))).Exec(p.Context); err != nil {
+					return nil, err
+				}
+				if _, err = tx.RecoveryCode.Delete().Where(recoverycode.HasUserWith(user.ID(uid))).Exec(p.Context); err != nil {
+					return nil, err
+				}
+				if err = tx.Commit(); err != nil {
+					return nil, err
+				}
+				return true, nil
+			},
+		},
+		"regenerateRecoveryCodes": &graphql.Field{
+			Type:        graphql.NewList(graphql.NewNonNull(graphql.String)),
+			Description: "Replaces every recovery code, including unused ones.",
+			Args: graphql.FieldConfigArgument{
+				"code": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String), Description: "A TOTP or recovery code."},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				if err := r.verifySecondFactor(p.Context, uid, p.Args["code"].(string)); err != nil {
+					return nil, err
+				}
+				return r.replaceRecoveryCodes(p.Context, uid)
+			},
+		},
+		"completeMfaLogin": &graphql.Field{
+			Type: r.authPayloadType(),
+			Args: graphql.FieldConfigArgument{
+				"mfaToken": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"code":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String), Description: "A TOTP or recovery code."},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				challenge, err := r.tokens.VerifyMFAChallenge(p.Args["mfaToken"].(string))
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				if err := r.verifySecondFactor(p.Context, challenge.UserID, p.Args["code"].(string)); err != nil {
+					return nil, err
+				}
+				usr, err := r.Client.User.Get(p.Context, challenge.UserID)
+				if err != nil {
+					return nil, err
+				}
+				now := time.Now()
+				return r.startSession(p.Context, usr, challenge.DeviceLabel, &now)
+			},
+		},
+		"verifySecondFactor": &graphql.Field{
+			Type:        graphql.Boolean,
+			Description: "Records a fresh second-factor verification on the current session, as required by sensitive mutations.",
+			Args: graphql.FieldConfigArgument{
+				"code": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String), Description: "A TOTP or recovery code."},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				claims, err := auth.ClaimsFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				if err := r.verifySecondFactor(p.Context, claims.UserID, p.Args["code"].(string)); err != nil {
+					return nil, err
+				}
+				if err := r.markSessionMFAVerified(p.Context, claims.SessionID); err != nil {
+					return nil, err
+				}
+				return true, nil
+			},
+		},
+	}
+}
+
+// mfaEnrolled reports whether the user has a confirmed second factor.
+func (r *Resolver) mfaEnrolled(ctx context.Context, userID int) (bool, error) {
+	return r.Client.TotpSecret.Query().
+		Where(totpsecret.HasUserWith(user.ID(userID)), totpsecret.ConfirmedAtNotNil()).
+		Exist(ctx)
+}
+
+func (r *Resolver) issueMFAChallenge(userID int, deviceLabel string) (*AuthPayload, error) {
+	token, expiresAt, err := r.tokens.IssueMFAChallenge(userID, deviceLabel)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthPayload{MFAChallenge: &mfaChallenge{
+		Token:     token,
+		ExpiresAt: expiresAt,
+		Methods:   []string{"totp", "recovery_code"},
+	}}, nil
+}
+
+// requireRecentMFA guards sensitive mutations. Users without a second factor
+// pass; enrolled users must have verified it on this session within mfaStepUpWindow.
+func (r *Resolver) requireRecentMFA(ctx context.Context) error {
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return ErrUnauthorized
+	}
+	enrolled, err := r.mfaEnrolled(ctx, claims.UserID)
+	if err != nil || !enrolled {
+		return err
+	}
+	recent, err := r.Client.Session.Query().
+		Where(
+			session.ID(claims.SessionID),
+			session.MfaVerifiedAtGT(time.Now().Add(-mfaStepUpWindow)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !recent {
+		return ErrMFARequired
+	}
+	return nil
+}
+
+// verifySecondFactor accepts either a current TOTP code or an unused recovery
+// code for a user with a confirmed enrollment.
+func (r *Resolver) verifySecondFactor(ctx context.Context, userID int, code string) error {
+	secret, err := r.Client.TotpSecret.Query().
+		Where(totpsecret.HasUserWith(user.ID(userID)), totpsecret.ConfirmedAtNotNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return errors.New("two-factor authentication is not enabled")
+	}
+	if err != nil {
+		return err
+	}
+	if len(code) == auth.TOTPDigits {
+		return r.checkTOTP(ctx, secret, code)
+	}
+	if secret.LockedUntil != nil && time.Now().Before(*secret.LockedUntil) {
+		return ErrMFALocked
+	}
+	// Guard on used_at so a recovery code cannot be spent twice concurrently.
+	used, err := r.Client.RecoveryCode.Update().
+		Where(
+			recoverycode.HasUserWith(user.ID(userID)),
+			recoverycode.CodeHash(auth.HashRecoveryCode(code)),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if used == 0 {
+		return r.recordMFAFailure(ctx, secret)
+	}
+	return secret.Update().SetFailedAttempts(0).ClearLockedUntil().Exec(ctx)
+}
+
+// checkTOTP validates a code against the secret and consumes its time step.
+func (r *Resolver) checkTOTP(ctx context.Context, secret *ent.TotpSecret, code string) error {
+	if secret.LockedUntil != nil && time.Now().Before(*secret.LockedUntil) {
+		return ErrMFALocked
+	}
+	step, err := auth.ValidateTOTP(secret.Secret, code, time.Now(), secret.LastUsedStep)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidTOTPCode) {
+			return r.recordMFAFailure(ctx, secret)
+		}
+		return err
+	}
+	// Guard on the previous step so the same code cannot be redeemed twice concurrently.
+	updated, err := r.Client.TotpSecret.Update().
+		Where(totpsecret.ID(secret.ID), totpsecret.LastUsedStepLT(step)).
+		SetLastUsedStep(step).
+		SetFailedAttempts(0).
+		ClearLockedUntil().
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return auth.ErrInvalidTOTPCode
+	}
+	return nil
+}
+
+// recordMFAFailure counts a wrong code and locks the factor once too many
+// consecutive failures accumulate. It always returns the error to report.
+func (r *Resolver) recordMFAFailure(ctx context.Context, secret *ent.TotpSecret) error {
+	failures := secret.FailedAttempts + 1
+	builder := secret.Update().SetFailedAttempts(failures)
+	if failures >= maxMFAFailures {
+		builder.SetFailedAttempts(0).SetLockedUntil(time.Now().Add(mfaLockout))
+	}
+	if err := builder.Exec(ctx); err != nil {
+		return err
+	}
+	return auth.ErrInvalidTOTPCode
+}
+
+// replaceRecoveryCodes discards the user's recovery codes and issues a new set.
+func (r *Resolver) replaceRecoveryCodes(ctx context.Context, userID int) (codes []string, err error) {
+	codes, err = auth.NewRecoveryCodes(auth.RecoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+	if _, err = tx.RecoveryCode.Delete().Where(recoverycode.HasUserWith(user.ID(userID))).Exec(ctx); err != nil {
+		return nil, err
+	}
+	builders := make([]*ent.RecoveryCodeCreate, len(codes))
+	for i, code := range codes {
+		builders[i] = tx.RecoveryCode.Create().SetUserID(userID).SetCodeHash(auth.HashRecoveryCode(code))
+	}
+	if _, err = tx.RecoveryCode.CreateBulk(builders...).Save(ctx); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (r *Resolver) markSessionMFAVerified(ctx context.Context, sessionID int) error {
+	return r.Client.Session.UpdateOneID(sessionID).SetMfaVerifiedAt(time.Now()).Exec(ctx)
+}
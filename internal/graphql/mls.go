@@ -0,0 +1,686 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+const (
+	// maxKeyPackages caps how many unclaimed KeyPackages a device may hold.
+	maxKeyPackages = 100
+	// maxMLSMessageLength bounds base64 encoded KeyPackages, Commits and Welcomes.
+	maxMLSMessageLength = 256 * 1024
+	// maxMLSGroupIDLength bounds the base64 encoded group ID of a room.
+	maxMLSGroupIDLength = 256
+)
+
+var (
+	// ErrNotMLSRoom is returned for MLS operations on rooms that are not MLS groups.
+	ErrNotMLSRoom = errors.New("room is not an MLS group")
+	// ErrMLSRoomMembership is returned when the membership of an MLS room is
+	// changed outside of a commit.
+	ErrMLSRoomMembership = errors.New("membership of MLS rooms changes through commits")
+)
+
+// StaleEpochError rejects a commit made in an epoch other than the group's
+// current one. The client fetches the commits it missed and retries.
+type StaleEpochError struct {
+	Epoch   int
+	Current int
+}
+
+func (e *StaleEpochError) Error() string {
+	return fmt.Sprintf("commit is for epoch %d but the group is at epoch %d", e.Epoch, e.Current)
+}
+
+// Extensions reports the group's current epoch in the GraphQL error.
+func (e *StaleEpochError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":         "STALE_EPOCH",
+		"currentEpoch": e.Current,
+	}
+}
+
+// PendingRemovalsError rejects a commit that leaves out members the server
+// already removed from the room. The client adds them to the commit's
+// removals and retries.
+type PendingRemovalsError struct {
+	UserIDs []int
+}
+
+func (e *PendingRemovalsError) Error() string {
+	return fmt.Sprintf("commit must remove users %v, who are no longer members of the room", e.UserIDs)
+}
+
+// Extensions reports the users the commit must remove in the GraphQL error.
+func (e *PendingRemovalsError) Extensions() map[string]interface{} {
+	ids := make([]string, len(e.UserIDs))
+	for i, id := range e.UserIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	return map[string]interface{}{
+		"code":    "PENDING_REMOVALS",
+		"userIds": ids,
+	}
+}
+
+// claimedKeyPackage is a KeyPackage handed out for adding one device to a group.
+type claimedKeyPackage struct {
+	UserID      int    `json:"userId"`
+	DeviceID    int    `json:"deviceId"`
+	CipherSuite int    `json:"cipherSuite"`
+	KeyPackage  string `json:"keyPackage"`
+}
+
+// mlsWelcomeInput is a decoded MlsWelcomeInput.
+type mlsWelcomeInput struct {
+	message    string
+	recipients []DeviceAddress
+}
+
+func (r *Resolver) keyPackageType() *graphql.Object {
+	if r.keyPackageObj == nil {
+		r.keyPackageObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "KeyPackage",
+			Fields: graphql.Fields{
+				"userId":      &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"deviceId":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"cipherSuite": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"keyPackage":  &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "Base64 encoded MLSMessage carrying the KeyPackage."},
+			},
+		})
+	}
+	return r.keyPackageObj
+}
+
+func (r *Resolver) mlsCommitType() *graphql.Object {
+	if r.mlsCommitObj == nil {
+		r.mlsCommitObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "MlsCommit",
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return graphql.Fields{
+					"id":             &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+					"epoch":          &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: resolveInt64Field("Epoch")},
+					"senderDeviceId": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: resolveInt64Field("SenderDeviceID")},
+					"message":        &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("Message")},
+					"createdAt":      &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+					"sender": &graphql.Field{
+						Type: r.userType(),
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							return p.Source.(*ent.MlsCommit).QuerySender().Only(p.Context)
+						},
+					},
+				}
+			}),
+		})
+	}
+	return r.mlsCommitObj
+}
+
+func (r *Resolver) mlsWelcomeType() *graphql.Object {
+	if r.mlsWelcomeObj == nil {
+		r.mlsWelcomeObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "MlsWelcome",
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return graphql.Fields{
+					"id":        &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+					"epoch":     &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: resolveInt64Field("Epoch")},
+					"message":   &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("Message")},
+					"createdAt": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+					"room": &graphql.Field{
+						Type: r.roomType(),
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							return p.Source.(*ent.MlsWelcome).QueryRoom().Only(p.Context)
+						},
+					},
+				}
+			}),
+		})
+	}
+	return r.mlsWelcomeObj
+}
+
+func (r *Resolver) keyPackageInputType() *graphql.InputObject {
+	if r.keyPackageInputObj == nil {
+		r.keyPackageInputObj = graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: "KeyPackageInput",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"keyPackage":  &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String), Description: "Base64 encoded MLSMessage carrying the KeyPackage."},
+				"cipherSuite": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"lastResort":  &graphql.InputObjectFieldConfig{Type: graphql.Boolean, Description: "Handed out when no other package is left and never consumed."},
+			},
+		})
+	}
+	return r.keyPackageInputObj
+}
+
+func (r *Resolver) deviceAddressInputType() *graphql.InputObject {
+	if r.deviceAddressInputObj == nil {
+		r.deviceAddressInputObj = graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: "DeviceAddressInput",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"userId":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"deviceId": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+			},
+		})
+	}
+	return r.deviceAddressInputObj
+}
+
+func (r *Resolver) mlsWelcomeInputType() *graphql.InputObject {
+	if r.mlsWelcomeInputObj == nil {
+		r.mlsWelcomeInputObj = graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: "MlsWelcomeInput",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"message":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String), Description: "Base64 encoded MLSMessage carrying the Welcome."},
+				"recipients": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.deviceAddressInputType())))},
+			},
+		})
+	}
+	return r.mlsWelcomeInputObj
+}
+
+func (r *Resolver) mlsQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"mlsCommits": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.mlsCommitType()))),
+			Description: "Lists a room's accepted commits in epoch order, starting at sinceEpoch.",
+			Args: graphql.FieldConfigArgument{
+				"roomId":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"sinceEpoch": &graphql.ArgumentConfig{Type: graphql.Int},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				roomID, err := decodeID(p.Args["roomId"])
+				if err != nil {
+					return nil, err
+				}
+				if err := r.ensureRoomAccess(p.Context, roomID, uid); err != nil {
+					return nil, err
+				}
+				query := r.Client.MlsCommit.Query().
+					Where(mlscommit.HasRoomWith(room.ID(roomID))).
+					Order(ent.Asc(mlscommit.FieldEpoch))
+				if since, ok := p.Args["sinceEpoch"].(int); ok {
+					query.Where(mlscommit.EpochGTE(since))
+				}
+				return query.All(p.Context)
+			},
+		},
+		"mlsWelcomes": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.mlsWelcomeType()))),
+			Description: "Lists the welcomes waiting for one of the caller's devices.",
+			Args: graphql.FieldConfigArgument{
+				"deviceId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				dev, err := r.ownDevice(p.Context, uid, p.Args["deviceId"].(int))
+				if err != nil {
+					return nil, err
+				}
+				return r.Client.MlsWelcome.Query().
+					Where(mlswelcome.HasDeviceWith(device.ID(dev.ID))).
+					Order(ent.Asc(mlswelcome.FieldID)).
+					All(p.Context)
+			},
+		},
+	}
+}
+
+func (r *Resolver) mlsMutationFields() graphql.Fields {
+	return graphql.Fields{
+		"uploadKeyPackages": &graphql.Field{
+			Type:        graphql.Int,
+			Description: "Adds KeyPackages to one of the caller's devices and returns how many are unclaimed.",
+			Args: graphql.FieldConfigArgument{
+				"deviceId":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"keyPackages": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.keyPackageInputType())))},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				dev, err := r.ownDevice(p.Context, uid, p.Args["deviceId"].(int))
+				if err != nil {
+					return nil, err
+				}
+				count, err := r.uploadKeyPackages(p.Context, dev, p.Args["keyPackages"].([]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				return count, nil
+			},
+		},
+		"claimKeyPackages": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.keyPackageType()))),
+			Description: "Returns one KeyPackage of the cipher suite for each device of a user, consuming it unless it is a last resort package. Devices without one are left out.",
+			Args: graphql.FieldConfigArgument{
+				"userId":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"cipherSuite": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				userID, err := decodeID(p.Args["userId"])
+				if err != nil {
+					return nil, err
+				}
+				return r.claimKeyPackages(p.Context, userID, p.Args["cipherSuite"].(int))
+			},
+		},
+		"createMlsGroup": &graphql.Field{
+			Type:        r.roomType(),
+			Description: "Turns a room into an MLS group at epoch 0. Only room admins can do this, and it cannot be undone.",
+			Args: graphql.FieldConfigArgument{
+				"roomId":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"groupId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String), Description: "Base64 encoded MLS group ID."},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				roomID, err := decodeID(p.Args["roomId"])
+				if err != nil {
+					return nil, err
+				}
+				groupID := p.Args["groupId"].(string)
+				if err := validateMLSData(groupID, maxMLSGroupIDLength); err != nil {
+					return nil, err
+				}
+				if err := r.ensureRoomAdmin(p.Context, roomID, uid); err != nil {
+					return nil, err
+				}
+				n, err := r.Client.Room.Update().
+					Where(room.ID(roomID), room.MlsGroupIDIsNil()).
+					SetMlsGroupID(groupID).
+					SetMlsEpoch(0).
+					Save(p.Context)
+				if ent.IsConstraintError(err) {
+					return nil, errors.New("mls group id is already in use")
+				}
+				if err != nil {
+					return nil, err
+				}
+				if n == 0 {
+					return nil, errors.New("room is already an MLS group")
+				}
+				return r.Client.Room.Get(p.Context, roomID)
+			},
+		},
+		"sendMlsCommit": &graphql.Field{
+			Type:        r.mlsCommitType(),
+			Description: "Publishes a commit for the group's current epoch and applies the membership changes it makes. Commits for any other epoch are rejected.",
+			Args: graphql.FieldConfigArgument{
+				"roomId":          &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"deviceId":        &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int), Description: "The caller's committing device."},
+				"epoch":           &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"commit":          &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String), Description: "Base64 encoded MLSMessage carrying the Commit."},
+				"addMemberIds":    &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"removeMemberIds": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.ID))},
+				"welcome":         &graphql.ArgumentConfig{Type: r.mlsWelcomeInputType()},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				roomID, err := decodeID(p.Args["roomId"])
+				if err != nil {
+					return nil, err
+				}
+				commit := p.Args["commit"].(string)
+				if err := validateMLSData(commit, maxMLSMessageLength); err != nil {
+					return nil, err
+				}
+				var welcome *mlsWelcomeInput
+				if raw, ok := p.Args["welcome"].(map[string]interface{}); ok {
+					if welcome, err = decodeMLSWelcome(raw); err != nil {
+						return nil, err
+					}
+				}
+				dev, err := r.ownDevice(p.Context, uid, p.Args["deviceId"].(int))
+				if err != nil {
+					return nil, err
+				}
+				return r.sendMLSCommit(p.Context, uid, roomID, dev.DeviceID, p.Args["epoch"].(int), commit,
+					decodeIDList(p.Args["addMemberIds"]), decodeIDList(p.Args["removeMemberIds"]), welcome)
+			},
+		},
+		"deleteMlsWelcome": &graphql.Field{
+			Type:        graphql.Boolean,
+			Description: "Acknowledges a welcome once the device has joined the group.",
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				id, err := decodeID(p.Args["id"])
+				if err != nil {
+					return nil, err
+				}
+				n, err := r.Client.MlsWelcome.Delete().Where(mlswelcome.ID(id)).Exec(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				return n == 1, nil
+			},
+		},
+	}
+}
+
+// uploadKeyPackages stores new KeyPackages for a device and returns how many
+// it holds.
+func (r *Resolver) uploadKeyPackages(ctx context.Context, dev *ent.Device, raw []interface{}) (int, error) {
+	builders := make([]*ent.KeyPackageCreate, 0, len(raw))
+	for _, item := range raw {
+		fields, _ := item.(map[string]interface{})
+		data, _ := fields["keyPackage"].(string)
+		if err := validateMLSData(data, maxMLSMessageLength); err != nil {
+			return 0, err
+		}
+		suite, _ := fields["cipherSuite"].(int)
+		lastResort, _ := fields["lastResort"].(bool)
+		builders = append(builders, r.Client.KeyPackage.Create().
+			SetDevice(dev).
+			SetKeyPackage(data).
+			SetCipherSuite(suite).
+			SetLastResort(lastResort))
+	}
+	existing, err := r.Client.KeyPackage.Query().Where(keypackage.HasDeviceWith(device.ID(dev.ID))).Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if existing+len(builders) > maxKeyPackages {
+		return 0, fmt.Errorf("a device can hold at most %d key packages", maxKeyPackages)
+	}
+	if len(builders) > 0 {
+		if err := r.Client.KeyPackage.CreateBulk(builders...).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return existing + len(builders), nil
+}
+
+// claimKeyPackages hands out one KeyPackage of the cipher suite per device of
+// the user. Like one-time prekeys, a consumed package is deleted under a guard
+// on its ID so concurrent claimers never receive the same one.
+func (r *Resolver) claimKeyPackages(ctx context.Context, userID, suite int) ([]*claimedKeyPackage, error) {
+	devices, err := r.Client.Device.Query().
+		Where(device.HasUserWith(user.ID(userID))).
+		Order(ent.Asc(device.FieldDeviceID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claimed := []*claimedKeyPackage{}
+	for _, dev := range devices {
+		var found *ent.KeyPackage
+		for attempt := 0; attempt < 3 && found == nil; attempt++ {
+			pkg, err := r.Client.KeyPackage.Query().
+				Where(keypackage.HasDeviceWith(device.ID(dev.ID)), keypackage.CipherSuite(suite)).
+				Order(ent.Asc(keypackage.FieldLastResort), ent.Asc(keypackage.FieldID)).
+				First(ctx)
+			if ent.IsNotFound(err) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if pkg.LastResort {
+				found = pkg
+				break
+			}
+			deleted, err := r.Client.KeyPackage.Delete().Where(keypackage.ID(pkg.ID)).Exec(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if deleted == 1 {
+				found = pkg
+			}
+		}
+		if found != nil {
+			claimed = append(claimed, &claimedKeyPackage{
+				UserID:      userID,
+				DeviceID:    dev.DeviceID,
+				CipherSuite: found.CipherSuite,
+				KeyPackage:  found.KeyPackage,
+			})
+		}
+	}
+	return claimed, nil
+}
+
+// sendMLSCommit accepts a commit for the room's current epoch, advances the
+// epoch, applies the commit's membership changes and queues its welcome, all
+// in one transaction. The membership changes go through the usual room admin
+// rules.
+func (r *Resolver) sendMLSCommit(ctx context.Context, uid, roomID, deviceID, epoch int, data string, addIDs, removeIDs []int, welcome *mlsWelcomeInput) (commit *ent.MlsCommit, err error) {
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	// Advancing the epoch is the first statement, so parallel commits wait
+	// for the transaction's write lock and then find the epoch taken, instead
+	// of failing to upgrade a read lock. The commit below is still checked
+	// against the sender's membership.
+	advanced, err := tx.Room.Update().
+		Where(room.ID(roomID), room.MlsGroupIDNotNil(), room.MlsEpoch(epoch)).
+		SetMlsEpoch(epoch + 1).
+		Save(rule.SystemContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	rm, err := tx.Room.Get(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if rm.MlsGroupID == nil {
+		err = ErrNotMLSRoom
+		return nil, err
+	}
+	if advanced == 0 {
+		err = &StaleEpochError{Epoch: epoch, Current: rm.MlsEpoch}
+		return nil, err
+	}
+	if missing := missingRemovals(rm.MlsPendingRemovals, removeIDs); len(missing) > 0 {
+		err = &PendingRemovalsError{UserIDs: missing}
+		return nil, err
+	}
+	commit, err = tx.MlsCommit.Create().
+		SetRoomID(roomID).
+		SetSenderID(uid).
+		SetSenderDeviceID(deviceID).
+		SetEpoch(epoch).
+		SetMessage(data).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		err = &StaleEpochError{Epoch: epoch, Current: epoch + 1}
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, memberID := range addIDs {
+		if err = tx.RoomMembership.Create().SetRoomID(roomID).SetUserID(memberID).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if len(removeIDs) > 0 {
+		if _, err = tx.RoomMembership.Delete().
+			Where(roommembership.HasRoomWith(room.ID(roomID)), roommembership.HasUserWith(user.IDIn(removeIDs...))).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+		if err = rotateDeliveryTokens(ctx, tx.Client(), room.ID(roomID)); err != nil {
+			return nil, err
+		}
+	}
+	if len(rm.MlsPendingRemovals) > 0 {
+		if err = tx.Room.UpdateOneID(roomID).ClearMlsPendingRemovals().Exec(rule.SystemContext(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	if welcome != nil {
+		if err = queueMLSWelcomeTx(ctx, tx, uid, roomID, epoch+1, welcome); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return commit.Unwrap(), nil
+}
+
+// queueMLSWelcomeTx stores a welcome for each recipient device. Recipients
+// must be devices of the room's members once the commit is applied; devices
+// are read without privacy checks because the commit is already authorized.
+func queueMLSWelcomeTx(ctx context.Context, tx *ent.Tx, uid, roomID, epoch int, welcome *mlsWelcomeInput) error {
+	builders := make([]*ent.MlsWelcomeCreate, 0, len(welcome.recipients))
+	seen := make(map[DeviceAddress]bool, len(welcome.recipients))
+	for _, addr := range welcome.recipients {
+		if seen[addr] {
+			continue
+		}
+		seen[addr] = true
+		dev, err := tx.Device.Query().
+			Where(
+				device.DeviceID(addr.DeviceID),
+				device.HasUserWith(user.ID(addr.UserID), user.HasMembershipsWith(roommembership.HasRoomWith(room.ID(roomID)))),
+			).
+			Only(rule.SystemContext(ctx))
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("welcome recipient device %d of user %d is not in the room", addr.DeviceID, addr.UserID)
+		}
+		if err != nil {
+			return err
+		}
+		builders = append(builders, tx.MlsWelcome.Create().
+			SetRoomID(roomID).
+			SetSenderID(uid).
+			SetDevice(dev).
+			SetEpoch(epoch).
+			SetMessage(welcome.message))
+	}
+	if len(builders) == 0 {
+		return errors.New("a welcome needs at least one recipient")
+	}
+	return tx.MlsWelcome.CreateBulk(builders...).Exec(ctx)
+}
+
+// ensureNotMLSRoom rejects membership changes to MLS rooms made outside of a
+// commit, which would leave the room and its group out of step.
+func (r *Resolver) ensureNotMLSRoom(ctx context.Context, roomID int) error {
+	isMLS, err := r.Client.Room.Query().Where(room.ID(roomID), room.MlsGroupIDNotNil()).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if isMLS {
+		return ErrMLSRoomMembership
+	}
+	return nil
+}
+
+// missingRemovals returns the pending removals a commit leaves out.
+func missingRemovals(pending, removeIDs []int) []int {
+	removed := make(map[int]bool, len(removeIDs))
+	for _, id := range removeIDs {
+		removed[id] = true
+	}
+	var missing []int
+	for _, id := range pending {
+		if !removed[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// recordMLSRemovalsTx notes that the server removed a user from the MLS rooms
+// matching where, so the next commit of each group removes them as well.
+func recordMLSRemovalsTx(ctx context.Context, tx *ent.Tx, userID int, where ...predicate.Room) error {
+	ctx = rule.SystemContext(ctx)
+	rooms, err := tx.Room.Query().
+		Where(append(where, room.MlsGroupIDNotNil())...).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rm := range rooms {
+		if err := tx.Room.UpdateOne(rm).AppendMlsPendingRemovals([]int{userID}).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteMLSStateTx removes the commits and pending welcomes of a room.
+func deleteMLSStateTx(ctx context.Context, tx *ent.Tx, roomID int) error {
+	if _, err := tx.MlsWelcome.Delete().Where(mlswelcome.HasRoomWith(room.ID(roomID))).Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.MlsCommit.Delete().Where(mlscommit.HasRoomWith(room.ID(roomID))).Exec(ctx)
+	return err
+}
+
+func decodeMLSWelcome(raw map[string]interface{}) (*mlsWelcomeInput, error) {
+	welcome := &mlsWelcomeInput{}
+	welcome.message, _ = raw["message"].(string)
+	if err := validateMLSData(welcome.message, maxMLSMessageLength); err != nil {
+		return nil, err
+	}
+	list, _ := raw["recipients"].([]interface{})
+	for _, item := range list {
+		fields, _ := item.(map[string]interface{})
+		userID, err := decodeID(fields["userId"])
+		if err != nil {
+			return nil, err
+		}
+		deviceID, _ := fields["deviceId"].(int)
+		welcome.recipients = append(welcome.recipients, DeviceAddress{UserID: userID, DeviceID: deviceID})
+	}
+	return welcome, nil
+}
+
+// validateMLSData checks that an opaque MLS value is non-empty base64 of at
+// most max characters. The server does not parse MLS messages.
+func validateMLSData(value string, max int) error {
+	if value == "" || len(value) > max {
+		return fmt.Errorf("mls data must be 1 to %d characters of base64", max)
+	}
+	if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+		return errors.New("mls data must be standard base64")
+	}
+	return nil
+}
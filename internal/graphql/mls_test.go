@@ -0,0 +1,82 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// sendMLSCommit publishes a commit for epoch from device 1 of uid.
+func (env *testEnv) sendMLSCommit(uid, roomID, epoch int) error {
+	_, err := env.exec(uid, fmt.Sprintf(`mutation { sendMlsCommit(roomId: "%d", deviceId: 1, epoch: %d, commit: "aGk=") { epoch } }`, roomID, epoch))
+	return err
+}
+
+func TestSendMLSCommitRejectsDuplicateEpoch(t *testing.T) {
+	env := newTestEnv(t)
+	alice := env.addUser("alice")
+	bob := env.addUser("bob")
+	roomID := env.createRoom(alice, bob)
+	env.registerDevice(alice, 1, 0)
+	env.registerDevice(bob, 1, 0)
+	env.do(alice, fmt.Sprintf(`mutation { createMlsGroup(roomId: "%d", groupId: "Z3JvdXA=") { id } }`, roomID))
+
+	if err := env.sendMLSCommit(alice, roomID, 0); err != nil {
+		t.Fatal(err)
+	}
+	var stale *StaleEpochError
+	if err := env.sendMLSCommit(bob, roomID, 0); !errors.As(err, &stale) || stale.Current != 1 {
+		t.Fatalf("second commit for epoch 0 returned %v, want a stale epoch error at epoch 1", err)
+	}
+
+	// Of parallel commits for the same epoch exactly one is applied.
+	const commits = 8
+	errs := make([]error, commits)
+	var wg sync.WaitGroup
+	for i := 0; i < commits; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sender := alice
+			if i%2 == 1 {
+				sender = bob
+			}
+			errs[i] = env.sendMLSCommit(sender, roomID, 1)
+		}(i)
+	}
+	wg.Wait()
+	applied := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			applied++
+		case !errors.As(err, &stale):
+			t.Fatalf("parallel commit returned %v, want a stale epoch error", err)
+		}
+	}
+	if applied != 1 {
+		t.Fatalf("%d parallel commits for epoch 1 were applied, want 1", applied)
+	}
+
+	ctx := rule.SystemContext(context.Background())
+	rm, err := env.client.Room.Get(ctx, roomID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rm.MlsEpoch != 2 {
+		t.Fatalf("group is at epoch %d, want 2", rm.MlsEpoch)
+	}
+	stored, err := env.client.MlsCommit.Query().Where(mlscommit.HasRoomWith(room.ID(roomID))).Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored != 2 {
+		t.Fatalf("%d commits were stored, want one per epoch", stored)
+	}
+}
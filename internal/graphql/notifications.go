@@ -2,11 +2,18 @@ package graphql
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 
 	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/notification"
+	"github.com/eleven-am/enclave/internal/rule"
 )
 
+// serverNotificationScheme marks notifications written by the server itself,
+// whose payload is plain JSON rather than ciphertext.
+const serverNotificationScheme = "none"
+
 type notificationBroker struct {
 	mu          sync.RWMutex
 	subscribers map[int]map[chan *ent.Notification]struct{}
@@ -72,3 +79,31 @@ func (b *notificationBroker) Publish(_ context.Context, notification *ent.Notifi
 		}
 	}
 }
+
+// publishServerNotification stores and publishes a notification generated by
+// the server, with payload encoded as JSON.
+func (r *Resolver) publishServerNotification(ctx context.Context, userID int, kind string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	ctx = rule.SystemContext(ctx)
+	saved, err := r.Client.Notification.Create().
+		SetRecipientID(userID).
+		SetKind(kind).
+		SetCipherText(string(body)).
+		SetEncryptionScheme(serverNotificationScheme).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	enriched, err := r.Client.Notification.Query().
+		Where(notification.IDEQ(saved.ID)).
+		WithRecipient().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	r.publishNotification(ctx, userID, enriched)
+	return nil
+}
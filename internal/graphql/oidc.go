@@ -0,0 +1,230 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/identity"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+)
+
+// maxUsernameAttempts bounds how many suffixed usernames are tried when the
+// preferred one is already taken.
+const maxUsernameAttempts = 20
+
+var (
+	// ErrIdentityLinkRequired is returned when an identity's email belongs to an
+	// account it may not be linked to automatically.
+	ErrIdentityLinkRequired = errors.New("email is already registered to another account; sign in and link this identity from your account")
+	// ErrIdentityLinked is returned when linking an identity that already
+	// belongs to another account.
+	ErrIdentityLinked = errors.New("identity is already linked to another account")
+)
+
+// IdentityLinkTicket starts an OIDC flow that links the provider identity to
+// the account that requested it.
+type IdentityLinkTicket struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// StartIdentityLink issues a ticket for linking a single sign-on identity to
+// the caller's account. The ticket only works alongside binding, which the
+// caller keeps in the requesting browser. It requires a recent second factor
+// when one is enrolled.
+func (r *Resolver) StartIdentityLink(ctx context.Context, binding string) (*IdentityLinkTicket, error) {
+	uid, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+	if err := r.requireRecentMFA(ctx); err != nil {
+		return nil, err
+	}
+	token, expiresAt, err := r.tokens.IssueOIDCLink(uid, binding)
+	if err != nil {
+		return nil, err
+	}
+	return &IdentityLinkTicket{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// SignInWithOIDC maps a validated OpenID Connect identity onto a local user and
+// opens a session for it. Users are matched by issuer and subject first, then
+// linked by an email address both sides have verified, and otherwise created
+// just in time. Accounts with a second factor get the same challenge as a
+// password login, since the provider's login says nothing about it.
+func (r *Resolver) SignInWithOIDC(ctx context.Context, id *auth.OIDCIdentity, deviceLabel string) (*AuthPayload, error) {
+	usr, err := r.userForIdentity(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	enrolled, err := r.mfaEnrolled(ctx, usr.ID)
+	if err != nil {
+		return nil, err
+	}
+	if enrolled {
+		return r.issueMFAChallenge(usr.ID, deviceLabel)
+	}
+	return r.startSession(ctx, usr, deviceLabel, nil)
+}
+
+func (r *Resolver) userForIdentity(ctx context.Context, id *auth.OIDCIdentity) (usr *ent.User, err error) {
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	existing, err := tx.Identity.Query().
+		Where(identity.Issuer(id.Issuer), identity.Subject(id.Subject)).
+		WithUser().
+		Only(ctx)
+	switch {
+	case err == nil:
+		err = existing.Update().
+			SetEmail(id.Email).
+			SetLastLoginAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		usr = existing.Edges.User
+	case ent.IsNotFound(err):
+		usr, err = linkOrCreateUser(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.Identity.Create().
+			SetIssuer(id.Issuer).
+			SetSubject(id.Subject).
+			SetEmail(id.Email).
+			SetUser(usr).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return usr, nil
+}
+
+// LinkOIDCIdentity links a validated identity to a signed-in user, so they can
+// sign in with it from then on.
+func (r *Resolver) LinkOIDCIdentity(ctx context.Context, userID int, id *auth.OIDCIdentity) (err error) {
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer rollbackOnError(tx, &err)
+
+	existing, err := tx.Identity.Query().
+		Where(identity.Issuer(id.Issuer), identity.Subject(id.Subject)).
+		WithUser().
+		Only(ctx)
+	switch {
+	case err == nil:
+		if existing.Edges.User.ID != userID {
+			err = ErrIdentityLinked
+			return err
+		}
+	case ent.IsNotFound(err):
+		_, err = tx.Identity.Create().
+			SetIssuer(id.Issuer).
+			SetSubject(id.Subject).
+			SetEmail(id.Email).
+			SetUserID(userID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+	return tx.Commit()
+}
+
+// linkOrCreateUser finds the local account an unseen identity belongs to. An
+// existing account is only linked when the provider and the account have both
+// verified the email; anything else would let whoever controls the provider,
+// or whoever registered the address first, claim someone else's account.
+func linkOrCreateUser(ctx context.Context, tx *ent.Tx, id *auth.OIDCIdentity) (*ent.User, error) {
+	email := strings.TrimSpace(id.Email)
+	if email == "" {
+		return nil, errors.New("identity provider did not supply an email address")
+	}
+	usr, err := tx.User.Query().Where(user.Email(email)).Only(ctx)
+	if err == nil {
+		if !id.EmailVerified || usr.VerifiedAt == nil {
+			return nil, ErrIdentityLinkRequired
+		}
+		return usr, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	base := usernameCandidate(id.PreferredUsername)
+	if base == "" {
+		local, _, _ := strings.Cut(email, "@")
+		base = usernameCandidate(local)
+	}
+	if base == "" {
+		base = "user"
+	}
+	username := ""
+	for attempt := 0; attempt < maxUsernameAttempts; attempt++ {
+		candidate := base
+		if attempt > 0 {
+			candidate = fmt.Sprintf("%s%d", base, attempt+1)
+		}
+		taken, err := tx.User.Query().Where(user.Username(candidate)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !taken {
+			username = candidate
+			break
+		}
+	}
+	if username == "" {
+		return nil, errors.New("could not allocate a username")
+	}
+	displayName := strings.TrimSpace(id.Name)
+	if displayName == "" {
+		displayName = username
+	}
+	builder := tx.User.Create().
+		SetUsername(username).
+		SetDisplayName(displayName).
+		SetEmail(email)
+	if id.EmailVerified {
+		builder.SetVerifiedAt(time.Now())
+	}
+	if id.Picture != "" {
+		builder.SetAvatarURL(id.Picture)
+	}
+	return builder.Save(ctx)
+}
+
+// usernameCandidate reduces a provider supplied name to the characters allowed in usernames.
+func usernameCandidate(raw string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(raw) {
+		if c < unicode.MaxASCII && (unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '.' || c == '-') {
+			b.WriteRune(c)
+		}
+		if b.Len() == 32 {
+			break
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,267 @@
+package graphql
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// pageArgs are the Relay pagination arguments of a connection field. Pages
+// are keyset pages over a timestamp or sequence column and the ID, so they
+// stay stable while rows are added.
+type pageArgs struct {
+	limit int
+	// backward pages end at before, or at the end of the listing, and are
+	// loaded in reverse order.
+	backward bool
+	after    *keysetCursor
+	before   *keysetCursor
+}
+
+// keysetCursor is the position of a row in a listing ordered by a key, a
+// time.Time or an int, and then by ID.
+type keysetCursor struct {
+	key interface{}
+	id  int
+}
+
+type connectionEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   interface{} `json:"node"`
+}
+
+type connection struct {
+	Edges    []*connectionEdge `json:"edges"`
+	PageInfo *pageInfo         `json:"pageInfo"`
+}
+
+// connectionArgs adds the Relay pagination arguments to args.
+func connectionArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	if args == nil {
+		args = graphql.FieldConfigArgument{}
+	}
+	args["first"] = &graphql.ArgumentConfig{Type: graphql.Int}
+	args["after"] = &graphql.ArgumentConfig{Type: graphql.String}
+	args["last"] = &graphql.ArgumentConfig{Type: graphql.Int}
+	args["before"] = &graphql.ArgumentConfig{Type: graphql.String}
+	return args
+}
+
+// newConnectionType builds the connection and edge types for nodes of the
+// named type. node is called lazily so node types may refer back to it.
+func (r *Resolver) newConnectionType(name string, node func() graphql.Output) *graphql.Object {
+	edge := graphql.NewObject(graphql.ObjectConfig{
+		Name: name + "Edge",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"node":   &graphql.Field{Type: graphql.NewNonNull(node())},
+			}
+		}),
+	})
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: name + "Connection",
+		Fields: graphql.Fields{
+			"edges":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(edge)))},
+			"pageInfo": &graphql.Field{Type: graphql.NewNonNull(r.pageInfoType())},
+		},
+	})
+}
+
+func (r *Resolver) messageConnectionType() *graphql.Object {
+	if r.messageConnectionObj == nil {
+		r.messageConnectionObj = r.newConnectionType("Message", func() graphql.Output { return r.messageType() })
+	}
+	return r.messageConnectionObj
+}
+
+func (r *Resolver) notificationConnectionType() *graphql.Object {
+	if r.notificationConnectionObj == nil {
+		r.notificationConnectionObj = r.newConnectionType("Notification", func() graphql.Output { return r.notificationType() })
+	}
+	return r.notificationConnectionObj
+}
+
+func (r *Resolver) callLogConnectionType() *graphql.Object {
+	if r.callLogConnectionObj == nil {
+		r.callLogConnectionObj = r.newConnectionType("CallLog", func() graphql.Output { return r.callLogType() })
+	}
+	return r.callLogConnectionObj
+}
+
+func (r *Resolver) contactConnectionType() *graphql.Object {
+	if r.contactConnectionObj == nil {
+		r.contactConnectionObj = r.newConnectionType("Contact", func() graphql.Output { return r.contactType() })
+	}
+	return r.contactConnectionObj
+}
+
+func (r *Resolver) roomConnectionType() *graphql.Object {
+	if r.roomConnectionObj == nil {
+		r.roomConnectionObj = r.newConnectionType("Room", func() graphql.Output { return r.roomType() })
+	}
+	return r.roomConnectionObj
+}
+
+// decodePageArgs reads the pagination arguments of a connection whose cursors
+// are of the given kind. Without first or last a page holds defaultPageSize
+// rows; a before cursor without first pages backward.
+func decodePageArgs(kind string, args map[string]interface{}) (pageArgs, error) {
+	first, hasFirst := args["first"].(int)
+	last, hasLast := args["last"].(int)
+	if hasFirst && hasLast {
+		return pageArgs{}, errors.New("first and last cannot be combined")
+	}
+	if first < 0 || last < 0 {
+		return pageArgs{}, errors.New("first and last must not be negative")
+	}
+	page := pageArgs{limit: defaultPageSize}
+	var err error
+	if raw, ok := args["after"].(string); ok && raw != "" {
+		if page.after, err = decodeKeysetCursor(kind, raw); err != nil {
+			return pageArgs{}, err
+		}
+	}
+	if raw, ok := args["before"].(string); ok && raw != "" {
+		if page.before, err = decodeKeysetCursor(kind, raw); err != nil {
+			return pageArgs{}, err
+		}
+	}
+	switch {
+	case hasFirst:
+		page.limit = first
+	case hasLast:
+		page.limit = last
+		page.backward = true
+	case page.before != nil:
+		page.backward = true
+	}
+	if page.limit > maxPageSize {
+		page.limit = maxPageSize
+	}
+	return page, nil
+}
+
+// where matches the rows between the page's cursors in a listing ordered by
+// keyField and then ID, descending when desc is set.
+func (p pageArgs) where(keyField string, desc bool) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		var preds []*sql.Predicate
+		if p.after != nil {
+			preds = append(preds, keysetBeyond(s, keyField, *p.after, !desc))
+		}
+		if p.before != nil {
+			preds = append(preds, keysetBeyond(s, keyField, *p.before, desc))
+		}
+		if len(preds) > 0 {
+			s.Where(sql.And(preds...))
+		}
+	}
+}
+
+// order sorts rows in the order the page is loaded: the listing's order, or
+// its reverse for backward pages.
+func (p pageArgs) order(keyField string, desc bool) func(*sql.Selector) {
+	if desc != p.backward {
+		return ent.Desc(keyField, "id")
+	}
+	return ent.Asc(keyField, "id")
+}
+
+// keysetBeyond matches the rows after c in ascending (keyField, id) order
+// when greater is set, and the rows before it otherwise.
+func keysetBeyond(s *sql.Selector, keyField string, c keysetCursor, greater bool) *sql.Predicate {
+	cmp := sql.LT
+	if greater {
+		cmp = sql.GT
+	}
+	return sql.Or(
+		cmp(s.C(keyField), c.key),
+		sql.And(sql.EQ(s.C(keyField), c.key), cmp(s.C("id"), c.id)),
+	)
+}
+
+// newConnection builds a page from nodes loaded with the page's where and
+// order and a limit of one more than the page size, which tells whether more
+// rows follow. key returns the key, a time.Time or an int, and the ID a node
+// is ordered by.
+func newConnection[T any](kind string, page pageArgs, nodes []T, key func(T) (interface{}, int)) *connection {
+	conn := &connection{Edges: []*connectionEdge{}, PageInfo: &pageInfo{}}
+	more := len(nodes) > page.limit
+	if more {
+		nodes = nodes[:page.limit]
+	}
+	if page.backward {
+		slices.Reverse(nodes)
+		conn.PageInfo.HasPreviousPage = more
+	} else {
+		conn.PageInfo.HasNextPage = more
+	}
+	for _, node := range nodes {
+		k, id := key(node)
+		conn.Edges = append(conn.Edges, &connectionEdge{Cursor: encodeKeysetCursor(kind, k, id), Node: node})
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn
+}
+
+// encodeKeysetCursor writes timestamp keys as "t" and their Unix nanoseconds,
+// and int keys as "n" and the number.
+func encodeKeysetCursor(kind string, key interface{}, id int) string {
+	var raw string
+	switch k := key.(type) {
+	case time.Time:
+		raw = "t" + strconv.FormatInt(k.UnixNano(), 10)
+	case int:
+		raw = "n" + strconv.Itoa(k)
+	}
+	return encodeCursor(kind, raw+":"+strconv.Itoa(id))
+}
+
+func decodeKeysetCursor(kind, cursor string) (*keysetCursor, error) {
+	value, err := decodeCursor(kind, cursor)
+	if err != nil {
+		return nil, err
+	}
+	rawKey, rawID, ok := strings.Cut(value, ":")
+	if !ok || rawKey == "" {
+		return nil, errInvalidCursor
+	}
+	id, err := strconv.Atoi(rawID)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	c := &keysetCursor{id: id}
+	switch rawKey[0] {
+	case 't':
+		nanos, err := strconv.ParseInt(rawKey[1:], 10, 64)
+		if err != nil {
+			return nil, errInvalidCursor
+		}
+		c.key = time.Unix(0, nanos)
+	case 'n':
+		n, err := strconv.Atoi(rawKey[1:])
+		if err != nil {
+			return nil, errInvalidCursor
+		}
+		c.key = n
+	default:
+		return nil, errInvalidCursor
+	}
+	return c, nil
+}
@@ -0,0 +1,862 @@
+package graphql
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/contact"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/identitykey"
+	"github.com/eleven-am/enclave/ent/identitykeyrecord"
+	"github.com/eleven-am/enclave/ent/keypackage"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/onetimeprekey"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/signedprekey"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+const (
+	// lowPreKeyThreshold is the number of one-time prekeys below which a
+	// device's user is asked to upload more.
+	lowPreKeyThreshold = 10
+	// maxOneTimePreKeys caps how many unclaimed one-time prekeys a device may hold.
+	maxOneTimePreKeys = 500
+	// maxPublicKeyLength bounds uploaded base64 key material and signatures.
+	maxPublicKeyLength = 1024
+	// maxDevicePreKeyClaims is how many bundles a caller may claim for one
+	// device per preKeyClaimWindow; maxPreKeyClaims bounds the caller's
+	// claims across all devices.
+	maxDevicePreKeyClaims = 10
+	maxPreKeyClaims       = 200
+	preKeyClaimWindow     = time.Hour
+)
+
+// NotificationKindPreKeysLow is the kind of the notification sent when a
+// device runs short of one-time prekeys.
+const NotificationKindPreKeysLow = "keys.prekeys_low"
+
+// ErrDeviceNotFound is returned for devices the user has not registered.
+var ErrDeviceNotFound = errors.New("device not found")
+
+// ErrPreKeyClaimsThrottled is returned once a caller has claimed too many
+// prekey bundles.
+var ErrPreKeyClaimsThrottled = errors.New("too many prekey bundle claims, try again later")
+
+// preKey is a public prekey as exchanged with clients.
+type preKey struct {
+	KeyID     int    `json:"keyId"`
+	PublicKey string `json:"publicKey"`
+	Signature string `json:"signature,omitempty"`
+}
+
+// preKeyBundle carries what a client needs to start an X3DH session with one
+// device of another user.
+type preKeyBundle struct {
+	UserID         int     `json:"userId"`
+	DeviceID       int     `json:"deviceId"`
+	RegistrationID int     `json:"registrationId"`
+	IdentityKey    string  `json:"identityKey"`
+	SignedPreKey   *preKey `json:"signedPreKey"`
+	OneTimePreKey  *preKey `json:"oneTimePreKey"`
+}
+
+func (r *Resolver) deviceType() *graphql.Object {
+	if r.deviceObj == nil {
+		r.deviceObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "Device",
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return graphql.Fields{
+					"id": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+					"deviceId": &graphql.Field{
+						Type: graphql.NewNonNull(graphql.Int),
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							return p.Source.(*ent.Device).DeviceID, nil
+						},
+					},
+					"registrationId": &graphql.Field{
+						Type: graphql.NewNonNull(graphql.Int),
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							return p.Source.(*ent.Device).RegistrationID, nil
+						},
+					},
+					"name": &graphql.Field{
+						Type:        graphql.String,
+						Description: "Only visible to the device's user.",
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							dev := p.Source.(*ent.Device)
+							if !r.ownsDevice(p.Context, dev) {
+								return nil, nil
+							}
+							return dev.Name, nil
+						},
+					},
+					"identityKey": &graphql.Field{
+						Type: graphql.String,
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							key, err := p.Source.(*ent.Device).QueryIdentityKey().Only(p.Context)
+							if ent.IsNotFound(err) {
+								return nil, nil
+							}
+							if err != nil {
+								return nil, err
+							}
+							return key.PublicKey, nil
+						},
+					},
+					"oneTimePreKeyCount": &graphql.Field{
+						Type:        graphql.Int,
+						Description: "Unclaimed one-time prekeys. Only visible to the device's user.",
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							dev := p.Source.(*ent.Device)
+							if !r.ownsDevice(p.Context, dev) {
+								return nil, nil
+							}
+							return dev.QueryOneTimePreKeys().Count(p.Context)
+						},
+					},
+					"keyPackageCount": &graphql.Field{
+						Type:        graphql.Int,
+						Description: "Unclaimed MLS KeyPackages. Only visible to the device's user.",
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							dev := p.Source.(*ent.Device)
+							if !r.ownsDevice(p.Context, dev) {
+								return nil, nil
+							}
+							return dev.QueryKeyPackages().Count(p.Context)
+						},
+					},
+					"createdAt": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+					"updatedAt": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("UpdatedAt")},
+					"user": &graphql.Field{
+						Type: r.userType(),
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							return p.Source.(*ent.Device).QueryUser().Only(p.Context)
+						},
+					},
+				}
+			}),
+		})
+	}
+	return r.deviceObj
+}
+
+func (r *Resolver) preKeyType() *graphql.Object {
+	if r.preKeyObj == nil {
+		r.preKeyObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "PreKey",
+			Fields: graphql.Fields{
+				"keyId":     &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"publicKey": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"signature": &graphql.Field{Type: graphql.String, Description: "Set on signed prekeys."},
+			},
+		})
+	}
+	return r.preKeyObj
+}
+
+func (r *Resolver) preKeyBundleType() *graphql.Object {
+	if r.preKeyBundleObj == nil {
+		r.preKeyBundleObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "PreKeyBundle",
+			Fields: graphql.Fields{
+				"userId":         &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+				"deviceId":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"registrationId": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"identityKey":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"signedPreKey":   &graphql.Field{Type: graphql.NewNonNull(r.preKeyType())},
+				"oneTimePreKey": &graphql.Field{
+					Type:        r.preKeyType(),
+					Description: "Null when the device has run out of one-time prekeys.",
+				},
+			},
+		})
+	}
+	return r.preKeyBundleObj
+}
+
+func (r *Resolver) preKeyInputType() *graphql.InputObject {
+	if r.preKeyInputObj == nil {
+		r.preKeyInputObj = graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: "PreKeyInput",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"keyId":     &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"publicKey": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String), Description: "Base64 encoded public key."},
+			},
+		})
+	}
+	return r.preKeyInputObj
+}
+
+func (r *Resolver) signedPreKeyInputType() *graphql.InputObject {
+	if r.signedPreKeyInputObj == nil {
+		r.signedPreKeyInputObj = graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: "SignedPreKeyInput",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"keyId":     &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"publicKey": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String), Description: "Base64 encoded public key."},
+				"signature": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String), Description: "Base64 encoded signature by the identity key."},
+			},
+		})
+	}
+	return r.signedPreKeyInputObj
+}
+
+func (r *Resolver) preKeyQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"devices": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.deviceType()))),
+			Description: "Lists the registered devices of a user.",
+			Args: graphql.FieldConfigArgument{
+				"userId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				userID, err := decodeID(p.Args["userId"])
+				if err != nil {
+					return nil, err
+				}
+				return r.Client.Device.Query().
+					Where(device.HasUserWith(user.ID(userID))).
+					Order(ent.Asc(device.FieldDeviceID)).
+					All(p.Context)
+			},
+		},
+	}
+}
+
+func (r *Resolver) preKeyMutationFields() graphql.Fields {
+	return graphql.Fields{
+		"registerDevice": &graphql.Field{
+			Type:        r.deviceType(),
+			Description: "Registers a device with its identity key and prekeys, or re-registers it. A new identity key discards the device's previous prekeys and is announced to everyone sharing a room with the caller.",
+			Args: graphql.FieldConfigArgument{
+				"deviceId":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"registrationId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"name":           &graphql.ArgumentConfig{Type: graphql.String},
+				"identityKey":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"signedPreKey":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(r.signedPreKeyInputType())},
+				"oneTimePreKeys": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(r.preKeyInputType()))},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				identityKey := p.Args["identityKey"].(string)
+				if err := validateKeyMaterial(identityKey); err != nil {
+					return nil, err
+				}
+				signed, err := decodePreKey(p.Args["signedPreKey"], true)
+				if err != nil {
+					return nil, err
+				}
+				oneTime, err := decodePreKeys(p.Args["oneTimePreKeys"])
+				if err != nil {
+					return nil, err
+				}
+				return r.registerDevice(p.Context, uid, p.Args, identityKey, signed, oneTime)
+			},
+		},
+		"uploadSignedPreKey": &graphql.Field{
+			Type:        r.deviceType(),
+			Description: "Replaces the signed prekey of one of the caller's devices.",
+			Args: graphql.FieldConfigArgument{
+				"deviceId":     &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"signedPreKey": &graphql.ArgumentConfig{Type: graphql.NewNonNull(r.signedPreKeyInputType())},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				signed, err := decodePreKey(p.Args["signedPreKey"], true)
+				if err != nil {
+					return nil, err
+				}
+				dev, err := r.ownDevice(p.Context, uid, p.Args["deviceId"].(int))
+				if err != nil {
+					return nil, err
+				}
+				tx, err := r.Client.Tx(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				defer rollbackOnError(tx, &err)
+				if err = replaceSignedPreKeyTx(p.Context, tx, dev.ID, signed); err != nil {
+					return nil, err
+				}
+				if err = tx.Commit(); err != nil {
+					return nil, err
+				}
+				return dev, nil
+			},
+		},
+		"uploadOneTimePreKeys": &graphql.Field{
+			Type:        graphql.Int,
+			Description: "Adds one-time prekeys to one of the caller's devices and returns how many are unclaimed.",
+			Args: graphql.FieldConfigArgument{
+				"deviceId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"preKeys":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.preKeyInputType())))},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				keys, err := decodePreKeys(p.Args["preKeys"])
+				if err != nil {
+					return nil, err
+				}
+				dev, err := r.ownDevice(p.Context, uid, p.Args["deviceId"].(int))
+				if err != nil {
+					return nil, err
+				}
+				tx, err := r.Client.Tx(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				defer rollbackOnError(tx, &err)
+				if err = addOneTimePreKeysTx(p.Context, tx, dev.ID, keys); err != nil {
+					return nil, err
+				}
+				count, err := tx.OneTimePreKey.Query().Where(onetimeprekey.HasDeviceWith(device.ID(dev.ID))).Count(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				if err = tx.Commit(); err != nil {
+					return nil, err
+				}
+				return count, nil
+			},
+		},
+		"removeDevice": &graphql.Field{
+			Type:        graphql.Boolean,
+			Description: "Removes one of the caller's devices together with its keys.",
+			Args: graphql.FieldConfigArgument{
+				"deviceId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				dev, err := r.ownDevice(p.Context, uid, p.Args["deviceId"].(int))
+				if err != nil {
+					return nil, err
+				}
+				tx, err := r.Client.Tx(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				defer rollbackOnError(tx, &err)
+				if err = deleteDevicesTx(p.Context, tx, device.ID(dev.ID)); err != nil {
+					return nil, err
+				}
+				if err = tx.Commit(); err != nil {
+					return nil, err
+				}
+				return true, nil
+			},
+		},
+		"claimPreKeyBundle": &graphql.Field{
+			Type:        r.preKeyBundleType(),
+			Description: "Returns a prekey bundle for a device of a user the caller shares a room with or has as an unblocked contact, consuming one of its one-time prekeys.",
+			Args: graphql.FieldConfigArgument{
+				"userId":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"deviceId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				userID, err := decodeID(p.Args["userId"])
+				if err != nil {
+					return nil, err
+				}
+				deviceID := p.Args["deviceId"].(int)
+				if err := r.canClaimPreKeys(p.Context, uid, userID); err != nil {
+					return nil, err
+				}
+				if !r.preKeyClaims.allow(uid, userID, deviceID, time.Now()) {
+					return nil, ErrPreKeyClaimsThrottled
+				}
+				return r.claimPreKeyBundle(p.Context, userID, deviceID)
+			},
+		},
+	}
+}
+
+// registerDevice creates or refreshes a device of the user with the given keys.
+func (r *Resolver) registerDevice(ctx context.Context, uid int, args map[string]interface{}, identityKey string, signed *preKey, oneTime []*preKey) (dev *ent.Device, err error) {
+	deviceID := args["deviceId"].(int)
+	registrationID := args["registrationId"].(int)
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	dev, err = tx.Device.Query().
+		Where(device.DeviceID(deviceID), device.HasUserWith(user.ID(uid))).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		create := tx.Device.Create().
+			SetUserID(uid).
+			SetDeviceID(deviceID).
+			SetRegistrationID(registrationID)
+		if name, ok := args["name"].(string); ok {
+			create.SetName(name)
+		}
+		if dev, err = create.Save(ctx); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		update := dev.Update().SetRegistrationID(registrationID).ClearLowPrekeysNotifiedAt()
+		if name, ok := args["name"].(string); ok {
+			update.SetName(name)
+		}
+		if dev, err = update.Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	keyChanged, err := replaceIdentityKeyTx(ctx, tx, uid, dev, identityKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = replaceSignedPreKeyTx(ctx, tx, dev.ID, signed); err != nil {
+		return nil, err
+	}
+	if err = addOneTimePreKeysTx(ctx, tx, dev.ID, oneTime); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	if keyChanged {
+		r.notifyIdentityKeyChanged(ctx, uid, deviceID, identityKey)
+	}
+	return dev.Unwrap(), nil
+}
+
+// replaceIdentityKeyTx stores the identity key of a new or re-registered
+// device, appends it to the device's history and reports whether it replaced
+// a different key. Prekeys signed by a previous identity key can no longer be
+// used and are dropped.
+func replaceIdentityKeyTx(ctx context.Context, tx *ent.Tx, userID int, dev *ent.Device, publicKey string) (bool, error) {
+	now := time.Now()
+	current, err := tx.IdentityKey.Query().Where(identitykey.HasDeviceWith(device.ID(dev.ID))).Only(ctx)
+	if ent.IsNotFound(err) {
+		// A removed device registered again under the same number is compared
+		// with the last key it used, so swapping the key this way is noticed.
+		last, err := lastIdentityKeyTx(ctx, tx, userID, dev.DeviceID)
+		if err != nil {
+			return false, err
+		}
+		if last != nil && last.PublicKey == publicKey {
+			if err := tx.IdentityKey.Create().SetDevice(dev).SetPublicKey(publicKey).SetCreatedAt(last.CreatedAt).Exec(ctx); err != nil {
+				return false, err
+			}
+			return false, nil
+		}
+		if err := tx.IdentityKey.Create().SetDevice(dev).SetPublicKey(publicKey).SetCreatedAt(now).Exec(ctx); err != nil {
+			return false, err
+		}
+		return last != nil, recordIdentityKeyTx(ctx, tx, userID, dev, publicKey, now)
+	}
+	if err != nil {
+		return false, err
+	}
+	if current.PublicKey == publicKey {
+		return false, nil
+	}
+	// Devices registered before the history was kept start it with the key
+	// they are replacing.
+	recorded, err := tx.IdentityKeyRecord.Query().Where(identitykeyrecord.HasDeviceWith(device.ID(dev.ID))).Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !recorded {
+		if err := recordIdentityKeyTx(ctx, tx, userID, dev, current.PublicKey, current.CreatedAt); err != nil {
+			return false, err
+		}
+	}
+	if _, err := tx.OneTimePreKey.Delete().Where(onetimeprekey.HasDeviceWith(device.ID(dev.ID))).Exec(ctx); err != nil {
+		return false, err
+	}
+	if err := current.Update().SetPublicKey(publicKey).SetCreatedAt(now).Exec(ctx); err != nil {
+		return false, err
+	}
+	return true, recordIdentityKeyTx(ctx, tx, userID, dev, publicKey, now)
+}
+
+// replaceSignedPreKeyTx makes key the device's only signed prekey.
+func replaceSignedPreKeyTx(ctx context.Context, tx *ent.Tx, deviceID int, key *preKey) error {
+	if _, err := tx.SignedPreKey.Delete().Where(signedprekey.HasDeviceWith(device.ID(deviceID))).Exec(ctx); err != nil {
+		return err
+	}
+	return tx.SignedPreKey.Create().
+		SetDeviceID(deviceID).
+		SetKeyID(key.KeyID).
+		SetPublicKey(key.PublicKey).
+		SetSignature(key.Signature).
+		Exec(ctx)
+}
+
+// addOneTimePreKeysTx stores new one-time prekeys for a device and re-arms
+// the low-prekey notification.
+func addOneTimePreKeysTx(ctx context.Context, tx *ent.Tx, deviceID int, keys []*preKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	existing, err := tx.OneTimePreKey.Query().Where(onetimeprekey.HasDeviceWith(device.ID(deviceID))).Count(ctx)
+	if err != nil {
+		return err
+	}
+	if existing+len(keys) > maxOneTimePreKeys {
+		return fmt.Errorf("a device can hold at most %d one-time prekeys", maxOneTimePreKeys)
+	}
+	builders := make([]*ent.OneTimePreKeyCreate, 0, len(keys))
+	for _, key := range keys {
+		builders = append(builders, tx.OneTimePreKey.Create().
+			SetDeviceID(deviceID).
+			SetKeyID(key.KeyID).
+			SetPublicKey(key.PublicKey))
+	}
+	if err := tx.OneTimePreKey.CreateBulk(builders...).Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return errors.New("a one-time prekey with that id was already uploaded")
+		}
+		return err
+	}
+	return tx.Device.UpdateOneID(deviceID).ClearLowPrekeysNotifiedAt().Exec(ctx)
+}
+
+// canClaimPreKeys allows a caller to claim the one-time prekeys of their own
+// devices and of users they share a room with or keep as an unblocked
+// contact, unless that user has blocked them. Anyone else could otherwise use
+// up every device's prekeys and push later sessions onto the signed prekey.
+func (r *Resolver) canClaimPreKeys(ctx context.Context, callerID, userID int) error {
+	if callerID == userID {
+		return nil
+	}
+	ctx = rule.SystemContext(ctx)
+	blocked, err := r.Client.Contact.Query().
+		Where(
+			contact.HasOwnerWith(user.ID(userID)),
+			contact.HasContactWith(user.ID(callerID)),
+			contact.IsBlocked(true),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrForbidden
+	}
+	related, err := r.Client.User.Query().
+		Where(
+			user.ID(userID),
+			user.Or(
+				user.HasMembershipsWith(roommembership.HasRoomWith(
+					room.HasMembershipsWith(roommembership.HasUserWith(user.ID(callerID))),
+				)),
+				user.HasContactEntriesWith(
+					contact.HasOwnerWith(user.ID(callerID)),
+					contact.IsBlocked(false),
+				),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !related {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// preKeyClaimLimiter counts the prekey bundles each caller claims in fixed
+// windows, per target device and in total.
+type preKeyClaimLimiter struct {
+	mu     sync.Mutex
+	counts map[preKeyClaimKey]*preKeyClaimCount
+	swept  time.Time
+}
+
+// preKeyClaimKey identifies a caller's claims for one device, or all of them
+// when userID and deviceID are zero.
+type preKeyClaimKey struct {
+	callerID, userID, deviceID int
+}
+
+type preKeyClaimCount struct {
+	start time.Time
+	n     int
+}
+
+func newPreKeyClaimLimiter() *preKeyClaimLimiter {
+	return &preKeyClaimLimiter{counts: make(map[preKeyClaimKey]*preKeyClaimCount)}
+}
+
+// allow counts a claim and reports whether it stays within the limits.
+func (l *preKeyClaimLimiter) allow(callerID, userID, deviceID int, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Sub(l.swept) >= preKeyClaimWindow {
+		for key, count := range l.counts {
+			if now.Sub(count.start) >= preKeyClaimWindow {
+				delete(l.counts, key)
+			}
+		}
+		l.swept = now
+	}
+	device := l.count(preKeyClaimKey{callerID, userID, deviceID}, now)
+	total := l.count(preKeyClaimKey{callerID: callerID}, now)
+	if device.n >= maxDevicePreKeyClaims || total.n >= maxPreKeyClaims {
+		return false
+	}
+	device.n++
+	total.n++
+	return true
+}
+
+// count returns the current window of a counter, starting a new one when the
+// last has ended.
+func (l *preKeyClaimLimiter) count(key preKeyClaimKey, now time.Time) *preKeyClaimCount {
+	count, ok := l.counts[key]
+	if !ok || now.Sub(count.start) >= preKeyClaimWindow {
+		count = &preKeyClaimCount{start: now}
+		l.counts[key] = count
+	}
+	return count
+}
+
+// claimPreKeyBundle assembles a bundle for the device and consumes its oldest
+// one-time prekey. The device row is touched before anything is read, so
+// concurrent claims for it run one after another under the transaction's
+// write lock, and the delete is guarded by the key's ID, so claimers never
+// receive the same key.
+func (r *Resolver) claimPreKeyBundle(ctx context.Context, userID, deviceID int) (bundle *preKeyBundle, err error) {
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	touched, err := tx.Device.Update().
+		Where(device.DeviceID(deviceID), device.HasUserWith(user.ID(userID))).
+		SetUpdatedAt(time.Now()).
+		Save(rule.SystemContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if touched == 0 {
+		err = ErrDeviceNotFound
+		return nil, err
+	}
+	dev, err := tx.Device.Query().
+		Where(device.DeviceID(deviceID), device.HasUserWith(user.ID(userID))).
+		WithIdentityKey().
+		WithSignedPreKeys(func(q *ent.SignedPreKeyQuery) {
+			q.Order(ent.Desc(signedprekey.FieldCreatedAt), ent.Desc(signedprekey.FieldID)).Limit(1)
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrDeviceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if dev.Edges.IdentityKey == nil || len(dev.Edges.SignedPreKeys) == 0 {
+		return nil, errors.New("device has not published its keys")
+	}
+	signed := dev.Edges.SignedPreKeys[0]
+	bundle = &preKeyBundle{
+		UserID:         userID,
+		DeviceID:       dev.DeviceID,
+		RegistrationID: dev.RegistrationID,
+		IdentityKey:    dev.Edges.IdentityKey.PublicKey,
+		SignedPreKey:   &preKey{KeyID: signed.KeyID, PublicKey: signed.PublicKey, Signature: signed.Signature},
+	}
+	for attempt := 0; attempt < 3 && bundle.OneTimePreKey == nil; attempt++ {
+		key, err := tx.OneTimePreKey.Query().
+			Where(onetimeprekey.HasDeviceWith(device.ID(dev.ID))).
+			Order(ent.Asc(onetimeprekey.FieldID)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		deleted, err := tx.OneTimePreKey.Delete().Where(onetimeprekey.ID(key.ID)).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if deleted == 1 {
+			bundle.OneTimePreKey = &preKey{KeyID: key.KeyID, PublicKey: key.PublicKey}
+		}
+	}
+	remaining, err := tx.OneTimePreKey.Query().Where(onetimeprekey.HasDeviceWith(device.ID(dev.ID))).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notify := false
+	if remaining < lowPreKeyThreshold {
+		// Only the claim that arms the flag sends the notification.
+		armed, err := tx.Device.Update().
+			Where(device.ID(dev.ID), device.LowPrekeysNotifiedAtIsNil()).
+			SetLowPrekeysNotifiedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		notify = armed == 1
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	if notify {
+		// The prekey is already handed out, so a failed notice must not fail
+		// the claim. Disarming the flag lets the next claim send it instead.
+		payload := map[string]interface{}{"deviceId": dev.DeviceID, "remaining": remaining}
+		if err := r.publishServerNotification(ctx, userID, NotificationKindPreKeysLow, payload); err != nil {
+			log.Printf("low prekey notice for user %d device %d: %v", userID, dev.DeviceID, err)
+			if err := r.Client.Device.UpdateOneID(dev.ID).ClearLowPrekeysNotifiedAt().Exec(rule.SystemContext(ctx)); err != nil {
+				log.Printf("low prekey notice for user %d device %d: %v", userID, dev.DeviceID, err)
+			}
+		}
+	}
+	return bundle, nil
+}
+
+// ownDevice loads one of the user's devices by its client-chosen ID.
+func (r *Resolver) ownDevice(ctx context.Context, uid, deviceID int) (*ent.Device, error) {
+	dev, err := r.Client.Device.Query().
+		Where(device.DeviceID(deviceID), device.HasUserWith(user.ID(uid))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrDeviceNotFound
+	}
+	return dev, err
+}
+
+// ownsDevice reports whether the viewer is the device's user.
+func (r *Resolver) ownsDevice(ctx context.Context, dev *ent.Device) bool {
+	uid, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		return false
+	}
+	owned, err := r.Client.Device.Query().
+		Where(device.ID(dev.ID), device.HasUserWith(user.ID(uid))).
+		Exist(ctx)
+	return err == nil && owned
+}
+
+// deleteDevicesTx removes the matching devices with all of their keys and the
+// message envelopes, sender keys and MLS welcomes addressed to them. Their
+// identity key history is kept.
+func deleteDevicesTx(ctx context.Context, tx *ent.Tx, devices ...predicate.Device) error {
+	match := device.And(devices...)
+	// Envelopes and sender keys addressed to a device were sent by other users,
+	// so the device's owner may not delete them through the privacy rules.
+	if _, err := tx.MessageEnvelope.Delete().Where(messageenvelope.HasDeviceWith(match)).Exec(rule.SystemContext(ctx)); err != nil {
+		return err
+	}
+	if _, err := tx.SenderKeyDistribution.Delete().Where(senderkeydistribution.HasDeviceWith(match)).Exec(rule.SystemContext(ctx)); err != nil {
+		return err
+	}
+	if _, err := tx.MlsWelcome.Delete().Where(mlswelcome.HasDeviceWith(match)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.KeyPackage.Delete().Where(keypackage.HasDeviceWith(match)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.OneTimePreKey.Delete().Where(onetimeprekey.HasDeviceWith(match)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.SignedPreKey.Delete().Where(signedprekey.HasDeviceWith(match)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.IdentityKey.Delete().Where(identitykey.HasDeviceWith(match)).Exec(ctx); err != nil {
+		return err
+	}
+	// The identity key history outlives the device.
+	if err := tx.IdentityKeyRecord.Update().Where(identitykeyrecord.HasDeviceWith(match)).ClearDevice().Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.Device.Delete().Where(match).Exec(ctx)
+	return err
+}
+
+// decodePreKey reads a PreKeyInput or SignedPreKeyInput argument.
+func decodePreKey(raw interface{}, signed bool) (*preKey, error) {
+	fields, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid prekey")
+	}
+	key := &preKey{}
+	key.KeyID, _ = fields["keyId"].(int)
+	key.PublicKey, _ = fields["publicKey"].(string)
+	if key.KeyID < 0 {
+		return nil, errors.New("prekey id must not be negative")
+	}
+	if err := validateKeyMaterial(key.PublicKey); err != nil {
+		return nil, err
+	}
+	if signed {
+		key.Signature, _ = fields["signature"].(string)
+		if err := validateKeyMaterial(key.Signature); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+func decodePreKeys(raw interface{}) ([]*preKey, error) {
+	list, _ := raw.([]interface{})
+	keys := make([]*preKey, 0, len(list))
+	for _, item := range list {
+		key, err := decodePreKey(item, false)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// validateKeyMaterial checks that a public key or signature is non-empty
+// base64. The server cannot verify signatures made with XEdDSA; clients check
+// them against the identity key when they process a bundle.
+func validateKeyMaterial(value string) error {
+	if value == "" || len(value) > maxPublicKeyLength {
+		return errors.New("key material must be 1 to 1024 characters of base64")
+	}
+	if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+		return errors.New("key material must be standard base64")
+	}
+	return nil
+}
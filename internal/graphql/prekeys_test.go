@@ -0,0 +1,126 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/eleven-am/enclave/internal/auth"
+)
+
+const testPublicKey = "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+
+// registerDevice registers a device of uid with oneTime one-time prekeys.
+func (env *testEnv) registerDevice(uid, deviceID, oneTime int) {
+	env.t.Helper()
+	keys := make([]string, oneTime)
+	for i := range keys {
+		keys[i] = fmt.Sprintf(`{keyId: %d, publicKey: %q}`, i+1, testPublicKey)
+	}
+	env.do(uid, fmt.Sprintf(`mutation { registerDevice(deviceId: %d, registrationId: 1, identityKey: %q, signedPreKey: {keyId: 1, publicKey: %q, signature: %q}, oneTimePreKeys: [%s]) { deviceId } }`,
+		deviceID, testPublicKey, testPublicKey, testPublicKey, strings.Join(keys, ",")))
+}
+
+// claimPreKeyBundle claims a bundle for a device and returns the ID of its
+// one-time prekey, or zero when none was left.
+func (env *testEnv) claimPreKeyBundle(claims *auth.Claims, userID, deviceID int) (int, error) {
+	data, err := env.execClaims(claims, fmt.Sprintf(`mutation { claimPreKeyBundle(userId: "%d", deviceId: %d) { oneTimePreKey { keyId } } }`, userID, deviceID))
+	if err != nil {
+		return 0, err
+	}
+	key, _ := data["claimPreKeyBundle"].(map[string]interface{})["oneTimePreKey"].(map[string]interface{})
+	if key == nil {
+		return 0, nil
+	}
+	return key["keyId"].(int), nil
+}
+
+func TestClaimPreKeyBundleHandsOutEachKeyOnce(t *testing.T) {
+	env := newTestEnv(t)
+	alice := env.addUser("alice")
+	bob := env.addUser("bob")
+	env.createRoom(alice, bob)
+	env.registerDevice(alice, 1, 5)
+
+	const claims = 8
+	keyIDs := make([]int, claims)
+	errs := make([]error, claims)
+	var wg sync.WaitGroup
+	for i := 0; i < claims; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			keyIDs[i], errs[i] = env.claimPreKeyBundle(&auth.Claims{UserID: bob}, alice, 1)
+		}(i)
+	}
+	wg.Wait()
+	seen := map[int]bool{}
+	for i, id := range keyIDs {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		if id == 0 {
+			continue
+		}
+		if seen[id] {
+			t.Fatalf("one-time prekey %d was handed out twice", id)
+		}
+		seen[id] = true
+	}
+	if len(seen) != 5 {
+		t.Fatalf("%d one-time prekeys were handed out, want all 5", len(seen))
+	}
+}
+
+func TestClaimPreKeyBundleRequiresRelationship(t *testing.T) {
+	env := newTestEnv(t)
+	alice := env.addUser("alice")
+	bob := env.addUser("bob")
+	carol := env.addUser("carol")
+	eve := env.addUser("eve")
+	env.createRoom(alice, bob)
+	env.registerDevice(alice, 1, 20)
+	env.do(carol, fmt.Sprintf(`mutation { createContact(contactId: "%d") { id } }`, alice))
+
+	if _, err := env.claimPreKeyBundle(&auth.Claims{UserID: eve}, alice, 1); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("stranger claim returned %v, want %v", err, ErrForbidden)
+	}
+	if _, err := env.claimPreKeyBundle(&auth.Claims{UserID: carol}, alice, 1); err != nil {
+		t.Fatalf("contact claim: %v", err)
+	}
+	if _, err := env.claimPreKeyBundle(&auth.Claims{UserID: bob, APIKeyID: 1, Scopes: auth.Scopes}, alice, 1); !errors.Is(err, auth.ErrInsufficientScope) {
+		t.Fatalf("API key claim returned %v, want %v", err, auth.ErrInsufficientScope)
+	}
+	if _, err := env.claimPreKeyBundle(&auth.Claims{UserID: bob}, alice, 1); err != nil {
+		t.Fatalf("room peer claim: %v", err)
+	}
+
+	data := env.do(alice, fmt.Sprintf(`mutation { createContact(contactId: "%d") { id } }`, bob))
+	env.do(alice, fmt.Sprintf(`mutation { updateContact(id: %q, isBlocked: true) { id } }`, data["createContact"].(map[string]interface{})["id"]))
+	if _, err := env.claimPreKeyBundle(&auth.Claims{UserID: bob}, alice, 1); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("claim from a blocked user returned %v, want %v", err, ErrForbidden)
+	}
+}
+
+func TestClaimPreKeyBundleIsRateLimited(t *testing.T) {
+	env := newTestEnv(t)
+	alice := env.addUser("alice")
+	bob := env.addUser("bob")
+	env.createRoom(alice, bob)
+	env.registerDevice(alice, 1, 20)
+	env.registerDevice(alice, 2, 20)
+
+	for i := 0; i < maxDevicePreKeyClaims; i++ {
+		if _, err := env.claimPreKeyBundle(&auth.Claims{UserID: bob}, alice, 1); err != nil {
+			t.Fatalf("claim %d: %v", i+1, err)
+		}
+	}
+	if _, err := env.claimPreKeyBundle(&auth.Claims{UserID: bob}, alice, 1); !errors.Is(err, ErrPreKeyClaimsThrottled) {
+		t.Fatalf("claim past the limit returned %v, want %v", err, ErrPreKeyClaimsThrottled)
+	}
+	if _, err := env.claimPreKeyBundle(&auth.Claims{UserID: bob}, alice, 2); err != nil {
+		t.Fatalf("claim for another device: %v", err)
+	}
+}
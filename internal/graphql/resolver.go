@@ -2,9 +2,9 @@ package graphql
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
-	"sort"
 	"time"
 
 	"github.com/graphql-go/graphql"
@@ -15,32 +15,104 @@ import (
 	"github.com/eleven-am/enclave/ent/callparticipant"
 	"github.com/eleven-am/enclave/ent/contact"
 	"github.com/eleven-am/enclave/ent/favourite"
-	"github.com/eleven-am/enclave/ent/media"
 	"github.com/eleven-am/enclave/ent/message"
 	"github.com/eleven-am/enclave/ent/notification"
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/roommembership"
 	"github.com/eleven-am/enclave/ent/user"
 	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/internal/directory"
+	"github.com/eleven-am/enclave/internal/encryption"
+	"github.com/eleven-am/enclave/internal/mail"
+	"github.com/eleven-am/enclave/internal/rule"
 )
 
 // Resolver encapsulates access to ent.Client for GraphQL handlers.
 type NotificationListener func(context.Context, int, *ent.Notification)
 
 type Resolver struct {
-	Client                *ent.Client
-	userObj               *graphql.Object
-	roomObj               *graphql.Object
-	roomMembershipObj     *graphql.Object
-	messageObj            *graphql.Object
-	mediaObj              *graphql.Object
-	contactObj            *graphql.Object
-	favouriteObj          *graphql.Object
-	callLogObj            *graphql.Object
-	callParticipantObj    *graphql.Object
-	notificationObj       *graphql.Object
-	notificationBroker    *notificationBroker
-	notificationListeners []NotificationListener
+	Client                    *ent.Client
+	userObj                   *graphql.Object
+	roomObj                   *graphql.Object
+	roomMembershipObj         *graphql.Object
+	messageObj                *graphql.Object
+	mediaObj                  *graphql.Object
+	contactObj                *graphql.Object
+	favouriteObj              *graphql.Object
+	callLogObj                *graphql.Object
+	callParticipantObj        *graphql.Object
+	notificationObj           *graphql.Object
+	authPayloadObj            *graphql.Object
+	sessionObj                *graphql.Object
+	mfaChallengeObj           *graphql.Object
+	totpEnrollmentObj         *graphql.Object
+	twoFactorStatusObj        *graphql.Object
+	apiKeyObj                 *graphql.Object
+	createdAPIKeyObj          *graphql.Object
+	instanceStatsObj          *graphql.Object
+	adminQueryObj             *graphql.Object
+	adminMutationObj          *graphql.Object
+	pageInfoObj               *graphql.Object
+	userConnectionObj         *graphql.Object
+	messageConnectionObj      *graphql.Object
+	notificationConnectionObj *graphql.Object
+	callLogConnectionObj      *graphql.Object
+	contactConnectionObj      *graphql.Object
+	roomConnectionObj         *graphql.Object
+	messageEventObj           *graphql.Object
+	deviceObj                 *graphql.Object
+	preKeyObj                 *graphql.Object
+	preKeyBundleObj           *graphql.Object
+	preKeyInputObj            *graphql.InputObject
+	signedPreKeyInputObj      *graphql.InputObject
+	messageEnvelopeObj        *graphql.Object
+	messageEnvelopeInputObj   *graphql.InputObject
+	keyPackageObj             *graphql.Object
+	mlsCommitObj              *graphql.Object
+	mlsWelcomeObj             *graphql.Object
+	keyPackageInputObj        *graphql.InputObject
+	deviceAddressInputObj     *graphql.InputObject
+	mlsWelcomeInputObj        *graphql.InputObject
+	senderKeyDistributionObj  *graphql.Object
+	identityKeyRecordObj      *graphql.Object
+	keyLogEntryObj            *graphql.Object
+	keyLogTreeHeadObj         *graphql.Object
+	keyLogInclusionProofObj   *graphql.Object
+	keyLogConsistencyProofObj *graphql.Object
+	encryptionSchemeObj       *graphql.Object
+	encryptionRequirementObj  *graphql.Object
+	requirementInputObj       *graphql.InputObject
+	keyBackupVersionObj       *graphql.Object
+	keyBackupKeyObj           *graphql.Object
+	keyBackupKeyInputObj      *graphql.InputObject
+	notificationBroker        *notificationBroker
+	messageBroker             *messageBroker
+	notificationListeners     []NotificationListener
+	messageListeners          []MessageListener
+	sessionRevokedListeners   []SessionRevokedListener
+	tokens                    *auth.TokenService
+	directory                 *directory.Index
+	deletionGrace             time.Duration
+	preKeyClaims              *preKeyClaimLimiter
+	mailer                    mail.Mailer
+	appURL                    string
+	logKey                    ed25519.PrivateKey
+}
+
+// Config carries the services the GraphQL resolvers depend on.
+type Config struct {
+	Tokens    *auth.TokenService
+	Directory *directory.Index
+	// DeletionGracePeriod is how long a deleted account can be restored
+	// before it is purged. Zero selects DefaultDeletionGracePeriod.
+	DeletionGracePeriod time.Duration
+	// Mailer delivers verification and password reset emails.
+	Mailer mail.Mailer
+	// AppURL is the base URL of the client app. Emailed links point at its
+	// /verify-email and /reset-password pages; without it emails carry the bare token.
+	AppURL string
+	// LogKey signs the tree heads of the key transparency log.
+	LogKey ed25519.PrivateKey
 }
 
 // ErrUnauthorized indicates the caller is not authorized to perform an action.
@@ -50,8 +122,34 @@ var ErrUnauthorized = errors.New("unauthorized")
 var ErrForbidden = errors.New("forbidden")
 
 // NewSchema constructs the GraphQL schema with resolvers backed by ent.
-func NewSchema(client *ent.Client) (graphql.Schema, *Resolver, error) {
-	r := &Resolver{Client: client, notificationBroker: newNotificationBroker()}
+func NewSchema(client *ent.Client, cfg Config) (graphql.Schema, *Resolver, error) {
+	if cfg.Tokens == nil {
+		return graphql.Schema{}, nil, errors.New("graphql schema requires a token service")
+	}
+	if cfg.Directory == nil {
+		return graphql.Schema{}, nil, errors.New("graphql schema requires a user directory")
+	}
+	if cfg.Mailer == nil {
+		return graphql.Schema{}, nil, errors.New("graphql schema requires a mailer")
+	}
+	if len(cfg.LogKey) != ed25519.PrivateKeySize {
+		return graphql.Schema{}, nil, errors.New("graphql schema requires an ed25519 key transparency log key")
+	}
+	if cfg.DeletionGracePeriod <= 0 {
+		cfg.DeletionGracePeriod = DefaultDeletionGracePeriod
+	}
+	r := &Resolver{
+		Client:             client,
+		notificationBroker: newNotificationBroker(),
+		messageBroker:      newMessageBroker(),
+		tokens:             cfg.Tokens,
+		directory:          cfg.Directory,
+		deletionGrace:      cfg.DeletionGracePeriod,
+		preKeyClaims:       newPreKeyClaimLimiter(),
+		mailer:             cfg.Mailer,
+		appURL:             cfg.AppURL,
+		logKey:             cfg.LogKey,
+	}
 	schemaConfig := graphql.SchemaConfig{
 		Query:        graphql.NewObject(r.queryFields()),
 		Mutation:     graphql.NewObject(r.mutationFields()),
@@ -81,11 +179,16 @@ func (r *Resolver) publishNotification(ctx context.Context, userID int, n *ent.N
 func (r *Resolver) queryFields() graphql.ObjectConfig {
 	return graphql.ObjectConfig{
 		Name: "Query",
-		Fields: graphql.Fields{
+		Fields: withScopes(withPrivacyErrors(mergeFields(graphql.Fields{
 			"users": &graphql.Field{
-				Type: graphql.NewList(r.userType()),
+				Type:              graphql.NewList(r.userType()),
+				DeprecationReason: "Use searchUsers.",
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					users, err := r.Client.User.Query().Order(ent.Asc(user.FieldUsername)).All(p.Context)
+					uid, err := auth.UserIDFromContext(p.Context)
+					if err != nil {
+						return nil, ErrUnauthorized
+					}
+					users, err := r.Client.User.Query().Where(discoverableBy(uid)).Order(ent.Asc(user.FieldUsername)).All(p.Context)
 					return users, err
 				},
 			},
@@ -95,6 +198,9 @@ func (r *Resolver) queryFields() graphql.ObjectConfig {
 					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
+						return nil, ErrUnauthorized
+					}
 					id, err := decodeID(p.Args["id"])
 					if err != nil {
 						return nil, err
@@ -103,31 +209,30 @@ func (r *Resolver) queryFields() graphql.ObjectConfig {
 				},
 			},
 			"rooms": &graphql.Field{
-				Type: graphql.NewList(r.roomType()),
+				Type:        graphql.NewNonNull(r.roomConnectionType()),
+				Description: "Pages through the caller's rooms, oldest first.",
+				Args:        connectionArgs(nil),
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					uid, err := auth.UserIDFromContext(p.Context)
 					if err != nil {
 						return nil, ErrUnauthorized
 					}
-					memberships, err := r.Client.RoomMembership.Query().
-						Where(roommembership.HasUserWith(user.ID(uid))).
-						WithRoom(func(q *ent.RoomQuery) {
-							q.WithOwner()
-						}).
-						All(p.Context)
+					page, err := decodePageArgs("room", p.Args)
 					if err != nil {
 						return nil, err
 					}
-					rooms := make([]*ent.Room, 0, len(memberships))
-					for _, m := range memberships {
-						if room := m.Edges.Room; room != nil {
-							rooms = append(rooms, room)
-						}
+					rooms, err := r.Client.Room.Query().
+						Where(room.HasMembershipsWith(roommembership.HasUserWith(user.ID(uid))), page.where(room.FieldCreatedAt, false)).
+						WithOwner().
+						Order(page.order(room.FieldCreatedAt, false)).
+						Limit(page.limit + 1).
+						All(p.Context)
+					if err != nil {
+						return nil, err
 					}
-					sort.SliceStable(rooms, func(i, j int) bool {
-						return rooms[i].CreatedAt.Before(rooms[j].CreatedAt)
-					})
-					return rooms, nil
+					return newConnection("room", page, rooms, func(rm *ent.Room) (interface{}, int) {
+						return rm.CreatedAt, rm.ID
+					}), nil
 				},
 			},
 			"room": &graphql.Field{
@@ -151,10 +256,19 @@ func (r *Resolver) queryFields() graphql.ObjectConfig {
 				},
 			},
 			"messages": &graphql.Field{
-				Type: graphql.NewList(r.messageType()),
-				Args: graphql.FieldConfigArgument{
+				Type:        graphql.NewNonNull(r.messageConnectionType()),
+				Description: "Pages through a room's messages in seq order, oldest first. Use last and before to scroll back from the newest.",
+				Args: connectionArgs(graphql.FieldConfigArgument{
 					"roomId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
-				},
+					"afterSeq": &graphql.ArgumentConfig{
+						Type:        graphql.Int,
+						Description: "Only return messages with a greater seq, to resync after the last message the client has.",
+					},
+					"deviceId": &graphql.ArgumentConfig{
+						Type:        graphql.Int,
+						Description: "Only return messages this device of the caller can read: those without envelopes, those with an envelope for it and those it sent.",
+					},
+				}),
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					uid, err := auth.UserIDFromContext(p.Context)
 					if err != nil {
@@ -164,36 +278,65 @@ func (r *Resolver) queryFields() graphql.ObjectConfig {
 					if err != nil {
 						return nil, err
 					}
+					page, err := decodePageArgs("messageSeq", p.Args)
+					if err != nil {
+						return nil, err
+					}
 					if err := r.ensureRoomAccess(p.Context, roomID, uid); err != nil {
 						return nil, err
 					}
-					return r.Client.Message.Query().
-						Where(message.HasRoomWith(room.ID(roomID))).
+					query := r.Client.Message.Query().
+						Where(message.HasRoomWith(room.ID(roomID)), page.where(message.FieldSeq, false)).
 						WithSender().
-						Order(ent.Asc(message.FieldCreatedAt)).
-						All(p.Context)
+						Order(page.order(message.FieldSeq, false)).
+						Limit(page.limit + 1)
+					if afterSeq, ok := p.Args["afterSeq"].(int); ok {
+						query.Where(message.SeqGT(afterSeq))
+					}
+					if deviceID, ok := p.Args["deviceId"].(int); ok {
+						query.Where(readableBy(uid, deviceID))
+					}
+					messages, err := query.All(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return newConnection("messageSeq", page, messages, func(msg *ent.Message) (interface{}, int) {
+						return msg.Seq, msg.ID
+					}), nil
 				},
 			},
 			"notifications": &graphql.Field{
-				Type: graphql.NewList(r.notificationType()),
-				Args: graphql.FieldConfigArgument{
+				Type:        graphql.NewNonNull(r.notificationConnectionType()),
+				Description: "Pages through the caller's notifications, newest first.",
+				Args: connectionArgs(graphql.FieldConfigArgument{
 					"unreadOnly": &graphql.ArgumentConfig{Type: graphql.Boolean},
-				},
+				}),
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					uid, err := auth.UserIDFromContext(p.Context)
 					if err != nil {
 						return nil, ErrUnauthorized
 					}
+					page, err := decodePageArgs("notification", p.Args)
+					if err != nil {
+						return nil, err
+					}
 					query := r.Client.Notification.Query().
-						Where(notification.HasRecipientWith(user.ID(uid))).
-						Order(ent.Desc(notification.FieldCreatedAt)).
+						Where(notification.HasRecipientWith(user.ID(uid)), page.where(notification.FieldCreatedAt, true)).
+						Order(page.order(notification.FieldCreatedAt, true)).
+						Limit(page.limit + 1).
 						WithRecipient().
 						WithRoom().
 						WithMessage()
 					if unreadOnly, ok := p.Args["unreadOnly"].(bool); ok && unreadOnly {
 						query = query.Where(notification.ReadEQ(false))
 					}
-					return query.All(p.Context)
+					notifications, err := query.All(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return newConnection("notification", page, notifications, func(n *ent.Notification) (interface{}, int) {
+						return n.CreatedAt, n.ID
+					}), nil
 				},
 			},
 			"notification": &graphql.Field{
@@ -219,18 +362,31 @@ func (r *Resolver) queryFields() graphql.ObjectConfig {
 				},
 			},
 			"contacts": &graphql.Field{
-				Type: graphql.NewList(r.contactType()),
+				Type:        graphql.NewNonNull(r.contactConnectionType()),
+				Description: "Pages through the caller's contacts in the order they were added.",
+				Args:        connectionArgs(nil),
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					uid, err := auth.UserIDFromContext(p.Context)
 					if err != nil {
 						return nil, ErrUnauthorized
 					}
-					return r.Client.Contact.Query().
-						Where(contact.HasOwnerWith(user.ID(uid))).
+					page, err := decodePageArgs("contact", p.Args)
+					if err != nil {
+						return nil, err
+					}
+					contacts, err := r.Client.Contact.Query().
+						Where(contact.HasOwnerWith(user.ID(uid)), page.where(contact.FieldCreatedAt, false)).
 						WithOwner().
 						WithContact().
-						Order(ent.Asc(contact.FieldCreatedAt)).
+						Order(page.order(contact.FieldCreatedAt, false)).
+						Limit(page.limit + 1).
 						All(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return newConnection("contact", page, contacts, func(c *ent.Contact) (interface{}, int) {
+						return c.CreatedAt, c.ID
+					}), nil
 				},
 			},
 			"favourites": &graphql.Field{
@@ -248,53 +404,47 @@ func (r *Resolver) queryFields() graphql.ObjectConfig {
 				},
 			},
 			"callLogs": &graphql.Field{
-				Type: graphql.NewList(r.callLogType()),
+				Type:        graphql.NewNonNull(r.callLogConnectionType()),
+				Description: "Pages through the calls the caller started or took part in, most recently started first.",
+				Args:        connectionArgs(nil),
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					uid, err := auth.UserIDFromContext(p.Context)
 					if err != nil {
 						return nil, ErrUnauthorized
 					}
-					return r.Client.CallLog.Query().
+					page, err := decodePageArgs("callLog", p.Args)
+					if err != nil {
+						return nil, err
+					}
+					calls, err := r.Client.CallLog.Query().
 						Where(calllog.Or(
 							calllog.HasInitiatorWith(user.ID(uid)),
 							calllog.HasParticipantsWith(callparticipant.HasParticipantWith(user.ID(uid))),
-						)).
+						), page.where(calllog.FieldStartedAt, true)).
 						WithInitiator().
 						WithRoom().
 						WithParticipants(func(q *ent.CallParticipantQuery) {
 							q.WithParticipant()
 						}).
-						Order(ent.Desc(calllog.FieldStartedAt)).
+						Order(page.order(calllog.FieldStartedAt, true)).
+						Limit(page.limit + 1).
 						All(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return newConnection("callLog", page, calls, func(call *ent.CallLog) (interface{}, int) {
+						return call.StartedAt, call.ID
+					}), nil
 				},
 			},
-		},
+		}, r.sessionQueryFields(), r.mfaQueryFields(), r.apiKeyQueryFields(), r.adminQueryFields(), r.directoryQueryFields(), r.preKeyQueryFields(), r.mlsQueryFields(), r.senderKeyQueryFields(), r.identityKeyQueryFields(), r.keyLogQueryFields(), r.encryptionQueryFields(), r.sealedSenderQueryFields(), r.keyBackupQueryFields()))),
 	}
 }
 
 func (r *Resolver) mutationFields() graphql.ObjectConfig {
 	return graphql.ObjectConfig{
 		Name: "Mutation",
-		Fields: graphql.Fields{
-			"createUser": &graphql.Field{
-				Type: r.userType(),
-				Args: graphql.FieldConfigArgument{
-					"username":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
-					"displayName": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
-					"email":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
-					"avatarUrl":   &graphql.ArgumentConfig{Type: graphql.String},
-				},
-				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					builder := r.Client.User.Create().
-						SetUsername(p.Args["username"].(string)).
-						SetDisplayName(p.Args["displayName"].(string)).
-						SetEmail(p.Args["email"].(string))
-					if avatar, ok := p.Args["avatarUrl"].(string); ok && avatar != "" {
-						builder.SetAvatarURL(avatar)
-					}
-					return builder.Save(p.Context)
-				},
-			},
+		Fields: withScopes(withPrivacyErrors(mergeFields(graphql.Fields{
 			"updateUser": &graphql.Field{
 				Type: r.userType(),
 				Args: graphql.FieldConfigArgument{
@@ -302,6 +452,10 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"displayName": &graphql.ArgumentConfig{Type: graphql.String},
 					"avatarUrl":   &graphql.ArgumentConfig{Type: graphql.String},
 					"lastSeenAt":  &graphql.ArgumentConfig{Type: graphql.DateTime},
+					"discoverability": &graphql.ArgumentConfig{
+						Type:        graphql.String,
+						Description: "Who can find the user in searchUsers: everyone, contacts (users the user added as contacts) or nobody.",
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					uid, err := auth.UserIDFromContext(p.Context)
@@ -329,12 +483,19 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if v, ok := p.Args["lastSeenAt"].(time.Time); ok {
 						builder.SetLastSeenAt(v)
 					}
+					if v, ok := p.Args["discoverability"].(string); ok {
+						if err := user.DiscoverabilityValidator(user.Discoverability(v)); err != nil {
+							return nil, err
+						}
+						builder.SetDiscoverability(user.Discoverability(v))
+					}
 					builder.SetUpdatedAt(time.Now())
 					return builder.Save(p.Context)
 				},
 			},
 			"deleteUser": &graphql.Field{
-				Type: graphql.Boolean,
+				Type:        graphql.Boolean,
+				Description: "Schedules the account for deletion once the grace period ends. Use cancelAccountDeletion to keep it.",
 				Args: graphql.FieldConfigArgument{
 					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
 				},
@@ -350,7 +511,13 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if uid != id {
 						return nil, ErrForbidden
 					}
-					return true, r.Client.User.DeleteOneID(id).Exec(p.Context)
+					if err := r.requireRecentMFA(p.Context); err != nil {
+						return nil, err
+					}
+					if _, err := r.scheduleAccountDeletion(p.Context, id); err != nil {
+						return nil, err
+					}
+					return true, nil
 				},
 			},
 			"createRoom": &graphql.Field{
@@ -438,6 +605,12 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"name":        &graphql.ArgumentConfig{Type: graphql.String},
 					"description": &graphql.ArgumentConfig{Type: graphql.String},
 					"isPrivate":   &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"encryptionPolicy": &graphql.ArgumentConfig{
+						Type:        graphql.NewList(graphql.NewNonNull(r.encryptionRequirementInputType())),
+						Description: "Replaces the schemes the room accepts. An empty list restores the default of every end-to-end scheme.",
+					},
+					"messageTtl":   &graphql.ArgumentConfig{Type: graphql.Int, Description: "Seconds messages sent from now on are kept; 0 turns disappearing messages off."},
+					"sealedSender": &graphql.ArgumentConfig{Type: graphql.Boolean, Description: "Lets members send messages without revealing who sent them. Turning it on issues a delivery token."},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					uid, err := auth.UserIDFromContext(p.Context)
@@ -451,7 +624,43 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if err := r.ensureRoomAdmin(p.Context, roomID, uid); err != nil {
 						return nil, err
 					}
-					builder := r.Client.Room.UpdateOneID(roomID)
+					current, err := r.Client.Room.Get(p.Context, roomID)
+					if err != nil {
+						return nil, err
+					}
+					tx, err := r.Client.Tx(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					defer rollbackOnError(tx, &err)
+
+					builder := tx.Room.UpdateOneID(roomID)
+					var ttlChanged bool
+					if ttl, ok := p.Args["messageTtl"].(int); ok {
+						if ttl != 0 {
+							if err = validateMessageTTL("messageTtl", ttl); err != nil {
+								return nil, err
+							}
+						}
+						if ttl == 0 {
+							ttlChanged = current.MessageTTL != nil
+							builder.ClearMessageTTL()
+						} else {
+							ttlChanged = current.MessageTTL == nil || *current.MessageTTL != ttl
+							builder.SetMessageTTL(ttl)
+						}
+					}
+					if raw, ok := p.Args["encryptionPolicy"].([]interface{}); ok {
+						var policy encryption.Policy
+						if policy, err = decodeEncryptionPolicy(raw); err != nil {
+							return nil, err
+						}
+						if len(policy) == 0 {
+							builder.ClearEncryptionPolicy()
+						} else {
+							builder.SetEncryptionPolicy(policy)
+						}
+					}
 					if v, ok := p.Args["name"].(string); ok {
 						builder.SetName(v)
 					}
@@ -461,11 +670,41 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if v, ok := p.Args["isPrivate"].(bool); ok {
 						builder.SetIsPrivate(v)
 					}
+					if sealed, ok := p.Args["sealedSender"].(bool); ok {
+						builder.SetSealedSender(sealed)
+						if !sealed {
+							builder.ClearDeliveryToken()
+						} else if current.DeliveryToken == nil {
+							var token string
+							if token, err = newDeliveryToken(); err != nil {
+								return nil, err
+							}
+							builder.SetDeliveryToken(token)
+						}
+					}
 					builder.SetUpdatedAt(time.Now())
-					if err := builder.Exec(p.Context); err != nil {
+					var updated *ent.Room
+					if updated, err = builder.Save(p.Context); err != nil {
+						return nil, err
+					}
+					var notice *ent.Message
+					if ttlChanged {
+						if notice, err = createMessageTTLNoticeTx(p.Context, tx, roomID, uid, updated.MessageTTL); err != nil {
+							return nil, err
+						}
+					}
+					if err = tx.Commit(); err != nil {
+						return nil, err
+					}
+					if notice != nil {
+						notice = notice.Unwrap()
+						r.publishMessageEvent(p.Context, &MessageEvent{Kind: MessageEventCreated, RoomID: roomID, MessageID: notice.ID, Message: notice})
+					}
+					updated, err = r.Client.Room.Query().Where(room.ID(roomID)).WithOwner().Only(p.Context)
+					if err != nil {
 						return nil, err
 					}
-					return r.Client.Room.Query().Where(room.ID(roomID)).WithOwner().Only(p.Context)
+					return updated, nil
 				},
 			},
 			"deleteRoom": &graphql.Field{
@@ -485,7 +724,15 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if err := r.ensureRoomOwner(p.Context, roomID, uid); err != nil {
 						return nil, err
 					}
-					return true, r.Client.Room.DeleteOneID(roomID).Exec(p.Context)
+					if err := r.requireRecentMFA(p.Context); err != nil {
+						return nil, err
+					}
+					// Privacy rules never let the owner's membership go, so with
+					// ownership checked the room is purged outside them.
+					if err := r.purgeRoom(rule.SystemContext(p.Context), roomID); err != nil {
+						return nil, err
+					}
+					return true, nil
 				},
 			},
 			"addRoomMembers": &graphql.Field{
@@ -496,15 +743,14 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"role":      &graphql.ArgumentConfig{Type: graphql.String},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					roomID, err := decodeID(p.Args["roomId"])
 					if err != nil {
 						return nil, err
 					}
-					if err := r.ensureRoomAdmin(p.Context, roomID, uid); err != nil {
+					if err := r.ensureNotMLSRoom(p.Context, roomID); err != nil {
 						return nil, err
 					}
 					role := roommembership.RoleMember
@@ -519,7 +765,8 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 
 					members := []*ent.RoomMembership{}
 					for _, mid := range decodeIDList(p.Args["memberIds"]) {
-						m, err := tx.RoomMembership.Create().
+						var m *ent.RoomMembership
+						m, err = tx.RoomMembership.Create().
 							SetRoomID(roomID).
 							SetUserID(mid).
 							SetRole(role).
@@ -549,8 +796,7 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"canCall":  &graphql.ArgumentConfig{Type: graphql.Boolean},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					roomID, err := decodeID(p.Args["roomId"])
@@ -561,9 +807,6 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if err != nil {
 						return nil, err
 					}
-					if err := r.ensureRoomAdmin(p.Context, roomID, uid); err != nil {
-						return nil, err
-					}
 					builder := r.Client.RoomMembership.Update().
 						Where(roommembership.HasRoomWith(room.ID(roomID)), roommembership.HasUserWith(user.ID(memberID)))
 					if v, ok := p.Args["role"].(string); ok && v != "" {
@@ -593,8 +836,7 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"memberId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					roomID, err := decodeID(p.Args["roomId"])
@@ -605,57 +847,73 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if err != nil {
 						return nil, err
 					}
-					if err := r.ensureRoomAdmin(p.Context, roomID, uid); err != nil {
+					if err := r.ensureNotMLSRoom(p.Context, roomID); err != nil {
 						return nil, err
 					}
-					_, err = r.Client.RoomMembership.Delete().
-						Where(roommembership.HasRoomWith(room.ID(roomID)), roommembership.HasUserWith(user.ID(memberID))).
-						Exec(p.Context)
-					if err != nil {
+					if _, err := r.removeMember(p.Context, roomID, memberID); err != nil {
 						return nil, err
 					}
 					return true, nil
 				},
 			},
 			"createMessage": &graphql.Field{
-				Type: r.messageType(),
+				Type:        r.messageType(),
+				Description: "Sends a message with a single cipherText, or with one envelope for every registered device of the room's members other than senderDeviceId. With a deliveryToken the message is sent with sealed sender.",
 				Args: graphql.FieldConfigArgument{
-					"roomId":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
-					"cipherText":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
-					"contentType": &graphql.ArgumentConfig{Type: graphql.String},
+					"roomId":            &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"cipherText":        &graphql.ArgumentConfig{Type: graphql.String},
+					"envelopes":         &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(r.messageEnvelopeInputType()))},
+					"senderDeviceId":    &graphql.ArgumentConfig{Type: graphql.Int, Description: "The caller's sending device. Required with envelopes, and recorded with a cipherText so recipients can pick the sender key it was encrypted with."},
+					"contentType":       &graphql.ArgumentConfig{Type: graphql.String},
+					"encryptionScheme":  &graphql.ArgumentConfig{Type: graphql.String, Description: "A scheme from encryptionSchemes that the room's policy allows. Defaults to signal, or mls in MLS rooms."},
+					"encryptionVersion": &graphql.ArgumentConfig{Type: graphql.Int, Description: "Defaults to the scheme's current version."},
+					"expiresIn":         &graphql.ArgumentConfig{Type: graphql.Int, Description: "Seconds until the message disappears, overriding the room's messageTtl."},
+					"deliveryToken":     &graphql.ArgumentConfig{Type: graphql.String, Description: "The room's delivery token. Authorizes a sealed sender message without recording who sent it; envelopes must then cover every device of the room, the sending one included."},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
-						return nil, ErrUnauthorized
-					}
 					roomID, err := decodeID(p.Args["roomId"])
 					if err != nil {
 						return nil, err
 					}
-					membership, err := r.ensureRoomMember(p.Context, roomID, uid)
+					out, err := decodeOutgoingMessage(p.Args)
 					if err != nil {
 						return nil, err
 					}
-					if !membership.CanPost {
-						return nil, ErrForbidden
+					if token, ok := p.Args["deliveryToken"].(string); ok {
+						if _, ok := p.Args["senderDeviceId"].(int); ok {
+							return nil, errors.New("sealed sender messages cannot name a senderDeviceId")
+						}
+						return r.createSealedMessage(p.Context, roomID, token, out)
 					}
-					builder := r.Client.Message.Create().
-						SetRoomID(roomID).
-						SetSenderID(uid).
-						SetCipherText(p.Args["cipherText"].(string))
-					if v, ok := p.Args["contentType"].(string); ok && v != "" {
-						builder.SetContentType(v)
+					uid, err := auth.UserIDFromContext(p.Context)
+					if err != nil {
+						return nil, ErrUnauthorized
 					}
-					return builder.Save(p.Context)
+					if out.envelopes != nil {
+						senderDeviceID, ok := p.Args["senderDeviceId"].(int)
+						if !ok {
+							return nil, errors.New("senderDeviceId is required with envelopes")
+						}
+						return r.createEnvelopeMessage(p.Context, uid, roomID, senderDeviceID, out)
+					}
+					var senderDeviceID *int
+					if id, ok := p.Args["senderDeviceId"].(int); ok {
+						senderDeviceID = &id
+					}
+					return r.createCipherTextMessage(p.Context, uid, roomID, senderDeviceID, out)
 				},
 			},
 			"updateMessage": &graphql.Field{
-				Type: r.messageType(),
+				Type:        r.messageType(),
+				Description: "Edits a message. Messages sent as envelopes are edited with a new set of envelopes.",
 				Args: graphql.FieldConfigArgument{
-					"id":          &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
-					"cipherText":  &graphql.ArgumentConfig{Type: graphql.String},
-					"contentType": &graphql.ArgumentConfig{Type: graphql.String},
+					"id":                &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"cipherText":        &graphql.ArgumentConfig{Type: graphql.String},
+					"envelopes":         &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(r.messageEnvelopeInputType()))},
+					"senderDeviceId":    &graphql.ArgumentConfig{Type: graphql.Int, Description: "The caller's sending device. Required with envelopes."},
+					"contentType":       &graphql.ArgumentConfig{Type: graphql.String},
+					"encryptionScheme":  &graphql.ArgumentConfig{Type: graphql.String, Description: "Defaults to the message's current scheme, which must still be allowed by the room's policy."},
+					"encryptionVersion": &graphql.ArgumentConfig{Type: graphql.Int},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					uid, err := auth.UserIDFromContext(p.Context)
@@ -666,29 +924,41 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if err != nil {
 						return nil, err
 					}
-					msg, err := r.Client.Message.Query().Where(message.IDEQ(id)).
+					encryptionScheme, encryptionVersion := declaredEncryption(p.Args)
+					if rawEnvelopes, ok := p.Args["envelopes"].([]interface{}); ok {
+						if _, ok := p.Args["cipherText"].(string); ok {
+							return nil, errors.New("cipherText and envelopes cannot be combined")
+						}
+						senderDeviceID, ok := p.Args["senderDeviceId"].(int)
+						if !ok {
+							return nil, errors.New("senderDeviceId is required with envelopes")
+						}
+						envelopes, err := decodeEnvelopes(rawEnvelopes)
+						if err != nil {
+							return nil, err
+						}
+						contentType, _ := p.Args["contentType"].(string)
+						return r.updateEnvelopeMessage(p.Context, uid, id, senderDeviceID, envelopes, contentType, encryptionScheme, encryptionVersion)
+					}
+					existing, err := r.Client.Message.Query().
+						Where(message.ID(id)).
 						WithRoom().
-						WithSender().
 						Only(p.Context)
 					if err != nil {
 						return nil, err
 					}
-					roomEdge := msg.Edges.Room
-					if roomEdge == nil {
-						return nil, fmt.Errorf("message missing room relationship")
+					if _, ok := p.Args["cipherText"].(string); ok && existing.CipherText == "" {
+						return nil, errors.New("message was sent as envelopes; edit it with envelopes")
 					}
-					membership, err := r.ensureRoomMember(p.Context, roomEdge.ID, uid)
+					encryptionScheme, encryptionVersion = editedEncryption(existing, encryptionScheme, encryptionVersion)
+					scheme, version, err := roomEncryption(existing.Edges.Room, encryptionScheme, encryptionVersion)
 					if err != nil {
 						return nil, err
 					}
-					senderEdge := msg.Edges.Sender
-					if senderEdge == nil {
-						return nil, fmt.Errorf("message missing sender relationship")
-					}
-					if senderEdge.ID != uid && membership.Role == roommembership.RoleMember {
-						return nil, ErrForbidden
-					}
-					builder := r.Client.Message.UpdateOneID(id).SetEdited(true)
+					builder := r.Client.Message.UpdateOneID(id).
+						SetEdited(true).
+						SetEncryptionScheme(scheme).
+						SetEncryptionVersion(version)
 					if v, ok := p.Args["cipherText"].(string); ok {
 						builder.SetCipherText(v)
 					}
@@ -699,10 +969,15 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if err := builder.Exec(p.Context); err != nil {
 						return nil, err
 					}
-					return r.Client.Message.Query().
+					msg, err := r.Client.Message.Query().
 						Where(message.ID(id)).
 						WithSender().
 						Only(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					r.publishMessageEvent(p.Context, &MessageEvent{Kind: MessageEventEdited, RoomID: existing.Edges.Room.ID, MessageID: id, Message: msg})
+					return msg, nil
 				},
 			},
 			"deleteMessage": &graphql.Field{
@@ -711,52 +986,29 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					id, err := decodeID(p.Args["id"])
 					if err != nil {
 						return nil, err
 					}
-					msg, err := r.Client.Message.Query().Where(message.IDEQ(id)).
-						WithSender().
-						WithRoom().
-						Only(p.Context)
-					if err != nil {
-						return nil, err
-					}
-					roomEdge := msg.Edges.Room
-					if roomEdge == nil {
-						return nil, fmt.Errorf("message missing room relationship")
-					}
-					membership, err := r.ensureRoomMember(p.Context, roomEdge.ID, uid)
-					if err != nil {
-						return nil, err
-					}
-					senderEdge := msg.Edges.Sender
-					if senderEdge == nil {
-						return nil, fmt.Errorf("message missing sender relationship")
-					}
-					if senderEdge.ID != uid && membership.Role == roommembership.RoleMember {
-						return nil, ErrForbidden
-					}
-					return true, r.Client.Message.DeleteOneID(id).Exec(p.Context)
+					return true, r.deleteMessage(p.Context, id)
 				},
 			},
 			"createNotification": &graphql.Field{
 				Type: r.notificationType(),
 				Args: graphql.FieldConfigArgument{
-					"recipientId":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
-					"kind":             &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
-					"cipherText":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
-					"encryptionScheme": &graphql.ArgumentConfig{Type: graphql.String},
-					"roomId":           &graphql.ArgumentConfig{Type: graphql.ID},
-					"messageId":        &graphql.ArgumentConfig{Type: graphql.ID},
+					"recipientId":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"kind":              &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"cipherText":        &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"encryptionScheme":  &graphql.ArgumentConfig{Type: graphql.String, Description: "A scheme from encryptionSchemes, checked against the room's policy when the notification has a room. Defaults to signal."},
+					"encryptionVersion": &graphql.ArgumentConfig{Type: graphql.Int, Description: "Defaults to the scheme's current version."},
+					"roomId":            &graphql.ArgumentConfig{Type: graphql.ID},
+					"messageId":         &graphql.ArgumentConfig{Type: graphql.ID},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					recipientID, err := decodeID(p.Args["recipientId"])
@@ -791,29 +1043,17 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 						roomID = &roomEdge.ID
 						messageID = &mid
 					}
-					if roomID != nil {
-						if _, err := r.ensureRoomMember(p.Context, *roomID, recipientID); err != nil {
-							return nil, err
-						}
-						if recipientID != uid {
-							if err := r.ensureRoomAdmin(p.Context, *roomID, uid); err != nil {
-								return nil, err
-							}
-						} else {
-							if _, err := r.ensureRoomMember(p.Context, *roomID, uid); err != nil {
-								return nil, err
-							}
-						}
-					} else if recipientID != uid {
-						return nil, ErrForbidden
+					encryptionScheme, encryptionVersion := declaredEncryption(p.Args)
+					scheme, version, err := r.notificationEncryption(p.Context, roomID, encryptionScheme, encryptionVersion)
+					if err != nil {
+						return nil, err
 					}
 					builder := r.Client.Notification.Create().
 						SetRecipientID(recipientID).
 						SetKind(p.Args["kind"].(string)).
-						SetCipherText(p.Args["cipherText"].(string))
-					if scheme, ok := p.Args["encryptionScheme"].(string); ok && scheme != "" {
-						builder.SetEncryptionScheme(scheme)
-					}
+						SetCipherText(p.Args["cipherText"].(string)).
+						SetEncryptionScheme(scheme).
+						SetEncryptionVersion(version)
 					if roomID != nil {
 						builder.SetRoomID(*roomID)
 					}
@@ -824,12 +1064,14 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if err != nil {
 						return nil, err
 					}
+					// The recipient may be someone else, whose notifications the viewer
+					// cannot read back; the create itself was authorized by the policy.
 					enriched, err := r.Client.Notification.Query().
 						Where(notification.IDEQ(savedNotification.ID)).
 						WithRecipient().
 						WithRoom().
 						WithMessage().
-						Only(p.Context)
+						Only(rule.SystemContext(p.Context))
 					if err != nil {
 						return nil, err
 					}
@@ -840,11 +1082,12 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 			"updateNotification": &graphql.Field{
 				Type: r.notificationType(),
 				Args: graphql.FieldConfigArgument{
-					"id":               &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
-					"kind":             &graphql.ArgumentConfig{Type: graphql.String},
-					"cipherText":       &graphql.ArgumentConfig{Type: graphql.String},
-					"encryptionScheme": &graphql.ArgumentConfig{Type: graphql.String},
-					"read":             &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"id":                &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"kind":              &graphql.ArgumentConfig{Type: graphql.String},
+					"cipherText":        &graphql.ArgumentConfig{Type: graphql.String},
+					"encryptionScheme":  &graphql.ArgumentConfig{Type: graphql.String},
+					"encryptionVersion": &graphql.ArgumentConfig{Type: graphql.Int},
+					"read":              &graphql.ArgumentConfig{Type: graphql.Boolean},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					uid, err := auth.UserIDFromContext(p.Context)
@@ -855,11 +1098,6 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if err != nil {
 						return nil, err
 					}
-					if _, err := r.Client.Notification.Query().
-						Where(notification.ID(id), notification.HasRecipientWith(user.ID(uid))).
-						Only(p.Context); err != nil {
-						return nil, err
-					}
 					builder := r.Client.Notification.UpdateOneID(id)
 					if v, ok := p.Args["kind"].(string); ok {
 						builder.SetKind(v)
@@ -867,8 +1105,12 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					if v, ok := p.Args["cipherText"].(string); ok {
 						builder.SetCipherText(v)
 					}
-					if v, ok := p.Args["encryptionScheme"].(string); ok {
-						builder.SetEncryptionScheme(v)
+					if encryptionScheme, encryptionVersion := declaredEncryption(p.Args); encryptionScheme != "" || encryptionVersion != 0 {
+						scheme, version, err := r.reencryptedNotification(p.Context, id, encryptionScheme, encryptionVersion)
+						if err != nil {
+							return nil, err
+						}
+						builder.SetEncryptionScheme(scheme).SetEncryptionVersion(version)
 					}
 					if v, ok := p.Args["read"].(bool); ok {
 						builder.SetRead(v)
@@ -896,19 +1138,13 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					id, err := decodeID(p.Args["id"])
 					if err != nil {
 						return nil, err
 					}
-					if _, err := r.Client.Notification.Query().
-						Where(notification.ID(id), notification.HasRecipientWith(user.ID(uid))).
-						Only(p.Context); err != nil {
-						return nil, err
-					}
 					return true, r.Client.Notification.DeleteOneID(id).Exec(p.Context)
 				},
 			},
@@ -939,30 +1175,6 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 						if err != nil {
 							return nil, err
 						}
-						msg, err := r.Client.Message.Query().Where(message.IDEQ(msgID)).
-							WithSender().
-							WithRoom().
-							Only(p.Context)
-						if err != nil {
-							return nil, err
-						}
-						senderEdge := msg.Edges.Sender
-						if senderEdge == nil {
-							return nil, fmt.Errorf("message missing sender relationship")
-						}
-						if senderEdge.ID != uid {
-							roomEdge := msg.Edges.Room
-							if roomEdge == nil {
-								return nil, fmt.Errorf("message missing room relationship")
-							}
-							membership, err := r.ensureRoomMember(p.Context, roomEdge.ID, uid)
-							if err != nil {
-								return nil, err
-							}
-							if membership.Role == roommembership.RoleMember {
-								return nil, ErrForbidden
-							}
-						}
 						builder.SetMessageID(msgID)
 					}
 					return builder.Save(p.Context)
@@ -974,25 +1186,13 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					id, err := decodeID(p.Args["id"])
 					if err != nil {
 						return nil, err
 					}
-					mediaItem, err := r.Client.Media.Query().Where(media.IDEQ(id)).WithUploader().Only(p.Context)
-					if err != nil {
-						return nil, err
-					}
-					uploader := mediaItem.Edges.Uploader
-					if uploader == nil {
-						return nil, fmt.Errorf("media missing uploader relationship")
-					}
-					if uploader.ID != uid {
-						return nil, ErrForbidden
-					}
 					return true, r.Client.Media.DeleteOneID(id).Exec(p.Context)
 				},
 			},
@@ -1037,25 +1237,13 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"isBlocked":   &graphql.ArgumentConfig{Type: graphql.Boolean},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					id, err := decodeID(p.Args["id"])
 					if err != nil {
 						return nil, err
 					}
-					contactItem, err := r.Client.Contact.Query().Where(contact.IDEQ(id)).WithOwner().Only(p.Context)
-					if err != nil {
-						return nil, err
-					}
-					owner := contactItem.Edges.Owner
-					if owner == nil {
-						return nil, fmt.Errorf("contact missing owner relationship")
-					}
-					if owner.ID != uid {
-						return nil, ErrForbidden
-					}
 					builder := r.Client.Contact.UpdateOneID(id)
 					if v, ok := p.Args["alias"].(string); ok {
 						builder.SetAlias(v)
@@ -1079,25 +1267,13 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					id, err := decodeID(p.Args["id"])
 					if err != nil {
 						return nil, err
 					}
-					contactItem, err := r.Client.Contact.Query().Where(contact.IDEQ(id)).WithOwner().Only(p.Context)
-					if err != nil {
-						return nil, err
-					}
-					owner := contactItem.Edges.Owner
-					if owner == nil {
-						return nil, fmt.Errorf("contact missing owner relationship")
-					}
-					if owner.ID != uid {
-						return nil, ErrForbidden
-					}
 					return true, r.Client.Contact.DeleteOneID(id).Exec(p.Context)
 				},
 			},
@@ -1162,9 +1338,6 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 						if err != nil {
 							return nil, err
 						}
-						if err := r.ensureRoomAccess(p.Context, roomID, uid); err != nil {
-							return nil, err
-						}
 						builder.SetRoomID(roomID)
 					}
 					if status, ok := p.Args["status"].(string); ok && status != "" {
@@ -1201,35 +1374,13 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"endedAt": &graphql.ArgumentConfig{Type: graphql.DateTime},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					id, err := decodeID(p.Args["id"])
 					if err != nil {
 						return nil, err
 					}
-					callEntry, err := r.Client.CallLog.Query().Where(calllog.IDEQ(id)).WithInitiator().WithRoom().Only(p.Context)
-					if err != nil {
-						return nil, err
-					}
-					initiator := callEntry.Edges.Initiator
-					if initiator == nil {
-						return nil, fmt.Errorf("call log missing initiator relationship")
-					}
-					if initiator.ID != uid {
-						roomEdge := callEntry.Edges.Room
-						if roomEdge == nil {
-							return nil, ErrForbidden
-						}
-						membership, err := r.ensureRoomMember(p.Context, roomEdge.ID, uid)
-						if err != nil {
-							return nil, err
-						}
-						if membership.Role == roommembership.RoleMember {
-							return nil, ErrForbidden
-						}
-					}
 					builder := r.Client.CallLog.UpdateOneID(id)
 					if status, ok := p.Args["status"].(string); ok && status != "" {
 						builder.SetStatus(calllog.Status(status))
@@ -1256,35 +1407,13 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					id, err := decodeID(p.Args["id"])
 					if err != nil {
 						return nil, err
 					}
-					callEntry, err := r.Client.CallLog.Query().Where(calllog.IDEQ(id)).WithInitiator().WithRoom().Only(p.Context)
-					if err != nil {
-						return nil, err
-					}
-					initiator := callEntry.Edges.Initiator
-					if initiator == nil {
-						return nil, fmt.Errorf("call log missing initiator relationship")
-					}
-					if initiator.ID != uid {
-						roomEdge := callEntry.Edges.Room
-						if roomEdge == nil {
-							return nil, ErrForbidden
-						}
-						membership, err := r.ensureRoomMember(p.Context, roomEdge.ID, uid)
-						if err != nil {
-							return nil, err
-						}
-						if membership.Role == roommembership.RoleMember {
-							return nil, ErrForbidden
-						}
-					}
 					return true, r.Client.CallLog.DeleteOneID(id).Exec(p.Context)
 				},
 			},
@@ -1296,35 +1425,13 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"role":          &graphql.ArgumentConfig{Type: graphql.String},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					callID, err := decodeID(p.Args["callId"])
 					if err != nil {
 						return nil, err
 					}
-					callEntry, err := r.Client.CallLog.Query().Where(calllog.IDEQ(callID)).WithInitiator().WithRoom().Only(p.Context)
-					if err != nil {
-						return nil, err
-					}
-					initiator := callEntry.Edges.Initiator
-					if initiator == nil {
-						return nil, fmt.Errorf("call log missing initiator relationship")
-					}
-					if initiator.ID != uid {
-						roomEdge := callEntry.Edges.Room
-						if roomEdge == nil {
-							return nil, ErrForbidden
-						}
-						membership, err := r.ensureRoomMember(p.Context, roomEdge.ID, uid)
-						if err != nil {
-							return nil, err
-						}
-						if membership.Role == roommembership.RoleMember {
-							return nil, ErrForbidden
-						}
-					}
 					participantID, err := decodeID(p.Args["participantId"])
 					if err != nil {
 						return nil, err
@@ -1356,35 +1463,13 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"leftAt":        &graphql.ArgumentConfig{Type: graphql.DateTime},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					callID, err := decodeID(p.Args["callId"])
 					if err != nil {
 						return nil, err
 					}
-					callEntry, err := r.Client.CallLog.Query().Where(calllog.IDEQ(callID)).WithInitiator().WithRoom().Only(p.Context)
-					if err != nil {
-						return nil, err
-					}
-					initiator := callEntry.Edges.Initiator
-					if initiator == nil {
-						return nil, fmt.Errorf("call log missing initiator relationship")
-					}
-					if initiator.ID != uid {
-						roomEdge := callEntry.Edges.Room
-						if roomEdge == nil {
-							return nil, ErrForbidden
-						}
-						membership, err := r.ensureRoomMember(p.Context, roomEdge.ID, uid)
-						if err != nil {
-							return nil, err
-						}
-						if membership.Role == roommembership.RoleMember {
-							return nil, ErrForbidden
-						}
-					}
 					participantID, err := decodeID(p.Args["participantId"])
 					if err != nil {
 						return nil, err
@@ -1416,35 +1501,13 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					"participantId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					uid, err := auth.UserIDFromContext(p.Context)
-					if err != nil {
+					if _, err := auth.UserIDFromContext(p.Context); err != nil {
 						return nil, ErrUnauthorized
 					}
 					callID, err := decodeID(p.Args["callId"])
 					if err != nil {
 						return nil, err
 					}
-					callEntry, err := r.Client.CallLog.Query().Where(calllog.IDEQ(callID)).WithInitiator().WithRoom().Only(p.Context)
-					if err != nil {
-						return nil, err
-					}
-					initiator := callEntry.Edges.Initiator
-					if initiator == nil {
-						return nil, fmt.Errorf("call log missing initiator relationship")
-					}
-					if initiator.ID != uid {
-						roomEdge := callEntry.Edges.Room
-						if roomEdge == nil {
-							return nil, ErrForbidden
-						}
-						membership, err := r.ensureRoomMember(p.Context, roomEdge.ID, uid)
-						if err != nil {
-							return nil, err
-						}
-						if membership.Role == roommembership.RoleMember {
-							return nil, ErrForbidden
-						}
-					}
 					participantID, err := decodeID(p.Args["participantId"])
 					if err != nil {
 						return nil, err
@@ -1458,14 +1521,14 @@ func (r *Resolver) mutationFields() graphql.ObjectConfig {
 					return true, nil
 				},
 			},
-		},
+		}, r.accountMutationFields(), r.emailMutationFields(), r.sessionMutationFields(), r.mfaMutationFields(), r.apiKeyMutationFields(), r.adminMutationFields(), r.deletionMutationFields(), r.preKeyMutationFields(), r.mlsMutationFields(), r.senderKeyMutationFields(), r.sealedSenderMutationFields(), r.keyBackupMutationFields()))),
 	}
 }
 
 func (r *Resolver) subscriptionFields() graphql.ObjectConfig {
 	return graphql.ObjectConfig{
 		Name: "Subscription",
-		Fields: graphql.Fields{
+		Fields: mergeFields(graphql.Fields{
 			"notifications": &graphql.Field{
 				Type: r.notificationType(),
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
@@ -1503,7 +1566,7 @@ func (r *Resolver) subscriptionFields() graphql.ObjectConfig {
 					return stream, nil
 				},
 			},
-		},
+		}, r.messageEventSubscriptionFields()),
 	}
 }
 
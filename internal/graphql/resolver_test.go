@@ -0,0 +1,134 @@
+package graphql
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/eleven-am/enclave/ent"
+	_ "github.com/eleven-am/enclave/ent/runtime"
+	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/internal/directory"
+	"github.com/eleven-am/enclave/internal/mail"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// testEnv is a resolver and its schema over a fresh database.
+type testEnv struct {
+	t      *testing.T
+	client *ent.Client
+	schema graphql.Schema
+	r      *Resolver
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	drv, err := entsql.Open(dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "enclave.db")+"?_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := BackfillKeyLog(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	dir, err := directory.Open(ctx, drv.DB())
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := auth.NewHMACKeySet("test", []byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := auth.NewTokenService(auth.TokenConfig{Keys: keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, logKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, r, err := NewSchema(client, Config{
+		Tokens:    tokens,
+		Directory: dir,
+		Mailer:    mail.NewMemoryMailer(),
+		LogKey:    logKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testEnv{t: t, client: client, schema: schema, r: r}
+}
+
+// addUser creates a user with a verified email address and returns its ID.
+func (env *testEnv) addUser(username string) int {
+	env.t.Helper()
+	u, err := env.client.User.Create().
+		SetUsername(username).
+		SetDisplayName(username).
+		SetEmail(username + "@example.com").
+		Save(rule.SystemContext(context.Background()))
+	if err != nil {
+		env.t.Fatal(err)
+	}
+	return u.ID
+}
+
+// exec runs an operation as the user uid, or anonymously when uid is zero,
+// and returns its data and the error the first failing resolver returned.
+func (env *testEnv) exec(uid int, query string) (map[string]interface{}, error) {
+	if uid == 0 {
+		return env.execClaims(nil, query)
+	}
+	return env.execClaims(&auth.Claims{UserID: uid}, query)
+}
+
+// execClaims runs an operation like exec for a principal with the given
+// claims, or anonymously when they are nil.
+func (env *testEnv) execClaims(claims *auth.Claims, query string) (map[string]interface{}, error) {
+	ctx := context.Background()
+	if claims != nil {
+		ctx = auth.ContextWithClaims(ctx, claims)
+	}
+	res := graphql.Do(graphql.Params{Schema: env.schema, RequestString: query, Context: ctx})
+	data, _ := res.Data.(map[string]interface{})
+	if len(res.Errors) > 0 {
+		if err := res.Errors[0].OriginalError(); err != nil {
+			return data, unwrapLocated(err)
+		}
+		return data, errors.New(res.Errors[0].Message)
+	}
+	return data, nil
+}
+
+// do runs an operation like exec and fails the test when it returns an error.
+func (env *testEnv) do(uid int, query string) map[string]interface{} {
+	env.t.Helper()
+	data, err := env.exec(uid, query)
+	if err != nil {
+		env.t.Fatalf("%s: %v", query, err)
+	}
+	return data
+}
+
+// unwrapLocated returns the resolver error graphql-go wrapped with its
+// location in the query.
+func unwrapLocated(err error) error {
+	var located *gqlerrors.Error
+	if errors.As(err, &located) && located.OriginalError != nil {
+		return located.OriginalError
+	}
+	return err
+}
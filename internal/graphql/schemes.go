@@ -0,0 +1,179 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/notification"
+	"github.com/eleven-am/enclave/internal/encryption"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+func (r *Resolver) encryptionSchemeType() *graphql.Object {
+	if r.encryptionSchemeObj == nil {
+		version := graphql.NewObject(graphql.ObjectConfig{
+			Name: "EncryptionSchemeVersion",
+			Fields: graphql.Fields{
+				"number":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"description": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			},
+		})
+		r.encryptionSchemeObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "EncryptionScheme",
+			Fields: graphql.Fields{
+				"name":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"description": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"endToEnd":    &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+				"serverOnly":  &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Description: "Set by the server and never accepted from clients."},
+				"currentVersion": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "Recorded when a client does not name a version.", Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*encryption.Scheme).CurrentVersion(), nil
+				}},
+				"versions": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(version)))},
+			},
+		})
+	}
+	return r.encryptionSchemeObj
+}
+
+func (r *Resolver) encryptionRequirementType() *graphql.Object {
+	if r.encryptionRequirementObj == nil {
+		r.encryptionRequirementObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "EncryptionRequirement",
+			Fields: graphql.Fields{
+				"scheme":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"minVersion": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "Zero accepts every supported version."},
+			},
+		})
+	}
+	return r.encryptionRequirementObj
+}
+
+func (r *Resolver) encryptionRequirementInputType() *graphql.InputObject {
+	if r.requirementInputObj == nil {
+		r.requirementInputObj = graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: "EncryptionRequirementInput",
+			Fields: graphql.InputObjectConfigFieldMap{
+				"scheme":     &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+				"minVersion": &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			},
+		})
+	}
+	return r.requirementInputObj
+}
+
+func (r *Resolver) encryptionQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"encryptionSchemes": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.encryptionSchemeType()))),
+			Description: "Lists the encryption schemes messages and notifications may declare.",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return encryption.Schemes(), nil
+			},
+		},
+	}
+}
+
+// decodeEncryptionPolicy reads an encryptionPolicy argument.
+func decodeEncryptionPolicy(raw []interface{}) (encryption.Policy, error) {
+	policy := make(encryption.Policy, 0, len(raw))
+	for _, item := range raw {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("invalid encryption requirement")
+		}
+		req := encryption.Requirement{}
+		req.Scheme, _ = fields["scheme"].(string)
+		req.MinVersion, _ = fields["minVersion"].(int)
+		policy = append(policy, req)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// declaredEncryption reads the encryptionScheme and encryptionVersion
+// arguments; missing arguments are returned as zero values.
+func declaredEncryption(args map[string]interface{}) (string, int) {
+	scheme, _ := args["encryptionScheme"].(string)
+	version, _ := args["encryptionVersion"].(int)
+	return scheme, version
+}
+
+// roomEncryption resolves the scheme and version declared for a message in rm
+// and checks them against the room's policy. Without a declared scheme,
+// messages are taken to use Signal, or MLS in MLS rooms.
+func roomEncryption(rm *ent.Room, name string, version int) (string, int, error) {
+	fallback := encryption.Signal
+	if rm.MlsGroupID != nil {
+		fallback = encryption.MLS
+	}
+	scheme, version, err := encryption.Resolve(name, version, fallback)
+	if err != nil {
+		return "", 0, err
+	}
+	if rm.MlsGroupID != nil && scheme.Name != encryption.MLS {
+		return "", 0, &encryption.PolicyError{Scheme: scheme.Name, Version: version, Reason: "messages to MLS rooms use the mls encryption scheme", Allowed: []string{encryption.MLS}}
+	}
+	if err := rm.EncryptionPolicy.Check(scheme, version); err != nil {
+		return "", 0, err
+	}
+	return scheme.Name, version, nil
+}
+
+// editedEncryption returns the scheme and version to check for an edit of msg:
+// the declared ones, or the message's own when no scheme is declared.
+func editedEncryption(msg *ent.Message, name string, version int) (string, int) {
+	if name != "" {
+		return name, version
+	}
+	if version == 0 && msg.EncryptionVersion != nil {
+		version = *msg.EncryptionVersion
+	}
+	return msg.EncryptionScheme, version
+}
+
+// notificationEncryption resolves the scheme and version declared for a
+// notification, checked against the policy of its room when it has one.
+func (r *Resolver) notificationEncryption(ctx context.Context, roomID *int, name string, version int) (string, int, error) {
+	scheme, version, err := encryption.Resolve(name, version, encryption.Signal)
+	if err != nil {
+		return "", 0, err
+	}
+	if roomID == nil {
+		return scheme.Name, version, nil
+	}
+	// The policy applies whether or not the caller may read the room.
+	rm, err := r.Client.Room.Get(rule.SystemContext(ctx), *roomID)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := rm.EncryptionPolicy.Check(scheme, version); err != nil {
+		return "", 0, err
+	}
+	return scheme.Name, version, nil
+}
+
+// reencryptedNotification checks the scheme and version declared for an
+// update of notification id. Without a declared scheme the notification keeps
+// its current one.
+func (r *Resolver) reencryptedNotification(ctx context.Context, id int, name string, version int) (string, int, error) {
+	n, err := r.Client.Notification.Query().
+		Where(notification.ID(id)).
+		WithRoom().
+		Only(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	if name == "" {
+		name = n.EncryptionScheme
+	}
+	var roomID *int
+	if n.Edges.Room != nil {
+		roomID = &n.Edges.Room.ID
+	}
+	return r.notificationEncryption(ctx, roomID, name, version)
+}
@@ -0,0 +1,189 @@
+package graphql
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// ErrInvalidDeliveryToken rejects a sealed sender message whose delivery token
+// does not match its room, or whose room does not use sealed sender. Clients
+// fetch the current token with roomDeliveryToken and retry.
+var ErrInvalidDeliveryToken = errors.New("invalid delivery token")
+
+func (r *Resolver) sealedSenderQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"roomDeliveryToken": &graphql.Field{
+			Type:        graphql.String,
+			Description: "Returns the token that authorizes sealed sender messages to the room, or null when the room does not use sealed sender. Only members may read it.",
+			Args: graphql.FieldConfigArgument{
+				"roomId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				roomID, err := decodeID(p.Args["roomId"])
+				if err != nil {
+					return nil, err
+				}
+				if err := r.ensureRoomAccess(p.Context, roomID, uid); err != nil {
+					return nil, err
+				}
+				rm, err := r.Client.Room.Get(p.Context, roomID)
+				if err != nil {
+					return nil, err
+				}
+				if !rm.SealedSender || rm.DeliveryToken == nil {
+					return nil, nil
+				}
+				return *rm.DeliveryToken, nil
+			},
+		},
+	}
+}
+
+func (r *Resolver) sealedSenderMutationFields() graphql.Fields {
+	return graphql.Fields{
+		"rotateDeliveryToken": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "Replaces the room's delivery token, for example after it leaked. Room admins only.",
+			Args: graphql.FieldConfigArgument{
+				"roomId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				roomID, err := decodeID(p.Args["roomId"])
+				if err != nil {
+					return nil, err
+				}
+				if err := r.ensureRoomAdmin(p.Context, roomID, uid); err != nil {
+					return nil, err
+				}
+				sealed, err := r.Client.Room.Query().Where(room.ID(roomID), room.SealedSender(true)).Exist(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				if !sealed {
+					return nil, errors.New("room does not use sealed sender")
+				}
+				token, err := newDeliveryToken()
+				if err != nil {
+					return nil, err
+				}
+				if err := r.Client.Room.UpdateOneID(roomID).SetDeliveryToken(token).Exec(p.Context); err != nil {
+					return nil, err
+				}
+				return token, nil
+			},
+		},
+	}
+}
+
+func newDeliveryToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// rotateDeliveryTokens gives every sealed sender room matching where a new
+// delivery token, so members who left can no longer send to it. client may be
+// bound to a transaction.
+func rotateDeliveryTokens(ctx context.Context, client *ent.Client, where ...predicate.Room) error {
+	ctx = rule.SystemContext(ctx)
+	ids, err := client.Room.Query().
+		Where(append(where, room.SealedSender(true))...).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		token, err := newDeliveryToken()
+		if err != nil {
+			return err
+		}
+		if err := client.Room.UpdateOneID(id).SetDeliveryToken(token).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createSealedMessage stores a message authorized by the room's delivery token
+// instead of the caller's account. No sender or sending device is recorded,
+// so envelopes must address every device of the room: leaving the sending
+// device out would reveal it.
+func (r *Resolver) createSealedMessage(ctx context.Context, roomID int, token string, out outgoingMessage) (msg *ent.Message, err error) {
+	// The caller may be anonymous; the token is the only authorization.
+	sys := rule.SystemContext(ctx)
+	rm, err := r.Client.Room.Get(sys, roomID)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidDeliveryToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !rm.SealedSender || rm.DeliveryToken == nil || subtle.ConstantTimeCompare([]byte(*rm.DeliveryToken), []byte(token)) != 1 {
+		return nil, ErrInvalidDeliveryToken
+	}
+	if out.envelopes != nil && rm.MlsGroupID != nil {
+		return nil, errors.New("messages to MLS rooms are encrypted for the group; send a single cipherText")
+	}
+	scheme, version, err := roomEncryption(rm, out.encryptionScheme, out.encryptionVersion)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := r.Client.Tx(sys)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	seq, err := nextMessageSeqTx(sys, tx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	builder := tx.Message.Create().
+		SetRoomID(roomID).
+		SetSeq(seq).
+		SetEncryptionScheme(scheme).
+		SetEncryptionVersion(version).
+		SetNillableExpiresAt(messageExpiry(rm, out.expiresIn, time.Now()))
+	if out.envelopes == nil {
+		builder.SetCipherText(out.cipherText)
+	}
+	if out.contentType != "" {
+		builder.SetContentType(out.contentType)
+	}
+	if msg, err = builder.Save(sys); err != nil {
+		return nil, err
+	}
+	if out.envelopes != nil {
+		if err = attachEnvelopesTx(sys, tx, msg, roomID, DeviceAddress{}, out.envelopes); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	msg = msg.Unwrap()
+	r.publishMessageEvent(ctx, &MessageEvent{Kind: MessageEventCreated, RoomID: roomID, MessageID: msg.ID, Message: msg})
+	return msg, nil
+}
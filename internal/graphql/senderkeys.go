@@ -0,0 +1,290 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// maxDistributionIDLength bounds the sender-chosen name of a sender key.
+const maxDistributionIDLength = 64
+
+// NotificationKindRotateSenderKey is the kind of the notification sent to the
+// remaining members of a room when a member is removed, asking them to replace
+// their sender keys.
+const NotificationKindRotateSenderKey = "keys.rotate_sender_key"
+
+func (r *Resolver) senderKeyDistributionType() *graphql.Object {
+	if r.senderKeyDistributionObj == nil {
+		r.senderKeyDistributionObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "SenderKeyDistribution",
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return graphql.Fields{
+					"id":             &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+					"distributionId": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("DistributionID")},
+					"senderDeviceId": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: resolveInt64Field("SenderDeviceID")},
+					"cipherText":     &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("CipherText")},
+					"createdAt":      &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+					"sender": &graphql.Field{
+						Type: r.userType(),
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							return p.Source.(*ent.SenderKeyDistribution).QuerySender().Only(p.Context)
+						},
+					},
+					"room": &graphql.Field{
+						Type: r.roomType(),
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							return p.Source.(*ent.SenderKeyDistribution).QueryRoom().Only(p.Context)
+						},
+					},
+				}
+			}),
+		})
+	}
+	return r.senderKeyDistributionObj
+}
+
+func (r *Resolver) senderKeyQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"senderKeyDistributions": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.senderKeyDistributionType()))),
+			Description: "Lists the sender key distributions waiting for one of the caller's devices, optionally for one room.",
+			Args: graphql.FieldConfigArgument{
+				"deviceId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"roomId":   &graphql.ArgumentConfig{Type: graphql.ID},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				dev, err := r.ownDevice(p.Context, uid, p.Args["deviceId"].(int))
+				if err != nil {
+					return nil, err
+				}
+				query := r.Client.SenderKeyDistribution.Query().
+					Where(senderkeydistribution.HasDeviceWith(device.ID(dev.ID))).
+					Order(ent.Asc(senderkeydistribution.FieldID))
+				if raw, ok := p.Args["roomId"]; ok && raw != nil {
+					roomID, err := decodeID(raw)
+					if err != nil {
+						return nil, err
+					}
+					query.Where(senderkeydistribution.HasRoomWith(room.ID(roomID)))
+				}
+				return query.All(p.Context)
+			},
+		},
+	}
+}
+
+func (r *Resolver) senderKeyMutationFields() graphql.Fields {
+	return graphql.Fields{
+		"distributeSenderKey": &graphql.Field{
+			Type:        graphql.Int,
+			Description: "Delivers the caller's sender key for a room, encrypted pairwise for every registered device of the room's members other than senderDeviceId. Replaces the device's earlier undelivered distributions for the room and returns how many devices it was sent to.",
+			Args: graphql.FieldConfigArgument{
+				"roomId":         &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"senderDeviceId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"distributionId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"envelopes":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.messageEnvelopeInputType())))},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				roomID, err := decodeID(p.Args["roomId"])
+				if err != nil {
+					return nil, err
+				}
+				distributionID := p.Args["distributionId"].(string)
+				if distributionID == "" || len(distributionID) > maxDistributionIDLength {
+					return nil, errors.New("distributionId must be 1 to 64 characters")
+				}
+				envelopes, err := decodeEnvelopes(p.Args["envelopes"].([]interface{}))
+				if err != nil {
+					return nil, err
+				}
+				dev, err := r.ownDevice(p.Context, uid, p.Args["senderDeviceId"].(int))
+				if err != nil {
+					return nil, err
+				}
+				if err := r.ensureRoomAccess(p.Context, roomID, uid); err != nil {
+					return nil, err
+				}
+				if err := r.distributeSenderKey(p.Context, uid, roomID, dev.DeviceID, distributionID, envelopes); err != nil {
+					return nil, err
+				}
+				return len(envelopes), nil
+			},
+		},
+		"deleteSenderKeyDistribution": &graphql.Field{
+			Type:        graphql.Boolean,
+			Description: "Acknowledges a sender key distribution once the device has processed it.",
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				id, err := decodeID(p.Args["id"])
+				if err != nil {
+					return nil, err
+				}
+				n, err := r.Client.SenderKeyDistribution.Delete().
+					Where(
+						senderkeydistribution.ID(id),
+						senderkeydistribution.HasDeviceWith(device.HasUserWith(user.ID(uid))),
+					).
+					Exec(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				return n == 1, nil
+			},
+		},
+	}
+}
+
+// distributeSenderKey stores one distribution per recipient device after
+// checking that they cover the room's devices like a message send.
+func (r *Resolver) distributeSenderKey(ctx context.Context, uid, roomID, deviceID int, distributionID string, envelopes []envelopeInput) (err error) {
+	isMLS, err := r.Client.Room.Query().Where(room.ID(roomID), room.MlsGroupIDNotNil()).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if isMLS {
+		return errors.New("MLS rooms do not use sender keys")
+	}
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer rollbackOnError(tx, &err)
+
+	sender := DeviceAddress{UserID: uid, DeviceID: deviceID}
+	devices, err := matchRoomDevicesTx(ctx, tx, roomID, sender, envelopes)
+	if err != nil {
+		return err
+	}
+	if _, err = tx.SenderKeyDistribution.Delete().
+		Where(
+			senderkeydistribution.HasRoomWith(room.ID(roomID)),
+			senderkeydistribution.HasSenderWith(user.ID(uid)),
+			senderkeydistribution.SenderDeviceID(deviceID),
+		).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if len(envelopes) > 0 {
+		builders := make([]*ent.SenderKeyDistributionCreate, 0, len(envelopes))
+		for _, env := range envelopes {
+			builders = append(builders, tx.SenderKeyDistribution.Create().
+				SetRoomID(roomID).
+				SetSenderID(uid).
+				SetSenderDeviceID(deviceID).
+				SetDistributionID(distributionID).
+				SetDevice(devices[env.address]).
+				SetCipherText(env.cipherText))
+		}
+		if err = tx.SenderKeyDistribution.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// removeMember removes a member from a room. Their undelivered sender keys
+// and the ones addressed to them are dropped and the room's delivery token is
+// replaced in the same transaction, and the remaining members are then asked
+// to replace their sender keys. ctx decides whether the removal is allowed.
+func (r *Resolver) removeMember(ctx context.Context, roomID, memberID int) (removed bool, err error) {
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	n, err := tx.RoomMembership.Delete().
+		Where(roommembership.HasRoomWith(room.ID(roomID)), roommembership.HasUserWith(user.ID(memberID))).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, tx.Commit()
+	}
+	rotatedAt := time.Now()
+	if err = rotateSenderKeysTx(ctx, tx, roomID, memberID, rotatedAt); err != nil {
+		return false, err
+	}
+	if err = rotateDeliveryTokens(ctx, tx.Client(), room.ID(roomID)); err != nil {
+		return false, err
+	}
+	if err = tx.Commit(); err != nil {
+		return false, err
+	}
+	r.notifySenderKeyRotation(ctx, roomID, memberID, rotatedAt)
+	return true, nil
+}
+
+// rotateSenderKeysTx handles a member leaving a room: their undelivered sender
+// keys and the ones addressed to them are dropped and the room records the
+// rotation. Once the transaction commits, notifySenderKeyRotation asks the
+// remaining members to replace their sender keys. The membership removal
+// must already be authorized.
+func rotateSenderKeysTx(ctx context.Context, tx *ent.Tx, roomID, removedUserID int, rotatedAt time.Time) error {
+	sys := rule.SystemContext(ctx)
+	_, err := tx.SenderKeyDistribution.Delete().
+		Where(
+			senderkeydistribution.HasRoomWith(room.ID(roomID)),
+			senderkeydistribution.Or(
+				senderkeydistribution.HasSenderWith(user.ID(removedUserID)),
+				senderkeydistribution.HasDeviceWith(device.HasUserWith(user.ID(removedUserID))),
+			),
+		).
+		Exec(sys)
+	if err != nil {
+		return err
+	}
+	return tx.Room.UpdateOneID(roomID).SetSenderKeysRotatedAt(rotatedAt).Exec(sys)
+}
+
+// notifySenderKeyRotation sends keys.rotate_sender_key to the remaining
+// members of a room. Failures are logged: the rotation is already recorded
+// in the room's senderKeysRotatedAt.
+func (r *Resolver) notifySenderKeyRotation(ctx context.Context, roomID, removedUserID int, rotatedAt time.Time) {
+	members, err := r.Client.User.Query().
+		Where(user.HasMembershipsWith(roommembership.HasRoomWith(room.ID(roomID)))).
+		IDs(rule.SystemContext(ctx))
+	if err != nil {
+		log.Printf("sender key rotation notices for room %d: %v", roomID, err)
+		return
+	}
+	payload := map[string]interface{}{
+		"roomId":        strconv.Itoa(roomID),
+		"removedUserId": strconv.Itoa(removedUserID),
+		"rotatedAt":     rotatedAt.UTC(),
+	}
+	for _, memberID := range members {
+		if err := r.publishServerNotification(ctx, memberID, NotificationKindRotateSenderKey, payload); err != nil {
+			log.Printf("sender key rotation notice for user %d: %v", memberID, err)
+		}
+	}
+}
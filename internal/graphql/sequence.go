@@ -0,0 +1,124 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// nextMessageSeqTx reserves the next sequence number of a room's messages.
+// It must be the first write of the transaction storing the message: the
+// room row stays locked until commit, so numbers are handed out without gaps
+// in the order messages are stored.
+func nextMessageSeqTx(ctx context.Context, tx *ent.Tx, roomID int) (int, error) {
+	rm, err := tx.Room.UpdateOneID(roomID).AddMessageSeq(1).Save(rule.SystemContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	return rm.MessageSeq, nil
+}
+
+// createCipherTextMessage sends a message as a single cipherText readable by
+// the whole room.
+func (r *Resolver) createCipherTextMessage(ctx context.Context, uid, roomID int, senderDeviceID *int, out outgoingMessage) (msg *ent.Message, err error) {
+	rm, err := r.Client.Room.Get(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	scheme, version, err := roomEncryption(rm, out.encryptionScheme, out.encryptionVersion)
+	if err != nil {
+		return nil, err
+	}
+	if senderDeviceID != nil {
+		if _, err := r.ownDevice(ctx, uid, *senderDeviceID); err != nil {
+			return nil, err
+		}
+	}
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+
+	seq, err := nextMessageSeqTx(ctx, tx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	builder := tx.Message.Create().
+		SetRoomID(roomID).
+		SetSenderID(uid).
+		SetSeq(seq).
+		SetCipherText(out.cipherText).
+		SetEncryptionScheme(scheme).
+		SetEncryptionVersion(version).
+		SetNillableExpiresAt(messageExpiry(rm, out.expiresIn, time.Now())).
+		SetNillableSenderDeviceID(senderDeviceID)
+	if out.contentType != "" {
+		builder.SetContentType(out.contentType)
+	}
+	if msg, err = builder.Save(ctx); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	msg = msg.Unwrap()
+	r.publishMessageEvent(ctx, &MessageEvent{Kind: MessageEventCreated, RoomID: roomID, MessageID: msg.ID, Message: msg})
+	return msg, nil
+}
+
+// BackfillMessageSeqs numbers messages stored before sequence numbers were
+// introduced, in the order they were created, continuing from each room's
+// latest number. It is run at startup, before any message is sent.
+func BackfillMessageSeqs(ctx context.Context, client *ent.Client) error {
+	ctx = rule.SystemContext(ctx)
+	roomIDs, err := client.Room.Query().
+		Where(room.HasMessagesWith(message.SeqIsNil())).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, roomID := range roomIDs {
+		if err := backfillRoomMessageSeqs(ctx, client, roomID); err != nil {
+			return fmt.Errorf("numbering messages of room %d: %w", roomID, err)
+		}
+	}
+	return nil
+}
+
+func backfillRoomMessageSeqs(ctx context.Context, client *ent.Client, roomID int) (err error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer rollbackOnError(tx, &err)
+
+	rm, err := tx.Room.Get(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	messages, err := tx.Message.Query().
+		Where(message.HasRoomWith(room.ID(roomID)), message.SeqIsNil()).
+		Order(ent.Asc(message.FieldCreatedAt, message.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	seq := rm.MessageSeq
+	// updated_at is kept, since numbering a message does not change it.
+	for _, msg := range messages {
+		seq++
+		if err = tx.Message.UpdateOne(msg).SetSeq(seq).SetUpdatedAt(msg.UpdatedAt).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if err = tx.Room.UpdateOne(rm).SetMessageSeq(seq).SetUpdatedAt(rm.UpdatedAt).Exec(ctx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,217 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+// createRoom creates a room owned by owner with the given other members.
+func (env *testEnv) createRoom(owner int, members ...int) int {
+	env.t.Helper()
+	ids := ""
+	for _, id := range members {
+		ids += fmt.Sprintf(`"%d",`, id)
+	}
+	data := env.do(owner, `mutation { createRoom(name: "room", participantIds: [`+ids+`]) { id } }`)
+	id, err := strconv.Atoi(data["createRoom"].(map[string]interface{})["id"].(string))
+	if err != nil {
+		env.t.Fatal(err)
+	}
+	return id
+}
+
+// sendMessage sends a message to a room and returns its seq.
+func (env *testEnv) sendMessage(uid, roomID int) (int, error) {
+	data, err := env.exec(uid, fmt.Sprintf(`mutation { createMessage(roomId: "%d", cipherText: "aGk=") { seq } }`, roomID))
+	if err != nil {
+		return 0, err
+	}
+	return data["createMessage"].(map[string]interface{})["seq"].(int), nil
+}
+
+// messagePage lists a page of a room's messages and returns their seqs and
+// cursors and the page info.
+func (env *testEnv) messagePage(uid, roomID int, args string) ([]int, []string, map[string]interface{}) {
+	env.t.Helper()
+	data := env.do(uid, fmt.Sprintf(`{ messages(roomId: "%d"%s) { edges { cursor node { seq } } pageInfo { hasNextPage hasPreviousPage } } }`, roomID, args))
+	conn := data["messages"].(map[string]interface{})
+	var seqs []int
+	var cursors []string
+	for _, raw := range conn["edges"].([]interface{}) {
+		edge := raw.(map[string]interface{})
+		seqs = append(seqs, edge["node"].(map[string]interface{})["seq"].(int))
+		cursors = append(cursors, edge["cursor"].(string))
+	}
+	return seqs, cursors, conn["pageInfo"].(map[string]interface{})
+}
+
+func TestCreateMessageSeqsAreConsecutiveUnderConcurrency(t *testing.T) {
+	env := newTestEnv(t)
+	alice := env.addUser("alice")
+	bob := env.addUser("bob")
+	roomID := env.createRoom(alice, bob)
+
+	const sends = 20
+	seqs := make([]int, sends)
+	errs := make([]error, sends)
+	var wg sync.WaitGroup
+	for i := 0; i < sends; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sender := alice
+			if i%2 == 1 {
+				sender = bob
+			}
+			seqs[i], errs[i] = env.sendMessage(sender, roomID)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	slices.Sort(seqs)
+	for i, seq := range seqs {
+		if seq != i+1 {
+			t.Fatalf("seqs = %v, want 1 to %d without gaps or repeats", seqs, sends)
+		}
+	}
+
+	// Numbers follow the order messages were stored.
+	ctx := rule.SystemContext(context.Background())
+	stored, err := env.client.Message.Query().
+		Where(message.HasRoomWith(room.ID(roomID))).
+		Order(ent.Asc(message.FieldID)).
+		All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, msg := range stored {
+		if msg.Seq != i+1 {
+			t.Fatalf("message %d stored at position %d has seq %d", msg.ID, i+1, msg.Seq)
+		}
+	}
+	rm, err := env.client.Room.Get(ctx, roomID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rm.MessageSeq != sends {
+		t.Fatalf("room message seq = %d, want %d", rm.MessageSeq, sends)
+	}
+}
+
+func TestMessagesAfterSeqPaging(t *testing.T) {
+	env := newTestEnv(t)
+	alice := env.addUser("alice")
+	roomID := env.createRoom(alice)
+	for i := 0; i < 10; i++ {
+		if _, err := env.sendMessage(alice, roomID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, all, _ := env.messagePage(alice, roomID, "")
+	cursorOf := func(seq int) string { return all[seq-1] }
+
+	forward, cursors, info := env.messagePage(alice, roomID, ", afterSeq: 3, first: 4")
+	if want := []int{4, 5, 6, 7}; !slices.Equal(forward, want) || info["hasNextPage"] != true {
+		t.Fatalf("afterSeq 3 first 4 = %v %v, want %v with a next page", forward, info, want)
+	}
+	rest, _, info := env.messagePage(alice, roomID, fmt.Sprintf(", afterSeq: 3, first: 4, after: %q", cursors[len(cursors)-1]))
+	if want := []int{8, 9, 10}; !slices.Equal(rest, want) || info["hasNextPage"] != false {
+		t.Fatalf("next page = %v %v, want %v and no more", rest, info, want)
+	}
+
+	tests := []struct {
+		name         string
+		args         string
+		want         []int
+		wantPrevious bool
+	}{
+		{"last", ", afterSeq: 3, last: 2", []int{9, 10}, true},
+		{"before", fmt.Sprintf(", afterSeq: 3, before: %q", cursorOf(6)), []int{4, 5}, false},
+		{"last before", fmt.Sprintf(", afterSeq: 3, last: 4, before: %q", cursorOf(10)), []int{6, 7, 8, 9}, true},
+		{"last before reaching afterSeq", fmt.Sprintf(", afterSeq: 3, last: 4, before: %q", cursorOf(7)), []int{4, 5, 6}, false},
+		{"afterSeq past the end", ", afterSeq: 10, last: 4", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seqs, _, info := env.messagePage(alice, roomID, tt.args)
+			if !slices.Equal(seqs, tt.want) || info["hasPreviousPage"] != tt.wantPrevious {
+				t.Fatalf("seqs = %v, hasPreviousPage = %v, want %v, %v", seqs, info["hasPreviousPage"], tt.want, tt.wantPrevious)
+			}
+		})
+	}
+}
+
+func TestBackfillMessageSeqs(t *testing.T) {
+	env := newTestEnv(t)
+	alice := env.addUser("alice")
+	roomID := env.createRoom(alice)
+	// Two messages were already numbered.
+	for i := 0; i < 2; i++ {
+		if _, err := env.sendMessage(alice, roomID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx := rule.SystemContext(context.Background())
+	base := time.Now().Add(-time.Hour)
+	store := func(offset time.Duration) *ent.Message {
+		t.Helper()
+		msg, err := env.client.Message.Create().
+			SetRoomID(roomID).
+			SetSenderID(alice).
+			SetCipherText("aGk=").
+			SetCreatedAt(base.Add(offset)).
+			SetUpdatedAt(base).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return msg
+	}
+	// Stored out of creation order, with two messages created at once that
+	// are ordered by ID.
+	latest := store(2 * time.Minute)
+	earliest := store(0)
+	tiedFirst := store(time.Minute)
+	tiedSecond := store(time.Minute)
+
+	if err := BackfillMessageSeqs(context.Background(), env.client); err != nil {
+		t.Fatal(err)
+	}
+	want := map[int]int{earliest.ID: 3, tiedFirst.ID: 4, tiedSecond.ID: 5, latest.ID: 6}
+	for id, seq := range want {
+		msg, err := env.client.Message.Get(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if msg.Seq != seq {
+			t.Errorf("message created at %v has seq %d, want %d", msg.CreatedAt.Sub(base), msg.Seq, seq)
+		}
+		if !msg.UpdatedAt.Equal(base) {
+			t.Errorf("numbering message %d changed its updated_at", id)
+		}
+	}
+	rm, err := env.client.Room.Get(ctx, roomID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rm.MessageSeq != 6 {
+		t.Fatalf("room message seq = %d, want 6", rm.MessageSeq)
+	}
+	if seq, err := env.sendMessage(alice, roomID); err != nil || seq != 7 {
+		t.Fatalf("next message got seq %d (%v), want 7", seq, err)
+	}
+}
@@ -0,0 +1,288 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+)
+
+// maxRetiredTokenHashes bounds how many rotated refresh tokens are remembered
+// per session for reuse detection.
+const maxRetiredTokenHashes = 16
+
+// SessionRevokedListener is notified with the IDs of sessions that were revoked.
+type SessionRevokedListener func(context.Context, []int)
+
+// RegisterSessionRevokedListener registers a callback invoked whenever sessions are revoked.
+func (r *Resolver) RegisterSessionRevokedListener(fn SessionRevokedListener) {
+	if fn == nil {
+		return
+	}
+	r.sessionRevokedListeners = append(r.sessionRevokedListeners, fn)
+}
+
+func (r *Resolver) sessionType() *graphql.Object {
+	if r.sessionObj == nil {
+		r.sessionObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "Session",
+			Fields: graphql.FieldsThunk(func() graphql.Fields {
+				return graphql.Fields{
+					"id":          &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+					"deviceLabel": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("DeviceLabel")},
+					"ipAddress":   &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("IPAddress")},
+					"userAgent":   &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("UserAgent")},
+					"createdAt":   &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+					"lastUsedAt":  &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("LastUsedAt")},
+					"expiresAt":   &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("ExpiresAt")},
+					"revokedAt":   &graphql.Field{Type: graphql.DateTime, Resolve: resolveOptionalTimeField("RevokedAt")},
+					"current": &graphql.Field{
+						Type: graphql.NewNonNull(graphql.Boolean),
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							sess := p.Source.(*ent.Session)
+							claims, err := auth.ClaimsFromContext(p.Context)
+							if err != nil {
+								return false, nil
+							}
+							return claims.SessionID == sess.ID, nil
+						},
+					},
+				}
+			}),
+		})
+	}
+	return r.sessionObj
+}
+
+func (r *Resolver) sessionQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"sessions": &graphql.Field{
+			Type: graphql.NewList(r.sessionType()),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				return r.Client.Session.Query().
+					Where(
+						session.HasUserWith(user.ID(uid)),
+						session.RevokedAtIsNil(),
+						session.ExpiresAtGT(time.Now()),
+					).
+					Order(ent.Desc(session.FieldLastUsedAt)).
+					All(p.Context)
+			},
+		},
+	}
+}
+
+func (r *Resolver) sessionMutationFields() graphql.Fields {
+	return graphql.Fields{
+		"refreshToken": &graphql.Field{
+			Type: r.authPayloadType(),
+			Args: graphql.FieldConfigArgument{
+				"refreshToken": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return r.rotateSession(p.Context, p.Args["refreshToken"].(string))
+			},
+		},
+		"revokeSession": &graphql.Field{
+			Type: graphql.Boolean,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				uid, err := auth.UserIDFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				id, err := decodeID(p.Args["id"])
+				if err != nil {
+					return nil, err
+				}
+				exists, err := r.Client.Session.Query().
+					Where(session.ID(id), session.HasUserWith(user.ID(uid))).
+					Exist(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				if !exists {
+					return nil, ErrForbidden
+				}
+				if _, err := r.revokeSessions(p.Context, "revoked by user", session.ID(id)); err != nil {
+					return nil, err
+				}
+				return true, nil
+			},
+		},
+		"revokeAllSessions": &graphql.Field{
+			Type:        graphql.Int,
+			Description: "Revokes every active session of the caller and returns how many were revoked.",
+			Args: graphql.FieldConfigArgument{
+				"includeCurrent": &graphql.ArgumentConfig{Type: graphql.Boolean},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				claims, err := auth.ClaimsFromContext(p.Context)
+				if err != nil {
+					return nil, ErrUnauthorized
+				}
+				predicates := []predicate.Session{session.HasUserWith(user.ID(claims.UserID))}
+				if includeCurrent, _ := p.Args["includeCurrent"].(bool); !includeCurrent {
+					predicates = append(predicates, session.IDNEQ(claims.SessionID))
+				}
+				return r.revokeSessions(p.Context, "revoked by user", predicates...)
+			},
+		},
+	}
+}
+
+// startSession opens a new session for the user and issues its first token pair.
+// mfaVerifiedAt records when the second factor was last presented, if it was.
+func (r *Resolver) startSession(ctx context.Context, usr *ent.User, deviceLabel string, mfaVerifiedAt *time.Time) (*AuthPayload, error) {
+	if usr.SuspendedAt != nil {
+		return nil, ErrAccountSuspended
+	}
+	info := auth.RequestInfoFromContext(ctx)
+	now := time.Now()
+	// The session ID is part of the refresh token, so the row is created with a
+	// placeholder hash and the real one is written once the ID is known.
+	placeholder, err := auth.NewSecret(32)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := r.Client.Session.Create().
+		SetUser(usr).
+		SetDeviceLabel(deviceLabel).
+		SetIPAddress(info.IPAddress).
+		SetUserAgent(info.UserAgent).
+		SetRefreshTokenHash(auth.HashToken(placeholder)).
+		SetCreatedAt(now).
+		SetLastUsedAt(now).
+		SetExpiresAt(now.Add(r.tokens.RefreshTTL())).
+		SetNillableMfaVerifiedAt(mfaVerifiedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	refresh, hash, err := auth.NewRefreshToken(sess.ID)
+	if err != nil {
+		return nil, err
+	}
+	sess, err = sess.Update().SetRefreshTokenHash(hash).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.sessionPayload(usr, sess, refresh)
+}
+
+// rotateSession exchanges a refresh token for a new token pair. Presenting a
+// refresh token that was already rotated away is treated as theft and revokes
+// the whole session.
+func (r *Resolver) rotateSession(ctx context.Context, raw string) (*AuthPayload, error) {
+	sessionID, hash, err := auth.ParseRefreshToken(raw)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+	sess, err := r.Client.Session.Query().
+		Where(session.ID(sessionID)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUnauthorized
+		}
+		return nil, err
+	}
+	if sess.RevokedAt != nil || time.Now().After(sess.ExpiresAt) {
+		return nil, ErrUnauthorized
+	}
+	if sess.Edges.User.SuspendedAt != nil {
+		return nil, ErrAccountSuspended
+	}
+	if sess.RefreshTokenHash != hash {
+		for _, retired := range sess.RetiredTokenHashes {
+			if retired == hash {
+				if _, err := r.revokeSessions(ctx, "refresh token reuse detected", session.ID(sess.ID)); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+		return nil, ErrUnauthorized
+	}
+	refresh, newHash, err := auth.NewRefreshToken(sess.ID)
+	if err != nil {
+		return nil, err
+	}
+	retired := append([]string{sess.RefreshTokenHash}, sess.RetiredTokenHashes...)
+	if len(retired) > maxRetiredTokenHashes {
+		retired = retired[:maxRetiredTokenHashes]
+	}
+	info := auth.RequestInfoFromContext(ctx)
+	now := time.Now()
+	expiresAt := now.Add(r.tokens.RefreshTTL())
+	// Guard on the current hash so two concurrent refreshes cannot both succeed.
+	updated, err := r.Client.Session.Update().
+		Where(session.ID(sess.ID), session.RefreshTokenHash(hash), session.RevokedAtIsNil()).
+		SetRefreshTokenHash(newHash).
+		SetRetiredTokenHashes(retired).
+		SetLastUsedAt(now).
+		SetExpiresAt(expiresAt).
+		SetIPAddress(info.IPAddress).
+		SetUserAgent(info.UserAgent).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, ErrUnauthorized
+	}
+	sess.ExpiresAt = expiresAt
+	return r.sessionPayload(sess.Edges.User, sess, refresh)
+}
+
+// revokeSessions marks every active session matching the predicates as revoked,
+// notifies listeners and returns the number of sessions revoked.
+func (r *Resolver) revokeSessions(ctx context.Context, reason string, predicates ...predicate.Session) (int, error) {
+	predicates = append(predicates, session.RevokedAtIsNil())
+	ids, err := r.Client.Session.Query().Where(predicates...).IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	_, err = r.Client.Session.Update().
+		Where(session.IDIn(ids...)).
+		SetRevokedAt(time.Now()).
+		SetRevocationReason(reason).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, listener := range r.sessionRevokedListeners {
+		listener(ctx, ids)
+	}
+	return len(ids), nil
+}
+
+func (r *Resolver) sessionPayload(usr *ent.User, sess *ent.Session, refresh string) (*AuthPayload, error) {
+	access, accessExp, err := r.tokens.IssueAccessToken(usr.ID, sess.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthPayload{
+		AccessToken:           access,
+		AccessTokenExpiresAt:  accessExp,
+		RefreshToken:          refresh,
+		RefreshTokenExpiresAt: sess.ExpiresAt,
+		User:                  usr,
+	}, nil
+}
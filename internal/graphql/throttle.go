@@ -0,0 +1,265 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/loginthrottle"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/internal/auth"
+)
+
+const (
+	// loginFreeAttempts is how many failed logins an account allows from one
+	// client address before each further attempt from it must wait. A client
+	// address, which may be shared by many users, allows loginFreeIPAttempts
+	// across all accounts.
+	loginFreeAttempts   = 3
+	loginFreeIPAttempts = 20
+	// loginBackoffBase is the wait after the first failure past the free
+	// attempts; it doubles with every further failure up to loginBackoffMax.
+	loginBackoffBase = time.Second
+	loginBackoffMax  = 5 * time.Minute
+	// loginFailureWindow is how long a failure is remembered without a new one.
+	loginFailureWindow = time.Hour
+	// maxLoginFailures locks an account's password login from one client
+	// address. The higher maxAccountLoginFailures locks it from everywhere,
+	// against guessing spread over many addresses, and maxIPLoginFailures
+	// locks a client address guessing across many accounts.
+	maxLoginFailures        = 10
+	maxAccountLoginFailures = 100
+	maxIPLoginFailures      = 50
+	// loginLockout is how long a lockout lasts.
+	loginLockout = 15 * time.Minute
+)
+
+// NotificationKindLoginLocked is the kind of the security notification sent
+// when password login to an account is locked.
+const NotificationKindLoginLocked = "security.login_locked"
+
+// ErrLoginThrottled is returned while failed logins hold back further attempts
+// for an account or client address.
+var ErrLoginThrottled = errors.New("too many failed login attempts, try again later")
+
+// loginThrottleKey identifies one counter of failed logins.
+type loginThrottleKey struct {
+	scope loginthrottle.Scope
+	key   string
+	// userID is set for the account counters of an existing user.
+	userID int
+}
+
+// freeAttempts is how many failures the counter allows before backing off.
+// The account-wide counter never backs off, so guessing from elsewhere cannot
+// slow the user down before the account is locked.
+func (k loginThrottleKey) freeAttempts() int {
+	switch k.scope {
+	case loginthrottle.ScopeIP:
+		return loginFreeIPAttempts
+	case loginthrottle.ScopeAccount:
+		return maxAccountLoginFailures
+	}
+	return loginFreeAttempts
+}
+
+func (k loginThrottleKey) limit() int {
+	switch k.scope {
+	case loginthrottle.ScopeIP:
+		return maxIPLoginFailures
+	case loginthrottle.ScopeAccount:
+		return maxAccountLoginFailures
+	}
+	return maxLoginFailures
+}
+
+// loginThrottleKeys returns the counters a login attempt is checked against:
+// the account from the client address, the account from anywhere, and the
+// client address when it is known. Identifiers that match no account are
+// counted under the identifier itself so they are throttled the same way and
+// cannot be told apart from real accounts.
+func loginThrottleKeys(ctx context.Context, identifier string, usr *ent.User) []loginThrottleKey {
+	account := loginThrottleKey{scope: loginthrottle.ScopeAccount}
+	if usr != nil {
+		account.key = strconv.Itoa(usr.ID)
+		account.userID = usr.ID
+	} else {
+		account.key = "unknown:" + strings.ToLower(identifier)
+	}
+	ip := auth.RequestInfoFromContext(ctx).IPAddress
+	pair := loginThrottleKey{
+		scope:  loginthrottle.ScopeAccountIP,
+		key:    account.key + "|" + ip,
+		userID: account.userID,
+	}
+	keys := []loginThrottleKey{pair, account}
+	if ip != "" {
+		keys = append(keys, loginThrottleKey{scope: loginthrottle.ScopeIP, key: ip})
+	}
+	return keys
+}
+
+// loginAttempt is a login attempt already counted against its throttle
+// counters.
+type loginAttempt struct {
+	keys []loginThrottleKey
+	// locked holds the account counters the attempt locked; their user is
+	// told once the password turns out to be wrong.
+	locked []loginThrottleKey
+	until  time.Time
+}
+
+// reserveLoginAttempt counts an attempt as a failure against every counter
+// before the password is checked, delaying the next attempt once the free
+// attempts are used up and locking a counter that reaches its limit. It
+// rejects the attempt while any counter is locked or backing off. The counters
+// change in one transaction that starts with a write, so SQLite runs parallel
+// attempts one after another and each sees the ones before it.
+func (r *Resolver) reserveLoginAttempt(ctx context.Context, keys []loginThrottleKey) (attempt *loginAttempt, err error) {
+	now := time.Now()
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackOnError(tx, &err)
+	attempt = &loginAttempt{keys: keys, until: now.Add(loginLockout)}
+	for _, k := range keys {
+		var row *ent.LoginThrottle
+		row, err = bumpLoginThrottle(ctx, tx, k, now)
+		if err != nil {
+			return nil, err
+		}
+		update := row.Update()
+		if row.Failures >= k.limit() {
+			update.SetFailures(0).ClearRetryAt().SetLockedUntil(attempt.until)
+			if k.userID != 0 {
+				attempt.locked = append(attempt.locked, k)
+			}
+		} else if row.Failures > k.freeAttempts() {
+			update.SetRetryAt(now.Add(loginBackoff(row.Failures - k.freeAttempts())))
+		}
+		if err = update.Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// bumpLoginThrottle adds a failure to a counter that is neither locked nor
+// backing off, creating it on the first failure and starting over when the
+// last one is older than the window. It returns ErrLoginThrottled when the
+// counter holds the attempt back.
+func bumpLoginThrottle(ctx context.Context, tx *ent.Tx, k loginThrottleKey, now time.Time) (*ent.LoginThrottle, error) {
+	counter := func() *ent.LoginThrottleUpdate {
+		return tx.LoginThrottle.Update().
+			Where(loginthrottle.ScopeEQ(k.scope), loginthrottle.Key(k.key))
+	}
+	_, err := counter().
+		Where(
+			loginthrottle.LastFailureAtLT(now.Add(-loginFailureWindow)),
+			loginthrottle.Or(loginthrottle.LockedUntilIsNil(), loginthrottle.LockedUntilLT(now)),
+		).
+		SetFailures(0).
+		ClearRetryAt().
+		ClearLockedUntil().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n, err := counter().
+		Where(
+			loginthrottle.Or(loginthrottle.LockedUntilIsNil(), loginthrottle.LockedUntilLTE(now)),
+			loginthrottle.Or(loginthrottle.RetryAtIsNil(), loginthrottle.RetryAtLTE(now)),
+		).
+		AddFailures(1).
+		SetLastFailureAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := tx.LoginThrottle.Query().
+		Where(loginthrottle.ScopeEQ(k.scope), loginthrottle.Key(k.key))
+	if n > 0 {
+		return query.Only(ctx)
+	}
+	exists, err := query.Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrLoginThrottled
+	}
+	create := tx.LoginThrottle.Create().
+		SetScope(k.scope).
+		SetKey(k.key).
+		SetFailures(1).
+		SetLastFailureAt(now)
+	if k.userID != 0 {
+		create.SetUserID(k.userID)
+	}
+	return create.Save(ctx)
+}
+
+// loginFailed notifies the users whose account the failed attempt locked and
+// returns the error to report.
+func (r *Resolver) loginFailed(ctx context.Context, attempt *loginAttempt) error {
+	for _, k := range attempt.locked {
+		if err := r.notifyLoginLocked(ctx, k.userID, attempt.until); err != nil {
+			return err
+		}
+	}
+	return ErrInvalidCredentials
+}
+
+// loginBackoff is the wait after the nth failure past the free attempts.
+func loginBackoff(n int) time.Duration {
+	wait := loginBackoffBase
+	for i := 1; i < n && wait < loginBackoffMax; i++ {
+		wait *= 2
+	}
+	if wait > loginBackoffMax {
+		wait = loginBackoffMax
+	}
+	return wait
+}
+
+// loginSucceeded forgets the failed logins to an account from the client
+// address and from anywhere after a successful one. The counters of other
+// addresses are left alone, and the client address counter only takes this
+// attempt back, so one working password does not reset guessing against the
+// account elsewhere or against other accounts.
+func (r *Resolver) loginSucceeded(ctx context.Context, attempt *loginAttempt) error {
+	for _, k := range attempt.keys {
+		counter := []predicate.LoginThrottle{loginthrottle.ScopeEQ(k.scope), loginthrottle.Key(k.key)}
+		if k.scope != loginthrottle.ScopeIP {
+			if _, err := r.Client.LoginThrottle.Delete().Where(counter...).Exec(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		_, err := r.Client.LoginThrottle.Update().
+			Where(counter...).
+			Where(loginthrottle.FailuresGT(0)).
+			AddFailures(-1).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notifyLoginLocked tells a user that password login to their account was locked.
+func (r *Resolver) notifyLoginLocked(ctx context.Context, userID int, until time.Time) error {
+	return r.publishServerNotification(ctx, userID, NotificationKindLoginLocked, map[string]interface{}{
+		"reason":      "too many failed login attempts",
+		"ipAddress":   auth.RequestInfoFromContext(ctx).IPAddress,
+		"lockedUntil": until.UTC(),
+	})
+}
@@ -0,0 +1,405 @@
+package graphql
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/keylogentry"
+	"github.com/eleven-am/enclave/ent/keylognode"
+	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/transparency"
+)
+
+// keyLogBackfillBatch is how many entries BackfillKeyLog reads at a time.
+const keyLogBackfillBatch = 500
+
+// keyLogTreeHead is a signed tree head as served over GraphQL, with hashes
+// and the signature in standard base64.
+type keyLogTreeHead struct {
+	TreeSize  int       `json:"treeSize"`
+	RootHash  string    `json:"rootHash"`
+	Timestamp time.Time `json:"timestamp"`
+	Signature string    `json:"signature"`
+}
+
+// keyLogInclusionProof proves that an entry is part of the tree of TreeSize.
+type keyLogInclusionProof struct {
+	Entry    *ent.KeyLogEntry `json:"entry"`
+	TreeSize int              `json:"treeSize"`
+	Hashes   []string         `json:"hashes"`
+}
+
+// keyLogConsistencyProof proves that the tree of FirstSize is a prefix of the
+// tree of SecondSize.
+type keyLogConsistencyProof struct {
+	FirstSize  int      `json:"firstSize"`
+	SecondSize int      `json:"secondSize"`
+	Hashes     []string `json:"hashes"`
+}
+
+func (r *Resolver) keyLogEntryType() *graphql.Object {
+	if r.keyLogEntryObj == nil {
+		r.keyLogEntryObj = graphql.NewObject(graphql.ObjectConfig{
+			Name:        "KeyLogEntry",
+			Description: "An identity key publication recorded in the key transparency log.",
+			Fields: graphql.Fields{
+				"index":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: resolveInt64Field("LeafIndex")},
+				"userId":      &graphql.Field{Type: graphql.NewNonNull(graphql.ID), Resolve: resolveInt64Field("UserID")},
+				"deviceId":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: resolveInt64Field("DeviceID")},
+				"identityKey": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("IdentityKey")},
+				"publishedAt": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("PublishedAt")},
+				"leafHash": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return base64.StdEncoding.EncodeToString(p.Source.(*ent.KeyLogEntry).LeafHash), nil
+					},
+				},
+			},
+		})
+	}
+	return r.keyLogEntryObj
+}
+
+func (r *Resolver) keyLogTreeHeadType() *graphql.Object {
+	if r.keyLogTreeHeadObj == nil {
+		r.keyLogTreeHeadObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "KeyLogTreeHead",
+			Fields: graphql.Fields{
+				"treeSize":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"rootHash":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"timestamp": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+				"signature": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "Ed25519 signature over the head, checked with keyLogPublicKey."},
+			},
+		})
+	}
+	return r.keyLogTreeHeadObj
+}
+
+func (r *Resolver) keyLogInclusionProofType() *graphql.Object {
+	if r.keyLogInclusionProofObj == nil {
+		r.keyLogInclusionProofObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "KeyLogInclusionProof",
+			Fields: graphql.Fields{
+				"entry":    &graphql.Field{Type: graphql.NewNonNull(r.keyLogEntryType())},
+				"treeSize": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"hashes":   &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String)))},
+			},
+		})
+	}
+	return r.keyLogInclusionProofObj
+}
+
+func (r *Resolver) keyLogConsistencyProofType() *graphql.Object {
+	if r.keyLogConsistencyProofObj == nil {
+		r.keyLogConsistencyProofObj = graphql.NewObject(graphql.ObjectConfig{
+			Name: "KeyLogConsistencyProof",
+			Fields: graphql.Fields{
+				"firstSize":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"secondSize": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"hashes":     &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String)))},
+			},
+		})
+	}
+	return r.keyLogConsistencyProofObj
+}
+
+func (r *Resolver) keyLogQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"keyLogPublicKey": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "The base64 encoded Ed25519 public key that signs tree heads of the key transparency log.",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				return base64.StdEncoding.EncodeToString(r.logKey.Public().(ed25519.PublicKey)), nil
+			},
+		},
+		"keyLogTreeHead": &graphql.Field{
+			Type:        graphql.NewNonNull(r.keyLogTreeHeadType()),
+			Description: "Signs and returns the current head of the key transparency log.",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				current, err := r.Client.KeyLogHead.Query().Only(p.Context)
+				if err != nil {
+					return nil, err
+				}
+				head := transparency.SignTreeHead(r.logKey, uint64(current.TreeSize), current.RootHash, time.Now())
+				return &keyLogTreeHead{
+					TreeSize:  int(head.Size),
+					RootHash:  base64.StdEncoding.EncodeToString(head.RootHash),
+					Timestamp: head.Timestamp,
+					Signature: base64.StdEncoding.EncodeToString(head.Signature),
+				}, nil
+			},
+		},
+		"keyLogEntries": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.keyLogEntryType()))),
+			Description: "Lists every identity key the log records for a user, so users can spot keys they did not publish.",
+			Args: graphql.FieldConfigArgument{
+				"userId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				userID, err := decodeID(p.Args["userId"])
+				if err != nil {
+					return nil, err
+				}
+				return r.Client.KeyLogEntry.Query().
+					Where(keylogentry.UserID(userID)).
+					Order(ent.Asc(keylogentry.FieldLeafIndex)).
+					All(p.Context)
+			},
+		},
+		"keyLogInclusionProof": &graphql.Field{
+			Type:        graphql.NewNonNull(r.keyLogInclusionProofType()),
+			Description: "Proves that a device's identity key is in the log. Without treeSize the proof is for the current tree.",
+			Args: graphql.FieldConfigArgument{
+				"userId":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				"deviceId":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"identityKey": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"treeSize":    &graphql.ArgumentConfig{Type: graphql.Int},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				userID, err := decodeID(p.Args["userId"])
+				if err != nil {
+					return nil, err
+				}
+				size := -1
+				if raw, ok := p.Args["treeSize"].(int); ok {
+					if raw < 1 {
+						return nil, errors.New("treeSize must be positive")
+					}
+					size = raw
+				}
+				return r.keyLogInclusionProof(p.Context, userID, p.Args["deviceId"].(int), p.Args["identityKey"].(string), size)
+			},
+		},
+		"keyLogConsistencyProof": &graphql.Field{
+			Type:        graphql.NewNonNull(r.keyLogConsistencyProofType()),
+			Description: "Proves that the log at firstSize is a prefix of the log at secondSize.",
+			Args: graphql.FieldConfigArgument{
+				"firstSize":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"secondSize": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if _, err := auth.UserIDFromContext(p.Context); err != nil {
+					return nil, ErrUnauthorized
+				}
+				first, second := p.Args["firstSize"].(int), p.Args["secondSize"].(int)
+				if first < 0 || first > second {
+					return nil, errors.New("firstSize must be between 0 and secondSize")
+				}
+				tree, err := r.keyLogTree(p.Context, second)
+				if err != nil {
+					return nil, err
+				}
+				hashes, err := tree.ConsistencyProof(uint64(first))
+				if err != nil {
+					return nil, err
+				}
+				return &keyLogConsistencyProof{FirstSize: first, SecondSize: second, Hashes: encodeHashes(hashes)}, nil
+			},
+		},
+	}
+}
+
+// keyLogNodes reads complete subtrees of the key transparency log: leaves
+// from the entries and larger subtrees from the stored nodes.
+type keyLogNodes struct {
+	ctx    context.Context
+	client *ent.Client
+}
+
+func (s keyLogNodes) Node(level uint, index uint64) ([]byte, error) {
+	if level == 0 {
+		entry, err := s.client.KeyLogEntry.Query().Where(keylogentry.LeafIndex(int(index))).Only(s.ctx)
+		if err != nil {
+			return nil, err
+		}
+		return entry.LeafHash, nil
+	}
+	node, err := s.client.KeyLogNode.Query().
+		Where(keylognode.Level(int(level)), keylognode.Position(int(index))).
+		Only(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return node.Hash, nil
+}
+
+// keyLogTree returns the log as it was at size, or the current log when size
+// is negative.
+func (r *Resolver) keyLogTree(ctx context.Context, size int) (transparency.Tree, error) {
+	head, err := r.Client.KeyLogHead.Query().Only(ctx)
+	if err != nil {
+		return transparency.Tree{}, err
+	}
+	if size < 0 {
+		size = head.TreeSize
+	}
+	if size > head.TreeSize {
+		return transparency.Tree{}, fmt.Errorf("the log has fewer than %d entries", size)
+	}
+	return transparency.Tree{Nodes: keyLogNodes{ctx: ctx, client: r.Client}, Size: uint64(size)}, nil
+}
+
+// keyLogInclusionProof finds the latest publication of the key by the device
+// within the first size entries and proves it is part of that tree.
+func (r *Resolver) keyLogInclusionProof(ctx context.Context, userID, deviceID int, identityKey string, size int) (*keyLogInclusionProof, error) {
+	tree, err := r.keyLogTree(ctx, size)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := r.Client.KeyLogEntry.Query().
+		Where(
+			keylogentry.UserID(userID),
+			keylogentry.DeviceID(deviceID),
+			keylogentry.IdentityKey(identityKey),
+			keylogentry.LeafIndexLT(int(tree.Size)),
+		).
+		Order(ent.Desc(keylogentry.FieldLeafIndex)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.New("identity key is not in the log")
+	}
+	if err != nil {
+		return nil, err
+	}
+	hashes, err := tree.InclusionProof(uint64(entry.LeafIndex))
+	if err != nil {
+		return nil, err
+	}
+	return &keyLogInclusionProof{Entry: entry, TreeSize: int(tree.Size), Hashes: encodeHashes(hashes)}, nil
+}
+
+// appendKeyLogTx adds an identity key publication to the end of the log. The
+// head is updated before anything else is read, so the entry's index is
+// allocated under the transaction's write lock and concurrent publications
+// cannot claim the same one.
+func appendKeyLogTx(ctx context.Context, tx *ent.Tx, entry transparency.KeyEntry) error {
+	if err := tx.KeyLogHead.Update().AddTreeSize(1).Exec(ctx); err != nil {
+		return err
+	}
+	head, err := tx.KeyLogHead.Query().Only(ctx)
+	if err != nil {
+		return err
+	}
+	index := head.TreeSize - 1
+	entry.Timestamp = entry.Timestamp.Truncate(time.Millisecond)
+	leaf := entry.LeafHash()
+	err = tx.KeyLogEntry.Create().
+		SetLeafIndex(index).
+		SetUserID(entry.UserID).
+		SetDeviceID(entry.DeviceID).
+		SetIdentityKey(entry.IdentityKey).
+		SetPublishedAt(entry.Timestamp).
+		SetLeafHash(leaf).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	tree := transparency.Tree{Nodes: keyLogNodes{ctx: ctx, client: tx.Client()}, Size: uint64(index)}
+	nodes, root, err := tree.Append(leaf)
+	if err != nil {
+		return err
+	}
+	if err := storeKeyLogNodesTx(ctx, tx, nodes); err != nil {
+		return err
+	}
+	return tx.KeyLogHead.UpdateOne(head).SetRootHash(root).Exec(ctx)
+}
+
+// storeKeyLogNodesTx stores the subtrees above the leaves; the leaves are
+// kept by their entries.
+func storeKeyLogNodesTx(ctx context.Context, tx *ent.Tx, nodes []transparency.Node) error {
+	builders := make([]*ent.KeyLogNodeCreate, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Level == 0 {
+			continue
+		}
+		builders = append(builders, tx.KeyLogNode.Create().
+			SetLevel(int(node.Level)).
+			SetPosition(int(node.Index)).
+			SetHash(node.Hash))
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+	return tx.KeyLogNode.CreateBulk(builders...).Exec(ctx)
+}
+
+// BackfillKeyLog creates the head of the key transparency log, storing the
+// subtrees of entries appended before subtrees and heads were kept. It is run
+// at startup, before any key is published.
+func BackfillKeyLog(ctx context.Context, client *ent.Client) (err error) {
+	exists, err := client.KeyLogHead.Query().Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer rollbackOnError(tx, &err)
+
+	tree := transparency.Tree{Nodes: keyLogNodes{ctx: ctx, client: tx.Client()}}
+	root, err := tree.RootHash()
+	if err != nil {
+		return err
+	}
+	for {
+		var entries []*ent.KeyLogEntry
+		entries, err = tx.KeyLogEntry.Query().
+			Where(keylogentry.LeafIndexGTE(int(tree.Size))).
+			Order(ent.Asc(keylogentry.FieldLeafIndex)).
+			Limit(keyLogBackfillBatch).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			break
+		}
+		for _, entry := range entries {
+			if entry.LeafIndex != int(tree.Size) {
+				err = fmt.Errorf("key log entry %d is missing", tree.Size)
+				return err
+			}
+			var nodes []transparency.Node
+			if nodes, root, err = tree.Append(entry.LeafHash); err != nil {
+				return err
+			}
+			if err = storeKeyLogNodesTx(ctx, tx, nodes); err != nil {
+				return err
+			}
+			tree.Size++
+		}
+	}
+	if err = tx.KeyLogHead.Create().SetTreeSize(int(tree.Size)).SetRootHash(root).Exec(ctx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func encodeHashes(hashes [][]byte) []string {
+	encoded := make([]string, len(hashes))
+	for i, h := range hashes {
+		encoded[i] = base64.StdEncoding.EncodeToString(h)
+	}
+	return encoded
+}
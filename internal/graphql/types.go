@@ -17,6 +17,7 @@ import (
 	"github.com/eleven-am/enclave/ent/room"
 	"github.com/eleven-am/enclave/ent/roommembership"
 	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/encryption"
 )
 
 func (r *Resolver) userType() *graphql.Object {
@@ -25,14 +26,20 @@ func (r *Resolver) userType() *graphql.Object {
 			Name: "User",
 			Fields: graphql.FieldsThunk(func() graphql.Fields {
 				return graphql.Fields{
-					"id":          &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
-					"username":    &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("Username")},
-					"displayName": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("DisplayName")},
-					"email":       &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("Email")},
-					"avatarUrl":   &graphql.Field{Type: graphql.String, Resolve: resolveStringPointerField("AvatarURL")},
-					"createdAt":   &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
-					"updatedAt":   &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("UpdatedAt")},
-					"lastSeenAt":  &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("LastSeenAt")},
+					"id":                  &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+					"username":            &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("Username")},
+					"displayName":         &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("DisplayName")},
+					"email":               &graphql.Field{Type: graphql.String, Description: "Only visible to the user and to admins.", Resolve: resolveEmail},
+					"avatarUrl":           &graphql.Field{Type: graphql.String, Resolve: resolveStringPointerField("AvatarURL")},
+					"isBot":               &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Resolve: resolveBoolField("IsBot")},
+					"role":                &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveEnumField()},
+					"suspendedAt":         &graphql.Field{Type: graphql.DateTime, Resolve: resolveOptionalTimeField("SuspendedAt")},
+					"verifiedAt":          &graphql.Field{Type: graphql.DateTime, Description: "When the email address was verified. Only visible to the user and admins.", Resolve: resolveVerifiedAt},
+					"discoverability":     &graphql.Field{Type: graphql.String, Description: "Only visible to the user.", Resolve: resolveDiscoverability},
+					"deletionScheduledAt": &graphql.Field{Type: graphql.DateTime, Description: "When the account will be purged. Only visible to the user.", Resolve: resolveDeletionScheduledAt},
+					"createdAt":           &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+					"updatedAt":           &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("UpdatedAt")},
+					"lastSeenAt":          &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("LastSeenAt")},
 					"memberships": &graphql.Field{
 						Type: graphql.NewList(r.roomMembershipType()),
 						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
@@ -71,8 +78,33 @@ func (r *Resolver) roomType() *graphql.Object {
 					"description": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("Description")},
 					"isPrivate":   &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Resolve: resolveBoolField("IsPrivate")},
 					"isDirect":    &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Resolve: resolveBoolField("IsDirect")},
-					"createdAt":   &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
-					"updatedAt":   &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("UpdatedAt")},
+					"mlsGroupId":  &graphql.Field{Type: graphql.String, Description: "Set once the room is an MLS group.", Resolve: resolveStringPointerField("MlsGroupID")},
+					"mlsEpoch":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: resolveInt64Field("MlsEpoch")},
+					"mlsPendingRemovals": &graphql.Field{
+						Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
+						Description: "Users the server removed from the room whom the group's next commit must remove.",
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							if ids := p.Source.(*ent.Room).MlsPendingRemovals; ids != nil {
+								return ids, nil
+							}
+							return []int{}, nil
+						},
+					},
+					"senderKeysRotatedAt": &graphql.Field{Type: graphql.DateTime, Description: "When a member last left and sender keys had to be replaced.", Resolve: resolveOptionalTimeField("SenderKeysRotatedAt")},
+					"sealedSender":        &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Description: "Whether members may send messages without revealing who sent them; see roomDeliveryToken.", Resolve: resolveBoolField("SealedSender")},
+					"messageTtl":          &graphql.Field{Type: graphql.Int, Description: "Seconds new messages are kept before they disappear; null when disappearing messages are off.", Resolve: resolveIntPointerField("MessageTTL")},
+					"encryptionPolicy": &graphql.Field{
+						Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(r.encryptionRequirementType()))),
+						Description: "The schemes messages and notifications in the room may use. Empty when every end-to-end scheme is allowed.",
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							if policy := p.Source.(*ent.Room).EncryptionPolicy; policy != nil {
+								return policy, nil
+							}
+							return encryption.Policy{}, nil
+						},
+					},
+					"createdAt": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+					"updatedAt": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("UpdatedAt")},
 					"owner": &graphql.Field{
 						Type: r.userType(),
 						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
@@ -135,21 +167,56 @@ func (r *Resolver) messageType() *graphql.Object {
 			Name: "Message",
 			Fields: graphql.FieldsThunk(func() graphql.Fields {
 				return graphql.Fields{
-					"id":               &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
-					"cipherText":       &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("CipherText")},
-					"contentType":      &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("ContentType")},
-					"encryptionScheme": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("EncryptionScheme")},
-					"edited":           &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Resolve: resolveBoolField("Edited")},
-					"createdAt":        &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
-					"updatedAt":        &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("UpdatedAt")},
+					"id": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+					"cipherText": &graphql.Field{
+						Type:        graphql.String,
+						Description: "Null for messages sent as per-device envelopes.",
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							if text := p.Source.(*ent.Message).CipherText; text != "" {
+								return text, nil
+							}
+							return nil, nil
+						},
+					},
+					"senderDeviceId": &graphql.Field{
+						Type:        graphql.Int,
+						Description: "The sending device, when the sender named it.",
+						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+							if id := p.Source.(*ent.Message).SenderDeviceID; id != nil {
+								return *id, nil
+							}
+							return nil, nil
+						},
+					},
+					"envelope": &graphql.Field{
+						Type:        r.messageEnvelopeType(),
+						Description: "The envelope addressed to one of the caller's devices.",
+						Args: graphql.FieldConfigArgument{
+							"deviceId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+						},
+						Resolve: r.resolveMessageEnvelope,
+					},
+					"contentType":       &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("ContentType")},
+					"encryptionScheme":  &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("EncryptionScheme")},
+					"encryptionVersion": &graphql.Field{Type: graphql.Int, Description: "Null for payloads stored before versions were recorded.", Resolve: resolveIntPointerField("EncryptionVersion")},
+					"edited":            &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Resolve: resolveBoolField("Edited")},
+					"seq":               &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "The message's position in its room, counting from 1. Gaps only follow deleted messages.", Resolve: resolveInt64Field("Seq")},
+					"expiresAt":         &graphql.Field{Type: graphql.DateTime, Description: "When the message disappears.", Resolve: resolveOptionalTimeField("ExpiresAt")},
+					"createdAt":         &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+					"updatedAt":         &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("UpdatedAt")},
 					"sender": &graphql.Field{
-						Type: r.userType(),
+						Type:        r.userType(),
+						Description: "Null for sealed sender messages.",
 						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 							msg := p.Source.(*ent.Message)
-							if msg.Edges.Sender != nil {
-								return msg.Edges.Sender, nil
+							sender, err := msg.Edges.SenderOrErr()
+							if ent.IsNotLoaded(err) {
+								sender, err = msg.QuerySender().Only(p.Context)
 							}
-							return r.Client.Message.Query().Where(message.IDEQ(msg.ID)).WithSender().Only(p.Context)
+							if ent.IsNotFound(err) {
+								return nil, nil
+							}
+							return sender, err
 						},
 					},
 					"room": &graphql.Field{
@@ -214,13 +281,14 @@ func (r *Resolver) notificationType() *graphql.Object {
 			Name: "Notification",
 			Fields: graphql.FieldsThunk(func() graphql.Fields {
 				return graphql.Fields{
-					"id":               &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
-					"kind":             &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("Kind")},
-					"cipherText":       &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("CipherText")},
-					"encryptionScheme": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("EncryptionScheme")},
-					"read":             &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Resolve: resolveBoolField("Read")},
-					"createdAt":        &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
-					"updatedAt":        &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("UpdatedAt")},
+					"id":                &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+					"kind":              &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("Kind")},
+					"cipherText":        &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("CipherText")},
+					"encryptionScheme":  &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: resolveStringField("EncryptionScheme")},
+					"encryptionVersion": &graphql.Field{Type: graphql.Int, Description: "Null for payloads stored before versions were recorded.", Resolve: resolveIntPointerField("EncryptionVersion")},
+					"read":              &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean), Resolve: resolveBoolField("Read")},
+					"createdAt":         &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("CreatedAt")},
+					"updatedAt":         &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime), Resolve: resolveTimeField("UpdatedAt")},
 					"recipient": &graphql.Field{
 						Type: r.userType(),
 						Resolve: func(p graphql.ResolveParams) (interface{}, error) {
@@ -398,6 +466,19 @@ func resolveStringPointerField(field string) graphql.FieldResolveFn {
 	}
 }
 
+func resolveIntPointerField(field string) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		value, err := getField(p.Source, field)
+		if err != nil {
+			return nil, err
+		}
+		if v, ok := value.(*int); ok && v != nil {
+			return *v, nil
+		}
+		return nil, nil
+	}
+}
+
 func resolveTimeField(field string) graphql.FieldResolveFn {
 	return func(p graphql.ResolveParams) (interface{}, error) {
 		value, err := getField(p.Source, field)
@@ -0,0 +1,36 @@
+// Package mail sends the transactional emails the server needs, such as
+// address verification and password reset links.
+package mail
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidMessage indicates a message is missing a recipient or carries
+// header values that could inject additional headers.
+var ErrInvalidMessage = errors.New("invalid mail message")
+
+// Message is a plain text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers messages.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// validate rejects messages that cannot be delivered safely.
+func (m Message) validate() error {
+	if strings.TrimSpace(m.To) == "" {
+		return ErrInvalidMessage
+	}
+	if strings.ContainsAny(m.To, "\r\n") || strings.ContainsAny(m.Subject, "\r\n") {
+		return ErrInvalidMessage
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/calllog"
+	"github.com/eleven-am/enclave/ent/callparticipant"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// FilterCallLogs limits call log queries to calls the viewer started or took
+// part in, and to calls held in rooms the viewer is a member of.
+func FilterCallLogs() privacy.CallLogQueryRuleFunc {
+	return func(ctx context.Context, q *ent.CallLogQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(visibleCalls(uid))
+		return privacy.Allow
+	}
+}
+
+// FilterCallParticipants limits participant queries to calls the viewer can see.
+func FilterCallParticipants() privacy.CallParticipantQueryRuleFunc {
+	return func(ctx context.Context, q *ent.CallParticipantQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(callparticipant.HasCallWith(visibleCalls(uid)))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeCallLogMutation lets users start calls as themselves in rooms they
+// belong to. Only the initiator or a room admin may update or delete a call.
+func AuthorizeCallLogMutation() privacy.CallLogMutationRuleFunc {
+	return func(ctx context.Context, m *ent.CallLogMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		client := m.Client()
+		if m.Op().Is(ent.OpCreate) {
+			if initiator, ok := m.InitiatorID(); !ok || initiator != uid {
+				return privacy.Denyf("calls must be started by the viewer")
+			}
+			if roomID, ok := m.RoomID(); ok {
+				if err := requireRoomMember(ctx, client, roomID, uid); err != nil {
+					return err
+				}
+			}
+			return privacy.Allow
+		}
+		if _, ok := m.InitiatorID(); ok || m.InitiatorCleared() {
+			return privacy.Denyf("call initiator cannot be changed")
+		}
+		if _, ok := m.RoomID(); ok || m.RoomCleared() {
+			return privacy.Denyf("call room cannot be changed")
+		}
+		m.Where(visibleCalls(uid))
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return err
+		}
+		calls, err := client.CallLog.Query().
+			Where(calllog.IDIn(ids...)).
+			WithInitiator().
+			WithRoom().
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, call := range calls {
+			if err := requireCallManager(ctx, client, call, uid); err != nil {
+				return err
+			}
+		}
+		return privacy.Allow
+	}
+}
+
+// AuthorizeCallParticipantMutation lets the initiator of a call or a room admin
+// manage its participants.
+func AuthorizeCallParticipantMutation() privacy.CallParticipantMutationRuleFunc {
+	return func(ctx context.Context, m *ent.CallParticipantMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		client := m.Client()
+		if m.Op().Is(ent.OpCreate) {
+			callID, _ := m.CallID()
+			call, err := client.CallLog.Query().
+				Where(calllog.ID(callID)).
+				WithInitiator().
+				WithRoom().
+				Only(ctx)
+			if ent.IsNotFound(err) {
+				return privacy.Denyf("call %d is not visible", callID)
+			}
+			if err != nil {
+				return err
+			}
+			if err := requireCallManager(ctx, client, call, uid); err != nil {
+				return err
+			}
+			return privacy.Allow
+		}
+		if _, ok := m.CallID(); ok || m.CallCleared() {
+			return privacy.Denyf("participant call cannot be changed")
+		}
+		m.Where(callparticipant.HasCallWith(visibleCalls(uid)))
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return err
+		}
+		calls, err := client.CallLog.Query().
+			Where(calllog.HasParticipantsWith(callparticipant.IDIn(ids...))).
+			WithInitiator().
+			WithRoom().
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, call := range calls {
+			if err := requireCallManager(ctx, client, call, uid); err != nil {
+				return err
+			}
+		}
+		return privacy.Allow
+	}
+}
+
+// requireCallManager allows the initiator of the call and admins of its room.
+// The call must be loaded with its initiator and room edges.
+func requireCallManager(ctx context.Context, client *ent.Client, call *ent.CallLog, uid int) error {
+	if call.Edges.Initiator != nil && call.Edges.Initiator.ID == uid {
+		return nil
+	}
+	if call.Edges.Room == nil {
+		return privacy.Denyf("only the initiator may manage call %d", call.ID)
+	}
+	return requireRoomAdmin(ctx, client, call.Edges.Room.ID, uid)
+}
+
+func visibleCalls(uid int) predicate.CallLog {
+	return calllog.Or(
+		calllog.HasInitiatorWith(user.ID(uid)),
+		calllog.HasParticipantsWith(callparticipant.HasParticipantWith(user.ID(uid))),
+		calllog.HasRoomWith(memberRooms(uid)),
+	)
+}
@@ -0,0 +1,43 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/contact"
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// FilterContacts limits contact queries to the viewer's own address book.
+func FilterContacts() privacy.ContactQueryRuleFunc {
+	return func(ctx context.Context, q *ent.ContactQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(contact.HasOwnerWith(user.ID(uid)))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeContactMutation only lets users manage their own contacts.
+func AuthorizeContactMutation() privacy.ContactMutationRuleFunc {
+	return func(ctx context.Context, m *ent.ContactMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		if m.Op().Is(ent.OpCreate) {
+			if owner, ok := m.OwnerID(); !ok || owner != uid {
+				return privacy.Denyf("contacts must be owned by the viewer")
+			}
+			return privacy.Allow
+		}
+		if _, ok := m.OwnerID(); ok || m.OwnerCleared() {
+			return privacy.Denyf("contact owner cannot be changed")
+		}
+		m.Where(contact.HasOwnerWith(user.ID(uid)))
+		return privacy.Allow
+	}
+}
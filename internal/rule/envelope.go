@@ -0,0 +1,55 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/messageenvelope"
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// FilterMessageEnvelopes limits envelope queries to envelopes addressed to the
+// viewer's own devices.
+func FilterMessageEnvelopes() privacy.MessageEnvelopeQueryRuleFunc {
+	return func(ctx context.Context, q *ent.MessageEnvelopeQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(messageenvelope.HasDeviceWith(device.HasUserWith(user.ID(uid))))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeMessageEnvelopeMutation lets senders attach envelopes to their own
+// messages and replace them later. Envelopes are never changed in place.
+func AuthorizeMessageEnvelopeMutation() privacy.MessageEnvelopeMutationRuleFunc {
+	return func(ctx context.Context, m *ent.MessageEnvelopeMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			messageID, _ := m.MessageID()
+			sent, err := m.Client().Message.Query().
+				Where(message.ID(messageID), message.HasSenderWith(user.ID(uid))).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if !sent {
+				return privacy.Denyf("envelopes must belong to the viewer's messages")
+			}
+			return privacy.Allow
+		case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+			m.Where(messageenvelope.HasMessageWith(message.HasSenderWith(user.ID(uid))))
+			return privacy.Allow
+		default:
+			return privacy.Denyf("message envelopes cannot be changed")
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/keybackupkey"
+	"github.com/eleven-am/enclave/ent/keybackupversion"
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// FilterKeyBackupVersions limits key backup version queries to the viewer's
+// own backups.
+func FilterKeyBackupVersions() privacy.KeyBackupVersionQueryRuleFunc {
+	return func(ctx context.Context, q *ent.KeyBackupVersionQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(keybackupversion.HasOwnerWith(user.ID(uid)))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeKeyBackupVersionMutation lets users create key backups for
+// themselves and change or delete only their own.
+func AuthorizeKeyBackupVersionMutation() privacy.KeyBackupVersionMutationRuleFunc {
+	return func(ctx context.Context, m *ent.KeyBackupVersionMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		if m.Op().Is(ent.OpCreate) {
+			if owner, ok := m.OwnerID(); !ok || owner != uid {
+				return privacy.Denyf("key backups must be created for the viewer")
+			}
+			return privacy.Allow
+		}
+		m.Where(keybackupversion.HasOwnerWith(user.ID(uid)))
+		return privacy.Allow
+	}
+}
+
+// FilterKeyBackupKeys limits backed up key queries to the viewer's backups.
+func FilterKeyBackupKeys() privacy.KeyBackupKeyQueryRuleFunc {
+	return func(ctx context.Context, q *ent.KeyBackupKeyQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(keybackupkey.HasVersionWith(keybackupversion.HasOwnerWith(user.ID(uid))))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeKeyBackupKeyMutation lets users store, replace and delete keys
+// only in their own backups.
+func AuthorizeKeyBackupKeyMutation() privacy.KeyBackupKeyMutationRuleFunc {
+	return func(ctx context.Context, m *ent.KeyBackupKeyMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		if m.Op().Is(ent.OpCreate) {
+			versionID, _ := m.VersionID()
+			owned, err := m.Client().KeyBackupVersion.Query().
+				Where(keybackupversion.ID(versionID), keybackupversion.HasOwnerWith(user.ID(uid))).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if !owned {
+				return privacy.Denyf("keys must be stored in the viewer's own backup")
+			}
+			return privacy.Allow
+		}
+		m.Where(keybackupkey.HasVersionWith(keybackupversion.HasOwnerWith(user.ID(uid))))
+		return privacy.Allow
+	}
+}
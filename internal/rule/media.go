@@ -0,0 +1,74 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/media"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// FilterMedia limits media queries to the viewer's uploads and to attachments of
+// messages in rooms the viewer is a member of.
+func FilterMedia() privacy.MediaQueryRuleFunc {
+	return func(ctx context.Context, q *ent.MediaQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(visibleMedia(uid))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeMediaMutation lets users upload media as themselves and attach it to
+// their own messages, or to any message in a room they administer. Only the
+// uploader may change or delete media afterwards.
+func AuthorizeMediaMutation() privacy.MediaMutationRuleFunc {
+	return func(ctx context.Context, m *ent.MediaMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		client := m.Client()
+		if m.Op().Is(ent.OpCreate) {
+			if uploader, ok := m.UploaderID(); !ok || uploader != uid {
+				return privacy.Denyf("media must be uploaded as the viewer")
+			}
+		} else {
+			if _, ok := m.UploaderID(); ok || m.UploaderCleared() {
+				return privacy.Denyf("media uploader cannot be changed")
+			}
+			m.Where(media.HasUploaderWith(user.ID(uid)))
+		}
+		if messageID, ok := m.MessageID(); ok {
+			msg, err := client.Message.Query().
+				Where(message.ID(messageID)).
+				WithSender().
+				WithRoom().
+				Only(ctx)
+			if err != nil {
+				return err
+			}
+			if msg.Edges.Sender == nil || msg.Edges.Sender.ID != uid {
+				if msg.Edges.Room == nil {
+					return privacy.Denyf("message %d has no room", msg.ID)
+				}
+				if err := requireRoomAdmin(ctx, client, msg.Edges.Room.ID, uid); err != nil {
+					return err
+				}
+			}
+		}
+		return privacy.Allow
+	}
+}
+
+func visibleMedia(uid int) predicate.Media {
+	return media.Or(
+		media.HasUploaderWith(user.ID(uid)),
+		media.HasMessageWith(visibleMessages(uid)),
+	)
+}
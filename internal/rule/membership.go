@@ -0,0 +1,123 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// FilterRoomMemberships limits membership queries to rooms the viewer is a member of.
+func FilterRoomMemberships() privacy.RoomMembershipQueryRuleFunc {
+	return func(ctx context.Context, q *ent.RoomMembershipQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(roommembership.HasRoomWith(memberRooms(uid)))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeRoomMembershipMutation lets the owner of a room seed its memberships
+// and lets room admins add, change and remove members. The owner role belongs
+// to the room's owner alone: it cannot be granted to anyone else, and the
+// owner's membership can neither lose it nor be removed. Only the owner may
+// change their own membership.
+func AuthorizeRoomMembershipMutation() privacy.RoomMembershipMutationRuleFunc {
+	return func(ctx context.Context, m *ent.RoomMembershipMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		client := m.Client()
+		role, setsRole := m.Role()
+		if m.Op().Is(ent.OpCreate) {
+			roomID, _ := m.RoomID()
+			memberID, _ := m.UserID()
+			if setsRole && role == roommembership.RoleOwner {
+				ownsRoom, err := isRoomOwner(ctx, client, roomID, memberID)
+				if err != nil {
+					return err
+				}
+				if !ownsRoom {
+					return privacy.Denyf("the owner role belongs to the room's owner")
+				}
+			}
+			owned, err := isRoomOwner(ctx, client, roomID, uid)
+			if err != nil {
+				return err
+			}
+			if owned {
+				return privacy.Allow
+			}
+			if err := requireRoomAdmin(ctx, client, roomID, uid); err != nil {
+				return err
+			}
+			return privacy.Allow
+		}
+		if _, ok := m.RoomID(); ok || m.RoomCleared() {
+			return privacy.Denyf("membership room cannot be changed")
+		}
+		if _, ok := m.UserID(); ok || m.UserCleared() {
+			return privacy.Denyf("membership user cannot be changed")
+		}
+		m.Where(roommembership.HasRoomWith(memberRooms(uid)))
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return err
+		}
+		roomIDs, err := client.Room.Query().
+			Where(room.HasMembershipsWith(roommembership.IDIn(ids...))).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		for _, roomID := range roomIDs {
+			targeted := client.RoomMembership.Query().
+				Where(roommembership.IDIn(ids...), roommembership.HasRoomWith(room.ID(roomID)))
+			targetsOwner, err := targeted.Clone().
+				Where(roommembership.HasUserWith(user.HasOwnedRoomsWith(room.ID(roomID)))).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if setsRole && role == roommembership.RoleOwner {
+				targetsOthers, err := targeted.Clone().
+					Where(roommembership.Not(roommembership.HasUserWith(user.HasOwnedRoomsWith(room.ID(roomID))))).
+					Exist(ctx)
+				if err != nil {
+					return err
+				}
+				if targetsOthers {
+					return privacy.Denyf("the owner role belongs to the room's owner")
+				}
+			}
+			if targetsOwner {
+				if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					return privacy.Denyf("the room owner's membership cannot be removed")
+				}
+				if setsRole && role != roommembership.RoleOwner {
+					return privacy.Denyf("the room owner's role cannot be changed")
+				}
+			}
+			owned, err := isRoomOwner(ctx, client, roomID, uid)
+			if err != nil {
+				return err
+			}
+			if owned {
+				continue
+			}
+			if err := requireRoomAdmin(ctx, client, roomID, uid); err != nil {
+				return err
+			}
+			if targetsOwner {
+				return privacy.Denyf("only the room owner can change the owner's membership")
+			}
+		}
+		return privacy.Allow
+	}
+}
@@ -0,0 +1,83 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/message"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/privacy"
+)
+
+// FilterMessages limits message queries to rooms the viewer is a member of.
+func FilterMessages() privacy.MessageQueryRuleFunc {
+	return func(ctx context.Context, q *ent.MessageQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(visibleMessages(uid))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeMessageMutation lets members with posting rights send messages as
+// themselves, and lets the sender or a room admin edit or delete a message.
+func AuthorizeMessageMutation() privacy.MessageMutationRuleFunc {
+	return func(ctx context.Context, m *ent.MessageMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		client := m.Client()
+		if m.Op().Is(ent.OpCreate) {
+			if sender, ok := m.SenderID(); !ok || sender != uid {
+				return privacy.Denyf("messages must be sent as the viewer")
+			}
+			roomID, _ := m.RoomID()
+			membership, err := roomMembership(ctx, client, roomID, uid)
+			if err != nil {
+				return err
+			}
+			if membership == nil || !membership.CanPost {
+				return privacy.Denyf("posting to room %d is not allowed", roomID)
+			}
+			return privacy.Allow
+		}
+		if _, ok := m.SenderID(); ok || m.SenderCleared() {
+			return privacy.Denyf("message sender cannot be changed")
+		}
+		if _, ok := m.RoomID(); ok || m.RoomCleared() {
+			return privacy.Denyf("message room cannot be changed")
+		}
+		m.Where(visibleMessages(uid))
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return err
+		}
+		messages, err := client.Message.Query().
+			Where(message.IDIn(ids...)).
+			WithSender().
+			WithRoom().
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, msg := range messages {
+			if msg.Edges.Sender != nil && msg.Edges.Sender.ID == uid {
+				continue
+			}
+			if msg.Edges.Room == nil {
+				return privacy.Denyf("message %d has no room", msg.ID)
+			}
+			if err := requireRoomAdmin(ctx, client, msg.Edges.Room.ID, uid); err != nil {
+				return err
+			}
+		}
+		return privacy.Allow
+	}
+}
+
+func visibleMessages(uid int) predicate.Message {
+	return message.HasRoomWith(memberRooms(uid))
+}
@@ -0,0 +1,85 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/mlscommit"
+	"github.com/eleven-am/enclave/ent/mlswelcome"
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// FilterMlsCommits limits commit queries to rooms the viewer is a member of.
+func FilterMlsCommits() privacy.MlsCommitQueryRuleFunc {
+	return func(ctx context.Context, q *ent.MlsCommitQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(mlscommit.HasRoomWith(memberRooms(uid)))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeMlsCommitMutation lets members publish commits to their rooms as
+// themselves. Accepted commits are never changed.
+func AuthorizeMlsCommitMutation() privacy.MlsCommitMutationRuleFunc {
+	return func(ctx context.Context, m *ent.MlsCommitMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		if !m.Op().Is(ent.OpCreate) {
+			return privacy.Denyf("mls commits cannot be changed")
+		}
+		if sender, ok := m.SenderID(); !ok || sender != uid {
+			return privacy.Denyf("mls commits must be sent as the viewer")
+		}
+		roomID, _ := m.RoomID()
+		if err := requireRoomMember(ctx, m.Client(), roomID, uid); err != nil {
+			return err
+		}
+		return privacy.Allow
+	}
+}
+
+// FilterMlsWelcomes limits welcome queries to welcomes for the viewer's devices.
+func FilterMlsWelcomes() privacy.MlsWelcomeQueryRuleFunc {
+	return func(ctx context.Context, q *ent.MlsWelcomeQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(mlswelcome.HasDeviceWith(device.HasUserWith(user.ID(uid))))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeMlsWelcomeMutation lets members send welcomes as themselves and
+// lets recipients delete the welcomes addressed to their devices.
+func AuthorizeMlsWelcomeMutation() privacy.MlsWelcomeMutationRuleFunc {
+	return func(ctx context.Context, m *ent.MlsWelcomeMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			if sender, ok := m.SenderID(); !ok || sender != uid {
+				return privacy.Denyf("mls welcomes must be sent as the viewer")
+			}
+			roomID, _ := m.RoomID()
+			if err := requireRoomMember(ctx, m.Client(), roomID, uid); err != nil {
+				return err
+			}
+			return privacy.Allow
+		case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+			m.Where(mlswelcome.HasDeviceWith(device.HasUserWith(user.ID(uid))))
+			return privacy.Allow
+		default:
+			return privacy.Denyf("mls welcomes cannot be changed")
+		}
+	}
+}
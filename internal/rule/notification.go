@@ -0,0 +1,60 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/notification"
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// FilterNotifications limits notification queries to the viewer's own.
+func FilterNotifications() privacy.NotificationQueryRuleFunc {
+	return func(ctx context.Context, q *ent.NotificationQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(notification.HasRecipientWith(user.ID(uid)))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeNotificationMutation lets users notify themselves, and lets room
+// admins notify other members of their room. Only the recipient may change or
+// delete a notification.
+func AuthorizeNotificationMutation() privacy.NotificationMutationRuleFunc {
+	return func(ctx context.Context, m *ent.NotificationMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		if !m.Op().Is(ent.OpCreate) {
+			if _, ok := m.RecipientID(); ok || m.RecipientCleared() {
+				return privacy.Denyf("notification recipient cannot be changed")
+			}
+			m.Where(notification.HasRecipientWith(user.ID(uid)))
+			return privacy.Allow
+		}
+		client := m.Client()
+		recipientID, _ := m.RecipientID()
+		roomID, hasRoom := m.RoomID()
+		if !hasRoom {
+			if recipientID != uid {
+				return privacy.Denyf("notifications for other users must belong to a room")
+			}
+			return privacy.Allow
+		}
+		if err := requireRoomMember(ctx, client, roomID, recipientID); err != nil {
+			return err
+		}
+		if recipientID == uid {
+			return privacy.Allow
+		}
+		if err := requireRoomAdmin(ctx, client, roomID, uid); err != nil {
+			return err
+		}
+		return privacy.Allow
+	}
+}
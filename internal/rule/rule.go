@@ -0,0 +1,91 @@
+// Package rule holds the ent privacy rules that authorize access to room
+// content. The viewer is the principal whose claims are on the context; system
+// code without a viewer must opt in explicitly with SystemContext.
+package rule
+
+import (
+	"context"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/predicate"
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/ent/room"
+	"github.com/eleven-am/enclave/ent/roommembership"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+)
+
+// SystemContext returns a context that bypasses every privacy policy. Use it for
+// background jobs and for reads performed after a mutation was already authorized.
+func SystemContext(ctx context.Context) context.Context {
+	return privacy.DecisionContext(ctx, privacy.Allow)
+}
+
+// DenyIfNoViewer rejects queries and mutations made without an authenticated viewer.
+func DenyIfNoViewer() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if _, ok := viewerID(ctx); !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		return privacy.Skip
+	})
+}
+
+func viewerID(ctx context.Context) (int, bool) {
+	uid, err := auth.UserIDFromContext(ctx)
+	if err != nil || uid == 0 {
+		return 0, false
+	}
+	return uid, true
+}
+
+// memberRooms matches the rooms the user is a member of.
+func memberRooms(uid int) predicate.Room {
+	return room.HasMembershipsWith(roommembership.HasUserWith(user.ID(uid)))
+}
+
+// roomMembership returns the user's membership of a room, or nil if they are not a member.
+func roomMembership(ctx context.Context, client *ent.Client, roomID, uid int) (*ent.RoomMembership, error) {
+	membership, err := client.RoomMembership.Query().
+		Where(
+			roommembership.HasRoomWith(room.ID(roomID)),
+			roommembership.HasUserWith(user.ID(uid)),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return membership, err
+}
+
+// isRoomOwner reports whether the user owns the room. Ownership follows the
+// room's owner edge, never the membership role.
+func isRoomOwner(ctx context.Context, client *ent.Client, roomID, uid int) (bool, error) {
+	return client.Room.Query().
+		Where(room.ID(roomID), room.HasOwnerWith(user.ID(uid))).
+		Exist(ctx)
+}
+
+// requireRoomAdmin allows owners and admins of the room.
+func requireRoomAdmin(ctx context.Context, client *ent.Client, roomID, uid int) error {
+	membership, err := roomMembership(ctx, client, roomID, uid)
+	if err != nil {
+		return err
+	}
+	if membership == nil || membership.Role == roommembership.RoleMember {
+		return privacy.Denyf("room admin required")
+	}
+	return nil
+}
+
+// requireRoomMember allows any member of the room.
+func requireRoomMember(ctx context.Context, client *ent.Client, roomID, uid int) error {
+	membership, err := roomMembership(ctx, client, roomID, uid)
+	if err != nil {
+		return err
+	}
+	if membership == nil {
+		return privacy.Denyf("room membership required")
+	}
+	return nil
+}
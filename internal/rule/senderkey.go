@@ -0,0 +1,55 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/device"
+	"github.com/eleven-am/enclave/ent/privacy"
+	"github.com/eleven-am/enclave/ent/senderkeydistribution"
+	"github.com/eleven-am/enclave/ent/user"
+)
+
+// FilterSenderKeyDistributions limits distribution queries to those addressed
+// to the viewer's devices.
+func FilterSenderKeyDistributions() privacy.SenderKeyDistributionQueryRuleFunc {
+	return func(ctx context.Context, q *ent.SenderKeyDistributionQuery) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		q.Where(senderkeydistribution.HasDeviceWith(device.HasUserWith(user.ID(uid))))
+		return privacy.Allow
+	}
+}
+
+// AuthorizeSenderKeyDistributionMutation lets members distribute their sender
+// keys in their rooms, lets senders replace their earlier distributions and
+// lets recipients delete the distributions addressed to their devices.
+func AuthorizeSenderKeyDistributionMutation() privacy.SenderKeyDistributionMutationRuleFunc {
+	return func(ctx context.Context, m *ent.SenderKeyDistributionMutation) error {
+		uid, ok := viewerID(ctx)
+		if !ok {
+			return privacy.Denyf("viewer is missing")
+		}
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			if sender, ok := m.SenderID(); !ok || sender != uid {
+				return privacy.Denyf("sender keys must be distributed as the viewer")
+			}
+			roomID, _ := m.RoomID()
+			if err := requireRoomMember(ctx, m.Client(), roomID, uid); err != nil {
+				return err
+			}
+			return privacy.Allow
+		case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+			m.Where(senderkeydistribution.Or(
+				senderkeydistribution.HasSenderWith(user.ID(uid)),
+				senderkeydistribution.HasDeviceWith(device.HasUserWith(user.ID(uid))),
+			))
+			return privacy.Allow
+		default:
+			return privacy.Denyf("sender key distributions cannot be changed")
+		}
+	}
+}
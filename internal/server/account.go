@@ -0,0 +1,33 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/eleven-am/enclave/internal/auth"
+	gql "github.com/eleven-am/enclave/internal/graphql"
+)
+
+// accountExportHandler serves the signed-in user's data export as a JSON
+// download. API keys cannot export the account they belong to.
+func accountExportHandler(resolver *gql.Resolver) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		claims, err := auth.ClaimsFromContext(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "authentication required")
+		}
+		if claims.APIKeyID != 0 {
+			return echo.NewHTTPError(http.StatusForbidden, "api keys cannot export accounts")
+		}
+		export, err := resolver.ExportAccount(ctx, claims.UserID)
+		if err != nil {
+			return err
+		}
+		filename := fmt.Sprintf("enclave-%s-%s.json", export.Profile.Username, export.ExportedAt.Format("20060102"))
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+		return c.JSONPretty(http.StatusOK, export, "  ")
+	}
+}
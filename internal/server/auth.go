@@ -0,0 +1,382 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/functionalfoundry/graphqlws"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/labstack/echo/v4"
+
+	"github.com/eleven-am/enclave/ent"
+	"github.com/eleven-am/enclave/ent/apikey"
+	"github.com/eleven-am/enclave/ent/session"
+	"github.com/eleven-am/enclave/ent/user"
+	"github.com/eleven-am/enclave/internal/auth"
+	gql "github.com/eleven-am/enclave/internal/graphql"
+)
+
+// errSessionInactive indicates the session behind a token was revoked or has expired.
+var errSessionInactive = errors.New("session expired or revoked")
+
+// errInvalidAPIKey indicates an API key is unknown, revoked or expired.
+var errInvalidAPIKey = errors.New("invalid api key")
+
+// apiKeyTouchInterval throttles last_used_at writes for busy keys.
+const apiKeyTouchInterval = time.Minute
+
+// messageEventQueueSize is how many events a messageEvents subscription may
+// fall behind by before further events are dropped for it.
+const messageEventQueueSize = 16
+
+// authMiddleware verifies bearer tokens and stores their claims on the request context.
+// Requests without a token continue anonymously; requests with a bad token are rejected.
+func authMiddleware(tokens *auth.TokenService, client *ent.Client) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := auth.ContextWithRequestInfo(req.Context(), auth.RequestInfo{
+				IPAddress: c.RealIP(),
+				UserAgent: req.UserAgent(),
+			})
+			if req.Header.Get("Authorization") == "" {
+				c.SetRequest(req.WithContext(ctx))
+				return next(c)
+			}
+			raw, err := auth.BearerToken(req)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+			}
+			if auth.IsAPIKey(raw) {
+				claims, err := authenticateAPIKey(ctx, client, raw)
+				if err != nil {
+					if errors.Is(err, errInvalidAPIKey) {
+						return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+					}
+					return err
+				}
+				c.SetRequest(req.WithContext(auth.ContextWithClaims(ctx, claims)))
+				return next(c)
+			}
+			claims, err := tokens.VerifyAccessToken(raw)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, "invalid access token")
+			}
+			if err := checkSession(ctx, client, claims); err != nil {
+				if errors.Is(err, errSessionInactive) {
+					return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+				}
+				return err
+			}
+			c.SetRequest(req.WithContext(auth.ContextWithClaims(ctx, claims)))
+			return next(c)
+		}
+	}
+}
+
+// checkSession ensures the session an access token was issued for is still active
+// and its user is not suspended, so revoking a session or suspending the user
+// takes effect before its access tokens expire. It records the user's current
+// role on the claims.
+func checkSession(ctx context.Context, client *ent.Client, claims *auth.Claims) error {
+	usr, err := client.User.Query().
+		Where(
+			user.ID(claims.UserID),
+			user.SuspendedAtIsNil(),
+			user.HasSessionsWith(
+				session.ID(claims.SessionID),
+				session.RevokedAtIsNil(),
+				session.ExpiresAtGT(time.Now()),
+			),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return errSessionInactive
+	}
+	if err != nil {
+		return err
+	}
+	claims.Role = string(usr.Role)
+	return nil
+}
+
+// authenticateAPIKey resolves an API key to claims for its user, carrying the
+// key's scopes so resolvers can restrict what it may do.
+func authenticateAPIKey(ctx context.Context, client *ent.Client, raw string) (*auth.Claims, error) {
+	prefix, hash, err := auth.ParseAPIKey(raw)
+	if err != nil {
+		return nil, errInvalidAPIKey
+	}
+	key, err := client.ApiKey.Query().
+		Where(apikey.Prefix(prefix)).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if subtle.ConstantTimeCompare([]byte(key.KeyHash), []byte(hash)) != 1 ||
+		key.RevokedAt != nil ||
+		key.Edges.User.SuspendedAt != nil ||
+		(key.ExpiresAt != nil && now.After(*key.ExpiresAt)) {
+		return nil, errInvalidAPIKey
+	}
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > apiKeyTouchInterval {
+		if err := key.Update().SetLastUsedAt(now).Exec(ctx); err != nil {
+			log.Printf("failed recording api key use: %v", err)
+		}
+	}
+	return &auth.Claims{
+		UserID:   key.Edges.User.ID,
+		APIKeyID: key.ID,
+		Scopes:   key.Scopes,
+		Role:     string(key.Edges.User.Role),
+	}, nil
+}
+
+// sessionSubscriptionManager ties WebSocket subscriptions to the session that
+// authenticated the connection.
+type sessionSubscriptionManager struct {
+	graphqlws.SubscriptionManager
+	client *ent.Client
+	schema *graphql.Schema
+
+	// mu guards the embedded manager, which is not safe for concurrent use,
+	// and subscribers.
+	mu          sync.Mutex
+	subscribers map[subscriptionKey]*messageSubscriber
+}
+
+// subscriptionKey identifies an operation on a connection. The handler stops
+// operations by ID, so subscriptions cannot be keyed by pointer.
+type subscriptionKey struct {
+	conn graphqlws.Connection
+	id   string
+}
+
+// messageSubscriber feeds one messageEvents subscription from its own
+// goroutine, so a slow subscriber only holds up itself.
+type messageSubscriber struct {
+	events chan *gql.MessageEvent
+	done   chan struct{}
+}
+
+// AddSubscription refuses new operations on connections whose session is no longer active.
+func (m *sessionSubscriptionManager) AddSubscription(conn graphqlws.Connection, sub *graphqlws.Subscription) []error {
+	claims, ok := conn.User().(*auth.Claims)
+	if !ok {
+		return []error{errors.New("connection is not authenticated")}
+	}
+	if err := checkSession(context.Background(), m.client, claims); err != nil {
+		return []error{err}
+	}
+	m.mu.Lock()
+	errs := m.SubscriptionManager.AddSubscription(conn, sub)
+	m.mu.Unlock()
+	if len(errs) > 0 {
+		return errs
+	}
+	// The operation is parsed once added. Without an event, messageEvents only
+	// checks that the user may watch the room.
+	if sub.MatchesField("messageEvents") {
+		if result := m.execute(sub, claims, nil); len(result.Errors) > 0 {
+			m.RemoveSubscription(conn, sub)
+			return graphqlws.ErrorsFromGraphQLErrors(result.Errors)
+		}
+		m.startMessageSubscriber(conn, sub, claims)
+	}
+	return nil
+}
+
+// Subscriptions returns a copy of the registered subscriptions, which
+// message event workers may remove from concurrently.
+func (m *sessionSubscriptionManager) Subscriptions() graphqlws.Subscriptions {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	subscriptions := make(graphqlws.Subscriptions)
+	for conn, subs := range m.SubscriptionManager.Subscriptions() {
+		copied := make(graphqlws.ConnectionSubscriptions, len(subs))
+		for id, sub := range subs {
+			copied[id] = sub
+		}
+		subscriptions[conn] = copied
+	}
+	return subscriptions
+}
+
+// RemoveSubscription stops the operation's message event delivery before
+// removing it.
+func (m *sessionSubscriptionManager) RemoveSubscription(conn graphqlws.Connection, sub *graphqlws.Subscription) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopMessageSubscriber(subscriptionKey{conn: conn, id: sub.ID})
+	m.SubscriptionManager.RemoveSubscription(conn, sub)
+}
+
+// RemoveSubscriptions stops message event delivery for every operation on the
+// connection before removing them.
+func (m *sessionSubscriptionManager) RemoveSubscriptions(conn graphqlws.Connection) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key := range m.subscribers {
+		if key.conn == conn {
+			m.stopMessageSubscriber(key)
+		}
+	}
+	m.SubscriptionManager.RemoveSubscriptions(conn)
+}
+
+// startMessageSubscriber starts delivering message events to sub.
+func (m *sessionSubscriptionManager) startMessageSubscriber(conn graphqlws.Connection, sub *graphqlws.Subscription, claims *auth.Claims) {
+	s := &messageSubscriber{
+		events: make(chan *gql.MessageEvent, messageEventQueueSize),
+		done:   make(chan struct{}),
+	}
+	m.mu.Lock()
+	m.stopMessageSubscriber(subscriptionKey{conn: conn, id: sub.ID})
+	m.subscribers[subscriptionKey{conn: conn, id: sub.ID}] = s
+	m.mu.Unlock()
+	go func() {
+		for {
+			select {
+			case <-s.done:
+				return
+			case event := <-s.events:
+				if !m.deliverMessageEvent(conn, sub, claims, event) {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// stopMessageSubscriber must be called with m.mu held.
+func (m *sessionSubscriptionManager) stopMessageSubscriber(key subscriptionKey) {
+	if s, ok := m.subscribers[key]; ok {
+		delete(m.subscribers, key)
+		close(s.done)
+	}
+}
+
+// execute runs a subscription's operation as the connection's user with root
+// as the source of its root fields.
+func (m *sessionSubscriptionManager) execute(sub *graphqlws.Subscription, claims *auth.Claims, root interface{}) *graphql.Result {
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        *m.schema,
+		Root:          root,
+		AST:           sub.Document,
+		OperationName: sub.OperationName,
+		Args:          sub.Variables,
+		Context:       auth.ContextWithClaims(context.Background(), claims),
+	})
+}
+
+// disconnectSessions drops every subscription held by connections that
+// authenticated with one of the given sessions.
+func (m *sessionSubscriptionManager) disconnectSessions(sessionIDs []int) {
+	revoked := make(map[int]struct{}, len(sessionIDs))
+	for _, id := range sessionIDs {
+		revoked[id] = struct{}{}
+	}
+	for conn := range m.Subscriptions() {
+		claims, ok := conn.User().(*auth.Claims)
+		if !ok {
+			continue
+		}
+		if _, hit := revoked[claims.SessionID]; !hit {
+			continue
+		}
+		m.RemoveSubscriptions(conn)
+		conn.SendError(errSessionInactive)
+	}
+}
+
+// sendMessageEvent queues event for every messageEvents subscription without
+// waiting for any of them. A subscription whose queue is full misses the event.
+func (m *sessionSubscriptionManager) sendMessageEvent(event *gql.MessageEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, s := range m.subscribers {
+		select {
+		case s.events <- event:
+		default:
+			log.Printf("message events for connection %s, operation %s: queue full, dropping event", key.conn.ID(), key.id)
+		}
+	}
+}
+
+// deliverMessageEvent executes a messageEvents subscription against event as
+// the connection's user and sends the result if the event is for its room.
+// Errors are sent to the subscriber; only a user who may no longer read the
+// room has the subscription dropped. It reports whether the subscription is
+// still open.
+func (m *sessionSubscriptionManager) deliverMessageEvent(conn graphqlws.Connection, sub *graphqlws.Subscription, claims *auth.Claims, event *gql.MessageEvent) bool {
+	result := m.execute(sub, claims, event)
+	if len(result.Errors) > 0 {
+		sub.SendData(&graphqlws.DataMessagePayload{Errors: graphqlws.ErrorsFromGraphQLErrors(result.Errors)})
+		if deniesAccess(result.Errors) {
+			m.RemoveSubscription(conn, sub)
+			return false
+		}
+		log.Printf("message events for connection %s, operation %s: %v", conn.ID(), sub.ID, result.Errors)
+		return true
+	}
+	if data, ok := result.Data.(map[string]interface{}); ok && data["messageEvents"] != nil {
+		sub.SendData(&graphqlws.DataMessagePayload{Data: result.Data})
+	}
+	return true
+}
+
+// deniesAccess reports whether any of errs comes from an authorization check
+// rather than a failure that may pass.
+func deniesAccess(errs []gqlerrors.FormattedError) bool {
+	for _, err := range errs {
+		original := err.OriginalError()
+		// Resolver errors arrive wrapped in a located error, which does not unwrap.
+		if located, ok := original.(*gqlerrors.Error); ok {
+			original = located.OriginalError
+		}
+		if errors.Is(original, gql.ErrForbidden) || errors.Is(original, gql.ErrUnauthorized) {
+			return true
+		}
+	}
+	return false
+}
+
+func newTokenService(cfg Config) (*auth.TokenService, error) {
+	var keys *auth.KeySet
+	var err error
+	switch {
+	case cfg.KeySetPath != "":
+		keys, err = auth.LoadKeySet(cfg.KeySetPath)
+	case cfg.TokenSecret != "":
+		keys, err = auth.NewHMACKeySet("default", []byte(cfg.TokenSecret))
+	default:
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed generating token secret: %w", err)
+		}
+		log.Printf("no token keyset configured; using an ephemeral key, tokens will not survive a restart")
+		keys, err = auth.NewHMACKeySet("ephemeral", secret)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed loading token keys: %w", err)
+	}
+	return auth.NewTokenService(auth.TokenConfig{
+		Keys:       keys,
+		Issuer:     cfg.TokenIssuer,
+		AccessTTL:  cfg.AccessTokenTTL,
+		RefreshTTL: cfg.RefreshTokenTTL,
+	})
+}
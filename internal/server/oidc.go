@@ -0,0 +1,175 @@
+package server
+
+import (
+	"crypto/subtle"
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/eleven-am/enclave/internal/auth"
+	gql "github.com/eleven-am/enclave/internal/graphql"
+)
+
+const (
+	// oidcStateCookie holds the signed state of an in-flight OIDC login.
+	oidcStateCookie = "enclave_oidc"
+	// oidcLinkCookie binds a link ticket to the browser that requested it, so
+	// a ticket sent to someone else cannot link their identity.
+	oidcLinkCookie = "enclave_oidc_link"
+)
+
+// oidcHandlers serves the browser side of the OpenID Connect login flow.
+type oidcHandlers struct {
+	provider *auth.OIDCProvider
+	tokens   *auth.TokenService
+	resolver *gql.Resolver
+	secure   bool
+}
+
+func newOIDCHandlers(cfg Config, tokens *auth.TokenService, resolver *gql.Resolver) (*oidcHandlers, error) {
+	provider, err := auth.NewOIDCProvider(auth.OIDCConfig{
+		Issuer:       cfg.OIDCIssuer,
+		ClientID:     cfg.OIDCClientID,
+		ClientSecret: cfg.OIDCClientSecret,
+		RedirectURL:  cfg.OIDCRedirectURL,
+		Scopes:       cfg.OIDCScopes,
+		HTTPClient:   cfg.OIDCHTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	redirect, err := url.Parse(cfg.OIDCRedirectURL)
+	if err != nil {
+		return nil, errors.New("invalid oidc redirect url")
+	}
+	return &oidcHandlers{
+		provider: provider,
+		tokens:   tokens,
+		resolver: resolver,
+		secure:   redirect.Scheme == "https",
+	}, nil
+}
+
+func (h *oidcHandlers) register(e *echo.Echo) {
+	e.POST("/auth/oidc/link", h.startLink)
+	e.GET("/auth/oidc/login", h.login)
+	e.GET("/auth/oidc/callback", h.callback)
+}
+
+// startLink issues a ticket for linking an identity to the signed-in user and
+// binds it to the requesting browser with a cookie. The browser then opens
+// /auth/oidc/login?link=<token>.
+func (h *oidcHandlers) startLink(c echo.Context) error {
+	ctx := c.Request().Context()
+	claims, err := auth.ClaimsFromContext(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "authentication required")
+	}
+	if claims.APIKeyID != 0 {
+		return echo.NewHTTPError(http.StatusForbidden, "api keys cannot link identities")
+	}
+	binding, err := auth.NewSecret(32)
+	if err != nil {
+		return err
+	}
+	ticket, err := h.resolver.StartIdentityLink(ctx, binding)
+	if err != nil {
+		if errors.Is(err, gql.ErrMFARequired) {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+		return err
+	}
+	h.setCookie(c, oidcLinkCookie, binding, int(time.Until(ticket.ExpiresAt).Seconds()))
+	return c.JSON(http.StatusOK, ticket)
+}
+
+// login starts an authorization code flow and redirects to the identity provider.
+func (h *oidcHandlers) login(c echo.Context) error {
+	ctx := c.Request().Context()
+	req, err := h.provider.NewAuthRequest()
+	if err != nil {
+		return err
+	}
+	if raw := c.QueryParam("link"); raw != "" {
+		claims, err := h.tokens.VerifyOIDCLink(raw)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid link ticket")
+		}
+		binding, err := c.Cookie(oidcLinkCookie)
+		if err != nil || claims.Binding == "" || subtle.ConstantTimeCompare([]byte(binding.Value), []byte(claims.Binding)) != 1 {
+			return echo.NewHTTPError(http.StatusForbidden, "link ticket was issued to another browser")
+		}
+		h.setCookie(c, oidcLinkCookie, "", -1)
+		req.LinkUserID = claims.UserID
+	}
+	state, err := h.tokens.IssueOIDCState(req, c.QueryParam("device_label"))
+	if err != nil {
+		return err
+	}
+	target, err := h.provider.AuthCodeURL(ctx, req)
+	if err != nil {
+		log.Printf("oidc login failed: %v", err)
+		return echo.NewHTTPError(http.StatusBadGateway, "identity provider unavailable")
+	}
+	h.setCookie(c, oidcStateCookie, state, 600)
+	return c.Redirect(http.StatusFound, target)
+}
+
+// callback completes the flow: it checks the state, redeems the code, validates
+// the ID token and returns a token pair for the mapped user, or links the
+// identity when the flow was started with a link ticket.
+func (h *oidcHandlers) callback(c echo.Context) error {
+	cookie, err := c.Cookie(oidcStateCookie)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "missing login state")
+	}
+	h.setCookie(c, oidcStateCookie, "", -1)
+	req, deviceLabel, err := h.tokens.VerifyOIDCState(cookie.Value)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid login state")
+	}
+	if subtle.ConstantTimeCompare([]byte(req.State), []byte(c.QueryParam("state"))) != 1 {
+		return echo.NewHTTPError(http.StatusBadRequest, "login state mismatch")
+	}
+	if providerErr := c.QueryParam("error"); providerErr != "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, strings.TrimSpace(providerErr+" "+c.QueryParam("error_description")))
+	}
+	code := c.QueryParam("code")
+	if code == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "missing authorization code")
+	}
+	ctx := c.Request().Context()
+	identity, err := h.provider.Exchange(ctx, code, req)
+	if err != nil {
+		log.Printf("oidc callback failed: %v", err)
+		return echo.NewHTTPError(http.StatusUnauthorized, "identity provider login failed")
+	}
+	if req.LinkUserID != 0 {
+		if err := h.resolver.LinkOIDCIdentity(ctx, req.LinkUserID, identity); err != nil {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+		return c.NoContent(http.StatusNoContent)
+	}
+	payload, err := h.resolver.SignInWithOIDC(ctx, identity, deviceLabel)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	}
+	return c.JSON(http.StatusOK, payload)
+}
+
+func (h *oidcHandlers) setCookie(c echo.Context, name, value string, maxAge int) {
+	c.SetCookie(&http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/auth/oidc",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   h.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
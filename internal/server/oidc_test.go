@@ -0,0 +1,444 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/eleven-am/enclave/internal/mail"
+	"github.com/eleven-am/enclave/internal/rule"
+)
+
+const testClientID = "enclave-test"
+
+// testIdP is a stand-in OpenID Connect provider. Codes are issued directly by
+// the test from the parameters of the authorization redirect, and redeemed at
+// its token endpoint only with the matching PKCE verifier.
+type testIdP struct {
+	t   *testing.T
+	srv *httptest.Server
+	key *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]testGrant
+}
+
+type testGrant struct {
+	challenge   string
+	nonce       string
+	redirectURI string
+	claims      testClaims
+}
+
+type testClaims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+func newTestIdP(t *testing.T) *testIdP {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp := &testIdP{t: t, key: key, codes: map[string]testGrant{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"issuer":                                idp.srv.URL,
+			"authorization_endpoint":                idp.srv.URL + "/authorize",
+			"token_endpoint":                        idp.srv.URL + "/token",
+			"jwks_uri":                              idp.srv.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "k1",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", idp.token)
+	idp.srv = httptest.NewServer(mux)
+	t.Cleanup(idp.srv.Close)
+	return idp
+}
+
+// authorize plays the provider's login page: it checks the redirect the
+// relying party sent the user to and returns a code for claims.
+func (idp *testIdP) authorize(location string, claims testClaims) (code, state string) {
+	idp.t.Helper()
+	target, err := url.Parse(location)
+	if err != nil {
+		idp.t.Fatal(err)
+	}
+	if !strings.HasPrefix(location, idp.srv.URL+"/authorize?") {
+		idp.t.Fatalf("redirected to %q", location)
+	}
+	q := target.Query()
+	if q.Get("client_id") != testClientID || q.Get("response_type") != "code" || q.Get("code_challenge_method") != "S256" {
+		idp.t.Fatalf("unexpected authorization request %v", q)
+	}
+	if q.Get("code_challenge") == "" || q.Get("nonce") == "" || q.Get("state") == "" {
+		idp.t.Fatalf("authorization request lacks PKCE, nonce or state: %v", q)
+	}
+	code = "code-" + q.Get("state")
+	idp.mu.Lock()
+	idp.codes[code] = testGrant{
+		challenge:   q.Get("code_challenge"),
+		nonce:       q.Get("nonce"),
+		redirectURI: q.Get("redirect_uri"),
+		claims:      claims,
+	}
+	idp.mu.Unlock()
+	return code, q.Get("state")
+}
+
+func (idp *testIdP) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	idp.mu.Lock()
+	grant, ok := idp.codes[r.Form.Get("code")]
+	delete(idp.codes, r.Form.Get("code"))
+	idp.mu.Unlock()
+	verifier := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+	if !ok || grant.redirectURI != r.Form.Get("redirect_uri") ||
+		base64.RawURLEncoding.EncodeToString(verifier[:]) != grant.challenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            idp.srv.URL,
+		"sub":            grant.claims.Subject,
+		"aud":            testClientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"nonce":          grant.nonce,
+		"email":          grant.claims.Email,
+		"email_verified": grant.claims.EmailVerified,
+	})
+	token.Header["kid"] = "k1"
+	signed, err := token.SignedString(idp.key)
+	if err != nil {
+		idp.t.Error(err)
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"id_token": signed, "token_type": "Bearer"})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+type oidcTestEnv struct {
+	t   *testing.T
+	srv *Server
+	idp *testIdP
+}
+
+func newOIDCTestEnv(t *testing.T) *oidcTestEnv {
+	t.Helper()
+	idp := newTestIdP(t)
+	srv, err := New(context.Background(), Config{
+		DatabasePath:    filepath.Join(t.TempDir(), "enclave.db"),
+		TokenSecret:     "0123456789abcdef0123456789abcdef",
+		Mailer:          mail.NewMemoryMailer(),
+		OIDCIssuer:      idp.srv.URL,
+		OIDCClientID:    testClientID,
+		OIDCRedirectURL: "https://chat.example.com/auth/oidc/callback",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { srv.Close() })
+	return &oidcTestEnv{t: t, srv: srv, idp: idp}
+}
+
+// login opens /auth/oidc/login from a browser holding cookies.
+func (env *oidcTestEnv) login(query string, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/auth/oidc/login"+query, nil)
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	rec := httptest.NewRecorder()
+	env.srv.App.ServeHTTP(rec, req)
+	return rec
+}
+
+// startLogin opens /auth/oidc/login and returns the provider redirect and the
+// state cookie the relying party set.
+func (env *oidcTestEnv) startLogin(query string, cookies ...*http.Cookie) (string, *http.Cookie) {
+	env.t.Helper()
+	rec := env.login(query, cookies...)
+	if rec.Code != http.StatusFound {
+		env.t.Fatalf("login returned %d: %s", rec.Code, rec.Body)
+	}
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == oidcStateCookie {
+			return rec.Header().Get("Location"), cookie
+		}
+	}
+	env.t.Fatalf("login set cookies %v", rec.Result().Cookies())
+	return "", nil
+}
+
+// startLink requests a link ticket with an access token and returns it with
+// the cookie that binds it to the browser.
+func (env *oidcTestEnv) startLink(token string) (string, *http.Cookie) {
+	env.t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/auth/oidc/link", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	env.srv.App.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		env.t.Fatalf("link returned %d: %s", rec.Code, rec.Body)
+	}
+	var ticket struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &ticket); err != nil {
+		env.t.Fatal(err)
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != oidcLinkCookie || ticket.Token == "" {
+		env.t.Fatalf("link returned %s with cookies %v", rec.Body, cookies)
+	}
+	return ticket.Token, cookies[0]
+}
+
+func (env *oidcTestEnv) callback(code, state string, cookie *http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback?"+url.Values{"code": {code}, "state": {state}}.Encode(), nil)
+	req.AddCookie(cookie)
+	rec := httptest.NewRecorder()
+	env.srv.App.ServeHTTP(rec, req)
+	return rec
+}
+
+// signIn runs a complete flow for claims, starting from query in a browser
+// holding cookies.
+func (env *oidcTestEnv) signIn(query string, claims testClaims, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+	env.t.Helper()
+	location, cookie := env.startLogin(query, cookies...)
+	code, state := env.idp.authorize(location, claims)
+	return env.callback(code, state, cookie)
+}
+
+// signedInUser returns the ID of the user a successful sign-in returned.
+func (env *oidcTestEnv) signedInUser(rec *httptest.ResponseRecorder) int {
+	env.t.Helper()
+	if rec.Code != http.StatusOK {
+		env.t.Fatalf("callback returned %d: %s", rec.Code, rec.Body)
+	}
+	var payload struct {
+		AccessToken string `json:"accessToken"`
+		User        struct {
+			ID int `json:"id"`
+		} `json:"user"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &payload); err != nil {
+		env.t.Fatal(err)
+	}
+	if payload.AccessToken == "" || payload.User.ID == 0 {
+		env.t.Fatalf("callback returned %s", rec.Body)
+	}
+	return payload.User.ID
+}
+
+func (env *oidcTestEnv) graphql(token, query string) map[string]interface{} {
+	env.t.Helper()
+	body, _ := json.Marshal(map[string]string{"query": query})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	env.srv.App.ServeHTTP(rec, req)
+	var out struct {
+		Data   map[string]interface{} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &out); err != nil {
+		env.t.Fatalf("graphql returned %d: %s", rec.Code, rec.Body)
+	}
+	if len(out.Errors) > 0 {
+		env.t.Fatalf("graphql error: %s", out.Errors[0].Message)
+	}
+	return out.Data
+}
+
+// register creates a password account and returns its ID and access token.
+func (env *oidcTestEnv) register(username, email string) (int, string) {
+	env.t.Helper()
+	data := env.graphql("", `mutation { register(username: "`+username+`", displayName: "`+username+`", email: "`+email+`", password: "correct horse battery") { accessToken user { id } } }`)
+	payload := data["register"].(map[string]interface{})
+	id, err := strconv.Atoi(payload["user"].(map[string]interface{})["id"].(string))
+	if err != nil {
+		env.t.Fatal(err)
+	}
+	return id, payload["accessToken"].(string)
+}
+
+func TestOIDCCreatesAndReturnsUser(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	claims := testClaims{Subject: "alice-sub", Email: "alice@example.com", EmailVerified: true}
+	first := env.signedInUser(env.signIn("", claims))
+	if again := env.signedInUser(env.signIn("", claims)); again != first {
+		t.Fatalf("second sign-in mapped to user %d, want %d", again, first)
+	}
+}
+
+func TestOIDCRejectsWrongCodeVerifier(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	location, cookie := env.startLogin("")
+	other, _ := env.startLogin("")
+	// The code is bound to the challenge of another login attempt, so this
+	// attempt's verifier does not match it.
+	code, _ := env.idp.authorize(other, testClaims{Subject: "eve-sub", Email: "eve@example.com"})
+	target, _ := url.Parse(location)
+	if rec := env.callback(code, target.Query().Get("state"), cookie); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("callback returned %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestOIDCRejectsStateMismatch(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	location, cookie := env.startLogin("")
+	code, _ := env.idp.authorize(location, testClaims{Subject: "eve-sub", Email: "eve@example.com"})
+	if rec := env.callback(code, "forged", cookie); rec.Code != http.StatusBadRequest {
+		t.Fatalf("callback returned %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestOIDCDoesNotLinkUnverifiedAccount(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	// Someone registers the victim's address before the victim ever signs in.
+	env.register("squatter", "victim@example.com")
+	rec := env.signIn("", testClaims{Subject: "victim-sub", Email: "victim@example.com", EmailVerified: true})
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("callback returned %d, want %d: %s", rec.Code, http.StatusForbidden, rec.Body)
+	}
+}
+
+func TestOIDCLinksVerifiedAccount(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	id, _ := env.register("bob", "bob@example.com")
+	if rec := env.signIn("", testClaims{Subject: "bob-sub", Email: "bob@example.com"}); rec.Code != http.StatusForbidden {
+		t.Fatalf("unverified provider email: callback returned %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	err := env.srv.Client.User.UpdateOneID(id).SetVerifiedAt(time.Now()).Exec(rule.SystemContext(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := env.signedInUser(env.signIn("", testClaims{Subject: "bob-sub", Email: "bob@example.com", EmailVerified: true}))
+	if got != id {
+		t.Fatalf("signed in as user %d, want %d", got, id)
+	}
+}
+
+func TestOIDCExplicitLink(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	id, token := env.register("carol", "carol@example.com")
+	ticket, binding := env.startLink(token)
+
+	claims := testClaims{Subject: "carol-sub", Email: "carol@idp.example.com"}
+	if rec := env.signIn("?link="+url.QueryEscape(ticket), claims, binding); rec.Code != http.StatusNoContent {
+		t.Fatalf("link returned %d: %s", rec.Code, rec.Body)
+	}
+	if got := env.signedInUser(env.signIn("", claims)); got != id {
+		t.Fatalf("signed in as user %d, want %d", got, id)
+	}
+
+	// The identity now belongs to carol and cannot be linked elsewhere.
+	_, other := env.register("dave", "dave@example.com")
+	ticket, binding = env.startLink(other)
+	if rec := env.signIn("?link="+url.QueryEscape(ticket), claims, binding); rec.Code != http.StatusForbidden {
+		t.Fatalf("relink returned %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestOIDCLinkTicketIsBoundToBrowser(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	_, attacker := env.register("mallory", "mallory@example.com")
+	ticket, _ := env.startLink(attacker)
+	// A victim sent to the attacker's ticket has no binding cookie, or one
+	// from a ticket of their own.
+	if rec := env.login("?link=" + url.QueryEscape(ticket)); rec.Code != http.StatusForbidden {
+		t.Fatalf("login without binding returned %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	_, victim := env.register("victor", "victor@example.com")
+	_, binding := env.startLink(victim)
+	if rec := env.login("?link="+url.QueryEscape(ticket), binding); rec.Code != http.StatusForbidden {
+		t.Fatalf("login with another binding returned %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestOIDCChallengesSecondFactor(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	id, _ := env.register("frank", "frank@example.com")
+	ctx := rule.SystemContext(context.Background())
+	if err := env.srv.Client.User.UpdateOneID(id).SetVerifiedAt(time.Now()).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.srv.Client.TotpSecret.Create().SetUserID(id).SetSecret("JBSWY3DPEHPK3PXP").SetConfirmedAt(time.Now()).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	claims := testClaims{Subject: "frank-sub", Email: "frank@example.com", EmailVerified: true}
+	for _, attempt := range []string{"auto-link", "linked identity"} {
+		rec := env.signIn("", claims)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: callback returned %d: %s", attempt, rec.Code, rec.Body)
+		}
+		var payload struct {
+			AccessToken  string `json:"accessToken"`
+			MFAChallenge struct {
+				Token string `json:"token"`
+			} `json:"mfaChallenge"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.AccessToken != "" || payload.MFAChallenge.Token == "" {
+			t.Fatalf("%s: callback returned %s, want an mfa challenge", attempt, rec.Body)
+		}
+	}
+}
+
+func TestOIDCRejectsInvalidLinkTicket(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	_, token := env.register("erin", "erin@example.com")
+	rec := httptest.NewRecorder()
+	// An access token is not a link ticket.
+	env.srv.App.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/auth/oidc/login?link="+url.QueryEscape(token), nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("login returned %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
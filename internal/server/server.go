@@ -2,11 +2,21 @@ package server
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"log"
+	"net"
 	"net/http"
-	"strconv"
+	"os"
+	"strings"
 	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/functionalfoundry/graphqlws"
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/handler"
@@ -15,13 +25,67 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 
 	"github.com/eleven-am/enclave/ent"
+	_ "github.com/eleven-am/enclave/ent/runtime"
+	"github.com/eleven-am/enclave/ent/user"
 	"github.com/eleven-am/enclave/internal/auth"
+	"github.com/eleven-am/enclave/internal/directory"
 	gql "github.com/eleven-am/enclave/internal/graphql"
+	"github.com/eleven-am/enclave/internal/mail"
 )
 
 // Config captures runtime configuration for the enclave server.
 type Config struct {
 	DatabasePath string
+
+	// KeySetPath points at a JSON keyset used to sign and verify tokens. When empty,
+	// TokenSecret is used as a single HS256 key.
+	KeySetPath      string
+	TokenSecret     string
+	TokenIssuer     string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+
+	// OIDCIssuer enables login through an OpenID Connect provider when set.
+	OIDCIssuer       string
+	OIDCClientID     string
+	OIDCClientSecret string
+	OIDCRedirectURL  string
+	OIDCScopes       []string
+	// OIDCHTTPClient overrides the client used to reach the provider.
+	OIDCHTTPClient *http.Client
+
+	// AdminUsernames are promoted to the admin role at startup, so a fresh
+	// instance has an operator. Only registered accounts with a verified
+	// email are promoted.
+	AdminUsernames []string
+
+	// AccountDeletionGrace is how long a deleted account can be restored
+	// before it is purged. Zero selects the GraphQL package default.
+	AccountDeletionGrace time.Duration
+
+	// SMTP settings for verification and password reset emails. Without an
+	// SMTP host, emails are kept in memory and never delivered.
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	MailFrom     string
+	// Mailer overrides the SMTP settings.
+	Mailer mail.Mailer
+	// AppURL is the base URL of the client app that emailed links point at.
+	AppURL string
+
+	// KeyLogKeyPath points at a PEM encoded Ed25519 private key that signs the
+	// tree heads of the key transparency log. When empty, an ephemeral key is
+	// generated and clients cannot check heads across restarts.
+	KeyLogKeyPath string
+
+	// TrustedProxies lists the addresses or CIDR ranges of reverse proxies
+	// whose X-Forwarded-For header is believed. Without any, the client
+	// address is the peer address of the connection and forwarding headers
+	// are ignored, so clients cannot pick the address login throttling and
+	// sessions record.
+	TrustedProxies []string
 }
 
 // Server bundles the Echo HTTP server, ent client and GraphQL schema.
@@ -31,6 +95,7 @@ type Server struct {
 	Schema        graphql.Schema
 	Resolver      *gql.Resolver
 	Subscriptions graphqlws.SubscriptionManager
+	Tokens        *auth.TokenService
 }
 
 // New constructs the server, initializes the database schema and GraphQL handler.
@@ -38,31 +103,73 @@ func New(ctx context.Context, cfg Config) (*Server, error) {
 	if cfg.DatabasePath == "" {
 		cfg.DatabasePath = "enclave.db"
 	}
+	tokens, err := newTokenService(cfg)
+	if err != nil {
+		return nil, err
+	}
 	dsn := fmt.Sprintf("file:%s?_fk=1", cfg.DatabasePath)
-	client, err := ent.Open("sqlite3", dsn)
+	drv, err := entsql.Open(dialect.SQLite, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed opening database: %w", err)
 	}
+	client := ent.NewClient(ent.Driver(drv))
 
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 	if err := client.Schema.Create(ctx); err != nil {
 		return nil, fmt.Errorf("failed creating schema resources: %w", err)
 	}
+	if err := gql.BackfillMessageSeqs(ctx, client); err != nil {
+		return nil, fmt.Errorf("failed numbering messages: %w", err)
+	}
+	if err := gql.BackfillIdentityKeyRecords(ctx, client); err != nil {
+		return nil, fmt.Errorf("failed backfilling identity key history: %w", err)
+	}
+	if err := gql.BackfillKeyLog(ctx, client); err != nil {
+		return nil, fmt.Errorf("failed building the key transparency log: %w", err)
+	}
+	dir, err := directory.Open(ctx, drv.DB())
+	if err != nil {
+		return nil, err
+	}
+	if err := promoteAdmins(ctx, client, cfg.AdminUsernames); err != nil {
+		return nil, fmt.Errorf("failed promoting admins: %w", err)
+	}
+
+	mailer, err := newMailer(cfg)
+	if err != nil {
+		return nil, err
+	}
+	logKey, err := newLogKey(cfg)
+	if err != nil {
+		return nil, err
+	}
 
-	schema, resolver, err := gql.NewSchema(client)
+	schema, resolver, err := gql.NewSchema(client, gql.Config{
+		Tokens:              tokens,
+		Directory:           dir,
+		DeletionGracePeriod: cfg.AccountDeletionGrace,
+		Mailer:              mailer,
+		AppURL:              cfg.AppURL,
+		LogKey:              logKey,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed constructing graphql schema: %w", err)
 	}
 
-	subscriptionManager := graphqlws.NewSubscriptionManager(&schema)
+	subscriptionManager := &sessionSubscriptionManager{
+		SubscriptionManager: graphqlws.NewSubscriptionManager(&schema),
+		client:              client,
+		schema:              &schema,
+		subscribers:         make(map[subscriptionKey]*messageSubscriber),
+	}
 	resolver.RegisterNotificationListener(func(ctx context.Context, userID int, n *ent.Notification) {
 		if n == nil {
 			return
 		}
 		for conn, subs := range subscriptionManager.Subscriptions() {
-			uid, ok := conn.User().(int)
-			if !ok || uid != userID {
+			claims, ok := conn.User().(*auth.Claims)
+			if !ok || claims.UserID != userID {
 				continue
 			}
 			for _, sub := range subs {
@@ -79,6 +186,14 @@ func New(ctx context.Context, cfg Config) (*Server, error) {
 		}
 	})
 
+	resolver.RegisterMessageListener(func(ctx context.Context, event *gql.MessageEvent) {
+		subscriptionManager.sendMessageEvent(event)
+	})
+
+	resolver.RegisterSessionRevokedListener(func(ctx context.Context, sessionIDs []int) {
+		subscriptionManager.disconnectSessions(sessionIDs)
+	})
+
 	graphHandler := handler.New(&handler.Config{
 		Schema:   &schema,
 		Pretty:   true,
@@ -91,34 +206,42 @@ func New(ctx context.Context, cfg Config) (*Server, error) {
 			if token == "" {
 				return nil, fmt.Errorf("missing auth token")
 			}
-			id, err := strconv.Atoi(token)
+			claims, err := tokens.VerifyAccessToken(token)
 			if err != nil {
 				return nil, fmt.Errorf("invalid auth token")
 			}
-			return id, nil
+			if err := checkSession(context.Background(), client, claims); err != nil {
+				return nil, err
+			}
+			return claims, nil
 		},
 	})
 
+	ipExtractor, err := newIPExtractor(cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
 	e := echo.New()
 	e.HideBanner = true
+	e.IPExtractor = ipExtractor
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
-	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			userID, err := auth.UserIDFromRequest(c.Request())
-			if err == nil {
-				ctx := auth.ContextWithUserID(c.Request().Context(), userID)
-				c.SetRequest(c.Request().WithContext(ctx))
-			}
-			return next(c)
-		}
-	})
+	e.Use(authMiddleware(tokens, client))
 
 	e.GET("/healthz", func(c echo.Context) error {
 		return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
 	})
 	e.Match([]string{http.MethodGet, http.MethodPost}, "/graphql", echo.WrapHandler(graphHandler))
 	e.GET("/graphql/ws", echo.WrapHandler(wsHandler))
+	e.GET("/account/export", accountExportHandler(resolver))
+
+	if cfg.OIDCIssuer != "" {
+		oidc, err := newOIDCHandlers(cfg, tokens, resolver)
+		if err != nil {
+			return nil, fmt.Errorf("failed configuring oidc: %w", err)
+		}
+		oidc.register(e)
+	}
 
 	return &Server{
 		App:           e,
@@ -126,6 +249,7 @@ func New(ctx context.Context, cfg Config) (*Server, error) {
 		Schema:        schema,
 		Resolver:      resolver,
 		Subscriptions: subscriptionManager,
+		Tokens:        tokens,
 	}, nil
 }
 
@@ -136,3 +260,123 @@ func (s *Server) Close() error {
 	}
 	return nil
 }
+
+// promoteAdmins grants the admin role to the accounts with the given usernames.
+// Names nobody has registered, and accounts whose email is unverified, are
+// skipped with a warning: otherwise whoever claimed a configured name first
+// would become admin on the next restart.
+func promoteAdmins(ctx context.Context, client *ent.Client, usernames []string) error {
+	if len(usernames) == 0 {
+		return nil
+	}
+	users, err := client.User.Query().Where(user.UsernameIn(usernames...)).All(ctx)
+	if err != nil {
+		return err
+	}
+	registered := make(map[string]bool, len(users))
+	var ids []int
+	for _, u := range users {
+		registered[u.Username] = true
+		if u.VerifiedAt == nil {
+			log.Printf("admin username %q has not verified its email; not promoting it", u.Username)
+			continue
+		}
+		ids = append(ids, u.ID)
+	}
+	for _, name := range usernames {
+		if !registered[name] {
+			log.Printf("admin username %q is not registered; register and verify it, then restart to promote it", name)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return client.User.Update().
+		Where(user.IDIn(ids...), user.RoleNEQ(user.RoleAdmin)).
+		SetRole(user.RoleAdmin).
+		Exec(ctx)
+}
+
+// newMailer picks the mailer for account emails.
+func newMailer(cfg Config) (mail.Mailer, error) {
+	if cfg.Mailer != nil {
+		return cfg.Mailer, nil
+	}
+	if cfg.SMTPHost == "" {
+		log.Printf("no smtp host configured; verification and password reset emails are not delivered")
+		return mail.NewMemoryMailer(), nil
+	}
+	mailer, err := mail.NewSMTPMailer(mail.SMTPConfig{
+		Host:     cfg.SMTPHost,
+		Port:     cfg.SMTPPort,
+		Username: cfg.SMTPUsername,
+		Password: cfg.SMTPPassword,
+		From:     cfg.MailFrom,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed configuring mailer: %w", err)
+	}
+	return mailer, nil
+}
+
+// newLogKey loads the key that signs key transparency tree heads.
+func newLogKey(cfg Config) (ed25519.PrivateKey, error) {
+	if cfg.KeyLogKeyPath == "" {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed generating key log key: %w", err)
+		}
+		log.Printf("no key log key configured; using an ephemeral key, tree heads cannot be checked across restarts")
+		return key, nil
+	}
+	raw, err := os.ReadFile(cfg.KeyLogKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading key log key: %w", err)
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("key log key is not PEM encoded")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing key log key: %w", err)
+	}
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("key log key must be an Ed25519 key")
+	}
+	return key, nil
+}
+
+// newIPExtractor picks how client addresses are read. Only the configured
+// proxies are trusted; echo's default of trusting loopback and private
+// ranges is turned off, since those peers may be other clients.
+func newIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, raw := range trustedProxies {
+		if !strings.Contains(raw, "/") {
+			ip := net.ParseIP(raw)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", raw)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				bits = 8 * net.IPv4len
+			}
+			raw = fmt.Sprintf("%s/%d", raw, bits)
+		}
+		_, ipRange, err := net.ParseCIDR(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", raw)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
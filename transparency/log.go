@@ -0,0 +1,89 @@
+package transparency
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+// entryVersion prefixes the encoding of every KeyEntry.
+const entryVersion = 1
+
+// treeHeadContext separates tree head signatures from any other use of the
+// log key.
+const treeHeadContext = "enclave key transparency tree head v1\n"
+
+// ErrInvalidSignature is returned for tree heads that were not signed by the
+// log key.
+var ErrInvalidSignature = errors.New("invalid tree head signature")
+
+// KeyEntry is one identity key publication recorded in the log.
+type KeyEntry struct {
+	UserID   int
+	DeviceID int
+	// IdentityKey is the base64 encoded key exactly as the device published it.
+	IdentityKey string
+	// Timestamp is when the key was published, with millisecond precision.
+	Timestamp time.Time
+}
+
+// LeafData returns the encoding of the entry that is hashed into the tree: a
+// version byte, the user ID, device ID and timestamp in Unix milliseconds as
+// big-endian 64-bit integers, and the identity key prefixed with its length
+// as a big-endian 32-bit integer.
+func (e KeyEntry) LeafData() []byte {
+	buf := make([]byte, 0, 1+8+8+8+4+len(e.IdentityKey))
+	buf = append(buf, entryVersion)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(e.UserID))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(e.DeviceID))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(e.Timestamp.UnixMilli()))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(e.IdentityKey)))
+	return append(buf, e.IdentityKey...)
+}
+
+// LeafHash returns the hash of the entry's leaf.
+func (e KeyEntry) LeafHash() []byte {
+	return LeafHash(e.LeafData())
+}
+
+// TreeHead commits to the log at one size.
+type TreeHead struct {
+	Size     uint64
+	RootHash []byte
+	// Timestamp is when the head was signed, with millisecond precision.
+	Timestamp time.Time
+	Signature []byte
+}
+
+// signedData returns the bytes covered by the head's signature.
+func (h *TreeHead) signedData() []byte {
+	buf := make([]byte, 0, len(treeHeadContext)+8+8+len(h.RootHash))
+	buf = append(buf, treeHeadContext...)
+	buf = binary.BigEndian.AppendUint64(buf, h.Size)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(h.Timestamp.UnixMilli()))
+	return append(buf, h.RootHash...)
+}
+
+// SignTreeHead builds and signs the head of a tree with the given size and
+// root hash.
+func SignTreeHead(key ed25519.PrivateKey, size uint64, root []byte, at time.Time) *TreeHead {
+	head := &TreeHead{
+		Size:      size,
+		RootHash:  root,
+		Timestamp: at.Truncate(time.Millisecond),
+	}
+	head.Signature = ed25519.Sign(key, head.signedData())
+	return head
+}
+
+// Verify checks the head's signature.
+func (h *TreeHead) Verify(key ed25519.PublicKey) error {
+	if len(key) != ed25519.PublicKeySize || len(h.RootHash) != HashSize {
+		return ErrInvalidSignature
+	}
+	if !ed25519.Verify(key, h.signedData(), h.Signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+// Package transparency implements the Merkle tree behind enclave's key
+// transparency log and the checks clients use to audit it.
+//
+// The tree follows RFC 9162 (Certificate Transparency 2.0): leaves are hashed
+// as SHA-256(0x00 || data) and interior nodes as SHA-256(0x01 || left ||
+// right). The server signs tree heads with an Ed25519 key; a Verifier holding
+// that key checks tree heads, inclusion proofs for identity keys and
+// consistency between tree heads, so a server that shows different clients
+// different logs is caught.
+package transparency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// HashSize is the length of leaf, node and root hashes.
+const HashSize = sha256.Size
+
+// ErrInvalidProof is returned when an inclusion or consistency proof does
+// not match the tree hashes it is checked against.
+var ErrInvalidProof = errors.New("invalid merkle proof")
+
+// LeafHash hashes the data of one log entry.
+func LeafHash(data []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{0x00})
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// nodeHash hashes two child nodes.
+func nodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{0x01})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// emptyRoot is the root hash of a tree without leaves.
+func emptyRoot() []byte {
+	sum := sha256.Sum256(nil)
+	return sum[:]
+}
+
+// split returns the largest power of two smaller than n, for n > 1.
+func split(n uint64) uint64 {
+	return 1 << (bits.Len64(n-1) - 1)
+}
+
+// subtreeFunc returns the hash of the leaves from lo up to hi, which are
+// always the leaves of one subtree as RFC 9162 splits the tree.
+type subtreeFunc func(lo, hi uint64) ([]byte, error)
+
+// leafSubtrees hashes subtrees from a slice holding every leaf hash.
+func leafSubtrees(leaves [][]byte) subtreeFunc {
+	return func(lo, hi uint64) ([]byte, error) {
+		return subtreeHash(leaves[lo:hi]), nil
+	}
+}
+
+// RootHash computes the root of the tree with the given leaf hashes.
+func RootHash(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return emptyRoot()
+	}
+	return subtreeHash(leaves)
+}
+
+func subtreeHash(leaves [][]byte) []byte {
+	if len(leaves) == 1 {
+		return leaves[0]
+	}
+	k := split(uint64(len(leaves)))
+	return nodeHash(subtreeHash(leaves[:k]), subtreeHash(leaves[k:]))
+}
+
+// InclusionProof returns the audit path for the leaf at index in the tree
+// formed by leaves.
+func InclusionProof(leaves [][]byte, index uint64) ([][]byte, error) {
+	if index >= uint64(len(leaves)) {
+		return nil, fmt.Errorf("leaf %d is outside a tree of size %d", index, len(leaves))
+	}
+	return inclusionPath(leafSubtrees(leaves), 0, uint64(len(leaves)), index)
+}
+
+// inclusionPath returns the audit path for the leaf at index within the
+// subtree of the leaves from lo up to hi.
+func inclusionPath(subtree subtreeFunc, lo, hi, index uint64) ([][]byte, error) {
+	n := hi - lo
+	if n <= 1 {
+		return nil, nil
+	}
+	k := split(n)
+	var (
+		path    [][]byte
+		sibling []byte
+		err     error
+	)
+	if index < k {
+		if path, err = inclusionPath(subtree, lo, lo+k, index); err != nil {
+			return nil, err
+		}
+		sibling, err = subtree(lo+k, hi)
+	} else {
+		if path, err = inclusionPath(subtree, lo+k, hi, index-k); err != nil {
+			return nil, err
+		}
+		sibling, err = subtree(lo, lo+k)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append(path, sibling), nil
+}
+
+// ConsistencyProof returns the proof that the tree of the first size leaves
+// is a prefix of the tree formed by all leaves.
+func ConsistencyProof(leaves [][]byte, size uint64) ([][]byte, error) {
+	if size > uint64(len(leaves)) {
+		return nil, fmt.Errorf("size %d is larger than the tree of size %d", size, len(leaves))
+	}
+	if size == 0 || size == uint64(len(leaves)) {
+		return nil, nil
+	}
+	return consistencySubproof(leafSubtrees(leaves), 0, uint64(len(leaves)), size, true)
+}
+
+// consistencySubproof is SUBPROOF from RFC 9162 section 2.1.4.2 for the
+// subtree of the leaves from lo up to hi.
+func consistencySubproof(subtree subtreeFunc, lo, hi, m uint64, complete bool) ([][]byte, error) {
+	n := hi - lo
+	if m == n {
+		if complete {
+			return nil, nil
+		}
+		root, err := subtree(lo, hi)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{root}, nil
+	}
+	k := split(n)
+	var (
+		proof   [][]byte
+		sibling []byte
+		err     error
+	)
+	if m <= k {
+		if proof, err = consistencySubproof(subtree, lo, lo+k, m, complete); err != nil {
+			return nil, err
+		}
+		sibling, err = subtree(lo+k, hi)
+	} else {
+		if proof, err = consistencySubproof(subtree, lo+k, hi, m-k, false); err != nil {
+			return nil, err
+		}
+		sibling, err = subtree(lo, lo+k)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append(proof, sibling), nil
+}
+
+// VerifyInclusion checks that leafHash is the leaf at index in the tree of
+// the given size and root.
+func VerifyInclusion(leafHash []byte, index, size uint64, proof [][]byte, root []byte) error {
+	if index >= size {
+		return fmt.Errorf("%w: leaf %d is outside a tree of size %d", ErrInvalidProof, index, size)
+	}
+	fn, sn := index, size-1
+	r := leafHash
+	for _, p := range proof {
+		if sn == 0 {
+			return fmt.Errorf("%w: proof is too long", ErrInvalidProof)
+		}
+		if fn&1 == 1 || fn == sn {
+			r = nodeHash(p, r)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = nodeHash(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 || !bytes.Equal(r, root) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// VerifyConsistency checks that the tree of size first and root firstRoot is
+// a prefix of the tree of size second and root secondRoot.
+func VerifyConsistency(first, second uint64, firstRoot, secondRoot []byte, proof [][]byte) error {
+	switch {
+	case first > second:
+		return fmt.Errorf("%w: size %d is larger than %d", ErrInvalidProof, first, second)
+	case first == second:
+		if len(proof) != 0 || !bytes.Equal(firstRoot, secondRoot) {
+			return ErrInvalidProof
+		}
+		return nil
+	case first == 0:
+		if len(proof) != 0 {
+			return ErrInvalidProof
+		}
+		return nil
+	case len(proof) == 0:
+		return ErrInvalidProof
+	}
+	if first&(first-1) == 0 {
+		proof = append([][]byte{firstRoot}, proof...)
+	}
+	fn, sn := first-1, second-1
+	for fn&1 == 1 {
+		fn >>= 1
+		sn >>= 1
+	}
+	fr, sr := proof[0], proof[0]
+	for _, c := range proof[1:] {
+		if sn == 0 {
+			return fmt.Errorf("%w: proof is too long", ErrInvalidProof)
+		}
+		if fn&1 == 1 || fn == sn {
+			fr = nodeHash(c, fr)
+			sr = nodeHash(c, sr)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			sr = nodeHash(sr, c)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 || !bytes.Equal(fr, firstRoot) || !bytes.Equal(sr, secondRoot) {
+		return ErrInvalidProof
+	}
+	return nil
+}
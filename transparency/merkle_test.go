@@ -0,0 +1,245 @@
+package transparency
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// The leaves and expected hashes below are the reference test vectors of the
+// Certificate Transparency Merkle tree, which RFC 9162 keeps unchanged.
+var testLeafData = []string{
+	"",
+	"00",
+	"10",
+	"2021",
+	"3031",
+	"40414243",
+	"5051525354555657",
+	"606162636465666768696a6b6c6d6e6f",
+}
+
+var testRoots = []string{
+	"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	"6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d",
+	"fac54203e7cc696cf0dfcb42c92a1d9dbaf70ad9e621f4bd8d98662f00e3c125",
+	"aeb6bcfe274b70a14fb067a5e5578264db0fa9b51af5e0ba159158f329e06e77",
+	"d37ee418976dd95753c1c73862b9398fa2a2cf9b4ff0fdfe8b30cd95209614b7",
+	"4e3bbb1f7b478dcfe71fb631631519a3bca12c9aefca1612bfce4c13a86264d4",
+	"76e67dadbcdf1e10e1b74ddc608abd2f98dfb16fbce75277b5232a127f2087ef",
+	"ddb89be403809e325750d3d263cd78929c2942b7942a34b77e122c9594a74c8c",
+	"5dc9da79a70659a9ad559cb701ded9a2ab9d823aad2f4960cfe370eff4604328",
+}
+
+var testInclusionProofs = []struct {
+	index, size uint64
+	proof       []string
+}{
+	{0, 1, nil},
+	{0, 8, []string{
+		"96a296d224f285c67bee93c30f8a309157f0daa35dc5b87e410b78630a09cfc7",
+		"5f083f0a1a33ca076a95279832580db3e0ef4584bdff1f54c8a360f50de3031e",
+		"6b47aaf29ee3c2af9af889bc1fb9254dabd31177f16232dd6aab035ca39bf6e4",
+	}},
+	{5, 8, []string{
+		"bc1a0643b12e4d2d7c77918f44e0f4f79a838b6cf9ec5b5c283e1f4d88599e6b",
+		"ca854ea128ed050b41b35ffc1b87b8eb2bde461e9e3b5596ece6b9d5975a0ae0",
+		"d37ee418976dd95753c1c73862b9398fa2a2cf9b4ff0fdfe8b30cd95209614b7",
+	}},
+	{2, 3, []string{
+		"fac54203e7cc696cf0dfcb42c92a1d9dbaf70ad9e621f4bd8d98662f00e3c125",
+	}},
+	{1, 5, []string{
+		"6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d",
+		"5f083f0a1a33ca076a95279832580db3e0ef4584bdff1f54c8a360f50de3031e",
+		"bc1a0643b12e4d2d7c77918f44e0f4f79a838b6cf9ec5b5c283e1f4d88599e6b",
+	}},
+}
+
+var testConsistencyProofs = []struct {
+	first, second uint64
+	proof         []string
+}{
+	{1, 1, nil},
+	{1, 8, []string{
+		"96a296d224f285c67bee93c30f8a309157f0daa35dc5b87e410b78630a09cfc7",
+		"5f083f0a1a33ca076a95279832580db3e0ef4584bdff1f54c8a360f50de3031e",
+		"6b47aaf29ee3c2af9af889bc1fb9254dabd31177f16232dd6aab035ca39bf6e4",
+	}},
+	{6, 8, []string{
+		"0ebc5d3437fbe2db158b9f126a1d118e308181031d0a949f8dededebc558ef6a",
+		"ca854ea128ed050b41b35ffc1b87b8eb2bde461e9e3b5596ece6b9d5975a0ae0",
+		"d37ee418976dd95753c1c73862b9398fa2a2cf9b4ff0fdfe8b30cd95209614b7",
+	}},
+	{2, 5, []string{
+		"5f083f0a1a33ca076a95279832580db3e0ef4584bdff1f54c8a360f50de3031e",
+		"bc1a0643b12e4d2d7c77918f44e0f4f79a838b6cf9ec5b5c283e1f4d88599e6b",
+	}},
+}
+
+func testLeaves(t *testing.T) [][]byte {
+	t.Helper()
+	leaves := make([][]byte, len(testLeafData))
+	for i, data := range testLeafData {
+		leaves[i] = LeafHash(mustDecodeHex(t, data))
+	}
+	return leaves
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func decodeProof(t *testing.T, proof []string) [][]byte {
+	t.Helper()
+	var hashes [][]byte
+	for _, h := range proof {
+		hashes = append(hashes, mustDecodeHex(t, h))
+	}
+	return hashes
+}
+
+func equalProofs(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRootHash(t *testing.T) {
+	leaves := testLeaves(t)
+	for size, want := range testRoots {
+		if got := RootHash(leaves[:size]); !bytes.Equal(got, mustDecodeHex(t, want)) {
+			t.Errorf("root of size %d = %x, want %s", size, got, want)
+		}
+	}
+}
+
+func TestInclusionProof(t *testing.T) {
+	leaves := testLeaves(t)
+	for _, tc := range testInclusionProofs {
+		want := decodeProof(t, tc.proof)
+		got, err := InclusionProof(leaves[:tc.size], tc.index)
+		if err != nil {
+			t.Fatalf("leaf %d of size %d: %v", tc.index, tc.size, err)
+		}
+		if !equalProofs(got, want) {
+			t.Errorf("leaf %d of size %d: proof %x, want %x", tc.index, tc.size, got, want)
+		}
+		root := mustDecodeHex(t, testRoots[tc.size])
+		if err := VerifyInclusion(leaves[tc.index], tc.index, tc.size, want, root); err != nil {
+			t.Errorf("leaf %d of size %d: %v", tc.index, tc.size, err)
+		}
+	}
+	if _, err := InclusionProof(leaves[:3], 3); err == nil {
+		t.Error("proof for a leaf outside the tree was built")
+	}
+}
+
+func TestVerifyInclusionRejectsBadProofs(t *testing.T) {
+	leaves := testLeaves(t)
+	root := mustDecodeHex(t, testRoots[8])
+	proof := decodeProof(t, testInclusionProofs[2].proof)
+	cases := map[string]struct {
+		leaf        []byte
+		index, size uint64
+		proof       [][]byte
+	}{
+		"wrong leaf":     {leaves[4], 5, 8, proof},
+		"wrong index":    {leaves[5], 4, 8, proof},
+		"wrong size":     {leaves[5], 5, 6, proof},
+		"index too big":  {leaves[5], 8, 8, proof},
+		"short proof":    {leaves[5], 5, 8, proof[:2]},
+		"long proof":     {leaves[5], 5, 8, append(append([][]byte{}, proof...), root)},
+		"tampered proof": {leaves[5], 5, 8, [][]byte{proof[0], proof[2], proof[1]}},
+	}
+	for name, tc := range cases {
+		if err := VerifyInclusion(tc.leaf, tc.index, tc.size, tc.proof, root); !errors.Is(err, ErrInvalidProof) {
+			t.Errorf("%s: got %v, want ErrInvalidProof", name, err)
+		}
+	}
+}
+
+func TestConsistencyProof(t *testing.T) {
+	leaves := testLeaves(t)
+	for _, tc := range testConsistencyProofs {
+		want := decodeProof(t, tc.proof)
+		got, err := ConsistencyProof(leaves[:tc.second], tc.first)
+		if err != nil {
+			t.Fatalf("%d to %d: %v", tc.first, tc.second, err)
+		}
+		if !equalProofs(got, want) {
+			t.Errorf("%d to %d: proof %x, want %x", tc.first, tc.second, got, want)
+		}
+		first, second := mustDecodeHex(t, testRoots[tc.first]), mustDecodeHex(t, testRoots[tc.second])
+		if err := VerifyConsistency(tc.first, tc.second, first, second, want); err != nil {
+			t.Errorf("%d to %d: %v", tc.first, tc.second, err)
+		}
+	}
+	if _, err := ConsistencyProof(leaves[:3], 4); err == nil {
+		t.Error("proof from a larger tree was built")
+	}
+}
+
+func TestVerifyConsistencyRejectsBadProofs(t *testing.T) {
+	proof := decodeProof(t, testConsistencyProofs[2].proof)
+	root6, root7, root8 := mustDecodeHex(t, testRoots[6]), mustDecodeHex(t, testRoots[7]), mustDecodeHex(t, testRoots[8])
+	cases := map[string]struct {
+		first, second   uint64
+		firstRoot, root []byte
+		proof           [][]byte
+	}{
+		"wrong first root":  {6, 8, root7, root8, proof},
+		"wrong second root": {6, 8, root6, root7, proof},
+		"wrong first size":  {5, 8, root6, root8, proof},
+		"sizes swapped":     {8, 6, root8, root6, proof},
+		"empty proof":       {6, 8, root6, root8, nil},
+		"short proof":       {6, 8, root6, root8, proof[:2]},
+		"same size":         {8, 8, root8, root7, nil},
+	}
+	for name, tc := range cases {
+		if err := VerifyConsistency(tc.first, tc.second, tc.firstRoot, tc.root, tc.proof); !errors.Is(err, ErrInvalidProof) {
+			t.Errorf("%s: got %v, want ErrInvalidProof", name, err)
+		}
+	}
+}
+
+func TestProofsRoundTrip(t *testing.T) {
+	var leaves [][]byte
+	for i := 0; i < 40; i++ {
+		leaves = append(leaves, LeafHash([]byte{byte(i)}))
+	}
+	for size := uint64(1); size <= uint64(len(leaves)); size++ {
+		tree := leaves[:size]
+		root := RootHash(tree)
+		for index := uint64(0); index < size; index++ {
+			proof, err := InclusionProof(tree, index)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := VerifyInclusion(tree[index], index, size, proof, root); err != nil {
+				t.Errorf("leaf %d of size %d: %v", index, size, err)
+			}
+		}
+		for first := uint64(0); first <= size; first++ {
+			proof, err := ConsistencyProof(tree, first)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := VerifyConsistency(first, size, RootHash(tree[:first]), root, proof); err != nil {
+				t.Errorf("%d to %d: %v", first, size, err)
+			}
+		}
+	}
+}
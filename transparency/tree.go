@@ -0,0 +1,113 @@
+package transparency
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// NodeStore reads the hashes of a log's complete subtrees. A complete
+// subtree at level l holds 2^l leaves; the one at index i covers the leaves
+// from i*2^l up to (i+1)*2^l. Level 0 holds the leaf hashes themselves.
+type NodeStore interface {
+	Node(level uint, index uint64) ([]byte, error)
+}
+
+// Node is the hash of one complete subtree.
+type Node struct {
+	Level uint
+	Index uint64
+	Hash  []byte
+}
+
+// Tree is a log of Size leaves whose complete subtree hashes are kept in a
+// NodeStore. Every subtree a root or proof needs is made of at most one
+// complete subtree per level, so they are computed from O(log² n) node reads
+// instead of every leaf.
+type Tree struct {
+	Nodes NodeStore
+	Size  uint64
+}
+
+// subtree hashes the leaves from lo up to hi from the complete subtrees that
+// make them up.
+func (t Tree) subtree(lo, hi uint64) ([]byte, error) {
+	n := hi - lo
+	if n&(n-1) == 0 && lo%n == 0 {
+		return t.Nodes.Node(uint(bits.TrailingZeros64(n)), lo/n)
+	}
+	k := split(n)
+	left, err := t.subtree(lo, lo+k)
+	if err != nil {
+		return nil, err
+	}
+	right, err := t.subtree(lo+k, hi)
+	if err != nil {
+		return nil, err
+	}
+	return nodeHash(left, right), nil
+}
+
+// RootHash computes the root of the tree.
+func (t Tree) RootHash() ([]byte, error) {
+	if t.Size == 0 {
+		return emptyRoot(), nil
+	}
+	return t.subtree(0, t.Size)
+}
+
+// InclusionProof returns the audit path for the leaf at index.
+func (t Tree) InclusionProof(index uint64) ([][]byte, error) {
+	if index >= t.Size {
+		return nil, fmt.Errorf("leaf %d is outside a tree of size %d", index, t.Size)
+	}
+	return inclusionPath(t.subtree, 0, t.Size, index)
+}
+
+// ConsistencyProof returns the proof that the tree of the first size leaves
+// is a prefix of this tree.
+func (t Tree) ConsistencyProof(size uint64) ([][]byte, error) {
+	if size > t.Size {
+		return nil, fmt.Errorf("size %d is larger than the tree of size %d", size, t.Size)
+	}
+	if size == 0 || size == t.Size {
+		return nil, nil
+	}
+	return consistencySubproof(t.subtree, 0, t.Size, size, true)
+}
+
+// Append adds a leaf at the end of the tree. It returns the complete subtrees
+// the leaf finishes, starting with the leaf itself at level 0, and the root of
+// the grown tree. The caller stores the nodes; t is left unchanged.
+func (t Tree) Append(leafHash []byte) ([]Node, []byte, error) {
+	nodes := []Node{{Level: 0, Index: t.Size, Hash: leafHash}}
+	hash, index := leafHash, t.Size
+	for level := uint(0); index&1 == 1; level++ {
+		left, err := t.Nodes.Node(level, index-1)
+		if err != nil {
+			return nil, nil, err
+		}
+		hash, index = nodeHash(left, hash), index>>1
+		nodes = append(nodes, Node{Level: level + 1, Index: index, Hash: hash})
+	}
+	grown := Tree{Nodes: appendedNodes{NodeStore: t.Nodes, nodes: nodes}, Size: t.Size + 1}
+	root, err := grown.RootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+	return nodes, root, nil
+}
+
+// appendedNodes reads nodes that are not stored yet ahead of the store.
+type appendedNodes struct {
+	NodeStore
+	nodes []Node
+}
+
+func (s appendedNodes) Node(level uint, index uint64) ([]byte, error) {
+	for _, n := range s.nodes {
+		if n.Level == level && n.Index == index {
+			return n.Hash, nil
+		}
+	}
+	return s.NodeStore.Node(level, index)
+}
@@ -0,0 +1,108 @@
+package transparency
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// memoryNodes is a NodeStore kept in a map.
+type memoryNodes map[[2]uint64][]byte
+
+func (m memoryNodes) Node(level uint, index uint64) ([]byte, error) {
+	hash, ok := m[[2]uint64{uint64(level), index}]
+	if !ok {
+		return nil, fmt.Errorf("no node %d at level %d", index, level)
+	}
+	return hash, nil
+}
+
+func TestTreeMatchesLeaves(t *testing.T) {
+	nodes := memoryNodes{}
+	tree := Tree{Nodes: nodes}
+	var leaves [][]byte
+	for i := 0; i < 40; i++ {
+		leaf := LeafHash([]byte{byte(i)})
+		added, root, err := tree.Append(leaf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, n := range added {
+			if _, ok := nodes[[2]uint64{uint64(n.Level), n.Index}]; ok {
+				t.Fatalf("node %d at level %d was returned twice", n.Index, n.Level)
+			}
+			nodes[[2]uint64{uint64(n.Level), n.Index}] = n.Hash
+		}
+		leaves = append(leaves, leaf)
+		tree.Size++
+
+		if want := RootHash(leaves); !bytes.Equal(root, want) {
+			t.Fatalf("size %d: appended root %x, want %x", tree.Size, root, want)
+		}
+		if got, err := tree.RootHash(); err != nil || !bytes.Equal(got, root) {
+			t.Fatalf("size %d: root %x (%v), want %x", tree.Size, got, err, root)
+		}
+		for index := uint64(0); index < tree.Size; index++ {
+			got, err := tree.InclusionProof(index)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, _ := InclusionProof(leaves, index)
+			if !equalProofs(got, want) {
+				t.Errorf("size %d: inclusion proof of leaf %d differs", tree.Size, index)
+			}
+		}
+		for first := uint64(0); first <= tree.Size; first++ {
+			got, err := tree.ConsistencyProof(first)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, _ := ConsistencyProof(leaves, first)
+			if !equalProofs(got, want) {
+				t.Errorf("size %d: consistency proof from %d differs", tree.Size, first)
+			}
+		}
+	}
+}
+
+func TestTreeVectors(t *testing.T) {
+	nodes := memoryNodes{}
+	tree := Tree{Nodes: nodes}
+	if root, err := tree.RootHash(); err != nil || !bytes.Equal(root, mustDecodeHex(t, testRoots[0])) {
+		t.Fatalf("empty root %x (%v)", root, err)
+	}
+	for _, leaf := range testLeaves(t) {
+		added, _, err := tree.Append(leaf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, n := range added {
+			nodes[[2]uint64{uint64(n.Level), n.Index}] = n.Hash
+		}
+		tree.Size++
+	}
+	for _, tc := range testInclusionProofs {
+		got, err := Tree{Nodes: nodes, Size: tc.size}.InclusionProof(tc.index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !equalProofs(got, decodeProof(t, tc.proof)) {
+			t.Errorf("leaf %d of size %d: proof %x", tc.index, tc.size, got)
+		}
+	}
+	for _, tc := range testConsistencyProofs {
+		got, err := Tree{Nodes: nodes, Size: tc.second}.ConsistencyProof(tc.first)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !equalProofs(got, decodeProof(t, tc.proof)) {
+			t.Errorf("%d to %d: proof %x", tc.first, tc.second, got)
+		}
+	}
+	if _, err := tree.InclusionProof(tree.Size); err == nil {
+		t.Error("proof for a leaf outside the tree was built")
+	}
+	if _, err := tree.ConsistencyProof(tree.Size + 1); err == nil {
+		t.Error("proof from a larger tree was built")
+	}
+}
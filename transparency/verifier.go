@@ -0,0 +1,111 @@
+package transparency
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrSplitView is returned when two validly signed tree heads cannot belong
+// to the same append-only log, which means the server has shown different
+// logs to different clients or rewritten its history.
+var ErrSplitView = errors.New("key transparency log split view")
+
+// Verifier tracks the latest tree head a client trusts and checks everything
+// the server serves against it. It is safe for concurrent use.
+type Verifier struct {
+	key ed25519.PublicKey
+
+	mu   sync.Mutex
+	head *TreeHead
+}
+
+// NewVerifier returns a verifier for the log signed by key. A client that
+// stored a trusted head from an earlier run passes it as head; otherwise head
+// is nil and the first head given to Update is trusted.
+func NewVerifier(key ed25519.PublicKey, head *TreeHead) (*Verifier, error) {
+	if head != nil {
+		if err := head.Verify(key); err != nil {
+			return nil, err
+		}
+	}
+	return &Verifier{key: key, head: head}, nil
+}
+
+// Head returns the latest trusted tree head, or nil before the first Update.
+func (v *Verifier) Head() *TreeHead {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.head
+}
+
+// Update checks a tree head served by the log and, if it extends the trusted
+// head, trusts it instead. proof is the consistency proof from the trusted
+// head's size to the new head's size; it is ignored before the first head is
+// trusted. A head smaller than the trusted one is checked the other way round
+// and kept out, so a lagging replica is not mistaken for a split view.
+func (v *Verifier) Update(head *TreeHead, proof [][]byte) error {
+	if err := head.Verify(v.key); err != nil {
+		return err
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.head == nil {
+		v.head = head
+		return nil
+	}
+	if err := checkConsistent(v.head, head, proof); err != nil {
+		return err
+	}
+	if head.Size > v.head.Size {
+		v.head = head
+	}
+	return nil
+}
+
+// CheckHead compares a tree head obtained elsewhere, for example from another
+// client, with the trusted head. proof is the consistency proof between the
+// smaller and the larger of the two heads.
+func (v *Verifier) CheckHead(head *TreeHead, proof [][]byte) error {
+	if err := head.Verify(v.key); err != nil {
+		return err
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.head == nil {
+		return errors.New("no trusted tree head")
+	}
+	return checkConsistent(v.head, head, proof)
+}
+
+// VerifyEntry checks that entry is the leaf at index in the log described by
+// the trusted head, using the inclusion proof for that head's size.
+func (v *Verifier) VerifyEntry(entry KeyEntry, index uint64, proof [][]byte) error {
+	v.mu.Lock()
+	head := v.head
+	v.mu.Unlock()
+	if head == nil {
+		return errors.New("no trusted tree head")
+	}
+	return VerifyInclusion(entry.LeafHash(), index, head.Size, proof, head.RootHash)
+}
+
+// checkConsistent reports ErrSplitView unless the two signed heads describe
+// the same log at different sizes.
+func checkConsistent(a, b *TreeHead, proof [][]byte) error {
+	if a.Size > b.Size {
+		a, b = b, a
+	}
+	if a.Size == b.Size {
+		if !bytes.Equal(a.RootHash, b.RootHash) {
+			return fmt.Errorf("%w: two roots for size %d", ErrSplitView, a.Size)
+		}
+		return nil
+	}
+	if err := VerifyConsistency(a.Size, b.Size, a.RootHash, b.RootHash, proof); err != nil {
+		return fmt.Errorf("%w: tree of size %d does not extend size %d: %v", ErrSplitView, b.Size, a.Size, err)
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package transparency
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+)
+
+// testLog is a log the tests append key entries to and sign heads for.
+type testLog struct {
+	key     ed25519.PrivateKey
+	entries []KeyEntry
+	leaves  [][]byte
+}
+
+func newTestLog(t *testing.T) *testLog {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testLog{key: key}
+}
+
+func (l *testLog) append(userID, deviceID int, identityKey string) {
+	entry := KeyEntry{
+		UserID:      userID,
+		DeviceID:    deviceID,
+		IdentityKey: identityKey,
+		Timestamp:   time.UnixMilli(int64(1700000000000 + len(l.entries))),
+	}
+	l.entries = append(l.entries, entry)
+	l.leaves = append(l.leaves, entry.LeafHash())
+}
+
+// fork returns a copy of the log sharing its key, so the two can diverge.
+func (l *testLog) fork() *testLog {
+	return &testLog{
+		key:     l.key,
+		entries: append([]KeyEntry(nil), l.entries...),
+		leaves:  append([][]byte(nil), l.leaves...),
+	}
+}
+
+func (l *testLog) head(size int) *TreeHead {
+	return SignTreeHead(l.key, uint64(size), RootHash(l.leaves[:size]), time.Now())
+}
+
+func (l *testLog) consistency(t *testing.T, first, second int) [][]byte {
+	t.Helper()
+	proof, err := ConsistencyProof(l.leaves[:second], uint64(first))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proof
+}
+
+func (l *testLog) verifier(t *testing.T) *Verifier {
+	t.Helper()
+	v, err := NewVerifier(l.key.Public().(ed25519.PublicKey), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestVerifierFollowsLog(t *testing.T) {
+	log := newTestLog(t)
+	for i := 1; i <= 3; i++ {
+		log.append(i, 1, "a2V5")
+	}
+	v := log.verifier(t)
+	if err := v.Update(log.head(3), nil); err != nil {
+		t.Fatal(err)
+	}
+	log.append(1, 2, "b3RoZXI=")
+	log.append(4, 1, "bmV3")
+	if err := v.Update(log.head(5), log.consistency(t, 3, 5)); err != nil {
+		t.Fatalf("consistent head rejected: %v", err)
+	}
+	if got := v.Head().Size; got != 5 {
+		t.Fatalf("trusted size %d, want 5", got)
+	}
+	// A lagging replica's older head is accepted but not trusted.
+	if err := v.Update(log.head(4), log.consistency(t, 4, 5)); err != nil {
+		t.Fatalf("older head rejected: %v", err)
+	}
+	if got := v.Head().Size; got != 5 {
+		t.Fatalf("trusted size %d after an older head, want 5", got)
+	}
+
+	for index, entry := range log.entries {
+		proof, err := InclusionProof(log.leaves, uint64(index))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := v.VerifyEntry(entry, uint64(index), proof); err != nil {
+			t.Errorf("entry %d: %v", index, err)
+		}
+	}
+	proof, _ := InclusionProof(log.leaves, 1)
+	forged := log.entries[1]
+	forged.IdentityKey = "Zm9yZ2Vk"
+	if err := v.VerifyEntry(forged, 1, proof); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("forged entry: got %v, want ErrInvalidProof", err)
+	}
+}
+
+func TestVerifierDetectsSplitView(t *testing.T) {
+	log := newTestLog(t)
+	log.append(1, 1, "a2V5")
+	log.append(2, 1, "a2V5")
+	other := log.fork()
+	log.append(3, 1, "cmVhbA==")
+	other.append(3, 1, "c3dhcHBlZA==")
+	log.append(4, 1, "a2V5")
+	other.append(4, 1, "a2V5")
+
+	v := log.verifier(t)
+	if err := v.Update(log.head(3), nil); err != nil {
+		t.Fatal(err)
+	}
+	// The same size with another root.
+	if err := v.CheckHead(other.head(3), nil); !errors.Is(err, ErrSplitView) {
+		t.Errorf("same size: got %v, want ErrSplitView", err)
+	}
+	// A larger forked head cannot be proven to extend the trusted one.
+	if err := v.Update(other.head(4), other.consistency(t, 3, 4)); !errors.Is(err, ErrSplitView) {
+		t.Errorf("larger fork: got %v, want ErrSplitView", err)
+	}
+	if got := v.Head().Size; got != 3 {
+		t.Errorf("a forked head was trusted: size %d", got)
+	}
+	// Heads of the shared prefix are fine.
+	if err := v.CheckHead(other.head(2), log.consistency(t, 2, 3)); err != nil {
+		t.Errorf("shared prefix: %v", err)
+	}
+	if err := v.CheckHead(log.head(4), log.consistency(t, 3, 4)); err != nil {
+		t.Errorf("honest larger head: %v", err)
+	}
+}
+
+func TestVerifierRejectsForeignHeads(t *testing.T) {
+	log := newTestLog(t)
+	log.append(1, 1, "a2V5")
+	v := log.verifier(t)
+	if err := v.CheckHead(log.head(1), nil); err == nil {
+		t.Error("a head was checked before any head was trusted")
+	}
+
+	impostor := log.fork()
+	_, impostor.key, _ = ed25519.GenerateKey(rand.Reader)
+	if err := v.Update(impostor.head(1), nil); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("head signed by another key: got %v, want ErrInvalidSignature", err)
+	}
+	tampered := log.head(1)
+	tampered.Size = 2
+	if err := v.Update(tampered, nil); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("tampered head: got %v, want ErrInvalidSignature", err)
+	}
+	if _, err := NewVerifier(log.key.Public().(ed25519.PublicKey), impostor.head(1)); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("stored head signed by another key: got %v, want ErrInvalidSignature", err)
+	}
+}